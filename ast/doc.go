@@ -38,4 +38,10 @@
 //   Name of the user who composed this message.
 //
 //   If unset, the comment was posted by an anonymous user.
+//
+// Rewriting
+//
+// Tools which change Thrift files may rewrite the AST with Rewrite and
+// write it back with Format. Format retains docstrings but drops other
+// comments.
 package ast
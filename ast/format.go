@@ -0,0 +1,258 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package ast
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Format writes the given program to the given Writer as Thrift IDL.
+//
+// The output is in a canonical layout: headers are listed first and
+// definitions are separated by blank lines and indented with four spaces.
+// Docstrings are retained but other comments are not, since they are not
+// recorded in the AST.
+//
+// Format may be combined with Rewrite to programmatically change Thrift
+// files.
+//
+//   prog, err := idl.Parse(contents)
+//   ...
+//   ast.Rewrite(prog, rename)
+//   err = ast.Format(w, prog)
+func Format(w io.Writer, p *Program) error {
+	bw := bufio.NewWriter(w)
+	f := formatter{w: bw}
+	f.program(p)
+	return bw.Flush()
+}
+
+type formatter struct {
+	w *bufio.Writer
+}
+
+func (f formatter) printf(format string, args ...interface{}) {
+	fmt.Fprintf(f.w, format, args...)
+}
+
+func (f formatter) program(p *Program) {
+	var last Header
+	for _, h := range p.Headers {
+		// Separate includes from namespaces.
+		if last != nil && fmt.Sprintf("%T", last) != fmt.Sprintf("%T", h) {
+			f.printf("\n")
+		}
+		f.header(h)
+		last = h
+	}
+
+	for i, d := range p.Definitions {
+		if i > 0 || len(p.Headers) > 0 {
+			f.printf("\n")
+		}
+		f.definition(d)
+	}
+}
+
+func (f formatter) header(h Header) {
+	switch h := h.(type) {
+	case *Include:
+		if h.Name != "" {
+			f.printf("include %v %v\n", h.Name, strconv.Quote(h.Path))
+		} else {
+			f.printf("include %v\n", strconv.Quote(h.Path))
+		}
+	case *Namespace:
+		f.printf("namespace %v %v\n", h.Scope, h.Name)
+	default:
+		panic(fmt.Sprintf("unknown header %T", h))
+	}
+}
+
+func (f formatter) definition(d Definition) {
+	switch d := d.(type) {
+	case *Constant:
+		f.doc("", d.Doc)
+		f.printf("const %v %v = %v\n", d.Type, d.Name, FormatConstantValue(d.Value))
+	case *Typedef:
+		f.doc("", d.Doc)
+		f.printf("typedef %v %v%v\n", d.Type, d.Name, annotationSuffix(d.Annotations))
+	case *Enum:
+		f.doc("", d.Doc)
+		f.printf("enum %v {\n", d.Name)
+		for _, item := range d.Items {
+			f.doc("    ", item.Doc)
+			f.printf("    %v", item.Name)
+			if item.Value != nil {
+				f.printf(" = %d", *item.Value)
+			}
+			f.printf("%v\n", annotationSuffix(item.Annotations))
+		}
+		f.printf("}%v\n", annotationSuffix(d.Annotations))
+	case *Struct:
+		f.doc("", d.Doc)
+		f.printf("%v %v {", structureKeyword(d.Type), d.Name)
+		if len(d.Fields) > 0 {
+			f.printf("\n")
+		}
+		for _, field := range d.Fields {
+			f.doc("    ", field.Doc)
+			f.printf("    %v\n", formatField(field))
+		}
+		f.printf("}%v\n", annotationSuffix(d.Annotations))
+	case *Service:
+		f.doc("", d.Doc)
+		f.printf("service %v ", d.Name)
+		if d.Parent != nil {
+			f.printf("extends %v ", d.Parent.Name)
+		}
+		f.printf("{")
+		if len(d.Functions) > 0 {
+			f.printf("\n")
+		}
+		for _, fn := range d.Functions {
+			f.doc("    ", fn.Doc)
+			f.printf("    %v\n", formatFunction(fn))
+		}
+		f.printf("}%v\n", annotationSuffix(d.Annotations))
+	default:
+		panic(fmt.Sprintf("unknown definition %T", d))
+	}
+}
+
+// doc writes the given docstring, if any, at the given indentation.
+func (f formatter) doc(indent, doc string) {
+	if doc == "" {
+		return
+	}
+
+	f.printf("%v/**\n", indent)
+	for _, line := range strings.Split(doc, "\n") {
+		if line == "" {
+			f.printf("%v *\n", indent)
+		} else {
+			f.printf("%v * %v\n", indent, line)
+		}
+	}
+	f.printf("%v */\n", indent)
+}
+
+func structureKeyword(t StructureType) string {
+	switch t {
+	case StructType:
+		return "struct"
+	case UnionType:
+		return "union"
+	case ExceptionType:
+		return "exception"
+	default:
+		panic(fmt.Sprintf("unknown structure type %v", t))
+	}
+}
+
+func formatField(field *Field) string {
+	s := fmt.Sprintf("%d: ", field.ID)
+	switch field.Requiredness {
+	case Required:
+		s += "required "
+	case Optional:
+		s += "optional "
+	}
+	s += field.Type.String() + " " + field.Name
+	if field.Default != nil {
+		s += " = " + FormatConstantValue(field.Default)
+	}
+	return s + annotationSuffix(field.Annotations)
+}
+
+func formatFunction(fn *Function) string {
+	var s string
+	if fn.OneWay {
+		s = "oneway "
+	}
+	if fn.ReturnType == nil {
+		s += "void"
+	} else {
+		s += fn.ReturnType.String()
+	}
+	s += " " + fn.Name + "(" + formatFields(fn.Parameters) + ")"
+	if len(fn.Exceptions) > 0 {
+		s += " throws (" + formatFields(fn.Exceptions) + ")"
+	}
+	return s + annotationSuffix(fn.Annotations)
+}
+
+func formatFields(fields []*Field) string {
+	fs := make([]string, len(fields))
+	for i, field := range fields {
+		fs[i] = formatField(field)
+	}
+	return strings.Join(fs, ", ")
+}
+
+// FormatConstantValue formats a constant value as it would appear in a
+// Thrift file.
+func FormatConstantValue(v ConstantValue) string {
+	switch v := v.(type) {
+	case ConstantBoolean:
+		return strconv.FormatBool(bool(v))
+	case ConstantInteger:
+		return strconv.FormatInt(int64(v), 10)
+	case ConstantString:
+		return strconv.Quote(string(v))
+	case ConstantDouble:
+		// Always write a decimal point so that doubles are not mistaken for
+		// integers, even by parsers which do not accept exponents without one.
+		s := strconv.FormatFloat(float64(v), 'g', -1, 64)
+		if strings.Contains(s, ".") {
+			return s
+		}
+		if i := strings.IndexByte(s, 'e'); i >= 0 {
+			return s[:i] + ".0" + s[i:]
+		}
+		return s + ".0"
+	case ConstantReference:
+		return v.Name
+	case ConstantList:
+		items := make([]string, len(v.Items))
+		for i, item := range v.Items {
+			items[i] = FormatConstantValue(item)
+		}
+		return "[" + strings.Join(items, ", ") + "]"
+	case ConstantMap:
+		items := make([]string, len(v.Items))
+		for i, item := range v.Items {
+			items[i] = FormatConstantValue(item.Key) + ": " + FormatConstantValue(item.Value)
+		}
+		return "{" + strings.Join(items, ", ") + "}"
+	default:
+		panic(fmt.Sprintf("unknown constant value %T", v))
+	}
+}
+
+// annotationSuffix formats the given annotations with a leading space, or
+// returns an empty string if there are none.
+func annotationSuffix(anns []*Annotation) string {
+	return appendAnnotations("", anns)
+}
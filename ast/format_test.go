@@ -0,0 +1,166 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package ast_test
+
+import (
+	"bytes"
+	"testing"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/idl"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func format(t *testing.T, prog *ast.Program) string {
+	var buf bytes.Buffer
+	require.NoError(t, ast.Format(&buf, prog))
+	return buf.String()
+}
+
+func TestFormat(t *testing.T) {
+	const src = `
+		namespace go foo.bar
+		include "./shared.thrift"
+		include other "./other.thrift"
+
+		// Regular comments are dropped.
+		const i32 count = 42
+		const double ratio = 1.0
+		const map<string, list<i32>> lookup = {"a": [1, 2], "b\n": []}
+		const shared.Thing thing = shared.DefaultThing
+
+		/** An alias. */
+		typedef binary (js.type = "Buffer") Bytes (foo = "bar")
+
+		/**
+		 * Colors.
+		 *
+		 * Only two of them.
+		 */
+		enum Color {
+			Red = 1 (go.name = "Rouge"), Green
+		}
+
+		struct Empty {}
+
+		union Value {
+			1: string stringValue (foo = "bar")
+			/** A number. */
+			2: i64 intValue = 0
+		}
+
+		exception Failed {
+			1: required string message
+			2: optional set<Color> colors
+		}
+
+		service Base {}
+
+		service Values extends Base {
+			/** Gets a value. */
+			Value getValue(1: string key, 2: bool fresh = false) throws (1: Failed failed) (cache = "1s")
+			oneway void ping()
+		} (owner = "values")
+	`
+
+	prog, err := idl.Parse([]byte(src))
+	require.NoError(t, err)
+
+	got := format(t, prog)
+	assert.Equal(t, `namespace go foo.bar
+
+include "./shared.thrift"
+include other "./other.thrift"
+
+const i32 count = 42
+
+const double ratio = 1.0
+
+const map<string, list<i32>> lookup = {"a": [1, 2], "b\n": []}
+
+const shared.Thing thing = shared.DefaultThing
+
+/**
+ * An alias.
+ */
+typedef binary (js.type = "Buffer") Bytes (foo = "bar")
+
+/**
+ * Colors.
+ *
+ * Only two of them.
+ */
+enum Color {
+    Red = 1 (go.name = "Rouge")
+    Green
+}
+
+struct Empty {}
+
+union Value {
+    1: string stringValue (foo = "bar")
+    /**
+     * A number.
+     */
+    2: i64 intValue = 0
+}
+
+exception Failed {
+    1: required string message
+    2: optional set<Color> colors
+}
+
+service Base {}
+
+service Values extends Base {
+    /**
+     * Gets a value.
+     */
+    Value getValue(1: string key, 2: bool fresh = false) throws (1: Failed failed) (cache = "1s")
+    oneway void ping()
+} (owner = "values")
+`, got)
+
+	// Formatted output must parse to a program that formats the same way.
+	reparsed, err := idl.Parse([]byte(got))
+	require.NoError(t, err)
+	assert.Equal(t, got, format(t, reparsed))
+}
+
+func TestFormatConstantValue(t *testing.T) {
+	tests := []struct {
+		give ast.ConstantValue
+		want string
+	}{
+		{ast.ConstantBoolean(true), "true"},
+		{ast.ConstantInteger(-1), "-1"},
+		{ast.ConstantString(`a "quoted" string`), `"a \"quoted\" string"`},
+		{ast.ConstantDouble(1.5), "1.5"},
+		{ast.ConstantDouble(3), "3.0"},
+		{ast.ConstantDouble(1e21), "1.0e+21"},
+		{ast.ConstantReference{Name: "Color.Red"}, "Color.Red"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, ast.FormatConstantValue(tt.give))
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package ast
+
+import "fmt"
+
+// RewriteFunc is called by Rewrite for each node of the AST. The node it
+// returns replaces the given node in the AST. It may return the given node
+// unchanged, modify and return it, or return a different node.
+//
+// Returning nil removes the node from the list that holds it, like the
+// fields of a struct or the annotations of a type. Nodes that are not in a
+// list, like the type of a field, are set to nil, so RewriteFunc must not
+// return nil for nodes that are required.
+type RewriteFunc func(w Walker, n Node) Node
+
+// Rewrite walks the AST depth-first starting at the given node, replacing
+// each node with the result of calling the given function on it. Children
+// are rewritten before their parents so that the function sees parents with
+// their rewritten children. It returns the rewritten node.
+//
+// Nodes referenced by pointers, like *Struct and *Field, are modified in
+// place. Nodes that are values, like ListType, are replaced in their
+// parents.
+//
+// For example, the following renames all references to a type.
+//
+//   ast.Rewrite(prog, func(w ast.Walker, n ast.Node) ast.Node {
+//     if ref, ok := n.(ast.TypeReference); ok && ref.Name == "OldName" {
+//       ref.Name = "NewName"
+//       return ref
+//     }
+//     return n
+//   })
+//
+// Rewrite panics if a node is replaced with a node that its parent cannot
+// hold, like a constant in place of a type.
+func Rewrite(n Node, f RewriteFunc) Node {
+	return rewriter{f: f}.rewrite(nil, n)
+}
+
+type rewriter struct {
+	f RewriteFunc
+}
+
+func (r rewriter) rewrite(ss nodeStack, n Node) Node {
+	if n == nil {
+		return nil
+	}
+
+	children := append(ss, n)
+	switch n := n.(type) {
+	case *Program:
+		var headers []Header
+		for _, h := range n.Headers {
+			if h := r.rewrite(children, h); h != nil {
+				header, ok := h.(Header)
+				if !ok {
+					panic(replaceError("header", h))
+				}
+				headers = append(headers, header)
+			}
+		}
+		n.Headers = headers
+
+		var definitions []Definition
+		for _, d := range n.Definitions {
+			if d := r.rewrite(children, d); d != nil {
+				definition, ok := d.(Definition)
+				if !ok {
+					panic(replaceError("definition", d))
+				}
+				definitions = append(definitions, definition)
+			}
+		}
+		n.Definitions = definitions
+		return r.f(ss, n)
+	case *Constant:
+		n.Type = r.typ(children, n.Type)
+		n.Value = r.constantValue(children, n.Value)
+		return r.f(ss, n)
+	case *Typedef:
+		n.Type = r.typ(children, n.Type)
+		n.Annotations = r.annotations(children, n.Annotations)
+		return r.f(ss, n)
+	case *Enum:
+		var items []*EnumItem
+		for _, item := range n.Items {
+			if i := r.rewrite(children, item); i != nil {
+				item, ok := i.(*EnumItem)
+				if !ok {
+					panic(replaceError("*ast.EnumItem", i))
+				}
+				items = append(items, item)
+			}
+		}
+		n.Items = items
+		n.Annotations = r.annotations(children, n.Annotations)
+		return r.f(ss, n)
+	case *EnumItem:
+		n.Annotations = r.annotations(children, n.Annotations)
+		return r.f(ss, n)
+	case *Struct:
+		n.Fields = r.fields(children, n.Fields)
+		n.Annotations = r.annotations(children, n.Annotations)
+		return r.f(ss, n)
+	case *Service:
+		var functions []*Function
+		for _, function := range n.Functions {
+			if f := r.rewrite(children, function); f != nil {
+				function, ok := f.(*Function)
+				if !ok {
+					panic(replaceError("*ast.Function", f))
+				}
+				functions = append(functions, function)
+			}
+		}
+		n.Functions = functions
+		n.Annotations = r.annotations(children, n.Annotations)
+		return r.f(ss, n)
+	case *Function:
+		n.ReturnType = r.typ(children, n.ReturnType)
+		n.Parameters = r.fields(children, n.Parameters)
+		n.Exceptions = r.fields(children, n.Exceptions)
+		n.Annotations = r.annotations(children, n.Annotations)
+		return r.f(ss, n)
+	case *Field:
+		n.Type = r.typ(children, n.Type)
+		n.Default = r.constantValue(children, n.Default)
+		n.Annotations = r.annotations(children, n.Annotations)
+		return r.f(ss, n)
+	case BaseType:
+		n.Annotations = r.annotations(children, n.Annotations)
+		return r.f(ss, n)
+	case MapType:
+		n.KeyType = r.typ(children, n.KeyType)
+		n.ValueType = r.typ(children, n.ValueType)
+		n.Annotations = r.annotations(children, n.Annotations)
+		return r.f(ss, n)
+	case ListType:
+		n.ValueType = r.typ(children, n.ValueType)
+		n.Annotations = r.annotations(children, n.Annotations)
+		return r.f(ss, n)
+	case SetType:
+		n.ValueType = r.typ(children, n.ValueType)
+		n.Annotations = r.annotations(children, n.Annotations)
+		return r.f(ss, n)
+	case ConstantList:
+		var items []ConstantValue
+		for _, item := range n.Items {
+			if item := r.constantValue(children, item); item != nil {
+				items = append(items, item)
+			}
+		}
+		n.Items = items
+		return r.f(ss, n)
+	case ConstantMap:
+		var items []ConstantMapItem
+		for _, item := range n.Items {
+			if i := r.rewrite(children, item); i != nil {
+				item, ok := i.(ConstantMapItem)
+				if !ok {
+					panic(replaceError("ast.ConstantMapItem", i))
+				}
+				items = append(items, item)
+			}
+		}
+		n.Items = items
+		return r.f(ss, n)
+	case ConstantMapItem:
+		n.Key = r.constantValue(children, n.Key)
+		n.Value = r.constantValue(children, n.Value)
+		return r.f(ss, n)
+	case *Annotation, *Include, *Namespace, TypeReference, ConstantBoolean,
+		ConstantInteger, ConstantString, ConstantDouble, ConstantReference:
+		// These nodes have no children.
+		return r.f(ss, n)
+	default:
+		panic(fmt.Sprintf("unknown node %T", n))
+	}
+}
+
+func (r rewriter) typ(ss nodeStack, t Type) Type {
+	n := r.rewrite(ss, t)
+	if n == nil {
+		return nil
+	}
+	t, ok := n.(Type)
+	if !ok {
+		panic(replaceError("type", n))
+	}
+	return t
+}
+
+func (r rewriter) constantValue(ss nodeStack, v ConstantValue) ConstantValue {
+	n := r.rewrite(ss, v)
+	if n == nil {
+		return nil
+	}
+	v, ok := n.(ConstantValue)
+	if !ok {
+		panic(replaceError("constant value", n))
+	}
+	return v
+}
+
+func (r rewriter) annotations(ss nodeStack, anns []*Annotation) []*Annotation {
+	var out []*Annotation
+	for _, ann := range anns {
+		if n := r.rewrite(ss, ann); n != nil {
+			ann, ok := n.(*Annotation)
+			if !ok {
+				panic(replaceError("*ast.Annotation", n))
+			}
+			out = append(out, ann)
+		}
+	}
+	return out
+}
+
+func (r rewriter) fields(ss nodeStack, fields []*Field) []*Field {
+	var out []*Field
+	for _, field := range fields {
+		if n := r.rewrite(ss, field); n != nil {
+			field, ok := n.(*Field)
+			if !ok {
+				panic(replaceError("*ast.Field", n))
+			}
+			out = append(out, field)
+		}
+	}
+	return out
+}
+
+func replaceError(want string, got Node) string {
+	return fmt.Sprintf("ast.Rewrite: cannot replace a %v with a %T", want, got)
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package ast_test
+
+import (
+	"fmt"
+	"testing"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/idl"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRewriteVisitsAllNodes(t *testing.T) {
+	prog, err := idl.Parse([]byte(allNodesThrift))
+	require.NoError(t, err)
+	before := format(t, prog)
+
+	walked := make(map[string]int)
+	ast.Walk(ast.VisitorFunc(func(w ast.Walker, n ast.Node) {
+		walked[fmt.Sprintf("%T", n)]++
+	}), prog)
+
+	rewritten := make(map[string]int)
+	got := ast.Rewrite(prog, func(w ast.Walker, n ast.Node) ast.Node {
+		rewritten[fmt.Sprintf("%T", n)]++
+		return n
+	})
+
+	assert.Equal(t, walked, rewritten)
+	assert.Equal(t, prog, got)
+	assert.Equal(t, before, format(t, prog), "identity rewrite must not change the program")
+}
+
+func TestRewrite(t *testing.T) {
+	prog, err := idl.Parse([]byte(`
+		typedef string UserID
+
+		struct User {
+			1: required UserID id
+			2: optional string emailAddress (deprecated = "true")
+			3: optional list<UserID> friends
+		}
+
+		service Users {
+			User getUser(1: UserID id)
+		}
+	`))
+	require.NoError(t, err)
+
+	ast.Rewrite(prog, func(w ast.Walker, n ast.Node) ast.Node {
+		switch n := n.(type) {
+		case ast.TypeReference:
+			// Change a type reference.
+			if n.Name == "UserID" {
+				return ast.BaseType{ID: ast.I64TypeID}
+			}
+		case *ast.Typedef:
+			// Remove a definition.
+			if n.Name == "UserID" {
+				return nil
+			}
+		case *ast.Annotation:
+			// Remove an annotation.
+			if n.Name == "deprecated" {
+				return nil
+			}
+		case *ast.Field:
+			// Rename a field if it is in a struct, not a function.
+			if _, ok := w.Parent().(*ast.Struct); ok && n.Name == "emailAddress" {
+				n.Name = "email"
+			}
+		case *ast.Struct:
+			// Add an annotation.
+			n.Annotations = append(n.Annotations, &ast.Annotation{Name: "owner", Value: "users"})
+		}
+		return n
+	})
+
+	assert.Equal(t, `struct User {
+    1: required i64 id
+    2: optional string email
+    3: optional list<i64> friends
+} (owner = "users")
+
+service Users {
+    User getUser(1: i64 id)
+}
+`, format(t, prog))
+}
+
+func TestRewriteInvalidReplacement(t *testing.T) {
+	prog, err := idl.Parse([]byte(`const i32 foo = 42`))
+	require.NoError(t, err)
+
+	assert.Panics(t, func() {
+		ast.Rewrite(prog, func(w ast.Walker, n ast.Node) ast.Node {
+			if _, ok := n.(ast.BaseType); ok {
+				return ast.ConstantInteger(42)
+			}
+			return n
+		})
+	})
+}
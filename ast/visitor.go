@@ -66,3 +66,160 @@ func (vs multiVisitor) Visit(w Walker, n Node) Visitor {
 	}
 	return newVS
 }
+
+// TypedVisitor is a Visitor which calls the hook for the type of each node
+// it visits. Hooks which are nil are skipped, and all descendants of a node
+// are visited regardless of the hooks that are set. If set, Node is called
+// for every node before the hook for its type.
+//
+//   ast.Walk(ast.TypedVisitor{
+//     Struct: func(w ast.Walker, s *ast.Struct) {
+//       fmt.Println(s.Name)
+//     },
+//   }, program)
+type TypedVisitor struct {
+	Node func(Walker, Node)
+
+	Program   func(Walker, *Program)
+	Include   func(Walker, *Include)
+	Namespace func(Walker, *Namespace)
+
+	Constant func(Walker, *Constant)
+	Typedef  func(Walker, *Typedef)
+	Enum     func(Walker, *Enum)
+	EnumItem func(Walker, *EnumItem)
+	Struct   func(Walker, *Struct)
+	Field    func(Walker, *Field)
+	Service  func(Walker, *Service)
+	Function func(Walker, *Function)
+
+	BaseType      func(Walker, BaseType)
+	MapType       func(Walker, MapType)
+	ListType      func(Walker, ListType)
+	SetType       func(Walker, SetType)
+	TypeReference func(Walker, TypeReference)
+
+	ConstantBoolean   func(Walker, ConstantBoolean)
+	ConstantInteger   func(Walker, ConstantInteger)
+	ConstantString    func(Walker, ConstantString)
+	ConstantDouble    func(Walker, ConstantDouble)
+	ConstantReference func(Walker, ConstantReference)
+	ConstantMap       func(Walker, ConstantMap)
+	ConstantMapItem   func(Walker, ConstantMapItem)
+	ConstantList      func(Walker, ConstantList)
+
+	Annotation func(Walker, *Annotation)
+}
+
+var _ Visitor = TypedVisitor{}
+
+// Visit calls the hooks for the given node.
+func (v TypedVisitor) Visit(w Walker, n Node) Visitor {
+	if v.Node != nil {
+		v.Node(w, n)
+	}
+
+	switch n := n.(type) {
+	case *Program:
+		if v.Program != nil {
+			v.Program(w, n)
+		}
+	case *Include:
+		if v.Include != nil {
+			v.Include(w, n)
+		}
+	case *Namespace:
+		if v.Namespace != nil {
+			v.Namespace(w, n)
+		}
+	case *Constant:
+		if v.Constant != nil {
+			v.Constant(w, n)
+		}
+	case *Typedef:
+		if v.Typedef != nil {
+			v.Typedef(w, n)
+		}
+	case *Enum:
+		if v.Enum != nil {
+			v.Enum(w, n)
+		}
+	case *EnumItem:
+		if v.EnumItem != nil {
+			v.EnumItem(w, n)
+		}
+	case *Struct:
+		if v.Struct != nil {
+			v.Struct(w, n)
+		}
+	case *Field:
+		if v.Field != nil {
+			v.Field(w, n)
+		}
+	case *Service:
+		if v.Service != nil {
+			v.Service(w, n)
+		}
+	case *Function:
+		if v.Function != nil {
+			v.Function(w, n)
+		}
+	case BaseType:
+		if v.BaseType != nil {
+			v.BaseType(w, n)
+		}
+	case MapType:
+		if v.MapType != nil {
+			v.MapType(w, n)
+		}
+	case ListType:
+		if v.ListType != nil {
+			v.ListType(w, n)
+		}
+	case SetType:
+		if v.SetType != nil {
+			v.SetType(w, n)
+		}
+	case TypeReference:
+		if v.TypeReference != nil {
+			v.TypeReference(w, n)
+		}
+	case ConstantBoolean:
+		if v.ConstantBoolean != nil {
+			v.ConstantBoolean(w, n)
+		}
+	case ConstantInteger:
+		if v.ConstantInteger != nil {
+			v.ConstantInteger(w, n)
+		}
+	case ConstantString:
+		if v.ConstantString != nil {
+			v.ConstantString(w, n)
+		}
+	case ConstantDouble:
+		if v.ConstantDouble != nil {
+			v.ConstantDouble(w, n)
+		}
+	case ConstantReference:
+		if v.ConstantReference != nil {
+			v.ConstantReference(w, n)
+		}
+	case ConstantMap:
+		if v.ConstantMap != nil {
+			v.ConstantMap(w, n)
+		}
+	case ConstantMapItem:
+		if v.ConstantMapItem != nil {
+			v.ConstantMapItem(w, n)
+		}
+	case ConstantList:
+		if v.ConstantList != nil {
+			v.ConstantList(w, n)
+		}
+	case *Annotation:
+		if v.Annotation != nil {
+			v.Annotation(w, n)
+		}
+	}
+	return v
+}
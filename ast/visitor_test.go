@@ -0,0 +1,96 @@
+package ast_test
+
+import (
+	"reflect"
+	"testing"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/idl"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// allNodesThrift declares at least one node of every kind.
+const allNodesThrift = `
+include "./other.thrift"
+
+namespace go foo.bar
+
+const bool flag = true
+const i32 count = 42
+const string name = "foo"
+const double ratio = 0.5
+const i32 other = count
+const map<string, list<i32>> lookup = {"a": [1, 2]}
+const set<string> names = ["a"]
+
+typedef other.Thing Thing (foo = "bar")
+
+enum Color { Red, Green }
+
+struct Point {
+	1: required double x
+}
+
+service Canvas {
+	void draw(1: Point point)
+}
+`
+
+func TestTypedVisitorHooks(t *testing.T) {
+	prog, err := idl.Parse([]byte(allNodesThrift))
+	require.NoError(t, err)
+
+	// Fill every hook of the TypedVisitor with a function which records that
+	// it was called, and verify that the hook is called for nodes of the
+	// type it accepts.
+	called := make(map[string]int)
+	var nodes int
+
+	var tv ast.TypedVisitor
+	tvValue := reflect.ValueOf(&tv).Elem()
+	for i := 0; i < tvValue.NumField(); i++ {
+		field := tvValue.Type().Field(i)
+		if field.Name == "Node" {
+			continue
+		}
+
+		name := field.Name
+		nodeType := field.Type.In(1)
+		hook := reflect.MakeFunc(field.Type, func(args []reflect.Value) []reflect.Value {
+			assert.Equal(t, nodeType, args[1].Type(), "hook %v", name)
+			called[name]++
+			return nil
+		})
+		tvValue.Field(i).Set(hook)
+	}
+	tv.Node = func(ast.Walker, ast.Node) { nodes++ }
+
+	ast.Walk(tv, prog)
+
+	total := 0
+	for i := 0; i < tvValue.NumField(); i++ {
+		name := tvValue.Type().Field(i).Name
+		if name == "Node" {
+			continue
+		}
+		assert.NotZero(t, called[name], "hook %v was not called", name)
+		total += called[name]
+	}
+	assert.Equal(t, nodes, total, "every node must have exactly one typed hook")
+}
+
+func TestTypedVisitorNilHooks(t *testing.T) {
+	prog, err := idl.Parse([]byte(allNodesThrift))
+	require.NoError(t, err)
+
+	var structs []string
+	ast.Walk(ast.TypedVisitor{
+		Struct: func(w ast.Walker, s *ast.Struct) {
+			structs = append(structs, s.Name)
+			assert.Equal(t, prog, w.Parent())
+		},
+	}, prog)
+	assert.Equal(t, []string{"Point"}, structs)
+}
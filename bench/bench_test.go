@@ -0,0 +1,158 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bench
+
+import (
+	"bytes"
+	"testing"
+
+	"go.uber.org/thriftrw/bench/types"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/require"
+)
+
+type thriftType interface {
+	ToWire() (wire.Value, error)
+	FromWire(wire.Value) error
+}
+
+var benchCases = []struct {
+	name string
+	give thriftType
+	new  func() thriftType // returns an empty value of the same type
+}{
+	{
+		name: "Wide",
+		give: Wide(),
+		new:  func() thriftType { return new(types.Wide) },
+	},
+	{
+		name: "Nested",
+		give: Nested(64),
+		new:  func() thriftType { return new(types.Nested) },
+	},
+	{
+		name: "Containers",
+		give: Containers(16),
+		new:  func() thriftType { return new(types.Containers) },
+	},
+}
+
+// encode encodes the given value with the given protocol.
+func encode(p protocol.Protocol, v thriftType, buf *bytes.Buffer) error {
+	w, err := v.ToWire()
+	if err != nil {
+		return err
+	}
+	return p.Encode(w, buf)
+}
+
+// decode decodes a value of the type of the given value from the given
+// bytes with the given protocol.
+func decode(p protocol.Protocol, data []byte, v thriftType) error {
+	w, err := p.Decode(bytes.NewReader(data), wire.TStruct)
+	if err != nil {
+		return err
+	}
+	return v.FromWire(w)
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, tt := range benchCases {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			require.NoError(t, encode(protocol.Binary, tt.give, &buf))
+
+			got := tt.new()
+			require.NoError(t, decode(protocol.Binary, buf.Bytes(), got))
+			require.Equal(t, tt.give, got)
+		})
+	}
+}
+
+func BenchmarkToWire(b *testing.B) {
+	for _, bb := range benchCases {
+		b.Run(bb.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := bb.give.ToWire(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkFromWire(b *testing.B) {
+	for _, bb := range benchCases {
+		b.Run(bb.name, func(b *testing.B) {
+			w, err := bb.give.ToWire()
+			require.NoError(b, err)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if err := bb.new().FromWire(w); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkBinary(b *testing.B) {
+	benchmarkProtocol(b, protocol.Binary)
+}
+
+// benchmarkProtocol benchmarks encoding and decoding the generated types
+// with the given protocol.
+func benchmarkProtocol(b *testing.B, p protocol.Protocol) {
+	for _, bb := range benchCases {
+		b.Run(bb.name+"/Encode", func(b *testing.B) {
+			var buf bytes.Buffer
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				buf.Reset()
+				if err := encode(p, bb.give, &buf); err != nil {
+					b.Fatal(err)
+				}
+			}
+			b.SetBytes(int64(buf.Len()))
+		})
+
+		b.Run(bb.name+"/Decode", func(b *testing.B) {
+			var buf bytes.Buffer
+			require.NoError(b, encode(p, bb.give, &buf))
+			data := buf.Bytes()
+
+			b.SetBytes(int64(len(data)))
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if err := decode(p, data, bb.new()); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
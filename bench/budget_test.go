@@ -0,0 +1,73 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// +build !race
+
+package bench
+
+import (
+	"bytes"
+	"testing"
+
+	"go.uber.org/thriftrw/protocol"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Allocation budgets for encoding and decoding the benchmark values with the
+// Binary protocol. If a change to the protocol or the generated code pushes
+// a value over its budget, either fix the regression or raise the budget
+// with an explanation.
+var allocBudgets = map[string]struct{ encode, decode float64 }{
+	"Wide":       {encode: 2, decode: 20},
+	"Nested":     {encode: 64, decode: 193},
+	"Containers": {encode: 85, decode: 510},
+}
+
+func TestAllocationBudget(t *testing.T) {
+	for _, tt := range benchCases {
+		t.Run(tt.name, func(t *testing.T) {
+			budget, ok := allocBudgets[tt.name]
+			require.True(t, ok, "no allocation budget for %q", tt.name)
+
+			var buf bytes.Buffer
+			require.NoError(t, encode(protocol.Binary, tt.give, &buf))
+			data := append([]byte(nil), buf.Bytes()...)
+
+			encodeAllocs := testing.AllocsPerRun(100, func() {
+				buf.Reset()
+				if err := encode(protocol.Binary, tt.give, &buf); err != nil {
+					t.Fatal(err)
+				}
+			})
+			assert.True(t, encodeAllocs <= budget.encode,
+				"encoding allocated %v times, budget is %v", encodeAllocs, budget.encode)
+
+			decodeAllocs := testing.AllocsPerRun(100, func() {
+				if err := decode(protocol.Binary, data, tt.new()); err != nil {
+					t.Fatal(err)
+				}
+			})
+			assert.True(t, decodeAllocs <= budget.decode,
+				"decoding allocated %v times, budget is %v", decodeAllocs, budget.decode)
+		})
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package bench benchmarks the encoding and decoding of representative
+// Thrift types: wide structs, deeply nested structs, and structs full of
+// containers. Run the benchmarks with,
+//
+//   go test -bench . -benchmem go.uber.org/thriftrw/bench
+//
+// Tests in this package also verify that common operations stay within an
+// allocation budget so that changes which add allocations to the protocol
+// or generated code are noticed.
+//
+// With the apachethrift build tag, the benchmarks also compare against the
+// Binary protocol of the Apache Thrift Go library.
+//
+//   go test -tags apachethrift -bench . go.uber.org/thriftrw/bench
+package bench
+
+//go:generate thriftrw --pkg-prefix go.uber.org/thriftrw/bench types.thrift
+//go:generate ../scripts/updateLicenses.sh
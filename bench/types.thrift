@@ -0,0 +1,40 @@
+/**
+ * Wide is a struct with many primitive fields.
+ */
+struct Wide {
+    1: required bool boolField
+    2: required byte byteField
+    3: required i16 int16Field
+    4: required i32 int32Field
+    5: required i64 int64Field
+    6: required double doubleField
+    7: required string stringField
+    8: required binary binaryField
+    9: optional bool optionalBoolField
+    10: optional byte optionalByteField
+    11: optional i16 optionalInt16Field
+    12: optional i32 optionalInt32Field
+    13: optional i64 optionalInt64Field
+    14: optional double optionalDoubleField
+    15: optional string optionalStringField
+    16: optional binary optionalBinaryField
+}
+
+/**
+ * Nested is a linked list of structs.
+ */
+struct Nested {
+    1: required i64 value
+    2: optional Nested child
+}
+
+/**
+ * Containers is a struct which holds mostly containers.
+ */
+struct Containers {
+    1: required list<Wide> structs
+    2: required list<list<i32>> matrix
+    3: required map<string, i64> counts
+    4: required map<i64, Nested> nodes
+    5: required set<string> tags
+}
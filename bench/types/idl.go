@@ -0,0 +1,38 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+import "go.uber.org/thriftrw/thriftreflect"
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:            "types",
+	Package:         "go.uber.org/thriftrw/bench/types",
+	FilePath:        "types.thrift",
+	SHA1:            "612b0e7440b293edc892eed02ed536b868412bbe",
+	ThriftRWVersion: "1.9.0",
+	Raw:             rawIDL,
+}
+
+const rawIDL = "/**\n * Wide is a struct with many primitive fields.\n */\nstruct Wide {\n    1: required bool boolField\n    2: required byte byteField\n    3: required i16 int16Field\n    4: required i32 int32Field\n    5: required i64 int64Field\n    6: required double doubleField\n    7: required string stringField\n    8: required binary binaryField\n    9: optional bool optionalBoolField\n    10: optional byte optionalByteField\n    11: optional i16 optionalInt16Field\n    12: optional i32 optionalInt32Field\n    13: optional i64 optionalInt64Field\n    14: optional double optionalDoubleField\n    15: optional string optionalStringField\n    16: optional binary optionalBinaryField\n}\n\n/**\n * Nested is a linked list of structs.\n */\nstruct Nested {\n    1: required i64 value\n    2: optional Nested child\n}\n\n/**\n * Containers is a struct which holds mostly containers.\n */\nstruct Containers {\n    1: required list<Wide> structs\n    2: required list<list<i32>> matrix\n    3: required map<string, i64> counts\n    4: required map<i64, Nested> nodes\n    5: required set<string> tags\n}\n"
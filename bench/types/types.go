@@ -0,0 +1,1743 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package types
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// Containers is a struct which holds mostly containers.
+type Containers struct {
+	Structs []*Wide             `json:"structs,required"`
+	Matrix  [][]int32           `json:"matrix,required"`
+	Counts  map[string]int64    `json:"counts,required"`
+	Nodes   map[int64]*Nested   `json:"nodes,required"`
+	Tags    map[string]struct{} `json:"tags,required"`
+}
+
+type _List_Wide_ValueList []*Wide
+
+func (v _List_Wide_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Wide_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Wide_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Wide_ValueList) Close() {}
+
+type _List_I32_ValueList []int32
+
+func (v _List_I32_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		if err := f(wire.NewValueI32(x)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_I32_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_I32_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_List_I32_ValueList) Close() {}
+
+type _List_List_I32_ValueList [][]int32
+
+func (v _List_List_I32_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		if err := f(wire.NewValueList(_List_I32_ValueList(x))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_List_I32_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_List_I32_ValueList) ValueType() wire.Type {
+	return wire.TList
+}
+
+func (_List_List_I32_ValueList) Close() {}
+
+type _Map_String_I64_MapItemList map[string]int64
+
+func (m _Map_String_I64_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw := wire.NewValueString(k)
+
+		vw := wire.NewValueI64(v)
+		if err := f(wire.MapItem{Key: kw, Value: vw}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_I64_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_I64_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_I64_MapItemList) ValueType() wire.Type {
+	return wire.TI64
+}
+
+func (_Map_String_I64_MapItemList) Close() {}
+
+type _Map_I64_Nested_MapItemList map[int64]*Nested
+
+func (m _Map_I64_Nested_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw := wire.NewValueI64(k)
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		if err = f(wire.MapItem{Key: kw, Value: vw}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_I64_Nested_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_I64_Nested_MapItemList) KeyType() wire.Type {
+	return wire.TI64
+}
+
+func (_Map_I64_Nested_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_I64_Nested_MapItemList) Close() {}
+
+type _Set_String_ValueList map[string]struct{}
+
+func (v _Set_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for x := range v {
+		if err := f(wire.NewValueString(x)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Set_String_ValueList) Close() {}
+
+// ToWire translates a Containers struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Containers) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Containers is nil")
+	}
+
+	if v.Structs == nil {
+		return w, errors.New("field Structs of Containers is required")
+	}
+	w = wire.NewValueList(_List_Wide_ValueList(v.Structs))
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Matrix == nil {
+		return w, errors.New("field Matrix of Containers is required")
+	}
+	w = wire.NewValueList(_List_List_I32_ValueList(v.Matrix))
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	if v.Counts == nil {
+		return w, errors.New("field Counts of Containers is required")
+	}
+	w = wire.NewValueMap(_Map_String_I64_MapItemList(v.Counts))
+	fields[i] = wire.Field{ID: 3, Value: w}
+	i++
+	if v.Nodes == nil {
+		return w, errors.New("field Nodes of Containers is required")
+	}
+	w = wire.NewValueMap(_Map_I64_Nested_MapItemList(v.Nodes))
+	fields[i] = wire.Field{ID: 4, Value: w}
+	i++
+	if v.Tags == nil {
+		return w, errors.New("field Tags of Containers is required")
+	}
+	w = wire.NewValueSet(_Set_String_ValueList(v.Tags))
+	fields[i] = wire.Field{ID: 5, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Wide_Read(w wire.Value) (*Wide, error) {
+	var v Wide
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_Wide_Read(l wire.ValueList) ([]*Wide, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Wide, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Wide_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _List_I32_Read(l wire.ValueList) ([]int32, error) {
+	if l.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make([]int32, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		o = append(o, x.GetI32())
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _List_List_I32_Read(l wire.ValueList) ([][]int32, error) {
+	if l.ValueType() != wire.TList {
+		return nil, nil
+	}
+
+	o := make([][]int32, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _List_I32_Read(x.GetList())
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Map_String_I64_Read(m wire.MapItemList) (map[string]int64, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI64 {
+		return nil, nil
+	}
+
+	o := make(map[string]int64, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k := x.Key.GetString()
+
+		v := x.Value.GetI64()
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Nested_Read(w wire.Value) (*Nested, error) {
+	var v Nested
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_I64_Nested_Read(m wire.MapItemList) (map[int64]*Nested, error) {
+	if m.KeyType() != wire.TI64 {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[int64]*Nested, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k := x.Key.GetI64()
+
+		v, err := _Nested_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Set_String_Read(s wire.ValueList) (map[string]struct{}, error) {
+	if s.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[string]struct{}, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i := x.GetString()
+
+		o[i] = struct{}{}
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+// FromWire deserializes a Containers struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Containers struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Containers
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Containers) FromWire(w wire.Value) error {
+	var err error
+
+	structsIsSet := false
+	matrixIsSet := false
+	countsIsSet := false
+	nodesIsSet := false
+	tagsIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TList {
+				v.Structs, err = _List_Wide_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+				structsIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TList {
+				v.Matrix, err = _List_List_I32_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+				matrixIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TMap {
+				v.Counts, err = _Map_String_I64_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+				countsIsSet = true
+			}
+		case 4:
+			if field.Value.Type() == wire.TMap {
+				v.Nodes, err = _Map_I64_Nested_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+				nodesIsSet = true
+			}
+		case 5:
+			if field.Value.Type() == wire.TSet {
+				v.Tags, err = _Set_String_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+				tagsIsSet = true
+			}
+		}
+	}
+
+	if !structsIsSet {
+		return errors.New("field Structs of Containers is required")
+	}
+
+	if !matrixIsSet {
+		return errors.New("field Matrix of Containers is required")
+	}
+
+	if !countsIsSet {
+		return errors.New("field Counts of Containers is required")
+	}
+
+	if !nodesIsSet {
+		return errors.New("field Nodes of Containers is required")
+	}
+
+	if !tagsIsSet {
+		return errors.New("field Tags of Containers is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Containers
+// struct.
+func (v *Containers) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	fields[i] = fmt.Sprintf("Structs: %v", v.Structs)
+	i++
+	fields[i] = fmt.Sprintf("Matrix: %v", v.Matrix)
+	i++
+	fields[i] = fmt.Sprintf("Counts: %v", v.Counts)
+	i++
+	fields[i] = fmt.Sprintf("Nodes: %v", v.Nodes)
+	i++
+	fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+	i++
+
+	return fmt.Sprintf("Containers{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_Wide_Equals(lhs, rhs []*Wide) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_I32_Equals(lhs, rhs []int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_List_I32_Equals(lhs, rhs [][]int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !_List_I32_Equals(lv, rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_I64_Equals(lhs, rhs map[string]int64) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_I64_Nested_Equals(lhs, rhs map[int64]*Nested) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Set_String_Equals(lhs, rhs map[string]struct{}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for x := range rhs {
+		if _, ok := lhs[x]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this Containers match the
+// provided Containers.
+//
+// This function performs a deep comparison.
+func (v *Containers) Equals(rhs *Containers) bool {
+	if !_List_Wide_Equals(v.Structs, rhs.Structs) {
+		return false
+	}
+	if !_List_List_I32_Equals(v.Matrix, rhs.Matrix) {
+		return false
+	}
+	if !_Map_String_I64_Equals(v.Counts, rhs.Counts) {
+		return false
+	}
+	if !_Map_I64_Nested_Equals(v.Nodes, rhs.Nodes) {
+		return false
+	}
+	if !_Set_String_Equals(v.Tags, rhs.Tags) {
+		return false
+	}
+
+	return true
+}
+
+func _List_Wide_Clone(l []*Wide) []*Wide {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]*Wide, len(l))
+	for i, x := range l {
+		o[i] = x.Clone()
+	}
+	return o
+}
+
+func _List_I32_Clone(l []int32) []int32 {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]int32, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
+}
+
+func _List_List_I32_Clone(l [][]int32) [][]int32 {
+	if l == nil {
+		return nil
+	}
+
+	o := make([][]int32, len(l))
+	for i, x := range l {
+		o[i] = _List_I32_Clone(x)
+	}
+	return o
+}
+
+func _Map_String_I64_Clone(m map[string]int64) map[string]int64 {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[string]int64, len(m))
+	for k, v := range m {
+		o[k] = v
+	}
+
+	return o
+}
+
+func _Map_I64_Nested_Clone(m map[int64]*Nested) map[int64]*Nested {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[int64]*Nested, len(m))
+	for k, v := range m {
+		o[k] = v.Clone()
+	}
+
+	return o
+}
+
+func _Set_String_Clone(s map[string]struct{}) map[string]struct{} {
+	if s == nil {
+		return nil
+	}
+
+	o := make(map[string]struct{}, len(s))
+	for x := range s {
+		o[x] = struct{}{}
+	}
+
+	return o
+}
+
+// Clone returns a deep copy of this Containers.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Containers) Clone() *Containers {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Structs = _List_Wide_Clone(v.Structs)
+	o.Matrix = _List_List_I32_Clone(v.Matrix)
+	o.Counts = _Map_String_I64_Clone(v.Counts)
+	o.Nodes = _Map_I64_Nested_Clone(v.Nodes)
+	o.Tags = _Set_String_Clone(v.Tags)
+
+	return &o
+}
+
+// GetStructs returns the value of Structs if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Containers.
+func (v *Containers) GetStructs() (o []*Wide) {
+	if v != nil {
+		o = v.Structs
+	}
+	return
+}
+
+// GetMatrix returns the value of Matrix if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Containers.
+func (v *Containers) GetMatrix() (o [][]int32) {
+	if v != nil {
+		o = v.Matrix
+	}
+	return
+}
+
+// GetCounts returns the value of Counts if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Containers.
+func (v *Containers) GetCounts() (o map[string]int64) {
+	if v != nil {
+		o = v.Counts
+	}
+	return
+}
+
+// GetNodes returns the value of Nodes if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Containers.
+func (v *Containers) GetNodes() (o map[int64]*Nested) {
+	if v != nil {
+		o = v.Nodes
+	}
+	return
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Containers.
+func (v *Containers) GetTags() (o map[string]struct{}) {
+	if v != nil {
+		o = v.Tags
+	}
+	return
+}
+
+// Nested is a linked list of structs.
+type Nested struct {
+	Value int64   `json:"value,required"`
+	Child *Nested `json:"child,omitempty"`
+}
+
+// ToWire translates a Nested struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Nested) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Nested is nil")
+	}
+
+	w = wire.NewValueI64(v.Value)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Child != nil {
+		w, err = v.Child.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Nested struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Nested struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Nested
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Nested) FromWire(w wire.Value) error {
+	var err error
+
+	valueIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI64 {
+				v.Value = field.Value.GetI64()
+				valueIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Child, err = _Nested_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !valueIsSet {
+		return errors.New("field Value of Nested is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Nested
+// struct.
+func (v *Nested) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Value: %v", v.Value)
+	i++
+	if v.Child != nil {
+		fields[i] = fmt.Sprintf("Child: %v", v.Child)
+		i++
+	}
+
+	return fmt.Sprintf("Nested{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Nested match the
+// provided Nested.
+//
+// This function performs a deep comparison.
+func (v *Nested) Equals(rhs *Nested) bool {
+	if !(v.Value == rhs.Value) {
+		return false
+	}
+	if !((v.Child == nil && rhs.Child == nil) || (v.Child != nil && rhs.Child != nil && v.Child.Equals(rhs.Child))) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this Nested.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Nested) Clone() *Nested {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Child = v.Child.Clone()
+
+	return &o
+}
+
+// GetValue returns the value of Value if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Nested.
+func (v *Nested) GetValue() (o int64) {
+	if v != nil {
+		o = v.Value
+	}
+	return
+}
+
+// GetChild returns the value of Child if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Nested.
+func (v *Nested) GetChild() (o *Nested) {
+	if v != nil && v.Child != nil {
+		return v.Child
+	}
+
+	return
+}
+
+// IsSetChild returns true if Child is not nil.
+//
+// This is safe to call on a nil Nested.
+func (v *Nested) IsSetChild() bool {
+	return v != nil && v.Child != nil
+}
+
+// Wide is a struct with many primitive fields.
+type Wide struct {
+	BoolField           bool     `json:"boolField,required"`
+	ByteField           int8     `json:"byteField,required"`
+	Int16Field          int16    `json:"int16Field,required"`
+	Int32Field          int32    `json:"int32Field,required"`
+	Int64Field          int64    `json:"int64Field,required"`
+	DoubleField         float64  `json:"doubleField,required"`
+	StringField         string   `json:"stringField,required"`
+	BinaryField         []byte   `json:"binaryField,required"`
+	OptionalBoolField   *bool    `json:"optionalBoolField,omitempty"`
+	OptionalByteField   *int8    `json:"optionalByteField,omitempty"`
+	OptionalInt16Field  *int16   `json:"optionalInt16Field,omitempty"`
+	OptionalInt32Field  *int32   `json:"optionalInt32Field,omitempty"`
+	OptionalInt64Field  *int64   `json:"optionalInt64Field,omitempty"`
+	OptionalDoubleField *float64 `json:"optionalDoubleField,omitempty"`
+	OptionalStringField *string  `json:"optionalStringField,omitempty"`
+	OptionalBinaryField []byte   `json:"optionalBinaryField,omitempty"`
+}
+
+// ToWire translates a Wide struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Wide) ToWire() (wire.Value, error) {
+	var (
+		fields [16]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Wide is nil")
+	}
+
+	w = wire.NewValueBool(v.BoolField)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w = wire.NewValueI8(v.ByteField)
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	w = wire.NewValueI16(v.Int16Field)
+	fields[i] = wire.Field{ID: 3, Value: w}
+	i++
+
+	w = wire.NewValueI32(v.Int32Field)
+	fields[i] = wire.Field{ID: 4, Value: w}
+	i++
+
+	w = wire.NewValueI64(v.Int64Field)
+	fields[i] = wire.Field{ID: 5, Value: w}
+	i++
+
+	w = wire.NewValueDouble(v.DoubleField)
+	fields[i] = wire.Field{ID: 6, Value: w}
+	i++
+
+	w = wire.NewValueString(v.StringField)
+	fields[i] = wire.Field{ID: 7, Value: w}
+	i++
+	if v.BinaryField == nil {
+		return w, errors.New("field BinaryField of Wide is required")
+	}
+	w = wire.NewValueBinary(v.BinaryField)
+	fields[i] = wire.Field{ID: 8, Value: w}
+	i++
+	if v.OptionalBoolField != nil {
+		w = wire.NewValueBool(*(v.OptionalBoolField))
+		fields[i] = wire.Field{ID: 9, Value: w}
+		i++
+	}
+	if v.OptionalByteField != nil {
+		w = wire.NewValueI8(*(v.OptionalByteField))
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.OptionalInt16Field != nil {
+		w = wire.NewValueI16(*(v.OptionalInt16Field))
+		fields[i] = wire.Field{ID: 11, Value: w}
+		i++
+	}
+	if v.OptionalInt32Field != nil {
+		w = wire.NewValueI32(*(v.OptionalInt32Field))
+		fields[i] = wire.Field{ID: 12, Value: w}
+		i++
+	}
+	if v.OptionalInt64Field != nil {
+		w = wire.NewValueI64(*(v.OptionalInt64Field))
+		fields[i] = wire.Field{ID: 13, Value: w}
+		i++
+	}
+	if v.OptionalDoubleField != nil {
+		w = wire.NewValueDouble(*(v.OptionalDoubleField))
+		fields[i] = wire.Field{ID: 14, Value: w}
+		i++
+	}
+	if v.OptionalStringField != nil {
+		w = wire.NewValueString(*(v.OptionalStringField))
+		fields[i] = wire.Field{ID: 15, Value: w}
+		i++
+	}
+	if v.OptionalBinaryField != nil {
+		w = wire.NewValueBinary(v.OptionalBinaryField)
+		fields[i] = wire.Field{ID: 16, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Wide struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Wide struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Wide
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Wide) FromWire(w wire.Value) error {
+
+	boolFieldIsSet := false
+	byteFieldIsSet := false
+	int16FieldIsSet := false
+	int32FieldIsSet := false
+	int64FieldIsSet := false
+	doubleFieldIsSet := false
+	stringFieldIsSet := false
+	binaryFieldIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBool {
+				v.BoolField = field.Value.GetBool()
+				boolFieldIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI8 {
+				v.ByteField = field.Value.GetI8()
+				byteFieldIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TI16 {
+				v.Int16Field = field.Value.GetI16()
+				int16FieldIsSet = true
+			}
+		case 4:
+			if field.Value.Type() == wire.TI32 {
+				v.Int32Field = field.Value.GetI32()
+				int32FieldIsSet = true
+			}
+		case 5:
+			if field.Value.Type() == wire.TI64 {
+				v.Int64Field = field.Value.GetI64()
+				int64FieldIsSet = true
+			}
+		case 6:
+			if field.Value.Type() == wire.TDouble {
+				v.DoubleField = field.Value.GetDouble()
+				doubleFieldIsSet = true
+			}
+		case 7:
+			if field.Value.Type() == wire.TBinary {
+				v.StringField = field.Value.GetString()
+				stringFieldIsSet = true
+			}
+		case 8:
+			if field.Value.Type() == wire.TBinary {
+				v.BinaryField = field.Value.GetBinary()
+				binaryFieldIsSet = true
+			}
+		case 9:
+			if field.Value.Type() == wire.TBool {
+				x := field.Value.GetBool()
+				v.OptionalBoolField = &x
+
+			}
+		case 10:
+			if field.Value.Type() == wire.TI8 {
+				x := field.Value.GetI8()
+				v.OptionalByteField = &x
+
+			}
+		case 11:
+			if field.Value.Type() == wire.TI16 {
+				x := field.Value.GetI16()
+				v.OptionalInt16Field = &x
+
+			}
+		case 12:
+			if field.Value.Type() == wire.TI32 {
+				x := field.Value.GetI32()
+				v.OptionalInt32Field = &x
+
+			}
+		case 13:
+			if field.Value.Type() == wire.TI64 {
+				x := field.Value.GetI64()
+				v.OptionalInt64Field = &x
+
+			}
+		case 14:
+			if field.Value.Type() == wire.TDouble {
+				x := field.Value.GetDouble()
+				v.OptionalDoubleField = &x
+
+			}
+		case 15:
+			if field.Value.Type() == wire.TBinary {
+				x := field.Value.GetString()
+				v.OptionalStringField = &x
+
+			}
+		case 16:
+			if field.Value.Type() == wire.TBinary {
+				v.OptionalBinaryField = field.Value.GetBinary()
+
+			}
+		}
+	}
+
+	if !boolFieldIsSet {
+		return errors.New("field BoolField of Wide is required")
+	}
+
+	if !byteFieldIsSet {
+		return errors.New("field ByteField of Wide is required")
+	}
+
+	if !int16FieldIsSet {
+		return errors.New("field Int16Field of Wide is required")
+	}
+
+	if !int32FieldIsSet {
+		return errors.New("field Int32Field of Wide is required")
+	}
+
+	if !int64FieldIsSet {
+		return errors.New("field Int64Field of Wide is required")
+	}
+
+	if !doubleFieldIsSet {
+		return errors.New("field DoubleField of Wide is required")
+	}
+
+	if !stringFieldIsSet {
+		return errors.New("field StringField of Wide is required")
+	}
+
+	if !binaryFieldIsSet {
+		return errors.New("field BinaryField of Wide is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Wide
+// struct.
+func (v *Wide) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [16]string
+	i := 0
+	fields[i] = fmt.Sprintf("BoolField: %v", v.BoolField)
+	i++
+	fields[i] = fmt.Sprintf("ByteField: %v", v.ByteField)
+	i++
+	fields[i] = fmt.Sprintf("Int16Field: %v", v.Int16Field)
+	i++
+	fields[i] = fmt.Sprintf("Int32Field: %v", v.Int32Field)
+	i++
+	fields[i] = fmt.Sprintf("Int64Field: %v", v.Int64Field)
+	i++
+	fields[i] = fmt.Sprintf("DoubleField: %v", v.DoubleField)
+	i++
+	fields[i] = fmt.Sprintf("StringField: %v", v.StringField)
+	i++
+	fields[i] = fmt.Sprintf("BinaryField: %v", v.BinaryField)
+	i++
+	if v.OptionalBoolField != nil {
+		fields[i] = fmt.Sprintf("OptionalBoolField: %v", *(v.OptionalBoolField))
+		i++
+	}
+	if v.OptionalByteField != nil {
+		fields[i] = fmt.Sprintf("OptionalByteField: %v", *(v.OptionalByteField))
+		i++
+	}
+	if v.OptionalInt16Field != nil {
+		fields[i] = fmt.Sprintf("OptionalInt16Field: %v", *(v.OptionalInt16Field))
+		i++
+	}
+	if v.OptionalInt32Field != nil {
+		fields[i] = fmt.Sprintf("OptionalInt32Field: %v", *(v.OptionalInt32Field))
+		i++
+	}
+	if v.OptionalInt64Field != nil {
+		fields[i] = fmt.Sprintf("OptionalInt64Field: %v", *(v.OptionalInt64Field))
+		i++
+	}
+	if v.OptionalDoubleField != nil {
+		fields[i] = fmt.Sprintf("OptionalDoubleField: %v", *(v.OptionalDoubleField))
+		i++
+	}
+	if v.OptionalStringField != nil {
+		fields[i] = fmt.Sprintf("OptionalStringField: %v", *(v.OptionalStringField))
+		i++
+	}
+	if v.OptionalBinaryField != nil {
+		fields[i] = fmt.Sprintf("OptionalBinaryField: %v", v.OptionalBinaryField)
+		i++
+	}
+
+	return fmt.Sprintf("Wide{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Byte_EqualsPtr(lhs, rhs *int8) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I16_EqualsPtr(lhs, rhs *int16) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Double_EqualsPtr(lhs, rhs *float64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Wide match the
+// provided Wide.
+//
+// This function performs a deep comparison.
+func (v *Wide) Equals(rhs *Wide) bool {
+	if !(v.BoolField == rhs.BoolField) {
+		return false
+	}
+	if !(v.ByteField == rhs.ByteField) {
+		return false
+	}
+	if !(v.Int16Field == rhs.Int16Field) {
+		return false
+	}
+	if !(v.Int32Field == rhs.Int32Field) {
+		return false
+	}
+	if !(v.Int64Field == rhs.Int64Field) {
+		return false
+	}
+	if !(v.DoubleField == rhs.DoubleField) {
+		return false
+	}
+	if !(v.StringField == rhs.StringField) {
+		return false
+	}
+	if !bytes.Equal(v.BinaryField, rhs.BinaryField) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.OptionalBoolField, rhs.OptionalBoolField) {
+		return false
+	}
+	if !_Byte_EqualsPtr(v.OptionalByteField, rhs.OptionalByteField) {
+		return false
+	}
+	if !_I16_EqualsPtr(v.OptionalInt16Field, rhs.OptionalInt16Field) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.OptionalInt32Field, rhs.OptionalInt32Field) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.OptionalInt64Field, rhs.OptionalInt64Field) {
+		return false
+	}
+	if !_Double_EqualsPtr(v.OptionalDoubleField, rhs.OptionalDoubleField) {
+		return false
+	}
+	if !_String_EqualsPtr(v.OptionalStringField, rhs.OptionalStringField) {
+		return false
+	}
+	if !((v.OptionalBinaryField == nil && rhs.OptionalBinaryField == nil) || (v.OptionalBinaryField != nil && rhs.OptionalBinaryField != nil && bytes.Equal(v.OptionalBinaryField, rhs.OptionalBinaryField))) {
+		return false
+	}
+
+	return true
+}
+
+func _Binary_Clone(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+
+	o := make([]byte, len(b))
+	copy(o, b)
+	return o
+}
+
+func _Bool_ClonePtr(p *bool) *bool {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _Byte_ClonePtr(p *int8) *int8 {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _I16_ClonePtr(p *int16) *int16 {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _I32_ClonePtr(p *int32) *int32 {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _I64_ClonePtr(p *int64) *int64 {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _Double_ClonePtr(p *float64) *float64 {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _String_ClonePtr(p *string) *string {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this Wide.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Wide) Clone() *Wide {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.BinaryField = _Binary_Clone(v.BinaryField)
+	o.OptionalBoolField = _Bool_ClonePtr(v.OptionalBoolField)
+	o.OptionalByteField = _Byte_ClonePtr(v.OptionalByteField)
+	o.OptionalInt16Field = _I16_ClonePtr(v.OptionalInt16Field)
+	o.OptionalInt32Field = _I32_ClonePtr(v.OptionalInt32Field)
+	o.OptionalInt64Field = _I64_ClonePtr(v.OptionalInt64Field)
+	o.OptionalDoubleField = _Double_ClonePtr(v.OptionalDoubleField)
+	o.OptionalStringField = _String_ClonePtr(v.OptionalStringField)
+	o.OptionalBinaryField = _Binary_Clone(v.OptionalBinaryField)
+
+	return &o
+}
+
+// GetBoolField returns the value of BoolField if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Wide.
+func (v *Wide) GetBoolField() (o bool) {
+	if v != nil {
+		o = v.BoolField
+	}
+	return
+}
+
+// GetByteField returns the value of ByteField if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Wide.
+func (v *Wide) GetByteField() (o int8) {
+	if v != nil {
+		o = v.ByteField
+	}
+	return
+}
+
+// GetInt16Field returns the value of Int16Field if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Wide.
+func (v *Wide) GetInt16Field() (o int16) {
+	if v != nil {
+		o = v.Int16Field
+	}
+	return
+}
+
+// GetInt32Field returns the value of Int32Field if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Wide.
+func (v *Wide) GetInt32Field() (o int32) {
+	if v != nil {
+		o = v.Int32Field
+	}
+	return
+}
+
+// GetInt64Field returns the value of Int64Field if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Wide.
+func (v *Wide) GetInt64Field() (o int64) {
+	if v != nil {
+		o = v.Int64Field
+	}
+	return
+}
+
+// GetDoubleField returns the value of DoubleField if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Wide.
+func (v *Wide) GetDoubleField() (o float64) {
+	if v != nil {
+		o = v.DoubleField
+	}
+	return
+}
+
+// GetStringField returns the value of StringField if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Wide.
+func (v *Wide) GetStringField() (o string) {
+	if v != nil {
+		o = v.StringField
+	}
+	return
+}
+
+// GetBinaryField returns the value of BinaryField if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Wide.
+func (v *Wide) GetBinaryField() (o []byte) {
+	if v != nil {
+		o = v.BinaryField
+	}
+	return
+}
+
+// GetOptionalBoolField returns the value of OptionalBoolField if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Wide.
+func (v *Wide) GetOptionalBoolField() (o bool) {
+	if v != nil && v.OptionalBoolField != nil {
+		return *v.OptionalBoolField
+	}
+
+	return
+}
+
+// IsSetOptionalBoolField returns true if OptionalBoolField is not nil.
+//
+// This is safe to call on a nil Wide.
+func (v *Wide) IsSetOptionalBoolField() bool {
+	return v != nil && v.OptionalBoolField != nil
+}
+
+// GetOptionalByteField returns the value of OptionalByteField if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Wide.
+func (v *Wide) GetOptionalByteField() (o int8) {
+	if v != nil && v.OptionalByteField != nil {
+		return *v.OptionalByteField
+	}
+
+	return
+}
+
+// IsSetOptionalByteField returns true if OptionalByteField is not nil.
+//
+// This is safe to call on a nil Wide.
+func (v *Wide) IsSetOptionalByteField() bool {
+	return v != nil && v.OptionalByteField != nil
+}
+
+// GetOptionalInt16Field returns the value of OptionalInt16Field if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Wide.
+func (v *Wide) GetOptionalInt16Field() (o int16) {
+	if v != nil && v.OptionalInt16Field != nil {
+		return *v.OptionalInt16Field
+	}
+
+	return
+}
+
+// IsSetOptionalInt16Field returns true if OptionalInt16Field is not nil.
+//
+// This is safe to call on a nil Wide.
+func (v *Wide) IsSetOptionalInt16Field() bool {
+	return v != nil && v.OptionalInt16Field != nil
+}
+
+// GetOptionalInt32Field returns the value of OptionalInt32Field if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Wide.
+func (v *Wide) GetOptionalInt32Field() (o int32) {
+	if v != nil && v.OptionalInt32Field != nil {
+		return *v.OptionalInt32Field
+	}
+
+	return
+}
+
+// IsSetOptionalInt32Field returns true if OptionalInt32Field is not nil.
+//
+// This is safe to call on a nil Wide.
+func (v *Wide) IsSetOptionalInt32Field() bool {
+	return v != nil && v.OptionalInt32Field != nil
+}
+
+// GetOptionalInt64Field returns the value of OptionalInt64Field if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Wide.
+func (v *Wide) GetOptionalInt64Field() (o int64) {
+	if v != nil && v.OptionalInt64Field != nil {
+		return *v.OptionalInt64Field
+	}
+
+	return
+}
+
+// IsSetOptionalInt64Field returns true if OptionalInt64Field is not nil.
+//
+// This is safe to call on a nil Wide.
+func (v *Wide) IsSetOptionalInt64Field() bool {
+	return v != nil && v.OptionalInt64Field != nil
+}
+
+// GetOptionalDoubleField returns the value of OptionalDoubleField if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Wide.
+func (v *Wide) GetOptionalDoubleField() (o float64) {
+	if v != nil && v.OptionalDoubleField != nil {
+		return *v.OptionalDoubleField
+	}
+
+	return
+}
+
+// IsSetOptionalDoubleField returns true if OptionalDoubleField is not nil.
+//
+// This is safe to call on a nil Wide.
+func (v *Wide) IsSetOptionalDoubleField() bool {
+	return v != nil && v.OptionalDoubleField != nil
+}
+
+// GetOptionalStringField returns the value of OptionalStringField if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Wide.
+func (v *Wide) GetOptionalStringField() (o string) {
+	if v != nil && v.OptionalStringField != nil {
+		return *v.OptionalStringField
+	}
+
+	return
+}
+
+// IsSetOptionalStringField returns true if OptionalStringField is not nil.
+//
+// This is safe to call on a nil Wide.
+func (v *Wide) IsSetOptionalStringField() bool {
+	return v != nil && v.OptionalStringField != nil
+}
+
+// GetOptionalBinaryField returns the value of OptionalBinaryField if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Wide.
+func (v *Wide) GetOptionalBinaryField() (o []byte) {
+	if v != nil && v.OptionalBinaryField != nil {
+		return v.OptionalBinaryField
+	}
+
+	return
+}
+
+// IsSetOptionalBinaryField returns true if OptionalBinaryField is not nil.
+//
+// This is safe to call on a nil Wide.
+func (v *Wide) IsSetOptionalBinaryField() bool {
+	return v != nil && v.OptionalBinaryField != nil
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package bench
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/bench/types"
+	"go.uber.org/thriftrw/ptr"
+)
+
+// Wide returns a Wide struct with all its fields set.
+func Wide() *types.Wide {
+	return &types.Wide{
+		BoolField:           true,
+		ByteField:           42,
+		Int16Field:          1234,
+		Int32Field:          123456,
+		Int64Field:          1234567890,
+		DoubleField:         3.14159,
+		StringField:         "the quick brown fox jumps over the lazy dog",
+		BinaryField:         []byte("the quick brown fox jumps over the lazy dog"),
+		OptionalBoolField:   ptr.Bool(false),
+		OptionalByteField:   ptr.Int8(-42),
+		OptionalInt16Field:  ptr.Int16(-1234),
+		OptionalInt32Field:  ptr.Int32(-123456),
+		OptionalInt64Field:  ptr.Int64(-1234567890),
+		OptionalDoubleField: ptr.Float64(-3.14159),
+		OptionalStringField: ptr.String("hello world"),
+		OptionalBinaryField: []byte("hello world"),
+	}
+}
+
+// Nested returns a chain of Nested structs of the given depth.
+func Nested(depth int) *types.Nested {
+	var n *types.Nested
+	for i := depth; i > 0; i-- {
+		n = &types.Nested{Value: int64(i), Child: n}
+	}
+	return n
+}
+
+// Containers returns a Containers struct whose containers each have the
+// given number of items.
+func Containers(size int) *types.Containers {
+	c := &types.Containers{
+		Structs: make([]*types.Wide, size),
+		Matrix:  make([][]int32, size),
+		Counts:  make(map[string]int64, size),
+		Nodes:   make(map[int64]*types.Nested, size),
+		Tags:    make(map[string]struct{}, size),
+	}
+
+	for i := 0; i < size; i++ {
+		c.Structs[i] = Wide()
+
+		row := make([]int32, size)
+		for j := range row {
+			row[j] = int32(i * j)
+		}
+		c.Matrix[i] = row
+
+		key := fmt.Sprintf("key-%d", i)
+		c.Counts[key] = int64(i)
+		c.Nodes[int64(i)] = Nested(3)
+		c.Tags[key] = struct{}{}
+	}
+
+	return c
+}
@@ -0,0 +1,265 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package canonical normalizes Thrift values so that equal values of the
+// same Thrift type are always encoded to the same bytes.
+//
+// The Binary protocol does not require struct fields, map items, or set
+// items to be written in any particular order, and decoders accept fields
+// which they do not know about. Two peers may therefore send different bytes
+// for the same value. Canonical encodings may be signed, hashed, or used as
+// cache keys instead.
+//
+//   spec, err := module.LookupType("User")
+//   ...
+//   b, err := canonical.Encode(spec, value)
+//
+// The canonical form of a value,
+//
+//   - drops struct fields which are not in the Thrift definition or which
+//     have the wrong type
+//   - adds fields which are not set but have a default value
+//   - orders struct fields by ID
+//   - orders map and set items by the canonical encoding of their keys, and
+//     drops duplicate keys and set items
+package canonical
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Encode returns the canonical Binary encoding of the given value of the
+// given type.
+func Encode(spec compile.TypeSpec, v wire.Value) ([]byte, error) {
+	v, err := Value(spec, v)
+	if err != nil {
+		return nil, err
+	}
+	return encode(v)
+}
+
+// Value returns the canonical form of the given value of the given type.
+//
+// An error is returned if the value does not have the type code of the
+// given type, or if a required field without a default value is missing.
+func Value(spec compile.TypeSpec, v wire.Value) (wire.Value, error) {
+	root := compile.RootTypeSpec(spec)
+	if v.Type() != root.TypeCode() {
+		return v, fmt.Errorf(
+			"cannot use %v as %q: expected %v", v.Type(), spec.ThriftName(), root.TypeCode())
+	}
+
+	switch s := root.(type) {
+	case *compile.StructSpec:
+		return canonicalStruct(s, v.GetStruct())
+	case *compile.ListSpec:
+		items, err := canonicalList(s.ValueSpec, v.GetList())
+		if err != nil {
+			return v, err
+		}
+		return wire.NewValueList(wire.ValueListFromSlice(s.ValueSpec.TypeCode(), items)), nil
+	case *compile.SetSpec:
+		items, err := canonicalSet(s.ValueSpec, v.GetSet())
+		if err != nil {
+			return v, err
+		}
+		return wire.NewValueSet(wire.ValueListFromSlice(s.ValueSpec.TypeCode(), items)), nil
+	case *compile.MapSpec:
+		items, err := canonicalMap(s, v.GetMap())
+		if err != nil {
+			return v, err
+		}
+		return wire.NewValueMap(wire.MapItemListFromSlice(
+			s.KeySpec.TypeCode(), s.ValueSpec.TypeCode(), items)), nil
+	default:
+		// Primitives and enums are already canonical.
+		return v, nil
+	}
+}
+
+func canonicalStruct(spec *compile.StructSpec, s wire.Struct) (wire.Value, error) {
+	fields := make([]wire.Field, 0, len(spec.Fields))
+	for _, f := range spec.Fields {
+		var (
+			value wire.Value
+			found bool
+		)
+		// If a field is repeated, decoders keep the last value.
+		for _, wf := range s.Fields {
+			if wf.ID == f.ID && wf.Value.Type() == f.Type.TypeCode() {
+				value, found = wf.Value, true
+			}
+		}
+
+		var err error
+		switch {
+		case found:
+			value, err = Value(f.Type, value)
+		case f.Default != nil:
+			value, err = constantValue(f.Type, f.Default)
+		case f.Required:
+			return wire.Value{}, fmt.Errorf("field %q of %q is required", f.Name, spec.Name)
+		default:
+			continue
+		}
+		if err != nil {
+			return wire.Value{}, fmt.Errorf("field %q of %q: %v", f.Name, spec.Name, err)
+		}
+
+		fields = append(fields, wire.Field{ID: f.ID, Value: value})
+	}
+
+	sort.Sort(fieldsByID(fields))
+	return wire.NewValueStruct(wire.Struct{Fields: fields}), nil
+}
+
+func canonicalList(spec compile.TypeSpec, l wire.ValueList) ([]wire.Value, error) {
+	items := make([]wire.Value, 0, l.Size())
+	err := l.ForEach(func(v wire.Value) error {
+		v, err := Value(spec, v)
+		if err != nil {
+			return fmt.Errorf("item %d: %v", len(items), err)
+		}
+		items = append(items, v)
+		return nil
+	})
+	return items, err
+}
+
+func canonicalSet(spec compile.TypeSpec, l wire.ValueList) ([]wire.Value, error) {
+	items, err := canonicalList(spec, l)
+	if err != nil {
+		return nil, err
+	}
+	return sortValues(items)
+}
+
+func canonicalMap(spec *compile.MapSpec, m wire.MapItemList) ([]wire.MapItem, error) {
+	items := make([]wire.MapItem, 0, m.Size())
+	err := m.ForEach(func(item wire.MapItem) error {
+		k, err := Value(spec.KeySpec, item.Key)
+		if err != nil {
+			return fmt.Errorf("key %d: %v", len(items), err)
+		}
+
+		v, err := Value(spec.ValueSpec, item.Value)
+		if err != nil {
+			return fmt.Errorf("value %d: %v", len(items), err)
+		}
+
+		items = append(items, wire.MapItem{Key: k, Value: v})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sortMapItems(items)
+}
+
+// sortValues orders the given canonical set items by their encoding and
+// drops duplicates.
+func sortValues(items []wire.Value) ([]wire.Value, error) {
+	sorted := make([]encodedValue, len(items))
+	for i, v := range items {
+		b, err := encode(v)
+		if err != nil {
+			return nil, err
+		}
+		sorted[i] = encodedValue{Encoded: b, Value: v}
+	}
+	sort.Stable(encodedValues(sorted))
+
+	items = items[:0]
+	for i, v := range sorted {
+		if i > 0 && bytes.Equal(sorted[i-1].Encoded, v.Encoded) {
+			continue
+		}
+		items = append(items, v.Value)
+	}
+	return items, nil
+}
+
+// sortMapItems orders the given canonical map items by the encoding of their
+// keys and drops all but the last item for repeated keys.
+func sortMapItems(items []wire.MapItem) ([]wire.MapItem, error) {
+	sorted := make([]encodedMapItem, len(items))
+	for i, item := range items {
+		b, err := encode(item.Key)
+		if err != nil {
+			return nil, err
+		}
+		sorted[i] = encodedMapItem{EncodedKey: b, Item: item}
+	}
+	sort.Stable(encodedMapItems(sorted))
+
+	// If a key is repeated, decoders keep the last value.
+	items = items[:0]
+	for i, item := range sorted {
+		if i+1 < len(sorted) && bytes.Equal(sorted[i+1].EncodedKey, item.EncodedKey) {
+			continue
+		}
+		items = append(items, item.Item)
+	}
+	return items, nil
+}
+
+func encode(v wire.Value) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := protocol.Binary.Encode(v, &buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+type fieldsByID []wire.Field
+
+func (fs fieldsByID) Len() int           { return len(fs) }
+func (fs fieldsByID) Less(i, j int) bool { return fs[i].ID < fs[j].ID }
+func (fs fieldsByID) Swap(i, j int)      { fs[i], fs[j] = fs[j], fs[i] }
+
+type encodedValue struct {
+	Encoded []byte
+	Value   wire.Value
+}
+
+type encodedValues []encodedValue
+
+func (vs encodedValues) Len() int           { return len(vs) }
+func (vs encodedValues) Less(i, j int) bool { return bytes.Compare(vs[i].Encoded, vs[j].Encoded) < 0 }
+func (vs encodedValues) Swap(i, j int)      { vs[i], vs[j] = vs[j], vs[i] }
+
+type encodedMapItem struct {
+	EncodedKey []byte
+	Item       wire.MapItem
+}
+
+type encodedMapItems []encodedMapItem
+
+func (is encodedMapItems) Len() int { return len(is) }
+func (is encodedMapItems) Less(i, j int) bool {
+	return bytes.Compare(is[i].EncodedKey, is[j].EncodedKey) < 0
+}
+func (is encodedMapItems) Swap(i, j int) { is[i], is[j] = is[j], is[i] }
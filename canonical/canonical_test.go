@@ -0,0 +1,321 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package canonical
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testThrift = `
+enum Role { USER, ADMIN }
+
+struct Address {
+    1: required string city
+    2: optional string country = "US"
+}
+
+struct User {
+    1: required string name
+    2: optional i32 age
+    3: optional Role role = Role.USER
+    4: optional Address address
+    5: optional list<Address> previousAddresses
+    6: optional map<string, i32> scores
+    7: optional set<string> tags
+    8: optional Address home = {"city": "Springfield"}
+}
+
+typedef map<i32, User> UsersByID
+`
+
+func compileTestModule(t *testing.T) *compile.Module {
+	dir, err := ioutil.TempDir("", "thriftrw-canonical-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "test.thrift")
+	require.NoError(t, ioutil.WriteFile(path, []byte(testThrift), 0644))
+
+	m, err := compile.Compile(path)
+	require.NoError(t, err)
+	return m
+}
+
+func lookupType(t *testing.T, m *compile.Module, name string) compile.TypeSpec {
+	spec, err := m.LookupType(name)
+	require.NoError(t, err)
+	return spec
+}
+
+func vstring(s string) wire.Value { return wire.NewValueString(s) }
+
+func vi32(i int32) wire.Value { return wire.NewValueI32(i) }
+
+func vstruct(fs ...wire.Field) wire.Value {
+	return wire.NewValueStruct(wire.Struct{Fields: fs})
+}
+
+func vlist(typ wire.Type, vs ...wire.Value) wire.Value {
+	return wire.NewValueList(wire.ValueListFromSlice(typ, vs))
+}
+
+func vset(typ wire.Type, vs ...wire.Value) wire.Value {
+	return wire.NewValueSet(wire.ValueListFromSlice(typ, vs))
+}
+
+func vmap(kt, vt wire.Type, items ...wire.MapItem) wire.Value {
+	return wire.NewValueMap(wire.MapItemListFromSlice(kt, vt, items))
+}
+
+func vitem(k, v wire.Value) wire.MapItem {
+	return wire.MapItem{Key: k, Value: v}
+}
+
+// springfield is the canonical form of the default value of User.home.
+var springfield = vstruct(
+	wire.Field{ID: 1, Value: vstring("Springfield")},
+	wire.Field{ID: 2, Value: vstring("US")},
+)
+
+func TestValue(t *testing.T) {
+	m := compileTestModule(t)
+
+	tests := []struct {
+		desc string
+		spec string
+		give wire.Value
+		want wire.Value
+	}{
+		{
+			desc: "defaults",
+			spec: "User",
+			give: vstruct(wire.Field{ID: 1, Value: vstring("foo")}),
+			want: vstruct(
+				wire.Field{ID: 1, Value: vstring("foo")},
+				wire.Field{ID: 3, Value: vi32(0)},
+				wire.Field{ID: 8, Value: springfield},
+			),
+		},
+		{
+			desc: "unknown fields and field order",
+			spec: "User",
+			give: vstruct(
+				wire.Field{ID: 8, Value: springfield},
+				wire.Field{ID: 42, Value: vstring("unknown")},
+				wire.Field{ID: 3, Value: vi32(1)},
+				wire.Field{ID: 2, Value: vstring("wrong type")},
+				wire.Field{ID: 1, Value: vstring("foo")},
+			),
+			want: vstruct(
+				wire.Field{ID: 1, Value: vstring("foo")},
+				wire.Field{ID: 3, Value: vi32(1)},
+				wire.Field{ID: 8, Value: springfield},
+			),
+		},
+		{
+			desc: "repeated field",
+			spec: "Address",
+			give: vstruct(
+				wire.Field{ID: 1, Value: vstring("foo")},
+				wire.Field{ID: 1, Value: vstring("bar")},
+				wire.Field{ID: 2, Value: vstring("CA")},
+			),
+			want: vstruct(
+				wire.Field{ID: 1, Value: vstring("bar")},
+				wire.Field{ID: 2, Value: vstring("CA")},
+			),
+		},
+		{
+			desc: "nested structs",
+			spec: "User",
+			give: vstruct(
+				wire.Field{ID: 1, Value: vstring("foo")},
+				wire.Field{ID: 4, Value: vstruct(wire.Field{ID: 1, Value: vstring("Shelbyville")})},
+				wire.Field{ID: 5, Value: vlist(wire.TStruct,
+					vstruct(wire.Field{ID: 1, Value: vstring("Capital City")}),
+				)},
+			),
+			want: vstruct(
+				wire.Field{ID: 1, Value: vstring("foo")},
+				wire.Field{ID: 3, Value: vi32(0)},
+				wire.Field{ID: 4, Value: vstruct(
+					wire.Field{ID: 1, Value: vstring("Shelbyville")},
+					wire.Field{ID: 2, Value: vstring("US")},
+				)},
+				wire.Field{ID: 5, Value: vlist(wire.TStruct, vstruct(
+					wire.Field{ID: 1, Value: vstring("Capital City")},
+					wire.Field{ID: 2, Value: vstring("US")},
+				))},
+				wire.Field{ID: 8, Value: springfield},
+			),
+		},
+		{
+			desc: "sets and maps",
+			spec: "User",
+			give: vstruct(
+				wire.Field{ID: 1, Value: vstring("foo")},
+				wire.Field{ID: 6, Value: vmap(wire.TBinary, wire.TI32,
+					vitem(vstring("b"), vi32(1)),
+					vitem(vstring("a"), vi32(2)),
+					vitem(vstring("b"), vi32(3)),
+				)},
+				wire.Field{ID: 7, Value: vset(wire.TBinary,
+					vstring("y"), vstring("x"), vstring("y"),
+				)},
+			),
+			want: vstruct(
+				wire.Field{ID: 1, Value: vstring("foo")},
+				wire.Field{ID: 3, Value: vi32(0)},
+				wire.Field{ID: 6, Value: vmap(wire.TBinary, wire.TI32,
+					vitem(vstring("a"), vi32(2)),
+					vitem(vstring("b"), vi32(3)),
+				)},
+				wire.Field{ID: 7, Value: vset(wire.TBinary, vstring("x"), vstring("y"))},
+				wire.Field{ID: 8, Value: springfield},
+			),
+		},
+		{
+			desc: "typedef",
+			spec: "UsersByID",
+			give: vmap(wire.TI32, wire.TStruct,
+				vitem(vi32(2), vstruct(
+					wire.Field{ID: 8, Value: springfield},
+					wire.Field{ID: 3, Value: vi32(0)},
+					wire.Field{ID: 1, Value: vstring("bar")},
+				)),
+				vitem(vi32(1), vstruct(wire.Field{ID: 1, Value: vstring("foo")})),
+			),
+			want: vmap(wire.TI32, wire.TStruct,
+				vitem(vi32(1), vstruct(
+					wire.Field{ID: 1, Value: vstring("foo")},
+					wire.Field{ID: 3, Value: vi32(0)},
+					wire.Field{ID: 8, Value: springfield},
+				)),
+				vitem(vi32(2), vstruct(
+					wire.Field{ID: 1, Value: vstring("bar")},
+					wire.Field{ID: 3, Value: vi32(0)},
+					wire.Field{ID: 8, Value: springfield},
+				)),
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got, err := Value(lookupType(t, m, tt.spec), tt.give)
+			require.NoError(t, err)
+			assert.True(t, wire.ValuesAreEqual(tt.want, got),
+				"\n\t   %v (expected)\n\t!= %v (actual)", tt.want, got)
+
+			// Items of maps and sets must also be in order.
+			var want bytes.Buffer
+			require.NoError(t, protocol.Binary.Encode(tt.want, &want))
+
+			b, err := Encode(lookupType(t, m, tt.spec), tt.give)
+			require.NoError(t, err)
+			assert.Equal(t, want.Bytes(), b)
+		})
+	}
+}
+
+func TestEncodeIsStable(t *testing.T) {
+	spec := lookupType(t, compileTestModule(t), "User")
+
+	a, err := Encode(spec, vstruct(
+		wire.Field{ID: 1, Value: vstring("foo")},
+		wire.Field{ID: 7, Value: vset(wire.TBinary, vstring("x"), vstring("y"))},
+	))
+	require.NoError(t, err)
+
+	b, err := Encode(spec, vstruct(
+		wire.Field{ID: 7, Value: vset(wire.TBinary, vstring("y"), vstring("x"))},
+		wire.Field{ID: 3, Value: vi32(0)},
+		wire.Field{ID: 1, Value: vstring("foo")},
+		wire.Field{ID: 100, Value: vi32(42)},
+	))
+	require.NoError(t, err)
+
+	assert.Equal(t, a, b)
+}
+
+func TestValueErrors(t *testing.T) {
+	m := compileTestModule(t)
+
+	tests := []struct {
+		desc    string
+		spec    string
+		give    wire.Value
+		wantErr string
+	}{
+		{
+			desc:    "type mismatch",
+			spec:    "User",
+			give:    vstring("foo"),
+			wantErr: `cannot use TBinary as "User": expected TStruct`,
+		},
+		{
+			desc:    "missing required field",
+			spec:    "User",
+			give:    vstruct(),
+			wantErr: `field "name" of "User" is required`,
+		},
+		{
+			desc: "nested",
+			spec: "User",
+			give: vstruct(
+				wire.Field{ID: 1, Value: vstring("foo")},
+				wire.Field{ID: 5, Value: vlist(wire.TStruct, springfield, vstruct())},
+			),
+			wantErr: `field "previousAddresses" of "User": item 1: field "city" of "Address" is required`,
+		},
+		{
+			desc: "map value",
+			spec: "UsersByID",
+			give: vmap(wire.TI32, wire.TStruct,
+				vitem(vi32(1), vstruct()),
+			),
+			wantErr: `value 0: field "name" of "User" is required`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := Value(lookupType(t, m, tt.spec), tt.give)
+			if assert.Error(t, err) {
+				assert.Equal(t, tt.wantErr, err.Error())
+			}
+
+			_, err = Encode(lookupType(t, m, tt.spec), tt.give)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package canonical
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/wire"
+)
+
+// constantValue returns the canonical form of the given constant value of
+// the given type. The constant must already have been linked to the type.
+func constantValue(spec compile.TypeSpec, c compile.ConstantValue) (wire.Value, error) {
+	v, err := constantToWire(spec, c)
+	if err != nil {
+		return v, err
+	}
+	return Value(spec, v)
+}
+
+func constantToWire(spec compile.TypeSpec, c compile.ConstantValue) (wire.Value, error) {
+	root := compile.RootTypeSpec(spec)
+	switch v := c.(type) {
+	case compile.ConstantBool:
+		return wire.NewValueBool(bool(v)), nil
+	case compile.ConstantDouble:
+		return wire.NewValueDouble(float64(v)), nil
+	case compile.ConstantInt:
+		switch root.(type) {
+		case *compile.I8Spec:
+			return wire.NewValueI8(int8(v)), nil
+		case *compile.I16Spec:
+			return wire.NewValueI16(int16(v)), nil
+		case *compile.I32Spec, *compile.EnumSpec:
+			return wire.NewValueI32(int32(v)), nil
+		case *compile.I64Spec:
+			return wire.NewValueI64(int64(v)), nil
+		}
+	case compile.ConstantString:
+		switch root.(type) {
+		case *compile.StringSpec:
+			return wire.NewValueString(string(v)), nil
+		case *compile.BinarySpec:
+			return wire.NewValueBinary([]byte(v)), nil
+		case *compile.UUIDSpec:
+			u, err := wire.ParseUUID(string(v))
+			return wire.NewValueUUID(u), err
+		}
+	case compile.EnumItemReference:
+		return wire.NewValueI32(v.Item.Value), nil
+	case compile.ConstReference:
+		return constantToWire(v.Target.Type, v.Target.Value)
+	case compile.ConstantList:
+		if s, ok := root.(*compile.ListSpec); ok {
+			return constantList(s.ValueSpec, v, wire.NewValueList)
+		}
+	case compile.ConstantSet:
+		if s, ok := root.(*compile.SetSpec); ok {
+			return constantList(s.ValueSpec, v, wire.NewValueSet)
+		}
+	case compile.ConstantMap:
+		if s, ok := root.(*compile.MapSpec); ok {
+			return constantMap(s, v)
+		}
+	case *compile.ConstantStruct:
+		if s, ok := root.(*compile.StructSpec); ok {
+			return constantStruct(s, v)
+		}
+	}
+
+	return wire.Value{}, fmt.Errorf("cannot use constant %v as %q", c, spec.ThriftName())
+}
+
+func constantList(
+	spec compile.TypeSpec,
+	c []compile.ConstantValue,
+	newValue func(wire.ValueList) wire.Value,
+) (wire.Value, error) {
+	items := make([]wire.Value, len(c))
+	for i, item := range c {
+		v, err := constantToWire(spec, item)
+		if err != nil {
+			return v, err
+		}
+		items[i] = v
+	}
+	return newValue(wire.ValueListFromSlice(spec.TypeCode(), items)), nil
+}
+
+func constantMap(spec *compile.MapSpec, c compile.ConstantMap) (wire.Value, error) {
+	items := make([]wire.MapItem, len(c))
+	for i, item := range c {
+		k, err := constantToWire(spec.KeySpec, item.Key)
+		if err != nil {
+			return k, err
+		}
+
+		v, err := constantToWire(spec.ValueSpec, item.Value)
+		if err != nil {
+			return v, err
+		}
+
+		items[i] = wire.MapItem{Key: k, Value: v}
+	}
+	return wire.NewValueMap(wire.MapItemListFromSlice(
+		spec.KeySpec.TypeCode(), spec.ValueSpec.TypeCode(), items)), nil
+}
+
+func constantStruct(spec *compile.StructSpec, c *compile.ConstantStruct) (wire.Value, error) {
+	fields := make([]wire.Field, 0, len(c.Fields))
+	for _, f := range spec.Fields {
+		value, ok := c.Fields[f.Name]
+		if !ok {
+			continue
+		}
+
+		v, err := constantToWire(f.Type, value)
+		if err != nil {
+			return v, err
+		}
+		fields = append(fields, wire.Field{ID: f.ID, Value: v})
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields}), nil
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package canonical
+
+import (
+	"sort"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// EncodeUntyped returns the canonical Binary encoding of the given value
+// without the help of its Thrift type.
+func EncodeUntyped(v wire.Value) ([]byte, error) {
+	v, err := Untyped(v)
+	if err != nil {
+		return nil, err
+	}
+	return encode(v)
+}
+
+// Untyped returns the canonical form of the given value without the help of
+// its Thrift type.
+//
+// Without the Thrift type, no struct fields are dropped and no default
+// values are added. Struct fields are ordered by ID and only the last value
+// of repeated fields is kept. Map and set items are ordered and deduplicated
+// as they are by Value.
+//
+// Untyped is suitable for values that must be signed or hashed by peers
+// which may not know about all of their fields.
+func Untyped(v wire.Value) (wire.Value, error) {
+	switch v.Type() {
+	case wire.TStruct:
+		return untypedStruct(v.GetStruct())
+	case wire.TList:
+		l := v.GetList()
+		items, err := untypedList(l)
+		if err != nil {
+			return v, err
+		}
+		return wire.NewValueList(wire.ValueListFromSlice(l.ValueType(), items)), nil
+	case wire.TSet:
+		l := v.GetSet()
+		items, err := untypedList(l)
+		if err == nil {
+			items, err = sortValues(items)
+		}
+		if err != nil {
+			return v, err
+		}
+		return wire.NewValueSet(wire.ValueListFromSlice(l.ValueType(), items)), nil
+	case wire.TMap:
+		m := v.GetMap()
+		items, err := untypedMap(m)
+		if err == nil {
+			items, err = sortMapItems(items)
+		}
+		if err != nil {
+			return v, err
+		}
+		return wire.NewValueMap(wire.MapItemListFromSlice(m.KeyType(), m.ValueType(), items)), nil
+	default:
+		return v, nil
+	}
+}
+
+func untypedStruct(s wire.Struct) (wire.Value, error) {
+	fields := make([]wire.Field, 0, len(s.Fields))
+	for _, f := range s.Fields {
+		value, err := Untyped(f.Value)
+		if err != nil {
+			return wire.Value{}, err
+		}
+		fields = append(fields, wire.Field{ID: f.ID, Value: value})
+	}
+
+	// If a field is repeated, decoders keep the last value.
+	sort.Stable(fieldsByID(fields))
+	out := fields[:0]
+	for i, f := range fields {
+		if i+1 < len(fields) && fields[i+1].ID == f.ID {
+			continue
+		}
+		out = append(out, f)
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: out}), nil
+}
+
+func untypedList(l wire.ValueList) ([]wire.Value, error) {
+	items := make([]wire.Value, 0, l.Size())
+	err := l.ForEach(func(v wire.Value) error {
+		v, err := Untyped(v)
+		if err != nil {
+			return err
+		}
+		items = append(items, v)
+		return nil
+	})
+	return items, err
+}
+
+func untypedMap(m wire.MapItemList) ([]wire.MapItem, error) {
+	items := make([]wire.MapItem, 0, m.Size())
+	err := m.ForEach(func(item wire.MapItem) error {
+		k, err := Untyped(item.Key)
+		if err != nil {
+			return err
+		}
+
+		v, err := Untyped(item.Value)
+		if err != nil {
+			return err
+		}
+
+		items = append(items, wire.MapItem{Key: k, Value: v})
+		return nil
+	})
+	return items, err
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package canonical
+
+import (
+	"testing"
+
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUntyped(t *testing.T) {
+	tests := []struct {
+		desc string
+		give wire.Value
+		want wire.Value
+	}{
+		{
+			desc: "primitive",
+			give: vi32(42),
+			want: vi32(42),
+		},
+		{
+			desc: "struct fields are ordered and unknown fields are kept",
+			give: vstruct(
+				wire.Field{ID: 100, Value: vi32(1)},
+				wire.Field{ID: 1, Value: vstring("foo")},
+				wire.Field{ID: 1, Value: vstring("bar")},
+				wire.Field{ID: -1, Value: vi32(2)},
+			),
+			want: vstruct(
+				wire.Field{ID: -1, Value: vi32(2)},
+				wire.Field{ID: 1, Value: vstring("bar")},
+				wire.Field{ID: 100, Value: vi32(1)},
+			),
+		},
+		{
+			desc: "nested containers",
+			give: vlist(wire.TStruct, vstruct(
+				wire.Field{ID: 2, Value: vset(wire.TBinary, vstring("y"), vstring("x"), vstring("y"))},
+				wire.Field{ID: 1, Value: vmap(wire.TBinary, wire.TI32,
+					vitem(vstring("b"), vi32(1)),
+					vitem(vstring("a"), vi32(2)),
+					vitem(vstring("b"), vi32(3)),
+				)},
+			)),
+			want: vlist(wire.TStruct, vstruct(
+				wire.Field{ID: 1, Value: vmap(wire.TBinary, wire.TI32,
+					vitem(vstring("a"), vi32(2)),
+					vitem(vstring("b"), vi32(3)),
+				)},
+				wire.Field{ID: 2, Value: vset(wire.TBinary, vstring("x"), vstring("y"))},
+			)),
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := Untyped(tt.give)
+		if assert.NoError(t, err, tt.desc) {
+			assert.True(t, wire.ValuesAreEqual(tt.want, got), "%v: expected %v, got %v", tt.desc, tt.want, got)
+		}
+	}
+}
+
+func TestEncodeUntypedIsStable(t *testing.T) {
+	a, err := EncodeUntyped(vstruct(
+		wire.Field{ID: 1, Value: vstring("foo")},
+		wire.Field{ID: 7, Value: vset(wire.TBinary, vstring("x"), vstring("y"))},
+	))
+	require.NoError(t, err)
+
+	b, err := EncodeUntyped(vstruct(
+		wire.Field{ID: 7, Value: vset(wire.TBinary, vstring("y"), vstring("x"))},
+		wire.Field{ID: 1, Value: vstring("foo")},
+	))
+	require.NoError(t, err)
+
+	assert.Equal(t, a, b)
+}
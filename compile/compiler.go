@@ -21,7 +21,10 @@
 package compile
 
 import (
+	"fmt"
 	"path/filepath"
+	"sort"
+	"time"
 
 	"go.uber.org/thriftrw/ast"
 	"go.uber.org/thriftrw/idl"
@@ -30,26 +33,57 @@ import (
 // Compile parses and compiles the Thrift file at the given path and any other
 // Thrift file it includes.
 func Compile(path string, opts ...Option) (*Module, error) {
+	modules, err := CompileFiles([]string{path}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return modules[0], nil
+}
+
+// CompileFiles parses and compiles the Thrift files at the given paths and
+// any other Thrift files they include.
+//
+// Thrift files included by more than one of the given files, or given more
+// than once, are compiled only once and shared between the returned modules.
+// The modules are returned in the same order as the paths.
+func CompileFiles(paths []string, opts ...Option) ([]*Module, error) {
 	c := newCompiler()
 	for _, opt := range opts {
 		opt(&c)
 	}
 
-	m, err := c.load(path)
-	if err != nil {
-		return nil, err
+	modules := make([]*Module, 0, len(paths))
+	for _, path := range paths {
+		m, err := c.load(path)
+		if err != nil {
+			return nil, err
+		}
+		modules = append(modules, m)
 	}
 
-	err = m.Walk(func(m *Module) error {
-		if err := c.link(m); err != nil {
-			return compileError{
-				Target: m.ThriftPath,
-				Reason: err,
+	linked := make(map[string]struct{})
+	for _, m := range modules {
+		err := m.Walk(func(m *Module) error {
+			if _, ok := linked[m.ThriftPath]; ok {
+				return nil
+			}
+			linked[m.ThriftPath] = struct{}{}
+
+			start := time.Now()
+			if err := c.link(m); err != nil {
+				return linkError{Path: m.ThriftPath, Reason: err}
 			}
+			c.observer.ModuleCompiled(ModuleCompiled{
+				Module:   m,
+				Duration: time.Since(start),
+			})
+			return nil
+		})
+		if err != nil {
+			return nil, err
 		}
-		return nil
-	})
-	return m, err
+	}
+	return modules, nil
 }
 
 // compiler is responsible for compiling Thrift files.
@@ -58,20 +92,26 @@ type compiler struct {
 	fs FS
 	// nonStrict will compile Thrift files that do not pass strict validation.
 	nonStrict bool
+	// observer is notified of the compiler's progress.
+	observer Observer
 	// Map from file path to Module representing that file.
 	Modules map[string]*Module
 }
 
 func newCompiler() compiler {
 	return compiler{
-		fs:      realFS{},
-		Modules: make(map[string]*Module),
+		fs:       realFS{},
+		observer: nopObserver{},
+		Modules:  make(map[string]*Module),
 	}
 }
 
 func (c compiler) link(m *Module) error {
 	// TODO(abg): might be worth accumulating compile errors with a max count
 
+	// Definitions are linked in order of their names so that the same error
+	// is reported on every run if more than one of them is invalid.
+
 	// make a copy so that we can modify the list of types as we're iterating
 	// through it.
 	types := make(map[string]TypeSpec)
@@ -79,38 +119,49 @@ func (c compiler) link(m *Module) error {
 		types[name] = typ
 	}
 
-	for name, typ := range types {
+	for _, name := range sortStringKeys(types) {
 		var err error
-		m.Types[name], err = typ.Link(m)
+		m.Types[name], err = types[name].Link(m)
 		if err != nil {
 			return compileError{Target: name, Reason: err}
 		}
 	}
 
-	for name, constant := range m.Constants {
-		if err := constant.Link(m); err != nil {
+	for _, name := range sortStringKeys(m.Constants) {
+		if err := m.Constants[name].Link(m); err != nil {
 			return compileError{Target: name, Reason: err}
 		}
 	}
 
-	for name, service := range m.Services {
-		if err := service.Link(m); err != nil {
+	for _, name := range sortStringKeys(m.Services) {
+		if err := m.Services[name].Link(m); err != nil {
 			return compileError{Target: name, Reason: err}
 		}
 	}
 
-	// Find cycles in typedefs
-	for name, t := range types {
-		if _, ok := t.(*TypedefSpec); !ok {
+	// Find structs whose values would be infinitely nested
+	for _, name := range sortStringKeys(types) {
+		s, ok := m.Types[name].(*StructSpec)
+		if !ok {
 			continue
 		}
 
-		if err := findTypeCycles(t); err != nil {
+		if err := findRequiredFieldCycles(s); err != nil {
 			return compileError{Target: name, Reason: err}
 		}
 	}
 
-	return nil
+	families, err := compileExceptionFamilies(m)
+	if err != nil {
+		return err
+	}
+	m.ExceptionFamilies = families
+
+	// References made by this module may have been resolved while linking
+	// modules which include it, so they aren't in any useful order yet.
+	sort.Stable(referencesByLine(m.References))
+
+	return checkStability(m)
 }
 
 // load populates the compiler with information from the given Thrift file.
@@ -127,6 +178,7 @@ func (c compiler) load(p string) (*Module, error) {
 		return m, nil
 	}
 
+	start := time.Now()
 	s, err := c.fs.Read(p)
 	if err != nil {
 		return nil, fileReadError{Path: p, Reason: err}
@@ -136,6 +188,11 @@ func (c compiler) load(p string) (*Module, error) {
 	if err != nil {
 		return nil, parseError{Path: p, Reason: err}
 	}
+	c.observer.FileParsed(FileParsed{
+		Path:     p,
+		Size:     len(s),
+		Duration: time.Since(start),
+	})
 
 	m := &Module{
 		Name:       fileBaseName(p),
@@ -144,6 +201,7 @@ func (c compiler) load(p string) (*Module, error) {
 		Constants:  make(map[string]*Constant),
 		Types:      make(map[string]TypeSpec),
 		Services:   make(map[string]*ServiceSpec),
+		Namespaces: make(map[string]string),
 	}
 
 	m.Raw = s
@@ -193,6 +251,27 @@ func (c compiler) gather(m *Module, prog *ast.Program) error {
 		m.Includes[include.Name] = include
 	}
 
+	// Line numbers of namespace declarations, keyed by scope.
+	namespaceLines := make(map[string]int)
+	for _, h := range prog.Headers {
+		header, ok := h.(*ast.Namespace)
+		if !ok {
+			continue
+		}
+
+		if line, ok := namespaceLines[header.Scope]; ok {
+			return namespaceError{
+				Namespace: header,
+				Reason: fmt.Errorf(
+					"a namespace for %q was already declared on line %d",
+					header.Scope, line),
+			}
+		}
+
+		namespaceLines[header.Scope] = header.Line
+		m.Namespaces[header.Scope] = header.Name
+	}
+
 	for _, d := range prog.Definitions {
 		if err := thriftNS.claim(d.Info().Name, d.Info().Line); err != nil {
 			return definitionError{Definition: d, Reason: err}
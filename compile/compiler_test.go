@@ -54,6 +54,41 @@ func TestFilesystem(t *testing.T) {
 	assert.Equal(t, wire.TStruct, sType.TypeCode(), "Type mismatch")
 }
 
+func TestCompileNamespaces(t *testing.T) {
+	files := map[string]string{
+		"/some/prefix/main.thrift": `
+			namespace go foo.bar
+			namespace py foo.bar.main
+			include "./shared.thrift"
+		`,
+		"/some/prefix/shared.thrift": `
+			typedef string UUID;
+		`,
+	}
+
+	module, err := Compile("main.thrift", Filesystem(dummyFS{"/some/prefix/", files}))
+	require.NoError(t, err, "Compile failed")
+
+	assert.Equal(t, map[string]string{"go": "foo.bar", "py": "foo.bar.main"}, module.Namespaces)
+	assert.Empty(t, module.Includes["shared"].Module.Namespaces)
+}
+
+func TestCompileNamespaceConflict(t *testing.T) {
+	files := map[string]string{
+		"/some/prefix/main.thrift": `
+			namespace go foo.bar
+			namespace go foo.baz
+		`,
+	}
+
+	_, err := Compile("main.thrift", Filesystem(dummyFS{"/some/prefix/", files}))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(),
+			`cannot declare namespace "foo.baz" for "go" on line 3: `+
+				`a namespace for "go" was already declared on line 2`)
+	}
+}
+
 func TestCompile(t *testing.T) {
 	module, err := Compile("../gen/testdata/thrift/services.thrift")
 	require.NoError(t, err, "Compile failed")
@@ -63,6 +98,94 @@ func TestCompile(t *testing.T) {
 	require.NotNil(t, kvSvc, "KeyValue service is nil")
 }
 
+type recordingObserver struct {
+	parsed   []FileParsed
+	compiled []ModuleCompiled
+}
+
+func (o *recordingObserver) FileParsed(e FileParsed)         { o.parsed = append(o.parsed, e) }
+func (o *recordingObserver) ModuleCompiled(e ModuleCompiled) { o.compiled = append(o.compiled, e) }
+
+func TestCompileObserver(t *testing.T) {
+	files := map[string]string{
+		"/some/prefix/main.thrift": `
+			include "./shared/shared.thrift"
+
+			struct S {
+				1: optional shared.UUID uuid;
+			}
+		`,
+		"/some/prefix/shared/shared.thrift": `
+			typedef string UUID;
+		`,
+	}
+
+	var o recordingObserver
+	module, err := Compile("main.thrift", Filesystem(dummyFS{"/some/prefix/", files}), Observe(&o))
+	require.NoError(t, err, "Compile failed")
+
+	var parsed []string
+	for _, e := range o.parsed {
+		parsed = append(parsed, e.Path)
+		assert.Equal(t, len(files[e.Path]), e.Size, "size of %q", e.Path)
+	}
+	assert.Equal(t, []string{"/some/prefix/main.thrift", "/some/prefix/shared/shared.thrift"}, parsed)
+
+	var compiled []*Module
+	for _, e := range o.compiled {
+		compiled = append(compiled, e.Module)
+	}
+	assert.Equal(t, []*Module{module, module.Includes["shared"].Module}, compiled)
+}
+
+func TestCompileNilObserver(t *testing.T) {
+	files := map[string]string{"/some/prefix/main.thrift": `struct S {}`}
+	_, err := Compile("main.thrift", Filesystem(dummyFS{"/some/prefix/", files}), Observe(nil))
+	assert.NoError(t, err, "Compile with a nil Observer failed")
+}
+
+func TestCompileInheritanceCycle(t *testing.T) {
+	tests := []struct {
+		desc    string
+		src     string
+		wantErr string
+	}{
+		{
+			desc:    "self",
+			src:     `service A extends A {}`,
+			wantErr: `service "A" inherits from itself: A -> A`,
+		},
+		{
+			desc: "without functions",
+			src: `
+				service A extends B {}
+				service B extends A {}
+			`,
+			wantErr: `service "B" inherits from itself: B -> A -> B`,
+		},
+		{
+			desc: "with functions",
+			src: `
+				service A extends B { void f() }
+				service B extends C { void g() }
+				service C extends A { void f() }
+			`,
+			wantErr: `service "C" inherits from itself: C -> A -> B -> C`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			files := map[string]string{"/some/prefix/main.thrift": tt.src}
+			_, err := Compile("main.thrift", Filesystem(dummyFS{"/some/prefix/", files}))
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.wantErr)
+				assert.NotContains(t, err.Error(), "conflicts with")
+			}
+		})
+	}
+}
+
 func TestCompileNonStrict(t *testing.T) {
 	files := map[string]string{
 		"/some/prefix/main.thrift": `
@@ -86,3 +209,49 @@ func TestCompileNonStrict(t *testing.T) {
 	require.NoError(t, err, "Failed to find UUID field in struct")
 	assert.False(t, uuidField.Required, "Unspecified requiredness should be treated as optional")
 }
+
+func TestCompileFiles(t *testing.T) {
+	files := map[string]string{
+		"/some/prefix/foo.thrift": `
+			include "./shared.thrift"
+			struct Foo { 1: optional shared.UUID uuid }
+		`,
+		"/some/prefix/bar.thrift": `
+			include "./shared.thrift"
+			struct Bar { 1: optional shared.UUID uuid }
+		`,
+		"/some/prefix/shared.thrift": `
+			typedef string UUID
+		`,
+	}
+
+	modules, err := CompileFiles(
+		[]string{"foo.thrift", "bar.thrift", "shared.thrift"},
+		Filesystem(dummyFS{"/some/prefix/", files}),
+	)
+	require.NoError(t, err, "CompileFiles failed")
+	require.Len(t, modules, 3)
+
+	foo, bar, shared := modules[0], modules[1], modules[2]
+	assert.Equal(t, "foo", foo.Name)
+	assert.Equal(t, "bar", bar.Name)
+
+	// Shared includes are compiled only once.
+	assert.True(t, shared == foo.Includes["shared"].Module)
+	assert.True(t, shared == bar.Includes["shared"].Module)
+}
+
+func TestCompileFilesError(t *testing.T) {
+	files := map[string]string{
+		"/some/prefix/foo.thrift": `struct Foo {}`,
+		"/some/prefix/bar.thrift": `struct Bar { 1: optional Baz baz }`,
+	}
+
+	_, err := CompileFiles(
+		[]string{"foo.thrift", "bar.thrift"},
+		Filesystem(dummyFS{"/some/prefix/", files}),
+	)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `could not resolve reference "Baz"`)
+	}
+}
@@ -30,6 +30,10 @@ import (
 type Constant struct {
 	linkOnce
 
+	// linking is true while the value of the constant is being linked. It
+	// is used to detect constants which refer to themselves.
+	linking bool
+
 	Name  string
 	File  string
 	Doc   string
@@ -56,9 +60,15 @@ func compileConstant(file string, src *ast.Constant) (*Constant, error) {
 // Link resolves any references made by the constant.
 func (c *Constant) Link(scope Scope) (err error) {
 	if c.linked() {
+		if c.linking {
+			return constantReferenceCycleError{Name: c.Name}
+		}
 		return nil
 	}
 
+	c.linking = true
+	defer func() { c.linking = false }()
+
 	if c.Type, err = c.Type.Link(scope); err != nil {
 		return compileError{Target: c.Name, Reason: err}
 	}
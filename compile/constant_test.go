@@ -32,16 +32,12 @@ import (
 )
 
 func parseConstant(s string) *ast.Constant {
-	prog, err := idl.Parse([]byte(s))
+	def, err := idl.ParseDefinition([]byte(s))
 	if err != nil {
 		panic(fmt.Sprintf("failure to parse: %v: %s", err, s))
 	}
 
-	if len(prog.Definitions) != 1 {
-		panic("parseConstant may be used to parse single constants only")
-	}
-
-	return prog.Definitions[0].(*ast.Constant)
+	return def.(*ast.Constant)
 }
 
 func TestCompileConstant(t *testing.T) {
@@ -51,6 +47,12 @@ func TestCompileConstant(t *testing.T) {
 		Value: ConstantString("bar"),
 	}
 
+	z := &Constant{
+		Name:  "z",
+		Type:  &I32Spec{},
+		Value: ConstantInt(42),
+	}
+
 	tests := []struct {
 		src      string
 		scope    Scope
@@ -89,6 +91,17 @@ func TestCompileConstant(t *testing.T) {
 				},
 			},
 		},
+		{
+			// i32 constants widen to i64.
+			`const i64 wide = z`,
+			scope("z", z),
+			&Constant{
+				Name:  "wide",
+				File:  "test.thrift",
+				Type:  &I64Spec{},
+				Value: ConstantInt(42),
+			},
+		},
 		{
 			`const list<string> foo = ["x", y]`,
 			scope("y", y),
@@ -164,6 +177,41 @@ func TestCompileConstantFailure(t *testing.T) {
 				`could not resolve reference "bar"`,
 			},
 		},
+		{
+			`const i32 foo = "bar"`,
+			nil,
+			[]string{`cannot cast bar to "i32"`},
+		},
+		{
+			`const i8 foo = 128`,
+			nil,
+			[]string{`cannot cast 128 to "byte": the value must be between -128 and 127`},
+		},
+		{
+			`const i16 foo = -32769`,
+			nil,
+			[]string{`cannot cast -32769 to "i16": the value must be between -32768 and 32767`},
+		},
+		{
+			`const list<i32> foo = [1, 2147483648]`,
+			nil,
+			[]string{`cannot cast 2147483648 to "i32": the value must be between -2147483648 and 2147483647`},
+		},
+		{
+			`const map<string, i32> foo = {"a": 1, "b": 2, "a": 3}`,
+			nil,
+			[]string{`duplicate key a`},
+		},
+		{
+			`const set<string> foo = ["bar", y]`,
+			scope("y", y),
+			[]string{`duplicate item bar`},
+		},
+		{
+			`const set<double> foo = [1, 1.0]`,
+			nil,
+			[]string{`duplicate item 1`},
+		},
 	}
 
 	for _, tt := range tests {
@@ -180,3 +228,18 @@ func TestCompileConstantFailure(t *testing.T) {
 		}
 	}
 }
+
+func TestCompileConstantCycle(t *testing.T) {
+	b := &Constant{
+		Name:  "b",
+		Type:  &I32Spec{},
+		Value: constantReference(ast.ConstantReference{Name: "a"}),
+	}
+
+	a, err := compileConstant("test.thrift", parseConstant(`const i32 a = b`))
+	require.NoError(t, err)
+
+	err = a.Link(scope("a", a, "b", b))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `the value of constant "a" refers back to itself`)
+}
@@ -23,8 +23,11 @@ package compile
 import (
 	"errors"
 	"fmt"
+	"math"
+	"sort"
 
 	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/wire"
 )
 
 // ConstantValue represents a compiled constant value or a reference to one.
@@ -88,8 +91,13 @@ func (c ConstantBool) Link(scope Scope, t TypeSpec) (ConstantValue, error) {
 func (c ConstantInt) Link(scope Scope, t TypeSpec) (ConstantValue, error) {
 	rt := RootTypeSpec(t)
 	switch spec := rt.(type) {
-	case *I8Spec, *I16Spec, *I32Spec, *I64Spec:
-		// TODO bounds checks?
+	case *I8Spec:
+		return c.checkRange(t, math.MinInt8, math.MaxInt8)
+	case *I16Spec:
+		return c.checkRange(t, math.MinInt16, math.MaxInt16)
+	case *I32Spec:
+		return c.checkRange(t, math.MinInt32, math.MaxInt32)
+	case *I64Spec:
 		return c, nil
 	case *DoubleSpec:
 		return ConstantDouble(float64(c)).Link(scope, t)
@@ -124,13 +132,33 @@ func (c ConstantInt) Link(scope Scope, t TypeSpec) (ConstantValue, error) {
 	// include them in the error messages.
 }
 
+// checkRange returns an error if the integer does not fit in the given
+// bounds of the given integer type.
+func (c ConstantInt) checkRange(t TypeSpec, min, max int64) (ConstantValue, error) {
+	if int64(c) < min || int64(c) > max {
+		return nil, constantValueCastError{
+			Value:  c,
+			Type:   t,
+			Reason: fmt.Errorf("the value must be between %d and %d", min, max),
+		}
+	}
+	return c, nil
+}
+
 // Link for ConstantString.
 func (c ConstantString) Link(scope Scope, t TypeSpec) (ConstantValue, error) {
 	// TODO(abg): Are binary literals a thing?
-	if _, ok := RootTypeSpec(t).(*StringSpec); !ok {
+	switch RootTypeSpec(t).(type) {
+	case *StringSpec:
+		return c, nil
+	case *UUIDSpec:
+		if _, err := wire.ParseUUID(string(c)); err != nil {
+			return nil, constantValueCastError{Value: c, Type: t, Reason: err}
+		}
+		return c, nil
+	default:
 		return nil, constantValueCastError{Value: c, Type: t}
 	}
-	return c, nil
 }
 
 // Link for ConstantDouble.
@@ -155,6 +183,9 @@ func buildConstantStruct(c ConstantMap) (*ConstantStruct, error) {
 			return nil, fmt.Errorf(
 				"%v is not a string: all keys must be strings", pair.Key)
 		}
+		if _, ok := fields[string(s)]; ok {
+			return nil, fmt.Errorf("field %q is specified more than once", string(s))
+		}
 		fields[string(s)] = pair.Value
 	}
 	return &ConstantStruct{Fields: fields}, nil
@@ -167,6 +198,21 @@ func (c *ConstantStruct) Link(scope Scope, t TypeSpec) (ConstantValue, error) {
 		return nil, constantValueCastError{Value: c, Type: t}
 	}
 
+	names := make([]string, 0, len(c.Fields))
+	for name := range c.Fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if _, err := s.Fields.FindByName(name); err != nil {
+			return nil, constantValueCastError{
+				Value:  c,
+				Type:   t,
+				Reason: fmt.Errorf("%q is not a field of %v", name, s.Name),
+			}
+		}
+	}
+
 	for _, field := range s.Fields {
 		f, ok := c.Fields[field.Name]
 		if !ok {
@@ -243,18 +289,29 @@ func (c ConstantMap) Link(scope Scope, t TypeSpec) (ConstantValue, error) {
 	}
 
 	items := make([]ConstantValuePair, len(c))
+	keys := make(map[interface{}]struct{}, len(c))
 	for i, item := range c {
 		key, err := item.Key.Link(scope, m.KeySpec)
 		if err != nil {
 			return nil, err
 		}
 
+		if k, ok := constantKey(key); ok {
+			if _, dup := keys[k]; dup {
+				return nil, constantValueCastError{
+					Value:  c,
+					Type:   t,
+					Reason: fmt.Errorf("duplicate key %v", key),
+				}
+			}
+			keys[k] = struct{}{}
+		}
+
 		value, err := item.Value.Link(scope, m.ValueSpec)
 		if err != nil {
 			return nil, err
 		}
 
-		// TODO(abg): Duplicate key check
 		items[i] = ConstantValuePair{Key: key, Value: value}
 	}
 
@@ -272,13 +329,25 @@ func (c ConstantSet) Link(scope Scope, t TypeSpec) (ConstantValue, error) {
 	}
 
 	// TODO(abg): Track whether things are linked so that we don't re-link here
-	// TODO(abg): Fail for duplicates
 	values := make([]ConstantValue, len(c))
+	items := make(map[interface{}]struct{}, len(c))
 	for i, v := range c {
 		value, err := v.Link(scope, s.ValueSpec)
 		if err != nil {
 			return nil, err
 		}
+
+		if k, ok := constantKey(value); ok {
+			if _, dup := items[k]; dup {
+				return nil, constantValueCastError{
+					Value:  c,
+					Type:   t,
+					Reason: fmt.Errorf("duplicate item %v", value),
+				}
+			}
+			items[k] = struct{}{}
+		}
+
 		values[i] = value
 	}
 
@@ -320,6 +389,29 @@ func (c ConstantList) Link(scope Scope, t TypeSpec) (ConstantValue, error) {
 	return ConstantList(values), nil
 }
 
+// enumItemKey identifies the value of an enum item in constantKey.
+type enumItemKey struct {
+	Enum  *EnumSpec
+	Value int32
+}
+
+// constantKey returns a comparable representation of the given linked
+// primitive constant value, following references to other constants. Two
+// values with the same key are the same value. It returns false for
+// values which are not primitives.
+func constantKey(v ConstantValue) (interface{}, bool) {
+	switch v := v.(type) {
+	case ConstantBool, ConstantInt, ConstantString, ConstantDouble:
+		return v, true
+	case EnumItemReference:
+		return enumItemKey{Enum: v.Enum, Value: v.Item.Value}, true
+	case ConstReference:
+		return constantKey(v.Target.Value)
+	default:
+		return nil, false
+	}
+}
+
 // ConstReference represents a reference to a 'const' declared in the Thrift
 // file.
 type ConstReference struct {
@@ -359,19 +451,32 @@ type constantReference ast.ConstantReference
 //
 // This resolves the reference to a ConstReference or an EnumItemReference.
 func (r constantReference) Link(scope Scope, t TypeSpec) (ConstantValue, error) {
+	v, ref, err := r.link(scope, t)
+	if err == nil {
+		ref.Name = r.Name
+		ref.Line = r.Line
+		recordReference(scope, ref)
+	}
+	return v, err
+}
+
+// link resolves the reference in the given scope, returning the linked value
+// and a Reference to the constant or enum item it resolved to.
+func (r constantReference) link(scope Scope, t TypeSpec) (ConstantValue, Reference, error) {
 	src := ast.ConstantReference(r)
 
 	c, err := scope.LookupConstant(src.Name)
 	if err == nil {
 		if err := c.Link(scope); err != nil {
-			return nil, err
+			return nil, Reference{}, err
 		}
-		return ConstReference{Target: c}.Link(scope, t)
+		v, err := ConstReference{Target: c}.Link(scope, t)
+		return v, Reference{Constant: c}, err
 	}
 
 	mname, iname := splitInclude(src.Name)
 	if len(mname) == 0 {
-		return nil, referenceError{
+		return nil, Reference{}, referenceError{
 			Target:    src.Name,
 			Line:      src.Line,
 			ScopeName: scope.GetName(),
@@ -381,13 +486,11 @@ func (r constantReference) Link(scope Scope, t TypeSpec) (ConstantValue, error)
 
 	if enum, ok := lookupEnum(scope, mname); ok {
 		if item, ok := enum.LookupItem(iname); ok {
-			return EnumItemReference{
-				Enum: enum,
-				Item: item,
-			}, nil
+			ref := EnumItemReference{Enum: enum, Item: item}
+			return ref, Reference{EnumItem: &ref}, nil
 		}
 
-		return nil, referenceError{
+		return nil, Reference{}, referenceError{
 			Target:    src.Name,
 			Line:      src.Line,
 			ScopeName: scope.GetName(),
@@ -400,7 +503,7 @@ func (r constantReference) Link(scope Scope, t TypeSpec) (ConstantValue, error)
 
 	includedScope, err := getIncludedScope(scope, mname)
 	if err != nil {
-		return nil, referenceError{
+		return nil, Reference{}, referenceError{
 			Target:    src.Name,
 			Line:      src.Line,
 			ScopeName: scope.GetName(),
@@ -408,9 +511,9 @@ func (r constantReference) Link(scope Scope, t TypeSpec) (ConstantValue, error)
 		}
 	}
 
-	value, err := constantReference{Name: iname}.Link(includedScope, t)
+	value, ref, err := constantReference{Name: iname}.link(includedScope, t)
 	if err != nil {
-		return nil, referenceError{
+		return nil, Reference{}, referenceError{
 			Target:    src.Name,
 			Line:      src.Line,
 			ScopeName: scope.GetName(),
@@ -418,7 +521,7 @@ func (r constantReference) Link(scope Scope, t TypeSpec) (ConstantValue, error)
 		}
 	}
 
-	return value, nil
+	return value, ref, nil
 }
 
 // lookupEnum looks up an enum with the given name in the given scope.
@@ -240,6 +240,18 @@ func TestCastConstants(t *testing.T) {
 			give: ConstantString("foo"),
 			want: ConstantString("foo"),
 		},
+		{
+			desc: "ConstantString: uuid",
+			typ:  &UUIDSpec{},
+			give: ConstantString("6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
+			want: ConstantString("6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
+		},
+		{
+			desc:      "ConstantString: uuid (failure)",
+			typ:       &UUIDSpec{},
+			give:      ConstantString("foo"),
+			wantError: `cannot cast foo to "uuid": invalid UUID "foo"`,
+		},
 		{
 			desc: "ConstantDouble",
 			typ:  &DoubleSpec{},
@@ -295,6 +307,17 @@ func TestCastConstants(t *testing.T) {
 			},
 			wantError: `failed to cast field "someRequiredField": cannot cast foo to "i32"`,
 		},
+		{
+			desc: "ConstantStruct: unknown field",
+			typ:  someStruct,
+			give: &ConstantStruct{
+				Fields: map[string]ConstantValue{
+					"someRequiredField": ConstantInt(100),
+					"someUnknownField":  ConstantInt(200),
+				},
+			},
+			wantError: `"someUnknownField" is not a field of SomeStruct`,
+		},
 		{
 			desc: "ConstantMap",
 			typ:  &MapSpec{KeySpec: &StringSpec{}, ValueSpec: &I32Spec{}},
@@ -334,6 +357,21 @@ func TestCastConstants(t *testing.T) {
 			},
 			wantError: "100 is not a string: all keys must be strings",
 		},
+		{
+			desc: "ConstantMap: struct (duplicate key)",
+			typ:  someStruct,
+			give: ConstantMap{
+				{
+					Key:   ConstantString("someRequiredField"),
+					Value: ConstantInt(100),
+				},
+				{
+					Key:   ConstantString("someRequiredField"),
+					Value: ConstantInt(200),
+				},
+			},
+			wantError: `field "someRequiredField" is specified more than once`,
+		},
 		{
 			desc: "ConstantSet",
 			typ:  &SetSpec{ValueSpec: &I32Spec{}},
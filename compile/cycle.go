@@ -20,6 +20,8 @@
 
 package compile
 
+import "go.uber.org/thriftrw/ast"
+
 // findTypeCycles look for invalid type reference cycles in the given
 // TypeSpec.
 func findTypeCycles(t TypeSpec) error {
@@ -59,6 +61,12 @@ func (f typeCycleFinder) Visit(s TypeSpec) error {
 		return nil
 	}
 
+	if _, unlinked := s.(typeSpecReference); unlinked {
+		// The typedef which refers to this type is still being linked. The
+		// cycle, if any, is found once it is.
+		return nil
+	}
+
 	if _, isStruct := s.(*StructSpec); isStruct {
 		// Cycles break at structs. A typedef for a struct which refreences back
 		// to the typedef is valid because we support self-referential structs.
@@ -67,3 +75,51 @@ func (f typeCycleFinder) Visit(s TypeSpec) error {
 
 	return s.ForEachTypeReference(f.cloneWithPart(s).Visit)
 }
+
+// findRequiredFieldCycles looks for cycles of required fields which lead
+// back to the given struct. Values of such structs would have to be
+// infinitely nested, so recursive structs must reference themselves through
+// optional fields, containers, or unions.
+func findRequiredFieldCycles(s *StructSpec) error {
+	f := requiredFieldCycleFinder{
+		root:    s,
+		visited: make(map[*StructSpec]struct{}),
+	}
+	return f.Visit(s, nil)
+}
+
+type requiredFieldCycleFinder struct {
+	root    *StructSpec
+	visited map[*StructSpec]struct{}
+}
+
+// Visit visits the required fields of the given struct. path holds the
+// fields which led to it.
+func (f requiredFieldCycleFinder) Visit(s *StructSpec, path []string) error {
+	for _, field := range s.Fields {
+		if !field.Required {
+			continue
+		}
+
+		// Unions may hold a different field instead.
+		target, ok := RootTypeSpec(field.Type).(*StructSpec)
+		if !ok || target.Type == ast.UnionType {
+			continue
+		}
+
+		fieldPath := append(path[:len(path):len(path)], s.Name+"."+field.Name)
+		if target == f.root {
+			return requiredFieldCycleError{Fields: fieldPath}
+		}
+
+		if _, ok := f.visited[target]; ok {
+			continue
+		}
+		f.visited[target] = struct{}{}
+
+		if err := f.Visit(target, fieldPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -63,11 +63,13 @@ func TestFindTypeCycles(t *testing.T) {
 					Target: x,
 				}
 			}),
+			// The cycle is complete once bar is linked because its target
+			// is linked already.
 			msgs: []string{
 				"found a type reference cycle",
-				"   foo",
-				"-> bar",
+				"   bar",
 				"-> foo",
+				"-> bar",
 			},
 		},
 		{
@@ -134,9 +136,8 @@ func TestFindTypeCycles(t *testing.T) {
 	}
 
 	for _, tt := range tests {
-		typ := mustLink(t, tt.typ, defaultScope)
-
-		err := findTypeCycles(typ)
+		// Cycles are reported when the typedef is linked.
+		_, err := tt.typ.Link(defaultScope)
 		if len(tt.msgs) > 0 {
 			if assert.Error(t, err, tt.desc) {
 				for _, msg := range tt.msgs {
@@ -148,3 +149,129 @@ func TestFindTypeCycles(t *testing.T) {
 		}
 	}
 }
+
+func TestCompileTypedefCycles(t *testing.T) {
+	tests := []struct {
+		desc  string
+		files map[string]string
+		msgs  []string
+	}{
+		{
+			desc: "used before the cycle is linked",
+			files: map[string]string{
+				"/test.thrift": `
+					struct Bar { 1: optional Foo foo = 1 }
+					const Foo baz = 2
+					typedef Qux Foo
+					typedef Foo Qux
+				`,
+			},
+			msgs: []string{
+				`cannot compile "Bar"`,
+				"found a type reference cycle:\n    Foo\n -> Qux\n -> Foo",
+			},
+		},
+		{
+			desc: "across files",
+			files: map[string]string{
+				"/a.thrift": `
+					include "./b.thrift"
+					typedef b.B A
+				`,
+				"/b.thrift": `
+					include "./a.thrift"
+					typedef a.A B
+				`,
+			},
+			msgs: []string{
+				"found a type reference cycle:",
+				"    A (/a.thrift)\n -> B (/b.thrift)\n -> A (/a.thrift)",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			path := "test.thrift"
+			if _, ok := tt.files["/a.thrift"]; ok {
+				path = "a.thrift"
+			}
+
+			_, err := Compile(path, Filesystem(dummyFS{"/", tt.files}))
+			if assert.Error(t, err) {
+				for _, msg := range tt.msgs {
+					assert.Contains(t, err.Error(), msg)
+				}
+			}
+		})
+	}
+}
+
+func TestFindRequiredFieldCycles(t *testing.T) {
+	tests := []struct {
+		desc string
+		src  string
+		msgs []string
+	}{
+		{
+			desc: "optional self-reference",
+			src:  `struct Node { 1: optional Node child }`,
+		},
+		{
+			desc: "required self-reference in container",
+			src:  `struct Node { 1: required list<Node> children }`,
+		},
+		{
+			desc: "required self-reference through union",
+			src: `
+				struct Operation { 1: required Expression left }
+				union Expression { 1: i64 literal; 2: Operation operation }
+			`,
+		},
+		{
+			desc: "required self-reference",
+			src:  `struct Node { 1: required Node child }`,
+			msgs: []string{
+				`cannot compile "Node"`,
+				"found a cycle of required fields, one of which must be optional:",
+				"    Node.child",
+			},
+		},
+		{
+			desc: "mutually recursive required fields",
+			src: `
+				struct Bar { 1: optional i32 x; 2: required Foo foo }
+				struct Foo { 1: required Bar bar }
+			`,
+			msgs: []string{
+				`cannot compile "Bar"`,
+				"    Bar.foo\n -> Foo.bar",
+			},
+		},
+		{
+			desc: "required self-reference through typedef",
+			src: `
+				typedef Node Next
+				exception Node { 1: required Next child }
+			`,
+			msgs: []string{"    Node.child"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			fs := dummyFS{"/", map[string]string{"/test.thrift": tt.src}}
+			_, err := Compile("test.thrift", Filesystem(fs))
+			if len(tt.msgs) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			if assert.Error(t, err) {
+				for _, msg := range tt.msgs {
+					assert.Contains(t, err.Error(), msg)
+				}
+			}
+		})
+	}
+}
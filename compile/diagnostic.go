@@ -0,0 +1,183 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compile
+
+import "go.uber.org/thriftrw/idl"
+
+// Diagnostic is a single problem found while compiling Thrift files, in a
+// form that editors and other tools can surface without parsing error
+// messages.
+type Diagnostic struct {
+	// Path to the Thrift file in which the problem was found.
+	File string `json:"file"`
+
+	// Line and column at which the problem was found, starting at 1. These
+	// are 0 if they are not known.
+	Line   int `json:"line,omitempty"`
+	Column int `json:"column,omitempty"`
+
+	// Code identifies the kind of problem, for example "syntax" or
+	// "unknown-reference".
+	Code string `json:"code"`
+
+	// Message describes the problem.
+	Message string `json:"message"`
+}
+
+// Diagnostics breaks an error returned by Compile or CompileFiles down into
+// diagnostics.
+//
+// A syntax error produces a diagnostic for every syntax error found in the
+// file. Other errors produce a single diagnostic. Errors that did not come
+// from the compiler produce a diagnostic without a file.
+func Diagnostics(err error) []Diagnostic {
+	if err == nil {
+		return nil
+	}
+
+	// Errors are wrapped with the context in which they occurred, starting
+	// with the file. The innermost file is the one with the problem and
+	// the innermost line and code are the most specific.
+	d := Diagnostic{Message: err.Error()}
+	for err != nil {
+		var reason error
+		switch e := err.(type) {
+		case fileReadError:
+			d = fileDiagnostic(e.Path, e.Reason)
+			d.Code = "file-read"
+		case parseError:
+			if pe, ok := e.Reason.(*idl.ParseError); ok {
+				return syntaxDiagnostics(e.Path, pe)
+			}
+			d = fileDiagnostic(e.Path, e.Reason)
+			d.Code = "syntax"
+		case fileCompileError:
+			d = fileDiagnostic(e.Path, e.Reason)
+			reason = e.Reason
+		case linkError:
+			d = fileDiagnostic(e.Path, e.Reason)
+			reason = e.Reason
+		case includeError:
+			d.Line = e.Include.Line
+			reason = e.Reason
+		case namespaceError:
+			d.Line = e.Namespace.Line
+			reason = e.Reason
+		case definitionError:
+			d.Line = e.Definition.Info().Line
+			reason = e.Reason
+		case compileError:
+			d.Line = lineOr(e.Line, d.Line)
+			reason = e.Reason
+		case referenceError:
+			d.Line = lineOr(e.Line, d.Line)
+			d.Code = "unknown-reference"
+			reason = e.Reason
+		case inheritedFunctionConflictError:
+			d.Line = lineOr(e.Function.line, d.Line)
+			d.Code = "inherited-function-conflict"
+		case inheritanceCycleError:
+			d.Code = "inheritance-cycle"
+		case requirednessRequiredError:
+			d.Line = e.Line
+			d.Code = "requiredness-required"
+		case cannotBeRequiredError:
+			d.Line = e.Line
+			d.Code = "cannot-be-required"
+		case defaultValueNotAllowedError:
+			d.Line = e.Line
+			d.Code = "default-value-not-allowed"
+		case includeAsDisabledError:
+			d.Code = "include-as-disabled"
+		case unrecognizedModuleError:
+			d.Code = "unknown-module"
+			reason = e.Reason
+		case unrecognizedEnumItemError:
+			d.Code = "unknown-enum-item"
+		case fieldIDConflictError:
+			d.Code = "field-id-conflict"
+		case fieldIDRangeError:
+			d.Code = "field-id-range"
+		case oneWayCannotReturnError:
+			d.Code = "oneway-cannot-return"
+		case notAnExceptionError:
+			d.Code = "not-an-exception"
+		case typeReferenceCycleError:
+			d.Code = "type-reference-cycle"
+		case constantValueCastError:
+			d.Code = "constant-cast"
+			reason = e.Reason
+		case constantStructFieldCastError:
+			d.Code = "constant-cast"
+			reason = e.Reason
+		case constantCastError:
+			d.Code = "constant-cast"
+			reason = e.Reason
+		case constantReferenceCycleError:
+			d.Code = "constant-reference-cycle"
+		case annotationConflictError:
+			d.Code = "annotation-conflict"
+			reason = e.Reason
+		case requiredFieldCycleError:
+			d.Code = "required-field-cycle"
+		case stabilityError:
+			d.Code = "stability"
+		case exceptionFamilyError:
+			d.Code = "exception-family"
+			reason = e.Reason
+		}
+		err = reason
+	}
+
+	if d.Code == "" {
+		d.Code = "compile"
+	}
+	return []Diagnostic{d}
+}
+
+// fileDiagnostic starts a diagnostic for a problem in the given file. The
+// message leaves out the path because the diagnostic records it separately.
+func fileDiagnostic(path string, reason error) Diagnostic {
+	return Diagnostic{File: path, Message: reason.Error()}
+}
+
+func lineOr(line, fallback int) int {
+	if line > 0 {
+		return line
+	}
+	return fallback
+}
+
+// syntaxDiagnostics returns a diagnostic for each syntax error in the given
+// file.
+func syntaxDiagnostics(path string, pe *idl.ParseError) []Diagnostic {
+	ds := make([]Diagnostic, len(pe.Errors))
+	for i, e := range pe.Errors {
+		ds[i] = Diagnostic{
+			File:    path,
+			Line:    e.Line,
+			Column:  e.Column,
+			Code:    "syntax",
+			Message: e.Message,
+		}
+	}
+	return ds
+}
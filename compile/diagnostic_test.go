@@ -0,0 +1,139 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compile
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiagnostics(t *testing.T) {
+	tests := []struct {
+		desc  string
+		files map[string]string
+		want  []Diagnostic
+	}{
+		{
+			desc:  "missing file",
+			files: map[string]string{},
+			want: []Diagnostic{{
+				File:    "/x/main.thrift",
+				Code:    "file-read",
+				Message: "file not found: /x/main.thrift",
+			}},
+		},
+		{
+			desc: "syntax errors",
+			files: map[string]string{
+				"/x/main.thrift": `struct Foo {
+  1: string foo bar
+  2: required i32 baz
+  3 i64 qux
+}
+`,
+			},
+			want: []Diagnostic{
+				{
+					File:    "/x/main.thrift",
+					Line:    2,
+					Column:  17,
+					Code:    "syntax",
+					Message: "syntax error: unexpected IDENTIFIER, expecting '}' or INTCONSTANT",
+				},
+				{
+					File:    "/x/main.thrift",
+					Line:    4,
+					Column:  5,
+					Code:    "syntax",
+					Message: "syntax error: unexpected I64, expecting ':'",
+				},
+			},
+		},
+		{
+			desc: "definition error",
+			files: map[string]string{
+				"/x/main.thrift": `struct Foo {
+  1: required string foo
+}
+union Bar {
+  1: required string bar
+}
+`,
+			},
+			want: []Diagnostic{{
+				File:    "/x/main.thrift",
+				Line:    5,
+				Code:    "cannot-be-required",
+				Message: `cannot define "Bar" on line 4: cannot compile "Bar" on line 4: cannot compile "bar" on line 5: field "bar" on line 5 is marked as required but it cannot be required`,
+			}},
+		},
+		{
+			desc: "unknown reference",
+			files: map[string]string{
+				"/x/main.thrift": `struct Foo {
+  1: optional Bar bar
+}
+`,
+			},
+			want: []Diagnostic{{
+				File:    "/x/main.thrift",
+				Line:    2,
+				Code:    "unknown-reference",
+				Message: `cannot compile "Foo": could not resolve reference "Bar" on line 2 in "main": unknown identifier "Bar"`,
+			}},
+		},
+		{
+			desc: "error in included file",
+			files: map[string]string{
+				"/x/main.thrift": `include "./shared.thrift"
+struct Foo {}
+`,
+				"/x/shared.thrift": `struct Bar {
+  1: optional string bar
+  1: optional string baz
+}
+`,
+			},
+			want: []Diagnostic{{
+				File:    "/x/shared.thrift",
+				Line:    3,
+				Code:    "field-id-conflict",
+				Message: `cannot define "Bar" on line 1: cannot compile "Bar" on line 1: cannot compile "baz" on line 3: field "bar" has already used ID 1 on line 2`,
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		_, err := Compile("main.thrift", Filesystem(dummyFS{"/x/", tt.files}))
+		require.Error(t, err, tt.desc)
+		assert.Equal(t, tt.want, Diagnostics(err), tt.desc)
+	}
+}
+
+func TestDiagnosticsUnknownError(t *testing.T) {
+	assert.Nil(t, Diagnostics(nil))
+	assert.Equal(t,
+		[]Diagnostic{{Code: "compile", Message: "great sadness"}},
+		Diagnostics(errors.New("great sadness")))
+}
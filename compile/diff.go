@@ -0,0 +1,511 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compile
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.uber.org/thriftrw/ast"
+)
+
+// ChangeKind specifies whether a definition or one of its members was added,
+// removed, or changed.
+type ChangeKind int
+
+// Kinds of changes reported by Diff.
+const (
+	Added ChangeKind = iota + 1
+	Removed
+	Changed
+)
+
+func (k ChangeKind) String() string {
+	switch k {
+	case Added:
+		return "added"
+	case Removed:
+		return "removed"
+	case Changed:
+		return "changed"
+	default:
+		return fmt.Sprintf("ChangeKind(%d)", int(k))
+	}
+}
+
+// Change is a single difference between two versions of a Thrift module.
+type Change struct {
+	Kind ChangeKind
+
+	// Name of the type, constant, or service which changed.
+	Definition string
+
+	// Name of the field, enum item, or function of the definition which
+	// changed. This is empty if the change applies to the definition as a
+	// whole.
+	//
+	// Arguments of functions are named "function.argument" and exceptions
+	// raised by them, "function.throws.exception".
+	Member string
+
+	// Detail describes what changed for changes of kind Changed.
+	Detail string
+
+	// Breaking is true if the change may break existing callers, or
+	// readers of data written before the change.
+	Breaking bool
+}
+
+// Name returns the qualified name of the definition or member that changed.
+func (c Change) Name() string {
+	if c.Member == "" {
+		return c.Definition
+	}
+	return c.Definition + "." + c.Member
+}
+
+func (c Change) String() string {
+	s := c.Kind.String() + " " + c.Name()
+	if c.Detail != "" {
+		s += ": " + c.Detail
+	}
+	if c.Breaking {
+		s += " (breaking)"
+	}
+	return s
+}
+
+// Diff compares two versions of a linked module and returns the changes
+// between them, sorted by name.
+//
+// Only the definitions of the given modules are compared. Changes to
+// included modules are reported by comparing them separately.
+//
+// Fields are matched by ID, and everything else by name. Changes are
+// breaking if they can affect the wire representation of existing data or
+// remove something that callers may rely on.
+func Diff(from, to *Module) []Change {
+	var d differ
+
+	for _, name := range sortedNames(from.Constants, to.Constants) {
+		d.constants(name, from.Constants[name], to.Constants[name])
+	}
+	for _, name := range sortedNames(from.Types, to.Types) {
+		d.types(name, from.Types[name], to.Types[name])
+	}
+	for _, name := range sortedNames(from.Services, to.Services) {
+		d.services(name, from.Services[name], to.Services[name])
+	}
+
+	sort.Stable(changesByName(d.changes))
+	return d.changes
+}
+
+type differ struct {
+	changes []Change
+}
+
+func (d *differ) add(c Change) {
+	d.changes = append(d.changes, c)
+}
+
+// presence records the addition or removal of a definition. It returns true
+// if the definition exists in both versions.
+func (d *differ) presence(name string, inFrom, inTo bool) bool {
+	switch {
+	case inFrom && !inTo:
+		d.add(Change{Kind: Removed, Definition: name, Breaking: true})
+	case !inFrom && inTo:
+		d.add(Change{Kind: Added, Definition: name})
+	}
+	return inFrom && inTo
+}
+
+func (d *differ) constants(name string, from, to *Constant) {
+	if !d.presence(name, from != nil, to != nil) {
+		return
+	}
+
+	if from.Type.ThriftName() != to.Type.ThriftName() {
+		d.add(Change{
+			Kind:       Changed,
+			Definition: name,
+			Detail:     changedDetail("type", from.Type.ThriftName(), to.Type.ThriftName()),
+			Breaking:   true,
+		})
+	}
+
+	if fv, tv := constantValueString(from.Value), constantValueString(to.Value); fv != tv {
+		d.add(Change{
+			Kind:       Changed,
+			Definition: name,
+			Detail:     changedDetail("value", fv, tv),
+		})
+	}
+}
+
+func (d *differ) types(name string, from, to TypeSpec) {
+	if !d.presence(name, from != nil, to != nil) {
+		return
+	}
+
+	if fk, tk := definitionKind(from), definitionKind(to); fk != tk {
+		d.add(Change{
+			Kind:       Changed,
+			Definition: name,
+			Detail:     fmt.Sprintf("changed from %v to %v", fk, tk),
+			Breaking:   true,
+		})
+		return
+	}
+
+	switch f := from.(type) {
+	case *StructSpec:
+		d.fields(name, "", f.Fields, to.(*StructSpec).Fields)
+	case *EnumSpec:
+		d.enumItems(name, f, to.(*EnumSpec))
+	case *TypedefSpec:
+		t := to.(*TypedefSpec)
+		if f.Target.ThriftName() != t.Target.ThriftName() {
+			d.add(Change{
+				Kind:       Changed,
+				Definition: name,
+				Detail:     changedDetail("target", f.Target.ThriftName(), t.Target.ThriftName()),
+				Breaking:   !sameWireType(f.Target, t.Target),
+			})
+		}
+	}
+}
+
+func (d *differ) enumItems(name string, from, to *EnumSpec) {
+	for _, item := range from.Items {
+		if _, ok := to.LookupItem(item.Name); !ok {
+			d.add(Change{Kind: Removed, Definition: name, Member: item.Name, Breaking: true})
+		}
+	}
+
+	for _, item := range to.Items {
+		old, ok := from.LookupItem(item.Name)
+		if !ok {
+			d.add(Change{Kind: Added, Definition: name, Member: item.Name})
+			continue
+		}
+
+		if old.Value != item.Value {
+			d.add(Change{
+				Kind:       Changed,
+				Definition: name,
+				Member:     item.Name,
+				Detail:     changedDetail("value", fmt.Sprint(old.Value), fmt.Sprint(item.Value)),
+				Breaking:   true,
+			})
+		}
+	}
+}
+
+// fields compares two groups of fields by ID. Member names of the reported
+// changes are prefixed with the given string.
+func (d *differ) fields(name, prefix string, from, to FieldGroup) {
+	toByID := make(map[int16]*FieldSpec, len(to))
+	for _, f := range to {
+		toByID[f.ID] = f
+	}
+
+	fromByID := make(map[int16]*FieldSpec, len(from))
+	for _, f := range from {
+		fromByID[f.ID] = f
+		if _, ok := toByID[f.ID]; !ok {
+			d.add(Change{
+				Kind:       Removed,
+				Definition: name,
+				Member:     prefix + f.Name,
+				Breaking:   f.Required,
+			})
+		}
+	}
+
+	for _, t := range to {
+		member := prefix + t.Name
+		f, ok := fromByID[t.ID]
+		if !ok {
+			d.add(Change{
+				Kind:       Added,
+				Definition: name,
+				Member:     member,
+				Breaking:   t.Required,
+			})
+			continue
+		}
+
+		if f.Name != t.Name {
+			d.add(Change{
+				Kind:       Changed,
+				Definition: name,
+				Member:     member,
+				Detail:     fmt.Sprintf("renamed from %q", f.Name),
+			})
+		}
+
+		if f.Type.ThriftName() != t.Type.ThriftName() {
+			d.add(Change{
+				Kind:       Changed,
+				Definition: name,
+				Member:     member,
+				Detail:     changedDetail("type", f.Type.ThriftName(), t.Type.ThriftName()),
+				Breaking:   !sameWireType(f.Type, t.Type),
+			})
+		}
+
+		if f.Required != t.Required {
+			d.add(Change{
+				Kind:       Changed,
+				Definition: name,
+				Member:     member,
+				Detail:     changedDetail("requiredness", requiredness(f), requiredness(t)),
+				Breaking:   true,
+			})
+		}
+
+		if fv, tv := constantValueString(f.Default), constantValueString(t.Default); fv != tv {
+			d.add(Change{
+				Kind:       Changed,
+				Definition: name,
+				Member:     member,
+				Detail:     changedDetail("default value", fv, tv),
+			})
+		}
+	}
+}
+
+func (d *differ) services(name string, from, to *ServiceSpec) {
+	if !d.presence(name, from != nil, to != nil) {
+		return
+	}
+
+	if fp, tp := parentName(from), parentName(to); fp != tp {
+		// Functions moved between a service and its parents are still
+		// available, so only the functions decide whether this breaks.
+		d.add(Change{
+			Kind:       Changed,
+			Definition: name,
+			Detail:     changedDetail("parent", fp, tp),
+		})
+	}
+
+	fromFuncs := allFunctions(from)
+	toFuncs := allFunctions(to)
+	for _, fname := range sortedNames(fromFuncs, toFuncs) {
+		f, t := fromFuncs[fname], toFuncs[fname]
+		switch {
+		case t == nil:
+			d.add(Change{Kind: Removed, Definition: name, Member: fname, Breaking: true})
+		case f == nil:
+			d.add(Change{Kind: Added, Definition: name, Member: fname})
+		default:
+			d.function(name, f, t)
+		}
+	}
+}
+
+func (d *differ) function(name string, from, to *FunctionSpec) {
+	if from.OneWay != to.OneWay {
+		d.add(Change{
+			Kind:       Changed,
+			Definition: name,
+			Member:     to.Name,
+			Detail:     changedDetail("oneway", fmt.Sprint(from.OneWay), fmt.Sprint(to.OneWay)),
+			Breaking:   true,
+		})
+		return
+	}
+
+	d.fields(name, to.Name+".", FieldGroup(from.ArgsSpec), FieldGroup(to.ArgsSpec))
+	if from.ResultSpec == nil || to.ResultSpec == nil {
+		return
+	}
+
+	fr, tr := from.ResultSpec.ReturnType, to.ResultSpec.ReturnType
+	if returnTypeName(fr) != returnTypeName(tr) {
+		d.add(Change{
+			Kind:       Changed,
+			Definition: name,
+			Member:     to.Name,
+			Detail:     changedDetail("return type", returnTypeName(fr), returnTypeName(tr)),
+			Breaking:   !sameWireType(fr, tr),
+		})
+	}
+
+	d.fields(name, to.Name+".throws.", from.ResultSpec.Exceptions, to.ResultSpec.Exceptions)
+}
+
+// sameWireType returns true if values of the given linked types have the
+// same wire representation. Referenced structs are compared separately, so
+// only their presence is compared here.
+func sameWireType(l, r TypeSpec) bool {
+	if l == nil || r == nil {
+		return l == nil && r == nil
+	}
+
+	l, r = RootTypeSpec(l), RootTypeSpec(r)
+	if l.TypeCode() != r.TypeCode() {
+		return false
+	}
+
+	switch lt := l.(type) {
+	case *MapSpec:
+		rt := r.(*MapSpec)
+		return sameWireType(lt.KeySpec, rt.KeySpec) && sameWireType(lt.ValueSpec, rt.ValueSpec)
+	case *ListSpec:
+		return sameWireType(lt.ValueSpec, r.(*ListSpec).ValueSpec)
+	case *SetSpec:
+		return sameWireType(lt.ValueSpec, r.(*SetSpec).ValueSpec)
+	default:
+		return true
+	}
+}
+
+// definitionKind returns the keyword with which the given type was defined.
+func definitionKind(t TypeSpec) string {
+	switch s := t.(type) {
+	case *StructSpec:
+		switch s.Type {
+		case ast.UnionType:
+			return "union"
+		case ast.ExceptionType:
+			return "exception"
+		default:
+			return "struct"
+		}
+	case *EnumSpec:
+		return "enum"
+	case *TypedefSpec:
+		return "typedef"
+	default:
+		return t.ThriftName()
+	}
+}
+
+// constantValueString renders a linked constant value for comparison. It
+// returns an empty string for nil values.
+func constantValueString(v ConstantValue) string {
+	switch c := v.(type) {
+	case nil:
+		return ""
+	case ConstantString:
+		return fmt.Sprintf("%q", string(c))
+	case ConstReference:
+		return c.Target.Name
+	case EnumItemReference:
+		return c.Enum.Name + "." + c.Item.Name
+	case ConstantList:
+		return "[" + constantValueStrings([]ConstantValue(c)) + "]"
+	case ConstantSet:
+		return "[" + constantValueStrings([]ConstantValue(c)) + "]"
+	case ConstantMap:
+		items := make([]string, len(c))
+		for i, pair := range c {
+			items[i] = constantValueString(pair.Key) + ": " + constantValueString(pair.Value)
+		}
+		return "{" + strings.Join(items, ", ") + "}"
+	case *ConstantStruct:
+		items := make([]string, 0, len(c.Fields))
+		for _, name := range sortStringKeys(c.Fields) {
+			items = append(items, fmt.Sprintf("%q: %v", name, constantValueString(c.Fields[name])))
+		}
+		return "{" + strings.Join(items, ", ") + "}"
+	default:
+		return fmt.Sprint(c)
+	}
+}
+
+func constantValueStrings(vs []ConstantValue) string {
+	items := make([]string, len(vs))
+	for i, v := range vs {
+		items[i] = constantValueString(v)
+	}
+	return strings.Join(items, ", ")
+}
+
+func changedDetail(what, from, to string) string {
+	if from == "" {
+		from = "none"
+	}
+	if to == "" {
+		to = "none"
+	}
+	return fmt.Sprintf("%v changed from %v to %v", what, from, to)
+}
+
+func requiredness(f *FieldSpec) string {
+	if f.Required {
+		return "required"
+	}
+	return "optional"
+}
+
+func returnTypeName(t TypeSpec) string {
+	if t == nil {
+		return "void"
+	}
+	return t.ThriftName()
+}
+
+func parentName(s *ServiceSpec) string {
+	if s.Parent == nil {
+		return ""
+	}
+	return s.Parent.Name
+}
+
+// allFunctions returns the functions of the given service including the
+// inherited ones. Services that redefine inherited functions fail to link,
+// so conflicts are not expected here.
+func allFunctions(s *ServiceSpec) map[string]*FunctionSpec {
+	functions, err := s.AllFunctions()
+	if err != nil {
+		return s.Functions
+	}
+	return functions
+}
+
+// sortedNames returns the union of the keys of the given maps, sorted.
+func sortedNames(from, to interface{}) []string {
+	names := sortStringKeys(from)
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		seen[name] = struct{}{}
+	}
+
+	for _, name := range sortStringKeys(to) {
+		if _, ok := seen[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+type changesByName []Change
+
+func (cs changesByName) Len() int           { return len(cs) }
+func (cs changesByName) Less(i, j int) bool { return cs[i].Name() < cs[j].Name() }
+func (cs changesByName) Swap(i, j int)      { cs[i], cs[j] = cs[j], cs[i] }
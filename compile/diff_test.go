@@ -0,0 +1,173 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func compileForDiff(t *testing.T, src string) *Module {
+	fs := dummyFS{"/", map[string]string{"/test.thrift": src}}
+	m, err := Compile("test.thrift", Filesystem(fs))
+	require.NoError(t, err, "failed to compile:\n%s", src)
+	return m
+}
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		desc     string
+		from, to string
+		want     []string
+	}{
+		{
+			desc: "no changes",
+			from: "struct Foo { 1: required string bar }",
+			to:   "struct Foo { 1: required string bar }",
+		},
+		{
+			desc: "definitions added and removed",
+			from: "struct Foo {}\nconst i32 x = 1\nservice Bar {}",
+			to:   "enum Foo2 {}\nconst i32 y = 1\nservice Baz {}",
+			want: []string{
+				"removed Bar (breaking)",
+				"added Baz",
+				"removed Foo (breaking)",
+				"added Foo2",
+				"removed x (breaking)",
+				"added y",
+			},
+		},
+		{
+			desc: "kind changed",
+			from: "struct Foo {}",
+			to:   "union Foo {}",
+			want: []string{"changed Foo: changed from struct to union (breaking)"},
+		},
+		{
+			desc: "fields",
+			from: `struct Foo {
+				1: required string a
+				2: optional string b
+				3: optional i32 c
+				4: optional i32 d
+				5: required i32 e
+				6: optional i32 f = 1
+				7: optional list<i32> g
+			}`,
+			to: `typedef i32 Count
+			struct Foo {
+				1: required string a
+				3: optional Count c
+				4: optional i64 d
+				5: optional i32 renamedE
+				6: optional i32 f = 2
+				7: optional list<i64> g
+				8: optional string h
+				9: required string i
+			}`,
+			want: []string{
+				"added Count",
+				"removed Foo.b",
+				"changed Foo.c: type changed from i32 to Count",
+				"changed Foo.d: type changed from i32 to i64 (breaking)",
+				"changed Foo.f: default value changed from 1 to 2",
+				"changed Foo.g: type changed from list<i32> to list<i64> (breaking)",
+				"added Foo.h",
+				"added Foo.i (breaking)",
+				`changed Foo.renamedE: renamed from "e"`,
+				"changed Foo.renamedE: requiredness changed from required to optional (breaking)",
+			},
+		},
+		{
+			desc: "enums",
+			from: "enum Color { Red = 1, Green = 2, Blue = 3 }",
+			to:   "enum Color { Red = 1, Green = 4, Yellow = 5 }",
+			want: []string{
+				"removed Color.Blue (breaking)",
+				"changed Color.Green: value changed from 2 to 4 (breaking)",
+				"added Color.Yellow",
+			},
+		},
+		{
+			desc: "typedefs and constants",
+			from: "typedef i32 Foo\ntypedef string Bar\nconst list<i32> x = [1, 2]\nconst i32 y = 1",
+			to:   "typedef i64 Foo\ntypedef binary Bar\nconst list<i32> x = [1, 2, 3]\nconst i64 y = 1",
+			want: []string{
+				"changed Bar: target changed from string to binary",
+				"changed Foo: target changed from i32 to i64 (breaking)",
+				"changed x: value changed from [1, 2] to [1, 2, 3]",
+				"changed y: type changed from i32 to i64 (breaking)",
+			},
+		},
+		{
+			desc: "services",
+			from: `exception NotFound {}
+			service Base { void ping() }
+			service KeyValue {
+				string get(1: string key) throws (1: NotFound notFound)
+				void put(1: string key, 2: string value)
+				oneway void log(1: string message)
+				void ping()
+				void remove(1: string key)
+			}`,
+			to: `exception NotFound {}
+			service Base { void ping() }
+			service KeyValue extends Base {
+				binary get(1: string key, 2: optional i64 version)
+				i32 put(1: string key, 2: binary value)
+				void log(1: string message)
+				void clear()
+			}`,
+			want: []string{
+				`changed KeyValue: parent changed from none to Base`,
+				"added KeyValue.clear",
+				"changed KeyValue.get: return type changed from string to binary",
+				"removed KeyValue.get.throws.notFound",
+				"added KeyValue.get.version",
+				"changed KeyValue.log: oneway changed from true to false (breaking)",
+				"changed KeyValue.put: return type changed from void to i32 (breaking)",
+				"changed KeyValue.put.value: type changed from string to binary",
+				"removed KeyValue.remove (breaking)",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			changes := Diff(compileForDiff(t, tt.from), compileForDiff(t, tt.to))
+
+			var got []string
+			for _, c := range changes {
+				got = append(got, c.String())
+			}
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestChangeName(t *testing.T) {
+	assert.Equal(t, "Foo", Change{Kind: Added, Definition: "Foo"}.Name())
+	assert.Equal(t, "Foo.bar", Change{Kind: Added, Definition: "Foo", Member: "bar"}.Name())
+	assert.Equal(t, "ChangeKind(42)", ChangeKind(42).String())
+}
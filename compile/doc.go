@@ -23,4 +23,26 @@
 //
 // Files are parsed using the Compile method, which will return a Module that
 // contains the types and services defined in the Thrift file.
+//
+// Compile parses the given file and every file it includes, directly or
+// transitively, and links all of them before it returns. References to
+// types, constants, and services, including those of included files, are
+// resolved to their specifications, so tools like linters, documentation
+// generators, and schema registries can inspect a whole program from the
+// returned Module.
+//
+//   m, err := compile.Compile("service.thrift")
+//   if err != nil {
+//     return err
+//   }
+//   err = m.Walk(func(m *compile.Module) error {
+//     for name, t := range m.Types {
+//       // ...
+//     }
+//     return nil
+//   })
+//
+// Options control how files are read and let callers observe the progress
+// of the compiler. CompileFiles compiles more than one file at a time,
+// sharing the modules of files included by more than one of them.
 package compile
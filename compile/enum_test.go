@@ -36,16 +36,12 @@ func i(x int) *int {
 }
 
 func parseEnum(s string) *ast.Enum {
-	prog, err := idl.Parse([]byte(s))
+	def, err := idl.ParseDefinition([]byte(s))
 	if err != nil {
 		panic(fmt.Sprintf("failure to parse: %v: %s", err, s))
 	}
 
-	if len(prog.Definitions) != 1 {
-		panic("parseEnum may be used to parse single enums only")
-	}
-
-	return prog.Definitions[0].(*ast.Enum)
+	return def.(*ast.Enum)
 }
 
 func TestCompileEnumSuccess(t *testing.T) {
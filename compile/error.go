@@ -22,6 +22,7 @@ package compile
 
 import (
 	"fmt"
+	"math"
 	"strings"
 
 	"go.uber.org/thriftrw/ast"
@@ -56,6 +57,16 @@ func (e fileCompileError) Error() string {
 	return fmt.Sprintf("could not compile file %q: %v", e.Path, e.Reason)
 }
 
+// linkError is raised when a Thrift file fails to link.
+type linkError struct {
+	Path   string
+	Reason error
+}
+
+func (e linkError) Error() string {
+	return compileError{Target: e.Path, Reason: e.Reason}.Error()
+}
+
 // includeAsDisabledError is raised when the user attempts to use the include-as
 // syntax without explicitly enabling it.
 type includeAsDisabledError struct{}
@@ -78,6 +89,20 @@ func (e includeError) Error() string {
 	)
 }
 
+// namespaceError is raised when there is an error with a namespace
+// declaration.
+type namespaceError struct {
+	Namespace *ast.Namespace
+	Reason    error
+}
+
+func (e namespaceError) Error() string {
+	return fmt.Sprintf(
+		"cannot declare namespace %q for %q on line %d: %v",
+		e.Namespace.Name, e.Namespace.Scope, e.Namespace.Line, e.Reason,
+	)
+}
+
 // definitionError is raised when there was an error compiling a definition
 // from the Thrift file.
 type definitionError struct {
@@ -205,10 +230,20 @@ func (e defaultValueNotAllowedError) Error() string {
 type fieldIDConflictError struct {
 	ID   int16
 	Name string
+	Line int
 }
 
 func (e fieldIDConflictError) Error() string {
-	return fmt.Sprintf("field %q has already used ID %d", e.Name, e.ID)
+	return fmt.Sprintf("field %q has already used ID %d on line %d", e.Name, e.ID, e.Line)
+}
+
+// fieldIDRangeError is raised when a field ID cannot be sent over the wire.
+type fieldIDRangeError struct {
+	ID int
+}
+
+func (e fieldIDRangeError) Error() string {
+	return fmt.Sprintf("field ID %d is out of range: IDs must be between 1 and %d", e.ID, math.MaxInt16)
 }
 
 type oneWayCannotReturnError struct {
@@ -222,6 +257,49 @@ func (e oneWayCannotReturnError) Error() string {
 	)
 }
 
+// inheritedFunctionConflictError is raised when a service redefines a
+// function that it inherits from a parent service.
+type inheritedFunctionConflictError struct {
+	Service        *ServiceSpec
+	Function       *FunctionSpec
+	Parent         *ServiceSpec
+	ParentFunction *FunctionSpec
+}
+
+func (e inheritedFunctionConflictError) Error() string {
+	return fmt.Sprintf(
+		"function %q of service %q (%v) conflicts with function %q inherited from service %q (%v)",
+		e.Function.Name, e.Service.Name, functionSite(e.Service, e.Function),
+		e.ParentFunction.Name, e.Parent.Name, functionSite(e.Parent, e.ParentFunction),
+	)
+}
+
+// inheritanceCycleError is raised when a service inherits from itself,
+// directly or through other services.
+type inheritanceCycleError struct {
+	// Services in the cycle, in order of inheritance. The first and last
+	// services are the same.
+	Services []*ServiceSpec
+}
+
+func (e inheritanceCycleError) Error() string {
+	names := make([]string, len(e.Services))
+	for i, s := range e.Services {
+		names[i] = s.Name
+	}
+	return fmt.Sprintf("service %q inherits from itself: %v",
+		e.Services[0].Name, strings.Join(names, " -> "))
+}
+
+// functionSite returns a human-readable description of where the given
+// function of the given service was declared.
+func functionSite(s *ServiceSpec, f *FunctionSpec) string {
+	if f.line > 0 {
+		return fmt.Sprintf("%v:%d", s.File, f.line)
+	}
+	return s.File
+}
+
 type notAnExceptionError struct {
 	TypeName  string
 	FieldName string
@@ -292,6 +370,15 @@ func (e constantValueCastError) Error() string {
 	return s
 }
 
+// A constant whose value refers back to the constant.
+type constantReferenceCycleError struct {
+	Name string
+}
+
+func (e constantReferenceCycleError) Error() string {
+	return fmt.Sprintf("the value of constant %q refers back to itself", e.Name)
+}
+
 // Failure to cast a specific field of a struct literal.
 type constantStructFieldCastError struct {
 	FieldName string
@@ -319,3 +406,26 @@ type annotationConflictError struct {
 func (e annotationConflictError) Error() string {
 	return fmt.Sprintf("annotation conflict: %v", e.Reason)
 }
+
+type requiredFieldCycleError struct {
+	Fields []string
+}
+
+func (e requiredFieldCycleError) Error() string {
+	// Outputs:
+	//
+	// 	found a cycle of required fields, one of which must be optional:
+	// 	    Foo.bar
+	// 	 -> Bar.foo
+
+	lines := make([]string, 0, len(e.Fields)+1)
+	lines = append(lines, "found a cycle of required fields, one of which must be optional:")
+	for i, f := range e.Fields {
+		if i == 0 {
+			lines = append(lines, "    "+f)
+		} else {
+			lines = append(lines, " -> "+f)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
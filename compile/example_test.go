@@ -0,0 +1,106 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compile_test
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// memFS is a compile.FS which serves files from memory.
+type memFS map[string]string
+
+func (fs memFS) Read(path string) ([]byte, error) {
+	if s, ok := fs[path]; ok {
+		return []byte(s), nil
+	}
+	return nil, os.ErrNotExist
+}
+
+func (memFS) Abs(path string) (string, error) {
+	return filepath.Join("/", path), nil
+}
+
+func ExampleCompile() {
+	fs := memFS{
+		"/shared.thrift": `
+			typedef string UUID
+			exception NotFound { 1: required UUID id }
+		`,
+		"/users.thrift": `
+			include "./shared.thrift"
+
+			struct User {
+				1: required shared.UUID id
+				2: optional string name
+			}
+
+			service Users {
+				User getUser(1: shared.UUID id) throws (1: shared.NotFound notFound)
+			}
+		`,
+	}
+
+	m, err := compile.Compile("users.thrift", compile.Filesystem(fs))
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	err = m.Walk(func(m *compile.Module) error {
+		fmt.Println("module", m.Name)
+
+		var types []string
+		for name := range m.Types {
+			types = append(types, name)
+		}
+		sort.Strings(types)
+		for _, name := range types {
+			t := m.Types[name]
+			fmt.Printf("  type %v (%v), root type %v\n",
+				name, t.TypeCode(), compile.RootTypeSpec(t).ThriftName())
+		}
+
+		for _, s := range m.Services {
+			for _, f := range s.Functions {
+				fmt.Printf("  function %v.%v returns %v and throws %v\n",
+					s.Name, f.Name, f.ResultSpec.ReturnType.ThriftName(),
+					f.Throws()[0].ThriftName())
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Output:
+	// module users
+	//   type User (TStruct), root type User
+	//   function Users.getUser returns User and throws NotFound
+	// module shared
+	//   type NotFound (TStruct), root type NotFound
+	//   type UUID (TBinary), root type string
+}
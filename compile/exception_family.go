@@ -0,0 +1,169 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compile
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/thriftrw/ast"
+)
+
+const (
+	// Exceptions with this annotation belong to the named family. All
+	// exceptions of a family must declare the family's base fields with the
+	// same types.
+	//
+	//   exception NotFound {
+	//     1: required i32 code
+	//     2: required string message
+	//   } (thriftrw.family = "ServiceError")
+	familyKey = "thriftrw.family"
+
+	// Comma-separated list of the base fields of a family. Defaults to
+	// defaultFamilyFields. If specified, it must be specified on all
+	// exceptions of the family.
+	familyFieldsKey = "thriftrw.family.fields"
+
+	defaultFamilyFields = "code,message"
+)
+
+// ExceptionFamily is a group of exceptions which share a set of base fields.
+// Exceptions declare the family they belong to with the thriftrw.family
+// annotation.
+type ExceptionFamily struct {
+	Name string
+
+	// Names of the base fields shared by all exceptions of the family, in
+	// the order in which they were listed.
+	Fields []string
+
+	// Exceptions of the family, sorted by name.
+	Exceptions []*StructSpec
+}
+
+// exceptionFamilyError is raised when an exception does not conform to the
+// family it claims to belong to.
+type exceptionFamilyError struct {
+	Family    string
+	Exception string
+	Reason    error
+}
+
+func (e exceptionFamilyError) Error() string {
+	return fmt.Sprintf(
+		"%q cannot be part of exception family %q: %v",
+		e.Exception, e.Family, e.Reason)
+}
+
+// compileExceptionFamilies groups the exceptions of the given linked module
+// into families and verifies that all exceptions of each family declare its
+// base fields with the same types.
+func compileExceptionFamilies(m *Module) (map[string]*ExceptionFamily, error) {
+	families := make(map[string]*ExceptionFamily)
+
+	// Exceptions are visited in order of their names so that the first
+	// exception of a family decides the types of its base fields.
+	for _, name := range sortStringKeys(m.Types) {
+		s, ok := m.Types[name].(*StructSpec)
+		if !ok {
+			continue
+		}
+
+		familyName, ok := s.Annotations[familyKey]
+		if !ok {
+			continue
+		}
+
+		if err := addToExceptionFamily(families, familyName, s); err != nil {
+			return nil, exceptionFamilyError{
+				Family:    familyName,
+				Exception: s.Name,
+				Reason:    err,
+			}
+		}
+	}
+
+	return families, nil
+}
+
+func addToExceptionFamily(families map[string]*ExceptionFamily, name string, s *StructSpec) error {
+	if s.Type != ast.ExceptionType {
+		return fmt.Errorf("only exceptions may have a %v annotation", familyKey)
+	}
+
+	if name == "" {
+		return fmt.Errorf("the %v annotation must not be empty", familyKey)
+	}
+
+	fieldList, ok := s.Annotations[familyFieldsKey]
+	if !ok {
+		fieldList = defaultFamilyFields
+	}
+
+	var fields []string
+	for _, f := range strings.Split(fieldList, ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			fields = append(fields, f)
+		}
+	}
+	if len(fields) == 0 {
+		return fmt.Errorf("the %v annotation must list at least one field", familyFieldsKey)
+	}
+
+	family, ok := families[name]
+	if !ok {
+		family = &ExceptionFamily{Name: name, Fields: fields}
+		families[name] = family
+	} else if strings.Join(family.Fields, ",") != strings.Join(fields, ",") {
+		return fmt.Errorf(
+			"its base fields %q do not match the base fields %q of %q",
+			strings.Join(fields, ","), strings.Join(family.Fields, ","),
+			family.Exceptions[0].Name)
+	}
+
+	for _, fieldName := range family.Fields {
+		field, err := s.Fields.FindByName(fieldName)
+		if err != nil {
+			return fmt.Errorf("base field %q is missing", fieldName)
+		}
+
+		if len(family.Exceptions) == 0 {
+			continue
+		}
+
+		first := family.Exceptions[0]
+		want, _ := first.Fields.FindByName(fieldName)
+		if !sameType(field.Type, want.Type) {
+			return fmt.Errorf(
+				"base field %q has type %q but it has type %q in %q",
+				fieldName, field.Type.ThriftName(), want.Type.ThriftName(), first.Name)
+		}
+	}
+
+	family.Exceptions = append(family.Exceptions, s)
+	return nil
+}
+
+// sameType returns true if the given linked TypeSpecs refer to the same type.
+func sameType(l, r TypeSpec) bool {
+	return l.ThriftName() == r.ThriftName() && l.ThriftFile() == r.ThriftFile()
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompileExceptionFamilies(t *testing.T) {
+	files := map[string]string{
+		"/some/prefix/main.thrift": `
+			exception NotFound {
+				1: required i32 code
+				2: required string message
+				3: optional string key
+			} (thriftrw.family = "ServiceError")
+
+			exception Unavailable {
+				1: optional string message
+				2: required i32 code
+			} (thriftrw.family = "ServiceError")
+
+			exception InvalidArgument {
+				1: required string argument
+			} (thriftrw.family = "RequestError", thriftrw.family.fields = "argument")
+
+			exception Unrelated {
+				1: required i32 code
+			}
+		`,
+	}
+
+	m, err := Compile("main.thrift", Filesystem(dummyFS{"/some/prefix/", files}))
+	require.NoError(t, err, "Compile failed")
+	require.Len(t, m.ExceptionFamilies, 2)
+
+	serviceError := m.ExceptionFamilies["ServiceError"]
+	require.NotNil(t, serviceError, "ServiceError family is missing")
+	assert.Equal(t, "ServiceError", serviceError.Name)
+	assert.Equal(t, []string{"code", "message"}, serviceError.Fields)
+	if assert.Len(t, serviceError.Exceptions, 2) {
+		assert.Equal(t, "NotFound", serviceError.Exceptions[0].Name)
+		assert.Equal(t, "Unavailable", serviceError.Exceptions[1].Name)
+	}
+
+	requestError := m.ExceptionFamilies["RequestError"]
+	require.NotNil(t, requestError, "RequestError family is missing")
+	assert.Equal(t, []string{"argument"}, requestError.Fields)
+	if assert.Len(t, requestError.Exceptions, 1) {
+		assert.Equal(t, "InvalidArgument", requestError.Exceptions[0].Name)
+	}
+}
+
+func TestCompileExceptionFamiliesError(t *testing.T) {
+	tests := []struct {
+		desc     string
+		src      string
+		messages []string
+	}{
+		{
+			desc: "not an exception",
+			src: `
+				struct Foo {
+					1: required i32 code
+					2: required string message
+				} (thriftrw.family = "ServiceError")
+			`,
+			messages: []string{
+				`"Foo" cannot be part of exception family "ServiceError"`,
+				"only exceptions may have a thriftrw.family annotation",
+			},
+		},
+		{
+			desc: "empty family",
+			src: `
+				exception Foo {
+					1: required i32 code
+					2: required string message
+				} (thriftrw.family = "")
+			`,
+			messages: []string{"the thriftrw.family annotation must not be empty"},
+		},
+		{
+			desc: "no base fields",
+			src: `
+				exception Foo {
+					1: required i32 code
+				} (thriftrw.family = "ServiceError", thriftrw.family.fields = " , ")
+			`,
+			messages: []string{"the thriftrw.family.fields annotation must list at least one field"},
+		},
+		{
+			desc: "missing base field",
+			src: `
+				exception Foo {
+					1: required i32 code
+				} (thriftrw.family = "ServiceError")
+			`,
+			messages: []string{
+				`"Foo" cannot be part of exception family "ServiceError"`,
+				`base field "message" is missing`,
+			},
+		},
+		{
+			desc: "base field type mismatch",
+			src: `
+				exception Bar {
+					1: required i32 code
+					2: required string message
+				} (thriftrw.family = "ServiceError")
+
+				exception Foo {
+					1: required i64 code
+					2: required string message
+				} (thriftrw.family = "ServiceError")
+			`,
+			messages: []string{
+				`"Foo" cannot be part of exception family "ServiceError"`,
+				`base field "code" has type "i64" but it has type "i32" in "Bar"`,
+			},
+		},
+		{
+			desc: "base field list mismatch",
+			src: `
+				exception Bar {
+					1: required i32 code
+					2: required string message
+				} (thriftrw.family = "ServiceError")
+
+				exception Foo {
+					1: required i32 code
+				} (thriftrw.family = "ServiceError", thriftrw.family.fields = "code")
+			`,
+			messages: []string{
+				`its base fields "code" do not match the base fields "code,message" of "Bar"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			files := map[string]string{"/some/prefix/main.thrift": tt.src}
+			_, err := Compile("main.thrift", Filesystem(dummyFS{"/some/prefix/", files}))
+			if assert.Error(t, err) {
+				for _, msg := range tt.messages {
+					assert.Contains(t, err.Error(), msg)
+				}
+			}
+		})
+	}
+}
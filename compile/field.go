@@ -22,6 +22,7 @@ package compile
 
 import (
 	"fmt"
+	"math"
 
 	"go.uber.org/thriftrw/ast"
 )
@@ -102,6 +103,11 @@ type FieldSpec struct {
 
 // compileField compiles the given Field source into a FieldSpec.
 func compileField(src *ast.Field, options fieldOptions) (*FieldSpec, error) {
+	// Field IDs are sent over the wire as positive 16-bit integers.
+	if src.ID < 1 || src.ID > math.MaxInt16 {
+		return nil, fieldIDRangeError{ID: src.ID}
+	}
+
 	required, err := options.requiredness.isRequired(src)
 	if err != nil {
 		return nil, err
@@ -133,7 +139,6 @@ func compileField(src *ast.Field, options fieldOptions) (*FieldSpec, error) {
 	}
 
 	return &FieldSpec{
-		// TODO(abg): perform bounds check on field ID
 		ID:          int16(src.ID),
 		Name:        src.Name,
 		Type:        typ,
@@ -166,12 +171,14 @@ func (f *FieldSpec) ThriftAnnotations() Annotations {
 }
 
 // FieldGroup represents a collection of fields for struct-like types.
+//
+// Fields are listed in the order in which they were declared.
 type FieldGroup []*FieldSpec
 
 // compileFields compiles a collection of AST fields into a FieldGroup.
 func compileFields(src []*ast.Field, options fieldOptions) (FieldGroup, error) {
 	fieldsNS := newNamespace(caseInsensitive)
-	usedIDs := make(map[int16]string)
+	usedIDs := make(map[int16]*ast.Field)
 
 	fields := make([]*FieldSpec, 0, len(src))
 	for _, astField := range src {
@@ -198,13 +205,14 @@ func compileFields(src []*ast.Field, options fieldOptions) (FieldGroup, error) {
 				Line:   astField.Line,
 				Reason: fieldIDConflictError{
 					ID:   field.ID,
-					Name: conflictingField,
+					Name: conflictingField.Name,
+					Line: conflictingField.Line,
 				},
 			}
 		}
 
 		fields = append(fields, field)
-		usedIDs[field.ID] = field.Name
+		usedIDs[field.ID] = astField
 	}
 
 	return FieldGroup(fields), nil
@@ -220,6 +228,16 @@ func (fg FieldGroup) FindByName(name string) (*FieldSpec, error) {
 	return nil, fmt.Errorf("unknown field %v", name)
 }
 
+// FindByID retrieves the FieldSpec for the field with the given ID.
+func (fg FieldGroup) FindByID(id int16) (*FieldSpec, error) {
+	for _, field := range fg {
+		if field.ID == id {
+			return field, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown field ID %v", id)
+}
+
 // Link resolves references made by fields inside the FieldGroup.
 func (fg FieldGroup) Link(scope Scope) error {
 	for _, field := range fg {
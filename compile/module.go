@@ -41,6 +41,23 @@ type Module struct {
 	Types     map[string]TypeSpec
 	Services  map[string]*ServiceSpec
 
+	// Mapping from the language scope of namespace declarations to the
+	// namespace declared for it. For example,
+	//
+	// 	namespace go foo.bar
+	//
+	// is recorded as "go" => "foo.bar".
+	Namespaces map[string]string
+
+	// Mapping from the names of exception families declared with the
+	// thriftrw.family annotation to the exceptions that belong to them.
+	ExceptionFamilies map[string]*ExceptionFamily
+
+	// References made by the Thrift file to types, constants, enum items,
+	// and services, along with the definitions they resolved to. These are
+	// ordered by line.
+	References []Reference
+
 	Raw []byte // The raw IDL input.
 }
 
@@ -86,8 +103,9 @@ func (m *Module) LookupInclude(name string) (Scope, error) {
 }
 
 // Walk the module tree starting at the given module. This module and all its
-// direct and transitive dependencies will be visited exactly once in an
-// unspecified order. The walk will stop on the first error returned by `f`.
+// direct and transitive dependencies will be visited exactly once,
+// breadth-first, with the includes of each module visited in order of their
+// names. The walk will stop on the first error returned by `f`.
 func (m *Module) Walk(f func(*Module) error) error {
 	visited := make(map[string]struct{})
 
@@ -103,8 +121,8 @@ func (m *Module) Walk(f func(*Module) error) error {
 		}
 
 		visited[m.ThriftPath] = struct{}{}
-		for _, inc := range m.Includes {
-			toVisit = append(toVisit, inc.Module)
+		for _, name := range sortStringKeys(m.Includes) {
+			toVisit = append(toVisit, m.Includes[name].Module)
 		}
 
 		if err := f(m); err != nil {
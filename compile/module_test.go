@@ -20,4 +20,41 @@
 
 package compile
 
-// TODO
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModuleWalkOrder(t *testing.T) {
+	newModule := func(path string, includes ...*Module) *Module {
+		m := &Module{
+			Name:       path,
+			ThriftPath: path,
+			Includes:   make(map[string]*IncludedModule),
+		}
+		for _, inc := range includes {
+			m.Includes[inc.Name] = &IncludedModule{Name: inc.Name, Module: inc}
+		}
+		return m
+	}
+
+	shared := newModule("shared")
+	root := newModule("root",
+		newModule("c"),
+		newModule("a", shared),
+		newModule("b", shared),
+		newModule("d", newModule("e")),
+	)
+
+	// Maps are iterated in a random order, so walk multiple times to
+	// catch non-determinism.
+	for i := 0; i < 10; i++ {
+		var visited []string
+		assert.NoError(t, root.Walk(func(m *Module) error {
+			visited = append(visited, m.ThriftPath)
+			return nil
+		}))
+		assert.Equal(t, []string{"root", "a", "b", "c", "d", "shared", "e"}, visited)
+	}
+}
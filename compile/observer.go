@@ -0,0 +1,73 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compile
+
+import "time"
+
+// Observer receives events about the progress of the compiler. This may be
+// used by build tooling to collect timing metrics and identify slow Thrift
+// files.
+//
+// Observer methods are called synchronously from the compiler.
+type Observer interface {
+	// FileParsed is called after a Thrift file has been read and parsed.
+	FileParsed(FileParsed)
+
+	// ModuleCompiled is called after all the types, constants, and services
+	// of a module have been linked.
+	ModuleCompiled(ModuleCompiled)
+}
+
+// FileParsed is emitted after a Thrift file has been read and parsed.
+type FileParsed struct {
+	// Absolute path to the Thrift file.
+	Path string
+
+	// Size of the Thrift file in bytes.
+	Size int
+
+	// Time taken to read and parse the file.
+	Duration time.Duration
+}
+
+// ModuleCompiled is emitted after a module has been linked.
+type ModuleCompiled struct {
+	Module *Module
+
+	// Time taken to link the module.
+	Duration time.Duration
+}
+
+// Observe registers an Observer with the compiler. A nil Observer is
+// ignored.
+func Observe(o Observer) Option {
+	return func(c *compiler) {
+		if o == nil {
+			o = nopObserver{}
+		}
+		c.observer = o
+	}
+}
+
+type nopObserver struct{}
+
+func (nopObserver) FileParsed(FileParsed)         {}
+func (nopObserver) ModuleCompiled(ModuleCompiled) {}
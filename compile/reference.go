@@ -0,0 +1,98 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package compile
+
+import "sort"
+
+// Reference is a reference in a Thrift file to a type, constant, enum item,
+// or service, along with the definition that it resolved to when the file
+// was linked.
+//
+// Exactly one of Type, Constant, EnumItem, and Service is set.
+type Reference struct {
+	// Name of the referenced definition as written in the Thrift file. This
+	// includes the name of the included module, if any, like "shared.UUID".
+	Name string
+
+	// Line on which the reference appears.
+	Line int
+
+	// Type to which a reference to a type resolved. This is the spec of the
+	// definition that was named, so references to typedefs resolve to a
+	// *TypedefSpec. Use RootTypeSpec to look through typedefs.
+	Type TypeSpec
+
+	// Constant to which a reference to a constant resolved.
+	Constant *Constant
+
+	// Enum item to which a reference to an enum item, like Status.OK,
+	// resolved.
+	EnumItem *EnumItemReference
+
+	// Service to which a reference to a parent service resolved.
+	Service *ServiceSpec
+}
+
+// ThriftFile returns the absolute path to the Thrift file in which the
+// referenced definition was declared, or an empty string if the reference
+// resolved to a built-in type like uuid.
+func (r Reference) ThriftFile() string {
+	switch {
+	case r.Type != nil:
+		return r.Type.ThriftFile()
+	case r.Constant != nil:
+		return r.Constant.File
+	case r.EnumItem != nil:
+		return r.EnumItem.Enum.ThriftFile()
+	case r.Service != nil:
+		return r.Service.File
+	default:
+		return ""
+	}
+}
+
+// ReferencesOnLine returns the references on the given line of the Thrift
+// file from which the module was compiled, in the order in which they were
+// resolved.
+func (m *Module) ReferencesOnLine(line int) []Reference {
+	i := sort.Search(len(m.References), func(i int) bool {
+		return m.References[i].Line >= line
+	})
+
+	var refs []Reference
+	for ; i < len(m.References) && m.References[i].Line == line; i++ {
+		refs = append(refs, m.References[i])
+	}
+	return refs
+}
+
+// recordReference records a resolved reference on the given scope if it is a
+// Module. Scopes built for tests and plugins do not record references.
+func recordReference(scope Scope, r Reference) {
+	if m, ok := scope.(*Module); ok {
+		m.References = append(m.References, r)
+	}
+}
+
+type referencesByLine []Reference
+
+func (rs referencesByLine) Len() int           { return len(rs) }
+func (rs referencesByLine) Less(i, j int) bool { return rs[i].Line < rs[j].Line }
+func (rs referencesByLine) Swap(i, j int)      { rs[i], rs[j] = rs[j], rs[i] }
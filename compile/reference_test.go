@@ -0,0 +1,97 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package compile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReferences(t *testing.T) {
+	files := map[string]string{
+		"/some/prefix/main.thrift": `
+			include "./shared.thrift"
+
+			typedef shared.UUID ID
+			const shared.Status defaultStatus = shared.Status.OK
+			const i32 limit = shared.maxItems
+			struct Item {
+				1: required ID id
+				2: optional map<ID, shared.Status> statuses
+				3: optional uuid token
+			}
+			service Items extends shared.Base {}
+		`,
+		"/some/prefix/shared.thrift": `
+			typedef string UUID
+			enum Status { OK, FAILED }
+			const i32 maxItems = 100
+			service Base {}
+		`,
+	}
+
+	m, err := Compile("main.thrift", Filesystem(dummyFS{"/some/prefix/", files}))
+	require.NoError(t, err)
+	shared := m.Includes["shared"].Module
+
+	type ref struct {
+		Name   string
+		Line   int
+		Target interface{}
+		File   string
+	}
+
+	var got []ref
+	for _, r := range m.References {
+		var target interface{}
+		switch {
+		case r.Type != nil:
+			target = r.Type
+		case r.Constant != nil:
+			target = r.Constant
+		case r.EnumItem != nil:
+			target = r.EnumItem.Item.Name
+		case r.Service != nil:
+			target = r.Service
+		}
+		got = append(got, ref{r.Name, r.Line, target, r.ThriftFile()})
+	}
+
+	status := shared.Types["Status"]
+	assert.Equal(t, []ref{
+		{"shared.UUID", 4, shared.Types["UUID"], "/some/prefix/shared.thrift"},
+		{"shared.Status", 5, status, "/some/prefix/shared.thrift"},
+		{"shared.Status.OK", 5, "OK", "/some/prefix/shared.thrift"},
+		{"shared.maxItems", 6, shared.Constants["maxItems"], "/some/prefix/shared.thrift"},
+		{"ID", 8, m.Types["ID"], "/some/prefix/main.thrift"},
+		{"ID", 9, m.Types["ID"], "/some/prefix/main.thrift"},
+		{"shared.Status", 9, status, "/some/prefix/shared.thrift"},
+		{"uuid", 10, &UUIDSpec{}, ""},
+		{"shared.Base", 12, shared.Services["Base"], "/some/prefix/shared.thrift"},
+	}, got)
+
+	assert.Len(t, m.ReferencesOnLine(9), 2)
+	assert.Empty(t, m.ReferencesOnLine(2))
+
+	// shared.thrift doesn't reference anything.
+	assert.Empty(t, shared.References)
+}
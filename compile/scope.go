@@ -76,3 +76,123 @@ func (emptyScope) LookupConstant(name string) (*Constant, error) {
 func (emptyScope) LookupInclude(name string) (Scope, error) {
 	return nil, fmt.Errorf("unknown include: %v", name)
 }
+
+// ScopeBuilder assembles a Scope from specs which have already been
+// compiled. It is intended for plugins and tests which need to compile or
+// link individual specs without a Thrift file on disk.
+//
+//   b := compile.NewScopeBuilder("foo")
+//   b.AddType("UUID", &compile.TypedefSpec{...})
+//   b.AddInclude("shared", sharedScope)
+//   err := spec.Link(b.Build())
+//
+// Lookups of names which were not added to the builder fail.
+type ScopeBuilder struct {
+	name      string
+	types     map[string]TypeSpec
+	services  map[string]*ServiceSpec
+	constants map[string]*Constant
+	includes  map[string]Scope
+}
+
+// NewScopeBuilder returns a ScopeBuilder for a scope with the given name.
+func NewScopeBuilder(name string) *ScopeBuilder {
+	return &ScopeBuilder{
+		name:      name,
+		types:     make(map[string]TypeSpec),
+		services:  make(map[string]*ServiceSpec),
+		constants: make(map[string]*Constant),
+		includes:  make(map[string]Scope),
+	}
+}
+
+// AddType adds a type to the scope under the given name, replacing any type
+// previously added under that name.
+func (b *ScopeBuilder) AddType(name string, t TypeSpec) *ScopeBuilder {
+	b.types[name] = t
+	return b
+}
+
+// AddService adds a service to the scope under the given name, replacing any
+// service previously added under that name.
+func (b *ScopeBuilder) AddService(name string, s *ServiceSpec) *ScopeBuilder {
+	b.services[name] = s
+	return b
+}
+
+// AddConstant adds a constant to the scope under the given name, replacing
+// any constant previously added under that name.
+func (b *ScopeBuilder) AddConstant(name string, c *Constant) *ScopeBuilder {
+	b.constants[name] = c
+	return b
+}
+
+// AddInclude makes the given scope available as an included module under
+// the given name, replacing any scope previously added under that name.
+func (b *ScopeBuilder) AddInclude(name string, s Scope) *ScopeBuilder {
+	b.includes[name] = s
+	return b
+}
+
+// Build returns a Scope holding the items added to the builder so far.
+// Items added to the builder afterwards do not affect the returned Scope.
+func (b *ScopeBuilder) Build() Scope {
+	s := builtScope{
+		name:      b.name,
+		types:     make(map[string]TypeSpec, len(b.types)),
+		services:  make(map[string]*ServiceSpec, len(b.services)),
+		constants: make(map[string]*Constant, len(b.constants)),
+		includes:  make(map[string]Scope, len(b.includes)),
+	}
+	for name, t := range b.types {
+		s.types[name] = t
+	}
+	for name, svc := range b.services {
+		s.services[name] = svc
+	}
+	for name, c := range b.constants {
+		s.constants[name] = c
+	}
+	for name, i := range b.includes {
+		s.includes[name] = i
+	}
+	return s
+}
+
+type builtScope struct {
+	name      string
+	types     map[string]TypeSpec
+	services  map[string]*ServiceSpec
+	constants map[string]*Constant
+	includes  map[string]Scope
+}
+
+func (s builtScope) GetName() string { return s.name }
+
+func (s builtScope) LookupType(name string) (TypeSpec, error) {
+	if t, ok := s.types[name]; ok {
+		return t, nil
+	}
+	return nil, fmt.Errorf("unknown type: %v", name)
+}
+
+func (s builtScope) LookupService(name string) (*ServiceSpec, error) {
+	if svc, ok := s.services[name]; ok {
+		return svc, nil
+	}
+	return nil, fmt.Errorf("unknown service: %v", name)
+}
+
+func (s builtScope) LookupConstant(name string) (*Constant, error) {
+	if c, ok := s.constants[name]; ok {
+		return c, nil
+	}
+	return nil, fmt.Errorf("unknown constant: %v", name)
+}
+
+func (s builtScope) LookupInclude(name string) (Scope, error) {
+	if i, ok := s.includes[name]; ok {
+		return i, nil
+	}
+	return nil, fmt.Errorf("unknown include: %v", name)
+}
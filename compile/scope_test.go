@@ -20,51 +20,15 @@
 
 package compile
 
-import "fmt"
+import (
+	"fmt"
+	"testing"
 
-var defaultScope = EmptyScope("fake")
-
-// fakeScope is an implementation of Scope for testing. Instances may be
-// constructed easily with the scope() function.
-type fakeScope struct {
-	name      string
-	types     map[string]TypeSpec
-	services  map[string]*ServiceSpec
-	constants map[string]*Constant
-	includes  map[string]Scope
-}
-
-func (s fakeScope) GetName() string {
-	return s.name
-}
-
-func (s fakeScope) LookupType(name string) (TypeSpec, error) {
-	if t, ok := s.types[name]; ok {
-		return t, nil
-	}
-	return nil, fmt.Errorf("unknown type: %s", name)
-}
-
-func (s fakeScope) LookupService(name string) (*ServiceSpec, error) {
-	if svc, ok := s.services[name]; ok {
-		return svc, nil
-	}
-	return nil, fmt.Errorf("unknown service: %s", name)
-}
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
 
-func (s fakeScope) LookupConstant(name string) (*Constant, error) {
-	if c, ok := s.constants[name]; ok {
-		return c, nil
-	}
-	return nil, fmt.Errorf("unknown constant: %s", name)
-}
-
-func (s fakeScope) LookupInclude(name string) (Scope, error) {
-	if i, ok := s.includes[name]; ok {
-		return i, nil
-	}
-	return nil, fmt.Errorf("unknown include: %s", name)
-}
+var defaultScope = EmptyScope("fake")
 
 // scopeOrDefault accepts an optional scope as an argument and returns a default
 // empty scope if the given scope was nil.
@@ -75,7 +39,8 @@ func scopeOrDefault(s Scope) Scope {
 	return s
 }
 
-// Helper to construct Scopes from the given pairs of items.
+// Helper to construct Scopes from the given pairs of items with a
+// ScopeBuilder.
 //
 // An even number of items must be given.
 //
@@ -92,13 +57,7 @@ func scope(args ...interface{}) Scope {
 		panic("scope() expects an even number of arguments after the name")
 	}
 
-	scope := fakeScope{
-		name:      scopeName,
-		types:     make(map[string]TypeSpec),
-		services:  make(map[string]*ServiceSpec),
-		constants: make(map[string]*Constant),
-		includes:  make(map[string]Scope),
-	}
+	b := NewScopeBuilder(scopeName)
 
 	var name string
 
@@ -112,18 +71,60 @@ func scope(args ...interface{}) Scope {
 
 		switch v := arg.(type) {
 		case TypeSpec:
-			scope.types[name] = v
+			b.AddType(name, v)
 		case *Constant:
-			scope.constants[name] = v
+			b.AddConstant(name, v)
 		case *ServiceSpec:
-			scope.services[name] = v
+			b.AddService(name, v)
 		case Scope:
-			scope.includes[name] = v
+			b.AddInclude(name, v)
 		default:
 			panic(fmt.Sprintf(
 				"value %v of unknown type %T with name %s", arg, arg, name,
 			))
 		}
 	}
-	return scope
+	return b.Build()
+}
+
+func TestScopeBuilder(t *testing.T) {
+	svc := &ServiceSpec{Name: "KeyValue"}
+	c := &Constant{Name: "version", Type: &I32Spec{}, Value: ConstantInt(1)}
+	included := EmptyScope("shared")
+
+	b := NewScopeBuilder("foo").
+		AddType("UUID", &StringSpec{}).
+		AddService("KeyValue", svc).
+		AddConstant("version", c).
+		AddInclude("shared", included)
+	s := b.Build()
+	b.AddType("Later", &I64Spec{})
+
+	assert.Equal(t, "foo", s.GetName())
+
+	typ, err := s.LookupType("UUID")
+	require.NoError(t, err)
+	assert.Equal(t, &StringSpec{}, typ)
+
+	gotSvc, err := s.LookupService("KeyValue")
+	require.NoError(t, err)
+	assert.True(t, svc == gotSvc, "expected the same service")
+
+	gotConst, err := s.LookupConstant("version")
+	require.NoError(t, err)
+	assert.True(t, c == gotConst, "expected the same constant")
+
+	inc, err := s.LookupInclude("shared")
+	require.NoError(t, err)
+	assert.Equal(t, "shared", inc.GetName())
+
+	_, err = s.LookupType("Later")
+	assert.EqualError(t, err, "unknown type: Later",
+		"types added after Build must not be visible")
+	_, err = s.LookupService("UUID")
+	assert.EqualError(t, err, "unknown service: UUID")
+	_, err = s.LookupConstant("KeyValue")
+	assert.EqualError(t, err, "unknown constant: KeyValue")
+	_, err = s.LookupInclude("other")
+	assert.EqualError(t, err, "unknown include: other")
 }
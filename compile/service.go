@@ -21,6 +21,8 @@
 package compile
 
 import (
+	"strings"
+
 	"go.uber.org/thriftrw/ast"
 	"go.uber.org/thriftrw/wire"
 )
@@ -34,6 +36,7 @@ type ServiceSpec struct {
 	Parent      *ServiceSpec
 	Functions   map[string]*FunctionSpec
 	Annotations Annotations
+	Doc         string
 
 	parentSrc *ast.ServiceReference
 }
@@ -77,21 +80,30 @@ func compileService(file string, src *ast.Service) (*ServiceSpec, error) {
 		File:        file,
 		Functions:   functions,
 		Annotations: annotations,
+		Doc:         src.Doc,
 		parentSrc:   src.Parent,
 	}, nil
 }
 
-// resolveService resolves a ServiceReference in the given scope.
-func resolveService(src ast.ServiceReference, scope Scope) (*ServiceSpec, error) {
+// resolveService resolves a ServiceReference in the given scope. It also
+// returns the scope in which the service was declared.
+func resolveService(src ast.ServiceReference, scope Scope) (*ServiceSpec, Scope, error) {
+	s, declScope, err := findService(src, scope)
+	if err == nil {
+		recordReference(scope, Reference{Name: src.Name, Line: src.Line, Service: s})
+	}
+	return s, declScope, err
+}
+
+func findService(src ast.ServiceReference, scope Scope) (*ServiceSpec, Scope, error) {
 	s, err := scope.LookupService(src.Name)
 	if err == nil {
-		err = s.Link(scope)
-		return s, err
+		return s, scope, nil
 	}
 
 	mname, iname := splitInclude(src.Name)
 	if len(mname) == 0 {
-		return nil, referenceError{
+		return nil, nil, referenceError{
 			Target:    src.Name,
 			Line:      src.Line,
 			ScopeName: scope.GetName(),
@@ -101,7 +113,7 @@ func resolveService(src ast.ServiceReference, scope Scope) (*ServiceSpec, error)
 
 	includedScope, err := getIncludedScope(scope, mname)
 	if err != nil {
-		return nil, referenceError{
+		return nil, nil, referenceError{
 			Target:    src.Name,
 			Line:      src.Line,
 			ScopeName: scope.GetName(),
@@ -109,7 +121,7 @@ func resolveService(src ast.ServiceReference, scope Scope) (*ServiceSpec, error)
 		}
 	}
 
-	return resolveService(ast.ServiceReference{Name: iname}, includedScope)
+	return findService(ast.ServiceReference{Name: iname}, includedScope)
 }
 
 // Link resolves any references made by the given service.
@@ -119,7 +131,7 @@ func (s *ServiceSpec) Link(scope Scope) error {
 	}
 
 	if s.parentSrc != nil {
-		parent, err := resolveService(*s.parentSrc, scope)
+		parent, parentScope, err := resolveService(*s.parentSrc, scope)
 		if err != nil {
 			return compileError{
 				Target: s.Name,
@@ -131,16 +143,27 @@ func (s *ServiceSpec) Link(scope Scope) error {
 				},
 			}
 		}
+		s.parentSrc = nil
 
-		if err := parent.Link(scope); err != nil {
+		// The parent is assigned before it is linked so that a cycle is
+		// found by following the parents of the services being linked.
+		s.Parent = parent
+		if _, err := s.inheritanceChain(); err != nil {
+			s.Parent = nil
 			return compileError{Target: s.Name, Reason: err}
 		}
 
-		s.Parent = parent
-		s.parentSrc = nil
+		if err := parent.Link(parentScope); err != nil {
+			return compileError{Target: s.Name, Reason: err}
+		}
+	}
+
+	if _, err := s.AllFunctions(); err != nil {
+		return compileError{Target: s.Name, Reason: err}
 	}
 
-	for _, function := range s.Functions {
+	for _, name := range sortStringKeys(s.Functions) {
+		function := s.Functions[name]
 		if err := function.Link(scope); err != nil {
 			return compileError{
 				Target: s.Name + "." + function.Name,
@@ -152,20 +175,107 @@ func (s *ServiceSpec) Link(scope Scope) error {
 	return nil
 }
 
+// AllFunctions returns the functions of this service and all the services it
+// inherits from, keyed by name.
+//
+// An error is returned if a service redefines a function that it inherits,
+// or if a service inherits from itself. As with functions inside a single
+// service, names are compared case-insensitively.
+func (s *ServiceSpec) AllFunctions() (map[string]*FunctionSpec, error) {
+	type definition struct {
+		Service  *ServiceSpec
+		Function *FunctionSpec
+	}
+
+	chain, err := s.inheritanceChain()
+	if err != nil {
+		return nil, err
+	}
+
+	functions := make(map[string]*FunctionSpec)
+	defined := make(map[string]definition) // keyed by lower-cased name
+
+	for _, svc := range chain {
+		for _, name := range sortStringKeys(svc.Functions) {
+			f := svc.Functions[name]
+			key := strings.ToLower(name)
+			if d, ok := defined[key]; ok {
+				return nil, inheritedFunctionConflictError{
+					Service:        d.Service,
+					Function:       d.Function,
+					Parent:         svc,
+					ParentFunction: f,
+				}
+			}
+			defined[key] = definition{Service: svc, Function: f}
+			functions[name] = f
+		}
+	}
+
+	return functions, nil
+}
+
+// inheritanceChain returns this service followed by the services it
+// inherits from, in order. An error is returned if a service inherits from
+// itself.
+func (s *ServiceSpec) inheritanceChain() ([]*ServiceSpec, error) {
+	var chain []*ServiceSpec
+	for svc := s; svc != nil; svc = svc.Parent {
+		for i, seen := range chain {
+			if seen == svc {
+				return nil, inheritanceCycleError{Services: append(chain[i:], svc)}
+			}
+		}
+		chain = append(chain, svc)
+	}
+	return chain, nil
+}
+
+// LookupFunction finds the function with the given name in this service or
+// in the services it inherits from. It also returns the service that declares
+// the function, which is s itself unless the function is inherited.
+//
+// Nil values are returned if no such function exists.
+func (s *ServiceSpec) LookupFunction(name string) (*FunctionSpec, *ServiceSpec) {
+	visited := make(map[*ServiceSpec]struct{})
+	for svc := s; svc != nil; svc = svc.Parent {
+		if _, ok := visited[svc]; ok {
+			break // inheritance cycle
+		}
+		visited[svc] = struct{}{}
+
+		if f, ok := svc.Functions[name]; ok {
+			return f, svc
+		}
+	}
+	return nil, nil
+}
+
 // ThriftFile is the Thrift file in which this service was defined.
 func (s *ServiceSpec) ThriftFile() string {
 	return s.File
 }
 
+// multiplexSeparator separates the service name from the function name in
+// method names used by multiplexed transports.
+const multiplexSeparator = ":"
+
 // FunctionSpec is a single function inside a Service.
 type FunctionSpec struct {
 	linkOnce
 
-	Name        string
-	ArgsSpec    ArgsSpec
-	ResultSpec  *ResultSpec // nil if OneWay is true
-	OneWay      bool
+	Name       string
+	ArgsSpec   ArgsSpec
+	ResultSpec *ResultSpec // nil if OneWay is true
+
+	// OneWay is true for functions declared with the oneway keyword. Callers
+	// of oneway functions do not wait for a response.
+	OneWay bool
+
 	Annotations Annotations
+	Doc         string
+
+	line int // line in the Thrift file on which the function was declared
 }
 
 func compileFunction(src *ast.Function) (*FunctionSpec, error) {
@@ -182,7 +292,11 @@ func compileFunction(src *ast.Function) (*FunctionSpec, error) {
 	if src.OneWay {
 		// oneway can't have a return type or exceptions
 		if src.ReturnType != nil || len(src.Exceptions) > 0 {
-			return nil, oneWayCannotReturnError{Name: src.Name}
+			return nil, compileError{
+				Target: src.Name,
+				Line:   src.Line,
+				Reason: oneWayCannotReturnError{Name: src.Name},
+			}
 		}
 	} else {
 		result, err = compileResultSpec(src.ReturnType, src.Exceptions)
@@ -209,7 +323,9 @@ func compileFunction(src *ast.Function) (*FunctionSpec, error) {
 		ArgsSpec:    args,
 		ResultSpec:  result,
 		Annotations: annotations,
+		Doc:         src.Doc,
 		OneWay:      src.OneWay,
+		line:        src.Line,
 	}, nil
 }
 
@@ -237,6 +353,32 @@ func (f *FunctionSpec) MethodName() string {
 	return f.Name
 }
 
+// QualifiedMethodName returns the method name for this function when it is
+// called on the given service over a multiplexed transport:
+// "Service:function".
+//
+// For inherited functions, this must be the service that is being called
+// rather than the service that declares the function.
+func (f *FunctionSpec) QualifiedMethodName(service *ServiceSpec) string {
+	return service.Name + multiplexSeparator + f.MethodName()
+}
+
+// Throws returns the exceptions that this function may raise in the order in
+// which they were declared. The function must be linked.
+//
+// Oneway functions do not raise exceptions.
+func (f *FunctionSpec) Throws() []*StructSpec {
+	if f.ResultSpec == nil || len(f.ResultSpec.Exceptions) == 0 {
+		return nil
+	}
+
+	exceptions := make([]*StructSpec, len(f.ResultSpec.Exceptions))
+	for i, field := range f.ResultSpec.Exceptions {
+		exceptions[i] = field.Type.(*StructSpec)
+	}
+	return exceptions
+}
+
 // CallType returns the envelope type that is used when making enveloped
 // requests for this function.
 func (f *FunctionSpec) CallType() wire.EnvelopeType {
@@ -247,6 +389,8 @@ func (f *FunctionSpec) CallType() wire.EnvelopeType {
 }
 
 // ArgsSpec contains information about a Function's arguments.
+//
+// Arguments are listed in the order in which they were declared.
 type ArgsSpec FieldGroup
 
 func compileArgSpec(args []*ast.Field) (ArgsSpec, error) {
@@ -262,6 +406,16 @@ func (as ArgsSpec) Link(scope Scope) error {
 	return FieldGroup(as).Link(scope)
 }
 
+// FindByName retrieves the FieldSpec for the argument with the given name.
+func (as ArgsSpec) FindByName(name string) (*FieldSpec, error) {
+	return FieldGroup(as).FindByName(name)
+}
+
+// FindByID retrieves the FieldSpec for the argument with the given ID.
+func (as ArgsSpec) FindByID(id int16) (*FieldSpec, error) {
+	return FieldGroup(as).FindByID(id)
+}
+
 // ResultSpec contains information about a Function's result type.
 type ResultSpec struct {
 	ReturnType TypeSpec
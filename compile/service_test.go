@@ -22,6 +22,7 @@ package compile
 
 import (
 	"fmt"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -32,16 +33,12 @@ import (
 )
 
 func parseService(s string) *ast.Service {
-	prog, err := idl.Parse([]byte(s))
+	def, err := idl.ParseDefinition([]byte(s))
 	if err != nil {
 		panic(fmt.Sprintf("failure to parse: %v: %s", err, s))
 	}
 
-	if len(prog.Definitions) != 1 {
-		panic("parseService may be used to parse single services only")
-	}
-
-	return prog.Definitions[0].(*ast.Service)
+	return def.(*ast.Service)
 }
 
 func TestCompileService(t *testing.T) {
@@ -65,6 +62,7 @@ func TestCompileService(t *testing.T) {
 		Functions: map[string]*FunctionSpec{
 			"setValue": {
 				Name: "setValue",
+				line: 3,
 				ArgsSpec: ArgsSpec{
 					{
 						ID:   1,
@@ -81,6 +79,7 @@ func TestCompileService(t *testing.T) {
 			},
 			"getValue": {
 				Name: "getValue",
+				line: 4,
 				ArgsSpec: ArgsSpec{
 					{
 						ID:   1,
@@ -113,6 +112,7 @@ func TestCompileService(t *testing.T) {
 		Functions: map[string]*FunctionSpec{
 			"setValue": {
 				Name: "setValue",
+				line: 3,
 				ArgsSpec: ArgsSpec{
 					{
 						ID:   1,
@@ -195,6 +195,7 @@ func TestCompileService(t *testing.T) {
 				Functions: map[string]*FunctionSpec{
 					"setValues": {
 						Name: "setValues",
+						line: 3,
 						ArgsSpec: ArgsSpec{
 							{
 								ID:   1,
@@ -275,6 +276,33 @@ func TestCompileServiceFailure(t *testing.T) {
 				`the name "foo" has already been used`,
 			},
 		},
+		{
+			"duplicate ID in arg list",
+			`
+				service Foo {
+					void bar(
+						1: string foo,
+						1: binary baz
+					)
+				}
+			`,
+			[]string{
+				`cannot compile "baz" on line 5`,
+				`field "foo" has already used ID 1 on line 4`,
+			},
+		},
+		{
+			"arg ID out of range",
+			`
+				service Foo {
+					void bar(0: string foo)
+				}
+			`,
+			[]string{
+				`cannot compile "foo" on line 3`,
+				"field ID 0 is out of range",
+			},
+		},
 		{
 			"duplicate in exception list",
 			`
@@ -307,7 +335,7 @@ func TestCompileServiceFailure(t *testing.T) {
 		{
 			"oneway cannot return",
 			"service Foo { oneway i32 bar() }",
-			[]string{`function "bar" cannot return values`},
+			[]string{`cannot compile "bar" on line 1`, `function "bar" cannot return values`},
 		},
 		{
 			"oneway cannot raise",
@@ -317,7 +345,7 @@ func TestCompileServiceFailure(t *testing.T) {
 						throws (1: KeyDoesNotExistError err)
 				}
 			`,
-			[]string{`function "bar" cannot`, "raise exceptions"},
+			[]string{`cannot compile "bar" on line 3`, `function "bar" cannot`, "raise exceptions"},
 		},
 		{
 			"duplicate annotation name",
@@ -404,6 +432,52 @@ func TestLinkServiceFailure(t *testing.T) {
 				`could not resolve reference "Baz"`,
 			},
 		},
+		{
+			"redefine inherited function",
+			`
+				service Foo extends Bar {
+					void foo()
+					i32 bar()
+				}
+			`,
+			scope(
+				"Bar", &ServiceSpec{
+					Name: "Bar",
+					File: "bar.thrift",
+					Functions: map[string]*FunctionSpec{
+						"bar": {Name: "bar", ResultSpec: &ResultSpec{}, line: 42},
+					},
+				},
+			),
+			[]string{
+				`cannot compile "Foo"`,
+				`function "bar" of service "Foo" (test.thrift:4) conflicts with ` +
+					`function "bar" inherited from service "Bar" (bar.thrift:42)`,
+			},
+		},
+		{
+			"redefine function inherited transitively",
+			"service Foo extends Bar { void BAZ() }",
+			scope(
+				"Bar", &ServiceSpec{
+					Name:      "Bar",
+					File:      "bar.thrift",
+					Functions: make(map[string]*FunctionSpec),
+					parentSrc: &ast.ServiceReference{Name: "Baz"},
+				},
+				"Baz", &ServiceSpec{
+					Name: "Baz",
+					File: "baz.thrift",
+					Functions: map[string]*FunctionSpec{
+						"baz": {Name: "baz", ResultSpec: &ResultSpec{}, line: 7},
+					},
+				},
+			),
+			[]string{
+				`function "BAZ" of service "Foo" (test.thrift:1) conflicts with ` +
+					`function "baz" inherited from service "Baz" (baz.thrift:7)`,
+			},
+		},
 		{
 			"can throw exceptions only",
 			`
@@ -472,3 +546,175 @@ func TestLinkServiceFailure(t *testing.T) {
 		}
 	}
 }
+
+func TestServiceAllFunctions(t *testing.T) {
+	tests := []struct {
+		desc   string
+		parent string // optional
+		src    string
+
+		want      []string
+		wantError string
+	}{
+		{
+			desc: "no parent",
+			src:  "service Foo { void foo() }",
+			want: []string{"foo"},
+		},
+		{
+			desc:   "inherited",
+			parent: "service Bar { void bar(); oneway void baz() }",
+			src:    "service Foo { void foo() }",
+			want:   []string{"bar", "baz", "foo"},
+		},
+		{
+			desc:   "redefined",
+			parent: "service Bar { void bar() }",
+			src:    "service Foo { i32 bar() }",
+			wantError: `function "bar" of service "Foo" (test.thrift:1) conflicts with ` +
+				`function "bar" inherited from service "Bar" (test.thrift:1)`,
+		},
+		{
+			desc:   "redefined with different case",
+			parent: "service Bar { void getValue() }",
+			src:    "service Foo { void GetValue() }",
+			wantError: `function "GetValue" of service "Foo" (test.thrift:1) conflicts with ` +
+				`function "getValue" inherited from service "Bar" (test.thrift:1)`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			// The parent is assigned directly rather than with Link because
+			// Link rejects conflicting functions.
+			spec, err := compileService("test.thrift", parseService(tt.src))
+			require.NoError(t, err)
+			if tt.parent != "" {
+				spec.Parent, err = compileService("test.thrift", parseService(tt.parent))
+				require.NoError(t, err)
+			}
+
+			functions, err := spec.AllFunctions()
+			if tt.wantError != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tt.wantError)
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			var names []string
+			for name, f := range functions {
+				assert.Equal(t, name, f.Name)
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			assert.Equal(t, tt.want, names)
+		})
+	}
+}
+
+func TestServiceAllFunctionsCycle(t *testing.T) {
+	a := &ServiceSpec{Name: "A", Functions: map[string]*FunctionSpec{"f": {Name: "f"}}}
+	b := &ServiceSpec{Name: "B", Functions: map[string]*FunctionSpec{"f": {Name: "f"}}, Parent: a}
+	a.Parent = b
+
+	_, err := a.AllFunctions()
+	if assert.Error(t, err) {
+		assert.Equal(t, `service "A" inherits from itself: A -> B -> A`, err.Error())
+	}
+
+	f, _ := a.LookupFunction("g")
+	assert.Nil(t, f, "lookup must terminate")
+}
+
+func TestServiceLookupFunction(t *testing.T) {
+	notFound := &StructSpec{
+		Name:   "NotFound",
+		File:   "shared.thrift",
+		Type:   ast.ExceptionType,
+		Fields: make(FieldGroup, 0),
+	}
+	internalError := &StructSpec{
+		Name:   "InternalError",
+		File:   "test.thrift",
+		Type:   ast.ExceptionType,
+		Fields: make(FieldGroup, 0),
+	}
+
+	base, err := compileService("test.thrift", parseService(`service Base {
+		string get(1: string key) throws (
+			1: shared.NotFound notFound,
+			2: InternalError internalError,
+		)
+		oneway void ping()
+	}`))
+	require.NoError(t, err)
+
+	spec, err := compileService("test.thrift", parseService(
+		"service Child extends Base { void put(1: string key) }"))
+	require.NoError(t, err)
+
+	scope := scope(
+		"Base", base,
+		"InternalError", internalError,
+		"shared", scope("NotFound", notFound),
+	)
+	require.NoError(t, spec.Link(scope))
+
+	t.Run("declared", func(t *testing.T) {
+		f, svc := spec.LookupFunction("put")
+		require.NotNil(t, f)
+		assert.Equal(t, spec, svc)
+		assert.Equal(t, "Child:put", f.QualifiedMethodName(spec))
+		assert.Empty(t, f.Throws())
+	})
+
+	t.Run("inherited", func(t *testing.T) {
+		f, svc := spec.LookupFunction("get")
+		require.NotNil(t, f)
+		assert.Equal(t, base, svc)
+		assert.Equal(t, "get", f.MethodName())
+		assert.Equal(t, "Child:get", f.QualifiedMethodName(spec))
+		assert.Equal(t, "Base:get", f.QualifiedMethodName(svc))
+		assert.Equal(t, []*StructSpec{notFound, internalError}, f.Throws())
+	})
+
+	t.Run("oneway", func(t *testing.T) {
+		f, _ := spec.LookupFunction("ping")
+		require.NotNil(t, f)
+		assert.True(t, f.OneWay)
+		assert.Empty(t, f.Throws())
+	})
+
+	t.Run("unknown", func(t *testing.T) {
+		f, svc := spec.LookupFunction("Put")
+		assert.Nil(t, f)
+		assert.Nil(t, svc)
+	})
+}
+
+func TestArgsSpecFind(t *testing.T) {
+	src := parseService(`service Foo {
+		void foo(2: string b, 1: i32 a)
+	}`)
+	spec, err := compileService("test.thrift", src)
+	require.NoError(t, err)
+
+	args := spec.Functions["foo"].ArgsSpec
+	require.Len(t, args, 2)
+	assert.Equal(t, "b", args[0].Name, "arguments must be in declaration order")
+	assert.Equal(t, "a", args[1].Name, "arguments must be in declaration order")
+
+	if f, err := args.FindByName("a"); assert.NoError(t, err) {
+		assert.Equal(t, int16(1), f.ID)
+	}
+	if f, err := args.FindByID(2); assert.NoError(t, err) {
+		assert.Equal(t, "b", f.Name)
+	}
+
+	_, err = args.FindByName("c")
+	assert.Error(t, err)
+	_, err = args.FindByID(3)
+	assert.Error(t, err)
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compile
+
+import "fmt"
+
+const (
+	// Definitions with this annotation declare how mature their API is.
+	// Stable definitions may not reference experimental ones.
+	//
+	//   struct User {
+	//     1: required string name
+	//     2: optional Preferences preferences
+	//   } (stability = "stable")
+	//
+	//   struct Preferences {
+	//     ...
+	//   } (stability = "experimental")
+	//
+	// Fields and functions may be marked experimental to exempt them. The
+	// functions of a stable service are stable unless they are marked
+	// otherwise.
+	stabilityKey = "stability"
+
+	stabilityExperimental = "experimental"
+	stabilityStable       = "stable"
+)
+
+// stabilityError is raised when a stable definition references an
+// experimental one.
+type stabilityError struct {
+	Reference string
+}
+
+func (e stabilityError) Error() string {
+	return fmt.Sprintf(
+		"a stable definition cannot reference experimental %q", e.Reference)
+}
+
+// checkStability verifies that the stability annotations of the types and
+// services of the given linked module are valid, and that stable types and
+// services do not reference experimental ones.
+func checkStability(m *Module) error {
+	for _, name := range sortStringKeys(m.Types) {
+		t := m.Types[name]
+		stable, err := isStable(t.ThriftAnnotations())
+		if err != nil {
+			return compileError{Target: name, Reason: err}
+		}
+		if !stable {
+			continue
+		}
+
+		switch spec := t.(type) {
+		case *StructSpec:
+			if err := checkStableFields(spec.Fields); err != nil {
+				return compileError{Target: name, Reason: err}
+			}
+		case *TypedefSpec:
+			if err := checkStableReference(spec.Target); err != nil {
+				return compileError{Target: name, Reason: err}
+			}
+		}
+	}
+
+	for _, name := range sortStringKeys(m.Services) {
+		s := m.Services[name]
+		stable, err := isStable(s.Annotations)
+		if err != nil {
+			return compileError{Target: name, Reason: err}
+		}
+
+		for _, fname := range sortStringKeys(s.Functions) {
+			f := s.Functions[fname]
+			fstable, err := isStable(f.Annotations)
+			if err != nil {
+				return compileError{Target: name + "." + fname, Reason: err}
+			}
+
+			// Functions of stable services are stable unless they are
+			// explicitly marked experimental.
+			if _, annotated := f.Annotations[stabilityKey]; !annotated {
+				fstable = stable
+			}
+			if !fstable {
+				continue
+			}
+			if err := checkStableFunction(f); err != nil {
+				return compileError{Target: name + "." + fname, Reason: err}
+			}
+		}
+
+		if !stable || s.Parent == nil {
+			continue
+		}
+		if s.Parent.Annotations[stabilityKey] == stabilityExperimental {
+			return compileError{
+				Target: name,
+				Reason: stabilityError{Reference: s.Parent.Name},
+			}
+		}
+	}
+
+	return nil
+}
+
+// isStable returns true if the given annotations mark a definition as stable.
+func isStable(annotations Annotations) (bool, error) {
+	switch level, ok := annotations[stabilityKey]; {
+	case !ok, level == stabilityExperimental:
+		return false, nil
+	case level == stabilityStable:
+		return true, nil
+	default:
+		return false, fmt.Errorf(
+			"unknown %v %q: expected %q or %q",
+			stabilityKey, level, stabilityExperimental, stabilityStable)
+	}
+}
+
+func checkStableFunction(f *FunctionSpec) error {
+	if err := checkStableFields(FieldGroup(f.ArgsSpec)); err != nil {
+		return err
+	}
+	if f.ResultSpec == nil {
+		return nil
+	}
+	if f.ResultSpec.ReturnType != nil {
+		if err := checkStableReference(f.ResultSpec.ReturnType); err != nil {
+			return err
+		}
+	}
+	return checkStableFields(f.ResultSpec.Exceptions)
+}
+
+// checkStableFields verifies the fields of a stable definition. Fields which
+// are explicitly marked experimental may reference experimental types.
+func checkStableFields(fields FieldGroup) error {
+	for _, f := range fields {
+		if f.Annotations[stabilityKey] == stabilityExperimental {
+			continue
+		}
+		if err := checkStableReference(f.Type); err != nil {
+			return fmt.Errorf("field %q: %v", f.Name, err)
+		}
+	}
+	return nil
+}
+
+// checkStableReference returns an error if the given linked type is or
+// contains an experimental definition. Referenced structs are not searched
+// because they are checked on their own.
+func checkStableReference(t TypeSpec) error {
+	switch spec := t.(type) {
+	case *MapSpec:
+		if err := checkStableReference(spec.KeySpec); err != nil {
+			return err
+		}
+		return checkStableReference(spec.ValueSpec)
+	case *ListSpec:
+		return checkStableReference(spec.ValueSpec)
+	case *SetSpec:
+		return checkStableReference(spec.ValueSpec)
+	}
+
+	if t.ThriftAnnotations()[stabilityKey] == stabilityExperimental {
+		return stabilityError{Reference: t.ThriftName()}
+	}
+	return nil
+}
@@ -0,0 +1,170 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package compile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStability(t *testing.T) {
+	tests := []struct {
+		desc    string
+		src     string
+		wantErr string
+	}{
+		{
+			desc: "stable references stable",
+			src: `
+				struct Foo {
+					1: optional Bar bar
+				} (stability = "stable")
+				struct Bar {} (stability = "stable")
+			`,
+		},
+		{
+			desc: "experimental references experimental",
+			src: `
+				struct Foo {
+					1: optional Bar bar
+				} (stability = "experimental")
+				struct Bar {} (stability = "experimental")
+			`,
+		},
+		{
+			desc: "unannotated references experimental",
+			src: `
+				struct Foo { 1: optional Bar bar }
+				struct Bar {} (stability = "experimental")
+			`,
+		},
+		{
+			desc: "stable struct",
+			src: `
+				struct Foo {
+					1: optional map<string, list<Bar>> bars
+				} (stability = "stable")
+				enum Bar {} (stability = "experimental")
+			`,
+			wantErr: `cannot compile "Foo": field "bars": a stable definition cannot reference experimental "Bar"`,
+		},
+		{
+			desc: "experimental field",
+			src: `
+				struct Foo {
+					1: optional Bar bar (stability = "experimental")
+				} (stability = "stable")
+				struct Bar {} (stability = "experimental")
+			`,
+		},
+		{
+			desc: "stable typedef",
+			src: `
+				typedef Bar Foo (stability = "stable")
+				struct Bar {} (stability = "experimental")
+			`,
+			wantErr: `cannot compile "Foo": a stable definition cannot reference experimental "Bar"`,
+		},
+		{
+			desc: "stable service",
+			src: `
+				service Foo {
+					void bar(1: Baz baz)
+				} (stability = "stable")
+				struct Baz {} (stability = "experimental")
+			`,
+			wantErr: `cannot compile "Foo.bar": field "baz": a stable definition cannot reference experimental "Baz"`,
+		},
+		{
+			desc: "stable service exception",
+			src: `
+				service Foo {
+					void bar() throws (1: Baz baz)
+				} (stability = "stable")
+				exception Baz {} (stability = "experimental")
+			`,
+			wantErr: `cannot compile "Foo.bar": field "baz": a stable definition cannot reference experimental "Baz"`,
+		},
+		{
+			desc: "stable function return type",
+			src: `
+				service Foo {
+					Baz bar() (stability = "stable")
+				}
+				struct Baz {} (stability = "experimental")
+			`,
+			wantErr: `cannot compile "Foo.bar": a stable definition cannot reference experimental "Baz"`,
+		},
+		{
+			desc: "experimental function of stable service",
+			src: `
+				service Foo {
+					Baz bar() (stability = "experimental")
+				} (stability = "stable")
+				struct Baz {} (stability = "experimental")
+			`,
+		},
+		{
+			desc: "stable service with experimental parent",
+			src: `
+				service Foo extends Bar {} (stability = "stable")
+				service Bar {} (stability = "experimental")
+			`,
+			wantErr: `cannot compile "Foo": a stable definition cannot reference experimental "Bar"`,
+		},
+		{
+			desc:    "unknown level",
+			src:     `struct Foo {} (stability = "beta")`,
+			wantErr: `cannot compile "Foo": unknown stability "beta": expected "experimental" or "stable"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			fs := dummyFS{"/", map[string]string{"/test.thrift": tt.src}}
+			_, err := Compile("test.thrift", Filesystem(fs))
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStabilityIncluded(t *testing.T) {
+	fs := dummyFS{"/", map[string]string{
+		"/test.thrift": `
+			include "./shared.thrift"
+
+			struct Foo {
+				1: optional shared.Bar bar
+			} (stability = "stable")
+		`,
+		"/shared.thrift": `struct Bar {} (stability = "experimental")`,
+	}}
+
+	_, err := Compile("test.thrift", Filesystem(fs))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `a stable definition cannot reference experimental "Bar"`)
+	}
+}
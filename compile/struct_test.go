@@ -25,6 +25,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.uber.org/thriftrw/ast"
 	"go.uber.org/thriftrw/idl"
@@ -32,16 +33,12 @@ import (
 )
 
 func parseStruct(s string) *ast.Struct {
-	prog, err := idl.Parse([]byte(s))
+	def, err := idl.ParseDefinition([]byte(s))
 	if err != nil {
 		panic(fmt.Sprintf("failure to parse: %v: %s", err, s))
 	}
 
-	if len(prog.Definitions) != 1 {
-		panic("parseStruct may be used to parse single structs only")
-	}
-
-	return prog.Definitions[0].(*ast.Struct)
+	return def.(*ast.Struct)
 }
 
 func TestCompileStructSuccess(t *testing.T) {
@@ -216,7 +213,41 @@ func TestCompileStructFailure(t *testing.T) {
 				1: optional string foo
 				1: optional string bar
 			}`,
-			[]string{`field "foo" has already used ID 1`},
+			[]string{
+				`cannot compile "bar" on line 3`,
+				`field "foo" has already used ID 1 on line 2`,
+			},
+		},
+		{
+			"field ID zero",
+			`struct Foo {
+				0: optional string foo
+			}`,
+			[]string{
+				`cannot compile "foo" on line 2`,
+				"field ID 0 is out of range: IDs must be between 1 and 32767",
+			},
+		},
+		{
+			"negative field ID",
+			"struct Foo { -1: optional string foo }",
+			[]string{`cannot compile "foo"`, "field ID -1 is out of range"},
+		},
+		{
+			"field ID too large",
+			`struct Foo {
+				1: optional string foo
+				32768: optional string bar
+			}`,
+			[]string{`cannot compile "bar" on line 3`, "field ID 32768 is out of range"},
+		},
+		{
+			"field IDs which conflict after truncation",
+			`struct Foo {
+				1: optional string foo
+				65537: optional string bar
+			}`,
+			[]string{`cannot compile "bar" on line 3`, "field ID 65537 is out of range"},
 		},
 	}
 
@@ -274,3 +305,33 @@ func TestLinkStructFailure(t *testing.T) {
 		}
 	}
 }
+
+func TestFieldGroupFind(t *testing.T) {
+	src := parseStruct(`struct Foo {
+		3: required string c
+		1: optional i32 a
+		2: optional binary b
+	}`)
+	spec, err := compileStruct("test.thrift", src, explicitRequiredness)
+	require.NoError(t, err)
+
+	var names []string
+	for _, f := range spec.Fields {
+		names = append(names, f.Name)
+	}
+	assert.Equal(t, []string{"c", "a", "b"}, names,
+		"fields must be in the order in which they were declared")
+
+	if f, err := spec.Fields.FindByName("a"); assert.NoError(t, err) {
+		assert.Equal(t, int16(1), f.ID)
+	}
+	if f, err := spec.Fields.FindByID(3); assert.NoError(t, err) {
+		assert.Equal(t, "c", f.Name)
+	}
+
+	_, err = spec.Fields.FindByName("d")
+	assert.EqualError(t, err, "unknown field d")
+
+	_, err = spec.Fields.FindByID(4)
+	assert.EqualError(t, err, "unknown field ID 4")
+}
@@ -68,7 +68,7 @@ type TypeSpec interface {
 // TypeSpec of the Typedef's target.
 func RootTypeSpec(s TypeSpec) TypeSpec {
 	if t, ok := s.(*TypedefSpec); ok {
-		return t.root
+		return t.RootTypeSpec()
 	}
 	return s
 }
@@ -86,6 +86,17 @@ type typeSpecReference ast.TypeReference
 
 // Link replaces the typeSpecReference with an actual linked TypeSpec.
 func (r typeSpecReference) Link(scope Scope) (TypeSpec, error) {
+	t, err := r.link(scope, true)
+	if err == nil {
+		recordReference(scope, Reference{Name: r.Name, Line: r.Line, Type: t})
+	}
+	return t, err
+}
+
+// link resolves the reference in the given scope. If resolveUUID is true,
+// unresolved references to uuid resolve to the uuid base type. This is not
+// the case for references to types in included modules, like foo.uuid.
+func (r typeSpecReference) link(scope Scope, resolveUUID bool) (TypeSpec, error) {
 	src := ast.TypeReference(r)
 	t, err := scope.LookupType(src.Name)
 	if err == nil {
@@ -94,6 +105,13 @@ func (r typeSpecReference) Link(scope Scope) (TypeSpec, error) {
 
 	mname, iname := splitInclude(src.Name)
 	if len(mname) == 0 {
+		// uuid is not a keyword so that existing Thrift files which use it
+		// as an identifier continue to compile. It refers to the base type
+		// only if no type with that name was declared.
+		if resolveUUID && src.Name == uuidTypeName {
+			return &UUIDSpec{}, nil
+		}
+
 		return nil, referenceError{
 			Target:    src.Name,
 			Line:      src.Line,
@@ -112,7 +130,7 @@ func (r typeSpecReference) Link(scope Scope) (TypeSpec, error) {
 		}
 	}
 
-	t, err = typeSpecReference{Name: iname}.Link(includedScope)
+	t, err = typeSpecReference{Name: iname}.link(includedScope, false)
 	if err != nil {
 		return nil, referenceError{
 			Target:    src.Name,
@@ -106,6 +106,8 @@ func TestLinkTypeReference(t *testing.T) {
 			"bar.Foo",
 			foo,
 		},
+		{"uuid base type", nil, "uuid", &UUIDSpec{}},
+		{"uuid shadowed by a declared type", scope("uuid", foo), "uuid", foo},
 	}
 
 	for _, tt := range tests {
@@ -150,6 +152,15 @@ func TestLinkTypeReferenceFailure(t *testing.T) {
 				`could not resolve reference "UUID" in "shared"`,
 			},
 		},
+		{
+			"uuid in included module",
+			scope("foo", "shared", scope("shared")),
+			"shared.uuid",
+			[]string{
+				`could not resolve reference "shared.uuid" in "foo"`,
+				`could not resolve reference "uuid" in "shared"`,
+			},
+		},
 		{
 			"unknown identifier in included module of included module",
 			scope("foo", "bar", scope("bar", "baz", scope("baz"))),
@@ -36,6 +36,9 @@ type TypedefSpec struct {
 	Doc         string
 
 	root TypeSpec
+
+	// Typedefs whose root is not known until this typedef has been linked.
+	dependents []*TypedefSpec
 }
 
 // compileTypedef compiles the given Typedef AST into a TypedefSpec.
@@ -76,10 +79,62 @@ func (t *TypedefSpec) Link(scope Scope) (TypeSpec, error) {
 
 	var err error
 	t.Target, err = t.Target.Link(scope)
-	if err == nil {
-		t.root = RootTypeSpec(t.Target)
+	if err != nil {
+		return t, err
+	}
+
+	// Typedefs which are part of a cycle are linked while the rest of the
+	// cycle is still being linked, so the cycle is complete only once the
+	// first typedef of the cycle has linked its target.
+	if err := findTypeCycles(t); err != nil {
+		return t, err
+	}
+
+	t.setRoot()
+	return t, nil
+}
+
+// setRoot records the TypeSpec at the end of this typedef's chain of
+// typedefs as its root.
+//
+// A typedef in the chain may still be linking its target if a struct
+// referenced by that target refers back to this typedef. The root is then
+// recorded once that typedef has been linked.
+func (t *TypedefSpec) setRoot() {
+	s := t.Target
+	for {
+		td, ok := s.(*TypedefSpec)
+		if !ok {
+			break
+		}
+		if td.root != nil {
+			s = td.root
+			break
+		}
+		if _, unlinked := td.Target.(typeSpecReference); unlinked {
+			td.dependents = append(td.dependents, t)
+			return
+		}
+		s = td.Target
+	}
+
+	t.root = s
+	for _, d := range t.dependents {
+		d.setRoot()
 	}
-	return t, err
+	t.dependents = nil
+}
+
+// RootTypeSpec returns the TypeSpec that this typedef refers to after
+// following all typedefs in between. For example, the root of Baz below is
+// list<map<string, Foo>>.
+//
+//	typedef list<map<string, Foo>> Bar
+//	typedef Bar Baz
+//
+// Returns nil if the typedef has not been linked.
+func (t *TypedefSpec) RootTypeSpec() TypeSpec {
+	return t.root
 }
 
 // ThriftName is the name of the typedef as it appears in the Thrift file.
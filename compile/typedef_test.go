@@ -25,6 +25,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"go.uber.org/thriftrw/ast"
 	"go.uber.org/thriftrw/idl"
@@ -32,16 +33,12 @@ import (
 )
 
 func parseTypedef(s string) *ast.Typedef {
-	prog, err := idl.Parse([]byte(s))
+	def, err := idl.ParseDefinition([]byte(s))
 	if err != nil {
 		panic(fmt.Sprintf("failure to parse: %v: %s", err, s))
 	}
 
-	if len(prog.Definitions) != 1 {
-		panic("parseTypedef may be used to parse a single typedef only")
-	}
-
-	return prog.Definitions[0].(*ast.Typedef)
+	return def.(*ast.Typedef)
 }
 
 func TestCompileTypedef(t *testing.T) {
@@ -141,3 +138,79 @@ func TestCompileTypedefFailure(t *testing.T) {
 		}
 	}
 }
+
+func TestTypedefRootTypeSpec(t *testing.T) {
+	// Typedefs are linked in order of their names. Bar is linked first and
+	// while it is being linked, Foo links Baz, which refers back to Bar.
+	files := map[string]string{
+		"/some/prefix/main.thrift": `
+			typedef list<map<string, Foo>> Bar
+			typedef Bar Baz
+			typedef Baz Qux
+
+			struct Foo {
+				1: optional Baz children
+			}
+		`,
+	}
+
+	module, err := Compile("main.thrift", Filesystem(dummyFS{"/some/prefix/", files}))
+	require.NoError(t, err, "Compile failed")
+
+	bar, err := module.LookupType("Bar")
+	require.NoError(t, err)
+	foo, err := module.LookupType("Foo")
+	require.NoError(t, err)
+
+	root := bar.(*TypedefSpec).Target
+	for _, name := range []string{"Bar", "Baz", "Qux"} {
+		spec, err := module.LookupType(name)
+		require.NoError(t, err)
+
+		typedef := spec.(*TypedefSpec)
+		assert.Equal(t, wire.TList, typedef.TypeCode(), name)
+		assert.True(t, root == typedef.RootTypeSpec(), "root of %v", name)
+		assert.True(t, root == RootTypeSpec(typedef), "root of %v", name)
+	}
+
+	list := root.(*ListSpec)
+	assert.True(t, foo == list.ValueSpec.(*MapSpec).ValueSpec, "Foo must be linked")
+
+	children, err := foo.(*StructSpec).Fields.FindByName("children")
+	require.NoError(t, err)
+	assert.True(t, root == RootTypeSpec(children.Type), "root of Foo.children")
+}
+
+func TestTypedefRootTypeSpecOfTypedefBeingLinked(t *testing.T) {
+	// Bar is linked first. While Bar is linking its target, Baz is linked
+	// and finds Bar without a target.
+	files := map[string]string{
+		"/some/prefix/main.thrift": `
+			typedef Zed Bar
+			typedef Bar Baz
+			typedef list<Foo> Zed
+
+			struct Foo {
+				1: optional Baz children
+			}
+		`,
+	}
+
+	module, err := Compile("main.thrift", Filesystem(dummyFS{"/some/prefix/", files}))
+	require.NoError(t, err, "Compile failed")
+
+	zed, err := module.LookupType("Zed")
+	require.NoError(t, err)
+
+	root := zed.(*TypedefSpec).Target
+	for _, name := range []string{"Bar", "Baz", "Zed"} {
+		spec, err := module.LookupType(name)
+		require.NoError(t, err)
+		assert.True(t, root == RootTypeSpec(spec), "root of %v", name)
+	}
+}
+
+func TestTypedefRootTypeSpecUnlinked(t *testing.T) {
+	typedef := &TypedefSpec{Name: "Foo", Target: typeSpecReference{Name: "Bar"}}
+	assert.Nil(t, typedef.RootTypeSpec())
+}
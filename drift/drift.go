@@ -0,0 +1,114 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package drift detects differences between the Thrift structs received by a
+// server and the structs it was generated with.
+//
+// Such differences are expected while a schema change is being rolled out,
+// but callers which keep sending fields that no longer exist, or which fail
+// to send required fields, are an early sign of an incompatible change.
+//
+// Code generated with the --drift-schemas option provides a DriftSchema
+// method on the arguments struct of each function. Servers may use it
+// together with a Reporter to find callers which have drifted.
+//
+//   reporter := drift.NewReporter(time.Minute, func(r drift.Report) {
+//     logger.Warn("schema drift", zap.String("caller", r.Caller), ...)
+//   })
+//
+//   var args kv.KeyValue_GetValue_Args
+//   reporter.Check(caller, "KeyValue:getValue", args.DriftSchema(), value)
+//   if err := args.FromWire(value); err != nil {
+//     ...
+//   }
+package drift
+
+import (
+	"sort"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// Field is a field of a Thrift struct.
+type Field struct {
+	ID       int16
+	Name     string
+	Required bool
+}
+
+// Schema describes a Thrift struct as known to the generated code.
+type Schema struct {
+	// Name of the generated Go type.
+	Name   string
+	Fields []Field
+}
+
+// Drift lists the differences between a struct received over the wire and
+// the Schema it was expected to match.
+type Drift struct {
+	// IDs of fields which were received but are not in the Schema, in
+	// ascending order.
+	Unknown []int16
+
+	// Names of required fields in the Schema which were not received, in
+	// the order of the Schema.
+	Missing []string
+}
+
+// IsEmpty returns true if there were no differences.
+func (d Drift) IsEmpty() bool {
+	return len(d.Unknown) == 0 && len(d.Missing) == 0
+}
+
+// Compare compares the given struct with the given Schema.
+//
+// Values which are not structs do not match any Schema and are reported as
+// missing all required fields.
+func Compare(s Schema, v wire.Value) Drift {
+	received := make(map[int16]struct{})
+	if v.Type() == wire.TStruct {
+		for _, f := range v.GetStruct().Fields {
+			received[f.ID] = struct{}{}
+		}
+	}
+
+	var d Drift
+	known := make(map[int16]struct{}, len(s.Fields))
+	for _, f := range s.Fields {
+		known[f.ID] = struct{}{}
+		if _, ok := received[f.ID]; !ok && f.Required {
+			d.Missing = append(d.Missing, f.Name)
+		}
+	}
+
+	for id := range received {
+		if _, ok := known[id]; !ok {
+			d.Unknown = append(d.Unknown, id)
+		}
+	}
+	sort.Sort(int16s(d.Unknown))
+	return d
+}
+
+type int16s []int16
+
+func (s int16s) Len() int           { return len(s) }
+func (s int16s) Less(i, j int) bool { return s[i] < s[j] }
+func (s int16s) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
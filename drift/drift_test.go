@@ -0,0 +1,89 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package drift
+
+import (
+	"testing"
+
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testSchema = Schema{
+	Name: "KeyValue_SetValue_Args",
+	Fields: []Field{
+		{ID: 1, Name: "key", Required: true},
+		{ID: 2, Name: "value", Required: true},
+		{ID: 3, Name: "ttl"},
+	},
+}
+
+func testStruct(ids ...int16) wire.Value {
+	fields := make([]wire.Field, 0, len(ids))
+	for _, id := range ids {
+		fields = append(fields, wire.Field{ID: id, Value: wire.NewValueString("x")})
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields})
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		desc  string
+		value wire.Value
+		want  Drift
+	}{
+		{
+			desc:  "match",
+			value: testStruct(1, 2, 3),
+		},
+		{
+			desc:  "optional field missing",
+			value: testStruct(2, 1),
+		},
+		{
+			desc:  "unknown fields",
+			value: testStruct(1, 2, 9, 4),
+			want:  Drift{Unknown: []int16{4, 9}},
+		},
+		{
+			desc:  "required field missing",
+			value: testStruct(2, 3),
+			want:  Drift{Missing: []string{"key"}},
+		},
+		{
+			desc:  "unknown and missing",
+			value: testStruct(5),
+			want:  Drift{Unknown: []int16{5}, Missing: []string{"key", "value"}},
+		},
+		{
+			desc:  "not a struct",
+			value: wire.NewValueI32(42),
+			want:  Drift{Missing: []string{"key", "value"}},
+		},
+	}
+
+	for _, tt := range tests {
+		got := Compare(testSchema, tt.value)
+		assert.Equal(t, tt.want, got, tt.desc)
+		assert.Equal(t, tt.want.IsEmpty(), got.IsEmpty(), tt.desc)
+	}
+}
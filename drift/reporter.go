@@ -0,0 +1,139 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package drift
+
+import (
+	"sync"
+	"time"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// Report is a difference found by a Reporter.
+type Report struct {
+	// Caller which sent the struct, as identified by the transport.
+	Caller string
+
+	// Method for which the struct was sent.
+	Method string
+
+	// Schema against which the struct was checked.
+	Schema string
+
+	Drift Drift
+
+	// Number of reports for the same caller and method that were dropped
+	// since the last one because of rate limiting.
+	Suppressed int
+}
+
+// Reporter checks received structs against their schemas and reports
+// differences at most once per interval for each caller and method.
+//
+// Callers are identified by the transport and may be numerous, so callers
+// and methods which have not been reported for two intervals are forgotten,
+// along with the number of their reports which were suppressed.
+//
+// Reporters are safe for concurrent use.
+type Reporter struct {
+	interval time.Duration
+	report   func(Report)
+	now      func() time.Time
+
+	mu        sync.Mutex
+	callers   map[reporterKey]*reporterState
+	lastSweep time.Time
+}
+
+type reporterKey struct{ caller, method string }
+
+type reporterState struct {
+	last       time.Time
+	suppressed int
+}
+
+// NewReporter builds a Reporter which calls the given function with
+// differences found by Check, at most once per interval for each caller and
+// method.
+func NewReporter(interval time.Duration, report func(Report)) *Reporter {
+	return &Reporter{
+		interval: interval,
+		report:   report,
+		now:      time.Now,
+		callers:  make(map[reporterKey]*reporterState),
+	}
+}
+
+// Check compares the struct sent by the given caller for the given method
+// with the given Schema, reporting any differences. The differences are
+// returned regardless of whether they were reported.
+func (r *Reporter) Check(caller, method string, s Schema, v wire.Value) Drift {
+	d := Compare(s, v)
+	if d.IsEmpty() {
+		return d
+	}
+
+	key := reporterKey{caller: caller, method: method}
+	now := r.now()
+
+	r.mu.Lock()
+	if now.Sub(r.lastSweep) >= r.interval {
+		r.sweep(now)
+	}
+
+	state, ok := r.callers[key]
+	if !ok {
+		state = &reporterState{}
+		r.callers[key] = state
+	} else if now.Sub(state.last) < r.interval {
+		state.suppressed++
+		r.mu.Unlock()
+		return d
+	}
+
+	suppressed := state.suppressed
+	state.last = now
+	state.suppressed = 0
+	r.mu.Unlock()
+
+	r.report(Report{
+		Caller:     caller,
+		Method:     method,
+		Schema:     s.Name,
+		Drift:      d,
+		Suppressed: suppressed,
+	})
+	return d
+}
+
+// sweep forgets the callers and methods which were last reported two or
+// more intervals ago. Reports may only be suppressed during the interval
+// after a report, so these have not sent differences for a whole interval.
+//
+// sweep must be called with mu held.
+func (r *Reporter) sweep(now time.Time) {
+	for key, state := range r.callers {
+		if now.Sub(state.last) >= 2*r.interval {
+			delete(r.callers, key)
+		}
+	}
+	r.lastSweep = now
+}
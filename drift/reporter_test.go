@@ -0,0 +1,118 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package drift
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReporter(t *testing.T) {
+	var reports []Report
+	r := NewReporter(time.Minute, func(r Report) { reports = append(reports, r) })
+
+	now := time.Unix(1500000000, 0)
+	r.now = func() time.Time { return now }
+
+	// Structs without differences are never reported.
+	assert.True(t, r.Check("foo", "setValue", testSchema, testStruct(1, 2)).IsEmpty())
+	assert.Empty(t, reports)
+
+	drifted := testStruct(1, 2, 4)
+	assert.Equal(t, []int16{4}, r.Check("foo", "setValue", testSchema, drifted).Unknown)
+	assert.Len(t, reports, 1)
+
+	// Rate limited per caller and method, but still returned.
+	now = now.Add(time.Second)
+	assert.Equal(t, []int16{4}, r.Check("foo", "setValue", testSchema, drifted).Unknown)
+	r.Check("foo", "setValue", testSchema, drifted)
+	assert.Len(t, reports, 1)
+
+	r.Check("bar", "setValue", testSchema, drifted)
+	r.Check("foo", "setValues", testSchema, drifted)
+	assert.Len(t, reports, 3)
+
+	now = now.Add(time.Minute)
+	r.Check("foo", "setValue", testSchema, testStruct(2))
+
+	assert.Equal(t, []Report{
+		{
+			Caller: "foo",
+			Method: "setValue",
+			Schema: "KeyValue_SetValue_Args",
+			Drift:  Drift{Unknown: []int16{4}},
+		},
+		{
+			Caller: "bar",
+			Method: "setValue",
+			Schema: "KeyValue_SetValue_Args",
+			Drift:  Drift{Unknown: []int16{4}},
+		},
+		{
+			Caller: "foo",
+			Method: "setValues",
+			Schema: "KeyValue_SetValue_Args",
+			Drift:  Drift{Unknown: []int16{4}},
+		},
+		{
+			Caller:     "foo",
+			Method:     "setValue",
+			Schema:     "KeyValue_SetValue_Args",
+			Drift:      Drift{Missing: []string{"key"}},
+			Suppressed: 2,
+		},
+	}, reports)
+}
+
+func TestReporterForgetsIdleCallers(t *testing.T) {
+	var reports []Report
+	r := NewReporter(time.Minute, func(r Report) { reports = append(reports, r) })
+
+	now := time.Unix(1500000000, 0)
+	r.now = func() time.Time { return now }
+
+	drifted := testStruct(1, 2, 4)
+	for _, caller := range []string{"a", "b", "c"} {
+		r.Check(caller, "setValue", testSchema, drifted)
+	}
+	assert.Len(t, r.callers, 3)
+
+	// Callers reported within two intervals are remembered.
+	now = now.Add(90 * time.Second)
+	r.Check("d", "setValue", testSchema, drifted)
+	assert.Len(t, r.callers, 4)
+
+	// Callers are forgotten at most once per interval.
+	now = now.Add(30 * time.Second)
+	r.Check("d", "setValues", testSchema, drifted)
+	assert.Len(t, r.callers, 5)
+
+	now = now.Add(30 * time.Second)
+	r.Check("a", "setValue", testSchema, drifted)
+	assert.Len(t, r.callers, 3, "b and c must be forgotten")
+	assert.Contains(t, r.callers, reporterKey{caller: "a", method: "setValue"})
+	assert.Contains(t, r.callers, reporterKey{caller: "d", method: "setValue"})
+	assert.Contains(t, r.callers, reporterKey{caller: "d", method: "setValues"})
+
+	assert.Len(t, reports, 6)
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package balancer sends enveloped Thrift requests to one of many instances
+// of a service. Service discovery integrations implement Balancer, or keep
+// the peers of a RoundRobin up to date, without changing the client.
+//
+//   rr := balancer.NewRoundRobin(peers...)
+//   client := balancer.NewClient(protocol.Binary, rr, nil)
+//
+//   // Later, when the instances of the service change:
+//   rr.Update(newPeers)
+package balancer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"go.uber.org/thriftrw/envelope"
+	internalenvelope "go.uber.org/thriftrw/internal/envelope"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+)
+
+// ErrNoPeers is returned by RoundRobin when it does not have any peers.
+var ErrNoPeers = errors.New("no peers are available")
+
+// Peer sends requests to one instance of a service. It has the same method
+// as envelope transports.
+type Peer interface {
+	Send([]byte) ([]byte, error)
+}
+
+// Balancer picks the peers to which requests are sent.
+type Balancer interface {
+	// Pick returns the peer to which a request to the given method should
+	// be sent. The shard key is empty if the request does not have one.
+	Pick(ctx context.Context, method, shardKey string) (Peer, error)
+}
+
+// PeerLister is implemented by Balancers which know all of their peers.
+// Balancers which wrap them use it to forget about peers which are gone.
+type PeerLister interface {
+	// Peers returns the current peers of the Balancer.
+	Peers() []Peer
+}
+
+// RoundRobin is a Balancer which sends requests to each of its peers in
+// turn, regardless of their shard keys. It is safe for concurrent use.
+type RoundRobin struct {
+	next uint32 // atomic
+
+	mu    sync.RWMutex
+	peers []Peer
+}
+
+var (
+	_ Balancer   = (*RoundRobin)(nil)
+	_ PeerLister = (*RoundRobin)(nil)
+)
+
+// NewRoundRobin builds a RoundRobin with the given peers.
+func NewRoundRobin(peers ...Peer) *RoundRobin {
+	rr := &RoundRobin{}
+	rr.Update(peers)
+	return rr
+}
+
+// Update replaces the peers of this RoundRobin. Requests which already
+// picked a peer are not affected.
+func (rr *RoundRobin) Update(peers []Peer) {
+	peers = append([]Peer(nil), peers...)
+
+	rr.mu.Lock()
+	rr.peers = peers
+	rr.mu.Unlock()
+}
+
+// Peers returns the current peers of this RoundRobin.
+func (rr *RoundRobin) Peers() []Peer {
+	rr.mu.RLock()
+	defer rr.mu.RUnlock()
+
+	return append([]Peer(nil), rr.peers...)
+}
+
+// Pick returns the next peer, or ErrNoPeers if there aren't any.
+func (rr *RoundRobin) Pick(ctx context.Context, method, shardKey string) (Peer, error) {
+	rr.mu.RLock()
+	defer rr.mu.RUnlock()
+
+	if len(rr.peers) == 0 {
+		return nil, ErrNoPeers
+	}
+	i := atomic.AddUint32(&rr.next, 1) - 1
+	return rr.peers[i%uint32(len(rr.peers))], nil
+}
+
+// ShardKeyFunc returns the shard key of a request to the given method.
+type ShardKeyFunc func(method string, body wire.Value) string
+
+// NewClient returns a Client which encodes requests with the given protocol
+// and sends each of them to the peer picked by the given Balancer. If
+// shardKey is non-nil, it provides the shard keys passed to the Balancer.
+// The context passed to SendContext is passed to the Balancer.
+func NewClient(p protocol.Protocol, b Balancer, shardKey ShardKeyFunc) envelope.ContextClient {
+	return balancedClient{p: p, b: b, shardKey: shardKey}
+}
+
+type balancedClient struct {
+	p        protocol.Protocol
+	b        Balancer
+	shardKey ShardKeyFunc
+}
+
+func (c balancedClient) Send(name string, body wire.Value) (wire.Value, error) {
+	return c.SendContext(context.Background(), name, body)
+}
+
+func (c balancedClient) SendContext(ctx context.Context, name string, body wire.Value) (wire.Value, error) {
+	var key string
+	if c.shardKey != nil {
+		key = c.shardKey(name, body)
+	}
+
+	peer, err := c.b.Pick(ctx, name, key)
+	if err != nil {
+		return wire.Value{}, err
+	}
+	return internalenvelope.NewClient(c.p, peer).Send(name, body)
+}
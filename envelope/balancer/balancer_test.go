@@ -0,0 +1,166 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package balancer
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+
+	"go.uber.org/thriftrw/envelope"
+	internalenvelope "go.uber.org/thriftrw/internal/envelope"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type handlerFunc func(string, wire.Value) (wire.Value, error)
+
+func (f handlerFunc) Handle(name string, body wire.Value) (wire.Value, error) {
+	return f(name, body)
+}
+
+// request builds a body with the given string in field 1.
+func request(s string) wire.Value {
+	return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString(s)},
+	}})
+}
+
+// field1 returns the string in field 1 of the given body.
+func field1(body wire.Value) string {
+	for _, f := range body.GetStruct().Fields {
+		if f.ID == 1 {
+			return f.Value.GetString()
+		}
+	}
+	return ""
+}
+
+type serverPeer struct{ s internalenvelope.Server }
+
+func (p *serverPeer) Send(data []byte) ([]byte, error) {
+	return p.s.Handle(data)
+}
+
+// newPeer builds a Peer which responds to all requests with its name in
+// field 1.
+func newPeer(name string) Peer {
+	return &serverPeer{internalenvelope.NewServer(protocol.Binary, handlerFunc(
+		func(string, wire.Value) (wire.Value, error) {
+			return request(name), nil
+		}))}
+}
+
+type balancerFunc func(context.Context, string, string) (Peer, error)
+
+func (f balancerFunc) Pick(ctx context.Context, method, shardKey string) (Peer, error) {
+	return f(ctx, method, shardKey)
+}
+
+func sendAll(t *testing.T, c envelope.Client, n int) []string {
+	var got []string
+	for i := 0; i < n; i++ {
+		res, err := c.Send("hello", wire.NewValueStruct(wire.Struct{}))
+		require.NoError(t, err)
+		got = append(got, field1(res))
+	}
+	return got
+}
+
+func TestRoundRobin(t *testing.T) {
+	rr := NewRoundRobin(newPeer("a"), newPeer("b"), newPeer("c"))
+	client := NewClient(protocol.Binary, rr, nil)
+	assert.Equal(t, []string{"a", "b", "c", "a", "b"}, sendAll(t, client, 5))
+
+	d := newPeer("d")
+	rr.Update([]Peer{d})
+	assert.Equal(t, []string{"d", "d"}, sendAll(t, client, 2))
+	assert.Equal(t, []Peer{d}, rr.Peers())
+
+	rr.Update(nil)
+	_, err := client.Send("hello", wire.NewValueStruct(wire.Struct{}))
+	assert.Equal(t, ErrNoPeers, err)
+}
+
+func TestRoundRobinConcurrent(t *testing.T) {
+	rr := NewRoundRobin(newPeer("a"), newPeer("b"))
+
+	var (
+		mu     sync.Mutex
+		counts = make(map[Peer]int)
+		wg     sync.WaitGroup
+	)
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				p, err := rr.Pick(context.Background(), "hello", "")
+				if !assert.NoError(t, err) {
+					return
+				}
+				mu.Lock()
+				counts[p]++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	require.Len(t, counts, 2)
+	for _, n := range counts {
+		assert.Equal(t, 500, n)
+	}
+}
+
+func TestClientPick(t *testing.T) {
+	type ctxKey struct{}
+
+	peers := map[string]Peer{"a": newPeer("a"), "b": newPeer("b")}
+	b := balancerFunc(func(ctx context.Context, method, shardKey string) (Peer, error) {
+		assert.Equal(t, "hello", method)
+		assert.Equal(t, "value", ctx.Value(ctxKey{}))
+		if p, ok := peers[shardKey]; ok {
+			return p, nil
+		}
+		return nil, errors.New("unknown shard")
+	})
+
+	client := NewClient(protocol.Binary, b, func(method string, body wire.Value) string {
+		return field1(body)
+	})
+	ctx := context.WithValue(context.Background(), ctxKey{}, "value")
+
+	res, err := client.SendContext(ctx, "hello", request("b"))
+	require.NoError(t, err)
+	assert.Equal(t, "b", field1(res))
+
+	res, err = client.SendContext(ctx, "hello", request("a"))
+	require.NoError(t, err)
+	assert.Equal(t, "a", field1(res))
+
+	_, err = client.SendContext(ctx, "hello", request("c"))
+	assert.EqualError(t, err, "unknown shard")
+}
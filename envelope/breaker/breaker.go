@@ -0,0 +1,368 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package breaker stops sending requests to peers which are failing or
+// slow. Each peer has a circuit breaker per method, so that a method which
+// fails on one instance of a service does not prevent calls to its other
+// methods or to other instances.
+//
+//   b := breaker.NewBalancer(balancer.NewRoundRobin(peers...), breaker.Config{
+//     FailureRatio: 0.5,
+//     SlowCall:     time.Second,
+//   })
+//   client := balancer.NewClient(protocol.Binary, b, nil)
+//
+// A breaker opens when the ratio of failed requests within a window reaches
+// the FailureRatio. Requests which fail to send and requests which take at
+// least SlowCall count as failed. Once the breaker has been open for the
+// OpenDuration, it lets HalfOpenProbes requests through, and closes again
+// if all of them succeed.
+package breaker
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.uber.org/thriftrw/envelope/balancer"
+)
+
+// ErrOpen is returned by Balancer when the breakers of all the peers it
+// picked are open, and by the peers it picked if their breakers opened
+// before requests were sent to them.
+var ErrOpen = errors.New("circuit breaker is open")
+
+// Config configures the circuit breakers of a Balancer. Fields with zero
+// values use the defaults.
+type Config struct {
+	// FailureRatio is the ratio of failed requests to all requests within
+	// the Window at which a breaker opens. Defaults to 0.5.
+	FailureRatio float64
+
+	// MinRequests is the number of requests a breaker must see within the
+	// Window before it may open. Defaults to 10.
+	MinRequests int
+
+	// Window is the period over which requests are counted. Defaults to 10
+	// seconds.
+	Window time.Duration
+
+	// SlowCall is the duration after which requests count as failed, even
+	// if they succeed. If zero, only the outcome of requests counts.
+	SlowCall time.Duration
+
+	// OpenDuration is how long a breaker stays open before it lets probing
+	// requests through. Defaults to 5 seconds.
+	OpenDuration time.Duration
+
+	// HalfOpenProbes is the number of probing requests which must succeed
+	// for a half-open breaker to close. Defaults to 1.
+	HalfOpenProbes int
+
+	// MaxPicks is the number of peers picked from the underlying Balancer
+	// for a request before giving up because their breakers are open.
+	// Defaults to 3.
+	MaxPicks int
+}
+
+func (c Config) withDefaults() Config {
+	if c.FailureRatio <= 0 {
+		c.FailureRatio = 0.5
+	}
+	if c.MinRequests <= 0 {
+		c.MinRequests = 10
+	}
+	if c.Window <= 0 {
+		c.Window = 10 * time.Second
+	}
+	if c.OpenDuration <= 0 {
+		c.OpenDuration = 5 * time.Second
+	}
+	if c.HalfOpenProbes <= 0 {
+		c.HalfOpenProbes = 1
+	}
+	if c.MaxPicks <= 0 {
+		c.MaxPicks = 3
+	}
+	return c
+}
+
+// State is the state of a circuit breaker.
+type State int
+
+const (
+	// Closed breakers let all requests through.
+	Closed State = iota
+
+	// Open breakers reject all requests.
+	Open
+
+	// HalfOpen breakers let a limited number of probing requests through.
+	HalfOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case Closed:
+		return "closed"
+	case Open:
+		return "open"
+	case HalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+// Balancer is a balancer.Balancer which skips the peers picked by another
+// Balancer if their breaker for the method is open. Peers must be
+// comparable, like pointers, because breakers are kept per peer.
+//
+// If the other Balancer is a balancer.PeerLister, like balancer.RoundRobin,
+// the breakers of peers which it no longer has are dropped once per Window.
+type Balancer struct {
+	b   balancer.Balancer
+	cfg Config
+	now func() time.Time
+
+	mu        sync.Mutex
+	circuits  map[circuitKey]*circuit
+	lastPrune time.Time
+}
+
+var _ balancer.Balancer = (*Balancer)(nil)
+
+type circuitKey struct {
+	peer   balancer.Peer
+	method string
+}
+
+// NewBalancer returns a Balancer with circuit breakers around the peers
+// picked by the given Balancer.
+func NewBalancer(b balancer.Balancer, cfg Config) *Balancer {
+	return &Balancer{
+		b:        b,
+		cfg:      cfg.withDefaults(),
+		now:      time.Now,
+		circuits: make(map[circuitKey]*circuit),
+	}
+}
+
+// prune drops the breakers of peers which the underlying Balancer no longer
+// has, at most once per Window.
+func (b *Balancer) prune(now time.Time) {
+	lister, ok := b.b.(balancer.PeerLister)
+	if !ok {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if now.Sub(b.lastPrune) < b.cfg.Window {
+		return
+	}
+	b.lastPrune = now
+
+	peers := make(map[balancer.Peer]struct{})
+	for _, p := range lister.Peers() {
+		peers[p] = struct{}{}
+	}
+	for key := range b.circuits {
+		if _, ok := peers[key.peer]; !ok {
+			delete(b.circuits, key)
+		}
+	}
+}
+
+// Pick picks a peer from the underlying Balancer whose breaker for the
+// given method lets the request through. It returns ErrOpen if it does not
+// find one within MaxPicks attempts.
+func (b *Balancer) Pick(ctx context.Context, method, shardKey string) (balancer.Peer, error) {
+	b.prune(b.now())
+	for i := 0; i < b.cfg.MaxPicks; i++ {
+		peer, err := b.b.Pick(ctx, method, shardKey)
+		if err != nil {
+			return nil, err
+		}
+
+		c := b.circuit(peer, method)
+		if _, ok := c.allow(b.now(), &b.cfg, false); ok {
+			return trackedPeer{Peer: peer, b: b, c: c}, nil
+		}
+	}
+	return nil, ErrOpen
+}
+
+// State returns the state of the breaker for the given peer and method.
+func (b *Balancer) State(peer balancer.Peer, method string) State {
+	return b.circuit(peer, method).state(b.now(), &b.cfg)
+}
+
+func (b *Balancer) circuit(peer balancer.Peer, method string) *circuit {
+	key := circuitKey{peer: peer, method: method}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	c, ok := b.circuits[key]
+	if !ok {
+		c = &circuit{windowStart: b.now()}
+		b.circuits[key] = c
+	}
+	return c
+}
+
+// trackedPeer records the outcome of requests in the breaker of a peer.
+type trackedPeer struct {
+	balancer.Peer
+
+	b *Balancer
+	c *circuit
+}
+
+func (p trackedPeer) Send(req []byte) ([]byte, error) {
+	start := p.b.now()
+	gen, ok := p.c.allow(start, &p.b.cfg, true)
+	if !ok {
+		return nil, ErrOpen
+	}
+
+	res, err := p.Peer.Send(req)
+	end := p.b.now()
+
+	slow := p.b.cfg.SlowCall > 0 && end.Sub(start) >= p.b.cfg.SlowCall
+	p.c.record(end, gen, err != nil || slow, &p.b.cfg)
+	return res, err
+}
+
+// circuit is the breaker of a single peer and method.
+type circuit struct {
+	mu sync.Mutex
+
+	current State
+
+	// generation is incremented on every change of state. Requests record
+	// the generation they were sent in so that their outcomes only count
+	// towards the state they were let through by.
+	generation uint64
+
+	// Requests and failures seen since windowStart while closed.
+	windowStart time.Time
+	requests    int
+	failures    int
+
+	openedAt time.Time
+
+	// Probing requests let through and succeeded while half-open.
+	probes    int
+	successes int
+}
+
+// state returns the current state of the breaker.
+func (c *circuit) state(now time.Time, cfg *Config) State {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.current == Open && now.Sub(c.openedAt) >= cfg.OpenDuration {
+		return HalfOpen
+	}
+	return c.current
+}
+
+// allow reports whether a request may be sent, and the generation of the
+// breaker it is sent in. If send is true, the request is being sent and
+// counts as a probe if the breaker is half-open. Peers are picked with send
+// set to false so that requests which are picked but never sent do not use
+// up probes.
+func (c *circuit) allow(now time.Time, cfg *Config, send bool) (uint64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	switch c.current {
+	case Open:
+		if now.Sub(c.openedAt) < cfg.OpenDuration {
+			return 0, false
+		}
+		c.setState(HalfOpen)
+		c.probes = 0
+		c.successes = 0
+		fallthrough
+	case HalfOpen:
+		if c.probes >= cfg.HalfOpenProbes {
+			return 0, false
+		}
+		if send {
+			c.probes++
+		}
+	}
+	return c.generation, true
+}
+
+// record records the outcome of a request sent in the given generation.
+func (c *circuit) record(now time.Time, gen uint64, failed bool, cfg *Config) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Requests sent before the breaker last changed state are ignored. In
+	// particular, requests sent while closed do not count as probes.
+	if gen != c.generation {
+		return
+	}
+
+	switch c.current {
+	case Closed:
+		if now.Sub(c.windowStart) >= cfg.Window {
+			c.resetWindow(now)
+		}
+		c.requests++
+		if failed {
+			c.failures++
+		}
+		if c.requests >= cfg.MinRequests &&
+			float64(c.failures) >= cfg.FailureRatio*float64(c.requests) {
+			c.setState(Open)
+			c.openedAt = now
+		}
+	case HalfOpen:
+		if failed {
+			c.setState(Open)
+			c.openedAt = now
+			return
+		}
+		c.successes++
+		if c.successes >= cfg.HalfOpenProbes {
+			c.setState(Closed)
+			c.resetWindow(now)
+		}
+	}
+}
+
+func (c *circuit) setState(s State) {
+	c.current = s
+	c.generation++
+}
+
+func (c *circuit) resetWindow(now time.Time) {
+	c.windowStart = now
+	c.requests = 0
+	c.failures = 0
+}
@@ -0,0 +1,289 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package breaker
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.uber.org/thriftrw/envelope/balancer"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClock struct{ t time.Time }
+
+func newClock() *fakeClock {
+	return &fakeClock{t: time.Unix(0, 0)}
+}
+
+func (c *fakeClock) Now() time.Time { return c.t }
+
+func (c *fakeClock) Add(d time.Duration) { c.t = c.t.Add(d) }
+
+// install makes the given Balancer use this clock.
+func (c *fakeClock) install(b *Balancer) { b.now = c.Now }
+
+// fakePeer fails requests if err is set and advances the clock by delay
+// for each request. If during is set, it is called while the request is in
+// flight.
+type fakePeer struct {
+	name   string
+	err    error
+	delay  time.Duration
+	clock  *fakeClock
+	during func()
+}
+
+func (p *fakePeer) Send(req []byte) ([]byte, error) {
+	if f := p.during; f != nil {
+		f()
+	}
+	p.clock.Add(p.delay)
+	if p.err != nil {
+		return nil, p.err
+	}
+	return []byte(p.name), nil
+}
+
+// send picks a peer for the given method and sends a request to it,
+// returning the name of the peer which responded.
+func send(b *Balancer, method string) (string, error) {
+	peer, err := b.Pick(context.Background(), method, "")
+	if err != nil {
+		return "", err
+	}
+	res, err := peer.Send(nil)
+	return string(res), err
+}
+
+func TestBreakerOpensOnFailures(t *testing.T) {
+	clock := newClock()
+	peer := &fakePeer{name: "a", clock: clock}
+	b := NewBalancer(balancer.NewRoundRobin(peer), Config{
+		MinRequests:  4,
+		FailureRatio: 0.5,
+	})
+	clock.install(b)
+
+	for i := 0; i < 3; i++ {
+		_, err := send(b, "foo")
+		require.NoError(t, err)
+	}
+
+	peer.err = errors.New("great sadness")
+	for i := 0; i < 2; i++ {
+		_, err := send(b, "foo")
+		assert.EqualError(t, err, "great sadness")
+	}
+	assert.Equal(t, Closed, b.State(peer, "foo"), "2 of 5 requests failed")
+
+	_, err := send(b, "foo")
+	assert.EqualError(t, err, "great sadness")
+	assert.Equal(t, Open, b.State(peer, "foo"), "3 of 6 requests failed")
+
+	_, err = send(b, "foo")
+	assert.Equal(t, ErrOpen, err)
+
+	// Breakers are per method.
+	assert.Equal(t, Closed, b.State(peer, "bar"))
+	_, err = send(b, "bar")
+	assert.EqualError(t, err, "great sadness")
+}
+
+func TestBreakerWindow(t *testing.T) {
+	clock := newClock()
+	peer := &fakePeer{name: "a", clock: clock, err: errors.New("great sadness")}
+	b := NewBalancer(balancer.NewRoundRobin(peer), Config{
+		MinRequests: 2,
+		Window:      time.Second,
+	})
+	clock.install(b)
+
+	send(b, "foo")
+	clock.Add(time.Second)
+	send(b, "foo")
+	assert.Equal(t, Closed, b.State(peer, "foo"), "failures were in different windows")
+
+	send(b, "foo")
+	assert.Equal(t, Open, b.State(peer, "foo"))
+}
+
+func TestBreakerSlowCalls(t *testing.T) {
+	clock := newClock()
+	peer := &fakePeer{name: "a", clock: clock, delay: 2 * time.Second}
+	b := NewBalancer(balancer.NewRoundRobin(peer), Config{
+		MinRequests: 2,
+		SlowCall:    time.Second,
+		Window:      time.Minute,
+	})
+	clock.install(b)
+
+	for i := 0; i < 2; i++ {
+		name, err := send(b, "foo")
+		require.NoError(t, err, "slow requests still succeed")
+		assert.Equal(t, "a", name)
+	}
+	assert.Equal(t, Open, b.State(peer, "foo"))
+}
+
+func TestBreakerHalfOpen(t *testing.T) {
+	clock := newClock()
+	peer := &fakePeer{name: "a", clock: clock, err: errors.New("great sadness")}
+	b := NewBalancer(balancer.NewRoundRobin(peer), Config{
+		MinRequests:    1,
+		OpenDuration:   time.Second,
+		HalfOpenProbes: 2,
+	})
+	clock.install(b)
+
+	send(b, "foo")
+	require.Equal(t, Open, b.State(peer, "foo"))
+
+	clock.Add(time.Second)
+	assert.Equal(t, HalfOpen, b.State(peer, "foo"))
+
+	// A failed probe opens the breaker again.
+	_, err := send(b, "foo")
+	assert.EqualError(t, err, "great sadness")
+	assert.Equal(t, Open, b.State(peer, "foo"))
+
+	clock.Add(time.Second)
+	peer.err = nil
+
+	// Picking a peer does not use up a probe.
+	var probes []balancer.Peer
+	for i := 0; i < 3; i++ {
+		p, err := b.Pick(context.Background(), "foo", "")
+		require.NoError(t, err)
+		probes = append(probes, p)
+	}
+
+	// Only HalfOpenProbes requests are sent at a time. The third request is
+	// sent while the first two are in flight.
+	peer.during = func() {
+		peer.during = func() {
+			peer.during = nil
+			_, err := probes[2].Send(nil)
+			assert.Equal(t, ErrOpen, err)
+		}
+		_, err := probes[1].Send(nil)
+		assert.NoError(t, err)
+		assert.Equal(t, HalfOpen, b.State(peer, "foo"))
+	}
+	_, err = probes[0].Send(nil)
+	require.NoError(t, err)
+	assert.Equal(t, Closed, b.State(peer, "foo"))
+
+	_, err = send(b, "foo")
+	assert.NoError(t, err)
+}
+
+func TestBreakerIgnoresRequestsSentBeforeHalfOpen(t *testing.T) {
+	clock := newClock()
+	peer := &fakePeer{name: "a", clock: clock}
+	b := NewBalancer(balancer.NewRoundRobin(peer), Config{
+		MinRequests:  1,
+		OpenDuration: time.Second,
+	})
+	clock.install(b)
+
+	// While the first request is in flight, a second one fails and opens
+	// the breaker, which then becomes half-open.
+	peer.during = func() {
+		peer.during = nil
+		peer.err = errors.New("great sadness")
+		send(b, "foo")
+		require.Equal(t, Open, b.State(peer, "foo"))
+
+		clock.Add(time.Second)
+		_, err := b.Pick(context.Background(), "foo", "")
+		require.NoError(t, err)
+		peer.err = nil
+	}
+	_, err := send(b, "foo")
+	require.NoError(t, err)
+	assert.Equal(t, HalfOpen, b.State(peer, "foo"),
+		"a request sent while closed must not count as a probe")
+
+	_, err = send(b, "foo")
+	require.NoError(t, err)
+	assert.Equal(t, Closed, b.State(peer, "foo"))
+}
+
+func TestBreakerSkipsOpenPeers(t *testing.T) {
+	clock := newClock()
+	bad := &fakePeer{name: "bad", clock: clock, err: errors.New("great sadness")}
+	good := &fakePeer{name: "good", clock: clock}
+	b := NewBalancer(balancer.NewRoundRobin(bad, good), Config{MinRequests: 1})
+	clock.install(b)
+
+	_, err := send(b, "foo")
+	assert.EqualError(t, err, "great sadness")
+	assert.Equal(t, Open, b.State(bad, "foo"))
+
+	for i := 0; i < 4; i++ {
+		name, err := send(b, "foo")
+		require.NoError(t, err)
+		assert.Equal(t, "good", name)
+	}
+}
+
+func TestBreakerForgetsRemovedPeers(t *testing.T) {
+	clock := newClock()
+	a := &fakePeer{name: "a", clock: clock, err: errors.New("great sadness")}
+	c := &fakePeer{name: "c", clock: clock}
+	rr := balancer.NewRoundRobin(a, c)
+	b := NewBalancer(rr, Config{MinRequests: 1, Window: time.Second})
+	clock.install(b)
+
+	send(b, "foo")
+	send(b, "foo")
+	require.Equal(t, Open, b.State(a, "foo"))
+	require.Len(t, b.circuits, 2)
+
+	rr.Update([]balancer.Peer{c})
+	send(b, "foo")
+	assert.Len(t, b.circuits, 2, "breakers must be pruned at most once per window")
+
+	clock.Add(time.Second)
+	name, err := send(b, "foo")
+	require.NoError(t, err)
+	assert.Equal(t, "c", name)
+	assert.Len(t, b.circuits, 1, "breakers of removed peers must be dropped")
+	assert.Contains(t, b.circuits, circuitKey{peer: c, method: "foo"})
+}
+
+func TestBreakerPickError(t *testing.T) {
+	b := NewBalancer(balancer.NewRoundRobin(), Config{})
+	_, err := b.Pick(context.Background(), "foo", "")
+	assert.Equal(t, balancer.ErrNoPeers, err)
+}
+
+func TestStateString(t *testing.T) {
+	assert.Equal(t, "closed", Closed.String())
+	assert.Equal(t, "open", Open.String())
+	assert.Equal(t, "half-open", HalfOpen.String())
+	assert.Equal(t, "unknown", State(42).String())
+}
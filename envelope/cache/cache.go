@@ -0,0 +1,314 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+// Package cache caches the responses of idempotent methods for clients of
+// enveloped Thrift services.
+//
+//   ttls, err := cache.TTLs(serviceSpec)
+//   ...
+//   client = cache.NewClient(client, cache.Config{TTLs: ttls})
+//
+// Responses are cached for the duration configured for their method, and
+// requests to methods without a TTL are always sent. Requests are keyed by
+// their method name and the canonical encoding of their arguments (see
+// canonical.EncodeUntyped) so that requests which differ only in the order
+// of their fields or of the items of their sets and maps share a response.
+//
+// TTLs may be declared in the Thrift file with the thriftrw.cache.ttl
+// annotation, which accepts durations like "500ms" or "1m".
+//
+//   service KeyValue {
+//     string getValue(1: string key) (thriftrw.cache.ttl = "30s")
+//   }
+//
+// Only successful responses are cached. Responses are cached regardless of
+// whether they hold exceptions, so exceptions returned by annotated methods
+// must be as idempotent as their results.
+package cache
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/thriftrw/canonical"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/envelope"
+	"go.uber.org/thriftrw/wire"
+)
+
+// ttlKey is the annotation on functions which specifies how long their
+// responses may be cached.
+const ttlKey = "thriftrw.cache.ttl"
+
+// TTLs returns the TTLs declared with the thriftrw.cache.ttl annotation on
+// the functions of the given service and the services it inherits, keyed by
+// function name. Methods of multiplexed services must be prefixed with the
+// service name and a ":" before they are passed to NewClient.
+//
+// An error is returned if an annotation is not a positive duration or if it
+// is declared on a oneway function.
+func TTLs(s *compile.ServiceSpec) (map[string]time.Duration, error) {
+	functions, err := s.AllFunctions()
+	if err != nil {
+		return nil, err
+	}
+
+	ttls := make(map[string]time.Duration)
+	for name, f := range functions {
+		value, ok := f.Annotations[ttlKey]
+		if !ok {
+			continue
+		}
+		if f.OneWay {
+			return nil, fmt.Errorf(
+				"%v: %v cannot be declared on oneway functions", f.Name, ttlKey)
+		}
+
+		ttl, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, fmt.Errorf("%v: invalid %v %q: %v", f.Name, ttlKey, value, err)
+		}
+		if ttl <= 0 {
+			return nil, fmt.Errorf("%v: %v must be positive, got %q", f.Name, ttlKey, value)
+		}
+		ttls[name] = ttl
+	}
+	return ttls, nil
+}
+
+// Config configures a Cache.
+type Config struct {
+	// TTLs specifies how long responses of each method may be cached.
+	// Responses of methods which are not listed are not cached.
+	TTLs map[string]time.Duration
+
+	// MaxEntries is the number of responses held by the cache, after
+	// which the least recently used responses are evicted. Defaults to
+	// 1024.
+	MaxEntries int
+}
+
+// Cache is a Client which answers requests to idempotent methods with the
+// responses of earlier requests.
+type Cache struct {
+	c          envelope.Client
+	ttls       map[string]time.Duration
+	maxEntries int
+
+	// now returns the current time. It may be replaced in tests.
+	now func() time.Time
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List // of *entry, most recently used first
+}
+
+var _ envelope.ContextClient = (*Cache)(nil)
+
+type entry struct {
+	key      string
+	response wire.Value
+	expires  time.Time
+}
+
+// NewClient returns a Cache which sends requests with the given Client and
+// caches their responses as specified by the given Config.
+func NewClient(c envelope.Client, cfg Config) *Cache {
+	ttls := make(map[string]time.Duration, len(cfg.TTLs))
+	for name, ttl := range cfg.TTLs {
+		if ttl > 0 {
+			ttls[name] = ttl
+		}
+	}
+
+	maxEntries := cfg.MaxEntries
+	if maxEntries <= 0 {
+		maxEntries = 1024
+	}
+
+	return &Cache{
+		c:          c,
+		ttls:       ttls,
+		maxEntries: maxEntries,
+		now:        time.Now,
+		entries:    make(map[string]*list.Element),
+		lru:        list.New(),
+	}
+}
+
+// Send sends the given request unless a response to an identical request
+// to the same method is cached.
+func (c *Cache) Send(name string, body wire.Value) (wire.Value, error) {
+	return c.SendContext(context.Background(), name, body)
+}
+
+// SendContext is Send with a context which is passed on to the wrapped
+// Client if it is an envelope.ContextClient.
+func (c *Cache) SendContext(ctx context.Context, name string, body wire.Value) (wire.Value, error) {
+	ttl, ok := c.ttls[name]
+	if !ok {
+		return envelope.SendContext(ctx, c.c, name, body)
+	}
+
+	args, err := canonical.EncodeUntyped(body)
+	if err != nil {
+		// Requests which cannot be canonicalized are not cached.
+		return envelope.SendContext(ctx, c.c, name, body)
+	}
+	key := name + "\x00" + string(args)
+
+	if res, ok := c.get(key); ok {
+		return clone(res)
+	}
+
+	res, err := envelope.SendContext(ctx, c.c, name, body)
+	if err != nil {
+		return res, err
+	}
+
+	// Decoded responses may hold pooled lists which are closed once they
+	// have been read, so the cache keeps a copy of its own and hands out
+	// copies of that.
+	res, err = clone(res)
+	if err != nil {
+		return wire.Value{}, err
+	}
+	c.put(key, res, ttl)
+	return clone(res)
+}
+
+// Len returns the number of responses held by the cache, including those
+// which have expired but have not yet been evicted.
+func (c *Cache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lru.Len()
+}
+
+// Purge removes all responses from the cache.
+func (c *Cache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries = make(map[string]*list.Element)
+	c.lru.Init()
+}
+
+func (c *Cache) get(key string) (wire.Value, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.entries[key]
+	if !ok {
+		return wire.Value{}, false
+	}
+
+	e := el.Value.(*entry)
+	if !c.now().Before(e.expires) {
+		c.remove(el)
+		return wire.Value{}, false
+	}
+
+	c.lru.MoveToFront(el)
+	return e.response, true
+}
+
+func (c *Cache) put(key string, res wire.Value, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e := &entry{key: key, response: res, expires: c.now().Add(ttl)}
+	if el, ok := c.entries[key]; ok {
+		el.Value = e
+		c.lru.MoveToFront(el)
+		return
+	}
+
+	c.entries[key] = c.lru.PushFront(e)
+	for c.lru.Len() > c.maxEntries {
+		c.remove(c.lru.Back())
+	}
+}
+
+func (c *Cache) remove(el *list.Element) {
+	c.lru.Remove(el)
+	delete(c.entries, el.Value.(*entry).key)
+}
+
+// clone returns a deep copy of the given value with its lists, sets, and
+// maps read into slices. The lists, sets, and maps of the given value are
+// closed.
+func clone(v wire.Value) (wire.Value, error) {
+	switch v.Type() {
+	case wire.TBinary:
+		return wire.NewValueBinary(append([]byte{}, v.GetBinary()...)), nil
+	case wire.TStruct:
+		fields := make([]wire.Field, len(v.GetStruct().Fields))
+		for i, f := range v.GetStruct().Fields {
+			fv, err := clone(f.Value)
+			if err != nil {
+				return wire.Value{}, err
+			}
+			fields[i] = wire.Field{ID: f.ID, Value: fv}
+		}
+		return wire.NewValueStruct(wire.Struct{Fields: fields}), nil
+	case wire.TMap:
+		m := v.GetMap()
+		defer m.Close()
+
+		items := make([]wire.MapItem, 0, m.Size())
+		err := m.ForEach(func(item wire.MapItem) error {
+			k, err := clone(item.Key)
+			if err != nil {
+				return err
+			}
+			v, err := clone(item.Value)
+			items = append(items, wire.MapItem{Key: k, Value: v})
+			return err
+		})
+		return wire.NewValueMap(wire.MapItemListFromSlice(m.KeyType(), m.ValueType(), items)), err
+	case wire.TSet:
+		s := v.GetSet()
+		defer s.Close()
+
+		items, err := cloneValues(s)
+		return wire.NewValueSet(wire.ValueListFromSlice(s.ValueType(), items)), err
+	case wire.TList:
+		l := v.GetList()
+		defer l.Close()
+
+		items, err := cloneValues(l)
+		return wire.NewValueList(wire.ValueListFromSlice(l.ValueType(), items)), err
+	default:
+		return v, nil
+	}
+}
+
+// cloneValues returns deep copies of the items of the given ValueList.
+func cloneValues(l wire.ValueList) ([]wire.Value, error) {
+	items := make([]wire.Value, 0, l.Size())
+	err := l.ForEach(func(v wire.Value) error {
+		c, err := clone(v)
+		items = append(items, c)
+		return err
+	})
+	return items, err
+}
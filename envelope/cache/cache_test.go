@@ -0,0 +1,271 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package cache
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/envelope"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func request(fields ...wire.Field) wire.Value {
+	return wire.NewValueStruct(wire.Struct{Fields: fields})
+}
+
+func field(id int16, s string) wire.Field {
+	return wire.Field{ID: id, Value: wire.NewValueString(s)}
+}
+
+// countingClient responds to each request with a struct holding the number
+// of requests it has received.
+type countingClient struct {
+	calls int
+	err   error
+}
+
+func (c *countingClient) Send(name string, body wire.Value) (wire.Value, error) {
+	c.calls++
+	if c.err != nil {
+		return wire.Value{}, c.err
+	}
+	return request(wire.Field{ID: 0, Value: wire.NewValueI32(int32(c.calls))}), nil
+}
+
+// decodingClient responds to each request with a response decoded with the
+// Binary protocol, like clients which read responses from the network.
+type decodingClient struct{ response []byte }
+
+func (c decodingClient) Send(string, wire.Value) (wire.Value, error) {
+	return protocol.Binary.Decode(bytes.NewReader(c.response), wire.TStruct)
+}
+
+func newTestCache(c envelope.Client, cfg Config) (*Cache, *time.Time) {
+	now := time.Unix(1500000000, 0)
+	cache := NewClient(c, cfg)
+	cache.now = func() time.Time { return now }
+	return cache, &now
+}
+
+func TestCache(t *testing.T) {
+	client := &countingClient{}
+	cache, now := newTestCache(client, Config{
+		TTLs: map[string]time.Duration{"getValue": time.Minute},
+	})
+
+	send := func(name string, body wire.Value) wire.Value {
+		res, err := cache.Send(name, body)
+		require.NoError(t, err)
+		return res
+	}
+
+	first := send("getValue", request(field(1, "foo"), field(2, "bar")))
+	assert.Equal(t, 1, client.calls)
+
+	// Requests which differ only in the order of their fields hit the
+	// cache.
+	got := send("getValue", request(field(2, "bar"), field(1, "foo")))
+	assert.Equal(t, 1, client.calls)
+	assert.True(t, wire.ValuesAreEqual(first, got), "expected %v, got %v", first, got)
+
+	send("getValue", request(field(1, "baz")))
+	assert.Equal(t, 2, client.calls, "different arguments must not hit the cache")
+
+	send("setValue", request(field(1, "foo"), field(2, "bar")))
+	send("setValue", request(field(1, "foo"), field(2, "bar")))
+	assert.Equal(t, 4, client.calls, "methods without TTLs must not be cached")
+
+	*now = now.Add(59 * time.Second)
+	send("getValue", request(field(1, "foo"), field(2, "bar")))
+	assert.Equal(t, 4, client.calls)
+
+	*now = now.Add(time.Second)
+	send("getValue", request(field(1, "foo"), field(2, "bar")))
+	assert.Equal(t, 5, client.calls, "expired responses must not be used")
+
+	assert.Equal(t, 2, cache.Len())
+	cache.Purge()
+	assert.Equal(t, 0, cache.Len())
+	send("getValue", request(field(1, "baz")))
+	assert.Equal(t, 6, client.calls)
+}
+
+func TestCacheDecodedResponses(t *testing.T) {
+	var buff bytes.Buffer
+	require.NoError(t, protocol.Binary.Encode(request(wire.Field{
+		ID: 0,
+		Value: wire.NewValueList(wire.ValueListFromSlice(wire.TBinary, []wire.Value{
+			wire.NewValueString("foo"),
+			wire.NewValueString("bar"),
+		})),
+	}), &buff))
+
+	cache, _ := newTestCache(decodingClient{response: buff.Bytes()}, Config{
+		TTLs: map[string]time.Duration{"getValue": time.Minute},
+	})
+
+	for i := 0; i < 3; i++ {
+		res, err := cache.Send("getValue", request())
+		require.NoError(t, err)
+
+		// Read and close the list like the FromWire methods of generated
+		// code do.
+		var got []string
+		l := res.GetStruct().Fields[0].Value.GetList()
+		require.NoError(t, l.ForEach(func(v wire.Value) error {
+			got = append(got, v.GetString())
+			return nil
+		}))
+		l.Close()
+
+		assert.Equal(t, []string{"foo", "bar"}, got, "response %v", i)
+	}
+}
+
+func TestCacheErrors(t *testing.T) {
+	client := &countingClient{err: errors.New("great sadness")}
+	cache, _ := newTestCache(client, Config{
+		TTLs: map[string]time.Duration{"getValue": time.Minute},
+	})
+
+	for i := 0; i < 2; i++ {
+		_, err := cache.Send("getValue", request(field(1, "foo")))
+		assert.EqualError(t, err, "great sadness")
+	}
+	assert.Equal(t, 2, client.calls, "errors must not be cached")
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestCacheEviction(t *testing.T) {
+	client := &countingClient{}
+	cache, _ := newTestCache(client, Config{
+		TTLs:       map[string]time.Duration{"getValue": time.Minute},
+		MaxEntries: 2,
+	})
+
+	send := func(key string) {
+		_, err := cache.Send("getValue", request(field(1, key)))
+		require.NoError(t, err)
+	}
+
+	send("a")
+	send("b")
+	send("a") // a is now the most recently used
+	send("c") // evicts b
+	assert.Equal(t, 3, client.calls)
+	assert.Equal(t, 2, cache.Len())
+
+	send("a")
+	send("c")
+	assert.Equal(t, 3, client.calls)
+
+	send("b")
+	assert.Equal(t, 4, client.calls)
+}
+
+func compileService(t *testing.T, contents string) *compile.ServiceSpec {
+	dir, err := ioutil.TempDir("", "cache-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "test.thrift")
+	require.NoError(t, ioutil.WriteFile(path, []byte(contents), 0644))
+
+	m, err := compile.Compile(path)
+	require.NoError(t, err)
+	return m.Services["KeyValue"]
+}
+
+func TestTTLs(t *testing.T) {
+	tests := []struct {
+		desc    string
+		thrift  string
+		want    map[string]time.Duration
+		wantErr string
+	}{
+		{
+			desc: "annotated",
+			thrift: `
+				service Base {
+					string healthy() (thriftrw.cache.ttl = "1s")
+				}
+				service KeyValue extends Base {
+					string getValue(1: string key) (thriftrw.cache.ttl = "500ms")
+					void setValue(1: string key, 2: string value)
+				}
+			`,
+			want: map[string]time.Duration{
+				"healthy":  time.Second,
+				"getValue": 500 * time.Millisecond,
+			},
+		},
+		{
+			desc: "invalid duration",
+			thrift: `
+				service KeyValue {
+					string getValue(1: string key) (thriftrw.cache.ttl = "forever")
+				}
+			`,
+			wantErr: `getValue: invalid thriftrw.cache.ttl "forever"`,
+		},
+		{
+			desc: "negative duration",
+			thrift: `
+				service KeyValue {
+					string getValue(1: string key) (thriftrw.cache.ttl = "-1s")
+				}
+			`,
+			wantErr: `getValue: thriftrw.cache.ttl must be positive, got "-1s"`,
+		},
+		{
+			desc: "oneway",
+			thrift: `
+				service KeyValue {
+					oneway void ping() (thriftrw.cache.ttl = "1s")
+				}
+			`,
+			wantErr: "ping: thriftrw.cache.ttl cannot be declared on oneway functions",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			ttls, err := TTLs(compileService(t, tt.thrift))
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, ttls)
+		})
+	}
+}
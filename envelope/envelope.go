@@ -21,14 +21,64 @@
 package envelope
 
 import (
+	"context"
 	"fmt"
 	"io"
+	"math"
 
 	"go.uber.org/thriftrw/internal/envelope/exception"
 	"go.uber.org/thriftrw/protocol"
 	"go.uber.org/thriftrw/wire"
 )
 
+// Handler handles enveloped requests. The middleware in the subpackages of
+// envelope wrap Handlers.
+type Handler interface {
+	// Handle handles a request to the method with the given envelope name
+	// and returns the response body.
+	Handle(name string, body wire.Value) (wire.Value, error)
+}
+
+// Client sends enveloped requests. The middleware in the subpackages of
+// envelope wrap Clients.
+type Client interface {
+	// Send sends a request to the method with the given envelope name and
+	// returns the response body.
+	Send(name string, body wire.Value) (wire.Value, error)
+}
+
+// ContextClient is a Client which may also send requests with a context.
+// The Clients returned by the middleware in the subpackages of envelope are
+// ContextClients, and pass the context on to the Clients they wrap with
+// SendContext.
+type ContextClient interface {
+	Client
+
+	// SendContext sends a request to the method with the given envelope
+	// name with the given context and returns the response body.
+	SendContext(ctx context.Context, name string, body wire.Value) (wire.Value, error)
+}
+
+// SendContext sends a request with the given Client. The request is sent
+// with the given context if the Client is a ContextClient, and without it
+// otherwise.
+func SendContext(ctx context.Context, c Client, name string, body wire.Value) (wire.Value, error) {
+	if cc, ok := c.(ContextClient); ok {
+		return cc.SendContext(ctx, name, body)
+	}
+	return c.Send(name, body)
+}
+
+// IDs of the fields which the middleware in the subpackages of envelope add
+// to request structs. The IDL does not allow negative field IDs so they
+// cannot conflict with declared fields, and each middleware uses its own so
+// that they may be used together.
+const (
+	SignatureFieldID int16 = math.MinInt16 + iota
+	FingerprintFieldID
+	MetadataFieldID
+)
+
 // Enveloper is the interface implemented by a type that can be written with
 // an envelope.
 type Enveloper interface {
@@ -22,18 +22,25 @@ package envelope_test
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"testing"
+	"time"
 
 	// Use . import so the generated Thrift code can import envelope
 	// without causing a circular dependency.
 	. "go.uber.org/thriftrw/envelope"
 
+	"go.uber.org/thriftrw/envelope/cache"
+	"go.uber.org/thriftrw/envelope/fingerprint"
+	"go.uber.org/thriftrw/envelope/metadata"
+	"go.uber.org/thriftrw/envelope/signature"
 	tv "go.uber.org/thriftrw/gen/testdata/services"
 	"go.uber.org/thriftrw/protocol"
 	"go.uber.org/thriftrw/wire"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type failToWire struct {
@@ -200,3 +207,45 @@ func TestReadReply(t *testing.T) {
 		assert.Equal(t, tt.wantSeqID, seqID, "%v: seqID mismatch", tt.desc)
 	}
 }
+
+type contextClientFunc func(context.Context, string, wire.Value) (wire.Value, error)
+
+func (f contextClientFunc) Send(name string, body wire.Value) (wire.Value, error) {
+	return f(context.Background(), name, body)
+}
+
+func (f contextClientFunc) SendContext(ctx context.Context, name string, body wire.Value) (wire.Value, error) {
+	return f(ctx, name, body)
+}
+
+func TestMiddlewarePassesContextOn(t *testing.T) {
+	type key struct{}
+
+	var got context.Context
+	var client ContextClient = contextClientFunc(
+		func(ctx context.Context, _ string, _ wire.Value) (wire.Value, error) {
+			got = ctx
+			return wire.NewValueStruct(wire.Struct{}), nil
+		})
+
+	// Each middleware must pass the context on to whatever it wraps so
+	// the metadata client at the bottom sees the deadline set at the top.
+	client = metadata.NewClient(client, "users-api")
+	client = fingerprint.NewClient(client, fingerprint.Fingerprints{"getValue": 42})
+	client = signature.NewClient(client, "k", signature.StaticKeys{"k": []byte("secret")})
+	client = cache.NewClient(client, cache.Config{
+		TTLs: map[string]time.Duration{"getValue": time.Minute},
+	})
+
+	ctx, cancel := context.WithTimeout(
+		context.WithValue(context.Background(), key{}, "foo"), time.Minute)
+	defer cancel()
+
+	_, err := client.SendContext(ctx, "getValue", wire.NewValueStruct(wire.Struct{}))
+	require.NoError(t, err)
+	require.NotNil(t, got, "request must be sent with its context")
+	assert.Equal(t, "foo", got.Value(key{}))
+
+	_, ok := got.Deadline()
+	assert.True(t, ok, "deadline must be passed on")
+}
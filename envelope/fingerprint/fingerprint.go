@@ -0,0 +1,192 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package fingerprint lets servers detect clients which were built from a
+// different version of a Thrift service before they exchange data that the
+// other side may misinterpret.
+//
+// Clients attach the fingerprint of the schema of each method they call to
+// their requests, and servers compare it with the fingerprint of their own
+// schema for the method. A mismatch means that the arguments, the result, or
+// the exceptions of the method were changed on one side and not the other.
+//
+//   fps := fingerprint.Service(module.Services["KeyValue"])
+//   client = fingerprint.NewClient(client, fps)
+//   handler = fingerprint.NewHandler(handler, fps, fingerprint.Config{
+//     Report: func(m fingerprint.Mismatch) {
+//       log.Printf("schema of %v differs: %v != %v", m.Method, m.Got, m.Want)
+//     },
+//   })
+//
+// The fingerprint is carried in an extra field of the request struct with
+// the ID FieldID. Servers that do not check fingerprints ignore it like any
+// other unknown field, and requests without fingerprints are passed on
+// unchecked so that clients and servers may adopt fingerprints separately.
+package fingerprint
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"sort"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/envelope"
+	"go.uber.org/thriftrw/protocol/dictionary"
+	"go.uber.org/thriftrw/wire"
+)
+
+// FieldID is the ID of the field of a request struct which holds its
+// fingerprint.
+const FieldID = envelope.FingerprintFieldID
+
+// Fingerprints maps envelope names of methods to the fingerprints of their
+// schemas.
+type Fingerprints map[string]dictionary.Fingerprint
+
+// Service returns the fingerprints of all functions of the given service,
+// including those inherited from its parents, keyed by their names.
+func Service(spec *compile.ServiceSpec) Fingerprints {
+	fps := make(Fingerprints)
+	for s := spec; s != nil; s = s.Parent {
+		for name, f := range s.Functions {
+			fps[name] = Function(f)
+		}
+	}
+	return fps
+}
+
+// Multiplexed returns a copy of these fingerprints keyed by the envelope
+// names used for the methods of a multiplexed service with the given name.
+//
+//   getValue -> KeyValue:getValue
+func (fs Fingerprints) Multiplexed(service string) Fingerprints {
+	m := make(Fingerprints, len(fs))
+	for name, fp := range fs {
+		m[service+":"+name] = fp
+	}
+	return m
+}
+
+// Function returns the fingerprint of the schema of the given function.
+//
+// The fingerprint covers the name of the function, whether it is oneway, and
+// the IDs, requiredness, and fingerprints (see dictionary.FingerprintOf) of
+// the types of its arguments, return value, and exceptions.
+func Function(f *compile.FunctionSpec) dictionary.Fingerprint {
+	h := fnv.New64a()
+	writeString(h, f.Name)
+	writeBool(h, f.OneWay)
+	writeFields(h, compile.FieldGroup(f.ArgsSpec))
+
+	if f.ResultSpec != nil {
+		writeBool(h, f.ResultSpec.ReturnType != nil)
+		if f.ResultSpec.ReturnType != nil {
+			writeUint64(h, uint64(dictionary.FingerprintOf(f.ResultSpec.ReturnType)))
+		}
+		writeFields(h, f.ResultSpec.Exceptions)
+	}
+	return dictionary.Fingerprint(h.Sum64())
+}
+
+// writeFields writes the given fields to the hash in the order of their IDs.
+func writeFields(h hash.Hash64, fields compile.FieldGroup) {
+	sorted := make([]*compile.FieldSpec, len(fields))
+	copy(sorted, fields)
+	sort.Sort(byFieldID(sorted))
+
+	writeUint64(h, uint64(len(sorted)))
+	for _, f := range sorted {
+		writeUint64(h, uint64(uint16(f.ID)))
+		writeBool(h, f.Required)
+		writeUint64(h, uint64(dictionary.FingerprintOf(f.Type)))
+	}
+}
+
+func writeString(h hash.Hash64, s string) {
+	writeUint64(h, uint64(len(s)))
+	h.Write([]byte(s))
+}
+
+func writeBool(h hash.Hash64, b bool) {
+	if b {
+		h.Write([]byte{1})
+	} else {
+		h.Write([]byte{0})
+	}
+}
+
+func writeUint64(h hash.Hash64, n uint64) {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], n)
+	h.Write(buf[:])
+}
+
+type byFieldID []*compile.FieldSpec
+
+func (fs byFieldID) Len() int           { return len(fs) }
+func (fs byFieldID) Less(i, j int) bool { return fs[i].ID < fs[j].ID }
+func (fs byFieldID) Swap(i, j int)      { fs[i], fs[j] = fs[j], fs[i] }
+
+// Attach returns the given request struct with the given fingerprint. A
+// fingerprint previously attached to the request is replaced.
+func Attach(body wire.Value, fp dictionary.Fingerprint) (wire.Value, error) {
+	fields, _, _, err := split(body)
+	if err != nil {
+		return body, err
+	}
+
+	fields = append(fields, wire.Field{ID: FieldID, Value: wire.NewValueI64(int64(fp))})
+	return wire.NewValueStruct(wire.Struct{Fields: fields}), nil
+}
+
+// Extract returns the fingerprint attached to the given request struct, if
+// any, and the request without it. ok is false if the request does not have
+// a fingerprint.
+func Extract(body wire.Value) (_ wire.Value, fp dictionary.Fingerprint, ok bool, err error) {
+	fields, fp, ok, err := split(body)
+	if err != nil || !ok {
+		return body, fp, ok, err
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields}), fp, true, nil
+}
+
+// split returns the fields of the given request struct without its
+// fingerprint, and its fingerprint if it has one.
+func split(body wire.Value) (fields []wire.Field, fp dictionary.Fingerprint, ok bool, err error) {
+	if body.Type() != wire.TStruct {
+		return nil, 0, false, fmt.Errorf("requests must be structs: got %v", body.Type())
+	}
+
+	for _, f := range body.GetStruct().Fields {
+		if f.ID != FieldID {
+			fields = append(fields, f)
+			continue
+		}
+
+		if f.Value.Type() != wire.TI64 {
+			return nil, 0, false, fmt.Errorf(
+				"field %v of the request must be an i64 fingerprint: got %v", FieldID, f.Value.Type())
+		}
+		fp, ok = dictionary.Fingerprint(f.Value.GetI64()), true
+	}
+	return fields, fp, ok, nil
+}
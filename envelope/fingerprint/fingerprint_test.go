@@ -0,0 +1,331 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fingerprint
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/envelope"
+	internalenvelope "go.uber.org/thriftrw/internal/envelope"
+	"go.uber.org/thriftrw/internal/envelope/envelopetest"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// compileService compiles the given Thrift source and returns the service
+// with the given name.
+func compileService(t *testing.T, src, name string) *compile.ServiceSpec {
+	dir, err := ioutil.TempDir("", "thriftrw-fingerprint-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "service.thrift")
+	require.NoError(t, ioutil.WriteFile(path, []byte(src), 0644))
+
+	m, err := compile.Compile(path)
+	require.NoError(t, err)
+	return m.Services[name]
+}
+
+const baseService = `
+	exception NotFound {}
+
+	struct Item {
+		1: required string key
+		2: optional binary value
+	}
+
+	service KeyValue {
+		Item getValue(1: string key) throws (1: NotFound notFound)
+		void setValue(1: Item item)
+		oneway void flush()
+	}
+`
+
+func TestFunction(t *testing.T) {
+	base := Service(compileService(t, baseService, "KeyValue"))
+	require.Len(t, base, 3)
+	assert.NotEqual(t, base["getValue"], base["setValue"])
+
+	tests := []struct {
+		desc string
+		src  string
+
+		// Methods whose fingerprints must differ from baseService.
+		changed []string
+	}{
+		{
+			desc: "unchanged",
+			src:  baseService,
+		},
+		{
+			desc: "renamed argument",
+			src: `
+				exception NotFound {}
+				struct Item {
+					1: required string key
+					2: optional binary value
+				}
+				service KeyValue {
+					Item getValue(1: string name) throws (1: NotFound missing)
+					void setValue(1: Item item)
+					oneway void flush()
+				}
+			`,
+		},
+		{
+			desc: "argument type changed",
+			src: `
+				exception NotFound {}
+				struct Item {
+					1: required string key
+					2: optional binary value
+				}
+				service KeyValue {
+					Item getValue(1: i64 key) throws (1: NotFound notFound)
+					void setValue(1: Item item)
+					oneway void flush()
+				}
+			`,
+			changed: []string{"getValue"},
+		},
+		{
+			desc: "struct field changed",
+			src: `
+				exception NotFound {}
+				struct Item {
+					1: required string key
+					2: optional string value
+				}
+				service KeyValue {
+					Item getValue(1: string key) throws (1: NotFound notFound)
+					void setValue(1: Item item)
+					oneway void flush()
+				}
+			`,
+			changed: []string{"getValue", "setValue"},
+		},
+		{
+			desc: "exception added",
+			src: `
+				exception NotFound {}
+				exception Unavailable {}
+				struct Item {
+					1: required string key
+					2: optional binary value
+				}
+				service KeyValue {
+					Item getValue(1: string key) throws (1: NotFound notFound)
+					void setValue(1: Item item) throws (1: Unavailable unavailable)
+					oneway void flush()
+				}
+			`,
+			changed: []string{"setValue"},
+		},
+		{
+			desc: "no longer oneway",
+			src: `
+				exception NotFound {}
+				struct Item {
+					1: required string key
+					2: optional binary value
+				}
+				service KeyValue {
+					Item getValue(1: string key) throws (1: NotFound notFound)
+					void setValue(1: Item item)
+					void flush()
+				}
+			`,
+			changed: []string{"flush"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got := Service(compileService(t, tt.src, "KeyValue"))
+			changed := make(map[string]struct{})
+			for _, name := range tt.changed {
+				changed[name] = struct{}{}
+			}
+
+			for name, fp := range base {
+				if _, ok := changed[name]; ok {
+					assert.NotEqual(t, fp, got[name], "fingerprint of %q must change", name)
+				} else {
+					assert.Equal(t, fp, got[name], "fingerprint of %q must not change", name)
+				}
+			}
+		})
+	}
+}
+
+func TestServiceInheritance(t *testing.T) {
+	src := `
+		service Base {
+			void ping()
+			string version()
+		}
+		service KeyValue extends Base {
+			void setValue(1: string key)
+		}
+	`
+	base := Service(compileService(t, src, "Base"))
+	fps := Service(compileService(t, src, "KeyValue"))
+
+	assert.Len(t, fps, 3)
+	assert.Equal(t, base["ping"], fps["ping"], "functions of parents must be included")
+	assert.Equal(t, base["version"], fps["version"], "functions of parents must be included")
+
+	multiplexed := fps.Multiplexed("KeyValue")
+	assert.Len(t, multiplexed, 3)
+	assert.Equal(t, fps["setValue"], multiplexed["KeyValue:setValue"])
+}
+
+func request(fields ...wire.Field) wire.Value {
+	return wire.NewValueStruct(wire.Struct{Fields: fields})
+}
+
+func TestAttachAndExtract(t *testing.T) {
+	body := request(wire.Field{ID: 1, Value: wire.NewValueString("foo")})
+
+	attached, err := Attach(body, 42)
+	require.NoError(t, err)
+	assert.Len(t, attached.GetStruct().Fields, 2)
+
+	reattached, err := Attach(attached, 43)
+	require.NoError(t, err)
+	assert.Len(t, reattached.GetStruct().Fields, 2, "fingerprints must be replaced")
+
+	got, fp, ok, err := Extract(reattached)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, 43, int(fp))
+	assert.True(t, wire.ValuesAreEqual(body, got), "expected %v, got %v", body, got)
+
+	_, _, ok, err = Extract(body)
+	require.NoError(t, err)
+	assert.False(t, ok, "requests without fingerprints must not have one")
+
+	_, _, _, err = Extract(request(wire.Field{ID: FieldID, Value: wire.NewValueString("foo")}))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "must be an i64 fingerprint")
+	}
+
+	_, err = Attach(wire.NewValueString("foo"), 42)
+	if assert.Error(t, err) {
+		assert.Equal(t, "requests must be structs: got TBinary", err.Error())
+	}
+}
+
+type handlerFunc func(string, wire.Value) (wire.Value, error)
+
+func (f handlerFunc) Handle(name string, body wire.Value) (wire.Value, error) {
+	return f(name, body)
+}
+
+func TestClientAndHandler(t *testing.T) {
+	body := request(wire.Field{ID: 1, Value: wire.NewValueString("foo")})
+	reply := request(wire.Field{ID: 0, Value: wire.NewValueString("bar")})
+	serverFps := Fingerprints{"getValue": 1}
+
+	newClient := func(cfg Config) envelope.Client {
+		server := internalenvelope.NewServer(protocol.Binary, NewHandler(
+			handlerFunc(func(name string, got wire.Value) (wire.Value, error) {
+				assert.True(t, wire.ValuesAreEqual(body, got), "expected %v, got %v", body, got)
+				return reply, nil
+			}), serverFps, cfg))
+		return internalenvelope.NewClient(protocol.Binary, envelopetest.TransportFunc(server.Handle))
+	}
+
+	tests := []struct {
+		desc   string
+		fps    Fingerprints
+		method string
+		reject bool
+
+		wantMismatch bool
+		wantErr      string
+	}{
+		{
+			desc:   "match",
+			fps:    Fingerprints{"getValue": 1},
+			method: "getValue",
+		},
+		{
+			desc:   "no fingerprint",
+			method: "getValue",
+			reject: true,
+		},
+		{
+			desc:   "unknown to the server",
+			fps:    Fingerprints{"setValue": 2},
+			method: "setValue",
+			reject: true,
+		},
+		{
+			desc:         "mismatch reported",
+			fps:          Fingerprints{"getValue": 2},
+			method:       "getValue",
+			wantMismatch: true,
+		},
+		{
+			desc:         "mismatch rejected",
+			fps:          Fingerprints{"getValue": 2},
+			method:       "getValue",
+			reject:       true,
+			wantMismatch: true,
+			wantErr: `schema of "getValue" does not match: ` +
+				"client has fingerprint 0000000000000002, server has 0000000000000001",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var mismatches []Mismatch
+			client := NewClient(newClient(Config{
+				Report: func(m Mismatch) { mismatches = append(mismatches, m) },
+				Reject: tt.reject,
+			}), tt.fps)
+
+			got, err := client.Send(tt.method, body)
+			if tt.wantErr != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tt.wantErr)
+				}
+			} else if assert.NoError(t, err) {
+				assert.True(t, wire.ValuesAreEqual(reply, got), "expected %v, got %v", reply, got)
+			}
+
+			if tt.wantMismatch {
+				assert.Equal(t, []Mismatch{{Method: tt.method, Want: 1, Got: 2}}, mismatches)
+			} else {
+				assert.Empty(t, mismatches)
+			}
+		})
+	}
+}
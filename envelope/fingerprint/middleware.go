@@ -0,0 +1,129 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package fingerprint
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/thriftrw/envelope"
+	"go.uber.org/thriftrw/protocol/dictionary"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Mismatch is a request whose fingerprint did not match the fingerprint of
+// the schema of the server for its method.
+type Mismatch struct {
+	// Envelope name of the method.
+	Method string
+
+	// Fingerprint of the schema of the server.
+	Want dictionary.Fingerprint
+
+	// Fingerprint attached to the request by the client.
+	Got dictionary.Fingerprint
+}
+
+// MismatchError is returned by Handlers configured to reject requests whose
+// fingerprints do not match.
+type MismatchError struct {
+	Mismatch
+}
+
+func (e *MismatchError) Error() string {
+	return fmt.Sprintf(
+		"schema of %q does not match: client has fingerprint %v, server has %v",
+		e.Method, e.Got, e.Want)
+}
+
+// Config configures how Handlers treat requests whose fingerprints do not
+// match.
+type Config struct {
+	// Report, if non-nil, is called with every mismatch. Servers may use it
+	// to emit metrics or logs. It is called synchronously and must be safe
+	// for concurrent use.
+	Report func(Mismatch)
+
+	// Reject mismatched requests with a MismatchError instead of passing
+	// them on.
+	Reject bool
+}
+
+// NewHandler returns a Handler which compares the fingerprints attached to
+// requests with the given fingerprints before passing the requests, without
+// their fingerprints, to the given Handler.
+//
+// Requests without fingerprints and requests to methods without
+// fingerprints are passed on unchecked.
+func NewHandler(h envelope.Handler, fps Fingerprints, cfg Config) envelope.Handler {
+	return checkingHandler{h: h, fps: fps, cfg: cfg}
+}
+
+type checkingHandler struct {
+	h   envelope.Handler
+	fps Fingerprints
+	cfg Config
+}
+
+func (ch checkingHandler) Handle(name string, body wire.Value) (wire.Value, error) {
+	body, got, ok, err := Extract(body)
+	if err != nil {
+		return wire.Value{}, err
+	}
+
+	if want, known := ch.fps[name]; ok && known && got != want {
+		m := Mismatch{Method: name, Want: want, Got: got}
+		if ch.cfg.Report != nil {
+			ch.cfg.Report(m)
+		}
+		if ch.cfg.Reject {
+			return wire.Value{}, &MismatchError{Mismatch: m}
+		}
+	}
+	return ch.h.Handle(name, body)
+}
+
+// NewClient returns a Client which attaches the given fingerprints to
+// requests before sending them with the given Client. Requests to methods
+// without fingerprints are sent unchanged.
+func NewClient(c envelope.Client, fps Fingerprints) envelope.ContextClient {
+	return attachingClient{c: c, fps: fps}
+}
+
+type attachingClient struct {
+	c   envelope.Client
+	fps Fingerprints
+}
+
+func (ac attachingClient) Send(name string, body wire.Value) (wire.Value, error) {
+	return ac.SendContext(context.Background(), name, body)
+}
+
+func (ac attachingClient) SendContext(ctx context.Context, name string, body wire.Value) (wire.Value, error) {
+	if fp, ok := ac.fps[name]; ok {
+		var err error
+		body, err = Attach(body, fp)
+		if err != nil {
+			return wire.Value{}, err
+		}
+	}
+	return envelope.SendContext(ctx, ac.c, name, body)
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package envelope
+
+import "fmt"
+
+// SizeLimit is the maximum size in bytes of the encoded requests and
+// responses of a method, including their envelopes. Zero means that the
+// size is not limited.
+type SizeLimit struct {
+	Request  int
+	Response int
+}
+
+// SizeLimits configures the sizes of requests and responses a server
+// accepts.
+type SizeLimits struct {
+	// Limits for methods not listed in Methods.
+	Default SizeLimit
+
+	// Limits for specific methods, keyed by envelope name.
+	Methods map[string]SizeLimit
+}
+
+// For returns the limits for the method with the given envelope name.
+func (l SizeLimits) For(name string) SizeLimit {
+	if limit, ok := l.Methods[name]; ok {
+		return limit
+	}
+	return l.Default
+}
+
+// MaxRequest returns the size of the largest request accepted for any
+// method, or zero if the size of requests to some method is not limited.
+//
+// This may be used to reject requests from the length prefixes of their
+// frames before they are read.
+func (l SizeLimits) MaxRequest() int {
+	max := l.Default.Request
+	if max == 0 {
+		return 0
+	}
+
+	for _, limit := range l.Methods {
+		if limit.Request == 0 {
+			return 0
+		}
+		if limit.Request > max {
+			max = limit.Request
+		}
+	}
+	return max
+}
+
+// ErrTooLarge is raised by servers for requests and responses which exceed
+// their size limits.
+type ErrTooLarge struct {
+	Method   string
+	Response bool // whether the response was too large
+	Size     int
+	Limit    int
+}
+
+func (e ErrTooLarge) Error() string {
+	kind := "request"
+	if e.Response {
+		kind = "response"
+	}
+	return fmt.Sprintf("%v for %q of %d bytes exceeds limit of %d bytes",
+		kind, e.Method, e.Size, e.Limit)
+}
+
+// SizeStats holds statistics about the sizes of the requests and responses
+// of a method.
+type SizeStats struct {
+	Requests        int64 // number of requests handled
+	RequestBytes    int64 // total size of requests
+	MaxRequestSize  int   // size of the largest request
+	ResponseBytes   int64 // total size of responses
+	MaxResponseSize int   // size of the largest response
+
+	// Number of requests rejected because their request or response was
+	// too large.
+	Rejected int64
+}
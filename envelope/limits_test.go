@@ -0,0 +1,84 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package envelope
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSizeLimitsFor(t *testing.T) {
+	l := SizeLimits{
+		Default: SizeLimit{Request: 100},
+		Methods: map[string]SizeLimit{"upload": {Request: 1000, Response: 10}},
+	}
+
+	assert.Equal(t, SizeLimit{Request: 100}, l.For("download"))
+	assert.Equal(t, SizeLimit{Request: 1000, Response: 10}, l.For("upload"))
+}
+
+func TestSizeLimitsMaxRequest(t *testing.T) {
+	tests := []struct {
+		desc string
+		give SizeLimits
+		want int
+	}{
+		{desc: "empty", want: 0},
+		{
+			desc: "default only",
+			give: SizeLimits{Default: SizeLimit{Request: 100}},
+			want: 100,
+		},
+		{
+			desc: "larger method limit",
+			give: SizeLimits{
+				Default: SizeLimit{Request: 100},
+				Methods: map[string]SizeLimit{
+					"upload": {Request: 1000},
+					"ping":   {Request: 10},
+				},
+			},
+			want: 1000,
+		},
+		{
+			desc: "unlimited method",
+			give: SizeLimits{
+				Default: SizeLimit{Request: 100},
+				Methods: map[string]SizeLimit{"upload": {Response: 10}},
+			},
+			want: 0,
+		},
+		{
+			desc: "unlimited default",
+			give: SizeLimits{
+				Methods: map[string]SizeLimit{"upload": {Request: 1000}},
+			},
+			want: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.give.MaxRequest())
+		})
+	}
+}
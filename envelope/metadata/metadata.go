@@ -0,0 +1,255 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package metadata defines standard headers which carry the deadline, the
+// attempt number, and the name of the caller of requests to enveloped Thrift
+// servers, so that services agree on them without conventions of their own.
+//
+// Clients attach headers for the deadline of the context of each request,
+// the attempt number recorded with WithAttempt, and their own name.
+//
+//   client := metadata.NewClient(envelopeClient, "users-api")
+//   ctx, cancel := context.WithTimeout(ctx, time.Second)
+//   defer cancel()
+//   res, err := client.SendContext(metadata.WithAttempt(ctx, 2), "getValue", body)
+//
+// Servers parse the headers and handle requests with a context which has
+// the deadline of the request and from which the metadata may be read with
+// FromContext.
+//
+//   handler := metadata.NewHandler(metadata.ContextHandlerFunc(
+//     func(ctx context.Context, name string, body wire.Value) (wire.Value, error) {
+//       md, _ := metadata.FromContext(ctx)
+//       log.Printf("attempt %v of %v from %v", md.Attempt, name, md.Caller)
+//       ...
+//     }))
+//
+// Because the context of a request has its deadline, requests made to other
+// services with that context carry what remains of the deadline.
+//
+// The headers are carried in an extra field of the request struct with the
+// ID FieldID as a map<string, string>. Servers that do not read headers
+// ignore it like any other unknown field, and requests without headers are
+// handled without a deadline.
+package metadata
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"go.uber.org/thriftrw/envelope"
+	"go.uber.org/thriftrw/wire"
+)
+
+// FieldID is the ID of the field of a request struct which holds its
+// headers.
+const FieldID = envelope.MetadataFieldID
+
+// Standard header keys.
+const (
+	// DeadlineKey is the time remaining until the deadline of the request
+	// when it was sent, in whole milliseconds. It is relative so that the
+	// clocks of the client and the server need not agree.
+	DeadlineKey = "rpc-deadline-ms"
+
+	// AttemptKey is the attempt number of the request, starting at 1 for
+	// the first attempt and increasing with each retry.
+	AttemptKey = "rpc-attempt"
+
+	// CallerKey is the name of the service making the request.
+	CallerKey = "rpc-caller"
+)
+
+// Metadata is the standard metadata of a request.
+type Metadata struct {
+	// Deadline by which the request must be handled, or the zero time if
+	// the request does not have a deadline.
+	Deadline time.Time
+
+	// Attempt number of the request, or 0 if it is not known.
+	Attempt int
+
+	// Name of the caller, or an empty string if it is not known.
+	Caller string
+}
+
+type contextKey int
+
+const (
+	metadataKey contextKey = iota
+	attemptKey
+)
+
+// FromContext returns the metadata of the request being handled with the
+// given context. ok is false if the context does not belong to a request
+// handled by a Handler built with NewHandler.
+func FromContext(ctx context.Context) (md Metadata, ok bool) {
+	md, ok = ctx.Value(metadataKey).(Metadata)
+	return md, ok
+}
+
+func withMetadata(ctx context.Context, md Metadata) context.Context {
+	return context.WithValue(ctx, metadataKey, md)
+}
+
+// WithAttempt returns a context for making the given attempt of a request.
+// Clients built with NewClient send it in the AttemptKey header.
+func WithAttempt(ctx context.Context, attempt int) context.Context {
+	return context.WithValue(ctx, attemptKey, attempt)
+}
+
+// attemptFromContext returns the attempt recorded with WithAttempt, or 0.
+func attemptFromContext(ctx context.Context) int {
+	attempt, _ := ctx.Value(attemptKey).(int)
+	return attempt
+}
+
+// Headers returns the standard headers for a request with the given
+// metadata sent at the given time. Unknown fields are left out.
+func (md Metadata) Headers(now time.Time) map[string]string {
+	headers := make(map[string]string)
+	if !md.Deadline.IsZero() {
+		remaining := md.Deadline.Sub(now)
+		// Round up so that a deadline in the future is never sent as
+		// having passed.
+		ms := int64((remaining + time.Millisecond - 1) / time.Millisecond)
+		if ms < 0 {
+			ms = 0
+		}
+		headers[DeadlineKey] = strconv.FormatInt(ms, 10)
+	}
+	if md.Attempt > 0 {
+		headers[AttemptKey] = strconv.Itoa(md.Attempt)
+	}
+	if md.Caller != "" {
+		headers[CallerKey] = md.Caller
+	}
+	return headers
+}
+
+// Parse parses the standard headers of a request received at the given
+// time. Other headers are ignored.
+func Parse(headers map[string]string, now time.Time) (Metadata, error) {
+	var md Metadata
+	if v, ok := headers[DeadlineKey]; ok {
+		ms, err := strconv.ParseInt(v, 10, 64)
+		if err != nil || ms < 0 {
+			return md, fmt.Errorf("invalid %v header %q: must be a non-negative integer", DeadlineKey, v)
+		}
+		md.Deadline = now.Add(time.Duration(ms) * time.Millisecond)
+	}
+	if v, ok := headers[AttemptKey]; ok {
+		attempt, err := strconv.Atoi(v)
+		if err != nil || attempt < 1 {
+			return md, fmt.Errorf("invalid %v header %q: must be a positive integer", AttemptKey, v)
+		}
+		md.Attempt = attempt
+	}
+	md.Caller = headers[CallerKey]
+	return md, nil
+}
+
+// Attach returns the given request struct with the given headers attached,
+// replacing any headers it already had.
+func Attach(body wire.Value, headers map[string]string) (wire.Value, error) {
+	fields, _, _, err := split(body)
+	if err != nil {
+		return body, err
+	}
+
+	// Sort the keys so that the same headers are always encoded the same
+	// way.
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	items := make([]wire.MapItem, len(keys))
+	for i, k := range keys {
+		items[i] = wire.MapItem{
+			Key:   wire.NewValueString(k),
+			Value: wire.NewValueString(headers[k]),
+		}
+	}
+
+	fields = append(fields, wire.Field{
+		ID: FieldID,
+		Value: wire.NewValueMap(wire.MapItemListFromSlice(
+			wire.TBinary, wire.TBinary, items)),
+	})
+	return wire.NewValueStruct(wire.Struct{Fields: fields}), nil
+}
+
+// Extract returns the headers attached to the given request struct, if any,
+// and the request without them. ok is false if the request does not have
+// headers.
+func Extract(body wire.Value) (_ wire.Value, headers map[string]string, ok bool, err error) {
+	fields, headers, ok, err := split(body)
+	if err != nil || !ok {
+		return body, headers, ok, err
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields}), headers, true, nil
+}
+
+// split returns the fields of the given request struct without its headers,
+// and its headers if it has them.
+func split(body wire.Value) (fields []wire.Field, headers map[string]string, ok bool, err error) {
+	if body.Type() != wire.TStruct {
+		return nil, nil, false, fmt.Errorf("requests must be structs: got %v", body.Type())
+	}
+
+	for _, f := range body.GetStruct().Fields {
+		if f.ID != FieldID {
+			fields = append(fields, f)
+			continue
+		}
+
+		if headers, err = decodeHeaders(f.Value); err != nil {
+			return nil, nil, false, err
+		}
+		ok = true
+	}
+	return fields, headers, ok, nil
+}
+
+func decodeHeaders(v wire.Value) (map[string]string, error) {
+	if v.Type() != wire.TMap {
+		return nil, fmt.Errorf(
+			"field %v of the request must be a map of headers: got %v", FieldID, v.Type())
+	}
+
+	m := v.GetMap()
+	if m.KeyType() != wire.TBinary || m.ValueType() != wire.TBinary {
+		return nil, fmt.Errorf(
+			"field %v of the request must be a map<string, string>: got map<%v, %v>",
+			FieldID, m.KeyType(), m.ValueType())
+	}
+
+	headers := make(map[string]string, m.Size())
+	err := m.ForEach(func(item wire.MapItem) error {
+		headers[item.Key.GetString()] = item.Value.GetString()
+		return nil
+	})
+	return headers, err
+}
@@ -0,0 +1,366 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metadata
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.uber.org/thriftrw/internal/envelope"
+	"go.uber.org/thriftrw/internal/envelope/envelopetest"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func request(fields ...wire.Field) wire.Value {
+	return wire.NewValueStruct(wire.Struct{Fields: fields})
+}
+
+// fixClock makes now return the given time until the returned function is
+// called.
+func fixClock(t time.Time) (restore func()) {
+	old := now
+	now = func() time.Time { return t }
+	return func() { now = old }
+}
+
+func TestHeadersAndParse(t *testing.T) {
+	start := time.Unix(1500000000, 0)
+
+	tests := []struct {
+		desc    string
+		md      Metadata
+		headers map[string]string
+
+		// Expected result of parsing the headers, if different from md.
+		want *Metadata
+	}{
+		{
+			desc:    "empty",
+			headers: map[string]string{},
+		},
+		{
+			desc: "all",
+			md: Metadata{
+				Deadline: start.Add(1500 * time.Millisecond),
+				Attempt:  2,
+				Caller:   "users-api",
+			},
+			headers: map[string]string{
+				DeadlineKey: "1500",
+				AttemptKey:  "2",
+				CallerKey:   "users-api",
+			},
+		},
+		{
+			desc:    "deadline rounded up",
+			md:      Metadata{Deadline: start.Add(time.Microsecond)},
+			headers: map[string]string{DeadlineKey: "1"},
+			want:    &Metadata{Deadline: start.Add(time.Millisecond)},
+		},
+		{
+			desc:    "deadline passed",
+			md:      Metadata{Deadline: start.Add(-time.Second)},
+			headers: map[string]string{DeadlineKey: "0"},
+			want:    &Metadata{Deadline: start},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.headers, tt.md.Headers(start))
+
+			want := tt.md
+			if tt.want != nil {
+				want = *tt.want
+			}
+			got, err := Parse(tt.headers, start)
+			require.NoError(t, err)
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestParseIgnoresOtherHeaders(t *testing.T) {
+	md, err := Parse(map[string]string{"x-team-header": "foo", CallerKey: "bar"}, time.Now())
+	require.NoError(t, err)
+	assert.Equal(t, Metadata{Caller: "bar"}, md)
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		headers map[string]string
+		wantErr string
+	}{
+		{
+			headers: map[string]string{DeadlineKey: "soon"},
+			wantErr: `invalid rpc-deadline-ms header "soon": must be a non-negative integer`,
+		},
+		{
+			headers: map[string]string{DeadlineKey: "-1"},
+			wantErr: `invalid rpc-deadline-ms header "-1": must be a non-negative integer`,
+		},
+		{
+			headers: map[string]string{AttemptKey: "0"},
+			wantErr: `invalid rpc-attempt header "0": must be a positive integer`,
+		},
+		{
+			headers: map[string]string{AttemptKey: "first"},
+			wantErr: `invalid rpc-attempt header "first": must be a positive integer`,
+		},
+	}
+
+	for _, tt := range tests {
+		_, err := Parse(tt.headers, time.Now())
+		if assert.Error(t, err, "expected error for %v", tt.headers) {
+			assert.Equal(t, tt.wantErr, err.Error())
+		}
+	}
+}
+
+func TestAttachAndExtract(t *testing.T) {
+	body := request(wire.Field{ID: 1, Value: wire.NewValueString("foo")})
+	headers := map[string]string{CallerKey: "users-api", AttemptKey: "1"}
+
+	attached, err := Attach(body, headers)
+	require.NoError(t, err)
+
+	// Attaching again replaces the headers.
+	attached, err = Attach(attached, headers)
+	require.NoError(t, err)
+	require.Len(t, attached.GetStruct().Fields, 2)
+
+	// Keys are sorted so that the encoding is deterministic.
+	items := attached.GetStruct().Fields[1].Value.GetMap()
+	var keys []string
+	require.NoError(t, items.ForEach(func(item wire.MapItem) error {
+		keys = append(keys, item.Key.GetString())
+		return nil
+	}))
+	assert.Equal(t, []string{AttemptKey, CallerKey}, keys)
+
+	got, gotHeaders, ok, err := Extract(attached)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, headers, gotHeaders)
+	assert.True(t, wire.ValuesAreEqual(body, got), "expected %v, got %v", body, got)
+
+	got, _, ok, err = Extract(body)
+	require.NoError(t, err)
+	assert.False(t, ok)
+	assert.True(t, wire.ValuesAreEqual(body, got), "expected %v, got %v", body, got)
+}
+
+func TestExtractErrors(t *testing.T) {
+	tests := []struct {
+		desc    string
+		body    wire.Value
+		wantErr string
+	}{
+		{
+			desc:    "not a struct",
+			body:    wire.NewValueString("foo"),
+			wantErr: "requests must be structs: got TBinary",
+		},
+		{
+			desc:    "not a map",
+			body:    request(wire.Field{ID: FieldID, Value: wire.NewValueI64(1)}),
+			wantErr: "field -32766 of the request must be a map of headers: got TI64",
+		},
+		{
+			desc: "not a map of strings",
+			body: request(wire.Field{ID: FieldID, Value: wire.NewValueMap(
+				wire.MapItemListFromSlice(wire.TBinary, wire.TI32, nil))}),
+			wantErr: "field -32766 of the request must be a map<string, string>: got map<TBinary, TI32>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, _, _, err := Extract(tt.body)
+			if assert.Error(t, err) {
+				assert.Equal(t, tt.wantErr, err.Error())
+			}
+		})
+	}
+}
+
+func TestClientAndHandler(t *testing.T) {
+	// Deadlines must be in the future for the client to send requests.
+	// Round(0) strips the monotonic clock reading so that times may be
+	// compared with assert.Equal.
+	start := time.Now().Round(0)
+	defer fixClock(start)()
+
+	body := request(wire.Field{ID: 1, Value: wire.NewValueString("foo")})
+	reply := request(wire.Field{ID: 0, Value: wire.NewValueString("bar")})
+
+	tests := []struct {
+		desc   string
+		caller string
+		ctx    func() (context.Context, context.CancelFunc)
+
+		wantMetadata Metadata
+	}{
+		{
+			desc: "no metadata",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithCancel(context.Background())
+			},
+		},
+		{
+			desc:   "caller",
+			caller: "users-api",
+			ctx: func() (context.Context, context.CancelFunc) {
+				return context.WithCancel(context.Background())
+			},
+			wantMetadata: Metadata{Caller: "users-api"},
+		},
+		{
+			desc:   "all",
+			caller: "users-api",
+			ctx: func() (context.Context, context.CancelFunc) {
+				ctx := WithAttempt(context.Background(), 3)
+				return context.WithDeadline(ctx, start.Add(time.Minute))
+			},
+			wantMetadata: Metadata{
+				Deadline: start.Add(time.Minute),
+				Attempt:  3,
+				Caller:   "users-api",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			server := envelope.NewServer(protocol.Binary, NewHandler(ContextHandlerFunc(
+				func(ctx context.Context, name string, got wire.Value) (wire.Value, error) {
+					assert.Equal(t, "getValue", name)
+					assert.True(t, wire.ValuesAreEqual(body, got), "expected %v, got %v", body, got)
+
+					md, ok := FromContext(ctx)
+					assert.True(t, ok, "metadata must be in the context")
+					assert.Equal(t, tt.wantMetadata, md)
+
+					deadline, ok := ctx.Deadline()
+					assert.Equal(t, !tt.wantMetadata.Deadline.IsZero(), ok)
+					assert.Equal(t, tt.wantMetadata.Deadline, deadline)
+
+					// The attempt of the incoming request is not passed
+					// on to requests made with its context.
+					assert.Equal(t, 0, attemptFromContext(ctx))
+					return reply, nil
+				})))
+			client := NewClient(envelope.NewClient(protocol.Binary, envelopetest.TransportFunc(server.Handle)), tt.caller)
+
+			ctx, cancel := tt.ctx()
+			defer cancel()
+
+			got, err := client.SendContext(ctx, "getValue", body)
+			require.NoError(t, err)
+			assert.True(t, wire.ValuesAreEqual(reply, got), "expected %v, got %v", reply, got)
+		})
+	}
+}
+
+func TestHandlerRejectsExpiredRequests(t *testing.T) {
+	start := time.Unix(1500000000, 0)
+	defer fixClock(start)()
+
+	body, err := Attach(request(), map[string]string{DeadlineKey: "0"})
+	require.NoError(t, err)
+
+	handler := NewHandler(ContextHandlerFunc(
+		func(context.Context, string, wire.Value) (wire.Value, error) {
+			t.Fatal("expired requests must not be handled")
+			return wire.Value{}, nil
+		}))
+
+	_, err = handler.Handle("getValue", body)
+	assert.Equal(t, context.DeadlineExceeded, err)
+}
+
+func TestHandlerRejectsInvalidHeaders(t *testing.T) {
+	body, err := Attach(request(), map[string]string{AttemptKey: "0"})
+	require.NoError(t, err)
+
+	handler := NewHandler(ContextHandlerFunc(
+		func(context.Context, string, wire.Value) (wire.Value, error) {
+			t.Fatal("invalid requests must not be handled")
+			return wire.Value{}, nil
+		}))
+
+	_, err = handler.Handle("getValue", body)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "invalid rpc-attempt header")
+	}
+}
+
+func TestClientDoesNotSendWhenContextDone(t *testing.T) {
+	client := NewClient(clientFunc(func(string, wire.Value) (wire.Value, error) {
+		t.Fatal("requests must not be sent after their context is done")
+		return wire.Value{}, nil
+	}), "users-api")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := client.SendContext(ctx, "getValue", request())
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestClientPassesContextOn(t *testing.T) {
+	type key struct{}
+	ctx := context.WithValue(context.Background(), key{}, "foo")
+
+	var got context.Context
+	client := NewClient(contextClientFunc(
+		func(ctx context.Context, _ string, _ wire.Value) (wire.Value, error) {
+			got = ctx
+			return request(), nil
+		}), "users-api")
+
+	_, err := client.SendContext(WithAttempt(ctx, 2), "getValue", request())
+	require.NoError(t, err)
+	require.NotNil(t, got, "request must be sent with its context")
+	assert.Equal(t, "foo", got.Value(key{}))
+	assert.Equal(t, 2, attemptFromContext(got))
+}
+
+type clientFunc func(string, wire.Value) (wire.Value, error)
+
+func (f clientFunc) Send(name string, body wire.Value) (wire.Value, error) {
+	return f(name, body)
+}
+
+type contextClientFunc func(context.Context, string, wire.Value) (wire.Value, error)
+
+func (f contextClientFunc) Send(name string, body wire.Value) (wire.Value, error) {
+	return f(context.Background(), name, body)
+}
+
+func (f contextClientFunc) SendContext(ctx context.Context, name string, body wire.Value) (wire.Value, error) {
+	return f(ctx, name, body)
+}
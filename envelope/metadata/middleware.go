@@ -0,0 +1,126 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package metadata
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/thriftrw/envelope"
+	"go.uber.org/thriftrw/wire"
+)
+
+// now is replaced in tests.
+var now = time.Now
+
+// ContextHandler handles enveloped requests with the context built from
+// their metadata.
+type ContextHandler interface {
+	Handle(ctx context.Context, name string, body wire.Value) (wire.Value, error)
+}
+
+// ContextHandlerFunc is a ContextHandler defined by a function.
+type ContextHandlerFunc func(ctx context.Context, name string, body wire.Value) (wire.Value, error)
+
+// Handle calls f.
+func (f ContextHandlerFunc) Handle(ctx context.Context, name string, body wire.Value) (wire.Value, error) {
+	return f(ctx, name, body)
+}
+
+// NewHandler returns a Handler which parses the headers attached to
+// requests and passes the requests, without their headers, to the given
+// ContextHandler with a context that has their metadata and deadline.
+//
+// Requests whose deadline has already passed are rejected with
+// context.DeadlineExceeded without being handled, and requests with
+// malformed standard headers are rejected with an error.
+func NewHandler(h ContextHandler) envelope.Handler {
+	return parsingHandler{h: h}
+}
+
+type parsingHandler struct {
+	h ContextHandler
+}
+
+func (ph parsingHandler) Handle(name string, body wire.Value) (wire.Value, error) {
+	body, headers, _, err := Extract(body)
+	if err != nil {
+		return wire.Value{}, err
+	}
+
+	md, err := Parse(headers, now())
+	if err != nil {
+		return wire.Value{}, err
+	}
+
+	ctx := withMetadata(context.Background(), md)
+	if !md.Deadline.IsZero() {
+		if !now().Before(md.Deadline) {
+			return wire.Value{}, context.DeadlineExceeded
+		}
+
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithDeadline(ctx, md.Deadline)
+		defer cancel()
+	}
+	return ph.h.Handle(ctx, name, body)
+}
+
+// NewClient returns a ContextClient which attaches headers for the deadline
+// of the context, the attempt recorded on it with WithAttempt, and the
+// given caller name to requests before sending them with the given Client.
+// Requests without any metadata are sent unchanged.
+//
+// Requests whose context is already done fail with the error of the
+// context without being sent. If the given Client is an
+// envelope.ContextClient, requests are sent to it with their context.
+func NewClient(c envelope.Client, caller string) envelope.ContextClient {
+	return attachingClient{c: c, caller: caller}
+}
+
+type attachingClient struct {
+	c      envelope.Client
+	caller string
+}
+
+func (ac attachingClient) Send(name string, body wire.Value) (wire.Value, error) {
+	return ac.SendContext(context.Background(), name, body)
+}
+
+func (ac attachingClient) SendContext(ctx context.Context, name string, body wire.Value) (wire.Value, error) {
+	if err := ctx.Err(); err != nil {
+		return wire.Value{}, err
+	}
+
+	md := Metadata{Attempt: attemptFromContext(ctx), Caller: ac.caller}
+	if deadline, ok := ctx.Deadline(); ok {
+		md.Deadline = deadline
+	}
+
+	if headers := md.Headers(now()); len(headers) > 0 {
+		var err error
+		body, err = Attach(body, headers)
+		if err != nil {
+			return wire.Value{}, err
+		}
+	}
+	return envelope.SendContext(ctx, ac.c, name, body)
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package methodfilter restricts which methods of an enveloped Thrift server
+// may be called. This is useful for instances which must not serve some
+// methods, like canaries which should not serve destructive methods.
+//
+//   handler = methodfilter.NewHandler(handler, methodfilter.Config{
+//     Deny: []string{"KeyValue:deleteValue"},
+//   })
+//
+// Calls to methods which are not exposed fail as if the method did not
+// exist, so envelope servers respond with an UNKNOWN_METHOD
+// TApplicationException.
+package methodfilter
+
+import (
+	"go.uber.org/thriftrw/envelope"
+	internalenvelope "go.uber.org/thriftrw/internal/envelope"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Config specifies which methods are exposed. Method names are matched
+// against envelope names exactly, so methods of multiplexed services must
+// be prefixed with the service name and a ":".
+type Config struct {
+	// Allow lists the methods which are exposed. If empty, all methods
+	// are exposed unless they are denied.
+	Allow []string `json:"allow,omitempty"`
+
+	// Deny lists methods which are not exposed, even if they are allowed.
+	Deny []string `json:"deny,omitempty"`
+}
+
+// Filter is a Handler which passes calls to methods exposed by its Config
+// to another Handler and rejects all other calls.
+type Filter struct {
+	h     envelope.Handler
+	cfg   Config
+	allow map[string]struct{}
+	deny  map[string]struct{}
+}
+
+var _ envelope.Handler = (*Filter)(nil)
+
+// NewHandler returns a Filter which only passes calls to the methods
+// exposed by the given Config to the given Handler.
+func NewHandler(h envelope.Handler, cfg Config) *Filter {
+	return &Filter{
+		h:     h,
+		cfg:   Config{Allow: copyStrings(cfg.Allow), Deny: copyStrings(cfg.Deny)},
+		allow: stringSet(cfg.Allow),
+		deny:  stringSet(cfg.Deny),
+	}
+}
+
+// Config returns the configuration of this Filter so that it may be
+// reported by the server, for example, from a debug endpoint.
+func (f *Filter) Config() Config {
+	return Config{Allow: copyStrings(f.cfg.Allow), Deny: copyStrings(f.cfg.Deny)}
+}
+
+// Exposes returns true if calls to the method with the given envelope name
+// are passed on to the underlying Handler.
+func (f *Filter) Exposes(name string) bool {
+	if _, denied := f.deny[name]; denied {
+		return false
+	}
+	if len(f.allow) == 0 {
+		return true
+	}
+	_, allowed := f.allow[name]
+	return allowed
+}
+
+// Handle handles the given request if the method is exposed.
+func (f *Filter) Handle(name string, body wire.Value) (wire.Value, error) {
+	if !f.Exposes(name) {
+		return wire.Value{}, internalenvelope.ErrUnknownMethod(name)
+	}
+	return f.h.Handle(name, body)
+}
+
+func stringSet(ss []string) map[string]struct{} {
+	if len(ss) == 0 {
+		return nil
+	}
+
+	set := make(map[string]struct{}, len(ss))
+	for _, s := range ss {
+		set[s] = struct{}{}
+	}
+	return set
+}
+
+func copyStrings(ss []string) []string {
+	if ss == nil {
+		return nil
+	}
+	return append([]string(nil), ss...)
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package methodfilter
+
+import (
+	"testing"
+
+	"go.uber.org/thriftrw/internal/envelope"
+	"go.uber.org/thriftrw/internal/envelope/envelopetest"
+	"go.uber.org/thriftrw/internal/envelope/exception"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type handlerFunc func(string, wire.Value) (wire.Value, error)
+
+func (f handlerFunc) Handle(name string, body wire.Value) (wire.Value, error) {
+	return f(name, body)
+}
+
+func TestExposes(t *testing.T) {
+	tests := []struct {
+		desc        string
+		cfg         Config
+		wantExposed []string
+		wantHidden  []string
+	}{
+		{
+			desc:        "empty",
+			wantExposed: []string{"getValue", "setValue", "deleteValue"},
+		},
+		{
+			desc:        "allow",
+			cfg:         Config{Allow: []string{"getValue", "setValue"}},
+			wantExposed: []string{"getValue", "setValue"},
+			wantHidden:  []string{"deleteValue", "KeyValue:getValue"},
+		},
+		{
+			desc:        "deny",
+			cfg:         Config{Deny: []string{"deleteValue"}},
+			wantExposed: []string{"getValue", "setValue"},
+			wantHidden:  []string{"deleteValue"},
+		},
+		{
+			desc: "deny wins",
+			cfg: Config{
+				Allow: []string{"getValue", "deleteValue"},
+				Deny:  []string{"deleteValue"},
+			},
+			wantExposed: []string{"getValue"},
+			wantHidden:  []string{"setValue", "deleteValue"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			f := NewHandler(nil, tt.cfg)
+			for _, name := range tt.wantExposed {
+				assert.True(t, f.Exposes(name), "%q must be exposed", name)
+			}
+			for _, name := range tt.wantHidden {
+				assert.False(t, f.Exposes(name), "%q must not be exposed", name)
+			}
+		})
+	}
+}
+
+func TestConfig(t *testing.T) {
+	allow := []string{"getValue"}
+	f := NewHandler(nil, Config{Allow: allow})
+
+	// Changes to the given Config must not affect the Filter.
+	allow[0] = "deleteValue"
+	assert.Equal(t, Config{Allow: []string{"getValue"}}, f.Config())
+	assert.False(t, f.Exposes("deleteValue"))
+
+	// Nor must changes to the returned Config.
+	f.Config().Allow[0] = "deleteValue"
+	assert.Equal(t, Config{Allow: []string{"getValue"}}, f.Config())
+}
+
+func TestServer(t *testing.T) {
+	reply := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 0, Value: wire.NewValueString("bar")},
+	}})
+
+	var called []string
+	server := envelope.NewServer(protocol.Binary, NewHandler(
+		handlerFunc(func(name string, _ wire.Value) (wire.Value, error) {
+			called = append(called, name)
+			return reply, nil
+		}), Config{Deny: []string{"deleteValue"}}))
+	client := envelope.NewClient(protocol.Binary, envelopetest.TransportFunc(server.Handle))
+
+	body := wire.NewValueStruct(wire.Struct{})
+
+	got, err := client.Send("getValue", body)
+	require.NoError(t, err)
+	assert.True(t, wire.ValuesAreEqual(reply, got), "expected %v, got %v", reply, got)
+
+	_, err = client.Send("deleteValue", body)
+	if assert.Error(t, err) {
+		exc, ok := err.(*exception.TApplicationException)
+		if assert.True(t, ok, "expected TApplicationException, got %T", err) {
+			assert.Equal(t, exception.ExceptionTypeUnknownMethod, exc.GetType())
+		}
+	}
+
+	assert.Equal(t, []string{"getValue"}, called)
+}
@@ -0,0 +1,343 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package priority schedules requests to enveloped Thrift servers by the
+// priority of their methods so that, under load, important methods are
+// served ahead of others.
+//
+//   priorities, err := priority.Priorities(serviceSpec)
+//   ...
+//   queue := priority.NewHandler(handler, priority.Config{
+//     Priorities:    priorities,
+//     MaxConcurrent: 64,
+//   })
+//
+// Priorities may be declared in the Thrift file with the rpc.priority
+// annotation, which accepts "high" or "low". Methods without the annotation
+// have Normal priority.
+//
+//   service KeyValue {
+//     string getValue(1: string key) (rpc.priority = "high")
+//     void compact() (rpc.priority = "low")
+//   }
+//
+// At most MaxConcurrent requests are handled at once. Other requests wait in
+// a queue and are handled in order of priority, and in the order in which
+// they arrived within a priority. Once MaxQueued requests are waiting, the
+// queue sheds load: the newest of the waiting requests with the lowest
+// priority is rejected with ErrOverloaded to make room for a request of a
+// higher priority, and requests which are not of a higher priority than any
+// waiting request are rejected right away.
+//
+// Queues record statistics for each priority which may be exported as
+// metrics with Stats.
+package priority
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/envelope"
+	"go.uber.org/thriftrw/wire"
+)
+
+// priorityKey is the annotation on functions which specifies their
+// priority.
+const priorityKey = "rpc.priority"
+
+// ErrOverloaded is returned by Queue for requests which were shed because
+// too many requests were waiting.
+var ErrOverloaded = errors.New("server is overloaded")
+
+// Priority is the priority of a method.
+type Priority int
+
+const (
+	// Low priority methods are handled after all other methods and are
+	// the first to be shed.
+	Low Priority = -1
+
+	// Normal is the priority of methods without an rpc.priority
+	// annotation.
+	Normal Priority = 0
+
+	// High priority methods are handled before all other methods and are
+	// the last to be shed.
+	High Priority = 1
+)
+
+// numPriorities is the number of distinct priorities.
+const numPriorities = int(High-Low) + 1
+
+// index returns the position of this priority in arrays indexed by
+// priority, from lowest to highest.
+func (p Priority) index() int {
+	return int(p - Low)
+}
+
+func (p Priority) String() string {
+	switch p {
+	case Low:
+		return "low"
+	case Normal:
+		return "normal"
+	case High:
+		return "high"
+	default:
+		return fmt.Sprintf("Priority(%d)", int(p))
+	}
+}
+
+// Priorities returns the priorities declared with the rpc.priority
+// annotation on the functions of the given service and the services it
+// inherits, keyed by function name. Functions without the annotation are
+// not included. Methods of multiplexed services must be prefixed with the
+// service name and a ":" before they are passed to NewHandler.
+//
+// An error is returned if an annotation is not "high" or "low".
+func Priorities(s *compile.ServiceSpec) (map[string]Priority, error) {
+	functions, err := s.AllFunctions()
+	if err != nil {
+		return nil, err
+	}
+
+	priorities := make(map[string]Priority)
+	for name, f := range functions {
+		value, ok := f.Annotations[priorityKey]
+		if !ok {
+			continue
+		}
+
+		switch value {
+		case "high":
+			priorities[name] = High
+		case "low":
+			priorities[name] = Low
+		default:
+			return nil, fmt.Errorf(
+				`%v: invalid %v %q: must be "high" or "low"`, f.Name, priorityKey, value)
+		}
+	}
+	return priorities, nil
+}
+
+// Config configures a Queue. Fields with zero values use the defaults.
+type Config struct {
+	// Priorities specifies the priority of each method. Methods which are
+	// not listed have Normal priority.
+	Priorities map[string]Priority
+
+	// MaxConcurrent is the number of requests handled at once. Defaults
+	// to 100.
+	MaxConcurrent int
+
+	// MaxQueued is the number of requests which may wait to be handled
+	// before requests are shed. Defaults to 1000.
+	MaxQueued int
+}
+
+func (c Config) withDefaults() Config {
+	if c.MaxConcurrent <= 0 {
+		c.MaxConcurrent = 100
+	}
+	if c.MaxQueued <= 0 {
+		c.MaxQueued = 1000
+	}
+	return c
+}
+
+// Stats holds statistics about the requests of a priority.
+type Stats struct {
+	Handled int64 // number of requests passed to the handler
+	Shed    int64 // number of requests rejected with ErrOverloaded
+	Waiting int   // number of requests currently waiting
+
+	// Total time spent waiting by requests which were handled.
+	WaitTime time.Duration
+}
+
+// Queue is a Handler which limits the number of requests handled at once,
+// queues the remaining requests by priority, and sheds the requests with
+// the lowest priority when too many are waiting.
+type Queue struct {
+	h          envelope.Handler
+	priorities map[string]Priority
+	cfg        Config
+
+	// now returns the current time. It may be replaced in tests.
+	now func() time.Time
+
+	mu      sync.Mutex
+	running int
+	waiting int
+	queues  [numPriorities][]*waiter // oldest first
+	stats   [numPriorities]Stats
+}
+
+var _ envelope.Handler = (*Queue)(nil)
+
+// waiter is a request waiting to be handled.
+type waiter struct {
+	since time.Time
+
+	// ready receives nil when the request may be handled, or
+	// ErrOverloaded if it was shed.
+	ready chan error
+}
+
+// NewHandler returns a Queue which passes requests to the given Handler in
+// order of priority as specified by the given Config.
+func NewHandler(h envelope.Handler, cfg Config) *Queue {
+	cfg = cfg.withDefaults()
+
+	priorities := make(map[string]Priority, len(cfg.Priorities))
+	for name, p := range cfg.Priorities {
+		if p < Low {
+			p = Low
+		} else if p > High {
+			p = High
+		}
+		priorities[name] = p
+	}
+
+	return &Queue{
+		h:          h,
+		priorities: priorities,
+		cfg:        cfg,
+		now:        time.Now,
+	}
+}
+
+// Priority returns the priority of the method with the given envelope name.
+func (q *Queue) Priority(name string) Priority {
+	return q.priorities[name]
+}
+
+// Handle handles the given request once its turn comes, or returns
+// ErrOverloaded if it was shed.
+func (q *Queue) Handle(name string, body wire.Value) (wire.Value, error) {
+	if err := q.acquire(q.Priority(name)); err != nil {
+		return wire.Value{}, err
+	}
+	defer q.release()
+	return q.h.Handle(name, body)
+}
+
+// Running returns the number of requests currently being handled.
+func (q *Queue) Running() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	return q.running
+}
+
+// Stats returns statistics for each priority so that they may be exported
+// as metrics.
+func (q *Queue) Stats() map[Priority]Stats {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	stats := make(map[Priority]Stats, numPriorities)
+	for p := Low; p <= High; p++ {
+		stats[p] = q.stats[p.index()]
+	}
+	return stats
+}
+
+// acquire blocks until a request of the given priority may be handled.
+func (q *Queue) acquire(p Priority) error {
+	q.mu.Lock()
+
+	i := p.index()
+	if q.running < q.cfg.MaxConcurrent && q.waiting == 0 {
+		q.running++
+		q.stats[i].Handled++
+		q.mu.Unlock()
+		return nil
+	}
+
+	if q.waiting >= q.cfg.MaxQueued && !q.shedBelow(p) {
+		q.stats[i].Shed++
+		q.mu.Unlock()
+		return ErrOverloaded
+	}
+
+	w := &waiter{since: q.now(), ready: make(chan error, 1)}
+	q.queues[i] = append(q.queues[i], w)
+	q.waiting++
+	q.stats[i].Waiting++
+	q.mu.Unlock()
+
+	return <-w.ready
+}
+
+// release hands the slot of a request which was handled to the waiting
+// request with the highest priority.
+func (q *Queue) release() {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	for i := numPriorities - 1; i >= 0; i-- {
+		if len(q.queues[i]) == 0 {
+			continue
+		}
+
+		w := q.queues[i][0]
+		q.queues[i][0] = nil
+		q.queues[i] = q.queues[i][1:]
+		q.waiting--
+
+		stats := &q.stats[i]
+		stats.Waiting--
+		stats.Handled++
+		stats.WaitTime += q.now().Sub(w.since)
+
+		w.ready <- nil
+		return
+	}
+	q.running--
+}
+
+// shedBelow rejects the newest waiting request with the lowest priority
+// if that priority is lower than p. It returns false if no such request
+// was waiting.
+func (q *Queue) shedBelow(p Priority) bool {
+	for i := 0; i < p.index(); i++ {
+		queue := q.queues[i]
+		if len(queue) == 0 {
+			continue
+		}
+
+		w := queue[len(queue)-1]
+		queue[len(queue)-1] = nil
+		q.queues[i] = queue[:len(queue)-1]
+		q.waiting--
+
+		stats := &q.stats[i]
+		stats.Waiting--
+		stats.Shed++
+
+		w.ready <- ErrOverloaded
+		return true
+	}
+	return false
+}
@@ -0,0 +1,251 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package priority
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingHandler reports the name of each request it starts handling to
+// started and blocks until it is told to finish with release.
+type blockingHandler struct {
+	started chan string
+	release chan struct{}
+}
+
+func newBlockingHandler() *blockingHandler {
+	return &blockingHandler{
+		started: make(chan string, 10),
+		release: make(chan struct{}),
+	}
+}
+
+func (h *blockingHandler) Handle(name string, body wire.Value) (wire.Value, error) {
+	h.started <- name
+	<-h.release
+	return wire.NewValueString(name), nil
+}
+
+func (h *blockingHandler) expectStarted(t *testing.T, name string) {
+	select {
+	case got := <-h.started:
+		assert.Equal(t, name, got, "unexpected request handled")
+	case <-time.After(time.Second):
+		t.Fatalf("timed out waiting for %q to be handled", name)
+	}
+}
+
+type result struct {
+	value wire.Value
+	err   error
+}
+
+func handleAsync(q *Queue, name string) <-chan result {
+	ch := make(chan result, 1)
+	go func() {
+		v, err := q.Handle(name, wire.NewValueStruct(wire.Struct{}))
+		ch <- result{value: v, err: err}
+	}()
+	return ch
+}
+
+// waitForWaiting waits until the given number of requests is waiting in
+// the queue.
+func waitForWaiting(t *testing.T, q *Queue, want int) {
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		waiting := 0
+		for _, s := range q.Stats() {
+			waiting += s.Waiting
+		}
+		if waiting == want {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatalf("timed out waiting for %d requests to be queued", want)
+}
+
+func TestQueue(t *testing.T) {
+	h := newBlockingHandler()
+	q := NewHandler(h, Config{
+		Priorities: map[string]Priority{
+			"getValue": High,
+			"compact":  Low,
+			"vacuum":   Low,
+		},
+		MaxConcurrent: 1,
+		MaxQueued:     2,
+	})
+
+	// Every reading of the clock advances it by a second.
+	now := time.Unix(1500000000, 0)
+	q.now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+
+	first := handleAsync(q, "setValue")
+	h.expectStarted(t, "setValue")
+	assert.Equal(t, 1, q.Running())
+
+	compact := handleAsync(q, "compact")
+	waitForWaiting(t, q, 1)
+	getValue := handleAsync(q, "getValue")
+	waitForWaiting(t, q, 2)
+
+	// The queue is full, so the low priority request is shed to make room
+	// for a normal priority one.
+	second := handleAsync(q, "setValue")
+	res := <-compact
+	assert.Equal(t, ErrOverloaded, res.err)
+	waitForWaiting(t, q, 2)
+
+	// No waiting request has a lower priority than this one.
+	_, err := q.Handle("vacuum", wire.NewValueStruct(wire.Struct{}))
+	assert.Equal(t, ErrOverloaded, err)
+
+	h.release <- struct{}{}
+	res = <-first
+	require.NoError(t, res.err)
+	assert.Equal(t, "setValue", res.value.GetString())
+
+	// The high priority request is handled before the normal priority one
+	// even though it arrived later.
+	h.expectStarted(t, "getValue")
+	h.release <- struct{}{}
+	res = <-getValue
+	require.NoError(t, res.err)
+	assert.Equal(t, "getValue", res.value.GetString())
+
+	h.expectStarted(t, "setValue")
+	h.release <- struct{}{}
+	res = <-second
+	require.NoError(t, res.err)
+
+	assert.Equal(t, 0, q.Running())
+	stats := q.Stats()
+	assert.Equal(t, Stats{Handled: 1, WaitTime: 2 * time.Second}, stats[High])
+	assert.Equal(t, Stats{Handled: 2, WaitTime: 2 * time.Second}, stats[Normal])
+	assert.Equal(t, Stats{Shed: 2}, stats[Low])
+}
+
+func TestQueueConcurrent(t *testing.T) {
+	h := newBlockingHandler()
+	q := NewHandler(h, Config{MaxConcurrent: 2})
+
+	a := handleAsync(q, "a")
+	b := handleAsync(q, "b")
+	for i := 0; i < 2; i++ {
+		select {
+		case <-h.started:
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for requests to be handled concurrently")
+		}
+	}
+	assert.Equal(t, 2, q.Running())
+
+	h.release <- struct{}{}
+	h.release <- struct{}{}
+	assert.NoError(t, (<-a).err)
+	assert.NoError(t, (<-b).err)
+	assert.Equal(t, 0, q.Running())
+}
+
+func TestPriorityString(t *testing.T) {
+	assert.Equal(t, "low", Low.String())
+	assert.Equal(t, "normal", Normal.String())
+	assert.Equal(t, "high", High.String())
+	assert.Equal(t, "Priority(5)", Priority(5).String())
+}
+
+func compileService(t *testing.T, contents string) *compile.ServiceSpec {
+	dir, err := ioutil.TempDir("", "priority-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "test.thrift")
+	require.NoError(t, ioutil.WriteFile(path, []byte(contents), 0644))
+
+	m, err := compile.Compile(path)
+	require.NoError(t, err)
+	return m.Services["KeyValue"]
+}
+
+func TestPriorities(t *testing.T) {
+	tests := []struct {
+		desc    string
+		thrift  string
+		want    map[string]Priority
+		wantErr string
+	}{
+		{
+			desc: "annotated",
+			thrift: `
+				service Base {
+					string healthy() (rpc.priority = "high")
+				}
+				service KeyValue extends Base {
+					string getValue(1: string key) (rpc.priority = "high")
+					void setValue(1: string key, 2: string value)
+					oneway void compact() (rpc.priority = "low")
+				}
+			`,
+			want: map[string]Priority{
+				"healthy":  High,
+				"getValue": High,
+				"compact":  Low,
+			},
+		},
+		{
+			desc: "invalid priority",
+			thrift: `
+				service KeyValue {
+					string getValue(1: string key) (rpc.priority = "urgent")
+				}
+			`,
+			wantErr: `getValue: invalid rpc.priority "urgent": must be "high" or "low"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			priorities, err := Priorities(compileService(t, tt.thrift))
+			if tt.wantErr != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, priorities)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package signature
+
+import (
+	"context"
+
+	"go.uber.org/thriftrw/envelope"
+	"go.uber.org/thriftrw/wire"
+)
+
+// NewHandler returns a Handler which verifies the signatures of requests
+// with the given keys before passing them, without their signatures, to the
+// given Handler. Requests which are not signed or whose signatures do not
+// match are rejected.
+func NewHandler(h envelope.Handler, keys KeyProvider) envelope.Handler {
+	return verifyingHandler{h: h, keys: keys}
+}
+
+type verifyingHandler struct {
+	h    envelope.Handler
+	keys KeyProvider
+}
+
+func (vh verifyingHandler) Handle(name string, body wire.Value) (wire.Value, error) {
+	body, err := Verify(name, body, vh.keys)
+	if err != nil {
+		return wire.Value{}, err
+	}
+	return vh.h.Handle(name, body)
+}
+
+// NewClient returns a Client which signs requests with the key with the
+// given ID before sending them with the given Client.
+func NewClient(c envelope.Client, keyID string, keys KeyProvider) envelope.ContextClient {
+	return signingClient{c: c, keyID: keyID, keys: keys}
+}
+
+type signingClient struct {
+	c     envelope.Client
+	keyID string
+	keys  KeyProvider
+}
+
+func (sc signingClient) Send(name string, body wire.Value) (wire.Value, error) {
+	return sc.SendContext(context.Background(), name, body)
+}
+
+func (sc signingClient) SendContext(ctx context.Context, name string, body wire.Value) (wire.Value, error) {
+	body, err := Sign(name, body, sc.keyID, sc.keys)
+	if err != nil {
+		return wire.Value{}, err
+	}
+	return envelope.SendContext(ctx, sc.c, name, body)
+}
@@ -0,0 +1,211 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package signature signs the bodies of enveloped Thrift requests with
+// HMAC-SHA256 and verifies them so that calls which cross trust boundaries
+// may be authenticated.
+//
+// The signature is carried in an extra field of the request struct with the
+// ID FieldID. Peers that do not verify signatures ignore it like any other
+// unknown field, so signing is an optional extension of the envelope.
+//
+// Clients sign requests with a key chosen by ID, and servers look the key up
+// by the same ID to verify them. Keys are provided by a KeyProvider.
+//
+//   keys := signature.StaticKeys{"2017-06": secret}
+//   client = signature.NewClient(client, "2017-06", keys)
+//   handler = signature.NewHandler(handler, keys)
+//
+// The signature covers the method name and the canonical encoding of the
+// request (see canonical.EncodeUntyped) so that it does not depend on the
+// order in which fields, map items, and set items were written.
+package signature
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+
+	"go.uber.org/thriftrw/canonical"
+	"go.uber.org/thriftrw/envelope"
+	"go.uber.org/thriftrw/wire"
+)
+
+// FieldID is the ID of the field of a request struct which holds its
+// signature.
+const FieldID = envelope.SignatureFieldID
+
+// Fields of the signature struct.
+const (
+	keyIDFieldID int16 = 1
+	macFieldID   int16 = 2
+)
+
+var (
+	// ErrUnsigned is returned by Verify if the request is not signed.
+	ErrUnsigned = errors.New("request is not signed")
+
+	// ErrInvalidSignature is returned by Verify if the signature of the
+	// request does not match its contents.
+	ErrInvalidSignature = errors.New("request has an invalid signature")
+)
+
+// KeyProvider provides the secret keys used to sign and verify requests.
+type KeyProvider interface {
+	// Key returns the secret key with the given ID, or an error if the key
+	// is unknown.
+	Key(id string) ([]byte, error)
+}
+
+// KeyProviderFunc is a KeyProvider backed by a function.
+type KeyProviderFunc func(id string) ([]byte, error)
+
+// Key calls the function with the given ID.
+func (f KeyProviderFunc) Key(id string) ([]byte, error) {
+	return f(id)
+}
+
+// StaticKeys is a KeyProvider backed by a fixed set of keys, indexed by their
+// IDs.
+type StaticKeys map[string][]byte
+
+// Key returns the key with the given ID.
+func (ks StaticKeys) Key(id string) ([]byte, error) {
+	if key, ok := ks[id]; ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown key %q", id)
+}
+
+// Sign returns the given request struct to the method with the given name,
+// signed with the key with the given ID. A previous signature of the request
+// is replaced.
+func Sign(name string, body wire.Value, keyID string, keys KeyProvider) (wire.Value, error) {
+	fields, _, err := splitSignature(body)
+	if err != nil {
+		return body, err
+	}
+
+	key, err := keys.Key(keyID)
+	if err != nil {
+		return body, fmt.Errorf("cannot sign request to %q: %v", name, err)
+	}
+
+	mac, err := computeMAC(name, fields, key)
+	if err != nil {
+		return body, err
+	}
+
+	sig := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: keyIDFieldID, Value: wire.NewValueString(keyID)},
+		{ID: macFieldID, Value: wire.NewValueBinary(mac)},
+	}})
+	fields = append(fields, wire.Field{ID: FieldID, Value: sig})
+	return wire.NewValueStruct(wire.Struct{Fields: fields}), nil
+}
+
+// Verify verifies the signature of the given request struct to the method
+// with the given name and returns the request without its signature.
+//
+// ErrUnsigned is returned if the request is not signed, and
+// ErrInvalidSignature is returned if its signature does not match.
+func Verify(name string, body wire.Value, keys KeyProvider) (wire.Value, error) {
+	fields, sig, err := splitSignature(body)
+	if err != nil {
+		return body, err
+	}
+	if sig == nil {
+		return body, ErrUnsigned
+	}
+
+	var (
+		keyID, mac    wire.Value
+		hasID, hasMAC bool
+	)
+	for _, f := range sig.Fields {
+		switch {
+		case f.ID == keyIDFieldID && f.Value.Type() == wire.TBinary:
+			keyID, hasID = f.Value, true
+		case f.ID == macFieldID && f.Value.Type() == wire.TBinary:
+			mac, hasMAC = f.Value, true
+		}
+	}
+	if !hasID || !hasMAC {
+		return body, ErrInvalidSignature
+	}
+
+	key, err := keys.Key(keyID.GetString())
+	if err != nil {
+		return body, fmt.Errorf("cannot verify request to %q: %v", name, err)
+	}
+
+	want, err := computeMAC(name, fields, key)
+	if err != nil {
+		return body, err
+	}
+	if !hmac.Equal(want, mac.GetBinary()) {
+		return body, ErrInvalidSignature
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields}), nil
+}
+
+// splitSignature returns the fields of the given request struct without its
+// signature, and its signature if it is signed.
+func splitSignature(body wire.Value) ([]wire.Field, *wire.Struct, error) {
+	if body.Type() != wire.TStruct {
+		return nil, nil, fmt.Errorf("requests must be structs: got %v", body.Type())
+	}
+
+	var (
+		fields []wire.Field
+		sig    *wire.Struct
+	)
+	for _, f := range body.GetStruct().Fields {
+		if f.ID != FieldID {
+			fields = append(fields, f)
+			continue
+		}
+
+		if f.Value.Type() != wire.TStruct {
+			return nil, nil, ErrInvalidSignature
+		}
+		s := f.Value.GetStruct()
+		sig = &s
+	}
+	return fields, sig, nil
+}
+
+// computeMAC returns the HMAC-SHA256 of the method name and the canonical
+// encoding of the given fields.
+func computeMAC(name string, fields []wire.Field, key []byte) ([]byte, error) {
+	b, err := canonical.EncodeUntyped(wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString(name)},
+		{ID: 2, Value: wire.NewValueStruct(wire.Struct{Fields: fields})},
+	}}))
+	if err != nil {
+		return nil, err
+	}
+
+	h := hmac.New(sha256.New, key)
+	h.Write(b)
+	return h.Sum(nil), nil
+}
@@ -0,0 +1,231 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package signature
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"go.uber.org/thriftrw/internal/envelope"
+	"go.uber.org/thriftrw/internal/envelope/envelopetest"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testKeys = StaticKeys{
+	"k1": []byte("secret"),
+	"k2": []byte("another secret"),
+}
+
+func request(fields ...wire.Field) wire.Value {
+	return wire.NewValueStruct(wire.Struct{Fields: fields})
+}
+
+func TestSignAndVerify(t *testing.T) {
+	body := request(
+		wire.Field{ID: 1, Value: wire.NewValueString("foo")},
+		wire.Field{ID: 2, Value: wire.NewValueI32(42)},
+	)
+
+	signed, err := Sign("getValue", body, "k1", testKeys)
+	require.NoError(t, err)
+	assert.Len(t, signed.GetStruct().Fields, 3)
+
+	got, err := Verify("getValue", signed, testKeys)
+	require.NoError(t, err)
+	assert.True(t, wire.ValuesAreEqual(body, got), "expected %v, got %v", body, got)
+
+	t.Run("reordered fields", func(t *testing.T) {
+		fs := signed.GetStruct().Fields
+		reordered := request(fs[2], fs[1], fs[0])
+		_, err := Verify("getValue", reordered, testKeys)
+		assert.NoError(t, err)
+	})
+
+	t.Run("re-signed", func(t *testing.T) {
+		resigned, err := Sign("getValue", signed, "k2", testKeys)
+		require.NoError(t, err)
+		assert.Len(t, resigned.GetStruct().Fields, 3)
+
+		_, err = Verify("getValue", resigned, testKeys)
+		assert.NoError(t, err)
+	})
+}
+
+func TestVerifyFailures(t *testing.T) {
+	body := request(wire.Field{ID: 1, Value: wire.NewValueString("foo")})
+	signed, err := Sign("getValue", body, "k1", testKeys)
+	require.NoError(t, err)
+	sig := signed.GetStruct().Fields[1]
+
+	tests := []struct {
+		desc    string
+		name    string
+		body    wire.Value
+		keys    KeyProvider
+		wantErr error
+		wantMsg string
+	}{
+		{
+			desc:    "unsigned",
+			name:    "getValue",
+			body:    body,
+			wantErr: ErrUnsigned,
+		},
+		{
+			desc:    "different method",
+			name:    "setValue",
+			body:    signed,
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			desc: "tampered",
+			name: "getValue",
+			body: request(
+				wire.Field{ID: 1, Value: wire.NewValueString("bar")},
+				sig,
+			),
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			desc: "added field",
+			name: "getValue",
+			body: request(
+				wire.Field{ID: 1, Value: wire.NewValueString("foo")},
+				wire.Field{ID: 2, Value: wire.NewValueBool(true)},
+				sig,
+			),
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			desc: "malformed signature",
+			name: "getValue",
+			body: request(
+				wire.Field{ID: 1, Value: wire.NewValueString("foo")},
+				wire.Field{ID: FieldID, Value: wire.NewValueString("sig")},
+			),
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			desc:    "different key",
+			name:    "getValue",
+			body:    signed,
+			keys:    StaticKeys{"k1": []byte("not the secret")},
+			wantErr: ErrInvalidSignature,
+		},
+		{
+			desc:    "unknown key",
+			name:    "getValue",
+			body:    signed,
+			keys:    StaticKeys{},
+			wantMsg: `cannot verify request to "getValue": unknown key "k1"`,
+		},
+		{
+			desc:    "not a struct",
+			name:    "getValue",
+			body:    wire.NewValueString("foo"),
+			wantMsg: "requests must be structs: got TBinary",
+		},
+	}
+
+	for _, tt := range tests {
+		keys := tt.keys
+		if keys == nil {
+			keys = testKeys
+		}
+
+		_, err := Verify(tt.name, tt.body, keys)
+		if tt.wantErr != nil {
+			assert.Equal(t, tt.wantErr, err, tt.desc)
+		} else if assert.Error(t, err, tt.desc) {
+			assert.Equal(t, tt.wantMsg, err.Error(), tt.desc)
+		}
+	}
+}
+
+func TestSignUnknownKey(t *testing.T) {
+	keys := KeyProviderFunc(func(id string) ([]byte, error) {
+		return nil, errors.New("great sadness")
+	})
+
+	_, err := Sign("getValue", request(), "k1", keys)
+	if assert.Error(t, err) {
+		assert.Equal(t, `cannot sign request to "getValue": great sadness`, err.Error())
+	}
+}
+
+type handlerFunc func(string, wire.Value) (wire.Value, error)
+
+func (f handlerFunc) Handle(name string, body wire.Value) (wire.Value, error) {
+	return f(name, body)
+}
+
+func TestClientAndHandler(t *testing.T) {
+	body := request(wire.Field{ID: 1, Value: wire.NewValueString("foo")})
+	reply := request(wire.Field{ID: 0, Value: wire.NewValueString("bar")})
+
+	server := envelope.NewServer(protocol.Binary, NewHandler(
+		handlerFunc(func(name string, got wire.Value) (wire.Value, error) {
+			assert.Equal(t, "getValue", name)
+			assert.True(t, wire.ValuesAreEqual(body, got), "expected %v, got %v", body, got)
+			return reply, nil
+		}), testKeys))
+	unsigned := envelope.NewClient(protocol.Binary, envelopetest.TransportFunc(server.Handle))
+
+	t.Run("signed", func(t *testing.T) {
+		got, err := NewClient(unsigned, "k1", testKeys).Send("getValue", body)
+		require.NoError(t, err)
+		assert.True(t, wire.ValuesAreEqual(reply, got), "expected %v, got %v", reply, got)
+	})
+
+	t.Run("unsigned", func(t *testing.T) {
+		_, err := unsigned.Send("getValue", body)
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), ErrUnsigned.Error())
+		}
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		keys := StaticKeys{"k1": []byte("not the secret")}
+		_, err := NewClient(unsigned, "k1", keys).Send("getValue", body)
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), ErrInvalidSignature.Error())
+		}
+	})
+}
+
+func TestSignedRequestIgnoredByUnsignedServer(t *testing.T) {
+	// Servers which do not verify signatures see the signature as an
+	// unknown field.
+	signed, err := Sign("getValue", request(wire.Field{ID: 1, Value: wire.NewValueString("foo")}), "k1", testKeys)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, protocol.Binary.Encode(signed, &buf))
+
+	decoded, err := protocol.Binary.Decode(bytes.NewReader(buf.Bytes()), wire.TStruct)
+	require.NoError(t, err)
+	assert.True(t, wire.ValuesAreEqual(signed, decoded), "expected %v, got %v", signed, decoded)
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// batchKey is the annotation on a function which names the function of the
+// same service that handles batches of its calls. The batch function must
+// accept a list of the arguments of the annotated function and return a
+// list of its results in the same order.
+//
+//   ArbitraryValue getValue(1: Key key) (thriftrw.batch = "getManyValues")
+//   list<ArbitraryValue> getManyValues(1: list<Key> keys)
+const batchKey = "thriftrw.batch"
+
+// batchFunction returns the batch function named by the thriftrw.batch
+// annotation of the given function, or nil if it does not have the
+// annotation.
+func batchFunction(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpec) (*compile.FunctionSpec, error) {
+	name, ok := f.Annotations[batchKey]
+	if !ok {
+		return nil, nil
+	}
+
+	invalid := func(msg string, args ...interface{}) error {
+		return fmt.Errorf("cannot batch calls to %v with %q: %v",
+			f.Name, name, fmt.Sprintf(msg, args...))
+	}
+
+	batch, ok := s.Functions[name]
+	if !ok {
+		return nil, invalid("%v does not have a function with this name", s.Name)
+	}
+
+	if f.OneWay || f.ResultSpec.ReturnType == nil || len(f.ArgsSpec) != 1 {
+		return nil, invalid("%v must accept one argument and return a value", f.Name)
+	}
+
+	if batch.OneWay || batch.ResultSpec.ReturnType == nil || len(batch.ArgsSpec) != 1 {
+		return nil, invalid("%v must accept a list and return a list", name)
+	}
+	args, argsOk := compile.RootTypeSpec(batch.ArgsSpec[0].Type).(*compile.ListSpec)
+	results, resultsOk := compile.RootTypeSpec(batch.ResultSpec.ReturnType).(*compile.ListSpec)
+	if !argsOk || !resultsOk {
+		return nil, invalid("%v must accept a list and return a list", name)
+	}
+
+	if err := sameGoType(g, f.ArgsSpec[0].Type, args.ValueSpec); err != nil {
+		return nil, invalid("its arguments: %v", err)
+	}
+	if err := sameGoType(g, f.ResultSpec.ReturnType, results.ValueSpec); err != nil {
+		return nil, invalid("its results: %v", err)
+	}
+	return batch, nil
+}
+
+// sameGoType returns an error if the given types are not represented by
+// the same Go type.
+func sameGoType(g Generator, a, b compile.TypeSpec) error {
+	aRef, err := typeReference(g, a)
+	if err != nil {
+		return err
+	}
+	bRef, err := typeReference(g, b)
+	if err != nil {
+		return err
+	}
+	if aRef != bRef {
+		return fmt.Errorf("%v does not match %v", aRef, bRef)
+	}
+	return nil
+}
+
+// functionBatcher generates a batcher for the given function if it has a
+// thriftrw.batch annotation. Batchers coalesce calls to the function into
+// calls to its batch function.
+func functionBatcher(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpec) error {
+	batch, err := batchFunction(g, s, f)
+	if err != nil || batch == nil {
+		return err
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$runtime := import "go.uber.org/thriftrw/runtime">
+		<$f := .Function>
+		<$batch := .Batch>
+		<$prefix := namePrefix .Service $f>
+		<$batchPrefix := namePrefix .Service $batch>
+		<$arg := index $f.ArgsSpec 0>
+		<$batchArg := index $batch.ArgsSpec 0>
+		<$ns := newNamespace>
+		<$b := $ns.NewName "b">
+		<$param := $ns.NewName $arg.Name>
+		<$success := $ns.NewName "success">
+		<$err := $ns.NewName "err">
+		<$res := $ns.NewName "res">
+
+		// <$prefix>Batcher coalesces calls to <.Service.Name>.<$f.Name> into
+		// calls to <.Service.Name>.<$batch.Name>.
+		type <$prefix>Batcher struct {
+			b *<$runtime>.Batcher
+		}
+
+		// New<$prefix>Batcher builds a <$prefix>Batcher
+		// which sends batches with the given client.
+		func New<$prefix>Batcher(client <$runtime>.Client, cfg <$runtime>.BatcherConfig) *<$prefix>Batcher {
+			send := func(requests []interface{}) ([]interface{}, error) {
+				batch := make(<typeReference $batchArg.Type>, len(requests))
+				for i, r := range requests {
+					batch[i] = r.(<typeReference $arg.Type>)
+				}
+
+				args := <$batchPrefix>Helper.Args(batch)
+				body, err := args.ToWire()
+				if err != nil {
+					return nil, err
+				}
+
+				body, err = client.Send(args.MethodName(), body)
+				if err != nil {
+					return nil, err
+				}
+
+				var result <$batchPrefix>Result
+				if err = result.FromWire(body); err != nil {
+					return nil, err
+				}
+
+				values, err := result.UnwrapResponse()
+				if err != nil {
+					return nil, err
+				}
+
+				responses := make([]interface{}, len(values))
+				for i, v := range values {
+					responses[i] = v
+				}
+				return responses, nil
+			}
+			return &<$prefix>Batcher{b: <$runtime>.NewBatcher(cfg, send)}
+		}
+
+		// <goCase $f.Name> calls <$f.Name> as part of the next batch of
+		// calls to <$batch.Name>. If the batch fails, all calls in it fail
+		// with the same error.
+		func (<$b> *<$prefix>Batcher) <goCase $f.Name>(<$param> <typeReference $arg.Type>) (<$success> <typeReference $f.ResultSpec.ReturnType>, <$err> error) {
+			var <$res> interface{}
+			<$res>, <$err> = <$b>.b.Do(<$param>)
+			if <$err> == nil {
+				<$success> = <$res>.(<typeReference $f.ResultSpec.ReturnType>)
+			}
+			return
+		}
+		`,
+		struct {
+			Service  *compile.ServiceSpec
+			Function *compile.FunctionSpec
+			Batch    *compile.FunctionSpec
+		}{
+			Service:  s,
+			Function: f,
+			Batch:    batch,
+		},
+		TemplateFunc("namePrefix", functionNamePrefix),
+	)
+}
@@ -0,0 +1,194 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"go.uber.org/thriftrw/compile"
+	tx "go.uber.org/thriftrw/gen/testdata/exceptions"
+	tv "go.uber.org/thriftrw/gen/testdata/services"
+	tu "go.uber.org/thriftrw/gen/testdata/unions"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/runtime"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// getManyValuesClient is a runtime.Client which serves getManyValues from
+// a map and records the batches it receives.
+type getManyValuesClient struct {
+	values map[tv.Key]string
+
+	mu      sync.Mutex
+	batches [][]tv.Key
+}
+
+func (c *getManyValuesClient) Send(name string, body wire.Value) (wire.Value, error) {
+	if name != "getManyValues" {
+		return wire.Value{}, errors.New("unexpected method " + name)
+	}
+
+	var args tv.KeyValue_GetManyValues_Args
+	if err := args.FromWire(body); err != nil {
+		return wire.Value{}, err
+	}
+
+	c.mu.Lock()
+	c.batches = append(c.batches, args.Range)
+	c.mu.Unlock()
+
+	var (
+		values []*tu.ArbitraryValue
+		err    error
+	)
+	for _, key := range args.Range {
+		v, ok := c.values[key]
+		if !ok {
+			err = &tx.DoesNotExistException{Key: string(key)}
+			break
+		}
+		values = append(values, &tu.ArbitraryValue{StringValue: ptr.String(v)})
+	}
+
+	result, err := tv.KeyValue_GetManyValues_Helper.WrapResponse(values, err)
+	if err != nil {
+		return wire.Value{}, err
+	}
+	return result.ToWire()
+}
+
+func TestBatcher(t *testing.T) {
+	client := &getManyValuesClient{values: map[tv.Key]string{"a": "1", "b": "2", "c": "3"}}
+	batcher := tv.NewKeyValue_GetValue_Batcher(client, runtime.BatcherConfig{Window: 50 * time.Millisecond})
+
+	var wg sync.WaitGroup
+	for key, want := range client.values {
+		wg.Add(1)
+		go func(key tv.Key, want string) {
+			defer wg.Done()
+			v, err := batcher.GetValue(key)
+			if assert.NoError(t, err) {
+				assert.Equal(t, want, v.GetStringValue())
+			}
+		}(key, want)
+	}
+	wg.Wait()
+
+	require.Len(t, client.batches, 1)
+	assert.Len(t, client.batches[0], 3)
+}
+
+func TestBatcherError(t *testing.T) {
+	client := &getManyValuesClient{values: map[tv.Key]string{"a": "1"}}
+	batcher := tv.NewKeyValue_GetValue_Batcher(client, runtime.BatcherConfig{Window: 50 * time.Millisecond})
+
+	var wg sync.WaitGroup
+	for _, key := range []tv.Key{"a", "missing"} {
+		wg.Add(1)
+		go func(key tv.Key) {
+			defer wg.Done()
+			_, err := batcher.GetValue(key)
+			assert.Equal(t, &tx.DoesNotExistException{Key: "missing"}, err,
+				"all calls in a failed batch fail")
+		}(key)
+	}
+	wg.Wait()
+}
+
+func TestBatcherInvalid(t *testing.T) {
+	tests := []struct {
+		desc    string
+		src     string
+		wantErr string
+	}{
+		{
+			desc: "unknown function",
+			src: `service Foo {
+				string get(1: string key) (thriftrw.batch = "getAll")
+			}`,
+			wantErr: `cannot batch calls to get with "getAll": Foo does not have a function with this name`,
+		},
+		{
+			desc: "too many arguments",
+			src: `service Foo {
+				string get(1: string key, 2: i32 version) (thriftrw.batch = "getAll")
+				list<string> getAll(1: list<string> keys)
+			}`,
+			wantErr: `get must accept one argument and return a value`,
+		},
+		{
+			desc: "not a list",
+			src: `service Foo {
+				string get(1: string key) (thriftrw.batch = "getAll")
+				map<string, string> getAll(1: list<string> keys)
+			}`,
+			wantErr: `getAll must accept a list and return a list`,
+		},
+		{
+			desc: "argument mismatch",
+			src: `service Foo {
+				string get(1: string key) (thriftrw.batch = "getAll")
+				list<string> getAll(1: list<i64> keys)
+			}`,
+			wantErr: `its arguments: string does not match int64`,
+		},
+		{
+			desc: "result mismatch",
+			src: `service Foo {
+				string get(1: string key) (thriftrw.batch = "getAll")
+				list<binary> getAll(1: list<string> keys)
+			}`,
+			wantErr: `its results: string does not match []byte`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "thriftrw-batch-test")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+
+			path := filepath.Join(dir, "batch.thrift")
+			require.NoError(t, ioutil.WriteFile(path, []byte(tt.src), 0644))
+
+			module, err := compile.Compile(path)
+			require.NoError(t, err)
+
+			err = Generate(module, &Options{
+				OutputDir:     dir,
+				PackagePrefix: "go.uber.org/thriftrw/gen/testdata",
+				ThriftRoot:    dir,
+			})
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
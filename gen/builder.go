@@ -0,0 +1,131 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import "go.uber.org/thriftrw/compile"
+
+// checkBuilder returns true if a builder should be generated for a struct
+// with the given number of fields by the given Generator.
+func checkBuilder(g Generator, numFields int) bool {
+	o := generatorOptions(g)
+	return o.GenerateBuilders && numFields > o.BuilderThreshold
+}
+
+// builder generates a FooBuilder type with a fluent interface to construct
+// values of the struct Foo. Build() verifies that all required fields have
+// been set, and for unions, that exactly one field was set.
+//
+// fg is the fieldGroupGenerator used to generate the struct.
+func builder(g Generator, spec *compile.StructSpec, fg fieldGroupGenerator) error {
+	name, err := goName(spec)
+	if err != nil {
+		return err
+	}
+
+	err = g.DeclareFromTemplate(
+		`
+		<$b := newVar "b">
+		<$v := newVar "v">
+		<$builder := printf "%vBuilder" .Name>
+
+		// <$builder> builds <.Name> structs.
+		//
+		// 	<$v>, err := New<$builder>().
+		// 		Set<goName (index .Fields 0)>(...).
+		// 		Build()
+		type <$builder> struct {
+			v   <.Name>
+			set [<len .Fields>]bool
+		}
+
+		// New<$builder> returns a new <$builder> with all fields that have
+		// default values set to their defaults.
+		func New<$builder>() *<$builder> {
+			var <$b> <$builder>
+			<range .Fields>
+				<- if .Default ->
+					<- if isOptionalValue . ->
+						<$b>.v.<setterName .>(<constantValue .Default .Type>)
+					<- else ->
+						<$b>.v.<fieldName .> = <constantValuePtr .Default .Type>
+					<- end>
+				<end>
+			<- end>
+			return &<$b>
+		}
+
+		<range $i, $f := .Fields>
+			<$fname := goName $f>
+			// Set<$fname> sets the value of <$fname>.
+			func (<$b> *<$builder>) Set<$fname>(<$v> <typeReference $f.Type>) *<$builder> {
+				<- if isOptionalValue $f>
+					<$b>.v.<setterName $f>(<$v>)
+				<- else if and (not $f.Required) (isPrimitiveType $f.Type)>
+					<$b>.v.<fieldName $f> = &<$v>
+				<- else>
+					<$b>.v.<fieldName $f> = <$v>
+				<- end>
+				<$b>.set[<$i>] = true
+				return <$b>
+			}
+		<end>
+
+		// Build returns the <.Name> built by this <$builder>.
+		//
+		// An error is returned if any of the required fields of <.Name> have
+		// not been set<if .IsUnion>, or if not exactly one field was set<end>.
+		func (<$b> *<$builder>) Build() (*<.Name>, error) {
+			<- range $i, $f := .Fields>
+				<- if $f.Required ->
+					if !<$b>.set[<$i>] {
+						return nil, <import "errors">.New("field <goName $f> of <$.Name> is required")
+					}
+				<end>
+			<- end>
+			<- if .IsUnion>
+				<- $n := newVar "n">
+				<- $isSet := newVar "isSet">
+				<$n> := 0
+				for _, <$isSet> := range <$b>.set {
+					if <$isSet> {
+						<$n>++
+					}
+				}
+				if <$n> != 1 {
+					return nil, <import "fmt">.Errorf("<.Name> should have exactly one field: got %v fields", <$n>)
+				}
+			<- end>
+			<$v> := <$b>.v
+			return &<$v>, nil
+		}
+		`,
+		struct {
+			Name    string
+			Fields  compile.FieldGroup
+			IsUnion bool
+		}{Name: name, Fields: fg.Fields, IsUnion: fg.IsUnion},
+		append(fg.optionalValueFuncs(),
+			TemplateFunc("constantValue", ConstantValue),
+			TemplateFunc("constantValuePtr", ConstantValuePtr),
+		)...,
+	)
+	return wrapGenerateError(spec.ThriftName(), err)
+}
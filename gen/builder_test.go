@@ -0,0 +1,86 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	tb "go.uber.org/thriftrw/gen/testdata/builders"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuilderRequiredFields(t *testing.T) {
+	_, err := tb.NewShapeBuilder().Build()
+	assert.EqualError(t, err, "field Name of Shape is required")
+
+	_, err = tb.NewShapeBuilder().SetName("square").Build()
+	assert.EqualError(t, err, "field Origin of Shape is required")
+
+	_, err = tb.NewShapeBuilder().SetOrigin(&tb.Point{}).SetColor("red").Build()
+	assert.EqualError(t, err, "field Name of Shape is required")
+}
+
+func TestBuilderBuild(t *testing.T) {
+	origin := &tb.Point{X: 1, Y: 2}
+	shape, err := tb.NewShapeBuilder().
+		SetName("square").
+		SetOrigin(origin).
+		SetColor("red").
+		Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, "square", shape.Name)
+	assert.Equal(t, origin, shape.Origin)
+	assert.Equal(t, "red", shape.GetColor())
+	assert.Equal(t, int32(4), shape.GetSides(), "default must be set")
+	assert.Nil(t, shape.Tags, "unset optional fields must stay nil")
+
+	shape, err = tb.NewShapeBuilder().
+		SetName("triangle").
+		SetOrigin(origin).
+		SetSides(3).
+		SetTags([]string{"pointy"}).
+		Build()
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), shape.GetSides())
+	assert.Equal(t, []string{"pointy"}, shape.Tags)
+	assert.False(t, shape.IsSetColor())
+
+	// The round trip through ToWire checks that the result is valid.
+	v, err := shape.ToWire()
+	require.NoError(t, err)
+	var got tb.Shape
+	require.NoError(t, got.FromWire(v))
+	assert.True(t, shape.Equals(&got))
+}
+
+func TestBuilderDoesNotShareValues(t *testing.T) {
+	b := tb.NewShapeBuilder().SetName("square").SetOrigin(&tb.Point{})
+	first, err := b.Build()
+	require.NoError(t, err)
+
+	second, err := b.SetName("circle").Build()
+	require.NoError(t, err)
+	assert.Equal(t, "square", first.Name, "built values must not change with the builder")
+	assert.Equal(t, "circle", second.Name)
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// cloneGenerator is responsible for generating code that makes deep copies of
+// Thrift types.
+type cloneGenerator struct {
+	mapG  mapGenerator
+	setG  setGenerator
+	listG listGenerator
+	rawG  rawGenerator
+}
+
+// Clone generates an expression of the same type as the given TypeSpec which
+// holds a deep copy of the value in v.
+func (c *cloneGenerator) Clone(g Generator, spec compile.TypeSpec, v string) (string, error) {
+	if isPrimitiveType(spec) {
+		// Primitives, enums, and typedefs of those are copied by value.
+		return v, nil
+	}
+
+	switch s := spec.(type) {
+	case *compile.BinarySpec:
+		name := cloneFuncName(g, spec)
+		err := g.EnsureDeclared(
+			`
+				<$b := newVar "b">
+				<$o := newVar "o">
+				func <.>(<$b> []byte) []byte {
+					if <$b> == nil {
+						return nil
+					}
+
+					<$o> := make([]byte, len(<$b>))
+					copy(<$o>, <$b>)
+					return <$o>
+				}
+			`, name)
+		return fmt.Sprintf("%s(%s)", name, v), err
+	case *compile.MapSpec:
+		clone, err := c.mapG.Clone(g, s)
+		return fmt.Sprintf("%s(%s)", clone, v), err
+	case *compile.ListSpec:
+		clone, err := c.listG.Clone(g, s)
+		return fmt.Sprintf("%s(%s)", clone, v), err
+	case *compile.SetSpec:
+		clone, err := c.setG.Clone(g, s)
+		return fmt.Sprintf("%s(%s)", clone, v), err
+	case *rawSpec:
+		clone, err := c.rawG.Clone(g)
+		return fmt.Sprintf("%s(%s)", clone, v), err
+	default:
+		// Custom defined type
+		return fmt.Sprintf("%s.Clone()", v), nil
+	}
+}
+
+// ClonePtr is the same as Clone except that v is expected to be a reference
+// to a value of the given type. The generated expression is also a reference
+// to a value of that type.
+func (c *cloneGenerator) ClonePtr(g Generator, spec compile.TypeSpec, v string) (string, error) {
+	if !isPrimitiveType(spec) {
+		// Everything else is either a reference type or a pointer to a
+		// struct, both of which are handled by Clone.
+		return c.Clone(g, spec, v)
+	}
+
+	name := clonePtrFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$type := typeReference .Spec>
+			<$p := newVar "p">
+			<$x := newVar "x">
+			func <.Name>(<$p> *<$type>) *<$type> {
+				if <$p> == nil {
+					return nil
+				}
+
+				<$x> := *<$p>
+				return &<$x>
+			}
+		`,
+		struct {
+			Name string
+			Spec compile.TypeSpec
+		}{Name: name, Spec: spec},
+	)
+	return fmt.Sprintf("%s(%s)", name, v), err
+}
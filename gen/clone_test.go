@@ -0,0 +1,120 @@
+// Copyright (c) 2015 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+	"testing/quick"
+	"time"
+
+	tc "go.uber.org/thriftrw/gen/testdata/containers"
+	ts "go.uber.org/thriftrw/gen/testdata/structs"
+	td "go.uber.org/thriftrw/gen/testdata/typedefs"
+	"go.uber.org/thriftrw/ptr"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuickClone(t *testing.T) {
+	tests := []reflect.Type{
+		reflect.TypeOf(tc.PrimitiveContainers{}),
+		reflect.TypeOf(tc.PrimitiveContainersRequired{}),
+		reflect.TypeOf(tc.EnumContainers{}),
+		reflect.TypeOf(ts.PrimitiveRequiredStruct{}),
+		reflect.TypeOf(ts.PrimitiveOptionalStruct{}),
+		reflect.TypeOf(ts.ContactInfo{}),
+		reflect.TypeOf(ts.User{}),
+		reflect.TypeOf(tc.ContainersOfContainers{}),
+	}
+
+	rand := rand.New(rand.NewSource(time.Now().UnixNano()))
+	for _, tt := range tests {
+		for i := 0; i < 100; i++ {
+			structValue, ok := quick.Value(tt, rand)
+			if !ok {
+				t.Fatalf("failed to generate a value for %v", tt)
+			}
+
+			original := structValue.Addr()
+			cloned := original.MethodByName("Clone").Call(nil)[0]
+			assert.Equal(t, original.Interface(), cloned.Interface(),
+				"clone of %v does not match", tt)
+			assert.True(t, original.Pointer() != cloned.Pointer(),
+				"clone of %v must not be the same pointer", tt)
+		}
+	}
+}
+
+func TestCloneIsDeep(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		var u *ts.User
+		assert.Nil(t, u.Clone())
+	})
+
+	t.Run("struct", func(t *testing.T) {
+		u := &ts.User{Name: "foo", Contact: &ts.ContactInfo{EmailAddress: "foo@example.com"}}
+		c := u.Clone()
+		c.Contact.EmailAddress = "bar@example.com"
+		assert.Equal(t, "foo@example.com", u.Contact.EmailAddress)
+	})
+
+	t.Run("optional primitives and binary", func(t *testing.T) {
+		s := &ts.PrimitiveOptionalStruct{
+			Int32Field:  ptr.Int32(42),
+			BinaryField: []byte("hello"),
+		}
+		c := s.Clone()
+		*c.Int32Field = 1
+		c.BinaryField[0] = 'j'
+		assert.Equal(t, int32(42), *s.Int32Field)
+		assert.Equal(t, []byte("hello"), s.BinaryField)
+	})
+
+	t.Run("containers", func(t *testing.T) {
+		v := &tc.ContainersOfContainers{
+			ListOfLists: [][]int32{{1, 2}, {3}},
+			ListOfMaps:  []map[int32]int32{{1: 2}},
+			MapOfListToSet: []struct {
+				Key   []int32
+				Value map[int64]struct{}
+			}{{Key: []int32{1}, Value: map[int64]struct{}{2: {}}}},
+		}
+		c := v.Clone()
+		c.ListOfLists[0][0] = 100
+		c.ListOfMaps[0][1] = 100
+		c.MapOfListToSet[0].Key[0] = 100
+		delete(c.MapOfListToSet[0].Value, 2)
+
+		assert.Equal(t, [][]int32{{1, 2}, {3}}, v.ListOfLists)
+		assert.Equal(t, []map[int32]int32{{1: 2}}, v.ListOfMaps)
+		assert.Equal(t, []int32{1}, v.MapOfListToSet[0].Key)
+		assert.Len(t, v.MapOfListToSet[0].Value, 1)
+	})
+
+	t.Run("typedef", func(t *testing.T) {
+		s := td.BinarySet{[]byte("foo")}
+		c := s.Clone()
+		c[0][0] = 'g'
+		assert.Equal(t, td.BinarySet{[]byte("foo")}, s)
+	})
+}
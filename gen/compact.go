@@ -0,0 +1,385 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"sort"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// compactListValueList generates a function which returns a runtime.List
+// for the given list.
+//
+// 	func $valueListName(v $listType) runtime.List { ... }
+func compactListValueList(g Generator, spec *compile.ListSpec, name string) error {
+	return g.EnsureDeclared(
+		`
+			<$wire := import "go.uber.org/thriftrw/wire">
+			<$runtime := import "go.uber.org/thriftrw/runtime">
+
+			<$v := newVar "v">
+			<$i := newVar "i">
+			<$x := newVar "x">
+			func <.Name>(<$v> <typeReference .Spec>) <$runtime>.List {
+				return <$runtime>.List{
+					Type: <typeCode .Spec.ValueSpec>,
+					Len:  len(<$v>),
+					Item: func(<$i> int) (<$wire>.Value, error) {
+						<$x> := <$v>[<$i>]
+						<- if not (isPrimitiveType .Spec.ValueSpec)>
+							if <$x> == nil {
+								return <$wire>.Value{}, <import "fmt">.Errorf("invalid [%v]: value is nil", <$i>)
+							}
+						<- end>
+						return <if toWireCanFail .Spec.ValueSpec><toWire .Spec.ValueSpec $x><else><toWireValue .Spec.ValueSpec $x>, nil<end>
+					},
+				}
+			}
+		`,
+		struct {
+			Name string
+			Spec *compile.ListSpec
+		}{Name: name, Spec: spec},
+	)
+}
+
+// compactSetValueList generates a function which returns a runtime.List
+// or runtime.Set for the given set, depending on whether it is represented
+// as a slice or a map.
+//
+// 	func $valueListName(v $setType) runtime.Set { ... }
+func compactSetValueList(g Generator, spec *compile.SetSpec, name string) error {
+	return g.EnsureDeclared(
+		`
+			<$wire := import "go.uber.org/thriftrw/wire">
+			<$runtime := import "go.uber.org/thriftrw/runtime">
+
+			<$v := newVar "v">
+			<$i := newVar "i">
+			<$x := newVar "x">
+			<$f := newVar "f">
+			<$w := newVar "w">
+			<if isHashable .Spec.ValueSpec ->
+			func <.Name>(<$v> <typeReference .Spec>) <$runtime>.Set {
+				return <$runtime>.Set{
+					Type: <typeCode .Spec.ValueSpec>,
+					Len:  len(<$v>),
+					Range: func(<$f> func(<$wire>.Value) error) error {
+						for <$x> := range <$v> {
+						<if toWireCanFail .Spec.ValueSpec ->
+							<$w>, err := <toWire .Spec.ValueSpec $x>
+							if err != nil {
+								return err
+							}
+							if err = <$f>(<$w>); err != nil {
+								return err
+							}
+						<- else ->
+							if err := <$f>(<toWireValue .Spec.ValueSpec $x>); err != nil {
+								return err
+							}
+						<- end>
+						}
+						return nil
+					},
+				}
+			}
+			<- else ->
+			func <.Name>(<$v> <typeReference .Spec>) <$runtime>.List {
+				return <$runtime>.List{
+					Type: <typeCode .Spec.ValueSpec>,
+					Len:  len(<$v>),
+					Item: func(<$i> int) (<$wire>.Value, error) {
+						<$x> := <$v>[<$i>]
+						<- if not (isPrimitiveType .Spec.ValueSpec)>
+							if <$x> == nil {
+								return <$wire>.Value{}, <import "fmt">.Errorf("invalid set item: value is nil")
+							}
+						<- end>
+						return <if toWireCanFail .Spec.ValueSpec><toWire .Spec.ValueSpec $x><else><toWireValue .Spec.ValueSpec $x>, nil<end>
+					},
+				}
+			}
+			<- end>
+		`,
+		struct {
+			Name string
+			Spec *compile.SetSpec
+		}{Name: name, Spec: spec},
+	)
+}
+
+// compactMapItemList generates a function which returns a runtime.Map for
+// the given map.
+//
+// 	func $mapItemListName(m $mapType) runtime.Map { ... }
+func compactMapItemList(g Generator, spec *compile.MapSpec, name string) error {
+	return g.EnsureDeclared(
+		`
+			<$wire := import "go.uber.org/thriftrw/wire">
+			<$runtime := import "go.uber.org/thriftrw/runtime">
+
+			<$m := newVar "m">
+			<$f := newVar "f">
+			<$k := newVar "k">
+			<$v := newVar "v">
+			<$i := newVar "i">
+			<$kw := newVar "kw">
+			<$vw := newVar "vw">
+			func <.Name>(<$m> <typeReference .Spec>) <$runtime>.Map {
+				return <$runtime>.Map{
+					Key:   <typeCode .Spec.KeySpec>,
+					Value: <typeCode .Spec.ValueSpec>,
+					Len:   len(<$m>),
+					Range: func(<$f> func(<$wire>.MapItem) error) error {
+						<- if isHashable .Spec.KeySpec ->
+							for <$k>, <$v> := range <$m> {
+						<else ->
+							for _, <$i> := range <$m> {
+								<$k> := <$i>.Key
+								<$v> := <$i>.Value
+						<end>
+								<- if not (isPrimitiveType .Spec.KeySpec) ->
+									if <$k> == nil {
+										return <import "fmt">.Errorf("invalid map key: value is nil")
+									}
+								<end ->
+								<- if not (isPrimitiveType .Spec.ValueSpec) ->
+									if <$v> == nil {
+										return <import "fmt">.Errorf("invalid [%v]: value is nil", <$k>)
+									}
+								<end ->
+
+							<if toWireCanFail .Spec.KeySpec ->
+								<$kw>, err := <toWire .Spec.KeySpec $k>
+								if err != nil {
+									return err
+								}
+							<- else ->
+								<$kw> := <toWireValue .Spec.KeySpec $k>
+							<- end>
+
+							<if toWireCanFail .Spec.ValueSpec ->
+								<$vw>, err := <toWire .Spec.ValueSpec $v>
+								if err != nil {
+									return err
+								}
+							<- else ->
+								<$vw> := <toWireValue .Spec.ValueSpec $v>
+							<- end>
+								if err <if or (toWireCanFail .Spec.KeySpec) (toWireCanFail .Spec.ValueSpec)>=<else>:=<end> <$f>(<$wire>.MapItem{Key: <$kw>, Value: <$vw>}); err != nil {
+									return err
+								}
+							}
+						return nil
+					},
+				}
+			}
+		`,
+		struct {
+			Name string
+			Spec *compile.MapSpec
+		}{Name: name, Spec: spec},
+	)
+}
+
+// compactFromWire generates a FromWire method for the given field group
+// which decodes fields with a table of runtime.FieldReaders, sorted by ID,
+// instead of a switch statement.
+func (f fieldGroupGenerator) compactFromWire(g Generator) error {
+	sorted := make(compile.FieldGroup, len(f.Fields))
+	copy(sorted, f.Fields)
+	sort.Sort(fieldsByID(sorted))
+
+	var hasRequired bool
+	for _, field := range f.Fields {
+		if field.Required && field.Default == nil {
+			hasRequired = true
+		}
+	}
+
+	var u utf8Generator
+	return g.DeclareFromTemplate(
+		`
+		<$wire := import "go.uber.org/thriftrw/wire">
+		<$runtime := import "go.uber.org/thriftrw/runtime">
+		<$readers := printf "_%v_fieldReaders" .Name>
+
+		var <$readers> <$runtime>.FieldReaders
+
+		<$s := newVar "s">
+		<$x := newVar "value">
+		<$v := newVar "v">
+		func init() {
+			<$readers> = <$runtime>.FieldReaders{
+				<range .Sorted ->
+				{
+					ID: <.ID>,
+					Type: <typeCode .Type>,
+					<- $lhs := printf "%s.%s" $v (fieldName .)>
+					Read: func(<$s> interface{}, <$x> <$wire>.Value) (err error) {
+						<$v> := <$s>.(*<$.Name>)
+						<if fromWireCanFail .Type ->
+							<if or .Required (isOptionalValue .) ->
+								<$lhs>, err = <fromWire .Type $x>
+							<- else ->
+								<fromWirePtr .Type $lhs $x>
+							<- end>
+							if err != nil {
+								return err
+							}
+						<- else if or .Required (isOptionalValue .) ->
+							<$lhs> = <fromWireValue .Type $x>
+						<- else ->
+							<fromWirePtr .Type $lhs $x>
+						<- end>
+						<- if checkUTF8 .>
+						if !<if or .Required (isOptionalValue .)><validUTF8 .Type $lhs><else><validUTF8Ptr .Type $lhs><end> {
+							return <import "errors">.New("field <goName .> of <$.Name> is not valid UTF-8")
+						}
+						<- end>
+						<- if isOptionalValue .>
+						<markSet $v .>
+						<- end>
+						return nil
+					},
+				},
+				<end>
+			}
+		}
+
+		<$w := newVar "w">
+		<$isSet := newVar "isSet">
+		// FromWire deserializes a <.Name> struct from its Thrift-level
+		// representation. The Thrift-level representation may be obtained
+		// from a ThriftRW protocol implementation.
+		//
+		// An error is returned if we were unable to build a <.Name> struct
+		// from the provided intermediate representation.
+		//
+		//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+		//   if err != nil {
+		//     return nil, err
+		//   }
+		//
+		//   var <$v> <.Name>
+		//   if err := <$v>.FromWire(x); err != nil {
+		//     return nil, err
+		//   }
+		//   return &<$v>, nil
+		func (<$v> *<.Name>) FromWire(<$w> <$wire>.Value) error {
+			<- $unknown := newVar "unknown">
+			<- if .IsResult>
+				var <$unknown> <$wire>.UnknownFields
+			<- end>
+			<- if .HasRequired>
+				var <$isSet> [<len .Sorted>]bool
+				if err := <$readers>.Read(<$v>, <$w>.GetStruct(), <$isSet>[:], <if .IsResult>&<$unknown><else><unknown $v><end>); err != nil {
+					return err
+				}
+			<- else>
+				if err := <$readers>.Read(<$v>, <$w>.GetStruct(), nil, <if .IsResult>&<$unknown><else><unknown $v><end>); err != nil {
+					return err
+				}
+			<- end>
+			<- if .IsResult>
+				<$v>.unexpectedException = <import "go.uber.org/thriftrw/runtime">.FindUnexpectedException("<.Name>", <$unknown>)
+			<- end>
+
+			<range .Fields>
+				<$fname := goName .>
+				<$f := printf "%s.%s" $v (fieldName .)>
+				<if and .Default (isOptionalValue .)>
+					if !<$v>.IsSet<$fname>() {
+						<$v>.<setterName .>(<constantValue .Default .Type>)
+					}
+				<else if .Default>
+					if <$f> == nil {
+						<$f> = <constantValuePtr .Default .Type>
+					}
+				<end>
+			<end>
+
+			<range $i, $f := .Sorted>
+				<if and .Required (not .Default)>
+					if !<$isSet>[<$i>] {
+						return <import "errors">.New("field <goName .> of <$.Name> is required")
+					}
+				<end>
+			<end>
+
+			<if and .IsUnion (len .Fields)>
+				<$fmt := import "fmt">
+				<$count := newVar "count">
+				<$count> := 0
+				<range .Fields ->
+					if <$v>.<fieldName .> != nil {
+						<$count>++
+					}
+				<end>
+				<- if .IsResult ->
+					if <$v>.unexpectedException != nil {
+						<$count>++
+					}
+				<end>
+				<- if .AllowEmptyUnion ->
+					if <$count> > 1 {
+						return <$fmt>.Errorf( "<.Name> should have at most one field: got %v fields", <$count>)
+					}
+				<- else ->
+					if <$count> != 1 {
+						return <$fmt>.Errorf( "<.Name> should have exactly one field: got %v fields", <$count>)
+					}
+				<- end>
+			<end>
+			return nil
+		}
+		`,
+		struct {
+			fieldGroupGenerator
+
+			Sorted      compile.FieldGroup
+			HasRequired bool
+		}{fieldGroupGenerator: f, Sorted: sorted, HasRequired: hasRequired},
+		append(f.optionalValueFuncs(),
+			TemplateFunc("constantValue", ConstantValue),
+			TemplateFunc("constantValuePtr", ConstantValuePtr),
+			TemplateFunc("checkUTF8", checkUTF8),
+			TemplateFunc("validUTF8", u.ValidUTF8),
+			TemplateFunc("validUTF8Ptr", u.ValidUTF8Ptr),
+			TemplateFunc("unknown", func(v string) string {
+				if f.PreserveUnknownFields {
+					return "&" + v + ".UnknownFields"
+				}
+
+				return "nil"
+			}),
+		)...,
+	)
+}
+
+// fieldsByID sorts fields by their IDs.
+type fieldsByID compile.FieldGroup
+
+func (fs fieldsByID) Len() int           { return len(fs) }
+func (fs fieldsByID) Less(i, j int) bool { return fs[i].ID < fs[j].ID }
+func (fs fieldsByID) Swap(i, j int)      { fs[i], fs[j] = fs[j], fs[i] }
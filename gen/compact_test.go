@@ -0,0 +1,232 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	tc "go.uber.org/thriftrw/gen/testdata/compact_code"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompactCodeRoundTrip(t *testing.T) {
+	origin := &tc.Point{X: 0, Y: 0}
+	unit := &tc.Point{X: 1, Y: 1}
+	point := func(x, y float64) wire.Value {
+		return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueDouble(x)},
+			{ID: 2, Value: wire.NewValueDouble(y)},
+		}})
+	}
+
+	tests := []struct {
+		desc string
+		x    thriftType
+		v    wire.Value
+	}{
+		{
+			desc: "lists",
+			x: &tc.Shapes{
+				Points: []*tc.Point{origin, unit},
+				Path:   tc.Path{unit},
+				Matrix: [][]int32{{1, 2}, {}},
+			},
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TStruct, []wire.Value{
+					point(0, 0), point(1, 1),
+				}))},
+				{ID: 2, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TStruct, []wire.Value{
+					point(1, 1),
+				}))},
+				{ID: 3, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TList, []wire.Value{
+					wire.NewValueList(wire.ValueListFromSlice(wire.TI32, []wire.Value{
+						wire.NewValueI32(1), wire.NewValueI32(2),
+					})),
+					wire.NewValueList(wire.ValueListFromSlice(wire.TI32, []wire.Value{})),
+				}))},
+			}}),
+		},
+		{
+			desc: "sets",
+			x: &tc.Shapes{
+				Tags:         map[string]struct{}{"a": {}},
+				UniquePoints: []*tc.Point{unit},
+				Blobs:        [][]byte{[]byte("b")},
+			},
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 4, Value: wire.NewValueSet(wire.ValueListFromSlice(wire.TBinary, []wire.Value{
+					wire.NewValueString("a"),
+				}))},
+				{ID: 5, Value: wire.NewValueSet(wire.ValueListFromSlice(wire.TStruct, []wire.Value{
+					point(1, 1),
+				}))},
+				{ID: 6, Value: wire.NewValueSet(wire.ValueListFromSlice(wire.TBinary, []wire.Value{
+					wire.NewValueBinary([]byte("b")),
+				}))},
+			}}),
+		},
+		{
+			desc: "maps",
+			x: &tc.Shapes{
+				Named: map[string]*tc.Point{"origin": origin},
+				Colors: []struct {
+					Key   *tc.Point
+					Value tc.Color
+				}{{Key: unit, Value: tc.ColorBlue}},
+				ByColor: map[tc.Color][]*tc.Point{tc.ColorRed: {origin}},
+			},
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 7, Value: wire.NewValueMap(wire.MapItemListFromSlice(wire.TBinary, wire.TStruct, []wire.MapItem{
+					{Key: wire.NewValueString("origin"), Value: point(0, 0)},
+				}))},
+				{ID: 8, Value: wire.NewValueMap(wire.MapItemListFromSlice(wire.TStruct, wire.TI32, []wire.MapItem{
+					{Key: point(1, 1), Value: wire.NewValueI32(2)},
+				}))},
+				{ID: 9, Value: wire.NewValueMap(wire.MapItemListFromSlice(wire.TI32, wire.TList, []wire.MapItem{
+					{
+						Key: wire.NewValueI32(0),
+						Value: wire.NewValueList(wire.ValueListFromSlice(wire.TStruct, []wire.Value{
+							point(0, 0),
+						})),
+					},
+				}))},
+			}}),
+		},
+		{
+			desc: "union",
+			x:    &tc.Shape{Properties: map[string]float64{"area": 1}},
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 2, Value: wire.NewValueMap(wire.MapItemListFromSlice(wire.TBinary, wire.TDouble, []wire.MapItem{
+					{Key: wire.NewValueString("area"), Value: wire.NewValueDouble(1)},
+				}))},
+			}}),
+		},
+	}
+
+	for _, tt := range tests {
+		assertRoundTrip(t, tt.x, tt.v, tt.desc)
+	}
+}
+
+func TestCompactCodeNilItems(t *testing.T) {
+	tests := []struct {
+		desc    string
+		give    *tc.Shapes
+		wantErr string
+	}{
+		{
+			desc:    "list",
+			give:    &tc.Shapes{Points: []*tc.Point{{}, nil}},
+			wantErr: "invalid [1]: value is nil",
+		},
+		{
+			desc:    "set",
+			give:    &tc.Shapes{UniquePoints: []*tc.Point{nil}},
+			wantErr: "invalid set item: value is nil",
+		},
+		{
+			desc:    "map value",
+			give:    &tc.Shapes{Named: map[string]*tc.Point{"foo": nil}},
+			wantErr: "invalid [foo]: value is nil",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			// Errors for container items are reported when the value is
+			// evaluated, not when it is converted to its wire
+			// representation.
+			w, err := tt.give.ToWire()
+			if err == nil {
+				err = wire.EvaluateValue(w)
+			}
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestCompactCodeFromWire(t *testing.T) {
+	name := func(s string) wire.Field {
+		return wire.Field{ID: 3, Value: wire.NewValueString(s)}
+	}
+
+	tests := []struct {
+		desc    string
+		give    wire.Value
+		want    *tc.Node
+		wantErr string
+	}{
+		{
+			desc: "defaults",
+			give: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{name("a")}}),
+			want: &tc.Node{Name: "a", Weight: int32p(1)},
+		},
+		{
+			desc: "nested",
+			give: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				name("a"),
+				{ID: 2, Value: wire.NewValueI32(3)},
+				{ID: 1, Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{name("b")}})},
+			}}),
+			want: &tc.Node{
+				Name:   "a",
+				Weight: int32p(3),
+				Child:  &tc.Node{Name: "b", Weight: int32p(1)},
+			},
+		},
+		{
+			desc: "unknown fields and mismatched types are ignored",
+			give: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 2, Value: wire.NewValueString("heavy")},
+				{ID: 4, Value: wire.NewValueI32(42)},
+				name("a"),
+			}}),
+			want: &tc.Node{Name: "a", Weight: int32p(1)},
+		},
+		{
+			desc:    "missing required field",
+			give:    wire.NewValueStruct(wire.Struct{Fields: []wire.Field{{ID: 2, Value: wire.NewValueI32(3)}}}),
+			wantErr: "field Name of Node is required",
+		},
+		{
+			desc: "missing required field in nested struct",
+			give: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				name("a"),
+				{ID: 1, Value: wire.NewValueStruct(wire.Struct{})},
+			}}),
+			wantErr: "field Name of Node is required",
+		},
+	}
+
+	for _, tt := range tests {
+		var got tc.Node
+		err := got.FromWire(tt.give)
+		if tt.wantErr != "" {
+			assert.EqualError(t, err, tt.wantErr, tt.desc)
+			continue
+		}
+		if assert.NoError(t, err, tt.desc) {
+			assert.Equal(t, tt.want, &got, tt.desc)
+		}
+	}
+}
@@ -23,8 +23,10 @@ package gen
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/wire"
 )
 
 // Constant generates code for `const` expressions in Thrift files.
@@ -58,6 +60,9 @@ func ConstantValue(g Generator, c compile.ConstantValue, t compile.TypeSpec) (st
 	case compile.ConstantSet:
 		return constantSet(g, v, t)
 	case compile.ConstantString:
+		if _, isUUID := compile.RootTypeSpec(t).(*compile.UUIDSpec); isUUID {
+			return constantUUID(g, v, t)
+		}
 		return strconv.Quote(string(v)), nil
 	case *compile.ConstantStruct:
 		return constantStruct(g, v, t)
@@ -129,6 +134,20 @@ func constantList(g Generator, v compile.ConstantList, t compile.TypeSpec) (stri
 		TemplateFunc("constantValue", ConstantValue))
 }
 
+func constantUUID(g Generator, v compile.ConstantString, t compile.TypeSpec) (string, error) {
+	u, err := wire.ParseUUID(string(v))
+	if err != nil {
+		return "", err
+	}
+
+	return g.TextTemplate(
+		`<typeName .Spec>{<range $i, $b := .UUID><if $i>, <end><printf "0x%02x" $b><end>}`,
+		struct {
+			Spec compile.TypeSpec
+			UUID wire.UUID
+		}{Spec: t, UUID: u})
+}
+
 func constantMap(g Generator, v compile.ConstantMap, t compile.TypeSpec) (string, error) {
 	mapSpec := compile.RootTypeSpec(t).(*compile.MapSpec)
 	keySpec := mapSpec.KeySpec
@@ -179,24 +198,102 @@ func constantSet(g Generator, v compile.ConstantSet, t compile.TypeSpec) (string
 }
 
 func constantStruct(g Generator, v *compile.ConstantStruct, t compile.TypeSpec) (string, error) {
-	fields := compile.RootTypeSpec(t).(*compile.StructSpec).Fields
+	// Fields are listed in the order in which they were declared in the
+	// struct.
+	type fieldValue struct {
+		Field *compile.FieldSpec
+		Value compile.ConstantValue
+	}
+
+	spec := compile.RootTypeSpec(t).(*compile.StructSpec)
+	optionalValues, err := optionalValueFields(g, spec, spec.Fields)
+	if err != nil {
+		return "", err
+	}
+
+	// Optional value fields are set with their setters so that they are
+	// marked as set even if they are set to their zero values. All fields
+	// of immutable structs are set with the setters of their builders.
+	var values, setters []fieldValue
+	for _, f := range spec.Fields {
+		value, ok := v.Fields[f.Name]
+		if !ok {
+			continue
+		}
+
+		if _, raw := f.Annotations[rawKey]; raw {
+			return "", fmt.Errorf(
+				"field %q of %q cannot be set in a constant because it has a %v annotation",
+				f.Name, t.ThriftName(), rawKey)
+		}
+		if _, ok := optionalValues[f]; ok || generatorOptions(g).Immutable {
+			setters = append(setters, fieldValue{Field: f, Value: value})
+		} else {
+			values = append(values, fieldValue{Field: f, Value: value})
+		}
+	}
+
+	// Fields of immutable structs can only be set with their builders.
+	var newBuilder string
+	if generatorOptions(g).Immutable && len(setters) > 0 {
+		name, err := typeName(g, spec)
+		if err != nil {
+			return "", err
+		}
+		i := strings.LastIndex(name, ".") + 1
+		newBuilder = name[:i] + "New" + name[i:] + "Builder"
+	}
+
 	return g.TextTemplate(
 		`
-		<- $fields := .Fields ->
+		<- if .NewBuilder ->
+			<- $v := newVar "v" ->
+			<- $err := newVar "err" ->
+			func() *<typeName .Spec> {
+				<$v>, <$err> := <.NewBuilder>()<range .Setters>.
+					Set<goName .Field>(<constantValue .Value .Field.Type>)<end>.
+					Build()
+				if <$err> != nil {
+					panic(<$err>)
+				}
+				return <if .Typedef>(*<typeName .Spec>)(<$v>)<else><$v><end>
+			}()
+		<- else if .Setters ->
+			<- $v := newVar "v" ->
+			func() *<typeName .Spec> {
+				<$v> := <template "literal" .>
+				<range .Setters ->
+					<$v>.Set<goName .Field>(<constantValue .Value .Field.Type>)
+				<end ->
+				return <$v>
+			}()
+		<- else ->
+			<- template "literal" . ->
+		<- end>
+
+		<- define "literal" ->
 		&<typeName .Spec>{
-			<range $name, $value := .Value.Fields>
-				<- $field := $fields.FindByName $name ->
-				<- if and (not $field.Required) (isPrimitiveType $field.Type) ->
-					<goName $field>: <constantValuePtr $value $field.Type>,
+			<range .Values>
+				<- if and (not .Field.Required) (isPrimitiveType .Field.Type) ->
+					<goName .Field>: <constantValuePtr .Value .Field.Type>,
 				<- else ->
-					<goName $field>: <constantValue $value $field.Type>,
+					<goName .Field>: <constantValue .Value .Field.Type>,
 				<- end>
 			<end>
-		}`, struct {
-			Spec   compile.TypeSpec
-			Fields compile.FieldGroup
-			Value  *compile.ConstantStruct
-		}{Spec: t, Fields: fields, Value: v},
+		}
+		<- end>`, struct {
+			Spec       compile.TypeSpec
+			Values     []fieldValue
+			Setters    []fieldValue
+			NewBuilder string
+			Typedef    bool
+		}{
+			Spec:       t,
+			Values:     values,
+			Setters:    setters,
+			NewBuilder: newBuilder,
+			Typedef:    t != spec,
+		},
 		TemplateFunc("constantValue", ConstantValue),
 		TemplateFunc("constantValuePtr", ConstantValuePtr),
 	)
@@ -234,7 +331,7 @@ func ConstantValuePtr(g Generator, c compile.ConstantValue, t compile.TypeSpec)
 		ptrFunc = fmt.Sprintf("%v.Float64", g.Import("go.uber.org/thriftrw/ptr"))
 	case *compile.StringSpec:
 		ptrFunc = fmt.Sprintf("%v.String", g.Import("go.uber.org/thriftrw/ptr"))
-	case *compile.EnumSpec, *compile.TypedefSpec:
+	case *compile.UUIDSpec, *compile.EnumSpec, *compile.TypedefSpec:
 		ptrFunc = fmt.Sprintf("_%s_ptr", g.MangleType(t))
 		err := g.EnsureDeclared(
 			`func <.Name>(v <typeReference .Spec>) *<typeReference .Spec> {
@@ -0,0 +1,68 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import "go.uber.org/thriftrw/compile"
+
+// functionArgsDriftSchema generates a DriftSchema method on the arguments
+// struct of the given function which servers may use to detect callers that
+// send fields they don't know about, or don't send required fields.
+func functionArgsDriftSchema(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpec) error {
+	if !generatorOptions(g).DriftSchemas {
+		return nil
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$f := .Function>
+		<$prefix := namePrefix .Service $f>
+
+		<$drift := import "go.uber.org/thriftrw/drift">
+		<$v := newVar "v">
+
+		// DriftSchema returns the fields of <$prefix>Args known to
+		// this code.
+		//
+		// Servers may compare the arguments they receive against this with a
+		// drift.Reporter to find callers which use a different version of
+		// the Thrift file.
+		func (<$v> *<$prefix>Args) DriftSchema() <$drift>.Schema {
+			return <$drift>.Schema{
+				Name: "<$prefix>Args",
+				<if $f.ArgsSpec ->
+				Fields: []<$drift>.Field{
+					<range $f.ArgsSpec ->
+						{ID: <.ID>, Name: "<.Name>", Required: <.Required>},
+					<end>
+				},
+				<end ->
+			}
+		}
+		`, struct {
+			Service  *compile.ServiceSpec
+			Function *compile.FunctionSpec
+		}{
+			Service:  s,
+			Function: f,
+		},
+		TemplateFunc("namePrefix", functionNamePrefix),
+	)
+}
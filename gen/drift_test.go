@@ -0,0 +1,83 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/drift"
+	td "go.uber.org/thriftrw/gen/testdata/drift_schemas"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDriftSchemaMatchesSpec(t *testing.T) {
+	module, err := compile.Compile("testdata/thrift/drift_schemas.thrift")
+	require.NoError(t, err)
+	service := module.Services["Inventory"]
+
+	tests := []struct {
+		function string
+		schema   drift.Schema
+	}{
+		{"count", (&td.Inventory_Count_Args{}).DriftSchema()},
+		{"restock", (&td.Inventory_Restock_Args{}).DriftSchema()},
+		{"ping", (&td.Inventory_Ping_Args{}).DriftSchema()},
+	}
+
+	for _, tt := range tests {
+		spec := service.Functions[tt.function]
+
+		var want []drift.Field
+		for _, f := range spec.ArgsSpec {
+			want = append(want, drift.Field{ID: f.ID, Name: f.Name, Required: f.Required})
+		}
+		assert.Equal(t, want, tt.schema.Fields, tt.function)
+	}
+}
+
+func TestDriftSchemaCompare(t *testing.T) {
+	args := td.Inventory_Count_Helper.Args("abc", nil)
+	schema := args.DriftSchema()
+
+	v, err := args.ToWire()
+	require.NoError(t, err)
+	assert.True(t, drift.Compare(schema, v).IsEmpty(),
+		"arguments built by the generated code must not drift")
+
+	// A newer caller which does not send the sku but sends a field this
+	// code does not know about.
+	v = wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 2, Value: wire.NewValueString("east")},
+		{ID: 3, Value: wire.NewValueBool(true)},
+	}})
+	assert.Equal(t, drift.Drift{
+		Unknown: []int16{3},
+		Missing: []string{"sku"},
+	}, drift.Compare(schema, v))
+
+	v, err = td.Inventory_Ping_Helper.Args().ToWire()
+	require.NoError(t, err)
+	assert.True(t, drift.Compare((&td.Inventory_Ping_Args{}).DriftSchema(), v).IsEmpty())
+}
@@ -21,11 +21,10 @@
 package gen
 
 import (
-	"crypto/sha1"
-	"encoding/hex"
 	"sort"
 
 	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/version"
 )
 
 // embedIDL generate Go code with a full copy of the IDL embeded.
@@ -39,10 +38,9 @@ func embedIDL(g Generator, i thriftPackageImporter, m *compile.Module) error {
 		return wrapGenerateError("idl embedding", err)
 	}
 
-	hash := sha1.Sum(m.Raw)
 	var includes []string
-	for _, v := range m.Includes {
-		importPath, err := i.Package(v.Module.ThriftPath)
+	for _, name := range sortStringKeys(m.Includes) {
+		importPath, err := i.Package(m.Includes[name].Module.ThriftPath)
 		if err != nil {
 			return wrapGenerateError("idl embedding", err)
 		}
@@ -56,13 +54,15 @@ func embedIDL(g Generator, i thriftPackageImporter, m *compile.Module) error {
 		Package  string
 		FilePath string
 		SHA1     string
+		Version  string
 		Includes []string
 		Raw      []byte
 	}{
 		Name:     m.Name,
 		Package:  pkg,
 		FilePath: packageRelPath,
-		SHA1:     hex.EncodeToString(hash[:]),
+		SHA1:     idlSHA1(m),
+		Version:  version.Version,
 		Includes: includes,
 		Raw:      m.Raw,
 	}
@@ -75,6 +75,7 @@ func embedIDL(g Generator, i thriftPackageImporter, m *compile.Module) error {
 			Package: "<.Package>",
 			FilePath: <printf "%q" .FilePath>,
 			SHA1: "<.SHA1>",
+			ThriftRWVersion: "<.Version>",
 			<if .Includes ->
 				Includes: []*<$idl>.ThriftModule {<range .Includes>
 						<.>.ThriftModule, <end>
@@ -56,7 +56,7 @@ func enum(g Generator, spec *compile.EnumSpec) error {
 	items := enumUniqueItems(spec.Items)
 
 	// TODO(abg) define an error type in the library for unrecognized enums.
-	err := g.DeclareFromTemplate(
+	err := g.DeclareFromTemplate(overrideTemplate(g, "enum",
 		`
 		<$bytes := import "bytes">
 		<$fmt := import "fmt">
@@ -214,7 +214,7 @@ func enum(g Generator, spec *compile.EnumSpec) error {
 				return <$fmt>.Errorf("invalid JSON value %q (%T) to unmarshal into %q", <$t>, <$t>, "<$enumName>")
 			}
 		}
-		`,
+		`),
 		struct {
 			Spec        *compile.EnumSpec
 			UniqueItems []compile.EnumItem
@@ -224,8 +224,20 @@ func enum(g Generator, spec *compile.EnumSpec) error {
 		},
 		TemplateFunc("enumItemName", enumItemName),
 	)
+	if err != nil {
+		return wrapGenerateError(spec.Name, err)
+	}
+
+	if err := enumSet(g, spec, items); err != nil {
+		return err
+	}
+
+	if !generatorOptions(g).Zap {
+		return nil
+	}
 
-	return wrapGenerateError(spec.Name, err)
+	var z zapGenerator
+	return z.zapEnum(g, spec)
 }
 
 // enumItemName returns the Go name that should be used for an enum item with
@@ -0,0 +1,133 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import "go.uber.org/thriftrw/compile"
+
+// enumSet generates an Ordinal method for the given enum which maps its
+// recognized values to consecutive integers, and a Foo_Set type which is a
+// set of Foo values backed by a bitset.
+//
+// items must be the unique items of the enum.
+func enumSet(g Generator, spec *compile.EnumSpec, items []compile.EnumItem) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	err := g.DeclareFromTemplate(
+		`
+		<$enumName := goName .Spec>
+		<$set := printf "%v_Set" $enumName>
+		<$v := newVar "v">
+		<$s := newVar "s">
+		<$i := newVar "i">
+		<$n := newVar "n">
+		<$x := newVar "x">
+
+		// <$enumName>_NumValues is the number of distinct recognized
+		// values of <$enumName>.
+		const <$enumName>_NumValues = <len .Items>
+
+		// Ordinal returns the position of this value among the distinct
+		// recognized values of <$enumName> or false if the value is not
+		// recognized. Ordinals are less than <$enumName>_NumValues.
+		//
+		// Ordinals may be used to index arrays of length
+		// <$enumName>_NumValues in place of map[<$enumName>]T.
+		//
+		//   var counts [<$enumName>_NumValues]int
+		//   if <$i>, ok := <$v>.Ordinal(); ok {
+		//     counts[<$i>]++
+		//   }
+		func (<$v> <$enumName>) Ordinal() (int, bool) {
+			switch int32(<$v>) {
+			<range $idx, $item := .Items ->
+				case <$item.Value>:
+					return <$idx>, true
+			<end ->
+			default:
+				return 0, false
+			}
+		}
+
+		// <$set> is a set of <$enumName> values backed by a
+		// bitset. The zero value is an empty set.
+		type <$set> struct {
+			bits [<words (len .Items)>]uint64
+		}
+
+		// Add adds the given value to the set. It returns false if the value
+		// is not a recognized value of <$enumName>.
+		func (<$s> *<$set>) Add(<$v> <$enumName>) bool {
+			<$i>, ok := <$v>.Ordinal()
+			if ok {
+				<$s>.bits[<$i>/64] |= 1 <raw><<<endraw> uint(<$i>%64)
+			}
+			return ok
+		}
+
+		// Remove removes the given value from the set.
+		func (<$s> *<$set>) Remove(<$v> <$enumName>) {
+			if <$i>, ok := <$v>.Ordinal(); ok {
+				<$s>.bits[<$i>/64] &^= 1 <raw><<<endraw> uint(<$i>%64)
+			}
+		}
+
+		// Contains returns true if the given value is in the set.
+		func (<$s> *<$set>) Contains(<$v> <$enumName>) bool {
+			<$i>, ok := <$v>.Ordinal()
+			return ok && <$s>.bits[<$i>/64]&(1<raw><<<endraw>uint(<$i>%64)) != 0
+		}
+
+		// Len returns the number of values in the set.
+		func (<$s> *<$set>) Len() int {
+			<$n> := 0
+			for _, <$x> := range <$s>.bits {
+				for ; <$x> != 0; <$n>++ {
+					<$x> &= <$x> - 1
+				}
+			}
+			return <$n>
+		}
+
+		// Values returns the values in the set in the order in which they
+		// were declared.
+		func (<$s> *<$set>) Values() []<$enumName> {
+			<$v> := make([]<$enumName>, 0, <$s>.Len())
+			<range $idx, $item := .Items ->
+				if <$s>.bits[<div $idx 64>]&(1<raw><<<endraw><mod $idx 64>) != 0 {
+					<$v> = append(<$v>, <enumItemName $enumName $item>)
+				}
+			<end ->
+			return <$v>
+		}
+		`,
+		struct {
+			Spec  *compile.EnumSpec
+			Items []compile.EnumItem
+		}{Spec: spec, Items: items},
+		TemplateFunc("enumItemName", enumItemName),
+		TemplateFunc("words", func(n int) int { return (n + 63) / 64 }),
+		TemplateFunc("div", func(a, b int) int { return a / b }),
+		TemplateFunc("mod", func(a, b int) int { return a % b }),
+	)
+	return wrapGenerateError(spec.Name, err)
+}
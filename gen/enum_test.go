@@ -357,3 +357,52 @@ func TestEnumAccessors(t *testing.T) {
 		})
 	})
 }
+
+func TestEnumOrdinal(t *testing.T) {
+	tests := []struct {
+		give interface {
+			Ordinal() (int, bool)
+		}
+		want   int
+		wantOK bool
+	}{
+		{te.EnumDefaultFoo, 0, true},
+		{te.EnumDefaultBaz, 2, true},
+		{te.EnumWithValuesY, 1, true},
+		{te.EnumWithValues(42), 0, false},
+		{te.EnumWithDuplicateValuesR, 0, true},
+		{te.EnumWithDuplicateValuesQ, 1, true},
+	}
+
+	for _, tt := range tests {
+		got, ok := tt.give.Ordinal()
+		assert.Equal(t, tt.wantOK, ok, "Ordinal(%v)", tt.give)
+		assert.Equal(t, tt.want, got, "Ordinal(%v)", tt.give)
+	}
+
+	assert.Equal(t, 3, te.EnumWithValues_NumValues)
+	assert.Equal(t, 2, te.EnumWithDuplicateValues_NumValues)
+}
+
+func TestEnumSet(t *testing.T) {
+	var s te.EnumWithValues_Set
+	assert.Equal(t, 0, s.Len())
+	assert.Empty(t, s.Values())
+
+	assert.True(t, s.Add(te.EnumWithValuesZ))
+	assert.True(t, s.Add(te.EnumWithValuesX))
+	assert.True(t, s.Add(te.EnumWithValuesX))
+	assert.False(t, s.Add(te.EnumWithValues(42)), "unknown values cannot be added")
+
+	assert.Equal(t, 2, s.Len())
+	assert.True(t, s.Contains(te.EnumWithValuesX))
+	assert.False(t, s.Contains(te.EnumWithValuesY))
+	assert.False(t, s.Contains(te.EnumWithValues(42)))
+	assert.Equal(t, []te.EnumWithValues{te.EnumWithValuesX, te.EnumWithValuesZ}, s.Values())
+
+	s.Remove(te.EnumWithValuesX)
+	s.Remove(te.EnumWithValues(42))
+	assert.Equal(t, 1, s.Len())
+	assert.False(t, s.Contains(te.EnumWithValuesX))
+	assert.Equal(t, []te.EnumWithValues{te.EnumWithValuesZ}, s.Values())
+}
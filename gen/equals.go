@@ -32,6 +32,7 @@ type equalsGenerator struct {
 	mapG  mapGenerator
 	setG  setGenerator
 	listG listGenerator
+	rawG  rawGenerator
 }
 
 // Equals generates a string comparing rhs to the given lhs.
@@ -56,6 +57,9 @@ func (e *equalsGenerator) Equals(g Generator, spec compile.TypeSpec, lhs, rhs st
 	case *compile.SetSpec:
 		equals, err := e.setG.Equals(g, s)
 		return fmt.Sprintf("%s(%s, %s)", equals, lhs, rhs), err
+	case *rawSpec:
+		equals, err := e.rawG.Equals(g)
+		return fmt.Sprintf("%s(%s, %s)", equals, lhs, rhs), err
 	default:
 		// Custom defined type
 		return fmt.Sprintf("%s.Equals(%s)", lhs, rhs), nil
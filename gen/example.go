@@ -0,0 +1,188 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+// exampleUUID is the value used for UUIDs in generated examples.
+const exampleUUID = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+
+// examples generates runnable Example functions for the structs, unions, and
+// exceptions of the given module. Each example builds a value of the type,
+// encodes it with the Thrift Binary protocol, and decodes it back.
+//
+// The Generator must be for the external test package of the package
+// generated for the module so that the examples refer to the types the way
+// users do. Types which cannot be built from constants, like those which
+// require binary fields, are skipped.
+func examples(g Generator, m *compile.Module) error {
+	for _, name := range sortStringKeys(m.Types) {
+		spec, ok := m.Types[name].(*compile.StructSpec)
+		if !ok {
+			continue
+		}
+
+		goName, err := goName(spec)
+		if err != nil {
+			return err
+		}
+
+		value, ok := exampleValue(spec)
+		if !ok {
+			continue
+		}
+
+		if err := example(g, spec, goName, value); err != nil {
+			return wrapGenerateError(name, err)
+		}
+	}
+	return nil
+}
+
+func example(g Generator, spec *compile.StructSpec, name string, value compile.ConstantValue) error {
+	return g.DeclareFromTemplate(
+		`
+		<$bytes := import "bytes">
+		<$fmt := import "fmt">
+		<$protocol := import "go.uber.org/thriftrw/protocol">
+		<$wire := import "go.uber.org/thriftrw/wire">
+
+		<$v := newVar "v">
+		<$w := newVar "w">
+		<$buf := newVar "buf">
+		<$decoded := newVar "decoded">
+		func Example<.Name>() {
+			<$v> := <constantValue .Value .Spec>
+
+			// Convert the value into its wire representation and encode it
+			// using the Thrift Binary protocol.
+			<$w>, err := <$v>.ToWire()
+			if err != nil {
+				panic(err)
+			}
+
+			var <$buf> <$bytes>.Buffer
+			if err = <$protocol>.Binary.Encode(<$w>, &<$buf>); err != nil {
+				panic(err)
+			}
+
+			// Decode the bytes back into a wire representation and read the
+			// value from it.
+			<$w>, err = <$protocol>.Binary.Decode(<$bytes>.NewReader(<$buf>.Bytes()), <$wire>.TStruct)
+			if err != nil {
+				panic(err)
+			}
+
+			var <$decoded> <typeName .Spec>
+			if err = <$decoded>.FromWire(<$w>); err != nil {
+				panic(err)
+			}
+
+			<$fmt>.Println(<$decoded>.Equals(<$v>))
+			// Output: true
+		}
+		`,
+		struct {
+			Spec  compile.TypeSpec
+			Name  string
+			Value compile.ConstantValue
+		}{Spec: spec, Name: name, Value: value},
+		TemplateFunc("constantValue", ConstantValue),
+	)
+}
+
+// exampleValue returns a constant value of the given type for use in
+// examples. Structs have only their required fields set and unions have only
+// their first field set, which is enough for them to be encoded.
+// False is returned if no such value can be expressed as a constant.
+func exampleValue(spec compile.TypeSpec) (compile.ConstantValue, bool) {
+	switch s := spec.(type) {
+	case *compile.BoolSpec:
+		return compile.ConstantBool(true), true
+	case *compile.I8Spec, *compile.I16Spec, *compile.I32Spec, *compile.I64Spec:
+		return compile.ConstantInt(42), true
+	case *compile.DoubleSpec:
+		return compile.ConstantDouble(1.5), true
+	case *compile.StringSpec:
+		return compile.ConstantString("hello"), true
+	case *compile.UUIDSpec:
+		return compile.ConstantString(exampleUUID), true
+	case *compile.EnumSpec:
+		if len(s.Items) == 0 {
+			return compile.ConstantInt(0), true
+		}
+		return compile.EnumItemReference{Enum: s, Item: &s.Items[0]}, true
+	case *compile.TypedefSpec:
+		return exampleValue(s.Target)
+	case *compile.ListSpec:
+		return compile.ConstantList(nil), true
+	case *compile.SetSpec:
+		return compile.ConstantSet(nil), true
+	case *compile.MapSpec:
+		return compile.ConstantMap(nil), true
+	case *compile.StructSpec:
+		return exampleStruct(s)
+	default:
+		// Binary values cannot be constants.
+		return nil, false
+	}
+}
+
+// exampleStruct returns a constant value of the given struct for use in
+// examples. The compiler rejects cycles of required fields so this always
+// terminates.
+func exampleStruct(spec *compile.StructSpec) (compile.ConstantValue, bool) {
+	fields := make(map[string]compile.ConstantValue)
+	for _, f := range spec.Fields {
+		if spec.Type != ast.UnionType && !f.Required {
+			continue
+		}
+
+		var (
+			value compile.ConstantValue
+			ok    bool
+		)
+		if _, raw := f.Annotations[rawKey]; !raw {
+			value, ok = exampleValue(f.Type)
+		}
+
+		if spec.Type == ast.UnionType {
+			if ok {
+				fields[f.Name] = value
+				break
+			}
+			continue
+		}
+
+		if !ok {
+			return nil, false
+		}
+		fields[f.Name] = value
+	}
+
+	if spec.Type == ast.UnionType && len(fields) == 0 {
+		return nil, false
+	}
+	return &compile.ConstantStruct{Fields: fields}, true
+}
@@ -0,0 +1,104 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateExamples(t *testing.T) {
+	tests := []struct {
+		desc     string
+		generate bool
+		want     []string
+	}{
+		{desc: "disabled"},
+		{
+			desc:     "enabled",
+			generate: true,
+			want: []string{
+				"ExampleContact",
+				"ExampleEmpty",
+				"ExamplePoint",
+				"ExampleUser",
+				"ExampleUserNotFound",
+			},
+		},
+	}
+
+	module, err := compile.Compile("testdata/thrift/examples.thrift")
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		func() {
+			outputDir, err := ioutil.TempDir("", "thriftrw-examples-test")
+			require.NoError(t, err)
+			defer os.RemoveAll(outputDir)
+
+			require.NoError(t, Generate(module, &Options{
+				OutputDir:        outputDir,
+				PackagePrefix:    "go.uber.org/thriftrw/gen/testdata",
+				ThriftRoot:       testdata(t, "thrift"),
+				NoRecurse:        true,
+				GenerateExamples: tt.generate,
+			}), tt.desc)
+
+			path := filepath.Join(outputDir, "examples", "example_test.go")
+			if !tt.generate {
+				_, err := os.Stat(path)
+				assert.True(t, os.IsNotExist(err), "%v: example_test.go must not exist", tt.desc)
+				return
+			}
+
+			f, err := parser.ParseFile(token.NewFileSet(), path, nil, parser.ParseComments)
+			require.NoError(t, err, tt.desc)
+			assert.Equal(t, "examples_test", f.Name.Name, tt.desc)
+
+			var got []string
+			for _, decl := range f.Decls {
+				if fn, ok := decl.(*ast.FuncDecl); ok {
+					got = append(got, fn.Name.Name)
+				}
+			}
+			assert.Equal(t, tt.want, got, tt.desc)
+
+			// Examples without output comments are compiled but not run.
+			var outputs int
+			for _, c := range f.Comments {
+				if c.Text() == "Output: true\n" {
+					outputs++
+				}
+			}
+			assert.Equal(t, len(tt.want), outputs, "%v: every example must have an output comment", tt.desc)
+		}()
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// exceptionFamilies generates an interface for each exception family of the
+// given module. The interface is implemented by all exceptions of the family
+// so that handlers may treat them uniformly.
+func exceptionFamilies(g Generator, m *compile.Module) error {
+	for _, name := range sortStringKeys(m.ExceptionFamilies) {
+		if err := exceptionFamily(g, m.ExceptionFamilies[name]); err != nil {
+			return wrapGenerateError(name, err)
+		}
+	}
+	return nil
+}
+
+func exceptionFamily(g Generator, f *compile.ExceptionFamily) error {
+	if !_identifierRegexp.MatchString(f.Name) {
+		return fmt.Errorf("%q is not a valid name for an exception family", f.Name)
+	}
+
+	// Base fields of the first exception. The compiler has already verified
+	// that the other exceptions declare these fields with the same types.
+	fields := make(compile.FieldGroup, len(f.Fields))
+	for i, name := range f.Fields {
+		var getter string
+		for _, e := range f.Exceptions {
+			field, err := e.Fields.FindByName(name)
+			if err != nil {
+				return err
+			}
+
+			if _, ok := field.Annotations[rawKey]; ok {
+				return fmt.Errorf(
+					"base field %q of %q cannot have a %v annotation",
+					name, e.Name, rawKey)
+			}
+
+			fieldName, err := goName(field)
+			if err != nil {
+				return err
+			}
+
+			if getter == "" {
+				getter = fieldName
+				fields[i] = field
+			} else if getter != fieldName {
+				return fmt.Errorf(
+					"base field %q of %q is named %q in Go but it is named %q in %q",
+					name, e.Name, fieldName, getter, f.Exceptions[0].Name)
+			}
+		}
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$name := goCase .Family.Name>
+		// <$name> is implemented by all exceptions of the <.Family.Name>
+		// exception family.
+		type <$name> interface {
+			error
+
+			<range .Fields>
+				// Get<goName .> returns the value of the <.Name> field of the
+				// exception.
+				Get<goName .>() <typeReference .Type>
+			<end>
+		}
+		`,
+		struct {
+			Family *compile.ExceptionFamily
+			Fields compile.FieldGroup
+		}{Family: f, Fields: fields},
+	)
+}
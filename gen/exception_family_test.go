@@ -0,0 +1,142 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	ef "go.uber.org/thriftrw/gen/testdata/exception_families"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExceptionFamilyInterface(t *testing.T) {
+	tests := []struct {
+		desc    string
+		err     error
+		code    int32
+		message string
+	}{
+		{
+			desc:    "NotFoundError",
+			err:     &ef.NotFoundError{Code: 404, Message: "not found"},
+			code:    404,
+			message: "not found",
+		},
+		{
+			desc:    "UnavailableError",
+			err:     &ef.UnavailableError{Code: 503},
+			code:    503,
+			message: "",
+		},
+		{
+			desc: "nil",
+			err:  (*ef.UnavailableError)(nil),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			serviceErr, ok := tt.err.(ef.ServiceError)
+			require.True(t, ok, "%T must implement ServiceError", tt.err)
+			assert.Equal(t, tt.code, serviceErr.GetCode())
+			assert.Equal(t, tt.message, serviceErr.GetMessage())
+
+			_, ok = tt.err.(ef.RequestError)
+			assert.False(t, ok, "%T must not implement RequestError", tt.err)
+		})
+	}
+
+	var requestErr ef.RequestError = &ef.InvalidArgumentError{Argument: "id"}
+	assert.Equal(t, "id", requestErr.GetArgument())
+}
+
+func TestExceptionFamilyInvalid(t *testing.T) {
+	tests := []struct {
+		desc    string
+		src     string
+		wantErr string
+	}{
+		{
+			desc: "invalid name",
+			src: `
+				exception Foo {
+					1: required i32 code
+					2: required string message
+				} (thriftrw.family = "service-error")
+			`,
+			wantErr: `"service-error" is not a valid name for an exception family`,
+		},
+		{
+			desc: "raw base field",
+			src: `
+				exception Foo {
+					1: required i32 code (thriftrw.raw)
+					2: required string message
+				} (thriftrw.family = "ServiceError")
+			`,
+			wantErr: `base field "code" of "Foo" cannot have a thriftrw.raw annotation`,
+		},
+		{
+			desc: "different Go names",
+			src: `
+				exception Bar {
+					1: required i32 code
+					2: required string message
+				} (thriftrw.family = "ServiceError")
+
+				exception Foo {
+					1: required i32 code (go.name = "ErrorCode")
+					2: required string message
+				} (thriftrw.family = "ServiceError")
+			`,
+			wantErr: `base field "code" of "Foo" is named "ErrorCode" in Go but it is named "Code" in "Bar"`,
+		},
+	}
+
+	for _, tt := range tests {
+		func() {
+			dir, err := ioutil.TempDir("", "thriftrw-family-test")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+
+			path := filepath.Join(dir, "family.thrift")
+			require.NoError(t, ioutil.WriteFile(path, []byte(tt.src), 0644), tt.desc)
+
+			module, err := compile.Compile(path)
+			require.NoError(t, err, tt.desc)
+
+			err = Generate(module, &Options{
+				OutputDir:     dir,
+				PackagePrefix: "go.uber.org/thriftrw/gen/testdata",
+				ThriftRoot:    dir,
+			})
+			if assert.Error(t, err, tt.desc) {
+				assert.Contains(t, err.Error(), tt.wantErr, tt.desc)
+			}
+		}()
+	}
+}
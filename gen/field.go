@@ -34,6 +34,19 @@ const (
 
 	// key for tag set on all generated go structs used by encoding/json
 	jsonTagKey = "json"
+
+	// key for tag set on generated go structs used by mapstructure if
+	// enabled with --mapstructure-tags
+	mapstructureTagKey = "mapstructure"
+
+	// fields with this annotation have their values replaced with
+	// redactedValue in String(), error messages, and logs.
+	//
+	//   1: required string token (thriftrw.redact = "true")
+	redactKey = "thriftrw.redact"
+
+	// value printed in place of redacted fields
+	redactedValue = "<redacted>"
 )
 
 var reservedIdentifiers = map[string]struct{}{
@@ -41,6 +54,9 @@ var reservedIdentifiers = map[string]struct{}{
 	"FromWire": {},
 	"String":   {},
 	"Equals":   {},
+	"Clone":    {},
+
+	"MarshalLogObject": {},
 }
 
 // fieldGroupGenerator is responsible for generating code for FieldGroups.
@@ -58,9 +74,50 @@ type fieldGroupGenerator struct {
 	// This field group represents a Thrift exception.
 	IsException bool
 
+	// The fields of this group are unexported and can only be read with
+	// their getters.
+	Immutable bool
+
+	// This field group represents the result of a function. Exceptions
+	// which are not recognized when decoding are recorded so that
+	// UnwrapResponse can report them.
+	IsResult bool
+
+	// Optional fields of primitive types which are generated as values
+	// rather than pointers, mapped to their index in the bitset which
+	// records whether they were set.
+	OptionalValues map[*compile.FieldSpec]int
+
+	// Fields which are not recognized when decoding are recorded in an
+	// UnknownFields field and written back when encoding. This is set by
+	// Generate.
+	PreserveUnknownFields bool
+
 	Doc string
 }
 
+// ToWireCanFail checks whether converting any of the fields of this group to
+// a wire.Value may produce an error.
+func (f fieldGroupGenerator) ToWireCanFail() bool {
+	for _, field := range f.Fields {
+		if toWireCanFail(field.Type) {
+			return true
+		}
+	}
+	return false
+}
+
+// FromWireCanFail checks whether reading any of the fields of this group from
+// a wire.Value may produce an error.
+func (f fieldGroupGenerator) FromWireCanFail() bool {
+	for _, field := range f.Fields {
+		if fromWireCanFail(field.Type) {
+			return true
+		}
+	}
+	return false
+}
+
 func (f fieldGroupGenerator) checkReservedIdentifier(name string) error {
 	_, match := reservedIdentifiers[name]
 	match = match || (f.IsException && name == "Error")
@@ -71,10 +128,48 @@ func (f fieldGroupGenerator) checkReservedIdentifier(name string) error {
 }
 
 func (f fieldGroupGenerator) Generate(g Generator) error {
+	if err := checkRedactAnnotations(f.Fields); err != nil {
+		return err
+	}
+
+	if generatorOptions(g).GenerateValidate {
+		// Fields may not share names with the generated Validate methods.
+		for _, name := range []string{"Validate", "ValidateWith"} {
+			if err := f.Reserve(name); err != nil {
+				return err
+			}
+		}
+	}
+
+	if generatorOptions(g).MemSize {
+		if err := f.Reserve("MemSize"); err != nil {
+			return err
+		}
+	}
+
+	if len(f.OptionalValues) > 0 {
+		if err := f.Reserve(isSetFieldName); err != nil {
+			return err
+		}
+	}
+
+	// Unions are left alone because an unknown field would always leave
+	// them without a field set.
+	f.PreserveUnknownFields = generatorOptions(g).PreserveUnknownFields && !f.IsUnion
+	if f.PreserveUnknownFields {
+		if err := f.Reserve(unknownFieldsName); err != nil {
+			return fmt.Errorf("could not declare field %q for unknown fields: %v", unknownFieldsName, err)
+		}
+	}
+
 	if err := f.DefineStruct(g); err != nil {
 		return err
 	}
 
+	if err := f.DefaultConstructor(g); err != nil {
+		return err
+	}
+
 	if err := f.ToWire(g); err != nil {
 		return err
 	}
@@ -91,28 +186,77 @@ func (f fieldGroupGenerator) Generate(g Generator) error {
 		return err
 	}
 
-	return f.PrimitiveAccessors(g)
+	if err := f.Clone(g); err != nil {
+		return err
+	}
+
+	if generatorOptions(g).MemSize {
+		var m memSizeGenerator
+		if err := m.fieldGroup(g, f); err != nil {
+			return err
+		}
+	}
+
+	if generatorOptions(g).Zap {
+		var z zapGenerator
+		if err := z.zapStruct(g, f); err != nil {
+			return err
+		}
+	}
+
+	if generatorOptions(g).GenerateValidate {
+		var vg validateGenerator
+		if err := vg.fieldGroup(g, f); err != nil {
+			return err
+		}
+	}
+
+	if f.Immutable && len(f.Fields) > 0 {
+		if err := f.JSON(g); err != nil {
+			return err
+		}
+	}
+
+	return f.Accessors(g)
 }
 
 func (f fieldGroupGenerator) DefineStruct(g Generator) error {
-	return g.DeclareFromTemplate(
+	return g.DeclareFromTemplate(overrideTemplate(g, "struct",
 		`<formatDoc .Doc>type <.Name> struct {
 			<range .Fields>
-				<- if .Required ->
-					<formatDoc .Doc><declFieldName .> <typeReference .Type> <tag .>
+				<- if or .Required (isOptionalValue .) ->
+					<formatDoc .Doc><declFieldName .> <typeReference .Type><if not $.Immutable> <tag .><end>
 				<- else ->
-					<formatDoc .Doc><declFieldName .> <typeReferencePtr .Type> <tag .>
+					<formatDoc .Doc><declFieldName .> <typeReferencePtr .Type><if not $.Immutable> <tag .><end>
 				<- end>
 			<end>
-		}`,
+			<- if .OptionalValues>
+
+				<isSetField> [<isSetWords>]uint64
+			<- end>
+			<- if .PreserveUnknownFields>
+
+				// Fields which were not recognized when this <.Name> was
+				// decoded. They are written back when it is encoded.
+				UnknownFields <import "go.uber.org/thriftrw/wire">.UnknownFields `+"`"+`json:"-"`+"`"+`
+			<- end>
+			<- if .IsResult>
+
+				// Exception which was not recognized when this result was
+				// decoded.
+				unexpectedException *<import "go.uber.org/thriftrw/runtime">.UnexpectedApplicationError
+			<- end>
+		}`),
 		f,
-		TemplateFunc("tag", generateTags),
-		TemplateFunc("declFieldName", f.declFieldName),
+		append(f.optionalValueFuncs(),
+			TemplateFunc("tag", generateTags),
+			TemplateFunc("declFieldName", f.declFieldName),
+		)...,
 	)
 }
 
 // generateTags parses the annotation on the thrift field and creates the resulting go tag
-func generateTags(f *compile.FieldSpec) (string, error) {
+func generateTags(g Generator, f *compile.FieldSpec) (string, error) {
 	tags, err := structtag.Parse("") // no tags
 	if err != nil {
 		return "", fmt.Errorf("failed to parse tag: %v", err)
@@ -122,6 +266,13 @@ func generateTags(f *compile.FieldSpec) (string, error) {
 		return "", fmt.Errorf("failed to set tag: %v", err)
 	}
 
+	if generatorOptions(g).MapstructureTags {
+		t := &structtag.Tag{Key: mapstructureTagKey, Name: f.Name}
+		if err := tags.Set(t); err != nil {
+			return "", fmt.Errorf("failed to set tag: %v", err)
+		}
+	}
+
 	// process go tags and overwrite json tag if specified in thrift annotation
 	if goAnnotation := f.Annotations[goTagKey]; goAnnotation != "" {
 		goTags, err := structtag.Parse(goAnnotation)
@@ -171,10 +322,77 @@ func compileJSONTag(f *compile.FieldSpec, name string, opts ...string) *structta
 	return t
 }
 
+// isRedacted returns true if the value of the given field must not be
+// printed or logged. Fields are redacted if their thriftrw.redact annotation
+// is empty or "true".
+func isRedacted(f *compile.FieldSpec) bool {
+	v, ok := f.Annotations[redactKey]
+	return ok && (v == "" || v == "true")
+}
+
+// checkRedactAnnotations verifies that the thriftrw.redact annotations of
+// the given fields, if any, are empty, "true", or "false".
+func checkRedactAnnotations(fields compile.FieldGroup) error {
+	for _, f := range fields {
+		switch v, ok := f.Annotations[redactKey]; {
+		case !ok, v == "", v == "true", v == "false":
+		default:
+			return fmt.Errorf(
+				`the %v annotation of field %q must be "true" or "false": got %q`,
+				redactKey, f.Name, v)
+		}
+	}
+	return nil
+}
+
 // declFieldName replaces goName during generation of a structure's definition.
 // It replicates goName but also register all field names in the
 // fieldGroupGenerator namespace, enforcing single field definition when
 // generating Go code. TL;DR: will fail during generation, before compilation.
+// DefaultConstructor generates a Default_* function which constructs the
+// struct with its fields set to their default values. Nothing is generated
+// for unions and for structs without default values.
+func (f fieldGroupGenerator) DefaultConstructor(g Generator) error {
+	if f.IsUnion || !hasDefaults(f.Fields) {
+		return nil
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$v := newVar "v">
+		// Default_<.Name> constructs a new <.Name> with its fields set to the
+		// default values declared for them in the Thrift file.
+		func Default_<.Name>() *<.Name> {
+			var <$v> <.Name>
+			<- range .Fields>
+				<- if .Default>
+					<- if isOptionalValue .>
+						<$v>.<setterName .>(<constantValue .Default .Type>)
+					<- else>
+						<$v>.<fieldName .> = <constantValuePtr .Default .Type>
+					<- end>
+				<- end>
+			<- end>
+			return &<$v>
+		}
+		`, f,
+		append(f.optionalValueFuncs(),
+			TemplateFunc("constantValue", ConstantValue),
+			TemplateFunc("constantValuePtr", ConstantValuePtr),
+		)...,
+	)
+}
+
+// hasDefaults returns true if any of the given fields has a default value.
+func hasDefaults(fields compile.FieldGroup) bool {
+	for _, f := range fields {
+		if f.Default != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func (f *fieldGroupGenerator) declFieldName(fs *compile.FieldSpec) (string, error) {
 	name, fromAnnotation, err := goNameForNamedEntity(fs)
 	if err != nil {
@@ -184,6 +402,10 @@ func (f *fieldGroupGenerator) declFieldName(fs *compile.FieldSpec) (string, erro
 	if err = f.checkReservedIdentifier(name); err == nil {
 		err = f.Reserve(name)
 	}
+	if err == nil && f.Immutable {
+		// Fields named ID and Id are both unexported as id.
+		err = f.Reserve(unexportedName(name))
+	}
 
 	if err != nil {
 		originalName := (name == fs.ThriftName())
@@ -198,10 +420,14 @@ func (f *fieldGroupGenerator) declFieldName(fs *compile.FieldSpec) (string, erro
 		}
 		return "", fmt.Errorf("could not declare field %q%s: %v", name, note, err)
 	}
+	if f.Immutable {
+		return unexportedName(name), nil
+	}
 	return name, nil
 }
 
 func (f fieldGroupGenerator) ToWire(g Generator) error {
+	var u utf8Generator
 	return g.DeclareFromTemplate(
 		`
 		<$wire := import "go.uber.org/thriftrw/wire">
@@ -232,26 +458,68 @@ func (f fieldGroupGenerator) ToWire(g Generator) error {
 				<$i> int = 0
 				<if len .Fields ->
 					<$wVal> <$wire>.Value
+				<- end>
+				<if .ToWireCanFail ->
 					err error
 				<- end>
 			)
 
+			<if or (len .Fields) .PreserveUnknownFields ->
+				if <$v> == nil {
+					return <$wire>.Value{}, <import "errors">.New("<.Name> is nil")
+				}
+			<- end>
+
 			<$structName := .Name>
 			<range .Fields>
 				<- $fname := goName . ->
-				<- $f := printf "%s.%s" $v $fname ->
+				<- $f := printf "%s.%s" $v (fieldName .) ->
 				<- if .Required ->
 					<- if not (isPrimitiveType .Type) ->
 						if <$f> == nil {
 							return <$wVal>, <import "errors">.New("field <$fname> of <$structName> is required")
 						}
 					<- end>
+					<- if checkUTF8 .>
+						if !<validUTF8 .Type $f> {
+							return <$wVal>, <import "errors">.New("field <$fname> of <$structName> is not valid UTF-8")
+						}
+					<- end>
+					<- if toWireCanFail .Type>
 						<$wVal>, err = <toWire .Type $f>
 						if err != nil {
 							return <$wVal>, err
 						}
+					<- else>
+						<$wVal> = <toWireValue .Type $f>
+					<- end>
 						<$fields>[<$i>] = <$wire>.Field{ID: <.ID>, Value: <$wVal>}
 						<$i>++
+				<- else if isOptionalValue . ->
+					<- if .Default ->
+						if !<$v>.IsSet<$fname>() {
+							<$v>.<setterName .>(<constantValue .Default .Type>)
+						}
+						{
+					<- else ->
+						if <$v>.IsSet<$fname>() {
+					<- end>
+					<- if checkUTF8 .>
+							if !<validUTF8 .Type $f> {
+								return <$wVal>, <import "errors">.New("field <$fname> of <$structName> is not valid UTF-8")
+							}
+					<- end>
+						<- if toWireCanFail .Type>
+							<$wVal>, err = <toWire .Type $f>
+							if err != nil {
+								return <$wVal>, err
+							}
+						<- else>
+							<$wVal> = <toWireValue .Type $f>
+						<- end>
+							<$fields>[<$i>] = <$wire>.Field{ID: <.ID>, Value: <$wVal>}
+							<$i>++
+						}
 				<- else ->
 					<- if .Default ->
 						if <$f> == nil {
@@ -261,10 +529,19 @@ func (f fieldGroupGenerator) ToWire(g Generator) error {
 					<- else ->
 						if <$f> != nil {
 					<- end>
+					<- if checkUTF8 .>
+							if !<validUTF8Ptr .Type $f> {
+								return <$wVal>, <import "errors">.New("field <$fname> of <$structName> is not valid UTF-8")
+							}
+					<- end>
+						<- if toWireCanFail .Type>
 							<$wVal>, err = <toWirePtr .Type $f>
 							if err != nil {
 								return <$wVal>, err
 							}
+						<- else>
+							<$wVal> = <toWireValuePtr .Type $f>
+						<- end>
 							<$fields>[<$i>] = <$wire>.Field{ID: <.ID>, Value: <$wVal>}
 							<$i>++
 						}
@@ -284,12 +561,29 @@ func (f fieldGroupGenerator) ToWire(g Generator) error {
 				<end>
 			<end>
 
-			return <$wire>.NewValueStruct(<$wire>.Struct{Fields: <$fields>[:<$i>]}), nil
+			<if .PreserveUnknownFields ->
+				return <$wire>.NewValueStruct(<$wire>.Struct{Fields: append(<$fields>[:<$i>], <$v>.UnknownFields...)}), nil
+			<- else ->
+				return <$wire>.NewValueStruct(<$wire>.Struct{Fields: <$fields>[:<$i>]}), nil
+			<- end>
 		}
-		`, f, TemplateFunc("constantValuePtr", ConstantValuePtr))
+		`, f,
+		append(f.optionalValueFuncs(),
+			TemplateFunc("constantValue", ConstantValue),
+			TemplateFunc("constantValuePtr", ConstantValuePtr),
+			TemplateFunc("checkUTF8", checkUTF8),
+			TemplateFunc("validUTF8", u.ValidUTF8),
+			TemplateFunc("validUTF8Ptr", u.ValidUTF8Ptr),
+		)...,
+	)
 }
 
 func (f fieldGroupGenerator) FromWire(g Generator) error {
+	if generatorOptions(g).CompactCode {
+		return f.compactFromWire(g)
+	}
+
+	var u utf8Generator
 	return g.DeclareFromTemplate(
 		`
 		<$wire := import "go.uber.org/thriftrw/wire">
@@ -314,8 +608,10 @@ func (f fieldGroupGenerator) FromWire(g Generator) error {
 		//   }
 		//   return &<$v>, nil
 		func (<$v> *<.Name>) FromWire(<$w> <$wire>.Value) error {
-			<if len .Fields> var err error <end>
+			<if .FromWireCanFail> var err error <end>
 			<$f := newVar "field">
+			<$unknown := newVar "unknown">
+			<if .IsResult> var <$unknown> <$wire>.UnknownFields <end>
 
 			<$isSet := newNamespace>
 			<range .Fields>
@@ -329,29 +625,60 @@ func (f fieldGroupGenerator) FromWire(g Generator) error {
 				<range .Fields ->
 				case <.ID>:
 					if <$f>.Value.Type() == <typeCode .Type> {
-						<- $lhs := printf "%s.%s" $v (goName .) ->
+						<- $lhs := printf "%s.%s" $v (fieldName .) ->
 						<- $value := printf "%s.Value" $f ->
-						<- if .Required ->
-							<$lhs>, err = <fromWire .Type $value>
+						<- if fromWireCanFail .Type ->
+							<- if or .Required (isOptionalValue .) ->
+								<$lhs>, err = <fromWire .Type $value>
+							<- else ->
+								<fromWirePtr .Type $lhs $value>
+							<- end>
+							if err != nil {
+								return err
+							}
+						<- else if or .Required (isOptionalValue .) ->
+							<$lhs> = <fromWireValue .Type $value>
 						<- else ->
 							<fromWirePtr .Type $lhs $value>
 						<- end>
-						if err != nil {
-							return err
+						<- if checkUTF8 .>
+						if !<if or .Required (isOptionalValue .)><validUTF8 .Type $lhs><else><validUTF8Ptr .Type $lhs><end> {
+							return <import "errors">.New("field <goName .> of <$.Name> is not valid UTF-8")
 						}
+						<- end>
 						<if .Required ->
 							<$isSet.Rotate (printf "%sIsSet" .Name)> = true
+						<- else if isOptionalValue . ->
+							<markSet $v .>
 						<- end>
 					}
 				<end ->
+				<if .PreserveUnknownFields ->
+				default:
+					if err <if .FromWireCanFail>=<else>:=<end> <$v>.UnknownFields.Add(<$f>); err != nil {
+						return err
+					}
+				<else if .IsResult ->
+				default:
+					if err <if .FromWireCanFail>=<else>:=<end> <$unknown>.Add(<$f>); err != nil {
+						return err
+					}
+				<end ->
 				}
 			}
+			<- if .IsResult>
+			<$v>.unexpectedException = <import "go.uber.org/thriftrw/runtime">.FindUnexpectedException("<.Name>", <$unknown>)
+			<- end>
 
 			<$structName := .Name>
 			<range .Fields>
 				<$fname := goName .>
-				<$f := printf "%s.%s" $v $fname>
-				<if .Default>
+				<$f := printf "%s.%s" $v (fieldName .)>
+				<if and .Default (isOptionalValue .)>
+					if !<$v>.IsSet<$fname>() {
+						<$v>.<setterName .>(<constantValue .Default .Type>)
+					}
+				<else if .Default>
 					if <$f> == nil {
 						<$f> = <constantValuePtr .Default .Type>
 					}
@@ -369,7 +696,12 @@ func (f fieldGroupGenerator) FromWire(g Generator) error {
 				<$count := newVar "count">
 				<$count> := 0
 				<range .Fields ->
-					if <$v>.<goName .> != nil {
+					if <$v>.<fieldName .> != nil {
+						<$count>++
+					}
+				<end>
+				<- if .IsResult ->
+					if <$v>.unexpectedException != nil {
 						<$count>++
 					}
 				<end>
@@ -385,7 +717,15 @@ func (f fieldGroupGenerator) FromWire(g Generator) error {
 			<end>
 			return nil
 		}
-		`, f, TemplateFunc("constantValuePtr", ConstantValuePtr))
+		`, f,
+		append(f.optionalValueFuncs(),
+			TemplateFunc("constantValue", ConstantValue),
+			TemplateFunc("constantValuePtr", ConstantValuePtr),
+			TemplateFunc("checkUTF8", checkUTF8),
+			TemplateFunc("validUTF8", u.ValidUTF8),
+			TemplateFunc("validUTF8Ptr", u.ValidUTF8Ptr),
+		)...,
+	)
 }
 
 func (f fieldGroupGenerator) String(g Generator) error {
@@ -409,17 +749,24 @@ func (f fieldGroupGenerator) String(g Generator) error {
 			<$i> := 0
 			<range .Fields>
 				<- $fname := goName . ->
-				<- $f := printf "%s.%s" $v $fname ->
+				<- $f := printf "%s.%s" $v (fieldName .) ->
 
 				<- if not .Required ->
-					if <$f> != nil {
-						<if isPrimitiveType .Type ->
+					if <if isOptionalValue .><$v>.IsSet<$fname>()<else><$f> != nil<end> {
+						<if isRedacted . ->
+							<$fields>[<$i>] = "<$fname>: <redactedValue>"
+						<- else if isOptionalValue . ->
+							<$fields>[<$i>] = <$fmt>.Sprintf("<$fname>: %v", <$f>)
+						<- else if isPrimitiveType .Type ->
 							<$fields>[<$i>] = <$fmt>.Sprintf("<$fname>: %v", *(<$f>))
 						<- else ->
 							<$fields>[<$i>] = <$fmt>.Sprintf("<$fname>: %v", <$f>)
 						<- end>
 						<$i>++
 					}
+				<- else if isRedacted . ->
+					<$fields>[<$i>] = "<$fname>: <redactedValue>"
+					<$i>++
 				<- else ->
 					<$fields>[<$i>] = <$fmt>.Sprintf("<$fname>: %v", <$f>)
 					<$i>++
@@ -428,7 +775,12 @@ func (f fieldGroupGenerator) String(g Generator) error {
 
 			return <$fmt>.Sprintf("<.Name>{%v}", <$strings>.Join(<$fields>[:<$i>], ", "))
 		}
-		`, f)
+		`, f,
+		append(f.optionalValueFuncs(),
+			TemplateFunc("isRedacted", isRedacted),
+			TemplateFunc("redactedValue", func() string { return redactedValue }),
+		)...,
+	)
 }
 
 func (f fieldGroupGenerator) Equals(g Generator) error {
@@ -443,25 +795,68 @@ func (f fieldGroupGenerator) Equals(g Generator) error {
 		func (<$v> *<.Name>) Equals(<$rhs> *<.Name>) bool {
 			<range .Fields>
 				<- $fname := goName . ->
-				<- $lhsField := printf "%s.%s" $v $fname ->
-				<- $rhsField := printf "%s.%s" $rhs $fname ->
+				<- $lhsField := printf "%s.%s" $v (fieldName .) ->
+				<- $rhsField := printf "%s.%s" $rhs (fieldName .) ->
 
 				<- if .Required ->
 					if !<equals .Type $lhsField $rhsField> {
 						return false
 					}
+				<- else if isOptionalValue . ->
+					if <$v>.IsSet<$fname>() != <$rhs>.IsSet<$fname>() || !<equals .Type $lhsField $rhsField> {
+						return false
+					}
 				<- else ->
 					if !<equalsPtr .Type $lhsField $rhsField> {
 						return false
 					}
 				<- end>
 			<end>
+			<- if .PreserveUnknownFields>
+			if !<$v>.UnknownFields.Equals(<$rhs>.UnknownFields) {
+				return false
+			}
+			<- end>
 			return true
 		}
-		`, f)
+		`, f, f.optionalValueFuncs()...)
+}
+
+func (f fieldGroupGenerator) Clone(g Generator) error {
+	return g.DeclareFromTemplate(
+		`
+		<$v := newVar "v">
+		<$o := newVar "o">
+		// Clone returns a deep copy of this <.Name>.
+		//
+		// Nested structs, containers, and binary fields are copied so that
+		// the result shares no memory with the original.
+		func (<$v> *<.Name>) Clone() *<.Name> {
+			if <$v> == nil {
+				return nil
+			}
+
+			<$o> := *<$v>
+			<- range .Fields>
+				<- $fname := fieldName . ->
+				<- $f := printf "%s.%s" $v $fname ->
+				<- if isOptionalValue .>
+				<- else if not .Required>
+					<$o>.<$fname> = <clonePtr .Type $f>
+				<- else if not (isPrimitiveType .Type)>
+					<$o>.<$fname> = <clone .Type $f>
+				<- end>
+			<- end>
+			<- if .PreserveUnknownFields>
+			<$o>.UnknownFields = <$v>.UnknownFields.Clone()
+			<- end>
+
+			return &<$o>
+		}
+		`, f, f.optionalValueFuncs()...)
 }
 
-func (f fieldGroupGenerator) PrimitiveAccessors(g Generator) error {
+func (f fieldGroupGenerator) Accessors(g Generator) error {
 	fieldsAndAccessors := NewNamespace()
 	return g.DeclareFromTemplate(
 		`
@@ -471,26 +866,130 @@ func (f fieldGroupGenerator) PrimitiveAccessors(g Generator) error {
 
 		<range .Fields>
 			<$fname := goName .>
-			<reserveFieldOrMethod $fname>
+			<$field := printf "%s.%s" $v (fieldName .)>
+			<reserveFieldOrMethod (fieldName .)>
 			<reserveFieldOrMethod (printf "Get%v" $fname)>
-			<if and (not .Required) (isPrimitiveType .Type)>
+			<if .Required>
 			// Get<$fname> returns the value of <$fname> if it is set or its
 			// zero value if it is unset.
+			//
+			// This is safe to call on a nil <$name>.
 			func (<$v> *<$name>) Get<$fname>() (<$o> <typeReference .Type>) {
-				if <$v>.<$fname> != nil {
-					return *<$v>.<$fname>
+				if <$v> != nil {
+					<$o> = <$field>
+				}
+				return
+			}
+			<else if isOptionalValue .>
+			<$set := setterName .>
+			<$unset := unsetterName .>
+			<reserveFieldOrMethod (printf "IsSet%v" $fname)>
+			<reserveFieldOrMethod $set>
+			<reserveFieldOrMethod $unset>
+			// Get<$fname> returns the value of <$fname> if it is set or its
+			// <if .Default>default<else>zero<end> value if it is unset.
+			//
+			// This is safe to call on a nil <$name>.
+			func (<$v> *<$name>) Get<$fname>() (<$o> <typeReference .Type>) {
+				if <$v>.IsSet<$fname>() {
+					return <$field>
 				}
 				<if .Default><$o> = <constantValue .Default .Type><end>
 				return
 			}
+
+			// IsSet<$fname> returns true if <$fname> was set<if not $.Immutable> with Set<$fname><end>,
+			// decoded from its Thrift representation, or has a non-zero value.
+			//
+			// This is safe to call on a nil <$name>.
+			func (<$v> *<$name>) IsSet<$fname>() bool {
+				return <$v> != nil && (<isNonZero .Type $field> || <checkSet $v .>)
+			}
+
+			// <$set> sets the value of <$fname> and marks it as set.
+			func (<$v> *<$name>) <$set>(<$o> <typeReference .Type>) {
+				<$field> = <$o>
+				<markSet $v .>
+			}
+
+			// <$unset> resets <$fname> to its zero value and marks it as
+			// unset.
+			func (<$v> *<$name>) <$unset>() {
+				<$field> = <zeroValue .Type>
+				<markUnset $v .>
+			}
+			<else>
+			<reserveFieldOrMethod (printf "IsSet%v" $fname)>
+			// Get<$fname> returns the value of <$fname> if it is set or its
+			// <if .Default>default<else>zero<end> value if it is unset.
+			//
+			// This is safe to call on a nil <$name>.
+			func (<$v> *<$name>) Get<$fname>() (<$o> <typeReference .Type>) {
+				if <$v> != nil && <$field> != nil {
+					return <if isPrimitiveType .Type>*<end><$field>
+				}
+				<if .Default><$o> = <constantValue .Default .Type><end>
+				return
+			}
+
+			// IsSet<$fname> returns true if <$fname> is not nil.
+			//
+			// This is safe to call on a nil <$name>.
+			func (<$v> *<$name>) IsSet<$fname>() bool {
+				return <$v> != nil && <$field> != nil
+			}
+			<end>
+
+			<$old := renamedFrom .>
+			<if $old>
+			<reserveFieldOrMethod (printf "Get%v" $old)>
+			// Get<$old> returns the value of <$fname>.
+			//
+			// Deprecated: <$fname> was renamed from <$old>. Use Get<$fname>
+			// instead.
+			func (<$v> *<$name>) Get<$old>() <typeReference .Type> {
+				return <$v>.Get<$fname>()
+			}
+			<if not .Required>
+			<reserveFieldOrMethod (printf "IsSet%v" $old)>
+			// IsSet<$old> returns true if <$fname> is set.
+			//
+			// Deprecated: <$fname> was renamed from <$old>. Use IsSet<$fname>
+			// instead.
+			func (<$v> *<$name>) IsSet<$old>() bool {
+				return <$v>.IsSet<$fname>()
+			}
+			<end>
+			<if and (isOptionalValue .) (not $.Immutable)>
+			<reserveFieldOrMethod (printf "Set%v" $old)>
+			<reserveFieldOrMethod (printf "Unset%v" $old)>
+			// Set<$old> sets the value of <$fname>.
+			//
+			// Deprecated: <$fname> was renamed from <$old>. Use Set<$fname>
+			// instead.
+			func (<$v> *<$name>) Set<$old>(<$o> <typeReference .Type>) {
+				<$v>.Set<$fname>(<$o>)
+			}
+
+			// Unset<$old> resets <$fname> to its zero value.
+			//
+			// Deprecated: <$fname> was renamed from <$old>. Use Unset<$fname>
+			// instead.
+			func (<$v> *<$name>) Unset<$old>() {
+				<$v>.Unset<$fname>()
+			}
+			<end>
 			<end>
 		<end>
 		`, f,
-		TemplateFunc("constantValue", ConstantValue),
-		TemplateFunc("reserveFieldOrMethod", func(name string) (string, error) {
-			// we return an empty string for the sake of the templating system
-			err := fieldsAndAccessors.Reserve(name)
-			return "", err
-		}),
+		append(f.optionalValueFuncs(),
+			TemplateFunc("constantValue", ConstantValue),
+			TemplateFunc("renamedFrom", renamedFromGoAnnotation),
+			TemplateFunc("reserveFieldOrMethod", func(name string) (string, error) {
+				// we return an empty string for the sake of the templating system
+				err := fieldsAndAccessors.Reserve(name)
+				return "", err
+			}),
+		)...,
 	)
 }
@@ -0,0 +1,110 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateTagsMapstructure(t *testing.T) {
+	tests := []struct {
+		desc             string
+		field            *compile.FieldSpec
+		mapstructureTags bool
+		want             string
+	}{
+		{
+			desc:  "disabled",
+			field: &compile.FieldSpec{Name: "fooBar", Type: &compile.StringSpec{}, Required: true},
+			want:  "`json:\"fooBar,required\"`",
+		},
+		{
+			desc:             "required",
+			field:            &compile.FieldSpec{Name: "fooBar", Type: &compile.StringSpec{}, Required: true},
+			mapstructureTags: true,
+			want:             "`json:\"fooBar,required\" mapstructure:\"fooBar\"`",
+		},
+		{
+			desc:             "optional",
+			field:            &compile.FieldSpec{Name: "fooBar", Type: &compile.I32Spec{}},
+			mapstructureTags: true,
+			want:             "`json:\"fooBar,omitempty\" mapstructure:\"fooBar\"`",
+		},
+		{
+			desc: "go.tag override",
+			field: &compile.FieldSpec{
+				Name:        "fooBar",
+				Type:        &compile.StringSpec{},
+				Required:    true,
+				Annotations: compile.Annotations{goTagKey: `mapstructure:"foo_bar"`},
+			},
+			mapstructureTags: true,
+			want:             "`json:\"fooBar,required\" mapstructure:\"foo_bar\"`",
+		},
+	}
+
+	for _, tt := range tests {
+		g := newGenerator(thriftPackageImporter{}, "foo", "foo")
+		g.opts = &Options{MapstructureTags: tt.mapstructureTags}
+
+		got, err := generateTags(g, tt.field)
+		if assert.NoError(t, err, tt.desc) {
+			assert.Equal(t, tt.want, got, tt.desc)
+		}
+	}
+}
+
+func TestRedactAnnotation(t *testing.T) {
+	tests := []struct {
+		give    compile.Annotations
+		want    bool
+		wantErr string
+	}{
+		{give: nil, want: false},
+		{give: compile.Annotations{redactKey: ""}, want: true},
+		{give: compile.Annotations{redactKey: "true"}, want: true},
+		{give: compile.Annotations{redactKey: "false"}, want: false},
+		{
+			give:    compile.Annotations{redactKey: "yes"},
+			wantErr: `the thriftrw.redact annotation of field "token" must be "true" or "false": got "yes"`,
+		},
+	}
+
+	for _, tt := range tests {
+		f := &compile.FieldSpec{Name: "token", Type: &compile.StringSpec{}, Annotations: tt.give}
+
+		err := checkRedactAnnotations(compile.FieldGroup{f})
+		if tt.wantErr != "" {
+			if assert.Error(t, err, "%v", tt.give) {
+				assert.Equal(t, tt.wantErr, err.Error())
+			}
+			continue
+		}
+
+		if assert.NoError(t, err, "%v", tt.give) {
+			assert.Equal(t, tt.want, isRedacted(f), "%v", tt.give)
+		}
+	}
+}
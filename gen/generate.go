@@ -25,11 +25,14 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"go.uber.org/thriftrw/compile"
 	"go.uber.org/thriftrw/internal/plugin"
+	"go.uber.org/thriftrw/version"
 
 	"go.uber.org/multierr"
 )
@@ -59,6 +62,10 @@ type Options struct {
 	// If true, we will not generate versioncheck.go files.
 	NoVersionCheck bool
 
+	// If non-empty, code is generated only if it may be used with this
+	// version of the ThriftRW library. See VerifyVersion.
+	RequireVersion string
+
 	// Code generation plugin
 	Plugin plugin.Handle
 
@@ -73,10 +80,145 @@ type Options struct {
 
 	// Do not embed IDLs in generated code
 	NoEmbedIDL bool
+
+	// Generate zap marshalers for generated types so that they may be
+	// logged with zap without reflection. Generated packages import
+	// go.uber.org/zap/zapcore.
+	Zap bool
+
+	// Generate FooBuilder types for structs with more than BuilderThreshold
+	// fields.
+	GenerateBuilders bool
+	BuilderThreshold int
+
+	// Add mapstructure tags to the fields of generated structs so that they
+	// may be decoded with mapstructure and viper.
+	MapstructureTags bool
+
+	// Generate DriftSchema methods on the arguments of service functions so
+	// that servers may detect callers with a different version of the
+	// Thrift file. See the drift package.
+	DriftSchemas bool
+
+	// Generate Validate methods which verify that required fields are set
+	// and unions have exactly one field set, recursively. See the validate
+	// package.
+	GenerateValidate bool
+
+	// Verify that string fields, and strings held in lists, sets, and maps
+	// of fields, are valid UTF-8 when structs are encoded or decoded.
+	// Fields annotated with thriftrw.allowInvalidUTF8 are not verified.
+	StrictUTF8 bool
+
+	// Generate optional fields of primitive types in structs and exceptions
+	// as values rather than pointers. Whether such fields are set is tracked
+	// by IsSet, Set, and Unset methods. Fields, structs, and exceptions may
+	// override this with the thriftrw.optional annotation.
+	OptionalValues bool
+
+	// Call into go.uber.org/thriftrw/runtime to encode lists, sets, and
+	// maps instead of declaring a type with the same methods for each of
+	// them, and to decode the fields of structs with a table of field
+	// readers instead of a switch statement. This reduces the size of the
+	// generated code at the cost of an indirect function call per item or
+	// field.
+	CompactCode bool
+
+	// Add an UnknownFields field to generated structs and exceptions which
+	// holds the fields that were not recognized when they were decoded, and
+	// write these fields back when they are encoded.
+	PreserveUnknownFields bool
+
+	// Generate MemSize methods on structs, unions, exceptions, and typedefs
+	// which estimate the memory they hold, including the memory referenced
+	// by their fields.
+	MemSize bool
+
+	// Generate the fields of structs, unions, and exceptions unexported so
+	// that they can only be read with their getters. Values are constructed
+	// with builders and may not be modified once built.
+	Immutable bool
+
+	// Write a thriftrw-manifest.json to each package which records the
+	// version of ThriftRW and the options used to generate it. See
+	// VerifyManifests.
+	Manifest bool
+
+	// Write a ChecksumFile to each package which records a checksum of the
+	// Thrift files, the version of ThriftRW, and the options it was
+	// generated with, and skip generating code for packages whose
+	// checksums have not changed since. Files of skipped packages are not
+	// written at all, so packages must not be modified by hand.
+	Incremental bool
+
+	// Generate code for all packages with Incremental, even if they have
+	// not changed.
+	Force bool
+
+	// Place packages for Thrift files with a "namespace go foo.bar"
+	// declaration at foo/bar relative to the OutputDir and PackagePrefix
+	// instead of at the path of the Thrift file relative to the ThriftRoot.
+	GoNamespaces bool
+
+	// Place the code generated for all Thrift files in a single package at
+	// the OutputDir, named after the last component of the PackagePrefix,
+	// instead of one package per Thrift file. Types from different Thrift
+	// files must not have the same names. IDLs are not embedded in flat
+	// packages.
+	Flat bool
+
+	// Generate a doc.go for each package which lists the Thrift file, the
+	// services, and the types it was generated from.
+	PackageDoc bool
+
+	// Write each type to its own types_$name.go file, where $name is the
+	// name of the type in lower case, instead of writing all types of a
+	// Thrift file to types.go. This keeps files small for Thrift files with
+	// many types.
+	SplitTypes bool
+
+	// Generate an example_test.go for each package with runnable examples
+	// which build, encode, and decode the structs, unions, and exceptions
+	// of the package.
+	GenerateExamples bool
+
+	// Templates replaces built-in templates with the given templates, keyed
+	// by name. The following templates may be replaced:
+	//
+	//   struct   The type declarations of structs, unions, exceptions, and
+	//            the arguments and results of service functions.
+	//   enum     Enum types, their items, and their methods.
+	//   typedef  Typedef types and their ToWire, String, FromWire, Equals,
+	//            and Clone methods.
+	//
+	// Replacements are rendered with the same data and functions as the
+	// templates they replace, which may be found in the source of this
+	// package, and use < and > as delimiters. See LoadTemplates.
+	Templates map[string]string
+
+	// Observer, if non-nil, is notified of the progress of code generation.
+	Observer Observer
+
+	// Profile, if non-nil, records where time is spent during code
+	// generation.
+	Profile *Profile
+
+	// PostProcessors are applied, in order, to every generated file before
+	// it is written.
+	PostProcessors []PostProcessor
 }
 
 // Generate generates code based on the given options.
 func Generate(m *compile.Module, o *Options) error {
+	return GenerateModules([]*compile.Module{m}, o)
+}
+
+// GenerateModules generates code for all the given modules based on the given
+// options.
+//
+// Code for modules included by more than one of the given modules is
+// generated only once.
+func GenerateModules(modules []*compile.Module, o *Options) error {
 	if !filepath.IsAbs(o.ThriftRoot) {
 		return fmt.Errorf(
 			"ThriftRoot must be an absolute path: %q is not absolute",
@@ -89,20 +231,123 @@ func Generate(m *compile.Module, o *Options) error {
 			o.OutputDir)
 	}
 
+	if o.Flat && o.GoNamespaces {
+		return fmt.Errorf("Flat and GoNamespaces cannot be used together")
+	}
+
+	// All modules are generated into the same package in flat mode so they
+	// cannot be skipped individually.
+	if o.Flat && o.Incremental {
+		return fmt.Errorf("Flat and Incremental cannot be used together")
+	}
+
+	if o.RequireVersion != "" {
+		if err := verifyVersion(o.RequireVersion, version.Version); err != nil {
+			return err
+		}
+	}
+
+	if err := checkTemplates(o.Templates); err != nil {
+		return err
+	}
+
 	importer := thriftPackageImporter{
 		ImportPrefix: o.PackagePrefix,
 		ThriftRoot:   o.ThriftRoot,
+		Flat:         o.Flat,
+	}
+	if o.GoNamespaces {
+		// Includes are always inspected, even with NoRecurse, so that
+		// references to their packages use the right import paths.
+		importer.Namespaces = goNamespaces(modules)
+	}
+
+	observer := o.Observer
+	if observer == nil {
+		observer = nopObserver{}
 	}
 
 	// Mapping of filenames relative to OutputDir to their contents.
 	files := make(map[string][]byte)
 	genBuilder := newGenerateServiceBuilder(importer)
 
+	// All modules share a generator in flat mode so that helpers needed by
+	// more than one of them are declared only once.
+	var flatGen *generator
+	if o.Flat {
+		flatGen = newModuleGenerator(importer, o.PackagePrefix, goPackageName(o.PackagePrefix), o)
+		if !o.NoVersionCheck {
+			if err := Version(flatGen, o.PackagePrefix); err != nil {
+				return err
+			}
+
+			var buff bytes.Buffer
+			if err := flatGen.Write(&buff, nil /* fset */); err != nil {
+				return fmt.Errorf("could not generate version check: %v", err)
+			}
+			files["versioncheck.go"] = buff.Bytes()
+		}
+
+		if o.Manifest {
+			manifest, err := manifestFile(o)
+			if err != nil {
+				return err
+			}
+			files[ManifestFile] = manifest
+		}
+	}
+
+	// Modules included by more than one of the given modules must be
+	// generated only once or their files will conflict.
+	generated := make(map[string]struct{})
 	generate := func(m *compile.Module) error {
-		moduleFiles, err := generateModule(m, importer, genBuilder, o)
+		if _, ok := generated[m.ThriftPath]; ok {
+			return nil
+		}
+		generated[m.ThriftPath] = struct{}{}
+
+		start := time.Now()
+		var checksum, checksumPath string
+		if o.Incremental {
+			pkg, err := importer.RelativePackage(m.ThriftPath)
+			if err != nil {
+				return generateError{Name: m.ThriftPath, Reason: err}
+			}
+			checksum, err = moduleChecksum(importer, m, o)
+			if err != nil {
+				return generateError{Name: m.ThriftPath, Reason: err}
+			}
+			checksumPath = filepath.Join(pkg, ChecksumFile)
+
+			if !o.Force && isUpToDate(filepath.Join(o.OutputDir, pkg), checksum) {
+				// Plugins generate code for all root services at once so
+				// they must know about the services of skipped modules.
+				for _, name := range sortStringKeys(m.Services) {
+					if _, err := genBuilder.AddRootService(m.Services[name]); err != nil {
+						return generateError{Name: m.ThriftPath, Reason: err}
+					}
+				}
+				observer.ModuleGenerated(ModuleGenerated{
+					Module:   m,
+					Skipped:  true,
+					Duration: time.Since(start),
+				})
+				return nil
+			}
+		}
+
+		moduleFiles, err := generateModule(m, importer, genBuilder, o, flatGen)
 		if err != nil {
 			return generateError{Name: m.ThriftPath, Reason: err}
 		}
+		if o.Incremental {
+			moduleFiles[checksumPath] = checksumFile(checksum)
+		}
+		observer.ModuleGenerated(ModuleGenerated{
+			Module:   m,
+			Files:    len(moduleFiles),
+			Duration: time.Since(start),
+		})
 		if err := mergeFiles(files, moduleFiles); err != nil {
 			return generateError{Name: m.ThriftPath, Reason: err}
 		}
@@ -112,13 +357,15 @@ func Generate(m *compile.Module, o *Options) error {
 	// Note that we call generate directly on only those modules that we need
 	// to generate code for. If the user used --no-recurse, we're not going to
 	// generate code for included modules.
-	if o.NoRecurse {
-		if err := generate(m); err != nil {
-			return err
-		}
-	} else {
-		if err := m.Walk(generate); err != nil {
-			return err
+	for _, m := range modules {
+		if o.NoRecurse {
+			if err := generate(m); err != nil {
+				return err
+			}
+		} else {
+			if err := m.Walk(generate); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -138,7 +385,24 @@ func Generate(m *compile.Module, o *Options) error {
 		}
 	}
 
-	for relPath, contents := range files {
+	// Files are written in order of their paths so that post-processors and
+	// observers see them in the same order on every run.
+	for _, relPath := range sortStringKeys(files) {
+		contents := files[relPath]
+		for _, p := range o.PostProcessors {
+			if base := filepath.Base(relPath); base == ManifestFile || base == ChecksumFile {
+				// Manifests and checksums describe the generated code and
+				// are not a part of it.
+				break
+			}
+
+			var err error
+			contents, err = p.PostProcess(relPath, contents)
+			if err != nil {
+				return fmt.Errorf("failed to post-process %q: %v", relPath, err)
+			}
+		}
+
 		fullPath := filepath.Join(o.OutputDir, relPath)
 		directory := filepath.Dir(fullPath)
 
@@ -149,6 +413,7 @@ func Generate(m *compile.Module, o *Options) error {
 		if err := ioutil.WriteFile(fullPath, contents, 0644); err != nil {
 			return fmt.Errorf("failed to write %q: %v", fullPath, err)
 		}
+		observer.FileGenerated(FileGenerated{Path: relPath, Size: len(contents)})
 	}
 
 	return nil
@@ -159,16 +424,57 @@ func Generate(m *compile.Module, o *Options) error {
 type thriftPackageImporter struct {
 	ImportPrefix string
 	ThriftRoot   string
+
+	// Namespaces maps the paths of Thrift files to their "namespace go"
+	// declarations. Packages for these files are placed at the declared
+	// namespace rather than at their location relative to the ThriftRoot.
+	Namespaces map[string]string
+
+	// Flat places all Thrift files in the top-level package.
+	Flat bool
 }
 
 // RelativePackage returns the import path for the top-level package of the
 // given Thrift file relative to the ImportPrefix.
 func (i thriftPackageImporter) RelativePackage(file string) (string, error) {
+	if i.Flat {
+		return ".", nil
+	}
+	if ns, ok := i.Namespaces[file]; ok {
+		return filepath.FromSlash(strings.Replace(ns, ".", "/", -1)), nil
+	}
 	return filepath.Rel(i.ThriftRoot, strings.TrimSuffix(file, ".thrift"))
 }
 
+// goNamespaceScope is the scope of namespace declarations which apply to Go.
+//
+//   namespace go foo.bar
+const goNamespaceScope = "go"
+
+// goNamespaces returns a mapping from the paths of the given modules and all
+// modules they include to their "namespace go" declarations, if any.
+func goNamespaces(modules []*compile.Module) map[string]string {
+	namespaces := make(map[string]string)
+	for _, m := range modules {
+		m.Walk(func(m *compile.Module) error {
+			if ns, ok := m.Namespaces[goNamespaceScope]; ok {
+				namespaces[m.ThriftPath] = ns
+			}
+			return nil
+		})
+	}
+	return namespaces
+}
+
+// RelativeThriftFilePath returns the path of the given Thrift file relative
+// to the ThriftRoot.
+//
+// The path is separated by forward slashes on all platforms because it may
+// be embedded in the generated code, which must not depend on the machine
+// that generated it.
 func (i thriftPackageImporter) RelativeThriftFilePath(file string) (string, error) {
-	return filepath.Rel(i.ThriftRoot, file)
+	rel, err := filepath.Rel(i.ThriftRoot, file)
+	return filepath.ToSlash(rel), err
 }
 
 // Package returns the import path for the top-level package of the given Thrift
@@ -178,7 +484,7 @@ func (i thriftPackageImporter) Package(file string) (string, error) {
 	if err != nil {
 		return "", err
 	}
-	return filepath.Join(i.ImportPrefix, pkg), nil
+	return path.Join(i.ImportPrefix, filepath.ToSlash(pkg)), nil
 }
 
 func mergeFiles(dest, src map[string][]byte) error {
@@ -193,9 +499,20 @@ func mergeFiles(dest, src map[string][]byte) error {
 	return multierr.Combine(errors...)
 }
 
+// newModuleGenerator builds a generator for the package with the given import
+// path and name, configured per the given options.
+func newModuleGenerator(i thriftPackageImporter, importPath, packageName string, o *Options) *generator {
+	g := newGenerator(i, importPath, packageName)
+	g.profile = o.Profile
+	g.opts = o
+	return g
+}
+
 // generateModule returns a mapping from filename to file contents of files that
 // should be generated relative to o.OutputDir.
-func generateModule(m *compile.Module, i thriftPackageImporter, builder *generateServiceBuilder, o *Options) (map[string][]byte, error) {
+//
+// If g is non-nil, code is generated with it rather than a new generator.
+func generateModule(m *compile.Module, i thriftPackageImporter, builder *generateServiceBuilder, o *Options, g *generator) (map[string][]byte, error) {
 	// packageRelPath is the path relative to outputDir into which we'll be
 	// writing the package for this Thrift file. For $thriftRoot/foo/bar.thrift,
 	// packageRelPath is foo/bar, and packageDir is $outputDir/foo/bar. All
@@ -206,9 +523,6 @@ func generateModule(m *compile.Module, i thriftPackageImporter, builder *generat
 		return nil, err
 	}
 
-	// TODO(abg): Prefer top-level package name from `namespace go` directive.
-	packageName := filepath.Base(packageRelPath)
-
 	// importPath is the full import path for the top-level package generated
 	// for this Thrift file.
 	importPath, err := i.Package(m.ThriftPath)
@@ -216,29 +530,57 @@ func generateModule(m *compile.Module, i thriftPackageImporter, builder *generat
 		return nil, err
 	}
 
+	packageName := goPackageName(importPath)
+
 	// Mapping of file names relative to packageRelPath to their contents.
 	// Note that we need to return a mapping relative to o.OutputDir so we
 	// will prepend $packageRelPath/ to all these paths.
 	files := make(map[string][]byte)
 
-	g := NewGenerator(i, importPath, packageName)
-
-	if !o.NoVersionCheck {
-		if err := Version(g, importPath); err != nil {
+	// In flat mode, files for all Thrift files are in the same directory so
+	// their names are prefixed with the path of the Thrift file.
+	var filePrefix string
+	if o.Flat {
+		thriftRelPath, err := i.RelativeThriftFilePath(m.ThriftPath)
+		if err != nil {
 			return nil, err
 		}
+		filePrefix = strings.Replace(
+			strings.TrimSuffix(thriftRelPath, ".thrift"),
+			"/", "_", -1) + "_"
+	}
 
-		var buff bytes.Buffer
-		if err := g.Write(&buff, nil /* fset */); err != nil {
-			return nil, fmt.Errorf(
-				"could not generate version check for %q: %v", m.ThriftPath, err)
+	if g == nil {
+		g = newModuleGenerator(i, importPath, packageName, o)
+
+		if !o.NoVersionCheck {
+			if err := Version(g, importPath); err != nil {
+				return nil, err
+			}
+
+			var buff bytes.Buffer
+			if err := g.Write(&buff, nil /* fset */); err != nil {
+				return nil, fmt.Errorf(
+					"could not generate version check for %q: %v", m.ThriftPath, err)
+			}
+			files["versioncheck.go"] = buff.Bytes()
+		}
+
+		if o.Manifest {
+			manifest, err := manifestFile(o)
+			if err != nil {
+				return nil, err
+			}
+			files[ManifestFile] = manifest
 		}
-		files["versioncheck.go"] = buff.Bytes()
 	}
 
 	if len(m.Constants) > 0 {
 		for _, constantName := range sortStringKeys(m.Constants) {
-			if err := Constant(g, m.Constants[constantName]); err != nil {
+			done := o.Profile.beginSpec(m.Name + "." + constantName)
+			err := Constant(g, m.Constants[constantName])
+			done()
+			if err != nil {
 				return nil, err
 			}
 		}
@@ -251,30 +593,57 @@ func generateModule(m *compile.Module, i thriftPackageImporter, builder *generat
 
 		// TODO(abg): Verify no file collisions
 		if !o.NoConstants {
-			files["constants.go"] = buff.Bytes()
+			files[filePrefix+"constants.go"] = buff.Bytes()
 		}
 	}
 
 	if len(m.Types) > 0 {
 		for _, typeName := range sortStringKeys(m.Types) {
-			if err := TypeDefinition(g, m.Types[typeName]); err != nil {
+			done := o.Profile.beginSpec(m.Name + "." + typeName)
+			err := TypeDefinition(g, m.Types[typeName])
+			done()
+			if err != nil {
 				return nil, err
 			}
+
+			if !o.SplitTypes {
+				continue
+			}
+
+			// Helpers are declared in the file of the first type that needs
+			// them. Types in later files use them from there.
+			buff := new(bytes.Buffer)
+			if err := g.Write(buff, nil /* fset */); err != nil {
+				return nil, fmt.Errorf(
+					"could not generate type %q for %q: %v", typeName, m.ThriftPath, err)
+			}
+			if !o.NoTypes {
+				fileName := fmt.Sprintf("types_%s.go", strings.ToLower(typeName))
+				files[filePrefix+fileName] = buff.Bytes()
+			}
 		}
 
-		buff := new(bytes.Buffer)
-		if err := g.Write(buff, nil /* fset */); err != nil {
-			return nil, fmt.Errorf(
-				"could not generate types for %q: %v", m.ThriftPath, err)
+		if err := exceptionFamilies(g, m); err != nil {
+			return nil, err
 		}
 
-		// TODO(abg): Verify no file collisions
-		if !o.NoTypes {
-			files["types.go"] = buff.Bytes()
+		// With SplitTypes, types.go holds only declarations that span
+		// multiple types, and only if there are any.
+		if !o.SplitTypes || g.hasDecls() {
+			buff := new(bytes.Buffer)
+			if err := g.Write(buff, nil /* fset */); err != nil {
+				return nil, fmt.Errorf(
+					"could not generate types for %q: %v", m.ThriftPath, err)
+			}
+
+			// TODO(abg): Verify no file collisions
+			if !o.NoTypes {
+				files[filePrefix+"types.go"] = buff.Bytes()
+			}
 		}
 	}
 
-	if !o.NoEmbedIDL {
+	if !o.NoEmbedIDL && !o.Flat {
 		if err := embedIDL(g, i, m); err != nil {
 			return nil, err
 		}
@@ -288,6 +657,35 @@ func generateModule(m *compile.Module, i thriftPackageImporter, builder *generat
 		files["idl.go"] = buff.Bytes()
 	}
 
+	if o.GenerateExamples && !o.NoTypes && len(m.Types) > 0 {
+		// Examples are in the external test package so that they use the
+		// generated types the same way users do.
+		eg := newModuleGenerator(i, importPath+"_test", packageName+"_test", o)
+		eg.keepComments = true // for "// Output:"
+		if err := examples(eg, m); err != nil {
+			return nil, err
+		}
+
+		// Modules with only enums and typedefs have nothing to show.
+		if eg.hasDecls() {
+			buff := new(bytes.Buffer)
+			if err := eg.Write(buff, nil /* fset */); err != nil {
+				return nil, fmt.Errorf(
+					"could not generate examples for %q: %v", m.ThriftPath, err)
+			}
+			files[filePrefix+"example_test.go"] = buff.Bytes()
+		}
+	}
+
+	if o.PackageDoc {
+		contents, err := packageDoc(g, i, m, packageName, o)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"could not generate doc.go for %q: %v", m.ThriftPath, err)
+		}
+		files[filePrefix+"doc.go"] = contents
+	}
+
 	// Services must be generated last because names of user-defined types take
 	// precedence over the names we pick for the service types.
 	if len(m.Services) > 0 {
@@ -305,7 +703,9 @@ func generateModule(m *compile.Module, i thriftPackageImporter, builder *generat
 				return nil, err
 			}
 
+			done := o.Profile.beginSpec(m.Name + "." + serviceName)
 			serviceFiles, err := Service(g, service)
+			done()
 			if err != nil {
 				return nil, fmt.Errorf(
 					"could not generate code for service %q: %v",
@@ -314,7 +714,7 @@ func generateModule(m *compile.Module, i thriftPackageImporter, builder *generat
 
 			if !o.NoServiceHelpers {
 				for name, buff := range serviceFiles {
-					files[name] = buff.Bytes()
+					files[filePrefix+name] = buff.Bytes()
 				}
 			}
 		}
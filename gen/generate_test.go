@@ -25,6 +25,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
 	"testing"
 
 	"go.uber.org/thriftrw/compile"
@@ -247,6 +248,45 @@ func TestGenerate(t *testing.T) {
 	}
 }
 
+type recordingObserver struct {
+	modules []ModuleGenerated
+	files   []FileGenerated
+}
+
+func (o *recordingObserver) ModuleGenerated(e ModuleGenerated) { o.modules = append(o.modules, e) }
+func (o *recordingObserver) FileGenerated(e FileGenerated)     { o.files = append(o.files, e) }
+
+func TestGenerateObserver(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "thriftrw-generate-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+
+	module, err := compile.Compile("testdata/thrift/structs.thrift")
+	require.NoError(t, err)
+
+	var o recordingObserver
+	err = Generate(module, &Options{
+		OutputDir:     outputDir,
+		PackagePrefix: "go.uber.org/thriftrw/gen/testdata",
+		ThriftRoot:    testdata(t, "thrift"),
+		NoRecurse:     true,
+		Observer:      &o,
+	})
+	require.NoError(t, err)
+
+	require.Len(t, o.modules, 1)
+	assert.Equal(t, module, o.modules[0].Module)
+	assert.Equal(t, len(o.files), o.modules[0].Files)
+
+	require.NotEmpty(t, o.files)
+	for _, f := range o.files {
+		info, err := os.Stat(filepath.Join(outputDir, f.Path))
+		if assert.NoError(t, err) {
+			assert.Equal(t, int(info.Size()), f.Size, "size of %q", f.Path)
+		}
+	}
+}
+
 func TestThriftPackageImporter(t *testing.T) {
 	importer := thriftPackageImporter{
 		ImportPrefix: "github.com/myteam/myservice",
@@ -289,3 +329,391 @@ func TestThriftPackageImporter(t *testing.T) {
 		}
 	}
 }
+
+func TestGeneratePackageName(t *testing.T) {
+	thriftRoot, err := ioutil.TempDir("", "thriftrw-generate-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(thriftRoot)
+
+	outputDir, err := ioutil.TempDir("", "thriftrw-generate-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+
+	thriftFile := filepath.Join(thriftRoot, "shared-types.thrift")
+	require.NoError(t, ioutil.WriteFile(thriftFile, []byte("typedef string UUID\n"), 0644))
+
+	module, err := compile.Compile(thriftFile)
+	require.NoError(t, err)
+
+	require.NoError(t, Generate(module, &Options{
+		OutputDir:      outputDir,
+		PackagePrefix:  "go.uber.org/thriftrw/gen/testdata",
+		ThriftRoot:     thriftRoot,
+		NoRecurse:      true,
+		NoVersionCheck: true,
+	}))
+
+	contents, err := ioutil.ReadFile(filepath.Join(outputDir, "shared-types", "types.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(contents), "\npackage shared_types\n")
+}
+
+func TestGenerateGoNamespaces(t *testing.T) {
+	thriftRoot, err := ioutil.TempDir("", "thriftrw-generate-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(thriftRoot)
+
+	files := map[string]string{
+		"users.thrift": `
+			namespace go services.users
+			namespace py services.users_py
+
+			include "./common.thrift"
+			include "./shared.thrift"
+
+			struct User {
+				1: required common.UUID id
+				2: optional shared.Email email
+			}
+		`,
+		"common.thrift": `
+			namespace go common.types
+			typedef string UUID
+		`,
+		"shared.thrift": `
+			typedef string Email
+		`,
+	}
+	for name, contents := range files {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(thriftRoot, name), []byte(contents), 0644))
+	}
+
+	module, err := compile.Compile(filepath.Join(thriftRoot, "users.thrift"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc         string
+		goNamespaces bool
+		noRecurse    bool
+		wantFiles    []string
+		wantImports  []string
+		wantPackage  string
+	}{
+		{
+			desc:         "namespaces",
+			goNamespaces: true,
+			wantFiles: []string{
+				"services/users/types.go",
+				"common/types/types.go",
+				"shared/types.go",
+			},
+			wantImports: []string{
+				`"go.uber.org/thriftrw/gen/testdata/common/types"`,
+				`"go.uber.org/thriftrw/gen/testdata/shared"`,
+			},
+			wantPackage: "users",
+		},
+		{
+			desc:         "namespaces without recursion",
+			goNamespaces: true,
+			noRecurse:    true,
+			wantFiles:    []string{"services/users/types.go"},
+			wantImports: []string{
+				`"go.uber.org/thriftrw/gen/testdata/common/types"`,
+				`"go.uber.org/thriftrw/gen/testdata/shared"`,
+			},
+			wantPackage: "users",
+		},
+		{
+			desc: "namespaces disabled",
+			wantFiles: []string{
+				"users/types.go",
+				"common/types.go",
+				"shared/types.go",
+			},
+			wantImports: []string{
+				`"go.uber.org/thriftrw/gen/testdata/common"`,
+				`"go.uber.org/thriftrw/gen/testdata/shared"`,
+			},
+			wantPackage: "users",
+		},
+	}
+
+	for _, tt := range tests {
+		func() {
+			outputDir, err := ioutil.TempDir("", "thriftrw-generate-test")
+			require.NoError(t, err)
+			defer os.RemoveAll(outputDir)
+
+			require.NoError(t, Generate(module, &Options{
+				OutputDir:      outputDir,
+				PackagePrefix:  "go.uber.org/thriftrw/gen/testdata",
+				ThriftRoot:     thriftRoot,
+				GoNamespaces:   tt.goNamespaces,
+				NoRecurse:      tt.noRecurse,
+				NoVersionCheck: true,
+			}), tt.desc)
+
+			for _, f := range tt.wantFiles {
+				_, err := os.Stat(filepath.Join(outputDir, f))
+				assert.NoError(t, err, tt.desc)
+			}
+
+			contents, err := ioutil.ReadFile(filepath.Join(outputDir, tt.wantFiles[0]))
+			require.NoError(t, err, tt.desc)
+			assert.Contains(t, string(contents), "\npackage "+tt.wantPackage+"\n", tt.desc)
+			for _, imp := range tt.wantImports {
+				assert.Contains(t, string(contents), imp, tt.desc)
+			}
+		}()
+	}
+}
+
+func TestGenerateFlat(t *testing.T) {
+	thriftRoot, err := ioutil.TempDir("", "thriftrw-generate-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(thriftRoot)
+
+	files := map[string]string{
+		"users.thrift": `
+			include "./common/uuid.thrift"
+
+			struct User {
+				1: required uuid.UUID id
+			}
+
+			service UserService {
+				User getUser(1: uuid.UUID id)
+			}
+		`,
+		"common/uuid.thrift": `
+			typedef string UUID
+		`,
+		"conflict.thrift": `
+			include "./common/uuid.thrift"
+
+			typedef uuid.UUID UUID
+		`,
+	}
+	for name, contents := range files {
+		path := filepath.Join(thriftRoot, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, ioutil.WriteFile(path, []byte(contents), 0644))
+	}
+
+	t.Run("success", func(t *testing.T) {
+		module, err := compile.Compile(filepath.Join(thriftRoot, "users.thrift"))
+		require.NoError(t, err)
+
+		outputDir, err := ioutil.TempDir("", "thriftrw-generate-test")
+		require.NoError(t, err)
+		defer os.RemoveAll(outputDir)
+
+		require.NoError(t, Generate(module, &Options{
+			OutputDir:     outputDir,
+			PackagePrefix: "go.uber.org/thriftrw/gen/testdata/models",
+			ThriftRoot:    thriftRoot,
+			Flat:          true,
+		}))
+
+		var got []string
+		err = filepath.Walk(outputDir, func(path string, info os.FileInfo, err error) error {
+			if err != nil || info.IsDir() {
+				return err
+			}
+			rel, err := filepath.Rel(outputDir, path)
+			got = append(got, rel)
+			return err
+		})
+		require.NoError(t, err)
+		sort.Strings(got)
+		assert.Equal(t, []string{
+			"common_uuid_types.go",
+			"users_types.go",
+			"users_userservice_getuser.go",
+			"versioncheck.go",
+		}, got)
+
+		contents, err := ioutil.ReadFile(filepath.Join(outputDir, "users_types.go"))
+		require.NoError(t, err)
+		assert.Contains(t, string(contents), "\npackage models\n")
+		assert.NotContains(t, string(contents), "gen/testdata/models/common",
+			"types from other Thrift files must be referenced without an import")
+		assert.NotContains(t, string(contents), "ThriftModule",
+			"IDLs must not be embedded")
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		module, err := compile.Compile(filepath.Join(thriftRoot, "conflict.thrift"))
+		require.NoError(t, err)
+
+		outputDir, err := ioutil.TempDir("", "thriftrw-generate-test")
+		require.NoError(t, err)
+		defer os.RemoveAll(outputDir)
+
+		err = Generate(module, &Options{
+			OutputDir:      outputDir,
+			PackagePrefix:  "go.uber.org/thriftrw/gen/testdata/models",
+			ThriftRoot:     thriftRoot,
+			Flat:           true,
+			NoVersionCheck: true,
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `"UUID"`)
+	})
+
+	t.Run("namespaces", func(t *testing.T) {
+		module, err := compile.Compile(filepath.Join(thriftRoot, "users.thrift"))
+		require.NoError(t, err)
+
+		err = Generate(module, &Options{
+			OutputDir:     thriftRoot,
+			PackagePrefix: "go.uber.org/thriftrw/gen/testdata/models",
+			ThriftRoot:    thriftRoot,
+			Flat:          true,
+			GoNamespaces:  true,
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Flat and GoNamespaces cannot be used together")
+	})
+}
+
+func TestGenerateIsDeterministic(t *testing.T) {
+	// In flat mode, helpers used by more than one module are generated into
+	// whichever module needs them first, so the order in which modules are
+	// generated must not change between runs.
+	modules, err := compile.CompileFiles([]string{
+		"testdata/thrift/services.thrift",
+		"testdata/thrift/typedefs.thrift",
+	})
+	require.NoError(t, err)
+
+	generate := func() map[string]string {
+		outputDir, err := ioutil.TempDir("", "thriftrw-generate-test")
+		require.NoError(t, err)
+		defer os.RemoveAll(outputDir)
+
+		require.NoError(t, GenerateModules(modules, &Options{
+			OutputDir:     outputDir,
+			PackagePrefix: "go.uber.org/thriftrw/gen/testdata/flat",
+			ThriftRoot:    testdata(t, "thrift"),
+			Flat:          true,
+		}))
+
+		return readFiles(t, outputDir)
+	}
+
+	want := generate()
+	for i := 0; i < 5; i++ {
+		got := generate()
+		require.Equal(t, len(want), len(got), "number of files must not change")
+		for path, contents := range want {
+			assert.Equal(t, contents, got[path], "contents of %q must not change", path)
+		}
+	}
+}
+
+func TestGenerateIsMachineIndependent(t *testing.T) {
+	// The same Thrift files at different locations must generate the same
+	// code, with no trace of where they or the output were.
+	thriftFiles, err := filepath.Glob("testdata/thrift/*.thrift")
+	require.NoError(t, err)
+
+	generate := func() (files map[string]string, dirs []string) {
+		thriftRoot, err := ioutil.TempDir("", "thriftrw-machine-test-root")
+		require.NoError(t, err)
+		defer os.RemoveAll(thriftRoot)
+
+		outputDir, err := ioutil.TempDir("", "thriftrw-machine-test-out")
+		require.NoError(t, err)
+		defer os.RemoveAll(outputDir)
+
+		var paths []string
+		for _, f := range thriftFiles {
+			contents, err := ioutil.ReadFile(f)
+			require.NoError(t, err)
+
+			path := filepath.Join(thriftRoot, "idl", filepath.Base(f))
+			require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+			require.NoError(t, ioutil.WriteFile(path, contents, 0644))
+			paths = append(paths, path)
+		}
+
+		modules, err := compile.CompileFiles(paths)
+		require.NoError(t, err)
+
+		require.NoError(t, GenerateModules(modules, &Options{
+			OutputDir:     outputDir,
+			PackagePrefix: "go.uber.org/thriftrw/gen/testdata",
+			ThriftRoot:    thriftRoot,
+			PackageDoc:    true,
+			Manifest:      true,
+		}))
+		return readFiles(t, outputDir), []string{thriftRoot, outputDir}
+	}
+
+	want, wantDirs := generate()
+	got, gotDirs := generate()
+	require.Equal(t, len(want), len(got), "number of files must match")
+	for path, contents := range want {
+		assert.Equal(t, contents, got[path], "contents of %q must match", path)
+		for _, dir := range append(wantDirs, gotDirs...) {
+			assert.NotContains(t, contents, dir, "%q must not contain absolute paths", path)
+		}
+	}
+}
+
+// readFiles returns a mapping from the paths of all files in the given
+// directory tree, relative to it, to their contents.
+func readFiles(t *testing.T, dir string) map[string]string {
+	files := make(map[string]string)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+		contents, err := ioutil.ReadFile(path)
+		files[rel] = string(contents)
+		return err
+	})
+	require.NoError(t, err)
+	return files
+}
+
+func TestGenerateModules(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "thriftrw-generate-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+
+	// Both include enums.thrift and structs includes it too.
+	modules, err := compile.CompileFiles([]string{
+		"testdata/thrift/enum_conflict.thrift",
+		"testdata/thrift/structs.thrift",
+		"testdata/thrift/enums.thrift",
+	})
+	require.NoError(t, err)
+
+	observer := &recordingObserver{}
+	require.NoError(t, GenerateModules(modules, &Options{
+		OutputDir:     outputDir,
+		PackagePrefix: "go.uber.org/thriftrw/gen/testdata",
+		ThriftRoot:    testdata(t, "thrift"),
+		Observer:      observer,
+	}))
+
+	var generated []string
+	for _, e := range observer.modules {
+		generated = append(generated, e.Module.Name)
+	}
+	sort.Strings(generated)
+	assert.Equal(t, []string{"enum_conflict", "enums", "structs"}, generated,
+		"each module must be generated exactly once")
+
+	for _, f := range []string{"enum_conflict/types.go", "structs/types.go", "enums/types.go"} {
+		_, err := os.Stat(filepath.Join(outputDir, f))
+		assert.NoError(t, err, f)
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"go/ast"
 	"go/parser"
 	"go/printer"
+	"go/scanner"
 	"go/token"
 	"go/types"
 	"io"
@@ -35,6 +36,7 @@ import (
 
 	"go.uber.org/thriftrw/compile"
 	"go.uber.org/thriftrw/internal/curry"
+	"go.uber.org/thriftrw/internal/templates"
 	"go.uber.org/thriftrw/version"
 )
 
@@ -53,6 +55,12 @@ type Generator interface {
 
 	// TextTemplate renders the given template with the given template
 	// context.
+	//
+	// Templates follow the text/template format with "<" and ">" as the
+	// delimiters. Text between <raw> and <endraw> is copied verbatim so that
+	// it may contain the delimiters.
+	//
+	// 	if <raw>a<b<endraw> { ... }
 	TextTemplate(s string, data interface{}, opts ...TemplateOption) (string, error)
 
 	// DeclareFromTemplate renders the given template, and includes the
@@ -93,8 +101,46 @@ type Generator interface {
 	Write(w io.Writer, _ *token.FileSet) error
 }
 
+// ImportsGenerator is implemented by Generators which can list the packages
+// imported so far. Check for it with a type assertion on a Generator.
+type ImportsGenerator interface {
+	Generator
+
+	// Imports returns a map from import path to the name used to reference
+	// each package imported so far in the current file. Names of packages
+	// whose base names collide are aliased.
+	Imports() map[string]string
+}
+
+// OptionsGenerator is implemented by Generators which know the options with
+// which code is being generated. Check for it with a type assertion on a
+// Generator.
+type OptionsGenerator interface {
+	Generator
+
+	// Options returns the options with which code is being generated. The
+	// returned Options must not be modified.
+	Options() *Options
+}
+
+var (
+	_ ImportsGenerator = (*generator)(nil)
+	_ OptionsGenerator = (*generator)(nil)
+)
+
 var _typeOfGenerator = reflect.TypeOf((*Generator)(nil)).Elem()
 
+// generatorOptions returns the options with which g is generating code, or
+// the zero Options if g does not implement OptionsGenerator.
+func generatorOptions(g Generator) *Options {
+	if og, ok := g.(OptionsGenerator); ok {
+		if opts := og.Options(); opts != nil {
+			return opts
+		}
+	}
+	return &Options{}
+}
+
 // TemplateOption customizes templates.
 type TemplateOption func(Generator, *template.Template) *template.Template
 
@@ -128,18 +174,41 @@ type generator struct {
 
 	w              WireGenerator
 	e              equalsGenerator
+	c              cloneGenerator
 	decls          []ast.Decl
+	declComments   map[ast.Decl][]*ast.CommentGroup
 	thriftImporter thriftPackageImporter
 	mangler        *mangler
 
+	// keepComments records comments inside declarations so that they are
+	// written with them. Otherwise, only doc comments are kept.
+	keepComments bool
+
 	counter int
 	fset    *token.FileSet
 
+	// profile, if non-nil, records time spent rendering templates.
+	profile *Profile
+
+	// templates holds the templates parsed by this generator keyed by
+	// their source. It is not shared between generators so that it does not
+	// outlive the code generation, and so that templates never keep
+	// functions bound to another generator.
+	templates map[string]*template.Template
+
+	// opts holds the options with which code is being generated. It is
+	// never nil.
+	opts *Options
+
 	// TODO use something to group related decls together
 }
 
 // NewGenerator sets up a new generator for Go code.
 func NewGenerator(timport thriftPackageImporter, importPath string, packageName string) Generator {
+	return newGenerator(timport, importPath, packageName)
+}
+
+func newGenerator(timport thriftPackageImporter, importPath string, packageName string) *generator {
 	// TODO(abg): Determine package name from `namespace go` directive.
 	namespace := NewNamespace()
 	return &generator{
@@ -150,9 +219,15 @@ func NewGenerator(timport thriftPackageImporter, importPath string, packageName
 		mangler:        newMangler(),
 		thriftImporter: timport,
 		fset:           token.NewFileSet(),
+		templates:      make(map[string]*template.Template),
+		opts:           &Options{},
 	}
 }
 
+func (g *generator) Options() *Options {
+	return g.opts
+}
+
 func (g *generator) MangleType(t compile.TypeSpec) string {
 	return g.mangler.MangleType(t)
 }
@@ -195,6 +270,13 @@ func (g *generator) LookupConstantName(c *compile.Constant) (string, error) {
 
 // TextTemplate renders the given template with the given template context.
 func (g *generator) TextTemplate(s string, data interface{}, opts ...TemplateOption) (string, error) {
+	done := g.profile.beginTemplate()
+	out, err := g.textTemplate(s, data, opts...)
+	done(len(out))
+	return out, err
+}
+
+func (g *generator) textTemplate(s string, data interface{}, opts ...TemplateOption) (string, error) {
 	templateFuncs := template.FuncMap{
 		"formatDoc":        formatDoc,
 		"goCase":           goCase,
@@ -212,33 +294,75 @@ func (g *generator) TextTemplate(s string, data interface{}, opts ...TemplateOpt
 		"fromWirePtr":      curryGenerator(g.w.FromWirePtr, g),
 		"toWire":           curryGenerator(g.w.ToWire, g),
 		"toWirePtr":        curryGenerator(g.w.ToWirePtr, g),
+		"toWireValue":      curryGenerator(g.w.ToWireValue, g),
+		"toWireValuePtr":   curryGenerator(g.w.ToWireValuePtr, g),
+		"fromWireValue":    curryGenerator(g.w.FromWireValue, g),
+		"toWireCanFail":    toWireCanFail,
+		"fromWireCanFail":  fromWireCanFail,
 		"typeCode":         curryGenerator(TypeCode, g),
 		"equals":           curryGenerator(g.e.Equals, g),
 		"equalsPtr":        curryGenerator(g.e.EqualsPtr, g),
+		"clone":            curryGenerator(g.c.Clone, g),
+		"clonePtr":         curryGenerator(g.c.ClonePtr, g),
 	}
 
-	tmpl := template.New("thriftrw").Delims("<", ">").Funcs(templateFuncs)
-	for _, opt := range opts {
-		tmpl = opt(g, tmpl)
-	}
-
-	tmpl, err := tmpl.Parse(s)
+	tmpl, err := g.parseTemplate(s, templateFuncs, opts)
 	if err != nil {
 		return "", err
 	}
 
 	buff := bytes.Buffer{}
 	if err := tmpl.Execute(&buff, data); err != nil {
-		return "", err
+		return "", templates.AnnotateError(err, s)
 	}
 
 	return buff.String(), nil
 
 }
 
+// parseTemplate returns a template for the given source with the given
+// functions and options applied to it.
+//
+// The source is parsed only the first time it is seen by this generator.
+// After that, the parsed template is cloned and its functions are rebound
+// to the given ones. Most templates are declared once per type so the same
+// source is seen many times while generating a package.
+func (g *generator) parseTemplate(s string, funcs template.FuncMap, opts []TemplateOption) (*template.Template, error) {
+	if cached, ok := g.templates[s]; ok {
+		tmpl, err := cached.Clone()
+		if err != nil {
+			return nil, err
+		}
+
+		tmpl = tmpl.Funcs(funcs)
+		for _, opt := range opts {
+			tmpl = opt(g, tmpl)
+		}
+		return tmpl, nil
+	}
+
+	tmpl := template.New("thriftrw").Delims("<", ">").Funcs(funcs)
+	for _, opt := range opts {
+		tmpl = opt(g, tmpl)
+	}
+
+	src, err := templates.ExpandRaw(s)
+	if err != nil {
+		return nil, err
+	}
+
+	tmpl, err = tmpl.Parse(src)
+	if err != nil {
+		return nil, templates.AnnotateError(err, s)
+	}
+
+	g.templates[s] = tmpl
+	return tmpl, nil
+}
+
 func (g *generator) renderTemplate(s string, data interface{}, opts ...TemplateOption) ([]byte, error) {
 	buff := bytes.NewBufferString("package thriftrw\n\n")
-	out, err := g.TextTemplate(s, data, opts...)
+	out, err := g.textTemplate(s, data, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -349,6 +473,19 @@ func (g *generator) recordGenDeclNames(d *ast.GenDecl) (conflict bool, err error
 // contains the wire representation of the item "v" which is a reference to a
 // value of type TypeSpec.
 //
+// toWireValue(TypeSpec, v), toWireValuePtr(TypeSpec, v): Same as toWire and
+// toWirePtr but return an expression of type Value. These may be used only if
+// toWireCanFail is false for the TypeSpec.
+//
+// fromWireValue(TypeSpec, v): Same as fromWire but returns an expression of
+// the type represented by TypeSpec. This may be used only if fromWireCanFail
+// is false for the TypeSpec.
+//
+// toWireCanFail(TypeSpec), fromWireCanFail(TypeSpec): Return true if the
+// expressions generated by toWire or fromWire for the TypeSpec may produce
+// an error. Generated code should not check errors that can't happen since
+// static analysis tools report such checks.
+//
 // typeCode(TypeSpec): Gets the wire.Type for the given TypeSpec, importing
 // the wire module if necessary.
 //
@@ -376,19 +513,30 @@ func (g *generator) recordGenDeclNames(d *ast.GenDecl) (conflict bool, err error
 //
 //  <equalsPtr $someType $lhs $rhs>
 //
+// clone(TypeSpec, v): Returns an expression of the given TypeSpec that holds a
+// deep copy of v.
+//
+//  <clone $someType $v>
+//
+// clonePtr(TypeSpec, v): Returns an expression that holds a deep copy of v
+// where v is a reference to a value of the given TypeSpec.
+//
+//  <clonePtr $someType $v>
+//
 // formatDoc(string): Formats a docblock. Generates a trailing newline so use
 // this NEXT to the thing being documented.
 //
 //   <formatDoc .Doc>type Foo
-func (g *generator) declare(ignoreConflicts bool, s string, data interface{}, opts ...TemplateOption) error {
+func (g *generator) declare(ignoreConflicts bool, s string, data interface{}, opts ...TemplateOption) (int, error) {
 	bs, err := g.renderTemplate(s, data, opts...)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	f, err := parser.ParseFile(g.fset, g.PackageName+".go", bs, parser.ParseComments)
 	if err != nil {
-		return fmt.Errorf("could not parse generated code: %v:\n%s", err, bs)
+		return 0, annotateSourceError(
+			fmt.Errorf("could not parse generated code: %v", err), err, bs)
 	}
 
 	for _, decl := range f.Decls {
@@ -405,46 +553,61 @@ func (g *generator) declare(ignoreConflicts bool, s string, data interface{}, op
 				}
 			}
 
+			// init may be declared any number of times in the same package.
+			if name == "init" {
+				break
+			}
+
 			// top-level function
 			if err := g.Reserve(name); err != nil {
 				if ignoreConflicts {
 					continue
 				}
-				return err
+				return 0, err
 			}
 		case *ast.GenDecl:
 			if conflict, err := g.recordGenDeclNames(d); err != nil {
 				if ignoreConflicts && conflict {
 					continue
 				}
-				return err
+				return 0, err
 			}
 		default:
 			// No special behavior. Move along.
 		}
 		g.appendDecl(decl)
+		if g.keepComments {
+			g.recordComments(decl, f.Comments)
+		}
 	}
 
-	return nil
+	return len(bs), nil
 }
 
 func (g *generator) DeclareFromTemplate(s string, data interface{}, opts ...TemplateOption) error {
-	return g.declare(false, s, data, opts...)
+	done := g.profile.beginTemplate()
+	n, err := g.declare(false, s, data, opts...)
+	done(n)
+	return err
 }
 
 func (g *generator) EnsureDeclared(s string, data interface{}, opts ...TemplateOption) error {
-	return g.declare(true, s, data, opts...)
+	done := g.profile.beginTemplate()
+	n, err := g.declare(true, s, data, opts...)
+	done(n)
+	return err
 }
 
 func (g *generator) Write(w io.Writer, _ *token.FileSet) error {
 	// TODO constants first, types next, and functions after that
 
-	if _, err := w.Write([]byte(generatedByHeader)); err != nil {
-		return err
-	}
-
-	if _, err := fmt.Fprintf(w, "package %s\n\n", g.PackageName); err != nil {
-		return err
+	var buff bytes.Buffer
+	buff.WriteString(generatedByHeader)
+	fmt.Fprintf(&buff, "package %s\n", g.PackageName)
+	if imports := g.importBlock(); imports != "" {
+		buff.WriteString("\n")
+		buff.WriteString(imports)
+		buff.WriteString("\n")
 	}
 
 	cfg := printer.Config{
@@ -452,44 +615,96 @@ func (g *generator) Write(w io.Writer, _ *token.FileSet) error {
 		Tabwidth: 8,
 	}
 
-	if importDecl := g.importDecl(); importDecl != nil {
-		if err := cfg.Fprint(w, g.fset, importDecl); err != nil {
-			return err
-		}
-	}
-
-	if _, err := io.WriteString(w, "\n"); err != nil {
-		return err
-	}
-
 	for _, decl := range g.decls {
-		if _, err := io.WriteString(w, "\n"); err != nil {
-			return err
+		var node interface{} = decl
+		if comments, ok := g.declComments[decl]; ok {
+			node = &printer.CommentedNode{Node: decl, Comments: comments}
 		}
 
-		if err := cfg.Fprint(w, g.fset, decl); err != nil {
+		buff.WriteString("\n")
+		if err := cfg.Fprint(&buff, g.fset, node); err != nil {
 			return err
 		}
+		buff.WriteString("\n")
+	}
 
-		if _, err := io.WriteString(w, "\n"); err != nil {
-			return err
-		}
+	// Declarations are printed with the same settings as gofmt. Parse the
+	// whole file again so that we never write a file that does not compile.
+	src := buff.Bytes()
+	if _, err := parser.ParseFile(token.NewFileSet(), g.PackageName+".go", src, 0); err != nil {
+		return annotateSourceError(
+			fmt.Errorf("generated invalid Go code: %v", err), err, src)
+	}
+
+	if _, err := w.Write(src); err != nil {
+		return err
 	}
 
 	g.decls = nil
+	g.declComments = nil
 	g.importer = newImporter(g.Namespace.Child())
-
-	// init can appear multiple times in the same package across different
-	// files
-	g.Namespace.Forget("init")
 	return nil
 }
 
+// annotateSourceError adds the lines of src surrounding the position of
+// parseErr to err. parseErr must be an error returned by go/parser or
+// go/format for src.
+func annotateSourceError(err, parseErr error, src []byte) error {
+	errs, ok := parseErr.(scanner.ErrorList)
+	if !ok || len(errs) == 0 {
+		return fmt.Errorf("%v:\n%s", err, src)
+	}
+	return templates.AnnotateLine(err, string(src), errs[0].Pos.Line)
+}
+
+// hasDecls returns true if anything was declared since the last Write.
+func (g *generator) hasDecls() bool {
+	return len(g.decls) > 0
+}
+
 // appendDecl appends a new declaration to the generator.
 func (g *generator) appendDecl(decl ast.Decl) {
 	g.decls = append(g.decls, decl)
 }
 
+// recordComments records the comments inside the given declaration so that
+// they are written with it. go/ast attaches only doc comments to
+// declarations; other comments are lost when declarations are printed on
+// their own.
+func (g *generator) recordComments(decl ast.Decl, comments []*ast.CommentGroup) {
+	start := decl.Pos()
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		if d.Doc != nil {
+			start = d.Doc.Pos()
+		}
+	case *ast.GenDecl:
+		if d.Doc != nil {
+			start = d.Doc.Pos()
+		}
+	}
+
+	var inner []*ast.CommentGroup
+	hasInner := false
+	for _, c := range comments {
+		if c.Pos() < start || c.End() > decl.End() {
+			continue
+		}
+		inner = append(inner, c)
+		if c.Pos() > decl.Pos() {
+			hasInner = true
+		}
+	}
+	if !hasInner {
+		return
+	}
+
+	if g.declComments == nil {
+		g.declComments = make(map[ast.Decl][]*ast.CommentGroup)
+	}
+	g.declComments[decl] = inner
+}
+
 func formatDoc(s string) string {
 	if len(s) == 0 {
 		return ""
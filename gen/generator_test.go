@@ -0,0 +1,181 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"text/template"
+
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTextTemplateCache(t *testing.T) {
+	const src = `<greeting> <.>`
+	greeting := func(s string) TemplateOption {
+		return TemplateFunc("greeting", func() string { return s })
+	}
+
+	g := newGenerator(thriftPackageImporter{}, "foo", "foo")
+	out, err := g.TextTemplate(src, "world", greeting("hello"))
+	require.NoError(t, err)
+	assert.Equal(t, "hello world", out)
+	assert.Len(t, g.templates, 1, "template must be cached")
+
+	// The cached template must use the functions of the new call.
+	out, err = g.TextTemplate(src, "there", greeting("hi"))
+	require.NoError(t, err)
+	assert.Equal(t, "hi there", out)
+
+	_, err = g.TextTemplate(`<foo`, nil)
+	assert.Error(t, err, "invalid templates must fail")
+	assert.Len(t, g.templates, 1, "invalid templates must not be cached")
+}
+
+func TestTextTemplateCacheIsPerGenerator(t *testing.T) {
+	const src = `<greeting> <.>`
+
+	g1 := newGenerator(thriftPackageImporter{}, "foo", "foo")
+	out, err := g1.TextTemplate(src, "world",
+		TemplateFunc("greeting", func() string { return "hello" }))
+	require.NoError(t, err)
+	assert.Equal(t, "hello world", out)
+
+	// The second generator must not see the template parsed by the first,
+	// or the function bound to it.
+	g2 := newGenerator(thriftPackageImporter{}, "bar", "bar")
+	_, err = g2.TextTemplate(src, "world")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `function "greeting" not defined`)
+	}
+	assert.Empty(t, g2.templates)
+	assert.Len(t, g1.templates, 1)
+}
+
+func BenchmarkTextTemplate(b *testing.B) {
+	const src = `
+		<$v := newVar "v">
+		func (<$v> *<.>) String() string {
+			return <import "fmt">.Sprint(<$v>)
+		}
+	`
+
+	run := func(b *testing.B, reset bool) {
+		g := newGenerator(thriftPackageImporter{}, "foo", "foo")
+		for i := 0; i < b.N; i++ {
+			if reset {
+				g.templates = make(map[string]*template.Template)
+			}
+			if _, err := g.TextTemplate(src, "Foo"); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+
+	b.Run("cached", func(b *testing.B) { run(b, false) })
+	b.Run("uncached", func(b *testing.B) { run(b, true) })
+}
+
+func BenchmarkGenerate(b *testing.B) {
+	outputDir, err := ioutil.TempDir("", "thriftrw-generate-bench")
+	require.NoError(b, err)
+	defer os.RemoveAll(outputDir)
+
+	cwd, err := os.Getwd()
+	require.NoError(b, err)
+
+	module, err := compile.Compile("testdata/thrift/structs.thrift")
+	require.NoError(b, err)
+
+	opts := &Options{
+		OutputDir:     outputDir,
+		PackagePrefix: "go.uber.org/thriftrw/gen/testdata",
+		ThriftRoot:    filepath.Join(cwd, "testdata", "thrift"),
+		NoRecurse:     true,
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := Generate(module, opts); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestTextTemplateRawAndErrors(t *testing.T) {
+	g := NewGenerator(thriftPackageImporter{}, "foo", "foo")
+
+	out, err := g.TextTemplate(`if <raw>a<b && <.>.c>d<endraw> { <.> }`, "x")
+	require.NoError(t, err)
+	assert.Equal(t, `if a<b && <.>.c>d { x }`, out)
+
+	_, err = g.TextTemplate("func foo() {\n\t<unknownFunc>\n}", nil)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `function "unknownFunc" not defined`)
+		assert.Contains(t, err.Error(), "\t>    2| \t<unknownFunc>")
+	}
+}
+
+func TestDeclareInvalidCode(t *testing.T) {
+	g := NewGenerator(thriftPackageImporter{}, "foo", "foo")
+
+	err := g.DeclareFromTemplate("func foo() {\n\treturn <.>(\n}\n\n\n\nfunc bar() {}", "x")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "could not parse generated code")
+		assert.Contains(t, err.Error(), "\t     4| \treturn x(\n\t>    5| }")
+		assert.NotContains(t, err.Error(), "func bar()",
+			"only lines around the error must be included")
+	}
+}
+
+func TestWriteGroupsImports(t *testing.T) {
+	g := NewGenerator(thriftPackageImporter{}, "foo", "foo")
+	require.NoError(t, g.DeclareFromTemplate(`
+		var x = <import "go.uber.org/thriftrw/wire">.TI32
+		var y = <import "fmt">.Sprint
+	`, nil))
+
+	var buff bytes.Buffer
+	require.NoError(t, g.Write(&buff, nil))
+	assert.Contains(t, buff.String(), "import (\n"+
+		"\t\"fmt\"\n"+
+		"\n"+
+		"\t\"go.uber.org/thriftrw/wire\"\n"+
+		")\n")
+}
+
+// minimalGenerator implements only the methods required of a Generator.
+type minimalGenerator struct{ Generator }
+
+func TestGeneratorOptions(t *testing.T) {
+	g := newGenerator(thriftPackageImporter{}, "foo", "foo")
+	g.opts = &Options{Zap: true}
+	assert.True(t, generatorOptions(g).Zap)
+
+	assert.Equal(t, &Options{}, generatorOptions(minimalGenerator{g}),
+		"Generators without an Options method must use the zero Options")
+}
@@ -68,6 +68,20 @@ func TestCodeIsUpToDate(t *testing.T) {
 			PackagePrefix: "go.uber.org/thriftrw/gen/testdata",
 			ThriftRoot:    thriftRoot,
 			NoRecurse:     true,
+			Zap:           true,
+			// Matches the rule for this package in testdata/Makefile.
+			GenerateValidate:      pkgRelPath == "validate",
+			StrictUTF8:            pkgRelPath == "strict_utf8",
+			OptionalValues:        pkgRelPath == "optional_values",
+			CompactCode:           pkgRelPath == "compact_code",
+			PreserveUnknownFields: pkgRelPath == "unknown_fields",
+			MemSize:               pkgRelPath == "mem_size",
+			GenerateExamples:      pkgRelPath == "examples",
+			SplitTypes:            pkgRelPath == "split_types",
+			Immutable:             pkgRelPath == "immutable",
+			GenerateBuilders:      pkgRelPath == "builders",
+			BuilderThreshold:      2,
+			DriftSchemas:          pkgRelPath == "drift_schemas",
 		})
 		require.NoError(t, err, "failed to generate code for %q", thriftFile)
 
@@ -0,0 +1,169 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"go/token"
+	"unicode"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// unexportedName returns the unexported form of the given exported Go name.
+// The leading run of upper case letters is lower cased, except for the last
+// one if it starts the next word.
+//
+//   Name       -> name
+//   ID         -> id
+//   HTTPServer -> httpServer
+//
+// An underscore is appended to names which are Go keywords.
+func unexportedName(name string) string {
+	runes := []rune(name)
+	i := 0
+	for i < len(runes) && unicode.IsUpper(runes[i]) {
+		i++
+	}
+	if i > 1 && i < len(runes) && unicode.IsLower(runes[i]) {
+		i--
+	}
+	for j := 0; j < i; j++ {
+		runes[j] = unicode.ToLower(runes[j])
+	}
+
+	name = string(runes)
+	if token.Lookup(name).IsKeyword() {
+		name += "_"
+	}
+	return name
+}
+
+// fieldName returns the name of the Go struct field generated for the given
+// field of this group. This is unexported if the group is immutable.
+func (f fieldGroupGenerator) fieldName(fs *compile.FieldSpec) (string, error) {
+	name, err := goName(fs)
+	if err != nil || !f.Immutable {
+		return name, err
+	}
+	return unexportedName(name), nil
+}
+
+// setterName returns the name of the method which sets the given optional
+// value field. This is unexported if the group is immutable.
+func (f fieldGroupGenerator) setterName(fs *compile.FieldSpec) (string, error) {
+	name, err := goName(fs)
+	if f.Immutable {
+		return "set" + name, err
+	}
+	return "Set" + name, err
+}
+
+// unsetterName returns the name of the method which unsets the given
+// optional value field. This is unexported if the group is immutable.
+func (f fieldGroupGenerator) unsetterName(fs *compile.FieldSpec) (string, error) {
+	name, err := goName(fs)
+	if f.Immutable {
+		return "unset" + name, err
+	}
+	return "Unset" + name, err
+}
+
+// fieldFuncs returns the template functions used to refer to the Go fields
+// of this group and the methods which set them.
+//
+//   <fieldName .>    // Name, or name if immutable
+//   <setterName .>   // SetName, or setName if immutable
+//   <unsetterName .> // UnsetName, or unsetName if immutable
+func (f fieldGroupGenerator) fieldFuncs() []TemplateOption {
+	return []TemplateOption{
+		TemplateFunc("fieldName", f.fieldName),
+		TemplateFunc("setterName", f.setterName),
+		TemplateFunc("unsetterName", f.unsetterName),
+	}
+}
+
+// JSON generates MarshalJSON and UnmarshalJSON methods for immutable field
+// groups. encoding/json ignores unexported fields, so their values are copied
+// to and from a struct with exported fields that carry the tags the fields
+// would have had otherwise.
+func (f fieldGroupGenerator) JSON(g Generator) error {
+	return g.DeclareFromTemplate(
+		`
+		<$json := import "encoding/json">
+		<$v := newVar "v">
+		<$b := newVar "b">
+		<$j := newVar "j">
+		<$mirror := printf "_%v_JSON" .Name>
+
+		type <$mirror> struct {
+			<range .Fields>
+				<- if .Required ->
+					<goName .> <typeReference .Type> <tag .>
+				<- else if isOptionalValue . ->
+					<goName .> *<typeReference .Type> <tag .>
+				<- else ->
+					<goName .> <typeReferencePtr .Type> <tag .>
+				<- end>
+			<end>
+		}
+
+		// MarshalJSON encodes this <.Name> into JSON.
+		func (<$v> *<.Name>) MarshalJSON() ([]byte, error) {
+			if <$v> == nil {
+				return []byte("null"), nil
+			}
+
+			var <$j> <$mirror>
+			<- range .Fields>
+				<- if isOptionalValue .>
+					if <$v>.IsSet<goName .>() {
+						<$j>.<goName .> = &<$v>.<fieldName .>
+					}
+				<- else>
+					<$j>.<goName .> = <$v>.<fieldName .>
+				<- end>
+			<- end>
+			return <$json>.Marshal(&<$j>)
+		}
+
+		// UnmarshalJSON decodes a <.Name> from JSON.
+		func (<$v> *<.Name>) UnmarshalJSON(<$b> []byte) error {
+			var <$j> <$mirror>
+			if err := <$json>.Unmarshal(<$b>, &<$j>); err != nil {
+				return err
+			}
+			<- range .Fields>
+				<- if isOptionalValue .>
+					if <$j>.<goName .> != nil {
+						<$v>.<setterName .>(*<$j>.<goName .>)
+					}
+				<- else>
+					<$v>.<fieldName .> = <$j>.<goName .>
+				<- end>
+			<- end>
+			return nil
+		}
+		`, f,
+		append(f.optionalValueFuncs(),
+			TemplateFunc("tag", generateTags),
+		)...,
+	)
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	ti "go.uber.org/thriftrw/gen/testdata/immutable"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnexportedName(t *testing.T) {
+	tests := []struct{ give, want string }{
+		{"Name", "name"},
+		{"DisplayName", "displayName"},
+		{"ID", "id"},
+		{"UserID", "userID"},
+		{"HTTPServer", "httpServer"},
+		{"X", "x"},
+		{"Type", "type_"},
+		{"Func", "func_"},
+		{"Int", "int"},
+		{"lower", "lower"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, unexportedName(tt.give), "unexportedName(%q)", tt.give)
+	}
+}
+
+func TestImmutableFieldsUnexported(t *testing.T) {
+	for _, v := range []interface{}{
+		ti.Account{},
+		ti.Address{},
+		ti.Admin{},
+		ti.Credential{},
+		ti.AccountNotFound{},
+	} {
+		typ := reflect.TypeOf(v)
+		for i := 0; i < typ.NumField(); i++ {
+			f := typ.Field(i)
+			assert.NotEmpty(t, f.PkgPath, "field %v of %v must be unexported", f.Name, typ.Name())
+			assert.Empty(t, f.Tag, "field %v of %v must not have tags", f.Name, typ.Name())
+		}
+	}
+}
+
+func TestImmutableBuilder(t *testing.T) {
+	addr, err := ti.NewAddressBuilder().SetStreet("1 Main St").Build()
+	require.NoError(t, err)
+
+	b := ti.NewAccountBuilder().
+		SetID(42).
+		SetType("user").
+		SetLoginCount(0).
+		SetAddress(addr).
+		SetTags([]string{"foo"})
+	account, err := b.Build()
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(42), account.GetID())
+	assert.Equal(t, "user", account.GetType())
+	assert.True(t, account.IsSetLoginCount(), "zero value set with the builder must be set")
+	assert.Equal(t, int32(0), account.GetLoginCount())
+	assert.Equal(t, ti.StatusActive, account.GetStatus(), "default must be set")
+	assert.False(t, account.IsSetDisplayName())
+	assert.Equal(t, addr, account.GetAddress())
+	assert.Equal(t, []string{"foo"}, account.GetTags())
+
+	b.SetID(43)
+	assert.Equal(t, int64(42), account.GetID(), "built values must not change with the builder")
+
+	_, err = ti.NewAccountBuilder().SetID(1).Build()
+	assert.EqualError(t, err, "field Type of Account is required")
+}
+
+func TestImmutableUnionBuilder(t *testing.T) {
+	c, err := ti.NewCredentialBuilder().SetPassword("hunter2").Build()
+	require.NoError(t, err)
+	assert.Equal(t, "hunter2", c.GetPassword())
+	assert.False(t, c.IsSetKey())
+
+	_, err = ti.NewCredentialBuilder().Build()
+	assert.EqualError(t, err, "Credential should have exactly one field: got 0 fields")
+
+	_, err = ti.NewCredentialBuilder().SetPassword("hunter2").SetKey([]byte("key")).Build()
+	assert.EqualError(t, err, "Credential should have exactly one field: got 2 fields")
+}
+
+func TestImmutableRoundTrip(t *testing.T) {
+	tests := []struct {
+		desc string
+		x    thriftType
+	}{
+		{
+			desc: "constant",
+			x:    ti.DefaultAccount,
+		},
+		{
+			desc: "typedef constant",
+			x:    ti.RootAdmin,
+		},
+		{
+			desc: "exception",
+			x: func() *ti.AccountNotFound {
+				e, err := ti.NewAccountNotFoundBuilder().SetID(1).SetMessage("not found").Build()
+				require.NoError(t, err)
+				return e
+			}(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			w, err := tt.x.ToWire()
+			require.NoError(t, err)
+
+			got := reflect.New(reflect.TypeOf(tt.x).Elem()).Interface().(thriftType)
+			require.NoError(t, got.FromWire(w))
+			assert.Equal(t, tt.x, got)
+		})
+	}
+}
+
+func TestImmutableConstants(t *testing.T) {
+	account := ti.DefaultAccount
+	assert.Equal(t, int64(1), account.GetID())
+	assert.Equal(t, "user", account.GetType())
+	assert.True(t, account.IsSetLoginCount())
+	assert.Equal(t, "1 Main St", account.GetAddress().GetStreet())
+	assert.Equal(t, []string{"new"}, account.GetTags())
+
+	admin := (*ti.Account)(ti.RootAdmin)
+	assert.Equal(t, "admin", admin.GetType())
+	assert.Equal(t, ti.StatusActive, admin.GetStatus())
+}
+
+func TestImmutableJSON(t *testing.T) {
+	account, err := ti.NewAccountBuilder().
+		SetID(1).
+		SetType("user").
+		SetDisplayName("Alice").
+		SetLoginCount(0).
+		Build()
+	require.NoError(t, err)
+
+	b, err := json.Marshal(account)
+	require.NoError(t, err)
+	assert.JSONEq(t,
+		`{"ID": 1, "type": "user", "name": "Alice", "loginCount": 0, "status": "ACTIVE"}`,
+		string(b), "go.tag annotations must be used for the JSON field names")
+
+	var got ti.Account
+	require.NoError(t, json.Unmarshal(b, &got))
+	assert.True(t, account.Equals(&got), "JSON round trip must not change the value")
+	assert.True(t, got.IsSetLoginCount())
+
+	b, err = json.Marshal((*ti.Account)(nil))
+	require.NoError(t, err)
+	assert.Equal(t, "null", string(b))
+}
+
+func TestImmutableClone(t *testing.T) {
+	clone := ti.DefaultAccount.Clone()
+	assert.True(t, ti.DefaultAccount.Equals(clone))
+
+	clone.GetTags()[0] = "changed"
+	assert.Equal(t, []string{"new"}, ti.DefaultAccount.GetTags(),
+		"changes to clones must not affect the original")
+}
@@ -21,9 +21,11 @@
 package gen
 
 import (
+	"bytes"
+	"fmt"
 	"go/ast"
-	"go/token"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"unicode"
 )
@@ -33,6 +35,9 @@ import (
 type importer struct {
 	ns      Namespace
 	imports map[string]*ast.ImportSpec
+
+	// import path -> name used to reference the package
+	names map[string]string
 }
 
 // newImporter builds a new importer.
@@ -40,51 +45,85 @@ func newImporter(ns Namespace) importer {
 	return importer{
 		ns:      ns,
 		imports: make(map[string]*ast.ImportSpec),
+		names:   make(map[string]string),
 	}
 }
 
 // AddImportSpec allows adding existing import specs to the importer.
 //
-// An error is returned if there's a naming conflict.
+// Adding an import for a path that was already imported under the same name
+// is a no-op. An error is returned if there's a naming conflict.
 func (i importer) AddImportSpec(spec *ast.ImportSpec) error {
-	path := spec.Path.Value
+	path, err := strconv.Unquote(spec.Path.Value)
+	if err != nil {
+		return fmt.Errorf("invalid import path %s: %v", spec.Path.Value, err)
+	}
+
 	name := filepath.Base(path)
 	if spec.Name != nil {
 		name = spec.Name.Name
 	}
 
+	if existing, ok := i.names[path]; ok {
+		if existing == name {
+			return nil
+		}
+		return fmt.Errorf("%q is already imported as %q", path, existing)
+	}
+
 	if err := i.ns.Reserve(name); err != nil {
 		return err
 	}
 
 	i.imports[path] = spec
+	i.names[path] = name
 	return nil
 }
 
 // Import ensures that the generated module has the given module imported and
 // returns the name that should be used by the generated code to reference items
 // defined in the module.
+//
+// Packages whose names collide with an earlier import or another name in the
+// file are imported under an alias. The same name is returned for every call
+// with a given path until the next file is started.
 func (i importer) Import(path string) string {
-	if imp, ok := i.imports[path]; ok {
-		if imp.Name != nil {
-			return imp.Name.Name
-		}
-		return filepath.Base(path)
+	if name, ok := i.names[path]; ok {
+		return name
 	}
 
 	// Find a name, preferring the base name
 	// TODO what if the package name is not the base name?
 	baseName := filepath.Base(path)
-	name := i.ns.NewName(sanitizeImportName(baseName))
+	name := i.ns.NewName(goPackageName(path))
 	astImport := &ast.ImportSpec{Path: stringLiteral(path)}
 	if name != baseName {
 		astImport.Name = ast.NewIdent(name)
 	}
 
 	i.imports[path] = astImport
+	i.names[path] = name
 	return name
 }
 
+// Imports returns a map from import path to the name used to reference each
+// package imported so far in the current file.
+func (i importer) Imports() map[string]string {
+	names := make(map[string]string, len(i.names))
+	for path, name := range i.names {
+		names[path] = name
+	}
+	return names
+}
+
+// goPackageName returns the name of the Go package with the given import
+// path. Packages generated by ThriftRW are always named this way so that
+// references to them from other generated packages agree with their package
+// clause.
+func goPackageName(importPath string) string {
+	return sanitizeImportName(filepath.Base(importPath))
+}
+
 func sanitizeImportName(s string) string {
 	// special handling for common "foo-go" pattern
 	if strings.HasSuffix(s, "-go") {
@@ -101,24 +140,56 @@ func sanitizeImportName(s string) string {
 	}, s)
 }
 
-// importDecl builds an import declation from the given list of imports.
-func (i importer) importDecl() ast.Decl {
-	imports := i.imports
-	if imports == nil || len(imports) == 0 {
-		return nil
+// importBlock builds the import declaration for the imports recorded so far.
+// Imports from the standard library are grouped separately from all other
+// imports, the same way goimports groups them.
+//
+// An empty string is returned if nothing was imported.
+func (i importer) importBlock() string {
+	if len(i.imports) == 0 {
+		return ""
 	}
 
-	specs := make([]ast.Spec, 0, len(imports))
-	for _, iname := range sortStringKeys(imports) {
-		imp := imports[iname]
-		specs = append(specs, imp)
+	var std, other []string
+	for _, path := range sortStringKeys(i.imports) {
+		spec := strconv.Quote(path)
+		if imp := i.imports[path]; imp.Name != nil {
+			spec = imp.Name.Name + " " + spec
+		}
+
+		if isStandardImport(path) {
+			std = append(std, spec)
+		} else {
+			other = append(other, spec)
+		}
 	}
 
-	decl := &ast.GenDecl{Tok: token.IMPORT, Specs: specs}
-	if len(specs) > 1 {
-		// Just need a non-zero value for Lparen to get the parens added.
-		decl.Lparen = token.Pos(1)
+	if len(std)+len(other) == 1 {
+		return "import " + append(std, other...)[0]
 	}
 
-	return decl
+	var buff bytes.Buffer
+	buff.WriteString("import (\n")
+	for _, spec := range std {
+		fmt.Fprintf(&buff, "\t%v\n", spec)
+	}
+	if len(std) > 0 && len(other) > 0 {
+		buff.WriteString("\n")
+	}
+	for _, spec := range other {
+		fmt.Fprintf(&buff, "\t%v\n", spec)
+	}
+	buff.WriteString(")")
+	return buff.String()
+}
+
+// isStandardImport checks if the given import path is for a package in the
+// standard library. Like goimports, this assumes that only standard library
+// import paths have no dot in their first element.
+func isStandardImport(path string) bool {
+	first := path
+	if i := strings.IndexByte(path, '/'); i >= 0 {
+		first = path[:i]
+	}
+	return !strings.Contains(first, ".")
 }
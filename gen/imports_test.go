@@ -21,10 +21,12 @@
 package gen
 
 import (
+	"go/ast"
 	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestImport(t *testing.T) {
@@ -49,6 +51,12 @@ func TestImport(t *testing.T) {
 				Name: "bar2",
 			},
 		},
+		{
+			{
+				Path: "go.uber.org/thriftrw/gen/testdata/shared-types",
+				Name: "shared_types",
+			},
+		},
 		{
 			{
 				Path: "github.com/yarpc/yarpc-go",
@@ -67,6 +75,14 @@ func TestImport(t *testing.T) {
 			assert.Equal(t, e.Name, imp.Import(e.Path))
 		}
 
+		// Imports are stable within a file.
+		names := make(map[string]string)
+		for _, e := range tt {
+			assert.Equal(t, e.Name, imp.Import(e.Path))
+			names[e.Path] = e.Name
+		}
+		assert.Equal(t, names, imp.Imports())
+
 		for _, e := range tt {
 			i, ok := imp.imports[e.Path]
 			if !assert.True(t, ok, "could not find %q", e.Path) {
@@ -81,3 +97,95 @@ func TestImport(t *testing.T) {
 		}
 	}
 }
+
+func TestAddImportSpec(t *testing.T) {
+	imp := newImporter(NewNamespace())
+
+	require.NoError(t, imp.AddImportSpec(&ast.ImportSpec{
+		Path: stringLiteral("example.com/a/shared"),
+	}))
+	assert.Equal(t, "shared", imp.Import("example.com/a/shared"))
+	assert.Equal(t, "shared2", imp.Import("example.com/b/shared"))
+
+	// Importing the same path under the same name again is allowed.
+	assert.NoError(t, imp.AddImportSpec(&ast.ImportSpec{
+		Path: stringLiteral("example.com/a/shared"),
+	}))
+
+	err := imp.AddImportSpec(&ast.ImportSpec{
+		Name: ast.NewIdent("other"),
+		Path: stringLiteral("example.com/a/shared"),
+	})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `"example.com/a/shared" is already imported as "shared"`)
+	}
+
+	err = imp.AddImportSpec(&ast.ImportSpec{
+		Path: stringLiteral("example.com/c/shared"),
+	})
+	assert.Error(t, err)
+
+	assert.Equal(t, map[string]string{
+		"example.com/a/shared": "shared",
+		"example.com/b/shared": "shared2",
+	}, imp.Imports())
+	assert.Equal(t, "import (\n"+
+		"\t\"example.com/a/shared\"\n"+
+		"\tshared2 \"example.com/b/shared\"\n"+
+		")", imp.importBlock())
+}
+
+func TestImportBlock(t *testing.T) {
+	tests := []struct {
+		desc    string
+		imports []string
+		want    string
+	}{
+		{desc: "empty"},
+		{
+			desc:    "single",
+			imports: []string{"fmt"},
+			want:    "import \"fmt\"",
+		},
+		{
+			desc:    "single named",
+			imports: []string{"github.com/foo/bar-go"},
+			want:    "import bar \"github.com/foo/bar-go\"",
+		},
+		{
+			desc:    "standard library only",
+			imports: []string{"strings", "fmt", "encoding/json"},
+			want: "import (\n" +
+				"\t\"encoding/json\"\n" +
+				"\t\"fmt\"\n" +
+				"\t\"strings\"\n" +
+				")",
+		},
+		{
+			desc: "grouped",
+			imports: []string{
+				"go.uber.org/thriftrw/wire",
+				"fmt",
+				"go.uber.org/thriftrw/other/wire",
+				"strings",
+			},
+			want: "import (\n" +
+				"\t\"fmt\"\n" +
+				"\t\"strings\"\n" +
+				"\n" +
+				"\twire2 \"go.uber.org/thriftrw/other/wire\"\n" +
+				"\t\"go.uber.org/thriftrw/wire\"\n" +
+				")",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			imp := newImporter(NewNamespace())
+			for _, path := range tt.imports {
+				imp.Import(path)
+			}
+			assert.Equal(t, tt.want, imp.importBlock())
+		})
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/version"
+)
+
+// ChecksumFile is the name of the file written to every generated package
+// when Options.Incremental is set. It holds the checksum of the inputs from
+// which the package was generated.
+const ChecksumFile = "thriftrw-checksum"
+
+// moduleChecksum returns a hex encoded SHA256 of everything that affects the
+// code generated for the given module: the version of ThriftRW, the options
+// recorded in manifests, the package prefix, and the paths and contents of
+// the Thrift file and all the files it includes.
+func moduleChecksum(i thriftPackageImporter, m *compile.Module, o *Options) (string, error) {
+	h := sha256.New()
+	manifest := newManifest(o)
+	fmt.Fprintf(h, "version %q\n", version.Version)
+	fmt.Fprintf(h, "prefix %q\n", o.PackagePrefix)
+	for _, name := range sortStringKeys(manifest.Options) {
+		fmt.Fprintf(h, "option %q %q\n", name, manifest.Options[name])
+	}
+
+	// Walk visits included modules in the same order for the same Thrift
+	// files.
+	err := m.Walk(func(m *compile.Module) error {
+		// Paths are relative to the ThriftRoot so that the checksum is the
+		// same for every checkout of a repository.
+		path, err := i.RelativeThriftFilePath(m.ThriftPath)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(h, "file %q %d\n", path, len(m.Raw))
+		_, err = h.Write(m.Raw)
+		return err
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// checksumFile returns the contents of the ChecksumFile for the given
+// checksum.
+func checksumFile(sum string) []byte {
+	return []byte(sum + "\n")
+}
+
+// isUpToDate returns true if the package in the given directory was last
+// generated from inputs with the given checksum.
+func isUpToDate(dir, sum string) bool {
+	b, err := ioutil.ReadFile(filepath.Join(dir, ChecksumFile))
+	return err == nil && bytes.Equal(b, checksumFile(sum))
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIncremental(t *testing.T) {
+	thriftRoot, err := ioutil.TempDir("", "thriftrw-incremental-thrift")
+	require.NoError(t, err)
+	defer os.RemoveAll(thriftRoot)
+
+	outputDir, err := ioutil.TempDir("", "thriftrw-incremental-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+
+	writeThrift := func(name, contents string) {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(thriftRoot, name), []byte(contents), 0644))
+	}
+	writeThrift("users.thrift", `
+		include "./common.thrift"
+
+		struct User {
+			1: required common.UUID id
+		}
+	`)
+	writeThrift("common.thrift", `typedef string UUID`)
+
+	// generate generates code for users.thrift and returns the modules
+	// that were skipped.
+	generate := func(opts Options) []string {
+		module, err := compile.Compile(filepath.Join(thriftRoot, "users.thrift"))
+		require.NoError(t, err)
+
+		var o recordingObserver
+		opts.OutputDir = outputDir
+		opts.PackagePrefix = "go.uber.org/thriftrw/gen/testdata"
+		opts.ThriftRoot = thriftRoot
+		opts.Incremental = true
+		opts.Observer = &o
+		require.NoError(t, Generate(module, &opts))
+
+		var skipped []string
+		for _, m := range o.modules {
+			if m.Skipped {
+				assert.Zero(t, m.Files, "no files must be generated for %v", m.Module.Name)
+				skipped = append(skipped, m.Module.Name)
+			}
+		}
+		return skipped
+	}
+
+	// mark overwrites the types.go of the given package so that we can tell
+	// whether it was regenerated.
+	mark := func(pkg string) {
+		require.NoError(t, ioutil.WriteFile(
+			filepath.Join(outputDir, pkg, "types.go"), []byte("marked"), 0644))
+	}
+	isMarked := func(pkg string) bool {
+		b, err := ioutil.ReadFile(filepath.Join(outputDir, pkg, "types.go"))
+		require.NoError(t, err)
+		return string(b) == "marked"
+	}
+
+	assert.Empty(t, generate(Options{}), "nothing must be skipped the first time")
+	for _, pkg := range []string{"users", "common"} {
+		_, err := os.Stat(filepath.Join(outputDir, pkg, ChecksumFile))
+		assert.NoError(t, err, "%v must have a checksum", pkg)
+	}
+
+	t.Run("unchanged", func(t *testing.T) {
+		mark("users")
+		mark("common")
+		assert.Equal(t, []string{"users", "common"}, generate(Options{}))
+		assert.True(t, isMarked("users"))
+		assert.True(t, isMarked("common"))
+	})
+
+	t.Run("force", func(t *testing.T) {
+		mark("users")
+		assert.Empty(t, generate(Options{Force: true}))
+		assert.False(t, isMarked("users"))
+	})
+
+	t.Run("options changed", func(t *testing.T) {
+		mark("users")
+		assert.Empty(t, generate(Options{Zap: true}))
+		assert.False(t, isMarked("users"))
+	})
+
+	t.Run("included file changed", func(t *testing.T) {
+		generate(Options{})
+		mark("users")
+		mark("common")
+		writeThrift("common.thrift", `typedef binary UUID`)
+		assert.Empty(t, generate(Options{}),
+			"modules which include a changed file must be regenerated")
+		assert.False(t, isMarked("users"))
+		assert.False(t, isMarked("common"))
+	})
+
+	t.Run("root file changed", func(t *testing.T) {
+		mark("common")
+		writeThrift("users.thrift", `
+			include "./common.thrift"
+
+			struct User {
+				1: required common.UUID id
+				2: optional string name
+			}
+		`)
+		assert.Equal(t, []string{"common"}, generate(Options{}))
+		assert.False(t, isMarked("users"))
+		assert.True(t, isMarked("common"))
+	})
+}
+
+func TestIncrementalFlat(t *testing.T) {
+	module, err := compile.Compile("testdata/thrift/structs.thrift")
+	require.NoError(t, err)
+
+	err = Generate(module, &Options{
+		OutputDir:     testdata(t, "flat"),
+		PackagePrefix: "go.uber.org/thriftrw/gen/testdata/flat",
+		ThriftRoot:    testdata(t, "thrift"),
+		Flat:          true,
+		Incremental:   true,
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Flat and Incremental cannot be used together")
+}
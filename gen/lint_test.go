@@ -0,0 +1,269 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestGeneratedCodeIsVetClean checks the generated code in testdata/ for
+// patterns that go vet and staticcheck complain about, so that users don't
+// have to exclude generated directories from those checks.
+func TestGeneratedCodeIsVetClean(t *testing.T) {
+	var files []string
+	err := filepath.Walk("testdata", func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() || filepath.Ext(path) != ".go" {
+			return err
+		}
+		generated, err := isGeneratedFile(path)
+		if generated {
+			files = append(files, path)
+		}
+		return err
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, files, "no generated files found")
+
+	for _, path := range files {
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		require.NoError(t, err, "failed to parse %q", path)
+
+		for _, problem := range lintGenerated(fset, f) {
+			assert.Fail(t, "generated code is not vet clean", problem)
+		}
+	}
+}
+
+func TestLintGenerated(t *testing.T) {
+	tests := []struct {
+		desc string
+		src  string
+		want []string
+	}{
+		{
+			desc: "clean",
+			src: `
+				func f() error {
+					x, err := g()
+					if err != nil {
+						return err
+					}
+					for _, y := range x {
+						if err = h(y); err != nil {
+							return err
+						}
+					}
+					return nil
+				}`,
+		},
+		{
+			desc: "impossible error",
+			src: `
+				func f() error {
+					x, err := 1, error(nil)
+					if err != nil {
+						return err
+					}
+					return h(x)
+				}`,
+			want: []string{"x.go:4:19: error that is always nil"},
+		},
+		{
+			desc: "self-assignment",
+			src: `
+				func f(v *T) {
+					v.X = v.X
+				}`,
+			want: []string{"x.go:4:6: self-assignment of v.X"},
+		},
+		{
+			desc: "shadowed err",
+			src: `
+				func f() error {
+					var err error
+					for _, x := range xs {
+						if err := h(x); err != nil {
+							return err
+						}
+					}
+					return err
+				}`,
+			want: []string{`x.go:6:10: declaration of "err" shadows declaration at x.go:4:10`},
+		},
+		{
+			desc: "err in sibling functions",
+			src: `
+				func f() error {
+					var err error
+					return err
+				}
+
+				func g() error {
+					if err := h(); err != nil {
+						return err
+					}
+					return nil
+				}`,
+		},
+	}
+
+	for _, tt := range tests {
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, "x.go", "package x\n"+tt.src, 0)
+		require.NoError(t, err, tt.desc)
+		assert.Equal(t, tt.want, lintGenerated(fset, f), tt.desc)
+	}
+}
+
+// isGeneratedFile reports whether the first line of the given file marks it
+// as generated code.
+func isGeneratedFile(path string) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	if !scanner.Scan() {
+		return false, scanner.Err()
+	}
+	return generatedByRegex.MatchString(scanner.Text()), nil
+}
+
+// lintGenerated looks for code that trips up vet and staticcheck: error
+// values that are always nil, self-assignments, and err variables declared
+// in if statements which shadow an err declared earlier in the function.
+func lintGenerated(fset *token.FileSet, f *ast.File) []string {
+	l := genLinter{fset: fset}
+	for _, decl := range f.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Body != nil {
+			l.errDecls = nil
+			ast.Walk(&l, fn.Body)
+		}
+	}
+	return l.problems
+}
+
+type genLinter struct {
+	fset     *token.FileSet
+	problems []string
+
+	// Positions at which err was declared in the enclosing blocks of the
+	// current function.
+	errDecls []token.Pos
+}
+
+func (l *genLinter) report(pos token.Pos, msg string, args ...interface{}) {
+	l.problems = append(l.problems, fmt.Sprintf("%v: %v", l.fset.Position(pos), fmt.Sprintf(msg, args...)))
+}
+
+func (l *genLinter) Visit(n ast.Node) ast.Visitor {
+	switch n := n.(type) {
+	case *ast.CallExpr:
+		if fun, ok := n.Fun.(*ast.Ident); ok && fun.Name == "error" && len(n.Args) == 1 {
+			if arg, ok := n.Args[0].(*ast.Ident); ok && arg.Name == "nil" {
+				l.report(n.Pos(), "error that is always nil")
+			}
+		}
+
+	case *ast.AssignStmt:
+		if n.Tok == token.ASSIGN && len(n.Lhs) == len(n.Rhs) {
+			for i, lhs := range n.Lhs {
+				if l.exprString(lhs) == l.exprString(n.Rhs[i]) {
+					l.report(n.Pos(), "self-assignment of %v", l.exprString(lhs))
+				}
+			}
+		}
+		if n.Tok == token.DEFINE {
+			l.declareErr(n.Lhs...)
+		}
+
+	case *ast.ValueSpec:
+		for _, name := range n.Names {
+			l.declareErr(name)
+		}
+
+	case *ast.IfStmt:
+		if init, ok := n.Init.(*ast.AssignStmt); ok && init.Tok == token.DEFINE {
+			for _, lhs := range init.Lhs {
+				if ident, ok := lhs.(*ast.Ident); ok && ident.Name == "err" && len(l.errDecls) > 0 {
+					l.report(ident.Pos(), "declaration of %q shadows declaration at %v",
+						ident.Name, l.fset.Position(l.errDecls[len(l.errDecls)-1]))
+				}
+			}
+		}
+		return l.scoped(n)
+
+	case *ast.BlockStmt, *ast.ForStmt, *ast.RangeStmt, *ast.SwitchStmt,
+		*ast.TypeSwitchStmt, *ast.CaseClause, *ast.FuncLit:
+		return l.scoped(n)
+	}
+	return l
+}
+
+// scoped walks the children of the given node in a new scope so that err
+// declarations inside it are forgotten once it ends.
+func (l *genLinter) scoped(n ast.Node) ast.Visitor {
+	saved := l.errDecls
+	if _, ok := n.(*ast.FuncLit); ok {
+		l.errDecls = nil
+	}
+	l.errDecls = l.errDecls[:len(l.errDecls):len(l.errDecls)]
+
+	ast.Inspect(n, func(c ast.Node) bool {
+		if c == n || c == nil {
+			return true
+		}
+		ast.Walk(l, c)
+		return false
+	})
+
+	l.errDecls = saved
+	return nil
+}
+
+func (l *genLinter) declareErr(exprs ...ast.Expr) {
+	for _, e := range exprs {
+		if ident, ok := e.(*ast.Ident); ok && ident.Name == "err" {
+			l.errDecls = append(l.errDecls, ident.Pos())
+		}
+	}
+}
+
+func (l *genLinter) exprString(e ast.Expr) string {
+	var buf bytes.Buffer
+	printer.Fprint(&buf, l.fset, e)
+	return buf.String()
+}
@@ -40,6 +40,11 @@ type listGenerator struct{}
 // given type is expected.
 func (l *listGenerator) ValueList(g Generator, spec *compile.ListSpec) (string, error) {
 	name := valueListName(g, spec)
+	if generatorOptions(g).CompactCode {
+		err := compactListValueList(g, spec, name)
+		return name, wrapGenerateError(spec.ThriftName(), err)
+	}
+
 	err := g.EnsureDeclared(
 		`
 			<$wire := import "go.uber.org/thriftrw/wire">
@@ -59,6 +64,7 @@ func (l *listGenerator) ValueList(g Generator, spec *compile.ListSpec) (string,
 						return <import "fmt">.Errorf("invalid [%v]: value is nil", <$i>)
 					}
 				<- end>
+				<if toWireCanFail .Spec.ValueSpec ->
 					<$w>, err := <toWire .Spec.ValueSpec $x>
 					if err != nil {
 						return err
@@ -67,6 +73,11 @@ func (l *listGenerator) ValueList(g Generator, spec *compile.ListSpec) (string,
 					if err != nil {
 						return err
 					}
+				<- else ->
+					if err := <$f>(<toWireValue .Spec.ValueSpec $x>); err != nil {
+						return err
+					}
+				<- end>
 				}
 				return nil
 			}
@@ -116,11 +127,15 @@ func (l *listGenerator) Reader(g Generator, spec *compile.ListSpec) (string, err
 
 				<$o> := make(<$listType>, 0, <$l>.Size())
 				err := <$l>.ForEach(func(<$x> <$wire>.Value) error {
+				<if fromWireCanFail .Spec.ValueSpec ->
 					<$i>, err := <fromWire .Spec.ValueSpec $x>
 					if err != nil {
 						return err
 					}
 					<$o> = append(<$o>, <$i>)
+				<- else ->
+					<$o> = append(<$o>, <fromWireValue .Spec.ValueSpec $x>)
+				<- end>
 					return nil
 				})
 				<$l>.Close()
@@ -177,3 +192,41 @@ func (l *listGenerator) Equals(g Generator, spec *compile.ListSpec) (string, err
 
 	return name, wrapGenerateError(spec.ThriftName(), err)
 }
+
+// Clone generates a function to deep copy lists of the given type
+//
+// 	func $name(l $listType) $listType {
+// 		...
+// 	}
+//
+// And returns its name.
+func (l *listGenerator) Clone(g Generator, spec *compile.ListSpec) (string, error) {
+	name := cloneFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$listType := typeReference .Spec>
+
+			<$l := newVar "l">
+			<$o := newVar "o">
+			func <.Name>(<$l> <$listType>) <$listType> {
+				if <$l> == nil {
+					return nil
+				}
+
+				<$i := newVar "i">
+				<$x := newVar "x">
+				<$o> := make(<$listType>, len(<$l>))
+				for <$i>, <$x> := range <$l> {
+					<$o>[<$i>] = <clone .Spec.ValueSpec $x>
+				}
+				return <$o>
+			}
+		`,
+		struct {
+			Name string
+			Spec *compile.ListSpec
+		}{Name: name, Spec: spec},
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
@@ -0,0 +1,243 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/internal/plugin"
+	"go.uber.org/thriftrw/version"
+
+	"go.uber.org/multierr"
+)
+
+// ManifestFile is the name of the file written to every generated package
+// when Options.Manifest is set.
+const ManifestFile = "thriftrw-manifest.json"
+
+// Manifest records the version of ThriftRW and the options with which a
+// package was generated.
+type Manifest struct {
+	Version string `json:"version"`
+
+	// Options maps the names of the command line options which affect the
+	// generated code, without the leading dashes, to their values. Options
+	// with their default values are omitted.
+	Options map[string]string `json:"options"`
+}
+
+// newManifest builds the Manifest for packages generated with the given
+// options.
+func newManifest(o *Options) Manifest {
+	opts := make(map[string]string)
+	flag := func(name string, set bool) {
+		if set {
+			opts[name] = "true"
+		}
+	}
+
+	flag("no-version-check", o.NoVersionCheck)
+	flag("no-types", o.NoTypes)
+	flag("no-constants", o.NoConstants)
+	flag("no-service-helpers", o.NoServiceHelpers)
+	flag("no-embed-idl", o.NoEmbedIDL)
+	flag("zap", o.Zap)
+	flag("generate-builders", o.GenerateBuilders)
+	if o.GenerateBuilders {
+		opts["builder-threshold"] = strconv.Itoa(o.BuilderThreshold)
+	}
+	flag("mapstructure-tags", o.MapstructureTags)
+	flag("drift-schemas", o.DriftSchemas)
+	flag("generate-validate", o.GenerateValidate)
+	flag("strict-utf8", o.StrictUTF8)
+	flag("optional-values", o.OptionalValues)
+	flag("compact-code", o.CompactCode)
+	flag("preserve-unknown-fields", o.PreserveUnknownFields)
+	flag("mem-size", o.MemSize)
+	flag("immutable", o.Immutable)
+	flag("go-namespaces", o.GoNamespaces)
+	flag("flat", o.Flat)
+	flag("package-doc", o.PackageDoc)
+	flag("generate-examples", o.GenerateExamples)
+	flag("split-types", o.SplitTypes)
+	if len(o.Templates) > 0 {
+		opts["templates"] = describeTemplates(o.Templates)
+	}
+	if names := pluginNames(o.Plugin); len(names) > 0 {
+		opts["plugin"] = strings.Join(names, ",")
+	}
+
+	// Only post-processors which run commands can be described.
+	var commands []string
+	for _, p := range o.PostProcessors {
+		if cmd, ok := p.(commandPostProcessor); ok {
+			commands = append(commands, strings.Join(append([]string{cmd.Name}, cmd.Args...), " "))
+		}
+	}
+	if len(commands) > 0 {
+		opts["post-process"] = strings.Join(commands, "; ")
+	}
+
+	return Manifest{Version: version.Version, Options: opts}
+}
+
+// pluginNames returns the names of the plugins of the given Handle.
+func pluginNames(h plugin.Handle) []string {
+	switch h := h.(type) {
+	case nil:
+		return nil
+	case plugin.MultiHandle:
+		var names []string
+		for _, p := range h {
+			names = append(names, pluginNames(p)...)
+		}
+		return names
+	default:
+		if h == plugin.EmptyHandle {
+			return nil
+		}
+		return []string{h.Name()}
+	}
+}
+
+// Diff returns a description of every difference between this manifest and
+// the given manifest, which is expected to be the one for the current
+// options. An empty result means that they match.
+func (m Manifest) Diff(want Manifest) []string {
+	var diffs []string
+	if m.Version != want.Version {
+		diffs = append(diffs, fmt.Sprintf(
+			"generated by ThriftRW v%v, not v%v", m.Version, want.Version))
+	}
+
+	names := make(map[string]struct{})
+	for name := range m.Options {
+		names[name] = struct{}{}
+	}
+	for name := range want.Options {
+		names[name] = struct{}{}
+	}
+
+	for _, name := range sortStringKeys(names) {
+		got, gotOk := m.Options[name]
+		want, wantOk := want.Options[name]
+		switch {
+		case !wantOk:
+			diffs = append(diffs, fmt.Sprintf(
+				"generated with --%v=%v, which was not requested", name, got))
+		case !gotOk:
+			diffs = append(diffs, fmt.Sprintf(
+				"generated without --%v=%v, which was requested", name, want))
+		case got != want:
+			diffs = append(diffs, fmt.Sprintf(
+				"generated with --%v=%v, but --%v=%v was requested", name, got, name, want))
+		}
+	}
+	return diffs
+}
+
+// manifestFile returns the contents of the manifest file for packages
+// generated with the given options.
+func manifestFile(o *Options) ([]byte, error) {
+	b, err := json.MarshalIndent(newManifest(o), "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("could not generate manifest: %v", err)
+	}
+	return append(b, '\n'), nil
+}
+
+// ReadManifest reads the manifest of the package in the given directory.
+func ReadManifest(dir string) (Manifest, error) {
+	var m Manifest
+	b, err := ioutil.ReadFile(filepath.Join(dir, ManifestFile))
+	if err != nil {
+		return m, err
+	}
+	if err := json.Unmarshal(b, &m); err != nil {
+		return m, fmt.Errorf("could not parse %q: %v", filepath.Join(dir, ManifestFile), err)
+	}
+	return m, nil
+}
+
+// VerifyManifests verifies that the packages that would be generated for
+// the given modules with the given options were last generated with the
+// same version of ThriftRW and the same options, per their manifests.
+//
+// An error describing every difference is returned if they were not.
+func VerifyManifests(modules []*compile.Module, o *Options) error {
+	importer := thriftPackageImporter{
+		ImportPrefix: o.PackagePrefix,
+		ThriftRoot:   o.ThriftRoot,
+		Flat:         o.Flat,
+	}
+	if o.GoNamespaces {
+		importer.Namespaces = goNamespaces(modules)
+	}
+	want := newManifest(o)
+
+	// Relative paths of the packages to verify.
+	packages := make(map[string]struct{})
+	visit := func(m *compile.Module) error {
+		pkg, err := importer.RelativePackage(m.ThriftPath)
+		if err != nil {
+			return err
+		}
+		packages[pkg] = struct{}{}
+		return nil
+	}
+	for _, m := range modules {
+		var err error
+		if o.NoRecurse {
+			err = visit(m)
+		} else {
+			err = m.Walk(visit)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	var errors []error
+	for _, pkg := range sortStringKeys(packages) {
+		got, err := ReadManifest(filepath.Join(o.OutputDir, pkg))
+		if err != nil {
+			if os.IsNotExist(err) {
+				err = fmt.Errorf("package %q does not have a %v: "+
+					"it was not generated with --manifest", pkg, ManifestFile)
+			}
+			errors = append(errors, err)
+			continue
+		}
+
+		if diffs := got.Diff(want); len(diffs) > 0 {
+			errors = append(errors, fmt.Errorf("package %q was %v",
+				pkg, strings.Join(diffs, ", and ")))
+		}
+	}
+	return multierr.Combine(errors...)
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/version"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManifest(t *testing.T) {
+	module, err := compile.Compile("testdata/thrift/containers.thrift")
+	require.NoError(t, err)
+
+	outputDir, err := ioutil.TempDir("", "thriftrw-manifest-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+
+	options := func(o Options) *Options {
+		o.OutputDir = outputDir
+		o.PackagePrefix = "go.uber.org/thriftrw/gen/testdata"
+		o.ThriftRoot = testdata(t, "thrift")
+		o.Manifest = true
+		return &o
+	}
+
+	err = VerifyManifests([]*compile.Module{module}, options(Options{}))
+	if assert.Error(t, err, "verify must fail without manifests") {
+		assert.Contains(t, err.Error(),
+			`package "containers" does not have a thriftrw-manifest.json`)
+	}
+
+	opts := options(Options{
+		Zap:              true,
+		GenerateBuilders: true,
+		BuilderThreshold: 5,
+		PostProcessors:   []PostProcessor{CommandPostProcessor("cat", "-")},
+	})
+	require.NoError(t, GenerateModules([]*compile.Module{module}, opts))
+
+	// Included modules get a manifest too.
+	for _, pkg := range []string{"containers", "enums", "typedefs"} {
+		got, err := ReadManifest(filepath.Join(outputDir, pkg))
+		require.NoError(t, err, pkg)
+		assert.Equal(t, Manifest{
+			Version: version.Version,
+			Options: map[string]string{
+				"zap":               "true",
+				"generate-builders": "true",
+				"builder-threshold": "5",
+				"post-process":      "cat -",
+			},
+		}, got, pkg)
+	}
+
+	assert.NoError(t, VerifyManifests([]*compile.Module{module}, opts))
+
+	err = VerifyManifests([]*compile.Module{module}, options(Options{
+		GenerateBuilders: true,
+		BuilderThreshold: 10,
+		StrictUTF8:       true,
+		PostProcessors:   opts.PostProcessors,
+	}))
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `package "containers" was `+
+			"generated with --builder-threshold=5, but --builder-threshold=10 was requested, "+
+			"and generated without --strict-utf8=true, which was requested, "+
+			"and generated with --zap=true, which was not requested")
+	}
+}
+
+func TestManifestDiff(t *testing.T) {
+	tests := []struct {
+		desc string
+		got  Manifest
+		want Manifest
+		diff []string
+	}{
+		{
+			desc: "equal",
+			got:  Manifest{Version: "1.0.0", Options: map[string]string{"zap": "true"}},
+			want: Manifest{Version: "1.0.0", Options: map[string]string{"zap": "true"}},
+		},
+		{
+			desc: "version",
+			got:  Manifest{Version: "1.0.0"},
+			want: Manifest{Version: "1.1.0"},
+			diff: []string{"generated by ThriftRW v1.0.0, not v1.1.0"},
+		},
+		{
+			desc: "options",
+			got:  Manifest{Options: map[string]string{"flat": "true", "plugin": "foo"}},
+			want: Manifest{Options: map[string]string{"zap": "true", "plugin": "bar"}},
+			diff: []string{
+				"generated with --flat=true, which was not requested",
+				"generated with --plugin=foo, but --plugin=bar was requested",
+				"generated without --zap=true, which was requested",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.diff, tt.got.Diff(tt.want), tt.desc)
+	}
+}
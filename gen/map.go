@@ -48,6 +48,11 @@ type mapGenerator struct{}
 // given type is expected.
 func (m *mapGenerator) ItemList(g Generator, spec *compile.MapSpec) (string, error) {
 	name := mapItemListName(g, spec)
+	if generatorOptions(g).CompactCode {
+		err := compactMapItemList(g, spec, name)
+		return name, wrapGenerateError(spec.ThriftName(), err)
+	}
+
 	err := g.EnsureDeclared(
 		`
 			<$wire := import "go.uber.org/thriftrw/wire">
@@ -79,17 +84,24 @@ func (m *mapGenerator) ItemList(g Generator, spec *compile.MapSpec) (string, err
 							}
 						<end ->
 
+					<if toWireCanFail .Spec.KeySpec ->
 						<$kw>, err := <toWire .Spec.KeySpec $k>
 						if err != nil {
 							return err
 						}
+					<- else ->
+						<$kw> := <toWireValue .Spec.KeySpec $k>
+					<- end>
 
+					<if toWireCanFail .Spec.ValueSpec ->
 						<$vw>, err := <toWire .Spec.ValueSpec $v>
 						if err != nil {
 							return err
 						}
-						err = <$f>(<$wire>.MapItem{Key: <$kw>, Value: <$vw>})
-						if err != nil {
+					<- else ->
+						<$vw> := <toWireValue .Spec.ValueSpec $v>
+					<- end>
+						if err <if or (toWireCanFail .Spec.KeySpec) (toWireCanFail .Spec.ValueSpec)>=<else>:=<end> <$f>(<$wire>.MapItem{Key: <$kw>, Value: <$vw>}); err != nil {
 							return err
 						}
 					}
@@ -146,15 +158,23 @@ func (m *mapGenerator) Reader(g Generator, spec *compile.MapSpec) (string, error
 					<$o> := make(<$mapType>, 0, <$m>.Size())
 				<end ->
 				err := <$m>.ForEach(func(<$x> <$wire>.MapItem) error {
-					<$k>, err := <fromWire .Spec.KeySpec (printf "%s.Key" $x)>
-					if err != nil {
-						return err
-					}
+					<if fromWireCanFail .Spec.KeySpec ->
+						<$k>, err := <fromWire .Spec.KeySpec (printf "%s.Key" $x)>
+						if err != nil {
+							return err
+						}
+					<- else ->
+						<$k> := <fromWireValue .Spec.KeySpec (printf "%s.Key" $x)>
+					<- end>
 
-					<$v>, err := <fromWire .Spec.ValueSpec (printf "%s.Value" $x)>
-					if err != nil {
-						return err
-					}
+					<if fromWireCanFail .Spec.ValueSpec ->
+						<$v>, err := <fromWire .Spec.ValueSpec (printf "%s.Value" $x)>
+						if err != nil {
+							return err
+						}
+					<- else ->
+						<$v> := <fromWireValue .Spec.ValueSpec (printf "%s.Value" $x)>
+					<- end>
 
 					<if isHashable .Spec.KeySpec>
 						<$o>[<$k>] = <$v>
@@ -281,3 +301,54 @@ func (m *mapGenerator) equalsUnhashable(g Generator, spec *compile.MapSpec) (str
 
 	return name, wrapGenerateError(spec.ThriftName(), err)
 }
+
+// Clone generates a function to deep copy maps of the given type
+//
+// 	func $name(m $mapType) $mapType {
+// 		...
+// 	}
+//
+// And returns its name.
+func (m *mapGenerator) Clone(g Generator, spec *compile.MapSpec) (string, error) {
+	name := cloneFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$mapType := typeReference .Spec>
+
+			<$m := newVar "m">
+			<$o := newVar "o">
+			<$k := newVar "k">
+			<$v := newVar "v">
+			<$i := newVar "i">
+			func <.Name>(<$m> <$mapType>) <$mapType> {
+				if <$m> == nil {
+					return nil
+				}
+
+				<if isHashable .Spec.KeySpec>
+					<$o> := make(<$mapType>, len(<$m>))
+					for <$k>, <$v> := range <$m> {
+						<$o>[<$k>] = <clone .Spec.ValueSpec $v>
+					}
+				<else>
+					<$o> := make(<$mapType>, 0, len(<$m>))
+					for _, <$i> := range <$m> {
+						<$k> := <$i>.Key
+						<$v> := <$i>.Value
+						<$o> = append(<$o>, struct {
+							Key <typeReference .Spec.KeySpec>
+							Value <typeReference .Spec.ValueSpec>
+						}{<clone .Spec.KeySpec $k>, <clone .Spec.ValueSpec $v>})
+					}
+				<end>
+				return <$o>
+			}
+		`,
+		struct {
+			Name string
+			Spec *compile.MapSpec
+		}{Name: name, Spec: spec},
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
@@ -0,0 +1,339 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// memSizeGenerator generates MemSize methods which estimate the memory held
+// by Thrift types.
+//
+// The estimate for a value counts the memory it references, but not the
+// value itself, since that is counted as part of whatever holds it. Structs
+// are referenced by pointers so the estimate for a struct includes the
+// struct itself.
+type memSizeGenerator struct{}
+
+// templateFuncs returns the template functions used by templates that
+// generate MemSize methods.
+func (m *memSizeGenerator) templateFuncs() []TemplateOption {
+	return []TemplateOption{
+		TemplateFunc("memSize", m.MemSize),
+		TemplateFunc("memSizePtr", m.MemSizePtr),
+	}
+}
+
+// MemSize returns an int expression estimating the number of bytes
+// referenced by the value v of the given type. The expression is "0" for
+// types which do not reference any memory.
+func (m *memSizeGenerator) MemSize(g Generator, spec compile.TypeSpec, v string) (string, error) {
+	if isPrimitiveType(spec) {
+		if _, ok := compile.RootTypeSpec(spec).(*compile.StringSpec); ok {
+			return fmt.Sprintf("len(%s)", v), nil
+		}
+		return "0", nil
+	}
+
+	switch s := spec.(type) {
+	case *compile.BinarySpec:
+		return fmt.Sprintf("cap(%s)", v), nil
+	case *compile.MapSpec:
+		name, err := m.mapMemSize(g, s)
+		return fmt.Sprintf("%s(%s)", name, v), err
+	case *compile.ListSpec:
+		name, err := m.listMemSize(g, s)
+		return fmt.Sprintf("%s(%s)", name, v), err
+	case *compile.SetSpec:
+		name, err := m.setMemSize(g, s)
+		return fmt.Sprintf("%s(%s)", name, v), err
+	case *rawSpec:
+		name, err := m.rawMemSize(g)
+		return fmt.Sprintf("%s(%s)", name, v), err
+	default:
+		// Structs and typedefs have MemSize methods.
+		return fmt.Sprintf("%s.MemSize()", v), nil
+	}
+}
+
+// MemSizePtr is the same as MemSize except that v is a reference to a value
+// of the given type, as held by optional fields.
+func (m *memSizeGenerator) MemSizePtr(g Generator, spec compile.TypeSpec, v string) (string, error) {
+	if !isPrimitiveType(spec) {
+		// Everything else is either a reference type or a pointer to a
+		// struct, both of which are handled by MemSize.
+		return m.MemSize(g, spec, v)
+	}
+
+	name := fmt.Sprintf("_%s_MemSizePtr", g.MangleType(spec))
+	err := g.EnsureDeclared(
+		`
+			<$unsafe := import "unsafe">
+			<$p := newVar "p">
+			func <.Name>(<$p> *<typeReference .Spec>) int {
+				if <$p> == nil {
+					return 0
+				}
+				<- $x := printf "*%s" $p>
+				<- $size := memSize .Spec $x>
+				<- if eq $size "0">
+					return int(<$unsafe>.Sizeof(*<$p>))
+				<- else>
+					return int(<$unsafe>.Sizeof(*<$p>)) + <$size>
+				<- end>
+			}
+		`,
+		struct {
+			Name string
+			Spec compile.TypeSpec
+		}{Name: name, Spec: spec},
+		m.templateFuncs()...,
+	)
+	return fmt.Sprintf("%s(%s)", name, v), err
+}
+
+// listMemSize generates a function which estimates the memory referenced by
+// lists of the given type and returns its name.
+func (m *memSizeGenerator) listMemSize(g Generator, spec *compile.ListSpec) (string, error) {
+	name := fmt.Sprintf("_%s_MemSize", g.MangleType(spec))
+	err := g.EnsureDeclared(
+		`
+			<$unsafe := import "unsafe">
+			<$l := newVar "l">
+			<$n := newVar "n">
+			<$x := newVar "x">
+			func <.Name>(<$l> <typeReference .Spec>) int {
+				<- $size := memSize .Spec.ValueSpec $x>
+				<- if eq $size "0">
+					return cap(<$l>) * int(<$unsafe>.Sizeof(<$l>[0]))
+				<- else>
+					<$n> := cap(<$l>) * int(<$unsafe>.Sizeof(<$l>[0]))
+					for _, <$x> := range <$l> {
+						<$n> += <$size>
+					}
+					return <$n>
+				<- end>
+			}
+		`,
+		struct {
+			Name string
+			Spec *compile.ListSpec
+		}{Name: name, Spec: spec},
+		m.templateFuncs()...,
+	)
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
+// setMemSize generates a function which estimates the memory referenced by
+// sets of the given type and returns its name.
+func (m *memSizeGenerator) setMemSize(g Generator, spec *compile.SetSpec) (string, error) {
+	name := fmt.Sprintf("_%s_MemSize", g.MangleType(spec))
+	err := g.EnsureDeclared(
+		`
+			<$runtime := import "go.uber.org/thriftrw/runtime">
+			<$unsafe := import "unsafe">
+			<$s := newVar "s">
+			<$n := newVar "n">
+			<$x := newVar "x">
+			<$zero := newVar "zero">
+			func <.Name>(<$s> <typeReference .Spec>) int {
+				<- $size := memSize .Spec.ValueSpec $x>
+				<- if isHashable .Spec.ValueSpec>
+					if <$s> == nil {
+						return 0
+					}
+					var <$zero> <typeReference .Spec.ValueSpec>
+					<$n> := <$runtime>.MapMemSize(len(<$s>), <$unsafe>.Sizeof(<$zero>), 0)
+					<- if ne $size "0">
+					for <$x> := range <$s> {
+						<$n> += <$size>
+					}
+					<- end>
+				<- else>
+					<$n> := cap(<$s>) * int(<$unsafe>.Sizeof(<$s>[0]))
+					<- if ne $size "0">
+					for _, <$x> := range <$s> {
+						<$n> += <$size>
+					}
+					<- end>
+				<- end>
+				return <$n>
+			}
+		`,
+		struct {
+			Name string
+			Spec *compile.SetSpec
+		}{Name: name, Spec: spec},
+		m.templateFuncs()...,
+	)
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
+// mapMemSize generates a function which estimates the memory referenced by
+// maps of the given type and returns its name.
+func (m *memSizeGenerator) mapMemSize(g Generator, spec *compile.MapSpec) (string, error) {
+	name := fmt.Sprintf("_%s_MemSize", g.MangleType(spec))
+	err := g.EnsureDeclared(
+		`
+			<$runtime := import "go.uber.org/thriftrw/runtime">
+			<$unsafe := import "unsafe">
+			<$m := newVar "m">
+			<$n := newVar "n">
+			<$k := newVar "k">
+			<$v := newVar "v">
+			<$i := newVar "i">
+			<$zeroK := newVar "zeroK">
+			<$zeroV := newVar "zeroV">
+			func <.Name>(<$m> <typeReference .Spec>) int {
+				<- $keySize := memSize .Spec.KeySpec $k>
+				<- $valueSize := memSize .Spec.ValueSpec $v>
+				<- if isHashable .Spec.KeySpec>
+					if <$m> == nil {
+						return 0
+					}
+					var (
+						<$zeroK> <typeReference .Spec.KeySpec>
+						<$zeroV> <typeReference .Spec.ValueSpec>
+					)
+					<$n> := <$runtime>.MapMemSize(len(<$m>), <$unsafe>.Sizeof(<$zeroK>), <$unsafe>.Sizeof(<$zeroV>))
+					<- if or (ne $keySize "0") (ne $valueSize "0")>
+					for <if eq $keySize "0">_, <$v><else if eq $valueSize "0"><$k><else><$k>, <$v><end> := range <$m> {
+						<- if ne $keySize "0">
+						<$n> += <$keySize>
+						<- end>
+						<- if ne $valueSize "0">
+						<$n> += <$valueSize>
+						<- end>
+					}
+					<- end>
+				<- else>
+					<$n> := cap(<$m>) * int(<$unsafe>.Sizeof(<$m>[0]))
+					<- if or (ne $keySize "0") (ne $valueSize "0")>
+					for _, <$i> := range <$m> {
+						<- if ne $keySize "0">
+						<$k> := <$i>.Key
+						<$n> += <$keySize>
+						<- end>
+						<- if ne $valueSize "0">
+						<$v> := <$i>.Value
+						<$n> += <$valueSize>
+						<- end>
+					}
+					<- end>
+				<- end>
+				return <$n>
+			}
+		`,
+		struct {
+			Name string
+			Spec *compile.MapSpec
+		}{Name: name, Spec: spec},
+		m.templateFuncs()...,
+	)
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
+// rawMemSize generates a function which estimates the memory referenced by
+// fields annotated with thriftrw.raw and returns its name. Only the
+// wire.Value itself is counted because the memory it references may be
+// shared with the buffer it was decoded from.
+func (m *memSizeGenerator) rawMemSize(g Generator) (string, error) {
+	name := "_RawValue_MemSize"
+	err := g.EnsureDeclared(
+		`
+		<$unsafe := import "unsafe">
+		<$wire := import "go.uber.org/thriftrw/wire">
+		<$v := newVar "v">
+		func <.>(<$v> *<$wire>.Value) int {
+			if <$v> == nil {
+				return 0
+			}
+			return int(<$unsafe>.Sizeof(*<$v>))
+		}
+		`, name)
+	return name, err
+}
+
+// fieldGroup generates a MemSize method for the given struct.
+func (m *memSizeGenerator) fieldGroup(g Generator, f fieldGroupGenerator) error {
+	return g.DeclareFromTemplate(
+		`
+		<$unsafe := import "unsafe">
+		<$v := newVar "v">
+		<$n := newVar "n">
+		// MemSize returns an estimate of the number of bytes of memory held
+		// by this <.Name>, including the memory referenced by its fields.
+		// Caches may use it to limit the memory held by the values they
+		// retain.
+		func (<$v> *<.Name>) MemSize() int {
+			if <$v> == nil {
+				return 0
+			}
+
+			<$n> := int(<$unsafe>.Sizeof(*<$v>))
+			<- range .Fields>
+				<- $size := fieldMemSize . (printf "%s.%s" $v (fieldName .))>
+				<- if ne $size "0">
+			<$n> += <$size>
+				<- end>
+			<- end>
+			<- if .PreserveUnknownFields>
+			<$wire := import "go.uber.org/thriftrw/wire">
+			<$n> += cap(<$v>.UnknownFields) * int(<$unsafe>.Sizeof(<$wire>.Field{}))
+			<- end>
+			return <$n>
+		}
+		`, f,
+		append(f.fieldFuncs(),
+			TemplateFunc("fieldMemSize", func(g Generator, field *compile.FieldSpec, v string) (string, error) {
+				if field.Required || f.isOptionalValue(field) {
+					return m.MemSize(g, field.Type, v)
+				}
+				return m.MemSizePtr(g, field.Type, v)
+			}),
+		)...,
+	)
+}
+
+// typedef generates a MemSize method for the given typedef.
+func (m *memSizeGenerator) typedef(g Generator, spec *compile.TypedefSpec) error {
+	err := g.DeclareFromTemplate(
+		`
+		<$v := newVar "v">
+		<$x := newVar "x">
+		<$typedefType := typeReference .>
+		// MemSize returns an estimate of the number of bytes of memory
+		// referenced by this <typeName .>.
+		func (<$v> <$typedefType>) MemSize() int {
+			<- $size := memSize .Target $x>
+			<- if isStructType .>
+				return (<typeReference .Target>)(<$v>).MemSize()
+			<- else if eq $size "0">
+				return 0
+			<- else>
+				<$x> := (<typeReference .Target>)(<$v>)
+				return <$size>
+			<- end>
+		}
+		`, spec, m.templateFuncs()...)
+	return wrapGenerateError(spec.Name, err)
+}
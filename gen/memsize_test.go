@@ -0,0 +1,123 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package gen
+
+import (
+	"testing"
+	"unsafe"
+
+	tm "go.uber.org/thriftrw/gen/testdata/mem_size"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMemSize(t *testing.T) {
+	itemSize := int(unsafe.Sizeof(tm.Item{}))
+	stringSize := int(unsafe.Sizeof(""))
+
+	t.Run("nil", func(t *testing.T) {
+		assert.Equal(t, 0, (*tm.Item)(nil).MemSize())
+		assert.Equal(t, 0, (*tm.Inventory)(nil).MemSize())
+		assert.Equal(t, 0, (*tm.ItemAlias)(nil).MemSize())
+	})
+
+	t.Run("primitives", func(t *testing.T) {
+		item := &tm.Item{Name: "foo"}
+		assert.Equal(t, itemSize+3, item.MemSize())
+
+		item.Label = ptr.String("label")
+		assert.Equal(t, itemSize+3+stringSize+5, item.MemSize())
+
+		item.Count = ptr.Int32(42)
+		kind := tm.KindLarge
+		item.Kind = &kind
+		assert.Equal(t, itemSize+3+stringSize+5+4+4, item.MemSize())
+
+		item.SetNote("note")
+		item.Data = make([]byte, 10, 16)
+		assert.Equal(t, itemSize+3+stringSize+5+4+4+4+16, item.MemSize())
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		item := &tm.Item{Name: "foo"}
+		inv := &tm.Inventory{Primary: item}
+		base := int(unsafe.Sizeof(tm.Inventory{}))
+		assert.Equal(t, base+item.MemSize(), inv.MemSize())
+
+		inv.Secondary = item
+		inv.Aliased = (*tm.ItemAlias)(item)
+		assert.Equal(t, base+3*item.MemSize(), inv.MemSize(),
+			"shared values are counted each time they are referenced")
+
+		content := &tm.Content{Item: item}
+		assert.Equal(t, int(unsafe.Sizeof(tm.Content{}))+item.MemSize(), content.MemSize())
+	})
+
+	t.Run("containers", func(t *testing.T) {
+		inv := &tm.Inventory{Primary: &tm.Item{}}
+		empty := inv.MemSize()
+
+		ptrSize := int(unsafe.Sizeof(inv))
+		inv.Items = make([]*tm.Item, 1, 4)
+		inv.Items[0] = &tm.Item{Name: "foo"}
+		assert.Equal(t, empty+4*ptrSize+inv.Items[0].MemSize(), inv.MemSize())
+
+		inv = &tm.Inventory{Primary: &tm.Item{}}
+		inv.Names = tm.Names{"foo", "quux"}
+		assert.Equal(t, empty+2*stringSize+7, inv.MemSize())
+		assert.Equal(t, 2*stringSize+7, inv.Names.MemSize())
+
+		inv = &tm.Inventory{Primary: &tm.Item{}}
+		inv.Groups = [][]int32{{1, 2}, {3}}
+		assert.Equal(t, empty+2*int(unsafe.Sizeof([]int32{}))+3*4, inv.MemSize())
+
+		inv = &tm.Inventory{Primary: &tm.Item{}}
+		inv.Tags = map[string]struct{}{}
+		emptyTags := inv.MemSize()
+		assert.True(t, emptyTags > empty, "empty maps must be counted")
+		inv.Tags["foo"] = struct{}{}
+		assert.Equal(t, emptyTags+3, inv.MemSize(), "keys must be counted")
+
+		inv = &tm.Inventory{Primary: &tm.Item{}}
+		inv.Blobs = map[string][]byte{"foo": make([]byte, 8)}
+		withBlobs := inv.MemSize()
+		inv.Blobs["foo"] = make([]byte, 16)
+		assert.Equal(t, withBlobs+8, inv.MemSize(), "values must be counted")
+
+		inv = &tm.Inventory{Primary: &tm.Item{}}
+		inv.Labels = []struct {
+			Key   []int32
+			Value string
+		}{{Key: []int32{1}, Value: "foo"}}
+		assert.Equal(t, empty+int(unsafe.Sizeof(inv.Labels[0]))+4+3, inv.MemSize())
+	})
+
+	t.Run("raw and typedefs", func(t *testing.T) {
+		inv := &tm.Inventory{Primary: &tm.Item{}}
+		empty := inv.MemSize()
+
+		inv.Raw = &wire.Value{}
+		inv.Owner = (*tm.Name)(ptr.String("owner"))
+		assert.Equal(t, empty+int(unsafe.Sizeof(wire.Value{}))+stringSize+5, inv.MemSize())
+		assert.Equal(t, 5, tm.Name("owner").MemSize())
+	})
+}
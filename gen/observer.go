@@ -0,0 +1,72 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"time"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// Observer receives events about the progress of code generation. This may
+// be used by build tooling to collect timing metrics and identify Thrift
+// files that are slow to generate code for.
+//
+// Observer methods are called synchronously from Generate.
+type Observer interface {
+	// ModuleGenerated is called after code has been generated for a
+	// module. Files generated for it will not have been written yet.
+	ModuleGenerated(ModuleGenerated)
+
+	// FileGenerated is called after a generated file has been written to
+	// the output directory.
+	FileGenerated(FileGenerated)
+}
+
+// ModuleGenerated is emitted after code has been generated for a module.
+type ModuleGenerated struct {
+	Module *compile.Module
+
+	// Number of files generated for the module.
+	Files int
+
+	// Skipped is true if code was not generated for the module because it
+	// has not changed since it was last generated with
+	// Options.Incremental.
+	Skipped bool
+
+	// Time taken to generate code for the module.
+	Duration time.Duration
+}
+
+// FileGenerated is emitted after a generated file has been written.
+type FileGenerated struct {
+	// Path to the file relative to the output directory.
+	Path string
+
+	// Size of the file in bytes.
+	Size int
+}
+
+type nopObserver struct{}
+
+func (nopObserver) ModuleGenerated(ModuleGenerated) {}
+func (nopObserver) FileGenerated(FileGenerated)     {}
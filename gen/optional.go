@@ -0,0 +1,202 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+const (
+	// optionalKey controls whether optional fields of primitive types are
+	// generated as pointers or as values. It may be set on fields, structs,
+	// and exceptions and overrides the --optional-values option.
+	//
+	//   struct User {
+	//     1: optional i32 age (thriftrw.optional = "value")
+	//   } (thriftrw.optional = "pointer")
+	optionalKey = "thriftrw.optional"
+
+	optionalValue   = "value"
+	optionalPointer = "pointer"
+
+	// isSetFieldName is the name of the unexported field of generated
+	// structs which records which optional value fields were set explicitly.
+	isSetFieldName = "_isSet"
+)
+
+// parseOptionalAnnotation returns whether the given annotations ask for
+// optional fields to be generated as values. ok is false if the annotation
+// is absent.
+func parseOptionalAnnotation(annotations compile.Annotations) (asValue, ok bool, err error) {
+	v, ok := annotations[optionalKey]
+	if !ok {
+		return false, false, nil
+	}
+
+	switch v {
+	case optionalValue:
+		return true, true, nil
+	case optionalPointer:
+		return false, true, nil
+	default:
+		return false, false, fmt.Errorf(
+			"invalid value %q for annotation %v: must be %q or %q",
+			v, optionalKey, optionalValue, optionalPointer)
+	}
+}
+
+// optionalValueFields returns the optional fields of primitive types of the
+// given struct which are generated as values rather than pointers, mapped to
+// their index in the bitset that records whether they were set.
+//
+// fields are the fields of the struct as passed to its fieldGroupGenerator.
+func optionalValueFields(g Generator, spec *compile.StructSpec, fields compile.FieldGroup) (map[*compile.FieldSpec]int, error) {
+	isUnion := spec.Type == ast.UnionType
+
+	asValue, ok, err := parseOptionalAnnotation(spec.Annotations)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		asValue = generatorOptions(g).OptionalValues && !isUnion
+	} else if asValue && isUnion {
+		return nil, fmt.Errorf(
+			"unions cannot have a %v = %q annotation", optionalKey, optionalValue)
+	}
+
+	var indexes map[*compile.FieldSpec]int
+	for _, f := range fields {
+		fieldAsValue, ok, err := parseOptionalAnnotation(f.Annotations)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %v", f.Name, err)
+		}
+
+		if !ok {
+			fieldAsValue = asValue
+		} else if fieldAsValue && (isUnion || f.Required || !isPrimitiveType(f.Type)) {
+			return nil, fmt.Errorf(
+				"field %q cannot have a %v = %q annotation: "+
+					"only optional fields of primitive types of structs and exceptions may",
+				f.Name, optionalKey, optionalValue)
+		}
+
+		if !fieldAsValue || f.Required || !isPrimitiveType(f.Type) {
+			continue
+		}
+
+		if indexes == nil {
+			indexes = make(map[*compile.FieldSpec]int)
+		}
+		indexes[f] = len(indexes)
+	}
+	return indexes, nil
+}
+
+// isSetWords returns the number of words needed by the bitset which records
+// whether the given number of optional value fields were set.
+func isSetWords(n int) int {
+	return (n + 63) / 64
+}
+
+// isSetBit returns the word of the bitset that records whether the optional
+// value field at the given index was set, and the mask for that field.
+func isSetBit(v string, i int) (word string, mask string) {
+	return fmt.Sprintf("%s.%s[%d]", v, isSetFieldName, i/64), fmt.Sprintf("1 << %d", i%64)
+}
+
+// zeroValue returns an expression for the zero value of the given primitive
+// type.
+func zeroValue(g Generator, spec compile.TypeSpec) (string, error) {
+	switch compile.RootTypeSpec(spec).(type) {
+	case *compile.BoolSpec:
+		return "false", nil
+	case *compile.StringSpec:
+		return `""`, nil
+	case *compile.UUIDSpec:
+		name, err := typeName(g, spec)
+		return name + "{}", err
+	default:
+		// Numbers and enums.
+		return "0", nil
+	}
+}
+
+// isNonZero returns a boolean expression which is true if v, a value of the
+// given primitive type, is not the zero value.
+func isNonZero(g Generator, spec compile.TypeSpec, v string) (string, error) {
+	zero, err := zeroValue(g, spec)
+	if err != nil {
+		return "", err
+	}
+
+	switch compile.RootTypeSpec(spec).(type) {
+	case *compile.BoolSpec:
+		return v, nil
+	case *compile.UUIDSpec:
+		// Composite literals must be parenthesized inside if statements.
+		return fmt.Sprintf("%s != (%s)", v, zero), nil
+	default:
+		return fmt.Sprintf("%s != %s", v, zero), nil
+	}
+}
+
+// isOptionalValue returns true if the given field is generated as a value
+// rather than a pointer even though it is optional.
+func (f fieldGroupGenerator) isOptionalValue(fs *compile.FieldSpec) bool {
+	_, ok := f.OptionalValues[fs]
+	return ok
+}
+
+// optionalValueFuncs returns the template functions used to generate code
+// for optional value fields.
+//
+//   <if isOptionalValue .>...<end>
+//   <markSet $v .>       // v._isSet[0] |= 1 << 2
+//   <markUnset $v .>     // v._isSet[0] &^= 1 << 2
+//   <checkSet $v .>      // v._isSet[0]&(1<<2) != 0
+//   <isNonZero .Type $x> // x != 0
+//   <zeroValue .Type>    // 0
+//
+// The functions returned by fieldFuncs are included as well.
+func (f fieldGroupGenerator) optionalValueFuncs() []TemplateOption {
+	return append(f.fieldFuncs(),
+		TemplateFunc("isOptionalValue", f.isOptionalValue),
+		TemplateFunc("markSet", func(v string, fs *compile.FieldSpec) string {
+			word, mask := isSetBit(v, f.OptionalValues[fs])
+			return fmt.Sprintf("%s |= %s", word, mask)
+		}),
+		TemplateFunc("markUnset", func(v string, fs *compile.FieldSpec) string {
+			word, mask := isSetBit(v, f.OptionalValues[fs])
+			return fmt.Sprintf("%s &^= %s", word, mask)
+		}),
+		TemplateFunc("checkSet", func(v string, fs *compile.FieldSpec) string {
+			word, mask := isSetBit(v, f.OptionalValues[fs])
+			return fmt.Sprintf("%s&(%s) != 0", word, mask)
+		}),
+		TemplateFunc("isNonZero", isNonZero),
+		TemplateFunc("zeroValue", zeroValue),
+		TemplateFunc("isSetField", func() string { return isSetFieldName }),
+		TemplateFunc("isSetWords", func() int { return isSetWords(len(f.OptionalValues)) }),
+	)
+}
@@ -0,0 +1,260 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	tov "go.uber.org/thriftrw/gen/testdata/optional_values"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestOptionalValuesRoundTrip(t *testing.T) {
+	zeroValues := &tov.Preferences{Version: 1}
+	zeroValues.SetNotifications(false)
+	zeroValues.SetVolume(0)
+	zeroValues.SetRetries(0)
+	zeroValues.SetLocale("")
+	zeroValues.SetTheme(tov.ThemeLight)
+
+	// Values decoded from Thrift are always marked as set so they are
+	// built with setters here.
+	nonZeroValues := &tov.Preferences{Nickname: ptr.String("jo"), Version: 2}
+	nonZeroValues.SetNotifications(true)
+	nonZeroValues.SetFontSize(12)
+	nonZeroValues.SetRetries(5)
+	nonZeroValues.SetTimeout(tov.Millis(100))
+	nonZeroValues.SetRatio(1.5)
+	nonZeroValues.SetLocale("fr_FR")
+	nonZeroValues.SetTheme(tov.ThemeDark)
+
+	tests := []struct {
+		desc string
+		x    thriftType
+		v    wire.Value
+	}{
+		{
+			desc: "zero values",
+			x:    zeroValues,
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueBool(false)},
+				{ID: 2, Value: wire.NewValueI8(0)},
+				{ID: 4, Value: wire.NewValueI32(0)},
+				{ID: 7, Value: wire.NewValueString("")},
+				{ID: 8, Value: wire.NewValueI32(0)},
+				{ID: 13, Value: wire.NewValueI32(1)},
+			}}),
+		},
+		{
+			desc: "non-zero values",
+			x:    nonZeroValues,
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueBool(true)},
+				{ID: 3, Value: wire.NewValueI16(12)},
+				{ID: 4, Value: wire.NewValueI32(5)},
+				{ID: 5, Value: wire.NewValueI64(100)},
+				{ID: 6, Value: wire.NewValueDouble(1.5)},
+				{ID: 7, Value: wire.NewValueString("fr_FR")},
+				{ID: 8, Value: wire.NewValueI32(1)},
+				{ID: 12, Value: wire.NewValueString("jo")},
+				{ID: 13, Value: wire.NewValueI32(2)},
+			}}),
+		},
+	}
+
+	for _, tt := range tests {
+		assertRoundTrip(t, tt.x, tt.v, tt.desc)
+	}
+}
+
+func TestOptionalValuesUnset(t *testing.T) {
+	var p tov.Preferences
+	require.NoError(t, p.FromWire(singleFieldStruct(13, wire.NewValueI32(1))))
+
+	assert.False(t, p.IsSetVolume())
+	assert.True(t, p.IsSetRetries(), "fields with defaults must be set")
+	assert.Equal(t, int32(3), p.GetRetries())
+	assert.Equal(t, "en_US", p.GetLocale())
+
+	p.SetVolume(0)
+	assert.True(t, p.IsSetVolume())
+	p.UnsetVolume()
+	assert.False(t, p.IsSetVolume())
+
+	p.SetFontSize(10)
+	p.UnsetFontSize()
+	assert.False(t, p.IsSetFontSize())
+	assert.Equal(t, int16(0), p.FontSize)
+
+	var nilPrefs *tov.Preferences
+	assert.False(t, nilPrefs.IsSetVolume())
+	assert.Equal(t, int32(3), nilPrefs.GetRetries())
+}
+
+func TestOptionalValuesRenamed(t *testing.T) {
+	var r tov.RenamedOptions
+	r.SetRetries(0)
+	assert.True(t, r.IsSetMaxRetries())
+	assert.True(t, r.IsSetRetries())
+	assert.Equal(t, int32(0), r.GetRetries())
+
+	r.SetMaxRetries(5)
+	assert.Equal(t, int32(5), r.GetRetries())
+
+	r.UnsetRetries()
+	assert.False(t, r.IsSetMaxRetries())
+}
+
+func TestOptionalValuesEqualsAndString(t *testing.T) {
+	unset := &tov.Preferences{Version: 1}
+	zero := &tov.Preferences{Version: 1}
+	zero.SetVolume(0)
+
+	assert.False(t, unset.Equals(zero))
+	assert.False(t, zero.Equals(unset))
+	assert.True(t, zero.Equals(zero.Clone()))
+
+	assert.Equal(t, "Preferences{Version: 1}", unset.String())
+	assert.Equal(t, "Preferences{Volume: 0, Version: 1}", zero.String())
+
+	enc := zapcore.NewMapObjectEncoder()
+	require.NoError(t, zero.MarshalLogObject(enc))
+	assert.Equal(t, map[string]interface{}{"volume": int8(0), "version": int32(1)}, enc.Fields)
+}
+
+func TestOptionalValuesJSON(t *testing.T) {
+	var p tov.Preferences
+	require.NoError(t, json.Unmarshal([]byte(`{"volume": 7, "version": 1}`), &p))
+	assert.True(t, p.IsSetVolume(), "non-zero values must be set")
+	assert.False(t, p.IsSetRatio())
+}
+
+func TestOptionalValuesConstant(t *testing.T) {
+	p := tov.QuietPreferences
+	assert.True(t, p.IsSetNotifications())
+	assert.True(t, p.IsSetVolume())
+	assert.False(t, p.IsSetFontSize())
+	assert.Equal(t, "en_GB", p.GetLocale())
+}
+
+func TestOptionalValuesAnnotations(t *testing.T) {
+	legacy := tov.LegacyPreferences{Retries: ptr.Int32(0)}
+	legacy.SetLocale("")
+	assertRoundTrip(t, &legacy, wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueI32(0)},
+		{ID: 2, Value: wire.NewValueString("")},
+	}}), "LegacyPreferences")
+
+	var e tov.QuotaExceeded
+	e.SetLimit(0)
+	assertRoundTrip(t, &e, singleFieldStruct(2, wire.NewValueI64(0)), "QuotaExceeded")
+
+	pref := tov.Preference{Retries: ptr.Int32(0)}
+	assertRoundTrip(t, &pref, singleFieldStruct(2, wire.NewValueI32(0)), "Preference")
+}
+
+func TestOptionalValuesInvalid(t *testing.T) {
+	tests := []struct {
+		desc    string
+		src     string
+		wantErr string
+	}{
+		{
+			desc: "unknown value",
+			src: `
+				struct Foo {
+					1: optional i32 x (thriftrw.optional = "maybe")
+				}
+			`,
+			wantErr: `field "x": invalid value "maybe" for annotation thriftrw.optional: must be "value" or "pointer"`,
+		},
+		{
+			desc: "required field",
+			src: `
+				struct Foo {
+					1: required i32 x (thriftrw.optional = "value")
+				}
+			`,
+			wantErr: `field "x" cannot have a thriftrw.optional = "value" annotation`,
+		},
+		{
+			desc: "non-primitive field",
+			src: `
+				struct Foo {
+					1: optional list<i32> x (thriftrw.optional = "value")
+				}
+			`,
+			wantErr: `field "x" cannot have a thriftrw.optional = "value" annotation`,
+		},
+		{
+			desc: "union",
+			src: `
+				union Foo {
+					1: i32 x
+				} (thriftrw.optional = "value")
+			`,
+			wantErr: `unions cannot have a thriftrw.optional = "value" annotation`,
+		},
+		{
+			desc: "name conflict",
+			src: `
+				struct Foo {
+					1: optional i32 x (thriftrw.optional = "value")
+					2: optional i32 setX
+				}
+			`,
+			wantErr: `"SetX"`,
+		},
+	}
+
+	for _, tt := range tests {
+		func() {
+			dir, err := ioutil.TempDir("", "thriftrw-optional-test")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+
+			path := filepath.Join(dir, "optional.thrift")
+			require.NoError(t, ioutil.WriteFile(path, []byte(tt.src), 0644), tt.desc)
+
+			module, err := compile.Compile(path)
+			require.NoError(t, err, tt.desc)
+
+			err = Generate(module, &Options{
+				OutputDir:     dir,
+				PackagePrefix: "go.uber.org/thriftrw/gen/testdata",
+				ThriftRoot:    dir,
+			})
+			if assert.Error(t, err, tt.desc) {
+				assert.Contains(t, err.Error(), tt.wantErr, tt.desc)
+			}
+		}()
+	}
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"strings"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+// packageDocEntry is a single type or service listed in the package
+// documentation.
+type packageDocEntry struct {
+	Name    string
+	Summary string
+	Items   []string
+}
+
+// packageDoc generates the contents of a doc.go which describes the package
+// generated for the given Thrift file: where it came from, and which services
+// and types it contains. Only code which is actually generated per the given
+// options is listed.
+func packageDoc(g Generator, i thriftPackageImporter, m *compile.Module, packageName string, o *Options) ([]byte, error) {
+	filePath, err := i.RelativeThriftFilePath(m.ThriftPath)
+	if err != nil {
+		return nil, wrapGenerateError("package documentation", err)
+	}
+
+	var services []packageDocEntry
+	for _, name := range sortStringKeys(m.Services) {
+		if o.NoServiceHelpers {
+			break
+		}
+
+		s := m.Services[name]
+		entry := packageDocEntry{Name: goCase(s.Name)}
+		if s.Parent != nil {
+			entry.Summary = "extends " + s.Parent.Name
+		}
+		for _, f := range sortStringKeys(s.Functions) {
+			entry.Items = append(entry.Items, f)
+		}
+		services = append(services, entry)
+	}
+
+	var types []packageDocEntry
+	for _, name := range sortStringKeys(m.Types) {
+		if o.NoTypes {
+			break
+		}
+
+		entry, err := packageDocType(m.Types[name])
+		if err != nil {
+			return nil, wrapGenerateError(name, err)
+		}
+		types = append(types, entry)
+	}
+	alignPackageDocEntries(types)
+
+	s, err := g.TextTemplate(
+		`
+		// Package <.Package> contains the code generated by ThriftRW for
+		// <.FilePath>.
+		<- if .EmbedIDL>
+		//
+		// The contents of the Thrift file are available as ThriftModule.
+		<- end>
+		<- if .Services>
+		//
+		// Services
+		//
+		// Arguments and results of the functions of these services are
+		// represented by the Service_Function_Args and Service_Function_Result
+		// types, and may be built with Service_Function_Helper.
+		//
+		<- range .Services>
+		//   <.Name><if .Summary> (<.Summary>)<end>
+		<- range .Items>
+		//     <.>
+		<- end>
+		<- end>
+		<- end>
+		<- if .Types>
+		//
+		// Types
+		//
+		<- range .Types>
+		//   <.Name><if .Summary> <.Summary><end>
+		<- end>
+		<- end>
+		package <.Package>
+		`,
+		struct {
+			Package  string
+			FilePath string
+			EmbedIDL bool
+			Services []packageDocEntry
+			Types    []packageDocEntry
+		}{
+			Package:  packageName,
+			FilePath: filePath,
+			EmbedIDL: !o.NoEmbedIDL,
+			Services: services,
+			Types:    types,
+		},
+	)
+	if err != nil {
+		return nil, wrapGenerateError("package documentation", err)
+	}
+
+	var lines []string
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			lines = append(lines, line)
+		}
+	}
+
+	// Strip the indentation of the template. Indentation inside comments
+	// follows the "//" and is left alone.
+	return []byte(generatedByHeader + strings.Join(lines, "\n") + "\n"), nil
+}
+
+// packageDocType builds the package documentation entry for a type.
+func packageDocType(spec compile.TypeSpec) (packageDocEntry, error) {
+	var (
+		kind string
+		doc  string
+	)
+	switch s := spec.(type) {
+	case *compile.EnumSpec:
+		kind, doc = "enum", s.Doc
+	case *compile.TypedefSpec:
+		kind, doc = "typedef", s.Doc
+	case *compile.StructSpec:
+		doc = s.Doc
+		switch s.Type {
+		case ast.UnionType:
+			kind = "union"
+		case ast.ExceptionType:
+			kind = "exception"
+		default:
+			kind = "struct"
+		}
+	default:
+		return packageDocEntry{}, fmt.Errorf("unknown type %T", spec)
+	}
+
+	name, err := goName(spec.(compile.NamedEntity))
+	if err != nil {
+		return packageDocEntry{}, err
+	}
+
+	summary := kind
+	if line := docSummary(doc); line != "" {
+		summary = fmt.Sprintf("%v: %v", kind, line)
+	}
+	return packageDocEntry{Name: name, Summary: summary}, nil
+}
+
+// alignPackageDocEntries pads the names of the given entries so that their
+// summaries line up.
+func alignPackageDocEntries(entries []packageDocEntry) {
+	width := 0
+	for _, e := range entries {
+		if len(e.Name) > width {
+			width = len(e.Name)
+		}
+	}
+	for i, e := range entries {
+		entries[i].Name = e.Name + strings.Repeat(" ", width-len(e.Name))
+	}
+}
+
+// docSummary returns the first line of the given docstring.
+func docSummary(doc string) string {
+	for _, line := range strings.Split(doc, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+	return ""
+}
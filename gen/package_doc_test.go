@@ -0,0 +1,99 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPackageDoc(t *testing.T) {
+	tests := []struct {
+		desc        string
+		opts        Options
+		wantDoc     []string
+		dontWantDoc []string
+	}{
+		{
+			desc: "default",
+			wantDoc: []string{
+				"Package services contains the code generated by ThriftRW for\nservices.thrift.\n",
+				"The contents of the Thrift file are available as ThriftModule.",
+				"\nServices\n",
+				"\n  KeyValue\n    deleteValue\n    getManyValues\n",
+				"\n  Cache\n    clear\n    clearAfter\n",
+				"\nTypes\n",
+				"\n  InternalError                exception\n",
+			},
+		},
+		{
+			desc: "no service helpers or embedded IDL",
+			opts: Options{NoServiceHelpers: true, NoEmbedIDL: true},
+			wantDoc: []string{
+				"\nTypes\n",
+				"\n  Key                          typedef\n",
+			},
+			dontWantDoc: []string{"ThriftModule", "Services", "KeyValue"},
+		},
+	}
+
+	module, err := compile.Compile("testdata/thrift/services.thrift")
+	require.NoError(t, err)
+
+	for _, tt := range tests {
+		func() {
+			outputDir, err := ioutil.TempDir("", "thriftrw-package-doc-test")
+			require.NoError(t, err)
+			defer os.RemoveAll(outputDir)
+
+			opts := tt.opts
+			opts.OutputDir = outputDir
+			opts.PackagePrefix = "go.uber.org/thriftrw/gen/testdata"
+			opts.ThriftRoot = testdata(t, "thrift")
+			opts.NoRecurse = true
+			opts.PackageDoc = true
+			require.NoError(t, Generate(module, &opts), tt.desc)
+
+			fset := token.NewFileSet()
+			f, err := parser.ParseFile(fset, filepath.Join(outputDir, "services", "doc.go"), nil, parser.ParseComments)
+			require.NoError(t, err, tt.desc)
+			require.NotNil(t, f.Doc, "%v: doc.go must have a package comment", tt.desc)
+
+			assert.Equal(t, "services", f.Name.Name, tt.desc)
+			doc := f.Doc.Text()
+			for _, want := range tt.wantDoc {
+				assert.Contains(t, doc, want, tt.desc)
+			}
+			for _, dontWant := range tt.dontWantDoc {
+				assert.NotContains(t, doc, dontWant, tt.desc)
+			}
+		}()
+	}
+}
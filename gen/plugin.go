@@ -22,6 +22,7 @@ package gen
 
 import (
 	"fmt"
+	"path/filepath"
 
 	"go.uber.org/thriftrw/compile"
 	"go.uber.org/thriftrw/plugin/api"
@@ -105,7 +106,7 @@ func (g *generateServiceBuilder) addModule(thriftPath string) (api.ModuleID, err
 
 	g.Modules[id] = &api.Module{
 		ImportPath: importPath,
-		Directory:  dir,
+		Directory:  filepath.ToSlash(dir),
 	}
 	return id, nil
 }
@@ -146,12 +147,23 @@ func (g *generateServiceBuilder) addService(spec *compile.ServiceSpec) (api.Serv
 		functions = append(functions, function)
 	}
 
+	aliases, err := serviceAliases(spec)
+	if err != nil {
+		return 0, err
+	}
+
+	var goAliases []string
+	for _, alias := range aliases {
+		goAliases = append(goAliases, goCase(alias))
+	}
+
 	g.Services[serviceID] = &api.Service{
 		ThriftName: spec.Name,
 		Name:       goCase(spec.Name),
 		ParentID:   parentID,
 		Functions:  functions,
 		ModuleID:   moduleID,
+		Aliases:    goAliases,
 	}
 	return serviceID, nil
 }
@@ -232,6 +244,16 @@ func (g *generateServiceBuilder) buildType(spec compile.TypeSpec, required bool)
 		t = &api.Type{SimpleType: simpleType(api.SimpleTypeFloat64)}
 	case *compile.StringSpec:
 		t = &api.Type{SimpleType: simpleType(api.SimpleTypeString)}
+	case *compile.UUIDSpec:
+		// UUIDs are not user-defined but they are represented by a named Go
+		// type so plugins can refer to them the same way.
+		t = &api.Type{
+			ReferenceType: &api.TypeReference{
+				Name:        "UUID",
+				ImportPath:  "go.uber.org/thriftrw/wire",
+				Annotations: s.Annotations,
+			},
+		}
 	case *compile.EnumSpec:
 		importPath, err := g.importer.Package(s.ThriftFile())
 		if err != nil {
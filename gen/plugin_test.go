@@ -65,6 +65,34 @@ func TestAddRootService(t *testing.T) {
 				},
 			},
 		},
+		{
+			desc: "service with aliases",
+			spec: &compile.ServiceSpec{
+				Name: "Users",
+				File: "idl/users.thrift",
+				Annotations: compile.Annotations{
+					"thriftrw.alias": "UserService, user_store",
+				},
+			},
+			want: &api.GenerateServiceRequest{
+				RootServices: []api.ServiceID{1},
+				Services: map[api.ServiceID]*api.Service{
+					1: {
+						Name:       "Users",
+						ThriftName: "Users",
+						Functions:  []*api.Function{}, // must be non-nil
+						ModuleID:   1,
+						Aliases:    []string{"UserService", "UserStore"},
+					},
+				},
+				Modules: map[api.ModuleID]*api.Module{
+					1: {
+						ImportPath: "go.uber.org/thriftrw/gen/testdata/users",
+						Directory:  "users",
+					},
+				},
+			},
+		},
 		{
 			desc: "service with a parent",
 			spec: &compile.ServiceSpec{
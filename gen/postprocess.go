@@ -0,0 +1,89 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// PostProcessor transforms generated files before they are written to disk.
+//
+// Post-processors may be used to inject build tags, apply custom
+// formatting, or validate the generated code.
+type PostProcessor interface {
+	// PostProcess receives the path of a generated file relative to the
+	// output directory and its contents, and returns the contents that
+	// should be written in its place.
+	PostProcess(path string, contents []byte) ([]byte, error)
+}
+
+// PostProcessorFunc is a PostProcessor backed by a function.
+type PostProcessorFunc func(path string, contents []byte) ([]byte, error)
+
+// PostProcess calls f.
+func (f PostProcessorFunc) PostProcess(path string, contents []byte) ([]byte, error) {
+	return f(path, contents)
+}
+
+// PostProcessFileEnv is the environment variable through which the path of
+// the generated file is passed to post-processing commands.
+const PostProcessFileEnv = "THRIFTRW_FILE"
+
+// CommandPostProcessor returns a PostProcessor which runs the given
+// executable once for each generated file.
+//
+// The contents of the file are written to the standard input of the command
+// and its standard output is used as the new contents of the file. The path
+// of the file relative to the output directory is available in the
+// THRIFTRW_FILE environment variable. The command fails the code generation
+// if it exits with a non-zero status.
+func CommandPostProcessor(name string, args ...string) PostProcessor {
+	return commandPostProcessor{Name: name, Args: args}
+}
+
+type commandPostProcessor struct {
+	Name string
+	Args []string
+}
+
+func (p commandPostProcessor) PostProcess(path string, contents []byte) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.Command(p.Name, p.Args...)
+	cmd.Env = append(os.Environ(), PostProcessFileEnv+"="+path)
+	cmd.Stdin = bytes.NewReader(contents)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		msg := strings.TrimSpace(stderr.String())
+		if msg == "" {
+			return nil, fmt.Errorf("%q failed: %v", p.Name, err)
+		}
+		return nil, fmt.Errorf("%q failed: %v: %v", p.Name, err, msg)
+	}
+
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratePostProcessors(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "thriftrw-postprocess-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+
+	module, err := compile.Compile("testdata/thrift/structs.thrift")
+	require.NoError(t, err)
+
+	var paths []string
+	err = Generate(module, &Options{
+		OutputDir:     outputDir,
+		PackagePrefix: "go.uber.org/thriftrw/gen/testdata",
+		ThriftRoot:    testdata(t, "thrift"),
+		NoRecurse:     true,
+		PostProcessors: []PostProcessor{
+			PostProcessorFunc(func(path string, contents []byte) ([]byte, error) {
+				paths = append(paths, path)
+				return append([]byte("// +build foo\n\n"), contents...), nil
+			}),
+			PostProcessorFunc(func(path string, contents []byte) ([]byte, error) {
+				// Post-processors are applied in order.
+				assert.True(t, strings.HasPrefix(string(contents), "// +build foo\n"))
+				return contents, nil
+			}),
+		},
+	})
+	require.NoError(t, err)
+
+	require.NotEmpty(t, paths)
+	for _, path := range paths {
+		contents, err := ioutil.ReadFile(filepath.Join(outputDir, path))
+		if assert.NoError(t, err) {
+			assert.True(t, strings.HasPrefix(string(contents), "// +build foo\n"),
+				"%q must start with the build tag", path)
+		}
+	}
+}
+
+func TestGeneratePostProcessorError(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "thriftrw-postprocess-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+
+	module, err := compile.Compile("testdata/thrift/structs.thrift")
+	require.NoError(t, err)
+
+	err = Generate(module, &Options{
+		OutputDir:     outputDir,
+		PackagePrefix: "go.uber.org/thriftrw/gen/testdata",
+		ThriftRoot:    testdata(t, "thrift"),
+		NoRecurse:     true,
+		PostProcessors: []PostProcessor{
+			PostProcessorFunc(func(string, []byte) ([]byte, error) {
+				return nil, errors.New("great sadness")
+			}),
+		},
+	})
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "failed to post-process")
+		assert.Contains(t, err.Error(), "great sadness")
+	}
+}
+
+func TestCommandPostProcessor(t *testing.T) {
+	tests := []struct {
+		desc string
+		name string
+		args []string
+
+		want      string
+		wantError string
+	}{
+		{
+			desc: "success",
+			name: "sh",
+			args: []string{"-c", `echo "// $THRIFTRW_FILE"; cat`},
+			want: "// foo/types.go\npackage foo\n",
+		},
+		{
+			desc:      "failure",
+			name:      "sh",
+			args:      []string{"-c", "echo great sadness >&2; exit 1"},
+			wantError: `"sh" failed: exit status 1: great sadness`,
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := CommandPostProcessor(tt.name, tt.args...).
+			PostProcess("foo/types.go", []byte("package foo\n"))
+		if tt.wantError != "" {
+			if assert.Error(t, err, tt.desc) {
+				assert.Contains(t, err.Error(), tt.wantError, tt.desc)
+			}
+			continue
+		}
+
+		if assert.NoError(t, err, tt.desc) {
+			assert.Equal(t, tt.want, string(got), tt.desc)
+		}
+	}
+}
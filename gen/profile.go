@@ -0,0 +1,197 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+)
+
+// Profile collects information about where time is spent and how much code
+// is produced during code generation.
+//
+// Templates are identified by the function which rendered them. Time
+// reported for templates excludes time spent rendering other templates
+// from within them. Time reported for types and services includes
+// everything needed to generate code for them.
+//
+//   profile := gen.NewProfile()
+//   err := gen.Generate(module, &gen.Options{..., Profile: profile})
+//   ...
+//   profile.WriteReport(os.Stderr)
+type Profile struct {
+	templates map[string]*ProfileEntry
+	specs     map[string]*ProfileEntry
+
+	// Templates currently being rendered, innermost last.
+	stack []*profileFrame
+
+	// Total number of bytes rendered by all templates so far.
+	bytes int
+}
+
+// ProfileEntry is a single row of a Profile.
+type ProfileEntry struct {
+	// Name of the template or spec.
+	Name string
+
+	// Number of times this entry was rendered.
+	Count int
+
+	// Total time spent rendering this entry.
+	Duration time.Duration
+
+	// Total number of bytes produced by this entry.
+	Bytes int
+}
+
+type profileFrame struct {
+	name  string
+	start time.Time
+	child time.Duration
+}
+
+// NewProfile builds a new, empty Profile.
+func NewProfile() *Profile {
+	return &Profile{
+		templates: make(map[string]*ProfileEntry),
+		specs:     make(map[string]*ProfileEntry),
+	}
+}
+
+// Templates returns entries for all templates rendered so far, slowest
+// first.
+func (p *Profile) Templates() []ProfileEntry {
+	return sortProfileEntries(p.templates)
+}
+
+// Specs returns entries for all types and services generated so far, slowest
+// first.
+func (p *Profile) Specs() []ProfileEntry {
+	return sortProfileEntries(p.specs)
+}
+
+// WriteReport writes a human-readable report of the profile to the given
+// Writer.
+func (p *Profile) WriteReport(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	sections := []struct {
+		Title   string
+		Entries []ProfileEntry
+	}{
+		{"TEMPLATE", p.Templates()},
+		{"SPEC", p.Specs()},
+	}
+
+	for i, s := range sections {
+		if i > 0 {
+			fmt.Fprintln(tw, "\t\t\t\t")
+		}
+		fmt.Fprintf(tw, "%s\tCOUNT\tTIME\tBYTES\t\n", s.Title)
+		for _, e := range s.Entries {
+			fmt.Fprintf(tw, "%s\t%d\t%v\t%d\t\n", e.Name, e.Count, e.Duration, e.Bytes)
+		}
+	}
+	return tw.Flush()
+}
+
+// beginTemplate starts timing a template rendered by the caller of the
+// function calling beginTemplate. The returned function must be called with
+// the number of bytes rendered once the template has been rendered.
+//
+// This is a no-op if the Profile is nil.
+func (p *Profile) beginTemplate() func(bytes int) {
+	if p == nil {
+		return func(int) {}
+	}
+
+	name := "unknown"
+	if pc, _, _, ok := runtime.Caller(2); ok {
+		if f := runtime.FuncForPC(pc); f != nil {
+			name = strings.TrimPrefix(f.Name(), "go.uber.org/thriftrw/gen.")
+		}
+	}
+
+	frame := &profileFrame{name: name, start: time.Now()}
+	p.stack = append(p.stack, frame)
+	return func(bytes int) {
+		elapsed := time.Since(frame.start)
+		p.stack = p.stack[:len(p.stack)-1]
+		if len(p.stack) > 0 {
+			p.stack[len(p.stack)-1].child += elapsed
+		}
+		p.templates[name] = p.record(p.templates[name], name, elapsed-frame.child, bytes)
+		p.bytes += bytes
+	}
+}
+
+// beginSpec starts timing the generation of the named type or service. The
+// returned function must be called once code for it has been generated.
+//
+// This is a no-op if the Profile is nil.
+func (p *Profile) beginSpec(name string) func() {
+	if p == nil {
+		return func() {}
+	}
+
+	start := time.Now()
+	startBytes := p.bytes
+	return func() {
+		p.specs[name] = p.record(p.specs[name], name, time.Since(start), p.bytes-startBytes)
+	}
+}
+
+func (p *Profile) record(e *ProfileEntry, name string, d time.Duration, bytes int) *ProfileEntry {
+	if e == nil {
+		e = &ProfileEntry{Name: name}
+	}
+	e.Count++
+	e.Duration += d
+	e.Bytes += bytes
+	return e
+}
+
+func sortProfileEntries(m map[string]*ProfileEntry) []ProfileEntry {
+	entries := make([]ProfileEntry, 0, len(m))
+	for _, e := range m {
+		entries = append(entries, *e)
+	}
+	sort.Sort(byDuration(entries))
+	return entries
+}
+
+// byDuration sorts ProfileEntries with the slowest first.
+type byDuration []ProfileEntry
+
+func (es byDuration) Len() int      { return len(es) }
+func (es byDuration) Swap(i, j int) { es[i], es[j] = es[j], es[i] }
+
+func (es byDuration) Less(i, j int) bool {
+	if es[i].Duration != es[j].Duration {
+		return es[i].Duration > es[j].Duration
+	}
+	return es[i].Name < es[j].Name
+}
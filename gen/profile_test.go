@@ -0,0 +1,99 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestProfile(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "thriftrw-profile-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+
+	module, err := compile.Compile("testdata/thrift/structs.thrift")
+	require.NoError(t, err)
+
+	profile := NewProfile()
+	err = Generate(module, &Options{
+		OutputDir:     outputDir,
+		PackagePrefix: "go.uber.org/thriftrw/gen/testdata",
+		ThriftRoot:    testdata(t, "thrift"),
+		NoRecurse:     true,
+		Profile:       profile,
+	})
+	require.NoError(t, err)
+
+	templates := make(map[string]ProfileEntry)
+	for _, e := range profile.Templates() {
+		templates[e.Name] = e
+	}
+	if assert.Contains(t, templates, "fieldGroupGenerator.ToWire") {
+		e := templates["fieldGroupGenerator.ToWire"]
+		assert.Equal(t, countStructs(module), e.Count)
+		assert.NotZero(t, e.Bytes)
+	}
+
+	specs := make(map[string]ProfileEntry)
+	for _, e := range profile.Specs() {
+		specs[e.Name] = e
+	}
+	if assert.Contains(t, specs, "structs.User") {
+		e := specs["structs.User"]
+		assert.Equal(t, 1, e.Count)
+		assert.NotZero(t, e.Bytes)
+	}
+
+	var buff bytes.Buffer
+	require.NoError(t, profile.WriteReport(&buff))
+	assert.Contains(t, buff.String(), "fieldGroupGenerator.ToWire")
+	assert.Contains(t, buff.String(), "structs.User")
+}
+
+func TestProfileEntriesSorted(t *testing.T) {
+	p := NewProfile()
+	p.templates["a"] = &ProfileEntry{Name: "a", Duration: 1}
+	p.templates["b"] = &ProfileEntry{Name: "b", Duration: 3}
+	p.templates["c"] = &ProfileEntry{Name: "c", Duration: 2}
+
+	var names []string
+	for _, e := range p.Templates() {
+		names = append(names, e.Name)
+	}
+	assert.Equal(t, []string{"b", "c", "a"}, names)
+}
+
+func countStructs(m *compile.Module) (n int) {
+	for _, t := range m.Types {
+		if _, ok := t.(*compile.StructSpec); ok {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// Fields with this annotation hold the Thrift-level representation of their
+// values instead of decoding them into Go types.
+//
+//   2: required Payload payload (thriftrw.raw)
+//
+// The field is a *wire.Value which FromWire stores as it was received and
+// ToWire sends back as-is. This lets proxies forward payloads they don't need
+// to inspect without decoding and re-encoding them.
+const rawKey = "thriftrw.raw"
+
+// rawSpec is the type of fields annotated with thriftrw.raw. The declared
+// type of the field still determines the type code it is sent with.
+type rawSpec struct {
+	compile.TypeSpec
+}
+
+// isRawType returns true if the given type is the type of a field annotated
+// with thriftrw.raw.
+func isRawType(spec compile.TypeSpec) bool {
+	_, ok := spec.(*rawSpec)
+	return ok
+}
+
+// rawFields returns the given FieldGroup with the types of fields annotated
+// with thriftrw.raw replaced with a rawSpec. The FieldGroup is returned as-is
+// if it does not have any such fields.
+func rawFields(fields compile.FieldGroup) (compile.FieldGroup, error) {
+	var out compile.FieldGroup
+	for i, f := range fields {
+		if _, ok := f.Annotations[rawKey]; !ok {
+			continue
+		}
+
+		if f.Default != nil {
+			return nil, fmt.Errorf(
+				"field %q cannot have a default value because it has a %v annotation",
+				f.Name, rawKey)
+		}
+
+		if out == nil {
+			out = make(compile.FieldGroup, len(fields))
+			copy(out, fields)
+		}
+
+		raw := *f
+		raw.Type = &rawSpec{TypeSpec: f.Type}
+		out[i] = &raw
+	}
+
+	if out == nil {
+		return fields, nil
+	}
+	return out, nil
+}
+
+// rawGenerator generates helpers for fields annotated with thriftrw.raw.
+type rawGenerator struct{}
+
+// Reader returns the name of a function which accepts a wire.Value and
+// returns a reference to a copy of it.
+func (rawGenerator) Reader(g Generator) (string, error) {
+	name := "_RawValue_Read"
+	err := g.EnsureDeclared(
+		`
+		<$wire := import "go.uber.org/thriftrw/wire">
+		<$w := newVar "w">
+		func <.>(<$w> <$wire>.Value) (*<$wire>.Value, error) {
+			return &<$w>, nil
+		}
+		`, name)
+	return name, err
+}
+
+// Equals returns the name of a function which compares two references to
+// wire.Values.
+func (rawGenerator) Equals(g Generator) (string, error) {
+	name := "_RawValue_Equals"
+	err := g.EnsureDeclared(
+		`
+		<$wire := import "go.uber.org/thriftrw/wire">
+		<$lhs := newVar "lhs">
+		<$rhs := newVar "rhs">
+		func <.>(<$lhs>, <$rhs> *<$wire>.Value) bool {
+			if <$lhs> == nil || <$rhs> == nil {
+				return <$lhs> == <$rhs>
+			}
+			return <$wire>.ValuesAreEqual(*<$lhs>, *<$rhs>)
+		}
+		`, name)
+	return name, err
+}
+
+// Clone returns the name of a function which copies a reference to a
+// wire.Value.
+//
+// The copy shares the contents of binary values, structs, and collections
+// with the original because wire.Values are not modified once built.
+func (rawGenerator) Clone(g Generator) (string, error) {
+	name := "_RawValue_Clone"
+	err := g.EnsureDeclared(
+		`
+		<$wire := import "go.uber.org/thriftrw/wire">
+		<$v := newVar "v">
+		<$o := newVar "o">
+		func <.>(<$v> *<$wire>.Value) *<$wire>.Value {
+			if <$v> == nil {
+				return nil
+			}
+
+			<$o> := *<$v>
+			return &<$o>
+		}
+		`, name)
+	return name, err
+}
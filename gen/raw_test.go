@@ -0,0 +1,219 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/gen/testdata/raw"
+	ts "go.uber.org/thriftrw/gen/testdata/structs"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRawFieldsRoundTrip(t *testing.T) {
+	point, err := (&ts.Point{X: 1, Y: 2}).ToWire()
+	require.NoError(t, err)
+
+	tags := wire.NewValueList(wire.ValueListFromSlice(wire.TBinary, []wire.Value{
+		wire.NewValueString("a"),
+		wire.NewValueString("b"),
+	}))
+
+	tests := []struct {
+		desc string
+		x    *raw.ProxyRequest
+		v    wire.Value
+	}{
+		{
+			desc: "required",
+			x:    &raw.ProxyRequest{Name: "foo", Payload: &point},
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueString("foo")},
+				{ID: 2, Value: point},
+			}}),
+		},
+		{
+			desc: "optional",
+			x:    &raw.ProxyRequest{Name: "foo", Payload: &point, Tags: &tags},
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueString("foo")},
+				{ID: 2, Value: point},
+				{ID: 3, Value: tags},
+			}}),
+		},
+	}
+
+	for _, tt := range tests {
+		w, err := tt.x.ToWire()
+		if assert.NoError(t, err, tt.desc) {
+			assert.True(t, wire.ValuesAreEqual(tt.v, w), "%v: %v.ToWire() != %v", tt.desc, tt.x, tt.v)
+		}
+
+		// wire.Values can't be compared with reflect.DeepEqual so we rely on
+		// the generated Equals method instead of assertRoundTrip.
+		var got raw.ProxyRequest
+		if assert.NoError(t, got.FromWire(tt.v), tt.desc) {
+			assert.True(t, tt.x.Equals(&got), "%v: FromWire(%v) != %v", tt.desc, tt.v, tt.x)
+		}
+	}
+
+	t.Run("union", func(t *testing.T) {
+		x := &raw.RawUnion{Point: &point}
+		v := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{{ID: 2, Value: point}}})
+
+		w, err := x.ToWire()
+		require.NoError(t, err)
+		assert.True(t, wire.ValuesAreEqual(v, w), "%v.ToWire() != %v", x, v)
+
+		var got raw.RawUnion
+		require.NoError(t, got.FromWire(v))
+		assert.True(t, x.Equals(&got), "FromWire(%v) != %v", v, x)
+	})
+}
+
+func TestRawFieldsForwardPayload(t *testing.T) {
+	frame := &ts.Frame{
+		TopLeft: &ts.Point{X: 1, Y: 2},
+		Size:    &ts.Size{Width: 3, Height: 4},
+	}
+	payload, err := frame.ToWire()
+	require.NoError(t, err)
+
+	in, err := (&raw.ProxyRequest{Name: "frame", Payload: &payload}).ToWire()
+	require.NoError(t, err)
+
+	var received bytes.Buffer
+	require.NoError(t, protocol.Binary.Encode(in, &received))
+
+	// A proxy decodes the request to route it based on its name and
+	// forwards it without decoding the payload.
+	decoded, err := protocol.Binary.Decode(bytes.NewReader(received.Bytes()), wire.TStruct)
+	require.NoError(t, err)
+
+	var req raw.ProxyRequest
+	require.NoError(t, req.FromWire(decoded))
+	assert.Equal(t, "frame", req.Name)
+
+	out, err := req.ToWire()
+	require.NoError(t, err)
+
+	var forwarded bytes.Buffer
+	require.NoError(t, protocol.Binary.Encode(out, &forwarded))
+	assert.Equal(t, received.Bytes(), forwarded.Bytes())
+
+	var got ts.Frame
+	require.NoError(t, got.FromWire(*req.Payload))
+	assert.True(t, frame.Equals(&got), "payload must decode to the original frame")
+}
+
+func TestRawFieldsTypeMismatch(t *testing.T) {
+	// Raw fields are still matched against the type code of their declared
+	// type.
+	var req raw.ProxyRequest
+	err := req.FromWire(wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("foo")},
+		{ID: 2, Value: wire.NewValueI32(42)},
+	}}))
+	assert.EqualError(t, err, "field Payload of ProxyRequest is required")
+}
+
+func TestRawFieldsEqualsCloneAndString(t *testing.T) {
+	point, err := (&ts.Point{X: 1, Y: 2}).ToWire()
+	require.NoError(t, err)
+	other, err := (&ts.Point{X: 3, Y: 4}).ToWire()
+	require.NoError(t, err)
+
+	req := &raw.ProxyRequest{Name: "foo", Payload: &point}
+	clone := req.Clone()
+	assert.True(t, req.Equals(clone))
+	assert.False(t, req.Payload == clone.Payload, "Clone must copy the reference")
+
+	clone.Payload = &other
+	assert.False(t, req.Equals(clone))
+
+	clone.Payload = nil
+	assert.False(t, req.Equals(clone))
+	assert.Equal(t, "ProxyRequest{Name: foo, Payload: <nil>}", clone.String())
+	assert.Equal(t,
+		"RawUnion{Point: TStruct({1: TDouble(1), 2: TDouble(2)})}",
+		(&raw.RawUnion{Point: &point}).String())
+}
+
+func TestRawFieldsInvalid(t *testing.T) {
+	tests := []struct {
+		desc    string
+		src     string
+		wantErr string
+	}{
+		{
+			desc: "default value",
+			src: `
+				struct Foo {
+					1: optional i32 x = 42 (thriftrw.raw)
+				}
+			`,
+			wantErr: `field "x" cannot have a default value because it has a thriftrw.raw annotation`,
+		},
+		{
+			desc: "constant",
+			src: `
+				struct Foo {
+					1: optional i32 x (thriftrw.raw)
+				}
+
+				const Foo foo = {"x": 42}
+			`,
+			wantErr: `field "x" of "Foo" cannot be set in a constant because it has a thriftrw.raw annotation`,
+		},
+	}
+
+	for _, tt := range tests {
+		func() {
+			dir, err := ioutil.TempDir("", "thriftrw-raw-test")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+
+			path := filepath.Join(dir, "raw.thrift")
+			require.NoError(t, ioutil.WriteFile(path, []byte(tt.src), 0644), tt.desc)
+
+			module, err := compile.Compile(path)
+			require.NoError(t, err, tt.desc)
+
+			err = Generate(module, &Options{
+				OutputDir:     dir,
+				PackagePrefix: "go.uber.org/thriftrw/gen/testdata",
+				ThriftRoot:    dir,
+			})
+			if assert.Error(t, err, tt.desc) {
+				assert.Contains(t, err.Error(), tt.wantErr, tt.desc)
+			}
+		}()
+	}
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	tr "go.uber.org/thriftrw/gen/testdata/recursive"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecursiveStructsRoundTrip(t *testing.T) {
+	node := func(name string, fields ...wire.Field) wire.Value {
+		fields = append([]wire.Field{{ID: 1, Value: wire.NewValueString(name)}}, fields...)
+		return wire.NewValueStruct(wire.Struct{Fields: fields})
+	}
+	literal := func(i int64) wire.Value {
+		return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueI64(i)},
+		}})
+	}
+
+	tests := []struct {
+		desc string
+		x    thriftType
+		v    wire.Value
+	}{
+		{
+			desc: "tree",
+			x: &tr.Tree{Root: &tr.TreeNode{
+				Name: "root",
+				Children: []*tr.TreeNode{
+					{Name: "a", Children: []*tr.TreeNode{{Name: "b"}}},
+				},
+				ByName: map[string]*tr.TreeNode{"c": {Name: "c"}},
+				Leaves: []*tr.TreeNode{{Name: "d"}},
+			}},
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: node("root",
+					wire.Field{ID: 2, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TStruct, []wire.Value{
+						node("a", wire.Field{ID: 2, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TStruct, []wire.Value{
+							node("b"),
+						}))}),
+					}))},
+					wire.Field{ID: 3, Value: wire.NewValueMap(wire.MapItemListFromSlice(wire.TBinary, wire.TStruct, []wire.MapItem{
+						{Key: wire.NewValueString("c"), Value: node("c")},
+					}))},
+					wire.Field{ID: 4, Value: wire.NewValueSet(wire.ValueListFromSlice(wire.TStruct, []wire.Value{
+						node("d"),
+					}))},
+				)},
+			}}),
+		},
+		{
+			desc: "mutually recursive",
+			x: &tr.Expression{Operation: &tr.Operation{
+				Operator: "+",
+				Left:     &tr.Expression{Literal: ptr.Int64(1)},
+				Right: &tr.Expression{Operation: &tr.Operation{
+					Operator: "*",
+					Left:     &tr.Expression{Literal: ptr.Int64(2)},
+					Right:    &tr.Expression{Literal: ptr.Int64(3)},
+				}},
+			}},
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 2, Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+					{ID: 1, Value: wire.NewValueString("+")},
+					{ID: 2, Value: literal(1)},
+					{ID: 3, Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+						{ID: 2, Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+							{ID: 1, Value: wire.NewValueString("*")},
+							{ID: 2, Value: literal(2)},
+							{ID: 3, Value: literal(3)},
+						}})},
+					}})},
+				}})},
+			}}),
+		},
+		{
+			desc: "through typedef",
+			x: &tr.Directory{
+				Name:           "usr",
+				Subdirectories: tr.Directories{{Name: "bin"}},
+				Parent:         &tr.Directory{Name: "/"},
+			},
+			v: node("usr",
+				wire.Field{ID: 2, Value: wire.NewValueList(wire.ValueListFromSlice(wire.TStruct, []wire.Value{
+					node("bin"),
+				}))},
+				wire.Field{ID: 3, Value: node("/")},
+			),
+		},
+		{
+			desc: "exception",
+			x: &tr.ChainedError{
+				Message: ptr.String("outer"),
+				Cause:   &tr.ChainedError{Message: ptr.String("inner")},
+			},
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueString("outer")},
+				{ID: 2, Value: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+					{ID: 1, Value: wire.NewValueString("inner")},
+				}})},
+			}}),
+		},
+	}
+
+	for _, tt := range tests {
+		assertRoundTrip(t, tt.x, tt.v, tt.desc)
+	}
+}
+
+func TestRecursiveStructsCloneAndEquals(t *testing.T) {
+	tree := &tr.Tree{Root: &tr.TreeNode{
+		Name:     "root",
+		Children: []*tr.TreeNode{{Name: "a", Children: []*tr.TreeNode{{Name: "b"}}}},
+	}}
+
+	clone := tree.Clone()
+	assert.True(t, tree.Equals(clone))
+	assert.Equal(t, tree.String(), clone.String())
+
+	clone.Root.Children[0].Children[0].Name = "c"
+	assert.False(t, tree.Equals(clone))
+	assert.Equal(t, "b", tree.Root.Children[0].Children[0].Name)
+}
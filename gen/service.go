@@ -55,6 +55,19 @@ func Service(g Generator, s *compile.ServiceSpec) (map[string]*bytes.Buffer, err
 		files[fileName] = buff
 	}
 
+	aliases, err := serviceAliases(s)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, alias := range aliases {
+		contents, err := serviceAlias(g, s, alias)
+		if err != nil {
+			return nil, err
+		}
+		files[fmt.Sprintf("%s_alias.go", strings.ToLower(alias))] = bytes.NewBuffer(contents)
+	}
+
 	return files, nil
 }
 
@@ -77,6 +90,9 @@ func ServiceFunction(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpe
 	if err := functionArgsEnveloper(g, s, f); err != nil {
 		return wrapGenerateError(fmt.Sprintf("%s.%s", s.Name, f.Name), err)
 	}
+	if err := functionArgsDriftSchema(g, s, f); err != nil {
+		return wrapGenerateError(fmt.Sprintf("%s.%s", s.Name, f.Name), err)
+	}
 
 	if err := functionHelper(g, s, f); err != nil {
 		return wrapGenerateError(fmt.Sprintf("%s.%s", s.Name, f.Name), err)
@@ -113,17 +129,21 @@ func ServiceFunction(g Generator, s *compile.ServiceSpec, f *compile.FunctionSpe
 		Fields:          resultFields,
 		IsUnion:         true,
 		AllowEmptyUnion: f.ResultSpec.ReturnType == nil,
+		IsResult:        true,
 		Doc:             resultDoc,
 	}
+	if err := resultGen.Reserve("UnwrapResponse"); err != nil {
+		return wrapGenerateError(fmt.Sprintf("%s.%s", s.Name, f.Name), err)
+	}
 	if err := resultGen.Generate(g); err != nil {
 		return wrapGenerateError(fmt.Sprintf("%s.%s", s.Name, f.Name), err)
 	}
 	if err := functionResponseEnveloper(g, s, f); err != nil {
 		return wrapGenerateError(fmt.Sprintf("%s.%s", s.Name, f.Name), err)
 	}
-
-	// TODO(abg): If we receive unknown exceptions over the wire, we need to
-	// throw a generic error.
+	if err := functionBatcher(g, s, f); err != nil {
+		return wrapGenerateError(fmt.Sprintf("%s.%s", s.Name, f.Name), err)
+	}
 	return nil
 }
 
@@ -365,6 +385,10 @@ func functionUnwrapResponse(g Generator, s *compile.ServiceSpec, f *compile.Func
 						return
 					}
 				<end ->
+				if result.unexpectedException != nil {
+					err = result.unexpectedException
+					return
+				}
 
 				<if $f.ResultSpec.ReturnType>
 					if result.Success != nil {
@@ -448,6 +472,20 @@ func functionResponseEnveloper(g Generator, s *compile.ServiceSpec, f *compile.F
 		func (<$v> *<$prefix>Result) EnvelopeType() <$wire>.EnvelopeType {
 			return <$wire>.Reply
 		}
+
+		// UnwrapResponse returns the <if $f.ResultSpec.ReturnType>value or <end>error returned by
+		// <$f.Name>.
+		//
+		// The error is an exception thrown by <$f.Name>, or a
+		// *runtime.UnexpectedApplicationError if the result holds an
+		// exception which is not declared in the IDL.
+		<if $f.ResultSpec.ReturnType ->
+		func (<$v> *<$prefix>Result) UnwrapResponse() (<typeReference $f.ResultSpec.ReturnType>, error) {
+		<- else ->
+		func (<$v> *<$prefix>Result) UnwrapResponse() error {
+		<- end>
+			return <$prefix>Helper.UnwrapResponse(<$v>)
+		}
 		`, struct {
 			Service  *compile.ServiceSpec
 			Function *compile.FunctionSpec
@@ -0,0 +1,139 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// Services may list their former names with this annotation. Type aliases
+// are generated with these names so that services may be renamed without
+// breaking packages which use the old names.
+//
+//   service Users {
+//     ...
+//   } (thriftrw.alias = "UserService")
+//
+// Multiple names may be separated by commas.
+const serviceAliasKey = "thriftrw.alias"
+
+// Type aliases require Go 1.9.
+const go19BuildTag = "// +build go1.9\n\n"
+
+var (
+	_identifierRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+	_blankLinesRegexp = regexp.MustCompile(`\n{3,}`)
+)
+
+// serviceAliases returns the aliases declared for the given service with the
+// thriftrw.alias annotation.
+func serviceAliases(s *compile.ServiceSpec) ([]string, error) {
+	annotation, ok := s.Annotations[serviceAliasKey]
+	if !ok {
+		return nil, nil
+	}
+
+	var aliases []string
+	for _, alias := range strings.Split(annotation, ",") {
+		alias = strings.TrimSpace(alias)
+		if !_identifierRegexp.MatchString(alias) {
+			return nil, fmt.Errorf(
+				"invalid %v annotation on service %q: %q is not a valid name",
+				serviceAliasKey, s.Name, alias)
+		}
+		if goCase(alias) == goCase(s.Name) {
+			return nil, fmt.Errorf(
+				"invalid %v annotation on service %q: a service cannot be an alias of itself",
+				serviceAliasKey, s.Name)
+		}
+		aliases = append(aliases, alias)
+	}
+	return aliases, nil
+}
+
+// serviceAlias generates type aliases with the given name for the argument
+// and result types of all functions of the given service.
+//
+// The returned file must be built only with Go 1.9 or newer.
+func serviceAlias(g Generator, s *compile.ServiceSpec, alias string) ([]byte, error) {
+	functions := make([]*compile.FunctionSpec, 0, len(s.Functions))
+	for _, name := range sortStringKeys(s.Functions) {
+		functions = append(functions, s.Functions[name])
+	}
+
+	err := g.DeclareFromTemplate(
+		`
+		<$service := .Service>
+		<$alias := .Alias>
+		<range .Functions>
+			<$old := printf "%v_%v_" (goCase $alias) (goCase .Name)>
+			<$new := functionNamePrefix $service .>
+
+			// <$old>Args is an alias for <$new>Args.
+			//
+			// Deprecated: Use <$new>Args instead.
+			type <$old>Args = <$new>Args
+
+			<if not .OneWay>
+				// <$old>Result is an alias for <$new>Result.
+				//
+				// Deprecated: Use <$new>Result instead.
+				type <$old>Result = <$new>Result
+			<end>
+		<end>
+		`,
+		struct {
+			Service   *compile.ServiceSpec
+			Alias     string
+			Functions []*compile.FunctionSpec
+		}{Service: s, Alias: alias, Functions: functions},
+		TemplateFunc("functionNamePrefix", functionNamePrefix),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate alias %q for service %q: %v", alias, s.Name, err)
+	}
+
+	var buff bytes.Buffer
+	if err := g.Write(&buff, nil /* fset */); err != nil {
+		return nil, fmt.Errorf("could not write alias %q for service %q: %v", alias, s.Name, err)
+	}
+
+	// The build tag must follow the generated code header which must be on
+	// the first line of the file.
+	contents := buff.Bytes()
+	header := []byte(generatedByHeader)
+	if bytes.HasPrefix(contents, header) {
+		contents = contents[len(header):]
+	}
+
+	out := make([]byte, 0, len(header)+len(go19BuildTag)+len(contents))
+	out = append(out, header...)
+	out = append(out, go19BuildTag...)
+	out = append(out, contents...)
+
+	// No imports are needed so clean up the space left for them.
+	return _blankLinesRegexp.ReplaceAll(out, []byte("\n\n")), nil
+}
@@ -0,0 +1,48 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// +build go1.9
+
+package gen
+
+import (
+	"reflect"
+	"testing"
+
+	tv "go.uber.org/thriftrw/gen/testdata/services"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceAliasTypes(t *testing.T) {
+	tests := []struct {
+		alias    interface{}
+		original interface{}
+	}{
+		{tv.KeyValueStore_GetValue_Args{}, tv.KeyValue_GetValue_Args{}},
+		{tv.KeyValueStore_GetValue_Result{}, tv.KeyValue_GetValue_Result{}},
+		{tv.KeyValueStore_Size_Args{}, tv.KeyValue_Size_Args{}},
+		{tv.KeyValueStore_Size_Result{}, tv.KeyValue_Size_Result{}},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, reflect.TypeOf(tt.original), reflect.TypeOf(tt.alias))
+	}
+}
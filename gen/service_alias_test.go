@@ -0,0 +1,86 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServiceAliases(t *testing.T) {
+	tests := []struct {
+		desc       string
+		annotation string
+		want       []string
+		wantError  string
+	}{
+		{
+			desc:       "single alias",
+			annotation: "KeyValueStore",
+			want:       []string{"KeyValueStore"},
+		},
+		{
+			desc:       "multiple aliases",
+			annotation: "KeyValueStore, kv_store",
+			want:       []string{"KeyValueStore", "kv_store"},
+		},
+		{
+			desc:       "empty alias",
+			annotation: "KeyValueStore,",
+			wantError:  `invalid thriftrw.alias annotation on service "KeyValue": "" is not a valid name`,
+		},
+		{
+			desc:       "invalid alias",
+			annotation: "Key-Value",
+			wantError:  `invalid thriftrw.alias annotation on service "KeyValue": "Key-Value" is not a valid name`,
+		},
+		{
+			desc:       "alias of itself",
+			annotation: "key_value",
+			wantError:  `invalid thriftrw.alias annotation on service "KeyValue": a service cannot be an alias of itself`,
+		},
+	}
+
+	for _, tt := range tests {
+		spec := &compile.ServiceSpec{
+			Name:        "KeyValue",
+			Annotations: compile.Annotations{serviceAliasKey: tt.annotation},
+		}
+
+		got, err := serviceAliases(spec)
+		if tt.wantError != "" {
+			assert.EqualError(t, err, tt.wantError, tt.desc)
+			continue
+		}
+		if assert.NoError(t, err, tt.desc) {
+			assert.Equal(t, tt.want, got, tt.desc)
+		}
+	}
+}
+
+func TestServiceAliasesNotAnnotated(t *testing.T) {
+	got, err := serviceAliases(&compile.ServiceSpec{Name: "KeyValue"})
+	assert.NoError(t, err)
+	assert.Empty(t, got)
+}
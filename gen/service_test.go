@@ -32,6 +32,7 @@ import (
 	tu "go.uber.org/thriftrw/gen/testdata/unions"
 	"go.uber.org/thriftrw/protocol"
 	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/runtime"
 	"go.uber.org/thriftrw/wire"
 
 	"github.com/stretchr/testify/assert"
@@ -502,6 +503,65 @@ func TestUnwrapResponse(t *testing.T) {
 	}
 }
 
+func TestUnwrapResponseUnexpectedException(t *testing.T) {
+	// An exception the generated code does not know about, as sent by a
+	// server built with a newer version of the IDL.
+	exception := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("great sadness")},
+	}})
+
+	t.Run("getValue", func(t *testing.T) {
+		var result tv.KeyValue_GetValue_Result
+		require.NoError(t, result.FromWire(wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 42, Value: exception},
+		}})))
+
+		success, err := result.UnwrapResponse()
+		assert.Nil(t, success)
+		require.Error(t, err)
+
+		unexpected, ok := err.(*runtime.UnexpectedApplicationError)
+		require.True(t, ok, "expected an UnexpectedApplicationError, got %T", err)
+		assert.Equal(t, "KeyValue_GetValue_Result", unexpected.Result)
+		assert.Equal(t, int16(42), unexpected.FieldID)
+		assert.True(t, wire.ValuesAreEqual(exception, unexpected.Exception))
+
+		_, helperErr := tv.KeyValue_GetValue_Helper.UnwrapResponse(&result)
+		assert.Equal(t, err, helperErr)
+	})
+
+	t.Run("deleteValue", func(t *testing.T) {
+		var result tv.KeyValue_DeleteValue_Result
+		require.NoError(t, result.FromWire(wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 3, Value: exception},
+		}})))
+
+		err := result.UnwrapResponse()
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "KeyValue_DeleteValue_Result holds an unexpected exception in field 3")
+		}
+	})
+
+	t.Run("unknown non-exception fields are ignored", func(t *testing.T) {
+		var result tv.KeyValue_DeleteValue_Result
+		require.NoError(t, result.FromWire(wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 3, Value: wire.NewValueI32(42)},
+		}})))
+		assert.NoError(t, result.UnwrapResponse())
+	})
+
+	t.Run("known and unexpected exceptions", func(t *testing.T) {
+		var result tv.KeyValue_GetValue_Result
+		err := result.FromWire(wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: exception},
+			{ID: 42, Value: exception},
+		}}))
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), "should have exactly one field: got 2 fields")
+		}
+	})
+}
+
 func TestServiceTypesEnveloper(t *testing.T) {
 	getResponse, err := tv.KeyValue_GetValue_Helper.WrapResponse(&tu.ArbitraryValue{BoolValue: boolp(true)}, nil)
 	require.NoError(t, err, "Failed to get successful GetValue response")
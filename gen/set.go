@@ -40,6 +40,11 @@ type setGenerator struct{}
 // given type is expected.
 func (s *setGenerator) ValueList(g Generator, spec *compile.SetSpec) (string, error) {
 	name := valueListName(g, spec)
+	if generatorOptions(g).CompactCode {
+		err := compactSetValueList(g, spec, name)
+		return name, wrapGenerateError(spec.ThriftName(), err)
+	}
+
 	err := g.EnsureDeclared(
 		`
 			<$wire := import "go.uber.org/thriftrw/wire">
@@ -61,14 +66,20 @@ func (s *setGenerator) ValueList(g Generator, spec *compile.SetSpec) (string, er
 							}
 						<end ->
 
+					<if toWireCanFail .Spec.ValueSpec ->
 						<$w>, err := <toWire .Spec.ValueSpec $x>
 						if err != nil {
 							return err
 						}
 
-						if err := <$f>(<$w>); err != nil {
+						if err = <$f>(<$w>); err != nil {
+							return err
+						}
+					<- else ->
+						if err := <$f>(<toWireValue .Spec.ValueSpec $x>); err != nil {
 							return err
 						}
+					<- end>
 					}
 				return nil
 			}
@@ -114,10 +125,14 @@ func (s *setGenerator) Reader(g Generator, spec *compile.SetSpec) (string, error
 					<$o> := make(<$setType>, 0, <$s>.Size())
 				<end ->
 				err := <$s>.ForEach(func(<$x> <$wire>.Value) error {
-					<$i>, err := <fromWire .Spec.ValueSpec $x>
-					if err != nil {
-						return err
-					}
+					<if fromWireCanFail .Spec.ValueSpec ->
+						<$i>, err := <fromWire .Spec.ValueSpec $x>
+						if err != nil {
+							return err
+						}
+					<- else ->
+						<$i> := <fromWireValue .Spec.ValueSpec $x>
+					<- end>
 					<if isHashable .Spec.ValueSpec>
 						<$o>[<$i>] = struct{}{}
 					<else>
@@ -197,3 +212,47 @@ func (s *setGenerator) Equals(g Generator, spec *compile.SetSpec) (string, error
 
 	return name, wrapGenerateError(spec.ThriftName(), err)
 }
+
+// Clone generates a function to deep copy sets of the given type
+//
+// func $name(s $setType) $setType {
+//      ...
+// }
+//
+// And returns its name.
+func (s *setGenerator) Clone(g Generator, spec *compile.SetSpec) (string, error) {
+	name := cloneFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$setType := typeReference .Spec>
+
+			<$s := newVar "s">
+			<$o := newVar "o">
+			<$x := newVar "x">
+			func <.Name>(<$s> <$setType>) <$setType> {
+				if <$s> == nil {
+					return nil
+				}
+
+				<if isHashable .Spec.ValueSpec>
+					<$o> := make(<$setType>, len(<$s>))
+					for <$x> := range <$s> {
+						<$o>[<$x>] = struct{}{}
+					}
+				<else>
+					<$o> := make(<$setType>, 0, len(<$s>))
+					for _, <$x> := range <$s> {
+						<$o> = append(<$o>, <clone .Spec.ValueSpec $x>)
+					}
+				<end>
+				return <$o>
+			}
+		`,
+		struct {
+			Name string
+			Spec *compile.SetSpec
+		}{Name: name, Spec: spec},
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
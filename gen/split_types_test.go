@@ -0,0 +1,120 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitTypes(t *testing.T) {
+	tests := []struct {
+		file string
+
+		wantFiles []string
+	}{
+		{
+			file: "split_types.thrift",
+			wantFiles: []string{
+				"idl.go",
+				"types.go", // exception families
+				"types_canvas.go",
+				"types_color.go",
+				"types_drawerror.go",
+				"types_shape.go",
+				"types_sizeerror.go",
+				"types_tags.go",
+			},
+		},
+		{
+			file: "unions.thrift",
+			wantFiles: []string{
+				"idl.go",
+				"types_arbitraryvalue.go",
+				"types_document.go",
+				"types_emptyunion.go",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		func() {
+			outputDir, err := ioutil.TempDir("", "thriftrw-split-types-test")
+			require.NoError(t, err)
+			defer os.RemoveAll(outputDir)
+
+			module, err := compile.Compile(filepath.Join("testdata/thrift", tt.file))
+			require.NoError(t, err, tt.file)
+
+			require.NoError(t, Generate(module, &Options{
+				OutputDir:      outputDir,
+				PackagePrefix:  "go.uber.org/thriftrw/gen/testdata",
+				ThriftRoot:     testdata(t, "thrift"),
+				NoRecurse:      true,
+				NoVersionCheck: true,
+				SplitTypes:     true,
+			}), tt.file)
+
+			packageDir := filepath.Join(outputDir, strings.TrimSuffix(tt.file, ".thrift"))
+			infos, err := ioutil.ReadDir(packageDir)
+			require.NoError(t, err, tt.file)
+
+			var files []string
+			for _, info := range infos {
+				files = append(files, info.Name())
+			}
+			sort.Strings(files)
+			assert.Equal(t, tt.wantFiles, files, tt.file)
+
+			// Helpers needed by more than one type must be declared only
+			// once across all files.
+			declared := make(map[string]string)
+			fset := token.NewFileSet()
+			for _, name := range files {
+				f, err := parser.ParseFile(fset, filepath.Join(packageDir, name), nil, 0)
+				require.NoError(t, err, "%v: failed to parse %v", tt.file, name)
+
+				for _, decl := range f.Decls {
+					fn, ok := decl.(*ast.FuncDecl)
+					if !ok || fn.Recv != nil {
+						continue
+					}
+					if other, ok := declared[fn.Name.Name]; ok {
+						t.Errorf("%v: %v is declared in both %v and %v", tt.file, fn.Name.Name, other, name)
+					}
+					declared[fn.Name.Name] = name
+				}
+			}
+		}()
+	}
+}
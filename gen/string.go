@@ -99,6 +99,46 @@ func goNameAnnotation(e compile.NamedEntity) (string, error) {
 		return "", nil
 	}
 
+	if err := checkGoName(name, "go.name"); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// renamedFromGoKey is the annotation on a field which records the name of
+// the field in Go before it was renamed.
+const renamedFromGoKey = "go.renamedFrom"
+
+// renamedFromGoAnnotation returns ("", nil) if there is no "go.renamedFrom"
+// annotation.
+func renamedFromGoAnnotation(f *compile.FieldSpec) (string, error) {
+	name, ok := f.Annotations[renamedFromGoKey]
+	if !ok {
+		return "", nil
+	}
+
+	if err := checkGoName(name, renamedFromGoKey); err != nil {
+		return "", err
+	}
+
+	newName, err := goName(f)
+	if err != nil {
+		return "", err
+	}
+	if name == newName {
+		return "", fmt.Errorf(
+			"%q (from %v annotation) is the current name of field %q", name, renamedFromGoKey, f.Name)
+	}
+	return name, nil
+}
+
+// checkGoName returns an error if the given name, taken from the given
+// annotation, is not a Go style public identifier.
+func checkGoName(name, annotation string) error {
+	if name == "" {
+		return fmt.Errorf("%v annotation must not be empty", annotation)
+	}
+
 	c, _ := utf8.DecodeRuneInString(name)
 	capitalized := unicode.IsLetter(c) && unicode.IsUpper(c)
 	underscore := strings.Contains(name, "_")
@@ -112,10 +152,9 @@ func goNameAnnotation(e compile.NamedEntity) (string, error) {
 			emsg = append(emsg, "is not capitalized")
 		}
 
-		return "", fmt.Errorf("%q (from go.name annotation) is not a Go style public identifier (%s), suggestion: %q)", name, strings.Join(emsg, ", "), goCase(name))
+		return fmt.Errorf("%q (from %v annotation) is not a Go style public identifier (%s), suggestion: %q)", name, annotation, strings.Join(emsg, ", "), goCase(name))
 	}
-
-	return name, nil
+	return nil
 }
 
 func goNameForNamedEntity(e compile.NamedEntity) (name string, fromAnnotation bool, err error) {
@@ -21,10 +21,27 @@
 package gen
 
 import (
+	"fmt"
+
 	"go.uber.org/thriftrw/ast"
 	"go.uber.org/thriftrw/compile"
 )
 
+const (
+	// exceptions with this annotation use the value of the named string
+	// field as their error message. Use an empty value to always use the
+	// String representation of the exception instead.
+	//
+	//   exception NotFound {
+	//     1: required string reason
+	//   } (thriftrw.message = "reason")
+	messageKey = "thriftrw.message"
+
+	// field whose value is used as the error message of exceptions without
+	// a thriftrw.message annotation, if it is a string
+	defaultMessageField = "message"
+)
+
 // structGenerator generates code to serialize and deserialize structs.
 type structGenerator struct{}
 
@@ -57,27 +74,74 @@ func structure(g Generator, spec *compile.StructSpec) error {
 		return err
 	}
 
+	fields, err := rawFields(spec.Fields)
+	if err != nil {
+		return wrapGenerateError(spec.ThriftName(), err)
+	}
+
+	optionalValues, err := optionalValueFields(g, spec, fields)
+	if err != nil {
+		return wrapGenerateError(spec.ThriftName(), err)
+	}
+
 	fg := fieldGroupGenerator{
-		Namespace:   NewNamespace(),
-		Name:        name,
-		Doc:         spec.Doc,
-		Fields:      spec.Fields,
-		IsUnion:     spec.Type == ast.UnionType,
-		IsException: spec.Type == ast.ExceptionType,
+		Namespace:      NewNamespace(),
+		Name:           name,
+		Doc:            spec.Doc,
+		Fields:         fields,
+		IsUnion:        spec.Type == ast.UnionType,
+		IsException:    spec.Type == ast.ExceptionType,
+		OptionalValues: optionalValues,
+		Immutable:      generatorOptions(g).Immutable,
 	}
 
 	if err := fg.Generate(g); err != nil {
 		return wrapGenerateError(spec.ThriftName(), err)
 	}
 
+	// Immutable structs, unions, and exceptions can only be built with
+	// builders.
+	if spec.Type == ast.StructType && checkBuilder(g, len(spec.Fields)) ||
+		fg.Immutable && len(spec.Fields) > 0 {
+		if err := builder(g, spec, fg); err != nil {
+			return err
+		}
+	}
+
 	if spec.Type == ast.ExceptionType {
-		err := g.DeclareFromTemplate(
+		message, err := exceptionMessageField(spec, fields)
+		if err != nil {
+			return wrapGenerateError(spec.ThriftName(), err)
+		}
+
+		err = g.DeclareFromTemplate(
 			`
 			<$v := newVar "v">
-			func (<$v> *<typeName .>) Error() string {
+			<with .Message>
+			<$m := newVar "m">
+			// Error returns the <.Name> of the exception if it is set and the
+			// String representation of the exception otherwise.
+			func (<$v> *<typeName $.Spec>) Error() string {
+				if <$m> := <$v>.Get<goName .>(); <$m> != "" {
+					return <if $.Convert>string(<$m>)<else><$m><end>
+				}
+				return <$v>.String()
+			}
+			<else>
+			func (<$v> *<typeName .Spec>) Error() string {
 				return <$v>.String()
 			}
-			`, spec)
+			<end>
+			`,
+			struct {
+				Spec    *compile.StructSpec
+				Message *compile.FieldSpec
+				Convert bool // whether the message is a typedef of string
+			}{
+				Spec:    spec,
+				Message: message,
+				Convert: message != nil && !isStringSpec(message.Type),
+			})
 		if err != nil {
 			return wrapGenerateError(spec.ThriftName(), err)
 		}
@@ -89,3 +153,48 @@ func structure(g Generator, spec *compile.StructSpec) error {
 	// TODO(abg): For exceptions, handle the case where a field is named
 	// Error.
 }
+
+// exceptionMessageField returns the field of the given exception whose value
+// is used as its error message, or nil if the exception does not have one.
+//
+// This is the field named by the thriftrw.message annotation or, if the
+// annotation is absent, the string field named "message". Fields which are
+// redacted or kept raw are not used unless requested explicitly, in which
+// case an error is returned.
+func exceptionMessageField(spec *compile.StructSpec, fields compile.FieldGroup) (*compile.FieldSpec, error) {
+	name, explicit := spec.Annotations[messageKey]
+	if !explicit {
+		name = defaultMessageField
+	}
+	if name == "" {
+		return nil, nil
+	}
+
+	field, err := fields.FindByName(name)
+	if err != nil {
+		if explicit {
+			return nil, fmt.Errorf("%v refers to unknown field %q", messageKey, name)
+		}
+		return nil, nil
+	}
+
+	var reason string
+	switch {
+	case !isStringSpec(compile.RootTypeSpec(field.Type)):
+		reason = "it is not a string"
+	case isRedacted(field):
+		reason = fmt.Sprintf("it has a %v annotation", redactKey)
+	default:
+		return field, nil
+	}
+
+	if explicit {
+		return nil, fmt.Errorf("field %q cannot be used as the error message because %v", name, reason)
+	}
+	return nil, nil
+}
+
+func isStringSpec(t compile.TypeSpec) bool {
+	_, ok := t.(*compile.StringSpec)
+	return ok
+}
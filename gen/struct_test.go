@@ -23,12 +23,17 @@ package gen
 import (
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
+	"go.uber.org/thriftrw/compile"
 	tc "go.uber.org/thriftrw/gen/testdata/containers"
 	te "go.uber.org/thriftrw/gen/testdata/enums"
 	tx "go.uber.org/thriftrw/gen/testdata/exceptions"
+	tv "go.uber.org/thriftrw/gen/testdata/services"
 	ts "go.uber.org/thriftrw/gen/testdata/structs"
 	td "go.uber.org/thriftrw/gen/testdata/typedefs"
 	tu "go.uber.org/thriftrw/gen/testdata/unions"
@@ -503,6 +508,39 @@ func TestStructStringWithNil(t *testing.T) {
 	assert.Equal(t, "<nil>", f.String())
 }
 
+func TestStructToWireWithNil(t *testing.T) {
+	tests := []struct {
+		desc      string
+		give      thriftType
+		wantError string
+	}{
+		{
+			desc:      "nil struct",
+			give:      (*ts.Frame)(nil),
+			wantError: "Frame is nil",
+		},
+		{
+			desc:      "nil union",
+			give:      (*tu.Document)(nil),
+			wantError: "Document is nil",
+		},
+		{
+			desc:      "nil exception",
+			give:      (*tx.DoesNotExistException)(nil),
+			wantError: "DoesNotExistException is nil",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.NotPanics(t, func() {
+				_, err := tt.give.ToWire()
+				assert.EqualError(t, err, tt.wantError)
+			})
+		})
+	}
+}
+
 func TestStructStringWithMissingRequiredFields(t *testing.T) {
 	tests := []struct {
 		i fmt.Stringer
@@ -539,6 +577,31 @@ func TestStructStringWithMissingRequiredFields(t *testing.T) {
 	}
 }
 
+func TestStructStringRedacted(t *testing.T) {
+	tests := []struct {
+		i fmt.Stringer
+		o string
+	}{
+		{
+			&ts.Credentials{Username: "alice", Password: "hunter2"},
+			"Credentials{Username: alice, Password: <redacted>}",
+		},
+		{
+			&ts.Credentials{
+				Username: "alice",
+				Password: "hunter2",
+				Token:    []byte("secret"),
+			},
+			"Credentials{Username: alice, Password: <redacted>, Token: <redacted>}",
+		},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.o, tt.i.String())
+		assert.Equal(t, tt.o, fmt.Sprint(tt.i))
+	}
+}
+
 func TestBasicException(t *testing.T) {
 	tests := []struct {
 		s tx.DoesNotExistException
@@ -560,6 +623,97 @@ func TestBasicException(t *testing.T) {
 	}
 }
 
+func TestExceptionErrorMessage(t *testing.T) {
+	tests := []struct {
+		desc string
+		give error
+		want string
+	}{
+		{
+			desc: "message",
+			give: &tv.InternalError{Message: ptr.String("great sadness")},
+			want: "great sadness",
+		},
+		{
+			desc: "message unset",
+			give: &tv.InternalError{},
+			want: "InternalError{}",
+		},
+		{
+			desc: "annotated typedef",
+			give: &tx.TimeoutException{Reason: reasonPtr("too slow"), ElapsedMillis: ptr.Int64(100)},
+			want: "too slow",
+		},
+		{
+			desc: "redacted message",
+			give: &tx.PermissionDenied{Message: ptr.String("secret")},
+			want: "PermissionDenied{Message: <redacted>}",
+		},
+		{
+			desc: "nil",
+			give: (*tx.TimeoutException)(nil),
+			want: "<nil>",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.give.Error())
+		})
+	}
+}
+
+func reasonPtr(s tx.Reason) *tx.Reason { return &s }
+
+func TestExceptionErrorMessageInvalid(t *testing.T) {
+	tests := []struct {
+		desc    string
+		src     string
+		wantErr string
+	}{
+		{
+			desc:    "unknown field",
+			src:     `exception Foo { 1: optional string reason } (thriftrw.message = "message")`,
+			wantErr: `thriftrw.message refers to unknown field "message"`,
+		},
+		{
+			desc:    "not a string",
+			src:     `exception Foo { 1: optional i32 code } (thriftrw.message = "code")`,
+			wantErr: `field "code" cannot be used as the error message because it is not a string`,
+		},
+		{
+			desc: "redacted",
+			src: `exception Foo {
+				1: optional string message (thriftrw.redact = "true")
+			} (thriftrw.message = "message")`,
+			wantErr: `field "message" cannot be used as the error message because it has a thriftrw.redact annotation`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "thriftrw-exception-test")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+
+			path := filepath.Join(dir, "exception.thrift")
+			require.NoError(t, ioutil.WriteFile(path, []byte(tt.src), 0644))
+
+			module, err := compile.Compile(path)
+			require.NoError(t, err)
+
+			err = Generate(module, &Options{
+				OutputDir:     dir,
+				PackagePrefix: "go.uber.org/thriftrw/gen/testdata",
+				ThriftRoot:    dir,
+			})
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestStructFromWireUnrecognizedField(t *testing.T) {
 	tests := []struct {
 		desc string
@@ -876,6 +1030,33 @@ func TestStructWithDefaults(t *testing.T) {
 	}
 }
 
+func TestStructDefaultConstructor(t *testing.T) {
+	enumDefaultBar := te.EnumDefaultBar
+	enumDefaultBaz := te.EnumDefaultBaz
+
+	assert.Equal(t, &ts.DefaultsStruct{
+		RequiredPrimitive: int32p(100),
+		OptionalPrimitive: int32p(200),
+		RequiredEnum:      &enumDefaultBar,
+		OptionalEnum:      &enumDefaultBaz,
+		RequiredList:      []string{"hello", "world"},
+		OptionalList:      []float64{1.0, 2.0, 3.0},
+		RequiredStruct: &ts.Frame{
+			TopLeft: &ts.Point{X: 1.0, Y: 2.0},
+			Size:    &ts.Size{Width: 100.0, Height: 200.0},
+		},
+		OptionalStruct: &ts.Edge{
+			StartPoint: &ts.Point{X: 1.0, Y: 2.0},
+			EndPoint:   &ts.Point{X: 3.0, Y: 4.0},
+		},
+	}, ts.Default_DefaultsStruct())
+
+	// Each call returns a new value.
+	d := ts.Default_DefaultsStruct()
+	d.RequiredStruct.TopLeft.X = 42
+	assert.Equal(t, 1.0, ts.Default_DefaultsStruct().RequiredStruct.TopLeft.X)
+}
+
 func TestStructJSON(t *testing.T) {
 	tests := []struct {
 		v interface{}
@@ -1677,6 +1858,146 @@ func TestStructAccessors(t *testing.T) {
 	})
 }
 
+func TestStructAccessorsNilSafe(t *testing.T) {
+	t.Run("nil receiver", func(t *testing.T) {
+		var s *ts.DefaultsStruct
+		assert.Equal(t, int32(100), s.GetRequiredPrimitive())
+		assert.Equal(t, te.EnumDefaultBaz, s.GetOptionalEnum())
+		assert.Equal(t, []string{"hello", "world"}, s.GetRequiredList())
+		assert.NotNil(t, s.GetOptionalStruct(), "must use default value")
+
+		var e *ts.Edge
+		assert.Nil(t, e.GetStartPoint())
+		assert.Equal(t, float64(0), e.GetStartPoint().GetX())
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		e := &ts.Edge{StartPoint: &ts.Point{X: 1, Y: 2}}
+		assert.Equal(t, float64(1), e.GetStartPoint().GetX())
+		assert.Equal(t, float64(0), e.GetEndPoint().GetY())
+	})
+
+	t.Run("required field", func(t *testing.T) {
+		c := &ts.Credentials{Username: "alice"}
+		assert.Equal(t, "alice", c.GetUsername())
+		assert.Nil(t, c.GetToken())
+	})
+}
+
+func TestStructIsSet(t *testing.T) {
+	t.Run("zero values", func(t *testing.T) {
+		var s ts.PrimitiveOptionalStruct
+		require.NoError(t, s.FromWire(wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueBool(false)},
+			{ID: 8, Value: wire.NewValueBinary([]byte{})},
+		}})))
+
+		assert.True(t, s.IsSetBoolField())
+		assert.False(t, s.GetBoolField())
+		assert.True(t, s.IsSetBinaryField())
+		assert.False(t, s.IsSetInt32Field())
+		assert.False(t, s.IsSetStringField())
+	})
+
+	t.Run("containers and structs", func(t *testing.T) {
+		s := ts.DefaultsStruct{OptionalList: []float64{}}
+		assert.True(t, s.IsSetOptionalList())
+		assert.False(t, s.IsSetOptionalStruct())
+	})
+
+	t.Run("union", func(t *testing.T) {
+		u := tu.Document{PlainText: ptr.String("")}
+		assert.True(t, u.IsSetPlainText())
+		assert.False(t, u.IsSetPdf())
+	})
+
+	t.Run("nil receiver", func(t *testing.T) {
+		var s *ts.PrimitiveOptionalStruct
+		assert.False(t, s.IsSetBoolField())
+	})
+}
+
+func TestStructRenamedFieldAccessors(t *testing.T) {
+	r := &ts.Renamed{Username: "alice", Total: ptr.Int32(3)}
+	assert.Equal(t, "alice", r.GetUserName())
+	assert.Equal(t, int32(3), r.GetCount())
+	assert.True(t, r.IsSetCount())
+
+	var empty *ts.Renamed
+	assert.Equal(t, "", empty.GetUserName())
+	assert.Equal(t, int32(0), empty.GetCount())
+	assert.False(t, empty.IsSetCount())
+}
+
+func TestStructRenamedFieldInvalid(t *testing.T) {
+	tests := []struct {
+		desc    string
+		src     string
+		wantErr string
+	}{
+		{
+			desc: "not exported",
+			src: `
+				struct Foo {
+					1: optional i32 x (go.renamedFrom = "oldX")
+				}
+			`,
+			wantErr: `"oldX" (from go.renamedFrom annotation) is not a Go style public identifier (is not capitalized)`,
+		},
+		{
+			desc: "empty",
+			src: `
+				struct Foo {
+					1: optional i32 x (go.renamedFrom = "")
+				}
+			`,
+			wantErr: `go.renamedFrom annotation must not be empty`,
+		},
+		{
+			desc: "same name",
+			src: `
+				struct Foo {
+					1: optional i32 x (go.name = "Y", go.renamedFrom = "Y")
+				}
+			`,
+			wantErr: `"Y" (from go.renamedFrom annotation) is the current name of field "x"`,
+		},
+		{
+			desc: "conflict with another field",
+			src: `
+				struct Foo {
+					1: optional i32 x (go.renamedFrom = "Y")
+					2: optional i32 y
+				}
+			`,
+			wantErr: `"GetY"`,
+		},
+	}
+
+	for _, tt := range tests {
+		func() {
+			dir, err := ioutil.TempDir("", "thriftrw-renamed-test")
+			require.NoError(t, err)
+			defer os.RemoveAll(dir)
+
+			path := filepath.Join(dir, "renamed.thrift")
+			require.NoError(t, ioutil.WriteFile(path, []byte(tt.src), 0644), tt.desc)
+
+			module, err := compile.Compile(path)
+			require.NoError(t, err, tt.desc)
+
+			err = Generate(module, &Options{
+				OutputDir:     dir,
+				PackagePrefix: "go.uber.org/thriftrw/gen/testdata",
+				ThriftRoot:    dir,
+			})
+			if assert.Error(t, err, tt.desc) {
+				assert.Contains(t, err.Error(), tt.wantErr, tt.desc)
+			}
+		}()
+	}
+}
+
 func TestEmptyPrimitivesRoundTrip(t *testing.T) {
 	t.Run("required", func(t *testing.T) {
 		give := ts.PrimitiveRequiredStruct{
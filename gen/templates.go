@@ -0,0 +1,109 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package gen
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// TemplateExt is the extension of files read by LoadTemplates.
+const TemplateExt = ".tmpl"
+
+// overridableTemplates is the set of names of templates which may be
+// replaced with Options.Templates.
+var overridableTemplates = map[string]struct{}{
+	"struct":  {},
+	"enum":    {},
+	"typedef": {},
+}
+
+// LoadTemplates reads template overrides for Options.Templates from the
+// given directory. Each file named after an overridable template with the
+// TemplateExt extension, like struct.tmpl, replaces that template. Other
+// files are ignored, but an error is returned if no templates were found.
+func LoadTemplates(dir string) (map[string]string, error) {
+	templates := make(map[string]string)
+	for _, name := range sortStringKeys(overridableTemplates) {
+		body, err := ioutil.ReadFile(filepath.Join(dir, name+TemplateExt))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		templates[name] = string(body)
+	}
+
+	if len(templates) == 0 {
+		return nil, fmt.Errorf("no templates found in %q: expected one of %v",
+			dir, templateFileNames())
+	}
+	return templates, nil
+}
+
+// checkTemplates verifies that all the given template overrides replace
+// known templates.
+func checkTemplates(templates map[string]string) error {
+	for _, name := range sortStringKeys(templates) {
+		if _, ok := overridableTemplates[name]; !ok {
+			return fmt.Errorf("unknown template %q: only %v may be overridden",
+				name, strings.Join(sortStringKeys(overridableTemplates), ", "))
+		}
+	}
+	return nil
+}
+
+// overrideTemplate returns the template which should be used in place of the
+// built-in template with the given name and source.
+//
+// Overrides are rendered with the same data and template functions as the
+// built-in templates, so they must use < and > as delimiters.
+func overrideTemplate(g Generator, name, s string) string {
+	if t, ok := generatorOptions(g).Templates[name]; ok {
+		return t
+	}
+	return s
+}
+
+// describeTemplates describes the given template overrides for the manifest
+// by listing their names with a prefix of the SHA-256 of their contents.
+func describeTemplates(templates map[string]string) string {
+	descs := make([]string, 0, len(templates))
+	for _, name := range sortStringKeys(templates) {
+		sum := sha256.Sum256([]byte(templates[name]))
+		descs = append(descs, fmt.Sprintf("%v@%x", name, sum[:4]))
+	}
+	return strings.Join(descs, ",")
+}
+
+func templateFileNames() []string {
+	names := make([]string, 0, len(overridableTemplates))
+	for name := range overridableTemplates {
+		names = append(names, name+TemplateExt)
+	}
+	sort.Strings(names)
+	return names
+}
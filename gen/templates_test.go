@@ -0,0 +1,92 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package gen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadTemplates(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thriftrw-templates-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	_, err = LoadTemplates(dir)
+	if assert.Error(t, err, "an empty directory must be rejected") {
+		assert.Contains(t, err.Error(), "expected one of [enum.tmpl struct.tmpl typedef.tmpl]")
+	}
+
+	write := func(name, contents string) {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), []byte(contents), 0644))
+	}
+	write("struct.tmpl", "struct template")
+	write("README.md", "ignored")
+
+	templates, err := LoadTemplates(dir)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"struct": "struct template"}, templates)
+}
+
+func TestGenerateWithTemplates(t *testing.T) {
+	module, err := compile.Compile("testdata/thrift/typedefs.thrift")
+	require.NoError(t, err)
+
+	outputDir, err := ioutil.TempDir("", "thriftrw-templates-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+
+	opts := Options{
+		OutputDir:     outputDir,
+		PackagePrefix: "go.uber.org/thriftrw/gen/testdata",
+		ThriftRoot:    testdata(t, "thrift"),
+		NoRecurse:     true,
+		Templates: map[string]string{
+			"struct": `
+				// <.Name> was generated from a custom template.
+				type <.Name> struct {
+					<range .Fields>
+						<declFieldName .> <if .Required><typeReference .Type><else><typeReferencePtr .Type><end>
+					<end>
+				}
+			`,
+		},
+	}
+	require.NoError(t, Generate(module, &opts))
+
+	code, err := ioutil.ReadFile(filepath.Join(outputDir, "typedefs", "types.go"))
+	require.NoError(t, err)
+	assert.Contains(t, string(code), "// Event was generated from a custom template.\ntype Event struct {")
+	assert.NotContains(t, string(code), "json:", "struct tags come from the built-in template")
+
+	opts.Templates = map[string]string{"service": "foo"}
+	err = Generate(module, &opts)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(),
+			`unknown template "service": only enum, struct, typedef may be overridden`)
+	}
+}
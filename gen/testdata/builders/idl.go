@@ -0,0 +1,18 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package builders
+
+import "go.uber.org/thriftrw/thriftreflect"
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:            "builders",
+	Package:         "go.uber.org/thriftrw/gen/testdata/builders",
+	FilePath:        "builders.thrift",
+	SHA1:            "ec89abc8eae3a5c504f48d1affa18d2fff77e3a5",
+	ThriftRWVersion: "1.9.0",
+	Raw:             rawIDL,
+}
+
+const rawIDL = "// Code for this file is generated with --generate-builders --builder-threshold=2.\n\n// Point has too few fields for a builder.\nstruct Point {\n    1: required double x\n    2: required double y\n}\n\nstruct Shape {\n    1: required string name\n    2: required Point origin\n    3: optional i32 sides = 4\n    4: optional list<string> tags\n    5: optional string color\n}\n\n"
@@ -0,0 +1,699 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package builders
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
+)
+
+type Point struct {
+	X float64 `json:"x,required"`
+	Y float64 `json:"y,required"`
+}
+
+// ToWire translates a Point struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Point) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Point is nil")
+	}
+
+	w = wire.NewValueDouble(v.X)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w = wire.NewValueDouble(v.Y)
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Point struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Point struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Point
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Point) FromWire(w wire.Value) error {
+
+	xIsSet := false
+	yIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TDouble {
+				v.X = field.Value.GetDouble()
+				xIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TDouble {
+				v.Y = field.Value.GetDouble()
+				yIsSet = true
+			}
+		}
+	}
+
+	if !xIsSet {
+		return errors.New("field X of Point is required")
+	}
+
+	if !yIsSet {
+		return errors.New("field Y of Point is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Point
+// struct.
+func (v *Point) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("X: %v", v.X)
+	i++
+	fields[i] = fmt.Sprintf("Y: %v", v.Y)
+	i++
+
+	return fmt.Sprintf("Point{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Point match the
+// provided Point.
+//
+// This function performs a deep comparison.
+func (v *Point) Equals(rhs *Point) bool {
+	if !(v.X == rhs.X) {
+		return false
+	}
+	if !(v.Y == rhs.Y) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this Point.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Point) Clone() *Point {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Point.
+func (v *Point) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddFloat64("x", v.X)
+	enc.AddFloat64("y", v.Y)
+	return nil
+}
+
+// GetX returns the value of X if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Point.
+func (v *Point) GetX() (o float64) {
+	if v != nil {
+		o = v.X
+	}
+	return
+}
+
+// GetY returns the value of Y if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Point.
+func (v *Point) GetY() (o float64) {
+	if v != nil {
+		o = v.Y
+	}
+	return
+}
+
+type Shape struct {
+	Name   string   `json:"name,required"`
+	Origin *Point   `json:"origin,required"`
+	Sides  *int32   `json:"sides,omitempty"`
+	Tags   []string `json:"tags,omitempty"`
+	Color  *string  `json:"color,omitempty"`
+}
+
+// Default_Shape constructs a new Shape with its fields set to the
+// default values declared for them in the Thrift file.
+func Default_Shape() *Shape {
+	var v Shape
+	v.Sides = ptr.Int32(4)
+	return &v
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		if err := f(wire.NewValueString(x)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+// ToWire translates a Shape struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Shape) ToWire() (wire.Value, error) {
+	var (
+		fields [5]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Shape is nil")
+	}
+
+	w = wire.NewValueString(v.Name)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Origin == nil {
+		return w, errors.New("field Origin of Shape is required")
+	}
+	w, err = v.Origin.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	if v.Sides == nil {
+		v.Sides = ptr.Int32(4)
+	}
+	{
+		w = wire.NewValueI32(*(v.Sides))
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Tags != nil {
+		w = wire.NewValueList(_List_String_ValueList(v.Tags))
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Color != nil {
+		w = wire.NewValueString(*(v.Color))
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Point_Read(w wire.Value) (*Point, error) {
+	var v Point
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		o = append(o, x.GetString())
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a Shape struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Shape struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Shape
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Shape) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+	originIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name = field.Value.GetString()
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Origin, err = _Point_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				originIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TI32 {
+				x := field.Value.GetI32()
+				v.Sides = &x
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TList {
+				v.Tags, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TBinary {
+				x := field.Value.GetString()
+				v.Color = &x
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Shape is required")
+	}
+
+	if !originIsSet {
+		return errors.New("field Origin of Shape is required")
+	}
+
+	if v.Sides == nil {
+		v.Sides = ptr.Int32(4)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Shape
+// struct.
+func (v *Shape) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [5]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	fields[i] = fmt.Sprintf("Origin: %v", v.Origin)
+	i++
+	if v.Sides != nil {
+		fields[i] = fmt.Sprintf("Sides: %v", *(v.Sides))
+		i++
+	}
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+	if v.Color != nil {
+		fields[i] = fmt.Sprintf("Color: %v", *(v.Color))
+		i++
+	}
+
+	return fmt.Sprintf("Shape{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Shape match the
+// provided Shape.
+//
+// This function performs a deep comparison.
+func (v *Shape) Equals(rhs *Shape) bool {
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !v.Origin.Equals(rhs.Origin) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Sides, rhs.Sides) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _List_String_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Color, rhs.Color) {
+		return false
+	}
+
+	return true
+}
+
+func _I32_ClonePtr(p *int32) *int32 {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _List_String_Clone(l []string) []string {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]string, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
+}
+
+func _String_ClonePtr(p *string) *string {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this Shape.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Shape) Clone() *Shape {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Origin = v.Origin.Clone()
+	o.Sides = _I32_ClonePtr(v.Sides)
+	o.Tags = _List_String_Clone(v.Tags)
+	o.Color = _String_ClonePtr(v.Color)
+
+	return &o
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Shape.
+func (v *Shape) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("name", v.Name)
+	if err := enc.AddObject("origin", v.Origin); err != nil {
+		return err
+	}
+	if v.Sides != nil {
+		enc.AddInt32("sides", *v.Sides)
+	}
+	if v.Tags != nil {
+		if err := enc.AddArray("tags", (_List_String_Zapper)(v.Tags)); err != nil {
+			return err
+		}
+	}
+	if v.Color != nil {
+		enc.AddString("color", *v.Color)
+	}
+	return nil
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Shape.
+func (v *Shape) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetOrigin returns the value of Origin if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Shape.
+func (v *Shape) GetOrigin() (o *Point) {
+	if v != nil {
+		o = v.Origin
+	}
+	return
+}
+
+// GetSides returns the value of Sides if it is set or its
+// default value if it is unset.
+//
+// This is safe to call on a nil Shape.
+func (v *Shape) GetSides() (o int32) {
+	if v != nil && v.Sides != nil {
+		return *v.Sides
+	}
+	o = 4
+	return
+}
+
+// IsSetSides returns true if Sides is not nil.
+//
+// This is safe to call on a nil Shape.
+func (v *Shape) IsSetSides() bool {
+	return v != nil && v.Sides != nil
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Shape.
+func (v *Shape) GetTags() (o []string) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+//
+// This is safe to call on a nil Shape.
+func (v *Shape) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+// GetColor returns the value of Color if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Shape.
+func (v *Shape) GetColor() (o string) {
+	if v != nil && v.Color != nil {
+		return *v.Color
+	}
+
+	return
+}
+
+// IsSetColor returns true if Color is not nil.
+//
+// This is safe to call on a nil Shape.
+func (v *Shape) IsSetColor() bool {
+	return v != nil && v.Color != nil
+}
+
+// ShapeBuilder builds Shape structs.
+//
+// 	v, err := NewShapeBuilder().
+// 		SetName(...).
+// 		Build()
+type ShapeBuilder struct {
+	v   Shape
+	set [5]bool
+}
+
+// NewShapeBuilder returns a new ShapeBuilder with all fields that have
+// default values set to their defaults.
+func NewShapeBuilder() *ShapeBuilder {
+	var b ShapeBuilder
+	b.v.Sides = ptr.Int32(4)
+
+	return &b
+}
+
+// SetName sets the value of Name.
+func (b *ShapeBuilder) SetName(v string) *ShapeBuilder {
+	b.v.Name = v
+	b.set[0] = true
+	return b
+}
+
+// SetOrigin sets the value of Origin.
+func (b *ShapeBuilder) SetOrigin(v *Point) *ShapeBuilder {
+	b.v.Origin = v
+	b.set[1] = true
+	return b
+}
+
+// SetSides sets the value of Sides.
+func (b *ShapeBuilder) SetSides(v int32) *ShapeBuilder {
+	b.v.Sides = &v
+	b.set[2] = true
+	return b
+}
+
+// SetTags sets the value of Tags.
+func (b *ShapeBuilder) SetTags(v []string) *ShapeBuilder {
+	b.v.Tags = v
+	b.set[3] = true
+	return b
+}
+
+// SetColor sets the value of Color.
+func (b *ShapeBuilder) SetColor(v string) *ShapeBuilder {
+	b.v.Color = &v
+	b.set[4] = true
+	return b
+}
+
+// Build returns the Shape built by this ShapeBuilder.
+//
+// An error is returned if any of the required fields of Shape have
+// not been set.
+func (b *ShapeBuilder) Build() (*Shape, error) {
+	if !b.set[0] {
+		return nil, errors.New("field Name of Shape is required")
+	}
+	if !b.set[1] {
+		return nil, errors.New("field Origin of Shape is required")
+	}
+
+	v := b.v
+	return &v, nil
+}
@@ -3,8 +3,6 @@
 
 package collision
 
-
-
 var StructConstant *StructCollision2 = &StructCollision2{
 	CollisionField:  false,
 	CollisionField2: "false indeed",
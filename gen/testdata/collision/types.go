@@ -8,10 +8,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"go.uber.org/thriftrw/wire"
 	"math"
 	"strconv"
 	"strings"
+
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
 )
 
 type AccessorConflict struct {
@@ -39,22 +41,19 @@ func (v *AccessorConflict) ToWire() (wire.Value, error) {
 		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("AccessorConflict is nil")
+	}
+
 	if v.Name != nil {
-		w, err = wire.NewValueString(*(v.Name)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueString(*(v.Name))
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
 	if v.GetName2 != nil {
-		w, err = wire.NewValueString(*(v.GetName2)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueString(*(v.GetName2))
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
@@ -80,28 +79,19 @@ func (v *AccessorConflict) ToWire() (wire.Value, error) {
 //   }
 //   return &v, nil
 func (v *AccessorConflict) FromWire(w wire.Value) error {
-	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
+				x := field.Value.GetString()
 				v.Name = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		case 2:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
+				x := field.Value.GetString()
 				v.GetName2 = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		}
@@ -156,26 +146,85 @@ func (v *AccessorConflict) Equals(rhs *AccessorConflict) bool {
 	return true
 }
 
+func _String_ClonePtr(p *string) *string {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this AccessorConflict.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *AccessorConflict) Clone() *AccessorConflict {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Name = _String_ClonePtr(v.Name)
+	o.GetName2 = _String_ClonePtr(v.GetName2)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AccessorConflict.
+func (v *AccessorConflict) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	if v.GetName2 != nil {
+		enc.AddString("get_name", *v.GetName2)
+	}
+	return nil
+}
+
 // GetName returns the value of Name if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil AccessorConflict.
 func (v *AccessorConflict) GetName() (o string) {
-	if v.Name != nil {
+	if v != nil && v.Name != nil {
 		return *v.Name
 	}
 
 	return
 }
 
+// IsSetName returns true if Name is not nil.
+//
+// This is safe to call on a nil AccessorConflict.
+func (v *AccessorConflict) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
 // GetGetName2 returns the value of GetName2 if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil AccessorConflict.
 func (v *AccessorConflict) GetGetName2() (o string) {
-	if v.GetName2 != nil {
+	if v != nil && v.GetName2 != nil {
 		return *v.GetName2
 	}
 
 	return
 }
 
+// IsSetGetName2 returns true if GetName2 is not nil.
+//
+// This is safe to call on a nil AccessorConflict.
+func (v *AccessorConflict) IsSetGetName2() bool {
+	return v != nil && v.GetName2 != nil
+}
+
 type AccessorNoConflict struct {
 	Getname *string `json:"getname,omitempty"`
 	GetName *string `json:"get_name,omitempty"`
@@ -201,22 +250,19 @@ func (v *AccessorNoConflict) ToWire() (wire.Value, error) {
 		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("AccessorNoConflict is nil")
+	}
+
 	if v.Getname != nil {
-		w, err = wire.NewValueString(*(v.Getname)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueString(*(v.Getname))
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
 	if v.GetName != nil {
-		w, err = wire.NewValueString(*(v.GetName)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueString(*(v.GetName))
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
@@ -242,28 +288,19 @@ func (v *AccessorNoConflict) ToWire() (wire.Value, error) {
 //   }
 //   return &v, nil
 func (v *AccessorNoConflict) FromWire(w wire.Value) error {
-	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
+				x := field.Value.GetString()
 				v.Getname = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		case 2:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
+				x := field.Value.GetString()
 				v.GetName = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		}
@@ -308,26 +345,76 @@ func (v *AccessorNoConflict) Equals(rhs *AccessorNoConflict) bool {
 	return true
 }
 
+// Clone returns a deep copy of this AccessorNoConflict.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *AccessorNoConflict) Clone() *AccessorNoConflict {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Getname = _String_ClonePtr(v.Getname)
+	o.GetName = _String_ClonePtr(v.GetName)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AccessorNoConflict.
+func (v *AccessorNoConflict) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Getname != nil {
+		enc.AddString("getname", *v.Getname)
+	}
+	if v.GetName != nil {
+		enc.AddString("get_name", *v.GetName)
+	}
+	return nil
+}
+
 // GetGetname returns the value of Getname if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil AccessorNoConflict.
 func (v *AccessorNoConflict) GetGetname() (o string) {
-	if v.Getname != nil {
+	if v != nil && v.Getname != nil {
 		return *v.Getname
 	}
 
 	return
 }
 
+// IsSetGetname returns true if Getname is not nil.
+//
+// This is safe to call on a nil AccessorNoConflict.
+func (v *AccessorNoConflict) IsSetGetname() bool {
+	return v != nil && v.Getname != nil
+}
+
 // GetGetName returns the value of GetName if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil AccessorNoConflict.
 func (v *AccessorNoConflict) GetGetName() (o string) {
-	if v.GetName != nil {
+	if v != nil && v.GetName != nil {
 		return *v.GetName
 	}
 
 	return
 }
 
+// IsSetGetName returns true if GetName is not nil.
+//
+// This is safe to call on a nil AccessorNoConflict.
+func (v *AccessorNoConflict) IsSetGetName() bool {
+	return v != nil && v.GetName != nil
+}
+
 type LittlePotatoe int64
 
 // ToWire translates LittlePotatoe into a Thrift-level intermediate
@@ -335,7 +422,7 @@ type LittlePotatoe int64
 // into bytes using a ThriftRW protocol implementation.
 func (v LittlePotatoe) ToWire() (wire.Value, error) {
 	x := (int64)(v)
-	return wire.NewValueI64(x), error(nil)
+	return wire.NewValueI64(x), nil
 }
 
 // String returns a readable string representation of LittlePotatoe.
@@ -348,9 +435,8 @@ func (v LittlePotatoe) String() string {
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 func (v *LittlePotatoe) FromWire(w wire.Value) error {
-	x, err := w.GetI64(), error(nil)
-	*v = (LittlePotatoe)(x)
-	return err
+	*v = (LittlePotatoe)(w.GetI64())
+	return nil
 }
 
 // Equals returns true if this LittlePotatoe is equal to the provided
@@ -359,6 +445,12 @@ func (lhs LittlePotatoe) Equals(rhs LittlePotatoe) bool {
 	return (lhs == rhs)
 }
 
+// Clone returns a deep copy of this LittlePotatoe.
+func (v LittlePotatoe) Clone() LittlePotatoe {
+	x := (int64)(v)
+	return (LittlePotatoe)(x)
+}
+
 type MyEnum int32
 
 const (
@@ -516,6 +608,123 @@ func (v *MyEnum) UnmarshalJSON(text []byte) error {
 	}
 }
 
+// MyEnum_NumValues is the number of distinct recognized
+// values of MyEnum.
+const MyEnum_NumValues = 5
+
+// Ordinal returns the position of this value among the distinct
+// recognized values of MyEnum or false if the value is not
+// recognized. Ordinals are less than MyEnum_NumValues.
+//
+// Ordinals may be used to index arrays of length
+// MyEnum_NumValues in place of map[MyEnum]T.
+//
+//   var counts [MyEnum_NumValues]int
+//   if i, ok := v.Ordinal(); ok {
+//     counts[i]++
+//   }
+func (v MyEnum) Ordinal() (int, bool) {
+	switch int32(v) {
+	case 123:
+		return 0, true
+	case 456:
+		return 1, true
+	case 789:
+		return 2, true
+	case 790:
+		return 3, true
+	case 791:
+		return 4, true
+	default:
+		return 0, false
+	}
+}
+
+// MyEnum_Set is a set of MyEnum values backed by a
+// bitset. The zero value is an empty set.
+type MyEnum_Set struct {
+	bits [1]uint64
+}
+
+// Add adds the given value to the set. It returns false if the value
+// is not a recognized value of MyEnum.
+func (s *MyEnum_Set) Add(v MyEnum) bool {
+	i, ok := v.Ordinal()
+	if ok {
+		s.bits[i/64] |= 1 << uint(i%64)
+	}
+	return ok
+}
+
+// Remove removes the given value from the set.
+func (s *MyEnum_Set) Remove(v MyEnum) {
+	if i, ok := v.Ordinal(); ok {
+		s.bits[i/64] &^= 1 << uint(i%64)
+	}
+}
+
+// Contains returns true if the given value is in the set.
+func (s *MyEnum_Set) Contains(v MyEnum) bool {
+	i, ok := v.Ordinal()
+	return ok && s.bits[i/64]&(1<<uint(i%64)) != 0
+}
+
+// Len returns the number of values in the set.
+func (s *MyEnum_Set) Len() int {
+	n := 0
+	for _, x := range s.bits {
+		for ; x != 0; n++ {
+			x &= x - 1
+		}
+	}
+	return n
+}
+
+// Values returns the values in the set in the order in which they
+// were declared.
+func (s *MyEnum_Set) Values() []MyEnum {
+	v := make([]MyEnum, 0, s.Len())
+	if s.bits[0]&(1<<0) != 0 {
+		v = append(v, MyEnumX)
+	}
+	if s.bits[0]&(1<<1) != 0 {
+		v = append(v, MyEnumY)
+	}
+	if s.bits[0]&(1<<2) != 0 {
+		v = append(v, MyEnumZ)
+	}
+	if s.bits[0]&(1<<3) != 0 {
+		v = append(v, MyEnumFooBar)
+	}
+	if s.bits[0]&(1<<4) != 0 {
+		v = append(v, MyEnumFooBar2)
+	}
+	return v
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MyEnum.
+//
+// Enums are logged as objects, where the value is logged with key
+// "value", and if this value's name is known, the name is logged with
+// key "name".
+func (v MyEnum) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 123:
+		enc.AddString("name", "X")
+	case 456:
+		enc.AddString("name", "Y")
+	case 789:
+		enc.AddString("name", "Z")
+	case 790:
+		enc.AddString("name", "FooBar")
+	case 791:
+		enc.AddString("name", "foo_bar")
+	}
+	return nil
+}
+
 type PrimitiveContainers struct {
 	A []string            `json:"ListOrSetOrMap,omitempty"`
 	B map[string]struct{} `json:"List_Or_SetOrMap,omitempty"`
@@ -526,12 +735,7 @@ type _List_String_ValueList []string
 
 func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
 	for _, x := range v {
-		w, err := wire.NewValueString(x), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
+		if err := f(wire.NewValueString(x)); err != nil {
 			return err
 		}
 	}
@@ -552,12 +756,7 @@ type _Set_String_ValueList map[string]struct{}
 
 func (v _Set_String_ValueList) ForEach(f func(wire.Value) error) error {
 	for x := range v {
-		w, err := wire.NewValueString(x), error(nil)
-		if err != nil {
-			return err
-		}
-
-		if err := f(w); err != nil {
+		if err := f(wire.NewValueString(x)); err != nil {
 			return err
 		}
 	}
@@ -578,17 +777,10 @@ type _Map_String_String_MapItemList map[string]string
 
 func (m _Map_String_String_MapItemList) ForEach(f func(wire.MapItem) error) error {
 	for k, v := range m {
-		kw, err := wire.NewValueString(k), error(nil)
-		if err != nil {
-			return err
-		}
+		kw := wire.NewValueString(k)
 
-		vw, err := wire.NewValueString(v), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
+		vw := wire.NewValueString(v)
+		if err := f(wire.MapItem{Key: kw, Value: vw}); err != nil {
 			return err
 		}
 	}
@@ -629,30 +821,24 @@ func (v *PrimitiveContainers) ToWire() (wire.Value, error) {
 		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("PrimitiveContainers is nil")
+	}
+
 	if v.A != nil {
-		w, err = wire.NewValueList(_List_String_ValueList(v.A)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueList(_List_String_ValueList(v.A))
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
 	if v.B != nil {
-		w, err = wire.NewValueSet(_Set_String_ValueList(v.B)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueSet(_Set_String_ValueList(v.B))
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
 	if v.C != nil {
-		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.C)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueMap(_Map_String_String_MapItemList(v.C))
 		fields[i] = wire.Field{ID: 5, Value: w}
 		i++
 	}
@@ -667,11 +853,7 @@ func _List_String_Read(l wire.ValueList) ([]string, error) {
 
 	o := make([]string, 0, l.Size())
 	err := l.ForEach(func(x wire.Value) error {
-		i, err := x.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
+		o = append(o, x.GetString())
 		return nil
 	})
 	l.Close()
@@ -685,10 +867,7 @@ func _Set_String_Read(s wire.ValueList) (map[string]struct{}, error) {
 
 	o := make(map[string]struct{}, s.Size())
 	err := s.ForEach(func(x wire.Value) error {
-		i, err := x.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
+		i := x.GetString()
 
 		o[i] = struct{}{}
 		return nil
@@ -708,15 +887,9 @@ func _Map_String_String_Read(m wire.MapItemList) (map[string]string, error) {
 
 	o := make(map[string]string, m.Size())
 	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
+		k := x.Key.GetString()
 
-		v, err := x.Value.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
+		v := x.Value.GetString()
 
 		o[k] = v
 		return nil
@@ -866,6 +1039,176 @@ func (v *PrimitiveContainers) Equals(rhs *PrimitiveContainers) bool {
 	return true
 }
 
+func _List_String_Clone(l []string) []string {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]string, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
+}
+
+func _Set_String_Clone(s map[string]struct{}) map[string]struct{} {
+	if s == nil {
+		return nil
+	}
+
+	o := make(map[string]struct{}, len(s))
+	for x := range s {
+		o[x] = struct{}{}
+	}
+
+	return o
+}
+
+func _Map_String_String_Clone(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[string]string, len(m))
+	for k, v := range m {
+		o[k] = v
+	}
+
+	return o
+}
+
+// Clone returns a deep copy of this PrimitiveContainers.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *PrimitiveContainers) Clone() *PrimitiveContainers {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.A = _List_String_Clone(v.A)
+	o.B = _Set_String_Clone(v.B)
+	o.C = _Map_String_String_Clone(v.C)
+
+	return &o
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return nil
+}
+
+type _Set_String_Zapper map[string]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_String_Zapper.
+func (s _Set_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for v := range s {
+		enc.AppendString(v)
+	}
+	return nil
+}
+
+type _Map_String_String_Zapper map[string]string
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_String_Zapper.
+func (m _Map_String_String_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for k, v := range m {
+		enc.AddString((string)(k), v)
+	}
+	return nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PrimitiveContainers.
+func (v *PrimitiveContainers) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.A != nil {
+		if err := enc.AddArray("ListOrSetOrMap", (_List_String_Zapper)(v.A)); err != nil {
+			return err
+		}
+	}
+	if v.B != nil {
+		if err := enc.AddArray("List_Or_SetOrMap", (_Set_String_Zapper)(v.B)); err != nil {
+			return err
+		}
+	}
+	if v.C != nil {
+		if err := enc.AddObject("ListOrSet_Or_Map", (_Map_String_String_Zapper)(v.C)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetA returns the value of A if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveContainers.
+func (v *PrimitiveContainers) GetA() (o []string) {
+	if v != nil && v.A != nil {
+		return v.A
+	}
+
+	return
+}
+
+// IsSetA returns true if A is not nil.
+//
+// This is safe to call on a nil PrimitiveContainers.
+func (v *PrimitiveContainers) IsSetA() bool {
+	return v != nil && v.A != nil
+}
+
+// GetB returns the value of B if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveContainers.
+func (v *PrimitiveContainers) GetB() (o map[string]struct{}) {
+	if v != nil && v.B != nil {
+		return v.B
+	}
+
+	return
+}
+
+// IsSetB returns true if B is not nil.
+//
+// This is safe to call on a nil PrimitiveContainers.
+func (v *PrimitiveContainers) IsSetB() bool {
+	return v != nil && v.B != nil
+}
+
+// GetC returns the value of C if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveContainers.
+func (v *PrimitiveContainers) GetC() (o map[string]string) {
+	if v != nil && v.C != nil {
+		return v.C
+	}
+
+	return
+}
+
+// IsSetC returns true if C is not nil.
+//
+// This is safe to call on a nil PrimitiveContainers.
+func (v *PrimitiveContainers) IsSetC() bool {
+	return v != nil && v.C != nil
+}
+
 type StructCollision struct {
 	CollisionField  bool   `json:"collisionField,required"`
 	CollisionField2 string `json:"collision_field,required"`
@@ -891,20 +1234,17 @@ func (v *StructCollision) ToWire() (wire.Value, error) {
 		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
-	w, err = wire.NewValueBool(v.CollisionField), error(nil)
-	if err != nil {
-		return w, err
+	if v == nil {
+		return wire.Value{}, errors.New("StructCollision is nil")
 	}
+
+	w = wire.NewValueBool(v.CollisionField)
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 
-	w, err = wire.NewValueString(v.CollisionField2), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueString(v.CollisionField2)
 	fields[i] = wire.Field{ID: 2, Value: w}
 	i++
 
@@ -929,7 +1269,6 @@ func (v *StructCollision) ToWire() (wire.Value, error) {
 //   }
 //   return &v, nil
 func (v *StructCollision) FromWire(w wire.Value) error {
-	var err error
 
 	collisionFieldIsSet := false
 	collision_fieldIsSet := false
@@ -938,18 +1277,12 @@ func (v *StructCollision) FromWire(w wire.Value) error {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TBool {
-				v.CollisionField, err = field.Value.GetBool(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.CollisionField = field.Value.GetBool()
 				collisionFieldIsSet = true
 			}
 		case 2:
 			if field.Value.Type() == wire.TBinary {
-				v.CollisionField2, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.CollisionField2 = field.Value.GetString()
 				collision_fieldIsSet = true
 			}
 		}
@@ -998,6 +1331,54 @@ func (v *StructCollision) Equals(rhs *StructCollision) bool {
 	return true
 }
 
+// Clone returns a deep copy of this StructCollision.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *StructCollision) Clone() *StructCollision {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of StructCollision.
+func (v *StructCollision) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddBool("collisionField", v.CollisionField)
+	enc.AddString("collision_field", v.CollisionField2)
+	return nil
+}
+
+// GetCollisionField returns the value of CollisionField if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil StructCollision.
+func (v *StructCollision) GetCollisionField() (o bool) {
+	if v != nil {
+		o = v.CollisionField
+	}
+	return
+}
+
+// GetCollisionField2 returns the value of CollisionField2 if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil StructCollision.
+func (v *StructCollision) GetCollisionField2() (o string) {
+	if v != nil {
+		o = v.CollisionField2
+	}
+	return
+}
+
 type UnionCollision struct {
 	CollisionField  *bool   `json:"collisionField,omitempty"`
 	CollisionField2 *string `json:"collision_field,omitempty"`
@@ -1023,22 +1404,19 @@ func (v *UnionCollision) ToWire() (wire.Value, error) {
 		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("UnionCollision is nil")
+	}
+
 	if v.CollisionField != nil {
-		w, err = wire.NewValueBool(*(v.CollisionField)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueBool(*(v.CollisionField))
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
 	if v.CollisionField2 != nil {
-		w, err = wire.NewValueString(*(v.CollisionField2)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueString(*(v.CollisionField2))
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
@@ -1068,28 +1446,19 @@ func (v *UnionCollision) ToWire() (wire.Value, error) {
 //   }
 //   return &v, nil
 func (v *UnionCollision) FromWire(w wire.Value) error {
-	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
+				x := field.Value.GetBool()
 				v.CollisionField = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		case 2:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
+				x := field.Value.GetString()
 				v.CollisionField2 = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		}
@@ -1155,30 +1524,100 @@ func (v *UnionCollision) Equals(rhs *UnionCollision) bool {
 	return true
 }
 
+func _Bool_ClonePtr(p *bool) *bool {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this UnionCollision.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *UnionCollision) Clone() *UnionCollision {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.CollisionField = _Bool_ClonePtr(v.CollisionField)
+	o.CollisionField2 = _String_ClonePtr(v.CollisionField2)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of UnionCollision.
+func (v *UnionCollision) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.CollisionField != nil {
+		enc.AddBool("collisionField", *v.CollisionField)
+	}
+	if v.CollisionField2 != nil {
+		enc.AddString("collision_field", *v.CollisionField2)
+	}
+	return nil
+}
+
 // GetCollisionField returns the value of CollisionField if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil UnionCollision.
 func (v *UnionCollision) GetCollisionField() (o bool) {
-	if v.CollisionField != nil {
+	if v != nil && v.CollisionField != nil {
 		return *v.CollisionField
 	}
 
 	return
 }
 
+// IsSetCollisionField returns true if CollisionField is not nil.
+//
+// This is safe to call on a nil UnionCollision.
+func (v *UnionCollision) IsSetCollisionField() bool {
+	return v != nil && v.CollisionField != nil
+}
+
 // GetCollisionField2 returns the value of CollisionField2 if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil UnionCollision.
 func (v *UnionCollision) GetCollisionField2() (o string) {
-	if v.CollisionField2 != nil {
+	if v != nil && v.CollisionField2 != nil {
 		return *v.CollisionField2
 	}
 
 	return
 }
 
+// IsSetCollisionField2 returns true if CollisionField2 is not nil.
+//
+// This is safe to call on a nil UnionCollision.
+func (v *UnionCollision) IsSetCollisionField2() bool {
+	return v != nil && v.CollisionField2 != nil
+}
+
 type WithDefault struct {
 	Pouet *StructCollision2 `json:"pouet,omitempty"`
 }
 
+// Default_WithDefault constructs a new WithDefault with its fields set to the
+// default values declared for them in the Thrift file.
+func Default_WithDefault() *WithDefault {
+	var v WithDefault
+	v.Pouet = &StructCollision2{
+		CollisionField:  false,
+		CollisionField2: "false indeed",
+	}
+	return &v
+}
+
 // ToWire translates a WithDefault struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -1202,6 +1641,10 @@ func (v *WithDefault) ToWire() (wire.Value, error) {
 		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("WithDefault is nil")
+	}
+
 	if v.Pouet == nil {
 		v.Pouet = &StructCollision2{
 			CollisionField:  false,
@@ -1298,6 +1741,58 @@ func (v *WithDefault) Equals(rhs *WithDefault) bool {
 	return true
 }
 
+// Clone returns a deep copy of this WithDefault.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *WithDefault) Clone() *WithDefault {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Pouet = v.Pouet.Clone()
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of WithDefault.
+func (v *WithDefault) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Pouet != nil {
+		if err := enc.AddObject("pouet", v.Pouet); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetPouet returns the value of Pouet if it is set or its
+// default value if it is unset.
+//
+// This is safe to call on a nil WithDefault.
+func (v *WithDefault) GetPouet() (o *StructCollision2) {
+	if v != nil && v.Pouet != nil {
+		return v.Pouet
+	}
+	o = &StructCollision2{
+		CollisionField:  false,
+		CollisionField2: "false indeed",
+	}
+	return
+}
+
+// IsSetPouet returns true if Pouet is not nil.
+//
+// This is safe to call on a nil WithDefault.
+func (v *WithDefault) IsSetPouet() bool {
+	return v != nil && v.Pouet != nil
+}
+
 type LittlePotatoe2 float64
 
 // ToWire translates LittlePotatoe2 into a Thrift-level intermediate
@@ -1305,7 +1800,7 @@ type LittlePotatoe2 float64
 // into bytes using a ThriftRW protocol implementation.
 func (v LittlePotatoe2) ToWire() (wire.Value, error) {
 	x := (float64)(v)
-	return wire.NewValueDouble(x), error(nil)
+	return wire.NewValueDouble(x), nil
 }
 
 // String returns a readable string representation of LittlePotatoe2.
@@ -1318,9 +1813,8 @@ func (v LittlePotatoe2) String() string {
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 func (v *LittlePotatoe2) FromWire(w wire.Value) error {
-	x, err := w.GetDouble(), error(nil)
-	*v = (LittlePotatoe2)(x)
-	return err
+	*v = (LittlePotatoe2)(w.GetDouble())
+	return nil
 }
 
 // Equals returns true if this LittlePotatoe2 is equal to the provided
@@ -1329,6 +1823,12 @@ func (lhs LittlePotatoe2) Equals(rhs LittlePotatoe2) bool {
 	return (lhs == rhs)
 }
 
+// Clone returns a deep copy of this LittlePotatoe2.
+func (v LittlePotatoe2) Clone() LittlePotatoe2 {
+	x := (float64)(v)
+	return (LittlePotatoe2)(x)
+}
+
 type MyEnum2 int32
 
 const (
@@ -1468,6 +1968,109 @@ func (v *MyEnum2) UnmarshalJSON(text []byte) error {
 	}
 }
 
+// MyEnum2_NumValues is the number of distinct recognized
+// values of MyEnum2.
+const MyEnum2_NumValues = 3
+
+// Ordinal returns the position of this value among the distinct
+// recognized values of MyEnum2 or false if the value is not
+// recognized. Ordinals are less than MyEnum2_NumValues.
+//
+// Ordinals may be used to index arrays of length
+// MyEnum2_NumValues in place of map[MyEnum2]T.
+//
+//   var counts [MyEnum2_NumValues]int
+//   if i, ok := v.Ordinal(); ok {
+//     counts[i]++
+//   }
+func (v MyEnum2) Ordinal() (int, bool) {
+	switch int32(v) {
+	case 12:
+		return 0, true
+	case 34:
+		return 1, true
+	case 56:
+		return 2, true
+	default:
+		return 0, false
+	}
+}
+
+// MyEnum2_Set is a set of MyEnum2 values backed by a
+// bitset. The zero value is an empty set.
+type MyEnum2_Set struct {
+	bits [1]uint64
+}
+
+// Add adds the given value to the set. It returns false if the value
+// is not a recognized value of MyEnum2.
+func (s *MyEnum2_Set) Add(v MyEnum2) bool {
+	i, ok := v.Ordinal()
+	if ok {
+		s.bits[i/64] |= 1 << uint(i%64)
+	}
+	return ok
+}
+
+// Remove removes the given value from the set.
+func (s *MyEnum2_Set) Remove(v MyEnum2) {
+	if i, ok := v.Ordinal(); ok {
+		s.bits[i/64] &^= 1 << uint(i%64)
+	}
+}
+
+// Contains returns true if the given value is in the set.
+func (s *MyEnum2_Set) Contains(v MyEnum2) bool {
+	i, ok := v.Ordinal()
+	return ok && s.bits[i/64]&(1<<uint(i%64)) != 0
+}
+
+// Len returns the number of values in the set.
+func (s *MyEnum2_Set) Len() int {
+	n := 0
+	for _, x := range s.bits {
+		for ; x != 0; n++ {
+			x &= x - 1
+		}
+	}
+	return n
+}
+
+// Values returns the values in the set in the order in which they
+// were declared.
+func (s *MyEnum2_Set) Values() []MyEnum2 {
+	v := make([]MyEnum2, 0, s.Len())
+	if s.bits[0]&(1<<0) != 0 {
+		v = append(v, MyEnum2X)
+	}
+	if s.bits[0]&(1<<1) != 0 {
+		v = append(v, MyEnum2Y)
+	}
+	if s.bits[0]&(1<<2) != 0 {
+		v = append(v, MyEnum2Z)
+	}
+	return v
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MyEnum2.
+//
+// Enums are logged as objects, where the value is logged with key
+// "value", and if this value's name is known, the name is logged with
+// key "name".
+func (v MyEnum2) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 12:
+		enc.AddString("name", "X")
+	case 34:
+		enc.AddString("name", "Y")
+	case 56:
+		enc.AddString("name", "Z")
+	}
+	return nil
+}
+
 type StructCollision2 struct {
 	CollisionField  bool   `json:"collisionField,required"`
 	CollisionField2 string `json:"collision_field,required"`
@@ -1493,20 +2096,17 @@ func (v *StructCollision2) ToWire() (wire.Value, error) {
 		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
-	w, err = wire.NewValueBool(v.CollisionField), error(nil)
-	if err != nil {
-		return w, err
+	if v == nil {
+		return wire.Value{}, errors.New("StructCollision2 is nil")
 	}
+
+	w = wire.NewValueBool(v.CollisionField)
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 
-	w, err = wire.NewValueString(v.CollisionField2), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueString(v.CollisionField2)
 	fields[i] = wire.Field{ID: 2, Value: w}
 	i++
 
@@ -1531,7 +2131,6 @@ func (v *StructCollision2) ToWire() (wire.Value, error) {
 //   }
 //   return &v, nil
 func (v *StructCollision2) FromWire(w wire.Value) error {
-	var err error
 
 	collisionFieldIsSet := false
 	collision_fieldIsSet := false
@@ -1540,18 +2139,12 @@ func (v *StructCollision2) FromWire(w wire.Value) error {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TBool {
-				v.CollisionField, err = field.Value.GetBool(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.CollisionField = field.Value.GetBool()
 				collisionFieldIsSet = true
 			}
 		case 2:
 			if field.Value.Type() == wire.TBinary {
-				v.CollisionField2, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.CollisionField2 = field.Value.GetString()
 				collision_fieldIsSet = true
 			}
 		}
@@ -1600,6 +2193,54 @@ func (v *StructCollision2) Equals(rhs *StructCollision2) bool {
 	return true
 }
 
+// Clone returns a deep copy of this StructCollision2.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *StructCollision2) Clone() *StructCollision2 {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of StructCollision2.
+func (v *StructCollision2) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddBool("collisionField", v.CollisionField)
+	enc.AddString("collision_field", v.CollisionField2)
+	return nil
+}
+
+// GetCollisionField returns the value of CollisionField if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil StructCollision2.
+func (v *StructCollision2) GetCollisionField() (o bool) {
+	if v != nil {
+		o = v.CollisionField
+	}
+	return
+}
+
+// GetCollisionField2 returns the value of CollisionField2 if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil StructCollision2.
+func (v *StructCollision2) GetCollisionField2() (o string) {
+	if v != nil {
+		o = v.CollisionField2
+	}
+	return
+}
+
 type UnionCollision2 struct {
 	CollisionField  *bool   `json:"collisionField,omitempty"`
 	CollisionField2 *string `json:"collision_field,omitempty"`
@@ -1625,22 +2266,19 @@ func (v *UnionCollision2) ToWire() (wire.Value, error) {
 		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("UnionCollision2 is nil")
+	}
+
 	if v.CollisionField != nil {
-		w, err = wire.NewValueBool(*(v.CollisionField)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueBool(*(v.CollisionField))
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
 	if v.CollisionField2 != nil {
-		w, err = wire.NewValueString(*(v.CollisionField2)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueString(*(v.CollisionField2))
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
@@ -1670,28 +2308,19 @@ func (v *UnionCollision2) ToWire() (wire.Value, error) {
 //   }
 //   return &v, nil
 func (v *UnionCollision2) FromWire(w wire.Value) error {
-	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
+				x := field.Value.GetBool()
 				v.CollisionField = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		case 2:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
+				x := field.Value.GetString()
 				v.CollisionField2 = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		}
@@ -1747,22 +2376,72 @@ func (v *UnionCollision2) Equals(rhs *UnionCollision2) bool {
 	return true
 }
 
+// Clone returns a deep copy of this UnionCollision2.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *UnionCollision2) Clone() *UnionCollision2 {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.CollisionField = _Bool_ClonePtr(v.CollisionField)
+	o.CollisionField2 = _String_ClonePtr(v.CollisionField2)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of UnionCollision2.
+func (v *UnionCollision2) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.CollisionField != nil {
+		enc.AddBool("collisionField", *v.CollisionField)
+	}
+	if v.CollisionField2 != nil {
+		enc.AddString("collision_field", *v.CollisionField2)
+	}
+	return nil
+}
+
 // GetCollisionField returns the value of CollisionField if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil UnionCollision2.
 func (v *UnionCollision2) GetCollisionField() (o bool) {
-	if v.CollisionField != nil {
+	if v != nil && v.CollisionField != nil {
 		return *v.CollisionField
 	}
 
 	return
 }
 
+// IsSetCollisionField returns true if CollisionField is not nil.
+//
+// This is safe to call on a nil UnionCollision2.
+func (v *UnionCollision2) IsSetCollisionField() bool {
+	return v != nil && v.CollisionField != nil
+}
+
 // GetCollisionField2 returns the value of CollisionField2 if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil UnionCollision2.
 func (v *UnionCollision2) GetCollisionField2() (o string) {
-	if v.CollisionField2 != nil {
+	if v != nil && v.CollisionField2 != nil {
 		return *v.CollisionField2
 	}
 
 	return
 }
+
+// IsSetCollisionField2 returns true if CollisionField2 is not nil.
+//
+// This is safe to call on a nil UnionCollision2.
+func (v *UnionCollision2) IsSetCollisionField2() bool {
+	return v != nil && v.CollisionField2 != nil
+}
@@ -0,0 +1,18 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package compact_code
+
+import "go.uber.org/thriftrw/thriftreflect"
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:            "compact_code",
+	Package:         "go.uber.org/thriftrw/gen/testdata/compact_code",
+	FilePath:        "compact_code.thrift",
+	SHA1:            "7b5117958370e4100bd1f7bc1edc88d4d49fed57",
+	ThriftRWVersion: "1.9.0",
+	Raw:             rawIDL,
+}
+
+const rawIDL = "// Code for this file is generated with --compact-code.\n\nenum Color {\n    RED, GREEN, BLUE\n}\n\nstruct Point {\n    1: required double x\n    2: required double y\n}\n\ntypedef list<Point> Path\n\nstruct Shapes {\n    1: optional list<Point> points\n    2: optional Path path\n    3: optional list<list<i32>> matrix\n    4: optional set<string> tags\n    5: optional set<Point> uniquePoints\n    6: optional set<binary> blobs\n    7: optional map<string, Point> named\n    8: optional map<Point, Color> colors\n    9: optional map<Color, list<Point>> byColor\n}\n\nunion Shape {\n    1: list<Point> polygon\n    2: map<string, double> properties\n}\n\nstruct Node {\n    3: required string name\n    1: optional Node child\n    2: optional i32 weight = 1\n}\n\nexception TooManyNodes {\n    1: optional string message\n}\n"
@@ -0,0 +1,2667 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package compact_code
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/runtime"
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
+)
+
+type Color int32
+
+const (
+	ColorRed   Color = 0
+	ColorGreen Color = 1
+	ColorBlue  Color = 2
+)
+
+// Color_Values returns all recognized values of Color.
+func Color_Values() []Color {
+	return []Color{
+		ColorRed,
+		ColorGreen,
+		ColorBlue,
+	}
+}
+
+// UnmarshalText tries to decode Color from a byte slice
+// containing its name.
+//
+//   var v Color
+//   err := v.UnmarshalText([]byte("RED"))
+func (v *Color) UnmarshalText(value []byte) error {
+	switch string(value) {
+	case "RED":
+		*v = ColorRed
+		return nil
+	case "GREEN":
+		*v = ColorGreen
+		return nil
+	case "BLUE":
+		*v = ColorBlue
+		return nil
+	default:
+		return fmt.Errorf("unknown enum value %q for %q", value, "Color")
+	}
+}
+
+// ToWire translates Color into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v Color) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes Color from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return Color(0), err
+//   }
+//
+//   var v Color
+//   if err := v.FromWire(x); err != nil {
+//     return Color(0), err
+//   }
+//   return v, nil
+func (v *Color) FromWire(w wire.Value) error {
+	*v = (Color)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of Color.
+func (v Color) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "RED"
+	case 1:
+		return "GREEN"
+	case 2:
+		return "BLUE"
+	}
+	return fmt.Sprintf("Color(%d)", w)
+}
+
+// Equals returns true if this Color value matches the provided
+// value.
+func (v Color) Equals(rhs Color) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes Color into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v Color) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"RED\""), nil
+	case 1:
+		return ([]byte)("\"GREEN\""), nil
+	case 2:
+		return ([]byte)("\"BLUE\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode Color from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *Color) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Color")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Color")
+		}
+		*v = (Color)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Color")
+	}
+}
+
+// Color_NumValues is the number of distinct recognized
+// values of Color.
+const Color_NumValues = 3
+
+// Ordinal returns the position of this value among the distinct
+// recognized values of Color or false if the value is not
+// recognized. Ordinals are less than Color_NumValues.
+//
+// Ordinals may be used to index arrays of length
+// Color_NumValues in place of map[Color]T.
+//
+//   var counts [Color_NumValues]int
+//   if i, ok := v.Ordinal(); ok {
+//     counts[i]++
+//   }
+func (v Color) Ordinal() (int, bool) {
+	switch int32(v) {
+	case 0:
+		return 0, true
+	case 1:
+		return 1, true
+	case 2:
+		return 2, true
+	default:
+		return 0, false
+	}
+}
+
+// Color_Set is a set of Color values backed by a
+// bitset. The zero value is an empty set.
+type Color_Set struct {
+	bits [1]uint64
+}
+
+// Add adds the given value to the set. It returns false if the value
+// is not a recognized value of Color.
+func (s *Color_Set) Add(v Color) bool {
+	i, ok := v.Ordinal()
+	if ok {
+		s.bits[i/64] |= 1 << uint(i%64)
+	}
+	return ok
+}
+
+// Remove removes the given value from the set.
+func (s *Color_Set) Remove(v Color) {
+	if i, ok := v.Ordinal(); ok {
+		s.bits[i/64] &^= 1 << uint(i%64)
+	}
+}
+
+// Contains returns true if the given value is in the set.
+func (s *Color_Set) Contains(v Color) bool {
+	i, ok := v.Ordinal()
+	return ok && s.bits[i/64]&(1<<uint(i%64)) != 0
+}
+
+// Len returns the number of values in the set.
+func (s *Color_Set) Len() int {
+	n := 0
+	for _, x := range s.bits {
+		for ; x != 0; n++ {
+			x &= x - 1
+		}
+	}
+	return n
+}
+
+// Values returns the values in the set in the order in which they
+// were declared.
+func (s *Color_Set) Values() []Color {
+	v := make([]Color, 0, s.Len())
+	if s.bits[0]&(1<<0) != 0 {
+		v = append(v, ColorRed)
+	}
+	if s.bits[0]&(1<<1) != 0 {
+		v = append(v, ColorGreen)
+	}
+	if s.bits[0]&(1<<2) != 0 {
+		v = append(v, ColorBlue)
+	}
+	return v
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Color.
+//
+// Enums are logged as objects, where the value is logged with key
+// "value", and if this value's name is known, the name is logged with
+// key "name".
+func (v Color) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "RED")
+	case 1:
+		enc.AddString("name", "GREEN")
+	case 2:
+		enc.AddString("name", "BLUE")
+	}
+	return nil
+}
+
+type Node struct {
+	Name   string `json:"name,required"`
+	Child  *Node  `json:"child,omitempty"`
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+// Default_Node constructs a new Node with its fields set to the
+// default values declared for them in the Thrift file.
+func Default_Node() *Node {
+	var v Node
+	v.Weight = ptr.Int32(1)
+	return &v
+}
+
+// ToWire translates a Node struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Node) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Node is nil")
+	}
+
+	w = wire.NewValueString(v.Name)
+	fields[i] = wire.Field{ID: 3, Value: w}
+	i++
+	if v.Child != nil {
+		w, err = v.Child.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Weight == nil {
+		v.Weight = ptr.Int32(1)
+	}
+	{
+		w = wire.NewValueI32(*(v.Weight))
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Node_Read(w wire.Value) (*Node, error) {
+	var v Node
+	err := v.FromWire(w)
+	return &v, err
+}
+
+var _Node_fieldReaders runtime.FieldReaders
+
+func init() {
+	_Node_fieldReaders = runtime.FieldReaders{
+		{
+			ID:   1,
+			Type: wire.TStruct,
+			Read: func(s interface{}, value wire.Value) (err error) {
+				v := s.(*Node)
+				v.Child, err = _Node_Read(value)
+				if err != nil {
+					return err
+				}
+				return nil
+			},
+		},
+		{
+			ID:   2,
+			Type: wire.TI32,
+			Read: func(s interface{}, value wire.Value) (err error) {
+				v := s.(*Node)
+				x := value.GetI32()
+				v.Weight = &x
+				return nil
+			},
+		},
+		{
+			ID:   3,
+			Type: wire.TBinary,
+			Read: func(s interface{}, value wire.Value) (err error) {
+				v := s.(*Node)
+				v.Name = value.GetString()
+				return nil
+			},
+		},
+	}
+}
+
+// FromWire deserializes a Node struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Node struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Node
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Node) FromWire(w wire.Value) error {
+	var isSet [3]bool
+	if err := _Node_fieldReaders.Read(v, w.GetStruct(), isSet[:], nil); err != nil {
+		return err
+	}
+
+	if v.Weight == nil {
+		v.Weight = ptr.Int32(1)
+	}
+
+	if !isSet[2] {
+		return errors.New("field Name of Node is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Node
+// struct.
+func (v *Node) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	if v.Child != nil {
+		fields[i] = fmt.Sprintf("Child: %v", v.Child)
+		i++
+	}
+	if v.Weight != nil {
+		fields[i] = fmt.Sprintf("Weight: %v", *(v.Weight))
+		i++
+	}
+
+	return fmt.Sprintf("Node{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Node match the
+// provided Node.
+//
+// This function performs a deep comparison.
+func (v *Node) Equals(rhs *Node) bool {
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !((v.Child == nil && rhs.Child == nil) || (v.Child != nil && rhs.Child != nil && v.Child.Equals(rhs.Child))) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Weight, rhs.Weight) {
+		return false
+	}
+
+	return true
+}
+
+func _I32_ClonePtr(p *int32) *int32 {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this Node.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Node) Clone() *Node {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Child = v.Child.Clone()
+	o.Weight = _I32_ClonePtr(v.Weight)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Node.
+func (v *Node) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("name", v.Name)
+	if v.Child != nil {
+		if err := enc.AddObject("child", v.Child); err != nil {
+			return err
+		}
+	}
+	if v.Weight != nil {
+		enc.AddInt32("weight", *v.Weight)
+	}
+	return nil
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Node.
+func (v *Node) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetChild returns the value of Child if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Node.
+func (v *Node) GetChild() (o *Node) {
+	if v != nil && v.Child != nil {
+		return v.Child
+	}
+
+	return
+}
+
+// IsSetChild returns true if Child is not nil.
+//
+// This is safe to call on a nil Node.
+func (v *Node) IsSetChild() bool {
+	return v != nil && v.Child != nil
+}
+
+// GetWeight returns the value of Weight if it is set or its
+// default value if it is unset.
+//
+// This is safe to call on a nil Node.
+func (v *Node) GetWeight() (o int32) {
+	if v != nil && v.Weight != nil {
+		return *v.Weight
+	}
+	o = 1
+	return
+}
+
+// IsSetWeight returns true if Weight is not nil.
+//
+// This is safe to call on a nil Node.
+func (v *Node) IsSetWeight() bool {
+	return v != nil && v.Weight != nil
+}
+
+func _List_Point_ValueList(v []*Point) runtime.List {
+	return runtime.List{
+		Type: wire.TStruct,
+		Len:  len(v),
+		Item: func(i int) (wire.Value, error) {
+			x := v[i]
+			if x == nil {
+				return wire.Value{}, fmt.Errorf("invalid [%v]: value is nil", i)
+			}
+			return x.ToWire()
+		},
+	}
+}
+
+func _Point_Read(w wire.Value) (*Point, error) {
+	var v Point
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_Point_Read(l wire.ValueList) ([]*Point, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Point, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Point_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _List_Point_Equals(lhs, rhs []*Point) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_Point_Clone(l []*Point) []*Point {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]*Point, len(l))
+	for i, x := range l {
+		o[i] = x.Clone()
+	}
+	return o
+}
+
+type Path []*Point
+
+// ToWire translates Path into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Path) ToWire() (wire.Value, error) {
+	x := ([]*Point)(v)
+	return wire.NewValueList(_List_Point_ValueList(x)), nil
+}
+
+// String returns a readable string representation of Path.
+func (v Path) String() string {
+	x := ([]*Point)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes Path from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Path) FromWire(w wire.Value) error {
+	x, err := _List_Point_Read(w.GetList())
+	*v = (Path)(x)
+	return err
+}
+
+// Equals returns true if this Path is equal to the provided
+// Path.
+func (lhs Path) Equals(rhs Path) bool {
+	return _List_Point_Equals(lhs, rhs)
+}
+
+// Clone returns a deep copy of this Path.
+func (v Path) Clone() Path {
+	x := ([]*Point)(v)
+	return (Path)(_List_Point_Clone(x))
+}
+
+type _List_Point_Zapper []*Point
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Point_Zapper.
+func (l _List_Point_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		if err := enc.AppendObject(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of Path.
+func (v Path) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	x := ([]*Point)(v)
+	return (_List_Point_Zapper)(x).MarshalLogArray(enc)
+}
+
+type Point struct {
+	X float64 `json:"x,required"`
+	Y float64 `json:"y,required"`
+}
+
+// ToWire translates a Point struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Point) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Point is nil")
+	}
+
+	w = wire.NewValueDouble(v.X)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w = wire.NewValueDouble(v.Y)
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+var _Point_fieldReaders runtime.FieldReaders
+
+func init() {
+	_Point_fieldReaders = runtime.FieldReaders{
+		{
+			ID:   1,
+			Type: wire.TDouble,
+			Read: func(s interface{}, value wire.Value) (err error) {
+				v := s.(*Point)
+				v.X = value.GetDouble()
+				return nil
+			},
+		},
+		{
+			ID:   2,
+			Type: wire.TDouble,
+			Read: func(s interface{}, value wire.Value) (err error) {
+				v := s.(*Point)
+				v.Y = value.GetDouble()
+				return nil
+			},
+		},
+	}
+}
+
+// FromWire deserializes a Point struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Point struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Point
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Point) FromWire(w wire.Value) error {
+	var isSet [2]bool
+	if err := _Point_fieldReaders.Read(v, w.GetStruct(), isSet[:], nil); err != nil {
+		return err
+	}
+
+	if !isSet[0] {
+		return errors.New("field X of Point is required")
+	}
+
+	if !isSet[1] {
+		return errors.New("field Y of Point is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Point
+// struct.
+func (v *Point) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("X: %v", v.X)
+	i++
+	fields[i] = fmt.Sprintf("Y: %v", v.Y)
+	i++
+
+	return fmt.Sprintf("Point{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Point match the
+// provided Point.
+//
+// This function performs a deep comparison.
+func (v *Point) Equals(rhs *Point) bool {
+	if !(v.X == rhs.X) {
+		return false
+	}
+	if !(v.Y == rhs.Y) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this Point.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Point) Clone() *Point {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Point.
+func (v *Point) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddFloat64("x", v.X)
+	enc.AddFloat64("y", v.Y)
+	return nil
+}
+
+// GetX returns the value of X if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Point.
+func (v *Point) GetX() (o float64) {
+	if v != nil {
+		o = v.X
+	}
+	return
+}
+
+// GetY returns the value of Y if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Point.
+func (v *Point) GetY() (o float64) {
+	if v != nil {
+		o = v.Y
+	}
+	return
+}
+
+type Shape struct {
+	Polygon    []*Point           `json:"polygon,omitempty"`
+	Properties map[string]float64 `json:"properties,omitempty"`
+}
+
+func _Map_String_Double_MapItemList(m map[string]float64) runtime.Map {
+	return runtime.Map{
+		Key:   wire.TBinary,
+		Value: wire.TDouble,
+		Len:   len(m),
+		Range: func(f func(wire.MapItem) error) error {
+			for k, v := range m {
+				kw := wire.NewValueString(k)
+
+				vw := wire.NewValueDouble(v)
+				if err := f(wire.MapItem{Key: kw, Value: vw}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// ToWire translates a Shape struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Shape) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Shape is nil")
+	}
+
+	if v.Polygon != nil {
+		w = wire.NewValueList(_List_Point_ValueList(v.Polygon))
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Properties != nil {
+		w = wire.NewValueMap(_Map_String_Double_MapItemList(v.Properties))
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Shape should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Map_String_Double_Read(m wire.MapItemList) (map[string]float64, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TDouble {
+		return nil, nil
+	}
+
+	o := make(map[string]float64, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k := x.Key.GetString()
+
+		v := x.Value.GetDouble()
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+var _Shape_fieldReaders runtime.FieldReaders
+
+func init() {
+	_Shape_fieldReaders = runtime.FieldReaders{
+		{
+			ID:   1,
+			Type: wire.TList,
+			Read: func(s interface{}, value wire.Value) (err error) {
+				v := s.(*Shape)
+				v.Polygon, err = _List_Point_Read(value.GetList())
+				if err != nil {
+					return err
+				}
+				return nil
+			},
+		},
+		{
+			ID:   2,
+			Type: wire.TMap,
+			Read: func(s interface{}, value wire.Value) (err error) {
+				v := s.(*Shape)
+				v.Properties, err = _Map_String_Double_Read(value.GetMap())
+				if err != nil {
+					return err
+				}
+				return nil
+			},
+		},
+	}
+}
+
+// FromWire deserializes a Shape struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Shape struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Shape
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Shape) FromWire(w wire.Value) error {
+	if err := _Shape_fieldReaders.Read(v, w.GetStruct(), nil, nil); err != nil {
+		return err
+	}
+
+	count := 0
+	if v.Polygon != nil {
+		count++
+	}
+	if v.Properties != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Shape should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Shape
+// struct.
+func (v *Shape) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Polygon != nil {
+		fields[i] = fmt.Sprintf("Polygon: %v", v.Polygon)
+		i++
+	}
+	if v.Properties != nil {
+		fields[i] = fmt.Sprintf("Properties: %v", v.Properties)
+		i++
+	}
+
+	return fmt.Sprintf("Shape{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Map_String_Double_Equals(lhs, rhs map[string]float64) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this Shape match the
+// provided Shape.
+//
+// This function performs a deep comparison.
+func (v *Shape) Equals(rhs *Shape) bool {
+	if !((v.Polygon == nil && rhs.Polygon == nil) || (v.Polygon != nil && rhs.Polygon != nil && _List_Point_Equals(v.Polygon, rhs.Polygon))) {
+		return false
+	}
+	if !((v.Properties == nil && rhs.Properties == nil) || (v.Properties != nil && rhs.Properties != nil && _Map_String_Double_Equals(v.Properties, rhs.Properties))) {
+		return false
+	}
+
+	return true
+}
+
+func _Map_String_Double_Clone(m map[string]float64) map[string]float64 {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[string]float64, len(m))
+	for k, v := range m {
+		o[k] = v
+	}
+
+	return o
+}
+
+// Clone returns a deep copy of this Shape.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Shape) Clone() *Shape {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Polygon = _List_Point_Clone(v.Polygon)
+	o.Properties = _Map_String_Double_Clone(v.Properties)
+
+	return &o
+}
+
+type _Map_String_Double_Zapper map[string]float64
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_Double_Zapper.
+func (m _Map_String_Double_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for k, v := range m {
+		enc.AddFloat64((string)(k), v)
+	}
+	return nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Shape.
+func (v *Shape) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Polygon != nil {
+		if err := enc.AddArray("polygon", (_List_Point_Zapper)(v.Polygon)); err != nil {
+			return err
+		}
+	}
+	if v.Properties != nil {
+		if err := enc.AddObject("properties", (_Map_String_Double_Zapper)(v.Properties)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetPolygon returns the value of Polygon if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Shape.
+func (v *Shape) GetPolygon() (o []*Point) {
+	if v != nil && v.Polygon != nil {
+		return v.Polygon
+	}
+
+	return
+}
+
+// IsSetPolygon returns true if Polygon is not nil.
+//
+// This is safe to call on a nil Shape.
+func (v *Shape) IsSetPolygon() bool {
+	return v != nil && v.Polygon != nil
+}
+
+// GetProperties returns the value of Properties if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Shape.
+func (v *Shape) GetProperties() (o map[string]float64) {
+	if v != nil && v.Properties != nil {
+		return v.Properties
+	}
+
+	return
+}
+
+// IsSetProperties returns true if Properties is not nil.
+//
+// This is safe to call on a nil Shape.
+func (v *Shape) IsSetProperties() bool {
+	return v != nil && v.Properties != nil
+}
+
+type Shapes struct {
+	Points       []*Point            `json:"points,omitempty"`
+	Path         Path                `json:"path,omitempty"`
+	Matrix       [][]int32           `json:"matrix,omitempty"`
+	Tags         map[string]struct{} `json:"tags,omitempty"`
+	UniquePoints []*Point            `json:"uniquePoints,omitempty"`
+	Blobs        [][]byte            `json:"blobs,omitempty"`
+	Named        map[string]*Point   `json:"named,omitempty"`
+	Colors       []struct {
+		Key   *Point
+		Value Color
+	} `json:"colors,omitempty"`
+	ByColor map[Color][]*Point `json:"byColor,omitempty"`
+}
+
+func _List_I32_ValueList(v []int32) runtime.List {
+	return runtime.List{
+		Type: wire.TI32,
+		Len:  len(v),
+		Item: func(i int) (wire.Value, error) {
+			x := v[i]
+			return wire.NewValueI32(x), nil
+		},
+	}
+}
+
+func _List_List_I32_ValueList(v [][]int32) runtime.List {
+	return runtime.List{
+		Type: wire.TList,
+		Len:  len(v),
+		Item: func(i int) (wire.Value, error) {
+			x := v[i]
+			if x == nil {
+				return wire.Value{}, fmt.Errorf("invalid [%v]: value is nil", i)
+			}
+			return wire.NewValueList(_List_I32_ValueList(x)), nil
+		},
+	}
+}
+
+func _Set_String_ValueList(v map[string]struct{}) runtime.Set {
+	return runtime.Set{
+		Type: wire.TBinary,
+		Len:  len(v),
+		Range: func(f func(wire.Value) error) error {
+			for x := range v {
+				if err := f(wire.NewValueString(x)); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func _Set_Point_ValueList(v []*Point) runtime.List {
+	return runtime.List{
+		Type: wire.TStruct,
+		Len:  len(v),
+		Item: func(i int) (wire.Value, error) {
+			x := v[i]
+			if x == nil {
+				return wire.Value{}, fmt.Errorf("invalid set item: value is nil")
+			}
+			return x.ToWire()
+		},
+	}
+}
+
+func _Set_Binary_ValueList(v [][]byte) runtime.List {
+	return runtime.List{
+		Type: wire.TBinary,
+		Len:  len(v),
+		Item: func(i int) (wire.Value, error) {
+			x := v[i]
+			if x == nil {
+				return wire.Value{}, fmt.Errorf("invalid set item: value is nil")
+			}
+			return wire.NewValueBinary(x), nil
+		},
+	}
+}
+
+func _Map_String_Point_MapItemList(m map[string]*Point) runtime.Map {
+	return runtime.Map{
+		Key:   wire.TBinary,
+		Value: wire.TStruct,
+		Len:   len(m),
+		Range: func(f func(wire.MapItem) error) error {
+			for k, v := range m {
+				if v == nil {
+					return fmt.Errorf("invalid [%v]: value is nil", k)
+				}
+				kw := wire.NewValueString(k)
+
+				vw, err := v.ToWire()
+				if err != nil {
+					return err
+				}
+				if err = f(wire.MapItem{Key: kw, Value: vw}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func _Map_Point_Color_MapItemList(m []struct {
+	Key   *Point
+	Value Color
+}) runtime.Map {
+	return runtime.Map{
+		Key:   wire.TStruct,
+		Value: wire.TI32,
+		Len:   len(m),
+		Range: func(f func(wire.MapItem) error) error {
+			for _, i := range m {
+				k := i.Key
+				v := i.Value
+				if k == nil {
+					return fmt.Errorf("invalid map key: value is nil")
+				}
+				kw, err := k.ToWire()
+				if err != nil {
+					return err
+				}
+
+				vw, err := v.ToWire()
+				if err != nil {
+					return err
+				}
+				if err = f(wire.MapItem{Key: kw, Value: vw}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+func _Map_Color_List_Point_MapItemList(m map[Color][]*Point) runtime.Map {
+	return runtime.Map{
+		Key:   wire.TI32,
+		Value: wire.TList,
+		Len:   len(m),
+		Range: func(f func(wire.MapItem) error) error {
+			for k, v := range m {
+				if v == nil {
+					return fmt.Errorf("invalid [%v]: value is nil", k)
+				}
+				kw, err := k.ToWire()
+				if err != nil {
+					return err
+				}
+
+				vw := wire.NewValueList(_List_Point_ValueList(v))
+				if err = f(wire.MapItem{Key: kw, Value: vw}); err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// ToWire translates a Shapes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Shapes) ToWire() (wire.Value, error) {
+	var (
+		fields [9]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Shapes is nil")
+	}
+
+	if v.Points != nil {
+		w = wire.NewValueList(_List_Point_ValueList(v.Points))
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Path != nil {
+		w, err = v.Path.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Matrix != nil {
+		w = wire.NewValueList(_List_List_I32_ValueList(v.Matrix))
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Tags != nil {
+		w = wire.NewValueSet(_Set_String_ValueList(v.Tags))
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.UniquePoints != nil {
+		w = wire.NewValueSet(_Set_Point_ValueList(v.UniquePoints))
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.Blobs != nil {
+		w = wire.NewValueSet(_Set_Binary_ValueList(v.Blobs))
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.Named != nil {
+		w = wire.NewValueMap(_Map_String_Point_MapItemList(v.Named))
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.Colors != nil {
+		w = wire.NewValueMap(_Map_Point_Color_MapItemList(v.Colors))
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+	if v.ByColor != nil {
+		w = wire.NewValueMap(_Map_Color_List_Point_MapItemList(v.ByColor))
+		fields[i] = wire.Field{ID: 9, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Path_Read(w wire.Value) (Path, error) {
+	var x Path
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _List_I32_Read(l wire.ValueList) ([]int32, error) {
+	if l.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make([]int32, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		o = append(o, x.GetI32())
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _List_List_I32_Read(l wire.ValueList) ([][]int32, error) {
+	if l.ValueType() != wire.TList {
+		return nil, nil
+	}
+
+	o := make([][]int32, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _List_I32_Read(x.GetList())
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Set_String_Read(s wire.ValueList) (map[string]struct{}, error) {
+	if s.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[string]struct{}, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i := x.GetString()
+
+		o[i] = struct{}{}
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+func _Set_Point_Read(s wire.ValueList) ([]*Point, error) {
+	if s.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Point, 0, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := _Point_Read(x)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, i)
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+func _Set_Binary_Read(s wire.ValueList) ([][]byte, error) {
+	if s.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([][]byte, 0, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i := x.GetBinary()
+
+		o = append(o, i)
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+func _Map_String_Point_Read(m wire.MapItemList) (map[string]*Point, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]*Point, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k := x.Key.GetString()
+
+		v, err := _Point_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Color_Read(w wire.Value) (Color, error) {
+	var v Color
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _Map_Point_Color_Read(m wire.MapItemList) ([]struct {
+	Key   *Point
+	Value Color
+}, error) {
+	if m.KeyType() != wire.TStruct {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make([]struct {
+		Key   *Point
+		Value Color
+	}, 0, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := _Point_Read(x.Key)
+		if err != nil {
+			return err
+		}
+
+		v, err := _Color_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, struct {
+			Key   *Point
+			Value Color
+		}{k, v})
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Map_Color_List_Point_Read(m wire.MapItemList) (map[Color][]*Point, error) {
+	if m.KeyType() != wire.TI32 {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TList {
+		return nil, nil
+	}
+
+	o := make(map[Color][]*Point, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := _Color_Read(x.Key)
+		if err != nil {
+			return err
+		}
+
+		v, err := _List_Point_Read(x.Value.GetList())
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+var _Shapes_fieldReaders runtime.FieldReaders
+
+func init() {
+	_Shapes_fieldReaders = runtime.FieldReaders{
+		{
+			ID:   1,
+			Type: wire.TList,
+			Read: func(s interface{}, value wire.Value) (err error) {
+				v := s.(*Shapes)
+				v.Points, err = _List_Point_Read(value.GetList())
+				if err != nil {
+					return err
+				}
+				return nil
+			},
+		},
+		{
+			ID:   2,
+			Type: wire.TList,
+			Read: func(s interface{}, value wire.Value) (err error) {
+				v := s.(*Shapes)
+				v.Path, err = _Path_Read(value)
+				if err != nil {
+					return err
+				}
+				return nil
+			},
+		},
+		{
+			ID:   3,
+			Type: wire.TList,
+			Read: func(s interface{}, value wire.Value) (err error) {
+				v := s.(*Shapes)
+				v.Matrix, err = _List_List_I32_Read(value.GetList())
+				if err != nil {
+					return err
+				}
+				return nil
+			},
+		},
+		{
+			ID:   4,
+			Type: wire.TSet,
+			Read: func(s interface{}, value wire.Value) (err error) {
+				v := s.(*Shapes)
+				v.Tags, err = _Set_String_Read(value.GetSet())
+				if err != nil {
+					return err
+				}
+				return nil
+			},
+		},
+		{
+			ID:   5,
+			Type: wire.TSet,
+			Read: func(s interface{}, value wire.Value) (err error) {
+				v := s.(*Shapes)
+				v.UniquePoints, err = _Set_Point_Read(value.GetSet())
+				if err != nil {
+					return err
+				}
+				return nil
+			},
+		},
+		{
+			ID:   6,
+			Type: wire.TSet,
+			Read: func(s interface{}, value wire.Value) (err error) {
+				v := s.(*Shapes)
+				v.Blobs, err = _Set_Binary_Read(value.GetSet())
+				if err != nil {
+					return err
+				}
+				return nil
+			},
+		},
+		{
+			ID:   7,
+			Type: wire.TMap,
+			Read: func(s interface{}, value wire.Value) (err error) {
+				v := s.(*Shapes)
+				v.Named, err = _Map_String_Point_Read(value.GetMap())
+				if err != nil {
+					return err
+				}
+				return nil
+			},
+		},
+		{
+			ID:   8,
+			Type: wire.TMap,
+			Read: func(s interface{}, value wire.Value) (err error) {
+				v := s.(*Shapes)
+				v.Colors, err = _Map_Point_Color_Read(value.GetMap())
+				if err != nil {
+					return err
+				}
+				return nil
+			},
+		},
+		{
+			ID:   9,
+			Type: wire.TMap,
+			Read: func(s interface{}, value wire.Value) (err error) {
+				v := s.(*Shapes)
+				v.ByColor, err = _Map_Color_List_Point_Read(value.GetMap())
+				if err != nil {
+					return err
+				}
+				return nil
+			},
+		},
+	}
+}
+
+// FromWire deserializes a Shapes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Shapes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Shapes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Shapes) FromWire(w wire.Value) error {
+	if err := _Shapes_fieldReaders.Read(v, w.GetStruct(), nil, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Shapes
+// struct.
+func (v *Shapes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [9]string
+	i := 0
+	if v.Points != nil {
+		fields[i] = fmt.Sprintf("Points: %v", v.Points)
+		i++
+	}
+	if v.Path != nil {
+		fields[i] = fmt.Sprintf("Path: %v", v.Path)
+		i++
+	}
+	if v.Matrix != nil {
+		fields[i] = fmt.Sprintf("Matrix: %v", v.Matrix)
+		i++
+	}
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+	if v.UniquePoints != nil {
+		fields[i] = fmt.Sprintf("UniquePoints: %v", v.UniquePoints)
+		i++
+	}
+	if v.Blobs != nil {
+		fields[i] = fmt.Sprintf("Blobs: %v", v.Blobs)
+		i++
+	}
+	if v.Named != nil {
+		fields[i] = fmt.Sprintf("Named: %v", v.Named)
+		i++
+	}
+	if v.Colors != nil {
+		fields[i] = fmt.Sprintf("Colors: %v", v.Colors)
+		i++
+	}
+	if v.ByColor != nil {
+		fields[i] = fmt.Sprintf("ByColor: %v", v.ByColor)
+		i++
+	}
+
+	return fmt.Sprintf("Shapes{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_I32_Equals(lhs, rhs []int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_List_I32_Equals(lhs, rhs [][]int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !_List_I32_Equals(lv, rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Set_String_Equals(lhs, rhs map[string]struct{}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for x := range rhs {
+		if _, ok := lhs[x]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Set_Point_Equals(lhs, rhs []*Point) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, x := range lhs {
+		ok := false
+		for _, y := range rhs {
+			if x.Equals(y) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Set_Binary_Equals(lhs, rhs [][]byte) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, x := range lhs {
+		ok := false
+		for _, y := range rhs {
+			if bytes.Equal(x, y) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_Point_Equals(lhs, rhs map[string]*Point) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_Point_Color_Equals(lhs, rhs []struct {
+	Key   *Point
+	Value Color
+}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, i := range lhs {
+		lk := i.Key
+		lv := i.Value
+		ok := false
+		for _, j := range rhs {
+			rk := j.Key
+			rv := j.Value
+			if !lk.Equals(rk) {
+				continue
+			}
+
+			if !lv.Equals(rv) {
+				return false
+			}
+			ok = true
+			break
+		}
+
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_Color_List_Point_Equals(lhs, rhs map[Color][]*Point) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !_List_Point_Equals(lv, rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this Shapes match the
+// provided Shapes.
+//
+// This function performs a deep comparison.
+func (v *Shapes) Equals(rhs *Shapes) bool {
+	if !((v.Points == nil && rhs.Points == nil) || (v.Points != nil && rhs.Points != nil && _List_Point_Equals(v.Points, rhs.Points))) {
+		return false
+	}
+	if !((v.Path == nil && rhs.Path == nil) || (v.Path != nil && rhs.Path != nil && v.Path.Equals(rhs.Path))) {
+		return false
+	}
+	if !((v.Matrix == nil && rhs.Matrix == nil) || (v.Matrix != nil && rhs.Matrix != nil && _List_List_I32_Equals(v.Matrix, rhs.Matrix))) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _Set_String_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+	if !((v.UniquePoints == nil && rhs.UniquePoints == nil) || (v.UniquePoints != nil && rhs.UniquePoints != nil && _Set_Point_Equals(v.UniquePoints, rhs.UniquePoints))) {
+		return false
+	}
+	if !((v.Blobs == nil && rhs.Blobs == nil) || (v.Blobs != nil && rhs.Blobs != nil && _Set_Binary_Equals(v.Blobs, rhs.Blobs))) {
+		return false
+	}
+	if !((v.Named == nil && rhs.Named == nil) || (v.Named != nil && rhs.Named != nil && _Map_String_Point_Equals(v.Named, rhs.Named))) {
+		return false
+	}
+	if !((v.Colors == nil && rhs.Colors == nil) || (v.Colors != nil && rhs.Colors != nil && _Map_Point_Color_Equals(v.Colors, rhs.Colors))) {
+		return false
+	}
+	if !((v.ByColor == nil && rhs.ByColor == nil) || (v.ByColor != nil && rhs.ByColor != nil && _Map_Color_List_Point_Equals(v.ByColor, rhs.ByColor))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_I32_Clone(l []int32) []int32 {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]int32, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
+}
+
+func _List_List_I32_Clone(l [][]int32) [][]int32 {
+	if l == nil {
+		return nil
+	}
+
+	o := make([][]int32, len(l))
+	for i, x := range l {
+		o[i] = _List_I32_Clone(x)
+	}
+	return o
+}
+
+func _Set_String_Clone(s map[string]struct{}) map[string]struct{} {
+	if s == nil {
+		return nil
+	}
+
+	o := make(map[string]struct{}, len(s))
+	for x := range s {
+		o[x] = struct{}{}
+	}
+
+	return o
+}
+
+func _Set_Point_Clone(s []*Point) []*Point {
+	if s == nil {
+		return nil
+	}
+
+	o := make([]*Point, 0, len(s))
+	for _, x := range s {
+		o = append(o, x.Clone())
+	}
+
+	return o
+}
+
+func _Binary_Clone(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+
+	o := make([]byte, len(b))
+	copy(o, b)
+	return o
+}
+
+func _Set_Binary_Clone(s [][]byte) [][]byte {
+	if s == nil {
+		return nil
+	}
+
+	o := make([][]byte, 0, len(s))
+	for _, x := range s {
+		o = append(o, _Binary_Clone(x))
+	}
+
+	return o
+}
+
+func _Map_String_Point_Clone(m map[string]*Point) map[string]*Point {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[string]*Point, len(m))
+	for k, v := range m {
+		o[k] = v.Clone()
+	}
+
+	return o
+}
+
+func _Map_Point_Color_Clone(m []struct {
+	Key   *Point
+	Value Color
+}) []struct {
+	Key   *Point
+	Value Color
+} {
+	if m == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   *Point
+		Value Color
+	}, 0, len(m))
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		o = append(o, struct {
+			Key   *Point
+			Value Color
+		}{k.Clone(), v})
+	}
+
+	return o
+}
+
+func _Map_Color_List_Point_Clone(m map[Color][]*Point) map[Color][]*Point {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[Color][]*Point, len(m))
+	for k, v := range m {
+		o[k] = _List_Point_Clone(v)
+	}
+
+	return o
+}
+
+// Clone returns a deep copy of this Shapes.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Shapes) Clone() *Shapes {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Points = _List_Point_Clone(v.Points)
+	o.Path = v.Path.Clone()
+	o.Matrix = _List_List_I32_Clone(v.Matrix)
+	o.Tags = _Set_String_Clone(v.Tags)
+	o.UniquePoints = _Set_Point_Clone(v.UniquePoints)
+	o.Blobs = _Set_Binary_Clone(v.Blobs)
+	o.Named = _Map_String_Point_Clone(v.Named)
+	o.Colors = _Map_Point_Color_Clone(v.Colors)
+	o.ByColor = _Map_Color_List_Point_Clone(v.ByColor)
+
+	return &o
+}
+
+type _List_I32_Zapper []int32
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_I32_Zapper.
+func (l _List_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		enc.AppendInt32(v)
+	}
+	return nil
+}
+
+type _List_List_I32_Zapper [][]int32
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_List_I32_Zapper.
+func (l _List_List_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		if err := enc.AppendArray((_List_I32_Zapper)(v)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _Set_String_Zapper map[string]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_String_Zapper.
+func (s _Set_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for v := range s {
+		enc.AppendString(v)
+	}
+	return nil
+}
+
+type _Set_Point_Zapper []*Point
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_Point_Zapper.
+func (s _Set_Point_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range s {
+		if err := enc.AppendObject(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _Set_Binary_Zapper [][]byte
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_Binary_Zapper.
+func (s _Set_Binary_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range s {
+		enc.AppendString(base64.StdEncoding.EncodeToString(v))
+	}
+	return nil
+}
+
+type _Map_String_Point_Zapper map[string]*Point
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_Point_Zapper.
+func (m _Map_String_Point_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for k, v := range m {
+		if err := enc.AddObject((string)(k), v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _Map_Point_Color_Item_Zapper struct {
+	Key   *Point
+	Value Color
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_Point_Color_Item_Zapper.
+func (i _Map_Point_Color_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if err := enc.AddObject("key", i.Key); err != nil {
+		return err
+	}
+	if err := enc.AddObject("value", i.Value); err != nil {
+		return err
+	}
+	return nil
+}
+
+type _Map_Point_Color_Zapper []struct {
+	Key   *Point
+	Value Color
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Point_Color_Zapper.
+func (m _Map_Point_Color_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		if err := enc.AppendObject(_Map_Point_Color_Item_Zapper{Key: k, Value: v}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _Map_Color_List_Point_Item_Zapper struct {
+	Key   Color
+	Value []*Point
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_Color_List_Point_Item_Zapper.
+func (i _Map_Color_List_Point_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if err := enc.AddObject("key", i.Key); err != nil {
+		return err
+	}
+	if err := enc.AddArray("value", (_List_Point_Zapper)(i.Value)); err != nil {
+		return err
+	}
+	return nil
+}
+
+type _Map_Color_List_Point_Zapper map[Color][]*Point
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Color_List_Point_Zapper.
+func (m _Map_Color_List_Point_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for k, v := range m {
+		if err := enc.AppendObject(_Map_Color_List_Point_Item_Zapper{Key: k, Value: v}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Shapes.
+func (v *Shapes) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Points != nil {
+		if err := enc.AddArray("points", (_List_Point_Zapper)(v.Points)); err != nil {
+			return err
+		}
+	}
+	if v.Path != nil {
+		if err := enc.AddArray("path", v.Path); err != nil {
+			return err
+		}
+	}
+	if v.Matrix != nil {
+		if err := enc.AddArray("matrix", (_List_List_I32_Zapper)(v.Matrix)); err != nil {
+			return err
+		}
+	}
+	if v.Tags != nil {
+		if err := enc.AddArray("tags", (_Set_String_Zapper)(v.Tags)); err != nil {
+			return err
+		}
+	}
+	if v.UniquePoints != nil {
+		if err := enc.AddArray("uniquePoints", (_Set_Point_Zapper)(v.UniquePoints)); err != nil {
+			return err
+		}
+	}
+	if v.Blobs != nil {
+		if err := enc.AddArray("blobs", (_Set_Binary_Zapper)(v.Blobs)); err != nil {
+			return err
+		}
+	}
+	if v.Named != nil {
+		if err := enc.AddObject("named", (_Map_String_Point_Zapper)(v.Named)); err != nil {
+			return err
+		}
+	}
+	if v.Colors != nil {
+		if err := enc.AddArray("colors", (_Map_Point_Color_Zapper)(v.Colors)); err != nil {
+			return err
+		}
+	}
+	if v.ByColor != nil {
+		if err := enc.AddArray("byColor", (_Map_Color_List_Point_Zapper)(v.ByColor)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetPoints returns the value of Points if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Shapes.
+func (v *Shapes) GetPoints() (o []*Point) {
+	if v != nil && v.Points != nil {
+		return v.Points
+	}
+
+	return
+}
+
+// IsSetPoints returns true if Points is not nil.
+//
+// This is safe to call on a nil Shapes.
+func (v *Shapes) IsSetPoints() bool {
+	return v != nil && v.Points != nil
+}
+
+// GetPath returns the value of Path if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Shapes.
+func (v *Shapes) GetPath() (o Path) {
+	if v != nil && v.Path != nil {
+		return v.Path
+	}
+
+	return
+}
+
+// IsSetPath returns true if Path is not nil.
+//
+// This is safe to call on a nil Shapes.
+func (v *Shapes) IsSetPath() bool {
+	return v != nil && v.Path != nil
+}
+
+// GetMatrix returns the value of Matrix if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Shapes.
+func (v *Shapes) GetMatrix() (o [][]int32) {
+	if v != nil && v.Matrix != nil {
+		return v.Matrix
+	}
+
+	return
+}
+
+// IsSetMatrix returns true if Matrix is not nil.
+//
+// This is safe to call on a nil Shapes.
+func (v *Shapes) IsSetMatrix() bool {
+	return v != nil && v.Matrix != nil
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Shapes.
+func (v *Shapes) GetTags() (o map[string]struct{}) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+//
+// This is safe to call on a nil Shapes.
+func (v *Shapes) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+// GetUniquePoints returns the value of UniquePoints if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Shapes.
+func (v *Shapes) GetUniquePoints() (o []*Point) {
+	if v != nil && v.UniquePoints != nil {
+		return v.UniquePoints
+	}
+
+	return
+}
+
+// IsSetUniquePoints returns true if UniquePoints is not nil.
+//
+// This is safe to call on a nil Shapes.
+func (v *Shapes) IsSetUniquePoints() bool {
+	return v != nil && v.UniquePoints != nil
+}
+
+// GetBlobs returns the value of Blobs if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Shapes.
+func (v *Shapes) GetBlobs() (o [][]byte) {
+	if v != nil && v.Blobs != nil {
+		return v.Blobs
+	}
+
+	return
+}
+
+// IsSetBlobs returns true if Blobs is not nil.
+//
+// This is safe to call on a nil Shapes.
+func (v *Shapes) IsSetBlobs() bool {
+	return v != nil && v.Blobs != nil
+}
+
+// GetNamed returns the value of Named if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Shapes.
+func (v *Shapes) GetNamed() (o map[string]*Point) {
+	if v != nil && v.Named != nil {
+		return v.Named
+	}
+
+	return
+}
+
+// IsSetNamed returns true if Named is not nil.
+//
+// This is safe to call on a nil Shapes.
+func (v *Shapes) IsSetNamed() bool {
+	return v != nil && v.Named != nil
+}
+
+// GetColors returns the value of Colors if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Shapes.
+func (v *Shapes) GetColors() (o []struct {
+	Key   *Point
+	Value Color
+}) {
+	if v != nil && v.Colors != nil {
+		return v.Colors
+	}
+
+	return
+}
+
+// IsSetColors returns true if Colors is not nil.
+//
+// This is safe to call on a nil Shapes.
+func (v *Shapes) IsSetColors() bool {
+	return v != nil && v.Colors != nil
+}
+
+// GetByColor returns the value of ByColor if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Shapes.
+func (v *Shapes) GetByColor() (o map[Color][]*Point) {
+	if v != nil && v.ByColor != nil {
+		return v.ByColor
+	}
+
+	return
+}
+
+// IsSetByColor returns true if ByColor is not nil.
+//
+// This is safe to call on a nil Shapes.
+func (v *Shapes) IsSetByColor() bool {
+	return v != nil && v.ByColor != nil
+}
+
+type TooManyNodes struct {
+	Message *string `json:"message,omitempty"`
+}
+
+// ToWire translates a TooManyNodes struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *TooManyNodes) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("TooManyNodes is nil")
+	}
+
+	if v.Message != nil {
+		w = wire.NewValueString(*(v.Message))
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+var _TooManyNodes_fieldReaders runtime.FieldReaders
+
+func init() {
+	_TooManyNodes_fieldReaders = runtime.FieldReaders{
+		{
+			ID:   1,
+			Type: wire.TBinary,
+			Read: func(s interface{}, value wire.Value) (err error) {
+				v := s.(*TooManyNodes)
+				x := value.GetString()
+				v.Message = &x
+				return nil
+			},
+		},
+	}
+}
+
+// FromWire deserializes a TooManyNodes struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a TooManyNodes struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v TooManyNodes
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *TooManyNodes) FromWire(w wire.Value) error {
+	if err := _TooManyNodes_fieldReaders.Read(v, w.GetStruct(), nil, nil); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a TooManyNodes
+// struct.
+func (v *TooManyNodes) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Message != nil {
+		fields[i] = fmt.Sprintf("Message: %v", *(v.Message))
+		i++
+	}
+
+	return fmt.Sprintf("TooManyNodes{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this TooManyNodes match the
+// provided TooManyNodes.
+//
+// This function performs a deep comparison.
+func (v *TooManyNodes) Equals(rhs *TooManyNodes) bool {
+	if !_String_EqualsPtr(v.Message, rhs.Message) {
+		return false
+	}
+
+	return true
+}
+
+func _String_ClonePtr(p *string) *string {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this TooManyNodes.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *TooManyNodes) Clone() *TooManyNodes {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Message = _String_ClonePtr(v.Message)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of TooManyNodes.
+func (v *TooManyNodes) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Message != nil {
+		enc.AddString("message", *v.Message)
+	}
+	return nil
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil TooManyNodes.
+func (v *TooManyNodes) GetMessage() (o string) {
+	if v != nil && v.Message != nil {
+		return *v.Message
+	}
+
+	return
+}
+
+// IsSetMessage returns true if Message is not nil.
+//
+// This is safe to call on a nil TooManyNodes.
+func (v *TooManyNodes) IsSetMessage() bool {
+	return v != nil && v.Message != nil
+}
+
+// Error returns the message of the exception if it is set and the
+// String representation of the exception otherwise.
+func (v *TooManyNodes) Error() string {
+	if m := v.GetMessage(); m != "" {
+		return m
+	}
+	return v.String()
+}
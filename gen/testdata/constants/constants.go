@@ -56,6 +56,18 @@ var ContainersOfContainers *containers.ContainersOfContainers = &containers.Cont
 			6,
 		},
 	},
+	ListOfSets: []map[int32]struct{}{
+		map[int32]struct{}{
+			1: struct{}{},
+			2: struct{}{},
+			3: struct{}{},
+		},
+		map[int32]struct{}{
+			4: struct{}{},
+			5: struct{}{},
+			6: struct{}{},
+		},
+	},
 	ListOfMaps: []map[int32]int32{
 		map[int32]int32{
 			1: 2,
@@ -68,16 +80,61 @@ var ContainersOfContainers *containers.ContainersOfContainers = &containers.Cont
 			11: 12,
 		},
 	},
-	ListOfSets: []map[int32]struct{}{
-		map[int32]struct{}{
-			1: struct{}{},
-			2: struct{}{},
-			3: struct{}{},
+	SetOfSets: []map[string]struct{}{
+		map[string]struct{}{
+			"1": struct{}{},
+			"2": struct{}{},
+			"3": struct{}{},
 		},
-		map[int32]struct{}{
-			4: struct{}{},
-			5: struct{}{},
-			6: struct{}{},
+		map[string]struct{}{
+			"4": struct{}{},
+			"5": struct{}{},
+			"6": struct{}{},
+		},
+	},
+	SetOfLists: [][]string{
+		[]string{
+			"1",
+			"2",
+			"3",
+		},
+		[]string{
+			"4",
+			"5",
+			"6",
+		},
+	},
+	SetOfMaps: []map[string]string{
+		map[string]string{
+			"1": "2",
+			"3": "4",
+			"5": "6",
+		},
+		map[string]string{
+			"7":  "8",
+			"9":  "10",
+			"11": "12",
+		},
+	},
+	MapOfMapToInt: []struct {
+		Key   map[string]int32
+		Value int64
+	}{
+		{
+			Key: map[string]int32{
+				"1": 1,
+				"2": 2,
+				"3": 3,
+			},
+			Value: 100,
+		},
+		{
+			Key: map[string]int32{
+				"4": 4,
+				"5": 5,
+				"6": 6,
+			},
+			Value: 200,
 		},
 	},
 	MapOfListToSet: []struct {
@@ -109,27 +166,6 @@ var ContainersOfContainers *containers.ContainersOfContainers = &containers.Cont
 			},
 		},
 	},
-	MapOfMapToInt: []struct {
-		Key   map[string]int32
-		Value int64
-	}{
-		{
-			Key: map[string]int32{
-				"1": 1,
-				"2": 2,
-				"3": 3,
-			},
-			Value: 100,
-		},
-		{
-			Key: map[string]int32{
-				"4": 4,
-				"5": 5,
-				"6": 6,
-			},
-			Value: 200,
-		},
-	},
 	MapOfSetToListOfDouble: []struct {
 		Key   map[int32]struct{}
 		Value []float64
@@ -157,42 +193,6 @@ var ContainersOfContainers *containers.ContainersOfContainers = &containers.Cont
 			},
 		},
 	},
-	SetOfLists: [][]string{
-		[]string{
-			"1",
-			"2",
-			"3",
-		},
-		[]string{
-			"4",
-			"5",
-			"6",
-		},
-	},
-	SetOfMaps: []map[string]string{
-		map[string]string{
-			"1": "2",
-			"3": "4",
-			"5": "6",
-		},
-		map[string]string{
-			"7":  "8",
-			"9":  "10",
-			"11": "12",
-		},
-	},
-	SetOfSets: []map[string]struct{}{
-		map[string]struct{}{
-			"1": struct{}{},
-			"2": struct{}{},
-			"3": struct{}{},
-		},
-		map[string]struct{}{
-			"4": struct{}{},
-			"5": struct{}{},
-			"6": struct{}{},
-		},
-	},
 }
 
 var EmptyException *exceptions.EmptyException = &exceptions.EmptyException{}
@@ -202,14 +202,14 @@ var EnumContainers *containers.EnumContainers = &containers.EnumContainers{
 		enums.EnumDefaultBar,
 		enums.EnumDefaultFoo,
 	},
-	MapOfEnums: map[enums.EnumWithDuplicateValues]int32{
-		enums.EnumWithDuplicateValuesP: 1,
-		enums.EnumWithDuplicateValuesQ: 2,
-	},
 	SetOfEnums: map[enums.EnumWithValues]struct{}{
 		enums.EnumWithValuesX: struct{}{},
 		enums.EnumWithValuesY: struct{}{},
 	},
+	MapOfEnums: map[enums.EnumWithDuplicateValues]int32{
+		enums.EnumWithDuplicateValuesP: 1,
+		enums.EnumWithDuplicateValuesQ: 2,
+	},
 }
 
 // An example frame group.
@@ -217,48 +217,48 @@ var EnumContainers *containers.EnumContainers = &containers.EnumContainers{
 // Contains two frames.
 var FrameGroup typedefs.FrameGroup = typedefs.FrameGroup{
 	&structs.Frame{
-		Size: &structs.Size{
-			Height: 200,
-			Width:  100,
-		},
 		TopLeft: &structs.Point{
 			X: 1,
 			Y: 2,
 		},
-	},
-	&structs.Frame{
 		Size: &structs.Size{
-			Height: 400,
-			Width:  300,
+			Width:  100,
+			Height: 200,
 		},
+	},
+	&structs.Frame{
 		TopLeft: &structs.Point{
 			X: 3,
 			Y: 4,
 		},
+		Size: &structs.Size{
+			Width:  300,
+			Height: 400,
+		},
 	},
 }
 
 var Graph *structs.Graph = &structs.Graph{
 	Edges: []*structs.Edge{
 		&structs.Edge{
-			EndPoint: &structs.Point{
-				X: 3,
-				Y: 4,
-			},
 			StartPoint: &structs.Point{
 				X: 1,
 				Y: 2,
 			},
-		},
-		&structs.Edge{
 			EndPoint: &structs.Point{
-				X: 7,
-				Y: 8,
+				X: 3,
+				Y: 4,
 			},
+		},
+		&structs.Edge{
 			StartPoint: &structs.Point{
 				X: 5,
 				Y: 6,
 			},
+			EndPoint: &structs.Point{
+				X: 7,
+				Y: 8,
+			},
 		},
 	},
 }
@@ -277,13 +277,13 @@ const Lower enums.LowerCaseEnum = enums.LowerCaseEnumItems
 const MyEnum typedefs.MyEnum = typedefs.MyEnum(enums.EnumWithValuesY)
 
 var Node *structs.Node = &structs.Node{
+	Value: 1,
 	Tail: &structs.List{
+		Value: 2,
 		Tail: &structs.List{
 			Value: 3,
 		},
-		Value: 2,
 	},
-	Value: 1,
 }
 
 var PrimitiveContainers *containers.PrimitiveContainers = &containers.PrimitiveContainers{
@@ -292,6 +292,15 @@ var PrimitiveContainers *containers.PrimitiveContainers = &containers.PrimitiveC
 		2,
 		3,
 	},
+	SetOfStrings: map[string]struct{}{
+		"foo": struct{}{},
+		"bar": struct{}{},
+	},
+	SetOfBytes: map[int8]struct{}{
+		1: struct{}{},
+		2: struct{}{},
+		3: struct{}{},
+	},
 	MapOfIntToString: map[int32]string{
 		1: "1",
 		2: "2",
@@ -302,15 +311,6 @@ var PrimitiveContainers *containers.PrimitiveContainers = &containers.PrimitiveC
 		"2": true,
 		"3": true,
 	},
-	SetOfBytes: map[int8]struct{}{
-		1: struct{}{},
-		2: struct{}{},
-		3: struct{}{},
-	},
-	SetOfStrings: map[string]struct{}{
-		"foo": struct{}{},
-		"bar": struct{}{},
-	},
 }
 
 func _EnumDefault_ptr(v enums.EnumDefault) *enums.EnumDefault {
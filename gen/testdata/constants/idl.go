@@ -16,10 +16,11 @@ import (
 
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
-	Name:     "constants",
-	Package:  "go.uber.org/thriftrw/gen/testdata/constants",
-	FilePath: "constants.thrift",
-	SHA1:     "c174d3c52157a0b78e14ad7677b2128174517eb6",
+	Name:            "constants",
+	Package:         "go.uber.org/thriftrw/gen/testdata/constants",
+	FilePath:        "constants.thrift",
+	SHA1:            "c174d3c52157a0b78e14ad7677b2128174517eb6",
+	ThriftRWVersion: "1.9.0",
 	Includes: []*thriftreflect.ThriftModule{
 		containers.ThriftModule,
 		enums.ThriftModule,
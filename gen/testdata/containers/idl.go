@@ -13,10 +13,11 @@ import (
 
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
-	Name:     "containers",
-	Package:  "go.uber.org/thriftrw/gen/testdata/containers",
-	FilePath: "containers.thrift",
-	SHA1:     "bb2b06a31ccbbcfce43163a9b0d50f109e21a24b",
+	Name:            "containers",
+	Package:         "go.uber.org/thriftrw/gen/testdata/containers",
+	FilePath:        "containers.thrift",
+	SHA1:            "bb2b06a31ccbbcfce43163a9b0d50f109e21a24b",
+	ThriftRWVersion: "1.9.0",
 	Includes: []*thriftreflect.ThriftModule{
 		enum_conflict.ThriftModule,
 		enums.ThriftModule,
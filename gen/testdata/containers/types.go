@@ -5,14 +5,17 @@ package containers
 
 import (
 	"bytes"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"strings"
+
 	"go.uber.org/thriftrw/gen/testdata/enum_conflict"
 	"go.uber.org/thriftrw/gen/testdata/enums"
 	"go.uber.org/thriftrw/gen/testdata/typedefs"
 	"go.uber.org/thriftrw/gen/testdata/uuid_conflict"
 	"go.uber.org/thriftrw/wire"
-	"strings"
+	"go.uber.org/zap/zapcore"
 )
 
 type ContainersOfContainers struct {
@@ -40,12 +43,7 @@ type _List_I32_ValueList []int32
 
 func (v _List_I32_ValueList) ForEach(f func(wire.Value) error) error {
 	for _, x := range v {
-		w, err := wire.NewValueI32(x), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
+		if err := f(wire.NewValueI32(x)); err != nil {
 			return err
 		}
 	}
@@ -69,12 +67,7 @@ func (v _List_List_I32_ValueList) ForEach(f func(wire.Value) error) error {
 		if x == nil {
 			return fmt.Errorf("invalid [%v]: value is nil", i)
 		}
-		w, err := wire.NewValueList(_List_I32_ValueList(x)), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
+		if err := f(wire.NewValueList(_List_I32_ValueList(x))); err != nil {
 			return err
 		}
 	}
@@ -95,12 +88,7 @@ type _Set_I32_ValueList map[int32]struct{}
 
 func (v _Set_I32_ValueList) ForEach(f func(wire.Value) error) error {
 	for x := range v {
-		w, err := wire.NewValueI32(x), error(nil)
-		if err != nil {
-			return err
-		}
-
-		if err := f(w); err != nil {
+		if err := f(wire.NewValueI32(x)); err != nil {
 			return err
 		}
 	}
@@ -124,12 +112,7 @@ func (v _List_Set_I32_ValueList) ForEach(f func(wire.Value) error) error {
 		if x == nil {
 			return fmt.Errorf("invalid [%v]: value is nil", i)
 		}
-		w, err := wire.NewValueSet(_Set_I32_ValueList(x)), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
+		if err := f(wire.NewValueSet(_Set_I32_ValueList(x))); err != nil {
 			return err
 		}
 	}
@@ -150,17 +133,10 @@ type _Map_I32_I32_MapItemList map[int32]int32
 
 func (m _Map_I32_I32_MapItemList) ForEach(f func(wire.MapItem) error) error {
 	for k, v := range m {
-		kw, err := wire.NewValueI32(k), error(nil)
-		if err != nil {
-			return err
-		}
+		kw := wire.NewValueI32(k)
 
-		vw, err := wire.NewValueI32(v), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
+		vw := wire.NewValueI32(v)
+		if err := f(wire.MapItem{Key: kw, Value: vw}); err != nil {
 			return err
 		}
 	}
@@ -188,12 +164,7 @@ func (v _List_Map_I32_I32_ValueList) ForEach(f func(wire.Value) error) error {
 		if x == nil {
 			return fmt.Errorf("invalid [%v]: value is nil", i)
 		}
-		w, err := wire.NewValueMap(_Map_I32_I32_MapItemList(x)), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
+		if err := f(wire.NewValueMap(_Map_I32_I32_MapItemList(x))); err != nil {
 			return err
 		}
 	}
@@ -214,12 +185,7 @@ type _Set_String_ValueList map[string]struct{}
 
 func (v _Set_String_ValueList) ForEach(f func(wire.Value) error) error {
 	for x := range v {
-		w, err := wire.NewValueString(x), error(nil)
-		if err != nil {
-			return err
-		}
-
-		if err := f(w); err != nil {
+		if err := f(wire.NewValueString(x)); err != nil {
 			return err
 		}
 	}
@@ -243,12 +209,7 @@ func (v _Set_Set_String_ValueList) ForEach(f func(wire.Value) error) error {
 		if x == nil {
 			return fmt.Errorf("invalid set item: value is nil")
 		}
-		w, err := wire.NewValueSet(_Set_String_ValueList(x)), error(nil)
-		if err != nil {
-			return err
-		}
-
-		if err := f(w); err != nil {
+		if err := f(wire.NewValueSet(_Set_String_ValueList(x))); err != nil {
 			return err
 		}
 	}
@@ -269,12 +230,7 @@ type _List_String_ValueList []string
 
 func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
 	for _, x := range v {
-		w, err := wire.NewValueString(x), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
+		if err := f(wire.NewValueString(x)); err != nil {
 			return err
 		}
 	}
@@ -298,12 +254,7 @@ func (v _Set_List_String_ValueList) ForEach(f func(wire.Value) error) error {
 		if x == nil {
 			return fmt.Errorf("invalid set item: value is nil")
 		}
-		w, err := wire.NewValueList(_List_String_ValueList(x)), error(nil)
-		if err != nil {
-			return err
-		}
-
-		if err := f(w); err != nil {
+		if err := f(wire.NewValueList(_List_String_ValueList(x))); err != nil {
 			return err
 		}
 	}
@@ -324,17 +275,10 @@ type _Map_String_String_MapItemList map[string]string
 
 func (m _Map_String_String_MapItemList) ForEach(f func(wire.MapItem) error) error {
 	for k, v := range m {
-		kw, err := wire.NewValueString(k), error(nil)
-		if err != nil {
-			return err
-		}
+		kw := wire.NewValueString(k)
 
-		vw, err := wire.NewValueString(v), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
+		vw := wire.NewValueString(v)
+		if err := f(wire.MapItem{Key: kw, Value: vw}); err != nil {
 			return err
 		}
 	}
@@ -362,12 +306,7 @@ func (v _Set_Map_String_String_ValueList) ForEach(f func(wire.Value) error) erro
 		if x == nil {
 			return fmt.Errorf("invalid set item: value is nil")
 		}
-		w, err := wire.NewValueMap(_Map_String_String_MapItemList(x)), error(nil)
-		if err != nil {
-			return err
-		}
-
-		if err := f(w); err != nil {
+		if err := f(wire.NewValueMap(_Map_String_String_MapItemList(x))); err != nil {
 			return err
 		}
 	}
@@ -388,17 +327,10 @@ type _Map_String_I32_MapItemList map[string]int32
 
 func (m _Map_String_I32_MapItemList) ForEach(f func(wire.MapItem) error) error {
 	for k, v := range m {
-		kw, err := wire.NewValueString(k), error(nil)
-		if err != nil {
-			return err
-		}
+		kw := wire.NewValueString(k)
 
-		vw, err := wire.NewValueI32(v), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
+		vw := wire.NewValueI32(v)
+		if err := f(wire.MapItem{Key: kw, Value: vw}); err != nil {
 			return err
 		}
 	}
@@ -431,17 +363,10 @@ func (m _Map_Map_String_I32_I64_MapItemList) ForEach(f func(wire.MapItem) error)
 		if k == nil {
 			return fmt.Errorf("invalid map key: value is nil")
 		}
-		kw, err := wire.NewValueMap(_Map_String_I32_MapItemList(k)), error(nil)
-		if err != nil {
-			return err
-		}
+		kw := wire.NewValueMap(_Map_String_I32_MapItemList(k))
 
-		vw, err := wire.NewValueI64(v), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
+		vw := wire.NewValueI64(v)
+		if err := f(wire.MapItem{Key: kw, Value: vw}); err != nil {
 			return err
 		}
 	}
@@ -466,12 +391,7 @@ type _Set_I64_ValueList map[int64]struct{}
 
 func (v _Set_I64_ValueList) ForEach(f func(wire.Value) error) error {
 	for x := range v {
-		w, err := wire.NewValueI64(x), error(nil)
-		if err != nil {
-			return err
-		}
-
-		if err := f(w); err != nil {
+		if err := f(wire.NewValueI64(x)); err != nil {
 			return err
 		}
 	}
@@ -503,17 +423,10 @@ func (m _Map_List_I32_Set_I64_MapItemList) ForEach(f func(wire.MapItem) error) e
 		if v == nil {
 			return fmt.Errorf("invalid [%v]: value is nil", k)
 		}
-		kw, err := wire.NewValueList(_List_I32_ValueList(k)), error(nil)
-		if err != nil {
-			return err
-		}
+		kw := wire.NewValueList(_List_I32_ValueList(k))
 
-		vw, err := wire.NewValueSet(_Set_I64_ValueList(v)), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
+		vw := wire.NewValueSet(_Set_I64_ValueList(v))
+		if err := f(wire.MapItem{Key: kw, Value: vw}); err != nil {
 			return err
 		}
 	}
@@ -538,12 +451,7 @@ type _List_Double_ValueList []float64
 
 func (v _List_Double_ValueList) ForEach(f func(wire.Value) error) error {
 	for _, x := range v {
-		w, err := wire.NewValueDouble(x), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
+		if err := f(wire.NewValueDouble(x)); err != nil {
 			return err
 		}
 	}
@@ -575,17 +483,10 @@ func (m _Map_Set_I32_List_Double_MapItemList) ForEach(f func(wire.MapItem) error
 		if v == nil {
 			return fmt.Errorf("invalid [%v]: value is nil", k)
 		}
-		kw, err := wire.NewValueSet(_Set_I32_ValueList(k)), error(nil)
-		if err != nil {
-			return err
-		}
+		kw := wire.NewValueSet(_Set_I32_ValueList(k))
 
-		vw, err := wire.NewValueList(_List_Double_ValueList(v)), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
+		vw := wire.NewValueList(_List_Double_ValueList(v))
+		if err := f(wire.MapItem{Key: kw, Value: vw}); err != nil {
 			return err
 		}
 	}
@@ -626,78 +527,54 @@ func (v *ContainersOfContainers) ToWire() (wire.Value, error) {
 		fields [9]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("ContainersOfContainers is nil")
+	}
+
 	if v.ListOfLists != nil {
-		w, err = wire.NewValueList(_List_List_I32_ValueList(v.ListOfLists)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueList(_List_List_I32_ValueList(v.ListOfLists))
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
 	if v.ListOfSets != nil {
-		w, err = wire.NewValueList(_List_Set_I32_ValueList(v.ListOfSets)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueList(_List_Set_I32_ValueList(v.ListOfSets))
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
 	if v.ListOfMaps != nil {
-		w, err = wire.NewValueList(_List_Map_I32_I32_ValueList(v.ListOfMaps)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueList(_List_Map_I32_I32_ValueList(v.ListOfMaps))
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
 	if v.SetOfSets != nil {
-		w, err = wire.NewValueSet(_Set_Set_String_ValueList(v.SetOfSets)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueSet(_Set_Set_String_ValueList(v.SetOfSets))
 		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
 	if v.SetOfLists != nil {
-		w, err = wire.NewValueSet(_Set_List_String_ValueList(v.SetOfLists)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueSet(_Set_List_String_ValueList(v.SetOfLists))
 		fields[i] = wire.Field{ID: 5, Value: w}
 		i++
 	}
 	if v.SetOfMaps != nil {
-		w, err = wire.NewValueSet(_Set_Map_String_String_ValueList(v.SetOfMaps)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueSet(_Set_Map_String_String_ValueList(v.SetOfMaps))
 		fields[i] = wire.Field{ID: 6, Value: w}
 		i++
 	}
 	if v.MapOfMapToInt != nil {
-		w, err = wire.NewValueMap(_Map_Map_String_I32_I64_MapItemList(v.MapOfMapToInt)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueMap(_Map_Map_String_I32_I64_MapItemList(v.MapOfMapToInt))
 		fields[i] = wire.Field{ID: 7, Value: w}
 		i++
 	}
 	if v.MapOfListToSet != nil {
-		w, err = wire.NewValueMap(_Map_List_I32_Set_I64_MapItemList(v.MapOfListToSet)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueMap(_Map_List_I32_Set_I64_MapItemList(v.MapOfListToSet))
 		fields[i] = wire.Field{ID: 8, Value: w}
 		i++
 	}
 	if v.MapOfSetToListOfDouble != nil {
-		w, err = wire.NewValueMap(_Map_Set_I32_List_Double_MapItemList(v.MapOfSetToListOfDouble)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueMap(_Map_Set_I32_List_Double_MapItemList(v.MapOfSetToListOfDouble))
 		fields[i] = wire.Field{ID: 9, Value: w}
 		i++
 	}
@@ -712,11 +589,7 @@ func _List_I32_Read(l wire.ValueList) ([]int32, error) {
 
 	o := make([]int32, 0, l.Size())
 	err := l.ForEach(func(x wire.Value) error {
-		i, err := x.GetI32(), error(nil)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
+		o = append(o, x.GetI32())
 		return nil
 	})
 	l.Close()
@@ -748,10 +621,7 @@ func _Set_I32_Read(s wire.ValueList) (map[int32]struct{}, error) {
 
 	o := make(map[int32]struct{}, s.Size())
 	err := s.ForEach(func(x wire.Value) error {
-		i, err := x.GetI32(), error(nil)
-		if err != nil {
-			return err
-		}
+		i := x.GetI32()
 
 		o[i] = struct{}{}
 		return nil
@@ -789,15 +659,9 @@ func _Map_I32_I32_Read(m wire.MapItemList) (map[int32]int32, error) {
 
 	o := make(map[int32]int32, m.Size())
 	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetI32(), error(nil)
-		if err != nil {
-			return err
-		}
+		k := x.Key.GetI32()
 
-		v, err := x.Value.GetI32(), error(nil)
-		if err != nil {
-			return err
-		}
+		v := x.Value.GetI32()
 
 		o[k] = v
 		return nil
@@ -831,10 +695,7 @@ func _Set_String_Read(s wire.ValueList) (map[string]struct{}, error) {
 
 	o := make(map[string]struct{}, s.Size())
 	err := s.ForEach(func(x wire.Value) error {
-		i, err := x.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
+		i := x.GetString()
 
 		o[i] = struct{}{}
 		return nil
@@ -869,11 +730,7 @@ func _List_String_Read(l wire.ValueList) ([]string, error) {
 
 	o := make([]string, 0, l.Size())
 	err := l.ForEach(func(x wire.Value) error {
-		i, err := x.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
+		o = append(o, x.GetString())
 		return nil
 	})
 	l.Close()
@@ -910,15 +767,9 @@ func _Map_String_String_Read(m wire.MapItemList) (map[string]string, error) {
 
 	o := make(map[string]string, m.Size())
 	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
+		k := x.Key.GetString()
 
-		v, err := x.Value.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
+		v := x.Value.GetString()
 
 		o[k] = v
 		return nil
@@ -957,15 +808,9 @@ func _Map_String_I32_Read(m wire.MapItemList) (map[string]int32, error) {
 
 	o := make(map[string]int32, m.Size())
 	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
+		k := x.Key.GetString()
 
-		v, err := x.Value.GetI32(), error(nil)
-		if err != nil {
-			return err
-		}
+		v := x.Value.GetI32()
 
 		o[k] = v
 		return nil
@@ -996,10 +841,7 @@ func _Map_Map_String_I32_I64_Read(m wire.MapItemList) ([]struct {
 			return err
 		}
 
-		v, err := x.Value.GetI64(), error(nil)
-		if err != nil {
-			return err
-		}
+		v := x.Value.GetI64()
 
 		o = append(o, struct {
 			Key   map[string]int32
@@ -1018,10 +860,7 @@ func _Set_I64_Read(s wire.ValueList) (map[int64]struct{}, error) {
 
 	o := make(map[int64]struct{}, s.Size())
 	err := s.ForEach(func(x wire.Value) error {
-		i, err := x.GetI64(), error(nil)
-		if err != nil {
-			return err
-		}
+		i := x.GetI64()
 
 		o[i] = struct{}{}
 		return nil
@@ -1074,11 +913,7 @@ func _List_Double_Read(l wire.ValueList) ([]float64, error) {
 
 	o := make([]float64, 0, l.Size())
 	err := l.ForEach(func(x wire.Value) error {
-		i, err := x.GetDouble(), error(nil)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
+		o = append(o, x.GetDouble())
 		return nil
 	})
 	l.Close()
@@ -1652,98 +1487,917 @@ func (v *ContainersOfContainers) Equals(rhs *ContainersOfContainers) bool {
 	return true
 }
 
-type EnumContainers struct {
-	ListOfEnums []enums.EnumDefault                     `json:"listOfEnums,omitempty"`
-	SetOfEnums  map[enums.EnumWithValues]struct{}       `json:"setOfEnums,omitempty"`
-	MapOfEnums  map[enums.EnumWithDuplicateValues]int32 `json:"mapOfEnums,omitempty"`
+func _List_I32_Clone(l []int32) []int32 {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]int32, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
 }
 
-type _List_EnumDefault_ValueList []enums.EnumDefault
+func _List_List_I32_Clone(l [][]int32) [][]int32 {
+	if l == nil {
+		return nil
+	}
 
-func (v _List_EnumDefault_ValueList) ForEach(f func(wire.Value) error) error {
-	for _, x := range v {
-		w, err := x.ToWire()
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
-			return err
-		}
+	o := make([][]int32, len(l))
+	for i, x := range l {
+		o[i] = _List_I32_Clone(x)
 	}
-	return nil
+	return o
 }
 
-func (v _List_EnumDefault_ValueList) Size() int {
-	return len(v)
-}
+func _Set_I32_Clone(s map[int32]struct{}) map[int32]struct{} {
+	if s == nil {
+		return nil
+	}
 
-func (_List_EnumDefault_ValueList) ValueType() wire.Type {
-	return wire.TI32
+	o := make(map[int32]struct{}, len(s))
+	for x := range s {
+		o[x] = struct{}{}
+	}
+
+	return o
 }
 
-func (_List_EnumDefault_ValueList) Close() {}
+func _List_Set_I32_Clone(l []map[int32]struct{}) []map[int32]struct{} {
+	if l == nil {
+		return nil
+	}
 
-type _Set_EnumWithValues_ValueList map[enums.EnumWithValues]struct{}
+	o := make([]map[int32]struct{}, len(l))
+	for i, x := range l {
+		o[i] = _Set_I32_Clone(x)
+	}
+	return o
+}
 
-func (v _Set_EnumWithValues_ValueList) ForEach(f func(wire.Value) error) error {
-	for x := range v {
-		w, err := x.ToWire()
-		if err != nil {
-			return err
-		}
+func _Map_I32_I32_Clone(m map[int32]int32) map[int32]int32 {
+	if m == nil {
+		return nil
+	}
 
-		if err := f(w); err != nil {
-			return err
-		}
+	o := make(map[int32]int32, len(m))
+	for k, v := range m {
+		o[k] = v
 	}
-	return nil
-}
 
-func (v _Set_EnumWithValues_ValueList) Size() int {
-	return len(v)
+	return o
 }
 
-func (_Set_EnumWithValues_ValueList) ValueType() wire.Type {
-	return wire.TI32
+func _List_Map_I32_I32_Clone(l []map[int32]int32) []map[int32]int32 {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]map[int32]int32, len(l))
+	for i, x := range l {
+		o[i] = _Map_I32_I32_Clone(x)
+	}
+	return o
 }
 
-func (_Set_EnumWithValues_ValueList) Close() {}
+func _Set_String_Clone(s map[string]struct{}) map[string]struct{} {
+	if s == nil {
+		return nil
+	}
 
-type _Map_EnumWithDuplicateValues_I32_MapItemList map[enums.EnumWithDuplicateValues]int32
+	o := make(map[string]struct{}, len(s))
+	for x := range s {
+		o[x] = struct{}{}
+	}
 
-func (m _Map_EnumWithDuplicateValues_I32_MapItemList) ForEach(f func(wire.MapItem) error) error {
-	for k, v := range m {
-		kw, err := k.ToWire()
-		if err != nil {
-			return err
-		}
+	return o
+}
 
-		vw, err := wire.NewValueI32(v), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
-			return err
-		}
+func _Set_Set_String_Clone(s []map[string]struct{}) []map[string]struct{} {
+	if s == nil {
+		return nil
 	}
-	return nil
-}
 
-func (m _Map_EnumWithDuplicateValues_I32_MapItemList) Size() int {
-	return len(m)
-}
+	o := make([]map[string]struct{}, 0, len(s))
+	for _, x := range s {
+		o = append(o, _Set_String_Clone(x))
+	}
 
-func (_Map_EnumWithDuplicateValues_I32_MapItemList) KeyType() wire.Type {
-	return wire.TI32
+	return o
 }
 
-func (_Map_EnumWithDuplicateValues_I32_MapItemList) ValueType() wire.Type {
-	return wire.TI32
-}
+func _List_String_Clone(l []string) []string {
+	if l == nil {
+		return nil
+	}
 
-func (_Map_EnumWithDuplicateValues_I32_MapItemList) Close() {}
+	o := make([]string, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
+}
+
+func _Set_List_String_Clone(s [][]string) [][]string {
+	if s == nil {
+		return nil
+	}
+
+	o := make([][]string, 0, len(s))
+	for _, x := range s {
+		o = append(o, _List_String_Clone(x))
+	}
+
+	return o
+}
+
+func _Map_String_String_Clone(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[string]string, len(m))
+	for k, v := range m {
+		o[k] = v
+	}
+
+	return o
+}
+
+func _Set_Map_String_String_Clone(s []map[string]string) []map[string]string {
+	if s == nil {
+		return nil
+	}
+
+	o := make([]map[string]string, 0, len(s))
+	for _, x := range s {
+		o = append(o, _Map_String_String_Clone(x))
+	}
+
+	return o
+}
+
+func _Map_String_I32_Clone(m map[string]int32) map[string]int32 {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[string]int32, len(m))
+	for k, v := range m {
+		o[k] = v
+	}
+
+	return o
+}
+
+func _Map_Map_String_I32_I64_Clone(m []struct {
+	Key   map[string]int32
+	Value int64
+}) []struct {
+	Key   map[string]int32
+	Value int64
+} {
+	if m == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   map[string]int32
+		Value int64
+	}, 0, len(m))
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		o = append(o, struct {
+			Key   map[string]int32
+			Value int64
+		}{_Map_String_I32_Clone(k), v})
+	}
+
+	return o
+}
+
+func _Set_I64_Clone(s map[int64]struct{}) map[int64]struct{} {
+	if s == nil {
+		return nil
+	}
+
+	o := make(map[int64]struct{}, len(s))
+	for x := range s {
+		o[x] = struct{}{}
+	}
+
+	return o
+}
+
+func _Map_List_I32_Set_I64_Clone(m []struct {
+	Key   []int32
+	Value map[int64]struct{}
+}) []struct {
+	Key   []int32
+	Value map[int64]struct{}
+} {
+	if m == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   []int32
+		Value map[int64]struct{}
+	}, 0, len(m))
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		o = append(o, struct {
+			Key   []int32
+			Value map[int64]struct{}
+		}{_List_I32_Clone(k), _Set_I64_Clone(v)})
+	}
+
+	return o
+}
+
+func _List_Double_Clone(l []float64) []float64 {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]float64, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
+}
+
+func _Map_Set_I32_List_Double_Clone(m []struct {
+	Key   map[int32]struct{}
+	Value []float64
+}) []struct {
+	Key   map[int32]struct{}
+	Value []float64
+} {
+	if m == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   map[int32]struct{}
+		Value []float64
+	}, 0, len(m))
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		o = append(o, struct {
+			Key   map[int32]struct{}
+			Value []float64
+		}{_Set_I32_Clone(k), _List_Double_Clone(v)})
+	}
+
+	return o
+}
+
+// Clone returns a deep copy of this ContainersOfContainers.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *ContainersOfContainers) Clone() *ContainersOfContainers {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.ListOfLists = _List_List_I32_Clone(v.ListOfLists)
+	o.ListOfSets = _List_Set_I32_Clone(v.ListOfSets)
+	o.ListOfMaps = _List_Map_I32_I32_Clone(v.ListOfMaps)
+	o.SetOfSets = _Set_Set_String_Clone(v.SetOfSets)
+	o.SetOfLists = _Set_List_String_Clone(v.SetOfLists)
+	o.SetOfMaps = _Set_Map_String_String_Clone(v.SetOfMaps)
+	o.MapOfMapToInt = _Map_Map_String_I32_I64_Clone(v.MapOfMapToInt)
+	o.MapOfListToSet = _Map_List_I32_Set_I64_Clone(v.MapOfListToSet)
+	o.MapOfSetToListOfDouble = _Map_Set_I32_List_Double_Clone(v.MapOfSetToListOfDouble)
+
+	return &o
+}
+
+type _List_I32_Zapper []int32
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_I32_Zapper.
+func (l _List_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		enc.AppendInt32(v)
+	}
+	return nil
+}
+
+type _List_List_I32_Zapper [][]int32
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_List_I32_Zapper.
+func (l _List_List_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		if err := enc.AppendArray((_List_I32_Zapper)(v)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _Set_I32_Zapper map[int32]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_I32_Zapper.
+func (s _Set_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for v := range s {
+		enc.AppendInt32(v)
+	}
+	return nil
+}
+
+type _List_Set_I32_Zapper []map[int32]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Set_I32_Zapper.
+func (l _List_Set_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		if err := enc.AppendArray((_Set_I32_Zapper)(v)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _Map_I32_I32_Item_Zapper struct {
+	Key   int32
+	Value int32
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_I32_I32_Item_Zapper.
+func (i _Map_I32_I32_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("key", i.Key)
+	enc.AddInt32("value", i.Value)
+	return nil
+}
+
+type _Map_I32_I32_Zapper map[int32]int32
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_I32_Zapper.
+func (m _Map_I32_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for k, v := range m {
+		if err := enc.AppendObject(_Map_I32_I32_Item_Zapper{Key: k, Value: v}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _List_Map_I32_I32_Zapper []map[int32]int32
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Map_I32_I32_Zapper.
+func (l _List_Map_I32_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		if err := enc.AppendArray((_Map_I32_I32_Zapper)(v)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _Set_String_Zapper map[string]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_String_Zapper.
+func (s _Set_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for v := range s {
+		enc.AppendString(v)
+	}
+	return nil
+}
+
+type _Set_Set_String_Zapper []map[string]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_Set_String_Zapper.
+func (s _Set_Set_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range s {
+		if err := enc.AppendArray((_Set_String_Zapper)(v)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return nil
+}
+
+type _Set_List_String_Zapper [][]string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_List_String_Zapper.
+func (s _Set_List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range s {
+		if err := enc.AppendArray((_List_String_Zapper)(v)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _Map_String_String_Zapper map[string]string
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_String_Zapper.
+func (m _Map_String_String_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for k, v := range m {
+		enc.AddString((string)(k), v)
+	}
+	return nil
+}
+
+type _Set_Map_String_String_Zapper []map[string]string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_Map_String_String_Zapper.
+func (s _Set_Map_String_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range s {
+		if err := enc.AppendObject((_Map_String_String_Zapper)(v)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _Map_String_I32_Zapper map[string]int32
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_I32_Zapper.
+func (m _Map_String_I32_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for k, v := range m {
+		enc.AddInt32((string)(k), v)
+	}
+	return nil
+}
+
+type _Map_Map_String_I32_I64_Item_Zapper struct {
+	Key   map[string]int32
+	Value int64
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_Map_String_I32_I64_Item_Zapper.
+func (i _Map_Map_String_I32_I64_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if err := enc.AddObject("key", (_Map_String_I32_Zapper)(i.Key)); err != nil {
+		return err
+	}
+	enc.AddInt64("value", i.Value)
+	return nil
+}
+
+type _Map_Map_String_I32_I64_Zapper []struct {
+	Key   map[string]int32
+	Value int64
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Map_String_I32_I64_Zapper.
+func (m _Map_Map_String_I32_I64_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		if err := enc.AppendObject(_Map_Map_String_I32_I64_Item_Zapper{Key: k, Value: v}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _Set_I64_Zapper map[int64]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_I64_Zapper.
+func (s _Set_I64_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for v := range s {
+		enc.AppendInt64(v)
+	}
+	return nil
+}
+
+type _Map_List_I32_Set_I64_Item_Zapper struct {
+	Key   []int32
+	Value map[int64]struct{}
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_List_I32_Set_I64_Item_Zapper.
+func (i _Map_List_I32_Set_I64_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if err := enc.AddArray("key", (_List_I32_Zapper)(i.Key)); err != nil {
+		return err
+	}
+	if err := enc.AddArray("value", (_Set_I64_Zapper)(i.Value)); err != nil {
+		return err
+	}
+	return nil
+}
+
+type _Map_List_I32_Set_I64_Zapper []struct {
+	Key   []int32
+	Value map[int64]struct{}
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_List_I32_Set_I64_Zapper.
+func (m _Map_List_I32_Set_I64_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		if err := enc.AppendObject(_Map_List_I32_Set_I64_Item_Zapper{Key: k, Value: v}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _List_Double_Zapper []float64
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Double_Zapper.
+func (l _List_Double_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		enc.AppendFloat64(v)
+	}
+	return nil
+}
+
+type _Map_Set_I32_List_Double_Item_Zapper struct {
+	Key   map[int32]struct{}
+	Value []float64
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_Set_I32_List_Double_Item_Zapper.
+func (i _Map_Set_I32_List_Double_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if err := enc.AddArray("key", (_Set_I32_Zapper)(i.Key)); err != nil {
+		return err
+	}
+	if err := enc.AddArray("value", (_List_Double_Zapper)(i.Value)); err != nil {
+		return err
+	}
+	return nil
+}
+
+type _Map_Set_I32_List_Double_Zapper []struct {
+	Key   map[int32]struct{}
+	Value []float64
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Set_I32_List_Double_Zapper.
+func (m _Map_Set_I32_List_Double_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		if err := enc.AppendObject(_Map_Set_I32_List_Double_Item_Zapper{Key: k, Value: v}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ContainersOfContainers.
+func (v *ContainersOfContainers) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.ListOfLists != nil {
+		if err := enc.AddArray("listOfLists", (_List_List_I32_Zapper)(v.ListOfLists)); err != nil {
+			return err
+		}
+	}
+	if v.ListOfSets != nil {
+		if err := enc.AddArray("listOfSets", (_List_Set_I32_Zapper)(v.ListOfSets)); err != nil {
+			return err
+		}
+	}
+	if v.ListOfMaps != nil {
+		if err := enc.AddArray("listOfMaps", (_List_Map_I32_I32_Zapper)(v.ListOfMaps)); err != nil {
+			return err
+		}
+	}
+	if v.SetOfSets != nil {
+		if err := enc.AddArray("setOfSets", (_Set_Set_String_Zapper)(v.SetOfSets)); err != nil {
+			return err
+		}
+	}
+	if v.SetOfLists != nil {
+		if err := enc.AddArray("setOfLists", (_Set_List_String_Zapper)(v.SetOfLists)); err != nil {
+			return err
+		}
+	}
+	if v.SetOfMaps != nil {
+		if err := enc.AddArray("setOfMaps", (_Set_Map_String_String_Zapper)(v.SetOfMaps)); err != nil {
+			return err
+		}
+	}
+	if v.MapOfMapToInt != nil {
+		if err := enc.AddArray("mapOfMapToInt", (_Map_Map_String_I32_I64_Zapper)(v.MapOfMapToInt)); err != nil {
+			return err
+		}
+	}
+	if v.MapOfListToSet != nil {
+		if err := enc.AddArray("mapOfListToSet", (_Map_List_I32_Set_I64_Zapper)(v.MapOfListToSet)); err != nil {
+			return err
+		}
+	}
+	if v.MapOfSetToListOfDouble != nil {
+		if err := enc.AddArray("mapOfSetToListOfDouble", (_Map_Set_I32_List_Double_Zapper)(v.MapOfSetToListOfDouble)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetListOfLists returns the value of ListOfLists if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil ContainersOfContainers.
+func (v *ContainersOfContainers) GetListOfLists() (o [][]int32) {
+	if v != nil && v.ListOfLists != nil {
+		return v.ListOfLists
+	}
+
+	return
+}
+
+// IsSetListOfLists returns true if ListOfLists is not nil.
+//
+// This is safe to call on a nil ContainersOfContainers.
+func (v *ContainersOfContainers) IsSetListOfLists() bool {
+	return v != nil && v.ListOfLists != nil
+}
+
+// GetListOfSets returns the value of ListOfSets if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil ContainersOfContainers.
+func (v *ContainersOfContainers) GetListOfSets() (o []map[int32]struct{}) {
+	if v != nil && v.ListOfSets != nil {
+		return v.ListOfSets
+	}
+
+	return
+}
+
+// IsSetListOfSets returns true if ListOfSets is not nil.
+//
+// This is safe to call on a nil ContainersOfContainers.
+func (v *ContainersOfContainers) IsSetListOfSets() bool {
+	return v != nil && v.ListOfSets != nil
+}
+
+// GetListOfMaps returns the value of ListOfMaps if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil ContainersOfContainers.
+func (v *ContainersOfContainers) GetListOfMaps() (o []map[int32]int32) {
+	if v != nil && v.ListOfMaps != nil {
+		return v.ListOfMaps
+	}
+
+	return
+}
+
+// IsSetListOfMaps returns true if ListOfMaps is not nil.
+//
+// This is safe to call on a nil ContainersOfContainers.
+func (v *ContainersOfContainers) IsSetListOfMaps() bool {
+	return v != nil && v.ListOfMaps != nil
+}
+
+// GetSetOfSets returns the value of SetOfSets if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil ContainersOfContainers.
+func (v *ContainersOfContainers) GetSetOfSets() (o []map[string]struct{}) {
+	if v != nil && v.SetOfSets != nil {
+		return v.SetOfSets
+	}
+
+	return
+}
+
+// IsSetSetOfSets returns true if SetOfSets is not nil.
+//
+// This is safe to call on a nil ContainersOfContainers.
+func (v *ContainersOfContainers) IsSetSetOfSets() bool {
+	return v != nil && v.SetOfSets != nil
+}
+
+// GetSetOfLists returns the value of SetOfLists if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil ContainersOfContainers.
+func (v *ContainersOfContainers) GetSetOfLists() (o [][]string) {
+	if v != nil && v.SetOfLists != nil {
+		return v.SetOfLists
+	}
+
+	return
+}
+
+// IsSetSetOfLists returns true if SetOfLists is not nil.
+//
+// This is safe to call on a nil ContainersOfContainers.
+func (v *ContainersOfContainers) IsSetSetOfLists() bool {
+	return v != nil && v.SetOfLists != nil
+}
+
+// GetSetOfMaps returns the value of SetOfMaps if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil ContainersOfContainers.
+func (v *ContainersOfContainers) GetSetOfMaps() (o []map[string]string) {
+	if v != nil && v.SetOfMaps != nil {
+		return v.SetOfMaps
+	}
+
+	return
+}
+
+// IsSetSetOfMaps returns true if SetOfMaps is not nil.
+//
+// This is safe to call on a nil ContainersOfContainers.
+func (v *ContainersOfContainers) IsSetSetOfMaps() bool {
+	return v != nil && v.SetOfMaps != nil
+}
+
+// GetMapOfMapToInt returns the value of MapOfMapToInt if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil ContainersOfContainers.
+func (v *ContainersOfContainers) GetMapOfMapToInt() (o []struct {
+	Key   map[string]int32
+	Value int64
+}) {
+	if v != nil && v.MapOfMapToInt != nil {
+		return v.MapOfMapToInt
+	}
+
+	return
+}
+
+// IsSetMapOfMapToInt returns true if MapOfMapToInt is not nil.
+//
+// This is safe to call on a nil ContainersOfContainers.
+func (v *ContainersOfContainers) IsSetMapOfMapToInt() bool {
+	return v != nil && v.MapOfMapToInt != nil
+}
+
+// GetMapOfListToSet returns the value of MapOfListToSet if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil ContainersOfContainers.
+func (v *ContainersOfContainers) GetMapOfListToSet() (o []struct {
+	Key   []int32
+	Value map[int64]struct{}
+}) {
+	if v != nil && v.MapOfListToSet != nil {
+		return v.MapOfListToSet
+	}
+
+	return
+}
+
+// IsSetMapOfListToSet returns true if MapOfListToSet is not nil.
+//
+// This is safe to call on a nil ContainersOfContainers.
+func (v *ContainersOfContainers) IsSetMapOfListToSet() bool {
+	return v != nil && v.MapOfListToSet != nil
+}
+
+// GetMapOfSetToListOfDouble returns the value of MapOfSetToListOfDouble if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil ContainersOfContainers.
+func (v *ContainersOfContainers) GetMapOfSetToListOfDouble() (o []struct {
+	Key   map[int32]struct{}
+	Value []float64
+}) {
+	if v != nil && v.MapOfSetToListOfDouble != nil {
+		return v.MapOfSetToListOfDouble
+	}
+
+	return
+}
+
+// IsSetMapOfSetToListOfDouble returns true if MapOfSetToListOfDouble is not nil.
+//
+// This is safe to call on a nil ContainersOfContainers.
+func (v *ContainersOfContainers) IsSetMapOfSetToListOfDouble() bool {
+	return v != nil && v.MapOfSetToListOfDouble != nil
+}
+
+type EnumContainers struct {
+	ListOfEnums []enums.EnumDefault                     `json:"listOfEnums,omitempty"`
+	SetOfEnums  map[enums.EnumWithValues]struct{}       `json:"setOfEnums,omitempty"`
+	MapOfEnums  map[enums.EnumWithDuplicateValues]int32 `json:"mapOfEnums,omitempty"`
+}
+
+type _List_EnumDefault_ValueList []enums.EnumDefault
+
+func (v _List_EnumDefault_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_EnumDefault_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_EnumDefault_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_List_EnumDefault_ValueList) Close() {}
+
+type _Set_EnumWithValues_ValueList map[enums.EnumWithValues]struct{}
+
+func (v _Set_EnumWithValues_ValueList) ForEach(f func(wire.Value) error) error {
+	for x := range v {
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+
+		if err = f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_EnumWithValues_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_EnumWithValues_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Set_EnumWithValues_ValueList) Close() {}
+
+type _Map_EnumWithDuplicateValues_I32_MapItemList map[enums.EnumWithDuplicateValues]int32
+
+func (m _Map_EnumWithDuplicateValues_I32_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw, err := k.ToWire()
+		if err != nil {
+			return err
+		}
+
+		vw := wire.NewValueI32(v)
+		if err = f(wire.MapItem{Key: kw, Value: vw}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_EnumWithDuplicateValues_I32_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_EnumWithDuplicateValues_I32_MapItemList) KeyType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_EnumWithDuplicateValues_I32_MapItemList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_EnumWithDuplicateValues_I32_MapItemList) Close() {}
 
 // ToWire translates a EnumContainers struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
@@ -1765,30 +2419,24 @@ func (v *EnumContainers) ToWire() (wire.Value, error) {
 		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("EnumContainers is nil")
+	}
+
 	if v.ListOfEnums != nil {
-		w, err = wire.NewValueList(_List_EnumDefault_ValueList(v.ListOfEnums)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueList(_List_EnumDefault_ValueList(v.ListOfEnums))
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
 	if v.SetOfEnums != nil {
-		w, err = wire.NewValueSet(_Set_EnumWithValues_ValueList(v.SetOfEnums)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueSet(_Set_EnumWithValues_ValueList(v.SetOfEnums))
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
 	if v.MapOfEnums != nil {
-		w, err = wire.NewValueMap(_Map_EnumWithDuplicateValues_I32_MapItemList(v.MapOfEnums)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueMap(_Map_EnumWithDuplicateValues_I32_MapItemList(v.MapOfEnums))
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
@@ -1867,10 +2515,7 @@ func _Map_EnumWithDuplicateValues_I32_Read(m wire.MapItemList) (map[enums.EnumWi
 			return err
 		}
 
-		v, err := x.Value.GetI32(), error(nil)
-		if err != nil {
-			return err
-		}
+		v := x.Value.GetI32()
 
 		o[k] = v
 		return nil
@@ -2020,6 +2665,197 @@ func (v *EnumContainers) Equals(rhs *EnumContainers) bool {
 	return true
 }
 
+func _List_EnumDefault_Clone(l []enums.EnumDefault) []enums.EnumDefault {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]enums.EnumDefault, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
+}
+
+func _Set_EnumWithValues_Clone(s map[enums.EnumWithValues]struct{}) map[enums.EnumWithValues]struct{} {
+	if s == nil {
+		return nil
+	}
+
+	o := make(map[enums.EnumWithValues]struct{}, len(s))
+	for x := range s {
+		o[x] = struct{}{}
+	}
+
+	return o
+}
+
+func _Map_EnumWithDuplicateValues_I32_Clone(m map[enums.EnumWithDuplicateValues]int32) map[enums.EnumWithDuplicateValues]int32 {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[enums.EnumWithDuplicateValues]int32, len(m))
+	for k, v := range m {
+		o[k] = v
+	}
+
+	return o
+}
+
+// Clone returns a deep copy of this EnumContainers.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *EnumContainers) Clone() *EnumContainers {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.ListOfEnums = _List_EnumDefault_Clone(v.ListOfEnums)
+	o.SetOfEnums = _Set_EnumWithValues_Clone(v.SetOfEnums)
+	o.MapOfEnums = _Map_EnumWithDuplicateValues_I32_Clone(v.MapOfEnums)
+
+	return &o
+}
+
+type _List_EnumDefault_Zapper []enums.EnumDefault
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_EnumDefault_Zapper.
+func (l _List_EnumDefault_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		if err := enc.AppendObject(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _Set_EnumWithValues_Zapper map[enums.EnumWithValues]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_EnumWithValues_Zapper.
+func (s _Set_EnumWithValues_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for v := range s {
+		if err := enc.AppendObject(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _Map_EnumWithDuplicateValues_I32_Item_Zapper struct {
+	Key   enums.EnumWithDuplicateValues
+	Value int32
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_EnumWithDuplicateValues_I32_Item_Zapper.
+func (i _Map_EnumWithDuplicateValues_I32_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if err := enc.AddObject("key", i.Key); err != nil {
+		return err
+	}
+	enc.AddInt32("value", i.Value)
+	return nil
+}
+
+type _Map_EnumWithDuplicateValues_I32_Zapper map[enums.EnumWithDuplicateValues]int32
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_EnumWithDuplicateValues_I32_Zapper.
+func (m _Map_EnumWithDuplicateValues_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for k, v := range m {
+		if err := enc.AppendObject(_Map_EnumWithDuplicateValues_I32_Item_Zapper{Key: k, Value: v}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of EnumContainers.
+func (v *EnumContainers) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.ListOfEnums != nil {
+		if err := enc.AddArray("listOfEnums", (_List_EnumDefault_Zapper)(v.ListOfEnums)); err != nil {
+			return err
+		}
+	}
+	if v.SetOfEnums != nil {
+		if err := enc.AddArray("setOfEnums", (_Set_EnumWithValues_Zapper)(v.SetOfEnums)); err != nil {
+			return err
+		}
+	}
+	if v.MapOfEnums != nil {
+		if err := enc.AddArray("mapOfEnums", (_Map_EnumWithDuplicateValues_I32_Zapper)(v.MapOfEnums)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetListOfEnums returns the value of ListOfEnums if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil EnumContainers.
+func (v *EnumContainers) GetListOfEnums() (o []enums.EnumDefault) {
+	if v != nil && v.ListOfEnums != nil {
+		return v.ListOfEnums
+	}
+
+	return
+}
+
+// IsSetListOfEnums returns true if ListOfEnums is not nil.
+//
+// This is safe to call on a nil EnumContainers.
+func (v *EnumContainers) IsSetListOfEnums() bool {
+	return v != nil && v.ListOfEnums != nil
+}
+
+// GetSetOfEnums returns the value of SetOfEnums if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil EnumContainers.
+func (v *EnumContainers) GetSetOfEnums() (o map[enums.EnumWithValues]struct{}) {
+	if v != nil && v.SetOfEnums != nil {
+		return v.SetOfEnums
+	}
+
+	return
+}
+
+// IsSetSetOfEnums returns true if SetOfEnums is not nil.
+//
+// This is safe to call on a nil EnumContainers.
+func (v *EnumContainers) IsSetSetOfEnums() bool {
+	return v != nil && v.SetOfEnums != nil
+}
+
+// GetMapOfEnums returns the value of MapOfEnums if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil EnumContainers.
+func (v *EnumContainers) GetMapOfEnums() (o map[enums.EnumWithDuplicateValues]int32) {
+	if v != nil && v.MapOfEnums != nil {
+		return v.MapOfEnums
+	}
+
+	return
+}
+
+// IsSetMapOfEnums returns true if MapOfEnums is not nil.
+//
+// This is safe to call on a nil EnumContainers.
+func (v *EnumContainers) IsSetMapOfEnums() bool {
+	return v != nil && v.MapOfEnums != nil
+}
+
 type ListOfConflictingEnums struct {
 	Records      []enum_conflict.RecordType `json:"records,required"`
 	OtherRecords []enums.RecordType         `json:"otherRecords,required"`
@@ -2097,25 +2933,22 @@ func (v *ListOfConflictingEnums) ToWire() (wire.Value, error) {
 		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("ListOfConflictingEnums is nil")
+	}
+
 	if v.Records == nil {
 		return w, errors.New("field Records of ListOfConflictingEnums is required")
 	}
-	w, err = wire.NewValueList(_List_RecordType_ValueList(v.Records)), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueList(_List_RecordType_ValueList(v.Records))
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 	if v.OtherRecords == nil {
 		return w, errors.New("field OtherRecords of ListOfConflictingEnums is required")
 	}
-	w, err = wire.NewValueList(_List_RecordType_1_ValueList(v.OtherRecords)), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueList(_List_RecordType_1_ValueList(v.OtherRecords))
 	fields[i] = wire.Field{ID: 2, Value: w}
 	i++
 
@@ -2257,34 +3090,138 @@ func _List_RecordType_Equals(lhs, rhs []enum_conflict.RecordType) bool {
 	return true
 }
 
-func _List_RecordType_1_Equals(lhs, rhs []enums.RecordType) bool {
-	if len(lhs) != len(rhs) {
-		return false
+func _List_RecordType_1_Equals(lhs, rhs []enums.RecordType) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this ListOfConflictingEnums match the
+// provided ListOfConflictingEnums.
+//
+// This function performs a deep comparison.
+func (v *ListOfConflictingEnums) Equals(rhs *ListOfConflictingEnums) bool {
+	if !_List_RecordType_Equals(v.Records, rhs.Records) {
+		return false
+	}
+	if !_List_RecordType_1_Equals(v.OtherRecords, rhs.OtherRecords) {
+		return false
+	}
+
+	return true
+}
+
+func _List_RecordType_Clone(l []enum_conflict.RecordType) []enum_conflict.RecordType {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]enum_conflict.RecordType, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
+}
+
+func _List_RecordType_1_Clone(l []enums.RecordType) []enums.RecordType {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]enums.RecordType, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
+}
+
+// Clone returns a deep copy of this ListOfConflictingEnums.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *ListOfConflictingEnums) Clone() *ListOfConflictingEnums {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Records = _List_RecordType_Clone(v.Records)
+	o.OtherRecords = _List_RecordType_1_Clone(v.OtherRecords)
+
+	return &o
+}
+
+type _List_RecordType_Zapper []enum_conflict.RecordType
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_RecordType_Zapper.
+func (l _List_RecordType_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		if err := enc.AppendObject(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _List_RecordType_1_Zapper []enums.RecordType
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_RecordType_1_Zapper.
+func (l _List_RecordType_1_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		if err := enc.AppendObject(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ListOfConflictingEnums.
+func (v *ListOfConflictingEnums) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
 	}
 
-	for i, lv := range lhs {
-		rv := rhs[i]
-		if !lv.Equals(rv) {
-			return false
-		}
+	if err := enc.AddArray("records", (_List_RecordType_Zapper)(v.Records)); err != nil {
+		return err
 	}
-
-	return true
+	if err := enc.AddArray("otherRecords", (_List_RecordType_1_Zapper)(v.OtherRecords)); err != nil {
+		return err
+	}
+	return nil
 }
 
-// Equals returns true if all the fields of this ListOfConflictingEnums match the
-// provided ListOfConflictingEnums.
+// GetRecords returns the value of Records if it is set or its
+// zero value if it is unset.
 //
-// This function performs a deep comparison.
-func (v *ListOfConflictingEnums) Equals(rhs *ListOfConflictingEnums) bool {
-	if !_List_RecordType_Equals(v.Records, rhs.Records) {
-		return false
-	}
-	if !_List_RecordType_1_Equals(v.OtherRecords, rhs.OtherRecords) {
-		return false
+// This is safe to call on a nil ListOfConflictingEnums.
+func (v *ListOfConflictingEnums) GetRecords() (o []enum_conflict.RecordType) {
+	if v != nil {
+		o = v.Records
 	}
+	return
+}
 
-	return true
+// GetOtherRecords returns the value of OtherRecords if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil ListOfConflictingEnums.
+func (v *ListOfConflictingEnums) GetOtherRecords() (o []enums.RecordType) {
+	if v != nil {
+		o = v.OtherRecords
+	}
+	return
 }
 
 type ListOfConflictingUUIDs struct {
@@ -2367,25 +3304,22 @@ func (v *ListOfConflictingUUIDs) ToWire() (wire.Value, error) {
 		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("ListOfConflictingUUIDs is nil")
+	}
+
 	if v.Uuids == nil {
 		return w, errors.New("field Uuids of ListOfConflictingUUIDs is required")
 	}
-	w, err = wire.NewValueList(_List_UUID_ValueList(v.Uuids)), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueList(_List_UUID_ValueList(v.Uuids))
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 	if v.OtherUUIDs == nil {
 		return w, errors.New("field OtherUUIDs of ListOfConflictingUUIDs is required")
 	}
-	w, err = wire.NewValueList(_List_UUID_1_ValueList(v.OtherUUIDs)), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueList(_List_UUID_1_ValueList(v.OtherUUIDs))
 	fields[i] = wire.Field{ID: 2, Value: w}
 	i++
 
@@ -2557,6 +3491,108 @@ func (v *ListOfConflictingUUIDs) Equals(rhs *ListOfConflictingUUIDs) bool {
 	return true
 }
 
+func _List_UUID_Clone(l []*typedefs.UUID) []*typedefs.UUID {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]*typedefs.UUID, len(l))
+	for i, x := range l {
+		o[i] = x.Clone()
+	}
+	return o
+}
+
+func _List_UUID_1_Clone(l []uuid_conflict.UUID) []uuid_conflict.UUID {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]uuid_conflict.UUID, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
+}
+
+// Clone returns a deep copy of this ListOfConflictingUUIDs.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *ListOfConflictingUUIDs) Clone() *ListOfConflictingUUIDs {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Uuids = _List_UUID_Clone(v.Uuids)
+	o.OtherUUIDs = _List_UUID_1_Clone(v.OtherUUIDs)
+
+	return &o
+}
+
+type _List_UUID_Zapper []*typedefs.UUID
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_UUID_Zapper.
+func (l _List_UUID_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		if err := enc.AppendObject(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _List_UUID_1_Zapper []uuid_conflict.UUID
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_UUID_1_Zapper.
+func (l _List_UUID_1_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		enc.AppendString((string)(v))
+	}
+	return nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ListOfConflictingUUIDs.
+func (v *ListOfConflictingUUIDs) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if err := enc.AddArray("uuids", (_List_UUID_Zapper)(v.Uuids)); err != nil {
+		return err
+	}
+	if err := enc.AddArray("otherUUIDs", (_List_UUID_1_Zapper)(v.OtherUUIDs)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetUuids returns the value of Uuids if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil ListOfConflictingUUIDs.
+func (v *ListOfConflictingUUIDs) GetUuids() (o []*typedefs.UUID) {
+	if v != nil {
+		o = v.Uuids
+	}
+	return
+}
+
+// GetOtherUUIDs returns the value of OtherUUIDs if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil ListOfConflictingUUIDs.
+func (v *ListOfConflictingUUIDs) GetOtherUUIDs() (o []uuid_conflict.UUID) {
+	if v != nil {
+		o = v.OtherUUIDs
+	}
+	return
+}
+
 type MapOfBinaryAndString struct {
 	BinaryToString []struct {
 		Key   []byte
@@ -2577,17 +3613,10 @@ func (m _Map_Binary_String_MapItemList) ForEach(f func(wire.MapItem) error) erro
 		if k == nil {
 			return fmt.Errorf("invalid map key: value is nil")
 		}
-		kw, err := wire.NewValueBinary(k), error(nil)
-		if err != nil {
-			return err
-		}
+		kw := wire.NewValueBinary(k)
 
-		vw, err := wire.NewValueString(v), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
+		vw := wire.NewValueString(v)
+		if err := f(wire.MapItem{Key: kw, Value: vw}); err != nil {
 			return err
 		}
 	}
@@ -2615,17 +3644,10 @@ func (m _Map_String_Binary_MapItemList) ForEach(f func(wire.MapItem) error) erro
 		if v == nil {
 			return fmt.Errorf("invalid [%v]: value is nil", k)
 		}
-		kw, err := wire.NewValueString(k), error(nil)
-		if err != nil {
-			return err
-		}
+		kw := wire.NewValueString(k)
 
-		vw, err := wire.NewValueBinary(v), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
+		vw := wire.NewValueBinary(v)
+		if err := f(wire.MapItem{Key: kw, Value: vw}); err != nil {
 			return err
 		}
 	}
@@ -2666,22 +3688,19 @@ func (v *MapOfBinaryAndString) ToWire() (wire.Value, error) {
 		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("MapOfBinaryAndString is nil")
+	}
+
 	if v.BinaryToString != nil {
-		w, err = wire.NewValueMap(_Map_Binary_String_MapItemList(v.BinaryToString)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueMap(_Map_Binary_String_MapItemList(v.BinaryToString))
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
 	if v.StringToBinary != nil {
-		w, err = wire.NewValueMap(_Map_String_Binary_MapItemList(v.StringToBinary)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueMap(_Map_String_Binary_MapItemList(v.StringToBinary))
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
@@ -2706,15 +3725,9 @@ func _Map_Binary_String_Read(m wire.MapItemList) ([]struct {
 		Value string
 	}, 0, m.Size())
 	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetBinary(), error(nil)
-		if err != nil {
-			return err
-		}
+		k := x.Key.GetBinary()
 
-		v, err := x.Value.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
+		v := x.Value.GetString()
 
 		o = append(o, struct {
 			Key   []byte
@@ -2737,15 +3750,9 @@ func _Map_String_Binary_Read(m wire.MapItemList) (map[string][]byte, error) {
 
 	o := make(map[string][]byte, m.Size())
 	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
+		k := x.Key.GetString()
 
-		v, err := x.Value.GetBinary(), error(nil)
-		if err != nil {
-			return err
-		}
+		v := x.Value.GetBinary()
 
 		o[k] = v
 		return nil
@@ -2884,6 +3891,175 @@ func (v *MapOfBinaryAndString) Equals(rhs *MapOfBinaryAndString) bool {
 	return true
 }
 
+func _Binary_Clone(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+
+	o := make([]byte, len(b))
+	copy(o, b)
+	return o
+}
+
+func _Map_Binary_String_Clone(m []struct {
+	Key   []byte
+	Value string
+}) []struct {
+	Key   []byte
+	Value string
+} {
+	if m == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   []byte
+		Value string
+	}, 0, len(m))
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		o = append(o, struct {
+			Key   []byte
+			Value string
+		}{_Binary_Clone(k), v})
+	}
+
+	return o
+}
+
+func _Map_String_Binary_Clone(m map[string][]byte) map[string][]byte {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[string][]byte, len(m))
+	for k, v := range m {
+		o[k] = _Binary_Clone(v)
+	}
+
+	return o
+}
+
+// Clone returns a deep copy of this MapOfBinaryAndString.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *MapOfBinaryAndString) Clone() *MapOfBinaryAndString {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.BinaryToString = _Map_Binary_String_Clone(v.BinaryToString)
+	o.StringToBinary = _Map_String_Binary_Clone(v.StringToBinary)
+
+	return &o
+}
+
+type _Map_Binary_String_Item_Zapper struct {
+	Key   []byte
+	Value string
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_Binary_String_Item_Zapper.
+func (i _Map_Binary_String_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("key", base64.StdEncoding.EncodeToString(i.Key))
+	enc.AddString("value", i.Value)
+	return nil
+}
+
+type _Map_Binary_String_Zapper []struct {
+	Key   []byte
+	Value string
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Binary_String_Zapper.
+func (m _Map_Binary_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		if err := enc.AppendObject(_Map_Binary_String_Item_Zapper{Key: k, Value: v}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _Map_String_Binary_Zapper map[string][]byte
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_Binary_Zapper.
+func (m _Map_String_Binary_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for k, v := range m {
+		enc.AddString((string)(k), base64.StdEncoding.EncodeToString(v))
+	}
+	return nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MapOfBinaryAndString.
+func (v *MapOfBinaryAndString) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.BinaryToString != nil {
+		if err := enc.AddArray("binaryToString", (_Map_Binary_String_Zapper)(v.BinaryToString)); err != nil {
+			return err
+		}
+	}
+	if v.StringToBinary != nil {
+		if err := enc.AddObject("stringToBinary", (_Map_String_Binary_Zapper)(v.StringToBinary)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetBinaryToString returns the value of BinaryToString if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil MapOfBinaryAndString.
+func (v *MapOfBinaryAndString) GetBinaryToString() (o []struct {
+	Key   []byte
+	Value string
+}) {
+	if v != nil && v.BinaryToString != nil {
+		return v.BinaryToString
+	}
+
+	return
+}
+
+// IsSetBinaryToString returns true if BinaryToString is not nil.
+//
+// This is safe to call on a nil MapOfBinaryAndString.
+func (v *MapOfBinaryAndString) IsSetBinaryToString() bool {
+	return v != nil && v.BinaryToString != nil
+}
+
+// GetStringToBinary returns the value of StringToBinary if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil MapOfBinaryAndString.
+func (v *MapOfBinaryAndString) GetStringToBinary() (o map[string][]byte) {
+	if v != nil && v.StringToBinary != nil {
+		return v.StringToBinary
+	}
+
+	return
+}
+
+// IsSetStringToBinary returns true if StringToBinary is not nil.
+//
+// This is safe to call on a nil MapOfBinaryAndString.
+func (v *MapOfBinaryAndString) IsSetStringToBinary() bool {
+	return v != nil && v.StringToBinary != nil
+}
+
 type PrimitiveContainers struct {
 	ListOfBinary      [][]byte            `json:"listOfBinary,omitempty"`
 	ListOfInts        []int64             `json:"listOfInts,omitempty"`
@@ -2900,12 +4076,7 @@ func (v _List_Binary_ValueList) ForEach(f func(wire.Value) error) error {
 		if x == nil {
 			return fmt.Errorf("invalid [%v]: value is nil", i)
 		}
-		w, err := wire.NewValueBinary(x), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
+		if err := f(wire.NewValueBinary(x)); err != nil {
 			return err
 		}
 	}
@@ -2926,12 +4097,7 @@ type _List_I64_ValueList []int64
 
 func (v _List_I64_ValueList) ForEach(f func(wire.Value) error) error {
 	for _, x := range v {
-		w, err := wire.NewValueI64(x), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
+		if err := f(wire.NewValueI64(x)); err != nil {
 			return err
 		}
 	}
@@ -2952,12 +4118,7 @@ type _Set_Byte_ValueList map[int8]struct{}
 
 func (v _Set_Byte_ValueList) ForEach(f func(wire.Value) error) error {
 	for x := range v {
-		w, err := wire.NewValueI8(x), error(nil)
-		if err != nil {
-			return err
-		}
-
-		if err := f(w); err != nil {
+		if err := f(wire.NewValueI8(x)); err != nil {
 			return err
 		}
 	}
@@ -2978,17 +4139,10 @@ type _Map_I32_String_MapItemList map[int32]string
 
 func (m _Map_I32_String_MapItemList) ForEach(f func(wire.MapItem) error) error {
 	for k, v := range m {
-		kw, err := wire.NewValueI32(k), error(nil)
-		if err != nil {
-			return err
-		}
+		kw := wire.NewValueI32(k)
 
-		vw, err := wire.NewValueString(v), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
+		vw := wire.NewValueString(v)
+		if err := f(wire.MapItem{Key: kw, Value: vw}); err != nil {
 			return err
 		}
 	}
@@ -3013,17 +4167,10 @@ type _Map_String_Bool_MapItemList map[string]bool
 
 func (m _Map_String_Bool_MapItemList) ForEach(f func(wire.MapItem) error) error {
 	for k, v := range m {
-		kw, err := wire.NewValueString(k), error(nil)
-		if err != nil {
-			return err
-		}
+		kw := wire.NewValueString(k)
 
-		vw, err := wire.NewValueBool(v), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
+		vw := wire.NewValueBool(v)
+		if err := f(wire.MapItem{Key: kw, Value: vw}); err != nil {
 			return err
 		}
 	}
@@ -3064,54 +4211,39 @@ func (v *PrimitiveContainers) ToWire() (wire.Value, error) {
 		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("PrimitiveContainers is nil")
+	}
+
 	if v.ListOfBinary != nil {
-		w, err = wire.NewValueList(_List_Binary_ValueList(v.ListOfBinary)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueList(_List_Binary_ValueList(v.ListOfBinary))
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
 	if v.ListOfInts != nil {
-		w, err = wire.NewValueList(_List_I64_ValueList(v.ListOfInts)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueList(_List_I64_ValueList(v.ListOfInts))
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
 	if v.SetOfStrings != nil {
-		w, err = wire.NewValueSet(_Set_String_ValueList(v.SetOfStrings)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueSet(_Set_String_ValueList(v.SetOfStrings))
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
 	if v.SetOfBytes != nil {
-		w, err = wire.NewValueSet(_Set_Byte_ValueList(v.SetOfBytes)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueSet(_Set_Byte_ValueList(v.SetOfBytes))
 		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
-	if v.MapOfIntToString != nil {
-		w, err = wire.NewValueMap(_Map_I32_String_MapItemList(v.MapOfIntToString)), error(nil)
-		if err != nil {
-			return w, err
-		}
+	if v.MapOfIntToString != nil {
+		w = wire.NewValueMap(_Map_I32_String_MapItemList(v.MapOfIntToString))
 		fields[i] = wire.Field{ID: 5, Value: w}
 		i++
 	}
 	if v.MapOfStringToBool != nil {
-		w, err = wire.NewValueMap(_Map_String_Bool_MapItemList(v.MapOfStringToBool)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueMap(_Map_String_Bool_MapItemList(v.MapOfStringToBool))
 		fields[i] = wire.Field{ID: 6, Value: w}
 		i++
 	}
@@ -3126,11 +4258,7 @@ func _List_Binary_Read(l wire.ValueList) ([][]byte, error) {
 
 	o := make([][]byte, 0, l.Size())
 	err := l.ForEach(func(x wire.Value) error {
-		i, err := x.GetBinary(), error(nil)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
+		o = append(o, x.GetBinary())
 		return nil
 	})
 	l.Close()
@@ -3144,11 +4272,7 @@ func _List_I64_Read(l wire.ValueList) ([]int64, error) {
 
 	o := make([]int64, 0, l.Size())
 	err := l.ForEach(func(x wire.Value) error {
-		i, err := x.GetI64(), error(nil)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
+		o = append(o, x.GetI64())
 		return nil
 	})
 	l.Close()
@@ -3162,10 +4286,7 @@ func _Set_Byte_Read(s wire.ValueList) (map[int8]struct{}, error) {
 
 	o := make(map[int8]struct{}, s.Size())
 	err := s.ForEach(func(x wire.Value) error {
-		i, err := x.GetI8(), error(nil)
-		if err != nil {
-			return err
-		}
+		i := x.GetI8()
 
 		o[i] = struct{}{}
 		return nil
@@ -3185,15 +4306,9 @@ func _Map_I32_String_Read(m wire.MapItemList) (map[int32]string, error) {
 
 	o := make(map[int32]string, m.Size())
 	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetI32(), error(nil)
-		if err != nil {
-			return err
-		}
+		k := x.Key.GetI32()
 
-		v, err := x.Value.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
+		v := x.Value.GetString()
 
 		o[k] = v
 		return nil
@@ -3213,15 +4328,9 @@ func _Map_String_Bool_Read(m wire.MapItemList) (map[string]bool, error) {
 
 	o := make(map[string]bool, m.Size())
 	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
+		k := x.Key.GetString()
 
-		v, err := x.Value.GetBool(), error(nil)
-		if err != nil {
-			return err
-		}
+		v := x.Value.GetBool()
 
 		o[k] = v
 		return nil
@@ -3448,6 +4557,313 @@ func (v *PrimitiveContainers) Equals(rhs *PrimitiveContainers) bool {
 	return true
 }
 
+func _List_Binary_Clone(l [][]byte) [][]byte {
+	if l == nil {
+		return nil
+	}
+
+	o := make([][]byte, len(l))
+	for i, x := range l {
+		o[i] = _Binary_Clone(x)
+	}
+	return o
+}
+
+func _List_I64_Clone(l []int64) []int64 {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]int64, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
+}
+
+func _Set_Byte_Clone(s map[int8]struct{}) map[int8]struct{} {
+	if s == nil {
+		return nil
+	}
+
+	o := make(map[int8]struct{}, len(s))
+	for x := range s {
+		o[x] = struct{}{}
+	}
+
+	return o
+}
+
+func _Map_I32_String_Clone(m map[int32]string) map[int32]string {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[int32]string, len(m))
+	for k, v := range m {
+		o[k] = v
+	}
+
+	return o
+}
+
+func _Map_String_Bool_Clone(m map[string]bool) map[string]bool {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[string]bool, len(m))
+	for k, v := range m {
+		o[k] = v
+	}
+
+	return o
+}
+
+// Clone returns a deep copy of this PrimitiveContainers.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *PrimitiveContainers) Clone() *PrimitiveContainers {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.ListOfBinary = _List_Binary_Clone(v.ListOfBinary)
+	o.ListOfInts = _List_I64_Clone(v.ListOfInts)
+	o.SetOfStrings = _Set_String_Clone(v.SetOfStrings)
+	o.SetOfBytes = _Set_Byte_Clone(v.SetOfBytes)
+	o.MapOfIntToString = _Map_I32_String_Clone(v.MapOfIntToString)
+	o.MapOfStringToBool = _Map_String_Bool_Clone(v.MapOfStringToBool)
+
+	return &o
+}
+
+type _List_Binary_Zapper [][]byte
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Binary_Zapper.
+func (l _List_Binary_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		enc.AppendString(base64.StdEncoding.EncodeToString(v))
+	}
+	return nil
+}
+
+type _List_I64_Zapper []int64
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_I64_Zapper.
+func (l _List_I64_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		enc.AppendInt64(v)
+	}
+	return nil
+}
+
+type _Set_Byte_Zapper map[int8]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_Byte_Zapper.
+func (s _Set_Byte_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for v := range s {
+		enc.AppendInt8(v)
+	}
+	return nil
+}
+
+type _Map_I32_String_Item_Zapper struct {
+	Key   int32
+	Value string
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_I32_String_Item_Zapper.
+func (i _Map_I32_String_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("key", i.Key)
+	enc.AddString("value", i.Value)
+	return nil
+}
+
+type _Map_I32_String_Zapper map[int32]string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_String_Zapper.
+func (m _Map_I32_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for k, v := range m {
+		if err := enc.AppendObject(_Map_I32_String_Item_Zapper{Key: k, Value: v}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _Map_String_Bool_Zapper map[string]bool
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_Bool_Zapper.
+func (m _Map_String_Bool_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for k, v := range m {
+		enc.AddBool((string)(k), v)
+	}
+	return nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PrimitiveContainers.
+func (v *PrimitiveContainers) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.ListOfBinary != nil {
+		if err := enc.AddArray("listOfBinary", (_List_Binary_Zapper)(v.ListOfBinary)); err != nil {
+			return err
+		}
+	}
+	if v.ListOfInts != nil {
+		if err := enc.AddArray("listOfInts", (_List_I64_Zapper)(v.ListOfInts)); err != nil {
+			return err
+		}
+	}
+	if v.SetOfStrings != nil {
+		if err := enc.AddArray("setOfStrings", (_Set_String_Zapper)(v.SetOfStrings)); err != nil {
+			return err
+		}
+	}
+	if v.SetOfBytes != nil {
+		if err := enc.AddArray("setOfBytes", (_Set_Byte_Zapper)(v.SetOfBytes)); err != nil {
+			return err
+		}
+	}
+	if v.MapOfIntToString != nil {
+		if err := enc.AddArray("mapOfIntToString", (_Map_I32_String_Zapper)(v.MapOfIntToString)); err != nil {
+			return err
+		}
+	}
+	if v.MapOfStringToBool != nil {
+		if err := enc.AddObject("mapOfStringToBool", (_Map_String_Bool_Zapper)(v.MapOfStringToBool)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetListOfBinary returns the value of ListOfBinary if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveContainers.
+func (v *PrimitiveContainers) GetListOfBinary() (o [][]byte) {
+	if v != nil && v.ListOfBinary != nil {
+		return v.ListOfBinary
+	}
+
+	return
+}
+
+// IsSetListOfBinary returns true if ListOfBinary is not nil.
+//
+// This is safe to call on a nil PrimitiveContainers.
+func (v *PrimitiveContainers) IsSetListOfBinary() bool {
+	return v != nil && v.ListOfBinary != nil
+}
+
+// GetListOfInts returns the value of ListOfInts if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveContainers.
+func (v *PrimitiveContainers) GetListOfInts() (o []int64) {
+	if v != nil && v.ListOfInts != nil {
+		return v.ListOfInts
+	}
+
+	return
+}
+
+// IsSetListOfInts returns true if ListOfInts is not nil.
+//
+// This is safe to call on a nil PrimitiveContainers.
+func (v *PrimitiveContainers) IsSetListOfInts() bool {
+	return v != nil && v.ListOfInts != nil
+}
+
+// GetSetOfStrings returns the value of SetOfStrings if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveContainers.
+func (v *PrimitiveContainers) GetSetOfStrings() (o map[string]struct{}) {
+	if v != nil && v.SetOfStrings != nil {
+		return v.SetOfStrings
+	}
+
+	return
+}
+
+// IsSetSetOfStrings returns true if SetOfStrings is not nil.
+//
+// This is safe to call on a nil PrimitiveContainers.
+func (v *PrimitiveContainers) IsSetSetOfStrings() bool {
+	return v != nil && v.SetOfStrings != nil
+}
+
+// GetSetOfBytes returns the value of SetOfBytes if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveContainers.
+func (v *PrimitiveContainers) GetSetOfBytes() (o map[int8]struct{}) {
+	if v != nil && v.SetOfBytes != nil {
+		return v.SetOfBytes
+	}
+
+	return
+}
+
+// IsSetSetOfBytes returns true if SetOfBytes is not nil.
+//
+// This is safe to call on a nil PrimitiveContainers.
+func (v *PrimitiveContainers) IsSetSetOfBytes() bool {
+	return v != nil && v.SetOfBytes != nil
+}
+
+// GetMapOfIntToString returns the value of MapOfIntToString if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveContainers.
+func (v *PrimitiveContainers) GetMapOfIntToString() (o map[int32]string) {
+	if v != nil && v.MapOfIntToString != nil {
+		return v.MapOfIntToString
+	}
+
+	return
+}
+
+// IsSetMapOfIntToString returns true if MapOfIntToString is not nil.
+//
+// This is safe to call on a nil PrimitiveContainers.
+func (v *PrimitiveContainers) IsSetMapOfIntToString() bool {
+	return v != nil && v.MapOfIntToString != nil
+}
+
+// GetMapOfStringToBool returns the value of MapOfStringToBool if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveContainers.
+func (v *PrimitiveContainers) GetMapOfStringToBool() (o map[string]bool) {
+	if v != nil && v.MapOfStringToBool != nil {
+		return v.MapOfStringToBool
+	}
+
+	return
+}
+
+// IsSetMapOfStringToBool returns true if MapOfStringToBool is not nil.
+//
+// This is safe to call on a nil PrimitiveContainers.
+func (v *PrimitiveContainers) IsSetMapOfStringToBool() bool {
+	return v != nil && v.MapOfStringToBool != nil
+}
+
 type PrimitiveContainersRequired struct {
 	ListOfStrings      []string           `json:"listOfStrings,required"`
 	SetOfInts          map[int32]struct{} `json:"setOfInts,required"`
@@ -3458,17 +4874,10 @@ type _Map_I64_Double_MapItemList map[int64]float64
 
 func (m _Map_I64_Double_MapItemList) ForEach(f func(wire.MapItem) error) error {
 	for k, v := range m {
-		kw, err := wire.NewValueI64(k), error(nil)
-		if err != nil {
-			return err
-		}
+		kw := wire.NewValueI64(k)
 
-		vw, err := wire.NewValueDouble(v), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
+		vw := wire.NewValueDouble(v)
+		if err := f(wire.MapItem{Key: kw, Value: vw}); err != nil {
 			return err
 		}
 	}
@@ -3509,34 +4918,28 @@ func (v *PrimitiveContainersRequired) ToWire() (wire.Value, error) {
 		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("PrimitiveContainersRequired is nil")
+	}
+
 	if v.ListOfStrings == nil {
 		return w, errors.New("field ListOfStrings of PrimitiveContainersRequired is required")
 	}
-	w, err = wire.NewValueList(_List_String_ValueList(v.ListOfStrings)), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueList(_List_String_ValueList(v.ListOfStrings))
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 	if v.SetOfInts == nil {
 		return w, errors.New("field SetOfInts of PrimitiveContainersRequired is required")
 	}
-	w, err = wire.NewValueSet(_Set_I32_ValueList(v.SetOfInts)), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueSet(_Set_I32_ValueList(v.SetOfInts))
 	fields[i] = wire.Field{ID: 2, Value: w}
 	i++
 	if v.MapOfIntsToDoubles == nil {
 		return w, errors.New("field MapOfIntsToDoubles of PrimitiveContainersRequired is required")
 	}
-	w, err = wire.NewValueMap(_Map_I64_Double_MapItemList(v.MapOfIntsToDoubles)), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueMap(_Map_I64_Double_MapItemList(v.MapOfIntsToDoubles))
 	fields[i] = wire.Field{ID: 3, Value: w}
 	i++
 
@@ -3554,15 +4957,9 @@ func _Map_I64_Double_Read(m wire.MapItemList) (map[int64]float64, error) {
 
 	o := make(map[int64]float64, m.Size())
 	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetI64(), error(nil)
-		if err != nil {
-			return err
-		}
+		k := x.Key.GetI64()
 
-		v, err := x.Value.GetDouble(), error(nil)
-		if err != nil {
-			return err
-		}
+		v := x.Value.GetDouble()
 
 		o[k] = v
 		return nil
@@ -3692,3 +5089,111 @@ func (v *PrimitiveContainersRequired) Equals(rhs *PrimitiveContainersRequired) b
 
 	return true
 }
+
+func _Map_I64_Double_Clone(m map[int64]float64) map[int64]float64 {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[int64]float64, len(m))
+	for k, v := range m {
+		o[k] = v
+	}
+
+	return o
+}
+
+// Clone returns a deep copy of this PrimitiveContainersRequired.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *PrimitiveContainersRequired) Clone() *PrimitiveContainersRequired {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.ListOfStrings = _List_String_Clone(v.ListOfStrings)
+	o.SetOfInts = _Set_I32_Clone(v.SetOfInts)
+	o.MapOfIntsToDoubles = _Map_I64_Double_Clone(v.MapOfIntsToDoubles)
+
+	return &o
+}
+
+type _Map_I64_Double_Item_Zapper struct {
+	Key   int64
+	Value float64
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_I64_Double_Item_Zapper.
+func (i _Map_I64_Double_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt64("key", i.Key)
+	enc.AddFloat64("value", i.Value)
+	return nil
+}
+
+type _Map_I64_Double_Zapper map[int64]float64
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I64_Double_Zapper.
+func (m _Map_I64_Double_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for k, v := range m {
+		if err := enc.AppendObject(_Map_I64_Double_Item_Zapper{Key: k, Value: v}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PrimitiveContainersRequired.
+func (v *PrimitiveContainersRequired) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if err := enc.AddArray("listOfStrings", (_List_String_Zapper)(v.ListOfStrings)); err != nil {
+		return err
+	}
+	if err := enc.AddArray("setOfInts", (_Set_I32_Zapper)(v.SetOfInts)); err != nil {
+		return err
+	}
+	if err := enc.AddArray("mapOfIntsToDoubles", (_Map_I64_Double_Zapper)(v.MapOfIntsToDoubles)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetListOfStrings returns the value of ListOfStrings if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveContainersRequired.
+func (v *PrimitiveContainersRequired) GetListOfStrings() (o []string) {
+	if v != nil {
+		o = v.ListOfStrings
+	}
+	return
+}
+
+// GetSetOfInts returns the value of SetOfInts if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveContainersRequired.
+func (v *PrimitiveContainersRequired) GetSetOfInts() (o map[int32]struct{}) {
+	if v != nil {
+		o = v.SetOfInts
+	}
+	return
+}
+
+// GetMapOfIntsToDoubles returns the value of MapOfIntsToDoubles if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveContainersRequired.
+func (v *PrimitiveContainersRequired) GetMapOfIntsToDoubles() (o map[int64]float64) {
+	if v != nil {
+		o = v.MapOfIntsToDoubles
+	}
+	return
+}
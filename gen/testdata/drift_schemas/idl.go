@@ -0,0 +1,18 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package drift_schemas
+
+import "go.uber.org/thriftrw/thriftreflect"
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:            "drift_schemas",
+	Package:         "go.uber.org/thriftrw/gen/testdata/drift_schemas",
+	FilePath:        "drift_schemas.thrift",
+	SHA1:            "d71824bc4140759cc213b17330db5c35ea83dcfd",
+	ThriftRWVersion: "1.9.0",
+	Raw:             rawIDL,
+}
+
+const rawIDL = "// Code for this file is generated with --drift-schemas.\n\nstruct Item {\n    1: required string sku\n    2: optional i32 quantity\n}\n\nservice Inventory {\n    i64 count(\n        1: required string sku\n        2: optional string warehouse\n    )\n\n    void restock(1: list<Item> items)\n\n    void ping()\n}\n"
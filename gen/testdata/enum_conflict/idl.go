@@ -10,10 +10,11 @@ import (
 
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
-	Name:     "enum_conflict",
-	Package:  "go.uber.org/thriftrw/gen/testdata/enum_conflict",
-	FilePath: "enum_conflict.thrift",
-	SHA1:     "75e0e6472e2f0c74412512d61531cf1a0da7429c",
+	Name:            "enum_conflict",
+	Package:         "go.uber.org/thriftrw/gen/testdata/enum_conflict",
+	FilePath:        "enum_conflict.thrift",
+	SHA1:            "75e0e6472e2f0c74412512d61531cf1a0da7429c",
+	ThriftRWVersion: "1.9.0",
 	Includes: []*thriftreflect.ThriftModule{
 		enums.ThriftModule,
 	},
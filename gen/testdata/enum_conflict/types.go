@@ -6,12 +6,15 @@ package enum_conflict
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"go.uber.org/thriftrw/gen/testdata/enums"
-	"go.uber.org/thriftrw/wire"
 	"math"
 	"strconv"
 	"strings"
+
+	"go.uber.org/thriftrw/gen/testdata/enums"
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
 )
 
 type RecordType int32
@@ -144,6 +147,102 @@ func (v *RecordType) UnmarshalJSON(text []byte) error {
 	}
 }
 
+// RecordType_NumValues is the number of distinct recognized
+// values of RecordType.
+const RecordType_NumValues = 2
+
+// Ordinal returns the position of this value among the distinct
+// recognized values of RecordType or false if the value is not
+// recognized. Ordinals are less than RecordType_NumValues.
+//
+// Ordinals may be used to index arrays of length
+// RecordType_NumValues in place of map[RecordType]T.
+//
+//   var counts [RecordType_NumValues]int
+//   if i, ok := v.Ordinal(); ok {
+//     counts[i]++
+//   }
+func (v RecordType) Ordinal() (int, bool) {
+	switch int32(v) {
+	case 0:
+		return 0, true
+	case 1:
+		return 1, true
+	default:
+		return 0, false
+	}
+}
+
+// RecordType_Set is a set of RecordType values backed by a
+// bitset. The zero value is an empty set.
+type RecordType_Set struct {
+	bits [1]uint64
+}
+
+// Add adds the given value to the set. It returns false if the value
+// is not a recognized value of RecordType.
+func (s *RecordType_Set) Add(v RecordType) bool {
+	i, ok := v.Ordinal()
+	if ok {
+		s.bits[i/64] |= 1 << uint(i%64)
+	}
+	return ok
+}
+
+// Remove removes the given value from the set.
+func (s *RecordType_Set) Remove(v RecordType) {
+	if i, ok := v.Ordinal(); ok {
+		s.bits[i/64] &^= 1 << uint(i%64)
+	}
+}
+
+// Contains returns true if the given value is in the set.
+func (s *RecordType_Set) Contains(v RecordType) bool {
+	i, ok := v.Ordinal()
+	return ok && s.bits[i/64]&(1<<uint(i%64)) != 0
+}
+
+// Len returns the number of values in the set.
+func (s *RecordType_Set) Len() int {
+	n := 0
+	for _, x := range s.bits {
+		for ; x != 0; n++ {
+			x &= x - 1
+		}
+	}
+	return n
+}
+
+// Values returns the values in the set in the order in which they
+// were declared.
+func (s *RecordType_Set) Values() []RecordType {
+	v := make([]RecordType, 0, s.Len())
+	if s.bits[0]&(1<<0) != 0 {
+		v = append(v, RecordTypeName)
+	}
+	if s.bits[0]&(1<<1) != 0 {
+		v = append(v, RecordTypeEmail)
+	}
+	return v
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of RecordType.
+//
+// Enums are logged as objects, where the value is logged with key
+// "value", and if this value's name is known, the name is logged with
+// key "name".
+func (v RecordType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "Name")
+	case 1:
+		enc.AddString("name", "Email")
+	}
+	return nil
+}
+
 type Records struct {
 	RecordType      *RecordType       `json:"recordType,omitempty"`
 	OtherRecordType *enums.RecordType `json:"otherRecordType,omitempty"`
@@ -157,6 +256,15 @@ func _RecordType_1_ptr(v enums.RecordType) *enums.RecordType {
 	return &v
 }
 
+// Default_Records constructs a new Records with its fields set to the
+// default values declared for them in the Thrift file.
+func Default_Records() *Records {
+	var v Records
+	v.RecordType = _RecordType_ptr(DefaultRecordType)
+	v.OtherRecordType = _RecordType_1_ptr(DefaultOtherRecordType)
+	return &v
+}
+
 // ToWire translates a Records struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -180,6 +288,10 @@ func (v *Records) ToWire() (wire.Value, error) {
 		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("Records is nil")
+	}
+
 	if v.RecordType == nil {
 		v.RecordType = _RecordType_ptr(DefaultRecordType)
 	}
@@ -330,22 +442,94 @@ func (v *Records) Equals(rhs *Records) bool {
 	return true
 }
 
+func _RecordType_ClonePtr(p *RecordType) *RecordType {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _RecordType_1_ClonePtr(p *enums.RecordType) *enums.RecordType {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this Records.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Records) Clone() *Records {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.RecordType = _RecordType_ClonePtr(v.RecordType)
+	o.OtherRecordType = _RecordType_1_ClonePtr(v.OtherRecordType)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Records.
+func (v *Records) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.RecordType != nil {
+		if err := enc.AddObject("recordType", *v.RecordType); err != nil {
+			return err
+		}
+	}
+	if v.OtherRecordType != nil {
+		if err := enc.AddObject("otherRecordType", *v.OtherRecordType); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // GetRecordType returns the value of RecordType if it is set or its
-// zero value if it is unset.
+// default value if it is unset.
+//
+// This is safe to call on a nil Records.
 func (v *Records) GetRecordType() (o RecordType) {
-	if v.RecordType != nil {
+	if v != nil && v.RecordType != nil {
 		return *v.RecordType
 	}
 	o = DefaultRecordType
 	return
 }
 
+// IsSetRecordType returns true if RecordType is not nil.
+//
+// This is safe to call on a nil Records.
+func (v *Records) IsSetRecordType() bool {
+	return v != nil && v.RecordType != nil
+}
+
 // GetOtherRecordType returns the value of OtherRecordType if it is set or its
-// zero value if it is unset.
+// default value if it is unset.
+//
+// This is safe to call on a nil Records.
 func (v *Records) GetOtherRecordType() (o enums.RecordType) {
-	if v.OtherRecordType != nil {
+	if v != nil && v.OtherRecordType != nil {
 		return *v.OtherRecordType
 	}
 	o = DefaultOtherRecordType
 	return
 }
+
+// IsSetOtherRecordType returns true if OtherRecordType is not nil.
+//
+// This is safe to call on a nil Records.
+func (v *Records) IsSetOtherRecordType() bool {
+	return v != nil && v.OtherRecordType != nil
+}
@@ -7,11 +7,12 @@ import "go.uber.org/thriftrw/thriftreflect"
 
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
-	Name:     "enums",
-	Package:  "go.uber.org/thriftrw/gen/testdata/enums",
-	FilePath: "enums.thrift",
-	SHA1:     "e5f5f95817a87808e0963a9340b3e67f9a09cdc6",
-	Raw:      rawIDL,
+	Name:            "enums",
+	Package:         "go.uber.org/thriftrw/gen/testdata/enums",
+	FilePath:        "enums.thrift",
+	SHA1:            "e5f5f95817a87808e0963a9340b3e67f9a09cdc6",
+	ThriftRWVersion: "1.9.0",
+	Raw:             rawIDL,
 }
 
 const rawIDL = "enum EmptyEnum {}\n\nenum EnumDefault {\n    Foo, Bar, Baz\n}\n\nenum EnumWithValues {\n    X = 123,\n    Y = 456,\n    Z = 789,\n}\n\nenum EnumWithDuplicateValues {\n    P, // 0\n    Q = -1,\n    R, // 0\n}\n\n// enum with item names conflicting with those of another enum\nenum EnumWithDuplicateName {\n    A, B, C, P, Q, R, X, Y, Z\n}\n\n// Enum treated as optional inside a struct\nstruct StructWithOptionalEnum {\n    1: optional EnumDefault e\n}\n\n/**\n * Kinds of records stored in the database.\n */\nenum RecordType {\n  /** Name of the user. */\n  NAME,\n\n  /**\n   * Home address of the user.\n   *\n   * This record is always present.\n   */\n  HOME_ADDRESS,\n\n  /**\n   * Home address of the user.\n   *\n   * This record may not be present.\n   */\n  WORK_ADDRESS\n}\n\nenum lowerCaseEnum {\n    containing, lower_case, items\n}\n\n// collision with RecordType_Values() function.\nenum RecordType_Values { FOO, BAR }\n"
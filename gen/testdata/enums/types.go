@@ -6,11 +6,14 @@ package enums
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"go.uber.org/thriftrw/wire"
 	"math"
 	"strconv"
 	"strings"
+
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
 )
 
 type EmptyEnum int32
@@ -109,6 +112,17 @@ func (v *EmptyEnum) UnmarshalJSON(text []byte) error {
 	}
 }
 
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of EmptyEnum.
+//
+// Enums are logged as objects, where the value is logged with key
+// "value", and if this value's name is known, the name is logged with
+// key "name".
+func (v EmptyEnum) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	return nil
+}
+
 type EnumDefault int32
 
 const (
@@ -248,6 +262,109 @@ func (v *EnumDefault) UnmarshalJSON(text []byte) error {
 	}
 }
 
+// EnumDefault_NumValues is the number of distinct recognized
+// values of EnumDefault.
+const EnumDefault_NumValues = 3
+
+// Ordinal returns the position of this value among the distinct
+// recognized values of EnumDefault or false if the value is not
+// recognized. Ordinals are less than EnumDefault_NumValues.
+//
+// Ordinals may be used to index arrays of length
+// EnumDefault_NumValues in place of map[EnumDefault]T.
+//
+//   var counts [EnumDefault_NumValues]int
+//   if i, ok := v.Ordinal(); ok {
+//     counts[i]++
+//   }
+func (v EnumDefault) Ordinal() (int, bool) {
+	switch int32(v) {
+	case 0:
+		return 0, true
+	case 1:
+		return 1, true
+	case 2:
+		return 2, true
+	default:
+		return 0, false
+	}
+}
+
+// EnumDefault_Set is a set of EnumDefault values backed by a
+// bitset. The zero value is an empty set.
+type EnumDefault_Set struct {
+	bits [1]uint64
+}
+
+// Add adds the given value to the set. It returns false if the value
+// is not a recognized value of EnumDefault.
+func (s *EnumDefault_Set) Add(v EnumDefault) bool {
+	i, ok := v.Ordinal()
+	if ok {
+		s.bits[i/64] |= 1 << uint(i%64)
+	}
+	return ok
+}
+
+// Remove removes the given value from the set.
+func (s *EnumDefault_Set) Remove(v EnumDefault) {
+	if i, ok := v.Ordinal(); ok {
+		s.bits[i/64] &^= 1 << uint(i%64)
+	}
+}
+
+// Contains returns true if the given value is in the set.
+func (s *EnumDefault_Set) Contains(v EnumDefault) bool {
+	i, ok := v.Ordinal()
+	return ok && s.bits[i/64]&(1<<uint(i%64)) != 0
+}
+
+// Len returns the number of values in the set.
+func (s *EnumDefault_Set) Len() int {
+	n := 0
+	for _, x := range s.bits {
+		for ; x != 0; n++ {
+			x &= x - 1
+		}
+	}
+	return n
+}
+
+// Values returns the values in the set in the order in which they
+// were declared.
+func (s *EnumDefault_Set) Values() []EnumDefault {
+	v := make([]EnumDefault, 0, s.Len())
+	if s.bits[0]&(1<<0) != 0 {
+		v = append(v, EnumDefaultFoo)
+	}
+	if s.bits[0]&(1<<1) != 0 {
+		v = append(v, EnumDefaultBar)
+	}
+	if s.bits[0]&(1<<2) != 0 {
+		v = append(v, EnumDefaultBaz)
+	}
+	return v
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of EnumDefault.
+//
+// Enums are logged as objects, where the value is logged with key
+// "value", and if this value's name is known, the name is logged with
+// key "name".
+func (v EnumDefault) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "Foo")
+	case 1:
+		enc.AddString("name", "Bar")
+	case 2:
+		enc.AddString("name", "Baz")
+	}
+	return nil
+}
+
 type EnumWithDuplicateName int32
 
 const (
@@ -441,6 +558,151 @@ func (v *EnumWithDuplicateName) UnmarshalJSON(text []byte) error {
 	}
 }
 
+// EnumWithDuplicateName_NumValues is the number of distinct recognized
+// values of EnumWithDuplicateName.
+const EnumWithDuplicateName_NumValues = 9
+
+// Ordinal returns the position of this value among the distinct
+// recognized values of EnumWithDuplicateName or false if the value is not
+// recognized. Ordinals are less than EnumWithDuplicateName_NumValues.
+//
+// Ordinals may be used to index arrays of length
+// EnumWithDuplicateName_NumValues in place of map[EnumWithDuplicateName]T.
+//
+//   var counts [EnumWithDuplicateName_NumValues]int
+//   if i, ok := v.Ordinal(); ok {
+//     counts[i]++
+//   }
+func (v EnumWithDuplicateName) Ordinal() (int, bool) {
+	switch int32(v) {
+	case 0:
+		return 0, true
+	case 1:
+		return 1, true
+	case 2:
+		return 2, true
+	case 3:
+		return 3, true
+	case 4:
+		return 4, true
+	case 5:
+		return 5, true
+	case 6:
+		return 6, true
+	case 7:
+		return 7, true
+	case 8:
+		return 8, true
+	default:
+		return 0, false
+	}
+}
+
+// EnumWithDuplicateName_Set is a set of EnumWithDuplicateName values backed by a
+// bitset. The zero value is an empty set.
+type EnumWithDuplicateName_Set struct {
+	bits [1]uint64
+}
+
+// Add adds the given value to the set. It returns false if the value
+// is not a recognized value of EnumWithDuplicateName.
+func (s *EnumWithDuplicateName_Set) Add(v EnumWithDuplicateName) bool {
+	i, ok := v.Ordinal()
+	if ok {
+		s.bits[i/64] |= 1 << uint(i%64)
+	}
+	return ok
+}
+
+// Remove removes the given value from the set.
+func (s *EnumWithDuplicateName_Set) Remove(v EnumWithDuplicateName) {
+	if i, ok := v.Ordinal(); ok {
+		s.bits[i/64] &^= 1 << uint(i%64)
+	}
+}
+
+// Contains returns true if the given value is in the set.
+func (s *EnumWithDuplicateName_Set) Contains(v EnumWithDuplicateName) bool {
+	i, ok := v.Ordinal()
+	return ok && s.bits[i/64]&(1<<uint(i%64)) != 0
+}
+
+// Len returns the number of values in the set.
+func (s *EnumWithDuplicateName_Set) Len() int {
+	n := 0
+	for _, x := range s.bits {
+		for ; x != 0; n++ {
+			x &= x - 1
+		}
+	}
+	return n
+}
+
+// Values returns the values in the set in the order in which they
+// were declared.
+func (s *EnumWithDuplicateName_Set) Values() []EnumWithDuplicateName {
+	v := make([]EnumWithDuplicateName, 0, s.Len())
+	if s.bits[0]&(1<<0) != 0 {
+		v = append(v, EnumWithDuplicateNameA)
+	}
+	if s.bits[0]&(1<<1) != 0 {
+		v = append(v, EnumWithDuplicateNameB)
+	}
+	if s.bits[0]&(1<<2) != 0 {
+		v = append(v, EnumWithDuplicateNameC)
+	}
+	if s.bits[0]&(1<<3) != 0 {
+		v = append(v, EnumWithDuplicateNameP)
+	}
+	if s.bits[0]&(1<<4) != 0 {
+		v = append(v, EnumWithDuplicateNameQ)
+	}
+	if s.bits[0]&(1<<5) != 0 {
+		v = append(v, EnumWithDuplicateNameR)
+	}
+	if s.bits[0]&(1<<6) != 0 {
+		v = append(v, EnumWithDuplicateNameX)
+	}
+	if s.bits[0]&(1<<7) != 0 {
+		v = append(v, EnumWithDuplicateNameY)
+	}
+	if s.bits[0]&(1<<8) != 0 {
+		v = append(v, EnumWithDuplicateNameZ)
+	}
+	return v
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of EnumWithDuplicateName.
+//
+// Enums are logged as objects, where the value is logged with key
+// "value", and if this value's name is known, the name is logged with
+// key "name".
+func (v EnumWithDuplicateName) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "A")
+	case 1:
+		enc.AddString("name", "B")
+	case 2:
+		enc.AddString("name", "C")
+	case 3:
+		enc.AddString("name", "P")
+	case 4:
+		enc.AddString("name", "Q")
+	case 5:
+		enc.AddString("name", "R")
+	case 6:
+		enc.AddString("name", "X")
+	case 7:
+		enc.AddString("name", "Y")
+	case 8:
+		enc.AddString("name", "Z")
+	}
+	return nil
+}
+
 type EnumWithDuplicateValues int32
 
 const (
@@ -576,6 +838,102 @@ func (v *EnumWithDuplicateValues) UnmarshalJSON(text []byte) error {
 	}
 }
 
+// EnumWithDuplicateValues_NumValues is the number of distinct recognized
+// values of EnumWithDuplicateValues.
+const EnumWithDuplicateValues_NumValues = 2
+
+// Ordinal returns the position of this value among the distinct
+// recognized values of EnumWithDuplicateValues or false if the value is not
+// recognized. Ordinals are less than EnumWithDuplicateValues_NumValues.
+//
+// Ordinals may be used to index arrays of length
+// EnumWithDuplicateValues_NumValues in place of map[EnumWithDuplicateValues]T.
+//
+//   var counts [EnumWithDuplicateValues_NumValues]int
+//   if i, ok := v.Ordinal(); ok {
+//     counts[i]++
+//   }
+func (v EnumWithDuplicateValues) Ordinal() (int, bool) {
+	switch int32(v) {
+	case 0:
+		return 0, true
+	case -1:
+		return 1, true
+	default:
+		return 0, false
+	}
+}
+
+// EnumWithDuplicateValues_Set is a set of EnumWithDuplicateValues values backed by a
+// bitset. The zero value is an empty set.
+type EnumWithDuplicateValues_Set struct {
+	bits [1]uint64
+}
+
+// Add adds the given value to the set. It returns false if the value
+// is not a recognized value of EnumWithDuplicateValues.
+func (s *EnumWithDuplicateValues_Set) Add(v EnumWithDuplicateValues) bool {
+	i, ok := v.Ordinal()
+	if ok {
+		s.bits[i/64] |= 1 << uint(i%64)
+	}
+	return ok
+}
+
+// Remove removes the given value from the set.
+func (s *EnumWithDuplicateValues_Set) Remove(v EnumWithDuplicateValues) {
+	if i, ok := v.Ordinal(); ok {
+		s.bits[i/64] &^= 1 << uint(i%64)
+	}
+}
+
+// Contains returns true if the given value is in the set.
+func (s *EnumWithDuplicateValues_Set) Contains(v EnumWithDuplicateValues) bool {
+	i, ok := v.Ordinal()
+	return ok && s.bits[i/64]&(1<<uint(i%64)) != 0
+}
+
+// Len returns the number of values in the set.
+func (s *EnumWithDuplicateValues_Set) Len() int {
+	n := 0
+	for _, x := range s.bits {
+		for ; x != 0; n++ {
+			x &= x - 1
+		}
+	}
+	return n
+}
+
+// Values returns the values in the set in the order in which they
+// were declared.
+func (s *EnumWithDuplicateValues_Set) Values() []EnumWithDuplicateValues {
+	v := make([]EnumWithDuplicateValues, 0, s.Len())
+	if s.bits[0]&(1<<0) != 0 {
+		v = append(v, EnumWithDuplicateValuesP)
+	}
+	if s.bits[0]&(1<<1) != 0 {
+		v = append(v, EnumWithDuplicateValuesQ)
+	}
+	return v
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of EnumWithDuplicateValues.
+//
+// Enums are logged as objects, where the value is logged with key
+// "value", and if this value's name is known, the name is logged with
+// key "name".
+func (v EnumWithDuplicateValues) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "P")
+	case -1:
+		enc.AddString("name", "Q")
+	}
+	return nil
+}
+
 type EnumWithValues int32
 
 const (
@@ -715,6 +1073,109 @@ func (v *EnumWithValues) UnmarshalJSON(text []byte) error {
 	}
 }
 
+// EnumWithValues_NumValues is the number of distinct recognized
+// values of EnumWithValues.
+const EnumWithValues_NumValues = 3
+
+// Ordinal returns the position of this value among the distinct
+// recognized values of EnumWithValues or false if the value is not
+// recognized. Ordinals are less than EnumWithValues_NumValues.
+//
+// Ordinals may be used to index arrays of length
+// EnumWithValues_NumValues in place of map[EnumWithValues]T.
+//
+//   var counts [EnumWithValues_NumValues]int
+//   if i, ok := v.Ordinal(); ok {
+//     counts[i]++
+//   }
+func (v EnumWithValues) Ordinal() (int, bool) {
+	switch int32(v) {
+	case 123:
+		return 0, true
+	case 456:
+		return 1, true
+	case 789:
+		return 2, true
+	default:
+		return 0, false
+	}
+}
+
+// EnumWithValues_Set is a set of EnumWithValues values backed by a
+// bitset. The zero value is an empty set.
+type EnumWithValues_Set struct {
+	bits [1]uint64
+}
+
+// Add adds the given value to the set. It returns false if the value
+// is not a recognized value of EnumWithValues.
+func (s *EnumWithValues_Set) Add(v EnumWithValues) bool {
+	i, ok := v.Ordinal()
+	if ok {
+		s.bits[i/64] |= 1 << uint(i%64)
+	}
+	return ok
+}
+
+// Remove removes the given value from the set.
+func (s *EnumWithValues_Set) Remove(v EnumWithValues) {
+	if i, ok := v.Ordinal(); ok {
+		s.bits[i/64] &^= 1 << uint(i%64)
+	}
+}
+
+// Contains returns true if the given value is in the set.
+func (s *EnumWithValues_Set) Contains(v EnumWithValues) bool {
+	i, ok := v.Ordinal()
+	return ok && s.bits[i/64]&(1<<uint(i%64)) != 0
+}
+
+// Len returns the number of values in the set.
+func (s *EnumWithValues_Set) Len() int {
+	n := 0
+	for _, x := range s.bits {
+		for ; x != 0; n++ {
+			x &= x - 1
+		}
+	}
+	return n
+}
+
+// Values returns the values in the set in the order in which they
+// were declared.
+func (s *EnumWithValues_Set) Values() []EnumWithValues {
+	v := make([]EnumWithValues, 0, s.Len())
+	if s.bits[0]&(1<<0) != 0 {
+		v = append(v, EnumWithValuesX)
+	}
+	if s.bits[0]&(1<<1) != 0 {
+		v = append(v, EnumWithValuesY)
+	}
+	if s.bits[0]&(1<<2) != 0 {
+		v = append(v, EnumWithValuesZ)
+	}
+	return v
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of EnumWithValues.
+//
+// Enums are logged as objects, where the value is logged with key
+// "value", and if this value's name is known, the name is logged with
+// key "name".
+func (v EnumWithValues) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 123:
+		enc.AddString("name", "X")
+	case 456:
+		enc.AddString("name", "Y")
+	case 789:
+		enc.AddString("name", "Z")
+	}
+	return nil
+}
+
 // Kinds of records stored in the database.
 type RecordType int32
 
@@ -862,6 +1323,109 @@ func (v *RecordType) UnmarshalJSON(text []byte) error {
 	}
 }
 
+// RecordType_NumValues is the number of distinct recognized
+// values of RecordType.
+const RecordType_NumValues = 3
+
+// Ordinal returns the position of this value among the distinct
+// recognized values of RecordType or false if the value is not
+// recognized. Ordinals are less than RecordType_NumValues.
+//
+// Ordinals may be used to index arrays of length
+// RecordType_NumValues in place of map[RecordType]T.
+//
+//   var counts [RecordType_NumValues]int
+//   if i, ok := v.Ordinal(); ok {
+//     counts[i]++
+//   }
+func (v RecordType) Ordinal() (int, bool) {
+	switch int32(v) {
+	case 0:
+		return 0, true
+	case 1:
+		return 1, true
+	case 2:
+		return 2, true
+	default:
+		return 0, false
+	}
+}
+
+// RecordType_Set is a set of RecordType values backed by a
+// bitset. The zero value is an empty set.
+type RecordType_Set struct {
+	bits [1]uint64
+}
+
+// Add adds the given value to the set. It returns false if the value
+// is not a recognized value of RecordType.
+func (s *RecordType_Set) Add(v RecordType) bool {
+	i, ok := v.Ordinal()
+	if ok {
+		s.bits[i/64] |= 1 << uint(i%64)
+	}
+	return ok
+}
+
+// Remove removes the given value from the set.
+func (s *RecordType_Set) Remove(v RecordType) {
+	if i, ok := v.Ordinal(); ok {
+		s.bits[i/64] &^= 1 << uint(i%64)
+	}
+}
+
+// Contains returns true if the given value is in the set.
+func (s *RecordType_Set) Contains(v RecordType) bool {
+	i, ok := v.Ordinal()
+	return ok && s.bits[i/64]&(1<<uint(i%64)) != 0
+}
+
+// Len returns the number of values in the set.
+func (s *RecordType_Set) Len() int {
+	n := 0
+	for _, x := range s.bits {
+		for ; x != 0; n++ {
+			x &= x - 1
+		}
+	}
+	return n
+}
+
+// Values returns the values in the set in the order in which they
+// were declared.
+func (s *RecordType_Set) Values() []RecordType {
+	v := make([]RecordType, 0, s.Len())
+	if s.bits[0]&(1<<0) != 0 {
+		v = append(v, RecordTypeName)
+	}
+	if s.bits[0]&(1<<1) != 0 {
+		v = append(v, RecordTypeHomeAddress)
+	}
+	if s.bits[0]&(1<<2) != 0 {
+		v = append(v, RecordTypeWorkAddress)
+	}
+	return v
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of RecordType.
+//
+// Enums are logged as objects, where the value is logged with key
+// "value", and if this value's name is known, the name is logged with
+// key "name".
+func (v RecordType) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "NAME")
+	case 1:
+		enc.AddString("name", "HOME_ADDRESS")
+	case 2:
+		enc.AddString("name", "WORK_ADDRESS")
+	}
+	return nil
+}
+
 type RecordTypeValues int32
 
 const (
@@ -992,6 +1556,102 @@ func (v *RecordTypeValues) UnmarshalJSON(text []byte) error {
 	}
 }
 
+// RecordTypeValues_NumValues is the number of distinct recognized
+// values of RecordTypeValues.
+const RecordTypeValues_NumValues = 2
+
+// Ordinal returns the position of this value among the distinct
+// recognized values of RecordTypeValues or false if the value is not
+// recognized. Ordinals are less than RecordTypeValues_NumValues.
+//
+// Ordinals may be used to index arrays of length
+// RecordTypeValues_NumValues in place of map[RecordTypeValues]T.
+//
+//   var counts [RecordTypeValues_NumValues]int
+//   if i, ok := v.Ordinal(); ok {
+//     counts[i]++
+//   }
+func (v RecordTypeValues) Ordinal() (int, bool) {
+	switch int32(v) {
+	case 0:
+		return 0, true
+	case 1:
+		return 1, true
+	default:
+		return 0, false
+	}
+}
+
+// RecordTypeValues_Set is a set of RecordTypeValues values backed by a
+// bitset. The zero value is an empty set.
+type RecordTypeValues_Set struct {
+	bits [1]uint64
+}
+
+// Add adds the given value to the set. It returns false if the value
+// is not a recognized value of RecordTypeValues.
+func (s *RecordTypeValues_Set) Add(v RecordTypeValues) bool {
+	i, ok := v.Ordinal()
+	if ok {
+		s.bits[i/64] |= 1 << uint(i%64)
+	}
+	return ok
+}
+
+// Remove removes the given value from the set.
+func (s *RecordTypeValues_Set) Remove(v RecordTypeValues) {
+	if i, ok := v.Ordinal(); ok {
+		s.bits[i/64] &^= 1 << uint(i%64)
+	}
+}
+
+// Contains returns true if the given value is in the set.
+func (s *RecordTypeValues_Set) Contains(v RecordTypeValues) bool {
+	i, ok := v.Ordinal()
+	return ok && s.bits[i/64]&(1<<uint(i%64)) != 0
+}
+
+// Len returns the number of values in the set.
+func (s *RecordTypeValues_Set) Len() int {
+	n := 0
+	for _, x := range s.bits {
+		for ; x != 0; n++ {
+			x &= x - 1
+		}
+	}
+	return n
+}
+
+// Values returns the values in the set in the order in which they
+// were declared.
+func (s *RecordTypeValues_Set) Values() []RecordTypeValues {
+	v := make([]RecordTypeValues, 0, s.Len())
+	if s.bits[0]&(1<<0) != 0 {
+		v = append(v, RecordTypeValuesFoo)
+	}
+	if s.bits[0]&(1<<1) != 0 {
+		v = append(v, RecordTypeValuesBar)
+	}
+	return v
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of RecordTypeValues.
+//
+// Enums are logged as objects, where the value is logged with key
+// "value", and if this value's name is known, the name is logged with
+// key "name".
+func (v RecordTypeValues) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "FOO")
+	case 1:
+		enc.AddString("name", "BAR")
+	}
+	return nil
+}
+
 type StructWithOptionalEnum struct {
 	E *EnumDefault `json:"e,omitempty"`
 }
@@ -1019,6 +1679,10 @@ func (v *StructWithOptionalEnum) ToWire() (wire.Value, error) {
 		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("StructWithOptionalEnum is nil")
+	}
+
 	if v.E != nil {
 		w, err = v.E.ToWire()
 		if err != nil {
@@ -1114,16 +1778,64 @@ func (v *StructWithOptionalEnum) Equals(rhs *StructWithOptionalEnum) bool {
 	return true
 }
 
+func _EnumDefault_ClonePtr(p *EnumDefault) *EnumDefault {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this StructWithOptionalEnum.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *StructWithOptionalEnum) Clone() *StructWithOptionalEnum {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.E = _EnumDefault_ClonePtr(v.E)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of StructWithOptionalEnum.
+func (v *StructWithOptionalEnum) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.E != nil {
+		if err := enc.AddObject("e", *v.E); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // GetE returns the value of E if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil StructWithOptionalEnum.
 func (v *StructWithOptionalEnum) GetE() (o EnumDefault) {
-	if v.E != nil {
+	if v != nil && v.E != nil {
 		return *v.E
 	}
 
 	return
 }
 
+// IsSetE returns true if E is not nil.
+//
+// This is safe to call on a nil StructWithOptionalEnum.
+func (v *StructWithOptionalEnum) IsSetE() bool {
+	return v != nil && v.E != nil
+}
+
 type LowerCaseEnum int32
 
 const (
@@ -1262,3 +1974,106 @@ func (v *LowerCaseEnum) UnmarshalJSON(text []byte) error {
 		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "LowerCaseEnum")
 	}
 }
+
+// LowerCaseEnum_NumValues is the number of distinct recognized
+// values of LowerCaseEnum.
+const LowerCaseEnum_NumValues = 3
+
+// Ordinal returns the position of this value among the distinct
+// recognized values of LowerCaseEnum or false if the value is not
+// recognized. Ordinals are less than LowerCaseEnum_NumValues.
+//
+// Ordinals may be used to index arrays of length
+// LowerCaseEnum_NumValues in place of map[LowerCaseEnum]T.
+//
+//   var counts [LowerCaseEnum_NumValues]int
+//   if i, ok := v.Ordinal(); ok {
+//     counts[i]++
+//   }
+func (v LowerCaseEnum) Ordinal() (int, bool) {
+	switch int32(v) {
+	case 0:
+		return 0, true
+	case 1:
+		return 1, true
+	case 2:
+		return 2, true
+	default:
+		return 0, false
+	}
+}
+
+// LowerCaseEnum_Set is a set of LowerCaseEnum values backed by a
+// bitset. The zero value is an empty set.
+type LowerCaseEnum_Set struct {
+	bits [1]uint64
+}
+
+// Add adds the given value to the set. It returns false if the value
+// is not a recognized value of LowerCaseEnum.
+func (s *LowerCaseEnum_Set) Add(v LowerCaseEnum) bool {
+	i, ok := v.Ordinal()
+	if ok {
+		s.bits[i/64] |= 1 << uint(i%64)
+	}
+	return ok
+}
+
+// Remove removes the given value from the set.
+func (s *LowerCaseEnum_Set) Remove(v LowerCaseEnum) {
+	if i, ok := v.Ordinal(); ok {
+		s.bits[i/64] &^= 1 << uint(i%64)
+	}
+}
+
+// Contains returns true if the given value is in the set.
+func (s *LowerCaseEnum_Set) Contains(v LowerCaseEnum) bool {
+	i, ok := v.Ordinal()
+	return ok && s.bits[i/64]&(1<<uint(i%64)) != 0
+}
+
+// Len returns the number of values in the set.
+func (s *LowerCaseEnum_Set) Len() int {
+	n := 0
+	for _, x := range s.bits {
+		for ; x != 0; n++ {
+			x &= x - 1
+		}
+	}
+	return n
+}
+
+// Values returns the values in the set in the order in which they
+// were declared.
+func (s *LowerCaseEnum_Set) Values() []LowerCaseEnum {
+	v := make([]LowerCaseEnum, 0, s.Len())
+	if s.bits[0]&(1<<0) != 0 {
+		v = append(v, LowerCaseEnumContaining)
+	}
+	if s.bits[0]&(1<<1) != 0 {
+		v = append(v, LowerCaseEnumLowerCase)
+	}
+	if s.bits[0]&(1<<2) != 0 {
+		v = append(v, LowerCaseEnumItems)
+	}
+	return v
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of LowerCaseEnum.
+//
+// Enums are logged as objects, where the value is logged with key
+// "value", and if this value's name is known, the name is logged with
+// key "name".
+func (v LowerCaseEnum) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "containing")
+	case 1:
+		enc.AddString("name", "lower_case")
+	case 2:
+		enc.AddString("name", "items")
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package examples
+
+import "go.uber.org/thriftrw/thriftreflect"
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:            "examples",
+	Package:         "go.uber.org/thriftrw/gen/testdata/examples",
+	FilePath:        "examples.thrift",
+	SHA1:            "dcc8d45ddb6e04a6d38e8dd6080e86a82241bf05",
+	ThriftRWVersion: "1.9.0",
+	Raw:             rawIDL,
+}
+
+const rawIDL = "// Code for this file is generated with --generate-examples.\n\nenum Status {\n    ACTIVE\n    INACTIVE\n}\n\ntypedef string UserName\ntypedef i64 Timestamp\ntypedef Point Location\n\nstruct Point {\n    1: required double x\n    2: required double y\n}\n\nstruct User {\n    1: required UserName name\n    2: required Status status\n    3: required Timestamp createdAt\n    4: required Location home\n    5: required list<string> emails\n    6: required map<string, Point> places\n    7: required uuid id\n    8: optional string nickname\n    9: optional binary avatar\n}\n\nunion Contact {\n    1: binary photo\n    2: string email\n    3: i64 phone\n}\n\nexception UserNotFound {\n    1: required string name\n    2: optional string message\n}\n\n// Binary values cannot be constants so no example is generated for this\n// struct.\nstruct Blob {\n    1: required binary data\n}\n\nstruct Empty {}\n"
@@ -0,0 +1,1922 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package examples
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
+)
+
+type Blob struct {
+	Data []byte `json:"data,required"`
+}
+
+// ToWire translates a Blob struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Blob) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Blob is nil")
+	}
+
+	if v.Data == nil {
+		return w, errors.New("field Data of Blob is required")
+	}
+	w = wire.NewValueBinary(v.Data)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Blob struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Blob struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Blob
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Blob) FromWire(w wire.Value) error {
+
+	dataIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Data = field.Value.GetBinary()
+				dataIsSet = true
+			}
+		}
+	}
+
+	if !dataIsSet {
+		return errors.New("field Data of Blob is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Blob
+// struct.
+func (v *Blob) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Data: %v", v.Data)
+	i++
+
+	return fmt.Sprintf("Blob{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Blob match the
+// provided Blob.
+//
+// This function performs a deep comparison.
+func (v *Blob) Equals(rhs *Blob) bool {
+	if !bytes.Equal(v.Data, rhs.Data) {
+		return false
+	}
+
+	return true
+}
+
+func _Binary_Clone(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+
+	o := make([]byte, len(b))
+	copy(o, b)
+	return o
+}
+
+// Clone returns a deep copy of this Blob.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Blob) Clone() *Blob {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Data = _Binary_Clone(v.Data)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Blob.
+func (v *Blob) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("data", base64.StdEncoding.EncodeToString(v.Data))
+	return nil
+}
+
+// GetData returns the value of Data if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Blob.
+func (v *Blob) GetData() (o []byte) {
+	if v != nil {
+		o = v.Data
+	}
+	return
+}
+
+type Contact struct {
+	Photo []byte  `json:"photo,omitempty"`
+	Email *string `json:"email,omitempty"`
+	Phone *int64  `json:"phone,omitempty"`
+}
+
+// ToWire translates a Contact struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Contact) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Contact is nil")
+	}
+
+	if v.Photo != nil {
+		w = wire.NewValueBinary(v.Photo)
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Email != nil {
+		w = wire.NewValueString(*(v.Email))
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Phone != nil {
+		w = wire.NewValueI64(*(v.Phone))
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Contact should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Contact struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Contact struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Contact
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Contact) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Photo = field.Value.GetBinary()
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				x := field.Value.GetString()
+				v.Email = &x
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TI64 {
+				x := field.Value.GetI64()
+				v.Phone = &x
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Photo != nil {
+		count++
+	}
+	if v.Email != nil {
+		count++
+	}
+	if v.Phone != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Contact should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Contact
+// struct.
+func (v *Contact) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	if v.Photo != nil {
+		fields[i] = fmt.Sprintf("Photo: %v", v.Photo)
+		i++
+	}
+	if v.Email != nil {
+		fields[i] = fmt.Sprintf("Email: %v", *(v.Email))
+		i++
+	}
+	if v.Phone != nil {
+		fields[i] = fmt.Sprintf("Phone: %v", *(v.Phone))
+		i++
+	}
+
+	return fmt.Sprintf("Contact{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Contact match the
+// provided Contact.
+//
+// This function performs a deep comparison.
+func (v *Contact) Equals(rhs *Contact) bool {
+	if !((v.Photo == nil && rhs.Photo == nil) || (v.Photo != nil && rhs.Photo != nil && bytes.Equal(v.Photo, rhs.Photo))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Email, rhs.Email) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.Phone, rhs.Phone) {
+		return false
+	}
+
+	return true
+}
+
+func _String_ClonePtr(p *string) *string {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _I64_ClonePtr(p *int64) *int64 {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this Contact.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Contact) Clone() *Contact {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Photo = _Binary_Clone(v.Photo)
+	o.Email = _String_ClonePtr(v.Email)
+	o.Phone = _I64_ClonePtr(v.Phone)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Contact.
+func (v *Contact) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Photo != nil {
+		enc.AddString("photo", base64.StdEncoding.EncodeToString(v.Photo))
+	}
+	if v.Email != nil {
+		enc.AddString("email", *v.Email)
+	}
+	if v.Phone != nil {
+		enc.AddInt64("phone", *v.Phone)
+	}
+	return nil
+}
+
+// GetPhoto returns the value of Photo if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Contact.
+func (v *Contact) GetPhoto() (o []byte) {
+	if v != nil && v.Photo != nil {
+		return v.Photo
+	}
+
+	return
+}
+
+// IsSetPhoto returns true if Photo is not nil.
+//
+// This is safe to call on a nil Contact.
+func (v *Contact) IsSetPhoto() bool {
+	return v != nil && v.Photo != nil
+}
+
+// GetEmail returns the value of Email if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Contact.
+func (v *Contact) GetEmail() (o string) {
+	if v != nil && v.Email != nil {
+		return *v.Email
+	}
+
+	return
+}
+
+// IsSetEmail returns true if Email is not nil.
+//
+// This is safe to call on a nil Contact.
+func (v *Contact) IsSetEmail() bool {
+	return v != nil && v.Email != nil
+}
+
+// GetPhone returns the value of Phone if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Contact.
+func (v *Contact) GetPhone() (o int64) {
+	if v != nil && v.Phone != nil {
+		return *v.Phone
+	}
+
+	return
+}
+
+// IsSetPhone returns true if Phone is not nil.
+//
+// This is safe to call on a nil Contact.
+func (v *Contact) IsSetPhone() bool {
+	return v != nil && v.Phone != nil
+}
+
+type Empty struct {
+}
+
+// ToWire translates a Empty struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Empty) ToWire() (wire.Value, error) {
+	var (
+		fields [0]wire.Field
+		i      int = 0
+	)
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Empty struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Empty struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Empty
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Empty) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Empty
+// struct.
+func (v *Empty) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [0]string
+	i := 0
+
+	return fmt.Sprintf("Empty{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Empty match the
+// provided Empty.
+//
+// This function performs a deep comparison.
+func (v *Empty) Equals(rhs *Empty) bool {
+
+	return true
+}
+
+// Clone returns a deep copy of this Empty.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Empty) Clone() *Empty {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Empty.
+func (v *Empty) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	return nil
+}
+
+type Location Point
+
+// ToWire translates Location into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v *Location) ToWire() (wire.Value, error) {
+	x := (*Point)(v)
+	return x.ToWire()
+}
+
+// String returns a readable string representation of Location.
+func (v *Location) String() string {
+	x := (*Point)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes Location from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Location) FromWire(w wire.Value) error {
+	return (*Point)(v).FromWire(w)
+}
+
+// Equals returns true if this Location is equal to the provided
+// Location.
+func (lhs *Location) Equals(rhs *Location) bool {
+	return (*Point)(lhs).Equals((*Point)(rhs))
+}
+
+// Clone returns a deep copy of this Location.
+func (v *Location) Clone() *Location {
+	return (*Location)((*Point)(v).Clone())
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Location.
+func (v *Location) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	x := (*Point)(v)
+	return x.MarshalLogObject(enc)
+}
+
+type Point struct {
+	X float64 `json:"x,required"`
+	Y float64 `json:"y,required"`
+}
+
+// ToWire translates a Point struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Point) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Point is nil")
+	}
+
+	w = wire.NewValueDouble(v.X)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w = wire.NewValueDouble(v.Y)
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Point struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Point struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Point
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Point) FromWire(w wire.Value) error {
+
+	xIsSet := false
+	yIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TDouble {
+				v.X = field.Value.GetDouble()
+				xIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TDouble {
+				v.Y = field.Value.GetDouble()
+				yIsSet = true
+			}
+		}
+	}
+
+	if !xIsSet {
+		return errors.New("field X of Point is required")
+	}
+
+	if !yIsSet {
+		return errors.New("field Y of Point is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Point
+// struct.
+func (v *Point) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("X: %v", v.X)
+	i++
+	fields[i] = fmt.Sprintf("Y: %v", v.Y)
+	i++
+
+	return fmt.Sprintf("Point{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Point match the
+// provided Point.
+//
+// This function performs a deep comparison.
+func (v *Point) Equals(rhs *Point) bool {
+	if !(v.X == rhs.X) {
+		return false
+	}
+	if !(v.Y == rhs.Y) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this Point.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Point) Clone() *Point {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Point.
+func (v *Point) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddFloat64("x", v.X)
+	enc.AddFloat64("y", v.Y)
+	return nil
+}
+
+// GetX returns the value of X if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Point.
+func (v *Point) GetX() (o float64) {
+	if v != nil {
+		o = v.X
+	}
+	return
+}
+
+// GetY returns the value of Y if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Point.
+func (v *Point) GetY() (o float64) {
+	if v != nil {
+		o = v.Y
+	}
+	return
+}
+
+type Status int32
+
+const (
+	StatusActive   Status = 0
+	StatusInactive Status = 1
+)
+
+// Status_Values returns all recognized values of Status.
+func Status_Values() []Status {
+	return []Status{
+		StatusActive,
+		StatusInactive,
+	}
+}
+
+// UnmarshalText tries to decode Status from a byte slice
+// containing its name.
+//
+//   var v Status
+//   err := v.UnmarshalText([]byte("ACTIVE"))
+func (v *Status) UnmarshalText(value []byte) error {
+	switch string(value) {
+	case "ACTIVE":
+		*v = StatusActive
+		return nil
+	case "INACTIVE":
+		*v = StatusInactive
+		return nil
+	default:
+		return fmt.Errorf("unknown enum value %q for %q", value, "Status")
+	}
+}
+
+// ToWire translates Status into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v Status) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes Status from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return Status(0), err
+//   }
+//
+//   var v Status
+//   if err := v.FromWire(x); err != nil {
+//     return Status(0), err
+//   }
+//   return v, nil
+func (v *Status) FromWire(w wire.Value) error {
+	*v = (Status)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of Status.
+func (v Status) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "ACTIVE"
+	case 1:
+		return "INACTIVE"
+	}
+	return fmt.Sprintf("Status(%d)", w)
+}
+
+// Equals returns true if this Status value matches the provided
+// value.
+func (v Status) Equals(rhs Status) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes Status into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v Status) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"ACTIVE\""), nil
+	case 1:
+		return ([]byte)("\"INACTIVE\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode Status from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *Status) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Status")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Status")
+		}
+		*v = (Status)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Status")
+	}
+}
+
+// Status_NumValues is the number of distinct recognized
+// values of Status.
+const Status_NumValues = 2
+
+// Ordinal returns the position of this value among the distinct
+// recognized values of Status or false if the value is not
+// recognized. Ordinals are less than Status_NumValues.
+//
+// Ordinals may be used to index arrays of length
+// Status_NumValues in place of map[Status]T.
+//
+//   var counts [Status_NumValues]int
+//   if i, ok := v.Ordinal(); ok {
+//     counts[i]++
+//   }
+func (v Status) Ordinal() (int, bool) {
+	switch int32(v) {
+	case 0:
+		return 0, true
+	case 1:
+		return 1, true
+	default:
+		return 0, false
+	}
+}
+
+// Status_Set is a set of Status values backed by a
+// bitset. The zero value is an empty set.
+type Status_Set struct {
+	bits [1]uint64
+}
+
+// Add adds the given value to the set. It returns false if the value
+// is not a recognized value of Status.
+func (s *Status_Set) Add(v Status) bool {
+	i, ok := v.Ordinal()
+	if ok {
+		s.bits[i/64] |= 1 << uint(i%64)
+	}
+	return ok
+}
+
+// Remove removes the given value from the set.
+func (s *Status_Set) Remove(v Status) {
+	if i, ok := v.Ordinal(); ok {
+		s.bits[i/64] &^= 1 << uint(i%64)
+	}
+}
+
+// Contains returns true if the given value is in the set.
+func (s *Status_Set) Contains(v Status) bool {
+	i, ok := v.Ordinal()
+	return ok && s.bits[i/64]&(1<<uint(i%64)) != 0
+}
+
+// Len returns the number of values in the set.
+func (s *Status_Set) Len() int {
+	n := 0
+	for _, x := range s.bits {
+		for ; x != 0; n++ {
+			x &= x - 1
+		}
+	}
+	return n
+}
+
+// Values returns the values in the set in the order in which they
+// were declared.
+func (s *Status_Set) Values() []Status {
+	v := make([]Status, 0, s.Len())
+	if s.bits[0]&(1<<0) != 0 {
+		v = append(v, StatusActive)
+	}
+	if s.bits[0]&(1<<1) != 0 {
+		v = append(v, StatusInactive)
+	}
+	return v
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Status.
+//
+// Enums are logged as objects, where the value is logged with key
+// "value", and if this value's name is known, the name is logged with
+// key "name".
+func (v Status) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "ACTIVE")
+	case 1:
+		enc.AddString("name", "INACTIVE")
+	}
+	return nil
+}
+
+type Timestamp int64
+
+// ToWire translates Timestamp into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Timestamp) ToWire() (wire.Value, error) {
+	x := (int64)(v)
+	return wire.NewValueI64(x), nil
+}
+
+// String returns a readable string representation of Timestamp.
+func (v Timestamp) String() string {
+	x := (int64)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes Timestamp from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Timestamp) FromWire(w wire.Value) error {
+	*v = (Timestamp)(w.GetI64())
+	return nil
+}
+
+// Equals returns true if this Timestamp is equal to the provided
+// Timestamp.
+func (lhs Timestamp) Equals(rhs Timestamp) bool {
+	return (lhs == rhs)
+}
+
+// Clone returns a deep copy of this Timestamp.
+func (v Timestamp) Clone() Timestamp {
+	x := (int64)(v)
+	return (Timestamp)(x)
+}
+
+type User struct {
+	Name      UserName          `json:"name,required"`
+	Status    Status            `json:"status,required"`
+	CreatedAt Timestamp         `json:"createdAt,required"`
+	Home      *Location         `json:"home,required"`
+	Emails    []string          `json:"emails,required"`
+	Places    map[string]*Point `json:"places,required"`
+	ID        wire.UUID         `json:"id,required"`
+	Nickname  *string           `json:"nickname,omitempty"`
+	Avatar    []byte            `json:"avatar,omitempty"`
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		if err := f(wire.NewValueString(x)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+type _Map_String_Point_MapItemList map[string]*Point
+
+func (m _Map_String_Point_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw := wire.NewValueString(k)
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		if err = f(wire.MapItem{Key: kw, Value: vw}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_Point_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_Point_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Point_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_String_Point_MapItemList) Close() {}
+
+// ToWire translates a User struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *User) ToWire() (wire.Value, error) {
+	var (
+		fields [9]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("User is nil")
+	}
+
+	w, err = v.Name.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w, err = v.Status.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+
+	w, err = v.CreatedAt.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 3, Value: w}
+	i++
+	if v.Home == nil {
+		return w, errors.New("field Home of User is required")
+	}
+	w, err = v.Home.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 4, Value: w}
+	i++
+	if v.Emails == nil {
+		return w, errors.New("field Emails of User is required")
+	}
+	w = wire.NewValueList(_List_String_ValueList(v.Emails))
+	fields[i] = wire.Field{ID: 5, Value: w}
+	i++
+	if v.Places == nil {
+		return w, errors.New("field Places of User is required")
+	}
+	w = wire.NewValueMap(_Map_String_Point_MapItemList(v.Places))
+	fields[i] = wire.Field{ID: 6, Value: w}
+	i++
+
+	w = wire.NewValueUUID(v.ID)
+	fields[i] = wire.Field{ID: 7, Value: w}
+	i++
+	if v.Nickname != nil {
+		w = wire.NewValueString(*(v.Nickname))
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+	if v.Avatar != nil {
+		w = wire.NewValueBinary(v.Avatar)
+		fields[i] = wire.Field{ID: 9, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _UserName_Read(w wire.Value) (UserName, error) {
+	var x UserName
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _Status_Read(w wire.Value) (Status, error) {
+	var v Status
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _Timestamp_Read(w wire.Value) (Timestamp, error) {
+	var x Timestamp
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _Location_Read(w wire.Value) (*Location, error) {
+	var x Location
+	err := x.FromWire(w)
+	return &x, err
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		o = append(o, x.GetString())
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Point_Read(w wire.Value) (*Point, error) {
+	var v Point
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_String_Point_Read(m wire.MapItemList) (map[string]*Point, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]*Point, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k := x.Key.GetString()
+
+		v, err := _Point_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a User struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a User struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v User
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *User) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+	statusIsSet := false
+	createdAtIsSet := false
+	homeIsSet := false
+	emailsIsSet := false
+	placesIsSet := false
+	idIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = _UserName_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				v.Status, err = _Status_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				statusIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TI64 {
+				v.CreatedAt, err = _Timestamp_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				createdAtIsSet = true
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.Home, err = _Location_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				homeIsSet = true
+			}
+		case 5:
+			if field.Value.Type() == wire.TList {
+				v.Emails, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+				emailsIsSet = true
+			}
+		case 6:
+			if field.Value.Type() == wire.TMap {
+				v.Places, err = _Map_String_Point_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+				placesIsSet = true
+			}
+		case 7:
+			if field.Value.Type() == wire.TUUID {
+				v.ID = field.Value.GetUUID()
+				idIsSet = true
+			}
+		case 8:
+			if field.Value.Type() == wire.TBinary {
+				x := field.Value.GetString()
+				v.Nickname = &x
+
+			}
+		case 9:
+			if field.Value.Type() == wire.TBinary {
+				v.Avatar = field.Value.GetBinary()
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of User is required")
+	}
+
+	if !statusIsSet {
+		return errors.New("field Status of User is required")
+	}
+
+	if !createdAtIsSet {
+		return errors.New("field CreatedAt of User is required")
+	}
+
+	if !homeIsSet {
+		return errors.New("field Home of User is required")
+	}
+
+	if !emailsIsSet {
+		return errors.New("field Emails of User is required")
+	}
+
+	if !placesIsSet {
+		return errors.New("field Places of User is required")
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of User is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a User
+// struct.
+func (v *User) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [9]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	fields[i] = fmt.Sprintf("Status: %v", v.Status)
+	i++
+	fields[i] = fmt.Sprintf("CreatedAt: %v", v.CreatedAt)
+	i++
+	fields[i] = fmt.Sprintf("Home: %v", v.Home)
+	i++
+	fields[i] = fmt.Sprintf("Emails: %v", v.Emails)
+	i++
+	fields[i] = fmt.Sprintf("Places: %v", v.Places)
+	i++
+	fields[i] = fmt.Sprintf("ID: %v", v.ID)
+	i++
+	if v.Nickname != nil {
+		fields[i] = fmt.Sprintf("Nickname: %v", *(v.Nickname))
+		i++
+	}
+	if v.Avatar != nil {
+		fields[i] = fmt.Sprintf("Avatar: %v", v.Avatar)
+		i++
+	}
+
+	return fmt.Sprintf("User{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_Point_Equals(lhs, rhs map[string]*Point) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this User match the
+// provided User.
+//
+// This function performs a deep comparison.
+func (v *User) Equals(rhs *User) bool {
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !v.Status.Equals(rhs.Status) {
+		return false
+	}
+	if !(v.CreatedAt == rhs.CreatedAt) {
+		return false
+	}
+	if !v.Home.Equals(rhs.Home) {
+		return false
+	}
+	if !_List_String_Equals(v.Emails, rhs.Emails) {
+		return false
+	}
+	if !_Map_String_Point_Equals(v.Places, rhs.Places) {
+		return false
+	}
+	if !(v.ID == rhs.ID) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Nickname, rhs.Nickname) {
+		return false
+	}
+	if !((v.Avatar == nil && rhs.Avatar == nil) || (v.Avatar != nil && rhs.Avatar != nil && bytes.Equal(v.Avatar, rhs.Avatar))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_String_Clone(l []string) []string {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]string, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
+}
+
+func _Map_String_Point_Clone(m map[string]*Point) map[string]*Point {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[string]*Point, len(m))
+	for k, v := range m {
+		o[k] = v.Clone()
+	}
+
+	return o
+}
+
+// Clone returns a deep copy of this User.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *User) Clone() *User {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Home = v.Home.Clone()
+	o.Emails = _List_String_Clone(v.Emails)
+	o.Places = _Map_String_Point_Clone(v.Places)
+	o.Nickname = _String_ClonePtr(v.Nickname)
+	o.Avatar = _Binary_Clone(v.Avatar)
+
+	return &o
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return nil
+}
+
+type _Map_String_Point_Zapper map[string]*Point
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_Point_Zapper.
+func (m _Map_String_Point_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for k, v := range m {
+		if err := enc.AddObject((string)(k), v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of User.
+func (v *User) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("name", (string)(v.Name))
+	if err := enc.AddObject("status", v.Status); err != nil {
+		return err
+	}
+	enc.AddInt64("createdAt", (int64)(v.CreatedAt))
+	if err := enc.AddObject("home", v.Home); err != nil {
+		return err
+	}
+	if err := enc.AddArray("emails", (_List_String_Zapper)(v.Emails)); err != nil {
+		return err
+	}
+	if err := enc.AddObject("places", (_Map_String_Point_Zapper)(v.Places)); err != nil {
+		return err
+	}
+	enc.AddString("id", (v.ID).String())
+	if v.Nickname != nil {
+		enc.AddString("nickname", *v.Nickname)
+	}
+	if v.Avatar != nil {
+		enc.AddString("avatar", base64.StdEncoding.EncodeToString(v.Avatar))
+	}
+	return nil
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil User.
+func (v *User) GetName() (o UserName) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetStatus returns the value of Status if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil User.
+func (v *User) GetStatus() (o Status) {
+	if v != nil {
+		o = v.Status
+	}
+	return
+}
+
+// GetCreatedAt returns the value of CreatedAt if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil User.
+func (v *User) GetCreatedAt() (o Timestamp) {
+	if v != nil {
+		o = v.CreatedAt
+	}
+	return
+}
+
+// GetHome returns the value of Home if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil User.
+func (v *User) GetHome() (o *Location) {
+	if v != nil {
+		o = v.Home
+	}
+	return
+}
+
+// GetEmails returns the value of Emails if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil User.
+func (v *User) GetEmails() (o []string) {
+	if v != nil {
+		o = v.Emails
+	}
+	return
+}
+
+// GetPlaces returns the value of Places if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil User.
+func (v *User) GetPlaces() (o map[string]*Point) {
+	if v != nil {
+		o = v.Places
+	}
+	return
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil User.
+func (v *User) GetID() (o wire.UUID) {
+	if v != nil {
+		o = v.ID
+	}
+	return
+}
+
+// GetNickname returns the value of Nickname if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil User.
+func (v *User) GetNickname() (o string) {
+	if v != nil && v.Nickname != nil {
+		return *v.Nickname
+	}
+
+	return
+}
+
+// IsSetNickname returns true if Nickname is not nil.
+//
+// This is safe to call on a nil User.
+func (v *User) IsSetNickname() bool {
+	return v != nil && v.Nickname != nil
+}
+
+// GetAvatar returns the value of Avatar if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil User.
+func (v *User) GetAvatar() (o []byte) {
+	if v != nil && v.Avatar != nil {
+		return v.Avatar
+	}
+
+	return
+}
+
+// IsSetAvatar returns true if Avatar is not nil.
+//
+// This is safe to call on a nil User.
+func (v *User) IsSetAvatar() bool {
+	return v != nil && v.Avatar != nil
+}
+
+type UserName string
+
+// ToWire translates UserName into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v UserName) ToWire() (wire.Value, error) {
+	x := (string)(v)
+	return wire.NewValueString(x), nil
+}
+
+// String returns a readable string representation of UserName.
+func (v UserName) String() string {
+	x := (string)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes UserName from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *UserName) FromWire(w wire.Value) error {
+	*v = (UserName)(w.GetString())
+	return nil
+}
+
+// Equals returns true if this UserName is equal to the provided
+// UserName.
+func (lhs UserName) Equals(rhs UserName) bool {
+	return (lhs == rhs)
+}
+
+// Clone returns a deep copy of this UserName.
+func (v UserName) Clone() UserName {
+	x := (string)(v)
+	return (UserName)(x)
+}
+
+type UserNotFound struct {
+	Name    string  `json:"name,required"`
+	Message *string `json:"message,omitempty"`
+}
+
+// ToWire translates a UserNotFound struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *UserNotFound) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("UserNotFound is nil")
+	}
+
+	w = wire.NewValueString(v.Name)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Message != nil {
+		w = wire.NewValueString(*(v.Message))
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a UserNotFound struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a UserNotFound struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v UserNotFound
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *UserNotFound) FromWire(w wire.Value) error {
+
+	nameIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name = field.Value.GetString()
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				x := field.Value.GetString()
+				v.Message = &x
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of UserNotFound is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a UserNotFound
+// struct.
+func (v *UserNotFound) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	if v.Message != nil {
+		fields[i] = fmt.Sprintf("Message: %v", *(v.Message))
+		i++
+	}
+
+	return fmt.Sprintf("UserNotFound{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this UserNotFound match the
+// provided UserNotFound.
+//
+// This function performs a deep comparison.
+func (v *UserNotFound) Equals(rhs *UserNotFound) bool {
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Message, rhs.Message) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this UserNotFound.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *UserNotFound) Clone() *UserNotFound {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Message = _String_ClonePtr(v.Message)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of UserNotFound.
+func (v *UserNotFound) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("name", v.Name)
+	if v.Message != nil {
+		enc.AddString("message", *v.Message)
+	}
+	return nil
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil UserNotFound.
+func (v *UserNotFound) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil UserNotFound.
+func (v *UserNotFound) GetMessage() (o string) {
+	if v != nil && v.Message != nil {
+		return *v.Message
+	}
+
+	return
+}
+
+// IsSetMessage returns true if Message is not nil.
+//
+// This is safe to call on a nil UserNotFound.
+func (v *UserNotFound) IsSetMessage() bool {
+	return v != nil && v.Message != nil
+}
+
+// Error returns the message of the exception if it is set and the
+// String representation of the exception otherwise.
+func (v *UserNotFound) Error() string {
+	if m := v.GetMessage(); m != "" {
+		return m
+	}
+	return v.String()
+}
@@ -0,0 +1,18 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package exception_families
+
+import "go.uber.org/thriftrw/thriftreflect"
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:            "exception_families",
+	Package:         "go.uber.org/thriftrw/gen/testdata/exception_families",
+	FilePath:        "exception_families.thrift",
+	SHA1:            "cbafcd9962da7d11fc24240592c2804d574a65db",
+	ThriftRWVersion: "1.9.0",
+	Raw:             rawIDL,
+}
+
+const rawIDL = "// Exceptions of a family declare the same base fields so that handlers may\n// treat them uniformly through the interface generated for the family.\n\nexception NotFoundError {\n    1: required i32 code\n    2: required string message\n    3: optional string key\n} (thriftrw.family = \"ServiceError\")\n\nexception UnavailableError {\n    1: required i32 code\n    2: optional string message\n    3: optional i64 retryAfterMs\n} (thriftrw.family = \"ServiceError\")\n\nexception InvalidArgumentError {\n    1: required string argument\n    2: optional string reason\n} (thriftrw.family = \"RequestError\", thriftrw.family.fields = \"argument\")\n"
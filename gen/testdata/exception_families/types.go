@@ -0,0 +1,711 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package exception_families
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
+)
+
+type InvalidArgumentError struct {
+	Argument string  `json:"argument,required"`
+	Reason   *string `json:"reason,omitempty"`
+}
+
+// ToWire translates a InvalidArgumentError struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *InvalidArgumentError) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("InvalidArgumentError is nil")
+	}
+
+	w = wire.NewValueString(v.Argument)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Reason != nil {
+		w = wire.NewValueString(*(v.Reason))
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a InvalidArgumentError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a InvalidArgumentError struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v InvalidArgumentError
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *InvalidArgumentError) FromWire(w wire.Value) error {
+
+	argumentIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Argument = field.Value.GetString()
+				argumentIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				x := field.Value.GetString()
+				v.Reason = &x
+
+			}
+		}
+	}
+
+	if !argumentIsSet {
+		return errors.New("field Argument of InvalidArgumentError is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a InvalidArgumentError
+// struct.
+func (v *InvalidArgumentError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Argument: %v", v.Argument)
+	i++
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+		i++
+	}
+
+	return fmt.Sprintf("InvalidArgumentError{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this InvalidArgumentError match the
+// provided InvalidArgumentError.
+//
+// This function performs a deep comparison.
+func (v *InvalidArgumentError) Equals(rhs *InvalidArgumentError) bool {
+	if !(v.Argument == rhs.Argument) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Reason, rhs.Reason) {
+		return false
+	}
+
+	return true
+}
+
+func _String_ClonePtr(p *string) *string {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this InvalidArgumentError.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *InvalidArgumentError) Clone() *InvalidArgumentError {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Reason = _String_ClonePtr(v.Reason)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of InvalidArgumentError.
+func (v *InvalidArgumentError) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("argument", v.Argument)
+	if v.Reason != nil {
+		enc.AddString("reason", *v.Reason)
+	}
+	return nil
+}
+
+// GetArgument returns the value of Argument if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil InvalidArgumentError.
+func (v *InvalidArgumentError) GetArgument() (o string) {
+	if v != nil {
+		o = v.Argument
+	}
+	return
+}
+
+// GetReason returns the value of Reason if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil InvalidArgumentError.
+func (v *InvalidArgumentError) GetReason() (o string) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
+	}
+
+	return
+}
+
+// IsSetReason returns true if Reason is not nil.
+//
+// This is safe to call on a nil InvalidArgumentError.
+func (v *InvalidArgumentError) IsSetReason() bool {
+	return v != nil && v.Reason != nil
+}
+
+func (v *InvalidArgumentError) Error() string {
+	return v.String()
+}
+
+type NotFoundError struct {
+	Code    int32   `json:"code,required"`
+	Message string  `json:"message,required"`
+	Key     *string `json:"key,omitempty"`
+}
+
+// ToWire translates a NotFoundError struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *NotFoundError) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("NotFoundError is nil")
+	}
+
+	w = wire.NewValueI32(v.Code)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w = wire.NewValueString(v.Message)
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	if v.Key != nil {
+		w = wire.NewValueString(*(v.Key))
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a NotFoundError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a NotFoundError struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v NotFoundError
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *NotFoundError) FromWire(w wire.Value) error {
+
+	codeIsSet := false
+	messageIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI32 {
+				v.Code = field.Value.GetI32()
+				codeIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.Message = field.Value.GetString()
+				messageIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TBinary {
+				x := field.Value.GetString()
+				v.Key = &x
+
+			}
+		}
+	}
+
+	if !codeIsSet {
+		return errors.New("field Code of NotFoundError is required")
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of NotFoundError is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a NotFoundError
+// struct.
+func (v *NotFoundError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	fields[i] = fmt.Sprintf("Code: %v", v.Code)
+	i++
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+	if v.Key != nil {
+		fields[i] = fmt.Sprintf("Key: %v", *(v.Key))
+		i++
+	}
+
+	return fmt.Sprintf("NotFoundError{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this NotFoundError match the
+// provided NotFoundError.
+//
+// This function performs a deep comparison.
+func (v *NotFoundError) Equals(rhs *NotFoundError) bool {
+	if !(v.Code == rhs.Code) {
+		return false
+	}
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Key, rhs.Key) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this NotFoundError.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *NotFoundError) Clone() *NotFoundError {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Key = _String_ClonePtr(v.Key)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of NotFoundError.
+func (v *NotFoundError) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddInt32("code", v.Code)
+	enc.AddString("message", v.Message)
+	if v.Key != nil {
+		enc.AddString("key", *v.Key)
+	}
+	return nil
+}
+
+// GetCode returns the value of Code if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil NotFoundError.
+func (v *NotFoundError) GetCode() (o int32) {
+	if v != nil {
+		o = v.Code
+	}
+	return
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil NotFoundError.
+func (v *NotFoundError) GetMessage() (o string) {
+	if v != nil {
+		o = v.Message
+	}
+	return
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil NotFoundError.
+func (v *NotFoundError) GetKey() (o string) {
+	if v != nil && v.Key != nil {
+		return *v.Key
+	}
+
+	return
+}
+
+// IsSetKey returns true if Key is not nil.
+//
+// This is safe to call on a nil NotFoundError.
+func (v *NotFoundError) IsSetKey() bool {
+	return v != nil && v.Key != nil
+}
+
+// Error returns the message of the exception if it is set and the
+// String representation of the exception otherwise.
+func (v *NotFoundError) Error() string {
+	if m := v.GetMessage(); m != "" {
+		return m
+	}
+	return v.String()
+}
+
+type UnavailableError struct {
+	Code         int32   `json:"code,required"`
+	Message      *string `json:"message,omitempty"`
+	RetryAfterMs *int64  `json:"retryAfterMs,omitempty"`
+}
+
+// ToWire translates a UnavailableError struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *UnavailableError) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("UnavailableError is nil")
+	}
+
+	w = wire.NewValueI32(v.Code)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Message != nil {
+		w = wire.NewValueString(*(v.Message))
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.RetryAfterMs != nil {
+		w = wire.NewValueI64(*(v.RetryAfterMs))
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a UnavailableError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a UnavailableError struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v UnavailableError
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *UnavailableError) FromWire(w wire.Value) error {
+
+	codeIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI32 {
+				v.Code = field.Value.GetI32()
+				codeIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				x := field.Value.GetString()
+				v.Message = &x
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TI64 {
+				x := field.Value.GetI64()
+				v.RetryAfterMs = &x
+
+			}
+		}
+	}
+
+	if !codeIsSet {
+		return errors.New("field Code of UnavailableError is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a UnavailableError
+// struct.
+func (v *UnavailableError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	fields[i] = fmt.Sprintf("Code: %v", v.Code)
+	i++
+	if v.Message != nil {
+		fields[i] = fmt.Sprintf("Message: %v", *(v.Message))
+		i++
+	}
+	if v.RetryAfterMs != nil {
+		fields[i] = fmt.Sprintf("RetryAfterMs: %v", *(v.RetryAfterMs))
+		i++
+	}
+
+	return fmt.Sprintf("UnavailableError{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this UnavailableError match the
+// provided UnavailableError.
+//
+// This function performs a deep comparison.
+func (v *UnavailableError) Equals(rhs *UnavailableError) bool {
+	if !(v.Code == rhs.Code) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Message, rhs.Message) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.RetryAfterMs, rhs.RetryAfterMs) {
+		return false
+	}
+
+	return true
+}
+
+func _I64_ClonePtr(p *int64) *int64 {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this UnavailableError.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *UnavailableError) Clone() *UnavailableError {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Message = _String_ClonePtr(v.Message)
+	o.RetryAfterMs = _I64_ClonePtr(v.RetryAfterMs)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of UnavailableError.
+func (v *UnavailableError) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddInt32("code", v.Code)
+	if v.Message != nil {
+		enc.AddString("message", *v.Message)
+	}
+	if v.RetryAfterMs != nil {
+		enc.AddInt64("retryAfterMs", *v.RetryAfterMs)
+	}
+	return nil
+}
+
+// GetCode returns the value of Code if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil UnavailableError.
+func (v *UnavailableError) GetCode() (o int32) {
+	if v != nil {
+		o = v.Code
+	}
+	return
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil UnavailableError.
+func (v *UnavailableError) GetMessage() (o string) {
+	if v != nil && v.Message != nil {
+		return *v.Message
+	}
+
+	return
+}
+
+// IsSetMessage returns true if Message is not nil.
+//
+// This is safe to call on a nil UnavailableError.
+func (v *UnavailableError) IsSetMessage() bool {
+	return v != nil && v.Message != nil
+}
+
+// GetRetryAfterMs returns the value of RetryAfterMs if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil UnavailableError.
+func (v *UnavailableError) GetRetryAfterMs() (o int64) {
+	if v != nil && v.RetryAfterMs != nil {
+		return *v.RetryAfterMs
+	}
+
+	return
+}
+
+// IsSetRetryAfterMs returns true if RetryAfterMs is not nil.
+//
+// This is safe to call on a nil UnavailableError.
+func (v *UnavailableError) IsSetRetryAfterMs() bool {
+	return v != nil && v.RetryAfterMs != nil
+}
+
+// Error returns the message of the exception if it is set and the
+// String representation of the exception otherwise.
+func (v *UnavailableError) Error() string {
+	if m := v.GetMessage(); m != "" {
+		return m
+	}
+	return v.String()
+}
+
+// RequestError is implemented by all exceptions of the RequestError
+// exception family.
+type RequestError interface {
+	error
+
+	// GetArgument returns the value of the argument field of the
+	// exception.
+	GetArgument() string
+}
+
+// ServiceError is implemented by all exceptions of the ServiceError
+// exception family.
+type ServiceError interface {
+	error
+
+	// GetCode returns the value of the code field of the
+	// exception.
+	GetCode() int32
+
+	// GetMessage returns the value of the message field of the
+	// exception.
+	GetMessage() string
+}
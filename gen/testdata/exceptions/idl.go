@@ -7,11 +7,12 @@ import "go.uber.org/thriftrw/thriftreflect"
 
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
-	Name:     "exceptions",
-	Package:  "go.uber.org/thriftrw/gen/testdata/exceptions",
-	FilePath: "exceptions.thrift",
-	SHA1:     "743daa9bfc5a3d69637e7c67dd6f35a7d10e79a3",
-	Raw:      rawIDL,
+	Name:            "exceptions",
+	Package:         "go.uber.org/thriftrw/gen/testdata/exceptions",
+	FilePath:        "exceptions.thrift",
+	SHA1:            "56ec51c59b5f1d709d64ad48647d53a9afcd1a9c",
+	ThriftRWVersion: "1.9.0",
+	Raw:             rawIDL,
 }
 
-const rawIDL = "exception EmptyException {}\n\n/**\n * Raised when something doesn't exist.\n */\nexception DoesNotExistException {\n    /** Key that was missing. */\n    1: required string key\n    2: optional string Error (go.name=\"Error2\")\n}\n"
+const rawIDL = "exception EmptyException {}\n\n/**\n * Raised when something doesn't exist.\n */\nexception DoesNotExistException {\n    /** Key that was missing. */\n    1: required string key\n    2: optional string Error (go.name=\"Error2\")\n}\n\ntypedef string Reason\n\n/**\n * Raised when a request takes too long.\n */\nexception TimeoutException {\n    1: optional Reason reason\n    2: optional i64 elapsedMillis\n} (thriftrw.message = \"reason\")\n\n/**\n * Raised when a caller is not allowed to make a request. Its message is not\n * used as the error message because it is redacted.\n */\nexception PermissionDenied {\n    1: optional string message (thriftrw.redact = \"true\")\n}\n"
@@ -6,8 +6,10 @@ package exceptions
 import (
 	"errors"
 	"fmt"
-	"go.uber.org/thriftrw/wire"
 	"strings"
+
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
 )
 
 // Raised when something doesn't exist.
@@ -37,20 +39,17 @@ func (v *DoesNotExistException) ToWire() (wire.Value, error) {
 		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
-	w, err = wire.NewValueString(v.Key), error(nil)
-	if err != nil {
-		return w, err
+	if v == nil {
+		return wire.Value{}, errors.New("DoesNotExistException is nil")
 	}
+
+	w = wire.NewValueString(v.Key)
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 	if v.Error2 != nil {
-		w, err = wire.NewValueString(*(v.Error2)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueString(*(v.Error2))
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
@@ -76,7 +75,6 @@ func (v *DoesNotExistException) ToWire() (wire.Value, error) {
 //   }
 //   return &v, nil
 func (v *DoesNotExistException) FromWire(w wire.Value) error {
-	var err error
 
 	keyIsSet := false
 
@@ -84,20 +82,13 @@ func (v *DoesNotExistException) FromWire(w wire.Value) error {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TBinary {
-				v.Key, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.Key = field.Value.GetString()
 				keyIsSet = true
 			}
 		case 2:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
+				x := field.Value.GetString()
 				v.Error2 = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		}
@@ -154,16 +145,74 @@ func (v *DoesNotExistException) Equals(rhs *DoesNotExistException) bool {
 	return true
 }
 
+func _String_ClonePtr(p *string) *string {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this DoesNotExistException.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *DoesNotExistException) Clone() *DoesNotExistException {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Error2 = _String_ClonePtr(v.Error2)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DoesNotExistException.
+func (v *DoesNotExistException) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("key", v.Key)
+	if v.Error2 != nil {
+		enc.AddString("Error", *v.Error2)
+	}
+	return nil
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil DoesNotExistException.
+func (v *DoesNotExistException) GetKey() (o string) {
+	if v != nil {
+		o = v.Key
+	}
+	return
+}
+
 // GetError2 returns the value of Error2 if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil DoesNotExistException.
 func (v *DoesNotExistException) GetError2() (o string) {
-	if v.Error2 != nil {
+	if v != nil && v.Error2 != nil {
 		return *v.Error2
 	}
 
 	return
 }
 
+// IsSetError2 returns true if Error2 is not nil.
+//
+// This is safe to call on a nil DoesNotExistException.
+func (v *DoesNotExistException) IsSetError2() bool {
+	return v != nil && v.Error2 != nil
+}
+
 func (v *DoesNotExistException) Error() string {
 	return v.String()
 }
@@ -244,6 +293,473 @@ func (v *EmptyException) Equals(rhs *EmptyException) bool {
 	return true
 }
 
+// Clone returns a deep copy of this EmptyException.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *EmptyException) Clone() *EmptyException {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of EmptyException.
+func (v *EmptyException) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	return nil
+}
+
 func (v *EmptyException) Error() string {
 	return v.String()
 }
+
+// Raised when a caller is not allowed to make a request. Its message is not
+// used as the error message because it is redacted.
+type PermissionDenied struct {
+	Message *string `json:"message,omitempty"`
+}
+
+// ToWire translates a PermissionDenied struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *PermissionDenied) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("PermissionDenied is nil")
+	}
+
+	if v.Message != nil {
+		w = wire.NewValueString(*(v.Message))
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a PermissionDenied struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a PermissionDenied struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v PermissionDenied
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *PermissionDenied) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				x := field.Value.GetString()
+				v.Message = &x
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a PermissionDenied
+// struct.
+func (v *PermissionDenied) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Message != nil {
+		fields[i] = "Message: <redacted>"
+		i++
+	}
+
+	return fmt.Sprintf("PermissionDenied{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this PermissionDenied match the
+// provided PermissionDenied.
+//
+// This function performs a deep comparison.
+func (v *PermissionDenied) Equals(rhs *PermissionDenied) bool {
+	if !_String_EqualsPtr(v.Message, rhs.Message) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this PermissionDenied.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *PermissionDenied) Clone() *PermissionDenied {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Message = _String_ClonePtr(v.Message)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PermissionDenied.
+func (v *PermissionDenied) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Message != nil {
+		enc.AddString("message", "<redacted>")
+	}
+	return nil
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil PermissionDenied.
+func (v *PermissionDenied) GetMessage() (o string) {
+	if v != nil && v.Message != nil {
+		return *v.Message
+	}
+
+	return
+}
+
+// IsSetMessage returns true if Message is not nil.
+//
+// This is safe to call on a nil PermissionDenied.
+func (v *PermissionDenied) IsSetMessage() bool {
+	return v != nil && v.Message != nil
+}
+
+func (v *PermissionDenied) Error() string {
+	return v.String()
+}
+
+type Reason string
+
+// ToWire translates Reason into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Reason) ToWire() (wire.Value, error) {
+	x := (string)(v)
+	return wire.NewValueString(x), nil
+}
+
+// String returns a readable string representation of Reason.
+func (v Reason) String() string {
+	x := (string)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes Reason from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Reason) FromWire(w wire.Value) error {
+	*v = (Reason)(w.GetString())
+	return nil
+}
+
+// Equals returns true if this Reason is equal to the provided
+// Reason.
+func (lhs Reason) Equals(rhs Reason) bool {
+	return (lhs == rhs)
+}
+
+// Clone returns a deep copy of this Reason.
+func (v Reason) Clone() Reason {
+	x := (string)(v)
+	return (Reason)(x)
+}
+
+// Raised when a request takes too long.
+type TimeoutException struct {
+	Reason        *Reason `json:"reason,omitempty"`
+	ElapsedMillis *int64  `json:"elapsedMillis,omitempty"`
+}
+
+// ToWire translates a TimeoutException struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *TimeoutException) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("TimeoutException is nil")
+	}
+
+	if v.Reason != nil {
+		w, err = v.Reason.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.ElapsedMillis != nil {
+		w = wire.NewValueI64(*(v.ElapsedMillis))
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Reason_Read(w wire.Value) (Reason, error) {
+	var x Reason
+	err := x.FromWire(w)
+	return x, err
+}
+
+// FromWire deserializes a TimeoutException struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a TimeoutException struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v TimeoutException
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *TimeoutException) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				var x Reason
+				x, err = _Reason_Read(field.Value)
+				v.Reason = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TI64 {
+				x := field.Value.GetI64()
+				v.ElapsedMillis = &x
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a TimeoutException
+// struct.
+func (v *TimeoutException) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Reason != nil {
+		fields[i] = fmt.Sprintf("Reason: %v", *(v.Reason))
+		i++
+	}
+	if v.ElapsedMillis != nil {
+		fields[i] = fmt.Sprintf("ElapsedMillis: %v", *(v.ElapsedMillis))
+		i++
+	}
+
+	return fmt.Sprintf("TimeoutException{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Reason_EqualsPtr(lhs, rhs *Reason) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this TimeoutException match the
+// provided TimeoutException.
+//
+// This function performs a deep comparison.
+func (v *TimeoutException) Equals(rhs *TimeoutException) bool {
+	if !_Reason_EqualsPtr(v.Reason, rhs.Reason) {
+		return false
+	}
+	if !_I64_EqualsPtr(v.ElapsedMillis, rhs.ElapsedMillis) {
+		return false
+	}
+
+	return true
+}
+
+func _Reason_ClonePtr(p *Reason) *Reason {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _I64_ClonePtr(p *int64) *int64 {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this TimeoutException.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *TimeoutException) Clone() *TimeoutException {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Reason = _Reason_ClonePtr(v.Reason)
+	o.ElapsedMillis = _I64_ClonePtr(v.ElapsedMillis)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of TimeoutException.
+func (v *TimeoutException) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Reason != nil {
+		enc.AddString("reason", (string)(*v.Reason))
+	}
+	if v.ElapsedMillis != nil {
+		enc.AddInt64("elapsedMillis", *v.ElapsedMillis)
+	}
+	return nil
+}
+
+// GetReason returns the value of Reason if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil TimeoutException.
+func (v *TimeoutException) GetReason() (o Reason) {
+	if v != nil && v.Reason != nil {
+		return *v.Reason
+	}
+
+	return
+}
+
+// IsSetReason returns true if Reason is not nil.
+//
+// This is safe to call on a nil TimeoutException.
+func (v *TimeoutException) IsSetReason() bool {
+	return v != nil && v.Reason != nil
+}
+
+// GetElapsedMillis returns the value of ElapsedMillis if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil TimeoutException.
+func (v *TimeoutException) GetElapsedMillis() (o int64) {
+	if v != nil && v.ElapsedMillis != nil {
+		return *v.ElapsedMillis
+	}
+
+	return
+}
+
+// IsSetElapsedMillis returns true if ElapsedMillis is not nil.
+//
+// This is safe to call on a nil TimeoutException.
+func (v *TimeoutException) IsSetElapsedMillis() bool {
+	return v != nil && v.ElapsedMillis != nil
+}
+
+// Error returns the reason of the exception if it is set and the
+// String representation of the exception otherwise.
+func (v *TimeoutException) Error() string {
+	if m := v.GetReason(); m != "" {
+		return string(m)
+	}
+	return v.String()
+}
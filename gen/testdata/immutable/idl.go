@@ -0,0 +1,18 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package immutable
+
+import "go.uber.org/thriftrw/thriftreflect"
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:            "immutable",
+	Package:         "go.uber.org/thriftrw/gen/testdata/immutable",
+	FilePath:        "immutable.thrift",
+	SHA1:            "fd269e3ff6090bd2823eabc4a4110bcd660e2ea2",
+	ThriftRWVersion: "1.9.0",
+	Raw:             rawIDL,
+}
+
+const rawIDL = "// Code for this file is generated with --immutable.\n\nenum Status {\n    ACTIVE, SUSPENDED\n}\n\nstruct Address {\n    1: required string street\n    2: optional string city\n}\n\nstruct Account {\n    1: required i64 ID\n    2: required string type\n    3: optional string displayName (go.tag = 'json:\"name,omitempty\"')\n    4: optional i32 loginCount (thriftrw.optional = \"value\")\n    5: optional Status status = Status.ACTIVE\n    6: optional Address address\n    7: optional list<string> tags\n    8: optional map<string, i32> limits\n    9: optional bool verified\n}\n\ntypedef Account Admin\n\nunion Credential {\n    1: string password\n    2: binary key\n}\n\nexception AccountNotFound {\n    1: required i64 ID\n    2: optional string message\n}\n\nconst Account defaultAccount = {\n    \"ID\": 1,\n    \"type\": \"user\",\n    \"loginCount\": 0,\n    \"address\": {\"street\": \"1 Main St\"},\n    \"tags\": [\"new\"],\n}\n\nconst Admin rootAdmin = {\n    \"ID\": 0,\n    \"type\": \"admin\",\n}\n"
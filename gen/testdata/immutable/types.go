@@ -0,0 +1,1999 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package immutable
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
+)
+
+type Account struct {
+	id          int64
+	type_       string
+	displayName *string
+	loginCount  int32
+	status      *Status
+	address     *Address
+	tags        []string
+	limits      map[string]int32
+	verified    *bool
+
+	_isSet [1]uint64
+}
+
+func _Status_ptr(v Status) *Status {
+	return &v
+}
+
+// Default_Account constructs a new Account with its fields set to the
+// default values declared for them in the Thrift file.
+func Default_Account() *Account {
+	var v Account
+	v.status = _Status_ptr(StatusActive)
+	return &v
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		if err := f(wire.NewValueString(x)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+type _Map_String_I32_MapItemList map[string]int32
+
+func (m _Map_String_I32_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw := wire.NewValueString(k)
+
+		vw := wire.NewValueI32(v)
+		if err := f(wire.MapItem{Key: kw, Value: vw}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_I32_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_I32_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_I32_MapItemList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_String_I32_MapItemList) Close() {}
+
+// ToWire translates a Account struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Account) ToWire() (wire.Value, error) {
+	var (
+		fields [9]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Account is nil")
+	}
+
+	w = wire.NewValueI64(v.id)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w = wire.NewValueString(v.type_)
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	if v.displayName != nil {
+		w = wire.NewValueString(*(v.displayName))
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.IsSetLoginCount() {
+		w = wire.NewValueI32(v.loginCount)
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.status == nil {
+		v.status = _Status_ptr(StatusActive)
+	}
+	{
+		w, err = v.status.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.address != nil {
+		w, err = v.address.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.tags != nil {
+		w = wire.NewValueList(_List_String_ValueList(v.tags))
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.limits != nil {
+		w = wire.NewValueMap(_Map_String_I32_MapItemList(v.limits))
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+	if v.verified != nil {
+		w = wire.NewValueBool(*(v.verified))
+		fields[i] = wire.Field{ID: 9, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Status_Read(w wire.Value) (Status, error) {
+	var v Status
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _Address_Read(w wire.Value) (*Address, error) {
+	var v Address
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		o = append(o, x.GetString())
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Map_String_I32_Read(m wire.MapItemList) (map[string]int32, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make(map[string]int32, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k := x.Key.GetString()
+
+		v := x.Value.GetI32()
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a Account struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Account struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Account
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Account) FromWire(w wire.Value) error {
+	var err error
+
+	IDIsSet := false
+	typeIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI64 {
+				v.id = field.Value.GetI64()
+				IDIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.type_ = field.Value.GetString()
+				typeIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TBinary {
+				x := field.Value.GetString()
+				v.displayName = &x
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TI32 {
+				v.loginCount = field.Value.GetI32()
+				v._isSet[0] |= 1 << 0
+			}
+		case 5:
+			if field.Value.Type() == wire.TI32 {
+				var x Status
+				x, err = _Status_Read(field.Value)
+				v.status = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TStruct {
+				v.address, err = _Address_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TList {
+				v.tags, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 8:
+			if field.Value.Type() == wire.TMap {
+				v.limits, err = _Map_String_I32_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 9:
+			if field.Value.Type() == wire.TBool {
+				x := field.Value.GetBool()
+				v.verified = &x
+
+			}
+		}
+	}
+
+	if !IDIsSet {
+		return errors.New("field ID of Account is required")
+	}
+
+	if !typeIsSet {
+		return errors.New("field Type of Account is required")
+	}
+
+	if v.status == nil {
+		v.status = _Status_ptr(StatusActive)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Account
+// struct.
+func (v *Account) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [9]string
+	i := 0
+	fields[i] = fmt.Sprintf("ID: %v", v.id)
+	i++
+	fields[i] = fmt.Sprintf("Type: %v", v.type_)
+	i++
+	if v.displayName != nil {
+		fields[i] = fmt.Sprintf("DisplayName: %v", *(v.displayName))
+		i++
+	}
+	if v.IsSetLoginCount() {
+		fields[i] = fmt.Sprintf("LoginCount: %v", v.loginCount)
+		i++
+	}
+	if v.status != nil {
+		fields[i] = fmt.Sprintf("Status: %v", *(v.status))
+		i++
+	}
+	if v.address != nil {
+		fields[i] = fmt.Sprintf("Address: %v", v.address)
+		i++
+	}
+	if v.tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.tags)
+		i++
+	}
+	if v.limits != nil {
+		fields[i] = fmt.Sprintf("Limits: %v", v.limits)
+		i++
+	}
+	if v.verified != nil {
+		fields[i] = fmt.Sprintf("Verified: %v", *(v.verified))
+		i++
+	}
+
+	return fmt.Sprintf("Account{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Status_EqualsPtr(lhs, rhs *Status) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_I32_Equals(lhs, rhs map[string]int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Account match the
+// provided Account.
+//
+// This function performs a deep comparison.
+func (v *Account) Equals(rhs *Account) bool {
+	if !(v.id == rhs.id) {
+		return false
+	}
+	if !(v.type_ == rhs.type_) {
+		return false
+	}
+	if !_String_EqualsPtr(v.displayName, rhs.displayName) {
+		return false
+	}
+	if v.IsSetLoginCount() != rhs.IsSetLoginCount() || !(v.loginCount == rhs.loginCount) {
+		return false
+	}
+	if !_Status_EqualsPtr(v.status, rhs.status) {
+		return false
+	}
+	if !((v.address == nil && rhs.address == nil) || (v.address != nil && rhs.address != nil && v.address.Equals(rhs.address))) {
+		return false
+	}
+	if !((v.tags == nil && rhs.tags == nil) || (v.tags != nil && rhs.tags != nil && _List_String_Equals(v.tags, rhs.tags))) {
+		return false
+	}
+	if !((v.limits == nil && rhs.limits == nil) || (v.limits != nil && rhs.limits != nil && _Map_String_I32_Equals(v.limits, rhs.limits))) {
+		return false
+	}
+	if !_Bool_EqualsPtr(v.verified, rhs.verified) {
+		return false
+	}
+
+	return true
+}
+
+func _String_ClonePtr(p *string) *string {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _Status_ClonePtr(p *Status) *Status {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _List_String_Clone(l []string) []string {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]string, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
+}
+
+func _Map_String_I32_Clone(m map[string]int32) map[string]int32 {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[string]int32, len(m))
+	for k, v := range m {
+		o[k] = v
+	}
+
+	return o
+}
+
+func _Bool_ClonePtr(p *bool) *bool {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this Account.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Account) Clone() *Account {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.displayName = _String_ClonePtr(v.displayName)
+	o.status = _Status_ClonePtr(v.status)
+	o.address = v.address.Clone()
+	o.tags = _List_String_Clone(v.tags)
+	o.limits = _Map_String_I32_Clone(v.limits)
+	o.verified = _Bool_ClonePtr(v.verified)
+
+	return &o
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return nil
+}
+
+type _Map_String_I32_Zapper map[string]int32
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_I32_Zapper.
+func (m _Map_String_I32_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for k, v := range m {
+		enc.AddInt32((string)(k), v)
+	}
+	return nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Account.
+func (v *Account) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddInt64("ID", v.id)
+	enc.AddString("type", v.type_)
+	if v.displayName != nil {
+		enc.AddString("displayName", *v.displayName)
+	}
+	if v.IsSetLoginCount() {
+		enc.AddInt32("loginCount", v.loginCount)
+	}
+	if v.status != nil {
+		if err := enc.AddObject("status", *v.status); err != nil {
+			return err
+		}
+	}
+	if v.address != nil {
+		if err := enc.AddObject("address", v.address); err != nil {
+			return err
+		}
+	}
+	if v.tags != nil {
+		if err := enc.AddArray("tags", (_List_String_Zapper)(v.tags)); err != nil {
+			return err
+		}
+	}
+	if v.limits != nil {
+		if err := enc.AddObject("limits", (_Map_String_I32_Zapper)(v.limits)); err != nil {
+			return err
+		}
+	}
+	if v.verified != nil {
+		enc.AddBool("verified", *v.verified)
+	}
+	return nil
+}
+
+type _Account_JSON struct {
+	ID          int64            `json:"ID,required"`
+	Type        string           `json:"type,required"`
+	DisplayName *string          `json:"name,omitempty"`
+	LoginCount  *int32           `json:"loginCount,omitempty"`
+	Status      *Status          `json:"status,omitempty"`
+	Address     *Address         `json:"address,omitempty"`
+	Tags        []string         `json:"tags,omitempty"`
+	Limits      map[string]int32 `json:"limits,omitempty"`
+	Verified    *bool            `json:"verified,omitempty"`
+}
+
+// MarshalJSON encodes this Account into JSON.
+func (v *Account) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var j _Account_JSON
+	j.ID = v.id
+	j.Type = v.type_
+	j.DisplayName = v.displayName
+	if v.IsSetLoginCount() {
+		j.LoginCount = &v.loginCount
+	}
+	j.Status = v.status
+	j.Address = v.address
+	j.Tags = v.tags
+	j.Limits = v.limits
+	j.Verified = v.verified
+	return json.Marshal(&j)
+}
+
+// UnmarshalJSON decodes a Account from JSON.
+func (v *Account) UnmarshalJSON(b []byte) error {
+	var j _Account_JSON
+	if err := json.Unmarshal(b, &j); err != nil {
+		return err
+	}
+	v.id = j.ID
+	v.type_ = j.Type
+	v.displayName = j.DisplayName
+	if j.LoginCount != nil {
+		v.setLoginCount(*j.LoginCount)
+	}
+	v.status = j.Status
+	v.address = j.Address
+	v.tags = j.Tags
+	v.limits = j.Limits
+	v.verified = j.Verified
+	return nil
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Account.
+func (v *Account) GetID() (o int64) {
+	if v != nil {
+		o = v.id
+	}
+	return
+}
+
+// GetType returns the value of Type if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Account.
+func (v *Account) GetType() (o string) {
+	if v != nil {
+		o = v.type_
+	}
+	return
+}
+
+// GetDisplayName returns the value of DisplayName if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Account.
+func (v *Account) GetDisplayName() (o string) {
+	if v != nil && v.displayName != nil {
+		return *v.displayName
+	}
+
+	return
+}
+
+// IsSetDisplayName returns true if DisplayName is not nil.
+//
+// This is safe to call on a nil Account.
+func (v *Account) IsSetDisplayName() bool {
+	return v != nil && v.displayName != nil
+}
+
+// GetLoginCount returns the value of LoginCount if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Account.
+func (v *Account) GetLoginCount() (o int32) {
+	if v.IsSetLoginCount() {
+		return v.loginCount
+	}
+
+	return
+}
+
+// IsSetLoginCount returns true if LoginCount was set,
+// decoded from its Thrift representation, or has a non-zero value.
+//
+// This is safe to call on a nil Account.
+func (v *Account) IsSetLoginCount() bool {
+	return v != nil && (v.loginCount != 0 || v._isSet[0]&(1<<0) != 0)
+}
+
+// setLoginCount sets the value of LoginCount and marks it as set.
+func (v *Account) setLoginCount(o int32) {
+	v.loginCount = o
+	v._isSet[0] |= 1 << 0
+}
+
+// unsetLoginCount resets LoginCount to its zero value and marks it as
+// unset.
+func (v *Account) unsetLoginCount() {
+	v.loginCount = 0
+	v._isSet[0] &^= 1 << 0
+}
+
+// GetStatus returns the value of Status if it is set or its
+// default value if it is unset.
+//
+// This is safe to call on a nil Account.
+func (v *Account) GetStatus() (o Status) {
+	if v != nil && v.status != nil {
+		return *v.status
+	}
+	o = StatusActive
+	return
+}
+
+// IsSetStatus returns true if Status is not nil.
+//
+// This is safe to call on a nil Account.
+func (v *Account) IsSetStatus() bool {
+	return v != nil && v.status != nil
+}
+
+// GetAddress returns the value of Address if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Account.
+func (v *Account) GetAddress() (o *Address) {
+	if v != nil && v.address != nil {
+		return v.address
+	}
+
+	return
+}
+
+// IsSetAddress returns true if Address is not nil.
+//
+// This is safe to call on a nil Account.
+func (v *Account) IsSetAddress() bool {
+	return v != nil && v.address != nil
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Account.
+func (v *Account) GetTags() (o []string) {
+	if v != nil && v.tags != nil {
+		return v.tags
+	}
+
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+//
+// This is safe to call on a nil Account.
+func (v *Account) IsSetTags() bool {
+	return v != nil && v.tags != nil
+}
+
+// GetLimits returns the value of Limits if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Account.
+func (v *Account) GetLimits() (o map[string]int32) {
+	if v != nil && v.limits != nil {
+		return v.limits
+	}
+
+	return
+}
+
+// IsSetLimits returns true if Limits is not nil.
+//
+// This is safe to call on a nil Account.
+func (v *Account) IsSetLimits() bool {
+	return v != nil && v.limits != nil
+}
+
+// GetVerified returns the value of Verified if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Account.
+func (v *Account) GetVerified() (o bool) {
+	if v != nil && v.verified != nil {
+		return *v.verified
+	}
+
+	return
+}
+
+// IsSetVerified returns true if Verified is not nil.
+//
+// This is safe to call on a nil Account.
+func (v *Account) IsSetVerified() bool {
+	return v != nil && v.verified != nil
+}
+
+// AccountBuilder builds Account structs.
+//
+// 	v, err := NewAccountBuilder().
+// 		SetID(...).
+// 		Build()
+type AccountBuilder struct {
+	v   Account
+	set [9]bool
+}
+
+// NewAccountBuilder returns a new AccountBuilder with all fields that have
+// default values set to their defaults.
+func NewAccountBuilder() *AccountBuilder {
+	var b AccountBuilder
+	b.v.status = _Status_ptr(StatusActive)
+
+	return &b
+}
+
+// SetID sets the value of ID.
+func (b *AccountBuilder) SetID(v int64) *AccountBuilder {
+	b.v.id = v
+	b.set[0] = true
+	return b
+}
+
+// SetType sets the value of Type.
+func (b *AccountBuilder) SetType(v string) *AccountBuilder {
+	b.v.type_ = v
+	b.set[1] = true
+	return b
+}
+
+// SetDisplayName sets the value of DisplayName.
+func (b *AccountBuilder) SetDisplayName(v string) *AccountBuilder {
+	b.v.displayName = &v
+	b.set[2] = true
+	return b
+}
+
+// SetLoginCount sets the value of LoginCount.
+func (b *AccountBuilder) SetLoginCount(v int32) *AccountBuilder {
+	b.v.setLoginCount(v)
+	b.set[3] = true
+	return b
+}
+
+// SetStatus sets the value of Status.
+func (b *AccountBuilder) SetStatus(v Status) *AccountBuilder {
+	b.v.status = &v
+	b.set[4] = true
+	return b
+}
+
+// SetAddress sets the value of Address.
+func (b *AccountBuilder) SetAddress(v *Address) *AccountBuilder {
+	b.v.address = v
+	b.set[5] = true
+	return b
+}
+
+// SetTags sets the value of Tags.
+func (b *AccountBuilder) SetTags(v []string) *AccountBuilder {
+	b.v.tags = v
+	b.set[6] = true
+	return b
+}
+
+// SetLimits sets the value of Limits.
+func (b *AccountBuilder) SetLimits(v map[string]int32) *AccountBuilder {
+	b.v.limits = v
+	b.set[7] = true
+	return b
+}
+
+// SetVerified sets the value of Verified.
+func (b *AccountBuilder) SetVerified(v bool) *AccountBuilder {
+	b.v.verified = &v
+	b.set[8] = true
+	return b
+}
+
+// Build returns the Account built by this AccountBuilder.
+//
+// An error is returned if any of the required fields of Account have
+// not been set.
+func (b *AccountBuilder) Build() (*Account, error) {
+	if !b.set[0] {
+		return nil, errors.New("field ID of Account is required")
+	}
+	if !b.set[1] {
+		return nil, errors.New("field Type of Account is required")
+	}
+
+	v := b.v
+	return &v, nil
+}
+
+type AccountNotFound struct {
+	id      int64
+	message *string
+}
+
+// ToWire translates a AccountNotFound struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *AccountNotFound) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("AccountNotFound is nil")
+	}
+
+	w = wire.NewValueI64(v.id)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.message != nil {
+		w = wire.NewValueString(*(v.message))
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a AccountNotFound struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a AccountNotFound struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v AccountNotFound
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *AccountNotFound) FromWire(w wire.Value) error {
+
+	IDIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI64 {
+				v.id = field.Value.GetI64()
+				IDIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				x := field.Value.GetString()
+				v.message = &x
+
+			}
+		}
+	}
+
+	if !IDIsSet {
+		return errors.New("field ID of AccountNotFound is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a AccountNotFound
+// struct.
+func (v *AccountNotFound) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("ID: %v", v.id)
+	i++
+	if v.message != nil {
+		fields[i] = fmt.Sprintf("Message: %v", *(v.message))
+		i++
+	}
+
+	return fmt.Sprintf("AccountNotFound{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this AccountNotFound match the
+// provided AccountNotFound.
+//
+// This function performs a deep comparison.
+func (v *AccountNotFound) Equals(rhs *AccountNotFound) bool {
+	if !(v.id == rhs.id) {
+		return false
+	}
+	if !_String_EqualsPtr(v.message, rhs.message) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this AccountNotFound.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *AccountNotFound) Clone() *AccountNotFound {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.message = _String_ClonePtr(v.message)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of AccountNotFound.
+func (v *AccountNotFound) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddInt64("ID", v.id)
+	if v.message != nil {
+		enc.AddString("message", *v.message)
+	}
+	return nil
+}
+
+type _AccountNotFound_JSON struct {
+	ID      int64   `json:"ID,required"`
+	Message *string `json:"message,omitempty"`
+}
+
+// MarshalJSON encodes this AccountNotFound into JSON.
+func (v *AccountNotFound) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var j _AccountNotFound_JSON
+	j.ID = v.id
+	j.Message = v.message
+	return json.Marshal(&j)
+}
+
+// UnmarshalJSON decodes a AccountNotFound from JSON.
+func (v *AccountNotFound) UnmarshalJSON(b []byte) error {
+	var j _AccountNotFound_JSON
+	if err := json.Unmarshal(b, &j); err != nil {
+		return err
+	}
+	v.id = j.ID
+	v.message = j.Message
+	return nil
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil AccountNotFound.
+func (v *AccountNotFound) GetID() (o int64) {
+	if v != nil {
+		o = v.id
+	}
+	return
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil AccountNotFound.
+func (v *AccountNotFound) GetMessage() (o string) {
+	if v != nil && v.message != nil {
+		return *v.message
+	}
+
+	return
+}
+
+// IsSetMessage returns true if Message is not nil.
+//
+// This is safe to call on a nil AccountNotFound.
+func (v *AccountNotFound) IsSetMessage() bool {
+	return v != nil && v.message != nil
+}
+
+// AccountNotFoundBuilder builds AccountNotFound structs.
+//
+// 	v, err := NewAccountNotFoundBuilder().
+// 		SetID(...).
+// 		Build()
+type AccountNotFoundBuilder struct {
+	v   AccountNotFound
+	set [2]bool
+}
+
+// NewAccountNotFoundBuilder returns a new AccountNotFoundBuilder with all fields that have
+// default values set to their defaults.
+func NewAccountNotFoundBuilder() *AccountNotFoundBuilder {
+	var b AccountNotFoundBuilder
+
+	return &b
+}
+
+// SetID sets the value of ID.
+func (b *AccountNotFoundBuilder) SetID(v int64) *AccountNotFoundBuilder {
+	b.v.id = v
+	b.set[0] = true
+	return b
+}
+
+// SetMessage sets the value of Message.
+func (b *AccountNotFoundBuilder) SetMessage(v string) *AccountNotFoundBuilder {
+	b.v.message = &v
+	b.set[1] = true
+	return b
+}
+
+// Build returns the AccountNotFound built by this AccountNotFoundBuilder.
+//
+// An error is returned if any of the required fields of AccountNotFound have
+// not been set.
+func (b *AccountNotFoundBuilder) Build() (*AccountNotFound, error) {
+	if !b.set[0] {
+		return nil, errors.New("field ID of AccountNotFound is required")
+	}
+
+	v := b.v
+	return &v, nil
+}
+
+// Error returns the message of the exception if it is set and the
+// String representation of the exception otherwise.
+func (v *AccountNotFound) Error() string {
+	if m := v.GetMessage(); m != "" {
+		return m
+	}
+	return v.String()
+}
+
+type Address struct {
+	street string
+	city   *string
+}
+
+// ToWire translates a Address struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Address) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Address is nil")
+	}
+
+	w = wire.NewValueString(v.street)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.city != nil {
+		w = wire.NewValueString(*(v.city))
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Address struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Address struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Address
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Address) FromWire(w wire.Value) error {
+
+	streetIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.street = field.Value.GetString()
+				streetIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				x := field.Value.GetString()
+				v.city = &x
+
+			}
+		}
+	}
+
+	if !streetIsSet {
+		return errors.New("field Street of Address is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Address
+// struct.
+func (v *Address) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Street: %v", v.street)
+	i++
+	if v.city != nil {
+		fields[i] = fmt.Sprintf("City: %v", *(v.city))
+		i++
+	}
+
+	return fmt.Sprintf("Address{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Address match the
+// provided Address.
+//
+// This function performs a deep comparison.
+func (v *Address) Equals(rhs *Address) bool {
+	if !(v.street == rhs.street) {
+		return false
+	}
+	if !_String_EqualsPtr(v.city, rhs.city) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this Address.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Address) Clone() *Address {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.city = _String_ClonePtr(v.city)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Address.
+func (v *Address) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("street", v.street)
+	if v.city != nil {
+		enc.AddString("city", *v.city)
+	}
+	return nil
+}
+
+type _Address_JSON struct {
+	Street string  `json:"street,required"`
+	City   *string `json:"city,omitempty"`
+}
+
+// MarshalJSON encodes this Address into JSON.
+func (v *Address) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var j _Address_JSON
+	j.Street = v.street
+	j.City = v.city
+	return json.Marshal(&j)
+}
+
+// UnmarshalJSON decodes a Address from JSON.
+func (v *Address) UnmarshalJSON(b []byte) error {
+	var j _Address_JSON
+	if err := json.Unmarshal(b, &j); err != nil {
+		return err
+	}
+	v.street = j.Street
+	v.city = j.City
+	return nil
+}
+
+// GetStreet returns the value of Street if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Address.
+func (v *Address) GetStreet() (o string) {
+	if v != nil {
+		o = v.street
+	}
+	return
+}
+
+// GetCity returns the value of City if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Address.
+func (v *Address) GetCity() (o string) {
+	if v != nil && v.city != nil {
+		return *v.city
+	}
+
+	return
+}
+
+// IsSetCity returns true if City is not nil.
+//
+// This is safe to call on a nil Address.
+func (v *Address) IsSetCity() bool {
+	return v != nil && v.city != nil
+}
+
+// AddressBuilder builds Address structs.
+//
+// 	v, err := NewAddressBuilder().
+// 		SetStreet(...).
+// 		Build()
+type AddressBuilder struct {
+	v   Address
+	set [2]bool
+}
+
+// NewAddressBuilder returns a new AddressBuilder with all fields that have
+// default values set to their defaults.
+func NewAddressBuilder() *AddressBuilder {
+	var b AddressBuilder
+
+	return &b
+}
+
+// SetStreet sets the value of Street.
+func (b *AddressBuilder) SetStreet(v string) *AddressBuilder {
+	b.v.street = v
+	b.set[0] = true
+	return b
+}
+
+// SetCity sets the value of City.
+func (b *AddressBuilder) SetCity(v string) *AddressBuilder {
+	b.v.city = &v
+	b.set[1] = true
+	return b
+}
+
+// Build returns the Address built by this AddressBuilder.
+//
+// An error is returned if any of the required fields of Address have
+// not been set.
+func (b *AddressBuilder) Build() (*Address, error) {
+	if !b.set[0] {
+		return nil, errors.New("field Street of Address is required")
+	}
+
+	v := b.v
+	return &v, nil
+}
+
+type Admin Account
+
+// ToWire translates Admin into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v *Admin) ToWire() (wire.Value, error) {
+	x := (*Account)(v)
+	return x.ToWire()
+}
+
+// String returns a readable string representation of Admin.
+func (v *Admin) String() string {
+	x := (*Account)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes Admin from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Admin) FromWire(w wire.Value) error {
+	return (*Account)(v).FromWire(w)
+}
+
+// Equals returns true if this Admin is equal to the provided
+// Admin.
+func (lhs *Admin) Equals(rhs *Admin) bool {
+	return (*Account)(lhs).Equals((*Account)(rhs))
+}
+
+// Clone returns a deep copy of this Admin.
+func (v *Admin) Clone() *Admin {
+	return (*Admin)((*Account)(v).Clone())
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Admin.
+func (v *Admin) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	x := (*Account)(v)
+	return x.MarshalLogObject(enc)
+}
+
+type Credential struct {
+	password *string
+	key      []byte
+}
+
+// ToWire translates a Credential struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Credential) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Credential is nil")
+	}
+
+	if v.password != nil {
+		w = wire.NewValueString(*(v.password))
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.key != nil {
+		w = wire.NewValueBinary(v.key)
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Credential should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Credential struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Credential struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Credential
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Credential) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				x := field.Value.GetString()
+				v.password = &x
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.key = field.Value.GetBinary()
+
+			}
+		}
+	}
+
+	count := 0
+	if v.password != nil {
+		count++
+	}
+	if v.key != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Credential should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Credential
+// struct.
+func (v *Credential) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.password != nil {
+		fields[i] = fmt.Sprintf("Password: %v", *(v.password))
+		i++
+	}
+	if v.key != nil {
+		fields[i] = fmt.Sprintf("Key: %v", v.key)
+		i++
+	}
+
+	return fmt.Sprintf("Credential{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Credential match the
+// provided Credential.
+//
+// This function performs a deep comparison.
+func (v *Credential) Equals(rhs *Credential) bool {
+	if !_String_EqualsPtr(v.password, rhs.password) {
+		return false
+	}
+	if !((v.key == nil && rhs.key == nil) || (v.key != nil && rhs.key != nil && bytes.Equal(v.key, rhs.key))) {
+		return false
+	}
+
+	return true
+}
+
+func _Binary_Clone(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+
+	o := make([]byte, len(b))
+	copy(o, b)
+	return o
+}
+
+// Clone returns a deep copy of this Credential.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Credential) Clone() *Credential {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.password = _String_ClonePtr(v.password)
+	o.key = _Binary_Clone(v.key)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Credential.
+func (v *Credential) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.password != nil {
+		enc.AddString("password", *v.password)
+	}
+	if v.key != nil {
+		enc.AddString("key", base64.StdEncoding.EncodeToString(v.key))
+	}
+	return nil
+}
+
+type _Credential_JSON struct {
+	Password *string `json:"password,omitempty"`
+	Key      []byte  `json:"key,omitempty"`
+}
+
+// MarshalJSON encodes this Credential into JSON.
+func (v *Credential) MarshalJSON() ([]byte, error) {
+	if v == nil {
+		return []byte("null"), nil
+	}
+
+	var j _Credential_JSON
+	j.Password = v.password
+	j.Key = v.key
+	return json.Marshal(&j)
+}
+
+// UnmarshalJSON decodes a Credential from JSON.
+func (v *Credential) UnmarshalJSON(b []byte) error {
+	var j _Credential_JSON
+	if err := json.Unmarshal(b, &j); err != nil {
+		return err
+	}
+	v.password = j.Password
+	v.key = j.Key
+	return nil
+}
+
+// GetPassword returns the value of Password if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Credential.
+func (v *Credential) GetPassword() (o string) {
+	if v != nil && v.password != nil {
+		return *v.password
+	}
+
+	return
+}
+
+// IsSetPassword returns true if Password is not nil.
+//
+// This is safe to call on a nil Credential.
+func (v *Credential) IsSetPassword() bool {
+	return v != nil && v.password != nil
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Credential.
+func (v *Credential) GetKey() (o []byte) {
+	if v != nil && v.key != nil {
+		return v.key
+	}
+
+	return
+}
+
+// IsSetKey returns true if Key is not nil.
+//
+// This is safe to call on a nil Credential.
+func (v *Credential) IsSetKey() bool {
+	return v != nil && v.key != nil
+}
+
+// CredentialBuilder builds Credential structs.
+//
+// 	v, err := NewCredentialBuilder().
+// 		SetPassword(...).
+// 		Build()
+type CredentialBuilder struct {
+	v   Credential
+	set [2]bool
+}
+
+// NewCredentialBuilder returns a new CredentialBuilder with all fields that have
+// default values set to their defaults.
+func NewCredentialBuilder() *CredentialBuilder {
+	var b CredentialBuilder
+
+	return &b
+}
+
+// SetPassword sets the value of Password.
+func (b *CredentialBuilder) SetPassword(v string) *CredentialBuilder {
+	b.v.password = &v
+	b.set[0] = true
+	return b
+}
+
+// SetKey sets the value of Key.
+func (b *CredentialBuilder) SetKey(v []byte) *CredentialBuilder {
+	b.v.key = v
+	b.set[1] = true
+	return b
+}
+
+// Build returns the Credential built by this CredentialBuilder.
+//
+// An error is returned if any of the required fields of Credential have
+// not been set, or if not exactly one field was set.
+func (b *CredentialBuilder) Build() (*Credential, error) {
+	n := 0
+	for _, isSet := range b.set {
+		if isSet {
+			n++
+		}
+	}
+	if n != 1 {
+		return nil, fmt.Errorf("Credential should have exactly one field: got %v fields", n)
+	}
+	v := b.v
+	return &v, nil
+}
+
+type Status int32
+
+const (
+	StatusActive    Status = 0
+	StatusSuspended Status = 1
+)
+
+// Status_Values returns all recognized values of Status.
+func Status_Values() []Status {
+	return []Status{
+		StatusActive,
+		StatusSuspended,
+	}
+}
+
+// UnmarshalText tries to decode Status from a byte slice
+// containing its name.
+//
+//   var v Status
+//   err := v.UnmarshalText([]byte("ACTIVE"))
+func (v *Status) UnmarshalText(value []byte) error {
+	switch string(value) {
+	case "ACTIVE":
+		*v = StatusActive
+		return nil
+	case "SUSPENDED":
+		*v = StatusSuspended
+		return nil
+	default:
+		return fmt.Errorf("unknown enum value %q for %q", value, "Status")
+	}
+}
+
+// ToWire translates Status into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v Status) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes Status from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return Status(0), err
+//   }
+//
+//   var v Status
+//   if err := v.FromWire(x); err != nil {
+//     return Status(0), err
+//   }
+//   return v, nil
+func (v *Status) FromWire(w wire.Value) error {
+	*v = (Status)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of Status.
+func (v Status) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "ACTIVE"
+	case 1:
+		return "SUSPENDED"
+	}
+	return fmt.Sprintf("Status(%d)", w)
+}
+
+// Equals returns true if this Status value matches the provided
+// value.
+func (v Status) Equals(rhs Status) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes Status into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v Status) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"ACTIVE\""), nil
+	case 1:
+		return ([]byte)("\"SUSPENDED\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode Status from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *Status) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Status")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Status")
+		}
+		*v = (Status)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Status")
+	}
+}
+
+// Status_NumValues is the number of distinct recognized
+// values of Status.
+const Status_NumValues = 2
+
+// Ordinal returns the position of this value among the distinct
+// recognized values of Status or false if the value is not
+// recognized. Ordinals are less than Status_NumValues.
+//
+// Ordinals may be used to index arrays of length
+// Status_NumValues in place of map[Status]T.
+//
+//   var counts [Status_NumValues]int
+//   if i, ok := v.Ordinal(); ok {
+//     counts[i]++
+//   }
+func (v Status) Ordinal() (int, bool) {
+	switch int32(v) {
+	case 0:
+		return 0, true
+	case 1:
+		return 1, true
+	default:
+		return 0, false
+	}
+}
+
+// Status_Set is a set of Status values backed by a
+// bitset. The zero value is an empty set.
+type Status_Set struct {
+	bits [1]uint64
+}
+
+// Add adds the given value to the set. It returns false if the value
+// is not a recognized value of Status.
+func (s *Status_Set) Add(v Status) bool {
+	i, ok := v.Ordinal()
+	if ok {
+		s.bits[i/64] |= 1 << uint(i%64)
+	}
+	return ok
+}
+
+// Remove removes the given value from the set.
+func (s *Status_Set) Remove(v Status) {
+	if i, ok := v.Ordinal(); ok {
+		s.bits[i/64] &^= 1 << uint(i%64)
+	}
+}
+
+// Contains returns true if the given value is in the set.
+func (s *Status_Set) Contains(v Status) bool {
+	i, ok := v.Ordinal()
+	return ok && s.bits[i/64]&(1<<uint(i%64)) != 0
+}
+
+// Len returns the number of values in the set.
+func (s *Status_Set) Len() int {
+	n := 0
+	for _, x := range s.bits {
+		for ; x != 0; n++ {
+			x &= x - 1
+		}
+	}
+	return n
+}
+
+// Values returns the values in the set in the order in which they
+// were declared.
+func (s *Status_Set) Values() []Status {
+	v := make([]Status, 0, s.Len())
+	if s.bits[0]&(1<<0) != 0 {
+		v = append(v, StatusActive)
+	}
+	if s.bits[0]&(1<<1) != 0 {
+		v = append(v, StatusSuspended)
+	}
+	return v
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Status.
+//
+// Enums are logged as objects, where the value is logged with key
+// "value", and if this value's name is known, the name is logged with
+// key "name".
+func (v Status) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "ACTIVE")
+	case 1:
+		enc.AddString("name", "SUSPENDED")
+	}
+	return nil
+}
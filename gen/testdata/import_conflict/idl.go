@@ -0,0 +1,24 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package import_conflict
+
+import (
+	"go.uber.org/thriftrw/gen/testdata/wire"
+	"go.uber.org/thriftrw/thriftreflect"
+)
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:            "import_conflict",
+	Package:         "go.uber.org/thriftrw/gen/testdata/import_conflict",
+	FilePath:        "import_conflict.thrift",
+	SHA1:            "2cde51940064d85f8c42a1d670b67a5122b7a7d8",
+	ThriftRWVersion: "1.9.0",
+	Includes: []*thriftreflect.ThriftModule{
+		wire.ThriftModule,
+	},
+	Raw: rawIDL,
+}
+
+const rawIDL = "include \"./wire.thrift\"\n\nstruct WireFields {\n    1: required wire.Field field\n    2: optional list<wire.Field> fields\n}\n"
@@ -0,0 +1,18 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package mem_size
+
+import "go.uber.org/thriftrw/thriftreflect"
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:            "mem_size",
+	Package:         "go.uber.org/thriftrw/gen/testdata/mem_size",
+	FilePath:        "mem_size.thrift",
+	SHA1:            "4b28386f0ceb333de813a56f18a2c0b6e05d4814",
+	ThriftRWVersion: "1.9.0",
+	Raw:             rawIDL,
+}
+
+const rawIDL = "// Code for this file is generated with --mem-size.\n\nenum Kind {\n    SMALL\n    LARGE\n}\n\ntypedef string Name\ntypedef list<Name> Names\ntypedef Item ItemAlias\n\nstruct Item {\n    1: required string name\n    2: optional string label\n    3: optional i32 count\n    4: optional i64 version (thriftrw.optional = \"value\")\n    5: optional string note (thriftrw.optional = \"value\")\n    6: optional Kind kind\n    7: optional binary data\n}\n\nstruct Inventory {\n    1: required Item primary\n    2: optional Item secondary\n    3: optional list<Item> items\n    4: optional list<i32> counts\n    5: optional set<string> tags\n    6: optional set<list<i32>> groups\n    7: optional map<string, binary> blobs\n    8: optional map<i32, i64> versions\n    9: optional map<list<i32>, string> labels\n    10: optional map<string, i32> sizes\n    11: optional Names names\n    12: optional ItemAlias aliased\n    13: optional Name owner\n    14: optional string raw (thriftrw.raw)\n}\n\nunion Content {\n    1: string text\n    2: Item item\n}\n\nexception NotFound {\n    1: required string message\n}\n"
@@ -0,0 +1,3144 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package mem_size
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unsafe"
+
+	"go.uber.org/thriftrw/runtime"
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
+)
+
+type Content struct {
+	Text *string `json:"text,omitempty"`
+	Item *Item   `json:"item,omitempty"`
+}
+
+// ToWire translates a Content struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Content) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Content is nil")
+	}
+
+	if v.Text != nil {
+		w = wire.NewValueString(*(v.Text))
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Item != nil {
+		w, err = v.Item.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Content should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Item_Read(w wire.Value) (*Item, error) {
+	var v Item
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Content struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Content struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Content
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Content) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				x := field.Value.GetString()
+				v.Text = &x
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Item, err = _Item_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Text != nil {
+		count++
+	}
+	if v.Item != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Content should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Content
+// struct.
+func (v *Content) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Text != nil {
+		fields[i] = fmt.Sprintf("Text: %v", *(v.Text))
+		i++
+	}
+	if v.Item != nil {
+		fields[i] = fmt.Sprintf("Item: %v", v.Item)
+		i++
+	}
+
+	return fmt.Sprintf("Content{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Content match the
+// provided Content.
+//
+// This function performs a deep comparison.
+func (v *Content) Equals(rhs *Content) bool {
+	if !_String_EqualsPtr(v.Text, rhs.Text) {
+		return false
+	}
+	if !((v.Item == nil && rhs.Item == nil) || (v.Item != nil && rhs.Item != nil && v.Item.Equals(rhs.Item))) {
+		return false
+	}
+
+	return true
+}
+
+func _String_ClonePtr(p *string) *string {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this Content.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Content) Clone() *Content {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Text = _String_ClonePtr(v.Text)
+	o.Item = v.Item.Clone()
+
+	return &o
+}
+
+func _String_MemSizePtr(p *string) int {
+	if p == nil {
+		return 0
+	}
+	return int(unsafe.Sizeof(*p)) + len(*p)
+}
+
+// MemSize returns an estimate of the number of bytes of memory held
+// by this Content, including the memory referenced by its fields.
+// Caches may use it to limit the memory held by the values they
+// retain.
+func (v *Content) MemSize() int {
+	if v == nil {
+		return 0
+	}
+
+	n := int(unsafe.Sizeof(*v))
+	n += _String_MemSizePtr(v.Text)
+	n += v.Item.MemSize()
+	return n
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Content.
+func (v *Content) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Text != nil {
+		enc.AddString("text", *v.Text)
+	}
+	if v.Item != nil {
+		if err := enc.AddObject("item", v.Item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetText returns the value of Text if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Content.
+func (v *Content) GetText() (o string) {
+	if v != nil && v.Text != nil {
+		return *v.Text
+	}
+
+	return
+}
+
+// IsSetText returns true if Text is not nil.
+//
+// This is safe to call on a nil Content.
+func (v *Content) IsSetText() bool {
+	return v != nil && v.Text != nil
+}
+
+// GetItem returns the value of Item if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Content.
+func (v *Content) GetItem() (o *Item) {
+	if v != nil && v.Item != nil {
+		return v.Item
+	}
+
+	return
+}
+
+// IsSetItem returns true if Item is not nil.
+//
+// This is safe to call on a nil Content.
+func (v *Content) IsSetItem() bool {
+	return v != nil && v.Item != nil
+}
+
+type Inventory struct {
+	Primary   *Item               `json:"primary,required"`
+	Secondary *Item               `json:"secondary,omitempty"`
+	Items     []*Item             `json:"items,omitempty"`
+	Counts    []int32             `json:"counts,omitempty"`
+	Tags      map[string]struct{} `json:"tags,omitempty"`
+	Groups    [][]int32           `json:"groups,omitempty"`
+	Blobs     map[string][]byte   `json:"blobs,omitempty"`
+	Versions  map[int32]int64     `json:"versions,omitempty"`
+	Labels    []struct {
+		Key   []int32
+		Value string
+	} `json:"labels,omitempty"`
+	Sizes   map[string]int32 `json:"sizes,omitempty"`
+	Names   Names            `json:"names,omitempty"`
+	Aliased *ItemAlias       `json:"aliased,omitempty"`
+	Owner   *Name            `json:"owner,omitempty"`
+	Raw     *wire.Value      `json:"raw,omitempty"`
+}
+
+type _List_Item_ValueList []*Item
+
+func (v _List_Item_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Item_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Item_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Item_ValueList) Close() {}
+
+type _List_I32_ValueList []int32
+
+func (v _List_I32_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		if err := f(wire.NewValueI32(x)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_I32_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_I32_ValueList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_List_I32_ValueList) Close() {}
+
+type _Set_String_ValueList map[string]struct{}
+
+func (v _Set_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for x := range v {
+		if err := f(wire.NewValueString(x)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Set_String_ValueList) Close() {}
+
+type _Set_List_I32_ValueList [][]int32
+
+func (v _Set_List_I32_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid set item: value is nil")
+		}
+		if err := f(wire.NewValueList(_List_I32_ValueList(x))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_List_I32_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_List_I32_ValueList) ValueType() wire.Type {
+	return wire.TList
+}
+
+func (_Set_List_I32_ValueList) Close() {}
+
+type _Map_String_Binary_MapItemList map[string][]byte
+
+func (m _Map_String_Binary_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw := wire.NewValueString(k)
+
+		vw := wire.NewValueBinary(v)
+		if err := f(wire.MapItem{Key: kw, Value: vw}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_Binary_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_Binary_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Binary_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Binary_MapItemList) Close() {}
+
+type _Map_I32_I64_MapItemList map[int32]int64
+
+func (m _Map_I32_I64_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw := wire.NewValueI32(k)
+
+		vw := wire.NewValueI64(v)
+		if err := f(wire.MapItem{Key: kw, Value: vw}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_I32_I64_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_I32_I64_MapItemList) KeyType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_I32_I64_MapItemList) ValueType() wire.Type {
+	return wire.TI64
+}
+
+func (_Map_I32_I64_MapItemList) Close() {}
+
+type _Map_List_I32_String_MapItemList []struct {
+	Key   []int32
+	Value string
+}
+
+func (m _Map_List_I32_String_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		if k == nil {
+			return fmt.Errorf("invalid map key: value is nil")
+		}
+		kw := wire.NewValueList(_List_I32_ValueList(k))
+
+		vw := wire.NewValueString(v)
+		if err := f(wire.MapItem{Key: kw, Value: vw}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_List_I32_String_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_List_I32_String_MapItemList) KeyType() wire.Type {
+	return wire.TList
+}
+
+func (_Map_List_I32_String_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_List_I32_String_MapItemList) Close() {}
+
+type _Map_String_I32_MapItemList map[string]int32
+
+func (m _Map_String_I32_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw := wire.NewValueString(k)
+
+		vw := wire.NewValueI32(v)
+		if err := f(wire.MapItem{Key: kw, Value: vw}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_I32_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_I32_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_I32_MapItemList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_String_I32_MapItemList) Close() {}
+
+// ToWire translates a Inventory struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Inventory) ToWire() (wire.Value, error) {
+	var (
+		fields [14]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Inventory is nil")
+	}
+
+	if v.Primary == nil {
+		return w, errors.New("field Primary of Inventory is required")
+	}
+	w, err = v.Primary.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Secondary != nil {
+		w, err = v.Secondary.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Items != nil {
+		w = wire.NewValueList(_List_Item_ValueList(v.Items))
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Counts != nil {
+		w = wire.NewValueList(_List_I32_ValueList(v.Counts))
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Tags != nil {
+		w = wire.NewValueSet(_Set_String_ValueList(v.Tags))
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.Groups != nil {
+		w = wire.NewValueSet(_Set_List_I32_ValueList(v.Groups))
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.Blobs != nil {
+		w = wire.NewValueMap(_Map_String_Binary_MapItemList(v.Blobs))
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.Versions != nil {
+		w = wire.NewValueMap(_Map_I32_I64_MapItemList(v.Versions))
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+	if v.Labels != nil {
+		w = wire.NewValueMap(_Map_List_I32_String_MapItemList(v.Labels))
+		fields[i] = wire.Field{ID: 9, Value: w}
+		i++
+	}
+	if v.Sizes != nil {
+		w = wire.NewValueMap(_Map_String_I32_MapItemList(v.Sizes))
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Names != nil {
+		w, err = v.Names.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 11, Value: w}
+		i++
+	}
+	if v.Aliased != nil {
+		w, err = v.Aliased.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 12, Value: w}
+		i++
+	}
+	if v.Owner != nil {
+		w, err = v.Owner.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 13, Value: w}
+		i++
+	}
+	if v.Raw != nil {
+		w = *(v.Raw)
+		fields[i] = wire.Field{ID: 14, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_Item_Read(l wire.ValueList) ([]*Item, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Item, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Item_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _List_I32_Read(l wire.ValueList) ([]int32, error) {
+	if l.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make([]int32, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		o = append(o, x.GetI32())
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Set_String_Read(s wire.ValueList) (map[string]struct{}, error) {
+	if s.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[string]struct{}, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i := x.GetString()
+
+		o[i] = struct{}{}
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+func _Set_List_I32_Read(s wire.ValueList) ([][]int32, error) {
+	if s.ValueType() != wire.TList {
+		return nil, nil
+	}
+
+	o := make([][]int32, 0, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := _List_I32_Read(x.GetList())
+		if err != nil {
+			return err
+		}
+
+		o = append(o, i)
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+func _Map_String_Binary_Read(m wire.MapItemList) (map[string][]byte, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[string][]byte, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k := x.Key.GetString()
+
+		v := x.Value.GetBinary()
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Map_I32_I64_Read(m wire.MapItemList) (map[int32]int64, error) {
+	if m.KeyType() != wire.TI32 {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI64 {
+		return nil, nil
+	}
+
+	o := make(map[int32]int64, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k := x.Key.GetI32()
+
+		v := x.Value.GetI64()
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Map_List_I32_String_Read(m wire.MapItemList) ([]struct {
+	Key   []int32
+	Value string
+}, error) {
+	if m.KeyType() != wire.TList {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]struct {
+		Key   []int32
+		Value string
+	}, 0, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := _List_I32_Read(x.Key.GetList())
+		if err != nil {
+			return err
+		}
+
+		v := x.Value.GetString()
+
+		o = append(o, struct {
+			Key   []int32
+			Value string
+		}{k, v})
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Map_String_I32_Read(m wire.MapItemList) (map[string]int32, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make(map[string]int32, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k := x.Key.GetString()
+
+		v := x.Value.GetI32()
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Names_Read(w wire.Value) (Names, error) {
+	var x Names
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _ItemAlias_Read(w wire.Value) (*ItemAlias, error) {
+	var x ItemAlias
+	err := x.FromWire(w)
+	return &x, err
+}
+
+func _Name_Read(w wire.Value) (Name, error) {
+	var x Name
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _RawValue_Read(w wire.Value) (*wire.Value, error) {
+	return &w, nil
+}
+
+// FromWire deserializes a Inventory struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Inventory struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Inventory
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Inventory) FromWire(w wire.Value) error {
+	var err error
+
+	primaryIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Primary, err = _Item_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				primaryIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Secondary, err = _Item_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TList {
+				v.Items, err = _List_Item_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TList {
+				v.Counts, err = _List_I32_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TSet {
+				v.Tags, err = _Set_String_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TSet {
+				v.Groups, err = _Set_List_I32_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TMap {
+				v.Blobs, err = _Map_String_Binary_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 8:
+			if field.Value.Type() == wire.TMap {
+				v.Versions, err = _Map_I32_I64_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 9:
+			if field.Value.Type() == wire.TMap {
+				v.Labels, err = _Map_List_I32_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 10:
+			if field.Value.Type() == wire.TMap {
+				v.Sizes, err = _Map_String_I32_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 11:
+			if field.Value.Type() == wire.TList {
+				v.Names, err = _Names_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 12:
+			if field.Value.Type() == wire.TStruct {
+				v.Aliased, err = _ItemAlias_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 13:
+			if field.Value.Type() == wire.TBinary {
+				var x Name
+				x, err = _Name_Read(field.Value)
+				v.Owner = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 14:
+			if field.Value.Type() == wire.TBinary {
+				v.Raw, err = _RawValue_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !primaryIsSet {
+		return errors.New("field Primary of Inventory is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Inventory
+// struct.
+func (v *Inventory) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [14]string
+	i := 0
+	fields[i] = fmt.Sprintf("Primary: %v", v.Primary)
+	i++
+	if v.Secondary != nil {
+		fields[i] = fmt.Sprintf("Secondary: %v", v.Secondary)
+		i++
+	}
+	if v.Items != nil {
+		fields[i] = fmt.Sprintf("Items: %v", v.Items)
+		i++
+	}
+	if v.Counts != nil {
+		fields[i] = fmt.Sprintf("Counts: %v", v.Counts)
+		i++
+	}
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+	if v.Groups != nil {
+		fields[i] = fmt.Sprintf("Groups: %v", v.Groups)
+		i++
+	}
+	if v.Blobs != nil {
+		fields[i] = fmt.Sprintf("Blobs: %v", v.Blobs)
+		i++
+	}
+	if v.Versions != nil {
+		fields[i] = fmt.Sprintf("Versions: %v", v.Versions)
+		i++
+	}
+	if v.Labels != nil {
+		fields[i] = fmt.Sprintf("Labels: %v", v.Labels)
+		i++
+	}
+	if v.Sizes != nil {
+		fields[i] = fmt.Sprintf("Sizes: %v", v.Sizes)
+		i++
+	}
+	if v.Names != nil {
+		fields[i] = fmt.Sprintf("Names: %v", v.Names)
+		i++
+	}
+	if v.Aliased != nil {
+		fields[i] = fmt.Sprintf("Aliased: %v", v.Aliased)
+		i++
+	}
+	if v.Owner != nil {
+		fields[i] = fmt.Sprintf("Owner: %v", *(v.Owner))
+		i++
+	}
+	if v.Raw != nil {
+		fields[i] = fmt.Sprintf("Raw: %v", v.Raw)
+		i++
+	}
+
+	return fmt.Sprintf("Inventory{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_Item_Equals(lhs, rhs []*Item) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_I32_Equals(lhs, rhs []int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Set_String_Equals(lhs, rhs map[string]struct{}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for x := range rhs {
+		if _, ok := lhs[x]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Set_List_I32_Equals(lhs, rhs [][]int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, x := range lhs {
+		ok := false
+		for _, y := range rhs {
+			if _List_I32_Equals(x, y) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_Binary_Equals(lhs, rhs map[string][]byte) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !bytes.Equal(lv, rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_I32_I64_Equals(lhs, rhs map[int32]int64) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_List_I32_String_Equals(lhs, rhs []struct {
+	Key   []int32
+	Value string
+}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, i := range lhs {
+		lk := i.Key
+		lv := i.Value
+		ok := false
+		for _, j := range rhs {
+			rk := j.Key
+			rv := j.Value
+			if !_List_I32_Equals(lk, rk) {
+				continue
+			}
+
+			if !(lv == rv) {
+				return false
+			}
+			ok = true
+			break
+		}
+
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_String_I32_Equals(lhs, rhs map[string]int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Name_EqualsPtr(lhs, rhs *Name) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _RawValue_Equals(lhs, rhs *wire.Value) bool {
+	if lhs == nil || rhs == nil {
+		return lhs == rhs
+	}
+	return wire.ValuesAreEqual(*lhs, *rhs)
+}
+
+// Equals returns true if all the fields of this Inventory match the
+// provided Inventory.
+//
+// This function performs a deep comparison.
+func (v *Inventory) Equals(rhs *Inventory) bool {
+	if !v.Primary.Equals(rhs.Primary) {
+		return false
+	}
+	if !((v.Secondary == nil && rhs.Secondary == nil) || (v.Secondary != nil && rhs.Secondary != nil && v.Secondary.Equals(rhs.Secondary))) {
+		return false
+	}
+	if !((v.Items == nil && rhs.Items == nil) || (v.Items != nil && rhs.Items != nil && _List_Item_Equals(v.Items, rhs.Items))) {
+		return false
+	}
+	if !((v.Counts == nil && rhs.Counts == nil) || (v.Counts != nil && rhs.Counts != nil && _List_I32_Equals(v.Counts, rhs.Counts))) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _Set_String_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+	if !((v.Groups == nil && rhs.Groups == nil) || (v.Groups != nil && rhs.Groups != nil && _Set_List_I32_Equals(v.Groups, rhs.Groups))) {
+		return false
+	}
+	if !((v.Blobs == nil && rhs.Blobs == nil) || (v.Blobs != nil && rhs.Blobs != nil && _Map_String_Binary_Equals(v.Blobs, rhs.Blobs))) {
+		return false
+	}
+	if !((v.Versions == nil && rhs.Versions == nil) || (v.Versions != nil && rhs.Versions != nil && _Map_I32_I64_Equals(v.Versions, rhs.Versions))) {
+		return false
+	}
+	if !((v.Labels == nil && rhs.Labels == nil) || (v.Labels != nil && rhs.Labels != nil && _Map_List_I32_String_Equals(v.Labels, rhs.Labels))) {
+		return false
+	}
+	if !((v.Sizes == nil && rhs.Sizes == nil) || (v.Sizes != nil && rhs.Sizes != nil && _Map_String_I32_Equals(v.Sizes, rhs.Sizes))) {
+		return false
+	}
+	if !((v.Names == nil && rhs.Names == nil) || (v.Names != nil && rhs.Names != nil && v.Names.Equals(rhs.Names))) {
+		return false
+	}
+	if !((v.Aliased == nil && rhs.Aliased == nil) || (v.Aliased != nil && rhs.Aliased != nil && v.Aliased.Equals(rhs.Aliased))) {
+		return false
+	}
+	if !_Name_EqualsPtr(v.Owner, rhs.Owner) {
+		return false
+	}
+	if !((v.Raw == nil && rhs.Raw == nil) || (v.Raw != nil && rhs.Raw != nil && _RawValue_Equals(v.Raw, rhs.Raw))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_Item_Clone(l []*Item) []*Item {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]*Item, len(l))
+	for i, x := range l {
+		o[i] = x.Clone()
+	}
+	return o
+}
+
+func _List_I32_Clone(l []int32) []int32 {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]int32, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
+}
+
+func _Set_String_Clone(s map[string]struct{}) map[string]struct{} {
+	if s == nil {
+		return nil
+	}
+
+	o := make(map[string]struct{}, len(s))
+	for x := range s {
+		o[x] = struct{}{}
+	}
+
+	return o
+}
+
+func _Set_List_I32_Clone(s [][]int32) [][]int32 {
+	if s == nil {
+		return nil
+	}
+
+	o := make([][]int32, 0, len(s))
+	for _, x := range s {
+		o = append(o, _List_I32_Clone(x))
+	}
+
+	return o
+}
+
+func _Binary_Clone(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+
+	o := make([]byte, len(b))
+	copy(o, b)
+	return o
+}
+
+func _Map_String_Binary_Clone(m map[string][]byte) map[string][]byte {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[string][]byte, len(m))
+	for k, v := range m {
+		o[k] = _Binary_Clone(v)
+	}
+
+	return o
+}
+
+func _Map_I32_I64_Clone(m map[int32]int64) map[int32]int64 {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[int32]int64, len(m))
+	for k, v := range m {
+		o[k] = v
+	}
+
+	return o
+}
+
+func _Map_List_I32_String_Clone(m []struct {
+	Key   []int32
+	Value string
+}) []struct {
+	Key   []int32
+	Value string
+} {
+	if m == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   []int32
+		Value string
+	}, 0, len(m))
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		o = append(o, struct {
+			Key   []int32
+			Value string
+		}{_List_I32_Clone(k), v})
+	}
+
+	return o
+}
+
+func _Map_String_I32_Clone(m map[string]int32) map[string]int32 {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[string]int32, len(m))
+	for k, v := range m {
+		o[k] = v
+	}
+
+	return o
+}
+
+func _Name_ClonePtr(p *Name) *Name {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _RawValue_Clone(v *wire.Value) *wire.Value {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	return &o
+}
+
+// Clone returns a deep copy of this Inventory.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Inventory) Clone() *Inventory {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Primary = v.Primary.Clone()
+	o.Secondary = v.Secondary.Clone()
+	o.Items = _List_Item_Clone(v.Items)
+	o.Counts = _List_I32_Clone(v.Counts)
+	o.Tags = _Set_String_Clone(v.Tags)
+	o.Groups = _Set_List_I32_Clone(v.Groups)
+	o.Blobs = _Map_String_Binary_Clone(v.Blobs)
+	o.Versions = _Map_I32_I64_Clone(v.Versions)
+	o.Labels = _Map_List_I32_String_Clone(v.Labels)
+	o.Sizes = _Map_String_I32_Clone(v.Sizes)
+	o.Names = v.Names.Clone()
+	o.Aliased = v.Aliased.Clone()
+	o.Owner = _Name_ClonePtr(v.Owner)
+	o.Raw = _RawValue_Clone(v.Raw)
+
+	return &o
+}
+
+func _List_Item_MemSize(l []*Item) int {
+	n := cap(l) * int(unsafe.Sizeof(l[0]))
+	for _, x := range l {
+		n += x.MemSize()
+	}
+	return n
+}
+
+func _List_I32_MemSize(l []int32) int {
+	return cap(l) * int(unsafe.Sizeof(l[0]))
+}
+
+func _Set_String_MemSize(s map[string]struct{}) int {
+	if s == nil {
+		return 0
+	}
+	var zero string
+	n := runtime.MapMemSize(len(s), unsafe.Sizeof(zero), 0)
+	for x := range s {
+		n += len(x)
+	}
+	return n
+}
+
+func _Set_List_I32_MemSize(s [][]int32) int {
+	n := cap(s) * int(unsafe.Sizeof(s[0]))
+	for _, x := range s {
+		n += _List_I32_MemSize(x)
+	}
+	return n
+}
+
+func _Map_String_Binary_MemSize(m map[string][]byte) int {
+	if m == nil {
+		return 0
+	}
+	var (
+		zeroK string
+		zeroV []byte
+	)
+	n := runtime.MapMemSize(len(m), unsafe.Sizeof(zeroK), unsafe.Sizeof(zeroV))
+	for k, v := range m {
+		n += len(k)
+		n += cap(v)
+	}
+	return n
+}
+
+func _Map_I32_I64_MemSize(m map[int32]int64) int {
+	if m == nil {
+		return 0
+	}
+	var (
+		zeroK int32
+		zeroV int64
+	)
+	n := runtime.MapMemSize(len(m), unsafe.Sizeof(zeroK), unsafe.Sizeof(zeroV))
+	return n
+}
+
+func _Map_List_I32_String_MemSize(m []struct {
+	Key   []int32
+	Value string
+}) int {
+	n := cap(m) * int(unsafe.Sizeof(m[0]))
+	for _, i := range m {
+		k := i.Key
+		n += _List_I32_MemSize(k)
+		v := i.Value
+		n += len(v)
+	}
+	return n
+}
+
+func _Map_String_I32_MemSize(m map[string]int32) int {
+	if m == nil {
+		return 0
+	}
+	var (
+		zeroK string
+		zeroV int32
+	)
+	n := runtime.MapMemSize(len(m), unsafe.Sizeof(zeroK), unsafe.Sizeof(zeroV))
+	for k := range m {
+		n += len(k)
+	}
+	return n
+}
+
+func _Name_MemSizePtr(p *Name) int {
+	if p == nil {
+		return 0
+	}
+	return int(unsafe.Sizeof(*p)) + len(*p)
+}
+
+func _RawValue_MemSize(v *wire.Value) int {
+	if v == nil {
+		return 0
+	}
+	return int(unsafe.Sizeof(*v))
+}
+
+// MemSize returns an estimate of the number of bytes of memory held
+// by this Inventory, including the memory referenced by its fields.
+// Caches may use it to limit the memory held by the values they
+// retain.
+func (v *Inventory) MemSize() int {
+	if v == nil {
+		return 0
+	}
+
+	n := int(unsafe.Sizeof(*v))
+	n += v.Primary.MemSize()
+	n += v.Secondary.MemSize()
+	n += _List_Item_MemSize(v.Items)
+	n += _List_I32_MemSize(v.Counts)
+	n += _Set_String_MemSize(v.Tags)
+	n += _Set_List_I32_MemSize(v.Groups)
+	n += _Map_String_Binary_MemSize(v.Blobs)
+	n += _Map_I32_I64_MemSize(v.Versions)
+	n += _Map_List_I32_String_MemSize(v.Labels)
+	n += _Map_String_I32_MemSize(v.Sizes)
+	n += v.Names.MemSize()
+	n += v.Aliased.MemSize()
+	n += _Name_MemSizePtr(v.Owner)
+	n += _RawValue_MemSize(v.Raw)
+	return n
+}
+
+type _List_Item_Zapper []*Item
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Item_Zapper.
+func (l _List_Item_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		if err := enc.AppendObject(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _List_I32_Zapper []int32
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_I32_Zapper.
+func (l _List_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		enc.AppendInt32(v)
+	}
+	return nil
+}
+
+type _Set_String_Zapper map[string]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_String_Zapper.
+func (s _Set_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for v := range s {
+		enc.AppendString(v)
+	}
+	return nil
+}
+
+type _Set_List_I32_Zapper [][]int32
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_List_I32_Zapper.
+func (s _Set_List_I32_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range s {
+		if err := enc.AppendArray((_List_I32_Zapper)(v)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _Map_String_Binary_Zapper map[string][]byte
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_Binary_Zapper.
+func (m _Map_String_Binary_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for k, v := range m {
+		enc.AddString((string)(k), base64.StdEncoding.EncodeToString(v))
+	}
+	return nil
+}
+
+type _Map_I32_I64_Item_Zapper struct {
+	Key   int32
+	Value int64
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_I32_I64_Item_Zapper.
+func (i _Map_I32_I64_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("key", i.Key)
+	enc.AddInt64("value", i.Value)
+	return nil
+}
+
+type _Map_I32_I64_Zapper map[int32]int64
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_I64_Zapper.
+func (m _Map_I32_I64_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for k, v := range m {
+		if err := enc.AppendObject(_Map_I32_I64_Item_Zapper{Key: k, Value: v}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _Map_List_I32_String_Item_Zapper struct {
+	Key   []int32
+	Value string
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_List_I32_String_Item_Zapper.
+func (i _Map_List_I32_String_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if err := enc.AddArray("key", (_List_I32_Zapper)(i.Key)); err != nil {
+		return err
+	}
+	enc.AddString("value", i.Value)
+	return nil
+}
+
+type _Map_List_I32_String_Zapper []struct {
+	Key   []int32
+	Value string
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_List_I32_String_Zapper.
+func (m _Map_List_I32_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		if err := enc.AppendObject(_Map_List_I32_String_Item_Zapper{Key: k, Value: v}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _Map_String_I32_Zapper map[string]int32
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_I32_Zapper.
+func (m _Map_String_I32_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for k, v := range m {
+		enc.AddInt32((string)(k), v)
+	}
+	return nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Inventory.
+func (v *Inventory) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if err := enc.AddObject("primary", v.Primary); err != nil {
+		return err
+	}
+	if v.Secondary != nil {
+		if err := enc.AddObject("secondary", v.Secondary); err != nil {
+			return err
+		}
+	}
+	if v.Items != nil {
+		if err := enc.AddArray("items", (_List_Item_Zapper)(v.Items)); err != nil {
+			return err
+		}
+	}
+	if v.Counts != nil {
+		if err := enc.AddArray("counts", (_List_I32_Zapper)(v.Counts)); err != nil {
+			return err
+		}
+	}
+	if v.Tags != nil {
+		if err := enc.AddArray("tags", (_Set_String_Zapper)(v.Tags)); err != nil {
+			return err
+		}
+	}
+	if v.Groups != nil {
+		if err := enc.AddArray("groups", (_Set_List_I32_Zapper)(v.Groups)); err != nil {
+			return err
+		}
+	}
+	if v.Blobs != nil {
+		if err := enc.AddObject("blobs", (_Map_String_Binary_Zapper)(v.Blobs)); err != nil {
+			return err
+		}
+	}
+	if v.Versions != nil {
+		if err := enc.AddArray("versions", (_Map_I32_I64_Zapper)(v.Versions)); err != nil {
+			return err
+		}
+	}
+	if v.Labels != nil {
+		if err := enc.AddArray("labels", (_Map_List_I32_String_Zapper)(v.Labels)); err != nil {
+			return err
+		}
+	}
+	if v.Sizes != nil {
+		if err := enc.AddObject("sizes", (_Map_String_I32_Zapper)(v.Sizes)); err != nil {
+			return err
+		}
+	}
+	if v.Names != nil {
+		if err := enc.AddArray("names", v.Names); err != nil {
+			return err
+		}
+	}
+	if v.Aliased != nil {
+		if err := enc.AddObject("aliased", v.Aliased); err != nil {
+			return err
+		}
+	}
+	if v.Owner != nil {
+		enc.AddString("owner", (string)(*v.Owner))
+	}
+	if v.Raw != nil {
+		enc.AddString("raw", fmt.Sprint(v.Raw))
+	}
+	return nil
+}
+
+// GetPrimary returns the value of Primary if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Inventory.
+func (v *Inventory) GetPrimary() (o *Item) {
+	if v != nil {
+		o = v.Primary
+	}
+	return
+}
+
+// GetSecondary returns the value of Secondary if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Inventory.
+func (v *Inventory) GetSecondary() (o *Item) {
+	if v != nil && v.Secondary != nil {
+		return v.Secondary
+	}
+
+	return
+}
+
+// IsSetSecondary returns true if Secondary is not nil.
+//
+// This is safe to call on a nil Inventory.
+func (v *Inventory) IsSetSecondary() bool {
+	return v != nil && v.Secondary != nil
+}
+
+// GetItems returns the value of Items if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Inventory.
+func (v *Inventory) GetItems() (o []*Item) {
+	if v != nil && v.Items != nil {
+		return v.Items
+	}
+
+	return
+}
+
+// IsSetItems returns true if Items is not nil.
+//
+// This is safe to call on a nil Inventory.
+func (v *Inventory) IsSetItems() bool {
+	return v != nil && v.Items != nil
+}
+
+// GetCounts returns the value of Counts if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Inventory.
+func (v *Inventory) GetCounts() (o []int32) {
+	if v != nil && v.Counts != nil {
+		return v.Counts
+	}
+
+	return
+}
+
+// IsSetCounts returns true if Counts is not nil.
+//
+// This is safe to call on a nil Inventory.
+func (v *Inventory) IsSetCounts() bool {
+	return v != nil && v.Counts != nil
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Inventory.
+func (v *Inventory) GetTags() (o map[string]struct{}) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+//
+// This is safe to call on a nil Inventory.
+func (v *Inventory) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+// GetGroups returns the value of Groups if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Inventory.
+func (v *Inventory) GetGroups() (o [][]int32) {
+	if v != nil && v.Groups != nil {
+		return v.Groups
+	}
+
+	return
+}
+
+// IsSetGroups returns true if Groups is not nil.
+//
+// This is safe to call on a nil Inventory.
+func (v *Inventory) IsSetGroups() bool {
+	return v != nil && v.Groups != nil
+}
+
+// GetBlobs returns the value of Blobs if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Inventory.
+func (v *Inventory) GetBlobs() (o map[string][]byte) {
+	if v != nil && v.Blobs != nil {
+		return v.Blobs
+	}
+
+	return
+}
+
+// IsSetBlobs returns true if Blobs is not nil.
+//
+// This is safe to call on a nil Inventory.
+func (v *Inventory) IsSetBlobs() bool {
+	return v != nil && v.Blobs != nil
+}
+
+// GetVersions returns the value of Versions if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Inventory.
+func (v *Inventory) GetVersions() (o map[int32]int64) {
+	if v != nil && v.Versions != nil {
+		return v.Versions
+	}
+
+	return
+}
+
+// IsSetVersions returns true if Versions is not nil.
+//
+// This is safe to call on a nil Inventory.
+func (v *Inventory) IsSetVersions() bool {
+	return v != nil && v.Versions != nil
+}
+
+// GetLabels returns the value of Labels if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Inventory.
+func (v *Inventory) GetLabels() (o []struct {
+	Key   []int32
+	Value string
+}) {
+	if v != nil && v.Labels != nil {
+		return v.Labels
+	}
+
+	return
+}
+
+// IsSetLabels returns true if Labels is not nil.
+//
+// This is safe to call on a nil Inventory.
+func (v *Inventory) IsSetLabels() bool {
+	return v != nil && v.Labels != nil
+}
+
+// GetSizes returns the value of Sizes if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Inventory.
+func (v *Inventory) GetSizes() (o map[string]int32) {
+	if v != nil && v.Sizes != nil {
+		return v.Sizes
+	}
+
+	return
+}
+
+// IsSetSizes returns true if Sizes is not nil.
+//
+// This is safe to call on a nil Inventory.
+func (v *Inventory) IsSetSizes() bool {
+	return v != nil && v.Sizes != nil
+}
+
+// GetNames returns the value of Names if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Inventory.
+func (v *Inventory) GetNames() (o Names) {
+	if v != nil && v.Names != nil {
+		return v.Names
+	}
+
+	return
+}
+
+// IsSetNames returns true if Names is not nil.
+//
+// This is safe to call on a nil Inventory.
+func (v *Inventory) IsSetNames() bool {
+	return v != nil && v.Names != nil
+}
+
+// GetAliased returns the value of Aliased if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Inventory.
+func (v *Inventory) GetAliased() (o *ItemAlias) {
+	if v != nil && v.Aliased != nil {
+		return v.Aliased
+	}
+
+	return
+}
+
+// IsSetAliased returns true if Aliased is not nil.
+//
+// This is safe to call on a nil Inventory.
+func (v *Inventory) IsSetAliased() bool {
+	return v != nil && v.Aliased != nil
+}
+
+// GetOwner returns the value of Owner if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Inventory.
+func (v *Inventory) GetOwner() (o Name) {
+	if v != nil && v.Owner != nil {
+		return *v.Owner
+	}
+
+	return
+}
+
+// IsSetOwner returns true if Owner is not nil.
+//
+// This is safe to call on a nil Inventory.
+func (v *Inventory) IsSetOwner() bool {
+	return v != nil && v.Owner != nil
+}
+
+// GetRaw returns the value of Raw if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Inventory.
+func (v *Inventory) GetRaw() (o *wire.Value) {
+	if v != nil && v.Raw != nil {
+		return v.Raw
+	}
+
+	return
+}
+
+// IsSetRaw returns true if Raw is not nil.
+//
+// This is safe to call on a nil Inventory.
+func (v *Inventory) IsSetRaw() bool {
+	return v != nil && v.Raw != nil
+}
+
+type Item struct {
+	Name    string  `json:"name,required"`
+	Label   *string `json:"label,omitempty"`
+	Count   *int32  `json:"count,omitempty"`
+	Version int64   `json:"version,omitempty"`
+	Note    string  `json:"note,omitempty"`
+	Kind    *Kind   `json:"kind,omitempty"`
+	Data    []byte  `json:"data,omitempty"`
+
+	_isSet [1]uint64
+}
+
+// ToWire translates a Item struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Item) ToWire() (wire.Value, error) {
+	var (
+		fields [7]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Item is nil")
+	}
+
+	w = wire.NewValueString(v.Name)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Label != nil {
+		w = wire.NewValueString(*(v.Label))
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Count != nil {
+		w = wire.NewValueI32(*(v.Count))
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.IsSetVersion() {
+		w = wire.NewValueI64(v.Version)
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.IsSetNote() {
+		w = wire.NewValueString(v.Note)
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.Kind != nil {
+		w, err = v.Kind.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.Data != nil {
+		w = wire.NewValueBinary(v.Data)
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Kind_Read(w wire.Value) (Kind, error) {
+	var v Kind
+	err := v.FromWire(w)
+	return v, err
+}
+
+// FromWire deserializes a Item struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Item struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Item
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Item) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name = field.Value.GetString()
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				x := field.Value.GetString()
+				v.Label = &x
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TI32 {
+				x := field.Value.GetI32()
+				v.Count = &x
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TI64 {
+				v.Version = field.Value.GetI64()
+				v._isSet[0] |= 1 << 0
+			}
+		case 5:
+			if field.Value.Type() == wire.TBinary {
+				v.Note = field.Value.GetString()
+				v._isSet[0] |= 1 << 1
+			}
+		case 6:
+			if field.Value.Type() == wire.TI32 {
+				var x Kind
+				x, err = _Kind_Read(field.Value)
+				v.Kind = &x
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TBinary {
+				v.Data = field.Value.GetBinary()
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Item is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Item
+// struct.
+func (v *Item) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [7]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	if v.Label != nil {
+		fields[i] = fmt.Sprintf("Label: %v", *(v.Label))
+		i++
+	}
+	if v.Count != nil {
+		fields[i] = fmt.Sprintf("Count: %v", *(v.Count))
+		i++
+	}
+	if v.IsSetVersion() {
+		fields[i] = fmt.Sprintf("Version: %v", v.Version)
+		i++
+	}
+	if v.IsSetNote() {
+		fields[i] = fmt.Sprintf("Note: %v", v.Note)
+		i++
+	}
+	if v.Kind != nil {
+		fields[i] = fmt.Sprintf("Kind: %v", *(v.Kind))
+		i++
+	}
+	if v.Data != nil {
+		fields[i] = fmt.Sprintf("Data: %v", v.Data)
+		i++
+	}
+
+	return fmt.Sprintf("Item{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _Kind_EqualsPtr(lhs, rhs *Kind) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return x.Equals(y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Item match the
+// provided Item.
+//
+// This function performs a deep comparison.
+func (v *Item) Equals(rhs *Item) bool {
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Label, rhs.Label) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Count, rhs.Count) {
+		return false
+	}
+	if v.IsSetVersion() != rhs.IsSetVersion() || !(v.Version == rhs.Version) {
+		return false
+	}
+	if v.IsSetNote() != rhs.IsSetNote() || !(v.Note == rhs.Note) {
+		return false
+	}
+	if !_Kind_EqualsPtr(v.Kind, rhs.Kind) {
+		return false
+	}
+	if !((v.Data == nil && rhs.Data == nil) || (v.Data != nil && rhs.Data != nil && bytes.Equal(v.Data, rhs.Data))) {
+		return false
+	}
+
+	return true
+}
+
+func _I32_ClonePtr(p *int32) *int32 {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _Kind_ClonePtr(p *Kind) *Kind {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this Item.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Item) Clone() *Item {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Label = _String_ClonePtr(v.Label)
+	o.Count = _I32_ClonePtr(v.Count)
+	o.Kind = _Kind_ClonePtr(v.Kind)
+	o.Data = _Binary_Clone(v.Data)
+
+	return &o
+}
+
+func _I32_MemSizePtr(p *int32) int {
+	if p == nil {
+		return 0
+	}
+	return int(unsafe.Sizeof(*p))
+}
+
+func _Kind_MemSizePtr(p *Kind) int {
+	if p == nil {
+		return 0
+	}
+	return int(unsafe.Sizeof(*p))
+}
+
+// MemSize returns an estimate of the number of bytes of memory held
+// by this Item, including the memory referenced by its fields.
+// Caches may use it to limit the memory held by the values they
+// retain.
+func (v *Item) MemSize() int {
+	if v == nil {
+		return 0
+	}
+
+	n := int(unsafe.Sizeof(*v))
+	n += len(v.Name)
+	n += _String_MemSizePtr(v.Label)
+	n += _I32_MemSizePtr(v.Count)
+	n += len(v.Note)
+	n += _Kind_MemSizePtr(v.Kind)
+	n += cap(v.Data)
+	return n
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Item.
+func (v *Item) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("name", v.Name)
+	if v.Label != nil {
+		enc.AddString("label", *v.Label)
+	}
+	if v.Count != nil {
+		enc.AddInt32("count", *v.Count)
+	}
+	if v.IsSetVersion() {
+		enc.AddInt64("version", v.Version)
+	}
+	if v.IsSetNote() {
+		enc.AddString("note", v.Note)
+	}
+	if v.Kind != nil {
+		if err := enc.AddObject("kind", *v.Kind); err != nil {
+			return err
+		}
+	}
+	if v.Data != nil {
+		enc.AddString("data", base64.StdEncoding.EncodeToString(v.Data))
+	}
+	return nil
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Item.
+func (v *Item) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetLabel returns the value of Label if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Item.
+func (v *Item) GetLabel() (o string) {
+	if v != nil && v.Label != nil {
+		return *v.Label
+	}
+
+	return
+}
+
+// IsSetLabel returns true if Label is not nil.
+//
+// This is safe to call on a nil Item.
+func (v *Item) IsSetLabel() bool {
+	return v != nil && v.Label != nil
+}
+
+// GetCount returns the value of Count if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Item.
+func (v *Item) GetCount() (o int32) {
+	if v != nil && v.Count != nil {
+		return *v.Count
+	}
+
+	return
+}
+
+// IsSetCount returns true if Count is not nil.
+//
+// This is safe to call on a nil Item.
+func (v *Item) IsSetCount() bool {
+	return v != nil && v.Count != nil
+}
+
+// GetVersion returns the value of Version if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Item.
+func (v *Item) GetVersion() (o int64) {
+	if v.IsSetVersion() {
+		return v.Version
+	}
+
+	return
+}
+
+// IsSetVersion returns true if Version was set with SetVersion,
+// decoded from its Thrift representation, or has a non-zero value.
+//
+// This is safe to call on a nil Item.
+func (v *Item) IsSetVersion() bool {
+	return v != nil && (v.Version != 0 || v._isSet[0]&(1<<0) != 0)
+}
+
+// SetVersion sets the value of Version and marks it as set.
+func (v *Item) SetVersion(o int64) {
+	v.Version = o
+	v._isSet[0] |= 1 << 0
+}
+
+// UnsetVersion resets Version to its zero value and marks it as
+// unset.
+func (v *Item) UnsetVersion() {
+	v.Version = 0
+	v._isSet[0] &^= 1 << 0
+}
+
+// GetNote returns the value of Note if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Item.
+func (v *Item) GetNote() (o string) {
+	if v.IsSetNote() {
+		return v.Note
+	}
+
+	return
+}
+
+// IsSetNote returns true if Note was set with SetNote,
+// decoded from its Thrift representation, or has a non-zero value.
+//
+// This is safe to call on a nil Item.
+func (v *Item) IsSetNote() bool {
+	return v != nil && (v.Note != "" || v._isSet[0]&(1<<1) != 0)
+}
+
+// SetNote sets the value of Note and marks it as set.
+func (v *Item) SetNote(o string) {
+	v.Note = o
+	v._isSet[0] |= 1 << 1
+}
+
+// UnsetNote resets Note to its zero value and marks it as
+// unset.
+func (v *Item) UnsetNote() {
+	v.Note = ""
+	v._isSet[0] &^= 1 << 1
+}
+
+// GetKind returns the value of Kind if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Item.
+func (v *Item) GetKind() (o Kind) {
+	if v != nil && v.Kind != nil {
+		return *v.Kind
+	}
+
+	return
+}
+
+// IsSetKind returns true if Kind is not nil.
+//
+// This is safe to call on a nil Item.
+func (v *Item) IsSetKind() bool {
+	return v != nil && v.Kind != nil
+}
+
+// GetData returns the value of Data if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Item.
+func (v *Item) GetData() (o []byte) {
+	if v != nil && v.Data != nil {
+		return v.Data
+	}
+
+	return
+}
+
+// IsSetData returns true if Data is not nil.
+//
+// This is safe to call on a nil Item.
+func (v *Item) IsSetData() bool {
+	return v != nil && v.Data != nil
+}
+
+type ItemAlias Item
+
+// ToWire translates ItemAlias into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v *ItemAlias) ToWire() (wire.Value, error) {
+	x := (*Item)(v)
+	return x.ToWire()
+}
+
+// String returns a readable string representation of ItemAlias.
+func (v *ItemAlias) String() string {
+	x := (*Item)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes ItemAlias from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *ItemAlias) FromWire(w wire.Value) error {
+	return (*Item)(v).FromWire(w)
+}
+
+// Equals returns true if this ItemAlias is equal to the provided
+// ItemAlias.
+func (lhs *ItemAlias) Equals(rhs *ItemAlias) bool {
+	return (*Item)(lhs).Equals((*Item)(rhs))
+}
+
+// Clone returns a deep copy of this ItemAlias.
+func (v *ItemAlias) Clone() *ItemAlias {
+	return (*ItemAlias)((*Item)(v).Clone())
+}
+
+// MemSize returns an estimate of the number of bytes of memory
+// referenced by this ItemAlias.
+func (v *ItemAlias) MemSize() int {
+	return (*Item)(v).MemSize()
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ItemAlias.
+func (v *ItemAlias) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	x := (*Item)(v)
+	return x.MarshalLogObject(enc)
+}
+
+type Kind int32
+
+const (
+	KindSmall Kind = 0
+	KindLarge Kind = 1
+)
+
+// Kind_Values returns all recognized values of Kind.
+func Kind_Values() []Kind {
+	return []Kind{
+		KindSmall,
+		KindLarge,
+	}
+}
+
+// UnmarshalText tries to decode Kind from a byte slice
+// containing its name.
+//
+//   var v Kind
+//   err := v.UnmarshalText([]byte("SMALL"))
+func (v *Kind) UnmarshalText(value []byte) error {
+	switch string(value) {
+	case "SMALL":
+		*v = KindSmall
+		return nil
+	case "LARGE":
+		*v = KindLarge
+		return nil
+	default:
+		return fmt.Errorf("unknown enum value %q for %q", value, "Kind")
+	}
+}
+
+// ToWire translates Kind into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v Kind) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes Kind from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return Kind(0), err
+//   }
+//
+//   var v Kind
+//   if err := v.FromWire(x); err != nil {
+//     return Kind(0), err
+//   }
+//   return v, nil
+func (v *Kind) FromWire(w wire.Value) error {
+	*v = (Kind)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of Kind.
+func (v Kind) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "SMALL"
+	case 1:
+		return "LARGE"
+	}
+	return fmt.Sprintf("Kind(%d)", w)
+}
+
+// Equals returns true if this Kind value matches the provided
+// value.
+func (v Kind) Equals(rhs Kind) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes Kind into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v Kind) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"SMALL\""), nil
+	case 1:
+		return ([]byte)("\"LARGE\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode Kind from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *Kind) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Kind")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Kind")
+		}
+		*v = (Kind)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Kind")
+	}
+}
+
+// Kind_NumValues is the number of distinct recognized
+// values of Kind.
+const Kind_NumValues = 2
+
+// Ordinal returns the position of this value among the distinct
+// recognized values of Kind or false if the value is not
+// recognized. Ordinals are less than Kind_NumValues.
+//
+// Ordinals may be used to index arrays of length
+// Kind_NumValues in place of map[Kind]T.
+//
+//   var counts [Kind_NumValues]int
+//   if i, ok := v.Ordinal(); ok {
+//     counts[i]++
+//   }
+func (v Kind) Ordinal() (int, bool) {
+	switch int32(v) {
+	case 0:
+		return 0, true
+	case 1:
+		return 1, true
+	default:
+		return 0, false
+	}
+}
+
+// Kind_Set is a set of Kind values backed by a
+// bitset. The zero value is an empty set.
+type Kind_Set struct {
+	bits [1]uint64
+}
+
+// Add adds the given value to the set. It returns false if the value
+// is not a recognized value of Kind.
+func (s *Kind_Set) Add(v Kind) bool {
+	i, ok := v.Ordinal()
+	if ok {
+		s.bits[i/64] |= 1 << uint(i%64)
+	}
+	return ok
+}
+
+// Remove removes the given value from the set.
+func (s *Kind_Set) Remove(v Kind) {
+	if i, ok := v.Ordinal(); ok {
+		s.bits[i/64] &^= 1 << uint(i%64)
+	}
+}
+
+// Contains returns true if the given value is in the set.
+func (s *Kind_Set) Contains(v Kind) bool {
+	i, ok := v.Ordinal()
+	return ok && s.bits[i/64]&(1<<uint(i%64)) != 0
+}
+
+// Len returns the number of values in the set.
+func (s *Kind_Set) Len() int {
+	n := 0
+	for _, x := range s.bits {
+		for ; x != 0; n++ {
+			x &= x - 1
+		}
+	}
+	return n
+}
+
+// Values returns the values in the set in the order in which they
+// were declared.
+func (s *Kind_Set) Values() []Kind {
+	v := make([]Kind, 0, s.Len())
+	if s.bits[0]&(1<<0) != 0 {
+		v = append(v, KindSmall)
+	}
+	if s.bits[0]&(1<<1) != 0 {
+		v = append(v, KindLarge)
+	}
+	return v
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Kind.
+//
+// Enums are logged as objects, where the value is logged with key
+// "value", and if this value's name is known, the name is logged with
+// key "name".
+func (v Kind) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "SMALL")
+	case 1:
+		enc.AddString("name", "LARGE")
+	}
+	return nil
+}
+
+type Name string
+
+// ToWire translates Name into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Name) ToWire() (wire.Value, error) {
+	x := (string)(v)
+	return wire.NewValueString(x), nil
+}
+
+// String returns a readable string representation of Name.
+func (v Name) String() string {
+	x := (string)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes Name from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Name) FromWire(w wire.Value) error {
+	*v = (Name)(w.GetString())
+	return nil
+}
+
+// Equals returns true if this Name is equal to the provided
+// Name.
+func (lhs Name) Equals(rhs Name) bool {
+	return (lhs == rhs)
+}
+
+// Clone returns a deep copy of this Name.
+func (v Name) Clone() Name {
+	x := (string)(v)
+	return (Name)(x)
+}
+
+// MemSize returns an estimate of the number of bytes of memory
+// referenced by this Name.
+func (v Name) MemSize() int {
+	x := (string)(v)
+	return len(x)
+}
+
+type _List_Name_ValueList []Name
+
+func (v _List_Name_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Name_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Name_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_Name_ValueList) Close() {}
+
+func _List_Name_Read(l wire.ValueList) ([]Name, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]Name, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Name_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _List_Name_Equals(lhs, rhs []Name) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_Name_Clone(l []Name) []Name {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]Name, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
+}
+
+type Names []Name
+
+// ToWire translates Names into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Names) ToWire() (wire.Value, error) {
+	x := ([]Name)(v)
+	return wire.NewValueList(_List_Name_ValueList(x)), nil
+}
+
+// String returns a readable string representation of Names.
+func (v Names) String() string {
+	x := ([]Name)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes Names from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Names) FromWire(w wire.Value) error {
+	x, err := _List_Name_Read(w.GetList())
+	*v = (Names)(x)
+	return err
+}
+
+// Equals returns true if this Names is equal to the provided
+// Names.
+func (lhs Names) Equals(rhs Names) bool {
+	return _List_Name_Equals(lhs, rhs)
+}
+
+// Clone returns a deep copy of this Names.
+func (v Names) Clone() Names {
+	x := ([]Name)(v)
+	return (Names)(_List_Name_Clone(x))
+}
+
+func _List_Name_MemSize(l []Name) int {
+	n := cap(l) * int(unsafe.Sizeof(l[0]))
+	for _, x := range l {
+		n += len(x)
+	}
+	return n
+}
+
+// MemSize returns an estimate of the number of bytes of memory
+// referenced by this Names.
+func (v Names) MemSize() int {
+	x := ([]Name)(v)
+	return _List_Name_MemSize(x)
+}
+
+type _List_Name_Zapper []Name
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Name_Zapper.
+func (l _List_Name_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		enc.AppendString((string)(v))
+	}
+	return nil
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of Names.
+func (v Names) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	x := ([]Name)(v)
+	return (_List_Name_Zapper)(x).MarshalLogArray(enc)
+}
+
+type NotFound struct {
+	Message string `json:"message,required"`
+}
+
+// ToWire translates a NotFound struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *NotFound) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("NotFound is nil")
+	}
+
+	w = wire.NewValueString(v.Message)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a NotFound struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a NotFound struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v NotFound
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *NotFound) FromWire(w wire.Value) error {
+
+	messageIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message = field.Value.GetString()
+				messageIsSet = true
+			}
+		}
+	}
+
+	if !messageIsSet {
+		return errors.New("field Message of NotFound is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a NotFound
+// struct.
+func (v *NotFound) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Message: %v", v.Message)
+	i++
+
+	return fmt.Sprintf("NotFound{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this NotFound match the
+// provided NotFound.
+//
+// This function performs a deep comparison.
+func (v *NotFound) Equals(rhs *NotFound) bool {
+	if !(v.Message == rhs.Message) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this NotFound.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *NotFound) Clone() *NotFound {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
+// MemSize returns an estimate of the number of bytes of memory held
+// by this NotFound, including the memory referenced by its fields.
+// Caches may use it to limit the memory held by the values they
+// retain.
+func (v *NotFound) MemSize() int {
+	if v == nil {
+		return 0
+	}
+
+	n := int(unsafe.Sizeof(*v))
+	n += len(v.Message)
+	return n
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of NotFound.
+func (v *NotFound) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("message", v.Message)
+	return nil
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil NotFound.
+func (v *NotFound) GetMessage() (o string) {
+	if v != nil {
+		o = v.Message
+	}
+	return
+}
+
+// Error returns the message of the exception if it is set and the
+// String representation of the exception otherwise.
+func (v *NotFound) Error() string {
+	if m := v.GetMessage(); m != "" {
+		return m
+	}
+	return v.String()
+}
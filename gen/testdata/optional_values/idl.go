@@ -0,0 +1,18 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package optional_values
+
+import "go.uber.org/thriftrw/thriftreflect"
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:            "optional_values",
+	Package:         "go.uber.org/thriftrw/gen/testdata/optional_values",
+	FilePath:        "optional_values.thrift",
+	SHA1:            "555e64cd47831109b1603f7f064862bfb710b563",
+	ThriftRWVersion: "1.9.0",
+	Raw:             rawIDL,
+}
+
+const rawIDL = "// Code for this file is generated with --optional-values.\n\nenum Theme {\n    LIGHT, DARK\n}\n\ntypedef i64 Millis\n\nstruct Preferences {\n    1: optional bool notifications\n    2: optional i8 volume\n    3: optional i16 fontSize\n    4: optional i32 retries = 3\n    5: optional Millis timeout\n    6: optional double ratio\n    7: optional string locale = \"en_US\"\n    8: optional Theme theme\n    9: optional uuid deviceID\n    10: optional binary avatar\n    11: optional list<string> tags\n    12: optional string nickname (thriftrw.optional = \"pointer\")\n    13: required i32 version\n}\n\nstruct LegacyPreferences {\n    1: optional i32 retries\n    2: optional string locale (thriftrw.optional = \"value\")\n} (thriftrw.optional = \"pointer\")\n\nexception QuotaExceeded {\n    1: optional string message\n    2: optional i64 limit\n}\n\nunion Preference {\n    1: bool notifications\n    2: i32 retries\n}\n\nconst Preferences quietPreferences = {\n    \"notifications\": false,\n    \"volume\": 0,\n    \"locale\": \"en_GB\",\n    \"version\": 1,\n}\n\nstruct RenamedOptions {\n    1: optional i32 retries (go.name = \"MaxRetries\", go.renamedFrom = \"Retries\")\n}\n"
@@ -0,0 +1,2055 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package optional_values
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
+)
+
+type LegacyPreferences struct {
+	Retries *int32 `json:"retries,omitempty"`
+	Locale  string `json:"locale,omitempty"`
+
+	_isSet [1]uint64
+}
+
+// ToWire translates a LegacyPreferences struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *LegacyPreferences) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("LegacyPreferences is nil")
+	}
+
+	if v.Retries != nil {
+		w = wire.NewValueI32(*(v.Retries))
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.IsSetLocale() {
+		w = wire.NewValueString(v.Locale)
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a LegacyPreferences struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a LegacyPreferences struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v LegacyPreferences
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *LegacyPreferences) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI32 {
+				x := field.Value.GetI32()
+				v.Retries = &x
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.Locale = field.Value.GetString()
+				v._isSet[0] |= 1 << 0
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a LegacyPreferences
+// struct.
+func (v *LegacyPreferences) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Retries != nil {
+		fields[i] = fmt.Sprintf("Retries: %v", *(v.Retries))
+		i++
+	}
+	if v.IsSetLocale() {
+		fields[i] = fmt.Sprintf("Locale: %v", v.Locale)
+		i++
+	}
+
+	return fmt.Sprintf("LegacyPreferences{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this LegacyPreferences match the
+// provided LegacyPreferences.
+//
+// This function performs a deep comparison.
+func (v *LegacyPreferences) Equals(rhs *LegacyPreferences) bool {
+	if !_I32_EqualsPtr(v.Retries, rhs.Retries) {
+		return false
+	}
+	if v.IsSetLocale() != rhs.IsSetLocale() || !(v.Locale == rhs.Locale) {
+		return false
+	}
+
+	return true
+}
+
+func _I32_ClonePtr(p *int32) *int32 {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this LegacyPreferences.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *LegacyPreferences) Clone() *LegacyPreferences {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Retries = _I32_ClonePtr(v.Retries)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of LegacyPreferences.
+func (v *LegacyPreferences) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Retries != nil {
+		enc.AddInt32("retries", *v.Retries)
+	}
+	if v.IsSetLocale() {
+		enc.AddString("locale", v.Locale)
+	}
+	return nil
+}
+
+// GetRetries returns the value of Retries if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil LegacyPreferences.
+func (v *LegacyPreferences) GetRetries() (o int32) {
+	if v != nil && v.Retries != nil {
+		return *v.Retries
+	}
+
+	return
+}
+
+// IsSetRetries returns true if Retries is not nil.
+//
+// This is safe to call on a nil LegacyPreferences.
+func (v *LegacyPreferences) IsSetRetries() bool {
+	return v != nil && v.Retries != nil
+}
+
+// GetLocale returns the value of Locale if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil LegacyPreferences.
+func (v *LegacyPreferences) GetLocale() (o string) {
+	if v.IsSetLocale() {
+		return v.Locale
+	}
+
+	return
+}
+
+// IsSetLocale returns true if Locale was set with SetLocale,
+// decoded from its Thrift representation, or has a non-zero value.
+//
+// This is safe to call on a nil LegacyPreferences.
+func (v *LegacyPreferences) IsSetLocale() bool {
+	return v != nil && (v.Locale != "" || v._isSet[0]&(1<<0) != 0)
+}
+
+// SetLocale sets the value of Locale and marks it as set.
+func (v *LegacyPreferences) SetLocale(o string) {
+	v.Locale = o
+	v._isSet[0] |= 1 << 0
+}
+
+// UnsetLocale resets Locale to its zero value and marks it as
+// unset.
+func (v *LegacyPreferences) UnsetLocale() {
+	v.Locale = ""
+	v._isSet[0] &^= 1 << 0
+}
+
+type Millis int64
+
+// ToWire translates Millis into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Millis) ToWire() (wire.Value, error) {
+	x := (int64)(v)
+	return wire.NewValueI64(x), nil
+}
+
+// String returns a readable string representation of Millis.
+func (v Millis) String() string {
+	x := (int64)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes Millis from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Millis) FromWire(w wire.Value) error {
+	*v = (Millis)(w.GetI64())
+	return nil
+}
+
+// Equals returns true if this Millis is equal to the provided
+// Millis.
+func (lhs Millis) Equals(rhs Millis) bool {
+	return (lhs == rhs)
+}
+
+// Clone returns a deep copy of this Millis.
+func (v Millis) Clone() Millis {
+	x := (int64)(v)
+	return (Millis)(x)
+}
+
+type Preference struct {
+	Notifications *bool  `json:"notifications,omitempty"`
+	Retries       *int32 `json:"retries,omitempty"`
+}
+
+// ToWire translates a Preference struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Preference) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Preference is nil")
+	}
+
+	if v.Notifications != nil {
+		w = wire.NewValueBool(*(v.Notifications))
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Retries != nil {
+		w = wire.NewValueI32(*(v.Retries))
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Preference should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Preference struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Preference struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Preference
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Preference) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBool {
+				x := field.Value.GetBool()
+				v.Notifications = &x
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				x := field.Value.GetI32()
+				v.Retries = &x
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Notifications != nil {
+		count++
+	}
+	if v.Retries != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Preference should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Preference
+// struct.
+func (v *Preference) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Notifications != nil {
+		fields[i] = fmt.Sprintf("Notifications: %v", *(v.Notifications))
+		i++
+	}
+	if v.Retries != nil {
+		fields[i] = fmt.Sprintf("Retries: %v", *(v.Retries))
+		i++
+	}
+
+	return fmt.Sprintf("Preference{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Bool_EqualsPtr(lhs, rhs *bool) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Preference match the
+// provided Preference.
+//
+// This function performs a deep comparison.
+func (v *Preference) Equals(rhs *Preference) bool {
+	if !_Bool_EqualsPtr(v.Notifications, rhs.Notifications) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Retries, rhs.Retries) {
+		return false
+	}
+
+	return true
+}
+
+func _Bool_ClonePtr(p *bool) *bool {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this Preference.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Preference) Clone() *Preference {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Notifications = _Bool_ClonePtr(v.Notifications)
+	o.Retries = _I32_ClonePtr(v.Retries)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Preference.
+func (v *Preference) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Notifications != nil {
+		enc.AddBool("notifications", *v.Notifications)
+	}
+	if v.Retries != nil {
+		enc.AddInt32("retries", *v.Retries)
+	}
+	return nil
+}
+
+// GetNotifications returns the value of Notifications if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Preference.
+func (v *Preference) GetNotifications() (o bool) {
+	if v != nil && v.Notifications != nil {
+		return *v.Notifications
+	}
+
+	return
+}
+
+// IsSetNotifications returns true if Notifications is not nil.
+//
+// This is safe to call on a nil Preference.
+func (v *Preference) IsSetNotifications() bool {
+	return v != nil && v.Notifications != nil
+}
+
+// GetRetries returns the value of Retries if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Preference.
+func (v *Preference) GetRetries() (o int32) {
+	if v != nil && v.Retries != nil {
+		return *v.Retries
+	}
+
+	return
+}
+
+// IsSetRetries returns true if Retries is not nil.
+//
+// This is safe to call on a nil Preference.
+func (v *Preference) IsSetRetries() bool {
+	return v != nil && v.Retries != nil
+}
+
+type Preferences struct {
+	Notifications bool      `json:"notifications,omitempty"`
+	Volume        int8      `json:"volume,omitempty"`
+	FontSize      int16     `json:"fontSize,omitempty"`
+	Retries       int32     `json:"retries,omitempty"`
+	Timeout       Millis    `json:"timeout,omitempty"`
+	Ratio         float64   `json:"ratio,omitempty"`
+	Locale        string    `json:"locale,omitempty"`
+	Theme         Theme     `json:"theme,omitempty"`
+	DeviceID      wire.UUID `json:"deviceID,omitempty"`
+	Avatar        []byte    `json:"avatar,omitempty"`
+	Tags          []string  `json:"tags,omitempty"`
+	Nickname      *string   `json:"nickname,omitempty"`
+	Version       int32     `json:"version,required"`
+
+	_isSet [1]uint64
+}
+
+// Default_Preferences constructs a new Preferences with its fields set to the
+// default values declared for them in the Thrift file.
+func Default_Preferences() *Preferences {
+	var v Preferences
+	v.SetRetries(3)
+	v.SetLocale("en_US")
+	return &v
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		if err := f(wire.NewValueString(x)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+// ToWire translates a Preferences struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Preferences) ToWire() (wire.Value, error) {
+	var (
+		fields [13]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Preferences is nil")
+	}
+
+	if v.IsSetNotifications() {
+		w = wire.NewValueBool(v.Notifications)
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.IsSetVolume() {
+		w = wire.NewValueI8(v.Volume)
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.IsSetFontSize() {
+		w = wire.NewValueI16(v.FontSize)
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if !v.IsSetRetries() {
+		v.SetRetries(3)
+	}
+	{
+		w = wire.NewValueI32(v.Retries)
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.IsSetTimeout() {
+		w, err = v.Timeout.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.IsSetRatio() {
+		w = wire.NewValueDouble(v.Ratio)
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if !v.IsSetLocale() {
+		v.SetLocale("en_US")
+	}
+	{
+		w = wire.NewValueString(v.Locale)
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.IsSetTheme() {
+		w, err = v.Theme.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+	if v.IsSetDeviceID() {
+		w = wire.NewValueUUID(v.DeviceID)
+		fields[i] = wire.Field{ID: 9, Value: w}
+		i++
+	}
+	if v.Avatar != nil {
+		w = wire.NewValueBinary(v.Avatar)
+		fields[i] = wire.Field{ID: 10, Value: w}
+		i++
+	}
+	if v.Tags != nil {
+		w = wire.NewValueList(_List_String_ValueList(v.Tags))
+		fields[i] = wire.Field{ID: 11, Value: w}
+		i++
+	}
+	if v.Nickname != nil {
+		w = wire.NewValueString(*(v.Nickname))
+		fields[i] = wire.Field{ID: 12, Value: w}
+		i++
+	}
+
+	w = wire.NewValueI32(v.Version)
+	fields[i] = wire.Field{ID: 13, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Millis_Read(w wire.Value) (Millis, error) {
+	var x Millis
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _Theme_Read(w wire.Value) (Theme, error) {
+	var v Theme
+	err := v.FromWire(w)
+	return v, err
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		o = append(o, x.GetString())
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+// FromWire deserializes a Preferences struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Preferences struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Preferences
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Preferences) FromWire(w wire.Value) error {
+	var err error
+
+	versionIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBool {
+				v.Notifications = field.Value.GetBool()
+				v._isSet[0] |= 1 << 0
+			}
+		case 2:
+			if field.Value.Type() == wire.TI8 {
+				v.Volume = field.Value.GetI8()
+				v._isSet[0] |= 1 << 1
+			}
+		case 3:
+			if field.Value.Type() == wire.TI16 {
+				v.FontSize = field.Value.GetI16()
+				v._isSet[0] |= 1 << 2
+			}
+		case 4:
+			if field.Value.Type() == wire.TI32 {
+				v.Retries = field.Value.GetI32()
+				v._isSet[0] |= 1 << 3
+			}
+		case 5:
+			if field.Value.Type() == wire.TI64 {
+				v.Timeout, err = _Millis_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				v._isSet[0] |= 1 << 4
+			}
+		case 6:
+			if field.Value.Type() == wire.TDouble {
+				v.Ratio = field.Value.GetDouble()
+				v._isSet[0] |= 1 << 5
+			}
+		case 7:
+			if field.Value.Type() == wire.TBinary {
+				v.Locale = field.Value.GetString()
+				v._isSet[0] |= 1 << 6
+			}
+		case 8:
+			if field.Value.Type() == wire.TI32 {
+				v.Theme, err = _Theme_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				v._isSet[0] |= 1 << 7
+			}
+		case 9:
+			if field.Value.Type() == wire.TUUID {
+				v.DeviceID = field.Value.GetUUID()
+				v._isSet[0] |= 1 << 8
+			}
+		case 10:
+			if field.Value.Type() == wire.TBinary {
+				v.Avatar = field.Value.GetBinary()
+
+			}
+		case 11:
+			if field.Value.Type() == wire.TList {
+				v.Tags, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 12:
+			if field.Value.Type() == wire.TBinary {
+				x := field.Value.GetString()
+				v.Nickname = &x
+
+			}
+		case 13:
+			if field.Value.Type() == wire.TI32 {
+				v.Version = field.Value.GetI32()
+				versionIsSet = true
+			}
+		}
+	}
+
+	if !v.IsSetRetries() {
+		v.SetRetries(3)
+	}
+
+	if !v.IsSetLocale() {
+		v.SetLocale("en_US")
+	}
+
+	if !versionIsSet {
+		return errors.New("field Version of Preferences is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Preferences
+// struct.
+func (v *Preferences) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [13]string
+	i := 0
+	if v.IsSetNotifications() {
+		fields[i] = fmt.Sprintf("Notifications: %v", v.Notifications)
+		i++
+	}
+	if v.IsSetVolume() {
+		fields[i] = fmt.Sprintf("Volume: %v", v.Volume)
+		i++
+	}
+	if v.IsSetFontSize() {
+		fields[i] = fmt.Sprintf("FontSize: %v", v.FontSize)
+		i++
+	}
+	if v.IsSetRetries() {
+		fields[i] = fmt.Sprintf("Retries: %v", v.Retries)
+		i++
+	}
+	if v.IsSetTimeout() {
+		fields[i] = fmt.Sprintf("Timeout: %v", v.Timeout)
+		i++
+	}
+	if v.IsSetRatio() {
+		fields[i] = fmt.Sprintf("Ratio: %v", v.Ratio)
+		i++
+	}
+	if v.IsSetLocale() {
+		fields[i] = fmt.Sprintf("Locale: %v", v.Locale)
+		i++
+	}
+	if v.IsSetTheme() {
+		fields[i] = fmt.Sprintf("Theme: %v", v.Theme)
+		i++
+	}
+	if v.IsSetDeviceID() {
+		fields[i] = fmt.Sprintf("DeviceID: %v", v.DeviceID)
+		i++
+	}
+	if v.Avatar != nil {
+		fields[i] = fmt.Sprintf("Avatar: %v", v.Avatar)
+		i++
+	}
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+	if v.Nickname != nil {
+		fields[i] = fmt.Sprintf("Nickname: %v", *(v.Nickname))
+		i++
+	}
+	fields[i] = fmt.Sprintf("Version: %v", v.Version)
+	i++
+
+	return fmt.Sprintf("Preferences{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Preferences match the
+// provided Preferences.
+//
+// This function performs a deep comparison.
+func (v *Preferences) Equals(rhs *Preferences) bool {
+	if v.IsSetNotifications() != rhs.IsSetNotifications() || !(v.Notifications == rhs.Notifications) {
+		return false
+	}
+	if v.IsSetVolume() != rhs.IsSetVolume() || !(v.Volume == rhs.Volume) {
+		return false
+	}
+	if v.IsSetFontSize() != rhs.IsSetFontSize() || !(v.FontSize == rhs.FontSize) {
+		return false
+	}
+	if v.IsSetRetries() != rhs.IsSetRetries() || !(v.Retries == rhs.Retries) {
+		return false
+	}
+	if v.IsSetTimeout() != rhs.IsSetTimeout() || !(v.Timeout == rhs.Timeout) {
+		return false
+	}
+	if v.IsSetRatio() != rhs.IsSetRatio() || !(v.Ratio == rhs.Ratio) {
+		return false
+	}
+	if v.IsSetLocale() != rhs.IsSetLocale() || !(v.Locale == rhs.Locale) {
+		return false
+	}
+	if v.IsSetTheme() != rhs.IsSetTheme() || !v.Theme.Equals(rhs.Theme) {
+		return false
+	}
+	if v.IsSetDeviceID() != rhs.IsSetDeviceID() || !(v.DeviceID == rhs.DeviceID) {
+		return false
+	}
+	if !((v.Avatar == nil && rhs.Avatar == nil) || (v.Avatar != nil && rhs.Avatar != nil && bytes.Equal(v.Avatar, rhs.Avatar))) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _List_String_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Nickname, rhs.Nickname) {
+		return false
+	}
+	if !(v.Version == rhs.Version) {
+		return false
+	}
+
+	return true
+}
+
+func _Binary_Clone(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+
+	o := make([]byte, len(b))
+	copy(o, b)
+	return o
+}
+
+func _List_String_Clone(l []string) []string {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]string, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
+}
+
+func _String_ClonePtr(p *string) *string {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this Preferences.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Preferences) Clone() *Preferences {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Avatar = _Binary_Clone(v.Avatar)
+	o.Tags = _List_String_Clone(v.Tags)
+	o.Nickname = _String_ClonePtr(v.Nickname)
+
+	return &o
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Preferences.
+func (v *Preferences) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.IsSetNotifications() {
+		enc.AddBool("notifications", v.Notifications)
+	}
+	if v.IsSetVolume() {
+		enc.AddInt8("volume", v.Volume)
+	}
+	if v.IsSetFontSize() {
+		enc.AddInt16("fontSize", v.FontSize)
+	}
+	if v.IsSetRetries() {
+		enc.AddInt32("retries", v.Retries)
+	}
+	if v.IsSetTimeout() {
+		enc.AddInt64("timeout", (int64)(v.Timeout))
+	}
+	if v.IsSetRatio() {
+		enc.AddFloat64("ratio", v.Ratio)
+	}
+	if v.IsSetLocale() {
+		enc.AddString("locale", v.Locale)
+	}
+	if v.IsSetTheme() {
+		if err := enc.AddObject("theme", v.Theme); err != nil {
+			return err
+		}
+	}
+	if v.IsSetDeviceID() {
+		enc.AddString("deviceID", (v.DeviceID).String())
+	}
+	if v.Avatar != nil {
+		enc.AddString("avatar", base64.StdEncoding.EncodeToString(v.Avatar))
+	}
+	if v.Tags != nil {
+		if err := enc.AddArray("tags", (_List_String_Zapper)(v.Tags)); err != nil {
+			return err
+		}
+	}
+	if v.Nickname != nil {
+		enc.AddString("nickname", *v.Nickname)
+	}
+	enc.AddInt32("version", v.Version)
+	return nil
+}
+
+// GetNotifications returns the value of Notifications if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Preferences.
+func (v *Preferences) GetNotifications() (o bool) {
+	if v.IsSetNotifications() {
+		return v.Notifications
+	}
+
+	return
+}
+
+// IsSetNotifications returns true if Notifications was set with SetNotifications,
+// decoded from its Thrift representation, or has a non-zero value.
+//
+// This is safe to call on a nil Preferences.
+func (v *Preferences) IsSetNotifications() bool {
+	return v != nil && (v.Notifications || v._isSet[0]&(1<<0) != 0)
+}
+
+// SetNotifications sets the value of Notifications and marks it as set.
+func (v *Preferences) SetNotifications(o bool) {
+	v.Notifications = o
+	v._isSet[0] |= 1 << 0
+}
+
+// UnsetNotifications resets Notifications to its zero value and marks it as
+// unset.
+func (v *Preferences) UnsetNotifications() {
+	v.Notifications = false
+	v._isSet[0] &^= 1 << 0
+}
+
+// GetVolume returns the value of Volume if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Preferences.
+func (v *Preferences) GetVolume() (o int8) {
+	if v.IsSetVolume() {
+		return v.Volume
+	}
+
+	return
+}
+
+// IsSetVolume returns true if Volume was set with SetVolume,
+// decoded from its Thrift representation, or has a non-zero value.
+//
+// This is safe to call on a nil Preferences.
+func (v *Preferences) IsSetVolume() bool {
+	return v != nil && (v.Volume != 0 || v._isSet[0]&(1<<1) != 0)
+}
+
+// SetVolume sets the value of Volume and marks it as set.
+func (v *Preferences) SetVolume(o int8) {
+	v.Volume = o
+	v._isSet[0] |= 1 << 1
+}
+
+// UnsetVolume resets Volume to its zero value and marks it as
+// unset.
+func (v *Preferences) UnsetVolume() {
+	v.Volume = 0
+	v._isSet[0] &^= 1 << 1
+}
+
+// GetFontSize returns the value of FontSize if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Preferences.
+func (v *Preferences) GetFontSize() (o int16) {
+	if v.IsSetFontSize() {
+		return v.FontSize
+	}
+
+	return
+}
+
+// IsSetFontSize returns true if FontSize was set with SetFontSize,
+// decoded from its Thrift representation, or has a non-zero value.
+//
+// This is safe to call on a nil Preferences.
+func (v *Preferences) IsSetFontSize() bool {
+	return v != nil && (v.FontSize != 0 || v._isSet[0]&(1<<2) != 0)
+}
+
+// SetFontSize sets the value of FontSize and marks it as set.
+func (v *Preferences) SetFontSize(o int16) {
+	v.FontSize = o
+	v._isSet[0] |= 1 << 2
+}
+
+// UnsetFontSize resets FontSize to its zero value and marks it as
+// unset.
+func (v *Preferences) UnsetFontSize() {
+	v.FontSize = 0
+	v._isSet[0] &^= 1 << 2
+}
+
+// GetRetries returns the value of Retries if it is set or its
+// default value if it is unset.
+//
+// This is safe to call on a nil Preferences.
+func (v *Preferences) GetRetries() (o int32) {
+	if v.IsSetRetries() {
+		return v.Retries
+	}
+	o = 3
+	return
+}
+
+// IsSetRetries returns true if Retries was set with SetRetries,
+// decoded from its Thrift representation, or has a non-zero value.
+//
+// This is safe to call on a nil Preferences.
+func (v *Preferences) IsSetRetries() bool {
+	return v != nil && (v.Retries != 0 || v._isSet[0]&(1<<3) != 0)
+}
+
+// SetRetries sets the value of Retries and marks it as set.
+func (v *Preferences) SetRetries(o int32) {
+	v.Retries = o
+	v._isSet[0] |= 1 << 3
+}
+
+// UnsetRetries resets Retries to its zero value and marks it as
+// unset.
+func (v *Preferences) UnsetRetries() {
+	v.Retries = 0
+	v._isSet[0] &^= 1 << 3
+}
+
+// GetTimeout returns the value of Timeout if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Preferences.
+func (v *Preferences) GetTimeout() (o Millis) {
+	if v.IsSetTimeout() {
+		return v.Timeout
+	}
+
+	return
+}
+
+// IsSetTimeout returns true if Timeout was set with SetTimeout,
+// decoded from its Thrift representation, or has a non-zero value.
+//
+// This is safe to call on a nil Preferences.
+func (v *Preferences) IsSetTimeout() bool {
+	return v != nil && (v.Timeout != 0 || v._isSet[0]&(1<<4) != 0)
+}
+
+// SetTimeout sets the value of Timeout and marks it as set.
+func (v *Preferences) SetTimeout(o Millis) {
+	v.Timeout = o
+	v._isSet[0] |= 1 << 4
+}
+
+// UnsetTimeout resets Timeout to its zero value and marks it as
+// unset.
+func (v *Preferences) UnsetTimeout() {
+	v.Timeout = 0
+	v._isSet[0] &^= 1 << 4
+}
+
+// GetRatio returns the value of Ratio if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Preferences.
+func (v *Preferences) GetRatio() (o float64) {
+	if v.IsSetRatio() {
+		return v.Ratio
+	}
+
+	return
+}
+
+// IsSetRatio returns true if Ratio was set with SetRatio,
+// decoded from its Thrift representation, or has a non-zero value.
+//
+// This is safe to call on a nil Preferences.
+func (v *Preferences) IsSetRatio() bool {
+	return v != nil && (v.Ratio != 0 || v._isSet[0]&(1<<5) != 0)
+}
+
+// SetRatio sets the value of Ratio and marks it as set.
+func (v *Preferences) SetRatio(o float64) {
+	v.Ratio = o
+	v._isSet[0] |= 1 << 5
+}
+
+// UnsetRatio resets Ratio to its zero value and marks it as
+// unset.
+func (v *Preferences) UnsetRatio() {
+	v.Ratio = 0
+	v._isSet[0] &^= 1 << 5
+}
+
+// GetLocale returns the value of Locale if it is set or its
+// default value if it is unset.
+//
+// This is safe to call on a nil Preferences.
+func (v *Preferences) GetLocale() (o string) {
+	if v.IsSetLocale() {
+		return v.Locale
+	}
+	o = "en_US"
+	return
+}
+
+// IsSetLocale returns true if Locale was set with SetLocale,
+// decoded from its Thrift representation, or has a non-zero value.
+//
+// This is safe to call on a nil Preferences.
+func (v *Preferences) IsSetLocale() bool {
+	return v != nil && (v.Locale != "" || v._isSet[0]&(1<<6) != 0)
+}
+
+// SetLocale sets the value of Locale and marks it as set.
+func (v *Preferences) SetLocale(o string) {
+	v.Locale = o
+	v._isSet[0] |= 1 << 6
+}
+
+// UnsetLocale resets Locale to its zero value and marks it as
+// unset.
+func (v *Preferences) UnsetLocale() {
+	v.Locale = ""
+	v._isSet[0] &^= 1 << 6
+}
+
+// GetTheme returns the value of Theme if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Preferences.
+func (v *Preferences) GetTheme() (o Theme) {
+	if v.IsSetTheme() {
+		return v.Theme
+	}
+
+	return
+}
+
+// IsSetTheme returns true if Theme was set with SetTheme,
+// decoded from its Thrift representation, or has a non-zero value.
+//
+// This is safe to call on a nil Preferences.
+func (v *Preferences) IsSetTheme() bool {
+	return v != nil && (v.Theme != 0 || v._isSet[0]&(1<<7) != 0)
+}
+
+// SetTheme sets the value of Theme and marks it as set.
+func (v *Preferences) SetTheme(o Theme) {
+	v.Theme = o
+	v._isSet[0] |= 1 << 7
+}
+
+// UnsetTheme resets Theme to its zero value and marks it as
+// unset.
+func (v *Preferences) UnsetTheme() {
+	v.Theme = 0
+	v._isSet[0] &^= 1 << 7
+}
+
+// GetDeviceID returns the value of DeviceID if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Preferences.
+func (v *Preferences) GetDeviceID() (o wire.UUID) {
+	if v.IsSetDeviceID() {
+		return v.DeviceID
+	}
+
+	return
+}
+
+// IsSetDeviceID returns true if DeviceID was set with SetDeviceID,
+// decoded from its Thrift representation, or has a non-zero value.
+//
+// This is safe to call on a nil Preferences.
+func (v *Preferences) IsSetDeviceID() bool {
+	return v != nil && (v.DeviceID != (wire.UUID{}) || v._isSet[0]&(1<<8) != 0)
+}
+
+// SetDeviceID sets the value of DeviceID and marks it as set.
+func (v *Preferences) SetDeviceID(o wire.UUID) {
+	v.DeviceID = o
+	v._isSet[0] |= 1 << 8
+}
+
+// UnsetDeviceID resets DeviceID to its zero value and marks it as
+// unset.
+func (v *Preferences) UnsetDeviceID() {
+	v.DeviceID = wire.UUID{}
+	v._isSet[0] &^= 1 << 8
+}
+
+// GetAvatar returns the value of Avatar if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Preferences.
+func (v *Preferences) GetAvatar() (o []byte) {
+	if v != nil && v.Avatar != nil {
+		return v.Avatar
+	}
+
+	return
+}
+
+// IsSetAvatar returns true if Avatar is not nil.
+//
+// This is safe to call on a nil Preferences.
+func (v *Preferences) IsSetAvatar() bool {
+	return v != nil && v.Avatar != nil
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Preferences.
+func (v *Preferences) GetTags() (o []string) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+//
+// This is safe to call on a nil Preferences.
+func (v *Preferences) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+// GetNickname returns the value of Nickname if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Preferences.
+func (v *Preferences) GetNickname() (o string) {
+	if v != nil && v.Nickname != nil {
+		return *v.Nickname
+	}
+
+	return
+}
+
+// IsSetNickname returns true if Nickname is not nil.
+//
+// This is safe to call on a nil Preferences.
+func (v *Preferences) IsSetNickname() bool {
+	return v != nil && v.Nickname != nil
+}
+
+// GetVersion returns the value of Version if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Preferences.
+func (v *Preferences) GetVersion() (o int32) {
+	if v != nil {
+		o = v.Version
+	}
+	return
+}
+
+type QuotaExceeded struct {
+	Message string `json:"message,omitempty"`
+	Limit   int64  `json:"limit,omitempty"`
+
+	_isSet [1]uint64
+}
+
+// ToWire translates a QuotaExceeded struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *QuotaExceeded) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("QuotaExceeded is nil")
+	}
+
+	if v.IsSetMessage() {
+		w = wire.NewValueString(v.Message)
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.IsSetLimit() {
+		w = wire.NewValueI64(v.Limit)
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a QuotaExceeded struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a QuotaExceeded struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v QuotaExceeded
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *QuotaExceeded) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Message = field.Value.GetString()
+				v._isSet[0] |= 1 << 0
+			}
+		case 2:
+			if field.Value.Type() == wire.TI64 {
+				v.Limit = field.Value.GetI64()
+				v._isSet[0] |= 1 << 1
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a QuotaExceeded
+// struct.
+func (v *QuotaExceeded) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.IsSetMessage() {
+		fields[i] = fmt.Sprintf("Message: %v", v.Message)
+		i++
+	}
+	if v.IsSetLimit() {
+		fields[i] = fmt.Sprintf("Limit: %v", v.Limit)
+		i++
+	}
+
+	return fmt.Sprintf("QuotaExceeded{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this QuotaExceeded match the
+// provided QuotaExceeded.
+//
+// This function performs a deep comparison.
+func (v *QuotaExceeded) Equals(rhs *QuotaExceeded) bool {
+	if v.IsSetMessage() != rhs.IsSetMessage() || !(v.Message == rhs.Message) {
+		return false
+	}
+	if v.IsSetLimit() != rhs.IsSetLimit() || !(v.Limit == rhs.Limit) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this QuotaExceeded.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *QuotaExceeded) Clone() *QuotaExceeded {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of QuotaExceeded.
+func (v *QuotaExceeded) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.IsSetMessage() {
+		enc.AddString("message", v.Message)
+	}
+	if v.IsSetLimit() {
+		enc.AddInt64("limit", v.Limit)
+	}
+	return nil
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil QuotaExceeded.
+func (v *QuotaExceeded) GetMessage() (o string) {
+	if v.IsSetMessage() {
+		return v.Message
+	}
+
+	return
+}
+
+// IsSetMessage returns true if Message was set with SetMessage,
+// decoded from its Thrift representation, or has a non-zero value.
+//
+// This is safe to call on a nil QuotaExceeded.
+func (v *QuotaExceeded) IsSetMessage() bool {
+	return v != nil && (v.Message != "" || v._isSet[0]&(1<<0) != 0)
+}
+
+// SetMessage sets the value of Message and marks it as set.
+func (v *QuotaExceeded) SetMessage(o string) {
+	v.Message = o
+	v._isSet[0] |= 1 << 0
+}
+
+// UnsetMessage resets Message to its zero value and marks it as
+// unset.
+func (v *QuotaExceeded) UnsetMessage() {
+	v.Message = ""
+	v._isSet[0] &^= 1 << 0
+}
+
+// GetLimit returns the value of Limit if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil QuotaExceeded.
+func (v *QuotaExceeded) GetLimit() (o int64) {
+	if v.IsSetLimit() {
+		return v.Limit
+	}
+
+	return
+}
+
+// IsSetLimit returns true if Limit was set with SetLimit,
+// decoded from its Thrift representation, or has a non-zero value.
+//
+// This is safe to call on a nil QuotaExceeded.
+func (v *QuotaExceeded) IsSetLimit() bool {
+	return v != nil && (v.Limit != 0 || v._isSet[0]&(1<<1) != 0)
+}
+
+// SetLimit sets the value of Limit and marks it as set.
+func (v *QuotaExceeded) SetLimit(o int64) {
+	v.Limit = o
+	v._isSet[0] |= 1 << 1
+}
+
+// UnsetLimit resets Limit to its zero value and marks it as
+// unset.
+func (v *QuotaExceeded) UnsetLimit() {
+	v.Limit = 0
+	v._isSet[0] &^= 1 << 1
+}
+
+// Error returns the message of the exception if it is set and the
+// String representation of the exception otherwise.
+func (v *QuotaExceeded) Error() string {
+	if m := v.GetMessage(); m != "" {
+		return m
+	}
+	return v.String()
+}
+
+type RenamedOptions struct {
+	MaxRetries int32 `json:"retries,omitempty"`
+
+	_isSet [1]uint64
+}
+
+// ToWire translates a RenamedOptions struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RenamedOptions) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("RenamedOptions is nil")
+	}
+
+	if v.IsSetMaxRetries() {
+		w = wire.NewValueI32(v.MaxRetries)
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a RenamedOptions struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RenamedOptions struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RenamedOptions
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RenamedOptions) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI32 {
+				v.MaxRetries = field.Value.GetI32()
+				v._isSet[0] |= 1 << 0
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RenamedOptions
+// struct.
+func (v *RenamedOptions) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.IsSetMaxRetries() {
+		fields[i] = fmt.Sprintf("MaxRetries: %v", v.MaxRetries)
+		i++
+	}
+
+	return fmt.Sprintf("RenamedOptions{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this RenamedOptions match the
+// provided RenamedOptions.
+//
+// This function performs a deep comparison.
+func (v *RenamedOptions) Equals(rhs *RenamedOptions) bool {
+	if v.IsSetMaxRetries() != rhs.IsSetMaxRetries() || !(v.MaxRetries == rhs.MaxRetries) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this RenamedOptions.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *RenamedOptions) Clone() *RenamedOptions {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of RenamedOptions.
+func (v *RenamedOptions) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.IsSetMaxRetries() {
+		enc.AddInt32("retries", v.MaxRetries)
+	}
+	return nil
+}
+
+// GetMaxRetries returns the value of MaxRetries if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil RenamedOptions.
+func (v *RenamedOptions) GetMaxRetries() (o int32) {
+	if v.IsSetMaxRetries() {
+		return v.MaxRetries
+	}
+
+	return
+}
+
+// IsSetMaxRetries returns true if MaxRetries was set with SetMaxRetries,
+// decoded from its Thrift representation, or has a non-zero value.
+//
+// This is safe to call on a nil RenamedOptions.
+func (v *RenamedOptions) IsSetMaxRetries() bool {
+	return v != nil && (v.MaxRetries != 0 || v._isSet[0]&(1<<0) != 0)
+}
+
+// SetMaxRetries sets the value of MaxRetries and marks it as set.
+func (v *RenamedOptions) SetMaxRetries(o int32) {
+	v.MaxRetries = o
+	v._isSet[0] |= 1 << 0
+}
+
+// UnsetMaxRetries resets MaxRetries to its zero value and marks it as
+// unset.
+func (v *RenamedOptions) UnsetMaxRetries() {
+	v.MaxRetries = 0
+	v._isSet[0] &^= 1 << 0
+}
+
+// GetRetries returns the value of MaxRetries.
+//
+// Deprecated: MaxRetries was renamed from Retries. Use GetMaxRetries
+// instead.
+func (v *RenamedOptions) GetRetries() int32 {
+	return v.GetMaxRetries()
+}
+
+// IsSetRetries returns true if MaxRetries is set.
+//
+// Deprecated: MaxRetries was renamed from Retries. Use IsSetMaxRetries
+// instead.
+func (v *RenamedOptions) IsSetRetries() bool {
+	return v.IsSetMaxRetries()
+}
+
+// SetRetries sets the value of MaxRetries.
+//
+// Deprecated: MaxRetries was renamed from Retries. Use SetMaxRetries
+// instead.
+func (v *RenamedOptions) SetRetries(o int32) {
+	v.SetMaxRetries(o)
+}
+
+// UnsetRetries resets MaxRetries to its zero value.
+//
+// Deprecated: MaxRetries was renamed from Retries. Use UnsetMaxRetries
+// instead.
+func (v *RenamedOptions) UnsetRetries() {
+	v.UnsetMaxRetries()
+}
+
+type Theme int32
+
+const (
+	ThemeLight Theme = 0
+	ThemeDark  Theme = 1
+)
+
+// Theme_Values returns all recognized values of Theme.
+func Theme_Values() []Theme {
+	return []Theme{
+		ThemeLight,
+		ThemeDark,
+	}
+}
+
+// UnmarshalText tries to decode Theme from a byte slice
+// containing its name.
+//
+//   var v Theme
+//   err := v.UnmarshalText([]byte("LIGHT"))
+func (v *Theme) UnmarshalText(value []byte) error {
+	switch string(value) {
+	case "LIGHT":
+		*v = ThemeLight
+		return nil
+	case "DARK":
+		*v = ThemeDark
+		return nil
+	default:
+		return fmt.Errorf("unknown enum value %q for %q", value, "Theme")
+	}
+}
+
+// ToWire translates Theme into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// Enums are represented as 32-bit integers over the wire.
+func (v Theme) ToWire() (wire.Value, error) {
+	return wire.NewValueI32(int32(v)), nil
+}
+
+// FromWire deserializes Theme from its Thrift-level
+// representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TI32)
+//   if err != nil {
+//     return Theme(0), err
+//   }
+//
+//   var v Theme
+//   if err := v.FromWire(x); err != nil {
+//     return Theme(0), err
+//   }
+//   return v, nil
+func (v *Theme) FromWire(w wire.Value) error {
+	*v = (Theme)(w.GetI32())
+	return nil
+}
+
+// String returns a readable string representation of Theme.
+func (v Theme) String() string {
+	w := int32(v)
+	switch w {
+	case 0:
+		return "LIGHT"
+	case 1:
+		return "DARK"
+	}
+	return fmt.Sprintf("Theme(%d)", w)
+}
+
+// Equals returns true if this Theme value matches the provided
+// value.
+func (v Theme) Equals(rhs Theme) bool {
+	return v == rhs
+}
+
+// MarshalJSON serializes Theme into JSON.
+//
+// If the enum value is recognized, its name is returned. Otherwise,
+// its integer value is returned.
+//
+// This implements json.Marshaler.
+func (v Theme) MarshalJSON() ([]byte, error) {
+	switch int32(v) {
+	case 0:
+		return ([]byte)("\"LIGHT\""), nil
+	case 1:
+		return ([]byte)("\"DARK\""), nil
+	}
+	return ([]byte)(strconv.FormatInt(int64(v), 10)), nil
+}
+
+// UnmarshalJSON attempts to decode Theme from its JSON
+// representation.
+//
+// This implementation supports both, numeric and string inputs. If a
+// string is provided, it must be a known enum name.
+//
+// This implements json.Unmarshaler.
+func (v *Theme) UnmarshalJSON(text []byte) error {
+	d := json.NewDecoder(bytes.NewReader(text))
+	d.UseNumber()
+	t, err := d.Token()
+	if err != nil {
+		return err
+	}
+
+	switch w := t.(type) {
+	case json.Number:
+		x, err := w.Int64()
+		if err != nil {
+			return err
+		}
+		if x > math.MaxInt32 {
+			return fmt.Errorf("enum overflow from JSON %q for %q", text, "Theme")
+		}
+		if x < math.MinInt32 {
+			return fmt.Errorf("enum underflow from JSON %q for %q", text, "Theme")
+		}
+		*v = (Theme)(x)
+		return nil
+	case string:
+		return v.UnmarshalText([]byte(w))
+	default:
+		return fmt.Errorf("invalid JSON value %q (%T) to unmarshal into %q", t, t, "Theme")
+	}
+}
+
+// Theme_NumValues is the number of distinct recognized
+// values of Theme.
+const Theme_NumValues = 2
+
+// Ordinal returns the position of this value among the distinct
+// recognized values of Theme or false if the value is not
+// recognized. Ordinals are less than Theme_NumValues.
+//
+// Ordinals may be used to index arrays of length
+// Theme_NumValues in place of map[Theme]T.
+//
+//   var counts [Theme_NumValues]int
+//   if i, ok := v.Ordinal(); ok {
+//     counts[i]++
+//   }
+func (v Theme) Ordinal() (int, bool) {
+	switch int32(v) {
+	case 0:
+		return 0, true
+	case 1:
+		return 1, true
+	default:
+		return 0, false
+	}
+}
+
+// Theme_Set is a set of Theme values backed by a
+// bitset. The zero value is an empty set.
+type Theme_Set struct {
+	bits [1]uint64
+}
+
+// Add adds the given value to the set. It returns false if the value
+// is not a recognized value of Theme.
+func (s *Theme_Set) Add(v Theme) bool {
+	i, ok := v.Ordinal()
+	if ok {
+		s.bits[i/64] |= 1 << uint(i%64)
+	}
+	return ok
+}
+
+// Remove removes the given value from the set.
+func (s *Theme_Set) Remove(v Theme) {
+	if i, ok := v.Ordinal(); ok {
+		s.bits[i/64] &^= 1 << uint(i%64)
+	}
+}
+
+// Contains returns true if the given value is in the set.
+func (s *Theme_Set) Contains(v Theme) bool {
+	i, ok := v.Ordinal()
+	return ok && s.bits[i/64]&(1<<uint(i%64)) != 0
+}
+
+// Len returns the number of values in the set.
+func (s *Theme_Set) Len() int {
+	n := 0
+	for _, x := range s.bits {
+		for ; x != 0; n++ {
+			x &= x - 1
+		}
+	}
+	return n
+}
+
+// Values returns the values in the set in the order in which they
+// were declared.
+func (s *Theme_Set) Values() []Theme {
+	v := make([]Theme, 0, s.Len())
+	if s.bits[0]&(1<<0) != 0 {
+		v = append(v, ThemeLight)
+	}
+	if s.bits[0]&(1<<1) != 0 {
+		v = append(v, ThemeDark)
+	}
+	return v
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Theme.
+//
+// Enums are logged as objects, where the value is logged with key
+// "value", and if this value's name is known, the name is logged with
+// key "name".
+func (v Theme) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("value", int32(v))
+	switch int32(v) {
+	case 0:
+		enc.AddString("name", "LIGHT")
+	case 1:
+		enc.AddString("name", "DARK")
+	}
+	return nil
+}
@@ -10,10 +10,11 @@ import (
 
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
-	Name:     "other_constants",
-	Package:  "go.uber.org/thriftrw/gen/testdata/other_constants",
-	FilePath: "other_constants.thrift",
-	SHA1:     "578e8e5aafda10921bb99b58be9a3714c78e31fc",
+	Name:            "other_constants",
+	Package:         "go.uber.org/thriftrw/gen/testdata/other_constants",
+	FilePath:        "other_constants.thrift",
+	SHA1:            "578e8e5aafda10921bb99b58be9a3714c78e31fc",
+	ThriftRWVersion: "1.9.0",
 	Includes: []*thriftreflect.ThriftModule{
 		structs.ThriftModule,
 	},
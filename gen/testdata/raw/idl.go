@@ -0,0 +1,24 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package raw
+
+import (
+	"go.uber.org/thriftrw/gen/testdata/structs"
+	"go.uber.org/thriftrw/thriftreflect"
+)
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:            "raw",
+	Package:         "go.uber.org/thriftrw/gen/testdata/raw",
+	FilePath:        "raw.thrift",
+	SHA1:            "ce047d1f693a3c3bb8fa09c59202eb942279b460",
+	ThriftRWVersion: "1.9.0",
+	Includes: []*thriftreflect.ThriftModule{
+		structs.ThriftModule,
+	},
+	Raw: rawIDL,
+}
+
+const rawIDL = "include \"./structs.thrift\"\n\n// Fields annotated with thriftrw.raw are kept as wire.Values so that they\n// may be forwarded without being decoded.\n\n/**\n * Request forwarded by a proxy which routes it based on its name.\n */\nstruct ProxyRequest {\n    1: required string name\n    2: required structs.Point payload (thriftrw.raw)\n    3: optional list<string> tags (thriftrw.raw)\n}\n\nunion RawUnion {\n    1: string name\n    2: structs.Point point (thriftrw.raw)\n}\n"
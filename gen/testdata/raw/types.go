@@ -0,0 +1,482 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package raw
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
+)
+
+// Request forwarded by a proxy which routes it based on its name.
+type ProxyRequest struct {
+	Name    string      `json:"name,required"`
+	Payload *wire.Value `json:"payload,required"`
+	Tags    *wire.Value `json:"tags,omitempty"`
+}
+
+// ToWire translates a ProxyRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ProxyRequest) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("ProxyRequest is nil")
+	}
+
+	w = wire.NewValueString(v.Name)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Payload == nil {
+		return w, errors.New("field Payload of ProxyRequest is required")
+	}
+	w = *(v.Payload)
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	if v.Tags != nil {
+		w = *(v.Tags)
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _RawValue_Read(w wire.Value) (*wire.Value, error) {
+	return &w, nil
+}
+
+// FromWire deserializes a ProxyRequest struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ProxyRequest struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ProxyRequest
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ProxyRequest) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+	payloadIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name = field.Value.GetString()
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Payload, err = _RawValue_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				payloadIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TList {
+				v.Tags, err = _RawValue_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of ProxyRequest is required")
+	}
+
+	if !payloadIsSet {
+		return errors.New("field Payload of ProxyRequest is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ProxyRequest
+// struct.
+func (v *ProxyRequest) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	fields[i] = fmt.Sprintf("Payload: %v", v.Payload)
+	i++
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+
+	return fmt.Sprintf("ProxyRequest{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _RawValue_Equals(lhs, rhs *wire.Value) bool {
+	if lhs == nil || rhs == nil {
+		return lhs == rhs
+	}
+	return wire.ValuesAreEqual(*lhs, *rhs)
+}
+
+// Equals returns true if all the fields of this ProxyRequest match the
+// provided ProxyRequest.
+//
+// This function performs a deep comparison.
+func (v *ProxyRequest) Equals(rhs *ProxyRequest) bool {
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !_RawValue_Equals(v.Payload, rhs.Payload) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _RawValue_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+
+	return true
+}
+
+func _RawValue_Clone(v *wire.Value) *wire.Value {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	return &o
+}
+
+// Clone returns a deep copy of this ProxyRequest.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *ProxyRequest) Clone() *ProxyRequest {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Payload = _RawValue_Clone(v.Payload)
+	o.Tags = _RawValue_Clone(v.Tags)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ProxyRequest.
+func (v *ProxyRequest) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("name", v.Name)
+	enc.AddString("payload", fmt.Sprint(v.Payload))
+	if v.Tags != nil {
+		enc.AddString("tags", fmt.Sprint(v.Tags))
+	}
+	return nil
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil ProxyRequest.
+func (v *ProxyRequest) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetPayload returns the value of Payload if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil ProxyRequest.
+func (v *ProxyRequest) GetPayload() (o *wire.Value) {
+	if v != nil {
+		o = v.Payload
+	}
+	return
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil ProxyRequest.
+func (v *ProxyRequest) GetTags() (o *wire.Value) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+//
+// This is safe to call on a nil ProxyRequest.
+func (v *ProxyRequest) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+type RawUnion struct {
+	Name  *string     `json:"name,omitempty"`
+	Point *wire.Value `json:"point,omitempty"`
+}
+
+// ToWire translates a RawUnion struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RawUnion) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("RawUnion is nil")
+	}
+
+	if v.Name != nil {
+		w = wire.NewValueString(*(v.Name))
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Point != nil {
+		w = *(v.Point)
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("RawUnion should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a RawUnion struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RawUnion struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RawUnion
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RawUnion) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				x := field.Value.GetString()
+				v.Name = &x
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Point, err = _RawValue_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Name != nil {
+		count++
+	}
+	if v.Point != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("RawUnion should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RawUnion
+// struct.
+func (v *RawUnion) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Name != nil {
+		fields[i] = fmt.Sprintf("Name: %v", *(v.Name))
+		i++
+	}
+	if v.Point != nil {
+		fields[i] = fmt.Sprintf("Point: %v", v.Point)
+		i++
+	}
+
+	return fmt.Sprintf("RawUnion{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this RawUnion match the
+// provided RawUnion.
+//
+// This function performs a deep comparison.
+func (v *RawUnion) Equals(rhs *RawUnion) bool {
+	if !_String_EqualsPtr(v.Name, rhs.Name) {
+		return false
+	}
+	if !((v.Point == nil && rhs.Point == nil) || (v.Point != nil && rhs.Point != nil && _RawValue_Equals(v.Point, rhs.Point))) {
+		return false
+	}
+
+	return true
+}
+
+func _String_ClonePtr(p *string) *string {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this RawUnion.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *RawUnion) Clone() *RawUnion {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Name = _String_ClonePtr(v.Name)
+	o.Point = _RawValue_Clone(v.Point)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of RawUnion.
+func (v *RawUnion) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Name != nil {
+		enc.AddString("name", *v.Name)
+	}
+	if v.Point != nil {
+		enc.AddString("point", fmt.Sprint(v.Point))
+	}
+	return nil
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil RawUnion.
+func (v *RawUnion) GetName() (o string) {
+	if v != nil && v.Name != nil {
+		return *v.Name
+	}
+
+	return
+}
+
+// IsSetName returns true if Name is not nil.
+//
+// This is safe to call on a nil RawUnion.
+func (v *RawUnion) IsSetName() bool {
+	return v != nil && v.Name != nil
+}
+
+// GetPoint returns the value of Point if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil RawUnion.
+func (v *RawUnion) GetPoint() (o *wire.Value) {
+	if v != nil && v.Point != nil {
+		return v.Point
+	}
+
+	return
+}
+
+// IsSetPoint returns true if Point is not nil.
+//
+// This is safe to call on a nil RawUnion.
+func (v *RawUnion) IsSetPoint() bool {
+	return v != nil && v.Point != nil
+}
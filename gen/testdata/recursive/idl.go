@@ -0,0 +1,18 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package recursive
+
+import "go.uber.org/thriftrw/thriftreflect"
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:            "recursive",
+	Package:         "go.uber.org/thriftrw/gen/testdata/recursive",
+	FilePath:        "recursive.thrift",
+	SHA1:            "9eaf99f62626c26697e6c07a60e2c374e4dd3460",
+	ThriftRWVersion: "1.9.0",
+	Raw:             rawIDL,
+}
+
+const rawIDL = "/**\n * Tree is a tree of nodes.\n */\nstruct Tree {\n    1: required TreeNode root\n}\n\nstruct TreeNode {\n    1: required string name\n    2: optional list<TreeNode> children\n    3: optional map<string, TreeNode> byName\n    4: optional set<TreeNode> leaves\n}\n\n/**\n * Expression and Operation reference each other.\n */\nunion Expression {\n    1: i64 literal\n    2: Operation operation\n}\n\nstruct Operation {\n    1: required string operator\n    2: required Expression left\n    3: required Expression right\n}\n\ntypedef list<Directory> Directories\n\nstruct Directory {\n    1: required string name\n    2: optional Directories subdirectories\n    3: optional Directory parent\n}\n\nexception ChainedError {\n    1: optional string message\n    2: optional ChainedError cause\n}\n"
@@ -0,0 +1,1819 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package recursive
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
+)
+
+type ChainedError struct {
+	Message *string       `json:"message,omitempty"`
+	Cause   *ChainedError `json:"cause,omitempty"`
+}
+
+// ToWire translates a ChainedError struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *ChainedError) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("ChainedError is nil")
+	}
+
+	if v.Message != nil {
+		w = wire.NewValueString(*(v.Message))
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Cause != nil {
+		w, err = v.Cause.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _ChainedError_Read(w wire.Value) (*ChainedError, error) {
+	var v ChainedError
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a ChainedError struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a ChainedError struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v ChainedError
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *ChainedError) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				x := field.Value.GetString()
+				v.Message = &x
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Cause, err = _ChainedError_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a ChainedError
+// struct.
+func (v *ChainedError) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Message != nil {
+		fields[i] = fmt.Sprintf("Message: %v", *(v.Message))
+		i++
+	}
+	if v.Cause != nil {
+		fields[i] = fmt.Sprintf("Cause: %v", v.Cause)
+		i++
+	}
+
+	return fmt.Sprintf("ChainedError{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this ChainedError match the
+// provided ChainedError.
+//
+// This function performs a deep comparison.
+func (v *ChainedError) Equals(rhs *ChainedError) bool {
+	if !_String_EqualsPtr(v.Message, rhs.Message) {
+		return false
+	}
+	if !((v.Cause == nil && rhs.Cause == nil) || (v.Cause != nil && rhs.Cause != nil && v.Cause.Equals(rhs.Cause))) {
+		return false
+	}
+
+	return true
+}
+
+func _String_ClonePtr(p *string) *string {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this ChainedError.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *ChainedError) Clone() *ChainedError {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Message = _String_ClonePtr(v.Message)
+	o.Cause = v.Cause.Clone()
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ChainedError.
+func (v *ChainedError) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Message != nil {
+		enc.AddString("message", *v.Message)
+	}
+	if v.Cause != nil {
+		if err := enc.AddObject("cause", v.Cause); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil ChainedError.
+func (v *ChainedError) GetMessage() (o string) {
+	if v != nil && v.Message != nil {
+		return *v.Message
+	}
+
+	return
+}
+
+// IsSetMessage returns true if Message is not nil.
+//
+// This is safe to call on a nil ChainedError.
+func (v *ChainedError) IsSetMessage() bool {
+	return v != nil && v.Message != nil
+}
+
+// GetCause returns the value of Cause if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil ChainedError.
+func (v *ChainedError) GetCause() (o *ChainedError) {
+	if v != nil && v.Cause != nil {
+		return v.Cause
+	}
+
+	return
+}
+
+// IsSetCause returns true if Cause is not nil.
+//
+// This is safe to call on a nil ChainedError.
+func (v *ChainedError) IsSetCause() bool {
+	return v != nil && v.Cause != nil
+}
+
+// Error returns the message of the exception if it is set and the
+// String representation of the exception otherwise.
+func (v *ChainedError) Error() string {
+	if m := v.GetMessage(); m != "" {
+		return m
+	}
+	return v.String()
+}
+
+type _List_Directory_ValueList []*Directory
+
+func (v _List_Directory_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Directory_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Directory_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Directory_ValueList) Close() {}
+
+func _Directory_Read(w wire.Value) (*Directory, error) {
+	var v Directory
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_Directory_Read(l wire.ValueList) ([]*Directory, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Directory, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Directory_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _List_Directory_Equals(lhs, rhs []*Directory) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_Directory_Clone(l []*Directory) []*Directory {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]*Directory, len(l))
+	for i, x := range l {
+		o[i] = x.Clone()
+	}
+	return o
+}
+
+type Directories []*Directory
+
+// ToWire translates Directories into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Directories) ToWire() (wire.Value, error) {
+	x := ([]*Directory)(v)
+	return wire.NewValueList(_List_Directory_ValueList(x)), nil
+}
+
+// String returns a readable string representation of Directories.
+func (v Directories) String() string {
+	x := ([]*Directory)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes Directories from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Directories) FromWire(w wire.Value) error {
+	x, err := _List_Directory_Read(w.GetList())
+	*v = (Directories)(x)
+	return err
+}
+
+// Equals returns true if this Directories is equal to the provided
+// Directories.
+func (lhs Directories) Equals(rhs Directories) bool {
+	return _List_Directory_Equals(lhs, rhs)
+}
+
+// Clone returns a deep copy of this Directories.
+func (v Directories) Clone() Directories {
+	x := ([]*Directory)(v)
+	return (Directories)(_List_Directory_Clone(x))
+}
+
+type _List_Directory_Zapper []*Directory
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Directory_Zapper.
+func (l _List_Directory_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		if err := enc.AppendObject(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of Directories.
+func (v Directories) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	x := ([]*Directory)(v)
+	return (_List_Directory_Zapper)(x).MarshalLogArray(enc)
+}
+
+type Directory struct {
+	Name           string      `json:"name,required"`
+	Subdirectories Directories `json:"subdirectories,omitempty"`
+	Parent         *Directory  `json:"parent,omitempty"`
+}
+
+// ToWire translates a Directory struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Directory) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Directory is nil")
+	}
+
+	w = wire.NewValueString(v.Name)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Subdirectories != nil {
+		w, err = v.Subdirectories.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Parent != nil {
+		w, err = v.Parent.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Directories_Read(w wire.Value) (Directories, error) {
+	var x Directories
+	err := x.FromWire(w)
+	return x, err
+}
+
+// FromWire deserializes a Directory struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Directory struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Directory
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Directory) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name = field.Value.GetString()
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TList {
+				v.Subdirectories, err = _Directories_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.Parent, err = _Directory_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Directory is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Directory
+// struct.
+func (v *Directory) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	if v.Subdirectories != nil {
+		fields[i] = fmt.Sprintf("Subdirectories: %v", v.Subdirectories)
+		i++
+	}
+	if v.Parent != nil {
+		fields[i] = fmt.Sprintf("Parent: %v", v.Parent)
+		i++
+	}
+
+	return fmt.Sprintf("Directory{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Directory match the
+// provided Directory.
+//
+// This function performs a deep comparison.
+func (v *Directory) Equals(rhs *Directory) bool {
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !((v.Subdirectories == nil && rhs.Subdirectories == nil) || (v.Subdirectories != nil && rhs.Subdirectories != nil && v.Subdirectories.Equals(rhs.Subdirectories))) {
+		return false
+	}
+	if !((v.Parent == nil && rhs.Parent == nil) || (v.Parent != nil && rhs.Parent != nil && v.Parent.Equals(rhs.Parent))) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this Directory.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Directory) Clone() *Directory {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Subdirectories = v.Subdirectories.Clone()
+	o.Parent = v.Parent.Clone()
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Directory.
+func (v *Directory) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("name", v.Name)
+	if v.Subdirectories != nil {
+		if err := enc.AddArray("subdirectories", v.Subdirectories); err != nil {
+			return err
+		}
+	}
+	if v.Parent != nil {
+		if err := enc.AddObject("parent", v.Parent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Directory.
+func (v *Directory) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetSubdirectories returns the value of Subdirectories if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Directory.
+func (v *Directory) GetSubdirectories() (o Directories) {
+	if v != nil && v.Subdirectories != nil {
+		return v.Subdirectories
+	}
+
+	return
+}
+
+// IsSetSubdirectories returns true if Subdirectories is not nil.
+//
+// This is safe to call on a nil Directory.
+func (v *Directory) IsSetSubdirectories() bool {
+	return v != nil && v.Subdirectories != nil
+}
+
+// GetParent returns the value of Parent if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Directory.
+func (v *Directory) GetParent() (o *Directory) {
+	if v != nil && v.Parent != nil {
+		return v.Parent
+	}
+
+	return
+}
+
+// IsSetParent returns true if Parent is not nil.
+//
+// This is safe to call on a nil Directory.
+func (v *Directory) IsSetParent() bool {
+	return v != nil && v.Parent != nil
+}
+
+// Expression and Operation reference each other.
+type Expression struct {
+	Literal   *int64     `json:"literal,omitempty"`
+	Operation *Operation `json:"operation,omitempty"`
+}
+
+// ToWire translates a Expression struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Expression) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Expression is nil")
+	}
+
+	if v.Literal != nil {
+		w = wire.NewValueI64(*(v.Literal))
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Operation != nil {
+		w, err = v.Operation.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Expression should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Operation_Read(w wire.Value) (*Operation, error) {
+	var v Operation
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Expression struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Expression struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Expression
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Expression) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TI64 {
+				x := field.Value.GetI64()
+				v.Literal = &x
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Operation, err = _Operation_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Literal != nil {
+		count++
+	}
+	if v.Operation != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Expression should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Expression
+// struct.
+func (v *Expression) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Literal != nil {
+		fields[i] = fmt.Sprintf("Literal: %v", *(v.Literal))
+		i++
+	}
+	if v.Operation != nil {
+		fields[i] = fmt.Sprintf("Operation: %v", v.Operation)
+		i++
+	}
+
+	return fmt.Sprintf("Expression{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _I64_EqualsPtr(lhs, rhs *int64) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Expression match the
+// provided Expression.
+//
+// This function performs a deep comparison.
+func (v *Expression) Equals(rhs *Expression) bool {
+	if !_I64_EqualsPtr(v.Literal, rhs.Literal) {
+		return false
+	}
+	if !((v.Operation == nil && rhs.Operation == nil) || (v.Operation != nil && rhs.Operation != nil && v.Operation.Equals(rhs.Operation))) {
+		return false
+	}
+
+	return true
+}
+
+func _I64_ClonePtr(p *int64) *int64 {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this Expression.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Expression) Clone() *Expression {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Literal = _I64_ClonePtr(v.Literal)
+	o.Operation = v.Operation.Clone()
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Expression.
+func (v *Expression) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Literal != nil {
+		enc.AddInt64("literal", *v.Literal)
+	}
+	if v.Operation != nil {
+		if err := enc.AddObject("operation", v.Operation); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetLiteral returns the value of Literal if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Expression.
+func (v *Expression) GetLiteral() (o int64) {
+	if v != nil && v.Literal != nil {
+		return *v.Literal
+	}
+
+	return
+}
+
+// IsSetLiteral returns true if Literal is not nil.
+//
+// This is safe to call on a nil Expression.
+func (v *Expression) IsSetLiteral() bool {
+	return v != nil && v.Literal != nil
+}
+
+// GetOperation returns the value of Operation if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Expression.
+func (v *Expression) GetOperation() (o *Operation) {
+	if v != nil && v.Operation != nil {
+		return v.Operation
+	}
+
+	return
+}
+
+// IsSetOperation returns true if Operation is not nil.
+//
+// This is safe to call on a nil Expression.
+func (v *Expression) IsSetOperation() bool {
+	return v != nil && v.Operation != nil
+}
+
+type Operation struct {
+	Operator string      `json:"operator,required"`
+	Left     *Expression `json:"left,required"`
+	Right    *Expression `json:"right,required"`
+}
+
+// ToWire translates a Operation struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Operation) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Operation is nil")
+	}
+
+	w = wire.NewValueString(v.Operator)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Left == nil {
+		return w, errors.New("field Left of Operation is required")
+	}
+	w, err = v.Left.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	if v.Right == nil {
+		return w, errors.New("field Right of Operation is required")
+	}
+	w, err = v.Right.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 3, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Expression_Read(w wire.Value) (*Expression, error) {
+	var v Expression
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Operation struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Operation struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Operation
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Operation) FromWire(w wire.Value) error {
+	var err error
+
+	operatorIsSet := false
+	leftIsSet := false
+	rightIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Operator = field.Value.GetString()
+				operatorIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Left, err = _Expression_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				leftIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.Right, err = _Expression_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				rightIsSet = true
+			}
+		}
+	}
+
+	if !operatorIsSet {
+		return errors.New("field Operator of Operation is required")
+	}
+
+	if !leftIsSet {
+		return errors.New("field Left of Operation is required")
+	}
+
+	if !rightIsSet {
+		return errors.New("field Right of Operation is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Operation
+// struct.
+func (v *Operation) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	fields[i] = fmt.Sprintf("Operator: %v", v.Operator)
+	i++
+	fields[i] = fmt.Sprintf("Left: %v", v.Left)
+	i++
+	fields[i] = fmt.Sprintf("Right: %v", v.Right)
+	i++
+
+	return fmt.Sprintf("Operation{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Operation match the
+// provided Operation.
+//
+// This function performs a deep comparison.
+func (v *Operation) Equals(rhs *Operation) bool {
+	if !(v.Operator == rhs.Operator) {
+		return false
+	}
+	if !v.Left.Equals(rhs.Left) {
+		return false
+	}
+	if !v.Right.Equals(rhs.Right) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this Operation.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Operation) Clone() *Operation {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Left = v.Left.Clone()
+	o.Right = v.Right.Clone()
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Operation.
+func (v *Operation) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("operator", v.Operator)
+	if err := enc.AddObject("left", v.Left); err != nil {
+		return err
+	}
+	if err := enc.AddObject("right", v.Right); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetOperator returns the value of Operator if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Operation.
+func (v *Operation) GetOperator() (o string) {
+	if v != nil {
+		o = v.Operator
+	}
+	return
+}
+
+// GetLeft returns the value of Left if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Operation.
+func (v *Operation) GetLeft() (o *Expression) {
+	if v != nil {
+		o = v.Left
+	}
+	return
+}
+
+// GetRight returns the value of Right if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Operation.
+func (v *Operation) GetRight() (o *Expression) {
+	if v != nil {
+		o = v.Right
+	}
+	return
+}
+
+// Tree is a tree of nodes.
+type Tree struct {
+	Root *TreeNode `json:"root,required"`
+}
+
+// ToWire translates a Tree struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Tree) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Tree is nil")
+	}
+
+	if v.Root == nil {
+		return w, errors.New("field Root of Tree is required")
+	}
+	w, err = v.Root.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _TreeNode_Read(w wire.Value) (*TreeNode, error) {
+	var v TreeNode
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Tree struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Tree struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Tree
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Tree) FromWire(w wire.Value) error {
+	var err error
+
+	rootIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TStruct {
+				v.Root, err = _TreeNode_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				rootIsSet = true
+			}
+		}
+	}
+
+	if !rootIsSet {
+		return errors.New("field Root of Tree is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Tree
+// struct.
+func (v *Tree) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("Root: %v", v.Root)
+	i++
+
+	return fmt.Sprintf("Tree{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Tree match the
+// provided Tree.
+//
+// This function performs a deep comparison.
+func (v *Tree) Equals(rhs *Tree) bool {
+	if !v.Root.Equals(rhs.Root) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this Tree.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Tree) Clone() *Tree {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Root = v.Root.Clone()
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Tree.
+func (v *Tree) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if err := enc.AddObject("root", v.Root); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetRoot returns the value of Root if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Tree.
+func (v *Tree) GetRoot() (o *TreeNode) {
+	if v != nil {
+		o = v.Root
+	}
+	return
+}
+
+type TreeNode struct {
+	Name     string               `json:"name,required"`
+	Children []*TreeNode          `json:"children,omitempty"`
+	ByName   map[string]*TreeNode `json:"byName,omitempty"`
+	Leaves   []*TreeNode          `json:"leaves,omitempty"`
+}
+
+type _List_TreeNode_ValueList []*TreeNode
+
+func (v _List_TreeNode_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_TreeNode_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_TreeNode_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_TreeNode_ValueList) Close() {}
+
+type _Map_String_TreeNode_MapItemList map[string]*TreeNode
+
+func (m _Map_String_TreeNode_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw := wire.NewValueString(k)
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		if err = f(wire.MapItem{Key: kw, Value: vw}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_TreeNode_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_TreeNode_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_TreeNode_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_String_TreeNode_MapItemList) Close() {}
+
+type _Set_TreeNode_ValueList []*TreeNode
+
+func (v _Set_TreeNode_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid set item: value is nil")
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+
+		if err = f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_TreeNode_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_TreeNode_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Set_TreeNode_ValueList) Close() {}
+
+// ToWire translates a TreeNode struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *TreeNode) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("TreeNode is nil")
+	}
+
+	w = wire.NewValueString(v.Name)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Children != nil {
+		w = wire.NewValueList(_List_TreeNode_ValueList(v.Children))
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.ByName != nil {
+		w = wire.NewValueMap(_Map_String_TreeNode_MapItemList(v.ByName))
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Leaves != nil {
+		w = wire.NewValueSet(_Set_TreeNode_ValueList(v.Leaves))
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _List_TreeNode_Read(l wire.ValueList) ([]*TreeNode, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*TreeNode, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _TreeNode_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Map_String_TreeNode_Read(m wire.MapItemList) (map[string]*TreeNode, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]*TreeNode, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k := x.Key.GetString()
+
+		v, err := _TreeNode_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Set_TreeNode_Read(s wire.ValueList) ([]*TreeNode, error) {
+	if s.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*TreeNode, 0, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := _TreeNode_Read(x)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, i)
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+// FromWire deserializes a TreeNode struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a TreeNode struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v TreeNode
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *TreeNode) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name = field.Value.GetString()
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TList {
+				v.Children, err = _List_TreeNode_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TMap {
+				v.ByName, err = _Map_String_TreeNode_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TSet {
+				v.Leaves, err = _Set_TreeNode_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of TreeNode is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a TreeNode
+// struct.
+func (v *TreeNode) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	if v.Children != nil {
+		fields[i] = fmt.Sprintf("Children: %v", v.Children)
+		i++
+	}
+	if v.ByName != nil {
+		fields[i] = fmt.Sprintf("ByName: %v", v.ByName)
+		i++
+	}
+	if v.Leaves != nil {
+		fields[i] = fmt.Sprintf("Leaves: %v", v.Leaves)
+		i++
+	}
+
+	return fmt.Sprintf("TreeNode{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_TreeNode_Equals(lhs, rhs []*TreeNode) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_TreeNode_Equals(lhs, rhs map[string]*TreeNode) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Set_TreeNode_Equals(lhs, rhs []*TreeNode) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, x := range lhs {
+		ok := false
+		for _, y := range rhs {
+			if x.Equals(y) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this TreeNode match the
+// provided TreeNode.
+//
+// This function performs a deep comparison.
+func (v *TreeNode) Equals(rhs *TreeNode) bool {
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !((v.Children == nil && rhs.Children == nil) || (v.Children != nil && rhs.Children != nil && _List_TreeNode_Equals(v.Children, rhs.Children))) {
+		return false
+	}
+	if !((v.ByName == nil && rhs.ByName == nil) || (v.ByName != nil && rhs.ByName != nil && _Map_String_TreeNode_Equals(v.ByName, rhs.ByName))) {
+		return false
+	}
+	if !((v.Leaves == nil && rhs.Leaves == nil) || (v.Leaves != nil && rhs.Leaves != nil && _Set_TreeNode_Equals(v.Leaves, rhs.Leaves))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_TreeNode_Clone(l []*TreeNode) []*TreeNode {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]*TreeNode, len(l))
+	for i, x := range l {
+		o[i] = x.Clone()
+	}
+	return o
+}
+
+func _Map_String_TreeNode_Clone(m map[string]*TreeNode) map[string]*TreeNode {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[string]*TreeNode, len(m))
+	for k, v := range m {
+		o[k] = v.Clone()
+	}
+
+	return o
+}
+
+func _Set_TreeNode_Clone(s []*TreeNode) []*TreeNode {
+	if s == nil {
+		return nil
+	}
+
+	o := make([]*TreeNode, 0, len(s))
+	for _, x := range s {
+		o = append(o, x.Clone())
+	}
+
+	return o
+}
+
+// Clone returns a deep copy of this TreeNode.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *TreeNode) Clone() *TreeNode {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Children = _List_TreeNode_Clone(v.Children)
+	o.ByName = _Map_String_TreeNode_Clone(v.ByName)
+	o.Leaves = _Set_TreeNode_Clone(v.Leaves)
+
+	return &o
+}
+
+type _List_TreeNode_Zapper []*TreeNode
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_TreeNode_Zapper.
+func (l _List_TreeNode_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		if err := enc.AppendObject(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _Map_String_TreeNode_Zapper map[string]*TreeNode
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_TreeNode_Zapper.
+func (m _Map_String_TreeNode_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for k, v := range m {
+		if err := enc.AddObject((string)(k), v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _Set_TreeNode_Zapper []*TreeNode
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_TreeNode_Zapper.
+func (s _Set_TreeNode_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range s {
+		if err := enc.AppendObject(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of TreeNode.
+func (v *TreeNode) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("name", v.Name)
+	if v.Children != nil {
+		if err := enc.AddArray("children", (_List_TreeNode_Zapper)(v.Children)); err != nil {
+			return err
+		}
+	}
+	if v.ByName != nil {
+		if err := enc.AddObject("byName", (_Map_String_TreeNode_Zapper)(v.ByName)); err != nil {
+			return err
+		}
+	}
+	if v.Leaves != nil {
+		if err := enc.AddArray("leaves", (_Set_TreeNode_Zapper)(v.Leaves)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil TreeNode.
+func (v *TreeNode) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetChildren returns the value of Children if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil TreeNode.
+func (v *TreeNode) GetChildren() (o []*TreeNode) {
+	if v != nil && v.Children != nil {
+		return v.Children
+	}
+
+	return
+}
+
+// IsSetChildren returns true if Children is not nil.
+//
+// This is safe to call on a nil TreeNode.
+func (v *TreeNode) IsSetChildren() bool {
+	return v != nil && v.Children != nil
+}
+
+// GetByName returns the value of ByName if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil TreeNode.
+func (v *TreeNode) GetByName() (o map[string]*TreeNode) {
+	if v != nil && v.ByName != nil {
+		return v.ByName
+	}
+
+	return
+}
+
+// IsSetByName returns true if ByName is not nil.
+//
+// This is safe to call on a nil TreeNode.
+func (v *TreeNode) IsSetByName() bool {
+	return v != nil && v.ByName != nil
+}
+
+// GetLeaves returns the value of Leaves if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil TreeNode.
+func (v *TreeNode) GetLeaves() (o []*TreeNode) {
+	if v != nil && v.Leaves != nil {
+		return v.Leaves
+	}
+
+	return
+}
+
+// IsSetLeaves returns true if Leaves is not nil.
+//
+// This is safe to call on a nil TreeNode.
+func (v *TreeNode) IsSetLeaves() bool {
+	return v != nil && v.Leaves != nil
+}
@@ -5,8 +5,10 @@ package services
 
 import (
 	"fmt"
-	"go.uber.org/thriftrw/wire"
 	"strings"
+
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
 )
 
 // Cache_Clear_Args represents the arguments for the Cache.clear function.
@@ -88,6 +90,30 @@ func (v *Cache_Clear_Args) Equals(rhs *Cache_Clear_Args) bool {
 	return true
 }
 
+// Clone returns a deep copy of this Cache_Clear_Args.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Cache_Clear_Args) Clone() *Cache_Clear_Args {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Cache_Clear_Args.
+func (v *Cache_Clear_Args) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	return nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
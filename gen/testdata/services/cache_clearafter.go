@@ -4,9 +4,12 @@
 package services
 
 import (
+	"errors"
 	"fmt"
-	"go.uber.org/thriftrw/wire"
 	"strings"
+
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
 )
 
 // Cache_ClearAfter_Args represents the arguments for the Cache.clearAfter function.
@@ -36,14 +39,14 @@ func (v *Cache_ClearAfter_Args) ToWire() (wire.Value, error) {
 		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("Cache_ClearAfter_Args is nil")
+	}
+
 	if v.DurationMS != nil {
-		w, err = wire.NewValueI64(*(v.DurationMS)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueI64(*(v.DurationMS))
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
@@ -69,18 +72,13 @@ func (v *Cache_ClearAfter_Args) ToWire() (wire.Value, error) {
 //   }
 //   return &v, nil
 func (v *Cache_ClearAfter_Args) FromWire(w wire.Value) error {
-	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
+				x := field.Value.GetI64()
 				v.DurationMS = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		}
@@ -128,16 +126,62 @@ func (v *Cache_ClearAfter_Args) Equals(rhs *Cache_ClearAfter_Args) bool {
 	return true
 }
 
+func _I64_ClonePtr(p *int64) *int64 {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this Cache_ClearAfter_Args.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Cache_ClearAfter_Args) Clone() *Cache_ClearAfter_Args {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.DurationMS = _I64_ClonePtr(v.DurationMS)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Cache_ClearAfter_Args.
+func (v *Cache_ClearAfter_Args) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.DurationMS != nil {
+		enc.AddInt64("durationMS", *v.DurationMS)
+	}
+	return nil
+}
+
 // GetDurationMS returns the value of DurationMS if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil Cache_ClearAfter_Args.
 func (v *Cache_ClearAfter_Args) GetDurationMS() (o int64) {
-	if v.DurationMS != nil {
+	if v != nil && v.DurationMS != nil {
 		return *v.DurationMS
 	}
 
 	return
 }
 
+// IsSetDurationMS returns true if DurationMS is not nil.
+//
+// This is safe to call on a nil Cache_ClearAfter_Args.
+func (v *Cache_ClearAfter_Args) IsSetDurationMS() bool {
+	return v != nil && v.DurationMS != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
@@ -4,9 +4,13 @@
 package services
 
 import (
+	"errors"
 	"fmt"
-	"go.uber.org/thriftrw/wire"
 	"strings"
+
+	"go.uber.org/thriftrw/runtime"
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
 )
 
 // ConflictingNames_SetValue_Args represents the arguments for the ConflictingNames.setValue function.
@@ -39,6 +43,10 @@ func (v *ConflictingNames_SetValue_Args) ToWire() (wire.Value, error) {
 		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("ConflictingNames_SetValue_Args is nil")
+	}
+
 	if v.Request != nil {
 		w, err = v.Request.ToWire()
 		if err != nil {
@@ -122,6 +130,55 @@ func (v *ConflictingNames_SetValue_Args) Equals(rhs *ConflictingNames_SetValue_A
 	return true
 }
 
+// Clone returns a deep copy of this ConflictingNames_SetValue_Args.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *ConflictingNames_SetValue_Args) Clone() *ConflictingNames_SetValue_Args {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Request = v.Request.Clone()
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ConflictingNames_SetValue_Args.
+func (v *ConflictingNames_SetValue_Args) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Request != nil {
+		if err := enc.AddObject("request", v.Request); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil ConflictingNames_SetValue_Args.
+func (v *ConflictingNames_SetValue_Args) GetRequest() (o *ConflictingNamesSetValueArgs) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+//
+// This is safe to call on a nil ConflictingNames_SetValue_Args.
+func (v *ConflictingNames_SetValue_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
@@ -207,6 +264,11 @@ func init() {
 		return nil, err
 	}
 	ConflictingNames_SetValue_Helper.UnwrapResponse = func(result *ConflictingNames_SetValue_Result) (err error) {
+		if result.unexpectedException != nil {
+			err = result.unexpectedException
+			return
+		}
+
 		return
 	}
 
@@ -216,6 +278,10 @@ func init() {
 //
 // The result of a setValue execution is sent and received over the wire as this struct.
 type ConflictingNames_SetValue_Result struct {
+
+	// Exception which was not recognized when this result was
+	// decoded.
+	unexpectedException *runtime.UnexpectedApplicationError
 }
 
 // ToWire translates a ConflictingNames_SetValue_Result struct into a Thrift-level intermediate
@@ -261,10 +327,17 @@ func (v *ConflictingNames_SetValue_Result) ToWire() (wire.Value, error) {
 //   return &v, nil
 func (v *ConflictingNames_SetValue_Result) FromWire(w wire.Value) error {
 
+	var unknown wire.UnknownFields
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
+		default:
+			if err := unknown.Add(field); err != nil {
+				return err
+			}
 		}
 	}
+	v.unexpectedException = runtime.FindUnexpectedException("ConflictingNames_SetValue_Result", unknown)
 
 	return nil
 }
@@ -291,6 +364,30 @@ func (v *ConflictingNames_SetValue_Result) Equals(rhs *ConflictingNames_SetValue
 	return true
 }
 
+// Clone returns a deep copy of this ConflictingNames_SetValue_Result.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *ConflictingNames_SetValue_Result) Clone() *ConflictingNames_SetValue_Result {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ConflictingNames_SetValue_Result.
+func (v *ConflictingNames_SetValue_Result) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	return nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
@@ -305,3 +402,13 @@ func (v *ConflictingNames_SetValue_Result) MethodName() string {
 func (v *ConflictingNames_SetValue_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
+
+// UnwrapResponse returns the error returned by
+// setValue.
+//
+// The error is an exception thrown by setValue, or a
+// *runtime.UnexpectedApplicationError if the result holds an
+// exception which is not declared in the IDL.
+func (v *ConflictingNames_SetValue_Result) UnwrapResponse() error {
+	return ConflictingNames_SetValue_Helper.UnwrapResponse(v)
+}
@@ -6,9 +6,12 @@ package services
 import (
 	"errors"
 	"fmt"
+	"strings"
+
 	"go.uber.org/thriftrw/gen/testdata/exceptions"
+	"go.uber.org/thriftrw/runtime"
 	"go.uber.org/thriftrw/wire"
-	"strings"
+	"go.uber.org/zap/zapcore"
 )
 
 // KeyValue_DeleteValue_Args represents the arguments for the KeyValue.deleteValue function.
@@ -41,6 +44,10 @@ func (v *KeyValue_DeleteValue_Args) ToWire() (wire.Value, error) {
 		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("KeyValue_DeleteValue_Args is nil")
+	}
+
 	if v.Key != nil {
 		w, err = v.Key.ToWire()
 		if err != nil {
@@ -136,16 +143,62 @@ func (v *KeyValue_DeleteValue_Args) Equals(rhs *KeyValue_DeleteValue_Args) bool
 	return true
 }
 
+func _Key_ClonePtr(p *Key) *Key {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this KeyValue_DeleteValue_Args.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *KeyValue_DeleteValue_Args) Clone() *KeyValue_DeleteValue_Args {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Key = _Key_ClonePtr(v.Key)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_DeleteValue_Args.
+func (v *KeyValue_DeleteValue_Args) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Key != nil {
+		enc.AddString("key", (string)(*v.Key))
+	}
+	return nil
+}
+
 // GetKey returns the value of Key if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil KeyValue_DeleteValue_Args.
 func (v *KeyValue_DeleteValue_Args) GetKey() (o Key) {
-	if v.Key != nil {
+	if v != nil && v.Key != nil {
 		return *v.Key
 	}
 
 	return
 }
 
+// IsSetKey returns true if Key is not nil.
+//
+// This is safe to call on a nil KeyValue_DeleteValue_Args.
+func (v *KeyValue_DeleteValue_Args) IsSetKey() bool {
+	return v != nil && v.Key != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
@@ -256,6 +309,11 @@ func init() {
 			err = result.InternalError
 			return
 		}
+		if result.unexpectedException != nil {
+			err = result.unexpectedException
+			return
+		}
+
 		return
 	}
 
@@ -268,6 +326,10 @@ type KeyValue_DeleteValue_Result struct {
 	// Raised if a value with the given key doesn't exist.
 	DoesNotExist  *exceptions.DoesNotExistException `json:"doesNotExist,omitempty"`
 	InternalError *InternalError                    `json:"internalError,omitempty"`
+
+	// Exception which was not recognized when this result was
+	// decoded.
+	unexpectedException *runtime.UnexpectedApplicationError
 }
 
 // ToWire translates a KeyValue_DeleteValue_Result struct into a Thrift-level intermediate
@@ -293,6 +355,10 @@ func (v *KeyValue_DeleteValue_Result) ToWire() (wire.Value, error) {
 		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("KeyValue_DeleteValue_Result is nil")
+	}
+
 	if v.DoesNotExist != nil {
 		w, err = v.DoesNotExist.ToWire()
 		if err != nil {
@@ -349,6 +415,8 @@ func _InternalError_Read(w wire.Value) (*InternalError, error) {
 func (v *KeyValue_DeleteValue_Result) FromWire(w wire.Value) error {
 	var err error
 
+	var unknown wire.UnknownFields
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
@@ -367,8 +435,13 @@ func (v *KeyValue_DeleteValue_Result) FromWire(w wire.Value) error {
 				}
 
 			}
+		default:
+			if err = unknown.Add(field); err != nil {
+				return err
+			}
 		}
 	}
+	v.unexpectedException = runtime.FindUnexpectedException("KeyValue_DeleteValue_Result", unknown)
 
 	count := 0
 	if v.DoesNotExist != nil {
@@ -377,6 +450,9 @@ func (v *KeyValue_DeleteValue_Result) FromWire(w wire.Value) error {
 	if v.InternalError != nil {
 		count++
 	}
+	if v.unexpectedException != nil {
+		count++
+	}
 	if count > 1 {
 		return fmt.Errorf("KeyValue_DeleteValue_Result should have at most one field: got %v fields", count)
 	}
@@ -420,6 +496,80 @@ func (v *KeyValue_DeleteValue_Result) Equals(rhs *KeyValue_DeleteValue_Result) b
 	return true
 }
 
+// Clone returns a deep copy of this KeyValue_DeleteValue_Result.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *KeyValue_DeleteValue_Result) Clone() *KeyValue_DeleteValue_Result {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.DoesNotExist = v.DoesNotExist.Clone()
+	o.InternalError = v.InternalError.Clone()
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_DeleteValue_Result.
+func (v *KeyValue_DeleteValue_Result) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.DoesNotExist != nil {
+		if err := enc.AddObject("doesNotExist", v.DoesNotExist); err != nil {
+			return err
+		}
+	}
+	if v.InternalError != nil {
+		if err := enc.AddObject("internalError", v.InternalError); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetDoesNotExist returns the value of DoesNotExist if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil KeyValue_DeleteValue_Result.
+func (v *KeyValue_DeleteValue_Result) GetDoesNotExist() (o *exceptions.DoesNotExistException) {
+	if v != nil && v.DoesNotExist != nil {
+		return v.DoesNotExist
+	}
+
+	return
+}
+
+// IsSetDoesNotExist returns true if DoesNotExist is not nil.
+//
+// This is safe to call on a nil KeyValue_DeleteValue_Result.
+func (v *KeyValue_DeleteValue_Result) IsSetDoesNotExist() bool {
+	return v != nil && v.DoesNotExist != nil
+}
+
+// GetInternalError returns the value of InternalError if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil KeyValue_DeleteValue_Result.
+func (v *KeyValue_DeleteValue_Result) GetInternalError() (o *InternalError) {
+	if v != nil && v.InternalError != nil {
+		return v.InternalError
+	}
+
+	return
+}
+
+// IsSetInternalError returns true if InternalError is not nil.
+//
+// This is safe to call on a nil KeyValue_DeleteValue_Result.
+func (v *KeyValue_DeleteValue_Result) IsSetInternalError() bool {
+	return v != nil && v.InternalError != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
@@ -434,3 +584,13 @@ func (v *KeyValue_DeleteValue_Result) MethodName() string {
 func (v *KeyValue_DeleteValue_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
+
+// UnwrapResponse returns the error returned by
+// deleteValue.
+//
+// The error is an exception thrown by deleteValue, or a
+// *runtime.UnexpectedApplicationError if the result holds an
+// exception which is not declared in the IDL.
+func (v *KeyValue_DeleteValue_Result) UnwrapResponse() error {
+	return KeyValue_DeleteValue_Helper.UnwrapResponse(v)
+}
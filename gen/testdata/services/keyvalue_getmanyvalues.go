@@ -6,10 +6,13 @@ package services
 import (
 	"errors"
 	"fmt"
+	"strings"
+
 	"go.uber.org/thriftrw/gen/testdata/exceptions"
 	"go.uber.org/thriftrw/gen/testdata/unions"
+	"go.uber.org/thriftrw/runtime"
 	"go.uber.org/thriftrw/wire"
-	"strings"
+	"go.uber.org/zap/zapcore"
 )
 
 // KeyValue_GetManyValues_Args represents the arguments for the KeyValue.getManyValues function.
@@ -65,14 +68,14 @@ func (v *KeyValue_GetManyValues_Args) ToWire() (wire.Value, error) {
 		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("KeyValue_GetManyValues_Args is nil")
+	}
+
 	if v.Range != nil {
-		w, err = wire.NewValueList(_List_Key_ValueList(v.Range)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueList(_List_Key_ValueList(v.Range))
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
@@ -178,6 +181,78 @@ func (v *KeyValue_GetManyValues_Args) Equals(rhs *KeyValue_GetManyValues_Args) b
 	return true
 }
 
+func _List_Key_Clone(l []Key) []Key {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]Key, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
+}
+
+// Clone returns a deep copy of this KeyValue_GetManyValues_Args.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *KeyValue_GetManyValues_Args) Clone() *KeyValue_GetManyValues_Args {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Range = _List_Key_Clone(v.Range)
+
+	return &o
+}
+
+type _List_Key_Zapper []Key
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Key_Zapper.
+func (l _List_Key_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		enc.AppendString((string)(v))
+	}
+	return nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_GetManyValues_Args.
+func (v *KeyValue_GetManyValues_Args) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Range != nil {
+		if err := enc.AddArray("range", (_List_Key_Zapper)(v.Range)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetRange returns the value of Range if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil KeyValue_GetManyValues_Args.
+func (v *KeyValue_GetManyValues_Args) GetRange() (o []Key) {
+	if v != nil && v.Range != nil {
+		return v.Range
+	}
+
+	return
+}
+
+// IsSetRange returns true if Range is not nil.
+//
+// This is safe to call on a nil KeyValue_GetManyValues_Args.
+func (v *KeyValue_GetManyValues_Args) IsSetRange() bool {
+	return v != nil && v.Range != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
@@ -276,6 +351,10 @@ func init() {
 			err = result.DoesNotExist
 			return
 		}
+		if result.unexpectedException != nil {
+			err = result.unexpectedException
+			return
+		}
 
 		if result.Success != nil {
 			success = result.Success
@@ -297,6 +376,10 @@ type KeyValue_GetManyValues_Result struct {
 	// Value returned by getManyValues after a successful execution.
 	Success      []*unions.ArbitraryValue          `json:"success,omitempty"`
 	DoesNotExist *exceptions.DoesNotExistException `json:"doesNotExist,omitempty"`
+
+	// Exception which was not recognized when this result was
+	// decoded.
+	unexpectedException *runtime.UnexpectedApplicationError
 }
 
 type _List_ArbitraryValue_ValueList []*unions.ArbitraryValue
@@ -351,11 +434,12 @@ func (v *KeyValue_GetManyValues_Result) ToWire() (wire.Value, error) {
 		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("KeyValue_GetManyValues_Result is nil")
+	}
+
 	if v.Success != nil {
-		w, err = wire.NewValueList(_List_ArbitraryValue_ValueList(v.Success)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueList(_List_ArbitraryValue_ValueList(v.Success))
 		fields[i] = wire.Field{ID: 0, Value: w}
 		i++
 	}
@@ -419,6 +503,8 @@ func _List_ArbitraryValue_Read(l wire.ValueList) ([]*unions.ArbitraryValue, erro
 func (v *KeyValue_GetManyValues_Result) FromWire(w wire.Value) error {
 	var err error
 
+	var unknown wire.UnknownFields
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
@@ -437,8 +523,13 @@ func (v *KeyValue_GetManyValues_Result) FromWire(w wire.Value) error {
 				}
 
 			}
+		default:
+			if err = unknown.Add(field); err != nil {
+				return err
+			}
 		}
 	}
+	v.unexpectedException = runtime.FindUnexpectedException("KeyValue_GetManyValues_Result", unknown)
 
 	count := 0
 	if v.Success != nil {
@@ -447,6 +538,9 @@ func (v *KeyValue_GetManyValues_Result) FromWire(w wire.Value) error {
 	if v.DoesNotExist != nil {
 		count++
 	}
+	if v.unexpectedException != nil {
+		count++
+	}
 	if count != 1 {
 		return fmt.Errorf("KeyValue_GetManyValues_Result should have exactly one field: got %v fields", count)
 	}
@@ -505,6 +599,105 @@ func (v *KeyValue_GetManyValues_Result) Equals(rhs *KeyValue_GetManyValues_Resul
 	return true
 }
 
+func _List_ArbitraryValue_Clone(l []*unions.ArbitraryValue) []*unions.ArbitraryValue {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]*unions.ArbitraryValue, len(l))
+	for i, x := range l {
+		o[i] = x.Clone()
+	}
+	return o
+}
+
+// Clone returns a deep copy of this KeyValue_GetManyValues_Result.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *KeyValue_GetManyValues_Result) Clone() *KeyValue_GetManyValues_Result {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Success = _List_ArbitraryValue_Clone(v.Success)
+	o.DoesNotExist = v.DoesNotExist.Clone()
+
+	return &o
+}
+
+type _List_ArbitraryValue_Zapper []*unions.ArbitraryValue
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_ArbitraryValue_Zapper.
+func (l _List_ArbitraryValue_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		if err := enc.AppendObject(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_GetManyValues_Result.
+func (v *KeyValue_GetManyValues_Result) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Success != nil {
+		if err := enc.AddArray("success", (_List_ArbitraryValue_Zapper)(v.Success)); err != nil {
+			return err
+		}
+	}
+	if v.DoesNotExist != nil {
+		if err := enc.AddObject("doesNotExist", v.DoesNotExist); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil KeyValue_GetManyValues_Result.
+func (v *KeyValue_GetManyValues_Result) GetSuccess() (o []*unions.ArbitraryValue) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+//
+// This is safe to call on a nil KeyValue_GetManyValues_Result.
+func (v *KeyValue_GetManyValues_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetDoesNotExist returns the value of DoesNotExist if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil KeyValue_GetManyValues_Result.
+func (v *KeyValue_GetManyValues_Result) GetDoesNotExist() (o *exceptions.DoesNotExistException) {
+	if v != nil && v.DoesNotExist != nil {
+		return v.DoesNotExist
+	}
+
+	return
+}
+
+// IsSetDoesNotExist returns true if DoesNotExist is not nil.
+//
+// This is safe to call on a nil KeyValue_GetManyValues_Result.
+func (v *KeyValue_GetManyValues_Result) IsSetDoesNotExist() bool {
+	return v != nil && v.DoesNotExist != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
@@ -519,3 +712,13 @@ func (v *KeyValue_GetManyValues_Result) MethodName() string {
 func (v *KeyValue_GetManyValues_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
+
+// UnwrapResponse returns the value or error returned by
+// getManyValues.
+//
+// The error is an exception thrown by getManyValues, or a
+// *runtime.UnexpectedApplicationError if the result holds an
+// exception which is not declared in the IDL.
+func (v *KeyValue_GetManyValues_Result) UnwrapResponse() ([]*unions.ArbitraryValue, error) {
+	return KeyValue_GetManyValues_Helper.UnwrapResponse(v)
+}
@@ -6,10 +6,13 @@ package services
 import (
 	"errors"
 	"fmt"
+	"strings"
+
 	"go.uber.org/thriftrw/gen/testdata/exceptions"
 	"go.uber.org/thriftrw/gen/testdata/unions"
+	"go.uber.org/thriftrw/runtime"
 	"go.uber.org/thriftrw/wire"
-	"strings"
+	"go.uber.org/zap/zapcore"
 )
 
 // KeyValue_GetValue_Args represents the arguments for the KeyValue.getValue function.
@@ -42,6 +45,10 @@ func (v *KeyValue_GetValue_Args) ToWire() (wire.Value, error) {
 		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("KeyValue_GetValue_Args is nil")
+	}
+
 	if v.Key != nil {
 		w, err = v.Key.ToWire()
 		if err != nil {
@@ -121,16 +128,53 @@ func (v *KeyValue_GetValue_Args) Equals(rhs *KeyValue_GetValue_Args) bool {
 	return true
 }
 
+// Clone returns a deep copy of this KeyValue_GetValue_Args.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *KeyValue_GetValue_Args) Clone() *KeyValue_GetValue_Args {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Key = _Key_ClonePtr(v.Key)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_GetValue_Args.
+func (v *KeyValue_GetValue_Args) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Key != nil {
+		enc.AddString("key", (string)(*v.Key))
+	}
+	return nil
+}
+
 // GetKey returns the value of Key if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil KeyValue_GetValue_Args.
 func (v *KeyValue_GetValue_Args) GetKey() (o Key) {
-	if v.Key != nil {
+	if v != nil && v.Key != nil {
 		return *v.Key
 	}
 
 	return
 }
 
+// IsSetKey returns true if Key is not nil.
+//
+// This is safe to call on a nil KeyValue_GetValue_Args.
+func (v *KeyValue_GetValue_Args) IsSetKey() bool {
+	return v != nil && v.Key != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
@@ -229,6 +273,10 @@ func init() {
 			err = result.DoesNotExist
 			return
 		}
+		if result.unexpectedException != nil {
+			err = result.unexpectedException
+			return
+		}
 
 		if result.Success != nil {
 			success = result.Success
@@ -250,6 +298,10 @@ type KeyValue_GetValue_Result struct {
 	// Value returned by getValue after a successful execution.
 	Success      *unions.ArbitraryValue            `json:"success,omitempty"`
 	DoesNotExist *exceptions.DoesNotExistException `json:"doesNotExist,omitempty"`
+
+	// Exception which was not recognized when this result was
+	// decoded.
+	unexpectedException *runtime.UnexpectedApplicationError
 }
 
 // ToWire translates a KeyValue_GetValue_Result struct into a Thrift-level intermediate
@@ -275,6 +327,10 @@ func (v *KeyValue_GetValue_Result) ToWire() (wire.Value, error) {
 		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("KeyValue_GetValue_Result is nil")
+	}
+
 	if v.Success != nil {
 		w, err = v.Success.ToWire()
 		if err != nil {
@@ -319,6 +375,8 @@ func (v *KeyValue_GetValue_Result) ToWire() (wire.Value, error) {
 func (v *KeyValue_GetValue_Result) FromWire(w wire.Value) error {
 	var err error
 
+	var unknown wire.UnknownFields
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
@@ -337,8 +395,13 @@ func (v *KeyValue_GetValue_Result) FromWire(w wire.Value) error {
 				}
 
 			}
+		default:
+			if err = unknown.Add(field); err != nil {
+				return err
+			}
 		}
 	}
+	v.unexpectedException = runtime.FindUnexpectedException("KeyValue_GetValue_Result", unknown)
 
 	count := 0
 	if v.Success != nil {
@@ -347,6 +410,9 @@ func (v *KeyValue_GetValue_Result) FromWire(w wire.Value) error {
 	if v.DoesNotExist != nil {
 		count++
 	}
+	if v.unexpectedException != nil {
+		count++
+	}
 	if count != 1 {
 		return fmt.Errorf("KeyValue_GetValue_Result should have exactly one field: got %v fields", count)
 	}
@@ -390,6 +456,80 @@ func (v *KeyValue_GetValue_Result) Equals(rhs *KeyValue_GetValue_Result) bool {
 	return true
 }
 
+// Clone returns a deep copy of this KeyValue_GetValue_Result.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *KeyValue_GetValue_Result) Clone() *KeyValue_GetValue_Result {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Success = v.Success.Clone()
+	o.DoesNotExist = v.DoesNotExist.Clone()
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_GetValue_Result.
+func (v *KeyValue_GetValue_Result) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Success != nil {
+		if err := enc.AddObject("success", v.Success); err != nil {
+			return err
+		}
+	}
+	if v.DoesNotExist != nil {
+		if err := enc.AddObject("doesNotExist", v.DoesNotExist); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil KeyValue_GetValue_Result.
+func (v *KeyValue_GetValue_Result) GetSuccess() (o *unions.ArbitraryValue) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+//
+// This is safe to call on a nil KeyValue_GetValue_Result.
+func (v *KeyValue_GetValue_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
+// GetDoesNotExist returns the value of DoesNotExist if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil KeyValue_GetValue_Result.
+func (v *KeyValue_GetValue_Result) GetDoesNotExist() (o *exceptions.DoesNotExistException) {
+	if v != nil && v.DoesNotExist != nil {
+		return v.DoesNotExist
+	}
+
+	return
+}
+
+// IsSetDoesNotExist returns true if DoesNotExist is not nil.
+//
+// This is safe to call on a nil KeyValue_GetValue_Result.
+func (v *KeyValue_GetValue_Result) IsSetDoesNotExist() bool {
+	return v != nil && v.DoesNotExist != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
@@ -404,3 +544,70 @@ func (v *KeyValue_GetValue_Result) MethodName() string {
 func (v *KeyValue_GetValue_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
+
+// UnwrapResponse returns the value or error returned by
+// getValue.
+//
+// The error is an exception thrown by getValue, or a
+// *runtime.UnexpectedApplicationError if the result holds an
+// exception which is not declared in the IDL.
+func (v *KeyValue_GetValue_Result) UnwrapResponse() (*unions.ArbitraryValue, error) {
+	return KeyValue_GetValue_Helper.UnwrapResponse(v)
+}
+
+// KeyValue_GetValue_Batcher coalesces calls to KeyValue.getValue into
+// calls to KeyValue.getManyValues.
+type KeyValue_GetValue_Batcher struct {
+	b *runtime.Batcher
+}
+
+// NewKeyValue_GetValue_Batcher builds a KeyValue_GetValue_Batcher
+// which sends batches with the given client.
+func NewKeyValue_GetValue_Batcher(client runtime.Client, cfg runtime.BatcherConfig) *KeyValue_GetValue_Batcher {
+	send := func(requests []interface{}) ([]interface{}, error) {
+		batch := make([]Key, len(requests))
+		for i, r := range requests {
+			batch[i] = r.(Key)
+		}
+
+		args := KeyValue_GetManyValues_Helper.Args(batch)
+		body, err := args.ToWire()
+		if err != nil {
+			return nil, err
+		}
+
+		body, err = client.Send(args.MethodName(), body)
+		if err != nil {
+			return nil, err
+		}
+
+		var result KeyValue_GetManyValues_Result
+		if err = result.FromWire(body); err != nil {
+			return nil, err
+		}
+
+		values, err := result.UnwrapResponse()
+		if err != nil {
+			return nil, err
+		}
+
+		responses := make([]interface{}, len(values))
+		for i, v := range values {
+			responses[i] = v
+		}
+		return responses, nil
+	}
+	return &KeyValue_GetValue_Batcher{b: runtime.NewBatcher(cfg, send)}
+}
+
+// GetValue calls getValue as part of the next batch of
+// calls to getManyValues. If the batch fails, all calls in it fail
+// with the same error.
+func (b *KeyValue_GetValue_Batcher) GetValue(key Key) (success *unions.ArbitraryValue, err error) {
+	var res interface{}
+	res, err = b.b.Do(key)
+	if err == nil {
+		success = res.(*unions.ArbitraryValue)
+	}
+	return
+}
@@ -4,10 +4,14 @@
 package services
 
 import (
+	"errors"
 	"fmt"
+	"strings"
+
 	"go.uber.org/thriftrw/gen/testdata/unions"
+	"go.uber.org/thriftrw/runtime"
 	"go.uber.org/thriftrw/wire"
-	"strings"
+	"go.uber.org/zap/zapcore"
 )
 
 // KeyValue_SetValue_Args represents the arguments for the KeyValue.setValue function.
@@ -41,6 +45,10 @@ func (v *KeyValue_SetValue_Args) ToWire() (wire.Value, error) {
 		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("KeyValue_SetValue_Args is nil")
+	}
+
 	if v.Key != nil {
 		w, err = v.Key.ToWire()
 		if err != nil {
@@ -143,16 +151,78 @@ func (v *KeyValue_SetValue_Args) Equals(rhs *KeyValue_SetValue_Args) bool {
 	return true
 }
 
+// Clone returns a deep copy of this KeyValue_SetValue_Args.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *KeyValue_SetValue_Args) Clone() *KeyValue_SetValue_Args {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Key = _Key_ClonePtr(v.Key)
+	o.Value = v.Value.Clone()
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_SetValue_Args.
+func (v *KeyValue_SetValue_Args) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Key != nil {
+		enc.AddString("key", (string)(*v.Key))
+	}
+	if v.Value != nil {
+		if err := enc.AddObject("value", v.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // GetKey returns the value of Key if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil KeyValue_SetValue_Args.
 func (v *KeyValue_SetValue_Args) GetKey() (o Key) {
-	if v.Key != nil {
+	if v != nil && v.Key != nil {
 		return *v.Key
 	}
 
 	return
 }
 
+// IsSetKey returns true if Key is not nil.
+//
+// This is safe to call on a nil KeyValue_SetValue_Args.
+func (v *KeyValue_SetValue_Args) IsSetKey() bool {
+	return v != nil && v.Key != nil
+}
+
+// GetValue returns the value of Value if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil KeyValue_SetValue_Args.
+func (v *KeyValue_SetValue_Args) GetValue() (o *unions.ArbitraryValue) {
+	if v != nil && v.Value != nil {
+		return v.Value
+	}
+
+	return
+}
+
+// IsSetValue returns true if Value is not nil.
+//
+// This is safe to call on a nil KeyValue_SetValue_Args.
+func (v *KeyValue_SetValue_Args) IsSetValue() bool {
+	return v != nil && v.Value != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
@@ -241,6 +311,11 @@ func init() {
 		return nil, err
 	}
 	KeyValue_SetValue_Helper.UnwrapResponse = func(result *KeyValue_SetValue_Result) (err error) {
+		if result.unexpectedException != nil {
+			err = result.unexpectedException
+			return
+		}
+
 		return
 	}
 
@@ -250,6 +325,10 @@ func init() {
 //
 // The result of a setValue execution is sent and received over the wire as this struct.
 type KeyValue_SetValue_Result struct {
+
+	// Exception which was not recognized when this result was
+	// decoded.
+	unexpectedException *runtime.UnexpectedApplicationError
 }
 
 // ToWire translates a KeyValue_SetValue_Result struct into a Thrift-level intermediate
@@ -295,10 +374,17 @@ func (v *KeyValue_SetValue_Result) ToWire() (wire.Value, error) {
 //   return &v, nil
 func (v *KeyValue_SetValue_Result) FromWire(w wire.Value) error {
 
+	var unknown wire.UnknownFields
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
+		default:
+			if err := unknown.Add(field); err != nil {
+				return err
+			}
 		}
 	}
+	v.unexpectedException = runtime.FindUnexpectedException("KeyValue_SetValue_Result", unknown)
 
 	return nil
 }
@@ -325,6 +411,30 @@ func (v *KeyValue_SetValue_Result) Equals(rhs *KeyValue_SetValue_Result) bool {
 	return true
 }
 
+// Clone returns a deep copy of this KeyValue_SetValue_Result.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *KeyValue_SetValue_Result) Clone() *KeyValue_SetValue_Result {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_SetValue_Result.
+func (v *KeyValue_SetValue_Result) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	return nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
@@ -339,3 +449,13 @@ func (v *KeyValue_SetValue_Result) MethodName() string {
 func (v *KeyValue_SetValue_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
+
+// UnwrapResponse returns the error returned by
+// setValue.
+//
+// The error is an exception thrown by setValue, or a
+// *runtime.UnexpectedApplicationError if the result holds an
+// exception which is not declared in the IDL.
+func (v *KeyValue_SetValue_Result) UnwrapResponse() error {
+	return KeyValue_SetValue_Helper.UnwrapResponse(v)
+}
@@ -6,9 +6,12 @@ package services
 import (
 	"errors"
 	"fmt"
+	"strings"
+
 	"go.uber.org/thriftrw/gen/testdata/unions"
+	"go.uber.org/thriftrw/runtime"
 	"go.uber.org/thriftrw/wire"
-	"strings"
+	"go.uber.org/zap/zapcore"
 )
 
 // KeyValue_SetValueV2_Args represents the arguments for the KeyValue.setValueV2 function.
@@ -46,6 +49,10 @@ func (v *KeyValue_SetValueV2_Args) ToWire() (wire.Value, error) {
 		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("KeyValue_SetValueV2_Args is nil")
+	}
+
 	w, err = v.Key.ToWire()
 	if err != nil {
 		return w, err
@@ -152,6 +159,57 @@ func (v *KeyValue_SetValueV2_Args) Equals(rhs *KeyValue_SetValueV2_Args) bool {
 	return true
 }
 
+// Clone returns a deep copy of this KeyValue_SetValueV2_Args.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *KeyValue_SetValueV2_Args) Clone() *KeyValue_SetValueV2_Args {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Value = v.Value.Clone()
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_SetValueV2_Args.
+func (v *KeyValue_SetValueV2_Args) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("key", (string)(v.Key))
+	if err := enc.AddObject("value", v.Value); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil KeyValue_SetValueV2_Args.
+func (v *KeyValue_SetValueV2_Args) GetKey() (o Key) {
+	if v != nil {
+		o = v.Key
+	}
+	return
+}
+
+// GetValue returns the value of Value if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil KeyValue_SetValueV2_Args.
+func (v *KeyValue_SetValueV2_Args) GetValue() (o *unions.ArbitraryValue) {
+	if v != nil {
+		o = v.Value
+	}
+	return
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
@@ -240,6 +298,11 @@ func init() {
 		return nil, err
 	}
 	KeyValue_SetValueV2_Helper.UnwrapResponse = func(result *KeyValue_SetValueV2_Result) (err error) {
+		if result.unexpectedException != nil {
+			err = result.unexpectedException
+			return
+		}
+
 		return
 	}
 
@@ -249,6 +312,10 @@ func init() {
 //
 // The result of a setValueV2 execution is sent and received over the wire as this struct.
 type KeyValue_SetValueV2_Result struct {
+
+	// Exception which was not recognized when this result was
+	// decoded.
+	unexpectedException *runtime.UnexpectedApplicationError
 }
 
 // ToWire translates a KeyValue_SetValueV2_Result struct into a Thrift-level intermediate
@@ -294,10 +361,17 @@ func (v *KeyValue_SetValueV2_Result) ToWire() (wire.Value, error) {
 //   return &v, nil
 func (v *KeyValue_SetValueV2_Result) FromWire(w wire.Value) error {
 
+	var unknown wire.UnknownFields
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
+		default:
+			if err := unknown.Add(field); err != nil {
+				return err
+			}
 		}
 	}
+	v.unexpectedException = runtime.FindUnexpectedException("KeyValue_SetValueV2_Result", unknown)
 
 	return nil
 }
@@ -324,6 +398,30 @@ func (v *KeyValue_SetValueV2_Result) Equals(rhs *KeyValue_SetValueV2_Result) boo
 	return true
 }
 
+// Clone returns a deep copy of this KeyValue_SetValueV2_Result.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *KeyValue_SetValueV2_Result) Clone() *KeyValue_SetValueV2_Result {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_SetValueV2_Result.
+func (v *KeyValue_SetValueV2_Result) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	return nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
@@ -338,3 +436,13 @@ func (v *KeyValue_SetValueV2_Result) MethodName() string {
 func (v *KeyValue_SetValueV2_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
+
+// UnwrapResponse returns the error returned by
+// setValueV2.
+//
+// The error is an exception thrown by setValueV2, or a
+// *runtime.UnexpectedApplicationError if the result holds an
+// exception which is not declared in the IDL.
+func (v *KeyValue_SetValueV2_Result) UnwrapResponse() error {
+	return KeyValue_SetValueV2_Helper.UnwrapResponse(v)
+}
@@ -6,8 +6,11 @@ package services
 import (
 	"errors"
 	"fmt"
-	"go.uber.org/thriftrw/wire"
 	"strings"
+
+	"go.uber.org/thriftrw/runtime"
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
 )
 
 // KeyValue_Size_Args represents the arguments for the KeyValue.size function.
@@ -89,6 +92,30 @@ func (v *KeyValue_Size_Args) Equals(rhs *KeyValue_Size_Args) bool {
 	return true
 }
 
+// Clone returns a deep copy of this KeyValue_Size_Args.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *KeyValue_Size_Args) Clone() *KeyValue_Size_Args {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_Size_Args.
+func (v *KeyValue_Size_Args) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	return nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
@@ -167,6 +194,10 @@ func init() {
 		return nil, err
 	}
 	KeyValue_Size_Helper.UnwrapResponse = func(result *KeyValue_Size_Result) (success int64, err error) {
+		if result.unexpectedException != nil {
+			err = result.unexpectedException
+			return
+		}
 
 		if result.Success != nil {
 			success = *result.Success
@@ -187,6 +218,10 @@ func init() {
 type KeyValue_Size_Result struct {
 	// Value returned by size after a successful execution.
 	Success *int64 `json:"success,omitempty"`
+
+	// Exception which was not recognized when this result was
+	// decoded.
+	unexpectedException *runtime.UnexpectedApplicationError
 }
 
 // ToWire translates a KeyValue_Size_Result struct into a Thrift-level intermediate
@@ -209,14 +244,14 @@ func (v *KeyValue_Size_Result) ToWire() (wire.Value, error) {
 		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("KeyValue_Size_Result is nil")
+	}
+
 	if v.Success != nil {
-		w, err = wire.NewValueI64(*(v.Success)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueI64(*(v.Success))
 		fields[i] = wire.Field{ID: 0, Value: w}
 		i++
 	}
@@ -246,27 +281,32 @@ func (v *KeyValue_Size_Result) ToWire() (wire.Value, error) {
 //   }
 //   return &v, nil
 func (v *KeyValue_Size_Result) FromWire(w wire.Value) error {
-	var err error
+
+	var unknown wire.UnknownFields
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
 			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
+				x := field.Value.GetI64()
 				v.Success = &x
-				if err != nil {
-					return err
-				}
 
 			}
+		default:
+			if err := unknown.Add(field); err != nil {
+				return err
+			}
 		}
 	}
+	v.unexpectedException = runtime.FindUnexpectedException("KeyValue_Size_Result", unknown)
 
 	count := 0
 	if v.Success != nil {
 		count++
 	}
+	if v.unexpectedException != nil {
+		count++
+	}
 	if count != 1 {
 		return fmt.Errorf("KeyValue_Size_Result should have exactly one field: got %v fields", count)
 	}
@@ -303,16 +343,53 @@ func (v *KeyValue_Size_Result) Equals(rhs *KeyValue_Size_Result) bool {
 	return true
 }
 
+// Clone returns a deep copy of this KeyValue_Size_Result.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *KeyValue_Size_Result) Clone() *KeyValue_Size_Result {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Success = _I64_ClonePtr(v.Success)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of KeyValue_Size_Result.
+func (v *KeyValue_Size_Result) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Success != nil {
+		enc.AddInt64("success", *v.Success)
+	}
+	return nil
+}
+
 // GetSuccess returns the value of Success if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil KeyValue_Size_Result.
 func (v *KeyValue_Size_Result) GetSuccess() (o int64) {
-	if v.Success != nil {
+	if v != nil && v.Success != nil {
 		return *v.Success
 	}
 
 	return
 }
 
+// IsSetSuccess returns true if Success is not nil.
+//
+// This is safe to call on a nil KeyValue_Size_Result.
+func (v *KeyValue_Size_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
@@ -327,3 +404,13 @@ func (v *KeyValue_Size_Result) MethodName() string {
 func (v *KeyValue_Size_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
+
+// UnwrapResponse returns the value or error returned by
+// size.
+//
+// The error is an exception thrown by size, or a
+// *runtime.UnexpectedApplicationError if the result holds an
+// exception which is not declared in the IDL.
+func (v *KeyValue_Size_Result) UnwrapResponse() (int64, error) {
+	return KeyValue_Size_Helper.UnwrapResponse(v)
+}
@@ -5,8 +5,11 @@ package services
 
 import (
 	"fmt"
-	"go.uber.org/thriftrw/wire"
 	"strings"
+
+	"go.uber.org/thriftrw/runtime"
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
 )
 
 // NonStandardServiceName_NonStandardFunctionName_Args represents the arguments for the non_standard_service_name.non_standard_function_name function.
@@ -88,6 +91,30 @@ func (v *NonStandardServiceName_NonStandardFunctionName_Args) Equals(rhs *NonSta
 	return true
 }
 
+// Clone returns a deep copy of this NonStandardServiceName_NonStandardFunctionName_Args.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *NonStandardServiceName_NonStandardFunctionName_Args) Clone() *NonStandardServiceName_NonStandardFunctionName_Args {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of NonStandardServiceName_NonStandardFunctionName_Args.
+func (v *NonStandardServiceName_NonStandardFunctionName_Args) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	return nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
@@ -167,6 +194,11 @@ func init() {
 		return nil, err
 	}
 	NonStandardServiceName_NonStandardFunctionName_Helper.UnwrapResponse = func(result *NonStandardServiceName_NonStandardFunctionName_Result) (err error) {
+		if result.unexpectedException != nil {
+			err = result.unexpectedException
+			return
+		}
+
 		return
 	}
 
@@ -176,6 +208,10 @@ func init() {
 //
 // The result of a non_standard_function_name execution is sent and received over the wire as this struct.
 type NonStandardServiceName_NonStandardFunctionName_Result struct {
+
+	// Exception which was not recognized when this result was
+	// decoded.
+	unexpectedException *runtime.UnexpectedApplicationError
 }
 
 // ToWire translates a NonStandardServiceName_NonStandardFunctionName_Result struct into a Thrift-level intermediate
@@ -221,10 +257,17 @@ func (v *NonStandardServiceName_NonStandardFunctionName_Result) ToWire() (wire.V
 //   return &v, nil
 func (v *NonStandardServiceName_NonStandardFunctionName_Result) FromWire(w wire.Value) error {
 
+	var unknown wire.UnknownFields
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
+		default:
+			if err := unknown.Add(field); err != nil {
+				return err
+			}
 		}
 	}
+	v.unexpectedException = runtime.FindUnexpectedException("NonStandardServiceName_NonStandardFunctionName_Result", unknown)
 
 	return nil
 }
@@ -251,6 +294,30 @@ func (v *NonStandardServiceName_NonStandardFunctionName_Result) Equals(rhs *NonS
 	return true
 }
 
+// Clone returns a deep copy of this NonStandardServiceName_NonStandardFunctionName_Result.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *NonStandardServiceName_NonStandardFunctionName_Result) Clone() *NonStandardServiceName_NonStandardFunctionName_Result {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of NonStandardServiceName_NonStandardFunctionName_Result.
+func (v *NonStandardServiceName_NonStandardFunctionName_Result) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	return nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
@@ -265,3 +332,13 @@ func (v *NonStandardServiceName_NonStandardFunctionName_Result) MethodName() str
 func (v *NonStandardServiceName_NonStandardFunctionName_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
+
+// UnwrapResponse returns the error returned by
+// non_standard_function_name.
+//
+// The error is an exception thrown by non_standard_function_name, or a
+// *runtime.UnexpectedApplicationError if the result holds an
+// exception which is not declared in the IDL.
+func (v *NonStandardServiceName_NonStandardFunctionName_Result) UnwrapResponse() error {
+	return NonStandardServiceName_NonStandardFunctionName_Helper.UnwrapResponse(v)
+}
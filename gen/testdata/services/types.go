@@ -5,10 +5,13 @@ package services
 
 import (
 	"bytes"
+	"encoding/base64"
 	"errors"
 	"fmt"
-	"go.uber.org/thriftrw/wire"
 	"strings"
+
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
 )
 
 type ConflictingNamesSetValueArgs struct {
@@ -36,22 +39,19 @@ func (v *ConflictingNamesSetValueArgs) ToWire() (wire.Value, error) {
 		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
-	w, err = wire.NewValueString(v.Key), error(nil)
-	if err != nil {
-		return w, err
+	if v == nil {
+		return wire.Value{}, errors.New("ConflictingNamesSetValueArgs is nil")
 	}
+
+	w = wire.NewValueString(v.Key)
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 	if v.Value == nil {
 		return w, errors.New("field Value of ConflictingNamesSetValueArgs is required")
 	}
-	w, err = wire.NewValueBinary(v.Value), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueBinary(v.Value)
 	fields[i] = wire.Field{ID: 2, Value: w}
 	i++
 
@@ -76,7 +76,6 @@ func (v *ConflictingNamesSetValueArgs) ToWire() (wire.Value, error) {
 //   }
 //   return &v, nil
 func (v *ConflictingNamesSetValueArgs) FromWire(w wire.Value) error {
-	var err error
 
 	keyIsSet := false
 	valueIsSet := false
@@ -85,18 +84,12 @@ func (v *ConflictingNamesSetValueArgs) FromWire(w wire.Value) error {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TBinary {
-				v.Key, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.Key = field.Value.GetString()
 				keyIsSet = true
 			}
 		case 2:
 			if field.Value.Type() == wire.TBinary {
-				v.Value, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.Value = field.Value.GetBinary()
 				valueIsSet = true
 			}
 		}
@@ -145,6 +138,65 @@ func (v *ConflictingNamesSetValueArgs) Equals(rhs *ConflictingNamesSetValueArgs)
 	return true
 }
 
+func _Binary_Clone(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+
+	o := make([]byte, len(b))
+	copy(o, b)
+	return o
+}
+
+// Clone returns a deep copy of this ConflictingNamesSetValueArgs.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *ConflictingNamesSetValueArgs) Clone() *ConflictingNamesSetValueArgs {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Value = _Binary_Clone(v.Value)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ConflictingNamesSetValueArgs.
+func (v *ConflictingNamesSetValueArgs) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("key", v.Key)
+	enc.AddString("value", base64.StdEncoding.EncodeToString(v.Value))
+	return nil
+}
+
+// GetKey returns the value of Key if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil ConflictingNamesSetValueArgs.
+func (v *ConflictingNamesSetValueArgs) GetKey() (o string) {
+	if v != nil {
+		o = v.Key
+	}
+	return
+}
+
+// GetValue returns the value of Value if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil ConflictingNamesSetValueArgs.
+func (v *ConflictingNamesSetValueArgs) GetValue() (o []byte) {
+	if v != nil {
+		o = v.Value
+	}
+	return
+}
+
 type InternalError struct {
 	Message *string `json:"message,omitempty"`
 }
@@ -169,14 +221,14 @@ func (v *InternalError) ToWire() (wire.Value, error) {
 		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("InternalError is nil")
+	}
+
 	if v.Message != nil {
-		w, err = wire.NewValueString(*(v.Message)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueString(*(v.Message))
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
@@ -202,18 +254,13 @@ func (v *InternalError) ToWire() (wire.Value, error) {
 //   }
 //   return &v, nil
 func (v *InternalError) FromWire(w wire.Value) error {
-	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
+				x := field.Value.GetString()
 				v.Message = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		}
@@ -261,17 +308,68 @@ func (v *InternalError) Equals(rhs *InternalError) bool {
 	return true
 }
 
+func _String_ClonePtr(p *string) *string {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this InternalError.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *InternalError) Clone() *InternalError {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Message = _String_ClonePtr(v.Message)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of InternalError.
+func (v *InternalError) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Message != nil {
+		enc.AddString("message", *v.Message)
+	}
+	return nil
+}
+
 // GetMessage returns the value of Message if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil InternalError.
 func (v *InternalError) GetMessage() (o string) {
-	if v.Message != nil {
+	if v != nil && v.Message != nil {
 		return *v.Message
 	}
 
 	return
 }
 
+// IsSetMessage returns true if Message is not nil.
+//
+// This is safe to call on a nil InternalError.
+func (v *InternalError) IsSetMessage() bool {
+	return v != nil && v.Message != nil
+}
+
+// Error returns the message of the exception if it is set and the
+// String representation of the exception otherwise.
 func (v *InternalError) Error() string {
+	if m := v.GetMessage(); m != "" {
+		return m
+	}
 	return v.String()
 }
 
@@ -282,7 +380,7 @@ type Key string
 // into bytes using a ThriftRW protocol implementation.
 func (v Key) ToWire() (wire.Value, error) {
 	x := (string)(v)
-	return wire.NewValueString(x), error(nil)
+	return wire.NewValueString(x), nil
 }
 
 // String returns a readable string representation of Key.
@@ -295,9 +393,8 @@ func (v Key) String() string {
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 func (v *Key) FromWire(w wire.Value) error {
-	x, err := w.GetString(), error(nil)
-	*v = (Key)(x)
-	return err
+	*v = (Key)(w.GetString())
+	return nil
 }
 
 // Equals returns true if this Key is equal to the provided
@@ -305,3 +402,9 @@ func (v *Key) FromWire(w wire.Value) error {
 func (lhs Key) Equals(rhs Key) bool {
 	return (lhs == rhs)
 }
+
+// Clone returns a deep copy of this Key.
+func (v Key) Clone() Key {
+	x := (string)(v)
+	return (Key)(x)
+}
@@ -0,0 +1,18 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package split_types
+
+import "go.uber.org/thriftrw/thriftreflect"
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:            "split_types",
+	Package:         "go.uber.org/thriftrw/gen/testdata/split_types",
+	FilePath:        "split_types.thrift",
+	SHA1:            "43b985f5573cbf242fc68c0a671698c5524b71d0",
+	ThriftRWVersion: "1.9.0",
+	Raw:             rawIDL,
+}
+
+const rawIDL = "// Code for this file is generated with --split-types.\n\nenum Color {\n    RED\n    GREEN\n}\n\ntypedef list<string> Tags\n\nstruct Shape {\n    1: required string name\n    2: optional Color color\n    3: optional list<string> labels\n}\n\nstruct Canvas {\n    1: required list<Shape> shapes\n    2: optional list<string> labels\n    3: optional Tags tags\n}\n\nexception DrawError {\n    1: required string message\n} (thriftrw.family = \"CanvasError\", thriftrw.family.fields = \"message\")\n\nexception SizeError {\n    1: required string message\n    2: optional i32 limit\n} (thriftrw.family = \"CanvasError\", thriftrw.family.fields = \"message\")\n"
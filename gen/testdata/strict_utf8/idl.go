@@ -0,0 +1,18 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package strict_utf8
+
+import "go.uber.org/thriftrw/thriftreflect"
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:            "strict_utf8",
+	Package:         "go.uber.org/thriftrw/gen/testdata/strict_utf8",
+	FilePath:        "strict_utf8.thrift",
+	SHA1:            "39c12b954d9b20ecaee04e15dec5b423130a18ee",
+	ThriftRWVersion: "1.9.0",
+	Raw:             rawIDL,
+}
+
+const rawIDL = "// Code for this file is generated with --strict-utf8.\n\ntypedef string Name\n\nstruct Person {\n    1: required Name name\n    2: optional string nickname\n    3: optional string legacyName (thriftrw.allowInvalidUTF8)\n    4: optional list<string> aliases\n    5: optional map<string, i32> scores\n    6: optional set<string> tags\n    7: optional map<i32, list<Name>> history\n    8: optional binary photo\n}\n\nunion Label {\n    1: string text\n    2: i32 code\n}\n"
@@ -0,0 +1,1288 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package strict_utf8
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
+)
+
+type Label struct {
+	Text *string `json:"text,omitempty"`
+	Code *int32  `json:"code,omitempty"`
+}
+
+// ToWire translates a Label struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Label) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Label is nil")
+	}
+
+	if v.Text != nil {
+		if !utf8.ValidString(*v.Text) {
+			return w, errors.New("field Text of Label is not valid UTF-8")
+		}
+		w = wire.NewValueString(*(v.Text))
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Code != nil {
+		w = wire.NewValueI32(*(v.Code))
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Label should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Label struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Label struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Label
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Label) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				x := field.Value.GetString()
+				v.Text = &x
+				if !utf8.ValidString(*v.Text) {
+					return errors.New("field Text of Label is not valid UTF-8")
+				}
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				x := field.Value.GetI32()
+				v.Code = &x
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Text != nil {
+		count++
+	}
+	if v.Code != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Label should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Label
+// struct.
+func (v *Label) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Text != nil {
+		fields[i] = fmt.Sprintf("Text: %v", *(v.Text))
+		i++
+	}
+	if v.Code != nil {
+		fields[i] = fmt.Sprintf("Code: %v", *(v.Code))
+		i++
+	}
+
+	return fmt.Sprintf("Label{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Label match the
+// provided Label.
+//
+// This function performs a deep comparison.
+func (v *Label) Equals(rhs *Label) bool {
+	if !_String_EqualsPtr(v.Text, rhs.Text) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Code, rhs.Code) {
+		return false
+	}
+
+	return true
+}
+
+func _String_ClonePtr(p *string) *string {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _I32_ClonePtr(p *int32) *int32 {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this Label.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Label) Clone() *Label {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Text = _String_ClonePtr(v.Text)
+	o.Code = _I32_ClonePtr(v.Code)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Label.
+func (v *Label) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Text != nil {
+		enc.AddString("text", *v.Text)
+	}
+	if v.Code != nil {
+		enc.AddInt32("code", *v.Code)
+	}
+	return nil
+}
+
+// GetText returns the value of Text if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Label.
+func (v *Label) GetText() (o string) {
+	if v != nil && v.Text != nil {
+		return *v.Text
+	}
+
+	return
+}
+
+// IsSetText returns true if Text is not nil.
+//
+// This is safe to call on a nil Label.
+func (v *Label) IsSetText() bool {
+	return v != nil && v.Text != nil
+}
+
+// GetCode returns the value of Code if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Label.
+func (v *Label) GetCode() (o int32) {
+	if v != nil && v.Code != nil {
+		return *v.Code
+	}
+
+	return
+}
+
+// IsSetCode returns true if Code is not nil.
+//
+// This is safe to call on a nil Label.
+func (v *Label) IsSetCode() bool {
+	return v != nil && v.Code != nil
+}
+
+type Name string
+
+// ToWire translates Name into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Name) ToWire() (wire.Value, error) {
+	x := (string)(v)
+	return wire.NewValueString(x), nil
+}
+
+// String returns a readable string representation of Name.
+func (v Name) String() string {
+	x := (string)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes Name from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Name) FromWire(w wire.Value) error {
+	*v = (Name)(w.GetString())
+	return nil
+}
+
+// Equals returns true if this Name is equal to the provided
+// Name.
+func (lhs Name) Equals(rhs Name) bool {
+	return (lhs == rhs)
+}
+
+// Clone returns a deep copy of this Name.
+func (v Name) Clone() Name {
+	x := (string)(v)
+	return (Name)(x)
+}
+
+type Person struct {
+	Name       Name                `json:"name,required"`
+	Nickname   *string             `json:"nickname,omitempty"`
+	LegacyName *string             `json:"legacyName,omitempty"`
+	Aliases    []string            `json:"aliases,omitempty"`
+	Scores     map[string]int32    `json:"scores,omitempty"`
+	Tags       map[string]struct{} `json:"tags,omitempty"`
+	History    map[int32][]Name    `json:"history,omitempty"`
+	Photo      []byte              `json:"photo,omitempty"`
+}
+
+func _List_String_ValidUTF8(c []string) bool {
+	for _, v := range c {
+		if !utf8.ValidString(v) {
+			return false
+		}
+	}
+	return true
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		if err := f(wire.NewValueString(x)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+func _Map_String_I32_ValidUTF8(c map[string]int32) bool {
+	for k := range c {
+		if !utf8.ValidString(k) {
+			return false
+		}
+	}
+	return true
+}
+
+type _Map_String_I32_MapItemList map[string]int32
+
+func (m _Map_String_I32_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw := wire.NewValueString(k)
+
+		vw := wire.NewValueI32(v)
+		if err := f(wire.MapItem{Key: kw, Value: vw}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_I32_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_I32_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_I32_MapItemList) ValueType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_String_I32_MapItemList) Close() {}
+
+func _Set_String_ValidUTF8(c map[string]struct{}) bool {
+	for v := range c {
+		if !utf8.ValidString(v) {
+			return false
+		}
+	}
+	return true
+}
+
+type _Set_String_ValueList map[string]struct{}
+
+func (v _Set_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for x := range v {
+		if err := f(wire.NewValueString(x)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Set_String_ValueList) Close() {}
+
+func _List_Name_ValidUTF8(c []Name) bool {
+	for _, v := range c {
+		if !utf8.ValidString((string)(v)) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_I32_List_Name_ValidUTF8(c map[int32][]Name) bool {
+	for _, v := range c {
+		if !_List_Name_ValidUTF8(v) {
+			return false
+		}
+	}
+	return true
+}
+
+type _List_Name_ValueList []Name
+
+func (v _List_Name_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Name_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Name_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_Name_ValueList) Close() {}
+
+type _Map_I32_List_Name_MapItemList map[int32][]Name
+
+func (m _Map_I32_List_Name_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw := wire.NewValueI32(k)
+
+		vw := wire.NewValueList(_List_Name_ValueList(v))
+		if err := f(wire.MapItem{Key: kw, Value: vw}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_I32_List_Name_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_I32_List_Name_MapItemList) KeyType() wire.Type {
+	return wire.TI32
+}
+
+func (_Map_I32_List_Name_MapItemList) ValueType() wire.Type {
+	return wire.TList
+}
+
+func (_Map_I32_List_Name_MapItemList) Close() {}
+
+// ToWire translates a Person struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Person) ToWire() (wire.Value, error) {
+	var (
+		fields [8]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Person is nil")
+	}
+
+	if !utf8.ValidString((string)(v.Name)) {
+		return w, errors.New("field Name of Person is not valid UTF-8")
+	}
+	w, err = v.Name.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Nickname != nil {
+		if !utf8.ValidString(*v.Nickname) {
+			return w, errors.New("field Nickname of Person is not valid UTF-8")
+		}
+		w = wire.NewValueString(*(v.Nickname))
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.LegacyName != nil {
+		w = wire.NewValueString(*(v.LegacyName))
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Aliases != nil {
+		if !_List_String_ValidUTF8(v.Aliases) {
+			return w, errors.New("field Aliases of Person is not valid UTF-8")
+		}
+		w = wire.NewValueList(_List_String_ValueList(v.Aliases))
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Scores != nil {
+		if !_Map_String_I32_ValidUTF8(v.Scores) {
+			return w, errors.New("field Scores of Person is not valid UTF-8")
+		}
+		w = wire.NewValueMap(_Map_String_I32_MapItemList(v.Scores))
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.Tags != nil {
+		if !_Set_String_ValidUTF8(v.Tags) {
+			return w, errors.New("field Tags of Person is not valid UTF-8")
+		}
+		w = wire.NewValueSet(_Set_String_ValueList(v.Tags))
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.History != nil {
+		if !_Map_I32_List_Name_ValidUTF8(v.History) {
+			return w, errors.New("field History of Person is not valid UTF-8")
+		}
+		w = wire.NewValueMap(_Map_I32_List_Name_MapItemList(v.History))
+		fields[i] = wire.Field{ID: 7, Value: w}
+		i++
+	}
+	if v.Photo != nil {
+		w = wire.NewValueBinary(v.Photo)
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Name_Read(w wire.Value) (Name, error) {
+	var x Name
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		o = append(o, x.GetString())
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Map_String_I32_Read(m wire.MapItemList) (map[string]int32, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TI32 {
+		return nil, nil
+	}
+
+	o := make(map[string]int32, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k := x.Key.GetString()
+
+		v := x.Value.GetI32()
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Set_String_Read(s wire.ValueList) (map[string]struct{}, error) {
+	if s.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[string]struct{}, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i := x.GetString()
+
+		o[i] = struct{}{}
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+func _List_Name_Read(l wire.ValueList) ([]Name, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]Name, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Name_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Map_I32_List_Name_Read(m wire.MapItemList) (map[int32][]Name, error) {
+	if m.KeyType() != wire.TI32 {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TList {
+		return nil, nil
+	}
+
+	o := make(map[int32][]Name, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k := x.Key.GetI32()
+
+		v, err := _List_Name_Read(x.Value.GetList())
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a Person struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Person struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Person
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Person) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name, err = _Name_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				if !utf8.ValidString((string)(v.Name)) {
+					return errors.New("field Name of Person is not valid UTF-8")
+				}
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				x := field.Value.GetString()
+				v.Nickname = &x
+				if !utf8.ValidString(*v.Nickname) {
+					return errors.New("field Nickname of Person is not valid UTF-8")
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TBinary {
+				x := field.Value.GetString()
+				v.LegacyName = &x
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TList {
+				v.Aliases, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+				if !_List_String_ValidUTF8(v.Aliases) {
+					return errors.New("field Aliases of Person is not valid UTF-8")
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TMap {
+				v.Scores, err = _Map_String_I32_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+				if !_Map_String_I32_ValidUTF8(v.Scores) {
+					return errors.New("field Scores of Person is not valid UTF-8")
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TSet {
+				v.Tags, err = _Set_String_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+				if !_Set_String_ValidUTF8(v.Tags) {
+					return errors.New("field Tags of Person is not valid UTF-8")
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TMap {
+				v.History, err = _Map_I32_List_Name_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+				if !_Map_I32_List_Name_ValidUTF8(v.History) {
+					return errors.New("field History of Person is not valid UTF-8")
+				}
+
+			}
+		case 8:
+			if field.Value.Type() == wire.TBinary {
+				v.Photo = field.Value.GetBinary()
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Person is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Person
+// struct.
+func (v *Person) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [8]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	if v.Nickname != nil {
+		fields[i] = fmt.Sprintf("Nickname: %v", *(v.Nickname))
+		i++
+	}
+	if v.LegacyName != nil {
+		fields[i] = fmt.Sprintf("LegacyName: %v", *(v.LegacyName))
+		i++
+	}
+	if v.Aliases != nil {
+		fields[i] = fmt.Sprintf("Aliases: %v", v.Aliases)
+		i++
+	}
+	if v.Scores != nil {
+		fields[i] = fmt.Sprintf("Scores: %v", v.Scores)
+		i++
+	}
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+	if v.History != nil {
+		fields[i] = fmt.Sprintf("History: %v", v.History)
+		i++
+	}
+	if v.Photo != nil {
+		fields[i] = fmt.Sprintf("Photo: %v", v.Photo)
+		i++
+	}
+
+	return fmt.Sprintf("Person{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_I32_Equals(lhs, rhs map[string]int32) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Set_String_Equals(lhs, rhs map[string]struct{}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for x := range rhs {
+		if _, ok := lhs[x]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_Name_Equals(lhs, rhs []Name) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_I32_List_Name_Equals(lhs, rhs map[int32][]Name) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !_List_Name_Equals(lv, rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this Person match the
+// provided Person.
+//
+// This function performs a deep comparison.
+func (v *Person) Equals(rhs *Person) bool {
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !_String_EqualsPtr(v.Nickname, rhs.Nickname) {
+		return false
+	}
+	if !_String_EqualsPtr(v.LegacyName, rhs.LegacyName) {
+		return false
+	}
+	if !((v.Aliases == nil && rhs.Aliases == nil) || (v.Aliases != nil && rhs.Aliases != nil && _List_String_Equals(v.Aliases, rhs.Aliases))) {
+		return false
+	}
+	if !((v.Scores == nil && rhs.Scores == nil) || (v.Scores != nil && rhs.Scores != nil && _Map_String_I32_Equals(v.Scores, rhs.Scores))) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _Set_String_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+	if !((v.History == nil && rhs.History == nil) || (v.History != nil && rhs.History != nil && _Map_I32_List_Name_Equals(v.History, rhs.History))) {
+		return false
+	}
+	if !((v.Photo == nil && rhs.Photo == nil) || (v.Photo != nil && rhs.Photo != nil && bytes.Equal(v.Photo, rhs.Photo))) {
+		return false
+	}
+
+	return true
+}
+
+func _List_String_Clone(l []string) []string {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]string, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
+}
+
+func _Map_String_I32_Clone(m map[string]int32) map[string]int32 {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[string]int32, len(m))
+	for k, v := range m {
+		o[k] = v
+	}
+
+	return o
+}
+
+func _Set_String_Clone(s map[string]struct{}) map[string]struct{} {
+	if s == nil {
+		return nil
+	}
+
+	o := make(map[string]struct{}, len(s))
+	for x := range s {
+		o[x] = struct{}{}
+	}
+
+	return o
+}
+
+func _List_Name_Clone(l []Name) []Name {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]Name, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
+}
+
+func _Map_I32_List_Name_Clone(m map[int32][]Name) map[int32][]Name {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[int32][]Name, len(m))
+	for k, v := range m {
+		o[k] = _List_Name_Clone(v)
+	}
+
+	return o
+}
+
+func _Binary_Clone(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+
+	o := make([]byte, len(b))
+	copy(o, b)
+	return o
+}
+
+// Clone returns a deep copy of this Person.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Person) Clone() *Person {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Nickname = _String_ClonePtr(v.Nickname)
+	o.LegacyName = _String_ClonePtr(v.LegacyName)
+	o.Aliases = _List_String_Clone(v.Aliases)
+	o.Scores = _Map_String_I32_Clone(v.Scores)
+	o.Tags = _Set_String_Clone(v.Tags)
+	o.History = _Map_I32_List_Name_Clone(v.History)
+	o.Photo = _Binary_Clone(v.Photo)
+
+	return &o
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return nil
+}
+
+type _Map_String_I32_Zapper map[string]int32
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_I32_Zapper.
+func (m _Map_String_I32_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for k, v := range m {
+		enc.AddInt32((string)(k), v)
+	}
+	return nil
+}
+
+type _Set_String_Zapper map[string]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_String_Zapper.
+func (s _Set_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for v := range s {
+		enc.AppendString(v)
+	}
+	return nil
+}
+
+type _List_Name_Zapper []Name
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Name_Zapper.
+func (l _List_Name_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		enc.AppendString((string)(v))
+	}
+	return nil
+}
+
+type _Map_I32_List_Name_Item_Zapper struct {
+	Key   int32
+	Value []Name
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_I32_List_Name_Item_Zapper.
+func (i _Map_I32_List_Name_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddInt32("key", i.Key)
+	if err := enc.AddArray("value", (_List_Name_Zapper)(i.Value)); err != nil {
+		return err
+	}
+	return nil
+}
+
+type _Map_I32_List_Name_Zapper map[int32][]Name
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_I32_List_Name_Zapper.
+func (m _Map_I32_List_Name_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for k, v := range m {
+		if err := enc.AppendObject(_Map_I32_List_Name_Item_Zapper{Key: k, Value: v}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Person.
+func (v *Person) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("name", (string)(v.Name))
+	if v.Nickname != nil {
+		enc.AddString("nickname", *v.Nickname)
+	}
+	if v.LegacyName != nil {
+		enc.AddString("legacyName", *v.LegacyName)
+	}
+	if v.Aliases != nil {
+		if err := enc.AddArray("aliases", (_List_String_Zapper)(v.Aliases)); err != nil {
+			return err
+		}
+	}
+	if v.Scores != nil {
+		if err := enc.AddObject("scores", (_Map_String_I32_Zapper)(v.Scores)); err != nil {
+			return err
+		}
+	}
+	if v.Tags != nil {
+		if err := enc.AddArray("tags", (_Set_String_Zapper)(v.Tags)); err != nil {
+			return err
+		}
+	}
+	if v.History != nil {
+		if err := enc.AddArray("history", (_Map_I32_List_Name_Zapper)(v.History)); err != nil {
+			return err
+		}
+	}
+	if v.Photo != nil {
+		enc.AddString("photo", base64.StdEncoding.EncodeToString(v.Photo))
+	}
+	return nil
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Person.
+func (v *Person) GetName() (o Name) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetNickname returns the value of Nickname if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Person.
+func (v *Person) GetNickname() (o string) {
+	if v != nil && v.Nickname != nil {
+		return *v.Nickname
+	}
+
+	return
+}
+
+// IsSetNickname returns true if Nickname is not nil.
+//
+// This is safe to call on a nil Person.
+func (v *Person) IsSetNickname() bool {
+	return v != nil && v.Nickname != nil
+}
+
+// GetLegacyName returns the value of LegacyName if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Person.
+func (v *Person) GetLegacyName() (o string) {
+	if v != nil && v.LegacyName != nil {
+		return *v.LegacyName
+	}
+
+	return
+}
+
+// IsSetLegacyName returns true if LegacyName is not nil.
+//
+// This is safe to call on a nil Person.
+func (v *Person) IsSetLegacyName() bool {
+	return v != nil && v.LegacyName != nil
+}
+
+// GetAliases returns the value of Aliases if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Person.
+func (v *Person) GetAliases() (o []string) {
+	if v != nil && v.Aliases != nil {
+		return v.Aliases
+	}
+
+	return
+}
+
+// IsSetAliases returns true if Aliases is not nil.
+//
+// This is safe to call on a nil Person.
+func (v *Person) IsSetAliases() bool {
+	return v != nil && v.Aliases != nil
+}
+
+// GetScores returns the value of Scores if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Person.
+func (v *Person) GetScores() (o map[string]int32) {
+	if v != nil && v.Scores != nil {
+		return v.Scores
+	}
+
+	return
+}
+
+// IsSetScores returns true if Scores is not nil.
+//
+// This is safe to call on a nil Person.
+func (v *Person) IsSetScores() bool {
+	return v != nil && v.Scores != nil
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Person.
+func (v *Person) GetTags() (o map[string]struct{}) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+//
+// This is safe to call on a nil Person.
+func (v *Person) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+// GetHistory returns the value of History if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Person.
+func (v *Person) GetHistory() (o map[int32][]Name) {
+	if v != nil && v.History != nil {
+		return v.History
+	}
+
+	return
+}
+
+// IsSetHistory returns true if History is not nil.
+//
+// This is safe to call on a nil Person.
+func (v *Person) IsSetHistory() bool {
+	return v != nil && v.History != nil
+}
+
+// GetPhoto returns the value of Photo if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Person.
+func (v *Person) GetPhoto() (o []byte) {
+	if v != nil && v.Photo != nil {
+		return v.Photo
+	}
+
+	return
+}
+
+// IsSetPhoto returns true if Photo is not nil.
+//
+// This is safe to call on a nil Person.
+func (v *Person) IsSetPhoto() bool {
+	return v != nil && v.Photo != nil
+}
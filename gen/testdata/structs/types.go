@@ -5,12 +5,15 @@ package structs
 
 import (
 	"bytes"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"strings"
+
 	"go.uber.org/thriftrw/gen/testdata/enums"
 	"go.uber.org/thriftrw/ptr"
 	"go.uber.org/thriftrw/wire"
-	"strings"
+	"go.uber.org/zap/zapcore"
 )
 
 type ContactInfo struct {
@@ -37,13 +40,13 @@ func (v *ContactInfo) ToWire() (wire.Value, error) {
 		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
-	w, err = wire.NewValueString(v.EmailAddress), error(nil)
-	if err != nil {
-		return w, err
+	if v == nil {
+		return wire.Value{}, errors.New("ContactInfo is nil")
 	}
+
+	w = wire.NewValueString(v.EmailAddress)
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 
@@ -68,7 +71,6 @@ func (v *ContactInfo) ToWire() (wire.Value, error) {
 //   }
 //   return &v, nil
 func (v *ContactInfo) FromWire(w wire.Value) error {
-	var err error
 
 	emailAddressIsSet := false
 
@@ -76,10 +78,7 @@ func (v *ContactInfo) FromWire(w wire.Value) error {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TBinary {
-				v.EmailAddress, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.EmailAddress = field.Value.GetString()
 				emailAddressIsSet = true
 			}
 		}
@@ -119,6 +118,263 @@ func (v *ContactInfo) Equals(rhs *ContactInfo) bool {
 	return true
 }
 
+// Clone returns a deep copy of this ContactInfo.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *ContactInfo) Clone() *ContactInfo {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ContactInfo.
+func (v *ContactInfo) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("emailAddress", v.EmailAddress)
+	return nil
+}
+
+// GetEmailAddress returns the value of EmailAddress if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil ContactInfo.
+func (v *ContactInfo) GetEmailAddress() (o string) {
+	if v != nil {
+		o = v.EmailAddress
+	}
+	return
+}
+
+type Credentials struct {
+	Username string `json:"username,required"`
+	Password string `json:"password,required"`
+	Token    []byte `json:"token,omitempty"`
+}
+
+// ToWire translates a Credentials struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Credentials) ToWire() (wire.Value, error) {
+	var (
+		fields [3]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Credentials is nil")
+	}
+
+	w = wire.NewValueString(v.Username)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	w = wire.NewValueString(v.Password)
+	fields[i] = wire.Field{ID: 2, Value: w}
+	i++
+	if v.Token != nil {
+		w = wire.NewValueBinary(v.Token)
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Credentials struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Credentials struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Credentials
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Credentials) FromWire(w wire.Value) error {
+
+	usernameIsSet := false
+	passwordIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Username = field.Value.GetString()
+				usernameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.Password = field.Value.GetString()
+				passwordIsSet = true
+			}
+		case 3:
+			if field.Value.Type() == wire.TBinary {
+				v.Token = field.Value.GetBinary()
+
+			}
+		}
+	}
+
+	if !usernameIsSet {
+		return errors.New("field Username of Credentials is required")
+	}
+
+	if !passwordIsSet {
+		return errors.New("field Password of Credentials is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Credentials
+// struct.
+func (v *Credentials) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [3]string
+	i := 0
+	fields[i] = fmt.Sprintf("Username: %v", v.Username)
+	i++
+	fields[i] = "Password: <redacted>"
+	i++
+	if v.Token != nil {
+		fields[i] = "Token: <redacted>"
+		i++
+	}
+
+	return fmt.Sprintf("Credentials{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Credentials match the
+// provided Credentials.
+//
+// This function performs a deep comparison.
+func (v *Credentials) Equals(rhs *Credentials) bool {
+	if !(v.Username == rhs.Username) {
+		return false
+	}
+	if !(v.Password == rhs.Password) {
+		return false
+	}
+	if !((v.Token == nil && rhs.Token == nil) || (v.Token != nil && rhs.Token != nil && bytes.Equal(v.Token, rhs.Token))) {
+		return false
+	}
+
+	return true
+}
+
+func _Binary_Clone(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+
+	o := make([]byte, len(b))
+	copy(o, b)
+	return o
+}
+
+// Clone returns a deep copy of this Credentials.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Credentials) Clone() *Credentials {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Token = _Binary_Clone(v.Token)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Credentials.
+func (v *Credentials) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("username", v.Username)
+	enc.AddString("password", "<redacted>")
+	if v.Token != nil {
+		enc.AddString("token", "<redacted>")
+	}
+	return nil
+}
+
+// GetUsername returns the value of Username if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Credentials.
+func (v *Credentials) GetUsername() (o string) {
+	if v != nil {
+		o = v.Username
+	}
+	return
+}
+
+// GetPassword returns the value of Password if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Credentials.
+func (v *Credentials) GetPassword() (o string) {
+	if v != nil {
+		o = v.Password
+	}
+	return
+}
+
+// GetToken returns the value of Token if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Credentials.
+func (v *Credentials) GetToken() (o []byte) {
+	if v != nil && v.Token != nil {
+		return v.Token
+	}
+
+	return
+}
+
+// IsSetToken returns true if Token is not nil.
+//
+// This is safe to call on a nil Credentials.
+func (v *Credentials) IsSetToken() bool {
+	return v != nil && v.Token != nil
+}
+
 type DefaultsStruct struct {
 	RequiredPrimitive *int32             `json:"requiredPrimitive,omitempty"`
 	OptionalPrimitive *int32             `json:"optionalPrimitive,omitempty"`
@@ -134,16 +390,51 @@ func _EnumDefault_ptr(v enums.EnumDefault) *enums.EnumDefault {
 	return &v
 }
 
+// Default_DefaultsStruct constructs a new DefaultsStruct with its fields set to the
+// default values declared for them in the Thrift file.
+func Default_DefaultsStruct() *DefaultsStruct {
+	var v DefaultsStruct
+	v.RequiredPrimitive = ptr.Int32(100)
+	v.OptionalPrimitive = ptr.Int32(200)
+	v.RequiredEnum = _EnumDefault_ptr(enums.EnumDefaultBar)
+	v.OptionalEnum = _EnumDefault_ptr(enums.EnumDefaultBaz)
+	v.RequiredList = []string{
+		"hello",
+		"world",
+	}
+	v.OptionalList = []float64{
+		1,
+		2,
+		3,
+	}
+	v.RequiredStruct = &Frame{
+		TopLeft: &Point{
+			X: 1,
+			Y: 2,
+		},
+		Size: &Size{
+			Width:  100,
+			Height: 200,
+		},
+	}
+	v.OptionalStruct = &Edge{
+		StartPoint: &Point{
+			X: 1,
+			Y: 2,
+		},
+		EndPoint: &Point{
+			X: 3,
+			Y: 4,
+		},
+	}
+	return &v
+}
+
 type _List_String_ValueList []string
 
 func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
 	for _, x := range v {
-		w, err := wire.NewValueString(x), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
+		if err := f(wire.NewValueString(x)); err != nil {
 			return err
 		}
 	}
@@ -164,12 +455,7 @@ type _List_Double_ValueList []float64
 
 func (v _List_Double_ValueList) ForEach(f func(wire.Value) error) error {
 	for _, x := range v {
-		w, err := wire.NewValueDouble(x), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(w)
-		if err != nil {
+		if err := f(wire.NewValueDouble(x)); err != nil {
 			return err
 		}
 	}
@@ -209,14 +495,15 @@ func (v *DefaultsStruct) ToWire() (wire.Value, error) {
 		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("DefaultsStruct is nil")
+	}
+
 	if v.RequiredPrimitive == nil {
 		v.RequiredPrimitive = ptr.Int32(100)
 	}
 	{
-		w, err = wire.NewValueI32(*(v.RequiredPrimitive)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueI32(*(v.RequiredPrimitive))
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
@@ -224,10 +511,7 @@ func (v *DefaultsStruct) ToWire() (wire.Value, error) {
 		v.OptionalPrimitive = ptr.Int32(200)
 	}
 	{
-		w, err = wire.NewValueI32(*(v.OptionalPrimitive)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueI32(*(v.OptionalPrimitive))
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
@@ -260,10 +544,7 @@ func (v *DefaultsStruct) ToWire() (wire.Value, error) {
 		}
 	}
 	{
-		w, err = wire.NewValueList(_List_String_ValueList(v.RequiredList)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueList(_List_String_ValueList(v.RequiredList))
 		fields[i] = wire.Field{ID: 5, Value: w}
 		i++
 	}
@@ -275,23 +556,20 @@ func (v *DefaultsStruct) ToWire() (wire.Value, error) {
 		}
 	}
 	{
-		w, err = wire.NewValueList(_List_Double_ValueList(v.OptionalList)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueList(_List_Double_ValueList(v.OptionalList))
 		fields[i] = wire.Field{ID: 6, Value: w}
 		i++
 	}
 	if v.RequiredStruct == nil {
 		v.RequiredStruct = &Frame{
-			Size: &Size{
-				Height: 200,
-				Width:  100,
-			},
 			TopLeft: &Point{
 				X: 1,
 				Y: 2,
 			},
+			Size: &Size{
+				Width:  100,
+				Height: 200,
+			},
 		}
 	}
 	{
@@ -304,14 +582,14 @@ func (v *DefaultsStruct) ToWire() (wire.Value, error) {
 	}
 	if v.OptionalStruct == nil {
 		v.OptionalStruct = &Edge{
-			EndPoint: &Point{
-				X: 3,
-				Y: 4,
-			},
 			StartPoint: &Point{
 				X: 1,
 				Y: 2,
 			},
+			EndPoint: &Point{
+				X: 3,
+				Y: 4,
+			},
 		}
 	}
 	{
@@ -339,11 +617,7 @@ func _List_String_Read(l wire.ValueList) ([]string, error) {
 
 	o := make([]string, 0, l.Size())
 	err := l.ForEach(func(x wire.Value) error {
-		i, err := x.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
+		o = append(o, x.GetString())
 		return nil
 	})
 	l.Close()
@@ -357,11 +631,7 @@ func _List_Double_Read(l wire.ValueList) ([]float64, error) {
 
 	o := make([]float64, 0, l.Size())
 	err := l.ForEach(func(x wire.Value) error {
-		i, err := x.GetDouble(), error(nil)
-		if err != nil {
-			return err
-		}
-		o = append(o, i)
+		o = append(o, x.GetDouble())
 		return nil
 	})
 	l.Close()
@@ -404,22 +674,14 @@ func (v *DefaultsStruct) FromWire(w wire.Value) error {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
+				x := field.Value.GetI32()
 				v.RequiredPrimitive = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		case 2:
 			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
+				x := field.Value.GetI32()
 				v.OptionalPrimitive = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		case 3:
@@ -510,27 +772,27 @@ func (v *DefaultsStruct) FromWire(w wire.Value) error {
 
 	if v.RequiredStruct == nil {
 		v.RequiredStruct = &Frame{
-			Size: &Size{
-				Height: 200,
-				Width:  100,
-			},
 			TopLeft: &Point{
 				X: 1,
 				Y: 2,
 			},
+			Size: &Size{
+				Width:  100,
+				Height: 200,
+			},
 		}
 	}
 
 	if v.OptionalStruct == nil {
 		v.OptionalStruct = &Edge{
-			EndPoint: &Point{
-				X: 3,
-				Y: 4,
-			},
 			StartPoint: &Point{
 				X: 1,
 				Y: 2,
 			},
+			EndPoint: &Point{
+				X: 3,
+				Y: 4,
+			},
 		}
 	}
 
@@ -665,46 +927,315 @@ func (v *DefaultsStruct) Equals(rhs *DefaultsStruct) bool {
 	return true
 }
 
-// GetRequiredPrimitive returns the value of RequiredPrimitive if it is set or its
-// zero value if it is unset.
-func (v *DefaultsStruct) GetRequiredPrimitive() (o int32) {
-	if v.RequiredPrimitive != nil {
-		return *v.RequiredPrimitive
+func _I32_ClonePtr(p *int32) *int32 {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _EnumDefault_ClonePtr(p *enums.EnumDefault) *enums.EnumDefault {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _List_String_Clone(l []string) []string {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]string, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
+}
+
+func _List_Double_Clone(l []float64) []float64 {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]float64, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
+}
+
+// Clone returns a deep copy of this DefaultsStruct.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *DefaultsStruct) Clone() *DefaultsStruct {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.RequiredPrimitive = _I32_ClonePtr(v.RequiredPrimitive)
+	o.OptionalPrimitive = _I32_ClonePtr(v.OptionalPrimitive)
+	o.RequiredEnum = _EnumDefault_ClonePtr(v.RequiredEnum)
+	o.OptionalEnum = _EnumDefault_ClonePtr(v.OptionalEnum)
+	o.RequiredList = _List_String_Clone(v.RequiredList)
+	o.OptionalList = _List_Double_Clone(v.OptionalList)
+	o.RequiredStruct = v.RequiredStruct.Clone()
+	o.OptionalStruct = v.OptionalStruct.Clone()
+
+	return &o
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return nil
+}
+
+type _List_Double_Zapper []float64
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Double_Zapper.
+func (l _List_Double_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		enc.AppendFloat64(v)
+	}
+	return nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DefaultsStruct.
+func (v *DefaultsStruct) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.RequiredPrimitive != nil {
+		enc.AddInt32("requiredPrimitive", *v.RequiredPrimitive)
+	}
+	if v.OptionalPrimitive != nil {
+		enc.AddInt32("optionalPrimitive", *v.OptionalPrimitive)
+	}
+	if v.RequiredEnum != nil {
+		if err := enc.AddObject("requiredEnum", *v.RequiredEnum); err != nil {
+			return err
+		}
+	}
+	if v.OptionalEnum != nil {
+		if err := enc.AddObject("optionalEnum", *v.OptionalEnum); err != nil {
+			return err
+		}
+	}
+	if v.RequiredList != nil {
+		if err := enc.AddArray("requiredList", (_List_String_Zapper)(v.RequiredList)); err != nil {
+			return err
+		}
+	}
+	if v.OptionalList != nil {
+		if err := enc.AddArray("optionalList", (_List_Double_Zapper)(v.OptionalList)); err != nil {
+			return err
+		}
+	}
+	if v.RequiredStruct != nil {
+		if err := enc.AddObject("requiredStruct", v.RequiredStruct); err != nil {
+			return err
+		}
+	}
+	if v.OptionalStruct != nil {
+		if err := enc.AddObject("optionalStruct", v.OptionalStruct); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetRequiredPrimitive returns the value of RequiredPrimitive if it is set or its
+// default value if it is unset.
+//
+// This is safe to call on a nil DefaultsStruct.
+func (v *DefaultsStruct) GetRequiredPrimitive() (o int32) {
+	if v != nil && v.RequiredPrimitive != nil {
+		return *v.RequiredPrimitive
 	}
 	o = 100
 	return
 }
 
+// IsSetRequiredPrimitive returns true if RequiredPrimitive is not nil.
+//
+// This is safe to call on a nil DefaultsStruct.
+func (v *DefaultsStruct) IsSetRequiredPrimitive() bool {
+	return v != nil && v.RequiredPrimitive != nil
+}
+
 // GetOptionalPrimitive returns the value of OptionalPrimitive if it is set or its
-// zero value if it is unset.
+// default value if it is unset.
+//
+// This is safe to call on a nil DefaultsStruct.
 func (v *DefaultsStruct) GetOptionalPrimitive() (o int32) {
-	if v.OptionalPrimitive != nil {
+	if v != nil && v.OptionalPrimitive != nil {
 		return *v.OptionalPrimitive
 	}
 	o = 200
 	return
 }
 
+// IsSetOptionalPrimitive returns true if OptionalPrimitive is not nil.
+//
+// This is safe to call on a nil DefaultsStruct.
+func (v *DefaultsStruct) IsSetOptionalPrimitive() bool {
+	return v != nil && v.OptionalPrimitive != nil
+}
+
 // GetRequiredEnum returns the value of RequiredEnum if it is set or its
-// zero value if it is unset.
+// default value if it is unset.
+//
+// This is safe to call on a nil DefaultsStruct.
 func (v *DefaultsStruct) GetRequiredEnum() (o enums.EnumDefault) {
-	if v.RequiredEnum != nil {
+	if v != nil && v.RequiredEnum != nil {
 		return *v.RequiredEnum
 	}
 	o = enums.EnumDefaultBar
 	return
 }
 
+// IsSetRequiredEnum returns true if RequiredEnum is not nil.
+//
+// This is safe to call on a nil DefaultsStruct.
+func (v *DefaultsStruct) IsSetRequiredEnum() bool {
+	return v != nil && v.RequiredEnum != nil
+}
+
 // GetOptionalEnum returns the value of OptionalEnum if it is set or its
-// zero value if it is unset.
+// default value if it is unset.
+//
+// This is safe to call on a nil DefaultsStruct.
 func (v *DefaultsStruct) GetOptionalEnum() (o enums.EnumDefault) {
-	if v.OptionalEnum != nil {
+	if v != nil && v.OptionalEnum != nil {
 		return *v.OptionalEnum
 	}
 	o = enums.EnumDefaultBaz
 	return
 }
 
+// IsSetOptionalEnum returns true if OptionalEnum is not nil.
+//
+// This is safe to call on a nil DefaultsStruct.
+func (v *DefaultsStruct) IsSetOptionalEnum() bool {
+	return v != nil && v.OptionalEnum != nil
+}
+
+// GetRequiredList returns the value of RequiredList if it is set or its
+// default value if it is unset.
+//
+// This is safe to call on a nil DefaultsStruct.
+func (v *DefaultsStruct) GetRequiredList() (o []string) {
+	if v != nil && v.RequiredList != nil {
+		return v.RequiredList
+	}
+	o = []string{
+		"hello",
+		"world",
+	}
+	return
+}
+
+// IsSetRequiredList returns true if RequiredList is not nil.
+//
+// This is safe to call on a nil DefaultsStruct.
+func (v *DefaultsStruct) IsSetRequiredList() bool {
+	return v != nil && v.RequiredList != nil
+}
+
+// GetOptionalList returns the value of OptionalList if it is set or its
+// default value if it is unset.
+//
+// This is safe to call on a nil DefaultsStruct.
+func (v *DefaultsStruct) GetOptionalList() (o []float64) {
+	if v != nil && v.OptionalList != nil {
+		return v.OptionalList
+	}
+	o = []float64{
+		1,
+		2,
+		3,
+	}
+	return
+}
+
+// IsSetOptionalList returns true if OptionalList is not nil.
+//
+// This is safe to call on a nil DefaultsStruct.
+func (v *DefaultsStruct) IsSetOptionalList() bool {
+	return v != nil && v.OptionalList != nil
+}
+
+// GetRequiredStruct returns the value of RequiredStruct if it is set or its
+// default value if it is unset.
+//
+// This is safe to call on a nil DefaultsStruct.
+func (v *DefaultsStruct) GetRequiredStruct() (o *Frame) {
+	if v != nil && v.RequiredStruct != nil {
+		return v.RequiredStruct
+	}
+	o = &Frame{
+		TopLeft: &Point{
+			X: 1,
+			Y: 2,
+		},
+		Size: &Size{
+			Width:  100,
+			Height: 200,
+		},
+	}
+	return
+}
+
+// IsSetRequiredStruct returns true if RequiredStruct is not nil.
+//
+// This is safe to call on a nil DefaultsStruct.
+func (v *DefaultsStruct) IsSetRequiredStruct() bool {
+	return v != nil && v.RequiredStruct != nil
+}
+
+// GetOptionalStruct returns the value of OptionalStruct if it is set or its
+// default value if it is unset.
+//
+// This is safe to call on a nil DefaultsStruct.
+func (v *DefaultsStruct) GetOptionalStruct() (o *Edge) {
+	if v != nil && v.OptionalStruct != nil {
+		return v.OptionalStruct
+	}
+	o = &Edge{
+		StartPoint: &Point{
+			X: 1,
+			Y: 2,
+		},
+		EndPoint: &Point{
+			X: 3,
+			Y: 4,
+		},
+	}
+	return
+}
+
+// IsSetOptionalStruct returns true if OptionalStruct is not nil.
+//
+// This is safe to call on a nil DefaultsStruct.
+func (v *DefaultsStruct) IsSetOptionalStruct() bool {
+	return v != nil && v.OptionalStruct != nil
+}
+
 type Edge struct {
 	StartPoint *Point `json:"startPoint,required"`
 	EndPoint   *Point `json:"endPoint,required"`
@@ -733,6 +1264,10 @@ func (v *Edge) ToWire() (wire.Value, error) {
 		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("Edge is nil")
+	}
+
 	if v.StartPoint == nil {
 		return w, errors.New("field StartPoint of Edge is required")
 	}
@@ -848,6 +1383,60 @@ func (v *Edge) Equals(rhs *Edge) bool {
 	return true
 }
 
+// Clone returns a deep copy of this Edge.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Edge) Clone() *Edge {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.StartPoint = v.StartPoint.Clone()
+	o.EndPoint = v.EndPoint.Clone()
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Edge.
+func (v *Edge) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if err := enc.AddObject("startPoint", v.StartPoint); err != nil {
+		return err
+	}
+	if err := enc.AddObject("endPoint", v.EndPoint); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetStartPoint returns the value of StartPoint if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Edge.
+func (v *Edge) GetStartPoint() (o *Point) {
+	if v != nil {
+		o = v.StartPoint
+	}
+	return
+}
+
+// GetEndPoint returns the value of EndPoint if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Edge.
+func (v *Edge) GetEndPoint() (o *Point) {
+	if v != nil {
+		o = v.EndPoint
+	}
+	return
+}
+
 type EmptyStruct struct {
 }
 
@@ -924,6 +1513,30 @@ func (v *EmptyStruct) Equals(rhs *EmptyStruct) bool {
 	return true
 }
 
+// Clone returns a deep copy of this EmptyStruct.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *EmptyStruct) Clone() *EmptyStruct {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of EmptyStruct.
+func (v *EmptyStruct) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	return nil
+}
+
 type Frame struct {
 	TopLeft *Point `json:"topLeft,required"`
 	Size    *Size  `json:"size,required"`
@@ -952,6 +1565,10 @@ func (v *Frame) ToWire() (wire.Value, error) {
 		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("Frame is nil")
+	}
+
 	if v.TopLeft == nil {
 		return w, errors.New("field TopLeft of Frame is required")
 	}
@@ -1067,6 +1684,60 @@ func (v *Frame) Equals(rhs *Frame) bool {
 	return true
 }
 
+// Clone returns a deep copy of this Frame.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Frame) Clone() *Frame {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.TopLeft = v.TopLeft.Clone()
+	o.Size = v.Size.Clone()
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Frame.
+func (v *Frame) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if err := enc.AddObject("topLeft", v.TopLeft); err != nil {
+		return err
+	}
+	if err := enc.AddObject("size", v.Size); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetTopLeft returns the value of TopLeft if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Frame.
+func (v *Frame) GetTopLeft() (o *Point) {
+	if v != nil {
+		o = v.TopLeft
+	}
+	return
+}
+
+// GetSize returns the value of Size if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Frame.
+func (v *Frame) GetSize() (o *Size) {
+	if v != nil {
+		o = v.Size
+	}
+	return
+}
+
 type GoTags struct {
 	Foo                 string  `json:"-" foo:"bar"`
 	Bar                 *string `json:"Bar,omitempty" bar:"foo"`
@@ -1096,50 +1767,35 @@ func (v *GoTags) ToWire() (wire.Value, error) {
 		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
-	w, err = wire.NewValueString(v.Foo), error(nil)
-	if err != nil {
-		return w, err
+	if v == nil {
+		return wire.Value{}, errors.New("GoTags is nil")
 	}
+
+	w = wire.NewValueString(v.Foo)
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 	if v.Bar != nil {
-		w, err = wire.NewValueString(*(v.Bar)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueString(*(v.Bar))
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
 
-	w, err = wire.NewValueString(v.FooBar), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueString(v.FooBar)
 	fields[i] = wire.Field{ID: 3, Value: w}
 	i++
 
-	w, err = wire.NewValueString(v.FooBarWithSpace), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueString(v.FooBarWithSpace)
 	fields[i] = wire.Field{ID: 4, Value: w}
 	i++
 	if v.FooBarWithOmitEmpty != nil {
-		w, err = wire.NewValueString(*(v.FooBarWithOmitEmpty)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueString(*(v.FooBarWithOmitEmpty))
 		fields[i] = wire.Field{ID: 5, Value: w}
 		i++
 	}
 
-	w, err = wire.NewValueString(v.FooBarWithRequired), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueString(v.FooBarWithRequired)
 	fields[i] = wire.Field{ID: 6, Value: w}
 	i++
 
@@ -1164,7 +1820,6 @@ func (v *GoTags) ToWire() (wire.Value, error) {
 //   }
 //   return &v, nil
 func (v *GoTags) FromWire(w wire.Value) error {
-	var err error
 
 	FooIsSet := false
 
@@ -1177,54 +1832,34 @@ func (v *GoTags) FromWire(w wire.Value) error {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TBinary {
-				v.Foo, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.Foo = field.Value.GetString()
 				FooIsSet = true
 			}
 		case 2:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
+				x := field.Value.GetString()
 				v.Bar = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		case 3:
 			if field.Value.Type() == wire.TBinary {
-				v.FooBar, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.FooBar = field.Value.GetString()
 				FooBarIsSet = true
 			}
 		case 4:
 			if field.Value.Type() == wire.TBinary {
-				v.FooBarWithSpace, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.FooBarWithSpace = field.Value.GetString()
 				FooBarWithSpaceIsSet = true
 			}
 		case 5:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
+				x := field.Value.GetString()
 				v.FooBarWithOmitEmpty = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		case 6:
 			if field.Value.Type() == wire.TBinary {
-				v.FooBarWithRequired, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.FooBarWithRequired = field.Value.GetString()
 				FooBarWithRequiredIsSet = true
 			}
 		}
@@ -1315,26 +1950,133 @@ func (v *GoTags) Equals(rhs *GoTags) bool {
 	return true
 }
 
-// GetBar returns the value of Bar if it is set or its
-// zero value if it is unset.
-func (v *GoTags) GetBar() (o string) {
-	if v.Bar != nil {
-		return *v.Bar
+func _String_ClonePtr(p *string) *string {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this GoTags.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *GoTags) Clone() *GoTags {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Bar = _String_ClonePtr(v.Bar)
+	o.FooBarWithOmitEmpty = _String_ClonePtr(v.FooBarWithOmitEmpty)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of GoTags.
+func (v *GoTags) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("Foo", v.Foo)
+	if v.Bar != nil {
+		enc.AddString("Bar", *v.Bar)
+	}
+	enc.AddString("FooBar", v.FooBar)
+	enc.AddString("FooBarWithSpace", v.FooBarWithSpace)
+	if v.FooBarWithOmitEmpty != nil {
+		enc.AddString("FooBarWithOmitEmpty", *v.FooBarWithOmitEmpty)
+	}
+	enc.AddString("FooBarWithRequired", v.FooBarWithRequired)
+	return nil
+}
+
+// GetFoo returns the value of Foo if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil GoTags.
+func (v *GoTags) GetFoo() (o string) {
+	if v != nil {
+		o = v.Foo
+	}
+	return
+}
+
+// GetBar returns the value of Bar if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil GoTags.
+func (v *GoTags) GetBar() (o string) {
+	if v != nil && v.Bar != nil {
+		return *v.Bar
+	}
+
+	return
+}
+
+// IsSetBar returns true if Bar is not nil.
+//
+// This is safe to call on a nil GoTags.
+func (v *GoTags) IsSetBar() bool {
+	return v != nil && v.Bar != nil
+}
+
+// GetFooBar returns the value of FooBar if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil GoTags.
+func (v *GoTags) GetFooBar() (o string) {
+	if v != nil {
+		o = v.FooBar
 	}
+	return
+}
 
+// GetFooBarWithSpace returns the value of FooBarWithSpace if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil GoTags.
+func (v *GoTags) GetFooBarWithSpace() (o string) {
+	if v != nil {
+		o = v.FooBarWithSpace
+	}
 	return
 }
 
 // GetFooBarWithOmitEmpty returns the value of FooBarWithOmitEmpty if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil GoTags.
 func (v *GoTags) GetFooBarWithOmitEmpty() (o string) {
-	if v.FooBarWithOmitEmpty != nil {
+	if v != nil && v.FooBarWithOmitEmpty != nil {
 		return *v.FooBarWithOmitEmpty
 	}
 
 	return
 }
 
+// IsSetFooBarWithOmitEmpty returns true if FooBarWithOmitEmpty is not nil.
+//
+// This is safe to call on a nil GoTags.
+func (v *GoTags) IsSetFooBarWithOmitEmpty() bool {
+	return v != nil && v.FooBarWithOmitEmpty != nil
+}
+
+// GetFooBarWithRequired returns the value of FooBarWithRequired if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil GoTags.
+func (v *GoTags) GetFooBarWithRequired() (o string) {
+	if v != nil {
+		o = v.FooBarWithRequired
+	}
+	return
+}
+
 // A graph is comprised of zero or more edges.
 type Graph struct {
 	// List of edges in the graph.
@@ -1392,16 +2134,16 @@ func (v *Graph) ToWire() (wire.Value, error) {
 		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("Graph is nil")
+	}
+
 	if v.Edges == nil {
 		return w, errors.New("field Edges of Graph is required")
 	}
-	w, err = wire.NewValueList(_List_Edge_ValueList(v.Edges)), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueList(_List_Edge_ValueList(v.Edges))
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 
@@ -1510,6 +2252,70 @@ func (v *Graph) Equals(rhs *Graph) bool {
 	return true
 }
 
+func _List_Edge_Clone(l []*Edge) []*Edge {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]*Edge, len(l))
+	for i, x := range l {
+		o[i] = x.Clone()
+	}
+	return o
+}
+
+// Clone returns a deep copy of this Graph.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Graph) Clone() *Graph {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Edges = _List_Edge_Clone(v.Edges)
+
+	return &o
+}
+
+type _List_Edge_Zapper []*Edge
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Edge_Zapper.
+func (l _List_Edge_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		if err := enc.AppendObject(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Graph.
+func (v *Graph) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if err := enc.AddArray("edges", (_List_Edge_Zapper)(v.Edges)); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetEdges returns the value of Edges if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Graph.
+func (v *Graph) GetEdges() (o []*Edge) {
+	if v != nil {
+		o = v.Edges
+	}
+	return
+}
+
 type List Node
 
 // ToWire translates List into a Thrift-level intermediate
@@ -1539,6 +2345,18 @@ func (lhs *List) Equals(rhs *List) bool {
 	return (*Node)(lhs).Equals((*Node)(rhs))
 }
 
+// Clone returns a deep copy of this List.
+func (v *List) Clone() *List {
+	return (*List)((*Node)(v).Clone())
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of List.
+func (v *List) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	x := (*Node)(v)
+	return x.MarshalLogObject(enc)
+}
+
 // Node is linked list of values.
 // All values are 32-bit integers.
 type Node struct {
@@ -1569,10 +2387,11 @@ func (v *Node) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	w, err = wire.NewValueI32(v.Value), error(nil)
-	if err != nil {
-		return w, err
+	if v == nil {
+		return wire.Value{}, errors.New("Node is nil")
 	}
+
+	w = wire.NewValueI32(v.Value)
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 	if v.Tail != nil {
@@ -1619,10 +2438,7 @@ func (v *Node) FromWire(w wire.Value) error {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TI32 {
-				v.Value, err = field.Value.GetI32(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.Value = field.Value.GetI32()
 				valueIsSet = true
 			}
 		case 2:
@@ -1677,6 +2493,67 @@ func (v *Node) Equals(rhs *Node) bool {
 	return true
 }
 
+// Clone returns a deep copy of this Node.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Node) Clone() *Node {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Tail = v.Tail.Clone()
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Node.
+func (v *Node) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddInt32("value", v.Value)
+	if v.Tail != nil {
+		if err := enc.AddObject("tail", v.Tail); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetValue returns the value of Value if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Node.
+func (v *Node) GetValue() (o int32) {
+	if v != nil {
+		o = v.Value
+	}
+	return
+}
+
+// GetTail returns the value of Tail if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Node.
+func (v *Node) GetTail() (o *List) {
+	if v != nil && v.Tail != nil {
+		return v.Tail
+	}
+
+	return
+}
+
+// IsSetTail returns true if Tail is not nil.
+//
+// This is safe to call on a nil Node.
+func (v *Node) IsSetTail() bool {
+	return v != nil && v.Tail != nil
+}
+
 type Omit struct {
 	Serialized string `json:"serialized,required"`
 	Hidden     string `json:"-"`
@@ -1702,20 +2579,17 @@ func (v *Omit) ToWire() (wire.Value, error) {
 		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
-	w, err = wire.NewValueString(v.Serialized), error(nil)
-	if err != nil {
-		return w, err
+	if v == nil {
+		return wire.Value{}, errors.New("Omit is nil")
 	}
+
+	w = wire.NewValueString(v.Serialized)
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 
-	w, err = wire.NewValueString(v.Hidden), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueString(v.Hidden)
 	fields[i] = wire.Field{ID: 2, Value: w}
 	i++
 
@@ -1740,7 +2614,6 @@ func (v *Omit) ToWire() (wire.Value, error) {
 //   }
 //   return &v, nil
 func (v *Omit) FromWire(w wire.Value) error {
-	var err error
 
 	serializedIsSet := false
 	hiddenIsSet := false
@@ -1749,18 +2622,12 @@ func (v *Omit) FromWire(w wire.Value) error {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TBinary {
-				v.Serialized, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.Serialized = field.Value.GetString()
 				serializedIsSet = true
 			}
 		case 2:
 			if field.Value.Type() == wire.TBinary {
-				v.Hidden, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.Hidden = field.Value.GetString()
 				hiddenIsSet = true
 			}
 		}
@@ -1809,6 +2676,54 @@ func (v *Omit) Equals(rhs *Omit) bool {
 	return true
 }
 
+// Clone returns a deep copy of this Omit.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Omit) Clone() *Omit {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Omit.
+func (v *Omit) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("serialized", v.Serialized)
+	enc.AddString("hidden", v.Hidden)
+	return nil
+}
+
+// GetSerialized returns the value of Serialized if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Omit.
+func (v *Omit) GetSerialized() (o string) {
+	if v != nil {
+		o = v.Serialized
+	}
+	return
+}
+
+// GetHidden returns the value of Hidden if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Omit.
+func (v *Omit) GetHidden() (o string) {
+	if v != nil {
+		o = v.Hidden
+	}
+	return
+}
+
 // A point in 2D space.
 type Point struct {
 	X float64 `json:"x,required"`
@@ -1835,20 +2750,17 @@ func (v *Point) ToWire() (wire.Value, error) {
 		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
-	w, err = wire.NewValueDouble(v.X), error(nil)
-	if err != nil {
-		return w, err
+	if v == nil {
+		return wire.Value{}, errors.New("Point is nil")
 	}
+
+	w = wire.NewValueDouble(v.X)
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 
-	w, err = wire.NewValueDouble(v.Y), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueDouble(v.Y)
 	fields[i] = wire.Field{ID: 2, Value: w}
 	i++
 
@@ -1873,7 +2785,6 @@ func (v *Point) ToWire() (wire.Value, error) {
 //   }
 //   return &v, nil
 func (v *Point) FromWire(w wire.Value) error {
-	var err error
 
 	xIsSet := false
 	yIsSet := false
@@ -1882,18 +2793,12 @@ func (v *Point) FromWire(w wire.Value) error {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TDouble {
-				v.X, err = field.Value.GetDouble(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.X = field.Value.GetDouble()
 				xIsSet = true
 			}
 		case 2:
 			if field.Value.Type() == wire.TDouble {
-				v.Y, err = field.Value.GetDouble(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.Y = field.Value.GetDouble()
 				yIsSet = true
 			}
 		}
@@ -1942,6 +2847,54 @@ func (v *Point) Equals(rhs *Point) bool {
 	return true
 }
 
+// Clone returns a deep copy of this Point.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Point) Clone() *Point {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Point.
+func (v *Point) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddFloat64("x", v.X)
+	enc.AddFloat64("y", v.Y)
+	return nil
+}
+
+// GetX returns the value of X if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Point.
+func (v *Point) GetX() (o float64) {
+	if v != nil {
+		o = v.X
+	}
+	return
+}
+
+// GetY returns the value of Y if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Point.
+func (v *Point) GetY() (o float64) {
+	if v != nil {
+		o = v.Y
+	}
+	return
+}
+
 // A struct that contains primitive fields exclusively.
 //
 // All fields are optional.
@@ -1976,70 +2929,49 @@ func (v *PrimitiveOptionalStruct) ToWire() (wire.Value, error) {
 		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("PrimitiveOptionalStruct is nil")
+	}
+
 	if v.BoolField != nil {
-		w, err = wire.NewValueBool(*(v.BoolField)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueBool(*(v.BoolField))
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
 	if v.ByteField != nil {
-		w, err = wire.NewValueI8(*(v.ByteField)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueI8(*(v.ByteField))
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
 	if v.Int16Field != nil {
-		w, err = wire.NewValueI16(*(v.Int16Field)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueI16(*(v.Int16Field))
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
 	if v.Int32Field != nil {
-		w, err = wire.NewValueI32(*(v.Int32Field)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueI32(*(v.Int32Field))
 		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
 	if v.Int64Field != nil {
-		w, err = wire.NewValueI64(*(v.Int64Field)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueI64(*(v.Int64Field))
 		fields[i] = wire.Field{ID: 5, Value: w}
 		i++
 	}
 	if v.DoubleField != nil {
-		w, err = wire.NewValueDouble(*(v.DoubleField)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueDouble(*(v.DoubleField))
 		fields[i] = wire.Field{ID: 6, Value: w}
 		i++
 	}
 	if v.StringField != nil {
-		w, err = wire.NewValueString(*(v.StringField)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueString(*(v.StringField))
 		fields[i] = wire.Field{ID: 7, Value: w}
 		i++
 	}
 	if v.BinaryField != nil {
-		w, err = wire.NewValueBinary(v.BinaryField), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueBinary(v.BinaryField)
 		fields[i] = wire.Field{ID: 8, Value: w}
 		i++
 	}
@@ -2065,86 +2997,54 @@ func (v *PrimitiveOptionalStruct) ToWire() (wire.Value, error) {
 //   }
 //   return &v, nil
 func (v *PrimitiveOptionalStruct) FromWire(w wire.Value) error {
-	var err error
 
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
+				x := field.Value.GetBool()
 				v.BoolField = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		case 2:
 			if field.Value.Type() == wire.TI8 {
-				var x int8
-				x, err = field.Value.GetI8(), error(nil)
+				x := field.Value.GetI8()
 				v.ByteField = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		case 3:
 			if field.Value.Type() == wire.TI16 {
-				var x int16
-				x, err = field.Value.GetI16(), error(nil)
+				x := field.Value.GetI16()
 				v.Int16Field = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		case 4:
 			if field.Value.Type() == wire.TI32 {
-				var x int32
-				x, err = field.Value.GetI32(), error(nil)
+				x := field.Value.GetI32()
 				v.Int32Field = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		case 5:
 			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
+				x := field.Value.GetI64()
 				v.Int64Field = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		case 6:
 			if field.Value.Type() == wire.TDouble {
-				var x float64
-				x, err = field.Value.GetDouble(), error(nil)
+				x := field.Value.GetDouble()
 				v.DoubleField = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		case 7:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
+				x := field.Value.GetString()
 				v.StringField = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		case 8:
 			if field.Value.Type() == wire.TBinary {
-				v.BinaryField, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.BinaryField = field.Value.GetBinary()
 
 			}
 		}
@@ -2281,76 +3181,259 @@ func (v *PrimitiveOptionalStruct) Equals(rhs *PrimitiveOptionalStruct) bool {
 	return true
 }
 
+func _Bool_ClonePtr(p *bool) *bool {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _Byte_ClonePtr(p *int8) *int8 {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _I16_ClonePtr(p *int16) *int16 {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _I64_ClonePtr(p *int64) *int64 {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _Double_ClonePtr(p *float64) *float64 {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this PrimitiveOptionalStruct.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *PrimitiveOptionalStruct) Clone() *PrimitiveOptionalStruct {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.BoolField = _Bool_ClonePtr(v.BoolField)
+	o.ByteField = _Byte_ClonePtr(v.ByteField)
+	o.Int16Field = _I16_ClonePtr(v.Int16Field)
+	o.Int32Field = _I32_ClonePtr(v.Int32Field)
+	o.Int64Field = _I64_ClonePtr(v.Int64Field)
+	o.DoubleField = _Double_ClonePtr(v.DoubleField)
+	o.StringField = _String_ClonePtr(v.StringField)
+	o.BinaryField = _Binary_Clone(v.BinaryField)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PrimitiveOptionalStruct.
+func (v *PrimitiveOptionalStruct) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.BoolField != nil {
+		enc.AddBool("boolField", *v.BoolField)
+	}
+	if v.ByteField != nil {
+		enc.AddInt8("byteField", *v.ByteField)
+	}
+	if v.Int16Field != nil {
+		enc.AddInt16("int16Field", *v.Int16Field)
+	}
+	if v.Int32Field != nil {
+		enc.AddInt32("int32Field", *v.Int32Field)
+	}
+	if v.Int64Field != nil {
+		enc.AddInt64("int64Field", *v.Int64Field)
+	}
+	if v.DoubleField != nil {
+		enc.AddFloat64("doubleField", *v.DoubleField)
+	}
+	if v.StringField != nil {
+		enc.AddString("stringField", *v.StringField)
+	}
+	if v.BinaryField != nil {
+		enc.AddString("binaryField", base64.StdEncoding.EncodeToString(v.BinaryField))
+	}
+	return nil
+}
+
 // GetBoolField returns the value of BoolField if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveOptionalStruct.
 func (v *PrimitiveOptionalStruct) GetBoolField() (o bool) {
-	if v.BoolField != nil {
+	if v != nil && v.BoolField != nil {
 		return *v.BoolField
 	}
 
 	return
 }
 
+// IsSetBoolField returns true if BoolField is not nil.
+//
+// This is safe to call on a nil PrimitiveOptionalStruct.
+func (v *PrimitiveOptionalStruct) IsSetBoolField() bool {
+	return v != nil && v.BoolField != nil
+}
+
 // GetByteField returns the value of ByteField if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveOptionalStruct.
 func (v *PrimitiveOptionalStruct) GetByteField() (o int8) {
-	if v.ByteField != nil {
+	if v != nil && v.ByteField != nil {
 		return *v.ByteField
 	}
 
 	return
 }
 
+// IsSetByteField returns true if ByteField is not nil.
+//
+// This is safe to call on a nil PrimitiveOptionalStruct.
+func (v *PrimitiveOptionalStruct) IsSetByteField() bool {
+	return v != nil && v.ByteField != nil
+}
+
 // GetInt16Field returns the value of Int16Field if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveOptionalStruct.
 func (v *PrimitiveOptionalStruct) GetInt16Field() (o int16) {
-	if v.Int16Field != nil {
+	if v != nil && v.Int16Field != nil {
 		return *v.Int16Field
 	}
 
 	return
 }
 
+// IsSetInt16Field returns true if Int16Field is not nil.
+//
+// This is safe to call on a nil PrimitiveOptionalStruct.
+func (v *PrimitiveOptionalStruct) IsSetInt16Field() bool {
+	return v != nil && v.Int16Field != nil
+}
+
 // GetInt32Field returns the value of Int32Field if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveOptionalStruct.
 func (v *PrimitiveOptionalStruct) GetInt32Field() (o int32) {
-	if v.Int32Field != nil {
+	if v != nil && v.Int32Field != nil {
 		return *v.Int32Field
 	}
 
 	return
 }
 
+// IsSetInt32Field returns true if Int32Field is not nil.
+//
+// This is safe to call on a nil PrimitiveOptionalStruct.
+func (v *PrimitiveOptionalStruct) IsSetInt32Field() bool {
+	return v != nil && v.Int32Field != nil
+}
+
 // GetInt64Field returns the value of Int64Field if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveOptionalStruct.
 func (v *PrimitiveOptionalStruct) GetInt64Field() (o int64) {
-	if v.Int64Field != nil {
+	if v != nil && v.Int64Field != nil {
 		return *v.Int64Field
 	}
 
 	return
 }
 
+// IsSetInt64Field returns true if Int64Field is not nil.
+//
+// This is safe to call on a nil PrimitiveOptionalStruct.
+func (v *PrimitiveOptionalStruct) IsSetInt64Field() bool {
+	return v != nil && v.Int64Field != nil
+}
+
 // GetDoubleField returns the value of DoubleField if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveOptionalStruct.
 func (v *PrimitiveOptionalStruct) GetDoubleField() (o float64) {
-	if v.DoubleField != nil {
+	if v != nil && v.DoubleField != nil {
 		return *v.DoubleField
 	}
 
 	return
 }
 
+// IsSetDoubleField returns true if DoubleField is not nil.
+//
+// This is safe to call on a nil PrimitiveOptionalStruct.
+func (v *PrimitiveOptionalStruct) IsSetDoubleField() bool {
+	return v != nil && v.DoubleField != nil
+}
+
 // GetStringField returns the value of StringField if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveOptionalStruct.
 func (v *PrimitiveOptionalStruct) GetStringField() (o string) {
-	if v.StringField != nil {
+	if v != nil && v.StringField != nil {
 		return *v.StringField
 	}
 
 	return
 }
 
+// IsSetStringField returns true if StringField is not nil.
+//
+// This is safe to call on a nil PrimitiveOptionalStruct.
+func (v *PrimitiveOptionalStruct) IsSetStringField() bool {
+	return v != nil && v.StringField != nil
+}
+
+// GetBinaryField returns the value of BinaryField if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveOptionalStruct.
+func (v *PrimitiveOptionalStruct) GetBinaryField() (o []byte) {
+	if v != nil && v.BinaryField != nil {
+		return v.BinaryField
+	}
+
+	return
+}
+
+// IsSetBinaryField returns true if BinaryField is not nil.
+//
+// This is safe to call on a nil PrimitiveOptionalStruct.
+func (v *PrimitiveOptionalStruct) IsSetBinaryField() bool {
+	return v != nil && v.BinaryField != nil
+}
+
 // A struct that contains primitive fields exclusively.
 //
 // All fields are required.
@@ -2385,64 +3468,43 @@ func (v *PrimitiveRequiredStruct) ToWire() (wire.Value, error) {
 		fields [8]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
-	w, err = wire.NewValueBool(v.BoolField), error(nil)
-	if err != nil {
-		return w, err
+	if v == nil {
+		return wire.Value{}, errors.New("PrimitiveRequiredStruct is nil")
 	}
+
+	w = wire.NewValueBool(v.BoolField)
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 
-	w, err = wire.NewValueI8(v.ByteField), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueI8(v.ByteField)
 	fields[i] = wire.Field{ID: 2, Value: w}
 	i++
 
-	w, err = wire.NewValueI16(v.Int16Field), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueI16(v.Int16Field)
 	fields[i] = wire.Field{ID: 3, Value: w}
 	i++
 
-	w, err = wire.NewValueI32(v.Int32Field), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueI32(v.Int32Field)
 	fields[i] = wire.Field{ID: 4, Value: w}
 	i++
 
-	w, err = wire.NewValueI64(v.Int64Field), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueI64(v.Int64Field)
 	fields[i] = wire.Field{ID: 5, Value: w}
 	i++
 
-	w, err = wire.NewValueDouble(v.DoubleField), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueDouble(v.DoubleField)
 	fields[i] = wire.Field{ID: 6, Value: w}
 	i++
 
-	w, err = wire.NewValueString(v.StringField), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueString(v.StringField)
 	fields[i] = wire.Field{ID: 7, Value: w}
 	i++
 	if v.BinaryField == nil {
 		return w, errors.New("field BinaryField of PrimitiveRequiredStruct is required")
 	}
-	w, err = wire.NewValueBinary(v.BinaryField), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueBinary(v.BinaryField)
 	fields[i] = wire.Field{ID: 8, Value: w}
 	i++
 
@@ -2467,7 +3529,6 @@ func (v *PrimitiveRequiredStruct) ToWire() (wire.Value, error) {
 //   }
 //   return &v, nil
 func (v *PrimitiveRequiredStruct) FromWire(w wire.Value) error {
-	var err error
 
 	boolFieldIsSet := false
 	byteFieldIsSet := false
@@ -2482,66 +3543,42 @@ func (v *PrimitiveRequiredStruct) FromWire(w wire.Value) error {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TBool {
-				v.BoolField, err = field.Value.GetBool(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.BoolField = field.Value.GetBool()
 				boolFieldIsSet = true
 			}
 		case 2:
 			if field.Value.Type() == wire.TI8 {
-				v.ByteField, err = field.Value.GetI8(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.ByteField = field.Value.GetI8()
 				byteFieldIsSet = true
 			}
 		case 3:
 			if field.Value.Type() == wire.TI16 {
-				v.Int16Field, err = field.Value.GetI16(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.Int16Field = field.Value.GetI16()
 				int16FieldIsSet = true
 			}
 		case 4:
 			if field.Value.Type() == wire.TI32 {
-				v.Int32Field, err = field.Value.GetI32(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.Int32Field = field.Value.GetI32()
 				int32FieldIsSet = true
 			}
 		case 5:
 			if field.Value.Type() == wire.TI64 {
-				v.Int64Field, err = field.Value.GetI64(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.Int64Field = field.Value.GetI64()
 				int64FieldIsSet = true
 			}
 		case 6:
 			if field.Value.Type() == wire.TDouble {
-				v.DoubleField, err = field.Value.GetDouble(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.DoubleField = field.Value.GetDouble()
 				doubleFieldIsSet = true
 			}
 		case 7:
 			if field.Value.Type() == wire.TBinary {
-				v.StringField, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.StringField = field.Value.GetString()
 				stringFieldIsSet = true
 			}
 		case 8:
 			if field.Value.Type() == wire.TBinary {
-				v.BinaryField, err = field.Value.GetBinary(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.BinaryField = field.Value.GetBinary()
 				binaryFieldIsSet = true
 			}
 		}
@@ -2644,6 +3681,127 @@ func (v *PrimitiveRequiredStruct) Equals(rhs *PrimitiveRequiredStruct) bool {
 	return true
 }
 
+// Clone returns a deep copy of this PrimitiveRequiredStruct.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *PrimitiveRequiredStruct) Clone() *PrimitiveRequiredStruct {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.BinaryField = _Binary_Clone(v.BinaryField)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of PrimitiveRequiredStruct.
+func (v *PrimitiveRequiredStruct) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddBool("boolField", v.BoolField)
+	enc.AddInt8("byteField", v.ByteField)
+	enc.AddInt16("int16Field", v.Int16Field)
+	enc.AddInt32("int32Field", v.Int32Field)
+	enc.AddInt64("int64Field", v.Int64Field)
+	enc.AddFloat64("doubleField", v.DoubleField)
+	enc.AddString("stringField", v.StringField)
+	enc.AddString("binaryField", base64.StdEncoding.EncodeToString(v.BinaryField))
+	return nil
+}
+
+// GetBoolField returns the value of BoolField if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveRequiredStruct.
+func (v *PrimitiveRequiredStruct) GetBoolField() (o bool) {
+	if v != nil {
+		o = v.BoolField
+	}
+	return
+}
+
+// GetByteField returns the value of ByteField if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveRequiredStruct.
+func (v *PrimitiveRequiredStruct) GetByteField() (o int8) {
+	if v != nil {
+		o = v.ByteField
+	}
+	return
+}
+
+// GetInt16Field returns the value of Int16Field if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveRequiredStruct.
+func (v *PrimitiveRequiredStruct) GetInt16Field() (o int16) {
+	if v != nil {
+		o = v.Int16Field
+	}
+	return
+}
+
+// GetInt32Field returns the value of Int32Field if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveRequiredStruct.
+func (v *PrimitiveRequiredStruct) GetInt32Field() (o int32) {
+	if v != nil {
+		o = v.Int32Field
+	}
+	return
+}
+
+// GetInt64Field returns the value of Int64Field if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveRequiredStruct.
+func (v *PrimitiveRequiredStruct) GetInt64Field() (o int64) {
+	if v != nil {
+		o = v.Int64Field
+	}
+	return
+}
+
+// GetDoubleField returns the value of DoubleField if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveRequiredStruct.
+func (v *PrimitiveRequiredStruct) GetDoubleField() (o float64) {
+	if v != nil {
+		o = v.DoubleField
+	}
+	return
+}
+
+// GetStringField returns the value of StringField if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveRequiredStruct.
+func (v *PrimitiveRequiredStruct) GetStringField() (o string) {
+	if v != nil {
+		o = v.StringField
+	}
+	return
+}
+
+// GetBinaryField returns the value of BinaryField if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil PrimitiveRequiredStruct.
+func (v *PrimitiveRequiredStruct) GetBinaryField() (o []byte) {
+	if v != nil {
+		o = v.BinaryField
+	}
+	return
+}
+
 type Rename struct {
 	Default   string `json:"default,required"`
 	CamelCase string `json:"snake_case,required"`
@@ -2669,20 +3827,17 @@ func (v *Rename) ToWire() (wire.Value, error) {
 		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
-	w, err = wire.NewValueString(v.Default), error(nil)
-	if err != nil {
-		return w, err
+	if v == nil {
+		return wire.Value{}, errors.New("Rename is nil")
 	}
+
+	w = wire.NewValueString(v.Default)
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 
-	w, err = wire.NewValueString(v.CamelCase), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueString(v.CamelCase)
 	fields[i] = wire.Field{ID: 2, Value: w}
 	i++
 
@@ -2707,7 +3862,6 @@ func (v *Rename) ToWire() (wire.Value, error) {
 //   }
 //   return &v, nil
 func (v *Rename) FromWire(w wire.Value) error {
-	var err error
 
 	DefaultIsSet := false
 	camelCaseIsSet := false
@@ -2716,18 +3870,12 @@ func (v *Rename) FromWire(w wire.Value) error {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TBinary {
-				v.Default, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.Default = field.Value.GetString()
 				DefaultIsSet = true
 			}
 		case 2:
 			if field.Value.Type() == wire.TBinary {
-				v.CamelCase, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.CamelCase = field.Value.GetString()
 				camelCaseIsSet = true
 			}
 		}
@@ -2776,6 +3924,258 @@ func (v *Rename) Equals(rhs *Rename) bool {
 	return true
 }
 
+// Clone returns a deep copy of this Rename.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Rename) Clone() *Rename {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Rename.
+func (v *Rename) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("Default", v.Default)
+	enc.AddString("camelCase", v.CamelCase)
+	return nil
+}
+
+// GetDefault returns the value of Default if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Rename.
+func (v *Rename) GetDefault() (o string) {
+	if v != nil {
+		o = v.Default
+	}
+	return
+}
+
+// GetCamelCase returns the value of CamelCase if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Rename.
+func (v *Rename) GetCamelCase() (o string) {
+	if v != nil {
+		o = v.CamelCase
+	}
+	return
+}
+
+type Renamed struct {
+	Username string `json:"userName,required"`
+	Total    *int32 `json:"count,omitempty"`
+}
+
+// ToWire translates a Renamed struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Renamed) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Renamed is nil")
+	}
+
+	w = wire.NewValueString(v.Username)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Total != nil {
+		w = wire.NewValueI32(*(v.Total))
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Renamed struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Renamed struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Renamed
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Renamed) FromWire(w wire.Value) error {
+
+	userNameIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Username = field.Value.GetString()
+				userNameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				x := field.Value.GetI32()
+				v.Total = &x
+
+			}
+		}
+	}
+
+	if !userNameIsSet {
+		return errors.New("field Username of Renamed is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Renamed
+// struct.
+func (v *Renamed) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Username: %v", v.Username)
+	i++
+	if v.Total != nil {
+		fields[i] = fmt.Sprintf("Total: %v", *(v.Total))
+		i++
+	}
+
+	return fmt.Sprintf("Renamed{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Renamed match the
+// provided Renamed.
+//
+// This function performs a deep comparison.
+func (v *Renamed) Equals(rhs *Renamed) bool {
+	if !(v.Username == rhs.Username) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Total, rhs.Total) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this Renamed.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Renamed) Clone() *Renamed {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Total = _I32_ClonePtr(v.Total)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Renamed.
+func (v *Renamed) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("userName", v.Username)
+	if v.Total != nil {
+		enc.AddInt32("count", *v.Total)
+	}
+	return nil
+}
+
+// GetUsername returns the value of Username if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Renamed.
+func (v *Renamed) GetUsername() (o string) {
+	if v != nil {
+		o = v.Username
+	}
+	return
+}
+
+// GetUserName returns the value of Username.
+//
+// Deprecated: Username was renamed from UserName. Use GetUsername
+// instead.
+func (v *Renamed) GetUserName() string {
+	return v.GetUsername()
+}
+
+// GetTotal returns the value of Total if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Renamed.
+func (v *Renamed) GetTotal() (o int32) {
+	if v != nil && v.Total != nil {
+		return *v.Total
+	}
+
+	return
+}
+
+// IsSetTotal returns true if Total is not nil.
+//
+// This is safe to call on a nil Renamed.
+func (v *Renamed) IsSetTotal() bool {
+	return v != nil && v.Total != nil
+}
+
+// GetCount returns the value of Total.
+//
+// Deprecated: Total was renamed from Count. Use GetTotal
+// instead.
+func (v *Renamed) GetCount() int32 {
+	return v.GetTotal()
+}
+
+// IsSetCount returns true if Total is set.
+//
+// Deprecated: Total was renamed from Count. Use IsSetTotal
+// instead.
+func (v *Renamed) IsSetCount() bool {
+	return v.IsSetTotal()
+}
+
 // Size of something.
 type Size struct {
 	// Width in pixels.
@@ -2804,20 +4204,17 @@ func (v *Size) ToWire() (wire.Value, error) {
 		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
-	w, err = wire.NewValueDouble(v.Width), error(nil)
-	if err != nil {
-		return w, err
+	if v == nil {
+		return wire.Value{}, errors.New("Size is nil")
 	}
+
+	w = wire.NewValueDouble(v.Width)
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 
-	w, err = wire.NewValueDouble(v.Height), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueDouble(v.Height)
 	fields[i] = wire.Field{ID: 2, Value: w}
 	i++
 
@@ -2842,7 +4239,6 @@ func (v *Size) ToWire() (wire.Value, error) {
 //   }
 //   return &v, nil
 func (v *Size) FromWire(w wire.Value) error {
-	var err error
 
 	widthIsSet := false
 	heightIsSet := false
@@ -2851,18 +4247,12 @@ func (v *Size) FromWire(w wire.Value) error {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TDouble {
-				v.Width, err = field.Value.GetDouble(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.Width = field.Value.GetDouble()
 				widthIsSet = true
 			}
 		case 2:
 			if field.Value.Type() == wire.TDouble {
-				v.Height, err = field.Value.GetDouble(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.Height = field.Value.GetDouble()
 				heightIsSet = true
 			}
 		}
@@ -2911,6 +4301,54 @@ func (v *Size) Equals(rhs *Size) bool {
 	return true
 }
 
+// Clone returns a deep copy of this Size.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Size) Clone() *Size {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Size.
+func (v *Size) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddFloat64("width", v.Width)
+	enc.AddFloat64("height", v.Height)
+	return nil
+}
+
+// GetWidth returns the value of Width if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Size.
+func (v *Size) GetWidth() (o float64) {
+	if v != nil {
+		o = v.Width
+	}
+	return
+}
+
+// GetHeight returns the value of Height if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Size.
+func (v *Size) GetHeight() (o float64) {
+	if v != nil {
+		o = v.Height
+	}
+	return
+}
+
 type User struct {
 	Name    string       `json:"name,required"`
 	Contact *ContactInfo `json:"contact,omitempty"`
@@ -2939,10 +4377,11 @@ func (v *User) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	w, err = wire.NewValueString(v.Name), error(nil)
-	if err != nil {
-		return w, err
+	if v == nil {
+		return wire.Value{}, errors.New("User is nil")
 	}
+
+	w = wire.NewValueString(v.Name)
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 	if v.Contact != nil {
@@ -2989,10 +4428,7 @@ func (v *User) FromWire(w wire.Value) error {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TBinary {
-				v.Name, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.Name = field.Value.GetString()
 				nameIsSet = true
 			}
 		case 2:
@@ -3046,3 +4482,64 @@ func (v *User) Equals(rhs *User) bool {
 
 	return true
 }
+
+// Clone returns a deep copy of this User.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *User) Clone() *User {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Contact = v.Contact.Clone()
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of User.
+func (v *User) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("name", v.Name)
+	if v.Contact != nil {
+		if err := enc.AddObject("contact", v.Contact); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil User.
+func (v *User) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetContact returns the value of Contact if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil User.
+func (v *User) GetContact() (o *ContactInfo) {
+	if v != nil && v.Contact != nil {
+		return v.Contact
+	}
+
+	return
+}
+
+// IsSetContact returns true if Contact is not nil.
+//
+// This is safe to call on a nil User.
+func (v *User) IsSetContact() bool {
+	return v != nil && v.Contact != nil
+}
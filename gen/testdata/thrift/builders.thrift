@@ -0,0 +1,16 @@
+// Code for this file is generated with --generate-builders --builder-threshold=2.
+
+// Point has too few fields for a builder.
+struct Point {
+    1: required double x
+    2: required double y
+}
+
+struct Shape {
+    1: required string name
+    2: required Point origin
+    3: optional i32 sides = 4
+    4: optional list<string> tags
+    5: optional string color
+}
+
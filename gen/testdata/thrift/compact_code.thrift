@@ -0,0 +1,39 @@
+// Code for this file is generated with --compact-code.
+
+enum Color {
+    RED, GREEN, BLUE
+}
+
+struct Point {
+    1: required double x
+    2: required double y
+}
+
+typedef list<Point> Path
+
+struct Shapes {
+    1: optional list<Point> points
+    2: optional Path path
+    3: optional list<list<i32>> matrix
+    4: optional set<string> tags
+    5: optional set<Point> uniquePoints
+    6: optional set<binary> blobs
+    7: optional map<string, Point> named
+    8: optional map<Point, Color> colors
+    9: optional map<Color, list<Point>> byColor
+}
+
+union Shape {
+    1: list<Point> polygon
+    2: map<string, double> properties
+}
+
+struct Node {
+    3: required string name
+    1: optional Node child
+    2: optional i32 weight = 1
+}
+
+exception TooManyNodes {
+    1: optional string message
+}
@@ -0,0 +1,17 @@
+// Code for this file is generated with --drift-schemas.
+
+struct Item {
+    1: required string sku
+    2: optional i32 quantity
+}
+
+service Inventory {
+    i64 count(
+        1: required string sku
+        2: optional string warehouse
+    )
+
+    void restock(1: list<Item> items)
+
+    void ping()
+}
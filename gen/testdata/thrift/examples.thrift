@@ -0,0 +1,46 @@
+// Code for this file is generated with --generate-examples.
+
+enum Status {
+    ACTIVE
+    INACTIVE
+}
+
+typedef string UserName
+typedef i64 Timestamp
+typedef Point Location
+
+struct Point {
+    1: required double x
+    2: required double y
+}
+
+struct User {
+    1: required UserName name
+    2: required Status status
+    3: required Timestamp createdAt
+    4: required Location home
+    5: required list<string> emails
+    6: required map<string, Point> places
+    7: required uuid id
+    8: optional string nickname
+    9: optional binary avatar
+}
+
+union Contact {
+    1: binary photo
+    2: string email
+    3: i64 phone
+}
+
+exception UserNotFound {
+    1: required string name
+    2: optional string message
+}
+
+// Binary values cannot be constants so no example is generated for this
+// struct.
+struct Blob {
+    1: required binary data
+}
+
+struct Empty {}
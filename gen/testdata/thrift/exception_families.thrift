@@ -0,0 +1,19 @@
+// Exceptions of a family declare the same base fields so that handlers may
+// treat them uniformly through the interface generated for the family.
+
+exception NotFoundError {
+    1: required i32 code
+    2: required string message
+    3: optional string key
+} (thriftrw.family = "ServiceError")
+
+exception UnavailableError {
+    1: required i32 code
+    2: optional string message
+    3: optional i64 retryAfterMs
+} (thriftrw.family = "ServiceError")
+
+exception InvalidArgumentError {
+    1: required string argument
+    2: optional string reason
+} (thriftrw.family = "RequestError", thriftrw.family.fields = "argument")
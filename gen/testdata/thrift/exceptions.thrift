@@ -8,3 +8,21 @@ exception DoesNotExistException {
     1: required string key
     2: optional string Error (go.name="Error2")
 }
+
+typedef string Reason
+
+/**
+ * Raised when a request takes too long.
+ */
+exception TimeoutException {
+    1: optional Reason reason
+    2: optional i64 elapsedMillis
+} (thriftrw.message = "reason")
+
+/**
+ * Raised when a caller is not allowed to make a request. Its message is not
+ * used as the error message because it is redacted.
+ */
+exception PermissionDenied {
+    1: optional string message (thriftrw.redact = "true")
+}
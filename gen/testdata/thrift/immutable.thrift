@@ -0,0 +1,47 @@
+// Code for this file is generated with --immutable.
+
+enum Status {
+    ACTIVE, SUSPENDED
+}
+
+struct Address {
+    1: required string street
+    2: optional string city
+}
+
+struct Account {
+    1: required i64 ID
+    2: required string type
+    3: optional string displayName (go.tag = 'json:"name,omitempty"')
+    4: optional i32 loginCount (thriftrw.optional = "value")
+    5: optional Status status = Status.ACTIVE
+    6: optional Address address
+    7: optional list<string> tags
+    8: optional map<string, i32> limits
+    9: optional bool verified
+}
+
+typedef Account Admin
+
+union Credential {
+    1: string password
+    2: binary key
+}
+
+exception AccountNotFound {
+    1: required i64 ID
+    2: optional string message
+}
+
+const Account defaultAccount = {
+    "ID": 1,
+    "type": "user",
+    "loginCount": 0,
+    "address": {"street": "1 Main St"},
+    "tags": ["new"],
+}
+
+const Admin rootAdmin = {
+    "ID": 0,
+    "type": "admin",
+}
@@ -0,0 +1,6 @@
+include "./wire.thrift"
+
+struct WireFields {
+    1: required wire.Field field
+    2: optional list<wire.Field> fields
+}
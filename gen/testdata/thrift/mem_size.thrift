@@ -0,0 +1,46 @@
+// Code for this file is generated with --mem-size.
+
+enum Kind {
+    SMALL
+    LARGE
+}
+
+typedef string Name
+typedef list<Name> Names
+typedef Item ItemAlias
+
+struct Item {
+    1: required string name
+    2: optional string label
+    3: optional i32 count
+    4: optional i64 version (thriftrw.optional = "value")
+    5: optional string note (thriftrw.optional = "value")
+    6: optional Kind kind
+    7: optional binary data
+}
+
+struct Inventory {
+    1: required Item primary
+    2: optional Item secondary
+    3: optional list<Item> items
+    4: optional list<i32> counts
+    5: optional set<string> tags
+    6: optional set<list<i32>> groups
+    7: optional map<string, binary> blobs
+    8: optional map<i32, i64> versions
+    9: optional map<list<i32>, string> labels
+    10: optional map<string, i32> sizes
+    11: optional Names names
+    12: optional ItemAlias aliased
+    13: optional Name owner
+    14: optional string raw (thriftrw.raw)
+}
+
+union Content {
+    1: string text
+    2: Item item
+}
+
+exception NotFound {
+    1: required string message
+}
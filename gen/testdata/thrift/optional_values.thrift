@@ -0,0 +1,49 @@
+// Code for this file is generated with --optional-values.
+
+enum Theme {
+    LIGHT, DARK
+}
+
+typedef i64 Millis
+
+struct Preferences {
+    1: optional bool notifications
+    2: optional i8 volume
+    3: optional i16 fontSize
+    4: optional i32 retries = 3
+    5: optional Millis timeout
+    6: optional double ratio
+    7: optional string locale = "en_US"
+    8: optional Theme theme
+    9: optional uuid deviceID
+    10: optional binary avatar
+    11: optional list<string> tags
+    12: optional string nickname (thriftrw.optional = "pointer")
+    13: required i32 version
+}
+
+struct LegacyPreferences {
+    1: optional i32 retries
+    2: optional string locale (thriftrw.optional = "value")
+} (thriftrw.optional = "pointer")
+
+exception QuotaExceeded {
+    1: optional string message
+    2: optional i64 limit
+}
+
+union Preference {
+    1: bool notifications
+    2: i32 retries
+}
+
+const Preferences quietPreferences = {
+    "notifications": false,
+    "volume": 0,
+    "locale": "en_GB",
+    "version": 1,
+}
+
+struct RenamedOptions {
+    1: optional i32 retries (go.name = "MaxRetries", go.renamedFrom = "Retries")
+}
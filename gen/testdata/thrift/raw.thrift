@@ -0,0 +1,18 @@
+include "./structs.thrift"
+
+// Fields annotated with thriftrw.raw are kept as wire.Values so that they
+// may be forwarded without being decoded.
+
+/**
+ * Request forwarded by a proxy which routes it based on its name.
+ */
+struct ProxyRequest {
+    1: required string name
+    2: required structs.Point payload (thriftrw.raw)
+    3: optional list<string> tags (thriftrw.raw)
+}
+
+union RawUnion {
+    1: string name
+    2: structs.Point point (thriftrw.raw)
+}
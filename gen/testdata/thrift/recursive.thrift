@@ -0,0 +1,40 @@
+/**
+ * Tree is a tree of nodes.
+ */
+struct Tree {
+    1: required TreeNode root
+}
+
+struct TreeNode {
+    1: required string name
+    2: optional list<TreeNode> children
+    3: optional map<string, TreeNode> byName
+    4: optional set<TreeNode> leaves
+}
+
+/**
+ * Expression and Operation reference each other.
+ */
+union Expression {
+    1: i64 literal
+    2: Operation operation
+}
+
+struct Operation {
+    1: required string operator
+    2: required Expression left
+    3: required Expression right
+}
+
+typedef list<Directory> Directories
+
+struct Directory {
+    1: required string name
+    2: optional Directories subdirectories
+    3: optional Directory parent
+}
+
+exception ChainedError {
+    1: optional string message
+    2: optional ChainedError cause
+}
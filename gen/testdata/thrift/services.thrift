@@ -25,6 +25,7 @@ service KeyValue {
     // Return with exceptions
     unions.ArbitraryValue getValue(1: Key key)
         throws (1: exceptions.DoesNotExistException doesNotExist)
+        (thriftrw.batch = "getManyValues")
 
     // void with exceptions
     void deleteValue(1: Key key)
@@ -43,7 +44,7 @@ service KeyValue {
     )
 
     i64 size()  // < primitve return value
-}
+} (thriftrw.alias = "KeyValueStore")
 
 service Cache {
     oneway void clear()
@@ -0,0 +1,29 @@
+// Code for this file is generated with --split-types.
+
+enum Color {
+    RED
+    GREEN
+}
+
+typedef list<string> Tags
+
+struct Shape {
+    1: required string name
+    2: optional Color color
+    3: optional list<string> labels
+}
+
+struct Canvas {
+    1: required list<Shape> shapes
+    2: optional list<string> labels
+    3: optional Tags tags
+}
+
+exception DrawError {
+    1: required string message
+} (thriftrw.family = "CanvasError", thriftrw.family.fields = "message")
+
+exception SizeError {
+    1: required string message
+    2: optional i32 limit
+} (thriftrw.family = "CanvasError", thriftrw.family.fields = "message")
@@ -0,0 +1,19 @@
+// Code for this file is generated with --strict-utf8.
+
+typedef string Name
+
+struct Person {
+    1: required Name name
+    2: optional string nickname
+    3: optional string legacyName (thriftrw.allowInvalidUTF8)
+    4: optional list<string> aliases
+    5: optional map<string, i32> scores
+    6: optional set<string> tags
+    7: optional map<i32, list<Name>> history
+    8: optional binary photo
+}
+
+union Label {
+    1: string text
+    2: i32 code
+}
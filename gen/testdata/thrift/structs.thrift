@@ -152,3 +152,18 @@ struct DefaultsStruct {
         "endPoint":   {"x": 3, "y": 4},
     }
 }
+
+//////////////////////////////////////////////////////////////////////////////
+// Redacted fields
+
+struct Credentials {
+    1: required string username
+    2: required string password (thriftrw.redact = "true")
+    3: optional binary token (thriftrw.redact = "true")
+}
+
+// Fields renamed in Go keep deprecated accessors with their old names.
+struct Renamed {
+    1: required string userName (go.name = "Username", go.renamedFrom = "UserName")
+    2: optional i32 count (go.name = "Total", go.renamedFrom = "Count")
+}
@@ -44,3 +44,19 @@ typedef set<binary> BinarySet
 typedef map<structs.Edge, structs.Edge> EdgeMap
 
 typedef enums.EnumWithValues MyEnum
+
+// Typedefs of typedefs of containers. Contents is linked before Directory,
+// which refers back to it through Listing.
+typedef list<map<string, Directory>> Contents
+typedef Contents Listing
+typedef Listing Archive
+
+struct Directory {
+    1: required string name
+    2: optional Listing children
+}
+
+struct Library {
+    1: required Archive archive
+    2: optional map<string, Listing> listings
+}
@@ -0,0 +1,25 @@
+// Code for this file is generated with --preserve-unknown-fields.
+
+struct Record {
+    1: required string name
+    2: optional i32 count
+}
+
+// RecordV2 is a newer version of Record with more fields.
+struct RecordV2 {
+    1: required string name
+    2: optional i32 count
+    3: optional list<string> tags
+    4: optional map<string, list<i64>> history
+    5: optional binary blob
+    6: optional Record parent
+}
+
+exception Failure {
+    1: optional string message
+}
+
+union Choice {
+    1: string text
+    2: i32 number
+}
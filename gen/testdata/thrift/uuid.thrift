@@ -0,0 +1,20 @@
+typedef uuid RequestID
+
+const uuid DefaultNamespace = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+const RequestID NilRequestID = "00000000-0000-0000-0000-000000000000"
+
+struct Request {
+    1: required RequestID id
+    2: optional uuid parent
+    3: optional uuid scope = "6ba7b810-9dad-11d1-80b4-00c04fd430c8"
+    4: optional list<uuid> related
+    5: optional set<uuid> tags
+    6: optional map<uuid, string> names
+}
+
+/**
+ * uuid is not a keyword, so it may still be used as an identifier.
+ */
+struct Entity {
+    1: required uuid uuid
+}
@@ -0,0 +1,31 @@
+// Code for this file is generated with --generate-validate.
+
+struct User {
+    1: required string name
+    2: optional binary avatar
+    3: optional User manager
+    4: optional Contact contact
+}
+
+typedef User Owner
+typedef list<User> Users
+
+union Contact {
+    1: string email
+    2: User user
+}
+
+struct Item {
+    1: required string name
+    2: optional Owner owner
+}
+
+struct Order {
+    1: required list<Item> items
+    2: optional Users watchers
+    3: optional map<string, User> usersByName
+    4: optional map<User, Contact> contacts
+    5: optional set<Contact> contactSet
+    6: optional Contact primary
+    7: required binary payload
+}
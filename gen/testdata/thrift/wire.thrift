@@ -0,0 +1,7 @@
+// Generated code imports go.uber.org/thriftrw/wire so packages which include
+// this file must refer to it by a different name.
+
+struct Field {
+    1: required i16 id
+    2: required string name
+}
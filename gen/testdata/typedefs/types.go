@@ -5,14 +5,67 @@ package typedefs
 
 import (
 	"bytes"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"strings"
+
 	"go.uber.org/thriftrw/gen/testdata/enums"
 	"go.uber.org/thriftrw/gen/testdata/structs"
 	"go.uber.org/thriftrw/wire"
-	"strings"
+	"go.uber.org/zap/zapcore"
 )
 
+func _Listing_Read(w wire.Value) (Listing, error) {
+	var x Listing
+	err := x.FromWire(w)
+	return x, err
+}
+
+type Archive Listing
+
+// ToWire translates Archive into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Archive) ToWire() (wire.Value, error) {
+	x := (Listing)(v)
+	return x.ToWire()
+}
+
+// String returns a readable string representation of Archive.
+func (v Archive) String() string {
+	x := (Listing)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes Archive from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Archive) FromWire(w wire.Value) error {
+	x, err := _Listing_Read(w)
+	*v = (Archive)(x)
+	return err
+}
+
+// Equals returns true if this Archive is equal to the provided
+// Archive.
+func (lhs Archive) Equals(rhs Archive) bool {
+	return (Listing)(lhs).Equals((Listing)(rhs))
+}
+
+// Clone returns a deep copy of this Archive.
+func (v Archive) Clone() Archive {
+	x := (Listing)(v)
+	return (Archive)(x.Clone())
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of Archive.
+func (v Archive) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	x := (Listing)(v)
+	return x.MarshalLogArray(enc)
+}
+
 type _Set_Binary_ValueList [][]byte
 
 func (v _Set_Binary_ValueList) ForEach(f func(wire.Value) error) error {
@@ -20,12 +73,7 @@ func (v _Set_Binary_ValueList) ForEach(f func(wire.Value) error) error {
 		if x == nil {
 			return fmt.Errorf("invalid set item: value is nil")
 		}
-		w, err := wire.NewValueBinary(x), error(nil)
-		if err != nil {
-			return err
-		}
-
-		if err := f(w); err != nil {
+		if err := f(wire.NewValueBinary(x)); err != nil {
 			return err
 		}
 	}
@@ -49,10 +97,7 @@ func _Set_Binary_Read(s wire.ValueList) ([][]byte, error) {
 
 	o := make([][]byte, 0, s.Size())
 	err := s.ForEach(func(x wire.Value) error {
-		i, err := x.GetBinary(), error(nil)
-		if err != nil {
-			return err
-		}
+		i := x.GetBinary()
 
 		o = append(o, i)
 		return nil
@@ -82,6 +127,29 @@ func _Set_Binary_Equals(lhs, rhs [][]byte) bool {
 	return true
 }
 
+func _Binary_Clone(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+
+	o := make([]byte, len(b))
+	copy(o, b)
+	return o
+}
+
+func _Set_Binary_Clone(s [][]byte) [][]byte {
+	if s == nil {
+		return nil
+	}
+
+	o := make([][]byte, 0, len(s))
+	for _, x := range s {
+		o = append(o, _Binary_Clone(x))
+	}
+
+	return o
+}
+
 type BinarySet [][]byte
 
 // ToWire translates BinarySet into a Thrift-level intermediate
@@ -89,7 +157,7 @@ type BinarySet [][]byte
 // into bytes using a ThriftRW protocol implementation.
 func (v BinarySet) ToWire() (wire.Value, error) {
 	x := ([][]byte)(v)
-	return wire.NewValueSet(_Set_Binary_ValueList(x)), error(nil)
+	return wire.NewValueSet(_Set_Binary_ValueList(x)), nil
 }
 
 // String returns a readable string representation of BinarySet.
@@ -113,6 +181,264 @@ func (lhs BinarySet) Equals(rhs BinarySet) bool {
 	return _Set_Binary_Equals(lhs, rhs)
 }
 
+// Clone returns a deep copy of this BinarySet.
+func (v BinarySet) Clone() BinarySet {
+	x := ([][]byte)(v)
+	return (BinarySet)(_Set_Binary_Clone(x))
+}
+
+type _Set_Binary_Zapper [][]byte
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_Binary_Zapper.
+func (s _Set_Binary_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range s {
+		enc.AppendString(base64.StdEncoding.EncodeToString(v))
+	}
+	return nil
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of BinarySet.
+func (v BinarySet) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	x := ([][]byte)(v)
+	return (_Set_Binary_Zapper)(x).MarshalLogArray(enc)
+}
+
+type _Map_String_Directory_MapItemList map[string]*Directory
+
+func (m _Map_String_Directory_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw := wire.NewValueString(k)
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		if err = f(wire.MapItem{Key: kw, Value: vw}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_Directory_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_Directory_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Directory_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_String_Directory_MapItemList) Close() {}
+
+type _List_Map_String_Directory_ValueList []map[string]*Directory
+
+func (v _List_Map_String_Directory_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		if err := f(wire.NewValueMap(_Map_String_Directory_MapItemList(x))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Map_String_Directory_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Map_String_Directory_ValueList) ValueType() wire.Type {
+	return wire.TMap
+}
+
+func (_List_Map_String_Directory_ValueList) Close() {}
+
+func _Directory_Read(w wire.Value) (*Directory, error) {
+	var v Directory
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_String_Directory_Read(m wire.MapItemList) (map[string]*Directory, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]*Directory, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k := x.Key.GetString()
+
+		v, err := _Directory_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _List_Map_String_Directory_Read(l wire.ValueList) ([]map[string]*Directory, error) {
+	if l.ValueType() != wire.TMap {
+		return nil, nil
+	}
+
+	o := make([]map[string]*Directory, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Map_String_Directory_Read(x.GetMap())
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Map_String_Directory_Equals(lhs, rhs map[string]*Directory) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _List_Map_String_Directory_Equals(lhs, rhs []map[string]*Directory) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !_Map_String_Directory_Equals(lv, rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_Directory_Clone(m map[string]*Directory) map[string]*Directory {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[string]*Directory, len(m))
+	for k, v := range m {
+		o[k] = v.Clone()
+	}
+
+	return o
+}
+
+func _List_Map_String_Directory_Clone(l []map[string]*Directory) []map[string]*Directory {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]map[string]*Directory, len(l))
+	for i, x := range l {
+		o[i] = _Map_String_Directory_Clone(x)
+	}
+	return o
+}
+
+type Contents []map[string]*Directory
+
+// ToWire translates Contents into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Contents) ToWire() (wire.Value, error) {
+	x := ([]map[string]*Directory)(v)
+	return wire.NewValueList(_List_Map_String_Directory_ValueList(x)), nil
+}
+
+// String returns a readable string representation of Contents.
+func (v Contents) String() string {
+	x := ([]map[string]*Directory)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes Contents from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Contents) FromWire(w wire.Value) error {
+	x, err := _List_Map_String_Directory_Read(w.GetList())
+	*v = (Contents)(x)
+	return err
+}
+
+// Equals returns true if this Contents is equal to the provided
+// Contents.
+func (lhs Contents) Equals(rhs Contents) bool {
+	return _List_Map_String_Directory_Equals(lhs, rhs)
+}
+
+// Clone returns a deep copy of this Contents.
+func (v Contents) Clone() Contents {
+	x := ([]map[string]*Directory)(v)
+	return (Contents)(_List_Map_String_Directory_Clone(x))
+}
+
+type _Map_String_Directory_Zapper map[string]*Directory
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_Directory_Zapper.
+func (m _Map_String_Directory_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for k, v := range m {
+		if err := enc.AddObject((string)(k), v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _List_Map_String_Directory_Zapper []map[string]*Directory
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Map_String_Directory_Zapper.
+func (l _List_Map_String_Directory_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		if err := enc.AppendObject((_Map_String_Directory_Zapper)(v)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of Contents.
+func (v Contents) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	x := ([]map[string]*Directory)(v)
+	return (_List_Map_String_Directory_Zapper)(x).MarshalLogArray(enc)
+}
+
 type DefaultPrimitiveTypedef struct {
 	State *State `json:"state,omitempty"`
 }
@@ -121,6 +447,14 @@ func _State_ptr(v State) *State {
 	return &v
 }
 
+// Default_DefaultPrimitiveTypedef constructs a new DefaultPrimitiveTypedef with its fields set to the
+// default values declared for them in the Thrift file.
+func Default_DefaultPrimitiveTypedef() *DefaultPrimitiveTypedef {
+	var v DefaultPrimitiveTypedef
+	v.State = _State_ptr("hello")
+	return &v
+}
+
 // ToWire translates a DefaultPrimitiveTypedef struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -144,6 +478,10 @@ func (v *DefaultPrimitiveTypedef) ToWire() (wire.Value, error) {
 		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("DefaultPrimitiveTypedef is nil")
+	}
+
 	if v.State == nil {
 		v.State = _State_ptr("hello")
 	}
@@ -246,44 +584,278 @@ func (v *DefaultPrimitiveTypedef) Equals(rhs *DefaultPrimitiveTypedef) bool {
 	return true
 }
 
+func _State_ClonePtr(p *State) *State {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this DefaultPrimitiveTypedef.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *DefaultPrimitiveTypedef) Clone() *DefaultPrimitiveTypedef {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.State = _State_ClonePtr(v.State)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of DefaultPrimitiveTypedef.
+func (v *DefaultPrimitiveTypedef) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.State != nil {
+		enc.AddString("state", (string)(*v.State))
+	}
+	return nil
+}
+
 // GetState returns the value of State if it is set or its
-// zero value if it is unset.
+// default value if it is unset.
+//
+// This is safe to call on a nil DefaultPrimitiveTypedef.
 func (v *DefaultPrimitiveTypedef) GetState() (o State) {
-	if v.State != nil {
+	if v != nil && v.State != nil {
 		return *v.State
 	}
 	o = "hello"
 	return
 }
 
-type _Map_Edge_Edge_MapItemList []struct {
-	Key   *structs.Edge
-	Value *structs.Edge
+// IsSetState returns true if State is not nil.
+//
+// This is safe to call on a nil DefaultPrimitiveTypedef.
+func (v *DefaultPrimitiveTypedef) IsSetState() bool {
+	return v != nil && v.State != nil
 }
 
-func (m _Map_Edge_Edge_MapItemList) ForEach(f func(wire.MapItem) error) error {
-	for _, i := range m {
-		k := i.Key
-		v := i.Value
-		if k == nil {
-			return fmt.Errorf("invalid map key: value is nil")
-		}
-		if v == nil {
-			return fmt.Errorf("invalid [%v]: value is nil", k)
-		}
-		kw, err := k.ToWire()
-		if err != nil {
+type Directory struct {
+	Name     string  `json:"name,required"`
+	Children Listing `json:"children,omitempty"`
+}
+
+// ToWire translates a Directory struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Directory) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Directory is nil")
+	}
+
+	w = wire.NewValueString(v.Name)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Children != nil {
+		w, err = v.Children.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Directory struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Directory struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Directory
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Directory) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name = field.Value.GetString()
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TList {
+				v.Children, err = _Listing_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Directory is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Directory
+// struct.
+func (v *Directory) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	if v.Children != nil {
+		fields[i] = fmt.Sprintf("Children: %v", v.Children)
+		i++
+	}
+
+	return fmt.Sprintf("Directory{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Directory match the
+// provided Directory.
+//
+// This function performs a deep comparison.
+func (v *Directory) Equals(rhs *Directory) bool {
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !((v.Children == nil && rhs.Children == nil) || (v.Children != nil && rhs.Children != nil && v.Children.Equals(rhs.Children))) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this Directory.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Directory) Clone() *Directory {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Children = v.Children.Clone()
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Directory.
+func (v *Directory) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("name", v.Name)
+	if v.Children != nil {
+		if err := enc.AddArray("children", v.Children); err != nil {
 			return err
 		}
+	}
+	return nil
+}
 
-		vw, err := v.ToWire()
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Directory.
+func (v *Directory) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetChildren returns the value of Children if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Directory.
+func (v *Directory) GetChildren() (o Listing) {
+	if v != nil && v.Children != nil {
+		return v.Children
+	}
+
+	return
+}
+
+// IsSetChildren returns true if Children is not nil.
+//
+// This is safe to call on a nil Directory.
+func (v *Directory) IsSetChildren() bool {
+	return v != nil && v.Children != nil
+}
+
+type _Map_Edge_Edge_MapItemList []struct {
+	Key   *structs.Edge
+	Value *structs.Edge
+}
+
+func (m _Map_Edge_Edge_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		if k == nil {
+			return fmt.Errorf("invalid map key: value is nil")
+		}
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := k.ToWire()
 		if err != nil {
 			return err
 		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
+
+		vw, err := v.ToWire()
 		if err != nil {
 			return err
 		}
+		if err = f(wire.MapItem{Key: kw, Value: vw}); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -378,6 +950,33 @@ func _Map_Edge_Edge_Equals(lhs, rhs []struct {
 	return true
 }
 
+func _Map_Edge_Edge_Clone(m []struct {
+	Key   *structs.Edge
+	Value *structs.Edge
+}) []struct {
+	Key   *structs.Edge
+	Value *structs.Edge
+} {
+	if m == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   *structs.Edge
+		Value *structs.Edge
+	}, 0, len(m))
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		o = append(o, struct {
+			Key   *structs.Edge
+			Value *structs.Edge
+		}{k.Clone(), v.Clone()})
+	}
+
+	return o
+}
+
 type EdgeMap []struct {
 	Key   *structs.Edge
 	Value *structs.Edge
@@ -391,7 +990,7 @@ func (v EdgeMap) ToWire() (wire.Value, error) {
 		Key   *structs.Edge
 		Value *structs.Edge
 	})(v)
-	return wire.NewValueMap(_Map_Edge_Edge_MapItemList(x)), error(nil)
+	return wire.NewValueMap(_Map_Edge_Edge_MapItemList(x)), nil
 }
 
 // String returns a readable string representation of EdgeMap.
@@ -418,6 +1017,60 @@ func (lhs EdgeMap) Equals(rhs EdgeMap) bool {
 	return _Map_Edge_Edge_Equals(lhs, rhs)
 }
 
+// Clone returns a deep copy of this EdgeMap.
+func (v EdgeMap) Clone() EdgeMap {
+	x := ([]struct {
+		Key   *structs.Edge
+		Value *structs.Edge
+	})(v)
+	return (EdgeMap)(_Map_Edge_Edge_Clone(x))
+}
+
+type _Map_Edge_Edge_Item_Zapper struct {
+	Key   *structs.Edge
+	Value *structs.Edge
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_Edge_Edge_Item_Zapper.
+func (i _Map_Edge_Edge_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if err := enc.AddObject("key", i.Key); err != nil {
+		return err
+	}
+	if err := enc.AddObject("value", i.Value); err != nil {
+		return err
+	}
+	return nil
+}
+
+type _Map_Edge_Edge_Zapper []struct {
+	Key   *structs.Edge
+	Value *structs.Edge
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Edge_Edge_Zapper.
+func (m _Map_Edge_Edge_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		if err := enc.AppendObject(_Map_Edge_Edge_Item_Zapper{Key: k, Value: v}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of EdgeMap.
+func (v EdgeMap) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	x := ([]struct {
+		Key   *structs.Edge
+		Value *structs.Edge
+	})(v)
+	return (_Map_Edge_Edge_Zapper)(x).MarshalLogArray(enc)
+}
+
 type Event struct {
 	UUID *UUID      `json:"uuid,required"`
 	Time *Timestamp `json:"time,omitempty"`
@@ -446,6 +1099,10 @@ func (v *Event) ToWire() (wire.Value, error) {
 		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("Event is nil")
+	}
+
 	if v.UUID == nil {
 		return w, errors.New("field UUID of Event is required")
 	}
@@ -575,16 +1232,77 @@ func (v *Event) Equals(rhs *Event) bool {
 	return true
 }
 
+func _Timestamp_ClonePtr(p *Timestamp) *Timestamp {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this Event.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Event) Clone() *Event {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.UUID = v.UUID.Clone()
+	o.Time = _Timestamp_ClonePtr(v.Time)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Event.
+func (v *Event) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if err := enc.AddObject("uuid", v.UUID); err != nil {
+		return err
+	}
+	if v.Time != nil {
+		enc.AddInt64("time", (int64)(*v.Time))
+	}
+	return nil
+}
+
+// GetUUID returns the value of UUID if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Event.
+func (v *Event) GetUUID() (o *UUID) {
+	if v != nil {
+		o = v.UUID
+	}
+	return
+}
+
 // GetTime returns the value of Time if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil Event.
 func (v *Event) GetTime() (o Timestamp) {
-	if v.Time != nil {
+	if v != nil && v.Time != nil {
 		return *v.Time
 	}
 
 	return
 }
 
+// IsSetTime returns true if Time is not nil.
+//
+// This is safe to call on a nil Event.
+func (v *Event) IsSetTime() bool {
+	return v != nil && v.Time != nil
+}
+
 type _List_Event_ValueList []*Event
 
 func (v _List_Event_ValueList) ForEach(f func(wire.Value) error) error {
@@ -653,6 +1371,18 @@ func _List_Event_Equals(lhs, rhs []*Event) bool {
 	return true
 }
 
+func _List_Event_Clone(l []*Event) []*Event {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]*Event, len(l))
+	for i, x := range l {
+		o[i] = x.Clone()
+	}
+	return o
+}
+
 type EventGroup []*Event
 
 // ToWire translates EventGroup into a Thrift-level intermediate
@@ -660,7 +1390,7 @@ type EventGroup []*Event
 // into bytes using a ThriftRW protocol implementation.
 func (v EventGroup) ToWire() (wire.Value, error) {
 	x := ([]*Event)(v)
-	return wire.NewValueList(_List_Event_ValueList(x)), error(nil)
+	return wire.NewValueList(_List_Event_ValueList(x)), nil
 }
 
 // String returns a readable string representation of EventGroup.
@@ -684,110 +1414,531 @@ func (lhs EventGroup) Equals(rhs EventGroup) bool {
 	return _List_Event_Equals(lhs, rhs)
 }
 
-type _Set_Frame_ValueList []*structs.Frame
+// Clone returns a deep copy of this EventGroup.
+func (v EventGroup) Clone() EventGroup {
+	x := ([]*Event)(v)
+	return (EventGroup)(_List_Event_Clone(x))
+}
 
-func (v _Set_Frame_ValueList) ForEach(f func(wire.Value) error) error {
-	for _, x := range v {
-		if x == nil {
-			return fmt.Errorf("invalid set item: value is nil")
+type _List_Event_Zapper []*Event
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Event_Zapper.
+func (l _List_Event_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		if err := enc.AppendObject(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of EventGroup.
+func (v EventGroup) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	x := ([]*Event)(v)
+	return (_List_Event_Zapper)(x).MarshalLogArray(enc)
+}
+
+type _Set_Frame_ValueList []*structs.Frame
+
+func (v _Set_Frame_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid set item: value is nil")
 		}
 		w, err := x.ToWire()
 		if err != nil {
 			return err
 		}
 
-		if err := f(w); err != nil {
+		if err = f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_Frame_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_Frame_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Set_Frame_ValueList) Close() {}
+
+func _Frame_Read(w wire.Value) (*structs.Frame, error) {
+	var v structs.Frame
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Set_Frame_Read(s wire.ValueList) ([]*structs.Frame, error) {
+	if s.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*structs.Frame, 0, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := _Frame_Read(x)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, i)
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+func _Set_Frame_Equals(lhs, rhs []*structs.Frame) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, x := range lhs {
+		ok := false
+		for _, y := range rhs {
+			if x.Equals(y) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Set_Frame_Clone(s []*structs.Frame) []*structs.Frame {
+	if s == nil {
+		return nil
+	}
+
+	o := make([]*structs.Frame, 0, len(s))
+	for _, x := range s {
+		o = append(o, x.Clone())
+	}
+
+	return o
+}
+
+type FrameGroup []*structs.Frame
+
+// ToWire translates FrameGroup into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v FrameGroup) ToWire() (wire.Value, error) {
+	x := ([]*structs.Frame)(v)
+	return wire.NewValueSet(_Set_Frame_ValueList(x)), nil
+}
+
+// String returns a readable string representation of FrameGroup.
+func (v FrameGroup) String() string {
+	x := ([]*structs.Frame)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes FrameGroup from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *FrameGroup) FromWire(w wire.Value) error {
+	x, err := _Set_Frame_Read(w.GetSet())
+	*v = (FrameGroup)(x)
+	return err
+}
+
+// Equals returns true if this FrameGroup is equal to the provided
+// FrameGroup.
+func (lhs FrameGroup) Equals(rhs FrameGroup) bool {
+	return _Set_Frame_Equals(lhs, rhs)
+}
+
+// Clone returns a deep copy of this FrameGroup.
+func (v FrameGroup) Clone() FrameGroup {
+	x := ([]*structs.Frame)(v)
+	return (FrameGroup)(_Set_Frame_Clone(x))
+}
+
+type _Set_Frame_Zapper []*structs.Frame
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_Frame_Zapper.
+func (s _Set_Frame_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range s {
+		if err := enc.AppendObject(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of FrameGroup.
+func (v FrameGroup) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	x := ([]*structs.Frame)(v)
+	return (_Set_Frame_Zapper)(x).MarshalLogArray(enc)
+}
+
+type Library struct {
+	Archive  Archive            `json:"archive,required"`
+	Listings map[string]Listing `json:"listings,omitempty"`
+}
+
+type _Map_String_Listing_MapItemList map[string]Listing
+
+func (m _Map_String_Listing_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw := wire.NewValueString(k)
+
+		vw, err := v.ToWire()
+		if err != nil {
 			return err
 		}
+		if err = f(wire.MapItem{Key: kw, Value: vw}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_Listing_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_Listing_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_Listing_MapItemList) ValueType() wire.Type {
+	return wire.TList
+}
+
+func (_Map_String_Listing_MapItemList) Close() {}
+
+// ToWire translates a Library struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Library) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Library is nil")
+	}
+
+	if v.Archive == nil {
+		return w, errors.New("field Archive of Library is required")
+	}
+	w, err = v.Archive.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Listings != nil {
+		w = wire.NewValueMap(_Map_String_Listing_MapItemList(v.Listings))
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Archive_Read(w wire.Value) (Archive, error) {
+	var x Archive
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _Map_String_Listing_Read(m wire.MapItemList) (map[string]Listing, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TList {
+		return nil, nil
+	}
+
+	o := make(map[string]Listing, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k := x.Key.GetString()
+
+		v, err := _Listing_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a Library struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Library struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Library
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Library) FromWire(w wire.Value) error {
+	var err error
+
+	archiveIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TList {
+				v.Archive, err = _Archive_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				archiveIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TMap {
+				v.Listings, err = _Map_String_Listing_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !archiveIsSet {
+		return errors.New("field Archive of Library is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Library
+// struct.
+func (v *Library) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Archive: %v", v.Archive)
+	i++
+	if v.Listings != nil {
+		fields[i] = fmt.Sprintf("Listings: %v", v.Listings)
+		i++
+	}
+
+	return fmt.Sprintf("Library{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _Map_String_Listing_Equals(lhs, rhs map[string]Listing) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this Library match the
+// provided Library.
+//
+// This function performs a deep comparison.
+func (v *Library) Equals(rhs *Library) bool {
+	if !v.Archive.Equals(rhs.Archive) {
+		return false
+	}
+	if !((v.Listings == nil && rhs.Listings == nil) || (v.Listings != nil && rhs.Listings != nil && _Map_String_Listing_Equals(v.Listings, rhs.Listings))) {
+		return false
+	}
+
+	return true
+}
+
+func _Map_String_Listing_Clone(m map[string]Listing) map[string]Listing {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[string]Listing, len(m))
+	for k, v := range m {
+		o[k] = v.Clone()
 	}
-	return nil
-}
 
-func (v _Set_Frame_ValueList) Size() int {
-	return len(v)
+	return o
 }
 
-func (_Set_Frame_ValueList) ValueType() wire.Type {
-	return wire.TStruct
-}
+// Clone returns a deep copy of this Library.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Library) Clone() *Library {
+	if v == nil {
+		return nil
+	}
 
-func (_Set_Frame_ValueList) Close() {}
+	o := *v
+	o.Archive = v.Archive.Clone()
+	o.Listings = _Map_String_Listing_Clone(v.Listings)
 
-func _Frame_Read(w wire.Value) (*structs.Frame, error) {
-	var v structs.Frame
-	err := v.FromWire(w)
-	return &v, err
+	return &o
 }
 
-func _Set_Frame_Read(s wire.ValueList) ([]*structs.Frame, error) {
-	if s.ValueType() != wire.TStruct {
-		return nil, nil
-	}
+type _Map_String_Listing_Zapper map[string]Listing
 
-	o := make([]*structs.Frame, 0, s.Size())
-	err := s.ForEach(func(x wire.Value) error {
-		i, err := _Frame_Read(x)
-		if err != nil {
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_Listing_Zapper.
+func (m _Map_String_Listing_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for k, v := range m {
+		if err := enc.AddArray((string)(k), v); err != nil {
 			return err
 		}
+	}
+	return nil
+}
 
-		o = append(o, i)
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Library.
+func (v *Library) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
 		return nil
-	})
-	s.Close()
-	return o, err
+	}
+
+	if err := enc.AddArray("archive", v.Archive); err != nil {
+		return err
+	}
+	if v.Listings != nil {
+		if err := enc.AddObject("listings", (_Map_String_Listing_Zapper)(v.Listings)); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func _Set_Frame_Equals(lhs, rhs []*structs.Frame) bool {
-	if len(lhs) != len(rhs) {
-		return false
+// GetArchive returns the value of Archive if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Library.
+func (v *Library) GetArchive() (o Archive) {
+	if v != nil {
+		o = v.Archive
 	}
+	return
+}
 
-	for _, x := range lhs {
-		ok := false
-		for _, y := range rhs {
-			if x.Equals(y) {
-				ok = true
-				break
-			}
-		}
-		if !ok {
-			return false
-		}
+// GetListings returns the value of Listings if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Library.
+func (v *Library) GetListings() (o map[string]Listing) {
+	if v != nil && v.Listings != nil {
+		return v.Listings
 	}
 
-	return true
+	return
 }
 
-type FrameGroup []*structs.Frame
+// IsSetListings returns true if Listings is not nil.
+//
+// This is safe to call on a nil Library.
+func (v *Library) IsSetListings() bool {
+	return v != nil && v.Listings != nil
+}
 
-// ToWire translates FrameGroup into a Thrift-level intermediate
+func _Contents_Read(w wire.Value) (Contents, error) {
+	var x Contents
+	err := x.FromWire(w)
+	return x, err
+}
+
+type Listing Contents
+
+// ToWire translates Listing into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
-func (v FrameGroup) ToWire() (wire.Value, error) {
-	x := ([]*structs.Frame)(v)
-	return wire.NewValueSet(_Set_Frame_ValueList(x)), error(nil)
+func (v Listing) ToWire() (wire.Value, error) {
+	x := (Contents)(v)
+	return x.ToWire()
 }
 
-// String returns a readable string representation of FrameGroup.
-func (v FrameGroup) String() string {
-	x := ([]*structs.Frame)(v)
+// String returns a readable string representation of Listing.
+func (v Listing) String() string {
+	x := (Contents)(v)
 	return fmt.Sprint(x)
 }
 
-// FromWire deserializes FrameGroup from its Thrift-level
+// FromWire deserializes Listing from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
-func (v *FrameGroup) FromWire(w wire.Value) error {
-	x, err := _Set_Frame_Read(w.GetSet())
-	*v = (FrameGroup)(x)
+func (v *Listing) FromWire(w wire.Value) error {
+	x, err := _Contents_Read(w)
+	*v = (Listing)(x)
 	return err
 }
 
-// Equals returns true if this FrameGroup is equal to the provided
-// FrameGroup.
-func (lhs FrameGroup) Equals(rhs FrameGroup) bool {
-	return _Set_Frame_Equals(lhs, rhs)
+// Equals returns true if this Listing is equal to the provided
+// Listing.
+func (lhs Listing) Equals(rhs Listing) bool {
+	return (Contents)(lhs).Equals((Contents)(rhs))
+}
+
+// Clone returns a deep copy of this Listing.
+func (v Listing) Clone() Listing {
+	x := (Contents)(v)
+	return (Listing)(x.Clone())
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of Listing.
+func (v Listing) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	x := (Contents)(v)
+	return x.MarshalLogArray(enc)
 }
 
 func _EnumWithValues_Read(w wire.Value) (enums.EnumWithValues, error) {
@@ -827,6 +1978,19 @@ func (lhs MyEnum) Equals(rhs MyEnum) bool {
 	return lhs.Equals(rhs)
 }
 
+// Clone returns a deep copy of this MyEnum.
+func (v MyEnum) Clone() MyEnum {
+	x := (enums.EnumWithValues)(v)
+	return (MyEnum)(x)
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of MyEnum.
+func (v MyEnum) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	x := (enums.EnumWithValues)(v)
+	return x.MarshalLogObject(enc)
+}
+
 type PDF []byte
 
 // ToWire translates PDF into a Thrift-level intermediate
@@ -834,7 +1998,7 @@ type PDF []byte
 // into bytes using a ThriftRW protocol implementation.
 func (v PDF) ToWire() (wire.Value, error) {
 	x := ([]byte)(v)
-	return wire.NewValueBinary(x), error(nil)
+	return wire.NewValueBinary(x), nil
 }
 
 // String returns a readable string representation of PDF.
@@ -847,9 +2011,8 @@ func (v PDF) String() string {
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 func (v *PDF) FromWire(w wire.Value) error {
-	x, err := w.GetBinary(), error(nil)
-	*v = (PDF)(x)
-	return err
+	*v = (PDF)(w.GetBinary())
+	return nil
 }
 
 // Equals returns true if this PDF is equal to the provided
@@ -858,6 +2021,12 @@ func (lhs PDF) Equals(rhs PDF) bool {
 	return bytes.Equal(lhs, rhs)
 }
 
+// Clone returns a deep copy of this PDF.
+func (v PDF) Clone() PDF {
+	x := ([]byte)(v)
+	return (PDF)(_Binary_Clone(x))
+}
+
 type _Map_Point_Point_MapItemList []struct {
 	Key   *structs.Point
 	Value *structs.Point
@@ -882,8 +2051,7 @@ func (m _Map_Point_Point_MapItemList) ForEach(f func(wire.MapItem) error) error
 		if err != nil {
 			return err
 		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
+		if err = f(wire.MapItem{Key: kw, Value: vw}); err != nil {
 			return err
 		}
 	}
@@ -980,6 +2148,33 @@ func _Map_Point_Point_Equals(lhs, rhs []struct {
 	return true
 }
 
+func _Map_Point_Point_Clone(m []struct {
+	Key   *structs.Point
+	Value *structs.Point
+}) []struct {
+	Key   *structs.Point
+	Value *structs.Point
+} {
+	if m == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   *structs.Point
+		Value *structs.Point
+	}, 0, len(m))
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		o = append(o, struct {
+			Key   *structs.Point
+			Value *structs.Point
+		}{k.Clone(), v.Clone()})
+	}
+
+	return o
+}
+
 type PointMap []struct {
 	Key   *structs.Point
 	Value *structs.Point
@@ -993,7 +2188,7 @@ func (v PointMap) ToWire() (wire.Value, error) {
 		Key   *structs.Point
 		Value *structs.Point
 	})(v)
-	return wire.NewValueMap(_Map_Point_Point_MapItemList(x)), error(nil)
+	return wire.NewValueMap(_Map_Point_Point_MapItemList(x)), nil
 }
 
 // String returns a readable string representation of PointMap.
@@ -1020,6 +2215,60 @@ func (lhs PointMap) Equals(rhs PointMap) bool {
 	return _Map_Point_Point_Equals(lhs, rhs)
 }
 
+// Clone returns a deep copy of this PointMap.
+func (v PointMap) Clone() PointMap {
+	x := ([]struct {
+		Key   *structs.Point
+		Value *structs.Point
+	})(v)
+	return (PointMap)(_Map_Point_Point_Clone(x))
+}
+
+type _Map_Point_Point_Item_Zapper struct {
+	Key   *structs.Point
+	Value *structs.Point
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_Point_Point_Item_Zapper.
+func (i _Map_Point_Point_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if err := enc.AddObject("key", i.Key); err != nil {
+		return err
+	}
+	if err := enc.AddObject("value", i.Value); err != nil {
+		return err
+	}
+	return nil
+}
+
+type _Map_Point_Point_Zapper []struct {
+	Key   *structs.Point
+	Value *structs.Point
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_Point_Point_Zapper.
+func (m _Map_Point_Point_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		if err := enc.AppendObject(_Map_Point_Point_Item_Zapper{Key: k, Value: v}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of PointMap.
+func (v PointMap) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	x := ([]struct {
+		Key   *structs.Point
+		Value *structs.Point
+	})(v)
+	return (_Map_Point_Point_Zapper)(x).MarshalLogArray(enc)
+}
+
 type State string
 
 // ToWire translates State into a Thrift-level intermediate
@@ -1027,7 +2276,7 @@ type State string
 // into bytes using a ThriftRW protocol implementation.
 func (v State) ToWire() (wire.Value, error) {
 	x := (string)(v)
-	return wire.NewValueString(x), error(nil)
+	return wire.NewValueString(x), nil
 }
 
 // String returns a readable string representation of State.
@@ -1040,9 +2289,8 @@ func (v State) String() string {
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 func (v *State) FromWire(w wire.Value) error {
-	x, err := w.GetString(), error(nil)
-	*v = (State)(x)
-	return err
+	*v = (State)(w.GetString())
+	return nil
 }
 
 // Equals returns true if this State is equal to the provided
@@ -1051,6 +2299,12 @@ func (lhs State) Equals(rhs State) bool {
 	return (lhs == rhs)
 }
 
+// Clone returns a deep copy of this State.
+func (v State) Clone() State {
+	x := (string)(v)
+	return (State)(x)
+}
+
 // Number of seconds since epoch.
 //
 // Deprecated: Use ISOTime instead.
@@ -1061,7 +2315,7 @@ type Timestamp int64
 // into bytes using a ThriftRW protocol implementation.
 func (v Timestamp) ToWire() (wire.Value, error) {
 	x := (int64)(v)
-	return wire.NewValueI64(x), error(nil)
+	return wire.NewValueI64(x), nil
 }
 
 // String returns a readable string representation of Timestamp.
@@ -1074,9 +2328,8 @@ func (v Timestamp) String() string {
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 func (v *Timestamp) FromWire(w wire.Value) error {
-	x, err := w.GetI64(), error(nil)
-	*v = (Timestamp)(x)
-	return err
+	*v = (Timestamp)(w.GetI64())
+	return nil
 }
 
 // Equals returns true if this Timestamp is equal to the provided
@@ -1085,6 +2338,12 @@ func (lhs Timestamp) Equals(rhs Timestamp) bool {
 	return (lhs == rhs)
 }
 
+// Clone returns a deep copy of this Timestamp.
+func (v Timestamp) Clone() Timestamp {
+	x := (int64)(v)
+	return (Timestamp)(x)
+}
+
 type Transition struct {
 	FromState State      `json:"fromState,required"`
 	ToState   State      `json:"toState,required"`
@@ -1114,6 +2373,10 @@ func (v *Transition) ToWire() (wire.Value, error) {
 		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("Transition is nil")
+	}
+
 	w, err = v.FromState.ToWire()
 	if err != nil {
 		return w, err
@@ -1247,6 +2510,79 @@ func (v *Transition) Equals(rhs *Transition) bool {
 	return true
 }
 
+// Clone returns a deep copy of this Transition.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Transition) Clone() *Transition {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Events = v.Events.Clone()
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Transition.
+func (v *Transition) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("fromState", (string)(v.FromState))
+	enc.AddString("toState", (string)(v.ToState))
+	if v.Events != nil {
+		if err := enc.AddArray("events", v.Events); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetFromState returns the value of FromState if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Transition.
+func (v *Transition) GetFromState() (o State) {
+	if v != nil {
+		o = v.FromState
+	}
+	return
+}
+
+// GetToState returns the value of ToState if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Transition.
+func (v *Transition) GetToState() (o State) {
+	if v != nil {
+		o = v.ToState
+	}
+	return
+}
+
+// GetEvents returns the value of Events if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Transition.
+func (v *Transition) GetEvents() (o EventGroup) {
+	if v != nil && v.Events != nil {
+		return v.Events
+	}
+
+	return
+}
+
+// IsSetEvents returns true if Events is not nil.
+//
+// This is safe to call on a nil Transition.
+func (v *Transition) IsSetEvents() bool {
+	return v != nil && v.Events != nil
+}
+
 type UUID I128
 
 // ToWire translates UUID into a Thrift-level intermediate
@@ -1276,6 +2612,18 @@ func (lhs *UUID) Equals(rhs *UUID) bool {
 	return (*I128)(lhs).Equals((*I128)(rhs))
 }
 
+// Clone returns a deep copy of this UUID.
+func (v *UUID) Clone() *UUID {
+	return (*UUID)((*I128)(v).Clone())
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of UUID.
+func (v *UUID) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	x := (*I128)(v)
+	return x.MarshalLogObject(enc)
+}
+
 type I128 struct {
 	High int64 `json:"high,required"`
 	Low  int64 `json:"low,required"`
@@ -1301,20 +2649,17 @@ func (v *I128) ToWire() (wire.Value, error) {
 		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
-	w, err = wire.NewValueI64(v.High), error(nil)
-	if err != nil {
-		return w, err
+	if v == nil {
+		return wire.Value{}, errors.New("I128 is nil")
 	}
+
+	w = wire.NewValueI64(v.High)
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 
-	w, err = wire.NewValueI64(v.Low), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueI64(v.Low)
 	fields[i] = wire.Field{ID: 2, Value: w}
 	i++
 
@@ -1339,7 +2684,6 @@ func (v *I128) ToWire() (wire.Value, error) {
 //   }
 //   return &v, nil
 func (v *I128) FromWire(w wire.Value) error {
-	var err error
 
 	highIsSet := false
 	lowIsSet := false
@@ -1348,18 +2692,12 @@ func (v *I128) FromWire(w wire.Value) error {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TI64 {
-				v.High, err = field.Value.GetI64(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.High = field.Value.GetI64()
 				highIsSet = true
 			}
 		case 2:
 			if field.Value.Type() == wire.TI64 {
-				v.Low, err = field.Value.GetI64(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.Low = field.Value.GetI64()
 				lowIsSet = true
 			}
 		}
@@ -1407,3 +2745,51 @@ func (v *I128) Equals(rhs *I128) bool {
 
 	return true
 }
+
+// Clone returns a deep copy of this I128.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *I128) Clone() *I128 {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of I128.
+func (v *I128) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddInt64("high", v.High)
+	enc.AddInt64("low", v.Low)
+	return nil
+}
+
+// GetHigh returns the value of High if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil I128.
+func (v *I128) GetHigh() (o int64) {
+	if v != nil {
+		o = v.High
+	}
+	return
+}
+
+// GetLow returns the value of Low if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil I128.
+func (v *I128) GetLow() (o int64) {
+	if v != nil {
+		o = v.Low
+	}
+	return
+}
@@ -10,10 +10,11 @@ import (
 
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
-	Name:     "unions",
-	Package:  "go.uber.org/thriftrw/gen/testdata/unions",
-	FilePath: "unions.thrift",
-	SHA1:     "ae222d8ff3a8efe55b3d2ebb0c70b4565255623c",
+	Name:            "unions",
+	Package:         "go.uber.org/thriftrw/gen/testdata/unions",
+	FilePath:        "unions.thrift",
+	SHA1:            "ae222d8ff3a8efe55b3d2ebb0c70b4565255623c",
+	ThriftRWVersion: "1.9.0",
 	Includes: []*thriftreflect.ThriftModule{
 		typedefs.ThriftModule,
 	},
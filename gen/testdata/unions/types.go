@@ -4,10 +4,14 @@
 package unions
 
 import (
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"strings"
+
 	"go.uber.org/thriftrw/gen/testdata/typedefs"
 	"go.uber.org/thriftrw/wire"
-	"strings"
+	"go.uber.org/zap/zapcore"
 )
 
 // ArbitraryValue allows constructing complex values without a schema.
@@ -63,17 +67,13 @@ func (m _Map_String_ArbitraryValue_MapItemList) ForEach(f func(wire.MapItem) err
 		if v == nil {
 			return fmt.Errorf("invalid [%v]: value is nil", k)
 		}
-		kw, err := wire.NewValueString(k), error(nil)
-		if err != nil {
-			return err
-		}
+		kw := wire.NewValueString(k)
 
 		vw, err := v.ToWire()
 		if err != nil {
 			return err
 		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
+		if err = f(wire.MapItem{Key: kw, Value: vw}); err != nil {
 			return err
 		}
 	}
@@ -114,46 +114,34 @@ func (v *ArbitraryValue) ToWire() (wire.Value, error) {
 		fields [5]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("ArbitraryValue is nil")
+	}
+
 	if v.BoolValue != nil {
-		w, err = wire.NewValueBool(*(v.BoolValue)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueBool(*(v.BoolValue))
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
 	if v.Int64Value != nil {
-		w, err = wire.NewValueI64(*(v.Int64Value)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueI64(*(v.Int64Value))
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
 	if v.StringValue != nil {
-		w, err = wire.NewValueString(*(v.StringValue)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueString(*(v.StringValue))
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
 	if v.ListValue != nil {
-		w, err = wire.NewValueList(_List_ArbitraryValue_ValueList(v.ListValue)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueList(_List_ArbitraryValue_ValueList(v.ListValue))
 		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
 	if v.MapValue != nil {
-		w, err = wire.NewValueMap(_Map_String_ArbitraryValue_MapItemList(v.MapValue)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueMap(_Map_String_ArbitraryValue_MapItemList(v.MapValue))
 		fields[i] = wire.Field{ID: 5, Value: w}
 		i++
 	}
@@ -200,10 +188,7 @@ func _Map_String_ArbitraryValue_Read(m wire.MapItemList) (map[string]*ArbitraryV
 
 	o := make(map[string]*ArbitraryValue, m.Size())
 	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
+		k := x.Key.GetString()
 
 		v, err := _ArbitraryValue_Read(x.Value)
 		if err != nil {
@@ -241,32 +226,20 @@ func (v *ArbitraryValue) FromWire(w wire.Value) error {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
+				x := field.Value.GetBool()
 				v.BoolValue = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		case 2:
 			if field.Value.Type() == wire.TI64 {
-				var x int64
-				x, err = field.Value.GetI64(), error(nil)
+				x := field.Value.GetI64()
 				v.Int64Value = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		case 3:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
+				x := field.Value.GetString()
 				v.StringValue = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		case 4:
@@ -430,36 +403,227 @@ func (v *ArbitraryValue) Equals(rhs *ArbitraryValue) bool {
 	return true
 }
 
+func _Bool_ClonePtr(p *bool) *bool {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _I64_ClonePtr(p *int64) *int64 {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _String_ClonePtr(p *string) *string {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _List_ArbitraryValue_Clone(l []*ArbitraryValue) []*ArbitraryValue {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]*ArbitraryValue, len(l))
+	for i, x := range l {
+		o[i] = x.Clone()
+	}
+	return o
+}
+
+func _Map_String_ArbitraryValue_Clone(m map[string]*ArbitraryValue) map[string]*ArbitraryValue {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[string]*ArbitraryValue, len(m))
+	for k, v := range m {
+		o[k] = v.Clone()
+	}
+
+	return o
+}
+
+// Clone returns a deep copy of this ArbitraryValue.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *ArbitraryValue) Clone() *ArbitraryValue {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.BoolValue = _Bool_ClonePtr(v.BoolValue)
+	o.Int64Value = _I64_ClonePtr(v.Int64Value)
+	o.StringValue = _String_ClonePtr(v.StringValue)
+	o.ListValue = _List_ArbitraryValue_Clone(v.ListValue)
+	o.MapValue = _Map_String_ArbitraryValue_Clone(v.MapValue)
+
+	return &o
+}
+
+type _List_ArbitraryValue_Zapper []*ArbitraryValue
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_ArbitraryValue_Zapper.
+func (l _List_ArbitraryValue_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		if err := enc.AppendObject(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _Map_String_ArbitraryValue_Zapper map[string]*ArbitraryValue
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_ArbitraryValue_Zapper.
+func (m _Map_String_ArbitraryValue_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for k, v := range m {
+		if err := enc.AddObject((string)(k), v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of ArbitraryValue.
+func (v *ArbitraryValue) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.BoolValue != nil {
+		enc.AddBool("boolValue", *v.BoolValue)
+	}
+	if v.Int64Value != nil {
+		enc.AddInt64("int64Value", *v.Int64Value)
+	}
+	if v.StringValue != nil {
+		enc.AddString("stringValue", *v.StringValue)
+	}
+	if v.ListValue != nil {
+		if err := enc.AddArray("listValue", (_List_ArbitraryValue_Zapper)(v.ListValue)); err != nil {
+			return err
+		}
+	}
+	if v.MapValue != nil {
+		if err := enc.AddObject("mapValue", (_Map_String_ArbitraryValue_Zapper)(v.MapValue)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // GetBoolValue returns the value of BoolValue if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil ArbitraryValue.
 func (v *ArbitraryValue) GetBoolValue() (o bool) {
-	if v.BoolValue != nil {
+	if v != nil && v.BoolValue != nil {
 		return *v.BoolValue
 	}
 
 	return
 }
 
+// IsSetBoolValue returns true if BoolValue is not nil.
+//
+// This is safe to call on a nil ArbitraryValue.
+func (v *ArbitraryValue) IsSetBoolValue() bool {
+	return v != nil && v.BoolValue != nil
+}
+
 // GetInt64Value returns the value of Int64Value if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil ArbitraryValue.
 func (v *ArbitraryValue) GetInt64Value() (o int64) {
-	if v.Int64Value != nil {
+	if v != nil && v.Int64Value != nil {
 		return *v.Int64Value
 	}
 
 	return
 }
 
+// IsSetInt64Value returns true if Int64Value is not nil.
+//
+// This is safe to call on a nil ArbitraryValue.
+func (v *ArbitraryValue) IsSetInt64Value() bool {
+	return v != nil && v.Int64Value != nil
+}
+
 // GetStringValue returns the value of StringValue if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil ArbitraryValue.
 func (v *ArbitraryValue) GetStringValue() (o string) {
-	if v.StringValue != nil {
+	if v != nil && v.StringValue != nil {
 		return *v.StringValue
 	}
 
 	return
 }
 
+// IsSetStringValue returns true if StringValue is not nil.
+//
+// This is safe to call on a nil ArbitraryValue.
+func (v *ArbitraryValue) IsSetStringValue() bool {
+	return v != nil && v.StringValue != nil
+}
+
+// GetListValue returns the value of ListValue if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil ArbitraryValue.
+func (v *ArbitraryValue) GetListValue() (o []*ArbitraryValue) {
+	if v != nil && v.ListValue != nil {
+		return v.ListValue
+	}
+
+	return
+}
+
+// IsSetListValue returns true if ListValue is not nil.
+//
+// This is safe to call on a nil ArbitraryValue.
+func (v *ArbitraryValue) IsSetListValue() bool {
+	return v != nil && v.ListValue != nil
+}
+
+// GetMapValue returns the value of MapValue if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil ArbitraryValue.
+func (v *ArbitraryValue) GetMapValue() (o map[string]*ArbitraryValue) {
+	if v != nil && v.MapValue != nil {
+		return v.MapValue
+	}
+
+	return
+}
+
+// IsSetMapValue returns true if MapValue is not nil.
+//
+// This is safe to call on a nil ArbitraryValue.
+func (v *ArbitraryValue) IsSetMapValue() bool {
+	return v != nil && v.MapValue != nil
+}
+
 type Document struct {
 	Pdf       typedefs.PDF `json:"pdf,omitempty"`
 	PlainText *string      `json:"plainText,omitempty"`
@@ -488,6 +652,10 @@ func (v *Document) ToWire() (wire.Value, error) {
 		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("Document is nil")
+	}
+
 	if v.Pdf != nil {
 		w, err = v.Pdf.ToWire()
 		if err != nil {
@@ -497,10 +665,7 @@ func (v *Document) ToWire() (wire.Value, error) {
 		i++
 	}
 	if v.PlainText != nil {
-		w, err = wire.NewValueString(*(v.PlainText)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueString(*(v.PlainText))
 		fields[i] = wire.Field{ID: 2, Value: w}
 		i++
 	}
@@ -550,12 +715,8 @@ func (v *Document) FromWire(w wire.Value) error {
 			}
 		case 2:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
+				x := field.Value.GetString()
 				v.PlainText = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		}
@@ -611,16 +772,76 @@ func (v *Document) Equals(rhs *Document) bool {
 	return true
 }
 
+// Clone returns a deep copy of this Document.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Document) Clone() *Document {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Pdf = v.Pdf.Clone()
+	o.PlainText = _String_ClonePtr(v.PlainText)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Document.
+func (v *Document) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Pdf != nil {
+		enc.AddString("pdf", base64.StdEncoding.EncodeToString(v.Pdf))
+	}
+	if v.PlainText != nil {
+		enc.AddString("plainText", *v.PlainText)
+	}
+	return nil
+}
+
+// GetPdf returns the value of Pdf if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Document.
+func (v *Document) GetPdf() (o typedefs.PDF) {
+	if v != nil && v.Pdf != nil {
+		return v.Pdf
+	}
+
+	return
+}
+
+// IsSetPdf returns true if Pdf is not nil.
+//
+// This is safe to call on a nil Document.
+func (v *Document) IsSetPdf() bool {
+	return v != nil && v.Pdf != nil
+}
+
 // GetPlainText returns the value of PlainText if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil Document.
 func (v *Document) GetPlainText() (o string) {
-	if v.PlainText != nil {
+	if v != nil && v.PlainText != nil {
 		return *v.PlainText
 	}
 
 	return
 }
 
+// IsSetPlainText returns true if PlainText is not nil.
+//
+// This is safe to call on a nil Document.
+func (v *Document) IsSetPlainText() bool {
+	return v != nil && v.PlainText != nil
+}
+
 type EmptyUnion struct {
 }
 
@@ -696,3 +917,27 @@ func (v *EmptyUnion) Equals(rhs *EmptyUnion) bool {
 
 	return true
 }
+
+// Clone returns a deep copy of this EmptyUnion.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *EmptyUnion) Clone() *EmptyUnion {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of EmptyUnion.
+func (v *EmptyUnion) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	return nil
+}
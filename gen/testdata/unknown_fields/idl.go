@@ -0,0 +1,18 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package unknown_fields
+
+import "go.uber.org/thriftrw/thriftreflect"
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:            "unknown_fields",
+	Package:         "go.uber.org/thriftrw/gen/testdata/unknown_fields",
+	FilePath:        "unknown_fields.thrift",
+	SHA1:            "36270bd9133e1f0e0df694d32755299ea52cdaf3",
+	ThriftRWVersion: "1.9.0",
+	Raw:             rawIDL,
+}
+
+const rawIDL = "// Code for this file is generated with --preserve-unknown-fields.\n\nstruct Record {\n    1: required string name\n    2: optional i32 count\n}\n\n// RecordV2 is a newer version of Record with more fields.\nstruct RecordV2 {\n    1: required string name\n    2: optional i32 count\n    3: optional list<string> tags\n    4: optional map<string, list<i64>> history\n    5: optional binary blob\n    6: optional Record parent\n}\n\nexception Failure {\n    1: optional string message\n}\n\nunion Choice {\n    1: string text\n    2: i32 number\n}\n"
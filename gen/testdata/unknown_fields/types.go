@@ -0,0 +1,1256 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package unknown_fields
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
+)
+
+type Choice struct {
+	Text   *string `json:"text,omitempty"`
+	Number *int32  `json:"number,omitempty"`
+}
+
+// ToWire translates a Choice struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Choice) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Choice is nil")
+	}
+
+	if v.Text != nil {
+		w = wire.NewValueString(*(v.Text))
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.Number != nil {
+		w = wire.NewValueI32(*(v.Number))
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Choice should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Choice struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Choice struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Choice
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Choice) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				x := field.Value.GetString()
+				v.Text = &x
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				x := field.Value.GetI32()
+				v.Number = &x
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Text != nil {
+		count++
+	}
+	if v.Number != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Choice should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Choice
+// struct.
+func (v *Choice) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Text != nil {
+		fields[i] = fmt.Sprintf("Text: %v", *(v.Text))
+		i++
+	}
+	if v.Number != nil {
+		fields[i] = fmt.Sprintf("Number: %v", *(v.Number))
+		i++
+	}
+
+	return fmt.Sprintf("Choice{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _I32_EqualsPtr(lhs, rhs *int32) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Choice match the
+// provided Choice.
+//
+// This function performs a deep comparison.
+func (v *Choice) Equals(rhs *Choice) bool {
+	if !_String_EqualsPtr(v.Text, rhs.Text) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Number, rhs.Number) {
+		return false
+	}
+
+	return true
+}
+
+func _String_ClonePtr(p *string) *string {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _I32_ClonePtr(p *int32) *int32 {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this Choice.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Choice) Clone() *Choice {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Text = _String_ClonePtr(v.Text)
+	o.Number = _I32_ClonePtr(v.Number)
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Choice.
+func (v *Choice) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Text != nil {
+		enc.AddString("text", *v.Text)
+	}
+	if v.Number != nil {
+		enc.AddInt32("number", *v.Number)
+	}
+	return nil
+}
+
+// GetText returns the value of Text if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Choice.
+func (v *Choice) GetText() (o string) {
+	if v != nil && v.Text != nil {
+		return *v.Text
+	}
+
+	return
+}
+
+// IsSetText returns true if Text is not nil.
+//
+// This is safe to call on a nil Choice.
+func (v *Choice) IsSetText() bool {
+	return v != nil && v.Text != nil
+}
+
+// GetNumber returns the value of Number if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Choice.
+func (v *Choice) GetNumber() (o int32) {
+	if v != nil && v.Number != nil {
+		return *v.Number
+	}
+
+	return
+}
+
+// IsSetNumber returns true if Number is not nil.
+//
+// This is safe to call on a nil Choice.
+func (v *Choice) IsSetNumber() bool {
+	return v != nil && v.Number != nil
+}
+
+type Failure struct {
+	Message *string `json:"message,omitempty"`
+
+	// Fields which were not recognized when this Failure was
+	// decoded. They are written back when it is encoded.
+	UnknownFields wire.UnknownFields `json:"-"`
+}
+
+// ToWire translates a Failure struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Failure) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Failure is nil")
+	}
+
+	if v.Message != nil {
+		w = wire.NewValueString(*(v.Message))
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: append(fields[:i], v.UnknownFields...)}), nil
+}
+
+// FromWire deserializes a Failure struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Failure struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Failure
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Failure) FromWire(w wire.Value) error {
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				x := field.Value.GetString()
+				v.Message = &x
+
+			}
+		default:
+			if err := v.UnknownFields.Add(field); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Failure
+// struct.
+func (v *Failure) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	if v.Message != nil {
+		fields[i] = fmt.Sprintf("Message: %v", *(v.Message))
+		i++
+	}
+
+	return fmt.Sprintf("Failure{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Failure match the
+// provided Failure.
+//
+// This function performs a deep comparison.
+func (v *Failure) Equals(rhs *Failure) bool {
+	if !_String_EqualsPtr(v.Message, rhs.Message) {
+		return false
+	}
+
+	if !v.UnknownFields.Equals(rhs.UnknownFields) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of this Failure.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Failure) Clone() *Failure {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Message = _String_ClonePtr(v.Message)
+	o.UnknownFields = v.UnknownFields.Clone()
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Failure.
+func (v *Failure) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Message != nil {
+		enc.AddString("message", *v.Message)
+	}
+	return nil
+}
+
+// GetMessage returns the value of Message if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Failure.
+func (v *Failure) GetMessage() (o string) {
+	if v != nil && v.Message != nil {
+		return *v.Message
+	}
+
+	return
+}
+
+// IsSetMessage returns true if Message is not nil.
+//
+// This is safe to call on a nil Failure.
+func (v *Failure) IsSetMessage() bool {
+	return v != nil && v.Message != nil
+}
+
+// Error returns the message of the exception if it is set and the
+// String representation of the exception otherwise.
+func (v *Failure) Error() string {
+	if m := v.GetMessage(); m != "" {
+		return m
+	}
+	return v.String()
+}
+
+type Record struct {
+	Name  string `json:"name,required"`
+	Count *int32 `json:"count,omitempty"`
+
+	// Fields which were not recognized when this Record was
+	// decoded. They are written back when it is encoded.
+	UnknownFields wire.UnknownFields `json:"-"`
+}
+
+// ToWire translates a Record struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Record) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Record is nil")
+	}
+
+	w = wire.NewValueString(v.Name)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Count != nil {
+		w = wire.NewValueI32(*(v.Count))
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: append(fields[:i], v.UnknownFields...)}), nil
+}
+
+// FromWire deserializes a Record struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Record struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Record
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Record) FromWire(w wire.Value) error {
+
+	nameIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name = field.Value.GetString()
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				x := field.Value.GetI32()
+				v.Count = &x
+
+			}
+		default:
+			if err := v.UnknownFields.Add(field); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Record is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Record
+// struct.
+func (v *Record) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	if v.Count != nil {
+		fields[i] = fmt.Sprintf("Count: %v", *(v.Count))
+		i++
+	}
+
+	return fmt.Sprintf("Record{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Record match the
+// provided Record.
+//
+// This function performs a deep comparison.
+func (v *Record) Equals(rhs *Record) bool {
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Count, rhs.Count) {
+		return false
+	}
+
+	if !v.UnknownFields.Equals(rhs.UnknownFields) {
+		return false
+	}
+	return true
+}
+
+// Clone returns a deep copy of this Record.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Record) Clone() *Record {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Count = _I32_ClonePtr(v.Count)
+	o.UnknownFields = v.UnknownFields.Clone()
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Record.
+func (v *Record) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("name", v.Name)
+	if v.Count != nil {
+		enc.AddInt32("count", *v.Count)
+	}
+	return nil
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Record.
+func (v *Record) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetCount returns the value of Count if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Record.
+func (v *Record) GetCount() (o int32) {
+	if v != nil && v.Count != nil {
+		return *v.Count
+	}
+
+	return
+}
+
+// IsSetCount returns true if Count is not nil.
+//
+// This is safe to call on a nil Record.
+func (v *Record) IsSetCount() bool {
+	return v != nil && v.Count != nil
+}
+
+type RecordV2 struct {
+	Name    string             `json:"name,required"`
+	Count   *int32             `json:"count,omitempty"`
+	Tags    []string           `json:"tags,omitempty"`
+	History map[string][]int64 `json:"history,omitempty"`
+	Blob    []byte             `json:"blob,omitempty"`
+	Parent  *Record            `json:"parent,omitempty"`
+
+	// Fields which were not recognized when this RecordV2 was
+	// decoded. They are written back when it is encoded.
+	UnknownFields wire.UnknownFields `json:"-"`
+}
+
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		if err := f(wire.NewValueString(x)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
+type _List_I64_ValueList []int64
+
+func (v _List_I64_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		if err := f(wire.NewValueI64(x)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_I64_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_I64_ValueList) ValueType() wire.Type {
+	return wire.TI64
+}
+
+func (_List_I64_ValueList) Close() {}
+
+type _Map_String_List_I64_MapItemList map[string][]int64
+
+func (m _Map_String_List_I64_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw := wire.NewValueString(k)
+
+		vw := wire.NewValueList(_List_I64_ValueList(v))
+		if err := f(wire.MapItem{Key: kw, Value: vw}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_List_I64_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_List_I64_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_List_I64_MapItemList) ValueType() wire.Type {
+	return wire.TList
+}
+
+func (_Map_String_List_I64_MapItemList) Close() {}
+
+// ToWire translates a RecordV2 struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *RecordV2) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("RecordV2 is nil")
+	}
+
+	w = wire.NewValueString(v.Name)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Count != nil {
+		w = wire.NewValueI32(*(v.Count))
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Tags != nil {
+		w = wire.NewValueList(_List_String_ValueList(v.Tags))
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.History != nil {
+		w = wire.NewValueMap(_Map_String_List_I64_MapItemList(v.History))
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Blob != nil {
+		w = wire.NewValueBinary(v.Blob)
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.Parent != nil {
+		w, err = v.Parent.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: append(fields[:i], v.UnknownFields...)}), nil
+}
+
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		o = append(o, x.GetString())
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _List_I64_Read(l wire.ValueList) ([]int64, error) {
+	if l.ValueType() != wire.TI64 {
+		return nil, nil
+	}
+
+	o := make([]int64, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		o = append(o, x.GetI64())
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Map_String_List_I64_Read(m wire.MapItemList) (map[string][]int64, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TList {
+		return nil, nil
+	}
+
+	o := make(map[string][]int64, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k := x.Key.GetString()
+
+		v, err := _List_I64_Read(x.Value.GetList())
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Record_Read(w wire.Value) (*Record, error) {
+	var v Record
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a RecordV2 struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a RecordV2 struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v RecordV2
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *RecordV2) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name = field.Value.GetString()
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TI32 {
+				x := field.Value.GetI32()
+				v.Count = &x
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TList {
+				v.Tags, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TMap {
+				v.History, err = _Map_String_List_I64_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TBinary {
+				v.Blob = field.Value.GetBinary()
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TStruct {
+				v.Parent, err = _Record_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		default:
+			if err = v.UnknownFields.Add(field); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of RecordV2 is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a RecordV2
+// struct.
+func (v *RecordV2) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	if v.Count != nil {
+		fields[i] = fmt.Sprintf("Count: %v", *(v.Count))
+		i++
+	}
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+	if v.History != nil {
+		fields[i] = fmt.Sprintf("History: %v", v.History)
+		i++
+	}
+	if v.Blob != nil {
+		fields[i] = fmt.Sprintf("Blob: %v", v.Blob)
+		i++
+	}
+	if v.Parent != nil {
+		fields[i] = fmt.Sprintf("Parent: %v", v.Parent)
+		i++
+	}
+
+	return fmt.Sprintf("RecordV2{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_I64_Equals(lhs, rhs []int64) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_List_I64_Equals(lhs, rhs map[string][]int64) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !_List_I64_Equals(lv, rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this RecordV2 match the
+// provided RecordV2.
+//
+// This function performs a deep comparison.
+func (v *RecordV2) Equals(rhs *RecordV2) bool {
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !_I32_EqualsPtr(v.Count, rhs.Count) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _List_String_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+	if !((v.History == nil && rhs.History == nil) || (v.History != nil && rhs.History != nil && _Map_String_List_I64_Equals(v.History, rhs.History))) {
+		return false
+	}
+	if !((v.Blob == nil && rhs.Blob == nil) || (v.Blob != nil && rhs.Blob != nil && bytes.Equal(v.Blob, rhs.Blob))) {
+		return false
+	}
+	if !((v.Parent == nil && rhs.Parent == nil) || (v.Parent != nil && rhs.Parent != nil && v.Parent.Equals(rhs.Parent))) {
+		return false
+	}
+
+	if !v.UnknownFields.Equals(rhs.UnknownFields) {
+		return false
+	}
+	return true
+}
+
+func _List_String_Clone(l []string) []string {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]string, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
+}
+
+func _List_I64_Clone(l []int64) []int64 {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]int64, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
+}
+
+func _Map_String_List_I64_Clone(m map[string][]int64) map[string][]int64 {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[string][]int64, len(m))
+	for k, v := range m {
+		o[k] = _List_I64_Clone(v)
+	}
+
+	return o
+}
+
+func _Binary_Clone(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+
+	o := make([]byte, len(b))
+	copy(o, b)
+	return o
+}
+
+// Clone returns a deep copy of this RecordV2.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *RecordV2) Clone() *RecordV2 {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Count = _I32_ClonePtr(v.Count)
+	o.Tags = _List_String_Clone(v.Tags)
+	o.History = _Map_String_List_I64_Clone(v.History)
+	o.Blob = _Binary_Clone(v.Blob)
+	o.Parent = v.Parent.Clone()
+	o.UnknownFields = v.UnknownFields.Clone()
+
+	return &o
+}
+
+type _List_String_Zapper []string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_String_Zapper.
+func (l _List_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		enc.AppendString(v)
+	}
+	return nil
+}
+
+type _List_I64_Zapper []int64
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_I64_Zapper.
+func (l _List_I64_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		enc.AppendInt64(v)
+	}
+	return nil
+}
+
+type _Map_String_List_I64_Zapper map[string][]int64
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_List_I64_Zapper.
+func (m _Map_String_List_I64_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for k, v := range m {
+		if err := enc.AddArray((string)(k), (_List_I64_Zapper)(v)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of RecordV2.
+func (v *RecordV2) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("name", v.Name)
+	if v.Count != nil {
+		enc.AddInt32("count", *v.Count)
+	}
+	if v.Tags != nil {
+		if err := enc.AddArray("tags", (_List_String_Zapper)(v.Tags)); err != nil {
+			return err
+		}
+	}
+	if v.History != nil {
+		if err := enc.AddObject("history", (_Map_String_List_I64_Zapper)(v.History)); err != nil {
+			return err
+		}
+	}
+	if v.Blob != nil {
+		enc.AddString("blob", base64.StdEncoding.EncodeToString(v.Blob))
+	}
+	if v.Parent != nil {
+		if err := enc.AddObject("parent", v.Parent); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil RecordV2.
+func (v *RecordV2) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetCount returns the value of Count if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil RecordV2.
+func (v *RecordV2) GetCount() (o int32) {
+	if v != nil && v.Count != nil {
+		return *v.Count
+	}
+
+	return
+}
+
+// IsSetCount returns true if Count is not nil.
+//
+// This is safe to call on a nil RecordV2.
+func (v *RecordV2) IsSetCount() bool {
+	return v != nil && v.Count != nil
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil RecordV2.
+func (v *RecordV2) GetTags() (o []string) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+//
+// This is safe to call on a nil RecordV2.
+func (v *RecordV2) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+// GetHistory returns the value of History if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil RecordV2.
+func (v *RecordV2) GetHistory() (o map[string][]int64) {
+	if v != nil && v.History != nil {
+		return v.History
+	}
+
+	return
+}
+
+// IsSetHistory returns true if History is not nil.
+//
+// This is safe to call on a nil RecordV2.
+func (v *RecordV2) IsSetHistory() bool {
+	return v != nil && v.History != nil
+}
+
+// GetBlob returns the value of Blob if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil RecordV2.
+func (v *RecordV2) GetBlob() (o []byte) {
+	if v != nil && v.Blob != nil {
+		return v.Blob
+	}
+
+	return
+}
+
+// IsSetBlob returns true if Blob is not nil.
+//
+// This is safe to call on a nil RecordV2.
+func (v *RecordV2) IsSetBlob() bool {
+	return v != nil && v.Blob != nil
+}
+
+// GetParent returns the value of Parent if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil RecordV2.
+func (v *RecordV2) GetParent() (o *Record) {
+	if v != nil && v.Parent != nil {
+		return v.Parent
+	}
+
+	return
+}
+
+// IsSetParent returns true if Parent is not nil.
+//
+// This is safe to call on a nil RecordV2.
+func (v *RecordV2) IsSetParent() bool {
+	return v != nil && v.Parent != nil
+}
@@ -0,0 +1,18 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package uuid
+
+import "go.uber.org/thriftrw/thriftreflect"
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:            "uuid",
+	Package:         "go.uber.org/thriftrw/gen/testdata/uuid",
+	FilePath:        "uuid.thrift",
+	SHA1:            "b8b51e5c346a559f9730cd5661732820f2338f2f",
+	ThriftRWVersion: "1.9.0",
+	Raw:             rawIDL,
+}
+
+const rawIDL = "typedef uuid RequestID\n\nconst uuid DefaultNamespace = \"6ba7b810-9dad-11d1-80b4-00c04fd430c8\"\nconst RequestID NilRequestID = \"00000000-0000-0000-0000-000000000000\"\n\nstruct Request {\n    1: required RequestID id\n    2: optional uuid parent\n    3: optional uuid scope = \"6ba7b810-9dad-11d1-80b4-00c04fd430c8\"\n    4: optional list<uuid> related\n    5: optional set<uuid> tags\n    6: optional map<uuid, string> names\n}\n\n/**\n * uuid is not a keyword, so it may still be used as an identifier.\n */\nstruct Entity {\n    1: required uuid uuid\n}\n"
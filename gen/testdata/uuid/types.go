@@ -0,0 +1,854 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package uuid
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
+)
+
+// uuid is not a keyword, so it may still be used as an identifier.
+type Entity struct {
+	UUID wire.UUID `json:"uuid,required"`
+}
+
+// ToWire translates a Entity struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Entity) ToWire() (wire.Value, error) {
+	var (
+		fields [1]wire.Field
+		i      int = 0
+		w      wire.Value
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Entity is nil")
+	}
+
+	w = wire.NewValueUUID(v.UUID)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a Entity struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Entity struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Entity
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Entity) FromWire(w wire.Value) error {
+
+	uuidIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TUUID {
+				v.UUID = field.Value.GetUUID()
+				uuidIsSet = true
+			}
+		}
+	}
+
+	if !uuidIsSet {
+		return errors.New("field UUID of Entity is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Entity
+// struct.
+func (v *Entity) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [1]string
+	i := 0
+	fields[i] = fmt.Sprintf("UUID: %v", v.UUID)
+	i++
+
+	return fmt.Sprintf("Entity{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Entity match the
+// provided Entity.
+//
+// This function performs a deep comparison.
+func (v *Entity) Equals(rhs *Entity) bool {
+	if !(v.UUID == rhs.UUID) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this Entity.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Entity) Clone() *Entity {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Entity.
+func (v *Entity) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("uuid", (v.UUID).String())
+	return nil
+}
+
+// GetUUID returns the value of UUID if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Entity.
+func (v *Entity) GetUUID() (o wire.UUID) {
+	if v != nil {
+		o = v.UUID
+	}
+	return
+}
+
+type Request struct {
+	ID      RequestID              `json:"id,required"`
+	Parent  *wire.UUID             `json:"parent,omitempty"`
+	Scope   *wire.UUID             `json:"scope,omitempty"`
+	Related []wire.UUID            `json:"related,omitempty"`
+	Tags    map[wire.UUID]struct{} `json:"tags,omitempty"`
+	Names   map[wire.UUID]string   `json:"names,omitempty"`
+}
+
+func _UUID_ptr(v wire.UUID) *wire.UUID {
+	return &v
+}
+
+// Default_Request constructs a new Request with its fields set to the
+// default values declared for them in the Thrift file.
+func Default_Request() *Request {
+	var v Request
+	v.Scope = _UUID_ptr(wire.UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8})
+	return &v
+}
+
+type _List_UUID_ValueList []wire.UUID
+
+func (v _List_UUID_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		if err := f(wire.NewValueUUID(x)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_UUID_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_UUID_ValueList) ValueType() wire.Type {
+	return wire.TUUID
+}
+
+func (_List_UUID_ValueList) Close() {}
+
+type _Set_UUID_ValueList map[wire.UUID]struct{}
+
+func (v _Set_UUID_ValueList) ForEach(f func(wire.Value) error) error {
+	for x := range v {
+		if err := f(wire.NewValueUUID(x)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_UUID_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_UUID_ValueList) ValueType() wire.Type {
+	return wire.TUUID
+}
+
+func (_Set_UUID_ValueList) Close() {}
+
+type _Map_UUID_String_MapItemList map[wire.UUID]string
+
+func (m _Map_UUID_String_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		kw := wire.NewValueUUID(k)
+
+		vw := wire.NewValueString(v)
+		if err := f(wire.MapItem{Key: kw, Value: vw}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_UUID_String_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_UUID_String_MapItemList) KeyType() wire.Type {
+	return wire.TUUID
+}
+
+func (_Map_UUID_String_MapItemList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_UUID_String_MapItemList) Close() {}
+
+// ToWire translates a Request struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Request) ToWire() (wire.Value, error) {
+	var (
+		fields [6]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Request is nil")
+	}
+
+	w, err = v.ID.ToWire()
+	if err != nil {
+		return w, err
+	}
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Parent != nil {
+		w = wire.NewValueUUID(*(v.Parent))
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Scope == nil {
+		v.Scope = _UUID_ptr(wire.UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8})
+	}
+	{
+		w = wire.NewValueUUID(*(v.Scope))
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Related != nil {
+		w = wire.NewValueList(_List_UUID_ValueList(v.Related))
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.Tags != nil {
+		w = wire.NewValueSet(_Set_UUID_ValueList(v.Tags))
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.Names != nil {
+		w = wire.NewValueMap(_Map_UUID_String_MapItemList(v.Names))
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _RequestID_Read(w wire.Value) (RequestID, error) {
+	var x RequestID
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _List_UUID_Read(l wire.ValueList) ([]wire.UUID, error) {
+	if l.ValueType() != wire.TUUID {
+		return nil, nil
+	}
+
+	o := make([]wire.UUID, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		o = append(o, x.GetUUID())
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Set_UUID_Read(s wire.ValueList) (map[wire.UUID]struct{}, error) {
+	if s.ValueType() != wire.TUUID {
+		return nil, nil
+	}
+
+	o := make(map[wire.UUID]struct{}, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i := x.GetUUID()
+
+		o[i] = struct{}{}
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+func _Map_UUID_String_Read(m wire.MapItemList) (map[wire.UUID]string, error) {
+	if m.KeyType() != wire.TUUID {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make(map[wire.UUID]string, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k := x.Key.GetUUID()
+
+		v := x.Value.GetString()
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+// FromWire deserializes a Request struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Request struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Request
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Request) FromWire(w wire.Value) error {
+	var err error
+
+	idIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TUUID {
+				v.ID, err = _RequestID_Read(field.Value)
+				if err != nil {
+					return err
+				}
+				idIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TUUID {
+				x := field.Value.GetUUID()
+				v.Parent = &x
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TUUID {
+				x := field.Value.GetUUID()
+				v.Scope = &x
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TList {
+				v.Related, err = _List_UUID_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TSet {
+				v.Tags, err = _Set_UUID_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TMap {
+				v.Names, err = _Map_UUID_String_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !idIsSet {
+		return errors.New("field ID of Request is required")
+	}
+
+	if v.Scope == nil {
+		v.Scope = _UUID_ptr(wire.UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8})
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Request
+// struct.
+func (v *Request) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [6]string
+	i := 0
+	fields[i] = fmt.Sprintf("ID: %v", v.ID)
+	i++
+	if v.Parent != nil {
+		fields[i] = fmt.Sprintf("Parent: %v", *(v.Parent))
+		i++
+	}
+	if v.Scope != nil {
+		fields[i] = fmt.Sprintf("Scope: %v", *(v.Scope))
+		i++
+	}
+	if v.Related != nil {
+		fields[i] = fmt.Sprintf("Related: %v", v.Related)
+		i++
+	}
+	if v.Tags != nil {
+		fields[i] = fmt.Sprintf("Tags: %v", v.Tags)
+		i++
+	}
+	if v.Names != nil {
+		fields[i] = fmt.Sprintf("Names: %v", v.Names)
+		i++
+	}
+
+	return fmt.Sprintf("Request{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _UUID_EqualsPtr(lhs, rhs *wire.UUID) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+func _List_UUID_Equals(lhs, rhs []wire.UUID) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Set_UUID_Equals(lhs, rhs map[wire.UUID]struct{}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for x := range rhs {
+		if _, ok := lhs[x]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_UUID_String_Equals(lhs, rhs map[wire.UUID]string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !(lv == rv) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equals returns true if all the fields of this Request match the
+// provided Request.
+//
+// This function performs a deep comparison.
+func (v *Request) Equals(rhs *Request) bool {
+	if !(v.ID == rhs.ID) {
+		return false
+	}
+	if !_UUID_EqualsPtr(v.Parent, rhs.Parent) {
+		return false
+	}
+	if !_UUID_EqualsPtr(v.Scope, rhs.Scope) {
+		return false
+	}
+	if !((v.Related == nil && rhs.Related == nil) || (v.Related != nil && rhs.Related != nil && _List_UUID_Equals(v.Related, rhs.Related))) {
+		return false
+	}
+	if !((v.Tags == nil && rhs.Tags == nil) || (v.Tags != nil && rhs.Tags != nil && _Set_UUID_Equals(v.Tags, rhs.Tags))) {
+		return false
+	}
+	if !((v.Names == nil && rhs.Names == nil) || (v.Names != nil && rhs.Names != nil && _Map_UUID_String_Equals(v.Names, rhs.Names))) {
+		return false
+	}
+
+	return true
+}
+
+func _UUID_ClonePtr(p *wire.UUID) *wire.UUID {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _List_UUID_Clone(l []wire.UUID) []wire.UUID {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]wire.UUID, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
+}
+
+func _Set_UUID_Clone(s map[wire.UUID]struct{}) map[wire.UUID]struct{} {
+	if s == nil {
+		return nil
+	}
+
+	o := make(map[wire.UUID]struct{}, len(s))
+	for x := range s {
+		o[x] = struct{}{}
+	}
+
+	return o
+}
+
+func _Map_UUID_String_Clone(m map[wire.UUID]string) map[wire.UUID]string {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[wire.UUID]string, len(m))
+	for k, v := range m {
+		o[k] = v
+	}
+
+	return o
+}
+
+// Clone returns a deep copy of this Request.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Request) Clone() *Request {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Parent = _UUID_ClonePtr(v.Parent)
+	o.Scope = _UUID_ClonePtr(v.Scope)
+	o.Related = _List_UUID_Clone(v.Related)
+	o.Tags = _Set_UUID_Clone(v.Tags)
+	o.Names = _Map_UUID_String_Clone(v.Names)
+
+	return &o
+}
+
+type _List_UUID_Zapper []wire.UUID
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_UUID_Zapper.
+func (l _List_UUID_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		enc.AppendString((v).String())
+	}
+	return nil
+}
+
+type _Set_UUID_Zapper map[wire.UUID]struct{}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_UUID_Zapper.
+func (s _Set_UUID_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for v := range s {
+		enc.AppendString((v).String())
+	}
+	return nil
+}
+
+type _Map_UUID_String_Item_Zapper struct {
+	Key   wire.UUID
+	Value string
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_UUID_String_Item_Zapper.
+func (i _Map_UUID_String_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("key", (i.Key).String())
+	enc.AddString("value", i.Value)
+	return nil
+}
+
+type _Map_UUID_String_Zapper map[wire.UUID]string
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_UUID_String_Zapper.
+func (m _Map_UUID_String_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for k, v := range m {
+		if err := enc.AppendObject(_Map_UUID_String_Item_Zapper{Key: k, Value: v}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Request.
+func (v *Request) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("id", (wire.UUID)(v.ID).String())
+	if v.Parent != nil {
+		enc.AddString("parent", (*v.Parent).String())
+	}
+	if v.Scope != nil {
+		enc.AddString("scope", (*v.Scope).String())
+	}
+	if v.Related != nil {
+		if err := enc.AddArray("related", (_List_UUID_Zapper)(v.Related)); err != nil {
+			return err
+		}
+	}
+	if v.Tags != nil {
+		if err := enc.AddArray("tags", (_Set_UUID_Zapper)(v.Tags)); err != nil {
+			return err
+		}
+	}
+	if v.Names != nil {
+		if err := enc.AddArray("names", (_Map_UUID_String_Zapper)(v.Names)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetID returns the value of ID if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Request.
+func (v *Request) GetID() (o RequestID) {
+	if v != nil {
+		o = v.ID
+	}
+	return
+}
+
+// GetParent returns the value of Parent if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Request.
+func (v *Request) GetParent() (o wire.UUID) {
+	if v != nil && v.Parent != nil {
+		return *v.Parent
+	}
+
+	return
+}
+
+// IsSetParent returns true if Parent is not nil.
+//
+// This is safe to call on a nil Request.
+func (v *Request) IsSetParent() bool {
+	return v != nil && v.Parent != nil
+}
+
+// GetScope returns the value of Scope if it is set or its
+// default value if it is unset.
+//
+// This is safe to call on a nil Request.
+func (v *Request) GetScope() (o wire.UUID) {
+	if v != nil && v.Scope != nil {
+		return *v.Scope
+	}
+	o = wire.UUID{0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1, 0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8}
+	return
+}
+
+// IsSetScope returns true if Scope is not nil.
+//
+// This is safe to call on a nil Request.
+func (v *Request) IsSetScope() bool {
+	return v != nil && v.Scope != nil
+}
+
+// GetRelated returns the value of Related if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Request.
+func (v *Request) GetRelated() (o []wire.UUID) {
+	if v != nil && v.Related != nil {
+		return v.Related
+	}
+
+	return
+}
+
+// IsSetRelated returns true if Related is not nil.
+//
+// This is safe to call on a nil Request.
+func (v *Request) IsSetRelated() bool {
+	return v != nil && v.Related != nil
+}
+
+// GetTags returns the value of Tags if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Request.
+func (v *Request) GetTags() (o map[wire.UUID]struct{}) {
+	if v != nil && v.Tags != nil {
+		return v.Tags
+	}
+
+	return
+}
+
+// IsSetTags returns true if Tags is not nil.
+//
+// This is safe to call on a nil Request.
+func (v *Request) IsSetTags() bool {
+	return v != nil && v.Tags != nil
+}
+
+// GetNames returns the value of Names if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Request.
+func (v *Request) GetNames() (o map[wire.UUID]string) {
+	if v != nil && v.Names != nil {
+		return v.Names
+	}
+
+	return
+}
+
+// IsSetNames returns true if Names is not nil.
+//
+// This is safe to call on a nil Request.
+func (v *Request) IsSetNames() bool {
+	return v != nil && v.Names != nil
+}
+
+type RequestID wire.UUID
+
+// ToWire translates RequestID into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v RequestID) ToWire() (wire.Value, error) {
+	x := (wire.UUID)(v)
+	return wire.NewValueUUID(x), nil
+}
+
+// String returns a readable string representation of RequestID.
+func (v RequestID) String() string {
+	x := (wire.UUID)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes RequestID from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *RequestID) FromWire(w wire.Value) error {
+	*v = (RequestID)(w.GetUUID())
+	return nil
+}
+
+// Equals returns true if this RequestID is equal to the provided
+// RequestID.
+func (lhs RequestID) Equals(rhs RequestID) bool {
+	return (lhs == rhs)
+}
+
+// Clone returns a deep copy of this RequestID.
+func (v RequestID) Clone() RequestID {
+	x := (wire.UUID)(v)
+	return (RequestID)(x)
+}
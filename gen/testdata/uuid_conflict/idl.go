@@ -10,10 +10,11 @@ import (
 
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
-	Name:     "uuid_conflict",
-	Package:  "go.uber.org/thriftrw/gen/testdata/uuid_conflict",
-	FilePath: "uuid_conflict.thrift",
-	SHA1:     "c7ab8450f4c3a548cde8938fe7e150cf1b8f9493",
+	Name:            "uuid_conflict",
+	Package:         "go.uber.org/thriftrw/gen/testdata/uuid_conflict",
+	FilePath:        "uuid_conflict.thrift",
+	SHA1:            "c7ab8450f4c3a548cde8938fe7e150cf1b8f9493",
+	ThriftRWVersion: "1.9.0",
 	Includes: []*thriftreflect.ThriftModule{
 		typedefs.ThriftModule,
 	},
@@ -6,9 +6,11 @@ package uuid_conflict
 import (
 	"errors"
 	"fmt"
+	"strings"
+
 	"go.uber.org/thriftrw/gen/testdata/typedefs"
 	"go.uber.org/thriftrw/wire"
-	"strings"
+	"go.uber.org/zap/zapcore"
 )
 
 type UUID string
@@ -18,7 +20,7 @@ type UUID string
 // into bytes using a ThriftRW protocol implementation.
 func (v UUID) ToWire() (wire.Value, error) {
 	x := (string)(v)
-	return wire.NewValueString(x), error(nil)
+	return wire.NewValueString(x), nil
 }
 
 // String returns a readable string representation of UUID.
@@ -31,9 +33,8 @@ func (v UUID) String() string {
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 func (v *UUID) FromWire(w wire.Value) error {
-	x, err := w.GetString(), error(nil)
-	*v = (UUID)(x)
-	return err
+	*v = (UUID)(w.GetString())
+	return nil
 }
 
 // Equals returns true if this UUID is equal to the provided
@@ -42,6 +43,12 @@ func (lhs UUID) Equals(rhs UUID) bool {
 	return (lhs == rhs)
 }
 
+// Clone returns a deep copy of this UUID.
+func (v UUID) Clone() UUID {
+	x := (string)(v)
+	return (UUID)(x)
+}
+
 type UUIDConflict struct {
 	LocalUUID    UUID           `json:"localUUID,required"`
 	ImportedUUID *typedefs.UUID `json:"importedUUID,required"`
@@ -70,6 +77,10 @@ func (v *UUIDConflict) ToWire() (wire.Value, error) {
 		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("UUIDConflict is nil")
+	}
+
 	w, err = v.LocalUUID.ToWire()
 	if err != nil {
 		return w, err
@@ -187,3 +198,54 @@ func (v *UUIDConflict) Equals(rhs *UUIDConflict) bool {
 
 	return true
 }
+
+// Clone returns a deep copy of this UUIDConflict.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *UUIDConflict) Clone() *UUIDConflict {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.ImportedUUID = v.ImportedUUID.Clone()
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of UUIDConflict.
+func (v *UUIDConflict) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("localUUID", (string)(v.LocalUUID))
+	if err := enc.AddObject("importedUUID", v.ImportedUUID); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetLocalUUID returns the value of LocalUUID if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil UUIDConflict.
+func (v *UUIDConflict) GetLocalUUID() (o UUID) {
+	if v != nil {
+		o = v.LocalUUID
+	}
+	return
+}
+
+// GetImportedUUID returns the value of ImportedUUID if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil UUIDConflict.
+func (v *UUIDConflict) GetImportedUUID() (o *typedefs.UUID) {
+	if v != nil {
+		o = v.ImportedUUID
+	}
+	return
+}
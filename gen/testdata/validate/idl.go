@@ -0,0 +1,18 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package validate
+
+import "go.uber.org/thriftrw/thriftreflect"
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:            "validate",
+	Package:         "go.uber.org/thriftrw/gen/testdata/validate",
+	FilePath:        "validate.thrift",
+	SHA1:            "efded6ee91f9befa194f286729c64ec833136313",
+	ThriftRWVersion: "1.9.0",
+	Raw:             rawIDL,
+}
+
+const rawIDL = "// Code for this file is generated with --generate-validate.\n\nstruct User {\n    1: required string name\n    2: optional binary avatar\n    3: optional User manager\n    4: optional Contact contact\n}\n\ntypedef User Owner\ntypedef list<User> Users\n\nunion Contact {\n    1: string email\n    2: User user\n}\n\nstruct Item {\n    1: required string name\n    2: optional Owner owner\n}\n\nstruct Order {\n    1: required list<Item> items\n    2: optional Users watchers\n    3: optional map<string, User> usersByName\n    4: optional map<User, Contact> contacts\n    5: optional set<Contact> contactSet\n    6: optional Contact primary\n    7: required binary payload\n}\n"
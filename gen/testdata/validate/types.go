@@ -0,0 +1,2031 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package validate
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/thriftrw/validate"
+	"go.uber.org/thriftrw/wire"
+	"go.uber.org/zap/zapcore"
+)
+
+type Contact struct {
+	Email *string `json:"email,omitempty"`
+	User  *User   `json:"user,omitempty"`
+}
+
+// ToWire translates a Contact struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Contact) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Contact is nil")
+	}
+
+	if v.Email != nil {
+		w = wire.NewValueString(*(v.Email))
+		fields[i] = wire.Field{ID: 1, Value: w}
+		i++
+	}
+	if v.User != nil {
+		w, err = v.User.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	if i != 1 {
+		return wire.Value{}, fmt.Errorf("Contact should have exactly one field: got %v fields", i)
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _User_Read(w wire.Value) (*User, error) {
+	var v User
+	err := v.FromWire(w)
+	return &v, err
+}
+
+// FromWire deserializes a Contact struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Contact struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Contact
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Contact) FromWire(w wire.Value) error {
+	var err error
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				x := field.Value.GetString()
+				v.Email = &x
+
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.User, err = _User_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	count := 0
+	if v.Email != nil {
+		count++
+	}
+	if v.User != nil {
+		count++
+	}
+	if count != 1 {
+		return fmt.Errorf("Contact should have exactly one field: got %v fields", count)
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Contact
+// struct.
+func (v *Contact) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	if v.Email != nil {
+		fields[i] = fmt.Sprintf("Email: %v", *(v.Email))
+		i++
+	}
+	if v.User != nil {
+		fields[i] = fmt.Sprintf("User: %v", v.User)
+		i++
+	}
+
+	return fmt.Sprintf("Contact{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _String_EqualsPtr(lhs, rhs *string) bool {
+	if lhs != nil && rhs != nil {
+
+		x := *lhs
+		y := *rhs
+		return (x == y)
+	}
+	return lhs == nil && rhs == nil
+}
+
+// Equals returns true if all the fields of this Contact match the
+// provided Contact.
+//
+// This function performs a deep comparison.
+func (v *Contact) Equals(rhs *Contact) bool {
+	if !_String_EqualsPtr(v.Email, rhs.Email) {
+		return false
+	}
+	if !((v.User == nil && rhs.User == nil) || (v.User != nil && rhs.User != nil && v.User.Equals(rhs.User))) {
+		return false
+	}
+
+	return true
+}
+
+func _String_ClonePtr(p *string) *string {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this Contact.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Contact) Clone() *Contact {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Email = _String_ClonePtr(v.Email)
+	o.User = v.User.Clone()
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Contact.
+func (v *Contact) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Email != nil {
+		enc.AddString("email", *v.Email)
+	}
+	if v.User != nil {
+		if err := enc.AddObject("user", v.User); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate returns an error if this Contact or any value it contains
+// is invalid. All violations are reported; use validate.Run with
+// FailFast to stop at the first one.
+func (v *Contact) Validate() error {
+	return validate.Run(v, validate.Options{})
+}
+
+// ValidateWith reports the violations found in this Contact and the
+// values it contains to the given Reporter, with paths relative to
+// the given path.
+func (v *Contact) ValidateWith(r *validate.Reporter, path string) {
+	if r.Done() {
+		return
+	}
+
+	if v == nil {
+		r.Report(path, errors.New("Contact is nil"))
+		return
+	}
+
+	if v.User != nil {
+		v.User.ValidateWith(r, validate.Field(path, "User"))
+	}
+
+	count := 0
+	if v.Email != nil {
+		count++
+	}
+	if v.User != nil {
+		count++
+	}
+	if count != 1 {
+		r.Report(path, fmt.Errorf("Contact should have exactly one field: got %v fields", count))
+	}
+}
+
+// GetEmail returns the value of Email if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Contact.
+func (v *Contact) GetEmail() (o string) {
+	if v != nil && v.Email != nil {
+		return *v.Email
+	}
+
+	return
+}
+
+// IsSetEmail returns true if Email is not nil.
+//
+// This is safe to call on a nil Contact.
+func (v *Contact) IsSetEmail() bool {
+	return v != nil && v.Email != nil
+}
+
+// GetUser returns the value of User if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Contact.
+func (v *Contact) GetUser() (o *User) {
+	if v != nil && v.User != nil {
+		return v.User
+	}
+
+	return
+}
+
+// IsSetUser returns true if User is not nil.
+//
+// This is safe to call on a nil Contact.
+func (v *Contact) IsSetUser() bool {
+	return v != nil && v.User != nil
+}
+
+type Item struct {
+	Name  string `json:"name,required"`
+	Owner *Owner `json:"owner,omitempty"`
+}
+
+// ToWire translates a Item struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Item) ToWire() (wire.Value, error) {
+	var (
+		fields [2]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Item is nil")
+	}
+
+	w = wire.NewValueString(v.Name)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Owner != nil {
+		w, err = v.Owner.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Owner_Read(w wire.Value) (*Owner, error) {
+	var x Owner
+	err := x.FromWire(w)
+	return &x, err
+}
+
+// FromWire deserializes a Item struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Item struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Item
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Item) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name = field.Value.GetString()
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TStruct {
+				v.Owner, err = _Owner_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of Item is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Item
+// struct.
+func (v *Item) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [2]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	if v.Owner != nil {
+		fields[i] = fmt.Sprintf("Owner: %v", v.Owner)
+		i++
+	}
+
+	return fmt.Sprintf("Item{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this Item match the
+// provided Item.
+//
+// This function performs a deep comparison.
+func (v *Item) Equals(rhs *Item) bool {
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !((v.Owner == nil && rhs.Owner == nil) || (v.Owner != nil && rhs.Owner != nil && v.Owner.Equals(rhs.Owner))) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this Item.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Item) Clone() *Item {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Owner = v.Owner.Clone()
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Item.
+func (v *Item) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("name", v.Name)
+	if v.Owner != nil {
+		if err := enc.AddObject("owner", v.Owner); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate returns an error if this Item or any value it contains
+// is invalid. All violations are reported; use validate.Run with
+// FailFast to stop at the first one.
+func (v *Item) Validate() error {
+	return validate.Run(v, validate.Options{})
+}
+
+// ValidateWith reports the violations found in this Item and the
+// values it contains to the given Reporter, with paths relative to
+// the given path.
+func (v *Item) ValidateWith(r *validate.Reporter, path string) {
+	if r.Done() {
+		return
+	}
+
+	if v == nil {
+		r.Report(path, errors.New("Item is nil"))
+		return
+	}
+
+	if v.Owner != nil {
+		v.Owner.ValidateWith(r, validate.Field(path, "Owner"))
+	}
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Item.
+func (v *Item) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetOwner returns the value of Owner if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Item.
+func (v *Item) GetOwner() (o *Owner) {
+	if v != nil && v.Owner != nil {
+		return v.Owner
+	}
+
+	return
+}
+
+// IsSetOwner returns true if Owner is not nil.
+//
+// This is safe to call on a nil Item.
+func (v *Item) IsSetOwner() bool {
+	return v != nil && v.Owner != nil
+}
+
+type Order struct {
+	Items       []*Item          `json:"items,required"`
+	Watchers    Users            `json:"watchers,omitempty"`
+	UsersByName map[string]*User `json:"usersByName,omitempty"`
+	Contacts    []struct {
+		Key   *User
+		Value *Contact
+	} `json:"contacts,omitempty"`
+	ContactSet []*Contact `json:"contactSet,omitempty"`
+	Primary    *Contact   `json:"primary,omitempty"`
+	Payload    []byte     `json:"payload,required"`
+}
+
+type _List_Item_ValueList []*Item
+
+func (v _List_Item_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_Item_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_Item_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_Item_ValueList) Close() {}
+
+type _Map_String_User_MapItemList map[string]*User
+
+func (m _Map_String_User_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for k, v := range m {
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw := wire.NewValueString(k)
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		if err = f(wire.MapItem{Key: kw, Value: vw}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_String_User_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_String_User_MapItemList) KeyType() wire.Type {
+	return wire.TBinary
+}
+
+func (_Map_String_User_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_String_User_MapItemList) Close() {}
+
+type _Map_User_Contact_MapItemList []struct {
+	Key   *User
+	Value *Contact
+}
+
+func (m _Map_User_Contact_MapItemList) ForEach(f func(wire.MapItem) error) error {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		if k == nil {
+			return fmt.Errorf("invalid map key: value is nil")
+		}
+		if v == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", k)
+		}
+		kw, err := k.ToWire()
+		if err != nil {
+			return err
+		}
+
+		vw, err := v.ToWire()
+		if err != nil {
+			return err
+		}
+		if err = f(wire.MapItem{Key: kw, Value: vw}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (m _Map_User_Contact_MapItemList) Size() int {
+	return len(m)
+}
+
+func (_Map_User_Contact_MapItemList) KeyType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_User_Contact_MapItemList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Map_User_Contact_MapItemList) Close() {}
+
+type _Set_Contact_ValueList []*Contact
+
+func (v _Set_Contact_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid set item: value is nil")
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+
+		if err = f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _Set_Contact_ValueList) Size() int {
+	return len(v)
+}
+
+func (_Set_Contact_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_Set_Contact_ValueList) Close() {}
+
+// ToWire translates a Order struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *Order) ToWire() (wire.Value, error) {
+	var (
+		fields [7]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("Order is nil")
+	}
+
+	if v.Items == nil {
+		return w, errors.New("field Items of Order is required")
+	}
+	w = wire.NewValueList(_List_Item_ValueList(v.Items))
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Watchers != nil {
+		w, err = v.Watchers.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.UsersByName != nil {
+		w = wire.NewValueMap(_Map_String_User_MapItemList(v.UsersByName))
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Contacts != nil {
+		w = wire.NewValueMap(_Map_User_Contact_MapItemList(v.Contacts))
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+	if v.ContactSet != nil {
+		w = wire.NewValueSet(_Set_Contact_ValueList(v.ContactSet))
+		fields[i] = wire.Field{ID: 5, Value: w}
+		i++
+	}
+	if v.Primary != nil {
+		w, err = v.Primary.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 6, Value: w}
+		i++
+	}
+	if v.Payload == nil {
+		return w, errors.New("field Payload of Order is required")
+	}
+	w = wire.NewValueBinary(v.Payload)
+	fields[i] = wire.Field{ID: 7, Value: w}
+	i++
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+func _Item_Read(w wire.Value) (*Item, error) {
+	var v Item
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _List_Item_Read(l wire.ValueList) ([]*Item, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Item, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _Item_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _Users_Read(w wire.Value) (Users, error) {
+	var x Users
+	err := x.FromWire(w)
+	return x, err
+}
+
+func _Map_String_User_Read(m wire.MapItemList) (map[string]*User, error) {
+	if m.KeyType() != wire.TBinary {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make(map[string]*User, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k := x.Key.GetString()
+
+		v, err := _User_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o[k] = v
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Contact_Read(w wire.Value) (*Contact, error) {
+	var v Contact
+	err := v.FromWire(w)
+	return &v, err
+}
+
+func _Map_User_Contact_Read(m wire.MapItemList) ([]struct {
+	Key   *User
+	Value *Contact
+}, error) {
+	if m.KeyType() != wire.TStruct {
+		return nil, nil
+	}
+
+	if m.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]struct {
+		Key   *User
+		Value *Contact
+	}, 0, m.Size())
+	err := m.ForEach(func(x wire.MapItem) error {
+		k, err := _User_Read(x.Key)
+		if err != nil {
+			return err
+		}
+
+		v, err := _Contact_Read(x.Value)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, struct {
+			Key   *User
+			Value *Contact
+		}{k, v})
+		return nil
+	})
+	m.Close()
+	return o, err
+}
+
+func _Set_Contact_Read(s wire.ValueList) ([]*Contact, error) {
+	if s.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*Contact, 0, s.Size())
+	err := s.ForEach(func(x wire.Value) error {
+		i, err := _Contact_Read(x)
+		if err != nil {
+			return err
+		}
+
+		o = append(o, i)
+		return nil
+	})
+	s.Close()
+	return o, err
+}
+
+// FromWire deserializes a Order struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a Order struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v Order
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *Order) FromWire(w wire.Value) error {
+	var err error
+
+	itemsIsSet := false
+
+	payloadIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TList {
+				v.Items, err = _List_Item_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+				itemsIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TList {
+				v.Watchers, err = _Users_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TMap {
+				v.UsersByName, err = _Map_String_User_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TMap {
+				v.Contacts, err = _Map_User_Contact_Read(field.Value.GetMap())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 5:
+			if field.Value.Type() == wire.TSet {
+				v.ContactSet, err = _Set_Contact_Read(field.Value.GetSet())
+				if err != nil {
+					return err
+				}
+
+			}
+		case 6:
+			if field.Value.Type() == wire.TStruct {
+				v.Primary, err = _Contact_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 7:
+			if field.Value.Type() == wire.TBinary {
+				v.Payload = field.Value.GetBinary()
+				payloadIsSet = true
+			}
+		}
+	}
+
+	if !itemsIsSet {
+		return errors.New("field Items of Order is required")
+	}
+
+	if !payloadIsSet {
+		return errors.New("field Payload of Order is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a Order
+// struct.
+func (v *Order) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [7]string
+	i := 0
+	fields[i] = fmt.Sprintf("Items: %v", v.Items)
+	i++
+	if v.Watchers != nil {
+		fields[i] = fmt.Sprintf("Watchers: %v", v.Watchers)
+		i++
+	}
+	if v.UsersByName != nil {
+		fields[i] = fmt.Sprintf("UsersByName: %v", v.UsersByName)
+		i++
+	}
+	if v.Contacts != nil {
+		fields[i] = fmt.Sprintf("Contacts: %v", v.Contacts)
+		i++
+	}
+	if v.ContactSet != nil {
+		fields[i] = fmt.Sprintf("ContactSet: %v", v.ContactSet)
+		i++
+	}
+	if v.Primary != nil {
+		fields[i] = fmt.Sprintf("Primary: %v", v.Primary)
+		i++
+	}
+	fields[i] = fmt.Sprintf("Payload: %v", v.Payload)
+	i++
+
+	return fmt.Sprintf("Order{%v}", strings.Join(fields[:i], ", "))
+}
+
+func _List_Item_Equals(lhs, rhs []*Item) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _Map_String_User_Equals(lhs, rhs map[string]*User) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for lk, lv := range lhs {
+		rv, ok := rhs[lk]
+		if !ok {
+			return false
+		}
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+	return true
+}
+
+func _Map_User_Contact_Equals(lhs, rhs []struct {
+	Key   *User
+	Value *Contact
+}) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, i := range lhs {
+		lk := i.Key
+		lv := i.Value
+		ok := false
+		for _, j := range rhs {
+			rk := j.Key
+			rv := j.Value
+			if !lk.Equals(rk) {
+				continue
+			}
+
+			if !lv.Equals(rv) {
+				return false
+			}
+			ok = true
+			break
+		}
+
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func _Set_Contact_Equals(lhs, rhs []*Contact) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for _, x := range lhs {
+		ok := false
+		for _, y := range rhs {
+			if x.Equals(y) {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equals returns true if all the fields of this Order match the
+// provided Order.
+//
+// This function performs a deep comparison.
+func (v *Order) Equals(rhs *Order) bool {
+	if !_List_Item_Equals(v.Items, rhs.Items) {
+		return false
+	}
+	if !((v.Watchers == nil && rhs.Watchers == nil) || (v.Watchers != nil && rhs.Watchers != nil && v.Watchers.Equals(rhs.Watchers))) {
+		return false
+	}
+	if !((v.UsersByName == nil && rhs.UsersByName == nil) || (v.UsersByName != nil && rhs.UsersByName != nil && _Map_String_User_Equals(v.UsersByName, rhs.UsersByName))) {
+		return false
+	}
+	if !((v.Contacts == nil && rhs.Contacts == nil) || (v.Contacts != nil && rhs.Contacts != nil && _Map_User_Contact_Equals(v.Contacts, rhs.Contacts))) {
+		return false
+	}
+	if !((v.ContactSet == nil && rhs.ContactSet == nil) || (v.ContactSet != nil && rhs.ContactSet != nil && _Set_Contact_Equals(v.ContactSet, rhs.ContactSet))) {
+		return false
+	}
+	if !((v.Primary == nil && rhs.Primary == nil) || (v.Primary != nil && rhs.Primary != nil && v.Primary.Equals(rhs.Primary))) {
+		return false
+	}
+	if !bytes.Equal(v.Payload, rhs.Payload) {
+		return false
+	}
+
+	return true
+}
+
+func _List_Item_Clone(l []*Item) []*Item {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]*Item, len(l))
+	for i, x := range l {
+		o[i] = x.Clone()
+	}
+	return o
+}
+
+func _Map_String_User_Clone(m map[string]*User) map[string]*User {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[string]*User, len(m))
+	for k, v := range m {
+		o[k] = v.Clone()
+	}
+
+	return o
+}
+
+func _Map_User_Contact_Clone(m []struct {
+	Key   *User
+	Value *Contact
+}) []struct {
+	Key   *User
+	Value *Contact
+} {
+	if m == nil {
+		return nil
+	}
+
+	o := make([]struct {
+		Key   *User
+		Value *Contact
+	}, 0, len(m))
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		o = append(o, struct {
+			Key   *User
+			Value *Contact
+		}{k.Clone(), v.Clone()})
+	}
+
+	return o
+}
+
+func _Set_Contact_Clone(s []*Contact) []*Contact {
+	if s == nil {
+		return nil
+	}
+
+	o := make([]*Contact, 0, len(s))
+	for _, x := range s {
+		o = append(o, x.Clone())
+	}
+
+	return o
+}
+
+func _Binary_Clone(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+
+	o := make([]byte, len(b))
+	copy(o, b)
+	return o
+}
+
+// Clone returns a deep copy of this Order.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Order) Clone() *Order {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Items = _List_Item_Clone(v.Items)
+	o.Watchers = v.Watchers.Clone()
+	o.UsersByName = _Map_String_User_Clone(v.UsersByName)
+	o.Contacts = _Map_User_Contact_Clone(v.Contacts)
+	o.ContactSet = _Set_Contact_Clone(v.ContactSet)
+	o.Primary = v.Primary.Clone()
+	o.Payload = _Binary_Clone(v.Payload)
+
+	return &o
+}
+
+type _List_Item_Zapper []*Item
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_Item_Zapper.
+func (l _List_Item_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		if err := enc.AppendObject(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _Map_String_User_Zapper map[string]*User
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_String_User_Zapper.
+func (m _Map_String_User_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	for k, v := range m {
+		if err := enc.AddObject((string)(k), v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _Map_User_Contact_Item_Zapper struct {
+	Key   *User
+	Value *Contact
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of _Map_User_Contact_Item_Zapper.
+func (i _Map_User_Contact_Item_Zapper) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if err := enc.AddObject("key", i.Key); err != nil {
+		return err
+	}
+	if err := enc.AddObject("value", i.Value); err != nil {
+		return err
+	}
+	return nil
+}
+
+type _Map_User_Contact_Zapper []struct {
+	Key   *User
+	Value *Contact
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Map_User_Contact_Zapper.
+func (m _Map_User_Contact_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, i := range m {
+		k := i.Key
+		v := i.Value
+		if err := enc.AppendObject(_Map_User_Contact_Item_Zapper{Key: k, Value: v}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type _Set_Contact_Zapper []*Contact
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _Set_Contact_Zapper.
+func (s _Set_Contact_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range s {
+		if err := enc.AppendObject(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Order.
+func (v *Order) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	if err := enc.AddArray("items", (_List_Item_Zapper)(v.Items)); err != nil {
+		return err
+	}
+	if v.Watchers != nil {
+		if err := enc.AddArray("watchers", v.Watchers); err != nil {
+			return err
+		}
+	}
+	if v.UsersByName != nil {
+		if err := enc.AddObject("usersByName", (_Map_String_User_Zapper)(v.UsersByName)); err != nil {
+			return err
+		}
+	}
+	if v.Contacts != nil {
+		if err := enc.AddArray("contacts", (_Map_User_Contact_Zapper)(v.Contacts)); err != nil {
+			return err
+		}
+	}
+	if v.ContactSet != nil {
+		if err := enc.AddArray("contactSet", (_Set_Contact_Zapper)(v.ContactSet)); err != nil {
+			return err
+		}
+	}
+	if v.Primary != nil {
+		if err := enc.AddObject("primary", v.Primary); err != nil {
+			return err
+		}
+	}
+	enc.AddString("payload", base64.StdEncoding.EncodeToString(v.Payload))
+	return nil
+}
+
+func _List_Item_Validate(l []*Item, r *validate.Reporter, path string) {
+	for i, x := range l {
+		x.ValidateWith(r, validate.Index(path, i))
+		if r.Done() {
+			return
+		}
+	}
+}
+
+func _Map_String_User_Validate(m map[string]*User, r *validate.Reporter, path string) {
+	for k, v := range m {
+		v.ValidateWith(r, validate.Key(path, k))
+		if r.Done() {
+			return
+		}
+	}
+}
+
+func _Map_User_Contact_Validate(m []struct {
+	Key   *User
+	Value *Contact
+}, r *validate.Reporter, path string) {
+	for i, item := range m {
+		item.Key.ValidateWith(r, validate.Field(validate.Index(path, i), "Key"))
+		if r.Done() {
+			return
+		}
+
+		item.Value.ValidateWith(r, validate.Field(validate.Index(path, i), "Value"))
+		if r.Done() {
+			return
+		}
+
+	}
+}
+
+func _Set_Contact_Validate(l []*Contact, r *validate.Reporter, path string) {
+	for i, x := range l {
+		x.ValidateWith(r, validate.Index(path, i))
+		if r.Done() {
+			return
+		}
+	}
+}
+
+// Validate returns an error if this Order or any value it contains
+// is invalid. All violations are reported; use validate.Run with
+// FailFast to stop at the first one.
+func (v *Order) Validate() error {
+	return validate.Run(v, validate.Options{})
+}
+
+// ValidateWith reports the violations found in this Order and the
+// values it contains to the given Reporter, with paths relative to
+// the given path.
+func (v *Order) ValidateWith(r *validate.Reporter, path string) {
+	if r.Done() {
+		return
+	}
+
+	if v == nil {
+		r.Report(path, errors.New("Order is nil"))
+		return
+	}
+
+	if v.Items == nil {
+		r.Report(path, errors.New("field Items of Order is required"))
+	} else {
+		_List_Item_Validate(v.Items, r, validate.Field(path, "Items"))
+	}
+	if v.Watchers != nil {
+		v.Watchers.ValidateWith(r, validate.Field(path, "Watchers"))
+	}
+	if v.UsersByName != nil {
+		_Map_String_User_Validate(v.UsersByName, r, validate.Field(path, "UsersByName"))
+	}
+	if v.Contacts != nil {
+		_Map_User_Contact_Validate(v.Contacts, r, validate.Field(path, "Contacts"))
+	}
+	if v.ContactSet != nil {
+		_Set_Contact_Validate(v.ContactSet, r, validate.Field(path, "ContactSet"))
+	}
+	if v.Primary != nil {
+		v.Primary.ValidateWith(r, validate.Field(path, "Primary"))
+	}
+	if v.Payload == nil {
+		r.Report(path, errors.New("field Payload of Order is required"))
+	}
+}
+
+// GetItems returns the value of Items if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Order.
+func (v *Order) GetItems() (o []*Item) {
+	if v != nil {
+		o = v.Items
+	}
+	return
+}
+
+// GetWatchers returns the value of Watchers if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Order.
+func (v *Order) GetWatchers() (o Users) {
+	if v != nil && v.Watchers != nil {
+		return v.Watchers
+	}
+
+	return
+}
+
+// IsSetWatchers returns true if Watchers is not nil.
+//
+// This is safe to call on a nil Order.
+func (v *Order) IsSetWatchers() bool {
+	return v != nil && v.Watchers != nil
+}
+
+// GetUsersByName returns the value of UsersByName if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Order.
+func (v *Order) GetUsersByName() (o map[string]*User) {
+	if v != nil && v.UsersByName != nil {
+		return v.UsersByName
+	}
+
+	return
+}
+
+// IsSetUsersByName returns true if UsersByName is not nil.
+//
+// This is safe to call on a nil Order.
+func (v *Order) IsSetUsersByName() bool {
+	return v != nil && v.UsersByName != nil
+}
+
+// GetContacts returns the value of Contacts if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Order.
+func (v *Order) GetContacts() (o []struct {
+	Key   *User
+	Value *Contact
+}) {
+	if v != nil && v.Contacts != nil {
+		return v.Contacts
+	}
+
+	return
+}
+
+// IsSetContacts returns true if Contacts is not nil.
+//
+// This is safe to call on a nil Order.
+func (v *Order) IsSetContacts() bool {
+	return v != nil && v.Contacts != nil
+}
+
+// GetContactSet returns the value of ContactSet if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Order.
+func (v *Order) GetContactSet() (o []*Contact) {
+	if v != nil && v.ContactSet != nil {
+		return v.ContactSet
+	}
+
+	return
+}
+
+// IsSetContactSet returns true if ContactSet is not nil.
+//
+// This is safe to call on a nil Order.
+func (v *Order) IsSetContactSet() bool {
+	return v != nil && v.ContactSet != nil
+}
+
+// GetPrimary returns the value of Primary if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Order.
+func (v *Order) GetPrimary() (o *Contact) {
+	if v != nil && v.Primary != nil {
+		return v.Primary
+	}
+
+	return
+}
+
+// IsSetPrimary returns true if Primary is not nil.
+//
+// This is safe to call on a nil Order.
+func (v *Order) IsSetPrimary() bool {
+	return v != nil && v.Primary != nil
+}
+
+// GetPayload returns the value of Payload if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Order.
+func (v *Order) GetPayload() (o []byte) {
+	if v != nil {
+		o = v.Payload
+	}
+	return
+}
+
+type Owner User
+
+// ToWire translates Owner into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v *Owner) ToWire() (wire.Value, error) {
+	x := (*User)(v)
+	return x.ToWire()
+}
+
+// String returns a readable string representation of Owner.
+func (v *Owner) String() string {
+	x := (*User)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes Owner from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Owner) FromWire(w wire.Value) error {
+	return (*User)(v).FromWire(w)
+}
+
+// Equals returns true if this Owner is equal to the provided
+// Owner.
+func (lhs *Owner) Equals(rhs *Owner) bool {
+	return (*User)(lhs).Equals((*User)(rhs))
+}
+
+// Clone returns a deep copy of this Owner.
+func (v *Owner) Clone() *Owner {
+	return (*Owner)((*User)(v).Clone())
+}
+
+// Validate returns an error if this Owner or any value it
+// contains is invalid.
+func (v *Owner) Validate() error {
+	return validate.Run(v, validate.Options{})
+}
+
+// ValidateWith reports the violations found in this Owner to
+// the given Reporter, with paths relative to the given path.
+func (v *Owner) ValidateWith(r *validate.Reporter, path string) {
+	x := (*User)(v)
+	x.ValidateWith(r, path)
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of Owner.
+func (v *Owner) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	x := (*User)(v)
+	return x.MarshalLogObject(enc)
+}
+
+type User struct {
+	Name    string   `json:"name,required"`
+	Avatar  []byte   `json:"avatar,omitempty"`
+	Manager *User    `json:"manager,omitempty"`
+	Contact *Contact `json:"contact,omitempty"`
+}
+
+// ToWire translates a User struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
+//     return err
+//   }
+//
+//   if err := binaryProtocol.Encode(x, writer); err != nil {
+//     return err
+//   }
+func (v *User) ToWire() (wire.Value, error) {
+	var (
+		fields [4]wire.Field
+		i      int = 0
+		w      wire.Value
+		err    error
+	)
+
+	if v == nil {
+		return wire.Value{}, errors.New("User is nil")
+	}
+
+	w = wire.NewValueString(v.Name)
+	fields[i] = wire.Field{ID: 1, Value: w}
+	i++
+	if v.Avatar != nil {
+		w = wire.NewValueBinary(v.Avatar)
+		fields[i] = wire.Field{ID: 2, Value: w}
+		i++
+	}
+	if v.Manager != nil {
+		w, err = v.Manager.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 3, Value: w}
+		i++
+	}
+	if v.Contact != nil {
+		w, err = v.Contact.ToWire()
+		if err != nil {
+			return w, err
+		}
+		fields[i] = wire.Field{ID: 4, Value: w}
+		i++
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
+}
+
+// FromWire deserializes a User struct from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+//
+// An error is returned if we were unable to build a User struct
+// from the provided intermediate representation.
+//
+//   x, err := binaryProtocol.Decode(reader, wire.TStruct)
+//   if err != nil {
+//     return nil, err
+//   }
+//
+//   var v User
+//   if err := v.FromWire(x); err != nil {
+//     return nil, err
+//   }
+//   return &v, nil
+func (v *User) FromWire(w wire.Value) error {
+	var err error
+
+	nameIsSet := false
+
+	for _, field := range w.GetStruct().Fields {
+		switch field.ID {
+		case 1:
+			if field.Value.Type() == wire.TBinary {
+				v.Name = field.Value.GetString()
+				nameIsSet = true
+			}
+		case 2:
+			if field.Value.Type() == wire.TBinary {
+				v.Avatar = field.Value.GetBinary()
+
+			}
+		case 3:
+			if field.Value.Type() == wire.TStruct {
+				v.Manager, err = _User_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		case 4:
+			if field.Value.Type() == wire.TStruct {
+				v.Contact, err = _Contact_Read(field.Value)
+				if err != nil {
+					return err
+				}
+
+			}
+		}
+	}
+
+	if !nameIsSet {
+		return errors.New("field Name of User is required")
+	}
+
+	return nil
+}
+
+// String returns a readable string representation of a User
+// struct.
+func (v *User) String() string {
+	if v == nil {
+		return "<nil>"
+	}
+
+	var fields [4]string
+	i := 0
+	fields[i] = fmt.Sprintf("Name: %v", v.Name)
+	i++
+	if v.Avatar != nil {
+		fields[i] = fmt.Sprintf("Avatar: %v", v.Avatar)
+		i++
+	}
+	if v.Manager != nil {
+		fields[i] = fmt.Sprintf("Manager: %v", v.Manager)
+		i++
+	}
+	if v.Contact != nil {
+		fields[i] = fmt.Sprintf("Contact: %v", v.Contact)
+		i++
+	}
+
+	return fmt.Sprintf("User{%v}", strings.Join(fields[:i], ", "))
+}
+
+// Equals returns true if all the fields of this User match the
+// provided User.
+//
+// This function performs a deep comparison.
+func (v *User) Equals(rhs *User) bool {
+	if !(v.Name == rhs.Name) {
+		return false
+	}
+	if !((v.Avatar == nil && rhs.Avatar == nil) || (v.Avatar != nil && rhs.Avatar != nil && bytes.Equal(v.Avatar, rhs.Avatar))) {
+		return false
+	}
+	if !((v.Manager == nil && rhs.Manager == nil) || (v.Manager != nil && rhs.Manager != nil && v.Manager.Equals(rhs.Manager))) {
+		return false
+	}
+	if !((v.Contact == nil && rhs.Contact == nil) || (v.Contact != nil && rhs.Contact != nil && v.Contact.Equals(rhs.Contact))) {
+		return false
+	}
+
+	return true
+}
+
+// Clone returns a deep copy of this User.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *User) Clone() *User {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Avatar = _Binary_Clone(v.Avatar)
+	o.Manager = v.Manager.Clone()
+	o.Contact = v.Contact.Clone()
+
+	return &o
+}
+
+// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+// fast logging of User.
+func (v *User) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	if v == nil {
+		return nil
+	}
+
+	enc.AddString("name", v.Name)
+	if v.Avatar != nil {
+		enc.AddString("avatar", base64.StdEncoding.EncodeToString(v.Avatar))
+	}
+	if v.Manager != nil {
+		if err := enc.AddObject("manager", v.Manager); err != nil {
+			return err
+		}
+	}
+	if v.Contact != nil {
+		if err := enc.AddObject("contact", v.Contact); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate returns an error if this User or any value it contains
+// is invalid. All violations are reported; use validate.Run with
+// FailFast to stop at the first one.
+func (v *User) Validate() error {
+	return validate.Run(v, validate.Options{})
+}
+
+// ValidateWith reports the violations found in this User and the
+// values it contains to the given Reporter, with paths relative to
+// the given path.
+func (v *User) ValidateWith(r *validate.Reporter, path string) {
+	if r.Done() {
+		return
+	}
+
+	if v == nil {
+		r.Report(path, errors.New("User is nil"))
+		return
+	}
+
+	if v.Manager != nil {
+		v.Manager.ValidateWith(r, validate.Field(path, "Manager"))
+	}
+	if v.Contact != nil {
+		v.Contact.ValidateWith(r, validate.Field(path, "Contact"))
+	}
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil User.
+func (v *User) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetAvatar returns the value of Avatar if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil User.
+func (v *User) GetAvatar() (o []byte) {
+	if v != nil && v.Avatar != nil {
+		return v.Avatar
+	}
+
+	return
+}
+
+// IsSetAvatar returns true if Avatar is not nil.
+//
+// This is safe to call on a nil User.
+func (v *User) IsSetAvatar() bool {
+	return v != nil && v.Avatar != nil
+}
+
+// GetManager returns the value of Manager if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil User.
+func (v *User) GetManager() (o *User) {
+	if v != nil && v.Manager != nil {
+		return v.Manager
+	}
+
+	return
+}
+
+// IsSetManager returns true if Manager is not nil.
+//
+// This is safe to call on a nil User.
+func (v *User) IsSetManager() bool {
+	return v != nil && v.Manager != nil
+}
+
+// GetContact returns the value of Contact if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil User.
+func (v *User) GetContact() (o *Contact) {
+	if v != nil && v.Contact != nil {
+		return v.Contact
+	}
+
+	return
+}
+
+// IsSetContact returns true if Contact is not nil.
+//
+// This is safe to call on a nil User.
+func (v *User) IsSetContact() bool {
+	return v != nil && v.Contact != nil
+}
+
+type _List_User_ValueList []*User
+
+func (v _List_User_ValueList) ForEach(f func(wire.Value) error) error {
+	for i, x := range v {
+		if x == nil {
+			return fmt.Errorf("invalid [%v]: value is nil", i)
+		}
+		w, err := x.ToWire()
+		if err != nil {
+			return err
+		}
+		err = f(w)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_User_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_User_ValueList) ValueType() wire.Type {
+	return wire.TStruct
+}
+
+func (_List_User_ValueList) Close() {}
+
+func _List_User_Read(l wire.ValueList) ([]*User, error) {
+	if l.ValueType() != wire.TStruct {
+		return nil, nil
+	}
+
+	o := make([]*User, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		i, err := _User_Read(x)
+		if err != nil {
+			return err
+		}
+		o = append(o, i)
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
+func _List_User_Equals(lhs, rhs []*User) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !lv.Equals(rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func _List_User_Clone(l []*User) []*User {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]*User, len(l))
+	for i, x := range l {
+		o[i] = x.Clone()
+	}
+	return o
+}
+
+type Users []*User
+
+// ToWire translates Users into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+func (v Users) ToWire() (wire.Value, error) {
+	x := ([]*User)(v)
+	return wire.NewValueList(_List_User_ValueList(x)), nil
+}
+
+// String returns a readable string representation of Users.
+func (v Users) String() string {
+	x := ([]*User)(v)
+	return fmt.Sprint(x)
+}
+
+// FromWire deserializes Users from its Thrift-level
+// representation. The Thrift-level representation may be obtained
+// from a ThriftRW protocol implementation.
+func (v *Users) FromWire(w wire.Value) error {
+	x, err := _List_User_Read(w.GetList())
+	*v = (Users)(x)
+	return err
+}
+
+// Equals returns true if this Users is equal to the provided
+// Users.
+func (lhs Users) Equals(rhs Users) bool {
+	return _List_User_Equals(lhs, rhs)
+}
+
+// Clone returns a deep copy of this Users.
+func (v Users) Clone() Users {
+	x := ([]*User)(v)
+	return (Users)(_List_User_Clone(x))
+}
+
+func _List_User_Validate(l []*User, r *validate.Reporter, path string) {
+	for i, x := range l {
+		x.ValidateWith(r, validate.Index(path, i))
+		if r.Done() {
+			return
+		}
+	}
+}
+
+// Validate returns an error if this Users or any value it
+// contains is invalid.
+func (v Users) Validate() error {
+	return validate.Run(v, validate.Options{})
+}
+
+// ValidateWith reports the violations found in this Users to
+// the given Reporter, with paths relative to the given path.
+func (v Users) ValidateWith(r *validate.Reporter, path string) {
+	x := ([]*User)(v)
+	_List_User_Validate(x, r, path)
+}
+
+type _List_User_Zapper []*User
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of _List_User_Zapper.
+func (l _List_User_Zapper) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	for _, v := range l {
+		if err := enc.AppendObject(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+// fast logging of Users.
+func (v Users) MarshalLogArray(enc zapcore.ArrayEncoder) error {
+	x := ([]*User)(v)
+	return (_List_User_Zapper)(x).MarshalLogArray(enc)
+}
@@ -0,0 +1,18 @@
+// Code generated by thriftrw v1.9.0. DO NOT EDIT.
+// @generated
+
+package wire
+
+import "go.uber.org/thriftrw/thriftreflect"
+
+// ThriftModule represents the IDL file used to generate this package.
+var ThriftModule = &thriftreflect.ThriftModule{
+	Name:            "wire",
+	Package:         "go.uber.org/thriftrw/gen/testdata/wire",
+	FilePath:        "wire.thrift",
+	SHA1:            "7446bd0cc7c3728ec3c79a807d88113856e9994e",
+	ThriftRWVersion: "1.9.0",
+	Raw:             rawIDL,
+}
+
+const rawIDL = "// Generated code imports go.uber.org/thriftrw/wire so packages which include\n// this file must refer to it by a different name.\n\nstruct Field {\n    1: required i16 id\n    2: required string name\n}\n"
@@ -59,7 +59,7 @@ func isPrimitiveType(spec compile.TypeSpec) bool {
 	spec = compile.RootTypeSpec(spec)
 	switch spec.(type) {
 	case *compile.BoolSpec, *compile.I8Spec, *compile.I16Spec, *compile.I32Spec,
-		*compile.I64Spec, *compile.DoubleSpec, *compile.StringSpec:
+		*compile.I64Spec, *compile.DoubleSpec, *compile.StringSpec, *compile.UUIDSpec:
 		return true
 	}
 
@@ -69,7 +69,8 @@ func isPrimitiveType(spec compile.TypeSpec) bool {
 
 // isReferenceType checks if the given TypeSpec represents a reference type.
 //
-// Sets, maps, lists, and slices are reference types.
+// Sets, maps, lists, and slices are reference types. Fields annotated with
+// thriftrw.raw are references to wire.Values.
 func isReferenceType(spec compile.TypeSpec) bool {
 	spec = compile.RootTypeSpec(spec)
 	if _, ok := spec.(*compile.BinarySpec); ok {
@@ -77,7 +78,7 @@ func isReferenceType(spec compile.TypeSpec) bool {
 	}
 
 	switch spec.(type) {
-	case *compile.MapSpec, *compile.ListSpec, *compile.SetSpec:
+	case *compile.MapSpec, *compile.ListSpec, *compile.SetSpec, *rawSpec:
 		return true
 	default:
 		return false
@@ -140,6 +141,8 @@ func typeName(g Generator, spec compile.TypeSpec) (string, error) {
 		return "string", nil
 	case *compile.BinarySpec:
 		return "[]byte", nil
+	case *compile.UUIDSpec:
+		return g.Import("go.uber.org/thriftrw/wire") + ".UUID", nil
 	case *compile.MapSpec:
 		k, err := typeReference(g, s.KeySpec)
 		if err != nil {
@@ -172,6 +175,8 @@ func typeName(g Generator, spec compile.TypeSpec) (string, error) {
 		return fmt.Sprintf("map[%s]struct{}", v), nil
 	case *compile.EnumSpec, *compile.StructSpec, *compile.TypedefSpec:
 		return g.LookupTypeName(spec)
+	case *rawSpec:
+		return "*" + g.Import("go.uber.org/thriftrw/wire") + ".Value", nil
 	default:
 		panic(fmt.Sprintf("Unknown type (%T) %v", spec, spec))
 	}
@@ -185,6 +190,22 @@ func equalsPtrFuncName(g Generator, spec compile.TypeSpec) string {
 	return fmt.Sprintf("_%s_EqualsPtr", g.MangleType(spec))
 }
 
+func cloneFuncName(g Generator, spec compile.TypeSpec) string {
+	return fmt.Sprintf("_%s_Clone", g.MangleType(spec))
+}
+
+func clonePtrFuncName(g Generator, spec compile.TypeSpec) string {
+	return fmt.Sprintf("_%s_ClonePtr", g.MangleType(spec))
+}
+
+func validateFuncName(g Generator, spec compile.TypeSpec) string {
+	return fmt.Sprintf("_%s_Validate", g.MangleType(spec))
+}
+
+func validUTF8FuncName(g Generator, spec compile.TypeSpec) string {
+	return fmt.Sprintf("_%s_ValidUTF8", g.MangleType(spec))
+}
+
 func readerFuncName(g Generator, spec compile.TypeSpec) string {
 	return fmt.Sprintf("_%s_Read", g.MangleType(spec))
 }
@@ -196,6 +217,9 @@ func valueListName(g Generator, spec compile.TypeSpec) string {
 // canBeConstant returns true if the given type can be a constant.
 func canBeConstant(t compile.TypeSpec) bool {
 	// Only primitives can use const declarations. Everything else has to be a
-	// `var` declaration.
+	// `var` declaration. UUIDs are arrays in Go so they cannot be constants.
+	if _, isUUID := compile.RootTypeSpec(t).(*compile.UUIDSpec); isUUID {
+		return false
+	}
 	return isPrimitiveType(t)
 }
@@ -54,7 +54,7 @@ func (t *typedefGenerator) Reader(g Generator, spec *compile.TypedefSpec) (strin
 
 // typedef generates code for the given typedef.
 func typedef(g Generator, spec *compile.TypedefSpec) error {
-	err := g.DeclareFromTemplate(
+	err := g.DeclareFromTemplate(overrideTemplate(g, "typedef",
 		`
 		<$fmt := import "fmt">
 		<$wire := import "go.uber.org/thriftrw/wire">
@@ -69,7 +69,7 @@ func typedef(g Generator, spec *compile.TypedefSpec) error {
 		// into bytes using a ThriftRW protocol implementation.
 		func (<$v> <$typedefType>) ToWire() (<$wire>.Value, error) {
 			<$x> := (<typeReference .Target>)(<$v>)
-			return <toWire .Target $x>
+			return <if toWireCanFail .Target><toWire .Target $x><else><toWireValue .Target $x>, nil<end>
 		}
 
 		// String returns a readable string representation of <typeName .>.
@@ -85,6 +85,9 @@ func typedef(g Generator, spec *compile.TypedefSpec) error {
 		func (<$v> *<typeName .>) FromWire(<$w> <$wire>.Value) error {
 			<if isStructType . ->
 				return (<typeReference .Target>)(<$v>).FromWire(<$w>)
+			<- else if not (fromWireCanFail .Target) ->
+				*<$v> = (<$typedefType>)(<fromWireValue .Target $w>)
+				return nil
 			<- else ->
 				<$x>, err := <fromWire .Target $w>
 				*<$v> = (<$typedefType>)(<$x>)
@@ -97,14 +100,56 @@ func typedef(g Generator, spec *compile.TypedefSpec) error {
 		// Equals returns true if this <typeName .> is equal to the provided
 		// <typeName .>.
 		func (<$lhs> <$typedefType>) Equals(<$rhs> <$typedefType>) bool {
-			<if isStructType . ->
+			<if or (isStructType .) (isTypedef .Target) ->
 				return (<typeReference .Target>)(<$lhs>).Equals((<typeReference .Target>)(<$rhs>))
 			<- else ->
 				return <equals .Target $lhs $rhs>
 			<- end>
 		}
-		`,
+
+		// Clone returns a deep copy of this <typeName .>.
+		func (<$v> <$typedefType>) Clone() <$typedefType> {
+			<if isStructType . ->
+				return (<$typedefType>)((<typeReference .Target>)(<$v>).Clone())
+			<- else ->
+				<$x> := (<typeReference .Target>)(<$v>)
+				return (<$typedefType>)(<clone .Target $x>)
+			<- end>
+		}
+		`),
 		spec,
+		TemplateFunc("isTypedef", isTypedef),
 	)
-	return wrapGenerateError(spec.Name, err)
+	if err != nil {
+		return wrapGenerateError(spec.Name, err)
+	}
+
+	if generatorOptions(g).GenerateValidate {
+		var vg validateGenerator
+		if err := vg.typedef(g, spec); err != nil {
+			return wrapGenerateError(spec.Name, err)
+		}
+	}
+
+	if generatorOptions(g).MemSize {
+		var m memSizeGenerator
+		if err := m.typedef(g, spec); err != nil {
+			return err
+		}
+	}
+
+	if !generatorOptions(g).Zap {
+		return nil
+	}
+
+	var z zapGenerator
+	return z.zapTypedef(g, spec)
+}
+
+// isTypedef returns true if the given TypeSpec is a typedef. Values of
+// typedefs of typedefs must be converted to the target type before calling
+// methods on them or they would call their own methods instead.
+func isTypedef(spec compile.TypeSpec) bool {
+	_, ok := spec.(*compile.TypedefSpec)
+	return ok
 }
@@ -538,3 +538,53 @@ func TestTypedefAccessors(t *testing.T) {
 		})
 	})
 }
+
+func TestTypedefOfTypedefContainer(t *testing.T) {
+	leaf := &td.Directory{Name: "leaf"}
+	root := &td.Directory{
+		Name:     "root",
+		Children: td.Listing{{"a": leaf}},
+	}
+
+	leafValue := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("leaf")},
+	}})
+	listingValue := wire.NewValueList(wire.ValueListFromSlice(wire.TMap, []wire.Value{
+		wire.NewValueMap(wire.MapItemListFromSlice(wire.TBinary, wire.TStruct, []wire.MapItem{
+			{Key: wire.NewValueString("a"), Value: leafValue},
+		})),
+	}))
+	rootValue := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString("root")},
+		{ID: 2, Value: listingValue},
+	}})
+	assertRoundTrip(t, root, rootValue, "Directory")
+
+	archive := td.Archive{{"root": root}}
+	archiveValue := wire.NewValueList(wire.ValueListFromSlice(wire.TMap, []wire.Value{
+		wire.NewValueMap(wire.MapItemListFromSlice(wire.TBinary, wire.TStruct, []wire.MapItem{
+			{Key: wire.NewValueString("root"), Value: rootValue},
+		})),
+	}))
+	assertRoundTrip(t, &archive, archiveValue, "Archive")
+
+	library := &td.Library{
+		Archive:  archive,
+		Listings: map[string]td.Listing{"l": root.Children},
+	}
+	assertRoundTrip(t, library, wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: archiveValue},
+		{ID: 2, Value: wire.NewValueMap(wire.MapItemListFromSlice(wire.TBinary, wire.TList, []wire.MapItem{
+			{Key: wire.NewValueString("l"), Value: listingValue},
+		}))},
+	}}), "Library")
+
+	assert.True(t, archive.Equals(archive.Clone()), "Archive must equal its clone")
+	assert.False(t, archive.Equals(td.Archive{}), "Archive must not equal an empty Archive")
+
+	clone := library.Clone()
+	assert.True(t, library.Equals(clone), "Library must equal its clone")
+	clone.Archive[0]["root"].Children[0]["a"].Name = "changed"
+	assert.Equal(t, "leaf", leaf.Name, "Clone must be deep")
+	assert.False(t, library.Equals(clone), "Library must not equal a modified clone")
+}
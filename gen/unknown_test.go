@@ -0,0 +1,110 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"bytes"
+	"testing"
+
+	ts "go.uber.org/thriftrw/gen/testdata/structs"
+	tu "go.uber.org/thriftrw/gen/testdata/unknown_fields"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnknownFieldsAreSkipped(t *testing.T) {
+	var p ts.Point
+	require.NoError(t, p.FromWire(wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueDouble(1)},
+		{ID: 3, Value: wire.NewValueString("unknown")},
+		{ID: 2, Value: wire.NewValueDouble(2)},
+	}})))
+	assert.Equal(t, ts.Point{X: 1, Y: 2}, p)
+}
+
+func TestPreserveUnknownFields(t *testing.T) {
+	encode := func(x thriftType) []byte {
+		w, err := x.ToWire()
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, protocol.Binary.Encode(w, &buf))
+		return buf.Bytes()
+	}
+	decode := func(b []byte, x thriftType) {
+		w, err := protocol.Binary.Decode(bytes.NewReader(b), wire.TStruct)
+		require.NoError(t, err)
+		require.NoError(t, x.FromWire(w))
+	}
+
+	give := &tu.RecordV2{
+		Name:    "foo",
+		Count:   ptr.Int32(42),
+		Tags:    []string{"a", "b"},
+		History: map[string][]int64{"x": {1, 2}, "y": {}},
+		Blob:    []byte("hello"),
+		Parent:  &tu.Record{Name: "bar"},
+	}
+
+	// Decode the newer version of the struct with the older one.
+	var old tu.Record
+	decode(encode(give), &old)
+	assert.Equal(t, "foo", old.Name)
+	assert.Equal(t, ptr.Int32(42), old.Count)
+	assert.Len(t, old.UnknownFields, 4)
+
+	t.Run("round trip", func(t *testing.T) {
+		var got tu.RecordV2
+		decode(encode(&old), &got)
+		assert.Equal(t, give, &got)
+		assert.Empty(t, got.UnknownFields)
+	})
+
+	t.Run("equals and clone", func(t *testing.T) {
+		clone := old.Clone()
+		assert.True(t, old.Equals(clone))
+
+		clone.UnknownFields = clone.UnknownFields[:3]
+		assert.False(t, old.Equals(clone))
+		assert.Len(t, old.UnknownFields, 4, "clone must not share unknown fields")
+	})
+
+	t.Run("exception", func(t *testing.T) {
+		var f tu.Failure
+		decode(encode(give), &f)
+		assert.Equal(t, ptr.String("foo"), f.Message)
+		assert.Len(t, f.UnknownFields, 5)
+
+		var got tu.RecordV2
+		decode(encode(&f), &got)
+		assert.Equal(t, give, &got)
+	})
+
+	t.Run("unions drop unknown fields", func(t *testing.T) {
+		var c tu.Choice
+		decode(encode(&tu.RecordV2{Name: "foo", Tags: []string{"a"}}), &c)
+		assert.Equal(t, tu.Choice{Text: ptr.String("foo")}, c)
+	})
+}
@@ -0,0 +1,182 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// Fields with this annotation are not checked for valid UTF-8 with
+// --strict-utf8. Use this for fields which carry legacy data.
+//
+//   1: optional string legacyName (thriftrw.allowInvalidUTF8)
+const allowInvalidUTF8Key = "thriftrw.allowInvalidUTF8"
+
+// checkUTF8 returns true if the value of the given field must be checked for
+// valid UTF-8 when it is encoded or decoded.
+func checkUTF8(g Generator, f *compile.FieldSpec) bool {
+	if !generatorOptions(g).StrictUTF8 {
+		return false
+	}
+	if _, ok := f.Annotations[allowInvalidUTF8Key]; ok {
+		return false
+	}
+	return containsString(f.Type)
+}
+
+// containsString returns true if values of the given type are strings or
+// lists, sets, or maps which hold strings. Strings inside structs are
+// checked by the structs themselves.
+func containsString(spec compile.TypeSpec) bool {
+	switch s := spec.(type) {
+	case *compile.StringSpec:
+		return true
+	case *compile.TypedefSpec:
+		return containsString(s.Target)
+	case *compile.ListSpec:
+		return containsString(s.ValueSpec)
+	case *compile.SetSpec:
+		return containsString(s.ValueSpec)
+	case *compile.MapSpec:
+		return containsString(s.KeySpec) || containsString(s.ValueSpec)
+	default:
+		return false
+	}
+}
+
+// utf8Generator generates code which verifies that strings are valid UTF-8.
+type utf8Generator struct{}
+
+// ValidUTF8 generates an expression of type bool which is true if all
+// strings in v, a value of the given type, are valid UTF-8.
+//
+// The type must be one for which containsString returns true.
+func (u *utf8Generator) ValidUTF8(g Generator, spec compile.TypeSpec, v string) (string, error) {
+	switch s := spec.(type) {
+	case *compile.StringSpec:
+		return fmt.Sprintf("%s.ValidString(%s)", g.Import("unicode/utf8"), v), nil
+	case *compile.TypedefSpec:
+		target, err := typeReference(g, s.Target)
+		if err != nil {
+			return "", err
+		}
+		return u.ValidUTF8(g, s.Target, fmt.Sprintf("(%s)(%s)", target, v))
+	case *compile.ListSpec, *compile.SetSpec, *compile.MapSpec:
+		name, err := u.container(g, spec)
+		return fmt.Sprintf("%s(%s)", name, v), err
+	default:
+		return "", fmt.Errorf("%v does not hold strings", spec.ThriftName())
+	}
+}
+
+// ValidUTF8Ptr is the same as ValidUTF8 except that v is expected to be a
+// reference to a value of the given type.
+func (u *utf8Generator) ValidUTF8Ptr(g Generator, spec compile.TypeSpec, v string) (string, error) {
+	if isPrimitiveType(spec) {
+		// Strings and typedefs of strings are referenced through a pointer.
+		// Containers are already reference types.
+		v = "*" + v
+	}
+	return u.ValidUTF8(g, spec, v)
+}
+
+// container generates a function which verifies that the strings held by
+// lists, sets, or maps of the given type are valid UTF-8, and returns its
+// name.
+func (u *utf8Generator) container(g Generator, spec compile.TypeSpec) (string, error) {
+	name := validUTF8FuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$c := newVar "c">
+			<$i := newVar "i">
+			<$k := newVar "k">
+			<$v := newVar "v">
+			func <.Name>(<$c> <typeReference .Spec>) bool {
+				<- if isMap .Spec ->
+					<- $keys := containsString .Spec.KeySpec ->
+					<- $values := containsString .Spec.ValueSpec ->
+					<- if not (isHashable .Spec.KeySpec) ->
+						for _, <$i> := range <$c> {
+							<- if $keys>
+								if !<validUTF8 .Spec.KeySpec (printf "%s.Key" $i)> {
+									return false
+								}
+							<- end>
+							<- if $values>
+								if !<validUTF8 .Spec.ValueSpec (printf "%s.Value" $i)> {
+									return false
+								}
+							<- end>
+						}
+					<- else if not $values ->
+						for <$k> := range <$c> {
+							if !<validUTF8 .Spec.KeySpec $k> {
+								return false
+							}
+						}
+					<- else ->
+						for <if $keys><$k><else>_<end>, <$v> := range <$c> {
+							<- if $keys>
+								if !<validUTF8 .Spec.KeySpec $k> {
+									return false
+								}
+							<- end>
+							if !<validUTF8 .Spec.ValueSpec $v> {
+								return false
+							}
+						}
+					<- end>
+				<- else if and (isSet .Spec) (isHashable .Spec.ValueSpec) ->
+					for <$v> := range <$c> {
+						if !<validUTF8 .Spec.ValueSpec $v> {
+							return false
+						}
+					}
+				<- else ->
+					for _, <$v> := range <$c> {
+						if !<validUTF8 .Spec.ValueSpec $v> {
+							return false
+						}
+					}
+				<- end>
+				return true
+			}
+		`,
+		struct {
+			Name string
+			Spec compile.TypeSpec
+		}{Name: name, Spec: spec},
+		TemplateFunc("validUTF8", u.ValidUTF8),
+		TemplateFunc("containsString", containsString),
+		TemplateFunc("isMap", func(spec compile.TypeSpec) bool {
+			_, ok := spec.(*compile.MapSpec)
+			return ok
+		}),
+		TemplateFunc("isSet", func(spec compile.TypeSpec) bool {
+			_, ok := spec.(*compile.SetSpec)
+			return ok
+		}),
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	tu "go.uber.org/thriftrw/gen/testdata/strict_utf8"
+	"go.uber.org/thriftrw/ptr"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const invalidUTF8 = "foo\xffbar"
+
+func TestStrictUTF8ToWire(t *testing.T) {
+	tests := []struct {
+		desc    string
+		give    interface{ ToWire() (wire.Value, error) }
+		wantErr string
+	}{
+		{
+			desc: "valid",
+			give: &tu.Person{
+				Name:     "José",
+				Nickname: ptr.String("☃"),
+				Aliases:  []string{"a", "b"},
+				Scores:   map[string]int32{"x": 1},
+				Tags:     map[string]struct{}{"y": {}},
+				History:  map[int32][]tu.Name{1: {"z"}},
+				Photo:    []byte(invalidUTF8),
+			},
+		},
+		{
+			desc: "exempt field",
+			give: &tu.Person{Name: "foo", LegacyName: ptr.String(invalidUTF8)},
+		},
+		{
+			desc:    "required typedef",
+			give:    &tu.Person{Name: invalidUTF8},
+			wantErr: "field Name of Person is not valid UTF-8",
+		},
+		{
+			desc:    "optional",
+			give:    &tu.Person{Name: "foo", Nickname: ptr.String(invalidUTF8)},
+			wantErr: "field Nickname of Person is not valid UTF-8",
+		},
+		{
+			desc:    "list",
+			give:    &tu.Person{Name: "foo", Aliases: []string{"a", invalidUTF8}},
+			wantErr: "field Aliases of Person is not valid UTF-8",
+		},
+		{
+			desc:    "map key",
+			give:    &tu.Person{Name: "foo", Scores: map[string]int32{invalidUTF8: 1}},
+			wantErr: "field Scores of Person is not valid UTF-8",
+		},
+		{
+			desc:    "set",
+			give:    &tu.Person{Name: "foo", Tags: map[string]struct{}{invalidUTF8: {}}},
+			wantErr: "field Tags of Person is not valid UTF-8",
+		},
+		{
+			desc:    "nested containers",
+			give:    &tu.Person{Name: "foo", History: map[int32][]tu.Name{1: {"a", invalidUTF8}}},
+			wantErr: "field History of Person is not valid UTF-8",
+		},
+		{
+			desc:    "union",
+			give:    &tu.Label{Text: ptr.String(invalidUTF8)},
+			wantErr: "field Text of Label is not valid UTF-8",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := tt.give.ToWire()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestStrictUTF8FromWire(t *testing.T) {
+	person := func(fields ...wire.Field) wire.Value {
+		fields = append([]wire.Field{
+			{ID: 1, Value: wire.NewValueString("foo")},
+		}, fields...)
+		return wire.NewValueStruct(wire.Struct{Fields: fields})
+	}
+
+	tests := []struct {
+		desc    string
+		give    wire.Value
+		wantErr string
+	}{
+		{
+			desc: "valid",
+			give: person(wire.Field{ID: 2, Value: wire.NewValueString("☃")}),
+		},
+		{
+			desc: "exempt field",
+			give: person(wire.Field{ID: 3, Value: wire.NewValueString(invalidUTF8)}),
+		},
+		{
+			desc: "binary",
+			give: person(wire.Field{ID: 8, Value: wire.NewValueString(invalidUTF8)}),
+		},
+		{
+			desc: "required",
+			give: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueString(invalidUTF8)},
+			}}),
+			wantErr: "field Name of Person is not valid UTF-8",
+		},
+		{
+			desc:    "optional",
+			give:    person(wire.Field{ID: 2, Value: wire.NewValueString(invalidUTF8)}),
+			wantErr: "field Nickname of Person is not valid UTF-8",
+		},
+		{
+			desc: "list",
+			give: person(wire.Field{ID: 4, Value: wire.NewValueList(
+				wire.ValueListFromSlice(wire.TBinary, []wire.Value{
+					wire.NewValueString(invalidUTF8),
+				}),
+			)}),
+			wantErr: "field Aliases of Person is not valid UTF-8",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var p tu.Person
+			err := p.FromWire(tt.give)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	tu "go.uber.org/thriftrw/gen/testdata/uuid"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func mustParseUUID(t *testing.T, s string) wire.UUID {
+	u, err := wire.ParseUUID(s)
+	require.NoError(t, err, "invalid UUID %q", s)
+	return u
+}
+
+func TestUUIDConstants(t *testing.T) {
+	assert.Equal(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", tu.DefaultNamespace.String())
+	assert.Equal(t, tu.RequestID{}, tu.NilRequestID)
+}
+
+func TestUUIDRoundTrip(t *testing.T) {
+	id := mustParseUUID(t, "00000000-0000-0000-0000-000000000001")
+	other := mustParseUUID(t, "00000000-0000-0000-0000-000000000002")
+	scope := tu.DefaultNamespace
+
+	tests := []struct {
+		desc string
+		x    thriftType
+		v    wire.Value
+	}{
+		{
+			desc: "required",
+			x:    &tu.Entity{UUID: id},
+			v:    singleFieldStruct(1, wire.NewValueUUID(id)),
+		},
+		{
+			desc: "typedef and optional",
+			x:    &tu.Request{ID: tu.RequestID(id), Parent: &other, Scope: &scope},
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueUUID(id)},
+				{ID: 2, Value: wire.NewValueUUID(other)},
+				{ID: 3, Value: wire.NewValueUUID(scope)},
+			}}),
+		},
+		{
+			desc: "containers",
+			x: &tu.Request{
+				ID:      tu.RequestID(id),
+				Scope:   &scope,
+				Related: []wire.UUID{other},
+				Tags:    map[wire.UUID]struct{}{other: {}},
+				Names:   map[wire.UUID]string{other: "foo"},
+			},
+			v: wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+				{ID: 1, Value: wire.NewValueUUID(id)},
+				{ID: 3, Value: wire.NewValueUUID(scope)},
+				{ID: 4, Value: wire.NewValueList(
+					wire.ValueListFromSlice(wire.TUUID, []wire.Value{wire.NewValueUUID(other)}),
+				)},
+				{ID: 5, Value: wire.NewValueSet(
+					wire.ValueListFromSlice(wire.TUUID, []wire.Value{wire.NewValueUUID(other)}),
+				)},
+				{ID: 6, Value: wire.NewValueMap(
+					wire.MapItemListFromSlice(wire.TUUID, wire.TBinary, []wire.MapItem{
+						{Key: wire.NewValueUUID(other), Value: wire.NewValueString("foo")},
+					}),
+				)},
+			}}),
+		},
+	}
+
+	for _, tt := range tests {
+		assertRoundTrip(t, tt.x, tt.v, tt.desc)
+	}
+}
+
+func TestUUIDDefault(t *testing.T) {
+	var r tu.Request
+	require.NoError(t, r.FromWire(singleFieldStruct(1, wire.NewValueUUID(wire.UUID{}))))
+	assert.Equal(t, tu.DefaultNamespace, r.GetScope())
+}
+
+func TestUUIDZap(t *testing.T) {
+	id := mustParseUUID(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+
+	enc := zapcore.NewMapObjectEncoder()
+	require.NoError(t, (&tu.Request{ID: tu.RequestID(id), Parent: &id}).MarshalLogObject(enc))
+	assert.Equal(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", enc.Fields["id"])
+	assert.Equal(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", enc.Fields["parent"])
+}
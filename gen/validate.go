@@ -0,0 +1,271 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// needsValidation returns true if values of the given type may contain
+// structs, unions, or exceptions which must be validated.
+func needsValidation(spec compile.TypeSpec) bool {
+	switch s := spec.(type) {
+	case *compile.StructSpec:
+		return true
+	case *compile.TypedefSpec:
+		return needsValidation(s.Target)
+	case *compile.ListSpec:
+		return needsValidation(s.ValueSpec)
+	case *compile.SetSpec:
+		return needsValidation(s.ValueSpec)
+	case *compile.MapSpec:
+		return needsValidation(s.KeySpec) || needsValidation(s.ValueSpec)
+	default:
+		// Primitives, enums, binary, and raw values are always valid.
+		return false
+	}
+}
+
+// validateGenerator generates code that validates values of Thrift types
+// and the values they contain.
+type validateGenerator struct{}
+
+// Validate generates a statement which reports the violations found in v, a
+// value of the given type, to the validate.Reporter r. path is an expression
+// for the path of v.
+//
+// The type must be one for which needsValidation returns true.
+func (vg *validateGenerator) Validate(g Generator, spec compile.TypeSpec, v, r, path string) (string, error) {
+	switch s := spec.(type) {
+	case *compile.MapSpec:
+		validate, err := vg.validateMap(g, s)
+		return fmt.Sprintf("%s(%s, %s, %s)", validate, v, r, path), err
+	case *compile.ListSpec:
+		validate, err := vg.validateSlice(g, s, s.ValueSpec)
+		return fmt.Sprintf("%s(%s, %s, %s)", validate, v, r, path), err
+	case *compile.SetSpec:
+		// Sets of values which need validation are not hashable so they are
+		// represented as slices.
+		validate, err := vg.validateSlice(g, s, s.ValueSpec)
+		return fmt.Sprintf("%s(%s, %s, %s)", validate, v, r, path), err
+	default:
+		// Structs and typedefs have a ValidateWith method.
+		return fmt.Sprintf("%s.ValidateWith(%s, %s)", v, r, path), nil
+	}
+}
+
+// validateSlice generates a function to validate the items of lists or sets
+// of the given type and returns its name.
+func (vg *validateGenerator) validateSlice(g Generator, spec, valueSpec compile.TypeSpec) (string, error) {
+	name := validateFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$validate := import "go.uber.org/thriftrw/validate">
+
+			<$l := newVar "l">
+			<$r := newVar "r">
+			<$path := newVar "path">
+			<$i := newVar "i">
+			<$x := newVar "x">
+			func <.Name>(<$l> <typeReference .Spec>, <$r> *<$validate>.Reporter, <$path> string) {
+				for <$i>, <$x> := range <$l> {
+					<validate .ValueSpec $x $r (printf "%s.Index(%s, %s)" $validate $path $i)>
+					if <$r>.Done() {
+						return
+					}
+				}
+			}
+		`,
+		struct {
+			Name      string
+			Spec      compile.TypeSpec
+			ValueSpec compile.TypeSpec
+		}{Name: name, Spec: spec, ValueSpec: valueSpec},
+		TemplateFunc("validate", vg.Validate),
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
+// validateMap generates a function to validate the keys and values of maps
+// of the given type and returns its name.
+func (vg *validateGenerator) validateMap(g Generator, spec *compile.MapSpec) (string, error) {
+	name := validateFuncName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$validate := import "go.uber.org/thriftrw/validate">
+
+			<$m := newVar "m">
+			<$r := newVar "r">
+			<$path := newVar "path">
+			<$k := newVar "k">
+			<$v := newVar "v">
+			<$i := newVar "i">
+			<$item := newVar "item">
+			func <.Name>(<$m> <typeReference .Spec>, <$r> *<$validate>.Reporter, <$path> string) {
+				<if isHashable .Spec.KeySpec ->
+					for <$k>, <$v> := range <$m> {
+						<validate .Spec.ValueSpec $v $r (printf "%s.Key(%s, %s)" $validate $path $k)>
+						if <$r>.Done() {
+							return
+						}
+					}
+				<- else ->
+					for <$i>, <$item> := range <$m> {
+						<- $itemPath := printf "%s.Index(%s, %s)" $validate $path $i ->
+						<if needsValidation .Spec.KeySpec>
+							<validate .Spec.KeySpec (printf "%s.Key" $item) $r (printf "%s.Field(%s, %q)" $validate $itemPath "Key")>
+							if <$r>.Done() {
+								return
+							}
+						<end>
+						<if needsValidation .Spec.ValueSpec>
+							<validate .Spec.ValueSpec (printf "%s.Value" $item) $r (printf "%s.Field(%s, %q)" $validate $itemPath "Value")>
+							if <$r>.Done() {
+								return
+							}
+						<end>
+					}
+				<- end>
+			}
+		`,
+		struct {
+			Name string
+			Spec *compile.MapSpec
+		}{Name: name, Spec: spec},
+		TemplateFunc("validate", vg.Validate),
+		TemplateFunc("needsValidation", needsValidation),
+	)
+
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
+// fieldGroup generates Validate and ValidateWith methods for the given
+// struct, union, or exception. Required fields must be set, unions must
+// have exactly one field set, and nested values must be valid.
+func (vg *validateGenerator) fieldGroup(g Generator, f fieldGroupGenerator) error {
+	return g.DeclareFromTemplate(
+		`
+		<$validate := import "go.uber.org/thriftrw/validate">
+
+		<$v := newVar "v">
+		<$r := newVar "r">
+		<$path := newVar "path">
+		// Validate returns an error if this <.Name> or any value it contains
+		// is invalid. All violations are reported; use validate.Run with
+		// FailFast to stop at the first one.
+		func (<$v> *<.Name>) Validate() error {
+			return <$validate>.Run(<$v>, <$validate>.Options{})
+		}
+
+		// ValidateWith reports the violations found in this <.Name> and the
+		// values it contains to the given Reporter, with paths relative to
+		// the given path.
+		func (<$v> *<.Name>) ValidateWith(<$r> *<$validate>.Reporter, <$path> string) {
+			if <$r>.Done() {
+				return
+			}
+
+			if <$v> == nil {
+				<$r>.Report(<$path>, <import "errors">.New("<.Name> is nil"))
+				return
+			}
+
+			<$structName := .Name>
+			<range .Fields>
+				<- $fname := goName . ->
+				<- $f := printf "%s.%s" $v (fieldName .) ->
+				<- $fpath := printf "%s.Field(%s, %q)" $validate $path $fname ->
+				<- if and .Required (not (isPrimitiveType .Type)) ->
+					if <$f> == nil {
+						<$r>.Report(<$path>, <import "errors">.New("field <$fname> of <$structName> is required"))
+					}<if needsValidation .Type> else {
+						<validate .Type $f $r $fpath>
+					}<end>
+				<- else if needsValidation .Type ->
+					if <$f> != nil {
+						<validate .Type $f $r $fpath>
+					}
+				<- end>
+			<end>
+
+			<- if and .IsUnion (len .Fields)>
+				<$fmt := import "fmt">
+				<$count := newVar "count">
+				<$count> := 0
+				<range .Fields ->
+					if <$v>.<fieldName .> != nil {
+						<$count>++
+					}
+				<end>
+				<- if .AllowEmptyUnion ->
+					if <$count> > 1 {
+						<$r>.Report(<$path>, <$fmt>.Errorf("<.Name> should have at most one field: got %v fields", <$count>))
+					}
+				<- else ->
+					if <$count> != 1 {
+						<$r>.Report(<$path>, <$fmt>.Errorf("<.Name> should have exactly one field: got %v fields", <$count>))
+					}
+				<- end>
+			<end ->
+		}
+		`, f,
+		append(f.fieldFuncs(),
+			TemplateFunc("validate", vg.Validate),
+			TemplateFunc("needsValidation", needsValidation),
+		)...,
+	)
+}
+
+// typedef generates Validate and ValidateWith methods for the given typedef
+// if its target needs validation.
+func (vg *validateGenerator) typedef(g Generator, spec *compile.TypedefSpec) error {
+	if !needsValidation(spec.Target) {
+		return nil
+	}
+
+	return g.DeclareFromTemplate(
+		`
+		<$validate := import "go.uber.org/thriftrw/validate">
+		<$typedefType := typeReference .>
+
+		<$v := newVar "v">
+		<$r := newVar "r">
+		<$path := newVar "path">
+		<$x := newVar "x">
+		// Validate returns an error if this <typeName .> or any value it
+		// contains is invalid.
+		func (<$v> <$typedefType>) Validate() error {
+			return <$validate>.Run(<$v>, <$validate>.Options{})
+		}
+
+		// ValidateWith reports the violations found in this <typeName .> to
+		// the given Reporter, with paths relative to the given path.
+		func (<$v> <$typedefType>) ValidateWith(<$r> *<$validate>.Reporter, <$path> string) {
+			<$x> := (<typeReference .Target>)(<$v>)
+			<validate .Target $x $r $path>
+		}
+		`, spec,
+		TemplateFunc("validate", vg.Validate),
+	)
+}
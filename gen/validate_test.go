@@ -0,0 +1,174 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	tv "go.uber.org/thriftrw/gen/testdata/validate"
+	"go.uber.org/thriftrw/validate"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	alice := &tv.User{Name: "alice"}
+	email := "alice@example.com"
+
+	tests := []struct {
+		desc string
+		give validate.Validator
+
+		// Expected violations, or nil if the value is valid.
+		want []string
+	}{
+		{
+			desc: "valid",
+			give: &tv.Order{
+				Items:       []*tv.Item{{Name: "foo", Owner: (*tv.Owner)(alice)}},
+				Watchers:    tv.Users{alice},
+				UsersByName: map[string]*tv.User{"alice": alice},
+				Primary:     &tv.Contact{Email: &email},
+				Payload:     []byte{},
+			},
+		},
+		{
+			desc: "required fields",
+			give: &tv.Order{},
+			want: []string{
+				"field Items of Order is required",
+				"field Payload of Order is required",
+			},
+		},
+		{
+			desc: "nested structs and typedefs",
+			give: &tv.Order{
+				Items: []*tv.Item{
+					{Name: "foo"},
+					nil,
+					{Name: "bar", Owner: &tv.Owner{Manager: &tv.User{Contact: &tv.Contact{}}}},
+				},
+				Payload: []byte{},
+			},
+			want: []string{
+				"Items[1]: Item is nil",
+				"Items[2].Owner.Manager.Contact: Contact should have exactly one field: got 0 fields",
+			},
+		},
+		{
+			desc: "unions",
+			give: &tv.Order{
+				Items:      []*tv.Item{},
+				Primary:    &tv.Contact{},
+				ContactSet: []*tv.Contact{{Email: &email, User: alice}},
+				Payload:    []byte{},
+			},
+			want: []string{
+				"ContactSet[0]: Contact should have exactly one field: got 2 fields",
+				"Primary: Contact should have exactly one field: got 0 fields",
+			},
+		},
+		{
+			desc: "maps",
+			give: &tv.Order{
+				Items:       []*tv.Item{},
+				UsersByName: map[string]*tv.User{"bob": nil},
+				Contacts: []struct {
+					Key   *tv.User
+					Value *tv.Contact
+				}{
+					{Key: alice, Value: &tv.Contact{User: &tv.User{Contact: &tv.Contact{}}}},
+					{Key: nil, Value: &tv.Contact{Email: &email}},
+				},
+				Payload: []byte{},
+			},
+			want: []string{
+				"UsersByName[bob]: User is nil",
+				"Contacts[0].Value.User.Contact: Contact should have exactly one field: got 0 fields",
+				"Contacts[1].Key: User is nil",
+			},
+		},
+		{
+			desc: "typedef of list",
+			give: tv.Users{alice, nil},
+			want: []string{"[1]: User is nil"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := tt.give.(interface {
+				Validate() error
+			}).Validate()
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.IsType(t, &validate.Error{}, err)
+			var got []string
+			for _, v := range err.(*validate.Error).Violations {
+				got = append(got, v.Error())
+			}
+			assert.Equal(t, tt.want, got)
+
+			err = validate.Run(tt.give, validate.Options{FailFast: true})
+			require.IsType(t, &validate.Error{}, err)
+			assert.Len(t, err.(*validate.Error).Violations, 1, "expected only one violation with FailFast")
+			assert.EqualError(t, err, tt.want[0])
+		})
+	}
+}
+
+func TestValidateReservedFieldNames(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thriftrw-validate-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "reserved.thrift")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`
+		struct Foo {
+			1: optional string validate
+		}
+	`), 0644))
+
+	module, err := compile.Compile(path)
+	require.NoError(t, err)
+
+	for _, generateValidate := range []bool{false, true} {
+		err = Generate(module, &Options{
+			OutputDir:        dir,
+			PackagePrefix:    "go.uber.org/thriftrw/gen/testdata",
+			ThriftRoot:       dir,
+			GenerateValidate: generateValidate,
+		})
+		if !generateValidate {
+			assert.NoError(t, err, "field may be named Validate without --generate-validate")
+		} else if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), `could not declare field "Validate"`)
+		}
+	}
+}
@@ -20,7 +20,15 @@
 
 package gen
 
-import "go.uber.org/thriftrw/version"
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/internal/semver"
+	"go.uber.org/thriftrw/version"
+)
 
 // Version generates an init() function checking the version of the library
 // during generation with the one used at runtime.
@@ -41,3 +49,40 @@ func Version(g Generator, importPath string) error {
 
 	return wrapGenerateError("init { version check }", err)
 }
+
+// idlSHA1 returns the hex encoded SHA1 of the contents of the Thrift file
+// of the given module.
+func idlSHA1(m *compile.Module) string {
+	hash := sha1.Sum(m.Raw)
+	return hex.EncodeToString(hash[:])
+}
+
+// VerifyVersion returns an error if code generated by the given version of
+// ThriftRW cannot be used with this version of the ThriftRW library.
+// Generated packages record the version which generated them in
+// ThriftModule.ThriftRWVersion.
+func VerifyVersion(generatedBy string) error {
+	return verifyVersion(version.Version, generatedBy)
+}
+
+// verifyVersion returns an error if code generated by ThriftRW at version
+// generatedBy cannot be used with version library of the ThriftRW library.
+func verifyVersion(library, generatedBy string) error {
+	lib, err := semver.Parse(library)
+	if err != nil {
+		return fmt.Errorf("invalid ThriftRW version %q: %v", library, err)
+	}
+
+	generated, err := semver.Parse(generatedBy)
+	if err != nil {
+		return fmt.Errorf("invalid ThriftRW version %q: %v", generatedBy, err)
+	}
+
+	r := semver.CompatibleRange(lib)
+	if !r.Contains(generated) {
+		return fmt.Errorf(
+			"code generated by ThriftRW v%v cannot be used with ThriftRW v%v: expected >=%v and <%v",
+			&generated, &lib, &r.Begin, &r.End)
+	}
+	return nil
+}
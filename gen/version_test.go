@@ -0,0 +1,111 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	te "go.uber.org/thriftrw/gen/testdata/enums"
+	"go.uber.org/thriftrw/version"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyVersion(t *testing.T) {
+	assert.NoError(t, VerifyVersion(version.Version))
+	assert.Error(t, VerifyVersion("0.1.0"))
+	assert.Error(t, VerifyVersion("foo"))
+}
+
+func TestVersionStamp(t *testing.T) {
+	assert.Equal(t, version.Version, te.ThriftModule.ThriftRWVersion)
+	assert.NoError(t, VerifyVersion(te.ThriftModule.ThriftRWVersion))
+}
+
+func TestVerifyVersionRange(t *testing.T) {
+	tests := []struct {
+		library     string
+		generatedBy string
+		wantErr     string
+	}{
+		{library: "1.9.0", generatedBy: "1.9.0"},
+		{library: "1.9.0", generatedBy: "1.9.3"},
+		{library: "1.9.0", generatedBy: "1.0.0"},
+		{library: "1.9.1", generatedBy: "1.9.0-rc1"},
+		{
+			library:     "1.9.0",
+			generatedBy: "1.10.0",
+			wantErr:     "code generated by ThriftRW v1.10.0 cannot be used with ThriftRW v1.9.0: expected >=1.0.0 and <1.10.0",
+		},
+		{
+			library:     "1.9.0",
+			generatedBy: "2.0.0",
+			wantErr:     "code generated by ThriftRW v2.0.0 cannot be used with ThriftRW v1.9.0",
+		},
+		{
+			library:     "2.0.0",
+			generatedBy: "1.9.0",
+			wantErr:     "code generated by ThriftRW v1.9.0 cannot be used with ThriftRW v2.0.0",
+		},
+		{
+			library:     "1.x",
+			generatedBy: "1.9.0",
+			wantErr:     `invalid ThriftRW version "1.x"`,
+		},
+	}
+
+	for _, tt := range tests {
+		err := verifyVersion(tt.library, tt.generatedBy)
+		if tt.wantErr == "" {
+			assert.NoError(t, err, "%v with %v", tt.generatedBy, tt.library)
+		} else if assert.Error(t, err, "%v with %v", tt.generatedBy, tt.library) {
+			assert.Contains(t, err.Error(), tt.wantErr)
+		}
+	}
+}
+
+func TestGenerateRequireVersion(t *testing.T) {
+	outputDir, err := ioutil.TempDir("", "thriftrw-require-version-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(outputDir)
+
+	module, err := compile.Compile("testdata/thrift/enums.thrift")
+	require.NoError(t, err)
+
+	opts := Options{
+		OutputDir:     outputDir,
+		PackagePrefix: "go.uber.org/thriftrw/gen/testdata",
+		ThriftRoot:    testdata(t, "thrift"),
+	}
+
+	opts.RequireVersion = version.Version
+	require.NoError(t, Generate(module, &opts))
+
+	opts.RequireVersion = "0.1.0"
+	err = Generate(module, &opts)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "cannot be used with ThriftRW v0.1.0")
+	}
+}
@@ -36,29 +36,65 @@ type WireGenerator struct {
 	enumG    enumGenerator
 	structG  structGenerator
 	typedefG typedefGenerator
+	rawG     rawGenerator
 }
 
 // ToWire generates an expression of type (Value, error) object containing the
 // wire representation of the variable $varName of type $spec or an error.
 func (w *WireGenerator) ToWire(g Generator, spec compile.TypeSpec, varName string) (string, error) {
+	if toWireCanFail(spec) {
+		// Custom defined type
+		return fmt.Sprintf("%s.ToWire()", varName), nil
+	}
+
+	value, err := w.ToWireValue(g, spec, varName)
+	if err != nil {
+		return "", err
+	}
+	return value + ", error(nil)", nil
+}
+
+// toWireCanFail checks whether the expression generated by ToWire for the
+// given type may produce a non-nil error. Code that checks the error of
+// values that can't fail trips static analysis tools, so templates use
+// ToWireValue for them instead.
+func toWireCanFail(spec compile.TypeSpec) bool {
+	switch spec.(type) {
+	case *compile.BoolSpec, *compile.I8Spec, *compile.I16Spec, *compile.I32Spec,
+		*compile.I64Spec, *compile.DoubleSpec, *compile.StringSpec,
+		*compile.BinarySpec, *compile.UUIDSpec, *compile.MapSpec,
+		*compile.ListSpec, *compile.SetSpec, *rawSpec:
+		return false
+	default:
+		return true
+	}
+}
+
+// ToWireValue generates an expression of type Value containing the wire
+// representation of the variable $varName of type $spec.
+//
+// This may be used only for types for which toWireCanFail is false.
+func (w *WireGenerator) ToWireValue(g Generator, spec compile.TypeSpec, varName string) (string, error) {
 	wire := g.Import("go.uber.org/thriftrw/wire")
 	switch s := spec.(type) {
 	case *compile.BoolSpec:
-		return fmt.Sprintf("%s.NewValueBool(%s), error(nil)", wire, varName), nil
+		return fmt.Sprintf("%s.NewValueBool(%s)", wire, varName), nil
 	case *compile.I8Spec:
-		return fmt.Sprintf("%s.NewValueI8(%s), error(nil)", wire, varName), nil
+		return fmt.Sprintf("%s.NewValueI8(%s)", wire, varName), nil
 	case *compile.I16Spec:
-		return fmt.Sprintf("%s.NewValueI16(%s), error(nil)", wire, varName), nil
+		return fmt.Sprintf("%s.NewValueI16(%s)", wire, varName), nil
 	case *compile.I32Spec:
-		return fmt.Sprintf("%s.NewValueI32(%s), error(nil)", wire, varName), nil
+		return fmt.Sprintf("%s.NewValueI32(%s)", wire, varName), nil
 	case *compile.I64Spec:
-		return fmt.Sprintf("%s.NewValueI64(%s), error(nil)", wire, varName), nil
+		return fmt.Sprintf("%s.NewValueI64(%s)", wire, varName), nil
 	case *compile.DoubleSpec:
-		return fmt.Sprintf("%s.NewValueDouble(%s), error(nil)", wire, varName), nil
+		return fmt.Sprintf("%s.NewValueDouble(%s)", wire, varName), nil
 	case *compile.StringSpec:
-		return fmt.Sprintf("%s.NewValueString(%s), error(nil)", wire, varName), nil
+		return fmt.Sprintf("%s.NewValueString(%s)", wire, varName), nil
 	case *compile.BinarySpec:
-		return fmt.Sprintf("%s.NewValueBinary(%s), error(nil)", wire, varName), nil
+		return fmt.Sprintf("%s.NewValueBinary(%s)", wire, varName), nil
+	case *compile.UUIDSpec:
+		return fmt.Sprintf("%s.NewValueUUID(%s)", wire, varName), nil
 	case *compile.MapSpec:
 		mapItemList, err := w.mapG.ItemList(g, s)
 		if err != nil {
@@ -66,7 +102,7 @@ func (w *WireGenerator) ToWire(g Generator, spec compile.TypeSpec, varName strin
 		}
 
 		return g.TextTemplate(
-			`<.Wire>.NewValueMap(<.MapItemList>(<.Name>)), error(nil)`,
+			`<.Wire>.NewValueMap(<.MapItemList>(<.Name>))`,
 			struct {
 				Wire        string
 				Name        string
@@ -81,7 +117,7 @@ func (w *WireGenerator) ToWire(g Generator, spec compile.TypeSpec, varName strin
 		}
 
 		return g.TextTemplate(
-			`<.Wire>.NewValueList(<.ValueList>(<.Name>)), error(nil)`,
+			`<.Wire>.NewValueList(<.ValueList>(<.Name>))`,
 			struct {
 				Wire      string
 				Name      string
@@ -96,7 +132,7 @@ func (w *WireGenerator) ToWire(g Generator, spec compile.TypeSpec, varName strin
 		}
 
 		return g.TextTemplate(
-			`<.Wire>.NewValueSet(<.ValueList>(<.Name>)), error(nil)`,
+			`<.Wire>.NewValueSet(<.ValueList>(<.Name>))`,
 			struct {
 				Wire      string
 				Name      string
@@ -104,9 +140,10 @@ func (w *WireGenerator) ToWire(g Generator, spec compile.TypeSpec, varName strin
 				ValueList string
 			}{Wire: wire, Name: varName, Spec: s, ValueList: valueList},
 		)
+	case *rawSpec:
+		return fmt.Sprintf("*(%s)", varName), nil
 	default:
-		// Custom defined type
-		return fmt.Sprintf("%s.ToWire()", varName), nil
+		return "", fmt.Errorf("converting %v to a Value may fail", spec.ThriftName())
 	}
 }
 
@@ -115,7 +152,7 @@ func (w *WireGenerator) ToWire(g Generator, spec compile.TypeSpec, varName strin
 func (w *WireGenerator) ToWirePtr(g Generator, spec compile.TypeSpec, varName string) (string, error) {
 	switch spec.(type) {
 	case *compile.BoolSpec, *compile.I8Spec, *compile.I16Spec, *compile.I32Spec,
-		*compile.I64Spec, *compile.DoubleSpec, *compile.StringSpec:
+		*compile.I64Spec, *compile.DoubleSpec, *compile.StringSpec, *compile.UUIDSpec:
 		return w.ToWire(g, spec, fmt.Sprintf("*(%s)", varName))
 	default:
 		// Everything else is either a reference type or has a ToWire method
@@ -124,26 +161,30 @@ func (w *WireGenerator) ToWirePtr(g Generator, spec compile.TypeSpec, varName st
 	}
 }
 
+// ToWireValuePtr is the same as ToWireValue except `varName` is expected to
+// be a reference to a value of the given type.
+func (w *WireGenerator) ToWireValuePtr(g Generator, spec compile.TypeSpec, varName string) (string, error) {
+	switch spec.(type) {
+	case *compile.BoolSpec, *compile.I8Spec, *compile.I16Spec, *compile.I32Spec,
+		*compile.I64Spec, *compile.DoubleSpec, *compile.StringSpec, *compile.UUIDSpec:
+		return w.ToWireValue(g, spec, fmt.Sprintf("*(%s)", varName))
+	default:
+		return w.ToWireValue(g, spec, varName)
+	}
+}
+
 // FromWire generates an expression of type ($spec, error) which reads the Value
 // at $value into a $spec.
 func (w *WireGenerator) FromWire(g Generator, spec compile.TypeSpec, value string) (string, error) {
+	if !fromWireCanFail(spec) {
+		out, err := w.FromWireValue(g, spec, value)
+		if err != nil {
+			return "", err
+		}
+		return out + ", error(nil)", nil
+	}
+
 	switch s := spec.(type) {
-	case *compile.BoolSpec:
-		return fmt.Sprintf("%s.GetBool(), error(nil)", value), nil
-	case *compile.I8Spec:
-		return fmt.Sprintf("%s.GetI8(), error(nil)", value), nil
-	case *compile.I16Spec:
-		return fmt.Sprintf("%s.GetI16(), error(nil)", value), nil
-	case *compile.I32Spec:
-		return fmt.Sprintf("%s.GetI32(), error(nil)", value), nil
-	case *compile.I64Spec:
-		return fmt.Sprintf("%s.GetI64(), error(nil)", value), nil
-	case *compile.DoubleSpec:
-		return fmt.Sprintf("%s.GetDouble(), error(nil)", value), nil
-	case *compile.StringSpec:
-		return fmt.Sprintf("%s.GetString(), error(nil)", value), nil
-	case *compile.BinarySpec:
-		return fmt.Sprintf("%s.GetBinary(), error(nil)", value), nil
 	case *compile.MapSpec:
 		reader, err := w.mapG.Reader(g, s)
 		if err != nil {
@@ -180,19 +221,72 @@ func (w *WireGenerator) FromWire(g Generator, spec compile.TypeSpec, value strin
 			return "", err
 		}
 		return fmt.Sprintf("%s(%s)", reader, value), nil
+	case *rawSpec:
+		reader, err := w.rawG.Reader(g)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s(%s)", reader, value), nil
 	default:
 		panic(fmt.Sprintf("Unknown TypeSpec (%T) %v", spec, spec))
 	}
 }
 
+// fromWireCanFail checks whether the expression generated by FromWire for
+// the given type may produce a non-nil error.
+func fromWireCanFail(spec compile.TypeSpec) bool {
+	switch spec.(type) {
+	case *compile.BoolSpec, *compile.I8Spec, *compile.I16Spec, *compile.I32Spec,
+		*compile.I64Spec, *compile.DoubleSpec, *compile.StringSpec,
+		*compile.BinarySpec, *compile.UUIDSpec:
+		return false
+	default:
+		return true
+	}
+}
+
+// FromWireValue generates an expression of type $spec which reads the Value
+// at $value.
+//
+// This may be used only for types for which fromWireCanFail is false.
+func (w *WireGenerator) FromWireValue(g Generator, spec compile.TypeSpec, value string) (string, error) {
+	switch spec.(type) {
+	case *compile.BoolSpec:
+		return fmt.Sprintf("%s.GetBool()", value), nil
+	case *compile.I8Spec:
+		return fmt.Sprintf("%s.GetI8()", value), nil
+	case *compile.I16Spec:
+		return fmt.Sprintf("%s.GetI16()", value), nil
+	case *compile.I32Spec:
+		return fmt.Sprintf("%s.GetI32()", value), nil
+	case *compile.I64Spec:
+		return fmt.Sprintf("%s.GetI64()", value), nil
+	case *compile.DoubleSpec:
+		return fmt.Sprintf("%s.GetDouble()", value), nil
+	case *compile.StringSpec:
+		return fmt.Sprintf("%s.GetString()", value), nil
+	case *compile.BinarySpec:
+		return fmt.Sprintf("%s.GetBinary()", value), nil
+	case *compile.UUIDSpec:
+		return fmt.Sprintf("%s.GetUUID()", value), nil
+	default:
+		return "", fmt.Errorf("reading %v from a Value may fail", spec.ThriftName())
+	}
+}
+
 // FromWirePtr generates a string assigning the given Value to the given lhs,
 // which is a pointer to a value of the given type.
 //
 // A variable err of type error MUST be in scope and will be assigned the
-// parse error, if any.
+// parse error, if any, unless fromWireCanFail is false for the given type.
 func (w *WireGenerator) FromWirePtr(g Generator, spec compile.TypeSpec, lhs string, value string) (string, error) {
 	if !isPrimitiveType(spec) {
 		// Everything else can be assigned to directly.
+		if !fromWireCanFail(spec) {
+			out, err := w.FromWireValue(g, spec, value)
+			return fmt.Sprintf("%s = %s", lhs, out), err
+		}
+
 		out, err := w.FromWire(g, spec, value)
 		if err != nil {
 			return "", err
@@ -202,8 +296,12 @@ func (w *WireGenerator) FromWirePtr(g Generator, spec compile.TypeSpec, lhs stri
 	return g.TextTemplate(
 		`
 			<- $x := newVar "x" ->
-			var <$x> <typeReference .Spec>
-			<$x>, err = <fromWire .Spec .Value>
+			<if fromWireCanFail .Spec ->
+				var <$x> <typeReference .Spec>
+				<$x>, err = <fromWire .Spec .Value>
+			<- else ->
+				<$x> := <fromWireValue .Spec .Value>
+			<- end>
 			<.LHS> = &<$x ->
 			`,
 		struct {
@@ -218,6 +316,10 @@ func (w *WireGenerator) FromWirePtr(g Generator, spec compile.TypeSpec, lhs stri
 // over-the-wire type code for the given TypeSpec.
 func TypeCode(g Generator, spec compile.TypeSpec) string {
 	wire := g.Import("go.uber.org/thriftrw/wire")
+	if raw, ok := spec.(*rawSpec); ok {
+		// Raw fields are sent with the type code of their declared type.
+		spec = raw.TypeSpec
+	}
 	spec = compile.RootTypeSpec(spec)
 
 	switch spec.(type) {
@@ -235,6 +337,8 @@ func TypeCode(g Generator, spec compile.TypeSpec) string {
 		return fmt.Sprintf("%s.TDouble", wire)
 	case *compile.StringSpec, *compile.BinarySpec:
 		return fmt.Sprintf("%s.TBinary", wire)
+	case *compile.UUIDSpec:
+		return fmt.Sprintf("%s.TUUID", wire)
 	case *compile.MapSpec:
 		return fmt.Sprintf("%s.TMap", wire)
 	case *compile.ListSpec:
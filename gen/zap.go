@@ -0,0 +1,471 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"fmt"
+	"strconv"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// zapGenerator generates code that allows Thrift types to be logged with
+// zap without reflection.
+type zapGenerator struct{}
+
+// templateFuncs returns the template functions used by templates that
+// generate zap marshalers.
+func (z *zapGenerator) templateFuncs() []TemplateOption {
+	return []TemplateOption{
+		TemplateFunc("zapAdd", z.zapAdd),
+		TemplateFunc("zapAddKey", z.zapAddKey),
+		TemplateFunc("zapAppend", z.zapAppend),
+		TemplateFunc("zapMarshaler", z.zapMarshaler),
+		TemplateFunc("isRedacted", isRedacted),
+		TemplateFunc("redactedValue", func() string { return redactedValue }),
+	}
+}
+
+// zapEncoder returns the suffix of the zapcore.ObjectEncoder Add* or the
+// zapcore.ArrayEncoder Append* method that should be used to log values of
+// the given type.
+func zapEncoder(spec compile.TypeSpec) string {
+	root := compile.RootTypeSpec(spec)
+	switch t := root.(type) {
+	case *compile.BoolSpec:
+		return "Bool"
+	case *compile.I8Spec:
+		return "Int8"
+	case *compile.I16Spec:
+		return "Int16"
+	case *compile.I32Spec:
+		return "Int32"
+	case *compile.I64Spec:
+		return "Int64"
+	case *compile.DoubleSpec:
+		return "Float64"
+	case *compile.StringSpec, *compile.BinarySpec, *compile.UUIDSpec, *rawSpec:
+		return "String"
+	case *compile.ListSpec, *compile.SetSpec:
+		return "Array"
+	case *compile.MapSpec:
+		if isStringMap(t) {
+			return "Object"
+		}
+		return "Array"
+	case *compile.EnumSpec, *compile.StructSpec:
+		return "Object"
+	default:
+		panic(fmt.Sprintf("unknown type (%T) %v", spec, spec))
+	}
+}
+
+// isStringMap returns true if the given map is keyed by strings. Such maps
+// are logged as objects; all other maps are logged as arrays of key-value
+// pairs.
+func isStringMap(spec *compile.MapSpec) bool {
+	_, ok := compile.RootTypeSpec(spec.KeySpec).(*compile.StringSpec)
+	return ok
+}
+
+// zapCanError returns true if encoding values of the given type with zap
+// can fail.
+func zapCanError(spec compile.TypeSpec) bool {
+	switch zapEncoder(spec) {
+	case "Object", "Array":
+		return true
+	default:
+		return false
+	}
+}
+
+// zapMarshaler returns an expression which may be passed to the zap encoder
+// method returned by zapEncoder for the value v of the given type.
+func (z *zapGenerator) zapMarshaler(g Generator, spec compile.TypeSpec, v string) (string, error) {
+	root := compile.RootTypeSpec(spec)
+
+	if isRawType(spec) {
+		// Raw values are logged as their string representation. fmt handles
+		// nil references for us.
+		return fmt.Sprintf("%s.Sprint(%s)", g.Import("fmt"), v), nil
+	}
+
+	if _, isBinary := root.(*compile.BinarySpec); isBinary {
+		base64 := g.Import("encoding/base64")
+		return fmt.Sprintf("%s.StdEncoding.EncodeToString(%s)", base64, v), nil
+	}
+
+	if _, isUUID := root.(*compile.UUIDSpec); isUUID {
+		// UUIDs are logged in their canonical form.
+		if _, isTypedef := spec.(*compile.TypedefSpec); isTypedef {
+			name, err := typeName(g, root)
+			return fmt.Sprintf("(%s)(%s).String()", name, v), err
+		}
+		return fmt.Sprintf("(%s).String()", v), nil
+	}
+
+	if isPrimitiveType(spec) {
+		if _, isEnum := root.(*compile.EnumSpec); !isEnum {
+			// Typedefs of primitives are logged as their underlying type.
+			if _, isTypedef := spec.(*compile.TypedefSpec); isTypedef {
+				name, err := typeName(g, root)
+				return fmt.Sprintf("(%s)(%s)", name, v), err
+			}
+			return v, nil
+		}
+	}
+
+	switch s := spec.(type) {
+	case *compile.MapSpec:
+		name, err := z.mapZapper(g, s)
+		return fmt.Sprintf("(%s)(%s)", name, v), err
+	case *compile.ListSpec:
+		name, err := z.listZapper(g, s)
+		return fmt.Sprintf("(%s)(%s)", name, v), err
+	case *compile.SetSpec:
+		name, err := z.setZapper(g, s)
+		return fmt.Sprintf("(%s)(%s)", name, v), err
+	default:
+		// Enums, structs, and typedefs of non-primitive types implement the
+		// zap marshaler interfaces directly.
+		return v, nil
+	}
+}
+
+// zapAdd generates a statement which adds the value v of the given type to
+// the zapcore.ObjectEncoder enc under the given key. If encoding can fail,
+// the generated statement returns the error.
+func (z *zapGenerator) zapAdd(g Generator, spec compile.TypeSpec, enc, key, v string) (string, error) {
+	return z.zapAddKey(g, spec, enc, strconv.Quote(key), v)
+}
+
+// zapAddKey is the same as zapAdd except that the key is an expression
+// rather than a constant string.
+func (z *zapGenerator) zapAddKey(g Generator, spec compile.TypeSpec, enc, key, v string) (string, error) {
+	marshaler, err := z.zapMarshaler(g, spec, v)
+	if err != nil {
+		return "", err
+	}
+
+	call := fmt.Sprintf("%s.Add%s(%s, %s)", enc, zapEncoder(spec), key, marshaler)
+	if zapCanError(spec) {
+		return fmt.Sprintf("if err := %s; err != nil {\nreturn err\n}", call), nil
+	}
+	return call, nil
+}
+
+// zapAppend generates a statement which appends the value v of the given
+// type to the zapcore.ArrayEncoder enc. If encoding can fail, the generated
+// statement returns the error.
+func (z *zapGenerator) zapAppend(g Generator, spec compile.TypeSpec, enc, v string) (string, error) {
+	marshaler, err := z.zapMarshaler(g, spec, v)
+	if err != nil {
+		return "", err
+	}
+
+	call := fmt.Sprintf("%s.Append%s(%s)", enc, zapEncoder(spec), marshaler)
+	if zapCanError(spec) {
+		return fmt.Sprintf("if err := %s; err != nil {\nreturn err\n}", call), nil
+	}
+	return call, nil
+}
+
+func zapperName(g Generator, spec compile.TypeSpec) string {
+	return fmt.Sprintf("_%s_Zapper", g.MangleType(spec))
+}
+
+func zapperItemName(g Generator, spec compile.TypeSpec) string {
+	return fmt.Sprintf("_%s_Item_Zapper", g.MangleType(spec))
+}
+
+// listZapper generates a type which logs lists of the given type as zap
+// arrays and returns its name.
+func (z *zapGenerator) listZapper(g Generator, spec *compile.ListSpec) (string, error) {
+	name := zapperName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$zapcore := import "go.uber.org/zap/zapcore">
+
+			type <.Name> <typeReference .Spec>
+
+			<$l := newVar "l">
+			<$enc := newVar "enc">
+			<$v := newVar "v">
+			// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+			// fast logging of <.Name>.
+			func (<$l> <.Name>) MarshalLogArray(<$enc> <$zapcore>.ArrayEncoder) error {
+				for _, <$v> := range <$l> {
+					<zapAppend .Spec.ValueSpec $enc $v>
+				}
+				return nil
+			}
+		`,
+		struct {
+			Name string
+			Spec *compile.ListSpec
+		}{Name: name, Spec: spec},
+		z.templateFuncs()...,
+	)
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
+// setZapper generates a type which logs sets of the given type as zap arrays
+// and returns its name.
+func (z *zapGenerator) setZapper(g Generator, spec *compile.SetSpec) (string, error) {
+	name := zapperName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$zapcore := import "go.uber.org/zap/zapcore">
+
+			type <.Name> <typeReference .Spec>
+
+			<$s := newVar "s">
+			<$enc := newVar "enc">
+			<$v := newVar "v">
+			// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+			// fast logging of <.Name>.
+			func (<$s> <.Name>) MarshalLogArray(<$enc> <$zapcore>.ArrayEncoder) error {
+				<if isHashable .Spec.ValueSpec ->
+					for <$v> := range <$s> {
+				<- else ->
+					for _, <$v> := range <$s> {
+				<- end>
+					<zapAppend .Spec.ValueSpec $enc $v>
+				}
+				return nil
+			}
+		`,
+		struct {
+			Name string
+			Spec *compile.SetSpec
+		}{Name: name, Spec: spec},
+		z.templateFuncs()...,
+	)
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
+// mapZapper generates a type which logs maps of the given type and returns
+// its name. Maps keyed by strings are logged as zap objects. All other maps
+// are logged as zap arrays of objects with "key" and "value" fields.
+func (z *zapGenerator) mapZapper(g Generator, spec *compile.MapSpec) (string, error) {
+	name := zapperName(g, spec)
+	if isStringMap(spec) {
+		err := g.EnsureDeclared(
+			`
+				<$zapcore := import "go.uber.org/zap/zapcore">
+
+				type <.Name> <typeReference .Spec>
+
+				<$m := newVar "m">
+				<$enc := newVar "enc">
+				<$k := newVar "k">
+				<$v := newVar "v">
+				// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+				// fast logging of <.Name>.
+				func (<$m> <.Name>) MarshalLogObject(<$enc> <$zapcore>.ObjectEncoder) error {
+					for <$k>, <$v> := range <$m> {
+						<zapAddKey .Spec.ValueSpec $enc (printf "(string)(%s)" $k) $v>
+					}
+					return nil
+				}
+			`,
+			struct {
+				Name string
+				Spec *compile.MapSpec
+			}{Name: name, Spec: spec},
+			z.templateFuncs()...,
+		)
+		return name, wrapGenerateError(spec.ThriftName(), err)
+	}
+
+	itemName := zapperItemName(g, spec)
+	err := g.EnsureDeclared(
+		`
+			<$zapcore := import "go.uber.org/zap/zapcore">
+
+			type <.ItemName> struct {
+				Key   <typeReference .Spec.KeySpec>
+				Value <typeReference .Spec.ValueSpec>
+			}
+
+			<$i := newVar "i">
+			<$enc := newVar "enc">
+			// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+			// fast logging of <.ItemName>.
+			func (<$i> <.ItemName>) MarshalLogObject(<$enc> <$zapcore>.ObjectEncoder) error {
+				<zapAdd .Spec.KeySpec $enc "key" (printf "%s.Key" $i)>
+				<zapAdd .Spec.ValueSpec $enc "value" (printf "%s.Value" $i)>
+				return nil
+			}
+
+			type <.Name> <typeReference .Spec>
+
+			<$m := newVar "m">
+			<$k := newVar "k">
+			<$v := newVar "v">
+			// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+			// fast logging of <.Name>.
+			func (<$m> <.Name>) MarshalLogArray(<$enc> <$zapcore>.ArrayEncoder) error {
+				<if isHashable .Spec.KeySpec ->
+					for <$k>, <$v> := range <$m> {
+				<- else ->
+					for _, <$i> := range <$m> {
+						<$k> := <$i>.Key
+						<$v> := <$i>.Value
+				<- end>
+					if err := <$enc>.AppendObject(<.ItemName>{Key: <$k>, Value: <$v>}); err != nil {
+						return err
+					}
+				}
+				return nil
+			}
+		`,
+		struct {
+			Name     string
+			ItemName string
+			Spec     *compile.MapSpec
+		}{Name: name, ItemName: itemName, Spec: spec},
+		z.templateFuncs()...,
+	)
+	return name, wrapGenerateError(spec.ThriftName(), err)
+}
+
+// zapTypedef generates zap marshaling methods for typedefs of types that are
+// not logged as primitives.
+func (z *zapGenerator) zapTypedef(g Generator, spec *compile.TypedefSpec) error {
+	encoder := zapEncoder(spec)
+	if encoder != "Object" && encoder != "Array" {
+		// Typedefs of primitives are logged as their underlying type.
+		return nil
+	}
+
+	err := g.DeclareFromTemplate(
+		`
+		<$zapcore := import "go.uber.org/zap/zapcore">
+		<$v := newVar "v">
+		<$enc := newVar "enc">
+		<$x := newVar "x">
+		<$typedefType := typeReference .Spec>
+		<if eq .Encoder "Object">
+		// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+		// fast logging of <typeName .Spec>.
+		func (<$v> <$typedefType>) MarshalLogObject(<$enc> <$zapcore>.ObjectEncoder) error {
+			<$x> := (<typeReference .Spec.Target>)(<$v>)
+			return <zapMarshaler .Spec.Target $x>.MarshalLogObject(<$enc>)
+		}
+		<else>
+		// MarshalLogArray implements zapcore.ArrayMarshaler, enabling
+		// fast logging of <typeName .Spec>.
+		func (<$v> <$typedefType>) MarshalLogArray(<$enc> <$zapcore>.ArrayEncoder) error {
+			<$x> := (<typeReference .Spec.Target>)(<$v>)
+			return <zapMarshaler .Spec.Target $x>.MarshalLogArray(<$enc>)
+		}
+		<end>
+		`,
+		struct {
+			Spec    *compile.TypedefSpec
+			Encoder string
+		}{Spec: spec, Encoder: encoder},
+		z.templateFuncs()...,
+	)
+	return wrapGenerateError(spec.Name, err)
+}
+
+// zapEnum generates a MarshalLogObject method for the given enum.
+//
+// Enums are logged as objects with the numeric value under "value" and, if
+// the value is known, its name under "name".
+func (z *zapGenerator) zapEnum(g Generator, spec *compile.EnumSpec) error {
+	err := g.DeclareFromTemplate(
+		`
+		<$zapcore := import "go.uber.org/zap/zapcore">
+		<$enumName := goName .Spec>
+		<$v := newVar "v">
+		<$enc := newVar "enc">
+		// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+		// fast logging of <$enumName>.
+		//
+		// Enums are logged as objects, where the value is logged with key
+		// "value", and if this value's name is known, the name is logged with
+		// key "name".
+		func (<$v> <$enumName>) MarshalLogObject(<$enc> <$zapcore>.ObjectEncoder) error {
+			<$enc>.AddInt32("value", int32(<$v>))
+			<if len .UniqueItems ->
+				switch int32(<$v>) {
+				<range .UniqueItems ->
+					case <.Value>:
+						<$enc>.AddString("name", "<.Name>")
+				<end ->
+				}
+			<end ->
+			return nil
+		}
+		`,
+		struct {
+			Spec        *compile.EnumSpec
+			UniqueItems []compile.EnumItem
+		}{Spec: spec, UniqueItems: enumUniqueItems(spec.Items)},
+	)
+	return wrapGenerateError(spec.Name, err)
+}
+
+// zapStruct generates a MarshalLogObject method for the given field group.
+//
+// Fields are logged under their Thrift names. Optional fields are logged
+// only if they are set, and redacted fields are never logged.
+func (z *zapGenerator) zapStruct(g Generator, f fieldGroupGenerator) error {
+	return g.DeclareFromTemplate(
+		`
+		<$zapcore := import "go.uber.org/zap/zapcore">
+		<$v := newVar "v">
+		<$enc := newVar "enc">
+		// MarshalLogObject implements zapcore.ObjectMarshaler, enabling
+		// fast logging of <.Name>.
+		func (<$v> *<.Name>) MarshalLogObject(<$enc> <$zapcore>.ObjectEncoder) error {
+			if <$v> == nil {
+				return nil
+			}
+			<range .Fields>
+				<- $f := printf "%s.%s" $v (fieldName .) ->
+				<- if .Required ->
+					<- if isRedacted .>
+						<$enc>.AddString("<.Name>", "<redactedValue>")
+					<- else>
+						<zapAdd .Type $enc .Name $f>
+					<- end>
+				<- else>
+					if <if isOptionalValue .><$v>.IsSet<goName .>()<else><$f> != nil<end> {
+						<- if isRedacted .>
+							<$enc>.AddString("<.Name>", "<redactedValue>")
+						<- else if isOptionalValue .>
+							<zapAdd .Type $enc .Name $f>
+						<- else if isPrimitiveType .Type>
+							<zapAdd .Type $enc .Name (printf "*%s" $f)>
+						<- else>
+							<zapAdd .Type $enc .Name $f>
+						<- end>
+					}
+				<- end>
+			<- end>
+			return nil
+		}
+		`, f, append(z.templateFuncs(), f.optionalValueFuncs()...)...)
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package gen
+
+import (
+	"testing"
+
+	tc "go.uber.org/thriftrw/gen/testdata/containers"
+	te "go.uber.org/thriftrw/gen/testdata/enums"
+	ts "go.uber.org/thriftrw/gen/testdata/structs"
+	td "go.uber.org/thriftrw/gen/testdata/typedefs"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestZapStructs(t *testing.T) {
+	tests := []struct {
+		desc string
+		give zapcore.ObjectMarshaler
+		want map[string]interface{}
+	}{
+		{
+			desc: "nested struct",
+			give: &ts.User{
+				Name:    "foo",
+				Contact: &ts.ContactInfo{EmailAddress: "foo@example.com"},
+			},
+			want: map[string]interface{}{
+				"name":    "foo",
+				"contact": map[string]interface{}{"emailAddress": "foo@example.com"},
+			},
+		},
+		{
+			desc: "optional fields unset",
+			give: &ts.User{Name: "foo"},
+			want: map[string]interface{}{"name": "foo"},
+		},
+		{
+			desc: "containers",
+			give: &tc.PrimitiveContainers{
+				ListOfBinary:      [][]byte{[]byte("foo")},
+				ListOfInts:        []int64{1, 2},
+				MapOfIntToString:  map[int32]string{1: "one"},
+				MapOfStringToBool: map[string]bool{"true": true},
+			},
+			want: map[string]interface{}{
+				"listOfBinary": []interface{}{"Zm9v"},
+				"listOfInts":   []interface{}{int64(1), int64(2)},
+				"mapOfIntToString": []interface{}{
+					map[string]interface{}{"key": int32(1), "value": "one"},
+				},
+				"mapOfStringToBool": map[string]interface{}{"true": true},
+			},
+		},
+		{
+			desc: "enum",
+			give: &te.StructWithOptionalEnum{E: enumDefaultPtr(te.EnumDefaultBar)},
+			want: map[string]interface{}{
+				"e": map[string]interface{}{"value": int32(1), "name": "Bar"},
+			},
+		},
+		{
+			desc: "typedef",
+			give: &td.Event{
+				UUID: &td.UUID{High: 1, Low: 2},
+				Time: (*td.Timestamp)(ptrInt64(42)),
+			},
+			want: map[string]interface{}{
+				"uuid": map[string]interface{}{"high": int64(1), "low": int64(2)},
+				"time": int64(42),
+			},
+		},
+		{
+			desc: "redacted",
+			give: &ts.Credentials{
+				Username: "foo",
+				Password: "hunter2",
+				Token:    []byte("secret"),
+			},
+			want: map[string]interface{}{
+				"username": "foo",
+				"password": "<redacted>",
+				"token":    "<redacted>",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			enc := zapcore.NewMapObjectEncoder()
+			require.NoError(t, tt.give.MarshalLogObject(enc))
+			assert.Equal(t, tt.want, enc.Fields)
+		})
+	}
+}
+
+func TestZapUnknownEnum(t *testing.T) {
+	enc := zapcore.NewMapObjectEncoder()
+	require.NoError(t, te.EnumDefault(42).MarshalLogObject(enc))
+	assert.Equal(t, map[string]interface{}{"value": int32(42)}, enc.Fields)
+}
+
+func ptrInt64(x int64) *int64 { return &x }
+
+func enumDefaultPtr(e te.EnumDefault) *te.EnumDefault { return &e }
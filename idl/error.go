@@ -0,0 +1,63 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package idl
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Error is a single syntax error found in a Thrift document.
+type Error struct {
+	// Line on which the error was found.
+	Line int
+
+	// Column at which the token that caused the error starts, or 0 if it
+	// is not known.
+	Column int
+
+	// Description of the error.
+	Message string
+}
+
+func (e Error) String() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+// ParseError is returned by Parse, ParseDefinition, and ParseType if their
+// input has syntax errors.
+//
+// The parser attempts to recover from syntax errors and keep going, so a
+// single ParseError reports all syntax errors found in the document rather
+// than just the first one.
+type ParseError struct {
+	// Errors found in the document, in the order in which they appear.
+	Errors []Error
+}
+
+func (pe *ParseError) Error() string {
+	var buffer bytes.Buffer
+	buffer.WriteString("parse error\n")
+	for _, e := range pe.Errors {
+		buffer.WriteString(fmt.Sprintf("  %v\n", e))
+	}
+	return buffer.String()
+}
@@ -20,32 +20,16 @@
 
 package internal
 
-import (
-	"bytes"
-	"fmt"
-)
-
-// parseError is an error type to keep track of any parse errors and the
-// positions they occur at.
-type parseError struct {
-	Errors map[int][]string
-}
-
-func newParseError() parseError {
-	return parseError{Errors: make(map[int][]string)}
+// Error is a single error encountered while parsing a Thrift document.
+type Error struct {
+	Line    int
+	Column  int
+	Message string
 }
 
-func (pe parseError) add(line int, msg string) {
-	pe.Errors[line] = append(pe.Errors[line], msg)
-}
+// errorList accumulates errors in the order in which they were encountered.
+type errorList []Error
 
-func (pe parseError) Error() string {
-	var buffer bytes.Buffer
-	buffer.WriteString("parse error\n")
-	for line, msgs := range pe.Errors {
-		for _, msg := range msgs {
-			buffer.WriteString(fmt.Sprintf("  line %d: %s\n", line, msg))
-		}
-	}
-	return buffer.String()
+func (el *errorList) add(line, column int, msg string) {
+	*el = append(*el, Error{Line: line, Column: column, Message: msg})
 }
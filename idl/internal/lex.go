@@ -45,11 +45,23 @@ type lexer struct {
 	line    int
 	program *ast.Program
 
+	// start is the token returned by the first call to Lex, if any. It tells
+	// the parser what kind of fragment it is parsing. The results of parsing
+	// fragments are recorded on the definition and fieldType fields.
+	start      int
+	definition ast.Definition
+	fieldType  ast.Type
+
+	// The token after start is scanned ahead of time and held here.
+	peeked  bool
+	peekTok int
+	peek    yySymType
+
 	docstringStart      int
 	lastDocstring       string
 	linesSinceDocstring int
 
-	err         parseError
+	errors      errorList
 	parseFailed bool
 
 	// Ragel:
@@ -60,14 +72,13 @@ type lexer struct {
 func newLexer(data []byte) *lexer {
 	lex := &lexer{
 		line:        1,
-		err:         newParseError(),
 		parseFailed: false,
 		data:        data,
 		p:           0,
 		pe:          len(data),
 	}
 
-//line lex.go:57
+//line lex.go:69
 	{
 		lex.cs = thrift_start
 		lex.ts = 0
@@ -75,7 +86,7 @@ func newLexer(data []byte) *lexer {
 		lex.act = 0
 	}
 
-//line lex.rl:51
+//line lex.rl:62
 	return lex
 }
 
@@ -87,7 +98,23 @@ func (lex *lexer) Lex(out *yySymType) int {
 		tok = 0
 	)
 
-//line lex.go:78
+	if lex.start != 0 {
+		tok, lex.start = lex.start, 0
+
+		// Scan the first token of the fragment right away so that the line
+		// numbers and docstrings recorded by the parser before it asks for
+		// that token are the same as in a full document.
+		lex.peekTok = lex.Lex(&lex.peek)
+		lex.peeked = true
+		return tok
+	}
+	if lex.peeked {
+		lex.peeked = false
+		*out = lex.peek
+		return lex.peekTok
+	}
+
+//line lex.go:106
 	{
 		if (lex.p) == (lex.pe) {
 			goto _test_eof
@@ -906,7 +933,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st_out
 	tr2:
-//line lex.rl:300
+//line lex.rl:328
 		lex.te = (lex.p) + 1
 		{
 			bs := lex.data[lex.ts:lex.te]
@@ -921,10 +948,9 @@ func (lex *lexer) Lex(out *yySymType) int {
 
 			if err != nil {
 				lex.Error(err.Error())
-			} else {
-				out.str = str
-				tok = LITERAL
 			}
+			out.str = str
+			tok = LITERAL
 
 			{
 				(lex.p)++
@@ -934,16 +960,16 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr7:
-//line lex.rl:289
+//line lex.rl:317
 		(lex.p) = (lex.te) - 1
 		{
 			str := string(lex.data[lex.ts:lex.te])
-			if dub, err := strconv.ParseFloat(str, 64); err != nil {
+			dub, err := strconv.ParseFloat(str, 64)
+			if err != nil {
 				lex.Error(err.Error())
-			} else {
-				out.dub = dub
-				tok = DUBCONSTANT
 			}
+			out.dub = dub
+			tok = DUBCONSTANT
 			{
 				(lex.p)++
 				lex.cs = 19
@@ -952,41 +978,42 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr16:
-//line lex.rl:270
+//line lex.rl:297
 		lex.te = (lex.p) + 1
 
 		goto st19
 	tr21:
-//line lex.rl:66
+//line lex.rl:93
 		lex.lastDocstring = string(lex.data[lex.docstringStart : lex.p+1])
 		lex.linesSinceDocstring = 0
 
-//line lex.rl:268
+//line lex.rl:295
 		lex.te = (lex.p) + 1
 
 		goto st19
 	tr22:
-//line lex.rl:270
+//line lex.rl:297
 		(lex.p) = (lex.te) - 1
 
 		goto st19
 	tr25:
-//line lex.rl:272
+//line lex.rl:299
 		(lex.p) = (lex.te) - 1
 		{
 			str := string(lex.data[lex.ts:lex.te])
 			base := 10
 			if len(str) > 2 && str[0:2] == "0x" {
 				// Hex constant
+				str = str[2:]
 				base = 16
 			}
 
-			if i64, err := strconv.ParseInt(str, base, 64); err != nil {
+			i64, err := strconv.ParseInt(str, base, 64)
+			if err != nil {
 				lex.Error(err.Error())
-			} else {
-				out.i64 = i64
-				tok = INTCONSTANT
 			}
+			out.i64 = i64
+			tok = INTCONSTANT
 			{
 				(lex.p)++
 				lex.cs = 19
@@ -1292,6 +1319,9 @@ func (lex *lexer) Lex(out *yySymType) int {
 				(lex.p) = (lex.te) - 1
 
 				lex.Error(fmt.Sprintf("%q is a reserved keyword", reservedKeyword))
+				// Treat it as an identifier so that parsing can continue.
+				out.str = reservedKeyword
+				tok = IDENTIFIER
 				{
 					(lex.p)++
 					lex.cs = 19
@@ -1314,21 +1344,21 @@ func (lex *lexer) Lex(out *yySymType) int {
 
 		goto st19
 	tr29:
-//line lex.rl:266
+//line lex.rl:293
 		lex.te = (lex.p) + 1
 
 		goto st19
 	tr30:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
-//line lex.rl:267
+//line lex.rl:294
 		lex.te = (lex.p) + 1
 
 		goto st19
 	tr31:
-//line lex.rl:260
+//line lex.rl:287
 		lex.te = (lex.p) + 1
 		{
 			tok = int(lex.data[lex.ts])
@@ -1340,13 +1370,13 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr59:
-//line lex.rl:269
+//line lex.rl:296
 		lex.te = (lex.p)
 		(lex.p)--
 
 		goto st19
 	tr60:
-//line lex.rl:272
+//line lex.rl:299
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1354,15 +1384,16 @@ func (lex *lexer) Lex(out *yySymType) int {
 			base := 10
 			if len(str) > 2 && str[0:2] == "0x" {
 				// Hex constant
+				str = str[2:]
 				base = 16
 			}
 
-			if i64, err := strconv.ParseInt(str, base, 64); err != nil {
+			i64, err := strconv.ParseInt(str, base, 64)
+			if err != nil {
 				lex.Error(err.Error())
-			} else {
-				out.i64 = i64
-				tok = INTCONSTANT
 			}
+			out.i64 = i64
+			tok = INTCONSTANT
 			{
 				(lex.p)++
 				lex.cs = 19
@@ -1371,17 +1402,17 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr62:
-//line lex.rl:289
+//line lex.rl:317
 		lex.te = (lex.p)
 		(lex.p)--
 		{
 			str := string(lex.data[lex.ts:lex.te])
-			if dub, err := strconv.ParseFloat(str, 64); err != nil {
+			dub, err := strconv.ParseFloat(str, 64)
+			if err != nil {
 				lex.Error(err.Error())
-			} else {
-				out.dub = dub
-				tok = DUBCONSTANT
 			}
+			out.dub = dub
+			tok = DUBCONSTANT
 			{
 				(lex.p)++
 				lex.cs = 19
@@ -1390,13 +1421,13 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr64:
-//line lex.rl:270
+//line lex.rl:297
 		lex.te = (lex.p)
 		(lex.p)--
 
 		goto st19
 	tr66:
-//line lex.rl:326
+//line lex.rl:356
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1410,11 +1441,14 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr72:
-//line lex.rl:321
+//line lex.rl:348
 		lex.te = (lex.p)
 		(lex.p)--
 		{
 			lex.Error(fmt.Sprintf("%q is a reserved keyword", reservedKeyword))
+			// Treat it as an identifier so that parsing can continue.
+			out.str = reservedKeyword
+			tok = IDENTIFIER
 			{
 				(lex.p)++
 				lex.cs = 19
@@ -1423,7 +1457,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr133:
-//line lex.rl:241
+//line lex.rl:268
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1436,7 +1470,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr138:
-//line lex.rl:233
+//line lex.rl:260
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1449,7 +1483,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr145:
-//line lex.rl:234
+//line lex.rl:261
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1462,7 +1496,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr160:
-//line lex.rl:254
+//line lex.rl:281
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1475,7 +1509,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr180:
-//line lex.rl:239
+//line lex.rl:266
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1488,7 +1522,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr209:
-//line lex.rl:253
+//line lex.rl:280
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1501,7 +1535,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr220:
-//line lex.rl:249
+//line lex.rl:276
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1514,7 +1548,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr227:
-//line lex.rl:250
+//line lex.rl:277
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1527,7 +1561,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr238:
-//line lex.rl:258
+//line lex.rl:285
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1540,7 +1574,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr264:
-//line lex.rl:236
+//line lex.rl:263
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1553,7 +1587,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr268:
-//line lex.rl:237
+//line lex.rl:264
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1566,7 +1600,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr272:
-//line lex.rl:238
+//line lex.rl:265
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1579,7 +1613,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr275:
-//line lex.rl:235
+//line lex.rl:262
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1592,7 +1626,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr292:
-//line lex.rl:230
+//line lex.rl:257
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1605,7 +1639,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr311:
-//line lex.rl:243
+//line lex.rl:270
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1618,7 +1652,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr317:
-//line lex.rl:242
+//line lex.rl:269
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1631,7 +1665,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr331:
-//line lex.rl:231
+//line lex.rl:258
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1644,7 +1678,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr341:
-//line lex.rl:245
+//line lex.rl:272
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1657,7 +1691,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr350:
-//line lex.rl:256
+//line lex.rl:283
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1670,7 +1704,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr383:
-//line lex.rl:255
+//line lex.rl:282
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1683,7 +1717,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr398:
-//line lex.rl:252
+//line lex.rl:279
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1696,7 +1730,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr401:
-//line lex.rl:244
+//line lex.rl:271
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1709,7 +1743,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr410:
-//line lex.rl:240
+//line lex.rl:267
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1722,7 +1756,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr415:
-//line lex.rl:247
+//line lex.rl:274
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1735,7 +1769,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr432:
-//line lex.rl:251
+//line lex.rl:278
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1748,7 +1782,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr442:
-//line lex.rl:257
+//line lex.rl:284
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1761,7 +1795,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr450:
-//line lex.rl:246
+//line lex.rl:273
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1774,7 +1808,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr461:
-//line lex.rl:248
+//line lex.rl:275
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1787,7 +1821,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st19
 	tr473:
-//line lex.rl:232
+//line lex.rl:259
 		lex.te = (lex.p)
 		(lex.p)--
 		{
@@ -1810,7 +1844,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.ts = (lex.p)
 
-//line lex.go:1355
+//line lex.go:1383
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto tr29
@@ -1998,7 +2032,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof22
 		}
 	st_case_22:
-//line lex.go:1543
+//line lex.go:1571
 		switch lex.data[(lex.p)] {
 		case 69:
 			goto st6
@@ -2067,7 +2101,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st10
 	tr13:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -2077,7 +2111,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof10
 		}
 	st_case_10:
-//line lex.go:1623
+//line lex.go:1651
 		switch lex.data[(lex.p)] {
 		case 10:
 			goto tr13
@@ -2100,7 +2134,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st10
 	tr14:
-//line lex.rl:64
+//line lex.rl:91
 		lex.docstringStart = lex.p - 2
 		goto st12
 	st12:
@@ -2108,7 +2142,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof12
 		}
 	st_case_12:
-//line lex.go:1654
+//line lex.go:1682
 		switch lex.data[(lex.p)] {
 		case 10:
 			goto tr18
@@ -2119,7 +2153,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto st13
 	tr18:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -2129,7 +2163,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof13
 		}
 	st_case_13:
-//line lex.go:1676
+//line lex.go:1704
 		switch lex.data[(lex.p)] {
 		case 10:
 			goto tr18
@@ -2161,7 +2195,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof24
 		}
 	st_case_24:
-//line lex.go:1708
+//line lex.go:1736
 		if lex.data[(lex.p)] == 42 {
 			goto st16
 		}
@@ -2197,7 +2231,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof25
 		}
 	st_case_25:
-//line lex.go:1744
+//line lex.go:1772
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto tr61
@@ -2248,7 +2282,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st27
 	st27:
@@ -2256,7 +2290,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof27
 		}
 	st_case_27:
-//line lex.go:1803
+//line lex.go:1831
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -2301,7 +2335,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st28
 	st28:
@@ -2309,7 +2343,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof28
 		}
 	st_case_28:
-//line lex.go:1856
+//line lex.go:1884
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -2335,7 +2369,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st29
 	st29:
@@ -2343,7 +2377,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof29
 		}
 	st_case_29:
-//line lex.go:1890
+//line lex.go:1918
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -2369,7 +2403,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st30
 	st30:
@@ -2377,7 +2411,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof30
 		}
 	st_case_30:
-//line lex.go:1924
+//line lex.go:1952
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -2403,7 +2437,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st31
 	st31:
@@ -2411,7 +2445,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof31
 		}
 	st_case_31:
-//line lex.go:1958
+//line lex.go:1986
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -2437,9 +2471,9 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:210
+//line lex.rl:237
 		reservedKeyword = string(lex.data[lex.ts:lex.te])
-//line lex.rl:321
+//line lex.rl:348
 		lex.act = 39
 		goto st32
 	st32:
@@ -2447,7 +2481,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof32
 		}
 	st_case_32:
-//line lex.go:1994
+//line lex.go:2022
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st33
@@ -2476,7 +2510,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr72
 	tr74:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -2486,7 +2520,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof33
 		}
 	st_case_33:
-//line lex.go:2034
+//line lex.go:2062
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st33
@@ -2502,7 +2536,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st34
 	st34:
@@ -2510,7 +2544,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof34
 		}
 	st_case_34:
-//line lex.go:2058
+//line lex.go:2086
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -2536,7 +2570,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st35
 	st35:
@@ -2544,7 +2578,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof35
 		}
 	st_case_35:
-//line lex.go:2092
+//line lex.go:2120
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -2570,7 +2604,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st36
 	st36:
@@ -2578,7 +2612,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof36
 		}
 	st_case_36:
-//line lex.go:2126
+//line lex.go:2154
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -2602,7 +2636,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st37
 	st37:
@@ -2610,7 +2644,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof37
 		}
 	st_case_37:
-//line lex.go:2158
+//line lex.go:2186
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -2646,7 +2680,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st38
 	st38:
@@ -2654,7 +2688,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof38
 		}
 	st_case_38:
-//line lex.go:2202
+//line lex.go:2230
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -2680,7 +2714,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st39
 	st39:
@@ -2688,7 +2722,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof39
 		}
 	st_case_39:
-//line lex.go:2236
+//line lex.go:2264
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -2714,7 +2748,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st40
 	st40:
@@ -2722,7 +2756,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof40
 		}
 	st_case_40:
-//line lex.go:2270
+//line lex.go:2298
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -2748,7 +2782,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st41
 	st41:
@@ -2756,7 +2790,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof41
 		}
 	st_case_41:
-//line lex.go:2304
+//line lex.go:2332
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -2782,7 +2816,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st42
 	st42:
@@ -2790,7 +2824,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof42
 		}
 	st_case_42:
-//line lex.go:2338
+//line lex.go:2366
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -2814,7 +2848,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st43
 	st43:
@@ -2822,7 +2856,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof43
 		}
 	st_case_43:
-//line lex.go:2370
+//line lex.go:2398
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -2846,7 +2880,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st44
 	st44:
@@ -2854,7 +2888,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof44
 		}
 	st_case_44:
-//line lex.go:2402
+//line lex.go:2430
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -2880,7 +2914,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st45
 	st45:
@@ -2888,7 +2922,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof45
 		}
 	st_case_45:
-//line lex.go:2436
+//line lex.go:2464
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -2914,7 +2948,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st46
 	st46:
@@ -2922,7 +2956,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof46
 		}
 	st_case_46:
-//line lex.go:2470
+//line lex.go:2498
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -2950,7 +2984,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st47
 	st47:
@@ -2958,7 +2992,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof47
 		}
 	st_case_47:
-//line lex.go:2506
+//line lex.go:2534
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -2984,7 +3018,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st48
 	st48:
@@ -2992,7 +3026,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof48
 		}
 	st_case_48:
-//line lex.go:2540
+//line lex.go:2568
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3018,7 +3052,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st49
 	st49:
@@ -3026,7 +3060,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof49
 		}
 	st_case_49:
-//line lex.go:2574
+//line lex.go:2602
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3052,7 +3086,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st50
 	st50:
@@ -3060,7 +3094,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof50
 		}
 	st_case_50:
-//line lex.go:2608
+//line lex.go:2636
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3086,7 +3120,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st51
 	st51:
@@ -3094,7 +3128,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof51
 		}
 	st_case_51:
-//line lex.go:2642
+//line lex.go:2670
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3120,7 +3154,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st52
 	st52:
@@ -3128,7 +3162,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof52
 		}
 	st_case_52:
-//line lex.go:2676
+//line lex.go:2704
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3154,7 +3188,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st53
 	st53:
@@ -3162,7 +3196,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof53
 		}
 	st_case_53:
-//line lex.go:2710
+//line lex.go:2738
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3188,7 +3222,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st54
 	st54:
@@ -3196,7 +3230,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof54
 		}
 	st_case_54:
-//line lex.go:2744
+//line lex.go:2772
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3222,7 +3256,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st55
 	st55:
@@ -3230,7 +3264,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof55
 		}
 	st_case_55:
-//line lex.go:2778
+//line lex.go:2806
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3256,7 +3290,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st56
 	st56:
@@ -3264,7 +3298,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof56
 		}
 	st_case_56:
-//line lex.go:2812
+//line lex.go:2840
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3290,7 +3324,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st57
 	st57:
@@ -3298,7 +3332,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof57
 		}
 	st_case_57:
-//line lex.go:2846
+//line lex.go:2874
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3324,7 +3358,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st58
 	st58:
@@ -3332,7 +3366,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof58
 		}
 	st_case_58:
-//line lex.go:2880
+//line lex.go:2908
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3358,7 +3392,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st59
 	st59:
@@ -3366,7 +3400,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof59
 		}
 	st_case_59:
-//line lex.go:2914
+//line lex.go:2942
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3392,7 +3426,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st60
 	st60:
@@ -3400,7 +3434,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof60
 		}
 	st_case_60:
-//line lex.go:2948
+//line lex.go:2976
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3426,7 +3460,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st61
 	st61:
@@ -3434,7 +3468,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof61
 		}
 	st_case_61:
-//line lex.go:2982
+//line lex.go:3010
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3460,7 +3494,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st62
 	st62:
@@ -3468,7 +3502,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof62
 		}
 	st_case_62:
-//line lex.go:3016
+//line lex.go:3044
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3494,7 +3528,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st63
 	st63:
@@ -3502,7 +3536,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof63
 		}
 	st_case_63:
-//line lex.go:3050
+//line lex.go:3078
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3528,7 +3562,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st64
 	st64:
@@ -3536,7 +3570,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof64
 		}
 	st_case_64:
-//line lex.go:3084
+//line lex.go:3112
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3562,7 +3596,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st65
 	st65:
@@ -3570,7 +3604,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof65
 		}
 	st_case_65:
-//line lex.go:3118
+//line lex.go:3146
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3596,7 +3630,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st66
 	st66:
@@ -3604,7 +3638,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof66
 		}
 	st_case_66:
-//line lex.go:3152
+//line lex.go:3180
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3630,7 +3664,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st67
 	st67:
@@ -3638,7 +3672,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof67
 		}
 	st_case_67:
-//line lex.go:3186
+//line lex.go:3214
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3664,7 +3698,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st68
 	st68:
@@ -3672,7 +3706,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof68
 		}
 	st_case_68:
-//line lex.go:3220
+//line lex.go:3248
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3698,7 +3732,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st69
 	st69:
@@ -3706,7 +3740,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof69
 		}
 	st_case_69:
-//line lex.go:3254
+//line lex.go:3282
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3740,7 +3774,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st70
 	st70:
@@ -3748,7 +3782,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof70
 		}
 	st_case_70:
-//line lex.go:3296
+//line lex.go:3324
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3774,7 +3808,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st71
 	st71:
@@ -3782,7 +3816,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof71
 		}
 	st_case_71:
-//line lex.go:3330
+//line lex.go:3358
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3808,7 +3842,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st72
 	st72:
@@ -3816,7 +3850,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof72
 		}
 	st_case_72:
-//line lex.go:3364
+//line lex.go:3392
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3842,7 +3876,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st73
 	st73:
@@ -3850,7 +3884,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof73
 		}
 	st_case_73:
-//line lex.go:3398
+//line lex.go:3426
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3876,7 +3910,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st74
 	st74:
@@ -3884,7 +3918,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof74
 		}
 	st_case_74:
-//line lex.go:3432
+//line lex.go:3460
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3910,7 +3944,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st75
 	st75:
@@ -3918,7 +3952,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof75
 		}
 	st_case_75:
-//line lex.go:3466
+//line lex.go:3494
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3944,7 +3978,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st76
 	st76:
@@ -3952,7 +3986,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof76
 		}
 	st_case_76:
-//line lex.go:3500
+//line lex.go:3528
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -3978,7 +4012,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st77
 	st77:
@@ -3986,7 +4020,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof77
 		}
 	st_case_77:
-//line lex.go:3534
+//line lex.go:3562
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -4012,7 +4046,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st78
 	st78:
@@ -4020,7 +4054,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof78
 		}
 	st_case_78:
-//line lex.go:3568
+//line lex.go:3596
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -4046,7 +4080,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st79
 	st79:
@@ -4054,7 +4088,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof79
 		}
 	st_case_79:
-//line lex.go:3602
+//line lex.go:3630
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -4080,7 +4114,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st80
 	st80:
@@ -4088,7 +4122,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof80
 		}
 	st_case_80:
-//line lex.go:3636
+//line lex.go:3664
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -4114,9 +4148,9 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:210
+//line lex.rl:237
 		reservedKeyword = string(lex.data[lex.ts:lex.te])
-//line lex.rl:321
+//line lex.rl:348
 		lex.act = 39
 		goto st81
 	st81:
@@ -4124,7 +4158,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof81
 		}
 	st_case_81:
-//line lex.go:3672
+//line lex.go:3700
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st33
@@ -4158,7 +4192,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st82
 	st82:
@@ -4166,7 +4200,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof82
 		}
 	st_case_82:
-//line lex.go:3714
+//line lex.go:3742
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -4192,7 +4226,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st83
 	st83:
@@ -4200,7 +4234,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof83
 		}
 	st_case_83:
-//line lex.go:3748
+//line lex.go:3776
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -4226,7 +4260,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st84
 	st84:
@@ -4234,7 +4268,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof84
 		}
 	st_case_84:
-//line lex.go:3782
+//line lex.go:3810
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -4268,7 +4302,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st85
 	st85:
@@ -4276,7 +4310,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof85
 		}
 	st_case_85:
-//line lex.go:3824
+//line lex.go:3852
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -4302,7 +4336,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st86
 	st86:
@@ -4310,7 +4344,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof86
 		}
 	st_case_86:
-//line lex.go:3858
+//line lex.go:3886
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -4336,7 +4370,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st87
 	st87:
@@ -4344,7 +4378,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof87
 		}
 	st_case_87:
-//line lex.go:3892
+//line lex.go:3920
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -4370,7 +4404,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st88
 	st88:
@@ -4378,7 +4412,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof88
 		}
 	st_case_88:
-//line lex.go:3926
+//line lex.go:3954
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -4404,7 +4438,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st89
 	st89:
@@ -4412,7 +4446,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof89
 		}
 	st_case_89:
-//line lex.go:3960
+//line lex.go:3988
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -4438,7 +4472,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st90
 	st90:
@@ -4446,7 +4480,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof90
 		}
 	st_case_90:
-//line lex.go:3994
+//line lex.go:4022
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -4472,7 +4506,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st91
 	st91:
@@ -4480,7 +4514,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof91
 		}
 	st_case_91:
-//line lex.go:4028
+//line lex.go:4056
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -4506,7 +4540,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:241
+//line lex.rl:268
 		lex.act = 12
 		goto st92
 	st92:
@@ -4514,7 +4548,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof92
 		}
 	st_case_92:
-//line lex.go:4062
+//line lex.go:4090
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st93
@@ -4543,7 +4577,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr133
 	tr135:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -4553,7 +4587,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof93
 		}
 	st_case_93:
-//line lex.go:4102
+//line lex.go:4130
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st93
@@ -4569,7 +4603,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st94
 	st94:
@@ -4577,7 +4611,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof94
 		}
 	st_case_94:
-//line lex.go:4126
+//line lex.go:4154
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -4603,7 +4637,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st95
 	st95:
@@ -4611,7 +4645,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof95
 		}
 	st_case_95:
-//line lex.go:4160
+//line lex.go:4188
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -4637,7 +4671,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:233
+//line lex.rl:260
 		lex.act = 4
 		goto st96
 	st96:
@@ -4645,7 +4679,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof96
 		}
 	st_case_96:
-//line lex.go:4194
+//line lex.go:4222
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st97
@@ -4674,7 +4708,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr138
 	tr140:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -4684,7 +4718,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof97
 		}
 	st_case_97:
-//line lex.go:4234
+//line lex.go:4262
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st97
@@ -4700,7 +4734,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st98
 	st98:
@@ -4708,7 +4742,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof98
 		}
 	st_case_98:
-//line lex.go:4258
+//line lex.go:4286
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -4734,7 +4768,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st99
 	st99:
@@ -4742,7 +4776,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof99
 		}
 	st_case_99:
-//line lex.go:4292
+//line lex.go:4320
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -4768,7 +4802,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st100
 	st100:
@@ -4776,7 +4810,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof100
 		}
 	st_case_100:
-//line lex.go:4326
+//line lex.go:4354
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -4802,7 +4836,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st101
 	st101:
@@ -4810,7 +4844,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof101
 		}
 	st_case_101:
-//line lex.go:4360
+//line lex.go:4388
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -4836,7 +4870,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st102
 	st102:
@@ -4844,7 +4878,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof102
 		}
 	st_case_102:
-//line lex.go:4394
+//line lex.go:4422
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -4870,7 +4904,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:234
+//line lex.rl:261
 		lex.act = 5
 		goto st103
 	st103:
@@ -4878,7 +4912,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof103
 		}
 	st_case_103:
-//line lex.go:4428
+//line lex.go:4456
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st104
@@ -4907,7 +4941,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr145
 	tr147:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -4917,7 +4951,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof104
 		}
 	st_case_104:
-//line lex.go:4468
+//line lex.go:4496
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st104
@@ -4933,7 +4967,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st105
 	st105:
@@ -4941,7 +4975,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof105
 		}
 	st_case_105:
-//line lex.go:4492
+//line lex.go:4520
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -4971,7 +5005,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st106
 	st106:
@@ -4979,7 +5013,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof106
 		}
 	st_case_106:
-//line lex.go:4530
+//line lex.go:4558
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -5007,7 +5041,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st107
 	st107:
@@ -5015,7 +5049,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof107
 		}
 	st_case_107:
-//line lex.go:4566
+//line lex.go:4594
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -5041,7 +5075,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st108
 	st108:
@@ -5049,7 +5083,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof108
 		}
 	st_case_108:
-//line lex.go:4600
+//line lex.go:4628
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -5075,7 +5109,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st109
 	st109:
@@ -5083,7 +5117,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof109
 		}
 	st_case_109:
-//line lex.go:4634
+//line lex.go:4662
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -5109,7 +5143,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st110
 	st110:
@@ -5117,7 +5151,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof110
 		}
 	st_case_110:
-//line lex.go:4668
+//line lex.go:4696
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -5145,7 +5179,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st111
 	st111:
@@ -5153,7 +5187,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof111
 		}
 	st_case_111:
-//line lex.go:4704
+//line lex.go:4732
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -5179,7 +5213,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st112
 	st112:
@@ -5187,7 +5221,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof112
 		}
 	st_case_112:
-//line lex.go:4738
+//line lex.go:4766
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -5213,7 +5247,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st113
 	st113:
@@ -5221,7 +5255,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof113
 		}
 	st_case_113:
-//line lex.go:4772
+//line lex.go:4800
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -5247,7 +5281,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st114
 	st114:
@@ -5255,7 +5289,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof114
 		}
 	st_case_114:
-//line lex.go:4806
+//line lex.go:4834
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -5283,7 +5317,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st115
 	st115:
@@ -5291,7 +5325,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof115
 		}
 	st_case_115:
-//line lex.go:4842
+//line lex.go:4870
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -5317,7 +5351,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:254
+//line lex.rl:281
 		lex.act = 25
 		goto st116
 	st116:
@@ -5325,7 +5359,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof116
 		}
 	st_case_116:
-//line lex.go:4876
+//line lex.go:4904
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st117
@@ -5354,7 +5388,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr160
 	tr162:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -5364,7 +5398,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof117
 		}
 	st_case_117:
-//line lex.go:4916
+//line lex.go:4944
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st117
@@ -5380,7 +5414,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st118
 	st118:
@@ -5388,7 +5422,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof118
 		}
 	st_case_118:
-//line lex.go:4940
+//line lex.go:4968
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -5414,7 +5448,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st119
 	st119:
@@ -5422,7 +5456,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof119
 		}
 	st_case_119:
-//line lex.go:4974
+//line lex.go:5002
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -5448,7 +5482,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st120
 	st120:
@@ -5456,7 +5490,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof120
 		}
 	st_case_120:
-//line lex.go:5008
+//line lex.go:5036
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -5482,7 +5516,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st121
 	st121:
@@ -5490,7 +5524,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof121
 		}
 	st_case_121:
-//line lex.go:5042
+//line lex.go:5070
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -5520,7 +5554,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st122
 	st122:
@@ -5528,7 +5562,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof122
 		}
 	st_case_122:
-//line lex.go:5080
+//line lex.go:5108
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -5558,7 +5592,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st123
 	st123:
@@ -5566,7 +5600,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof123
 		}
 	st_case_123:
-//line lex.go:5118
+//line lex.go:5146
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -5592,7 +5626,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st124
 	st124:
@@ -5600,7 +5634,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof124
 		}
 	st_case_124:
-//line lex.go:5152
+//line lex.go:5180
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -5626,7 +5660,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st125
 	st125:
@@ -5634,7 +5668,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof125
 		}
 	st_case_125:
-//line lex.go:5186
+//line lex.go:5214
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -5660,9 +5694,9 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:210
+//line lex.rl:237
 		reservedKeyword = string(lex.data[lex.ts:lex.te])
-//line lex.rl:321
+//line lex.rl:348
 		lex.act = 39
 		goto st126
 	st126:
@@ -5670,7 +5704,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof126
 		}
 	st_case_126:
-//line lex.go:5222
+//line lex.go:5250
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st33
@@ -5704,7 +5738,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st127
 	st127:
@@ -5712,7 +5746,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof127
 		}
 	st_case_127:
-//line lex.go:5264
+//line lex.go:5292
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -5738,7 +5772,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st128
 	st128:
@@ -5746,7 +5780,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof128
 		}
 	st_case_128:
-//line lex.go:5298
+//line lex.go:5326
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -5772,9 +5806,9 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:210
+//line lex.rl:237
 		reservedKeyword = string(lex.data[lex.ts:lex.te])
-//line lex.rl:321
+//line lex.rl:348
 		lex.act = 39
 		goto st129
 	st129:
@@ -5782,7 +5816,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof129
 		}
 	st_case_129:
-//line lex.go:5334
+//line lex.go:5362
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st33
@@ -5816,7 +5850,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st130
 	st130:
@@ -5824,7 +5858,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof130
 		}
 	st_case_130:
-//line lex.go:5376
+//line lex.go:5404
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -5850,9 +5884,9 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:210
+//line lex.rl:237
 		reservedKeyword = string(lex.data[lex.ts:lex.te])
-//line lex.rl:321
+//line lex.rl:348
 		lex.act = 39
 		goto st131
 	st131:
@@ -5860,7 +5894,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof131
 		}
 	st_case_131:
-//line lex.go:5412
+//line lex.go:5440
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st33
@@ -5894,7 +5928,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st132
 	st132:
@@ -5902,7 +5936,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof132
 		}
 	st_case_132:
-//line lex.go:5454
+//line lex.go:5482
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -5928,7 +5962,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st133
 	st133:
@@ -5936,7 +5970,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof133
 		}
 	st_case_133:
-//line lex.go:5488
+//line lex.go:5516
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -5962,7 +5996,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st134
 	st134:
@@ -5970,7 +6004,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof134
 		}
 	st_case_134:
-//line lex.go:5522
+//line lex.go:5550
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -5996,7 +6030,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:239
+//line lex.rl:266
 		lex.act = 10
 		goto st135
 	st135:
@@ -6004,7 +6038,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof135
 		}
 	st_case_135:
-//line lex.go:5556
+//line lex.go:5584
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st136
@@ -6033,7 +6067,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr180
 	tr182:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -6043,7 +6077,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof136
 		}
 	st_case_136:
-//line lex.go:5596
+//line lex.go:5624
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st136
@@ -6059,7 +6093,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st137
 	st137:
@@ -6067,7 +6101,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof137
 		}
 	st_case_137:
-//line lex.go:5620
+//line lex.go:5648
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -6093,7 +6127,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st138
 	st138:
@@ -6101,7 +6135,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof138
 		}
 	st_case_138:
-//line lex.go:5654
+//line lex.go:5682
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -6127,7 +6161,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st139
 	st139:
@@ -6135,7 +6169,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof139
 		}
 	st_case_139:
-//line lex.go:5688
+//line lex.go:5716
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -6161,7 +6195,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st140
 	st140:
@@ -6169,7 +6203,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof140
 		}
 	st_case_140:
-//line lex.go:5722
+//line lex.go:5750
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -6195,7 +6229,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st141
 	st141:
@@ -6203,7 +6237,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof141
 		}
 	st_case_141:
-//line lex.go:5756
+//line lex.go:5784
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -6229,7 +6263,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st142
 	st142:
@@ -6237,7 +6271,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof142
 		}
 	st_case_142:
-//line lex.go:5790
+//line lex.go:5818
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -6267,7 +6301,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st143
 	st143:
@@ -6275,7 +6309,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof143
 		}
 	st_case_143:
-//line lex.go:5828
+//line lex.go:5856
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -6303,7 +6337,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st144
 	st144:
@@ -6311,7 +6345,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof144
 		}
 	st_case_144:
-//line lex.go:5864
+//line lex.go:5892
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -6337,7 +6371,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st145
 	st145:
@@ -6345,7 +6379,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof145
 		}
 	st_case_145:
-//line lex.go:5898
+//line lex.go:5926
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -6373,9 +6407,9 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:210
+//line lex.rl:237
 		reservedKeyword = string(lex.data[lex.ts:lex.te])
-//line lex.rl:321
+//line lex.rl:348
 		lex.act = 39
 		goto st146
 	st146:
@@ -6383,7 +6417,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof146
 		}
 	st_case_146:
-//line lex.go:5936
+//line lex.go:5964
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st33
@@ -6417,7 +6451,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st147
 	st147:
@@ -6425,7 +6459,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof147
 		}
 	st_case_147:
-//line lex.go:5978
+//line lex.go:6006
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -6455,9 +6489,9 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:210
+//line lex.rl:237
 		reservedKeyword = string(lex.data[lex.ts:lex.te])
-//line lex.rl:321
+//line lex.rl:348
 		lex.act = 39
 		goto st148
 	st148:
@@ -6465,7 +6499,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof148
 		}
 	st_case_148:
-//line lex.go:6018
+//line lex.go:6046
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st33
@@ -6507,7 +6541,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st149
 	st149:
@@ -6515,7 +6549,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof149
 		}
 	st_case_149:
-//line lex.go:6068
+//line lex.go:6096
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -6541,7 +6575,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st150
 	st150:
@@ -6549,7 +6583,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof150
 		}
 	st_case_150:
-//line lex.go:6102
+//line lex.go:6130
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -6575,7 +6609,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st151
 	st151:
@@ -6583,7 +6617,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof151
 		}
 	st_case_151:
-//line lex.go:6136
+//line lex.go:6164
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -6609,7 +6643,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st152
 	st152:
@@ -6617,7 +6651,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof152
 		}
 	st_case_152:
-//line lex.go:6170
+//line lex.go:6198
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -6643,9 +6677,9 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:210
+//line lex.rl:237
 		reservedKeyword = string(lex.data[lex.ts:lex.te])
-//line lex.rl:321
+//line lex.rl:348
 		lex.act = 39
 		goto st153
 	st153:
@@ -6653,7 +6687,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof153
 		}
 	st_case_153:
-//line lex.go:6206
+//line lex.go:6234
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st33
@@ -6687,7 +6721,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st154
 	st154:
@@ -6695,7 +6729,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof154
 		}
 	st_case_154:
-//line lex.go:6248
+//line lex.go:6276
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -6721,7 +6755,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st155
 	st155:
@@ -6729,7 +6763,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof155
 		}
 	st_case_155:
-//line lex.go:6282
+//line lex.go:6310
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -6755,7 +6789,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st156
 	st156:
@@ -6763,7 +6797,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof156
 		}
 	st_case_156:
-//line lex.go:6316
+//line lex.go:6344
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -6789,7 +6823,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st157
 	st157:
@@ -6797,7 +6831,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof157
 		}
 	st_case_157:
-//line lex.go:6350
+//line lex.go:6378
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -6823,7 +6857,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st158
 	st158:
@@ -6831,7 +6865,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof158
 		}
 	st_case_158:
-//line lex.go:6384
+//line lex.go:6412
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -6857,7 +6891,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st159
 	st159:
@@ -6865,7 +6899,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof159
 		}
 	st_case_159:
-//line lex.go:6418
+//line lex.go:6446
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -6891,7 +6925,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st160
 	st160:
@@ -6899,7 +6933,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof160
 		}
 	st_case_160:
-//line lex.go:6452
+//line lex.go:6480
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -6925,7 +6959,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st161
 	st161:
@@ -6933,7 +6967,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof161
 		}
 	st_case_161:
-//line lex.go:6486
+//line lex.go:6514
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -6959,7 +6993,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st162
 	st162:
@@ -6967,7 +7001,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof162
 		}
 	st_case_162:
-//line lex.go:6520
+//line lex.go:6548
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -6993,7 +7027,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:253
+//line lex.rl:280
 		lex.act = 24
 		goto st163
 	st163:
@@ -7001,7 +7035,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof163
 		}
 	st_case_163:
-//line lex.go:6554
+//line lex.go:6582
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st164
@@ -7030,7 +7064,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr209
 	tr211:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -7040,7 +7074,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof164
 		}
 	st_case_164:
-//line lex.go:6594
+//line lex.go:6622
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st164
@@ -7056,7 +7090,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st165
 	st165:
@@ -7064,7 +7098,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof165
 		}
 	st_case_165:
-//line lex.go:6618
+//line lex.go:6646
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -7094,7 +7128,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st166
 	st166:
@@ -7102,7 +7136,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof166
 		}
 	st_case_166:
-//line lex.go:6656
+//line lex.go:6684
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -7128,7 +7162,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st167
 	st167:
@@ -7136,7 +7170,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof167
 		}
 	st_case_167:
-//line lex.go:6690
+//line lex.go:6718
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -7162,7 +7196,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st168
 	st168:
@@ -7170,7 +7204,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof168
 		}
 	st_case_168:
-//line lex.go:6724
+//line lex.go:6752
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -7196,9 +7230,9 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:210
+//line lex.rl:237
 		reservedKeyword = string(lex.data[lex.ts:lex.te])
-//line lex.rl:321
+//line lex.rl:348
 		lex.act = 39
 		goto st169
 	st169:
@@ -7206,7 +7240,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof169
 		}
 	st_case_169:
-//line lex.go:6760
+//line lex.go:6788
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st33
@@ -7240,7 +7274,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st170
 	st170:
@@ -7248,7 +7282,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof170
 		}
 	st_case_170:
-//line lex.go:6802
+//line lex.go:6830
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -7274,7 +7308,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st171
 	st171:
@@ -7282,7 +7316,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof171
 		}
 	st_case_171:
-//line lex.go:6836
+//line lex.go:6864
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -7308,7 +7342,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:249
+//line lex.rl:276
 		lex.act = 20
 		goto st172
 	st172:
@@ -7316,7 +7350,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof172
 		}
 	st_case_172:
-//line lex.go:6870
+//line lex.go:6898
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st173
@@ -7345,7 +7379,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr220
 	tr222:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -7355,7 +7389,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof173
 		}
 	st_case_173:
-//line lex.go:6910
+//line lex.go:6938
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st173
@@ -7371,7 +7405,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st174
 	st174:
@@ -7379,7 +7413,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof174
 		}
 	st_case_174:
-//line lex.go:6934
+//line lex.go:6962
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -7405,7 +7439,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st175
 	st175:
@@ -7413,7 +7447,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof175
 		}
 	st_case_175:
-//line lex.go:6968
+//line lex.go:6996
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -7439,7 +7473,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st176
 	st176:
@@ -7447,7 +7481,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof176
 		}
 	st_case_176:
-//line lex.go:7002
+//line lex.go:7030
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -7473,7 +7507,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st177
 	st177:
@@ -7481,7 +7515,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof177
 		}
 	st_case_177:
-//line lex.go:7036
+//line lex.go:7064
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -7507,7 +7541,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:250
+//line lex.rl:277
 		lex.act = 21
 		goto st178
 	st178:
@@ -7515,7 +7549,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof178
 		}
 	st_case_178:
-//line lex.go:7070
+//line lex.go:7098
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st179
@@ -7544,7 +7578,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr227
 	tr229:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -7554,7 +7588,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof179
 		}
 	st_case_179:
-//line lex.go:7110
+//line lex.go:7138
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st179
@@ -7570,7 +7604,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st180
 	st180:
@@ -7578,7 +7612,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof180
 		}
 	st_case_180:
-//line lex.go:7134
+//line lex.go:7162
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -7614,7 +7648,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st181
 	st181:
@@ -7622,7 +7656,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof181
 		}
 	st_case_181:
-//line lex.go:7178
+//line lex.go:7206
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -7648,7 +7682,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st182
 	st182:
@@ -7656,7 +7690,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof182
 		}
 	st_case_182:
-//line lex.go:7212
+//line lex.go:7240
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -7682,7 +7716,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st183
 	st183:
@@ -7690,7 +7724,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof183
 		}
 	st_case_183:
-//line lex.go:7246
+//line lex.go:7274
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -7716,7 +7750,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:258
+//line lex.rl:285
 		lex.act = 29
 		goto st184
 	st184:
@@ -7724,7 +7758,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof184
 		}
 	st_case_184:
-//line lex.go:7280
+//line lex.go:7308
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st185
@@ -7753,7 +7787,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr238
 	tr240:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -7763,7 +7797,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof185
 		}
 	st_case_185:
-//line lex.go:7320
+//line lex.go:7348
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st185
@@ -7779,7 +7813,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st186
 	st186:
@@ -7787,7 +7821,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof186
 		}
 	st_case_186:
-//line lex.go:7344
+//line lex.go:7372
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -7813,7 +7847,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st187
 	st187:
@@ -7821,7 +7855,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof187
 		}
 	st_case_187:
-//line lex.go:7378
+//line lex.go:7406
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -7847,7 +7881,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st188
 	st188:
@@ -7855,7 +7889,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof188
 		}
 	st_case_188:
-//line lex.go:7412
+//line lex.go:7440
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -7881,7 +7915,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st189
 	st189:
@@ -7889,7 +7923,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof189
 		}
 	st_case_189:
-//line lex.go:7446
+//line lex.go:7474
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -7915,7 +7949,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st190
 	st190:
@@ -7923,7 +7957,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof190
 		}
 	st_case_190:
-//line lex.go:7480
+//line lex.go:7508
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -7949,7 +7983,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st191
 	st191:
@@ -7957,7 +7991,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof191
 		}
 	st_case_191:
-//line lex.go:7514
+//line lex.go:7542
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -7983,7 +8017,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st192
 	st192:
@@ -7991,7 +8025,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof192
 		}
 	st_case_192:
-//line lex.go:7548
+//line lex.go:7576
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -8017,7 +8051,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st193
 	st193:
@@ -8025,7 +8059,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof193
 		}
 	st_case_193:
-//line lex.go:7582
+//line lex.go:7610
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -8051,7 +8085,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st194
 	st194:
@@ -8059,7 +8093,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof194
 		}
 	st_case_194:
-//line lex.go:7616
+//line lex.go:7644
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -8085,7 +8119,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st195
 	st195:
@@ -8093,7 +8127,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof195
 		}
 	st_case_195:
-//line lex.go:7650
+//line lex.go:7678
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -8119,7 +8153,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st196
 	st196:
@@ -8127,7 +8161,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof196
 		}
 	st_case_196:
-//line lex.go:7684
+//line lex.go:7712
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -8153,7 +8187,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st197
 	st197:
@@ -8161,7 +8195,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof197
 		}
 	st_case_197:
-//line lex.go:7718
+//line lex.go:7746
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -8187,7 +8221,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st198
 	st198:
@@ -8195,7 +8229,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof198
 		}
 	st_case_198:
-//line lex.go:7752
+//line lex.go:7780
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -8221,7 +8255,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st199
 	st199:
@@ -8229,7 +8263,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof199
 		}
 	st_case_199:
-//line lex.go:7786
+//line lex.go:7814
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -8255,7 +8289,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st200
 	st200:
@@ -8263,7 +8297,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof200
 		}
 	st_case_200:
-//line lex.go:7820
+//line lex.go:7848
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -8291,7 +8325,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st201
 	st201:
@@ -8299,7 +8333,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof201
 		}
 	st_case_201:
-//line lex.go:7856
+//line lex.go:7884
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -8325,7 +8359,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st202
 	st202:
@@ -8333,7 +8367,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof202
 		}
 	st_case_202:
-//line lex.go:7890
+//line lex.go:7918
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -8359,7 +8393,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st203
 	st203:
@@ -8367,7 +8401,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof203
 		}
 	st_case_203:
-//line lex.go:7924
+//line lex.go:7952
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -8393,7 +8427,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st204
 	st204:
@@ -8401,7 +8435,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof204
 		}
 	st_case_204:
-//line lex.go:7958
+//line lex.go:7986
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -8427,7 +8461,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st205
 	st205:
@@ -8435,7 +8469,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof205
 		}
 	st_case_205:
-//line lex.go:7992
+//line lex.go:8020
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -8461,7 +8495,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st206
 	st206:
@@ -8469,7 +8503,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof206
 		}
 	st_case_206:
-//line lex.go:8026
+//line lex.go:8054
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -8495,7 +8529,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st207
 	st207:
@@ -8503,7 +8537,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof207
 		}
 	st_case_207:
-//line lex.go:8060
+//line lex.go:8088
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -8543,7 +8577,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st208
 	st208:
@@ -8551,7 +8585,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof208
 		}
 	st_case_208:
-//line lex.go:8108
+//line lex.go:8136
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -8577,7 +8611,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:236
+//line lex.rl:263
 		lex.act = 7
 		goto st209
 	st209:
@@ -8585,7 +8619,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof209
 		}
 	st_case_209:
-//line lex.go:8142
+//line lex.go:8170
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st210
@@ -8614,7 +8648,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr264
 	tr266:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -8624,7 +8658,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof210
 		}
 	st_case_210:
-//line lex.go:8182
+//line lex.go:8210
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st210
@@ -8640,7 +8674,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st211
 	st211:
@@ -8648,7 +8682,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof211
 		}
 	st_case_211:
-//line lex.go:8206
+//line lex.go:8234
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -8674,7 +8708,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:237
+//line lex.rl:264
 		lex.act = 8
 		goto st212
 	st212:
@@ -8682,7 +8716,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof212
 		}
 	st_case_212:
-//line lex.go:8240
+//line lex.go:8268
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st213
@@ -8711,7 +8745,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr268
 	tr270:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -8721,7 +8755,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof213
 		}
 	st_case_213:
-//line lex.go:8280
+//line lex.go:8308
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st213
@@ -8737,7 +8771,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st214
 	st214:
@@ -8745,7 +8779,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof214
 		}
 	st_case_214:
-//line lex.go:8304
+//line lex.go:8332
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -8771,7 +8805,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:238
+//line lex.rl:265
 		lex.act = 9
 		goto st215
 	st215:
@@ -8779,7 +8813,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof215
 		}
 	st_case_215:
-//line lex.go:8338
+//line lex.go:8366
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st216
@@ -8808,7 +8842,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr272
 	tr274:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -8818,7 +8852,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof216
 		}
 	st_case_216:
-//line lex.go:8378
+//line lex.go:8406
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st216
@@ -8834,7 +8868,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:235
+//line lex.rl:262
 		lex.act = 6
 		goto st217
 	st217:
@@ -8842,7 +8876,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof217
 		}
 	st_case_217:
-//line lex.go:8402
+//line lex.go:8430
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st218
@@ -8871,7 +8905,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr275
 	tr277:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -8881,7 +8915,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof218
 		}
 	st_case_218:
-//line lex.go:8442
+//line lex.go:8470
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st218
@@ -8897,7 +8931,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st219
 	st219:
@@ -8905,7 +8939,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof219
 		}
 	st_case_219:
-//line lex.go:8466
+//line lex.go:8494
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -8931,7 +8965,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st220
 	st220:
@@ -8939,7 +8973,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof220
 		}
 	st_case_220:
-//line lex.go:8500
+//line lex.go:8528
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -8967,7 +9001,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st221
 	st221:
@@ -8975,7 +9009,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof221
 		}
 	st_case_221:
-//line lex.go:8536
+//line lex.go:8564
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -9001,7 +9035,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st222
 	st222:
@@ -9009,7 +9043,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof222
 		}
 	st_case_222:
-//line lex.go:8570
+//line lex.go:8598
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -9035,7 +9069,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st223
 	st223:
@@ -9043,7 +9077,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof223
 		}
 	st_case_223:
-//line lex.go:8604
+//line lex.go:8632
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -9069,7 +9103,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st224
 	st224:
@@ -9077,7 +9111,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof224
 		}
 	st_case_224:
-//line lex.go:8638
+//line lex.go:8666
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -9103,7 +9137,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st225
 	st225:
@@ -9111,7 +9145,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof225
 		}
 	st_case_225:
-//line lex.go:8672
+//line lex.go:8700
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -9137,9 +9171,9 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:210
+//line lex.rl:237
 		reservedKeyword = string(lex.data[lex.ts:lex.te])
-//line lex.rl:321
+//line lex.rl:348
 		lex.act = 39
 		goto st226
 	st226:
@@ -9147,7 +9181,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof226
 		}
 	st_case_226:
-//line lex.go:8708
+//line lex.go:8736
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st33
@@ -9187,7 +9221,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st227
 	st227:
@@ -9195,7 +9229,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof227
 		}
 	st_case_227:
-//line lex.go:8756
+//line lex.go:8784
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -9221,7 +9255,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st228
 	st228:
@@ -9229,7 +9263,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof228
 		}
 	st_case_228:
-//line lex.go:8790
+//line lex.go:8818
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -9255,7 +9289,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st229
 	st229:
@@ -9263,7 +9297,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof229
 		}
 	st_case_229:
-//line lex.go:8824
+//line lex.go:8852
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -9289,7 +9323,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st230
 	st230:
@@ -9297,7 +9331,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof230
 		}
 	st_case_230:
-//line lex.go:8858
+//line lex.go:8886
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -9323,7 +9357,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:230
+//line lex.rl:257
 		lex.act = 1
 		goto st231
 	st231:
@@ -9331,7 +9365,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof231
 		}
 	st_case_231:
-//line lex.go:8892
+//line lex.go:8920
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st232
@@ -9360,7 +9394,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr292
 	tr294:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -9370,7 +9404,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof232
 		}
 	st_case_232:
-//line lex.go:8932
+//line lex.go:8960
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st232
@@ -9386,7 +9420,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st233
 	st233:
@@ -9394,7 +9428,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof233
 		}
 	st_case_233:
-//line lex.go:8956
+//line lex.go:8984
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -9420,7 +9454,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st234
 	st234:
@@ -9428,7 +9462,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof234
 		}
 	st_case_234:
-//line lex.go:8990
+//line lex.go:9018
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -9454,7 +9488,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st235
 	st235:
@@ -9462,7 +9496,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof235
 		}
 	st_case_235:
-//line lex.go:9024
+//line lex.go:9052
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -9488,7 +9522,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st236
 	st236:
@@ -9496,7 +9530,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof236
 		}
 	st_case_236:
-//line lex.go:9058
+//line lex.go:9086
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -9522,7 +9556,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st237
 	st237:
@@ -9530,7 +9564,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof237
 		}
 	st_case_237:
-//line lex.go:9092
+//line lex.go:9120
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -9556,7 +9590,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st238
 	st238:
@@ -9564,7 +9598,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof238
 		}
 	st_case_238:
-//line lex.go:9126
+//line lex.go:9154
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -9590,7 +9624,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st239
 	st239:
@@ -9598,7 +9632,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof239
 		}
 	st_case_239:
-//line lex.go:9160
+//line lex.go:9188
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -9624,7 +9658,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st240
 	st240:
@@ -9632,7 +9666,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof240
 		}
 	st_case_240:
-//line lex.go:9194
+//line lex.go:9222
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -9658,7 +9692,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st241
 	st241:
@@ -9666,7 +9700,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof241
 		}
 	st_case_241:
-//line lex.go:9228
+//line lex.go:9256
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -9692,7 +9726,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st242
 	st242:
@@ -9700,7 +9734,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof242
 		}
 	st_case_242:
-//line lex.go:9262
+//line lex.go:9290
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -9726,7 +9760,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st243
 	st243:
@@ -9734,7 +9768,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof243
 		}
 	st_case_243:
-//line lex.go:9296
+//line lex.go:9324
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -9760,7 +9794,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st244
 	st244:
@@ -9768,7 +9802,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof244
 		}
 	st_case_244:
-//line lex.go:9330
+//line lex.go:9358
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -9794,7 +9828,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st245
 	st245:
@@ -9802,7 +9836,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof245
 		}
 	st_case_245:
-//line lex.go:9364
+//line lex.go:9392
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -9830,7 +9864,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st246
 	st246:
@@ -9838,7 +9872,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof246
 		}
 	st_case_246:
-//line lex.go:9400
+//line lex.go:9428
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -9864,7 +9898,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st247
 	st247:
@@ -9872,7 +9906,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof247
 		}
 	st_case_247:
-//line lex.go:9434
+//line lex.go:9462
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -9898,7 +9932,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st248
 	st248:
@@ -9906,7 +9940,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof248
 		}
 	st_case_248:
-//line lex.go:9468
+//line lex.go:9496
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -9932,7 +9966,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st249
 	st249:
@@ -9940,7 +9974,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof249
 		}
 	st_case_249:
-//line lex.go:9502
+//line lex.go:9530
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -9966,7 +10000,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st250
 	st250:
@@ -9974,7 +10008,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof250
 		}
 	st_case_250:
-//line lex.go:9536
+//line lex.go:9564
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -10000,7 +10034,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st251
 	st251:
@@ -10008,7 +10042,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof251
 		}
 	st_case_251:
-//line lex.go:9570
+//line lex.go:9598
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -10034,7 +10068,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:243
+//line lex.rl:270
 		lex.act = 14
 		goto st252
 	st252:
@@ -10042,7 +10076,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof252
 		}
 	st_case_252:
-//line lex.go:9604
+//line lex.go:9632
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st253
@@ -10071,7 +10105,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr311
 	tr313:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -10081,7 +10115,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof253
 		}
 	st_case_253:
-//line lex.go:9644
+//line lex.go:9672
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st253
@@ -10097,7 +10131,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st254
 	st254:
@@ -10105,7 +10139,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof254
 		}
 	st_case_254:
-//line lex.go:9668
+//line lex.go:9696
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -10133,7 +10167,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st255
 	st255:
@@ -10141,7 +10175,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof255
 		}
 	st_case_255:
-//line lex.go:9704
+//line lex.go:9732
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -10167,7 +10201,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:242
+//line lex.rl:269
 		lex.act = 13
 		goto st256
 	st256:
@@ -10175,7 +10209,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof256
 		}
 	st_case_256:
-//line lex.go:9738
+//line lex.go:9766
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st257
@@ -10204,7 +10238,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr317
 	tr319:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -10214,7 +10248,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof257
 		}
 	st_case_257:
-//line lex.go:9778
+//line lex.go:9806
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st257
@@ -10230,7 +10264,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st258
 	st258:
@@ -10238,7 +10272,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof258
 		}
 	st_case_258:
-//line lex.go:9802
+//line lex.go:9830
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -10264,7 +10298,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st259
 	st259:
@@ -10272,7 +10306,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof259
 		}
 	st_case_259:
-//line lex.go:9836
+//line lex.go:9864
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -10298,7 +10332,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st260
 	st260:
@@ -10306,7 +10340,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof260
 		}
 	st_case_260:
-//line lex.go:9870
+//line lex.go:9898
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -10338,7 +10372,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st261
 	st261:
@@ -10346,7 +10380,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof261
 		}
 	st_case_261:
-//line lex.go:9910
+//line lex.go:9938
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -10374,7 +10408,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st262
 	st262:
@@ -10382,7 +10416,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof262
 		}
 	st_case_262:
-//line lex.go:9946
+//line lex.go:9974
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -10408,7 +10442,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st263
 	st263:
@@ -10416,7 +10450,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof263
 		}
 	st_case_263:
-//line lex.go:9980
+//line lex.go:10008
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -10442,7 +10476,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st264
 	st264:
@@ -10450,7 +10484,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof264
 		}
 	st_case_264:
-//line lex.go:10014
+//line lex.go:10042
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -10476,7 +10510,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st265
 	st265:
@@ -10484,7 +10518,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof265
 		}
 	st_case_265:
-//line lex.go:10048
+//line lex.go:10076
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -10510,7 +10544,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st266
 	st266:
@@ -10518,7 +10552,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof266
 		}
 	st_case_266:
-//line lex.go:10082
+//line lex.go:10110
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -10544,7 +10578,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st267
 	st267:
@@ -10552,7 +10586,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof267
 		}
 	st_case_267:
-//line lex.go:10116
+//line lex.go:10144
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -10578,7 +10612,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:231
+//line lex.rl:258
 		lex.act = 2
 		goto st268
 	st268:
@@ -10586,7 +10620,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof268
 		}
 	st_case_268:
-//line lex.go:10150
+//line lex.go:10178
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st269
@@ -10615,7 +10649,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr331
 	tr333:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -10625,7 +10659,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof269
 		}
 	st_case_269:
-//line lex.go:10190
+//line lex.go:10218
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st269
@@ -10641,7 +10675,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st270
 	st270:
@@ -10649,7 +10683,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof270
 		}
 	st_case_270:
-//line lex.go:10214
+//line lex.go:10242
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -10675,7 +10709,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st271
 	st271:
@@ -10683,7 +10717,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof271
 		}
 	st_case_271:
-//line lex.go:10248
+//line lex.go:10276
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -10709,7 +10743,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st272
 	st272:
@@ -10717,7 +10751,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof272
 		}
 	st_case_272:
-//line lex.go:10282
+//line lex.go:10310
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -10745,7 +10779,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st273
 	st273:
@@ -10753,7 +10787,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof273
 		}
 	st_case_273:
-//line lex.go:10318
+//line lex.go:10346
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -10783,7 +10817,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st274
 	st274:
@@ -10791,7 +10825,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof274
 		}
 	st_case_274:
-//line lex.go:10356
+//line lex.go:10384
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -10817,7 +10851,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st275
 	st275:
@@ -10825,7 +10859,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof275
 		}
 	st_case_275:
-//line lex.go:10390
+//line lex.go:10418
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -10851,7 +10885,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st276
 	st276:
@@ -10859,7 +10893,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof276
 		}
 	st_case_276:
-//line lex.go:10424
+//line lex.go:10452
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -10885,7 +10919,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st277
 	st277:
@@ -10893,7 +10927,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof277
 		}
 	st_case_277:
-//line lex.go:10458
+//line lex.go:10486
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -10919,7 +10953,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:245
+//line lex.rl:272
 		lex.act = 16
 		goto st278
 	st278:
@@ -10927,7 +10961,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof278
 		}
 	st_case_278:
-//line lex.go:10492
+//line lex.go:10520
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st279
@@ -10956,7 +10990,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr341
 	tr343:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -10966,7 +11000,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof279
 		}
 	st_case_279:
-//line lex.go:10532
+//line lex.go:10560
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st279
@@ -10982,7 +11016,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st280
 	st280:
@@ -10990,7 +11024,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof280
 		}
 	st_case_280:
-//line lex.go:10556
+//line lex.go:10584
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -11016,7 +11050,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st281
 	st281:
@@ -11024,7 +11058,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof281
 		}
 	st_case_281:
-//line lex.go:10590
+//line lex.go:10618
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -11050,7 +11084,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st282
 	st282:
@@ -11058,7 +11092,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof282
 		}
 	st_case_282:
-//line lex.go:10624
+//line lex.go:10652
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -11084,7 +11118,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st283
 	st283:
@@ -11092,7 +11126,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof283
 		}
 	st_case_283:
-//line lex.go:10658
+//line lex.go:10686
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -11118,7 +11152,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st284
 	st284:
@@ -11126,7 +11160,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof284
 		}
 	st_case_284:
-//line lex.go:10692
+//line lex.go:10720
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -11152,7 +11186,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st285
 	st285:
@@ -11160,7 +11194,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof285
 		}
 	st_case_285:
-//line lex.go:10726
+//line lex.go:10754
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -11186,7 +11220,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:256
+//line lex.rl:283
 		lex.act = 27
 		goto st286
 	st286:
@@ -11194,7 +11228,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof286
 		}
 	st_case_286:
-//line lex.go:10760
+//line lex.go:10788
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st287
@@ -11223,7 +11257,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr350
 	tr352:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -11233,7 +11267,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof287
 		}
 	st_case_287:
-//line lex.go:10800
+//line lex.go:10828
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st287
@@ -11249,7 +11283,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st288
 	st288:
@@ -11257,7 +11291,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof288
 		}
 	st_case_288:
-//line lex.go:10824
+//line lex.go:10852
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -11287,7 +11321,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st289
 	st289:
@@ -11295,7 +11329,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof289
 		}
 	st_case_289:
-//line lex.go:10862
+//line lex.go:10890
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -11323,7 +11357,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st290
 	st290:
@@ -11331,7 +11365,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof290
 		}
 	st_case_290:
-//line lex.go:10898
+//line lex.go:10926
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -11357,7 +11391,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st291
 	st291:
@@ -11365,7 +11399,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof291
 		}
 	st_case_291:
-//line lex.go:10932
+//line lex.go:10960
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -11391,7 +11425,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st292
 	st292:
@@ -11399,7 +11433,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof292
 		}
 	st_case_292:
-//line lex.go:10966
+//line lex.go:10994
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -11425,7 +11459,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st293
 	st293:
@@ -11433,7 +11467,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof293
 		}
 	st_case_293:
-//line lex.go:11000
+//line lex.go:11028
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -11461,7 +11495,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st294
 	st294:
@@ -11469,7 +11503,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof294
 		}
 	st_case_294:
-//line lex.go:11036
+//line lex.go:11064
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -11497,7 +11531,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st295
 	st295:
@@ -11505,7 +11539,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof295
 		}
 	st_case_295:
-//line lex.go:11072
+//line lex.go:11100
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -11531,7 +11565,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st296
 	st296:
@@ -11539,7 +11573,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof296
 		}
 	st_case_296:
-//line lex.go:11106
+//line lex.go:11134
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -11565,7 +11599,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st297
 	st297:
@@ -11573,7 +11607,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof297
 		}
 	st_case_297:
-//line lex.go:11140
+//line lex.go:11168
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -11599,7 +11633,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st298
 	st298:
@@ -11607,7 +11641,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof298
 		}
 	st_case_298:
-//line lex.go:11174
+//line lex.go:11202
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -11633,7 +11667,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st299
 	st299:
@@ -11641,7 +11675,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof299
 		}
 	st_case_299:
-//line lex.go:11208
+//line lex.go:11236
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -11667,7 +11701,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st300
 	st300:
@@ -11675,7 +11709,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof300
 		}
 	st_case_300:
-//line lex.go:11242
+//line lex.go:11270
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -11701,7 +11735,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st301
 	st301:
@@ -11709,7 +11743,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof301
 		}
 	st_case_301:
-//line lex.go:11276
+//line lex.go:11304
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -11735,7 +11769,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st302
 	st302:
@@ -11743,7 +11777,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof302
 		}
 	st_case_302:
-//line lex.go:11310
+//line lex.go:11338
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -11769,7 +11803,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st303
 	st303:
@@ -11777,7 +11811,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof303
 		}
 	st_case_303:
-//line lex.go:11344
+//line lex.go:11372
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -11805,7 +11839,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st304
 	st304:
@@ -11813,7 +11847,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof304
 		}
 	st_case_304:
-//line lex.go:11380
+//line lex.go:11408
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -11839,7 +11873,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st305
 	st305:
@@ -11847,7 +11881,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof305
 		}
 	st_case_305:
-//line lex.go:11414
+//line lex.go:11442
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -11873,7 +11907,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st306
 	st306:
@@ -11881,7 +11915,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof306
 		}
 	st_case_306:
-//line lex.go:11448
+//line lex.go:11476
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -11915,7 +11949,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st307
 	st307:
@@ -11923,7 +11957,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof307
 		}
 	st_case_307:
-//line lex.go:11490
+//line lex.go:11518
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -11949,7 +11983,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st308
 	st308:
@@ -11957,7 +11991,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof308
 		}
 	st_case_308:
-//line lex.go:11524
+//line lex.go:11552
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -11983,7 +12017,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st309
 	st309:
@@ -11991,7 +12025,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof309
 		}
 	st_case_309:
-//line lex.go:11558
+//line lex.go:11586
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -12017,7 +12051,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st310
 	st310:
@@ -12025,7 +12059,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof310
 		}
 	st_case_310:
-//line lex.go:11592
+//line lex.go:11620
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -12051,7 +12085,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st311
 	st311:
@@ -12059,7 +12093,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof311
 		}
 	st_case_311:
-//line lex.go:11626
+//line lex.go:11654
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -12085,7 +12119,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st312
 	st312:
@@ -12093,7 +12127,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof312
 		}
 	st_case_312:
-//line lex.go:11660
+//line lex.go:11688
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -12119,7 +12153,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st313
 	st313:
@@ -12127,7 +12161,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof313
 		}
 	st_case_313:
-//line lex.go:11694
+//line lex.go:11722
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -12153,7 +12187,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st314
 	st314:
@@ -12161,7 +12195,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof314
 		}
 	st_case_314:
-//line lex.go:11728
+//line lex.go:11756
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -12187,7 +12221,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st315
 	st315:
@@ -12195,7 +12229,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof315
 		}
 	st_case_315:
-//line lex.go:11762
+//line lex.go:11790
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -12221,7 +12255,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st316
 	st316:
@@ -12229,7 +12263,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof316
 		}
 	st_case_316:
-//line lex.go:11796
+//line lex.go:11824
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -12255,7 +12289,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:255
+//line lex.rl:282
 		lex.act = 26
 		goto st317
 	st317:
@@ -12263,7 +12297,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof317
 		}
 	st_case_317:
-//line lex.go:11830
+//line lex.go:11858
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st318
@@ -12292,7 +12326,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr383
 	tr385:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -12302,7 +12336,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof318
 		}
 	st_case_318:
-//line lex.go:11870
+//line lex.go:11898
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st318
@@ -12318,7 +12352,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st319
 	st319:
@@ -12326,7 +12360,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof319
 		}
 	st_case_319:
-//line lex.go:11894
+//line lex.go:11922
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -12352,7 +12386,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st320
 	st320:
@@ -12360,7 +12394,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof320
 		}
 	st_case_320:
-//line lex.go:11928
+//line lex.go:11956
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -12388,7 +12422,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st321
 	st321:
@@ -12396,7 +12430,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof321
 		}
 	st_case_321:
-//line lex.go:11964
+//line lex.go:11992
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -12422,7 +12456,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st322
 	st322:
@@ -12430,7 +12464,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof322
 		}
 	st_case_322:
-//line lex.go:11998
+//line lex.go:12026
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -12466,7 +12500,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st323
 	st323:
@@ -12474,7 +12508,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof323
 		}
 	st_case_323:
-//line lex.go:12042
+//line lex.go:12070
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -12504,7 +12538,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st324
 	st324:
@@ -12512,7 +12546,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof324
 		}
 	st_case_324:
-//line lex.go:12080
+//line lex.go:12108
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -12538,7 +12572,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st325
 	st325:
@@ -12546,7 +12580,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof325
 		}
 	st_case_325:
-//line lex.go:12114
+//line lex.go:12142
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -12572,7 +12606,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st326
 	st326:
@@ -12580,7 +12614,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof326
 		}
 	st_case_326:
-//line lex.go:12148
+//line lex.go:12176
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -12606,7 +12640,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st327
 	st327:
@@ -12614,7 +12648,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof327
 		}
 	st_case_327:
-//line lex.go:12182
+//line lex.go:12210
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -12640,7 +12674,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:252
+//line lex.rl:279
 		lex.act = 23
 		goto st328
 	st328:
@@ -12648,7 +12682,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof328
 		}
 	st_case_328:
-//line lex.go:12216
+//line lex.go:12244
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st329
@@ -12677,7 +12711,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr398
 	tr400:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -12687,7 +12721,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof329
 		}
 	st_case_329:
-//line lex.go:12256
+//line lex.go:12284
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st329
@@ -12703,7 +12737,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:244
+//line lex.rl:271
 		lex.act = 15
 		goto st330
 	st330:
@@ -12711,7 +12745,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof330
 		}
 	st_case_330:
-//line lex.go:12280
+//line lex.go:12308
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st331
@@ -12740,7 +12774,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr401
 	tr403:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -12750,7 +12784,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof331
 		}
 	st_case_331:
-//line lex.go:12320
+//line lex.go:12348
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st331
@@ -12766,7 +12800,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st332
 	st332:
@@ -12774,7 +12808,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof332
 		}
 	st_case_332:
-//line lex.go:12344
+//line lex.go:12372
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -12800,7 +12834,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st333
 	st333:
@@ -12808,7 +12842,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof333
 		}
 	st_case_333:
-//line lex.go:12378
+//line lex.go:12406
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -12836,7 +12870,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st334
 	st334:
@@ -12844,7 +12878,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof334
 		}
 	st_case_334:
-//line lex.go:12414
+//line lex.go:12442
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -12870,7 +12904,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st335
 	st335:
@@ -12878,7 +12912,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof335
 		}
 	st_case_335:
-//line lex.go:12448
+//line lex.go:12476
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -12906,7 +12940,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st336
 	st336:
@@ -12914,7 +12948,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof336
 		}
 	st_case_336:
-//line lex.go:12484
+//line lex.go:12512
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -12940,7 +12974,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st337
 	st337:
@@ -12948,7 +12982,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof337
 		}
 	st_case_337:
-//line lex.go:12518
+//line lex.go:12546
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -12974,7 +13008,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:240
+//line lex.rl:267
 		lex.act = 11
 		goto st338
 	st338:
@@ -12982,7 +13016,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof338
 		}
 	st_case_338:
-//line lex.go:12552
+//line lex.go:12580
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st339
@@ -13011,7 +13045,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr410
 	tr412:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -13021,7 +13055,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof339
 		}
 	st_case_339:
-//line lex.go:12592
+//line lex.go:12620
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st339
@@ -13037,7 +13071,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st340
 	st340:
@@ -13045,7 +13079,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof340
 		}
 	st_case_340:
-//line lex.go:12616
+//line lex.go:12644
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -13071,7 +13105,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st341
 	st341:
@@ -13079,7 +13113,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof341
 		}
 	st_case_341:
-//line lex.go:12650
+//line lex.go:12678
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -13105,7 +13139,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:247
+//line lex.rl:274
 		lex.act = 18
 		goto st342
 	st342:
@@ -13113,7 +13147,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof342
 		}
 	st_case_342:
-//line lex.go:12684
+//line lex.go:12712
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st343
@@ -13142,7 +13176,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr415
 	tr417:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -13152,7 +13186,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof343
 		}
 	st_case_343:
-//line lex.go:12724
+//line lex.go:12752
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st343
@@ -13168,7 +13202,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st344
 	st344:
@@ -13176,7 +13210,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof344
 		}
 	st_case_344:
-//line lex.go:12748
+//line lex.go:12776
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -13202,7 +13236,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st345
 	st345:
@@ -13210,7 +13244,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof345
 		}
 	st_case_345:
-//line lex.go:12782
+//line lex.go:12810
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -13236,7 +13270,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st346
 	st346:
@@ -13244,7 +13278,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof346
 		}
 	st_case_346:
-//line lex.go:12816
+//line lex.go:12844
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -13270,7 +13304,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st347
 	st347:
@@ -13278,7 +13312,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof347
 		}
 	st_case_347:
-//line lex.go:12850
+//line lex.go:12878
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -13304,7 +13338,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st348
 	st348:
@@ -13312,7 +13346,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof348
 		}
 	st_case_348:
-//line lex.go:12884
+//line lex.go:12912
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -13338,7 +13372,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st349
 	st349:
@@ -13346,7 +13380,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof349
 		}
 	st_case_349:
-//line lex.go:12918
+//line lex.go:12946
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -13372,7 +13406,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st350
 	st350:
@@ -13380,7 +13414,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof350
 		}
 	st_case_350:
-//line lex.go:12952
+//line lex.go:12980
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -13406,7 +13440,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st351
 	st351:
@@ -13414,7 +13448,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof351
 		}
 	st_case_351:
-//line lex.go:12986
+//line lex.go:13014
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -13440,7 +13474,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st352
 	st352:
@@ -13448,7 +13482,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof352
 		}
 	st_case_352:
-//line lex.go:13020
+//line lex.go:13048
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -13474,7 +13508,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st353
 	st353:
@@ -13482,7 +13516,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof353
 		}
 	st_case_353:
-//line lex.go:13054
+//line lex.go:13082
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -13512,7 +13546,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st354
 	st354:
@@ -13520,7 +13554,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof354
 		}
 	st_case_354:
-//line lex.go:13092
+//line lex.go:13120
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -13550,7 +13584,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st355
 	st355:
@@ -13558,7 +13592,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof355
 		}
 	st_case_355:
-//line lex.go:13130
+//line lex.go:13158
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -13584,7 +13618,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st356
 	st356:
@@ -13592,7 +13626,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof356
 		}
 	st_case_356:
-//line lex.go:13164
+//line lex.go:13192
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -13618,9 +13652,9 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:210
+//line lex.rl:237
 		reservedKeyword = string(lex.data[lex.ts:lex.te])
-//line lex.rl:321
+//line lex.rl:348
 		lex.act = 39
 		goto st357
 	st357:
@@ -13628,7 +13662,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof357
 		}
 	st_case_357:
-//line lex.go:13200
+//line lex.go:13228
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st33
@@ -13662,7 +13696,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:251
+//line lex.rl:278
 		lex.act = 22
 		goto st358
 	st358:
@@ -13670,7 +13704,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof358
 		}
 	st_case_358:
-//line lex.go:13242
+//line lex.go:13270
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st359
@@ -13699,7 +13733,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr432
 	tr434:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -13709,7 +13743,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof359
 		}
 	st_case_359:
-//line lex.go:13282
+//line lex.go:13310
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st359
@@ -13725,7 +13759,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st360
 	st360:
@@ -13733,7 +13767,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof360
 		}
 	st_case_360:
-//line lex.go:13306
+//line lex.go:13334
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -13763,7 +13797,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st361
 	st361:
@@ -13771,7 +13805,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof361
 		}
 	st_case_361:
-//line lex.go:13344
+//line lex.go:13372
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -13797,7 +13831,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st362
 	st362:
@@ -13805,7 +13839,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof362
 		}
 	st_case_362:
-//line lex.go:13378
+//line lex.go:13406
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -13831,7 +13865,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st363
 	st363:
@@ -13839,7 +13873,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof363
 		}
 	st_case_363:
-//line lex.go:13412
+//line lex.go:13440
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -13865,7 +13899,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st364
 	st364:
@@ -13873,7 +13907,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof364
 		}
 	st_case_364:
-//line lex.go:13446
+//line lex.go:13474
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -13899,7 +13933,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st365
 	st365:
@@ -13907,7 +13941,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof365
 		}
 	st_case_365:
-//line lex.go:13480
+//line lex.go:13508
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -13933,7 +13967,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st366
 	st366:
@@ -13941,7 +13975,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof366
 		}
 	st_case_366:
-//line lex.go:13514
+//line lex.go:13542
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -13967,7 +14001,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:257
+//line lex.rl:284
 		lex.act = 28
 		goto st367
 	st367:
@@ -13975,7 +14009,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof367
 		}
 	st_case_367:
-//line lex.go:13548
+//line lex.go:13576
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st368
@@ -14004,7 +14038,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr442
 	tr444:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -14014,7 +14048,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof368
 		}
 	st_case_368:
-//line lex.go:13588
+//line lex.go:13616
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st368
@@ -14030,7 +14064,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st369
 	st369:
@@ -14038,7 +14072,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof369
 		}
 	st_case_369:
-//line lex.go:13612
+//line lex.go:13640
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -14064,7 +14098,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st370
 	st370:
@@ -14072,7 +14106,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof370
 		}
 	st_case_370:
-//line lex.go:13646
+//line lex.go:13674
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -14098,7 +14132,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st371
 	st371:
@@ -14106,7 +14140,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof371
 		}
 	st_case_371:
-//line lex.go:13680
+//line lex.go:13708
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -14132,7 +14166,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st372
 	st372:
@@ -14140,7 +14174,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof372
 		}
 	st_case_372:
-//line lex.go:13714
+//line lex.go:13742
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -14166,7 +14200,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st373
 	st373:
@@ -14174,7 +14208,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof373
 		}
 	st_case_373:
-//line lex.go:13748
+//line lex.go:13776
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -14200,7 +14234,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:246
+//line lex.rl:273
 		lex.act = 17
 		goto st374
 	st374:
@@ -14208,7 +14242,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof374
 		}
 	st_case_374:
-//line lex.go:13782
+//line lex.go:13810
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st375
@@ -14237,7 +14271,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr450
 	tr452:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -14247,7 +14281,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof375
 		}
 	st_case_375:
-//line lex.go:13822
+//line lex.go:13850
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st375
@@ -14263,7 +14297,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st376
 	st376:
@@ -14271,7 +14305,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof376
 		}
 	st_case_376:
-//line lex.go:13846
+//line lex.go:13874
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -14299,7 +14333,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st377
 	st377:
@@ -14307,7 +14341,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof377
 		}
 	st_case_377:
-//line lex.go:13882
+//line lex.go:13910
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -14341,7 +14375,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st378
 	st378:
@@ -14349,7 +14383,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof378
 		}
 	st_case_378:
-//line lex.go:13924
+//line lex.go:13952
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -14375,7 +14409,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st379
 	st379:
@@ -14383,7 +14417,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof379
 		}
 	st_case_379:
-//line lex.go:13958
+//line lex.go:13986
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -14409,7 +14443,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st380
 	st380:
@@ -14417,7 +14451,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof380
 		}
 	st_case_380:
-//line lex.go:13992
+//line lex.go:14020
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -14443,7 +14477,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:248
+//line lex.rl:275
 		lex.act = 19
 		goto st381
 	st381:
@@ -14451,7 +14485,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof381
 		}
 	st_case_381:
-//line lex.go:14026
+//line lex.go:14054
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st382
@@ -14480,7 +14514,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr461
 	tr463:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -14490,7 +14524,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof382
 		}
 	st_case_382:
-//line lex.go:14066
+//line lex.go:14094
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st382
@@ -14506,7 +14540,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st383
 	st383:
@@ -14514,7 +14548,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof383
 		}
 	st_case_383:
-//line lex.go:14090
+//line lex.go:14118
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -14540,7 +14574,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st384
 	st384:
@@ -14548,7 +14582,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof384
 		}
 	st_case_384:
-//line lex.go:14124
+//line lex.go:14152
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -14574,7 +14608,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st385
 	st385:
@@ -14582,7 +14616,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof385
 		}
 	st_case_385:
-//line lex.go:14158
+//line lex.go:14186
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -14608,7 +14642,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st386
 	st386:
@@ -14616,7 +14650,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof386
 		}
 	st_case_386:
-//line lex.go:14192
+//line lex.go:14220
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -14642,7 +14676,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st387
 	st387:
@@ -14650,7 +14684,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof387
 		}
 	st_case_387:
-//line lex.go:14226
+//line lex.go:14254
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -14676,7 +14710,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st388
 	st388:
@@ -14684,7 +14718,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof388
 		}
 	st_case_388:
-//line lex.go:14260
+//line lex.go:14288
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -14714,7 +14748,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st389
 	st389:
@@ -14722,7 +14756,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof389
 		}
 	st_case_389:
-//line lex.go:14298
+//line lex.go:14326
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -14748,7 +14782,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st390
 	st390:
@@ -14756,7 +14790,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof390
 		}
 	st_case_390:
-//line lex.go:14332
+//line lex.go:14360
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -14782,7 +14816,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st391
 	st391:
@@ -14790,7 +14824,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof391
 		}
 	st_case_391:
-//line lex.go:14366
+//line lex.go:14394
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -14816,7 +14850,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st392
 	st392:
@@ -14824,7 +14858,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof392
 		}
 	st_case_392:
-//line lex.go:14400
+//line lex.go:14428
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -14852,7 +14886,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st393
 	st393:
@@ -14860,7 +14894,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof393
 		}
 	st_case_393:
-//line lex.go:14436
+//line lex.go:14464
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -14886,7 +14920,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:232
+//line lex.rl:259
 		lex.act = 3
 		goto st394
 	st394:
@@ -14894,7 +14928,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof394
 		}
 	st_case_394:
-//line lex.go:14470
+//line lex.go:14498
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st395
@@ -14923,7 +14957,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 		goto tr473
 	tr475:
-//line lex.rl:75
+//line lex.rl:102
 		lex.line++
 		lex.linesSinceDocstring++
 
@@ -14933,7 +14967,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof395
 		}
 	st_case_395:
-//line lex.go:14510
+//line lex.go:14538
 		switch lex.data[(lex.p)] {
 		case 9:
 			goto st395
@@ -14949,7 +14983,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st396
 	st396:
@@ -14957,7 +14991,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof396
 		}
 	st_case_396:
-//line lex.go:14534
+//line lex.go:14562
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -14983,7 +15017,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st397
 	st397:
@@ -14991,7 +15025,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof397
 		}
 	st_case_397:
-//line lex.go:14568
+//line lex.go:14596
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -15017,7 +15051,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st398
 	st398:
@@ -15025,7 +15059,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof398
 		}
 	st_case_398:
-//line lex.go:14602
+//line lex.go:14630
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -15053,7 +15087,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st399
 	st399:
@@ -15061,7 +15095,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof399
 		}
 	st_case_399:
-//line lex.go:14638
+//line lex.go:14666
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -15089,7 +15123,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st400
 	st400:
@@ -15097,7 +15131,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof400
 		}
 	st_case_400:
-//line lex.go:14674
+//line lex.go:14702
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -15123,7 +15157,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st401
 	st401:
@@ -15131,7 +15165,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof401
 		}
 	st_case_401:
-//line lex.go:14708
+//line lex.go:14736
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -15157,7 +15191,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st402
 	st402:
@@ -15165,7 +15199,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof402
 		}
 	st_case_402:
-//line lex.go:14742
+//line lex.go:14770
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -15191,7 +15225,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st403
 	st403:
@@ -15199,7 +15233,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof403
 		}
 	st_case_403:
-//line lex.go:14776
+//line lex.go:14804
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -15225,7 +15259,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 //line NONE:1
 		lex.te = (lex.p) + 1
 
-//line lex.rl:326
+//line lex.rl:356
 		lex.act = 40
 		goto st404
 	st404:
@@ -15233,7 +15267,7 @@ func (lex *lexer) Lex(out *yySymType) int {
 			goto _test_eof404
 		}
 	st_case_404:
-//line lex.go:14810
+//line lex.go:14838
 		switch lex.data[(lex.p)] {
 		case 46:
 			goto st18
@@ -17264,16 +17298,44 @@ func (lex *lexer) Lex(out *yySymType) int {
 		}
 	}
 
-//line lex.rl:335
+//line lex.rl:365
 	if lex.cs == thrift_error {
 		lex.Error(fmt.Sprintf("unknown token at index %d", lex.p))
+
+		// Skip the offending byte and keep going so that errors in the rest of
+		// the document are reported too.
+		lex.cs = thrift_start
+		lex.p++
+		return lex.Lex(out)
 	}
-	return tok
+	return lex.scanNumberSuffix(tok, out)
 }
 
 func (lex *lexer) Error(e string) {
 	lex.parseFailed = true
-	lex.err.add(lex.line, e)
+	lex.errors.add(lex.line, lex.column(), e)
+}
+
+// column returns the column at which the last token scanned starts, or 0 if
+// that token is not on the line currently being scanned.
+func (lex *lexer) column() int {
+	start := lex.ts
+	if start < 0 || start > len(lex.data) {
+		return 0
+	}
+
+	line, column := 1, 1
+	for _, c := range lex.data[:start] {
+		column++
+		if c == '\n' {
+			line++
+			column = 1
+		}
+	}
+	if line != lex.line {
+		return 0
+	}
+	return column
 }
 
 func (lex *lexer) LastDocstring() string {
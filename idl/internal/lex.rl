@@ -25,11 +25,23 @@ type lexer struct {
     line int
     program *ast.Program
 
+    // start is the token returned by the first call to Lex, if any. It tells
+    // the parser what kind of fragment it is parsing. The results of parsing
+    // fragments are recorded on the definition and fieldType fields.
+    start int
+    definition ast.Definition
+    fieldType ast.Type
+
+    // The token after start is scanned ahead of time and held here.
+    peeked bool
+    peekTok int
+    peek yySymType
+
     docstringStart int
     lastDocstring string
     linesSinceDocstring int
 
-    err parseError
+    errors errorList
     parseFailed bool
 
     // Ragel:
@@ -41,7 +53,6 @@ type lexer struct {
 func newLexer(data []byte) *lexer {
     lex := &lexer{
         line: 1,
-        err: newParseError(),
         parseFailed: false,
         data: data,
         p: 0,
@@ -59,6 +70,22 @@ func (lex *lexer) Lex(out *yySymType) int {
         tok = 0
     )
 
+    if lex.start != 0 {
+        tok, lex.start = lex.start, 0
+
+        // Scan the first token of the fragment right away so that the line
+        // numbers and docstrings recorded by the parser before it asks for
+        // that token are the same as in a full document.
+        lex.peekTok = lex.Lex(&lex.peek)
+        lex.peeked = true
+        return tok
+    }
+    if lex.peeked {
+        lex.peeked = false
+        *out = lex.peek
+        return lex.peekTok
+    }
+
     %%{
        docstring =
             '/**' @{ lex.docstringStart = lex.p - 2 }
@@ -96,8 +123,12 @@ func (lex *lexer) Lex(out *yySymType) int {
 
         integer = ('+' | '-')? digit+;
         hex_integer = '0x' xdigit+;
+        octal_integer = ('+' | '-')? '0o' [0-7]+;
 
-        double = integer '.' digit* ([Ee] integer)?;
+        double
+            = integer '.' digit* ([Ee] integer)?
+            | integer [Ee] integer
+            ;
 
         # The following keywords are reserved in different languages and are
         # disallowed as identifiers in the IDL.
@@ -269,31 +300,36 @@ func (lex *lexer) Lex(out *yySymType) int {
             line_comment;
             multiline_comment;
 
-            (integer | hex_integer) => {
+            (integer | hex_integer | octal_integer) => {
                 str := string(lex.data[lex.ts:lex.te])
                 base := 10
                 if len(str) > 2 && str[0:2] == "0x" {
                     // Hex constant
+                    str = str[2:]
                     base = 16
+                } else if i := strings.Index(str, "0o"); i >= 0 {
+                    // Octal constant, possibly signed
+                    str = str[:i] + str[i+2:]
+                    base = 8
                 }
 
-                if i64, err := strconv.ParseInt(str, base, 64); err != nil {
+                i64, err := strconv.ParseInt(str, base, 64)
+                if err != nil {
                     lex.Error(err.Error())
-                } else {
-                    out.i64 = i64
-                    tok = INTCONSTANT
                 }
+                out.i64 = i64
+                tok = INTCONSTANT
                 fbreak;
             };
 
             double => {
                 str := string(lex.data[lex.ts:lex.te])
-                if dub, err := strconv.ParseFloat(str, 64); err != nil {
+                dub, err := strconv.ParseFloat(str, 64)
+                if err != nil {
                     lex.Error(err.Error())
-                } else {
-                    out.dub = dub
-                    tok = DUBCONSTANT
                 }
+                out.dub = dub
+                tok = DUBCONSTANT
                 fbreak;
             };
 
@@ -310,16 +346,18 @@ func (lex *lexer) Lex(out *yySymType) int {
 
                 if err != nil {
                     lex.Error(err.Error())
-                } else {
-                    out.str = str
-                    tok = LITERAL
                 }
+                out.str = str
+                tok = LITERAL
 
                 fbreak;
             };
 
             reservedKeyword __ => {
                 lex.Error(fmt.Sprintf("%q is a reserved keyword", reservedKeyword))
+                // Treat it as an identifier so that parsing can continue.
+                out.str = reservedKeyword
+                tok = IDENTIFIER
                 fbreak;
             };
 
@@ -336,13 +374,41 @@ func (lex *lexer) Lex(out *yySymType) int {
 
     if lex.cs == thrift_error {
         lex.Error(fmt.Sprintf("unknown token at index %d", lex.p))
+
+        // Skip the offending byte and keep going so that errors in the rest of
+        // the document are reported too.
+        lex.cs = thrift_start
+        lex.p++
+        return lex.Lex(out)
     }
     return tok
 }
 
 func (lex *lexer) Error(e string) {
     lex.parseFailed = true
-    lex.err.add(lex.line, e)
+    lex.errors.add(lex.line, lex.column(), e)
+}
+
+// column returns the column at which the last token scanned starts, or 0 if
+// that token is not on the line currently being scanned.
+func (lex *lexer) column() int {
+    start := lex.ts
+    if start < 0 || start > len(lex.data) {
+        return 0
+    }
+
+    line, column := 1, 1
+    for _, c := range lex.data[:start] {
+        column++
+        if c == '\n' {
+            line++
+            column = 1
+        }
+    }
+    if line != lex.line {
+        return 0
+    }
+    return column
 }
 
 func (lex *lexer) LastDocstring() string {
@@ -0,0 +1,127 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package internal
+
+import "strconv"
+
+// scanNumberSuffix extends the given INTCONSTANT token if it is the start of
+// an octal literal like 0o17 or of a double with an exponent but no decimal
+// point like 1e-9, and returns the kind of the extended token. Other tokens
+// are returned as-is.
+//
+// lex.rl matches these literals itself, but the checked-in lex.go predates
+// that and ends integer tokens before the "o" or "e", so the rest of these
+// literals is consumed here. Delete this file once lex.go is regenerated
+// with ragel ("make generate").
+func (lex *lexer) scanNumberSuffix(tok int, out *yySymType) int {
+	if tok != INTCONSTANT {
+		return tok
+	}
+
+	text := string(lex.data[lex.ts:lex.te])
+	rest := lex.data[lex.p:lex.pe]
+	if len(rest) < 2 || !isDecimal(text) {
+		return tok
+	}
+
+	var n int // length of the suffix
+	switch rest[0] {
+	case 'o':
+		if trimSign(text) != "0" {
+			return tok
+		}
+		n = 1 + countDigits(rest[1:], isOctalDigit)
+		if n == 1 || continuesIdentifier(rest[n:]) {
+			return tok
+		}
+
+		i64, err := strconv.ParseInt(signOf(text)+string(rest[1:n]), 8, 64)
+		if err != nil {
+			lex.Error(err.Error())
+		}
+		out.i64 = i64
+
+	case 'e', 'E':
+		n = 1
+		if rest[n] == '+' || rest[n] == '-' {
+			n++
+		}
+		digits := countDigits(rest[n:], isDecimalDigit)
+		if digits == 0 || continuesIdentifier(rest[n+digits:]) {
+			return tok
+		}
+		n += digits
+
+		dub, err := strconv.ParseFloat(text+string(rest[:n]), 64)
+		if err != nil {
+			lex.Error(err.Error())
+		}
+		out.dub = dub
+		tok = DUBCONSTANT
+
+	default:
+		return tok
+	}
+
+	lex.p += n
+	lex.te = lex.p
+	return tok
+}
+
+// isDecimal returns true if the given integer literal is in base 10.
+func isDecimal(s string) bool {
+	s = trimSign(s)
+	return len(s) > 0 && countDigits([]byte(s), isDecimalDigit) == len(s)
+}
+
+func signOf(s string) string {
+	if len(s) > 0 && (s[0] == '+' || s[0] == '-') {
+		return s[:1]
+	}
+	return ""
+}
+
+func trimSign(s string) string {
+	return s[len(signOf(s)):]
+}
+
+func countDigits(b []byte, isDigit func(byte) bool) int {
+	n := 0
+	for n < len(b) && isDigit(b[n]) {
+		n++
+	}
+	return n
+}
+
+// continuesIdentifier returns true if the given input starts with a
+// character which may appear inside identifiers, in which case the literal
+// ran into an identifier and is not a number.
+func continuesIdentifier(b []byte) bool {
+	if len(b) == 0 {
+		return false
+	}
+	c := b[0]
+	return c == '_' || c == '.' || isDecimalDigit(c) || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+func isDecimalDigit(c byte) bool { return '0' <= c && c <= '9' }
+
+func isOctalDigit(c byte) bool { return '0' <= c && c <= '7' }
@@ -26,14 +26,43 @@ func init() {
 	yyErrorVerbose = true
 }
 
-// Parse parses the given Thrift document.
-func Parse(s []byte) (*ast.Program, error) {
+// Parse parses the given Thrift document. If the document could not be parsed,
+// all errors encountered while parsing it are returned in the order in which
+// they appeared.
+func Parse(s []byte) (*ast.Program, []Error) {
 	lex := newLexer(s)
-	e := yyParse(lex)
-	if e == 0 && !lex.parseFailed {
-		return lex.program, nil
+	if !lex.parse() {
+		return nil, lex.errors
+	}
+	return lex.program, nil
+}
+
+// ParseDefinition parses a single definition, like a struct or a service, and
+// its docstring.
+func ParseDefinition(s []byte) (ast.Definition, []Error) {
+	lex := newLexer(s)
+	lex.start = PARSE_DEFINITION
+	if !lex.parse() {
+		return nil, lex.errors
+	}
+	return lex.definition, nil
+}
+
+// ParseType parses a single type reference, like "map<string, Foo>".
+func ParseType(s []byte) (ast.Type, []Error) {
+	lex := newLexer(s)
+	lex.start = PARSE_TYPE
+	if !lex.parse() {
+		return nil, lex.errors
 	}
-	return nil, lex.err
+	return lex.fieldType, nil
+}
+
+// parse runs the parser over the lexer's input, reporting whether it
+// succeeded.
+func (lex *lexer) parse() bool {
+	e := yyParse(lex)
+	return e == 0 && !lex.parseFailed
 }
 
 //go:generate ragel -Z -G2 -o lex.go lex.rl
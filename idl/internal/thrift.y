@@ -59,6 +59,10 @@ import "go.uber.org/thriftrw/ast"
 %token ONEWAY TYPEDEF STRUCT UNION EXCEPTION EXTENDS THROWS SERVICE ENUM CONST
 %token REQUIRED OPTIONAL TRUE FALSE
 
+// Sent by the lexer before the input to parse fragments of a document
+// instead of a full document.
+%token PARSE_DEFINITION PARSE_TYPE
+
 %type <line> lineno
 %type <docstring> docstring
 %type <prog> program
@@ -90,6 +94,14 @@ import "go.uber.org/thriftrw/ast"
 
 %%
 
+start
+    : program
+    | PARSE_DEFINITION definition optional_sep
+        { yylex.(*lexer).definition = $2 }
+    | PARSE_TYPE type
+        { yylex.(*lexer).fieldType = $2 }
+    ;
+
 program
     : headers definitions
         {
@@ -106,6 +118,9 @@ program
 headers
     : /* no headers */     { $$ = nil }
     | headers header     { $$ = append($1, $2) }
+    /* Skip malformed headers on syntax errors. */
+    | headers lineno INCLUDE error   { $$ = $1 }
+    | headers lineno NAMESPACE error { $$ = $1 }
     ;
 
 header
@@ -149,6 +164,9 @@ header
 definitions
     : /* nothing */ { $$ = nil }
     | definitions definition optional_sep { $$ = append($1, $2) }
+    /* Skip to the start of the next definition on syntax errors so that the
+       rest of the file is still checked. */
+    | definitions error { $$ = $1 }
     ;
 
 
@@ -235,6 +253,7 @@ struct_type
 enum_items
     : /* nothing */ { $$ = nil }
     | enum_items enum_item optional_sep { $$ = append($1, $2) }
+    | enum_items error { $$ = $1 }
     ;
 
 enum_item
@@ -263,6 +282,7 @@ enum_item
 fields
     : /* nothing */ { $$ = nil }
     | fields field optional_sep { $$ = append($1, $2) }
+    | fields error { $$ = $1 }
     ;
 
 
@@ -304,6 +324,7 @@ field_required
 functions
     : /* nothing */ { $$ = nil }
     | functions function optional_sep { $$ = append($1, $2) }
+    | functions error { $$ = $1 }
     ;
 
 function
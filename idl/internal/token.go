@@ -0,0 +1,90 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package internal
+
+// Token is a single token read by a Lexer.
+type Token struct {
+	// ID is one of the token constants declared in thrift.y, the character
+	// for symbols, or 0 at the end of the input.
+	ID int
+
+	// Byte offsets of the start and end of the token in the input.
+	Start, End int
+
+	// Values of IDENTIFIER, LITERAL, INTCONSTANT, and DUBCONSTANT tokens.
+	Str    string
+	Int    int64
+	Double float64
+}
+
+// IsKeyword returns true if the given token ID is a reserved keyword like
+// STRUCT or I32.
+func IsKeyword(id int) bool {
+	// Keywords are declared in a single block in thrift.y, so goyacc
+	// numbers them consecutively.
+	return id >= NAMESPACE && id <= FALSE
+}
+
+// Lexer splits Thrift documents into tokens with the same rules as Parse.
+type Lexer struct {
+	lex *lexer
+}
+
+// NewLexer builds a Lexer which reads tokens from the given document.
+func NewLexer(data []byte) *Lexer {
+	return &Lexer{lex: newLexer(data)}
+}
+
+// Next returns the next token in the document. Once the end of the
+// document is reached, Next returns tokens with ID 0.
+func (l *Lexer) Next() Token {
+	var sym yySymType
+	id := l.lex.Lex(&sym)
+	if id == 0 {
+		end := len(l.lex.data)
+		return Token{Start: end, End: end}
+	}
+
+	// Keywords consume the whitespace which follows them.
+	start, end := l.lex.ts, l.lex.te
+	for end > start && isSpace(l.lex.data[end-1]) {
+		end--
+	}
+
+	return Token{
+		ID:     id,
+		Start:  start,
+		End:    end,
+		Str:    sym.str,
+		Int:    sym.i64,
+		Double: sym.dub,
+	}
+}
+
+// Errors returns the errors encountered so far, in the order in which they
+// were encountered.
+func (l *Lexer) Errors() []Error {
+	return l.lex.errors
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r' || c == '\n'
+}
@@ -21,12 +21,15 @@
 // THE SOFTWARE.
 
 
+// Code generated by goyacc thrift.y. DO NOT EDIT.
+
 //line thrift.y:2
 package internal
 
 import __yyfmt__ "fmt"
 
 //line thrift.y:2
+
 import "go.uber.org/thriftrw/ast"
 
 //line thrift.y:7
@@ -108,6 +111,8 @@ const REQUIRED = 57375
 const OPTIONAL = 57376
 const TRUE = 57377
 const FALSE = 57378
+const PARSE_DEFINITION = 57379
+const PARSE_TYPE = 57380
 
 var yyToknames = [...]string{
 	"$end",
@@ -146,6 +151,8 @@ var yyToknames = [...]string{
 	"OPTIONAL",
 	"TRUE",
 	"FALSE",
+	"PARSE_DEFINITION",
+	"PARSE_TYPE",
 	"'*'",
 	"'='",
 	"'{'",
@@ -160,6 +167,7 @@ var yyToknames = [...]string{
 	"']'",
 	"';'",
 }
+
 var yyStatenames = [...]string{}
 
 const yyEofCode = 1
@@ -167,154 +175,215 @@ const yyErrCode = 2
 const yyInitialStackSize = 16
 
 //line yacctab:1
-var yyExca = [...]int{
+var yyExca = [...]int16{
 	-1, 1,
 	1, -1,
 	-2, 0,
-	-1, 2,
-	8, 70,
-	9, 70,
-	-2, 8,
-	-1, 3,
-	1, 1,
-	-2, 70,
+	-1, 5,
+	8, 79,
+	9, 79,
+	-2, 13,
+	-1, 10,
+	1, 4,
+	24, 79,
+	25, 79,
+	26, 79,
+	27, 79,
+	30, 79,
+	31, 79,
+	32, 79,
+	-2, 0,
+	-1, 80,
+	4, 79,
+	-2, 0,
+	-1, 81,
+	6, 79,
+	-2, 0,
+	-1, 82,
+	4, 80,
+	10, 80,
+	11, 80,
+	12, 80,
+	13, 80,
+	14, 80,
+	15, 80,
+	16, 80,
+	17, 80,
+	18, 80,
+	19, 80,
+	20, 80,
+	21, 80,
+	22, 80,
+	23, 80,
+	-2, 0,
+	-1, 134,
+	4, 80,
+	10, 80,
+	11, 80,
+	12, 80,
+	13, 80,
+	14, 80,
+	15, 80,
+	16, 80,
+	17, 80,
+	18, 80,
+	19, 80,
+	20, 80,
+	21, 80,
+	22, 80,
+	23, 80,
+	-2, 0,
+	-1, 159,
+	6, 79,
+	-2, 0,
+	-1, 170,
+	6, 79,
+	-2, 0,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 187
-
-var yyAct = [...]int{
-
-	30, 10, 66, 5, 7, 63, 64, 56, 122, 85,
-	29, 71, 67, 68, 11, 11, 87, 13, 12, 12,
-	124, 94, 93, 92, 60, 59, 58, 159, 151, 150,
-	31, 126, 90, 57, 157, 57, 57, 144, 140, 127,
-	129, 69, 70, 120, 83, 71, 67, 68, 80, 77,
-	54, 89, 105, 52, 118, 65, 72, 51, 61, 88,
-	17, 53, 55, 79, 82, 136, 137, 154, 104, 74,
-	75, 76, 115, 134, 91, 69, 70, 9, 8, 113,
-	96, 15, 14, 99, 132, 95, 102, 26, 98, 97,
-	146, 101, 100, 16, 138, 112, 108, 86, 50, 35,
-	34, 110, 111, 109, 33, 32, 28, 72, 121, 119,
-	123, 27, 117, 153, 116, 128, 114, 103, 73, 107,
-	125, 130, 72, 106, 131, 3, 6, 62, 78, 84,
-	2, 4, 133, 81, 141, 21, 135, 139, 36, 1,
-	0, 72, 142, 145, 0, 0, 143, 148, 82, 0,
-	147, 72, 0, 152, 149, 0, 0, 0, 0, 82,
-	155, 156, 0, 158, 19, 23, 24, 25, 40, 0,
-	22, 20, 18, 0, 0, 41, 42, 43, 44, 45,
-	46, 47, 48, 49, 37, 38, 39,
+const yyLast = 190
+
+var yyAct = [...]uint8{
+	9, 13, 88, 108, 7, 81, 12, 43, 82, 106,
+	14, 7, 8, 110, 15, 14, 75, 16, 111, 15,
+	93, 89, 90, 77, 76, 101, 101, 47, 46, 45,
+	162, 141, 73, 48, 44, 44, 169, 44, 156, 153,
+	105, 142, 138, 105, 72, 124, 70, 101, 55, 56,
+	91, 92, 97, 69, 52, 113, 54, 71, 61, 62,
+	63, 74, 78, 112, 166, 136, 3, 4, 171, 163,
+	93, 89, 90, 83, 123, 147, 79, 149, 150, 94,
+	144, 98, 102, 103, 86, 87, 109, 99, 85, 132,
+	130, 53, 95, 6, 34, 33, 125, 64, 115, 158,
+	91, 92, 118, 114, 31, 151, 121, 117, 116, 129,
+	107, 120, 119, 36, 40, 41, 42, 84, 126, 39,
+	37, 35, 49, 68, 51, 50, 67, 135, 94, 139,
+	137, 66, 143, 134, 65, 133, 59, 140, 58, 145,
+	94, 57, 146, 32, 165, 131, 122, 1, 60, 128,
+	127, 10, 152, 80, 94, 154, 157, 96, 104, 160,
+	102, 155, 159, 94, 5, 164, 161, 11, 100, 21,
+	38, 102, 167, 168, 148, 170, 22, 23, 24, 25,
+	26, 27, 28, 29, 30, 18, 19, 20, 17, 2,
 }
-var yyPact = [...]int{
 
-	-1000, -1000, -1000, -1000, -1000, 69, -31, -1000, 77, 56,
-	-1000, -1000, -1000, 140, -1000, 82, 107, 102, -1000, -1000,
-	101, 100, 96, -1000, -1000, -1000, -1000, -1000, -1000, 95,
-	164, 94, 18, 14, 22, 24, -6, -18, -19, -20,
+var yyPact = [...]int16{
+	29, -1000, -1000, -1000, -1000, -1000, -32, -1000, -1000, 165,
+	141, -1000, 86, -1000, -1000, -1000, 89, -7, -17, -18,
+	-19, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -32, -1000, 120, 52, -1000, -1000, 137, 134, 132,
 	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-6, -1000, -1000, -1000, -1000, 40, -1000, -1000, -1000, -1000,
-	-1000, -1000, 9, 8, 4, 93, -1000, -1000, -1000, -1000,
-	-1000, -1000, 12, -11, -22, -24, -25, -6, -31, -1000,
-	-6, -31, -1000, -6, -31, 45, 13, -1000, -1000, -1000,
-	-1000, 92, -1000, -6, -6, -1000, -1000, 91, -1000, -1000,
-	73, -1000, -1000, 62, -1000, -1000, 6, 3, -30, -26,
-	-1000, -1000, -7, -2, -1000, -1000, -1000, 0, -1000, -31,
-	-1000, 40, 79, -1000, -6, -1000, 67, 32, 90, -6,
-	-1000, -3, -31, -1000, -6, -1000, -1000, -1000, -5, -1000,
-	40, -1000, -1000, 86, -1000, -31, -9, -15, -1000, -1000,
-	40, 38, -6, -6, -8, -1000, -1000, -1000, -16, -1000,
+	-1000, 92, -1000, 130, 127, 122, 119, 12, 5, 16,
+	-13, -31, -24, -25, -1000, -1000, -1000, 22, -7, -1000,
+	-1000, -1000, -1000, -1000, 113, -1000, -7, -7, 65, -1000,
+	50, 45, 41, 106, -37, -35, -1000, -1000, -1000, -1000,
+	-1000, -1000, -1000, -1000, 14, -7, -32, -1000, -1000, -7,
+	-32, -1000, -1000, -7, -32, -1000, 51, 4, 91, -1000,
+	-7, -1000, -1000, -1000, -1000, -1000, 105, -1000, -1000, 84,
+	-1000, -1000, 79, -1000, -1000, -32, -1000, 15, 0, -9,
+	-2, -1000, -1000, -1000, 38, -1000, -1000, -32, -1000, 65,
+	-1000, 69, 44, 101, -7, -1000, -4, -7, -1000, -1000,
+	-1000, -6, -1000, 65, -1000, 95, -1000, -32, -10, 24,
+	-1000, -1000, 65, 35, -7, -7, -8, -1000, -1000, -1000,
+	23, -1000,
 }
-var yyPgo = [...]int{
 
-	0, 0, 9, 139, 10, 138, 136, 135, 133, 5,
-	131, 130, 129, 6, 128, 127, 126, 125, 2, 123,
-	119, 118, 7, 1, 117, 116, 113,
+var yyPgo = [...]uint8{
+	0, 0, 9, 189, 12, 188, 174, 170, 168, 5,
+	167, 164, 158, 8, 157, 153, 93, 151, 2, 150,
+	149, 148, 7, 147, 1, 146, 145, 144,
 }
-var yyR1 = [...]int{
-
-	0, 3, 11, 11, 10, 10, 10, 10, 17, 17,
-	16, 16, 16, 16, 16, 16, 7, 7, 7, 15,
-	15, 14, 14, 9, 9, 8, 8, 6, 6, 6,
-	13, 13, 12, 24, 24, 25, 25, 26, 26, 4,
-	4, 4, 4, 4, 5, 5, 5, 5, 5, 5,
-	5, 5, 5, 18, 18, 18, 18, 18, 18, 18,
-	18, 19, 19, 20, 20, 22, 22, 21, 21, 21,
-	1, 2, 23, 23, 23,
+
+var yyR1 = [...]int8{
+	0, 23, 23, 23, 3, 11, 11, 11, 11, 10,
+	10, 10, 10, 17, 17, 17, 16, 16, 16, 16,
+	16, 16, 7, 7, 7, 15, 15, 15, 14, 14,
+	9, 9, 9, 8, 8, 6, 6, 6, 13, 13,
+	13, 12, 25, 25, 26, 26, 27, 27, 4, 4,
+	4, 4, 4, 5, 5, 5, 5, 5, 5, 5,
+	5, 5, 18, 18, 18, 18, 18, 18, 18, 18,
+	19, 19, 20, 20, 22, 22, 21, 21, 21, 1,
+	2, 24, 24, 24,
 }
-var yyR2 = [...]int{
-
-	0, 2, 0, 2, 3, 4, 4, 4, 0, 3,
-	7, 6, 8, 8, 8, 11, 1, 1, 1, 0,
-	3, 4, 6, 0, 3, 8, 10, 1, 1, 0,
-	0, 3, 10, 1, 0, 1, 1, 0, 4, 3,
-	8, 6, 6, 2, 1, 1, 1, 1, 1, 1,
-	1, 1, 1, 1, 1, 1, 1, 1, 2, 4,
-	4, 0, 3, 0, 6, 0, 3, 0, 6, 4,
-	0, 0, 1, 1, 0,
+
+var yyR2 = [...]int8{
+	0, 1, 3, 2, 2, 0, 2, 4, 4, 3,
+	4, 4, 4, 0, 3, 2, 7, 6, 8, 8,
+	8, 11, 1, 1, 1, 0, 3, 2, 4, 6,
+	0, 3, 2, 8, 10, 1, 1, 0, 0, 3,
+	2, 10, 1, 0, 1, 1, 0, 4, 3, 8,
+	6, 6, 2, 1, 1, 1, 1, 1, 1, 1,
+	1, 1, 1, 1, 1, 1, 1, 2, 4, 4,
+	0, 3, 0, 6, 0, 3, 0, 6, 4, 0,
+	0, 1, 1, 0,
 }
-var yyChk = [...]int{
-
-	-1000, -3, -11, -17, -10, -1, -16, -1, 9, 8,
-	-23, 45, 49, -2, 5, 4, 37, 4, 32, 24,
-	31, -7, 30, 25, 26, 27, 5, 4, 4, -4,
-	-1, -4, 4, 4, 4, 4, -5, 20, 21, 22,
-	4, 11, 12, 13, 14, 15, 16, 17, 18, 19,
-	4, 39, 39, 39, 28, 38, -22, 42, 44, 44,
-	44, -22, -15, -9, -13, -1, -18, 6, 7, 35,
-	36, 5, -1, -21, -4, -4, -4, 40, -14, -1,
-	40, -8, -1, 40, -12, -2, 4, 4, 47, 39,
-	43, -1, 45, 46, 46, -22, -23, -2, -22, -23,
-	-2, -22, -23, -24, 23, 39, -19, -20, 4, -4,
-	-22, -22, 4, 6, -25, 10, -4, -13, 48, -18,
-	40, -1, 38, -23, 46, -22, 38, 41, -1, 40,
-	-23, -18, 5, -22, 6, -6, 33, 34, 4, -22,
-	41, -23, -22, -4, 42, -18, 4, -9, -23, -22,
-	38, 43, -18, -26, 29, -22, -22, 42, -9, 43,
+
+var yyChk = [...]int16{
+	-1000, -23, -3, 37, 38, -11, -16, -1, -4, -1,
+	-17, -10, -1, -24, 47, 51, -2, -5, 20, 21,
+	22, 4, 11, 12, 13, 14, 15, 16, 17, 18,
+	19, -16, 2, 9, 8, 32, 24, 31, -7, 30,
+	25, 26, 27, -22, 44, 46, 46, 46, -24, 2,
+	5, 4, 2, 39, 4, -4, -4, 4, 4, 4,
+	-21, -4, -4, -4, 5, 4, 4, 4, 4, 41,
+	41, 41, 28, 45, -1, 47, 48, 48, 40, -22,
+	-15, -9, -13, -1, 4, -4, -22, -22, -18, 6,
+	7, 35, 36, 5, -1, 42, -14, 2, -1, 42,
+	-8, 2, -1, 42, -12, 2, -2, 4, 40, -24,
+	48, 4, 49, 41, -22, -24, -2, -22, -24, -2,
+	-22, -24, -25, 23, 41, 5, -22, -19, -20, 4,
+	6, -26, 10, -4, -13, -24, 50, -18, 42, -1,
+	-22, 40, 43, -1, 42, -24, -18, 6, -6, 33,
+	34, 4, -22, 43, -22, -4, 44, -18, 4, -9,
+	-24, -22, 40, 45, -18, -27, 29, -22, -22, 44,
+	-9, 45,
 }
-var yyDef = [...]int{
-
-	2, -2, -2, -2, 3, 0, 74, 71, 0, 0,
-	9, 72, 73, 0, 4, 0, 0, 0, 70, 70,
-	0, 0, 0, 16, 17, 18, 5, 6, 7, 0,
-	0, 0, 0, 0, 0, 0, 65, 0, 0, 0,
-	43, 44, 45, 46, 47, 48, 49, 50, 51, 52,
-	65, 19, 23, 30, 70, 70, 39, 67, 70, 70,
-	70, 11, 70, 70, 71, 0, 10, 53, 54, 55,
-	56, 57, 0, 70, 0, 0, 0, 65, 74, 71,
-	65, 74, 71, 65, 74, 34, 0, 58, 61, 63,
-	66, 0, 70, 65, 65, 12, 20, 0, 13, 24,
-	0, 14, 31, 70, 33, 30, 70, 70, 74, 0,
-	41, 42, 65, 0, 70, 35, 36, 71, 59, 74,
-	60, 70, 0, 69, 65, 21, 0, 29, 0, 65,
-	62, 0, 74, 40, 65, 70, 27, 28, 0, 15,
-	70, 68, 22, 0, 23, 74, 65, 70, 64, 25,
-	70, 37, 65, 65, 0, 26, 32, 23, 70, 38,
+
+var yyDef = [...]int8{
+	5, -2, 1, 79, 79, -2, 83, 80, 3, 0,
+	-2, 6, 0, 2, 81, 82, 0, 74, 0, 0,
+	0, 52, 53, 54, 55, 56, 57, 58, 59, 60,
+	61, 83, 15, 0, 0, 79, 79, 0, 0, 0,
+	22, 23, 24, 48, 76, 79, 79, 79, 14, 7,
+	9, 0, 8, 0, 0, 0, 0, 0, 0, 0,
+	79, 0, 0, 0, 10, 11, 12, 0, 74, 25,
+	30, 38, 79, 75, 0, 79, 74, 74, 79, 17,
+	-2, -2, -2, 0, 83, 0, 50, 51, 16, 62,
+	63, 64, 65, 66, 0, 74, 83, 27, 80, 74,
+	83, 32, 80, 74, 83, 40, 43, 0, 0, 78,
+	74, 67, 70, 72, 18, 26, 0, 19, 31, 0,
+	20, 39, 79, 42, 38, 83, 49, 79, 79, 74,
+	0, 79, 44, 45, -2, 77, 68, 83, 69, 79,
+	28, 0, 37, 0, 74, 71, 0, 74, 79, 35,
+	36, 0, 21, 79, 29, 0, 30, 83, 74, -2,
+	73, 33, 79, 46, 74, 74, 0, 34, 41, 30,
+	-2, 47,
 }
-var yyTok1 = [...]int{
 
+var yyTok1 = [...]int8{
 	1, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	42, 43, 37, 3, 45, 3, 3, 3, 3, 3,
-	3, 3, 3, 3, 3, 3, 3, 3, 41, 49,
-	44, 38, 46, 3, 3, 3, 3, 3, 3, 3,
+	44, 45, 39, 3, 47, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 3, 3, 43, 51,
+	46, 40, 48, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 47, 3, 48, 3, 3, 3, 3, 3, 3,
+	3, 49, 3, 50, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 39, 3, 40,
+	3, 3, 3, 41, 3, 42,
 }
-var yyTok2 = [...]int{
 
+var yyTok2 = [...]int8{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
-	32, 33, 34, 35, 36,
+	32, 33, 34, 35, 36, 37, 38,
 }
-var yyTok3 = [...]int{
+
+var yyTok3 = [...]int8{
 	0,
 }
 
@@ -396,9 +465,9 @@ func yyErrorMessage(state, lookAhead int) string {
 	expected := make([]int, 0, 4)
 
 	// Look for shiftable tokens.
-	base := yyPact[state]
+	base := int(yyPact[state])
 	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
-		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
+		if n := base + tok; n >= 0 && n < yyLast && int(yyChk[int(yyAct[n])]) == tok {
 			if len(expected) == cap(expected) {
 				return res
 			}
@@ -408,13 +477,13 @@ func yyErrorMessage(state, lookAhead int) string {
 
 	if yyDef[state] == -2 {
 		i := 0
-		for yyExca[i] != -1 || yyExca[i+1] != state {
+		for yyExca[i] != -1 || int(yyExca[i+1]) != state {
 			i += 2
 		}
 
 		// Look for tokens that we accept or reduce.
 		for i += 2; yyExca[i] >= 0; i += 2 {
-			tok := yyExca[i]
+			tok := int(yyExca[i])
 			if tok < TOKSTART || yyExca[i+1] == 0 {
 				continue
 			}
@@ -445,30 +514,30 @@ func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
 	if char <= 0 {
-		token = yyTok1[0]
+		token = int(yyTok1[0])
 		goto out
 	}
 	if char < len(yyTok1) {
-		token = yyTok1[char]
+		token = int(yyTok1[char])
 		goto out
 	}
 	if char >= yyPrivate {
 		if char < yyPrivate+len(yyTok2) {
-			token = yyTok2[char-yyPrivate]
+			token = int(yyTok2[char-yyPrivate])
 			goto out
 		}
 	}
 	for i := 0; i < len(yyTok3); i += 2 {
-		token = yyTok3[i+0]
+		token = int(yyTok3[i+0])
 		if token == char {
-			token = yyTok3[i+1]
+			token = int(yyTok3[i+1])
 			goto out
 		}
 	}
 
 out:
 	if token == 0 {
-		token = yyTok2[1] /* unknown char */
+		token = int(yyTok2[1]) /* unknown char */
 	}
 	if yyDebug >= 3 {
 		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
@@ -523,7 +592,7 @@ yystack:
 	yyS[yyp].yys = yystate
 
 yynewstate:
-	yyn = yyPact[yystate]
+	yyn = int(yyPact[yystate])
 	if yyn <= yyFlag {
 		goto yydefault /* simple state */
 	}
@@ -534,8 +603,8 @@ yynewstate:
 	if yyn < 0 || yyn >= yyLast {
 		goto yydefault
 	}
-	yyn = yyAct[yyn]
-	if yyChk[yyn] == yytoken { /* valid shift */
+	yyn = int(yyAct[yyn])
+	if int(yyChk[yyn]) == yytoken { /* valid shift */
 		yyrcvr.char = -1
 		yytoken = -1
 		yyVAL = yyrcvr.lval
@@ -548,7 +617,7 @@ yynewstate:
 
 yydefault:
 	/* default state action */
-	yyn = yyDef[yystate]
+	yyn = int(yyDef[yystate])
 	if yyn == -2 {
 		if yyrcvr.char < 0 {
 			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
@@ -557,18 +626,18 @@ yydefault:
 		/* look through exception table */
 		xi := 0
 		for {
-			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
+			if yyExca[xi+0] == -1 && int(yyExca[xi+1]) == yystate {
 				break
 			}
 			xi += 2
 		}
 		for xi += 2; ; xi += 2 {
-			yyn = yyExca[xi+0]
+			yyn = int(yyExca[xi+0])
 			if yyn < 0 || yyn == yytoken {
 				break
 			}
 		}
-		yyn = yyExca[xi+1]
+		yyn = int(yyExca[xi+1])
 		if yyn < 0 {
 			goto ret0
 		}
@@ -590,10 +659,10 @@ yydefault:
 
 			/* find a state where "error" is a legal shift action */
 			for yyp >= 0 {
-				yyn = yyPact[yyS[yyp].yys] + yyErrCode
+				yyn = int(yyPact[yyS[yyp].yys]) + yyErrCode
 				if yyn >= 0 && yyn < yyLast {
-					yystate = yyAct[yyn] /* simulate a shift of "error" */
-					if yyChk[yystate] == yyErrCode {
+					yystate = int(yyAct[yyn]) /* simulate a shift of "error" */
+					if int(yyChk[yystate]) == yyErrCode {
 						goto yystack
 					}
 				}
@@ -629,7 +698,7 @@ yydefault:
 	yypt := yyp
 	_ = yypt // guard against "declared and not used"
 
-	yyp -= yyR2[yyn]
+	yyp -= int(yyR2[yyn])
 	// yyp is now the index of $0. Perform the default action. Iff the
 	// reduced production is ε, $1 is possibly out of range.
 	if yyp+1 >= len(yyS) {
@@ -640,53 +709,77 @@ yydefault:
 	yyVAL = yyS[yyp+1]
 
 	/* consult goto table to find next state */
-	yyn = yyR1[yyn]
-	yyg := yyPgo[yyn]
+	yyn = int(yyR1[yyn])
+	yyg := int(yyPgo[yyn])
 	yyj := yyg + yyS[yyp].yys + 1
 
 	if yyj >= yyLast {
-		yystate = yyAct[yyg]
+		yystate = int(yyAct[yyg])
 	} else {
-		yystate = yyAct[yyj]
-		if yyChk[yystate] != -yyn {
-			yystate = yyAct[yyg]
+		yystate = int(yyAct[yyj])
+		if int(yyChk[yystate]) != -yyn {
+			yystate = int(yyAct[yyg])
 		}
 	}
 	// dummy call; replaced with literal code
 	switch yynt {
 
-	case 1:
+	case 2:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line thrift.y:100
+		{
+			yylex.(*lexer).definition = yyDollar[2].definition
+		}
+	case 3:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line thrift.y:95
+//line thrift.y:102
+		{
+			yylex.(*lexer).fieldType = yyDollar[2].fieldType
+		}
+	case 4:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line thrift.y:107
 		{
 			yyVAL.prog = &ast.Program{Headers: yyDollar[1].headers, Definitions: yyDollar[2].definitions}
 			yylex.(*lexer).program = yyVAL.prog
 			return 0
 		}
-	case 2:
+	case 5:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line thrift.y:107
+//line thrift.y:119
 		{
 			yyVAL.headers = nil
 		}
-	case 3:
+	case 6:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line thrift.y:108
+//line thrift.y:120
 		{
 			yyVAL.headers = append(yyDollar[1].headers, yyDollar[2].header)
 		}
-	case 4:
+	case 7:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line thrift.y:122
+		{
+			yyVAL.headers = yyDollar[1].headers
+		}
+	case 8:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line thrift.y:123
+		{
+			yyVAL.headers = yyDollar[1].headers
+		}
+	case 9:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line thrift.y:113
+//line thrift.y:128
 		{
 			yyVAL.header = &ast.Include{
 				Path: yyDollar[3].str,
 				Line: yyDollar[1].line,
 			}
 		}
-	case 5:
+	case 10:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line thrift.y:120
+//line thrift.y:135
 		{
 			yyVAL.header = &ast.Include{
 				Name: yyDollar[3].str,
@@ -694,9 +787,9 @@ yydefault:
 				Line: yyDollar[1].line,
 			}
 		}
-	case 6:
+	case 11:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line thrift.y:128
+//line thrift.y:143
 		{
 			yyVAL.header = &ast.Namespace{
 				Scope: "*",
@@ -704,9 +797,9 @@ yydefault:
 				Line:  yyDollar[1].line,
 			}
 		}
-	case 7:
+	case 12:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line thrift.y:136
+//line thrift.y:151
 		{
 			yyVAL.header = &ast.Namespace{
 				Scope: yyDollar[3].str,
@@ -714,21 +807,27 @@ yydefault:
 				Line:  yyDollar[1].line,
 			}
 		}
-	case 8:
+	case 13:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line thrift.y:150
+//line thrift.y:165
 		{
 			yyVAL.definitions = nil
 		}
-	case 9:
+	case 14:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line thrift.y:151
+//line thrift.y:166
 		{
 			yyVAL.definitions = append(yyDollar[1].definitions, yyDollar[2].definition)
 		}
-	case 10:
+	case 15:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line thrift.y:169
+		{
+			yyVAL.definitions = yyDollar[1].definitions
+		}
+	case 16:
 		yyDollar = yyS[yypt-7 : yypt+1]
-		//line thrift.y:158
+//line thrift.y:176
 		{
 			yyVAL.definition = &ast.Constant{
 				Name:  yyDollar[5].str,
@@ -738,9 +837,9 @@ yydefault:
 				Doc:   ParseDocstring(yyDollar[2].docstring),
 			}
 		}
-	case 11:
+	case 17:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line thrift.y:169
+//line thrift.y:187
 		{
 			yyVAL.definition = &ast.Typedef{
 				Name:        yyDollar[5].str,
@@ -750,9 +849,9 @@ yydefault:
 				Doc:         ParseDocstring(yyDollar[2].docstring),
 			}
 		}
-	case 12:
+	case 18:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line thrift.y:179
+//line thrift.y:197
 		{
 			yyVAL.definition = &ast.Enum{
 				Name:        yyDollar[4].str,
@@ -762,9 +861,9 @@ yydefault:
 				Doc:         ParseDocstring(yyDollar[2].docstring),
 			}
 		}
-	case 13:
+	case 19:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line thrift.y:189
+//line thrift.y:207
 		{
 			yyVAL.definition = &ast.Struct{
 				Name:        yyDollar[4].str,
@@ -775,9 +874,9 @@ yydefault:
 				Doc:         ParseDocstring(yyDollar[2].docstring),
 			}
 		}
-	case 14:
+	case 20:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line thrift.y:201
+//line thrift.y:219
 		{
 			yyVAL.definition = &ast.Service{
 				Name:        yyDollar[4].str,
@@ -787,9 +886,9 @@ yydefault:
 				Doc:         ParseDocstring(yyDollar[2].docstring),
 			}
 		}
-	case 15:
+	case 21:
 		yyDollar = yyS[yypt-11 : yypt+1]
-		//line thrift.y:212
+//line thrift.y:230
 		{
 			parent := &ast.ServiceReference{
 				Name: yyDollar[7].str,
@@ -805,39 +904,45 @@ yydefault:
 				Doc:         ParseDocstring(yyDollar[2].docstring),
 			}
 		}
-	case 16:
+	case 22:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line thrift.y:230
+//line thrift.y:248
 		{
 			yyVAL.structType = ast.StructType
 		}
-	case 17:
+	case 23:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line thrift.y:231
+//line thrift.y:249
 		{
 			yyVAL.structType = ast.UnionType
 		}
-	case 18:
+	case 24:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line thrift.y:232
+//line thrift.y:250
 		{
 			yyVAL.structType = ast.ExceptionType
 		}
-	case 19:
+	case 25:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line thrift.y:236
+//line thrift.y:254
 		{
 			yyVAL.enumItems = nil
 		}
-	case 20:
+	case 26:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line thrift.y:237
+//line thrift.y:255
 		{
 			yyVAL.enumItems = append(yyDollar[1].enumItems, yyDollar[2].enumItem)
 		}
-	case 21:
+	case 27:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line thrift.y:256
+		{
+			yyVAL.enumItems = yyDollar[1].enumItems
+		}
+	case 28:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line thrift.y:242
+//line thrift.y:261
 		{
 			yyVAL.enumItem = &ast.EnumItem{
 				Name:        yyDollar[3].str,
@@ -846,9 +951,9 @@ yydefault:
 				Doc:         ParseDocstring(yyDollar[2].docstring),
 			}
 		}
-	case 22:
+	case 29:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line thrift.y:251
+//line thrift.y:270
 		{
 			value := int(yyDollar[5].i64)
 			yyVAL.enumItem = &ast.EnumItem{
@@ -859,21 +964,27 @@ yydefault:
 				Doc:         ParseDocstring(yyDollar[2].docstring),
 			}
 		}
-	case 23:
+	case 30:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line thrift.y:264
+//line thrift.y:283
 		{
 			yyVAL.fields = nil
 		}
-	case 24:
+	case 31:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line thrift.y:265
+//line thrift.y:284
 		{
 			yyVAL.fields = append(yyDollar[1].fields, yyDollar[2].field)
 		}
-	case 25:
+	case 32:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line thrift.y:285
+		{
+			yyVAL.fields = yyDollar[1].fields
+		}
+	case 33:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line thrift.y:271
+//line thrift.y:291
 		{
 			yyVAL.field = &ast.Field{
 				ID:           int(yyDollar[3].i64),
@@ -885,9 +996,9 @@ yydefault:
 				Doc:          ParseDocstring(yyDollar[2].docstring),
 			}
 		}
-	case 26:
+	case 34:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line thrift.y:284
+//line thrift.y:304
 		{
 			yyVAL.field = &ast.Field{
 				ID:           int(yyDollar[3].i64),
@@ -900,39 +1011,45 @@ yydefault:
 				Doc:          ParseDocstring(yyDollar[2].docstring),
 			}
 		}
-	case 27:
+	case 35:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line thrift.y:299
+//line thrift.y:319
 		{
 			yyVAL.fieldRequired = ast.Required
 		}
-	case 28:
+	case 36:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line thrift.y:300
+//line thrift.y:320
 		{
 			yyVAL.fieldRequired = ast.Optional
 		}
-	case 29:
+	case 37:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line thrift.y:301
+//line thrift.y:321
 		{
 			yyVAL.fieldRequired = ast.Unspecified
 		}
-	case 30:
+	case 38:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line thrift.y:305
+//line thrift.y:325
 		{
 			yyVAL.functions = nil
 		}
-	case 31:
+	case 39:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line thrift.y:306
+//line thrift.y:326
 		{
 			yyVAL.functions = append(yyDollar[1].functions, yyDollar[2].function)
 		}
-	case 32:
+	case 40:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line thrift.y:327
+		{
+			yyVAL.functions = yyDollar[1].functions
+		}
+	case 41:
 		yyDollar = yyS[yypt-10 : yypt+1]
-		//line thrift.y:312
+//line thrift.y:333
 		{
 			yyVAL.function = &ast.Function{
 				Name:        yyDollar[5].str,
@@ -945,237 +1062,237 @@ yydefault:
 				Doc:         ParseDocstring(yyDollar[1].docstring),
 			}
 		}
-	case 33:
+	case 42:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line thrift.y:327
+//line thrift.y:348
 		{
 			yyVAL.bul = true
 		}
-	case 34:
+	case 43:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line thrift.y:328
+//line thrift.y:349
 		{
 			yyVAL.bul = false
 		}
-	case 35:
+	case 44:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line thrift.y:332
+//line thrift.y:353
 		{
 			yyVAL.fieldType = nil
 		}
-	case 36:
+	case 45:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line thrift.y:333
+//line thrift.y:354
 		{
 			yyVAL.fieldType = yyDollar[1].fieldType
 		}
-	case 37:
+	case 46:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line thrift.y:337
+//line thrift.y:358
 		{
 			yyVAL.fields = nil
 		}
-	case 38:
+	case 47:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line thrift.y:338
+//line thrift.y:359
 		{
 			yyVAL.fields = yyDollar[3].fields
 		}
-	case 39:
+	case 48:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line thrift.y:347
+//line thrift.y:368
 		{
 			yyVAL.fieldType = ast.BaseType{ID: yyDollar[2].baseTypeID, Annotations: yyDollar[3].typeAnnotations, Line: yyDollar[1].line}
 		}
-	case 40:
+	case 49:
 		yyDollar = yyS[yypt-8 : yypt+1]
-		//line thrift.y:351
+//line thrift.y:372
 		{
 			yyVAL.fieldType = ast.MapType{KeyType: yyDollar[4].fieldType, ValueType: yyDollar[6].fieldType, Annotations: yyDollar[8].typeAnnotations, Line: yyDollar[1].line}
 		}
-	case 41:
+	case 50:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line thrift.y:353
+//line thrift.y:374
 		{
 			yyVAL.fieldType = ast.ListType{ValueType: yyDollar[4].fieldType, Annotations: yyDollar[6].typeAnnotations, Line: yyDollar[1].line}
 		}
-	case 42:
+	case 51:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line thrift.y:355
+//line thrift.y:376
 		{
 			yyVAL.fieldType = ast.SetType{ValueType: yyDollar[4].fieldType, Annotations: yyDollar[6].typeAnnotations, Line: yyDollar[1].line}
 		}
-	case 43:
+	case 52:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line thrift.y:357
+//line thrift.y:378
 		{
 			yyVAL.fieldType = ast.TypeReference{Name: yyDollar[2].str, Line: yyDollar[1].line}
 		}
-	case 44:
+	case 53:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line thrift.y:361
+//line thrift.y:382
 		{
 			yyVAL.baseTypeID = ast.BoolTypeID
 		}
-	case 45:
+	case 54:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line thrift.y:362
+//line thrift.y:383
 		{
 			yyVAL.baseTypeID = ast.I8TypeID
 		}
-	case 46:
+	case 55:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line thrift.y:363
+//line thrift.y:384
 		{
 			yyVAL.baseTypeID = ast.I8TypeID
 		}
-	case 47:
+	case 56:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line thrift.y:364
+//line thrift.y:385
 		{
 			yyVAL.baseTypeID = ast.I16TypeID
 		}
-	case 48:
+	case 57:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line thrift.y:365
+//line thrift.y:386
 		{
 			yyVAL.baseTypeID = ast.I32TypeID
 		}
-	case 49:
+	case 58:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line thrift.y:366
+//line thrift.y:387
 		{
 			yyVAL.baseTypeID = ast.I64TypeID
 		}
-	case 50:
+	case 59:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line thrift.y:367
+//line thrift.y:388
 		{
 			yyVAL.baseTypeID = ast.DoubleTypeID
 		}
-	case 51:
+	case 60:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line thrift.y:368
+//line thrift.y:389
 		{
 			yyVAL.baseTypeID = ast.StringTypeID
 		}
-	case 52:
+	case 61:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line thrift.y:369
+//line thrift.y:390
 		{
 			yyVAL.baseTypeID = ast.BinaryTypeID
 		}
-	case 53:
+	case 62:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line thrift.y:377
+//line thrift.y:398
 		{
 			yyVAL.constantValue = ast.ConstantInteger(yyDollar[1].i64)
 		}
-	case 54:
+	case 63:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line thrift.y:378
+//line thrift.y:399
 		{
 			yyVAL.constantValue = ast.ConstantDouble(yyDollar[1].dub)
 		}
-	case 55:
+	case 64:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line thrift.y:379
+//line thrift.y:400
 		{
 			yyVAL.constantValue = ast.ConstantBoolean(true)
 		}
-	case 56:
+	case 65:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line thrift.y:380
+//line thrift.y:401
 		{
 			yyVAL.constantValue = ast.ConstantBoolean(false)
 		}
-	case 57:
+	case 66:
 		yyDollar = yyS[yypt-1 : yypt+1]
-		//line thrift.y:381
+//line thrift.y:402
 		{
 			yyVAL.constantValue = ast.ConstantString(yyDollar[1].str)
 		}
-	case 58:
+	case 67:
 		yyDollar = yyS[yypt-2 : yypt+1]
-		//line thrift.y:383
+//line thrift.y:404
 		{
 			yyVAL.constantValue = ast.ConstantReference{Name: yyDollar[2].str, Line: yyDollar[1].line}
 		}
-	case 59:
+	case 68:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line thrift.y:385
+//line thrift.y:406
 		{
 			yyVAL.constantValue = ast.ConstantList{Items: yyDollar[3].constantValues, Line: yyDollar[1].line}
 		}
-	case 60:
+	case 69:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line thrift.y:386
+//line thrift.y:407
 		{
 			yyVAL.constantValue = ast.ConstantMap{Items: yyDollar[3].constantMapItems, Line: yyDollar[1].line}
 		}
-	case 61:
+	case 70:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line thrift.y:390
+//line thrift.y:411
 		{
 			yyVAL.constantValues = nil
 		}
-	case 62:
+	case 71:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line thrift.y:392
+//line thrift.y:413
 		{
 			yyVAL.constantValues = append(yyDollar[1].constantValues, yyDollar[2].constantValue)
 		}
-	case 63:
+	case 72:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line thrift.y:396
+//line thrift.y:417
 		{
 			yyVAL.constantMapItems = nil
 		}
-	case 64:
+	case 73:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line thrift.y:398
+//line thrift.y:419
 		{
 			yyVAL.constantMapItems = append(yyDollar[1].constantMapItems, ast.ConstantMapItem{Key: yyDollar[3].constantValue, Value: yyDollar[5].constantValue, Line: yyDollar[2].line})
 		}
-	case 65:
+	case 74:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line thrift.y:406
+//line thrift.y:427
 		{
 			yyVAL.typeAnnotations = nil
 		}
-	case 66:
+	case 75:
 		yyDollar = yyS[yypt-3 : yypt+1]
-		//line thrift.y:407
+//line thrift.y:428
 		{
 			yyVAL.typeAnnotations = yyDollar[2].typeAnnotations
 		}
-	case 67:
+	case 76:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line thrift.y:411
+//line thrift.y:432
 		{
 			yyVAL.typeAnnotations = nil
 		}
-	case 68:
+	case 77:
 		yyDollar = yyS[yypt-6 : yypt+1]
-		//line thrift.y:413
+//line thrift.y:434
 		{
 			yyVAL.typeAnnotations = append(yyDollar[1].typeAnnotations, &ast.Annotation{Name: yyDollar[3].str, Value: yyDollar[5].str, Line: yyDollar[2].line})
 		}
-	case 69:
+	case 78:
 		yyDollar = yyS[yypt-4 : yypt+1]
-		//line thrift.y:415
+//line thrift.y:436
 		{
 			yyVAL.typeAnnotations = append(yyDollar[1].typeAnnotations, &ast.Annotation{Name: yyDollar[3].str, Line: yyDollar[2].line})
 		}
-	case 70:
+	case 79:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line thrift.y:432
+//line thrift.y:453
 		{
 			yyVAL.line = yylex.(*lexer).line
 		}
-	case 71:
+	case 80:
 		yyDollar = yyS[yypt-0 : yypt+1]
-		//line thrift.y:436
+//line thrift.y:457
 		{
 			yyVAL.docstring = yylex.(*lexer).LastDocstring()
 		}
@@ -0,0 +1,189 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package idl
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/idl/internal"
+)
+
+// TokenKind is the kind of a Token.
+type TokenKind int
+
+const (
+	// EOF marks the end of the document.
+	EOF TokenKind = iota
+
+	// Keyword is a Thrift keyword like "struct" or "i32".
+	Keyword
+
+	// Identifier is the name of a type, field, constant, or annotation,
+	// possibly qualified with dots.
+	Identifier
+
+	// StringLiteral is a quoted string.
+	StringLiteral
+
+	// IntLiteral is a decimal or hexadecimal integer.
+	IntLiteral
+
+	// DoubleLiteral is a floating point number.
+	DoubleLiteral
+
+	// Symbol is one of the punctuation characters *=<>(){},;:[]
+	Symbol
+)
+
+func (k TokenKind) String() string {
+	switch k {
+	case EOF:
+		return "EOF"
+	case Keyword:
+		return "Keyword"
+	case Identifier:
+		return "Identifier"
+	case StringLiteral:
+		return "StringLiteral"
+	case IntLiteral:
+		return "IntLiteral"
+	case DoubleLiteral:
+		return "DoubleLiteral"
+	case Symbol:
+		return "Symbol"
+	default:
+		return fmt.Sprintf("TokenKind(%d)", int(k))
+	}
+}
+
+// Position is a location in a Thrift document.
+type Position struct {
+	Offset int // byte offset, starting at 0
+	Line   int // line number, starting at 1
+	Column int // byte offset within the line, starting at 1
+}
+
+func (p Position) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+// Token is a single token of a Thrift document.
+type Token struct {
+	Kind TokenKind
+
+	// Text of the token exactly as it appears in the document.
+	Text string
+
+	// Pos is the position of the first byte of the token.
+	Pos Position
+
+	// Value of literals: the unquoted string for StringLiterals, an int64
+	// for IntLiterals, and a float64 for DoubleLiterals. Value is nil for
+	// other kinds of tokens.
+	Value interface{}
+}
+
+// Lexer splits Thrift documents into tokens using the same rules as Parse,
+// for tools like syntax highlighters and formatters.
+//
+// Whitespace and comments are skipped, so anything in the document between
+// two consecutive tokens is whitespace or a comment.
+//
+//   lex := idl.NewLexer(src)
+//   for tok := lex.Next(); tok.Kind != idl.EOF; tok = lex.Next() {
+//     fmt.Println(tok.Pos, tok.Kind, tok.Text)
+//   }
+//
+// The Lexer keeps going when it finds invalid tokens. Unknown characters are
+// skipped, reserved words are returned as Identifiers, and malformed
+// literals are returned with zero values. These errors are available from
+// Errors.
+type Lexer struct {
+	lex *internal.Lexer
+	src []byte
+
+	// Position up to which src has been scanned for newlines.
+	offset    int
+	line      int
+	lineStart int // offset of the start of the current line
+}
+
+// NewLexer builds a Lexer which reads tokens from the given Thrift
+// document.
+func NewLexer(src []byte) *Lexer {
+	return &Lexer{lex: internal.NewLexer(src), src: src, line: 1}
+}
+
+// Next returns the next token in the document. Once the end of the
+// document is reached, Next returns tokens of kind EOF.
+func (l *Lexer) Next() Token {
+	t := l.lex.Next()
+	tok := Token{
+		Text: string(l.src[t.Start:t.End]),
+		Pos:  l.position(t.Start),
+	}
+
+	switch {
+	case t.ID == 0:
+		tok.Kind = EOF
+	case internal.IsKeyword(t.ID):
+		tok.Kind = Keyword
+	case t.ID == internal.IDENTIFIER:
+		tok.Kind = Identifier
+	case t.ID == internal.LITERAL:
+		tok.Kind = StringLiteral
+		tok.Value = t.Str
+	case t.ID == internal.INTCONSTANT:
+		tok.Kind = IntLiteral
+		tok.Value = t.Int
+	case t.ID == internal.DUBCONSTANT:
+		tok.Kind = DoubleLiteral
+		tok.Value = t.Double
+	default:
+		tok.Kind = Symbol
+	}
+	return tok
+}
+
+// Errors returns the errors found in the tokens read so far.
+func (l *Lexer) Errors() []Error {
+	errs := l.lex.Errors()
+	if len(errs) == 0 {
+		return nil
+	}
+	return convertErrors(errs)
+}
+
+// position returns the position of the given offset, which must not be
+// before the offset of the previous token.
+func (l *Lexer) position(offset int) Position {
+	for ; l.offset < offset; l.offset++ {
+		if l.src[l.offset] == '\n' {
+			l.line++
+			l.lineStart = l.offset + 1
+		}
+	}
+	return Position{
+		Offset: offset,
+		Line:   l.line,
+		Column: offset - l.lineStart + 1,
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package idl
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func lexAll(l *Lexer) []Token {
+	var tokens []Token
+	for {
+		tok := l.Next()
+		tokens = append(tokens, tok)
+		if tok.Kind == EOF {
+			return tokens
+		}
+	}
+}
+
+func TestLexer(t *testing.T) {
+	src := unlines(
+		`include "shared.thrift"`,
+		``,
+		`/** A user. */`,
+		`struct User {`,
+		`  1: required string name # the name`,
+		`  2: optional i64 id = 0x2A (go.tag = 'json:"id"')`,
+		`  3: double score = -1.5`,
+		`}`,
+	)
+
+	pos := func(offset, line, column int) Position {
+		return Position{Offset: offset, Line: line, Column: column}
+	}
+
+	want := []Token{
+		{Kind: Keyword, Text: "include", Pos: pos(0, 1, 1)},
+		{Kind: StringLiteral, Text: `"shared.thrift"`, Pos: pos(8, 1, 9), Value: "shared.thrift"},
+		{Kind: Keyword, Text: "struct", Pos: pos(40, 4, 1)},
+		{Kind: Identifier, Text: "User", Pos: pos(47, 4, 8)},
+		{Kind: Symbol, Text: "{", Pos: pos(52, 4, 13)},
+		{Kind: IntLiteral, Text: "1", Pos: pos(56, 5, 3), Value: int64(1)},
+		{Kind: Symbol, Text: ":", Pos: pos(57, 5, 4)},
+		{Kind: Keyword, Text: "required", Pos: pos(59, 5, 6)},
+		{Kind: Keyword, Text: "string", Pos: pos(68, 5, 15)},
+		{Kind: Identifier, Text: "name", Pos: pos(75, 5, 22)},
+		{Kind: IntLiteral, Text: "2", Pos: pos(93, 6, 3), Value: int64(2)},
+		{Kind: Symbol, Text: ":", Pos: pos(94, 6, 4)},
+		{Kind: Keyword, Text: "optional", Pos: pos(96, 6, 6)},
+		{Kind: Keyword, Text: "i64", Pos: pos(105, 6, 15)},
+		{Kind: Identifier, Text: "id", Pos: pos(109, 6, 19)},
+		{Kind: Symbol, Text: "=", Pos: pos(112, 6, 22)},
+		{Kind: IntLiteral, Text: "0x2A", Pos: pos(114, 6, 24), Value: int64(42)},
+		{Kind: Symbol, Text: "(", Pos: pos(119, 6, 29)},
+		{Kind: Identifier, Text: "go.tag", Pos: pos(120, 6, 30)},
+		{Kind: Symbol, Text: "=", Pos: pos(127, 6, 37)},
+		{Kind: StringLiteral, Text: `'json:"id"'`, Pos: pos(129, 6, 39), Value: `json:"id"`},
+		{Kind: Symbol, Text: ")", Pos: pos(140, 6, 50)},
+		{Kind: IntLiteral, Text: "3", Pos: pos(144, 7, 3), Value: int64(3)},
+		{Kind: Symbol, Text: ":", Pos: pos(145, 7, 4)},
+		{Kind: Keyword, Text: "double", Pos: pos(147, 7, 6)},
+		{Kind: Identifier, Text: "score", Pos: pos(154, 7, 13)},
+		{Kind: Symbol, Text: "=", Pos: pos(160, 7, 19)},
+		{Kind: DoubleLiteral, Text: "-1.5", Pos: pos(162, 7, 21), Value: -1.5},
+		{Kind: Symbol, Text: "}", Pos: pos(167, 8, 1)},
+		{Kind: EOF, Text: "", Pos: pos(168, 8, 2)},
+	}
+
+	lex := NewLexer([]byte(src))
+	got := lexAll(lex)
+	assert.Equal(t, want, got)
+	assert.Empty(t, lex.Errors())
+
+	for _, tok := range got {
+		assert.Equal(t, tok.Text, src[tok.Pos.Offset:tok.Pos.Offset+len(tok.Text)],
+			"text of %v must match the document", tok.Pos)
+	}
+}
+
+func TestLexerErrors(t *testing.T) {
+	lex := NewLexer([]byte("typedef string $ next\nconst i32 x = 99999999999999999999"))
+	tokens := lexAll(lex)
+
+	var texts []string
+	for _, tok := range tokens {
+		texts = append(texts, tok.Text)
+	}
+	assert.Equal(t, []string{
+		"typedef", "string", "next", "const", "i32", "x", "=", "99999999999999999999", "",
+	}, texts)
+	assert.Equal(t, Identifier, tokens[2].Kind, "reserved words are identifiers")
+
+	assert.Equal(t, []Error{
+		{Line: 1, Column: 16, Message: "unknown token at index 15"},
+		{Line: 2, Message: `"next" is a reserved keyword`},
+		{Line: 2, Column: 15, Message: `strconv.ParseInt: parsing "99999999999999999999": value out of range`},
+	}, lex.Errors())
+}
+
+func TestLexerNumbers(t *testing.T) {
+	tests := []struct {
+		give      string
+		wantKinds []TokenKind
+		wantText  []string
+		wantValue interface{}
+	}{
+		{give: "0x1F", wantKinds: []TokenKind{IntLiteral}, wantValue: int64(31)},
+		{give: "0o17", wantKinds: []TokenKind{IntLiteral}, wantValue: int64(15)},
+		{give: "-0o17", wantKinds: []TokenKind{IntLiteral}, wantValue: int64(-15)},
+		{give: "1e-9", wantKinds: []TokenKind{DoubleLiteral}, wantValue: 1e-9},
+		{give: "1E3", wantKinds: []TokenKind{DoubleLiteral}, wantValue: float64(1000)},
+		{give: "1.5e+3", wantKinds: []TokenKind{DoubleLiteral}, wantValue: float64(1500)},
+		{
+			give:      "1e3x",
+			wantKinds: []TokenKind{IntLiteral, Identifier},
+			wantText:  []string{"1", "e3x"},
+			wantValue: int64(1),
+		},
+		{
+			give:      "10o7",
+			wantKinds: []TokenKind{IntLiteral, Identifier},
+			wantText:  []string{"10", "o7"},
+			wantValue: int64(10),
+		},
+	}
+
+	for _, tt := range tests {
+		lex := NewLexer([]byte(tt.give))
+		tokens := lexAll(lex)
+		assert.Empty(t, lex.Errors(), tt.give)
+
+		var (
+			kinds []TokenKind
+			texts []string
+		)
+		for _, tok := range tokens[:len(tokens)-1] {
+			kinds = append(kinds, tok.Kind)
+			texts = append(texts, tok.Text)
+		}
+
+		wantText := tt.wantText
+		if wantText == nil {
+			wantText = []string{tt.give}
+		}
+		assert.Equal(t, tt.wantKinds, kinds, tt.give)
+		assert.Equal(t, wantText, texts, tt.give)
+		assert.Equal(t, tt.wantValue, tokens[0].Value, tt.give)
+	}
+}
+
+func TestTokenKindString(t *testing.T) {
+	assert.Equal(t, "Keyword", Keyword.String())
+	assert.Equal(t, "TokenKind(42)", TokenKind(42).String())
+}
@@ -25,6 +25,57 @@ import "go.uber.org/thriftrw/ast"
 import "go.uber.org/thriftrw/idl/internal"
 
 // Parse parses a Thrift document.
+//
+// If the document has syntax errors, a *ParseError listing all of them is
+// returned.
 func Parse(s []byte) (*ast.Program, error) {
-	return internal.Parse(s)
+	prog, errs := internal.Parse(s)
+	if len(errs) == 0 {
+		return prog, nil
+	}
+
+	return nil, &ParseError{Errors: convertErrors(errs)}
+}
+
+// ParseDefinition parses a single definition from a Thrift document, like a
+// struct, an enum, or a service, along with its docstring. Line numbers in the
+// returned definition are relative to the start of s.
+//
+// References to other definitions are not resolved, so the definition need
+// not be valid by itself.
+//
+//   def, err := idl.ParseDefinition([]byte(`struct Foo { 1: required string bar }`))
+//
+// If the definition has syntax errors, a *ParseError listing all of them is
+// returned.
+func ParseDefinition(s []byte) (ast.Definition, error) {
+	def, errs := internal.ParseDefinition(s)
+	if len(errs) == 0 {
+		return def, nil
+	}
+
+	return nil, &ParseError{Errors: convertErrors(errs)}
+}
+
+// ParseType parses a single type reference, like "list<string>" or
+// "map<i32, Foo> (go.type = "slice")". Line numbers in the returned type are
+// relative to the start of s.
+//
+// If the type has syntax errors, a *ParseError listing all of them is
+// returned.
+func ParseType(s []byte) (ast.Type, error) {
+	typ, errs := internal.ParseType(s)
+	if len(errs) == 0 {
+		return typ, nil
+	}
+
+	return nil, &ParseError{Errors: convertErrors(errs)}
+}
+
+func convertErrors(errs []internal.Error) []Error {
+	out := make([]Error, len(errs))
+	for i, e := range errs {
+		out[i] = Error{Line: e.Line, Column: e.Column, Message: e.Message}
+	}
+	return out
 }
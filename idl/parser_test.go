@@ -148,6 +148,18 @@ func TestParseErrors(t *testing.T) {
 			give:       `union Operation { 1: Insert insert; 2: Delete delete }`,
 			wantErrors: []string{"line 1:", `"delete" is a reserved keyword`},
 		},
+		{
+			give:       `const i32 x = 0o19`,
+			wantErrors: []string{"line 1:", "unexpected IDENTIFIER"},
+		},
+		{
+			give:       `const double x = 1e`,
+			wantErrors: []string{"line 1:", "unexpected IDENTIFIER"},
+		},
+		{
+			give:       `const i64 x = 0x1ffffffffffffffff`,
+			wantErrors: []string{"line 1:", "value out of range"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -160,6 +172,97 @@ func TestParseErrors(t *testing.T) {
 	}
 }
 
+func TestParseErrorRecovery(t *testing.T) {
+	tests := []struct {
+		desc string
+		give string
+		want []Error
+	}{
+		{
+			desc: "definitions",
+			give: unlines(
+				`typedef string`,
+				`struct Foo {}`,
+				`const i32 x = `,
+				`enum Bar {}`,
+			),
+			want: []Error{
+				{Line: 2, Column: 1, Message: "syntax error: unexpected STRUCT, expecting IDENTIFIER"},
+				{Line: 4, Column: 1, Message: "syntax error: unexpected ENUM, expecting IDENTIFIER or '{' or '['"},
+			},
+		},
+		{
+			desc: "fields",
+			give: unlines(
+				`struct Foo {`,
+				`  1: string foo bar`,
+				`  2: required i32 baz`,
+				`  3 i64 qux`,
+				`}`,
+			),
+			want: []Error{
+				{Line: 2, Column: 17, Message: "syntax error: unexpected IDENTIFIER, expecting '}' or INTCONSTANT"},
+				{Line: 4, Column: 5, Message: "syntax error: unexpected I64, expecting ':'"},
+			},
+		},
+		{
+			desc: "enum items and functions",
+			give: unlines(
+				`enum Foo { A = B, C }`,
+				`service Bar {`,
+				`  void foo(1: i32 x y)`,
+				`  i32 bar()`,
+				`}`,
+			),
+			want: []Error{
+				{Line: 1, Column: 16, Message: "syntax error: unexpected IDENTIFIER, expecting INTCONSTANT"},
+				{Line: 3, Column: 21, Message: "syntax error: unexpected IDENTIFIER, expecting ')' or INTCONSTANT"},
+			},
+		},
+		{
+			desc: "headers",
+			give: unlines(
+				`include foo`,
+				`namespace * foo`,
+				`namespace py`,
+				`include "bar.thrift"`,
+				`struct Foo { 1: string delete }`,
+			),
+			want: []Error{
+				{Line: 2, Column: 1, Message: "syntax error: unexpected NAMESPACE, expecting LITERAL"},
+				{Line: 4, Column: 1, Message: "syntax error: unexpected INCLUDE, expecting IDENTIFIER"},
+				{Line: 5, Column: 24, Message: `"delete" is a reserved keyword`},
+			},
+		},
+		{
+			desc: "unknown tokens",
+			give: unlines(
+				"namespace go foo $",
+				`const i32 x = 99999999999999999999`,
+				`const string y = "\q"`,
+			),
+			want: []Error{
+				{Line: 1, Column: 18, Message: "unknown token at index 17"},
+				{Line: 2, Column: 15, Message: `strconv.ParseInt: parsing "99999999999999999999": value out of range`},
+				{Line: 3, Column: 18, Message: "invalid syntax"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		_, err := Parse([]byte(tt.give))
+		if assert.Error(t, err, "%v: expected error", tt.desc) {
+			if pe, ok := err.(*ParseError); assert.True(t, ok, "%v: expected a *ParseError, got %T", tt.desc, err) {
+				assert.Equal(t, tt.want, pe.Errors, tt.desc)
+			}
+		}
+	}
+}
+
+func unlines(lines ...string) string {
+	return strings.Join(lines, "\n")
+}
+
 func TestParseHeaders(t *testing.T) {
 	tests := []parseCase{
 		{
@@ -423,6 +526,65 @@ func TestParseConstants(t *testing.T) {
 				},
 			}},
 		},
+		{
+			`
+				const i32 hex = 0x1F
+				const i32 oct = -0o17
+				const double tiny = 1e-9
+				const double big = 1.5E3
+				const double huge = +2E+10
+				const list<i64> nums = [0x7fffffffffffffff, 0o0, 0, 3e2]
+			`,
+			&Program{Definitions: []Definition{
+				&Constant{
+					Name:  "hex",
+					Type:  BaseType{ID: I32TypeID, Line: 2},
+					Value: ConstantInteger(31),
+					Line:  2,
+				},
+				&Constant{
+					Name:  "oct",
+					Type:  BaseType{ID: I32TypeID, Line: 3},
+					Value: ConstantInteger(-15),
+					Line:  3,
+				},
+				&Constant{
+					Name:  "tiny",
+					Type:  BaseType{ID: DoubleTypeID, Line: 4},
+					Value: ConstantDouble(1e-9),
+					Line:  4,
+				},
+				&Constant{
+					Name:  "big",
+					Type:  BaseType{ID: DoubleTypeID, Line: 5},
+					Value: ConstantDouble(1500),
+					Line:  5,
+				},
+				&Constant{
+					Name:  "huge",
+					Type:  BaseType{ID: DoubleTypeID, Line: 6},
+					Value: ConstantDouble(2e10),
+					Line:  6,
+				},
+				&Constant{
+					Name: "nums",
+					Type: ListType{
+						ValueType: BaseType{ID: I64TypeID, Line: 7},
+						Line:      7,
+					},
+					Value: ConstantList{
+						Items: []ConstantValue{
+							ConstantInteger(9223372036854775807),
+							ConstantInteger(0),
+							ConstantInteger(0),
+							ConstantDouble(300),
+						},
+						Line: 7,
+					},
+					Line: 7,
+				},
+			}},
+		},
 	}
 	assertParseCases(t, tests)
 }
@@ -1038,4 +1200,151 @@ func TestParseServices(t *testing.T) {
 	assertParseCases(t, tests)
 }
 
+func TestParseDefinition(t *testing.T) {
+	tests := []struct {
+		desc string
+		give string
+		want Definition
+	}{
+		{
+			desc: "struct",
+			give: unlines(
+				`/** A user. */`,
+				`struct User {`,
+				`  1: required string name`,
+				`}`,
+			),
+			want: &Struct{
+				Name: "User",
+				Type: StructType,
+				Fields: []*Field{
+					{
+						ID:           1,
+						Name:         "name",
+						Type:         BaseType{ID: StringTypeID, Line: 3},
+						Requiredness: Required,
+						Line:         3,
+					},
+				},
+				Line: 2,
+				Doc:  "A user.",
+			},
+		},
+		{
+			desc: "service with trailing separator",
+			give: `service Foo extends Bar {};`,
+			want: &Service{
+				Name:   "Foo",
+				Parent: &ServiceReference{Name: "Bar", Line: 1},
+				Line:   1,
+			},
+		},
+		{
+			desc: "constant",
+			give: `const list<i32> nums = [1, 2]`,
+			want: &Constant{
+				Name: "nums",
+				Type: ListType{ValueType: BaseType{ID: I32TypeID, Line: 1}, Line: 1},
+				Value: ConstantList{
+					Items: []ConstantValue{ConstantInteger(1), ConstantInteger(2)},
+					Line:  1,
+				},
+				Line: 1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			def, err := ParseDefinition([]byte(tt.give))
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, def)
+			}
+		})
+	}
+}
+
+func TestParseDefinitionErrors(t *testing.T) {
+	tests := []struct {
+		desc string
+		give string
+		want string
+	}{
+		{
+			desc: "empty",
+			give: ``,
+			want: "line 1: syntax error: unexpected $end",
+		},
+		{
+			desc: "two definitions",
+			give: unlines(`typedef i32 A`, `typedef i32 B`),
+			want: "line 2: syntax error: unexpected TYPEDEF",
+		},
+		{
+			desc: "header",
+			give: `include "foo.thrift"`,
+			want: "line 1: syntax error: unexpected INCLUDE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			_, err := ParseDefinition([]byte(tt.give))
+			if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tt.want)
+			}
+		})
+	}
+}
+
+func TestParseType(t *testing.T) {
+	tests := []struct {
+		give    string
+		want    Type
+		wantErr string
+	}{
+		{
+			give: "i64",
+			want: BaseType{ID: I64TypeID, Line: 1},
+		},
+		{
+			give: "map<string, list<foo.Bar>> (go.type = \"slice\")",
+			want: MapType{
+				KeyType: BaseType{ID: StringTypeID, Line: 1},
+				ValueType: ListType{
+					ValueType: TypeReference{Name: "foo.Bar", Line: 1},
+					Line:      1,
+				},
+				Annotations: []*Annotation{
+					{Name: "go.type", Value: "slice", Line: 1},
+				},
+				Line: 1,
+			},
+		},
+		{
+			give:    "list<>",
+			wantErr: "line 1: syntax error: unexpected '>'",
+		},
+		{
+			give:    "string foo",
+			wantErr: "line 1: syntax error: unexpected IDENTIFIER",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.give, func(t *testing.T) {
+			typ, err := ParseType([]byte(tt.give))
+			if tt.wantErr != "" {
+				if assert.Error(t, err) {
+					assert.Contains(t, err.Error(), tt.wantErr)
+				}
+				return
+			}
+			if assert.NoError(t, err) {
+				assert.Equal(t, tt.want, typ)
+			}
+		})
+	}
+}
+
 func ptrInt(n int) *int { return &n }
@@ -0,0 +1,204 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package changelog renders the differences between two revisions of a set
+// of Thrift files as Markdown for inclusion in release notes.
+package changelog
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// File is a Thrift file as it exists in two revisions.
+type File struct {
+	// Path to the Thrift file relative to the root of both revisions,
+	// separated by forward slashes.
+	Path string
+
+	// Modules compiled from the file in each revision. These are nil if the
+	// file does not exist in that revision.
+	From, To *compile.Module
+}
+
+// Files matches the Thrift files of two revisions by their paths relative to
+// the root directory of each revision. Included files are matched too as
+// long as they are inside the root directory.
+//
+// The returned files are sorted by path.
+func Files(fromRoot string, from []*compile.Module, toRoot string, to []*compile.Module) ([]File, error) {
+	byPath := make(map[string]*File)
+	add := func(root string, modules []*compile.Module, set func(*File, *compile.Module)) error {
+		for _, m := range modules {
+			err := m.Walk(func(m *compile.Module) error {
+				path, err := filepath.Rel(root, m.ThriftPath)
+				if err != nil {
+					return fmt.Errorf("could not resolve path for %q: %v", m.ThriftPath, err)
+				}
+				if strings.HasPrefix(path, "..") {
+					// Files outside the revision are not part of it.
+					return nil
+				}
+
+				path = filepath.ToSlash(path)
+				f, ok := byPath[path]
+				if !ok {
+					f = &File{Path: path}
+					byPath[path] = f
+				}
+				set(f, m)
+				return nil
+			})
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := add(fromRoot, from, func(f *File, m *compile.Module) { f.From = m }); err != nil {
+		return nil, err
+	}
+	if err := add(toRoot, to, func(f *File, m *compile.Module) { f.To = m }); err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(byPath))
+	for path := range byPath {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	files := make([]File, len(paths))
+	for i, path := range paths {
+		files[i] = *byPath[path]
+	}
+	return files, nil
+}
+
+// Write writes a Markdown changelog for the given files to the given
+// writer. Changes are grouped by file, then by services, types, and
+// constants, and then by definition. Files without changes are omitted.
+func Write(w io.Writer, files []File) error {
+	bw := bufio.NewWriter(w)
+
+	var changed bool
+	for _, f := range files {
+		switch {
+		case f.From == nil && f.To == nil:
+			continue
+		case f.From == nil:
+			fmt.Fprintf(bw, "## %v\n\n- Added.\n\n", f.Path)
+		case f.To == nil:
+			fmt.Fprintf(bw, "## %v\n\n- **Breaking:** Removed.\n\n", f.Path)
+		default:
+			changes := compile.Diff(f.From, f.To)
+			if len(changes) == 0 {
+				continue
+			}
+			fmt.Fprintf(bw, "## %v\n\n", f.Path)
+			writeSections(bw, f, changes)
+		}
+		changed = true
+	}
+
+	if !changed {
+		fmt.Fprintln(bw, "No changes.")
+	}
+	return bw.Flush()
+}
+
+// Sections of the changelog of a file, in order.
+const (
+	servicesSection = iota
+	typesSection
+	constantsSection
+)
+
+var sectionTitles = []string{"Services", "Types", "Constants"}
+
+func writeSections(w io.Writer, f File, changes []compile.Change) {
+	var sections [3][]compile.Change
+	for _, c := range changes {
+		s := section(f, c.Definition)
+		sections[s] = append(sections[s], c)
+	}
+
+	for i, changes := range sections {
+		if len(changes) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(w, "### %v\n\n", sectionTitles[i])
+
+		// Changes are sorted by name, so those of a definition are
+		// adjacent.
+		var definition string
+		for j, c := range changes {
+			if j == 0 || c.Definition != definition {
+				if j > 0 {
+					fmt.Fprintln(w)
+				}
+				definition = c.Definition
+				fmt.Fprintf(w, "#### %v\n\n", definition)
+			}
+			fmt.Fprintf(w, "- %v\n", entry(c))
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+// section returns the section of the changelog in which changes to the
+// given definition are listed.
+func section(f File, name string) int {
+	for _, m := range []*compile.Module{f.To, f.From} {
+		if _, ok := m.Services[name]; ok {
+			return servicesSection
+		}
+		if _, ok := m.Constants[name]; ok {
+			return constantsSection
+		}
+	}
+	return typesSection
+}
+
+// entry renders a single change as a list item.
+func entry(c compile.Change) string {
+	var s string
+	if c.Breaking {
+		s = "**Breaking:** "
+	}
+
+	kind := c.Kind.String()
+	s += strings.ToUpper(kind[:1]) + kind[1:]
+	if c.Member != "" {
+		s += " `" + c.Member + "`"
+	}
+	if c.Detail != "" {
+		s += ": " + c.Detail
+	}
+	return s + "."
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package changelog
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// revision writes the given files to a temporary directory and compiles
+// them.
+func revision(t *testing.T, files map[string]string) (string, []*compile.Module, func()) {
+	root, err := ioutil.TempDir("", "thriftrw-changelog-test")
+	require.NoError(t, err)
+
+	var paths []string
+	for name, contents := range files {
+		path := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, ioutil.WriteFile(path, []byte(contents), 0644))
+		paths = append(paths, path)
+	}
+
+	modules, err := compile.CompileFiles(paths)
+	require.NoError(t, err)
+	return root, modules, func() { os.RemoveAll(root) }
+}
+
+func TestWrite(t *testing.T) {
+	fromRoot, from, cleanup := revision(t, map[string]string{
+		"kv.thrift": `
+			include "shared/errors.thrift"
+
+			const i32 maxKeys = 100
+
+			struct Item {
+				1: required string key
+				2: optional binary value
+			}
+
+			service KeyValue {
+				Item get(1: string key) throws (1: errors.NotFound notFound)
+				void remove(1: string key)
+			}
+		`,
+		"shared/errors.thrift": "exception NotFound {}",
+		"old.thrift":           "struct Old {}",
+	})
+	defer cleanup()
+
+	toRoot, to, cleanup := revision(t, map[string]string{
+		"kv.thrift": `
+			include "shared/errors.thrift"
+
+			const i32 maxKeys = 200
+
+			struct Item {
+				1: required string key
+				2: optional binary value
+				3: optional i64 version
+			}
+
+			service KeyValue {
+				Item get(1: string key) throws (1: errors.NotFound notFound)
+				void clear()
+			}
+		`,
+		"shared/errors.thrift": "exception NotFound {}",
+		"new.thrift":           "struct New {}",
+	})
+	defer cleanup()
+
+	files, err := Files(fromRoot, from, toRoot, to)
+	require.NoError(t, err)
+
+	var paths []string
+	for _, f := range files {
+		paths = append(paths, f.Path)
+	}
+	assert.Equal(t, []string{"kv.thrift", "new.thrift", "old.thrift", "shared/errors.thrift"}, paths)
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, files))
+	assert.Equal(t, "## kv.thrift\n\n"+
+		"### Services\n\n"+
+		"#### KeyValue\n\n"+
+		"- Added `clear`.\n"+
+		"- **Breaking:** Removed `remove`.\n\n"+
+		"### Types\n\n"+
+		"#### Item\n\n"+
+		"- Added `version`.\n\n"+
+		"### Constants\n\n"+
+		"#### maxKeys\n\n"+
+		"- Changed: value changed from 100 to 200.\n\n"+
+		"## new.thrift\n\n"+
+		"- Added.\n\n"+
+		"## old.thrift\n\n"+
+		"- **Breaking:** Removed.\n\n",
+		buf.String())
+}
+
+func TestWriteNoChanges(t *testing.T) {
+	root, modules, cleanup := revision(t, map[string]string{
+		"kv.thrift": "struct Item {}",
+	})
+	defer cleanup()
+
+	files, err := Files(root, modules, root, modules)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, files))
+	assert.Equal(t, "No changes.\n", buf.String())
+}
@@ -0,0 +1,331 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package doc renders reference documentation for Thrift files as Markdown
+// or HTML. The documentation lists the services, functions, types, and
+// constants of each file along with their doc comments and annotations, and
+// links references to types to their definitions.
+package doc
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+// Format is a format in which documentation is written.
+type Format int
+
+const (
+	// Markdown documentation is suitable for hosting alongside code.
+	Markdown Format = iota
+
+	// HTML documentation is a standalone web page.
+	HTML
+)
+
+// File is a Thrift file to document.
+type File struct {
+	// Path to the Thrift file relative to the root directory, separated by
+	// forward slashes.
+	Path string
+
+	Module *compile.Module
+}
+
+// Files returns the files of the given modules and the modules they include,
+// sorted by path. Paths are relative to the given root directory, and
+// modules outside it are skipped.
+func Files(root string, modules []*compile.Module) ([]File, error) {
+	byPath := make(map[string]*compile.Module)
+	for _, m := range modules {
+		err := m.Walk(func(m *compile.Module) error {
+			path, err := filepath.Rel(root, m.ThriftPath)
+			if err != nil {
+				return fmt.Errorf("could not resolve path for %q: %v", m.ThriftPath, err)
+			}
+			if strings.HasPrefix(path, "..") {
+				return nil
+			}
+			byPath[filepath.ToSlash(path)] = m
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	paths := make([]string, 0, len(byPath))
+	for path := range byPath {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	files := make([]File, len(paths))
+	for i, path := range paths {
+		files[i] = File{Path: path, Module: byPath[path]}
+	}
+	return files, nil
+}
+
+// Write writes documentation for the given files to the given writer in the
+// given format. The title is used as the heading of the document.
+func Write(w io.Writer, format Format, title string, files []File) error {
+	p := newPage(title, files)
+	switch format {
+	case Markdown:
+		return newMarkdownTemplate(p).Execute(w, p)
+	case HTML:
+		return newHTMLTemplate(p).Execute(w, p)
+	default:
+		return fmt.Errorf("unknown format %v", format)
+	}
+}
+
+// page holds everything needed to render the documentation of a set of
+// files.
+type page struct {
+	Title string
+	Files []*filePage
+
+	// Anchors of documented files, keyed by their absolute paths.
+	anchors map[string]string
+}
+
+// filePage holds the definitions of a single file sorted by name.
+type filePage struct {
+	Path      string
+	File      string // absolute path
+	Anchor    string
+	Services  []*compile.ServiceSpec
+	Types     []compile.TypeSpec
+	Constants []*compile.Constant
+}
+
+func newPage(title string, files []File) *page {
+	p := &page{Title: title, anchors: make(map[string]string, len(files))}
+	for _, f := range files {
+		fp := &filePage{
+			Path:   f.Path,
+			File:   f.Module.ThriftPath,
+			Anchor: strings.TrimSuffix(f.Path, ".thrift"),
+		}
+		p.anchors[f.Module.ThriftPath] = fp.Anchor
+
+		for _, name := range sortedKeys(f.Module.Services) {
+			fp.Services = append(fp.Services, f.Module.Services[name])
+		}
+		for _, name := range sortedKeys(f.Module.Types) {
+			fp.Types = append(fp.Types, f.Module.Types[name])
+		}
+		for _, name := range sortedKeys(f.Module.Constants) {
+			fp.Constants = append(fp.Constants, f.Module.Constants[name])
+		}
+		p.Files = append(p.Files, fp)
+	}
+	return p
+}
+
+// definitionAnchor returns the anchor of the definition with the given name
+// in the given Thrift file, or an empty string if the file is not
+// documented.
+func (p *page) definitionAnchor(file, name string) string {
+	anchor, ok := p.anchors[file]
+	if !ok {
+		return ""
+	}
+	return anchor + "." + name
+}
+
+// typeReference renders a reference to the given type using link to render
+// references to named types. Names of types declared in files other than
+// the given one are qualified with the name of their file.
+func (p *page) typeReference(from string, t compile.TypeSpec, link func(text, anchor string) string, escape func(string) string) string {
+	switch s := t.(type) {
+	case *compile.ListSpec:
+		return escape("list<") + p.typeReference(from, s.ValueSpec, link, escape) + escape(">")
+	case *compile.SetSpec:
+		return escape("set<") + p.typeReference(from, s.ValueSpec, link, escape) + escape(">")
+	case *compile.MapSpec:
+		return escape("map<") +
+			p.typeReference(from, s.KeySpec, link, escape) + escape(", ") +
+			p.typeReference(from, s.ValueSpec, link, escape) + escape(">")
+	}
+
+	file := t.ThriftFile()
+	if file == "" {
+		// Primitive types are not declared in any file.
+		return escape(t.ThriftName())
+	}
+
+	name := t.ThriftName()
+	if file != from {
+		name = fileBaseName(file) + "." + name
+	}
+
+	anchor := p.definitionAnchor(file, t.ThriftName())
+	if anchor == "" {
+		return escape(name)
+	}
+	return link(name, anchor)
+}
+
+// serviceReference renders a reference to the given service as seen from
+// the given file.
+func (p *page) serviceReference(from string, s *compile.ServiceSpec, link func(text, anchor string) string, escape func(string) string) string {
+	name := s.Name
+	if s.File != from {
+		name = fileBaseName(s.File) + "." + name
+	}
+
+	anchor := p.definitionAnchor(s.File, s.Name)
+	if anchor == "" {
+		return escape(name)
+	}
+	return link(name, anchor)
+}
+
+// fieldList is a list of fields along with the file in which they were
+// declared.
+type fieldList struct {
+	File   string
+	Fields compile.FieldGroup
+}
+
+// newFieldList builds a fieldList from a FieldGroup or ArgsSpec.
+func newFieldList(file string, fields interface{}) (fieldList, error) {
+	switch fs := fields.(type) {
+	case compile.FieldGroup:
+		return fieldList{File: file, Fields: fs}, nil
+	case compile.ArgsSpec:
+		return fieldList{File: file, Fields: compile.FieldGroup(fs)}, nil
+	default:
+		return fieldList{}, fmt.Errorf("unexpected fields %T", fields)
+	}
+}
+
+// functions returns the functions of a service sorted by name.
+func functions(s *compile.ServiceSpec) []*compile.FunctionSpec {
+	fs := make([]*compile.FunctionSpec, 0, len(s.Functions))
+	for _, name := range sortedKeys(s.Functions) {
+		fs = append(fs, s.Functions[name])
+	}
+	return fs
+}
+
+// annotations renders the given annotations as they would appear in a
+// Thrift file, sorted by key.
+func annotations(as compile.Annotations) []string {
+	out := make([]string, 0, len(as))
+	for _, key := range sortedKeys(as) {
+		out = append(out, fmt.Sprintf("%v = %q", key, as[key]))
+	}
+	return out
+}
+
+// kind returns the keyword with which the given type was declared.
+func kind(t compile.TypeSpec) string {
+	switch s := t.(type) {
+	case *compile.StructSpec:
+		switch s.Type {
+		case ast.UnionType:
+			return "union"
+		case ast.ExceptionType:
+			return "exception"
+		default:
+			return "struct"
+		}
+	case *compile.EnumSpec:
+		return "enum"
+	case *compile.TypedefSpec:
+		return "typedef"
+	default:
+		return t.ThriftName()
+	}
+}
+
+func requiredness(f *compile.FieldSpec) string {
+	if f.Required {
+		return "required"
+	}
+	return "optional"
+}
+
+// value renders a constant value as it would appear in a Thrift file.
+func value(v compile.ConstantValue) string {
+	switch c := v.(type) {
+	case nil:
+		return ""
+	case compile.ConstantString:
+		return fmt.Sprintf("%q", string(c))
+	case compile.ConstReference:
+		return c.Target.Name
+	case compile.EnumItemReference:
+		return c.Enum.Name + "." + c.Item.Name
+	case compile.ConstantList:
+		return "[" + values([]compile.ConstantValue(c)) + "]"
+	case compile.ConstantSet:
+		return "[" + values([]compile.ConstantValue(c)) + "]"
+	case compile.ConstantMap:
+		items := make([]string, len(c))
+		for i, pair := range c {
+			items[i] = value(pair.Key) + ": " + value(pair.Value)
+		}
+		return "{" + strings.Join(items, ", ") + "}"
+	case *compile.ConstantStruct:
+		items := make([]string, 0, len(c.Fields))
+		for _, name := range sortedKeys(c.Fields) {
+			items = append(items, fmt.Sprintf("%q: %v", name, value(c.Fields[name])))
+		}
+		return "{" + strings.Join(items, ", ") + "}"
+	default:
+		return fmt.Sprint(c)
+	}
+}
+
+func values(vs []compile.ConstantValue) string {
+	items := make([]string, len(vs))
+	for i, v := range vs {
+		items[i] = value(v)
+	}
+	return strings.Join(items, ", ")
+}
+
+func fileBaseName(path string) string {
+	return strings.TrimSuffix(filepath.Base(path), ".thrift")
+}
+
+// sortedKeys returns the keys of the given map, which must have string keys,
+// in sorted order.
+func sortedKeys(m interface{}) []string {
+	keys := reflect.ValueOf(m).MapKeys()
+	out := make([]string, len(keys))
+	for i, k := range keys {
+		out[i] = k.String()
+	}
+	sort.Strings(out)
+	return out
+}
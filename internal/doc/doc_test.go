@@ -0,0 +1,253 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package doc
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// compileFiles writes the given files to a temporary directory, compiles
+// the ones in the api directory, and returns the files to document.
+func compileFiles(t *testing.T, files map[string]string) ([]File, func()) {
+	dir, err := ioutil.TempDir("", "thriftrw-doc-test")
+	require.NoError(t, err)
+
+	root := filepath.Join(dir, "api")
+	var paths []string
+	for name, contents := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, ioutil.WriteFile(path, []byte(contents), 0644))
+		if filepath.Dir(path) == root {
+			paths = append(paths, path)
+		}
+	}
+
+	modules, err := compile.CompileFiles(paths)
+	require.NoError(t, err)
+
+	docFiles, err := Files(root, modules)
+	require.NoError(t, err)
+	return docFiles, func() { os.RemoveAll(dir) }
+}
+
+var testFiles = map[string]string{
+	"api/kv.thrift": `include "shared/errors.thrift"
+include "../common.thrift"
+
+/** Maximum number of keys in a batch. */
+const i32 maxKeys = 100
+
+/** Key of an item. */
+typedef string Key (length.max = "64")
+
+enum Kind {
+    /** Plain values. */
+    PLAIN,
+    COMPRESSED = 5
+}
+
+/**
+ * A single item.
+ *
+ * Items are immutable.
+ */
+struct Item {
+    1: required Key key
+    2: optional map<Key, list<common.Tag>> tags
+    3: optional Kind kind = Kind.PLAIN
+}
+
+service Base {}
+
+/** Stores items. */
+service KeyValue extends Base {
+    /** Gets an item. */
+    Item get(1: Key key) throws (
+        /** Raised if the key | does not exist. */
+        1: errors.NotFound notFound
+    ) (ttl = "10s")
+
+    oneway void forget(1: Key key)
+}
+`,
+	"api/shared/errors.thrift": "exception NotFound {}",
+	"common.thrift":            "struct Tag {}",
+}
+
+func TestFiles(t *testing.T) {
+	files, cleanup := compileFiles(t, testFiles)
+	defer cleanup()
+
+	var paths []string
+	for _, f := range files {
+		paths = append(paths, f.Path)
+	}
+	assert.Equal(t, []string{"kv.thrift", "shared/errors.thrift"}, paths,
+		"files outside the root must be skipped")
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	files, cleanup := compileFiles(t, testFiles)
+	defer cleanup()
+
+	var buff bytes.Buffer
+	require.NoError(t, Write(&buff, Markdown, "Key Value API", files))
+	assert.Equal(t, `# Key Value API
+
+- [kv.thrift](#kv)
+- [shared/errors.thrift](#shared/errors)
+
+<a id="kv"></a>
+
+## kv.thrift
+
+### Services
+
+<a id="kv.Base"></a>
+
+#### service Base
+
+<a id="kv.KeyValue"></a>
+
+#### service KeyValue
+
+Extends [Base](#kv.Base).
+
+Stores items.
+
+##### forget
+
+Arguments:
+
+| ID | Name | Type | Required | Default | Description |
+| --- | --- | --- | --- | --- | --- |
+| 1 | key | [Key](#kv.Key) | optional |  |  |
+
+This function is oneway. Callers do not wait for a response.
+
+##### get
+
+Gets an item.
+
+Annotations: `+"`"+`ttl = "10s"`+"`"+`
+
+Arguments:
+
+| ID | Name | Type | Required | Default | Description |
+| --- | --- | --- | --- | --- | --- |
+| 1 | key | [Key](#kv.Key) | optional |  |  |
+
+Returns [Item](#kv.Item).
+
+Throws:
+
+| ID | Name | Type | Required | Default | Description |
+| --- | --- | --- | --- | --- | --- |
+| 1 | notFound | [errors.NotFound](#shared/errors.NotFound) | optional |  | Raised if the key \| does not exist. |
+
+### Types
+
+<a id="kv.Item"></a>
+
+#### struct Item
+
+A single item.
+
+Items are immutable.
+
+| ID | Name | Type | Required | Default | Description |
+| --- | --- | --- | --- | --- | --- |
+| 1 | key | [Key](#kv.Key) | required |  |  |
+| 2 | tags | map\<[Key](#kv.Key), list\<common.Tag\>\> | optional |  |  |
+| 3 | kind | [Kind](#kv.Kind) | optional | `+"`"+`Kind.PLAIN`+"`"+` |  |
+
+<a id="kv.Key"></a>
+
+#### typedef Key
+
+Key of an item.
+
+Annotations: `+"`"+`length.max = "64"`+"`"+`
+
+Alias of string.
+
+<a id="kv.Kind"></a>
+
+#### enum Kind
+
+| Name | Value | Description |
+| --- | --- | --- |
+| PLAIN | 0 | Plain values. |
+| COMPRESSED | 5 |  |
+
+### Constants
+
+| Name | Type | Value | Description |
+| --- | --- | --- | --- |
+| maxKeys | i32 | `+"`"+`100`+"`"+` | Maximum number of keys in a batch. |
+
+<a id="shared/errors"></a>
+
+## shared/errors.thrift
+
+### Types
+
+<a id="shared/errors.NotFound"></a>
+
+#### exception NotFound
+`, buff.String())
+}
+
+func TestWriteHTML(t *testing.T) {
+	files, cleanup := compileFiles(t, testFiles)
+	defer cleanup()
+
+	var buff bytes.Buffer
+	require.NoError(t, Write(&buff, HTML, "Key & Value", files))
+	out := buff.String()
+
+	for _, want := range []string{
+		"<title>Key &amp; Value</title>",
+		`<h4 id="kv.KeyValue">service KeyValue</h4>`,
+		`<p>Extends <a href="#kv.Base">Base</a>.</p>`,
+		"<p>A single item.</p>\n<p>Items are immutable.</p>",
+		`<td>map&lt;<a href="#kv.Key">Key</a>, list&lt;common.Tag&gt;&gt;</td>`,
+		`<td><a href="#shared/errors.NotFound">errors.NotFound</a></td>`,
+		`<code>ttl = &#34;10s&#34;</code>`,
+		`<h4 id="shared/errors.NotFound">exception NotFound</h4>`,
+	} {
+		assert.Contains(t, out, want)
+	}
+}
+
+func TestWriteUnknownFormat(t *testing.T) {
+	assert.Error(t, Write(ioutil.Discard, Format(42), "", nil))
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package doc
+
+import (
+	"html"
+	"html/template"
+	"strings"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+const htmlTemplate = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+body { font-family: sans-serif; max-width: 60em; margin: 0 auto; padding: 1em; }
+table { border-collapse: collapse; margin: 1em 0; }
+th, td { border: 1px solid #ccc; padding: 0.3em 0.6em; text-align: left; vertical-align: top; }
+code { background: #f4f4f4; }
+.annotations { color: #666; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<ul>
+{{- range .Files}}
+<li><a href="#{{.Anchor}}">{{.Path}}</a></li>
+{{- end}}
+</ul>
+{{- range $f := .Files}}
+<h2 id="{{$f.Anchor}}">{{$f.Path}}</h2>
+{{- if $f.Services}}
+<h3>Services</h3>
+{{- range $f.Services}}
+<h4 id="{{$f.Anchor}}.{{.Name}}">service {{.Name}}</h4>
+{{- with .Parent}}
+<p>Extends {{serviceRef $f.File .}}.</p>
+{{- end}}
+{{- template "doc" .}}
+{{- range functions .}}
+<h5>{{.Name}}</h5>
+{{- template "doc" .}}
+{{- if .ArgsSpec}}
+<p>Arguments:</p>
+{{- template "fields" (fields $f.File .ArgsSpec)}}
+{{- end}}
+{{- if .OneWay}}
+<p>This function is oneway. Callers do not wait for a response.</p>
+{{- else}}
+{{- with .ResultSpec}}
+{{- if .ReturnType}}
+<p>Returns {{typeRef $f.File .ReturnType}}.</p>
+{{- end}}
+{{- if .Exceptions}}
+<p>Throws:</p>
+{{- template "fields" (fields $f.File .Exceptions)}}
+{{- end}}
+{{- end}}
+{{- end}}
+{{- end}}
+{{- end}}
+{{- end}}
+{{- if $f.Types}}
+<h3>Types</h3>
+{{- range $f.Types}}
+<h4 id="{{$f.Anchor}}.{{.ThriftName}}">{{kind .}} {{.ThriftName}}</h4>
+{{- template "doc" .}}
+{{- if eq (kind .) "enum"}}
+{{- if .Items}}
+<table>
+<tr><th>Name</th><th>Value</th><th>Description</th></tr>
+{{- range .Items}}
+<tr><td>{{.Name}}</td><td>{{.Value}}</td><td>{{.Doc}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- else if eq (kind .) "typedef"}}
+<p>Alias of {{typeRef $f.File .Target}}.</p>
+{{- else if .Fields}}
+{{- template "fields" (fields $f.File .Fields)}}
+{{- end}}
+{{- end}}
+{{- end}}
+{{- if $f.Constants}}
+<h3>Constants</h3>
+<table>
+<tr><th>Name</th><th>Type</th><th>Value</th><th>Description</th></tr>
+{{- range $f.Constants}}
+<tr><td>{{.Name}}</td><td>{{typeRef $f.File .Type}}</td><td><code>{{value .Value}}</code></td><td>{{.Doc}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+{{- end}}
+</body>
+</html>
+{{define "doc"}}
+{{- range paragraphs .Doc}}
+<p>{{.}}</p>
+{{- end}}
+{{- with annotations .Annotations}}
+<p class="annotations">Annotations: {{range $i, $a := .}}{{if $i}}, {{end}}<code>{{$a}}</code>{{end}}</p>
+{{- end}}
+{{- end}}
+{{- define "fields"}}
+{{- $file := .File}}
+<table>
+<tr><th>ID</th><th>Name</th><th>Type</th><th>Required</th><th>Default</th><th>Description</th></tr>
+{{- range .Fields}}
+<tr><td>{{.ID}}</td><td>{{.Name}}</td><td>{{typeRef $file .Type}}</td><td>{{requiredness .}}</td><td>{{with value .Default}}<code>{{.}}</code>{{end}}</td><td>{{.Doc}}</td></tr>
+{{- end}}
+</table>
+{{- end}}`
+
+func newHTMLTemplate(p *page) *template.Template {
+	link := func(text, anchor string) string {
+		return `<a href="#` + html.EscapeString(anchor) + `">` + html.EscapeString(text) + "</a>"
+	}
+
+	return template.Must(template.New("html").Funcs(template.FuncMap{
+		"typeRef": func(from string, t compile.TypeSpec) template.HTML {
+			return template.HTML(p.typeReference(from, t, link, html.EscapeString))
+		},
+		"serviceRef": func(from string, s *compile.ServiceSpec) template.HTML {
+			return template.HTML(p.serviceReference(from, s, link, html.EscapeString))
+		},
+		"fields":       newFieldList,
+		"functions":    functions,
+		"annotations":  annotations,
+		"kind":         kind,
+		"requiredness": requiredness,
+		"value":        value,
+		"paragraphs":   paragraphs,
+	}).Parse(htmlTemplate))
+}
+
+// paragraphs splits a doc comment into paragraphs separated by blank
+// lines.
+func paragraphs(doc string) []string {
+	var out []string
+	for _, p := range strings.Split(doc, "\n\n") {
+		if p = strings.TrimSpace(p); p != "" {
+			out = append(out, p)
+		}
+	}
+	return out
+}
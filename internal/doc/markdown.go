@@ -0,0 +1,175 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package doc
+
+import (
+	"strings"
+	"text/template"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+const markdownTemplate = `# {{.Title}}
+{{range .Files}}
+- [{{.Path}}](#{{.Anchor}})
+{{- end}}
+{{range $f := .Files}}
+<a id="{{$f.Anchor}}"></a>
+
+## {{$f.Path}}
+{{- if $f.Services}}
+
+### Services
+{{- range $f.Services}}
+
+<a id="{{$f.Anchor}}.{{.Name}}"></a>
+
+#### service {{.Name}}
+{{- with .Parent}}
+
+Extends {{serviceRef $f.File .}}.
+{{- end}}
+{{- template "doc" .}}
+{{- range functions .}}
+
+##### {{.Name}}
+{{- template "doc" .}}
+{{- if .ArgsSpec}}
+
+Arguments:
+
+{{template "fields" (fields $f.File .ArgsSpec)}}
+{{- end}}
+{{- if .OneWay}}
+
+This function is oneway. Callers do not wait for a response.
+{{- else}}
+{{- with .ResultSpec}}
+{{- if .ReturnType}}
+
+Returns {{typeRef $f.File .ReturnType}}.
+{{- end}}
+{{- if .Exceptions}}
+
+Throws:
+
+{{template "fields" (fields $f.File .Exceptions)}}
+{{- end}}
+{{- end}}
+{{- end}}
+{{- end}}
+{{- end}}
+{{- end}}
+{{- if $f.Types}}
+
+### Types
+{{- range $f.Types}}
+
+<a id="{{$f.Anchor}}.{{.ThriftName}}"></a>
+
+#### {{kind .}} {{.ThriftName}}
+{{- template "doc" .}}
+{{- if eq (kind .) "enum"}}
+{{- if .Items}}
+
+| Name | Value | Description |
+| --- | --- | --- |
+{{- range .Items}}
+| {{.Name}} | {{.Value}} | {{cell .Doc}} |
+{{- end}}
+{{- end}}
+{{- else if eq (kind .) "typedef"}}
+
+Alias of {{typeRef $f.File .Target}}.
+{{- else if .Fields}}
+
+{{template "fields" (fields $f.File .Fields)}}
+{{- end}}
+{{- end}}
+{{- end}}
+{{- if $f.Constants}}
+
+### Constants
+
+| Name | Type | Value | Description |
+| --- | --- | --- | --- |
+{{- range $f.Constants}}
+| {{.Name}} | {{typeRef $f.File .Type}} | {{code (value .Value)}} | {{cell .Doc}} |
+{{- end}}
+{{- end}}
+{{end}}
+{{- define "doc"}}
+{{- with .Doc}}
+
+{{.}}
+{{- end}}
+{{- with annotations .Annotations}}
+
+Annotations: {{range $i, $a := .}}{{if $i}}, {{end}}{{code $a}}{{end}}
+{{- end}}
+{{- end}}
+{{- define "fields"}}
+{{- $file := .File -}}
+| ID | Name | Type | Required | Default | Description |
+| --- | --- | --- | --- | --- | --- |
+{{- range .Fields}}
+| {{.ID}} | {{.Name}} | {{typeRef $file .Type}} | {{requiredness .}} | {{with value .Default}}{{code .}}{{end}} | {{cell .Doc}} |
+{{- end}}
+{{- end}}`
+
+func newMarkdownTemplate(p *page) *template.Template {
+	link := func(text, anchor string) string {
+		return "[" + text + "](#" + anchor + ")"
+	}
+	escape := markdownEscaper.Replace
+
+	return template.Must(template.New("markdown").Funcs(template.FuncMap{
+		"typeRef": func(from string, t compile.TypeSpec) string {
+			return p.typeReference(from, t, link, escape)
+		},
+		"serviceRef": func(from string, s *compile.ServiceSpec) string {
+			return p.serviceReference(from, s, link, escape)
+		},
+		"fields":       newFieldList,
+		"functions":    functions,
+		"annotations":  annotations,
+		"kind":         kind,
+		"requiredness": requiredness,
+		"value":        value,
+		"cell":         markdownCell,
+		"code":         markdownCode,
+	}).Parse(markdownTemplate))
+}
+
+// markdownEscaper escapes angle brackets in type names so that they are not
+// mistaken for HTML tags.
+var markdownEscaper = strings.NewReplacer("<", `\<`, ">", `\>`)
+
+// markdownCell makes text safe to use in a cell of a Markdown table.
+func markdownCell(s string) string {
+	s = strings.Replace(s, "|", `\|`, -1)
+	return strings.Replace(s, "\n", "<br>", -1)
+}
+
+// markdownCode renders text as inline code in a table cell.
+func markdownCode(s string) string {
+	return "`" + strings.Replace(s, "|", `\|`, -1) + "`"
+}
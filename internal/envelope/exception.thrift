@@ -15,4 +15,4 @@ enum ExceptionType {
 exception TApplicationException {
   1: optional string message
   2: optional ExceptionType type
-}
+} (thriftrw.message = "") // report the type of the exception too
@@ -27,11 +27,12 @@ import "go.uber.org/thriftrw/thriftreflect"
 
 // ThriftModule represents the IDL file used to generate this package.
 var ThriftModule = &thriftreflect.ThriftModule{
-	Name:     "exception",
-	Package:  "go.uber.org/thriftrw/internal/envelope/exception",
-	FilePath: "exception.thrift",
-	SHA1:     "88105bcd404d4aee06542af9452f7cf76647ae98",
-	Raw:      rawIDL,
+	Name:            "exception",
+	Package:         "go.uber.org/thriftrw/internal/envelope/exception",
+	FilePath:        "exception.thrift",
+	SHA1:            "f7e4aedc53ce6cbac767b799e11889f704a3efa9",
+	ThriftRWVersion: "1.9.0",
+	Raw:             rawIDL,
 }
 
-const rawIDL = "enum ExceptionType {\n  UNKNOWN = 0\n  UNKNOWN_METHOD = 1\n  INVALID_MESSAGE_TYPE = 2\n  WRONG_METHOD_NAME = 3\n  BAD_SEQUENCE_ID = 4\n  MISSING_RESULT = 5\n  INTERNAL_ERROR = 6\n  PROTOCOL_ERROR = 7\n  INVALID_TRANSFORM = 8\n  INVALID_PROTOCOL = 9\n  UNSUPPORTED_CLIENT_TYPE = 10\n}\n\nexception TApplicationException {\n  1: optional string message\n  2: optional ExceptionType type\n}\n"
+const rawIDL = "enum ExceptionType {\n  UNKNOWN = 0\n  UNKNOWN_METHOD = 1\n  INVALID_MESSAGE_TYPE = 2\n  WRONG_METHOD_NAME = 3\n  BAD_SEQUENCE_ID = 4\n  MISSING_RESULT = 5\n  INTERNAL_ERROR = 6\n  PROTOCOL_ERROR = 7\n  INVALID_TRANSFORM = 8\n  INVALID_PROTOCOL = 9\n  UNSUPPORTED_CLIENT_TYPE = 10\n}\n\nexception TApplicationException {\n  1: optional string message\n  2: optional ExceptionType type\n} (thriftrw.message = \"\") // report the type of the exception too\n"
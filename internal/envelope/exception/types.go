@@ -26,11 +26,13 @@ package exception
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"go.uber.org/thriftrw/wire"
 	"math"
 	"strconv"
 	"strings"
+
+	"go.uber.org/thriftrw/wire"
 )
 
 type ExceptionType int32
@@ -244,6 +246,130 @@ func (v *ExceptionType) UnmarshalJSON(text []byte) error {
 	}
 }
 
+// ExceptionType_NumValues is the number of distinct recognized
+// values of ExceptionType.
+const ExceptionType_NumValues = 11
+
+// Ordinal returns the position of this value among the distinct
+// recognized values of ExceptionType or false if the value is not
+// recognized. Ordinals are less than ExceptionType_NumValues.
+//
+// Ordinals may be used to index arrays of length
+// ExceptionType_NumValues in place of map[ExceptionType]T.
+//
+//   var counts [ExceptionType_NumValues]int
+//   if i, ok := v.Ordinal(); ok {
+//     counts[i]++
+//   }
+func (v ExceptionType) Ordinal() (int, bool) {
+	switch int32(v) {
+	case 0:
+		return 0, true
+	case 1:
+		return 1, true
+	case 2:
+		return 2, true
+	case 3:
+		return 3, true
+	case 4:
+		return 4, true
+	case 5:
+		return 5, true
+	case 6:
+		return 6, true
+	case 7:
+		return 7, true
+	case 8:
+		return 8, true
+	case 9:
+		return 9, true
+	case 10:
+		return 10, true
+	default:
+		return 0, false
+	}
+}
+
+// ExceptionType_Set is a set of ExceptionType values backed by a
+// bitset. The zero value is an empty set.
+type ExceptionType_Set struct {
+	bits [1]uint64
+}
+
+// Add adds the given value to the set. It returns false if the value
+// is not a recognized value of ExceptionType.
+func (s *ExceptionType_Set) Add(v ExceptionType) bool {
+	i, ok := v.Ordinal()
+	if ok {
+		s.bits[i/64] |= 1 << uint(i%64)
+	}
+	return ok
+}
+
+// Remove removes the given value from the set.
+func (s *ExceptionType_Set) Remove(v ExceptionType) {
+	if i, ok := v.Ordinal(); ok {
+		s.bits[i/64] &^= 1 << uint(i%64)
+	}
+}
+
+// Contains returns true if the given value is in the set.
+func (s *ExceptionType_Set) Contains(v ExceptionType) bool {
+	i, ok := v.Ordinal()
+	return ok && s.bits[i/64]&(1<<uint(i%64)) != 0
+}
+
+// Len returns the number of values in the set.
+func (s *ExceptionType_Set) Len() int {
+	n := 0
+	for _, x := range s.bits {
+		for ; x != 0; n++ {
+			x &= x - 1
+		}
+	}
+	return n
+}
+
+// Values returns the values in the set in the order in which they
+// were declared.
+func (s *ExceptionType_Set) Values() []ExceptionType {
+	v := make([]ExceptionType, 0, s.Len())
+	if s.bits[0]&(1<<0) != 0 {
+		v = append(v, ExceptionTypeUnknown)
+	}
+	if s.bits[0]&(1<<1) != 0 {
+		v = append(v, ExceptionTypeUnknownMethod)
+	}
+	if s.bits[0]&(1<<2) != 0 {
+		v = append(v, ExceptionTypeInvalidMessageType)
+	}
+	if s.bits[0]&(1<<3) != 0 {
+		v = append(v, ExceptionTypeWrongMethodName)
+	}
+	if s.bits[0]&(1<<4) != 0 {
+		v = append(v, ExceptionTypeBadSequenceID)
+	}
+	if s.bits[0]&(1<<5) != 0 {
+		v = append(v, ExceptionTypeMissingResult)
+	}
+	if s.bits[0]&(1<<6) != 0 {
+		v = append(v, ExceptionTypeInternalError)
+	}
+	if s.bits[0]&(1<<7) != 0 {
+		v = append(v, ExceptionTypeProtocolError)
+	}
+	if s.bits[0]&(1<<8) != 0 {
+		v = append(v, ExceptionTypeInvalidTransform)
+	}
+	if s.bits[0]&(1<<9) != 0 {
+		v = append(v, ExceptionTypeInvalidProtocol)
+	}
+	if s.bits[0]&(1<<10) != 0 {
+		v = append(v, ExceptionTypeUnsupportedClientType)
+	}
+	return v
+}
+
 type TApplicationException struct {
 	Message *string        `json:"message,omitempty"`
 	Type    *ExceptionType `json:"type,omitempty"`
@@ -272,11 +398,12 @@ func (v *TApplicationException) ToWire() (wire.Value, error) {
 		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("TApplicationException is nil")
+	}
+
 	if v.Message != nil {
-		w, err = wire.NewValueString(*(v.Message)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueString(*(v.Message))
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
@@ -322,12 +449,8 @@ func (v *TApplicationException) FromWire(w wire.Value) error {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
+				x := field.Value.GetString()
 				v.Message = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		case 2:
@@ -402,26 +525,78 @@ func (v *TApplicationException) Equals(rhs *TApplicationException) bool {
 	return true
 }
 
+func _String_ClonePtr(p *string) *string {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _ExceptionType_ClonePtr(p *ExceptionType) *ExceptionType {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this TApplicationException.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *TApplicationException) Clone() *TApplicationException {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Message = _String_ClonePtr(v.Message)
+	o.Type = _ExceptionType_ClonePtr(v.Type)
+
+	return &o
+}
+
 // GetMessage returns the value of Message if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil TApplicationException.
 func (v *TApplicationException) GetMessage() (o string) {
-	if v.Message != nil {
+	if v != nil && v.Message != nil {
 		return *v.Message
 	}
 
 	return
 }
 
+// IsSetMessage returns true if Message is not nil.
+//
+// This is safe to call on a nil TApplicationException.
+func (v *TApplicationException) IsSetMessage() bool {
+	return v != nil && v.Message != nil
+}
+
 // GetType returns the value of Type if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil TApplicationException.
 func (v *TApplicationException) GetType() (o ExceptionType) {
-	if v.Type != nil {
+	if v != nil && v.Type != nil {
 		return *v.Type
 	}
 
 	return
 }
 
+// IsSetType returns true if Type is not nil.
+//
+// This is safe to call on a nil TApplicationException.
+func (v *TApplicationException) IsSetType() bool {
+	return v != nil && v.Type != nil
+}
+
 func (v *TApplicationException) Error() string {
 	return v.String()
 }
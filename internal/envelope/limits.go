@@ -0,0 +1,92 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package envelope
+
+import (
+	"sync"
+
+	"go.uber.org/thriftrw/envelope"
+)
+
+// sizeStats accumulates SizeStats for each method.
+type sizeStats struct {
+	sync.Mutex
+
+	methods map[string]*envelope.SizeStats
+}
+
+func (s *sizeStats) get(name string) *envelope.SizeStats {
+	stats, ok := s.methods[name]
+	if !ok {
+		stats = &envelope.SizeStats{}
+		s.methods[name] = stats
+	}
+	return stats
+}
+
+// has returns true if sizes have been recorded for the given method.
+func (s *sizeStats) has(name string) bool {
+	s.Lock()
+	defer s.Unlock()
+
+	_, ok := s.methods[name]
+	return ok
+}
+
+func (s *sizeStats) recordRequest(name string, size int) {
+	s.Lock()
+	defer s.Unlock()
+
+	stats := s.get(name)
+	stats.Requests++
+	stats.RequestBytes += int64(size)
+	if size > stats.MaxRequestSize {
+		stats.MaxRequestSize = size
+	}
+}
+
+func (s *sizeStats) recordResponse(name string, size int) {
+	s.Lock()
+	defer s.Unlock()
+
+	stats := s.get(name)
+	stats.ResponseBytes += int64(size)
+	if size > stats.MaxResponseSize {
+		stats.MaxResponseSize = size
+	}
+}
+
+func (s *sizeStats) recordRejected(name string) {
+	s.Lock()
+	s.get(name).Rejected++
+	s.Unlock()
+}
+
+func (s *sizeStats) snapshot() map[string]envelope.SizeStats {
+	s.Lock()
+	defer s.Unlock()
+
+	m := make(map[string]envelope.SizeStats, len(s.methods))
+	for name, stats := range s.methods {
+		m[name] = *stats
+	}
+	return m
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package envelope
+
+import (
+	"bytes"
+	"testing"
+
+	"go.uber.org/thriftrw/envelope"
+	"go.uber.org/thriftrw/internal/envelope/envelopetest"
+	"go.uber.org/thriftrw/internal/envelope/exception"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerSizeLimits(t *testing.T) {
+	echo := handlerFunc(func(name string, body wire.Value) (wire.Value, error) {
+		if name == "unknown" {
+			return wire.Value{}, ErrUnknownMethod(name)
+		}
+		return body, nil
+	})
+
+	server := NewServer(protocol.Binary, echo).WithSizeLimits(envelope.SizeLimits{
+		Default: envelope.SizeLimit{Request: 100, Response: 100},
+		Methods: map[string]envelope.SizeLimit{
+			"upload":   {Request: 1000, Response: 100},
+			"download": {Request: 100, Response: 1000},
+		},
+	})
+	client := NewClient(protocol.Binary, envelopetest.TransportFunc(server.Handle))
+
+	small := wire.NewValueStruct(wire.Struct{})
+	large := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueBinary(make([]byte, 500))},
+	}})
+
+	assertTooLarge := func(t *testing.T, err error, wantType exception.ExceptionType) {
+		if assert.Error(t, err) {
+			exc, ok := err.(*exception.TApplicationException)
+			if assert.True(t, ok, "expected TApplicationException, got %T", err) {
+				assert.Equal(t, wantType, exc.GetType())
+				assert.Contains(t, exc.GetMessage(), "exceeds limit")
+			}
+		}
+	}
+
+	_, err := client.Send("ping", small)
+	assert.NoError(t, err, "small request must succeed")
+
+	_, err = client.Send("ping", large)
+	assertTooLarge(t, err, exception.ExceptionTypeProtocolError)
+
+	// The request is accepted but the echoed response is too large.
+	_, err = client.Send("upload", large)
+	assertTooLarge(t, err, exception.ExceptionTypeInternalError)
+
+	_, err = client.Send("download", large)
+	assertTooLarge(t, err, exception.ExceptionTypeProtocolError)
+
+	_, err = client.Send("unknown", small)
+	require.Error(t, err)
+
+	// The Handler never sees these so the server cannot tell whether the
+	// method exists.
+	_, err = client.Send("random", large)
+	assertTooLarge(t, err, exception.ExceptionTypeProtocolError)
+
+	stats := server.SizeStats()
+	assert.NotContains(t, stats, "unknown", "unknown methods must not be recorded")
+	assert.NotContains(t, stats, "random", "rejected calls to unseen methods must not be recorded")
+
+	ping := stats["ping"]
+	assert.Equal(t, int64(2), ping.Requests)
+	assert.Equal(t, int64(1), ping.Rejected)
+	assert.True(t, ping.MaxRequestSize > 500, "max request size must include the rejected request")
+	assert.Equal(t, int64(1), stats["upload"].Rejected)
+	assert.Equal(t, int64(1), stats["download"].Rejected)
+}
+
+func TestServerRejectsLargeRequestsBeforeDecoding(t *testing.T) {
+	server := NewServer(protocol.Binary, handlerFunc(
+		func(string, wire.Value) (wire.Value, error) {
+			t.Fatal("requests which are too large must not be handled")
+			return wire.Value{}, nil
+		})).WithSizeLimits(envelope.SizeLimits{
+		Default: envelope.SizeLimit{Request: 100},
+	})
+
+	var buff bytes.Buffer
+	require.NoError(t, protocol.Binary.EncodeEnveloped(wire.Envelope{
+		Name:  "ping",
+		SeqID: 42,
+		Type:  wire.Call,
+		Value: wire.NewValueStruct(wire.Struct{}),
+	}, &buff))
+
+	// Replace the end of the struct with a binary field whose length prefix
+	// is larger than the request so that decoding the body would fail.
+	data := buff.Bytes()[:buff.Len()-1]
+	data = append(data, 0x0b, 0x00, 0x01, 0x7f, 0xff, 0xff, 0xff)
+	data = append(data, make([]byte, 200)...)
+
+	res, err := server.Handle(data)
+	require.NoError(t, err)
+
+	response, err := protocol.Binary.DecodeEnveloped(bytes.NewReader(res))
+	require.NoError(t, err)
+	assert.Equal(t, wire.Exception, response.Type)
+	assert.Equal(t, int32(42), response.SeqID)
+
+	var exc exception.TApplicationException
+	require.NoError(t, exc.FromWire(response.Value))
+	assert.Equal(t, exception.ExceptionTypeProtocolError, exc.GetType())
+	assert.Contains(t, exc.GetMessage(), "exceeds limit")
+}
@@ -24,6 +24,7 @@ import (
 	"bytes"
 	"fmt"
 
+	"go.uber.org/thriftrw/envelope"
 	"go.uber.org/thriftrw/internal/envelope/exception"
 	"go.uber.org/thriftrw/protocol"
 	"go.uber.org/thriftrw/ptr"
@@ -51,16 +52,45 @@ type Handler interface {
 type Server struct {
 	p protocol.Protocol
 	h Handler
+
+	limits envelope.SizeLimits
+	stats  *sizeStats
 }
 
 // NewServer builds a new server.
 func NewServer(p protocol.Protocol, h Handler) Server {
-	return Server{p: p, h: h}
+	return Server{
+		p:     p,
+		h:     h,
+		stats: &sizeStats{methods: make(map[string]*envelope.SizeStats)},
+	}
+}
+
+// WithSizeLimits returns a copy of this Server which enforces the given
+// limits on the sizes of requests and responses. Requests which are too
+// large are not passed to the Handler and responses which are too large are
+// not sent. The caller receives a TApplicationException instead.
+//
+// If the protocol of the Server is a protocol.EnvelopeHeaderDecoder, requests
+// which are too large are rejected after decoding only their envelope
+// headers.
+func (s Server) WithSizeLimits(l envelope.SizeLimits) Server {
+	s.limits = l
+	return s
+}
+
+// SizeStats returns statistics about the sizes of the requests and
+// responses handled by this Server, keyed by method name.
+func (s Server) SizeStats() map[string]envelope.SizeStats {
+	if s.stats == nil {
+		return nil
+	}
+	return s.stats.snapshot()
 }
 
 // Handle handles the given binary payload.
 func (s Server) Handle(data []byte) ([]byte, error) {
-	request, err := s.p.DecodeEnveloped(bytes.NewReader(data))
+	request, decoded, err := s.decodeHeader(data)
 	if err != nil {
 		return nil, err
 	}
@@ -71,29 +101,113 @@ func (s Server) Handle(data []byte) ([]byte, error) {
 		Type:  wire.Reply,
 	}
 
-	response.Value, err = s.h.Handle(request.Name, request.Value)
-	if err != nil {
-		response.Type = wire.Exception
-		switch err.(type) {
-		case ErrUnknownMethod:
-			response.Value, err = tappExc(err, exception.ExceptionTypeUnknownMethod)
-		default:
-			response.Value, err = tappExc(err, exception.ExceptionTypeInternalError)
+	// Calls to unknown methods are not recorded so that clients cannot grow
+	// the stats without bound. Requests rejected before reaching the Handler
+	// are only recorded for methods which have limits of their own or which
+	// the Handler has accepted before.
+	known := s.knows(request.Name)
+
+	limit := s.limits.For(request.Name)
+	if limit.Request > 0 && len(data) > limit.Request {
+		err = envelope.ErrTooLarge{Method: request.Name, Size: len(data), Limit: limit.Request}
+	} else {
+		if !decoded {
+			request, err = s.p.DecodeEnveloped(bytes.NewReader(data))
+			if err != nil {
+				return nil, err
+			}
 		}
+		response.Value, err = s.h.Handle(request.Name, request.Value)
+		_, unknownMethod := err.(ErrUnknownMethod)
+		known = !unknownMethod
+	}
 
+	_, rejected := err.(envelope.ErrTooLarge)
+	if err != nil {
+		response, err = s.exception(response, err)
 		if err != nil {
 			return nil, err
 		}
 	}
 
 	var buff bytes.Buffer
-	if err := s.p.EncodeEnveloped(response, &buff); err != nil {
+	if err = s.p.EncodeEnveloped(response, &buff); err != nil {
 		return nil, err
 	}
 
+	if limit.Response > 0 && buff.Len() > limit.Response && response.Type == wire.Reply {
+		response, err = s.exception(response, envelope.ErrTooLarge{
+			Method:   request.Name,
+			Response: true,
+			Size:     buff.Len(),
+			Limit:    limit.Response,
+		})
+		if err != nil {
+			return nil, err
+		}
+		rejected = true
+
+		buff.Reset()
+		if err := s.p.EncodeEnveloped(response, &buff); err != nil {
+			return nil, err
+		}
+	}
+
+	if s.stats != nil && known {
+		s.stats.recordRequest(request.Name, len(data))
+		s.stats.recordResponse(request.Name, buff.Len())
+		if rejected {
+			s.stats.recordRejected(request.Name)
+		}
+	}
+
 	return buff.Bytes(), nil
 }
 
+// knows returns true if sizes of requests to the method with the given
+// envelope name may be recorded without the Handler accepting them first.
+func (s Server) knows(name string) bool {
+	if _, ok := s.limits.Methods[name]; ok {
+		return true
+	}
+	return s.stats != nil && s.stats.has(name)
+}
+
+// decodeHeader decodes the envelope header of the given request so that its
+// size may be checked before its body is decoded. If the Server does not
+// limit the sizes of requests or its protocol cannot decode headers alone,
+// the body is decoded too and the returned bool is true.
+func (s Server) decodeHeader(data []byte) (wire.Envelope, bool, error) {
+	limited := s.limits.Default.Request > 0 || len(s.limits.Methods) > 0
+	if d, ok := s.p.(protocol.EnvelopeHeaderDecoder); ok && limited {
+		e, err := d.DecodeEnvelopeHeader(bytes.NewReader(data))
+		return e, false, err
+	}
+
+	e, err := s.p.DecodeEnveloped(bytes.NewReader(data))
+	return e, true, err
+}
+
+// exception turns the given response into a TApplicationException for the
+// given error.
+func (s Server) exception(response wire.Envelope, err error) (wire.Envelope, error) {
+	response.Type = wire.Exception
+	switch e := err.(type) {
+	case ErrUnknownMethod:
+		response.Value, err = tappExc(err, exception.ExceptionTypeUnknownMethod)
+	case envelope.ErrTooLarge:
+		// Large requests are the caller's fault but large responses are not.
+		typ := exception.ExceptionTypeProtocolError
+		if e.Response {
+			typ = exception.ExceptionTypeInternalError
+		}
+		response.Value, err = tappExc(err, typ)
+	default:
+		response.Value, err = tappExc(err, exception.ExceptionTypeInternalError)
+	}
+	return response, err
+}
+
 // Helper to build TApplicationException wire.Values
 func tappExc(err error, typ exception.ExceptionType) (wire.Value, error) {
 	return (&exception.TApplicationException{
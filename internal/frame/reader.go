@@ -23,7 +23,9 @@ package frame
 import (
 	"bytes"
 	"encoding/binary"
+	"fmt"
 	"io"
+	"io/ioutil"
 	"sync"
 
 	"go.uber.org/atomic"
@@ -39,6 +41,18 @@ type Reader struct {
 	closed atomic.Bool
 	r      io.Reader
 	buff   [4]byte
+	limit  int64
+}
+
+// FrameTooLargeError is returned by Reader.Read if the length prefix of a
+// frame exceeds the limit of the Reader.
+type FrameTooLargeError struct {
+	Size  int64
+	Limit int64
+}
+
+func (e FrameTooLargeError) Error() string {
+	return fmt.Sprintf("frame of %d bytes exceeds limit of %d bytes", e.Size, e.Limit)
 }
 
 // NewReader builds a new Reader which reads frames from the given io.Reader.
@@ -49,6 +63,17 @@ func NewReader(r io.Reader) *Reader {
 	return &Reader{r: r}
 }
 
+// SetLimit limits the size of frames read by this Reader to the given
+// number of bytes. Zero means that frames are not limited.
+//
+// Frames which are too large are skipped without being buffered and Read
+// returns a FrameTooLargeError for them.
+func (r *Reader) SetLimit(n int64) {
+	r.Lock()
+	r.limit = n
+	r.Unlock()
+}
+
 // Read reads the next frame from the Reader.
 func (r *Reader) Read() ([]byte, error) {
 	r.Lock()
@@ -59,6 +84,13 @@ func (r *Reader) Read() ([]byte, error) {
 	}
 
 	length := int64(binary.BigEndian.Uint32(r.buff[:]))
+	if r.limit > 0 && length > r.limit {
+		if _, err := io.CopyN(ioutil.Discard, r.r, length); err != nil {
+			return nil, err
+		}
+		return nil, FrameTooLargeError{Size: length, Limit: r.limit}
+	}
+
 	if length < _fastPathFrameSize {
 		return r.readFastPath(length)
 	}
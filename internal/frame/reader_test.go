@@ -37,6 +37,9 @@ func TestReader(t *testing.T) {
 
 		// if non-zero, the _fastPathFrameSize will be set to this value for the test
 		fastPathThreshold int64
+
+		// if non-zero, the Reader will be limited to frames of this size
+		limit int64
 	}{
 		{
 			desc: "error while reading length",
@@ -136,6 +139,32 @@ func TestReader(t *testing.T) {
 				{err: io.EOF},
 			},
 		},
+		{
+			desc: "frame too large",
+			giveReader: func() io.Reader {
+				return bytes.NewReader([]byte{
+					0x00, 0x00, 0x00, 0x02, 0x01, 0x02,
+					0x00, 0x00, 0x00, 0x03, 0x01, 0x02, 0x03,
+					0x00, 0x00, 0x00, 0x01, 0x01,
+				})
+			},
+			wantReads: []wantRead{
+				{frame: []byte{0x01, 0x02}},
+				{err: FrameTooLargeError{Size: 3, Limit: 2}},
+				{frame: []byte{0x01}},
+			},
+			limit: 2,
+		},
+		{
+			desc: "frame too large, body too short",
+			giveReader: func() io.Reader {
+				return bytes.NewReader([]byte{0x00, 0x00, 0x00, 0x03, 0x01})
+			},
+			wantReads: []wantRead{
+				{err: io.EOF},
+			},
+			limit: 2,
+		},
 	}
 
 	for _, tt := range tests {
@@ -146,6 +175,9 @@ func TestReader(t *testing.T) {
 			}
 
 			r := NewReader(tt.giveReader())
+			if tt.limit != 0 {
+				r.SetLimit(tt.limit)
+			}
 
 			for _, want := range tt.wantReads {
 				frame, err := r.Read()
@@ -54,6 +54,16 @@ func NewServer(r io.Reader, w io.Writer) *Server {
 	}
 }
 
+// SetMaxFrameSize limits the size of requests accepted by the Server to the
+// given number of bytes. Zero means that requests are not limited.
+//
+// Larger requests are rejected based on their length prefix and discarded
+// without being read into memory. The Server responds to them with an empty
+// frame, which the caller fails to decode, and keeps serving.
+func (s *Server) SetMaxFrameSize(n int64) {
+	s.r.SetLimit(n)
+}
+
 // Serve serves the given Handler with the Server.
 //
 // Only one request is served at a time. The server stops handling requests if
@@ -72,6 +82,14 @@ func (s *Server) Serve(h Handler) (err error) {
 
 	for s.running.Load() {
 		req, err := s.r.Read()
+		if _, tooLarge := err.(FrameTooLargeError); tooLarge {
+			// Respond with an empty frame so that the caller is not left
+			// waiting for a response.
+			if err := s.w.Write(nil); err != nil {
+				return err
+			}
+			continue
+		}
 		if err != nil {
 			// If the error occurred because the server was stopped, ignore it.
 			if !s.running.Load() {
@@ -3,6 +3,7 @@ package frame
 import (
 	"bytes"
 	"errors"
+	"io"
 	"testing"
 
 	"go.uber.org/thriftrw/internal/iotest"
@@ -69,3 +70,32 @@ func TestServeWriteError(t *testing.T) {
 
 	assert.Equal(t, errors.New("great sadness"), err)
 }
+
+func TestServeFrameTooLarge(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+
+	r := bytes.NewReader([]byte{
+		0x00, 0x00, 0x00, 0x03, 0x01, 0x02, 0x03, // too large
+		0x00, 0x00, 0x00, 0x02, 0x04, 0x05,
+	})
+
+	// The large request gets an empty response and the server keeps serving.
+	w := NewMockWriter(mockCtrl)
+	gomock.InOrder(
+		w.EXPECT().Write([]byte{0x00, 0x00, 0x00, 0x00}).Return(4, nil),
+		w.EXPECT().Write([]byte{0x00, 0x00, 0x00, 0x02}).Return(4, nil),
+		w.EXPECT().Write([]byte{0x04, 0x05}).Return(2, nil),
+	)
+
+	server := NewServer(r, w)
+	server.SetMaxFrameSize(2)
+	err := server.Serve(handlerFunc(
+		func(req []byte) ([]byte, error) {
+			assert.Equal(t, []byte{0x04, 0x05}, req)
+			return req, nil
+		},
+	))
+
+	assert.Equal(t, io.EOF, err)
+}
@@ -0,0 +1,93 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+)
+
+// Shapes of nodes in DOT output, keyed by the kind of the node.
+var dotShapes = map[string]string{
+	FileNode:      "note",
+	ServiceNode:   "component",
+	StructNode:    "box",
+	UnionNode:     "box",
+	ExceptionNode: "box",
+	EnumNode:      "ellipse",
+	TypedefNode:   "plaintext",
+}
+
+// Attributes of edges in DOT output, keyed by the kind of the edge.
+var dotEdgeAttributes = map[string]string{
+	IncludesEdge:   "style=dashed",
+	ExtendsEdge:    "arrowhead=empty",
+	ReferencesEdge: "",
+}
+
+// WriteDOT writes the graph in the DOT language of Graphviz. The services
+// and types of each file are grouped in a cluster labeled with its path.
+func (g *Graph) WriteDOT(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintln(bw, "digraph thrift {")
+	fmt.Fprintln(bw, "\trankdir=LR;")
+
+	// Nodes are sorted by file so the nodes of each file are adjacent.
+	for i := 0; i < len(g.Nodes); {
+		file := g.Nodes[i].File
+		fmt.Fprintf(bw, "\tsubgraph %v {\n", dotQuote("cluster_"+file))
+		fmt.Fprintf(bw, "\t\tlabel=%v;\n", dotQuote(file))
+		for ; i < len(g.Nodes) && g.Nodes[i].File == file; i++ {
+			n := g.Nodes[i]
+			fmt.Fprintf(bw, "\t\t%v [label=%v, shape=%v];\n",
+				dotQuote(n.ID), dotQuote(dotLabel(n)), dotShapes[n.Kind])
+		}
+		fmt.Fprintln(bw, "\t}")
+	}
+
+	for _, e := range g.Edges {
+		if attrs := dotEdgeAttributes[e.Kind]; attrs != "" {
+			fmt.Fprintf(bw, "\t%v -> %v [%v];\n", dotQuote(e.From), dotQuote(e.To), attrs)
+		} else {
+			fmt.Fprintf(bw, "\t%v -> %v;\n", dotQuote(e.From), dotQuote(e.To))
+		}
+	}
+
+	fmt.Fprintln(bw, "}")
+	return bw.Flush()
+}
+
+func dotLabel(n Node) string {
+	if n.Kind == FileNode {
+		return path.Base(n.File)
+	}
+	return n.Kind + " " + n.Name
+}
+
+var dotEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+// dotQuote returns the given string as a quoted DOT ID.
+func dotQuote(s string) string {
+	return `"` + dotEscaper.Replace(s) + `"`
+}
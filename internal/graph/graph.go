@@ -0,0 +1,297 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package graph builds the dependency graph of Thrift files: which files
+// include which, which services extend which, and which services and types
+// refer to which types. Teams may use it to see which services depend on
+// shared types before refactoring them or dividing their ownership.
+package graph
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+// Kinds of nodes.
+const (
+	FileNode      = "file"
+	ServiceNode   = "service"
+	StructNode    = "struct"
+	UnionNode     = "union"
+	ExceptionNode = "exception"
+	EnumNode      = "enum"
+	TypedefNode   = "typedef"
+)
+
+// Kinds of edges.
+const (
+	// IncludesEdge is from a file to a file it includes.
+	IncludesEdge = "includes"
+
+	// ExtendsEdge is from a service to the service it extends.
+	ExtendsEdge = "extends"
+
+	// ReferencesEdge is from a service or type to a type used by its
+	// functions, fields, or target.
+	ReferencesEdge = "references"
+)
+
+// Graph is the dependency graph of a set of Thrift files.
+type Graph struct {
+	// Nodes sorted by file and ID. Each file precedes its services and
+	// types.
+	Nodes []Node `json:"nodes"`
+
+	// Edges sorted by source, target, and kind.
+	Edges []Edge `json:"edges"`
+}
+
+// Node is a Thrift file or a service or type declared in one.
+type Node struct {
+	// ID of the node. This is the path of files and the path of the file
+	// followed by a colon and the name for services and types.
+	ID string `json:"id"`
+
+	Kind string `json:"kind"`
+
+	// Path to the Thrift file relative to the root directory, separated by
+	// forward slashes.
+	File string `json:"file"`
+
+	// Name of the service or type. Empty for files.
+	Name string `json:"name,omitempty"`
+}
+
+// Edge is a dependency of one node on another.
+type Edge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	Kind string `json:"kind"`
+}
+
+// Build returns the dependency graph of the given modules and the modules
+// they include. Paths are relative to the given root directory, and modules
+// outside it are skipped along with their dependencies.
+func Build(root string, modules []*compile.Module) (*Graph, error) {
+	b := builder{
+		paths: make(map[string]string),
+		edges: make(map[Edge]struct{}),
+	}
+
+	var included []*compile.Module
+	visit := func(m *compile.Module) error {
+		if _, ok := b.paths[m.ThriftPath]; ok {
+			return nil
+		}
+
+		path, err := filepath.Rel(root, m.ThriftPath)
+		if err != nil {
+			return fmt.Errorf("could not resolve path for %q: %v", m.ThriftPath, err)
+		}
+		if strings.HasPrefix(path, "..") {
+			// Record the module as visited without including it.
+			b.paths[m.ThriftPath] = ""
+			return nil
+		}
+		b.paths[m.ThriftPath] = filepath.ToSlash(path)
+		included = append(included, m)
+		return nil
+	}
+
+	for _, m := range modules {
+		if err := m.Walk(visit); err != nil {
+			return nil, err
+		}
+	}
+
+	// Edges may only be added once the paths of all files are known.
+	for _, m := range included {
+		b.addModule(m)
+	}
+	return b.graph(), nil
+}
+
+type builder struct {
+	// Paths of files relative to the root directory, keyed by their
+	// absolute paths. Paths of files outside the root directory are empty.
+	paths map[string]string
+
+	nodes []Node
+	edges map[Edge]struct{}
+}
+
+func (b *builder) addModule(m *compile.Module) {
+	file := b.paths[m.ThriftPath]
+	b.nodes = append(b.nodes, Node{ID: file, Kind: FileNode, File: file})
+
+	for _, inc := range m.Includes {
+		b.addEdge(file, b.paths[inc.Module.ThriftPath], IncludesEdge)
+	}
+
+	for name, s := range m.Services {
+		id := definitionID(file, name)
+		b.nodes = append(b.nodes, Node{ID: id, Kind: ServiceNode, File: file, Name: name})
+
+		if s.Parent != nil {
+			b.addEdge(id, b.definitionID(s.Parent.File, s.Parent.Name), ExtendsEdge)
+		}
+		for _, f := range s.Functions {
+			for _, arg := range f.ArgsSpec {
+				b.addReferences(id, arg.Type)
+			}
+			if f.ResultSpec == nil {
+				continue
+			}
+			if f.ResultSpec.ReturnType != nil {
+				b.addReferences(id, f.ResultSpec.ReturnType)
+			}
+			for _, exc := range f.ResultSpec.Exceptions {
+				b.addReferences(id, exc.Type)
+			}
+		}
+	}
+
+	for name, t := range m.Types {
+		id := definitionID(file, name)
+		b.nodes = append(b.nodes, Node{ID: id, Kind: typeKind(t), File: file, Name: name})
+
+		switch s := t.(type) {
+		case *compile.StructSpec:
+			for _, f := range s.Fields {
+				b.addReferences(id, f.Type)
+			}
+		case *compile.TypedefSpec:
+			b.addReferences(id, s.Target)
+		}
+	}
+}
+
+// addReferences adds edges from the given node to the named types used by
+// the given type. Containers are not nodes themselves so references are
+// made to the types they contain.
+func (b *builder) addReferences(from string, t compile.TypeSpec) {
+	switch s := t.(type) {
+	case *compile.ListSpec:
+		b.addReferences(from, s.ValueSpec)
+	case *compile.SetSpec:
+		b.addReferences(from, s.ValueSpec)
+	case *compile.MapSpec:
+		b.addReferences(from, s.KeySpec)
+		b.addReferences(from, s.ValueSpec)
+	default:
+		if file := t.ThriftFile(); file != "" {
+			b.addEdge(from, b.definitionID(file, t.ThriftName()), ReferencesEdge)
+		}
+		// Primitive types are not declared in any file.
+	}
+}
+
+// addEdge adds an edge unless its target is outside the root directory.
+func (b *builder) addEdge(from, to, kind string) {
+	if to == "" {
+		return
+	}
+	b.edges[Edge{From: from, To: to, Kind: kind}] = struct{}{}
+}
+
+// definitionID returns the ID of the definition with the given name in the
+// Thrift file at the given absolute path, or an empty string if the file is
+// outside the root directory.
+func (b *builder) definitionID(file, name string) string {
+	path := b.paths[file]
+	if path == "" {
+		return ""
+	}
+	return definitionID(path, name)
+}
+
+func definitionID(file, name string) string {
+	return file + ":" + name
+}
+
+func (b *builder) graph() *Graph {
+	g := &Graph{Nodes: b.nodes, Edges: make([]Edge, 0, len(b.edges))}
+	for e := range b.edges {
+		g.Edges = append(g.Edges, e)
+	}
+	sort.Sort(nodesByFile(g.Nodes))
+	sort.Sort(edgesByEnds(g.Edges))
+	return g
+}
+
+func typeKind(t compile.TypeSpec) string {
+	switch s := t.(type) {
+	case *compile.StructSpec:
+		switch s.Type {
+		case ast.UnionType:
+			return UnionNode
+		case ast.ExceptionType:
+			return ExceptionNode
+		default:
+			return StructNode
+		}
+	case *compile.EnumSpec:
+		return EnumNode
+	default:
+		return TypedefNode
+	}
+}
+
+// WriteJSON writes the graph as indented JSON.
+func (g *Graph) WriteJSON(w io.Writer) error {
+	b, err := json.MarshalIndent(g, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(b, '\n'))
+	return err
+}
+
+type nodesByFile []Node
+
+func (ns nodesByFile) Len() int      { return len(ns) }
+func (ns nodesByFile) Swap(i, j int) { ns[i], ns[j] = ns[j], ns[i] }
+func (ns nodesByFile) Less(i, j int) bool {
+	if ns[i].File != ns[j].File {
+		return ns[i].File < ns[j].File
+	}
+	return ns[i].ID < ns[j].ID
+}
+
+type edgesByEnds []Edge
+
+func (es edgesByEnds) Len() int      { return len(es) }
+func (es edgesByEnds) Swap(i, j int) { es[i], es[j] = es[j], es[i] }
+func (es edgesByEnds) Less(i, j int) bool {
+	if es[i].From != es[j].From {
+		return es[i].From < es[j].From
+	}
+	if es[i].To != es[j].To {
+		return es[i].To < es[j].To
+	}
+	return es[i].Kind < es[j].Kind
+}
@@ -0,0 +1,202 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package graph
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// buildGraph writes the given files to a temporary directory, compiles the
+// ones in the api directory, and returns the graph of the api directory.
+func buildGraph(t *testing.T, files map[string]string) *Graph {
+	dir, err := ioutil.TempDir("", "thriftrw-graph-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	root := filepath.Join(dir, "api")
+	var paths []string
+	for name, contents := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, ioutil.WriteFile(path, []byte(contents), 0644))
+		if filepath.Dir(path) == root {
+			paths = append(paths, path)
+		}
+	}
+
+	modules, err := compile.CompileFiles(paths)
+	require.NoError(t, err)
+
+	g, err := Build(root, modules)
+	require.NoError(t, err)
+	return g
+}
+
+var testFiles = map[string]string{
+	"api/users.thrift": `include "shared/types.thrift"
+include "../common.thrift"
+
+struct User {
+    1: required types.UUID id
+    2: optional list<User> friends
+    3: optional common.Tag tag
+}
+
+service Base {}
+
+service Users extends Base {
+    User getUser(1: types.UUID id) throws (1: types.NotFound notFound)
+    oneway void ping(1: map<string, types.Status> statuses)
+}
+`,
+	"api/orders.thrift": `include "shared/types.thrift"
+
+service Orders {
+    void cancel(1: types.UUID id)
+}
+`,
+	"api/shared/types.thrift": `typedef string UUID
+
+enum Status { ACTIVE, DELETED }
+
+exception NotFound {
+    1: optional string message
+}
+
+union Lookup {
+    1: UUID id
+    2: string name
+}
+`,
+	"common.thrift": `struct Tag {
+    1: required string name
+}
+`,
+}
+
+func TestBuild(t *testing.T) {
+	g := buildGraph(t, testFiles)
+
+	assert.Equal(t, []Node{
+		{ID: "orders.thrift", Kind: FileNode, File: "orders.thrift"},
+		{ID: "orders.thrift:Orders", Kind: ServiceNode, File: "orders.thrift", Name: "Orders"},
+		{ID: "shared/types.thrift", Kind: FileNode, File: "shared/types.thrift"},
+		{ID: "shared/types.thrift:Lookup", Kind: UnionNode, File: "shared/types.thrift", Name: "Lookup"},
+		{ID: "shared/types.thrift:NotFound", Kind: ExceptionNode, File: "shared/types.thrift", Name: "NotFound"},
+		{ID: "shared/types.thrift:Status", Kind: EnumNode, File: "shared/types.thrift", Name: "Status"},
+		{ID: "shared/types.thrift:UUID", Kind: TypedefNode, File: "shared/types.thrift", Name: "UUID"},
+		{ID: "users.thrift", Kind: FileNode, File: "users.thrift"},
+		{ID: "users.thrift:Base", Kind: ServiceNode, File: "users.thrift", Name: "Base"},
+		{ID: "users.thrift:User", Kind: StructNode, File: "users.thrift", Name: "User"},
+		{ID: "users.thrift:Users", Kind: ServiceNode, File: "users.thrift", Name: "Users"},
+	}, g.Nodes)
+
+	assert.Equal(t, []Edge{
+		{From: "orders.thrift", To: "shared/types.thrift", Kind: IncludesEdge},
+		{From: "orders.thrift:Orders", To: "shared/types.thrift:UUID", Kind: ReferencesEdge},
+		{From: "shared/types.thrift:Lookup", To: "shared/types.thrift:UUID", Kind: ReferencesEdge},
+		{From: "users.thrift", To: "shared/types.thrift", Kind: IncludesEdge},
+		{From: "users.thrift:User", To: "shared/types.thrift:UUID", Kind: ReferencesEdge},
+		{From: "users.thrift:User", To: "users.thrift:User", Kind: ReferencesEdge},
+		{From: "users.thrift:Users", To: "shared/types.thrift:NotFound", Kind: ReferencesEdge},
+		{From: "users.thrift:Users", To: "shared/types.thrift:Status", Kind: ReferencesEdge},
+		{From: "users.thrift:Users", To: "shared/types.thrift:UUID", Kind: ReferencesEdge},
+		{From: "users.thrift:Users", To: "users.thrift:Base", Kind: ExtendsEdge},
+		{From: "users.thrift:Users", To: "users.thrift:User", Kind: ReferencesEdge},
+	}, g.Edges)
+}
+
+func TestWriteDOT(t *testing.T) {
+	g := &Graph{
+		Nodes: []Node{
+			{ID: "a.thrift", Kind: FileNode, File: "a.thrift"},
+			{ID: "a.thrift:Foo", Kind: ServiceNode, File: "a.thrift", Name: "Foo"},
+			{ID: "b/c.thrift", Kind: FileNode, File: "b/c.thrift"},
+			{ID: "b/c.thrift:Bar", Kind: StructNode, File: "b/c.thrift", Name: "Bar"},
+		},
+		Edges: []Edge{
+			{From: "a.thrift", To: "b/c.thrift", Kind: IncludesEdge},
+			{From: "a.thrift:Foo", To: "b/c.thrift:Bar", Kind: ReferencesEdge},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, g.WriteDOT(&buf))
+	assert.Equal(t, `digraph thrift {
+	rankdir=LR;
+	subgraph "cluster_a.thrift" {
+		label="a.thrift";
+		"a.thrift" [label="a.thrift", shape=note];
+		"a.thrift:Foo" [label="service Foo", shape=component];
+	}
+	subgraph "cluster_b/c.thrift" {
+		label="b/c.thrift";
+		"b/c.thrift" [label="c.thrift", shape=note];
+		"b/c.thrift:Bar" [label="struct Bar", shape=box];
+	}
+	"a.thrift" -> "b/c.thrift" [style=dashed];
+	"a.thrift:Foo" -> "b/c.thrift:Bar";
+}
+`, buf.String())
+}
+
+func TestWriteJSON(t *testing.T) {
+	g := &Graph{
+		Nodes: []Node{
+			{ID: "a.thrift", Kind: FileNode, File: "a.thrift"},
+			{ID: "a.thrift:Foo", Kind: ServiceNode, File: "a.thrift", Name: "Foo"},
+		},
+		Edges: []Edge{},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, g.WriteJSON(&buf))
+	assert.Equal(t, `{
+  "nodes": [
+    {
+      "id": "a.thrift",
+      "kind": "file",
+      "file": "a.thrift"
+    },
+    {
+      "id": "a.thrift:Foo",
+      "kind": "service",
+      "file": "a.thrift",
+      "name": "Foo"
+    }
+  ],
+  "edges": []
+}
+`, buf.String())
+}
+
+func TestDOTQuote(t *testing.T) {
+	assert.Equal(t, `"a\"b\\c"`, dotQuote(`a"b\c`))
+}
@@ -0,0 +1,190 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package owners reports the owners of Thrift definitions declared with the
+// owner annotation.
+//
+//   service Payments {
+//     ...
+//   } (owner = "team-payments")
+//
+// Multiple owners may be listed separated by commas.
+package owners
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// Key of the annotation which lists the owners of a definition.
+const ownerKey = "owner"
+
+// Entry records the owners of a single service or type.
+type Entry struct {
+	// Path to the Thrift file relative to the root directory, separated by
+	// forward slashes.
+	File string
+
+	// Name of the service or type.
+	Definition string
+
+	// Owners listed in the annotation, in order.
+	Owners []string
+}
+
+// Collect returns the owned definitions of the given modules and the
+// modules they include, sorted by file and name. Paths are relative to the
+// given root directory, and modules outside it are skipped.
+func Collect(root string, modules []*compile.Module) ([]Entry, error) {
+	var entries []Entry
+	visited := make(map[string]struct{})
+	visit := func(m *compile.Module) error {
+		if _, ok := visited[m.ThriftPath]; ok {
+			return nil
+		}
+		visited[m.ThriftPath] = struct{}{}
+
+		path, err := filepath.Rel(root, m.ThriftPath)
+		if err != nil {
+			return fmt.Errorf("could not resolve path for %q: %v", m.ThriftPath, err)
+		}
+		if strings.HasPrefix(path, "..") {
+			return nil
+		}
+		path = filepath.ToSlash(path)
+
+		names := make([]string, 0, len(m.Services)+len(m.Types))
+		annotations := make(map[string]compile.Annotations)
+		for name, s := range m.Services {
+			names = append(names, name)
+			annotations[name] = s.Annotations
+		}
+		for name, t := range m.Types {
+			names = append(names, name)
+			annotations[name] = t.ThriftAnnotations()
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			value, ok := annotations[name][ownerKey]
+			if !ok {
+				continue
+			}
+
+			owners := splitOwners(value)
+			if len(owners) == 0 {
+				return fmt.Errorf("%v: the %v annotation of %q must not be empty", path, ownerKey, name)
+			}
+			entries = append(entries, Entry{File: path, Definition: name, Owners: owners})
+		}
+		return nil
+	}
+
+	for _, m := range modules {
+		if err := m.Walk(visit); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Stable(entriesByFile(entries))
+	return entries, nil
+}
+
+func splitOwners(s string) []string {
+	var owners []string
+	for _, o := range strings.Split(s, ",") {
+		if o = strings.TrimSpace(o); o != "" {
+			owners = append(owners, o)
+		}
+	}
+	return owners
+}
+
+// WriteReport writes a table of the given entries to the given writer.
+func WriteReport(w io.Writer, entries []Entry) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(tw, "FILE\tDEFINITION\tOWNERS")
+	for _, e := range entries {
+		fmt.Fprintf(tw, "%v\t%v\t%v\n", e.File, e.Definition, strings.Join(e.Owners, ", "))
+	}
+	return tw.Flush()
+}
+
+// WriteCodeOwners writes a CODEOWNERS fragment which assigns each Thrift
+// file to the owners of its definitions. Paths are prefixed with the given
+// directory, which is the location of the root directory relative to the
+// root of the repository.
+//
+// Owners which do not start with "@" and are not email addresses are
+// prefixed with "@".
+func WriteCodeOwners(w io.Writer, dir string, entries []Entry) error {
+	dir = strings.Trim(filepath.ToSlash(dir), "/")
+	if dir != "" && dir != "." {
+		dir = "/" + dir
+	} else {
+		dir = ""
+	}
+
+	var (
+		files  []string
+		owners = make(map[string][]string)
+	)
+	for _, e := range entries {
+		if _, ok := owners[e.File]; !ok {
+			files = append(files, e.File)
+		}
+		for _, o := range e.Owners {
+			if !strings.Contains(o, "@") {
+				o = "@" + o
+			}
+			if !containsString(owners[e.File], o) {
+				owners[e.File] = append(owners[e.File], o)
+			}
+		}
+	}
+
+	for _, f := range files {
+		if _, err := fmt.Fprintf(w, "%v/%v %v\n", dir, f, strings.Join(owners[f], " ")); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func containsString(ss []string, s string) bool {
+	for _, x := range ss {
+		if x == s {
+			return true
+		}
+	}
+	return false
+}
+
+type entriesByFile []Entry
+
+func (es entriesByFile) Len() int           { return len(es) }
+func (es entriesByFile) Less(i, j int) bool { return es[i].File < es[j].File }
+func (es entriesByFile) Swap(i, j int)      { es[i], es[j] = es[j], es[i] }
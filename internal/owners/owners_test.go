@@ -0,0 +1,118 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package owners
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOwners(t *testing.T) {
+	root, err := ioutil.TempDir("", "thriftrw-owners-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	files := map[string]string{
+		"payments.thrift": `
+			include "shared/money.thrift"
+
+			struct Charge {
+				1: required money.Amount amount
+			} (owner = "team-payments")
+
+			struct Unowned {}
+
+			service Payments {
+				Charge charge(1: money.Amount amount)
+			} (owner = "team-payments, @org/billing")
+		`,
+		"shared/money.thrift": `
+			struct Amount {
+				1: required i64 cents
+			} (owner = "finance@example.com")
+		`,
+	}
+	for name, contents := range files {
+		path := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, ioutil.WriteFile(path, []byte(contents), 0644))
+	}
+
+	module, err := compile.Compile(filepath.Join(root, "payments.thrift"))
+	require.NoError(t, err)
+
+	entries, err := Collect(root, []*compile.Module{module})
+	require.NoError(t, err)
+	assert.Equal(t, []Entry{
+		{File: "payments.thrift", Definition: "Charge", Owners: []string{"team-payments"}},
+		{File: "payments.thrift", Definition: "Payments", Owners: []string{"team-payments", "@org/billing"}},
+		{File: "shared/money.thrift", Definition: "Amount", Owners: []string{"finance@example.com"}},
+	}, entries)
+
+	t.Run("report", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, WriteReport(&buf, entries))
+		assert.Equal(t,
+			"FILE                 DEFINITION  OWNERS\n"+
+				"payments.thrift      Charge      team-payments\n"+
+				"payments.thrift      Payments    team-payments, @org/billing\n"+
+				"shared/money.thrift  Amount      finance@example.com\n",
+			buf.String())
+	})
+
+	t.Run("codeowners", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, WriteCodeOwners(&buf, "idl", entries))
+		assert.Equal(t,
+			"/idl/payments.thrift @team-payments @org/billing\n"+
+				"/idl/shared/money.thrift finance@example.com\n",
+			buf.String())
+	})
+
+	t.Run("codeowners at the root", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, WriteCodeOwners(&buf, ".", entries[2:]))
+		assert.Equal(t, "/shared/money.thrift finance@example.com\n", buf.String())
+	})
+}
+
+func TestOwnersEmpty(t *testing.T) {
+	root, err := ioutil.TempDir("", "thriftrw-owners-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	path := filepath.Join(root, "foo.thrift")
+	require.NoError(t, ioutil.WriteFile(path, []byte(`struct Foo {} (owner = " , ")`), 0644))
+
+	module, err := compile.Compile(path)
+	require.NoError(t, err)
+
+	_, err = Collect(root, []*compile.Module{module})
+	assert.EqualError(t, err, `foo.thrift: the owner annotation of "Foo" must not be empty`)
+}
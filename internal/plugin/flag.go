@@ -40,8 +40,9 @@ const _pluginExecPrefix = "thriftrw-plugin-"
 // Plugin specifications received over the command line are simply plugin
 // names followed by arguments for the plugin.
 //
-// An executable with the name thriftrw-plugin-$name is expected on the $PATH.
-// Remaining arguments are passed to the program. For example,
+// An executable with the name thriftrw-plugin-$name is expected in one of the
+// directories searched for plugins, which include the $PATH. Remaining
+// arguments are passed to the program. For example,
 //
 // 	-p "foo -a --bc"
 //
@@ -84,7 +85,7 @@ func (f *Flag) UnmarshalFlag(value string) error {
 
 	f.Name = tokens[0]
 	exe := _pluginExecPrefix + f.Name
-	path, err := exec.LookPath(exe)
+	path, err := lookPath(exe)
 	if err != nil {
 		return fmt.Errorf("invalid plugin %q: could not find executable %q: %v", value, exe, err)
 	}
@@ -0,0 +1,86 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package plugin
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// _pluginPathEnv is the environment variable listing directories that are
+// searched for plugins before any others.
+const _pluginPathEnv = "THRIFTRW_PLUGIN_PATH"
+
+// lookPath finds the plugin executable with the given name.
+//
+// The following directories are searched before $PATH, in order:
+//
+//  1. Directories listed in $THRIFTRW_PLUGIN_PATH.
+//  2. When running under "go generate", the bin directory of the Go module
+//     being generated, which is found by looking for a go.mod in the current
+//     directory and its parents. Plugins listed in a tools.go may be
+//     installed there with "GOBIN=$PWD/bin go install".
+func lookPath(exe string) (string, error) {
+	for _, dir := range searchDirs() {
+		if path, err := exec.LookPath(filepath.Join(dir, exe)); err == nil {
+			return path, nil
+		}
+	}
+	return exec.LookPath(exe)
+}
+
+// searchDirs returns the directories searched for plugins before $PATH.
+func searchDirs() []string {
+	var dirs []string
+	for _, dir := range filepath.SplitList(os.Getenv(_pluginPathEnv)) {
+		if dir != "" {
+			dirs = append(dirs, dir)
+		}
+	}
+
+	// go generate sets $GOFILE to the file holding the directive.
+	if os.Getenv("GOFILE") != "" {
+		if cwd, err := os.Getwd(); err == nil {
+			if root, ok := findModuleRoot(cwd); ok {
+				dirs = append(dirs, filepath.Join(root, "bin"))
+			}
+		}
+	}
+
+	return dirs
+}
+
+// findModuleRoot returns the closest directory to dir, starting with dir
+// itself, that contains a go.mod.
+func findModuleRoot(dir string) (string, bool) {
+	for {
+		if info, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil && !info.IsDir() {
+			return dir, true
+		}
+
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+		dir = parent
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package plugin
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setenv(k, v string) func() {
+	old, ok := os.LookupEnv(k)
+	os.Setenv(k, v)
+	return func() {
+		if ok {
+			os.Setenv(k, old)
+		} else {
+			os.Unsetenv(k)
+		}
+	}
+}
+
+// writeExecutable writes an executable file at the given path, creating its
+// parent directories.
+func writeExecutable(t *testing.T, path string) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+	require.NoError(t, ioutil.WriteFile(path, []byte("#!/bin/sh\n"), 0755))
+}
+
+func TestLookPathPluginPath(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "thriftrw-plugin-path")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	first := filepath.Join(tmpDir, "first")
+	second := filepath.Join(tmpDir, "second")
+	writeExecutable(t, filepath.Join(second, "thriftrw-plugin-empty"))
+
+	// A file that cannot be executed is skipped.
+	require.NoError(t, os.MkdirAll(first, 0755))
+	require.NoError(t, ioutil.WriteFile(
+		filepath.Join(first, "thriftrw-plugin-empty"), nil, 0644))
+
+	defer prependToPath(testdata(t))()
+	defer setenv(_pluginPathEnv, first+string(os.PathListSeparator)+second)()
+
+	path, err := lookPath("thriftrw-plugin-empty")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(second, "thriftrw-plugin-empty"), path,
+		"$THRIFTRW_PLUGIN_PATH must take precedence over $PATH")
+
+	path, err = lookPath("thriftrw-plugin-handshake-failed")
+	require.NoError(t, err)
+	assert.Equal(t, testdata(t, "thriftrw-plugin-handshake-failed"), path,
+		"must fall back to $PATH")
+}
+
+func TestLookPathGoGenerate(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "thriftrw-go-generate")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	// Resolve symlinks in the temporary directory so that it matches the
+	// working directory.
+	tmpDir, err = filepath.EvalSymlinks(tmpDir)
+	require.NoError(t, err)
+
+	require.NoError(t, ioutil.WriteFile(
+		filepath.Join(tmpDir, "go.mod"), []byte("module example.com/foo\n"), 0644))
+	writeExecutable(t, filepath.Join(tmpDir, "bin", "thriftrw-plugin-local"))
+
+	pkgDir := filepath.Join(tmpDir, "internal", "foo")
+	require.NoError(t, os.MkdirAll(pkgDir, 0755))
+
+	cwd, err := os.Getwd()
+	require.NoError(t, err)
+	require.NoError(t, os.Chdir(pkgDir))
+	defer os.Chdir(cwd)
+
+	defer setenv("GOFILE", "")()
+	_, err = lookPath("thriftrw-plugin-local")
+	assert.Error(t, err, "the module's bin directory must only be used by go generate")
+
+	defer setenv("GOFILE", "foo.go")()
+	path, err := lookPath("thriftrw-plugin-local")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(tmpDir, "bin", "thriftrw-plugin-local"), path)
+}
+
+func TestLookPathIgnoresExecutableDir(t *testing.T) {
+	exe, err := filepath.Abs(os.Args[0])
+	require.NoError(t, err)
+
+	path := filepath.Join(filepath.Dir(exe), "thriftrw-plugin-beside")
+	writeExecutable(t, path)
+	defer os.Remove(path)
+
+	defer setenv("GOFILE", "")()
+	_, err = lookPath("thriftrw-plugin-beside")
+	assert.Error(t, err, "the directory holding the executable must not be searched")
+}
+
+func TestFindModuleRoot(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "thriftrw-module-root")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	nested := filepath.Join(tmpDir, "a", "b")
+	require.NoError(t, os.MkdirAll(nested, 0755))
+
+	// A directory named go.mod does not mark a module.
+	require.NoError(t, os.Mkdir(filepath.Join(tmpDir, "a", "go.mod"), 0755))
+
+	root, _ := findModuleRoot(nested)
+	assert.NotEqual(t, filepath.Join(tmpDir, "a"), root)
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(tmpDir, "go.mod"), nil, 0644))
+	root, ok := findModuleRoot(nested)
+	assert.True(t, ok)
+	assert.Equal(t, tmpDir, root)
+}
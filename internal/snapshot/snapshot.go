@@ -0,0 +1,266 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package snapshot records the shapes of Thrift structs, unions, and
+// exceptions so that changes which affect their wire representation are
+// made deliberately.
+//
+// A snapshot is a JSON file which lists the ID, name, type, and
+// requiredness of every field of every struct. It is meant to be checked in
+// next to the Thrift files. Code generation fails while the Thrift files do
+// not match the snapshot, so changes to the shape of a struct must be
+// accompanied by an update of the snapshot, which shows up in code review.
+package snapshot
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+)
+
+// Snapshot holds the shapes of a set of structs.
+type Snapshot struct {
+	// Structs sorted by file and name.
+	Structs []Struct `json:"structs"`
+}
+
+// Struct is the shape of a struct, union, or exception.
+type Struct struct {
+	// Path to the Thrift file relative to the root directory, separated by
+	// forward slashes.
+	File string `json:"file"`
+
+	Name string `json:"name"`
+	Kind string `json:"kind"` // struct, union, or exception
+
+	// Fields sorted by ID.
+	Fields []Field `json:"fields"`
+}
+
+// Field is the shape of a field of a struct.
+type Field struct {
+	ID   int16  `json:"id"`
+	Name string `json:"name"`
+
+	// Type of the field as written in the Thrift file, and the type with
+	// which it is sent over the wire. The latter catches changes to the
+	// targets of typedefs.
+	Type     string `json:"type"`
+	WireType string `json:"wireType"`
+
+	Required bool `json:"required,omitempty"`
+}
+
+// Take returns a snapshot of the structs of the given modules and the
+// modules they include. Paths are relative to the given root directory,
+// and modules outside it are skipped.
+func Take(root string, modules []*compile.Module) (*Snapshot, error) {
+	var structs []Struct
+	visited := make(map[string]struct{})
+	visit := func(m *compile.Module) error {
+		if _, ok := visited[m.ThriftPath]; ok {
+			return nil
+		}
+		visited[m.ThriftPath] = struct{}{}
+
+		path, err := filepath.Rel(root, m.ThriftPath)
+		if err != nil {
+			return fmt.Errorf("could not resolve path for %q: %v", m.ThriftPath, err)
+		}
+		if strings.HasPrefix(path, "..") {
+			return nil
+		}
+		path = filepath.ToSlash(path)
+
+		for _, t := range m.Types {
+			if s, ok := t.(*compile.StructSpec); ok {
+				structs = append(structs, structShape(path, s))
+			}
+		}
+		return nil
+	}
+
+	for _, m := range modules {
+		if err := m.Walk(visit); err != nil {
+			return nil, err
+		}
+	}
+
+	sort.Sort(structsByName(structs))
+	return &Snapshot{Structs: structs}, nil
+}
+
+func structShape(file string, s *compile.StructSpec) Struct {
+	fields := make([]Field, len(s.Fields))
+	for i, f := range s.Fields {
+		fields[i] = Field{
+			ID:       f.ID,
+			Name:     f.Name,
+			Type:     f.Type.ThriftName(),
+			WireType: f.Type.TypeCode().String(),
+			Required: f.Required,
+		}
+	}
+	sort.Sort(fieldsByID(fields))
+
+	return Struct{
+		File:   file,
+		Name:   s.Name,
+		Kind:   structKind(s.Type),
+		Fields: fields,
+	}
+}
+
+func structKind(t ast.StructureType) string {
+	switch t {
+	case ast.UnionType:
+		return "union"
+	case ast.ExceptionType:
+		return "exception"
+	default:
+		return "struct"
+	}
+}
+
+// Read reads a snapshot written by Write.
+func Read(r io.Reader) (*Snapshot, error) {
+	var s Snapshot
+	if err := json.NewDecoder(r).Decode(&s); err != nil {
+		return nil, fmt.Errorf("could not read snapshot: %v", err)
+	}
+	return &s, nil
+}
+
+// Write writes the snapshot as indented JSON.
+func (s *Snapshot) Write(w io.Writer) error {
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(b, '\n'))
+	return err
+}
+
+// Compare returns descriptions of the differences between a frozen snapshot
+// and the current one, or nil if they have the same shapes.
+func Compare(frozen, current *Snapshot) []string {
+	from := structsByKey(frozen)
+	to := structsByKey(current)
+
+	keys := make([]string, 0, len(from)+len(to))
+	for k := range from {
+		keys = append(keys, k)
+	}
+	for k := range to {
+		if _, ok := from[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var diffs []string
+	for _, k := range keys {
+		f, inFrom := from[k]
+		t, inTo := to[k]
+		switch {
+		case !inFrom:
+			diffs = append(diffs, fmt.Sprintf("%v: %v was added", k, t.Kind))
+		case !inTo:
+			diffs = append(diffs, fmt.Sprintf("%v: %v was removed", k, f.Kind))
+		default:
+			diffs = append(diffs, compareStructs(k, f, t)...)
+		}
+	}
+	return diffs
+}
+
+func compareStructs(key string, from, to Struct) []string {
+	var diffs []string
+	if from.Kind != to.Kind {
+		diffs = append(diffs, fmt.Sprintf("%v: changed from %v to %v", key, from.Kind, to.Kind))
+	}
+
+	fromFields := make(map[int16]Field, len(from.Fields))
+	for _, f := range from.Fields {
+		fromFields[f.ID] = f
+	}
+	toFields := make(map[int16]Field, len(to.Fields))
+	for _, f := range to.Fields {
+		toFields[f.ID] = f
+	}
+
+	for _, f := range from.Fields {
+		t, ok := toFields[f.ID]
+		if !ok {
+			diffs = append(diffs, fmt.Sprintf("%v: field %d (%v) was removed", key, f.ID, f.Name))
+			continue
+		}
+		if f != t {
+			diffs = append(diffs, fmt.Sprintf(
+				"%v: field %d changed from %v to %v", key, f.ID, describeField(f), describeField(t)))
+		}
+	}
+	for _, t := range to.Fields {
+		if _, ok := fromFields[t.ID]; !ok {
+			diffs = append(diffs, fmt.Sprintf("%v: field %d (%v) was added", key, t.ID, t.Name))
+		}
+	}
+	return diffs
+}
+
+// describeField describes a field the way it is written in Thrift files.
+func describeField(f Field) string {
+	required := "optional"
+	if f.Required {
+		required = "required"
+	}
+	return fmt.Sprintf("%v %v %v (%v)", required, f.Type, f.Name, f.WireType)
+}
+
+func structsByKey(s *Snapshot) map[string]Struct {
+	m := make(map[string]Struct, len(s.Structs))
+	for _, st := range s.Structs {
+		m[st.File+":"+st.Name] = st
+	}
+	return m
+}
+
+type structsByName []Struct
+
+func (ss structsByName) Len() int      { return len(ss) }
+func (ss structsByName) Swap(i, j int) { ss[i], ss[j] = ss[j], ss[i] }
+func (ss structsByName) Less(i, j int) bool {
+	if ss[i].File != ss[j].File {
+		return ss[i].File < ss[j].File
+	}
+	return ss[i].Name < ss[j].Name
+}
+
+type fieldsByID []Field
+
+func (fs fieldsByID) Len() int           { return len(fs) }
+func (fs fieldsByID) Less(i, j int) bool { return fs[i].ID < fs[j].ID }
+func (fs fieldsByID) Swap(i, j int)      { fs[i], fs[j] = fs[j], fs[i] }
@@ -0,0 +1,174 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package snapshot
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func takeSnapshot(t *testing.T, files map[string]string) *Snapshot {
+	root, err := ioutil.TempDir("", "thriftrw-snapshot-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(root)
+
+	for name, contents := range files {
+		path := filepath.Join(root, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, ioutil.WriteFile(path, []byte(contents), 0644))
+	}
+
+	module, err := compile.Compile(filepath.Join(root, "payments.thrift"))
+	require.NoError(t, err)
+
+	s, err := Take(root, []*compile.Module{module})
+	require.NoError(t, err)
+	return s
+}
+
+const moneyThrift = `
+	typedef i64 Cents
+
+	struct Amount {
+		1: required Cents cents
+	}
+`
+
+func TestTake(t *testing.T) {
+	s := takeSnapshot(t, map[string]string{
+		"payments.thrift": `
+			include "shared/money.thrift"
+
+			union Method {
+				2: string card
+				1: string bank
+			}
+
+			exception ChargeFailed {}
+
+			struct Charge {
+				1: required money.Amount amount
+				2: optional list<Method> methods
+			}
+		`,
+		"shared/money.thrift": moneyThrift,
+	})
+
+	assert.Equal(t, &Snapshot{Structs: []Struct{
+		{
+			File: "payments.thrift",
+			Name: "Charge",
+			Kind: "struct",
+			Fields: []Field{
+				{ID: 1, Name: "amount", Type: "Amount", WireType: "TStruct", Required: true},
+				{ID: 2, Name: "methods", Type: "list<Method>", WireType: "TList"},
+			},
+		},
+		{File: "payments.thrift", Name: "ChargeFailed", Kind: "exception", Fields: []Field{}},
+		{
+			File: "payments.thrift",
+			Name: "Method",
+			Kind: "union",
+			Fields: []Field{
+				{ID: 1, Name: "bank", Type: "string", WireType: "TBinary"},
+				{ID: 2, Name: "card", Type: "string", WireType: "TBinary"},
+			},
+		},
+		{
+			File: "shared/money.thrift",
+			Name: "Amount",
+			Kind: "struct",
+			Fields: []Field{
+				{ID: 1, Name: "cents", Type: "Cents", WireType: "TI64", Required: true},
+			},
+		},
+	}}, s)
+
+	var buf bytes.Buffer
+	require.NoError(t, s.Write(&buf))
+	read, err := Read(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, s, read, "snapshot must round trip")
+	assert.Empty(t, Compare(s, read))
+}
+
+func TestCompare(t *testing.T) {
+	frozen := takeSnapshot(t, map[string]string{
+		"payments.thrift": `
+			include "shared/money.thrift"
+
+			struct Charge {
+				1: required money.Amount amount
+				2: optional string description
+				3: optional string memo
+			}
+
+			struct Refund {}
+			exception Declined {}
+		`,
+		"shared/money.thrift": moneyThrift,
+	})
+
+	current := takeSnapshot(t, map[string]string{
+		"payments.thrift": `
+			include "shared/money.thrift"
+
+			struct Charge {
+				1: required money.Amount amount
+				2: required string description
+				4: optional string note
+			}
+
+			union Declined {}
+			struct Dispute {}
+		`,
+		"shared/money.thrift": `
+			typedef i32 Cents
+
+			struct Amount {
+				1: required Cents cents
+			}
+		`,
+	})
+
+	assert.Equal(t, []string{
+		`payments.thrift:Charge: field 2 changed from optional string description (TBinary) to required string description (TBinary)`,
+		`payments.thrift:Charge: field 3 (memo) was removed`,
+		`payments.thrift:Charge: field 4 (note) was added`,
+		`payments.thrift:Declined: changed from exception to union`,
+		`payments.thrift:Dispute: struct was added`,
+		`payments.thrift:Refund: struct was removed`,
+		`shared/money.thrift:Amount: field 1 changed from required Cents cents (TI64) to required Cents cents (TI32)`,
+	}, Compare(frozen, current))
+}
+
+func TestReadInvalid(t *testing.T) {
+	_, err := Read(bytes.NewBufferString("{"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,88 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package templates
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// contextLines is the number of lines shown around the offending line of a
+// template.
+const contextLines = 2
+
+// Errors reported by text/template start with the template name and the line
+// number.
+//
+// 	template: foo:12: function "bar" not defined
+// 	template: foo:12:3: executing "foo" at <bar>: error calling bar: ...
+var _errorLine = regexp.MustCompile(`^template: [^:]*:(\d+):`)
+
+// AnnotateError adds the lines of the template source surrounding the line
+// at which the given text/template error occurred to the error message.
+//
+// Errors that don't include a line number are returned unchanged.
+func AnnotateError(err error, src string) error {
+	if err == nil {
+		return nil
+	}
+
+	m := _errorLine.FindStringSubmatch(err.Error())
+	if m == nil {
+		return err
+	}
+
+	line, perr := strconv.Atoi(m[1])
+	if perr != nil {
+		return err
+	}
+	return AnnotateLine(err, src, line)
+}
+
+// AnnotateLine adds the lines of src surrounding the given 1-indexed line to
+// the message of err.
+//
+// err is returned unchanged if the line is out of range.
+func AnnotateLine(err error, src string, line int) error {
+	lines := strings.Split(src, "\n")
+	if line < 1 || line > len(lines) {
+		return err
+	}
+
+	var buff bytes.Buffer
+	buff.WriteString(err.Error())
+	for i := line - contextLines; i <= line+contextLines; i++ {
+		if i < 1 || i > len(lines) {
+			continue
+		}
+
+		marker := " "
+		if i == line {
+			marker = ">"
+		}
+		fmt.Fprintf(&buff, "\n\t%v %4d| %v", marker, i, lines[i-1])
+	}
+	return errors.New(buff.String())
+}
@@ -0,0 +1,96 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package templates
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnnotateError(t *testing.T) {
+	const src = "one\ntwo\nthree <foo>\nfour\nfive\nsix"
+
+	tests := []struct {
+		desc string
+		give error
+		want string
+	}{
+		{
+			desc: "nil",
+		},
+		{
+			desc: "no line number",
+			give: errors.New("great sadness"),
+			want: "great sadness",
+		},
+		{
+			desc: "line out of range",
+			give: errors.New("template: foo:42: great sadness"),
+			want: "template: foo:42: great sadness",
+		},
+		{
+			desc: "first line",
+			give: errors.New("template: foo:1: great sadness"),
+			want: "template: foo:1: great sadness\n" +
+				"\t>    1| one\n" +
+				"\t     2| two\n" +
+				"\t     3| three <foo>",
+		},
+		{
+			desc: "exec error",
+			give: errors.New(`template: foo:4:2: executing "foo" at <bar>: great sadness`),
+			want: `template: foo:4:2: executing "foo" at <bar>: great sadness` + "\n" +
+				"\t     2| two\n" +
+				"\t     3| three <foo>\n" +
+				"\t>    4| four\n" +
+				"\t     5| five\n" +
+				"\t     6| six",
+		},
+	}
+
+	for _, tt := range tests {
+		err := AnnotateError(tt.give, src)
+		if tt.want == "" {
+			assert.NoError(t, err, tt.desc)
+			continue
+		}
+		assert.Equal(t, tt.want, err.Error(), tt.desc)
+	}
+}
+
+func TestAnnotateErrorFromTemplate(t *testing.T) {
+	const src = "package foo\n\nvar x = <fail>\n"
+
+	tmpl, err := template.New("foo").Delims("<", ">").Funcs(template.FuncMap{
+		"fail": func() (string, error) { return "", errors.New("great sadness") },
+	}).Parse(src)
+	assert.NoError(t, err)
+
+	err = AnnotateError(tmpl.Execute(&bytes.Buffer{}, nil), src)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "great sadness")
+		assert.Contains(t, err.Error(), "\t>    3| var x = <fail>")
+	}
+}
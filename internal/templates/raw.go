@@ -0,0 +1,85 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package templates provides the extensions to text/template shared by the
+// code generator and by plugins. Templates use "<" and ">" as delimiters.
+package templates
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	rawOpen  = "<raw>"
+	rawClose = "<endraw>"
+)
+
+// ExpandRaw rewrites raw blocks in the given template source into actions
+// which print their contents verbatim.
+//
+// Raw blocks start with <raw> and end with <endraw>. Their contents are not
+// interpreted by the template engine so they may freely contain the
+// delimiters.
+//
+// 	<raw>if a<b && c>d {<endraw>
+//
+// Line numbers in the expanded template match those in the source so that
+// errors reported by text/template point to the right line.
+func ExpandRaw(src string) (string, error) {
+	if !strings.Contains(src, rawOpen) {
+		return src, nil
+	}
+
+	var (
+		buff bytes.Buffer
+		line = 1
+	)
+	for {
+		i := strings.Index(src, rawOpen)
+		if i < 0 {
+			break
+		}
+		line += strings.Count(src[:i], "\n")
+		buff.WriteString(src[:i])
+		src = src[i+len(rawOpen):]
+
+		j := strings.Index(src, rawClose)
+		if j < 0 {
+			return "", fmt.Errorf("line %d: %v block is never closed with %v", line, rawOpen, rawClose)
+		}
+
+		for k, l := range strings.Split(src[:j], "\n") {
+			if k > 0 {
+				buff.WriteByte('\n')
+				line++
+			}
+			if len(l) > 0 {
+				fmt.Fprintf(&buff, "<%v>", strconv.Quote(l))
+			}
+		}
+		src = src[j+len(rawClose):]
+	}
+
+	buff.WriteString(src)
+	return buff.String(), nil
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package templates
+
+import (
+	"bytes"
+	"testing"
+	"text/template"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandRaw(t *testing.T) {
+	tests := []struct {
+		desc string
+		give string
+		data interface{}
+		want string
+	}{
+		{
+			desc: "no raw blocks",
+			give: `x := <.>`,
+			data: 42,
+			want: `x := 42`,
+		},
+		{
+			desc: "single line",
+			give: `if <raw>a<b && c>d<endraw> { return <.> }`,
+			data: "foo",
+			want: `if a<b && c>d { return foo }`,
+		},
+		{
+			desc: "multiple lines",
+			give: "<raw>x := a <\n\n\tb<endraw>\n<.>",
+			data: "foo",
+			want: "x := a <\n\n\tb\nfoo",
+		},
+		{
+			desc: "multiple blocks",
+			give: `<raw><<endraw><.><raw>><endraw>`,
+			data: "T",
+			want: `<T>`,
+		},
+		{
+			desc: "quotes and actions",
+			give: "<raw>\"<.>\" `<if>`<endraw>",
+			want: "\"<.>\" `<if>`",
+		},
+	}
+
+	for _, tt := range tests {
+		src, err := ExpandRaw(tt.give)
+		require.NoError(t, err, tt.desc)
+
+		tmpl, err := template.New("test").Delims("<", ">").Parse(src)
+		require.NoError(t, err, tt.desc)
+
+		var buff bytes.Buffer
+		require.NoError(t, tmpl.Execute(&buff, tt.data), tt.desc)
+		assert.Equal(t, tt.want, buff.String(), tt.desc)
+	}
+}
+
+func TestExpandRawKeepsLineNumbers(t *testing.T) {
+	src, err := ExpandRaw("<raw>a\nb\n<endraw>\nc<raw>d\ne<endraw>\n<foo>")
+	require.NoError(t, err)
+
+	_, err = template.New("test").Delims("<", ">").Parse(src)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "test:6:")
+	}
+}
+
+func TestExpandRawUnterminated(t *testing.T) {
+	_, err := ExpandRaw("foo\n<raw>bar<endraw>\nbaz <raw>\nqux")
+	if assert.Error(t, err) {
+		assert.Equal(t, "line 3: <raw> block is never closed with <endraw>", err.Error())
+	}
+}
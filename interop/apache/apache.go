@@ -0,0 +1,323 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// +build apachethrift
+
+// Package apache implements protocol.Protocol with the Binary protocol of
+// the Apache Thrift Go library so that thriftrw may be checked against it
+// with the interop package.
+//
+// This package depends on git.apache.org/thrift.git/lib/go/thrift, which
+// thriftrw does not otherwise need. It is built only with the apachethrift
+// build tag.
+//
+//   go test -tags apachethrift go.uber.org/thriftrw/interop/...
+package apache
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"git.apache.org/thrift.git/lib/go/thrift"
+)
+
+// Binary is the Binary protocol of the Apache Thrift Go library.
+var Binary protocol.Protocol = binaryProtocol{}
+
+type binaryProtocol struct{}
+
+func (binaryProtocol) Encode(v wire.Value, w io.Writer) error {
+	return encode(w, func(p thrift.TProtocol) error {
+		return writeValue(p, v)
+	})
+}
+
+func (binaryProtocol) EncodeEnveloped(e wire.Envelope, w io.Writer) error {
+	return encode(w, func(p thrift.TProtocol) error {
+		if err := p.WriteMessageBegin(e.Name, thrift.TMessageType(e.Type), e.SeqID); err != nil {
+			return err
+		}
+		if err := writeValue(p, e.Value); err != nil {
+			return err
+		}
+		return p.WriteMessageEnd()
+	})
+}
+
+func (binaryProtocol) Decode(r io.ReaderAt, t wire.Type) (wire.Value, error) {
+	p, err := decoder(r)
+	if err != nil {
+		return wire.Value{}, err
+	}
+	return readValue(p, t)
+}
+
+func (binaryProtocol) DecodeEnveloped(r io.ReaderAt) (wire.Envelope, error) {
+	p, err := decoder(r)
+	if err != nil {
+		return wire.Envelope{}, err
+	}
+
+	name, typ, seqID, err := p.ReadMessageBegin()
+	if err != nil {
+		return wire.Envelope{}, err
+	}
+
+	v, err := readValue(p, wire.TStruct)
+	if err != nil {
+		return wire.Envelope{}, err
+	}
+
+	e := wire.Envelope{Name: name, Type: wire.EnvelopeType(typ), SeqID: seqID, Value: v}
+	return e, p.ReadMessageEnd()
+}
+
+// encode writes to w what f writes to a TBinaryProtocol.
+func encode(w io.Writer, f func(thrift.TProtocol) error) error {
+	buf := thrift.NewTMemoryBuffer()
+	p := thrift.NewTBinaryProtocolTransport(buf)
+	if err := f(p); err != nil {
+		return err
+	}
+	if err := p.Flush(); err != nil {
+		return err
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// decoder returns a TBinaryProtocol which reads the contents of r.
+func decoder(r io.ReaderAt) (thrift.TProtocol, error) {
+	b, err := ioutil.ReadAll(io.NewSectionReader(r, 0, math.MaxInt64))
+	if err != nil {
+		return nil, err
+	}
+
+	buf := thrift.NewTMemoryBuffer()
+	if _, err := buf.Write(b); err != nil {
+		return nil, err
+	}
+	return thrift.NewTBinaryProtocolTransport(buf), nil
+}
+
+// wire.Type values match the TType values of Apache Thrift.
+
+func writeValue(p thrift.TProtocol, v wire.Value) error {
+	switch v.Type() {
+	case wire.TBool:
+		return p.WriteBool(v.GetBool())
+	case wire.TI8:
+		return p.WriteByte(v.GetI8())
+	case wire.TDouble:
+		return p.WriteDouble(v.GetDouble())
+	case wire.TI16:
+		return p.WriteI16(v.GetI16())
+	case wire.TI32:
+		return p.WriteI32(v.GetI32())
+	case wire.TI64:
+		return p.WriteI64(v.GetI64())
+	case wire.TBinary:
+		return p.WriteBinary(v.GetBinary())
+	case wire.TStruct:
+		return writeStruct(p, v.GetStruct())
+	case wire.TMap:
+		return writeMap(p, v.GetMap())
+	case wire.TSet:
+		s := v.GetSet()
+		if err := p.WriteSetBegin(thrift.TType(s.ValueType()), s.Size()); err != nil {
+			return err
+		}
+		if err := s.ForEach(func(v wire.Value) error { return writeValue(p, v) }); err != nil {
+			return err
+		}
+		return p.WriteSetEnd()
+	case wire.TList:
+		l := v.GetList()
+		if err := p.WriteListBegin(thrift.TType(l.ValueType()), l.Size()); err != nil {
+			return err
+		}
+		if err := l.ForEach(func(v wire.Value) error { return writeValue(p, v) }); err != nil {
+			return err
+		}
+		return p.WriteListEnd()
+	default:
+		return fmt.Errorf("%v is not supported by Apache Thrift", v.Type())
+	}
+}
+
+func writeStruct(p thrift.TProtocol, s wire.Struct) error {
+	if err := p.WriteStructBegin(""); err != nil {
+		return err
+	}
+	for _, f := range s.Fields {
+		if err := p.WriteFieldBegin("", thrift.TType(f.Value.Type()), f.ID); err != nil {
+			return err
+		}
+		if err := writeValue(p, f.Value); err != nil {
+			return err
+		}
+		if err := p.WriteFieldEnd(); err != nil {
+			return err
+		}
+	}
+	if err := p.WriteFieldStop(); err != nil {
+		return err
+	}
+	return p.WriteStructEnd()
+}
+
+func writeMap(p thrift.TProtocol, m wire.MapItemList) error {
+	if err := p.WriteMapBegin(thrift.TType(m.KeyType()), thrift.TType(m.ValueType()), m.Size()); err != nil {
+		return err
+	}
+	err := m.ForEach(func(item wire.MapItem) error {
+		if err := writeValue(p, item.Key); err != nil {
+			return err
+		}
+		return writeValue(p, item.Value)
+	})
+	if err != nil {
+		return err
+	}
+	return p.WriteMapEnd()
+}
+
+func readValue(p thrift.TProtocol, t wire.Type) (wire.Value, error) {
+	switch t {
+	case wire.TBool:
+		v, err := p.ReadBool()
+		return wire.NewValueBool(v), err
+	case wire.TI8:
+		v, err := p.ReadByte()
+		return wire.NewValueI8(v), err
+	case wire.TDouble:
+		v, err := p.ReadDouble()
+		return wire.NewValueDouble(v), err
+	case wire.TI16:
+		v, err := p.ReadI16()
+		return wire.NewValueI16(v), err
+	case wire.TI32:
+		v, err := p.ReadI32()
+		return wire.NewValueI32(v), err
+	case wire.TI64:
+		v, err := p.ReadI64()
+		return wire.NewValueI64(v), err
+	case wire.TBinary:
+		v, err := p.ReadBinary()
+		return wire.NewValueBinary(v), err
+	case wire.TStruct:
+		return readStruct(p)
+	case wire.TMap:
+		return readMap(p)
+	case wire.TSet:
+		typ, size, err := p.ReadSetBegin()
+		if err != nil {
+			return wire.Value{}, err
+		}
+		items, err := readValues(p, wire.Type(typ), size)
+		if err != nil {
+			return wire.Value{}, err
+		}
+		return wire.NewValueSet(wire.ValueListFromSlice(wire.Type(typ), items)), p.ReadSetEnd()
+	case wire.TList:
+		typ, size, err := p.ReadListBegin()
+		if err != nil {
+			return wire.Value{}, err
+		}
+		items, err := readValues(p, wire.Type(typ), size)
+		if err != nil {
+			return wire.Value{}, err
+		}
+		return wire.NewValueList(wire.ValueListFromSlice(wire.Type(typ), items)), p.ReadListEnd()
+	default:
+		return wire.Value{}, fmt.Errorf("%v is not supported by Apache Thrift", t)
+	}
+}
+
+func readStruct(p thrift.TProtocol) (wire.Value, error) {
+	if _, err := p.ReadStructBegin(); err != nil {
+		return wire.Value{}, err
+	}
+
+	var fields []wire.Field
+	for {
+		_, typ, id, err := p.ReadFieldBegin()
+		if err != nil {
+			return wire.Value{}, err
+		}
+		if typ == thrift.STOP {
+			break
+		}
+
+		v, err := readValue(p, wire.Type(typ))
+		if err != nil {
+			return wire.Value{}, err
+		}
+		fields = append(fields, wire.Field{ID: id, Value: v})
+
+		if err := p.ReadFieldEnd(); err != nil {
+			return wire.Value{}, err
+		}
+	}
+
+	return wire.NewValueStruct(wire.Struct{Fields: fields}), p.ReadStructEnd()
+}
+
+func readMap(p thrift.TProtocol) (wire.Value, error) {
+	kt, vt, size, err := p.ReadMapBegin()
+	if err != nil {
+		return wire.Value{}, err
+	}
+
+	items := make([]wire.MapItem, size)
+	for i := range items {
+		k, err := readValue(p, wire.Type(kt))
+		if err != nil {
+			return wire.Value{}, err
+		}
+
+		v, err := readValue(p, wire.Type(vt))
+		if err != nil {
+			return wire.Value{}, err
+		}
+
+		items[i] = wire.MapItem{Key: k, Value: v}
+	}
+
+	m := wire.NewValueMap(wire.MapItemListFromSlice(wire.Type(kt), wire.Type(vt), items))
+	return m, p.ReadMapEnd()
+}
+
+func readValues(p thrift.TProtocol, t wire.Type, size int) ([]wire.Value, error) {
+	items := make([]wire.Value, size)
+	for i := range items {
+		v, err := readValue(p, t)
+		if err != nil {
+			return nil, err
+		}
+		items[i] = v
+	}
+	return items, nil
+}
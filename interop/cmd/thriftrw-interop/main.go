@@ -0,0 +1,106 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// +build apachethrift
+
+// thriftrw-interop checks that values of the types declared in Thrift files
+// survive round trips between thriftrw and the Apache Thrift Go library.
+//
+// Random values of each type are encoded with thriftrw and decoded with
+// Apache Thrift, and vice versa. Values which do not match after the round
+// trip are reported and the command exits with a non-zero status.
+//
+//   go get -tags apachethrift go.uber.org/thriftrw/interop/cmd/thriftrw-interop
+//   thriftrw-interop --iterations=1000 idl/service.thrift
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/interop"
+	"go.uber.org/thriftrw/interop/apache"
+
+	"github.com/jessevdk/go-flags"
+)
+
+type options struct {
+	Iterations int   `long:"iterations" short:"n" default:"100" description:"Number of random values checked per type."`
+	Seed       int64 `long:"seed" default:"1" description:"Seed for the random values. Runs with the same seed check the same values."`
+	MaxSize    int   `long:"max-size" default:"5" description:"Maximum number of items in containers and bytes in strings."`
+	MaxDepth   int   `long:"max-depth" default:"4" description:"Maximum nesting of optional fields and containers."`
+}
+
+func main() {
+	if err := do(); err != nil {
+		log.Fatalf("%+v", err)
+	}
+}
+
+func do() error {
+	log.SetFlags(0) // don't include timestamps, etc. in the output
+
+	var opts options
+
+	parser := flags.NewParser(&opts, flags.Default & ^flags.PrintErrors)
+	parser.Usage = "[OPTIONS] FILE..."
+
+	args, err := parser.Parse()
+	if ferr, ok := err.(*flags.Error); ok && ferr.Type == flags.ErrHelp {
+		parser.WriteHelp(os.Stdout)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if len(args) == 0 {
+		var buffer bytes.Buffer
+		parser.WriteHelp(&buffer)
+		return errors.New(buffer.String())
+	}
+
+	var failed bool
+	for _, file := range args {
+		module, err := compile.Compile(file)
+		if err != nil {
+			return err
+		}
+
+		errs := interop.Run(module, apache.Binary, interop.Options{
+			Iterations: opts.Iterations,
+			Seed:       opts.Seed,
+			MaxSize:    opts.MaxSize,
+			MaxDepth:   opts.MaxDepth,
+		})
+		for _, err := range errs {
+			fmt.Printf("%v: %v\n", file, err)
+			failed = true
+		}
+	}
+
+	if failed {
+		return errors.New("found values which did not survive a round trip")
+	}
+	return nil
+}
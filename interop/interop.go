@@ -0,0 +1,201 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package interop checks that values encoded by thriftrw can be decoded by
+// another implementation of a Thrift protocol, and vice versa.
+//
+// Random values of the types of a Thrift file are encoded with one
+// implementation and decoded with the other. Values which do not survive
+// the round trip are reported as Mismatches.
+//
+//   module, err := compile.Compile("idl/service.thrift")
+//   ...
+//   for _, err := range interop.Run(module, peer, interop.Options{}) {
+//     log.Println(err)
+//   }
+//
+// The interop/apache package provides the Binary protocol of the Apache
+// Thrift Go library as a peer, and the thriftrw-interop command runs these
+// checks against it.
+package interop
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"go.uber.org/thriftrw/canonical"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Direction of a round trip between thriftrw and its peer.
+type Direction int
+
+const (
+	// ToPeer values are encoded by thriftrw and decoded by the peer.
+	ToPeer Direction = iota + 1
+
+	// FromPeer values are encoded by the peer and decoded by thriftrw.
+	FromPeer
+)
+
+func (d Direction) String() string {
+	switch d {
+	case ToPeer:
+		return "thriftrw to peer"
+	case FromPeer:
+		return "peer to thriftrw"
+	default:
+		return fmt.Sprintf("Direction(%d)", int(d))
+	}
+}
+
+// Mismatch is a value which did not survive a round trip between thriftrw
+// and its peer.
+type Mismatch struct {
+	// Type is the Thrift type of the value.
+	Type compile.TypeSpec
+
+	Direction Direction
+
+	// Value is the value that was encoded, and Got is the value that was
+	// decoded from it. Got is unset if the round trip failed with Err.
+	Value wire.Value
+	Got   wire.Value
+	Err   error
+}
+
+func (m *Mismatch) Error() string {
+	if m.Err != nil {
+		return fmt.Sprintf("%v: %v failed for %v: %v", m.Type.ThriftName(), m.Direction, m.Value, m.Err)
+	}
+	return fmt.Sprintf("%v: %v changed %v into %v", m.Type.ThriftName(), m.Direction, m.Value, m.Got)
+}
+
+// Check encodes the given value of the given type with thriftrw's Binary
+// protocol and decodes it with the given peer protocol, and vice versa. A
+// Mismatch is returned if the decoded values do not match the original
+// value.
+//
+// Values are compared in their canonical forms so struct fields, map
+// items, and set items may be written in any order.
+func Check(spec compile.TypeSpec, v wire.Value, peer protocol.Protocol) error {
+	want, err := canonical.Value(spec, v)
+	if err != nil {
+		return err
+	}
+
+	trips := []struct {
+		dir      Direction
+		enc, dec protocol.Protocol
+	}{
+		{dir: ToPeer, enc: protocol.Binary, dec: peer},
+		{dir: FromPeer, enc: peer, dec: protocol.Binary},
+	}
+	for _, trip := range trips {
+		got, err := roundTrip(spec, want, trip.enc, trip.dec)
+		if err != nil {
+			return &Mismatch{Type: spec, Direction: trip.dir, Value: v, Err: err}
+		}
+		if !wire.ValuesAreEqual(want, got) {
+			return &Mismatch{Type: spec, Direction: trip.dir, Value: v, Got: got}
+		}
+	}
+	return nil
+}
+
+// roundTrip encodes the given value with enc and returns the canonical form
+// of the value decoded from it by dec.
+func roundTrip(spec compile.TypeSpec, v wire.Value, enc, dec protocol.Protocol) (wire.Value, error) {
+	var buf bytes.Buffer
+	if err := enc.Encode(v, &buf); err != nil {
+		return wire.Value{}, fmt.Errorf("failed to encode: %v", err)
+	}
+
+	got, err := dec.Decode(bytes.NewReader(buf.Bytes()), v.Type())
+	if err != nil {
+		return wire.Value{}, fmt.Errorf("failed to decode: %v", err)
+	}
+	return canonical.Value(spec, got)
+}
+
+// Options configure Run.
+type Options struct {
+	// Number of random values checked per type. Defaults to 100.
+	Iterations int
+
+	// Seed for the random values. Runs with the same seed check the same
+	// values. Defaults to 1.
+	Seed int64
+
+	// Maximum number of items in containers, and of bytes in strings and
+	// binary values. Defaults to 5.
+	MaxSize int
+
+	// Maximum nesting of optional fields and containers. Defaults to 4.
+	MaxDepth int
+}
+
+// Run checks random values of all types declared in the given module
+// against the given peer protocol, and returns the Mismatches it found. At
+// most one Mismatch is reported per type.
+//
+// Types of which no value can be generated are reported with the error
+// that prevented it.
+func Run(m *compile.Module, peer protocol.Protocol, opts Options) []error {
+	if opts.Iterations <= 0 {
+		opts.Iterations = 100
+	}
+	if opts.Seed == 0 {
+		opts.Seed = 1
+	}
+	if opts.MaxSize <= 0 {
+		opts.MaxSize = 5
+	}
+	if opts.MaxDepth <= 0 {
+		opts.MaxDepth = 4
+	}
+
+	names := make([]string, 0, len(m.Types))
+	for name := range m.Types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var errs []error
+	for _, name := range names {
+		spec := m.Types[name]
+		r := rand.New(rand.NewSource(opts.Seed))
+		for i := 0; i < opts.Iterations; i++ {
+			v, err := RandomValue(r, spec, opts.MaxSize, opts.MaxDepth)
+			if err == nil {
+				err = Check(spec, v, peer)
+			}
+			if err != nil {
+				errs = append(errs, err)
+				break
+			}
+		}
+	}
+	return errs
+}
@@ -0,0 +1,220 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interop
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/canonical"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testThrift = `
+enum Color { RED, GREEN = 5, BLUE }
+
+typedef binary Blob
+
+struct Point {
+    1: required double x
+    2: required double y
+}
+
+struct Shape {
+    1: required string name
+    2: optional Color color = Color.GREEN
+    3: optional list<Point> points
+    4: optional map<string, set<i64>> tags
+    5: optional Blob data
+    6: optional bool filled
+    7: optional i8 layer
+    8: optional i16 zIndex
+    9: optional uuid id
+    10: optional Shape parent
+}
+
+union Figure {
+    1: Point point
+    2: Shape shape
+}
+
+exception ShapeError {
+    1: optional string message
+}
+`
+
+func compileTestModule(t *testing.T, src string) *compile.Module {
+	dir, err := ioutil.TempDir("", "thriftrw-interop-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "test.thrift")
+	require.NoError(t, ioutil.WriteFile(path, []byte(src), 0644))
+
+	m, err := compile.Compile(path)
+	require.NoError(t, err)
+	return m
+}
+
+func TestRandomValue(t *testing.T) {
+	m := compileTestModule(t, testThrift)
+	r := rand.New(rand.NewSource(1))
+
+	for name, spec := range m.Types {
+		for i := 0; i < 50; i++ {
+			v, err := RandomValue(r, spec, 3, 3)
+			require.NoError(t, err, name)
+
+			_, err = canonical.Value(spec, v)
+			assert.NoError(t, err, "%v: invalid value %v", name, v)
+
+			if name == "Figure" {
+				assert.Len(t, v.GetStruct().Fields, 1, "unions must have exactly one field")
+			}
+		}
+	}
+}
+
+func TestRandomValueRecursiveRequired(t *testing.T) {
+	// The compiler rejects such structs so the spec is built by hand.
+	node := &compile.StructSpec{Name: "Node", Type: ast.StructType}
+	node.Fields = compile.FieldGroup{
+		{ID: 1, Name: "child", Type: node, Required: true},
+	}
+
+	_, err := RandomValue(rand.New(rand.NewSource(1)), node, 3, 3)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `cannot generate a value of "Node"`)
+	}
+}
+
+// lossyProtocol is a Binary protocol which drops struct fields with the ID 2
+// when decoding.
+type lossyProtocol struct{ protocol.Protocol }
+
+func (p lossyProtocol) Decode(r io.ReaderAt, t wire.Type) (wire.Value, error) {
+	v, err := p.Protocol.Decode(r, t)
+	if err != nil || v.Type() != wire.TStruct {
+		return v, err
+	}
+
+	var fields []wire.Field
+	for _, f := range v.GetStruct().Fields {
+		if f.ID != 2 {
+			fields = append(fields, f)
+		}
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: fields}), nil
+}
+
+// brokenProtocol is a protocol which fails to encode values.
+type brokenProtocol struct{ protocol.Protocol }
+
+func (brokenProtocol) Encode(wire.Value, io.Writer) error {
+	return errors.New("great sadness")
+}
+
+func TestCheck(t *testing.T) {
+	m := compileTestModule(t, testThrift)
+	point := m.Types["Point"]
+	v := wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 2, Value: wire.NewValueDouble(2)},
+		{ID: 1, Value: wire.NewValueDouble(1)},
+	}})
+
+	assert.NoError(t, Check(point, v, protocol.Binary))
+
+	t.Run("lossy peer", func(t *testing.T) {
+		err := Check(m.Types["Shape"], wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueString("foo")},
+			{ID: 2, Value: wire.NewValueI32(0)},
+		}}), lossyProtocol{protocol.Binary})
+		require.Error(t, err)
+
+		mismatch, ok := err.(*Mismatch)
+		require.True(t, ok, "expected a Mismatch, got %T", err)
+		assert.Equal(t, ToPeer, mismatch.Direction)
+		assert.NoError(t, mismatch.Err)
+		assert.Contains(t, mismatch.Error(), "Shape: thriftrw to peer changed")
+	})
+
+	t.Run("invalid after round trip", func(t *testing.T) {
+		err := Check(point, v, lossyProtocol{protocol.Binary})
+		if assert.Error(t, err) {
+			assert.Contains(t, err.Error(), `Point: thriftrw to peer failed`)
+			assert.Contains(t, err.Error(), `field "y" of "Point" is required`)
+		}
+	})
+
+	t.Run("broken peer", func(t *testing.T) {
+		err := Check(point, v, brokenProtocol{protocol.Binary})
+		if assert.Error(t, err) {
+			assert.Equal(t, FromPeer, err.(*Mismatch).Direction)
+			assert.Contains(t, err.Error(), "failed to encode: great sadness")
+		}
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		assert.Error(t, Check(point, wire.NewValueI32(42), protocol.Binary))
+	})
+}
+
+func TestRun(t *testing.T) {
+	m := compileTestModule(t, testThrift)
+
+	assert.Empty(t, Run(m, protocol.Binary, Options{Iterations: 20}))
+
+	errs := Run(m, lossyProtocol{protocol.Binary}, Options{Iterations: 20})
+	var failed []string
+	for _, err := range errs {
+		failed = append(failed, err.(*Mismatch).Type.ThriftName())
+	}
+	assert.Equal(t, []string{"Figure", "Point", "Shape"}, failed)
+}
+
+func TestRunIsDeterministic(t *testing.T) {
+	m := compileTestModule(t, testThrift)
+
+	encodeAll := func() []byte {
+		var buf bytes.Buffer
+		r := rand.New(rand.NewSource(42))
+		for i := 0; i < 10; i++ {
+			v, err := RandomValue(r, m.Types["Shape"], 5, 4)
+			require.NoError(t, err)
+			require.NoError(t, protocol.Binary.Encode(v, &buf))
+		}
+		return buf.Bytes()
+	}
+
+	assert.Equal(t, encodeAll(), encodeAll())
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package interop
+
+import (
+	"fmt"
+	"math/rand"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/wire"
+)
+
+// valueGenerator generates random values of Thrift types.
+type valueGenerator struct {
+	rand     *rand.Rand
+	maxSize  int
+	maxDepth int
+}
+
+// RandomValue returns a random value of the given Thrift type.
+//
+// Required fields of structs are always set, and exactly one field of
+// unions is set. Containers hold at most maxSize items. Beyond maxDepth
+// levels of nesting, optional fields are left unset and containers are
+// empty so that values of recursive types remain finite.
+func RandomValue(r *rand.Rand, spec compile.TypeSpec, maxSize, maxDepth int) (wire.Value, error) {
+	g := valueGenerator{rand: r, maxSize: maxSize, maxDepth: maxDepth}
+	return g.value(spec, 0)
+}
+
+func (g valueGenerator) value(spec compile.TypeSpec, depth int) (wire.Value, error) {
+	switch s := compile.RootTypeSpec(spec).(type) {
+	case *compile.BoolSpec:
+		return wire.NewValueBool(g.rand.Intn(2) == 1), nil
+	case *compile.I8Spec:
+		return wire.NewValueI8(int8(g.rand.Uint32())), nil
+	case *compile.I16Spec:
+		return wire.NewValueI16(int16(g.rand.Uint32())), nil
+	case *compile.I32Spec:
+		return wire.NewValueI32(int32(g.rand.Uint32())), nil
+	case *compile.I64Spec:
+		return wire.NewValueI64(int64(g.rand.Uint32())<<32 | int64(g.rand.Uint32())), nil
+	case *compile.DoubleSpec:
+		// NaN is never equal to itself so it cannot be compared.
+		return wire.NewValueDouble(g.rand.NormFloat64() * 1e6), nil
+	case *compile.StringSpec, *compile.BinarySpec:
+		return wire.NewValueBinary(g.bytes(g.rand.Intn(g.maxSize + 1))), nil
+	case *compile.UUIDSpec:
+		var u wire.UUID
+		copy(u[:], g.bytes(len(u)))
+		return wire.NewValueUUID(u), nil
+	case *compile.EnumSpec:
+		if len(s.Items) == 0 {
+			return wire.NewValueI32(int32(g.rand.Uint32())), nil
+		}
+		return wire.NewValueI32(s.Items[g.rand.Intn(len(s.Items))].Value), nil
+	case *compile.StructSpec:
+		return g.structValue(s, depth)
+	case *compile.ListSpec:
+		items, err := g.values(s.ValueSpec, depth)
+		if err != nil {
+			return wire.Value{}, err
+		}
+		return wire.NewValueList(wire.ValueListFromSlice(s.ValueSpec.TypeCode(), items)), nil
+	case *compile.SetSpec:
+		items, err := g.values(s.ValueSpec, depth)
+		if err != nil {
+			return wire.Value{}, err
+		}
+		return wire.NewValueSet(wire.ValueListFromSlice(s.ValueSpec.TypeCode(), items)), nil
+	case *compile.MapSpec:
+		return g.mapValue(s, depth)
+	default:
+		return wire.Value{}, fmt.Errorf("cannot generate values of %q", spec.ThriftName())
+	}
+}
+
+func (g valueGenerator) bytes(n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte(g.rand.Intn(256))
+	}
+	return b
+}
+
+// size returns a random size for a container at the given depth.
+func (g valueGenerator) size(depth int) int {
+	if depth >= g.maxDepth {
+		return 0
+	}
+	return g.rand.Intn(g.maxSize + 1)
+}
+
+func (g valueGenerator) structValue(spec *compile.StructSpec, depth int) (wire.Value, error) {
+	// Required fields may recurse forever, in which case no finite value of
+	// the struct exists.
+	if depth > 2*g.maxDepth {
+		return wire.Value{}, fmt.Errorf(
+			"cannot generate a value of %q: required fields are nested too deeply", spec.Name)
+	}
+
+	var fields []*compile.FieldSpec
+	if spec.Type == ast.UnionType {
+		if len(spec.Fields) > 0 {
+			fields = append(fields, spec.Fields[g.rand.Intn(len(spec.Fields))])
+		}
+	} else {
+		for _, f := range spec.Fields {
+			if f.Required || (depth < g.maxDepth && g.rand.Intn(2) == 1) {
+				fields = append(fields, f)
+			}
+		}
+	}
+
+	values := make([]wire.Field, 0, len(fields))
+	for _, f := range fields {
+		v, err := g.value(f.Type, depth+1)
+		if err != nil {
+			return wire.Value{}, err
+		}
+		values = append(values, wire.Field{ID: f.ID, Value: v})
+	}
+	return wire.NewValueStruct(wire.Struct{Fields: values}), nil
+}
+
+func (g valueGenerator) values(spec compile.TypeSpec, depth int) ([]wire.Value, error) {
+	items := make([]wire.Value, g.size(depth))
+	for i := range items {
+		v, err := g.value(spec, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		items[i] = v
+	}
+	return items, nil
+}
+
+func (g valueGenerator) mapValue(spec *compile.MapSpec, depth int) (wire.Value, error) {
+	items := make([]wire.MapItem, g.size(depth))
+	for i := range items {
+		k, err := g.value(spec.KeySpec, depth+1)
+		if err != nil {
+			return wire.Value{}, err
+		}
+
+		v, err := g.value(spec.ValueSpec, depth+1)
+		if err != nil {
+			return wire.Value{}, err
+		}
+
+		items[i] = wire.MapItem{Key: k, Value: v}
+	}
+	return wire.NewValueMap(wire.MapItemListFromSlice(
+		spec.KeySpec.TypeCode(), spec.ValueSpec.TypeCode(), items)), nil
+}
@@ -22,26 +22,83 @@ package main
 
 import (
 	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"log"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	"go.uber.org/thriftrw/compile"
 	"go.uber.org/thriftrw/gen"
+	"go.uber.org/thriftrw/internal/changelog"
+	"go.uber.org/thriftrw/internal/doc"
+	"go.uber.org/thriftrw/internal/graph"
+	"go.uber.org/thriftrw/internal/owners"
 	"go.uber.org/thriftrw/internal/plugin"
 	"go.uber.org/thriftrw/internal/plugin/builtin/pluginapigen"
+	"go.uber.org/thriftrw/internal/snapshot"
+	"go.uber.org/thriftrw/protocol/dictionary"
 	"go.uber.org/thriftrw/version"
 
+	"github.com/anmitsu/go-shlex"
 	"github.com/jessevdk/go-flags"
 	"go.uber.org/multierr"
 )
 
 type options struct {
-	DisplayVersion bool       `long:"version" short:"v" description:"Show the ThriftRW version number"`
-	GOpts          genOptions `group:"Generator Options"`
+	DisplayVersion bool              `long:"version" short:"v" description:"Show the ThriftRW version number"`
+	Config         string            `long:"config" value-name:"FILE" description:"INI file from which to read options. Sections are named after the groups of options listed here and hold their long names. Options given on the command line take precedence."`
+	Diagnostics    string            `long:"diagnostics" value-name:"FORMAT" choice:"text" choice:"json" default:"text" description:"Format in which compile errors are reported. With json, the errors are written to stdout as a JSON array of objects with the file, line, column, code, and message of each error. The array is empty if the files compiled successfully."`
+	GOpts          genOptions        `group:"Generator Options"`
+	Changelog      changelogOptions  `group:"Changelog Options"`
+	Owners         ownersOptions     `group:"Ownership Options"`
+	Dictionaries   dictionaryOptions `group:"Dictionary Options"`
+	Snapshot       snapshotOptions   `group:"Snapshot Options"`
+	Init           initOptions       `command:"init" description:"Create a directory with a starter Thrift file and the configuration needed to generate code for it"`
+	Doc            docOptions        `command:"doc" description:"Write documentation for the services, types, and constants of the given Thrift files to stdout instead of generating code"`
+	Graph          graphOptions      `command:"graph" description:"Write the graph of the includes of the given Thrift files and the dependencies of their services and types to stdout instead of generating code"`
+}
+
+type ownersOptions struct {
+	Report     bool `long:"owners" description:"Instead of generating code, print the owners of services and types declared with the owner annotation."`
+	CodeOwners bool `long:"codeowners" description:"Instead of generating code, print a CODEOWNERS fragment assigning each Thrift file to the owners of its services and types. Paths are relative to the current directory."`
+}
+
+type docOptions struct {
+	Format string `long:"format" value-name:"FORMAT" choice:"markdown" choice:"html" default:"markdown" description:"Format of the documentation."`
+	Title  string `long:"title" value-name:"TITLE" default:"API Documentation" description:"Heading of the documentation."`
+
+	// enabled is set if the doc command was given.
+	enabled bool
+}
+
+type graphOptions struct {
+	Format string `long:"format" value-name:"FORMAT" choice:"dot" choice:"json" default:"dot" description:"Format of the graph. dot is the language of Graphviz; json is an object with lists of nodes and edges."`
+
+	// enabled is set if the graph command was given.
+	enabled bool
+}
+
+type changelogOptions struct {
+	From string `long:"changelog-from" value-name:"DIR" description:"Directory containing the older revision of the Thrift files. Together with --changelog-to, prints a Markdown changelog of the changes between the two revisions instead of generating code."`
+	To   string `long:"changelog-to" value-name:"DIR" description:"Directory containing the newer revision of the Thrift files."`
+}
+
+type dictionaryOptions struct {
+	Samples string `long:"train-dictionaries" value-name:"DIR" description:"Instead of generating code, train compression dictionaries for go.uber.org/thriftrw/protocol/dictionary from payloads captured in DIR. DIR must contain a directory for each type named after it, like users.User for the User type of users.thrift, holding one Binary-encoded payload per file."`
+	Output  string `long:"dictionaries-out" value-name:"FILE" default:"dictionaries.bin" description:"File to which the trained dictionaries are written."`
+	Size    int    `long:"dictionary-size" value-name:"BYTES" default:"16384" description:"Maximum size of each dictionary."`
+}
+
+type snapshotOptions struct {
+	File   string `long:"snapshot" value-name:"FILE" description:"Snapshot of the fields of all structs, unions, and exceptions, written by --freeze. If provided, code is not generated unless they match the snapshot."`
+	Freeze bool   `long:"freeze" description:"Instead of generating code, record the fields of all structs, unions, and exceptions in the file given by --snapshot."`
 }
 
 type genOptions struct {
@@ -49,8 +106,11 @@ type genOptions struct {
 	PackagePrefix   string `long:"pkg-prefix" value-name:"PREFIX" description:"Prefix for import paths of generated module. By default, this is based on the output directory's location relative to $GOPATH."`
 	ThriftRoot      string `long:"thrift-root" value-name:"DIR" description:"Directory whose descendants contain all Thrift files. The structure of the generated Go packages mirrors the paths to the Thrift files relative to this directory. By default, this is the deepest common ancestor directory of the Thrift files."`
 
-	NoRecurse bool         `long:"no-recurse" description:"Don't generate code for included Thrift files."`
-	Plugins   plugin.Flags `long:"plugin" short:"p" value-name:"PLUGIN" description:"Code generation plugin for ThriftRW. This option may be provided multiple times to apply multiple plugins."`
+	NoRecurse    bool `long:"no-recurse" description:"Don't generate code for included Thrift files."`
+	GoNamespaces bool `long:"go-namespaces" description:"Use 'namespace go' declarations in Thrift files to choose the import paths of generated packages. The namespaces are relative to --pkg-prefix."`
+	Flat         bool `long:"flat" description:"Generate code for all Thrift files into a single package at the output directory instead of one package per Thrift file. The IDL is not embedded in the generated code."`
+
+	Plugins plugin.Flags `long:"plugin" short:"p" value-name:"PLUGIN" description:"Code generation plugin for ThriftRW. This option may be provided multiple times to apply multiple plugins. The executable thriftrw-plugin-PLUGIN is searched for in $THRIFTRW_PLUGIN_PATH and the bin directory of the Go module when run by go generate before $PATH."`
 
 	GeneratePluginAPI bool `long:"generate-plugin-api" hidden:"true" description:"Generates code for the plugin API"`
 	NoVersionCheck    bool `long:"no-version-check" hidden:"true" description:"Does not add library version checks to generated code."`
@@ -58,6 +118,35 @@ type genOptions struct {
 	NoConstants       bool `long:"no-constants" description:"Do not generate code for const declarations."`
 	NoServiceHelpers  bool `long:"no-service-helpers" description:"Do not generate service helpers."`
 	NoEmbedIDL        bool `long:"no-embed-idl" description:"Do not embed IDLs into the generated code."`
+	Zap               bool `long:"zap" description:"Generate code for logging the generated types with zap. Generated packages import go.uber.org/zap/zapcore."`
+	GenerateBuilders  bool `long:"generate-builders" description:"Generate builders for structs with many fields."`
+	BuilderThreshold  int  `long:"builder-threshold" value-name:"N" default:"10" description:"Generate builders only for structs with more than N fields. Requires --generate-builders."`
+	MapstructureTags  bool `long:"mapstructure-tags" description:"Add mapstructure tags to the fields of generated structs."`
+	DriftSchemas      bool `long:"drift-schemas" description:"Generate DriftSchema methods on the arguments of service functions for use with go.uber.org/thriftrw/drift."`
+	GenerateValidate  bool `long:"generate-validate" description:"Generate Validate methods which check required fields and unions recursively for use with go.uber.org/thriftrw/validate."`
+	StrictUTF8        bool `long:"strict-utf8" description:"Fail to encode or decode structs with strings that are not valid UTF-8, except for fields annotated with thriftrw.allowInvalidUTF8."`
+	OptionalValues    bool `long:"optional-values" description:"Generate optional primitive fields of structs and exceptions as values with IsSet methods instead of pointers. Use the thriftrw.optional annotation to override this per struct or field."`
+	Manifest          bool `long:"manifest" description:"Write a thriftrw-manifest.json to each generated package recording the ThriftRW version and the options used to generate it."`
+	Incremental       bool `long:"incremental" description:"Write a thriftrw-checksum to each generated package and skip packages whose Thrift files, ThriftRW version, and options have not changed since they were last generated."`
+	Force             bool `long:"force" description:"Generate code for all packages even if they have not changed. Requires --incremental."`
+	Verify            bool `long:"verify" description:"Instead of generating code, verify that the manifests of the packages that would be generated match the ThriftRW version and the given options. Requires that the packages were generated with --manifest."`
+	MemSize           bool `long:"mem-size" description:"Generate MemSize methods which estimate the memory held by structs, unions, exceptions, and typedefs, including the memory referenced by their fields."`
+	Immutable         bool `long:"immutable" description:"Generate the fields of structs, unions, and exceptions unexported so that they may only be read with getters. Values are constructed with builders and cannot be modified afterwards."`
+	PreserveUnknown   bool `long:"preserve-unknown-fields" description:"Record fields of structs and exceptions which are not recognized when they are decoded in an UnknownFields field and write them back when they are encoded."`
+	CompactCode       bool `long:"compact-code" description:"Reduce the size of generated code by calling into go.uber.org/thriftrw/runtime to encode lists, sets, and maps and to decode struct fields instead of generating the same logic for each of them."`
+	PackageDoc        bool `long:"package-doc" description:"Generate a doc.go for each package describing the Thrift file, services, and types it was generated from."`
+	SplitTypes        bool `long:"split-types" description:"Write each type to its own types_NAME.go file instead of writing all types of a Thrift file to types.go."`
+	GenerateExamples  bool `long:"generate-examples" description:"Generate an example_test.go for each package with runnable examples which encode and decode its structs, unions, and exceptions."`
+	Profile           bool `long:"profile" description:"Print a report of the time spent and code generated per template and per type to stderr."`
+	Watch             bool `long:"watch" description:"Keep running after generating code and regenerate it whenever the given Thrift files or the files they include change. Compile errors are printed to stderr as they occur."`
+
+	WatchInterval time.Duration `long:"watch-interval" value-name:"DURATION" default:"1s" description:"How often the Thrift files are checked for changes with --watch."`
+
+	Templates string `long:"templates" value-name:"DIR" description:"Directory of templates which replace the built-in templates for structs, enums, and typedefs. The files must be named struct.tmpl, enum.tmpl, or typedef.tmpl."`
+
+	PostProcess []string `long:"post-process" value-name:"COMMAND" description:"Command through which each generated file is piped before it is written. The path of the file is available in $THRIFTRW_FILE. This option may be provided multiple times."`
+
+	RequireVersion string `long:"require-version" value-name:"VERSION" description:"Fail unless code generated by this ThriftRW may be used with the given version of the ThriftRW library, such as the version that the generated code is built against."`
 
 	// TODO(abg): Detailed help with examples of --thrift-root, --pkg-prefix,
 	// and --plugin
@@ -77,10 +166,8 @@ func do() (err error) {
 
 	var opts options
 
-	parser := flags.NewParser(&opts, flags.Default & ^flags.PrintErrors)
-	parser.Usage = "[OPTIONS] FILE"
-
-	args, err := parser.Parse()
+	parser := newParser(&opts)
+	args, err := parseArgs(parser, &opts, os.Args[1:])
 	if ferr, ok := err.(*flags.Error); ok && ferr.Type == flags.ErrHelp {
 		parser.WriteHelp(os.Stdout)
 		return nil
@@ -88,75 +175,142 @@ func do() (err error) {
 		return err
 	}
 
+	if parser.Active != nil {
+		switch parser.Active.Name {
+		case "init":
+			return initProject(".", opts.Init.Args.Name)
+		case "doc":
+			opts.Doc.enabled = true
+		case "graph":
+			opts.Graph.enabled = true
+		}
+	}
+
 	if opts.DisplayVersion {
 		fmt.Printf("thriftrw v%s\n", version.Version)
 		return nil
 	}
 
-	if len(args) != 1 {
+	if opts.Changelog.From != "" || opts.Changelog.To != "" {
+		return writeChangelog(os.Stdout, opts.Changelog)
+	}
+
+	if len(args) == 0 {
 		var buffer bytes.Buffer
 		parser.WriteHelp(&buffer)
 		return errors.New(buffer.String())
 	}
 
-	inputFile := args[0]
-	if _, err := os.Stat(inputFile); err != nil {
-		if os.IsNotExist(err) {
-			return fmt.Errorf("File %q does not exist: %v", inputFile, err)
+	if opts.GOpts.Watch {
+		w := watcher{
+			Args:     args,
+			Interval: opts.GOpts.WatchInterval,
+			Run: func() ([]*compile.Module, error) {
+				return run(opts, args)
+			},
+			Log: log.New(os.Stderr, "", log.Ltime),
 		}
-		return fmt.Errorf("Could not stat file %q: %v", inputFile, err)
+		return w.Watch(nil /* stop */)
 	}
-	gopts := opts.GOpts
 
-	if len(gopts.OutputDirectory) == 0 {
-		gopts.OutputDirectory = "."
-	}
-	gopts.OutputDirectory, err = filepath.Abs(gopts.OutputDirectory)
+	_, err = run(opts, args)
+	return err
+}
+
+// run compiles the given Thrift files and generates code for them, or does
+// whatever else the given options ask for instead. The compiled modules are
+// returned if the files compiled successfully, even if a later step failed.
+func run(opts options, args []string) (modules []*compile.Module, err error) {
+	inputFiles, err := findThriftFiles(args)
 	if err != nil {
-		return fmt.Errorf("Unable to resolve absolute path for %q: %v", gopts.OutputDirectory, err)
+		return nil, err
 	}
+	gopts := opts.GOpts
 
-	if gopts.PackagePrefix == "" {
-		gopts.PackagePrefix, err = determinePackagePrefix(gopts.OutputDirectory)
+	modules, err = compile.CompileFiles(inputFiles)
+	if opts.Diagnostics == "json" {
+		if werr := writeDiagnostics(os.Stdout, err); werr != nil {
+			return nil, werr
+		}
 		if err != nil {
-			return fmt.Errorf(
-				"Could not determine a package prefix automatically: %v\n"+
-					"A package prefix is required to use correct import paths in the generated code.\n"+
-					"Use the --pkg-prefix option to provide a package prefix manually.", err)
+			return nil, fmt.Errorf("Failed to compile %v", quoteAll(args))
 		}
 	}
-
-	module, err := compile.Compile(inputFile)
 	if err != nil {
 		// TODO(abg): For nested compile errors, split causal chain across
 		// multiple lines.
-		return fmt.Errorf("Failed to compile %q: %+v", inputFile, err)
+		return nil, fmt.Errorf("Failed to compile %v: %+v", quoteAll(args), err)
 	}
 
 	if gopts.ThriftRoot == "" {
-		gopts.ThriftRoot, err = findCommonAncestor(module)
+		gopts.ThriftRoot, err = findCommonAncestor(modules...)
 		if err != nil {
-			return fmt.Errorf(
-				"Could not find a common parent directory for %q and the Thrift files "+
-					"imported by it.\nThis directory is required to generate a consistent "+
+			return modules, fmt.Errorf(
+				"Could not find a common parent directory for %v and the Thrift files "+
+					"imported by them.\nThis directory is required to generate a consistent "+
 					"hierarchy for generated packages.\nUse the --thrift-root option to "+
-					"provide this path.\n\t%v", inputFile, err)
+					"provide this path.\n\t%v", quoteAll(args), err)
 		}
 	} else {
 		gopts.ThriftRoot, err = filepath.Abs(gopts.ThriftRoot)
 		if err != nil {
-			return fmt.Errorf("Unable to resolve absolute path for %q: %v", gopts.ThriftRoot, err)
+			return modules, fmt.Errorf("Unable to resolve absolute path for %q: %v", gopts.ThriftRoot, err)
 		}
-		if err := verifyAncestry(module, gopts.ThriftRoot); err != nil {
-			return fmt.Errorf(
-				"An included Thrift file is not contained in the %q directory tree: %v",
-				gopts.ThriftRoot, err)
+		for _, module := range modules {
+			if err := verifyAncestry(module, gopts.ThriftRoot); err != nil {
+				return modules, fmt.Errorf(
+					"An included Thrift file is not contained in the %q directory tree: %v",
+					gopts.ThriftRoot, err)
+			}
+		}
+	}
+
+	if opts.Owners.Report || opts.Owners.CodeOwners {
+		return modules, writeOwners(os.Stdout, opts.Owners, gopts.ThriftRoot, modules)
+	}
+
+	if opts.Doc.enabled {
+		return modules, writeDoc(os.Stdout, opts.Doc, gopts.ThriftRoot, modules)
+	}
+
+	if opts.Graph.enabled {
+		return modules, writeGraph(os.Stdout, opts.Graph, gopts.ThriftRoot, modules)
+	}
+
+	if opts.Dictionaries.Samples != "" {
+		return modules, trainDictionaries(opts.Dictionaries, modules)
+	}
+
+	if opts.Snapshot.Freeze {
+		return modules, freezeSnapshot(opts.Snapshot, gopts.ThriftRoot, modules)
+	}
+	if opts.Snapshot.File != "" {
+		if err := checkSnapshot(opts.Snapshot, gopts.ThriftRoot, modules); err != nil {
+			return modules, err
+		}
+	}
+
+	if len(gopts.OutputDirectory) == 0 {
+		gopts.OutputDirectory = "."
+	}
+	gopts.OutputDirectory, err = filepath.Abs(gopts.OutputDirectory)
+	if err != nil {
+		return modules, fmt.Errorf("Unable to resolve absolute path for %q: %v", gopts.OutputDirectory, err)
+	}
+
+	if gopts.PackagePrefix == "" {
+		gopts.PackagePrefix, err = determinePackagePrefix(gopts.OutputDirectory)
+		if err != nil {
+			return modules, fmt.Errorf(
+				"Could not determine a package prefix automatically: %v\n"+
+					"A package prefix is required to use correct import paths in the generated code.\n"+
+					"Use the --pkg-prefix option to provide a package prefix manually.", err)
 		}
 	}
 
 	pluginHandle, err := gopts.Plugins.Handle()
 	if err != nil {
-		return fmt.Errorf("Failed to initialize plugins: %+v", err)
+		return modules, fmt.Errorf("Failed to initialize plugins: %+v", err)
 	}
 
 	if gopts.GeneratePluginAPI {
@@ -168,21 +322,353 @@ func do() (err error) {
 	}()
 
 	generatorOptions := gen.Options{
-		OutputDir:        gopts.OutputDirectory,
-		PackagePrefix:    gopts.PackagePrefix,
-		ThriftRoot:       gopts.ThriftRoot,
-		NoRecurse:        gopts.NoRecurse,
-		NoVersionCheck:   gopts.NoVersionCheck,
-		Plugin:           pluginHandle,
-		NoTypes:          gopts.NoTypes,
-		NoConstants:      gopts.NoConstants,
-		NoServiceHelpers: gopts.NoServiceHelpers || gopts.NoTypes,
-		NoEmbedIDL:       gopts.NoEmbedIDL,
-	}
-	if err := gen.Generate(module, &generatorOptions); err != nil {
-		return fmt.Errorf("Failed to generate code: %+v", err)
-	}
-	return nil
+		OutputDir:             gopts.OutputDirectory,
+		PackagePrefix:         gopts.PackagePrefix,
+		ThriftRoot:            gopts.ThriftRoot,
+		NoRecurse:             gopts.NoRecurse,
+		GoNamespaces:          gopts.GoNamespaces,
+		Flat:                  gopts.Flat,
+		NoVersionCheck:        gopts.NoVersionCheck,
+		RequireVersion:        gopts.RequireVersion,
+		Plugin:                pluginHandle,
+		NoTypes:               gopts.NoTypes,
+		NoConstants:           gopts.NoConstants,
+		NoServiceHelpers:      gopts.NoServiceHelpers || gopts.NoTypes,
+		NoEmbedIDL:            gopts.NoEmbedIDL,
+		Zap:                   gopts.Zap,
+		GenerateBuilders:      gopts.GenerateBuilders,
+		BuilderThreshold:      gopts.BuilderThreshold,
+		MapstructureTags:      gopts.MapstructureTags,
+		PackageDoc:            gopts.PackageDoc,
+		GenerateExamples:      gopts.GenerateExamples,
+		SplitTypes:            gopts.SplitTypes,
+		DriftSchemas:          gopts.DriftSchemas,
+		GenerateValidate:      gopts.GenerateValidate,
+		StrictUTF8:            gopts.StrictUTF8,
+		OptionalValues:        gopts.OptionalValues,
+		CompactCode:           gopts.CompactCode,
+		PreserveUnknownFields: gopts.PreserveUnknown,
+		MemSize:               gopts.MemSize,
+		Immutable:             gopts.Immutable,
+		Incremental:           gopts.Incremental,
+		Force:                 gopts.Force,
+		Manifest:              gopts.Manifest,
+	}
+	if gopts.Profile {
+		generatorOptions.Profile = gen.NewProfile()
+	}
+	if gopts.Templates != "" {
+		generatorOptions.Templates, err = gen.LoadTemplates(gopts.Templates)
+		if err != nil {
+			return modules, fmt.Errorf("Failed to load templates: %v", err)
+		}
+	}
+	for _, command := range gopts.PostProcess {
+		tokens, err := shlex.Split(command, true /* posix */)
+		if err != nil {
+			return modules, fmt.Errorf("invalid post-process command %q: %v", command, err)
+		}
+		if len(tokens) == 0 {
+			return modules, fmt.Errorf("invalid post-process command %q: please provide a command", command)
+		}
+		generatorOptions.PostProcessors = append(generatorOptions.PostProcessors,
+			gen.CommandPostProcessor(tokens[0], tokens[1:]...))
+	}
+	if gopts.Verify {
+		if err := gen.VerifyManifests(modules, &generatorOptions); err != nil {
+			return modules, fmt.Errorf("Generated code does not match the requested options: %v", err)
+		}
+		return modules, nil
+	}
+	if err := gen.GenerateModules(modules, &generatorOptions); err != nil {
+		return modules, fmt.Errorf("Failed to generate code: %+v", err)
+	}
+	if gopts.Profile {
+		return modules, generatorOptions.Profile.WriteReport(os.Stderr)
+	}
+	return modules, nil
+}
+
+// newParser builds a parser for the command line arguments of ThriftRW.
+func newParser(opts *options) *flags.Parser {
+	parser := flags.NewParser(opts, flags.Default & ^flags.PrintErrors)
+	parser.Usage = "[OPTIONS] FILE|DIR..."
+	parser.SubcommandsOptional = true
+	return parser
+}
+
+// parseArgs parses the given command line arguments into opts using the
+// given parser, which must have been built for opts. If the arguments name a
+// config file, options are read from it first so that the arguments take
+// precedence.
+func parseArgs(parser *flags.Parser, opts *options, args []string) ([]string, error) {
+	rest, err := parser.ParseArgs(args)
+	if err != nil || opts.Config == "" {
+		return rest, err
+	}
+
+	if err := flags.NewIniParser(parser).ParseFile(opts.Config); err != nil {
+		return nil, fmt.Errorf("failed to read config %q: %v", opts.Config, err)
+	}
+	return parser.ParseArgs(args)
+}
+
+// writeDiagnostics writes the problems found by the compiler, if any, to the
+// given writer as a JSON array on a single line.
+func writeDiagnostics(w io.Writer, err error) error {
+	diagnostics := compile.Diagnostics(err)
+	if diagnostics == nil {
+		diagnostics = []compile.Diagnostic{}
+	}
+	return json.NewEncoder(w).Encode(diagnostics)
+}
+
+// writeChangelog writes a Markdown changelog of the differences between the
+// Thrift files of two revisions to the given writer.
+func writeChangelog(w io.Writer, opts changelogOptions) error {
+	if opts.From == "" || opts.To == "" {
+		return errors.New("Both --changelog-from and --changelog-to must be provided")
+	}
+
+	fromRoot, from, err := compileRevision(opts.From)
+	if err != nil {
+		return err
+	}
+
+	toRoot, to, err := compileRevision(opts.To)
+	if err != nil {
+		return err
+	}
+
+	files, err := changelog.Files(fromRoot, from, toRoot, to)
+	if err != nil {
+		return fmt.Errorf("Failed to compare %q and %q: %v", opts.From, opts.To, err)
+	}
+	return changelog.Write(w, files)
+}
+
+// compileRevision compiles all Thrift files in the given directory. It
+// returns the absolute path to the directory and the compiled modules.
+func compileRevision(dir string) (string, []*compile.Module, error) {
+	root, err := filepath.Abs(dir)
+	if err != nil {
+		return "", nil, fmt.Errorf("Unable to resolve absolute path for %q: %v", dir, err)
+	}
+
+	files, err := findThriftFiles([]string{root})
+	if err != nil {
+		return "", nil, err
+	}
+
+	modules, err := compile.CompileFiles(files)
+	if err != nil {
+		return "", nil, fmt.Errorf("Failed to compile %q: %+v", dir, err)
+	}
+	return root, modules, nil
+}
+
+// writeOwners writes a report of the owners of the definitions in the given
+// modules, or a CODEOWNERS fragment.
+func writeOwners(w io.Writer, opts ownersOptions, root string, modules []*compile.Module) error {
+	entries, err := owners.Collect(root, modules)
+	if err != nil {
+		return fmt.Errorf("Failed to collect owners: %v", err)
+	}
+
+	if !opts.CodeOwners {
+		return owners.WriteReport(w, entries)
+	}
+
+	cwd, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("Unable to determine the current directory: %v", err)
+	}
+	dir, err := filepath.Rel(cwd, root)
+	if err != nil || strings.HasPrefix(dir, "..") {
+		return fmt.Errorf("The Thrift root %q must be inside the current directory to generate a CODEOWNERS fragment", root)
+	}
+	return owners.WriteCodeOwners(w, dir, entries)
+}
+
+// writeDoc writes documentation for the given modules and the modules they
+// include.
+func writeDoc(w io.Writer, opts docOptions, root string, modules []*compile.Module) error {
+	files, err := doc.Files(root, modules)
+	if err != nil {
+		return fmt.Errorf("Failed to collect files: %v", err)
+	}
+
+	format := doc.Markdown
+	if opts.Format == "html" {
+		format = doc.HTML
+	}
+	return doc.Write(w, format, opts.Title, files)
+}
+
+// writeGraph writes the dependency graph of the given modules and the
+// modules they include.
+func writeGraph(w io.Writer, opts graphOptions, root string, modules []*compile.Module) error {
+	g, err := graph.Build(root, modules)
+	if err != nil {
+		return fmt.Errorf("Failed to build the dependency graph: %v", err)
+	}
+
+	if opts.Format == "json" {
+		return g.WriteJSON(w)
+	}
+	return g.WriteDOT(w)
+}
+
+// trainDictionaries trains compression dictionaries for the types of the
+// given modules from the payloads captured for them.
+func trainDictionaries(opts dictionaryOptions, modules []*compile.Module) error {
+	types := make(map[string]compile.TypeSpec)
+	for _, m := range modules {
+		err := m.Walk(func(m *compile.Module) error {
+			for name, spec := range m.Types {
+				types[m.Name+"."+name] = spec
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	dirs, err := ioutil.ReadDir(opts.Samples)
+	if err != nil {
+		return fmt.Errorf("Unable to read samples from %q: %v", opts.Samples, err)
+	}
+
+	trainer := dictionary.NewTrainer(dictionary.TrainerConfig{Size: opts.Size})
+	for _, dir := range dirs {
+		if !dir.IsDir() {
+			continue
+		}
+
+		spec, ok := types[dir.Name()]
+		if !ok {
+			return fmt.Errorf("Samples directory %q does not match a type, like users.User", dir.Name())
+		}
+
+		fp := dictionary.FingerprintOf(spec)
+		files, err := ioutil.ReadDir(filepath.Join(opts.Samples, dir.Name()))
+		if err != nil {
+			return fmt.Errorf("Unable to read samples for %v: %v", dir.Name(), err)
+		}
+		for _, file := range files {
+			if file.IsDir() {
+				continue
+			}
+			payload, err := ioutil.ReadFile(filepath.Join(opts.Samples, dir.Name(), file.Name()))
+			if err != nil {
+				return fmt.Errorf("Unable to read sample for %v: %v", dir.Name(), err)
+			}
+			trainer.AddEncoded(fp, payload)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := trainer.Train().Encode(&buf); err != nil {
+		return fmt.Errorf("Failed to encode dictionaries: %v", err)
+	}
+	return ioutil.WriteFile(opts.Output, buf.Bytes(), 0644)
+}
+
+// freezeSnapshot writes a snapshot of the structs of the given modules.
+func freezeSnapshot(opts snapshotOptions, root string, modules []*compile.Module) error {
+	if opts.File == "" {
+		return errors.New("--freeze requires --snapshot")
+	}
+
+	s, err := snapshot.Take(root, modules)
+	if err != nil {
+		return fmt.Errorf("Failed to take snapshot: %v", err)
+	}
+
+	var buf bytes.Buffer
+	if err := s.Write(&buf); err != nil {
+		return fmt.Errorf("Failed to encode snapshot: %v", err)
+	}
+	return ioutil.WriteFile(opts.File, buf.Bytes(), 0644)
+}
+
+// checkSnapshot fails if the structs of the given modules do not match the
+// snapshot.
+func checkSnapshot(opts snapshotOptions, root string, modules []*compile.Module) error {
+	f, err := os.Open(opts.File)
+	if err != nil {
+		return fmt.Errorf("Unable to open snapshot: %v", err)
+	}
+	defer f.Close()
+
+	frozen, err := snapshot.Read(f)
+	if err != nil {
+		return fmt.Errorf("Unable to read %q: %v", opts.File, err)
+	}
+
+	current, err := snapshot.Take(root, modules)
+	if err != nil {
+		return fmt.Errorf("Failed to take snapshot: %v", err)
+	}
+
+	diffs := snapshot.Compare(frozen, current)
+	if len(diffs) == 0 {
+		return nil
+	}
+	return fmt.Errorf(
+		"Structs do not match the snapshot in %q:\n\t%v\n"+
+			"If these changes are intended, run with --freeze to update the snapshot.",
+		opts.File, strings.Join(diffs, "\n\t"))
+}
+
+// findThriftFiles returns the Thrift files specified on the command line.
+// Directories are searched recursively for files with the .thrift extension.
+func findThriftFiles(args []string) ([]string, error) {
+	var files []string
+	for _, arg := range args {
+		info, err := os.Stat(arg)
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil, fmt.Errorf("File %q does not exist: %v", arg, err)
+			}
+			return nil, fmt.Errorf("Could not stat file %q: %v", arg, err)
+		}
+
+		if !info.IsDir() {
+			files = append(files, arg)
+			continue
+		}
+
+		var found int
+		err = filepath.Walk(arg, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if !info.IsDir() && filepath.Ext(path) == ".thrift" {
+				files = append(files, path)
+				found++
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Could not search directory %q: %v", arg, err)
+		}
+		if found == 0 {
+			return nil, fmt.Errorf("Directory %q does not contain any Thrift files", arg)
+		}
+	}
+	return files, nil
+}
+
+// quoteAll formats the given strings as a comma-separated list of quoted
+// strings.
+func quoteAll(ss []string) string {
+	quoted := make([]string, len(ss))
+	for i, s := range ss {
+		quoted[i] = strconv.Quote(s)
+	}
+	return strings.Join(quoted, ", ")
 }
 
 // verifyAncestry verifies that the Thrift file for the given module and the
@@ -206,13 +692,13 @@ func verifyAncestry(m *compile.Module, root string) error {
 	})
 }
 
-// findCommonAncestor finds the deepest common ancestor for the given module
-// and all modules imported by it.
-func findCommonAncestor(m *compile.Module) (string, error) {
+// findCommonAncestor finds the deepest common ancestor for the given modules
+// and all modules imported by them.
+func findCommonAncestor(modules ...*compile.Module) (string, error) {
 	var result []string
 	var lastString string
 
-	err := m.Walk(func(m *compile.Module) error {
+	visit := func(m *compile.Module) error {
 		thriftPath := m.ThriftPath
 		if !filepath.IsAbs(thriftPath) {
 			return fmt.Errorf(
@@ -238,9 +724,12 @@ func findCommonAncestor(m *compile.Module) (string, error) {
 
 		lastString = thriftPath
 		return nil
-	})
-	if err != nil {
-		return "", err
+	}
+
+	for _, m := range modules {
+		if err := m.Walk(visit); err != nil {
+			return "", err
+		}
 	}
 
 	return strings.Join(result, string(filepath.Separator)), nil
@@ -21,12 +21,18 @@
 package main
 
 import (
+	"bytes"
+	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/protocol/dictionary"
+	"go.uber.org/thriftrw/wire"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -435,3 +441,195 @@ func TestFindCommonAncestor(t *testing.T) {
 		}
 	}
 }
+
+func TestFindCommonAncestorOfModules(t *testing.T) {
+	got, err := findCommonAncestor(
+		&compile.Module{Name: "foo", ThriftPath: "/tmp/idl/services/foo.thrift"},
+		&compile.Module{Name: "bar", ThriftPath: "/tmp/idl/common/bar.thrift"},
+	)
+	if assert.NoError(t, err) {
+		assert.Equal(t, "/tmp/idl", got)
+	}
+}
+
+func TestFindThriftFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thriftrw-find-files-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	for _, name := range []string{
+		"idl/a.thrift",
+		"idl/common/b.thrift",
+		"idl/common/README.md",
+		"empty/README.md",
+		"c.thrift",
+	} {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, ioutil.WriteFile(path, nil, 0644))
+	}
+
+	tests := []struct {
+		desc      string
+		args      []string
+		want      []string
+		wantError string
+	}{
+		{
+			desc: "file",
+			args: []string{"c.thrift"},
+			want: []string{"c.thrift"},
+		},
+		{
+			desc: "files and directories",
+			args: []string{"idl", "c.thrift"},
+			want: []string{"idl/a.thrift", "idl/common/b.thrift", "c.thrift"},
+		},
+		{
+			desc:      "file does not exist",
+			args:      []string{"idl", "d.thrift"},
+			wantError: `File "$DIR/d.thrift" does not exist`,
+		},
+		{
+			desc:      "directory without Thrift files",
+			args:      []string{"empty"},
+			wantError: `Directory "$DIR/empty" does not contain any Thrift files`,
+		},
+	}
+
+	for _, tt := range tests {
+		var args, want []string
+		for _, arg := range tt.args {
+			args = append(args, filepath.Join(dir, arg))
+		}
+		for _, w := range tt.want {
+			want = append(want, filepath.Join(dir, w))
+		}
+
+		got, err := findThriftFiles(args)
+		if tt.wantError != "" {
+			if assert.Error(t, err, tt.desc) {
+				assert.Contains(t, err.Error(), strings.Replace(tt.wantError, "$DIR", dir, -1), tt.desc)
+			}
+			continue
+		}
+		if assert.NoError(t, err, tt.desc) {
+			assert.Equal(t, want, got, tt.desc)
+		}
+	}
+}
+
+func TestTrainDictionaries(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thriftrw-dictionaries")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	thriftFile := filepath.Join(dir, "users.thrift")
+	require.NoError(t, ioutil.WriteFile(thriftFile, []byte(`
+		struct User {
+			1: required string name
+			2: optional string email
+		}
+	`), 0644))
+	modules, err := compile.CompileFiles([]string{thriftFile})
+	require.NoError(t, err)
+
+	samples := filepath.Join(dir, "samples")
+	require.NoError(t, os.MkdirAll(filepath.Join(samples, "users.User"), 0755))
+	for i := 0; i < 10; i++ {
+		var buf bytes.Buffer
+		require.NoError(t, protocol.Binary.Encode(wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+			{ID: 1, Value: wire.NewValueString(fmt.Sprintf("user %d", i))},
+			{ID: 2, Value: wire.NewValueString(fmt.Sprintf("user%d@example.com", i))},
+		}}), &buf))
+		path := filepath.Join(samples, "users.User", fmt.Sprintf("%d.bin", i))
+		require.NoError(t, ioutil.WriteFile(path, buf.Bytes(), 0644))
+	}
+
+	out := filepath.Join(dir, "dictionaries.bin")
+	opts := dictionaryOptions{Samples: samples, Output: out, Size: 1024}
+	require.NoError(t, trainDictionaries(opts, modules))
+
+	contents, err := ioutil.ReadFile(out)
+	require.NoError(t, err)
+	dicts, err := dictionary.Decode(bytes.NewReader(contents))
+	require.NoError(t, err)
+	assert.Contains(t, dicts, dictionary.FingerprintOf(modules[0].Types["User"]))
+
+	t.Run("unknown type", func(t *testing.T) {
+		require.NoError(t, os.MkdirAll(filepath.Join(samples, "users.Group"), 0755))
+		defer os.RemoveAll(filepath.Join(samples, "users.Group"))
+
+		err := trainDictionaries(opts, modules)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), `Samples directory "users.Group" does not match a type`)
+	})
+}
+
+func TestSnapshot(t *testing.T) {
+	dir, err := ioutil.TempDir("", "thriftrw-snapshot")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	thriftFile := filepath.Join(dir, "users.thrift")
+	compileUsers := func(contents string) []*compile.Module {
+		require.NoError(t, ioutil.WriteFile(thriftFile, []byte(contents), 0644))
+		modules, err := compile.CompileFiles([]string{thriftFile})
+		require.NoError(t, err)
+		return modules
+	}
+
+	modules := compileUsers(`
+		struct User {
+			1: required string name
+		}
+	`)
+
+	opts := snapshotOptions{File: filepath.Join(dir, "thriftrw.snapshot"), Freeze: true}
+	require.NoError(t, freezeSnapshot(opts, dir, modules))
+	assert.NoError(t, checkSnapshot(opts, dir, modules))
+
+	modules = compileUsers(`
+		struct User {
+			1: required string name
+			2: optional string email
+		}
+	`)
+	err = checkSnapshot(opts, dir, modules)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "users.thrift:User: field 2 (email) was added")
+	assert.Contains(t, err.Error(), "run with --freeze")
+
+	require.NoError(t, freezeSnapshot(opts, dir, modules))
+	assert.NoError(t, checkSnapshot(opts, dir, modules))
+
+	t.Run("missing snapshot file", func(t *testing.T) {
+		err := freezeSnapshot(snapshotOptions{Freeze: true}, dir, modules)
+		assert.EqualError(t, err, "--freeze requires --snapshot")
+	})
+}
+
+func TestWriteDiagnostics(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, writeDiagnostics(&buf, nil))
+	assert.Equal(t, "[]\n", buf.String())
+
+	tmpDir, err := ioutil.TempDir("", "thriftrw-diagnostics-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	path := filepath.Join(tmpDir, "foo.thrift")
+	require.NoError(t, ioutil.WriteFile(path, []byte("struct Foo {\n  1 string foo\n}\n"), 0644))
+	_, err = compile.Compile(path)
+	require.Error(t, err)
+
+	buf.Reset()
+	require.NoError(t, writeDiagnostics(&buf, err))
+	assert.JSONEq(t, fmt.Sprintf(`[{
+		"file": %q,
+		"line": 2,
+		"column": 5,
+		"code": "syntax",
+		"message": "syntax error: unexpected STRING, expecting ':'"
+	}]`, path), buf.String())
+}
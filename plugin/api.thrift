@@ -193,6 +193,13 @@ struct Service {
      * ID of the module where this service was declared.
      */
     6: required ModuleID moduleID
+    /**
+     * Former names of this service in Go code, listed with the
+     * thriftrw.alias annotation. Plugins SHOULD generate aliases with these
+     * names for the types they generate for this service so that renaming
+     * the service does not break existing code.
+     */
+    8: optional list<string> aliases
 }
 
 /**
@@ -23,8 +23,6 @@
 
 package api
 
-
-
 // API_VERSION is the version of the plugin API.
 //
 // This MUST be provided in the HandshakeResponse.
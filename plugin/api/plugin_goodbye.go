@@ -25,8 +25,10 @@ package api
 
 import (
 	"fmt"
-	"go.uber.org/thriftrw/wire"
 	"strings"
+
+	"go.uber.org/thriftrw/runtime"
+	"go.uber.org/thriftrw/wire"
 )
 
 // Plugin_Goodbye_Args represents the arguments for the Plugin.goodbye function.
@@ -108,6 +110,20 @@ func (v *Plugin_Goodbye_Args) Equals(rhs *Plugin_Goodbye_Args) bool {
 	return true
 }
 
+// Clone returns a deep copy of this Plugin_Goodbye_Args.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Plugin_Goodbye_Args) Clone() *Plugin_Goodbye_Args {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
@@ -187,6 +203,11 @@ func init() {
 		return nil, err
 	}
 	Plugin_Goodbye_Helper.UnwrapResponse = func(result *Plugin_Goodbye_Result) (err error) {
+		if result.unexpectedException != nil {
+			err = result.unexpectedException
+			return
+		}
+
 		return
 	}
 
@@ -196,6 +217,10 @@ func init() {
 //
 // The result of a goodbye execution is sent and received over the wire as this struct.
 type Plugin_Goodbye_Result struct {
+
+	// Exception which was not recognized when this result was
+	// decoded.
+	unexpectedException *runtime.UnexpectedApplicationError
 }
 
 // ToWire translates a Plugin_Goodbye_Result struct into a Thrift-level intermediate
@@ -241,10 +266,17 @@ func (v *Plugin_Goodbye_Result) ToWire() (wire.Value, error) {
 //   return &v, nil
 func (v *Plugin_Goodbye_Result) FromWire(w wire.Value) error {
 
+	var unknown wire.UnknownFields
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
+		default:
+			if err := unknown.Add(field); err != nil {
+				return err
+			}
 		}
 	}
+	v.unexpectedException = runtime.FindUnexpectedException("Plugin_Goodbye_Result", unknown)
 
 	return nil
 }
@@ -271,6 +303,20 @@ func (v *Plugin_Goodbye_Result) Equals(rhs *Plugin_Goodbye_Result) bool {
 	return true
 }
 
+// Clone returns a deep copy of this Plugin_Goodbye_Result.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Plugin_Goodbye_Result) Clone() *Plugin_Goodbye_Result {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
@@ -285,3 +331,13 @@ func (v *Plugin_Goodbye_Result) MethodName() string {
 func (v *Plugin_Goodbye_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
+
+// UnwrapResponse returns the error returned by
+// goodbye.
+//
+// The error is an exception thrown by goodbye, or a
+// *runtime.UnexpectedApplicationError if the result holds an
+// exception which is not declared in the IDL.
+func (v *Plugin_Goodbye_Result) UnwrapResponse() error {
+	return Plugin_Goodbye_Helper.UnwrapResponse(v)
+}
@@ -26,8 +26,10 @@ package api
 import (
 	"errors"
 	"fmt"
-	"go.uber.org/thriftrw/wire"
 	"strings"
+
+	"go.uber.org/thriftrw/runtime"
+	"go.uber.org/thriftrw/wire"
 )
 
 // Plugin_Handshake_Args represents the arguments for the Plugin.handshake function.
@@ -60,6 +62,10 @@ func (v *Plugin_Handshake_Args) ToWire() (wire.Value, error) {
 		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("Plugin_Handshake_Args is nil")
+	}
+
 	if v.Request != nil {
 		w, err = v.Request.ToWire()
 		if err != nil {
@@ -143,6 +149,40 @@ func (v *Plugin_Handshake_Args) Equals(rhs *Plugin_Handshake_Args) bool {
 	return true
 }
 
+// Clone returns a deep copy of this Plugin_Handshake_Args.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Plugin_Handshake_Args) Clone() *Plugin_Handshake_Args {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Request = v.Request.Clone()
+
+	return &o
+}
+
+// GetRequest returns the value of Request if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Plugin_Handshake_Args.
+func (v *Plugin_Handshake_Args) GetRequest() (o *HandshakeRequest) {
+	if v != nil && v.Request != nil {
+		return v.Request
+	}
+
+	return
+}
+
+// IsSetRequest returns true if Request is not nil.
+//
+// This is safe to call on a nil Plugin_Handshake_Args.
+func (v *Plugin_Handshake_Args) IsSetRequest() bool {
+	return v != nil && v.Request != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the arguments.
 //
@@ -227,6 +267,10 @@ func init() {
 		return nil, err
 	}
 	Plugin_Handshake_Helper.UnwrapResponse = func(result *Plugin_Handshake_Result) (success *HandshakeResponse, err error) {
+		if result.unexpectedException != nil {
+			err = result.unexpectedException
+			return
+		}
 
 		if result.Success != nil {
 			success = result.Success
@@ -247,6 +291,10 @@ func init() {
 type Plugin_Handshake_Result struct {
 	// Value returned by handshake after a successful execution.
 	Success *HandshakeResponse `json:"success,omitempty"`
+
+	// Exception which was not recognized when this result was
+	// decoded.
+	unexpectedException *runtime.UnexpectedApplicationError
 }
 
 // ToWire translates a Plugin_Handshake_Result struct into a Thrift-level intermediate
@@ -272,6 +320,10 @@ func (v *Plugin_Handshake_Result) ToWire() (wire.Value, error) {
 		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("Plugin_Handshake_Result is nil")
+	}
+
 	if v.Success != nil {
 		w, err = v.Success.ToWire()
 		if err != nil {
@@ -314,6 +366,8 @@ func _HandshakeResponse_Read(w wire.Value) (*HandshakeResponse, error) {
 func (v *Plugin_Handshake_Result) FromWire(w wire.Value) error {
 	var err error
 
+	var unknown wire.UnknownFields
+
 	for _, field := range w.GetStruct().Fields {
 		switch field.ID {
 		case 0:
@@ -324,13 +378,21 @@ func (v *Plugin_Handshake_Result) FromWire(w wire.Value) error {
 				}
 
 			}
+		default:
+			if err = unknown.Add(field); err != nil {
+				return err
+			}
 		}
 	}
+	v.unexpectedException = runtime.FindUnexpectedException("Plugin_Handshake_Result", unknown)
 
 	count := 0
 	if v.Success != nil {
 		count++
 	}
+	if v.unexpectedException != nil {
+		count++
+	}
 	if count != 1 {
 		return fmt.Errorf("Plugin_Handshake_Result should have exactly one field: got %v fields", count)
 	}
@@ -367,6 +429,40 @@ func (v *Plugin_Handshake_Result) Equals(rhs *Plugin_Handshake_Result) bool {
 	return true
 }
 
+// Clone returns a deep copy of this Plugin_Handshake_Result.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Plugin_Handshake_Result) Clone() *Plugin_Handshake_Result {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Success = v.Success.Clone()
+
+	return &o
+}
+
+// GetSuccess returns the value of Success if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Plugin_Handshake_Result.
+func (v *Plugin_Handshake_Result) GetSuccess() (o *HandshakeResponse) {
+	if v != nil && v.Success != nil {
+		return v.Success
+	}
+
+	return
+}
+
+// IsSetSuccess returns true if Success is not nil.
+//
+// This is safe to call on a nil Plugin_Handshake_Result.
+func (v *Plugin_Handshake_Result) IsSetSuccess() bool {
+	return v != nil && v.Success != nil
+}
+
 // MethodName returns the name of the Thrift function as specified in
 // the IDL, for which this struct represent the result.
 //
@@ -381,3 +477,13 @@ func (v *Plugin_Handshake_Result) MethodName() string {
 func (v *Plugin_Handshake_Result) EnvelopeType() wire.EnvelopeType {
 	return wire.Reply
 }
+
+// UnwrapResponse returns the value or error returned by
+// handshake.
+//
+// The error is an exception thrown by handshake, or a
+// *runtime.UnexpectedApplicationError if the result holds an
+// exception which is not declared in the IDL.
+func (v *Plugin_Handshake_Result) UnwrapResponse() (*HandshakeResponse, error) {
+	return Plugin_Handshake_Helper.UnwrapResponse(v)
+}
@@ -28,10 +28,11 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"go.uber.org/thriftrw/wire"
 	"math"
 	"strconv"
 	"strings"
+
+	"go.uber.org/thriftrw/wire"
 )
 
 // Argument is a single Argument inside a Function.
@@ -75,10 +76,11 @@ func (v *Argument) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	w, err = wire.NewValueString(v.Name), error(nil)
-	if err != nil {
-		return w, err
+	if v == nil {
+		return wire.Value{}, errors.New("Argument is nil")
 	}
+
+	w = wire.NewValueString(v.Name)
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 	if v.Type == nil {
@@ -127,10 +129,7 @@ func (v *Argument) FromWire(w wire.Value) error {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TBinary {
-				v.Name, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.Name = field.Value.GetString()
 				nameIsSet = true
 			}
 		case 2:
@@ -187,6 +186,43 @@ func (v *Argument) Equals(rhs *Argument) bool {
 	return true
 }
 
+// Clone returns a deep copy of this Argument.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Argument) Clone() *Argument {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Type = v.Type.Clone()
+
+	return &o
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Argument.
+func (v *Argument) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetType returns the value of Type if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Argument.
+func (v *Argument) GetType() (o *Type) {
+	if v != nil {
+		o = v.Type
+	}
+	return
+}
+
 // Feature is a functionality offered by a ThriftRW plugin.
 type Feature int32
 
@@ -314,6 +350,80 @@ func (v *Feature) UnmarshalJSON(text []byte) error {
 	}
 }
 
+// Feature_NumValues is the number of distinct recognized
+// values of Feature.
+const Feature_NumValues = 1
+
+// Ordinal returns the position of this value among the distinct
+// recognized values of Feature or false if the value is not
+// recognized. Ordinals are less than Feature_NumValues.
+//
+// Ordinals may be used to index arrays of length
+// Feature_NumValues in place of map[Feature]T.
+//
+//   var counts [Feature_NumValues]int
+//   if i, ok := v.Ordinal(); ok {
+//     counts[i]++
+//   }
+func (v Feature) Ordinal() (int, bool) {
+	switch int32(v) {
+	case 1:
+		return 0, true
+	default:
+		return 0, false
+	}
+}
+
+// Feature_Set is a set of Feature values backed by a
+// bitset. The zero value is an empty set.
+type Feature_Set struct {
+	bits [1]uint64
+}
+
+// Add adds the given value to the set. It returns false if the value
+// is not a recognized value of Feature.
+func (s *Feature_Set) Add(v Feature) bool {
+	i, ok := v.Ordinal()
+	if ok {
+		s.bits[i/64] |= 1 << uint(i%64)
+	}
+	return ok
+}
+
+// Remove removes the given value from the set.
+func (s *Feature_Set) Remove(v Feature) {
+	if i, ok := v.Ordinal(); ok {
+		s.bits[i/64] &^= 1 << uint(i%64)
+	}
+}
+
+// Contains returns true if the given value is in the set.
+func (s *Feature_Set) Contains(v Feature) bool {
+	i, ok := v.Ordinal()
+	return ok && s.bits[i/64]&(1<<uint(i%64)) != 0
+}
+
+// Len returns the number of values in the set.
+func (s *Feature_Set) Len() int {
+	n := 0
+	for _, x := range s.bits {
+		for ; x != 0; n++ {
+			x &= x - 1
+		}
+	}
+	return n
+}
+
+// Values returns the values in the set in the order in which they
+// were declared.
+func (s *Feature_Set) Values() []Feature {
+	v := make([]Feature, 0, s.Len())
+	if s.bits[0]&(1<<0) != 0 {
+		v = append(v, FeatureServiceGenerator)
+	}
+	return v
+}
+
 // Function is a single function on a Thrift service.
 type Function struct {
 	// Name of the Go function.
@@ -389,26 +499,21 @@ func (v *Function) ToWire() (wire.Value, error) {
 		err    error
 	)
 
-	w, err = wire.NewValueString(v.Name), error(nil)
-	if err != nil {
-		return w, err
+	if v == nil {
+		return wire.Value{}, errors.New("Function is nil")
 	}
+
+	w = wire.NewValueString(v.Name)
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 
-	w, err = wire.NewValueString(v.ThriftName), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueString(v.ThriftName)
 	fields[i] = wire.Field{ID: 2, Value: w}
 	i++
 	if v.Arguments == nil {
 		return w, errors.New("field Arguments of Function is required")
 	}
-	w, err = wire.NewValueList(_List_Argument_ValueList(v.Arguments)), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueList(_List_Argument_ValueList(v.Arguments))
 	fields[i] = wire.Field{ID: 3, Value: w}
 	i++
 	if v.ReturnType != nil {
@@ -420,18 +525,12 @@ func (v *Function) ToWire() (wire.Value, error) {
 		i++
 	}
 	if v.Exceptions != nil {
-		w, err = wire.NewValueList(_List_Argument_ValueList(v.Exceptions)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueList(_List_Argument_ValueList(v.Exceptions))
 		fields[i] = wire.Field{ID: 5, Value: w}
 		i++
 	}
 	if v.OneWay != nil {
-		w, err = wire.NewValueBool(*(v.OneWay)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueBool(*(v.OneWay))
 		fields[i] = wire.Field{ID: 6, Value: w}
 		i++
 	}
@@ -491,18 +590,12 @@ func (v *Function) FromWire(w wire.Value) error {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TBinary {
-				v.Name, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.Name = field.Value.GetString()
 				nameIsSet = true
 			}
 		case 2:
 			if field.Value.Type() == wire.TBinary {
-				v.ThriftName, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.ThriftName = field.Value.GetString()
 				thriftNameIsSet = true
 			}
 		case 3:
@@ -531,12 +624,8 @@ func (v *Function) FromWire(w wire.Value) error {
 			}
 		case 6:
 			if field.Value.Type() == wire.TBool {
-				var x bool
-				x, err = field.Value.GetBool(), error(nil)
+				x := field.Value.GetBool()
 				v.OneWay = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		}
@@ -640,16 +729,135 @@ func (v *Function) Equals(rhs *Function) bool {
 	return true
 }
 
+func _List_Argument_Clone(l []*Argument) []*Argument {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]*Argument, len(l))
+	for i, x := range l {
+		o[i] = x.Clone()
+	}
+	return o
+}
+
+func _Bool_ClonePtr(p *bool) *bool {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this Function.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Function) Clone() *Function {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Arguments = _List_Argument_Clone(v.Arguments)
+	o.ReturnType = v.ReturnType.Clone()
+	o.Exceptions = _List_Argument_Clone(v.Exceptions)
+	o.OneWay = _Bool_ClonePtr(v.OneWay)
+
+	return &o
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Function.
+func (v *Function) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetThriftName returns the value of ThriftName if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Function.
+func (v *Function) GetThriftName() (o string) {
+	if v != nil {
+		o = v.ThriftName
+	}
+	return
+}
+
+// GetArguments returns the value of Arguments if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Function.
+func (v *Function) GetArguments() (o []*Argument) {
+	if v != nil {
+		o = v.Arguments
+	}
+	return
+}
+
+// GetReturnType returns the value of ReturnType if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Function.
+func (v *Function) GetReturnType() (o *Type) {
+	if v != nil && v.ReturnType != nil {
+		return v.ReturnType
+	}
+
+	return
+}
+
+// IsSetReturnType returns true if ReturnType is not nil.
+//
+// This is safe to call on a nil Function.
+func (v *Function) IsSetReturnType() bool {
+	return v != nil && v.ReturnType != nil
+}
+
+// GetExceptions returns the value of Exceptions if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Function.
+func (v *Function) GetExceptions() (o []*Argument) {
+	if v != nil && v.Exceptions != nil {
+		return v.Exceptions
+	}
+
+	return
+}
+
+// IsSetExceptions returns true if Exceptions is not nil.
+//
+// This is safe to call on a nil Function.
+func (v *Function) IsSetExceptions() bool {
+	return v != nil && v.Exceptions != nil
+}
+
 // GetOneWay returns the value of OneWay if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil Function.
 func (v *Function) GetOneWay() (o bool) {
-	if v.OneWay != nil {
+	if v != nil && v.OneWay != nil {
 		return *v.OneWay
 	}
 
 	return
 }
 
+// IsSetOneWay returns true if OneWay is not nil.
+//
+// This is safe to call on a nil Function.
+func (v *Function) IsSetOneWay() bool {
+	return v != nil && v.OneWay != nil
+}
+
 // GenerateServiceRequest is a request to generate code for zero or more
 // Thrift services.
 type GenerateServiceRequest struct {
@@ -714,8 +922,7 @@ func (m _Map_ServiceID_Service_MapItemList) ForEach(f func(wire.MapItem) error)
 		if err != nil {
 			return err
 		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
+		if err = f(wire.MapItem{Key: kw, Value: vw}); err != nil {
 			return err
 		}
 	}
@@ -752,8 +959,7 @@ func (m _Map_ModuleID_Module_MapItemList) ForEach(f func(wire.MapItem) error) er
 		if err != nil {
 			return err
 		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
+		if err = f(wire.MapItem{Key: kw, Value: vw}); err != nil {
 			return err
 		}
 	}
@@ -794,34 +1000,28 @@ func (v *GenerateServiceRequest) ToWire() (wire.Value, error) {
 		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("GenerateServiceRequest is nil")
+	}
+
 	if v.RootServices == nil {
 		return w, errors.New("field RootServices of GenerateServiceRequest is required")
 	}
-	w, err = wire.NewValueList(_List_ServiceID_ValueList(v.RootServices)), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueList(_List_ServiceID_ValueList(v.RootServices))
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 	if v.Services == nil {
 		return w, errors.New("field Services of GenerateServiceRequest is required")
 	}
-	w, err = wire.NewValueMap(_Map_ServiceID_Service_MapItemList(v.Services)), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueMap(_Map_ServiceID_Service_MapItemList(v.Services))
 	fields[i] = wire.Field{ID: 2, Value: w}
 	i++
 	if v.Modules == nil {
 		return w, errors.New("field Modules of GenerateServiceRequest is required")
 	}
-	w, err = wire.NewValueMap(_Map_ModuleID_Module_MapItemList(v.Modules)), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueMap(_Map_ModuleID_Module_MapItemList(v.Modules))
 	fields[i] = wire.Field{ID: 3, Value: w}
 	i++
 
@@ -1080,6 +1280,94 @@ func (v *GenerateServiceRequest) Equals(rhs *GenerateServiceRequest) bool {
 	return true
 }
 
+func _List_ServiceID_Clone(l []ServiceID) []ServiceID {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]ServiceID, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
+}
+
+func _Map_ServiceID_Service_Clone(m map[ServiceID]*Service) map[ServiceID]*Service {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[ServiceID]*Service, len(m))
+	for k, v := range m {
+		o[k] = v.Clone()
+	}
+
+	return o
+}
+
+func _Map_ModuleID_Module_Clone(m map[ModuleID]*Module) map[ModuleID]*Module {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[ModuleID]*Module, len(m))
+	for k, v := range m {
+		o[k] = v.Clone()
+	}
+
+	return o
+}
+
+// Clone returns a deep copy of this GenerateServiceRequest.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *GenerateServiceRequest) Clone() *GenerateServiceRequest {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.RootServices = _List_ServiceID_Clone(v.RootServices)
+	o.Services = _Map_ServiceID_Service_Clone(v.Services)
+	o.Modules = _Map_ModuleID_Module_Clone(v.Modules)
+
+	return &o
+}
+
+// GetRootServices returns the value of RootServices if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil GenerateServiceRequest.
+func (v *GenerateServiceRequest) GetRootServices() (o []ServiceID) {
+	if v != nil {
+		o = v.RootServices
+	}
+	return
+}
+
+// GetServices returns the value of Services if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil GenerateServiceRequest.
+func (v *GenerateServiceRequest) GetServices() (o map[ServiceID]*Service) {
+	if v != nil {
+		o = v.Services
+	}
+	return
+}
+
+// GetModules returns the value of Modules if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil GenerateServiceRequest.
+func (v *GenerateServiceRequest) GetModules() (o map[ModuleID]*Module) {
+	if v != nil {
+		o = v.Modules
+	}
+	return
+}
+
 // GenerateServiceResponse is response to a GenerateServiceRequest.
 type GenerateServiceResponse struct {
 	// Map of file path to file contents.
@@ -1099,17 +1387,10 @@ func (m _Map_String_Binary_MapItemList) ForEach(f func(wire.MapItem) error) erro
 		if v == nil {
 			return fmt.Errorf("invalid [%v]: value is nil", k)
 		}
-		kw, err := wire.NewValueString(k), error(nil)
-		if err != nil {
-			return err
-		}
+		kw := wire.NewValueString(k)
 
-		vw, err := wire.NewValueBinary(v), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
+		vw := wire.NewValueBinary(v)
+		if err := f(wire.MapItem{Key: kw, Value: vw}); err != nil {
 			return err
 		}
 	}
@@ -1150,14 +1431,14 @@ func (v *GenerateServiceResponse) ToWire() (wire.Value, error) {
 		fields [1]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("GenerateServiceResponse is nil")
+	}
+
 	if v.Files != nil {
-		w, err = wire.NewValueMap(_Map_String_Binary_MapItemList(v.Files)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueMap(_Map_String_Binary_MapItemList(v.Files))
 		fields[i] = wire.Field{ID: 1, Value: w}
 		i++
 	}
@@ -1176,15 +1457,9 @@ func _Map_String_Binary_Read(m wire.MapItemList) (map[string][]byte, error) {
 
 	o := make(map[string][]byte, m.Size())
 	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
+		k := x.Key.GetString()
 
-		v, err := x.Value.GetBinary(), error(nil)
-		if err != nil {
-			return err
-		}
+		v := x.Value.GetBinary()
 
 		o[k] = v
 		return nil
@@ -1275,20 +1550,77 @@ func (v *GenerateServiceResponse) Equals(rhs *GenerateServiceResponse) bool {
 	return true
 }
 
-// HandshakeRequest is the initial request sent to the plugin as part of
-// establishing communication and feature negotiation.
-type HandshakeRequest struct {
+func _Binary_Clone(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+
+	o := make([]byte, len(b))
+	copy(o, b)
+	return o
 }
 
-// ToWire translates a HandshakeRequest struct into a Thrift-level intermediate
-// representation. This intermediate representation may be serialized
-// into bytes using a ThriftRW protocol implementation.
+func _Map_String_Binary_Clone(m map[string][]byte) map[string][]byte {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[string][]byte, len(m))
+	for k, v := range m {
+		o[k] = _Binary_Clone(v)
+	}
+
+	return o
+}
+
+// Clone returns a deep copy of this GenerateServiceResponse.
 //
-// An error is returned if the struct or any of its fields failed to
-// validate.
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *GenerateServiceResponse) Clone() *GenerateServiceResponse {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Files = _Map_String_Binary_Clone(v.Files)
+
+	return &o
+}
+
+// GetFiles returns the value of Files if it is set or its
+// zero value if it is unset.
 //
-//   x, err := v.ToWire()
-//   if err != nil {
+// This is safe to call on a nil GenerateServiceResponse.
+func (v *GenerateServiceResponse) GetFiles() (o map[string][]byte) {
+	if v != nil && v.Files != nil {
+		return v.Files
+	}
+
+	return
+}
+
+// IsSetFiles returns true if Files is not nil.
+//
+// This is safe to call on a nil GenerateServiceResponse.
+func (v *GenerateServiceResponse) IsSetFiles() bool {
+	return v != nil && v.Files != nil
+}
+
+// HandshakeRequest is the initial request sent to the plugin as part of
+// establishing communication and feature negotiation.
+type HandshakeRequest struct {
+}
+
+// ToWire translates a HandshakeRequest struct into a Thrift-level intermediate
+// representation. This intermediate representation may be serialized
+// into bytes using a ThriftRW protocol implementation.
+//
+// An error is returned if the struct or any of its fields failed to
+// validate.
+//
+//   x, err := v.ToWire()
+//   if err != nil {
 //     return err
 //   }
 //
@@ -1353,6 +1685,20 @@ func (v *HandshakeRequest) Equals(rhs *HandshakeRequest) bool {
 	return true
 }
 
+// Clone returns a deep copy of this HandshakeRequest.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *HandshakeRequest) Clone() *HandshakeRequest {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
 // HandshakeResponse is the response from the plugin for a HandshakeRequest.
 type HandshakeResponse struct {
 	// Name of the plugin. This MUST match the name of the plugin specified
@@ -1417,36 +1763,27 @@ func (v *HandshakeResponse) ToWire() (wire.Value, error) {
 		fields [4]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
-	w, err = wire.NewValueString(v.Name), error(nil)
-	if err != nil {
-		return w, err
+	if v == nil {
+		return wire.Value{}, errors.New("HandshakeResponse is nil")
 	}
+
+	w = wire.NewValueString(v.Name)
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 
-	w, err = wire.NewValueI32(v.APIVersion), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueI32(v.APIVersion)
 	fields[i] = wire.Field{ID: 2, Value: w}
 	i++
 	if v.Features == nil {
 		return w, errors.New("field Features of HandshakeResponse is required")
 	}
-	w, err = wire.NewValueList(_List_Feature_ValueList(v.Features)), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueList(_List_Feature_ValueList(v.Features))
 	fields[i] = wire.Field{ID: 3, Value: w}
 	i++
 	if v.LibraryVersion != nil {
-		w, err = wire.NewValueString(*(v.LibraryVersion)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueString(*(v.LibraryVersion))
 		fields[i] = wire.Field{ID: 4, Value: w}
 		i++
 	}
@@ -1506,18 +1843,12 @@ func (v *HandshakeResponse) FromWire(w wire.Value) error {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TBinary {
-				v.Name, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.Name = field.Value.GetString()
 				nameIsSet = true
 			}
 		case 2:
 			if field.Value.Type() == wire.TI32 {
-				v.APIVersion, err = field.Value.GetI32(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.APIVersion = field.Value.GetI32()
 				apiVersionIsSet = true
 			}
 		case 3:
@@ -1530,12 +1861,8 @@ func (v *HandshakeResponse) FromWire(w wire.Value) error {
 			}
 		case 4:
 			if field.Value.Type() == wire.TBinary {
-				var x string
-				x, err = field.Value.GetString(), error(nil)
+				x := field.Value.GetString()
 				v.LibraryVersion = &x
-				if err != nil {
-					return err
-				}
 
 			}
 		}
@@ -1625,16 +1952,95 @@ func (v *HandshakeResponse) Equals(rhs *HandshakeResponse) bool {
 	return true
 }
 
+func _List_Feature_Clone(l []Feature) []Feature {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]Feature, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
+}
+
+func _String_ClonePtr(p *string) *string {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this HandshakeResponse.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *HandshakeResponse) Clone() *HandshakeResponse {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Features = _List_Feature_Clone(v.Features)
+	o.LibraryVersion = _String_ClonePtr(v.LibraryVersion)
+
+	return &o
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil HandshakeResponse.
+func (v *HandshakeResponse) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetAPIVersion returns the value of APIVersion if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil HandshakeResponse.
+func (v *HandshakeResponse) GetAPIVersion() (o int32) {
+	if v != nil {
+		o = v.APIVersion
+	}
+	return
+}
+
+// GetFeatures returns the value of Features if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil HandshakeResponse.
+func (v *HandshakeResponse) GetFeatures() (o []Feature) {
+	if v != nil {
+		o = v.Features
+	}
+	return
+}
+
 // GetLibraryVersion returns the value of LibraryVersion if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil HandshakeResponse.
 func (v *HandshakeResponse) GetLibraryVersion() (o string) {
-	if v.LibraryVersion != nil {
+	if v != nil && v.LibraryVersion != nil {
 		return *v.LibraryVersion
 	}
 
 	return
 }
 
+// IsSetLibraryVersion returns true if LibraryVersion is not nil.
+//
+// This is safe to call on a nil HandshakeResponse.
+func (v *HandshakeResponse) IsSetLibraryVersion() bool {
+	return v != nil && v.LibraryVersion != nil
+}
+
 // Module is a module generated from a single Thrift file. Each module
 // corresponds to exactly one Thrift file and contains all the types and
 // constants defined in that Thrift file.
@@ -1669,20 +2075,17 @@ func (v *Module) ToWire() (wire.Value, error) {
 		fields [2]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
-	w, err = wire.NewValueString(v.ImportPath), error(nil)
-	if err != nil {
-		return w, err
+	if v == nil {
+		return wire.Value{}, errors.New("Module is nil")
 	}
+
+	w = wire.NewValueString(v.ImportPath)
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 
-	w, err = wire.NewValueString(v.Directory), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueString(v.Directory)
 	fields[i] = wire.Field{ID: 2, Value: w}
 	i++
 
@@ -1707,7 +2110,6 @@ func (v *Module) ToWire() (wire.Value, error) {
 //   }
 //   return &v, nil
 func (v *Module) FromWire(w wire.Value) error {
-	var err error
 
 	importPathIsSet := false
 	directoryIsSet := false
@@ -1716,18 +2118,12 @@ func (v *Module) FromWire(w wire.Value) error {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TBinary {
-				v.ImportPath, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.ImportPath = field.Value.GetString()
 				importPathIsSet = true
 			}
 		case 2:
 			if field.Value.Type() == wire.TBinary {
-				v.Directory, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.Directory = field.Value.GetString()
 				directoryIsSet = true
 			}
 		}
@@ -1776,6 +2172,42 @@ func (v *Module) Equals(rhs *Module) bool {
 	return true
 }
 
+// Clone returns a deep copy of this Module.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Module) Clone() *Module {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+
+	return &o
+}
+
+// GetImportPath returns the value of ImportPath if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Module.
+func (v *Module) GetImportPath() (o string) {
+	if v != nil {
+		o = v.ImportPath
+	}
+	return
+}
+
+// GetDirectory returns the value of Directory if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Module.
+func (v *Module) GetDirectory() (o string) {
+	if v != nil {
+		o = v.Directory
+	}
+	return
+}
+
 // ModuleID is an arbitrary unique identifier to reference the different
 // modules in this request.
 type ModuleID int32
@@ -1785,7 +2217,7 @@ type ModuleID int32
 // into bytes using a ThriftRW protocol implementation.
 func (v ModuleID) ToWire() (wire.Value, error) {
 	x := (int32)(v)
-	return wire.NewValueI32(x), error(nil)
+	return wire.NewValueI32(x), nil
 }
 
 // String returns a readable string representation of ModuleID.
@@ -1798,9 +2230,8 @@ func (v ModuleID) String() string {
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 func (v *ModuleID) FromWire(w wire.Value) error {
-	x, err := w.GetI32(), error(nil)
-	*v = (ModuleID)(x)
-	return err
+	*v = (ModuleID)(w.GetI32())
+	return nil
 }
 
 // Equals returns true if this ModuleID is equal to the provided
@@ -1809,6 +2240,12 @@ func (lhs ModuleID) Equals(rhs ModuleID) bool {
 	return (lhs == rhs)
 }
 
+// Clone returns a deep copy of this ModuleID.
+func (v ModuleID) Clone() ModuleID {
+	x := (int32)(v)
+	return (ModuleID)(x)
+}
+
 // Service is a service defined by the user in the Thrift file.
 type Service struct {
 	// Name of the Thrift service in Go code.
@@ -1821,6 +2258,11 @@ type Service struct {
 	Functions []*Function `json:"functions,required"`
 	// ID of the module where this service was declared.
 	ModuleID ModuleID `json:"moduleID,required"`
+	// Former names of this service in Go code, listed with the
+	// thriftrw.alias annotation. Plugins SHOULD generate aliases with these
+	// names for the types they generate for this service so that renaming
+	// the service does not break existing code.
+	Aliases []string `json:"aliases,omitempty"`
 }
 
 type _List_Function_ValueList []*Function
@@ -1852,6 +2294,27 @@ func (_List_Function_ValueList) ValueType() wire.Type {
 
 func (_List_Function_ValueList) Close() {}
 
+type _List_String_ValueList []string
+
+func (v _List_String_ValueList) ForEach(f func(wire.Value) error) error {
+	for _, x := range v {
+		if err := f(wire.NewValueString(x)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (v _List_String_ValueList) Size() int {
+	return len(v)
+}
+
+func (_List_String_ValueList) ValueType() wire.Type {
+	return wire.TBinary
+}
+
+func (_List_String_ValueList) Close() {}
+
 // ToWire translates a Service struct into a Thrift-level intermediate
 // representation. This intermediate representation may be serialized
 // into bytes using a ThriftRW protocol implementation.
@@ -1869,23 +2332,21 @@ func (_List_Function_ValueList) Close() {}
 //   }
 func (v *Service) ToWire() (wire.Value, error) {
 	var (
-		fields [5]wire.Field
+		fields [6]wire.Field
 		i      int = 0
 		w      wire.Value
 		err    error
 	)
 
-	w, err = wire.NewValueString(v.Name), error(nil)
-	if err != nil {
-		return w, err
+	if v == nil {
+		return wire.Value{}, errors.New("Service is nil")
 	}
+
+	w = wire.NewValueString(v.Name)
 	fields[i] = wire.Field{ID: 7, Value: w}
 	i++
 
-	w, err = wire.NewValueString(v.ThriftName), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueString(v.ThriftName)
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 	if v.ParentID != nil {
@@ -1899,10 +2360,7 @@ func (v *Service) ToWire() (wire.Value, error) {
 	if v.Functions == nil {
 		return w, errors.New("field Functions of Service is required")
 	}
-	w, err = wire.NewValueList(_List_Function_ValueList(v.Functions)), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueList(_List_Function_ValueList(v.Functions))
 	fields[i] = wire.Field{ID: 5, Value: w}
 	i++
 
@@ -1912,6 +2370,11 @@ func (v *Service) ToWire() (wire.Value, error) {
 	}
 	fields[i] = wire.Field{ID: 6, Value: w}
 	i++
+	if v.Aliases != nil {
+		w = wire.NewValueList(_List_String_ValueList(v.Aliases))
+		fields[i] = wire.Field{ID: 8, Value: w}
+		i++
+	}
 
 	return wire.NewValueStruct(wire.Struct{Fields: fields[:i]}), nil
 }
@@ -1940,6 +2403,20 @@ func _List_Function_Read(l wire.ValueList) ([]*Function, error) {
 	return o, err
 }
 
+func _List_String_Read(l wire.ValueList) ([]string, error) {
+	if l.ValueType() != wire.TBinary {
+		return nil, nil
+	}
+
+	o := make([]string, 0, l.Size())
+	err := l.ForEach(func(x wire.Value) error {
+		o = append(o, x.GetString())
+		return nil
+	})
+	l.Close()
+	return o, err
+}
+
 // FromWire deserializes a Service struct from its Thrift-level
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
@@ -1970,18 +2447,12 @@ func (v *Service) FromWire(w wire.Value) error {
 		switch field.ID {
 		case 7:
 			if field.Value.Type() == wire.TBinary {
-				v.Name, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.Name = field.Value.GetString()
 				nameIsSet = true
 			}
 		case 1:
 			if field.Value.Type() == wire.TBinary {
-				v.ThriftName, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.ThriftName = field.Value.GetString()
 				thriftNameIsSet = true
 			}
 		case 4:
@@ -2010,6 +2481,14 @@ func (v *Service) FromWire(w wire.Value) error {
 				}
 				moduleIDIsSet = true
 			}
+		case 8:
+			if field.Value.Type() == wire.TList {
+				v.Aliases, err = _List_String_Read(field.Value.GetList())
+				if err != nil {
+					return err
+				}
+
+			}
 		}
 	}
 
@@ -2039,7 +2518,7 @@ func (v *Service) String() string {
 		return "<nil>"
 	}
 
-	var fields [5]string
+	var fields [6]string
 	i := 0
 	fields[i] = fmt.Sprintf("Name: %v", v.Name)
 	i++
@@ -2053,6 +2532,10 @@ func (v *Service) String() string {
 	i++
 	fields[i] = fmt.Sprintf("ModuleID: %v", v.ModuleID)
 	i++
+	if v.Aliases != nil {
+		fields[i] = fmt.Sprintf("Aliases: %v", v.Aliases)
+		i++
+	}
 
 	return fmt.Sprintf("Service{%v}", strings.Join(fields[:i], ", "))
 }
@@ -2082,6 +2565,21 @@ func _List_Function_Equals(lhs, rhs []*Function) bool {
 	return true
 }
 
+func _List_String_Equals(lhs, rhs []string) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+
+	for i, lv := range lhs {
+		rv := rhs[i]
+		if !(lv == rv) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Equals returns true if all the fields of this Service match the
 // provided Service.
 //
@@ -2102,20 +2600,145 @@ func (v *Service) Equals(rhs *Service) bool {
 	if !(v.ModuleID == rhs.ModuleID) {
 		return false
 	}
+	if !((v.Aliases == nil && rhs.Aliases == nil) || (v.Aliases != nil && rhs.Aliases != nil && _List_String_Equals(v.Aliases, rhs.Aliases))) {
+		return false
+	}
 
 	return true
 }
 
+func _ServiceID_ClonePtr(p *ServiceID) *ServiceID {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+func _List_Function_Clone(l []*Function) []*Function {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]*Function, len(l))
+	for i, x := range l {
+		o[i] = x.Clone()
+	}
+	return o
+}
+
+func _List_String_Clone(l []string) []string {
+	if l == nil {
+		return nil
+	}
+
+	o := make([]string, len(l))
+	for i, x := range l {
+		o[i] = x
+	}
+	return o
+}
+
+// Clone returns a deep copy of this Service.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Service) Clone() *Service {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.ParentID = _ServiceID_ClonePtr(v.ParentID)
+	o.Functions = _List_Function_Clone(v.Functions)
+	o.Aliases = _List_String_Clone(v.Aliases)
+
+	return &o
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Service.
+func (v *Service) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetThriftName returns the value of ThriftName if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Service.
+func (v *Service) GetThriftName() (o string) {
+	if v != nil {
+		o = v.ThriftName
+	}
+	return
+}
+
 // GetParentID returns the value of ParentID if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil Service.
 func (v *Service) GetParentID() (o ServiceID) {
-	if v.ParentID != nil {
+	if v != nil && v.ParentID != nil {
 		return *v.ParentID
 	}
 
 	return
 }
 
+// IsSetParentID returns true if ParentID is not nil.
+//
+// This is safe to call on a nil Service.
+func (v *Service) IsSetParentID() bool {
+	return v != nil && v.ParentID != nil
+}
+
+// GetFunctions returns the value of Functions if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Service.
+func (v *Service) GetFunctions() (o []*Function) {
+	if v != nil {
+		o = v.Functions
+	}
+	return
+}
+
+// GetModuleID returns the value of ModuleID if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Service.
+func (v *Service) GetModuleID() (o ModuleID) {
+	if v != nil {
+		o = v.ModuleID
+	}
+	return
+}
+
+// GetAliases returns the value of Aliases if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Service.
+func (v *Service) GetAliases() (o []string) {
+	if v != nil && v.Aliases != nil {
+		return v.Aliases
+	}
+
+	return
+}
+
+// IsSetAliases returns true if Aliases is not nil.
+//
+// This is safe to call on a nil Service.
+func (v *Service) IsSetAliases() bool {
+	return v != nil && v.Aliases != nil
+}
+
 // ServiceID is an arbitrary unique identifier to reference the different
 // services in this request.
 type ServiceID int32
@@ -2125,7 +2748,7 @@ type ServiceID int32
 // into bytes using a ThriftRW protocol implementation.
 func (v ServiceID) ToWire() (wire.Value, error) {
 	x := (int32)(v)
-	return wire.NewValueI32(x), error(nil)
+	return wire.NewValueI32(x), nil
 }
 
 // String returns a readable string representation of ServiceID.
@@ -2138,9 +2761,8 @@ func (v ServiceID) String() string {
 // representation. The Thrift-level representation may be obtained
 // from a ThriftRW protocol implementation.
 func (v *ServiceID) FromWire(w wire.Value) error {
-	x, err := w.GetI32(), error(nil)
-	*v = (ServiceID)(x)
-	return err
+	*v = (ServiceID)(w.GetI32())
+	return nil
 }
 
 // Equals returns true if this ServiceID is equal to the provided
@@ -2149,6 +2771,12 @@ func (lhs ServiceID) Equals(rhs ServiceID) bool {
 	return (lhs == rhs)
 }
 
+// Clone returns a deep copy of this ServiceID.
+func (v ServiceID) Clone() ServiceID {
+	x := (int32)(v)
+	return (ServiceID)(x)
+}
+
 // SimpleType is a standalone native Go type.
 type SimpleType int32
 
@@ -2343,6 +2971,120 @@ func (v *SimpleType) UnmarshalJSON(text []byte) error {
 	}
 }
 
+// SimpleType_NumValues is the number of distinct recognized
+// values of SimpleType.
+const SimpleType_NumValues = 9
+
+// Ordinal returns the position of this value among the distinct
+// recognized values of SimpleType or false if the value is not
+// recognized. Ordinals are less than SimpleType_NumValues.
+//
+// Ordinals may be used to index arrays of length
+// SimpleType_NumValues in place of map[SimpleType]T.
+//
+//   var counts [SimpleType_NumValues]int
+//   if i, ok := v.Ordinal(); ok {
+//     counts[i]++
+//   }
+func (v SimpleType) Ordinal() (int, bool) {
+	switch int32(v) {
+	case 1:
+		return 0, true
+	case 2:
+		return 1, true
+	case 3:
+		return 2, true
+	case 4:
+		return 3, true
+	case 5:
+		return 4, true
+	case 6:
+		return 5, true
+	case 7:
+		return 6, true
+	case 8:
+		return 7, true
+	case 9:
+		return 8, true
+	default:
+		return 0, false
+	}
+}
+
+// SimpleType_Set is a set of SimpleType values backed by a
+// bitset. The zero value is an empty set.
+type SimpleType_Set struct {
+	bits [1]uint64
+}
+
+// Add adds the given value to the set. It returns false if the value
+// is not a recognized value of SimpleType.
+func (s *SimpleType_Set) Add(v SimpleType) bool {
+	i, ok := v.Ordinal()
+	if ok {
+		s.bits[i/64] |= 1 << uint(i%64)
+	}
+	return ok
+}
+
+// Remove removes the given value from the set.
+func (s *SimpleType_Set) Remove(v SimpleType) {
+	if i, ok := v.Ordinal(); ok {
+		s.bits[i/64] &^= 1 << uint(i%64)
+	}
+}
+
+// Contains returns true if the given value is in the set.
+func (s *SimpleType_Set) Contains(v SimpleType) bool {
+	i, ok := v.Ordinal()
+	return ok && s.bits[i/64]&(1<<uint(i%64)) != 0
+}
+
+// Len returns the number of values in the set.
+func (s *SimpleType_Set) Len() int {
+	n := 0
+	for _, x := range s.bits {
+		for ; x != 0; n++ {
+			x &= x - 1
+		}
+	}
+	return n
+}
+
+// Values returns the values in the set in the order in which they
+// were declared.
+func (s *SimpleType_Set) Values() []SimpleType {
+	v := make([]SimpleType, 0, s.Len())
+	if s.bits[0]&(1<<0) != 0 {
+		v = append(v, SimpleTypeBool)
+	}
+	if s.bits[0]&(1<<1) != 0 {
+		v = append(v, SimpleTypeByte)
+	}
+	if s.bits[0]&(1<<2) != 0 {
+		v = append(v, SimpleTypeInt8)
+	}
+	if s.bits[0]&(1<<3) != 0 {
+		v = append(v, SimpleTypeInt16)
+	}
+	if s.bits[0]&(1<<4) != 0 {
+		v = append(v, SimpleTypeInt32)
+	}
+	if s.bits[0]&(1<<5) != 0 {
+		v = append(v, SimpleTypeInt64)
+	}
+	if s.bits[0]&(1<<6) != 0 {
+		v = append(v, SimpleTypeFloat64)
+	}
+	if s.bits[0]&(1<<7) != 0 {
+		v = append(v, SimpleTypeString)
+	}
+	if s.bits[0]&(1<<8) != 0 {
+		v = append(v, SimpleTypeStructEmpty)
+	}
+	return v
+}
+
 // Type is a reference to a Go type which may be native or user defined.
 type Type struct {
 	SimpleType *SimpleType `json:"simpleType,omitempty"`
@@ -2387,6 +3129,10 @@ func (v *Type) ToWire() (wire.Value, error) {
 		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("Type is nil")
+	}
+
 	if v.SimpleType != nil {
 		w, err = v.SimpleType.ToWire()
 		if err != nil {
@@ -2636,16 +3382,149 @@ func (v *Type) Equals(rhs *Type) bool {
 	return true
 }
 
+func _SimpleType_ClonePtr(p *SimpleType) *SimpleType {
+	if p == nil {
+		return nil
+	}
+
+	x := *p
+	return &x
+}
+
+// Clone returns a deep copy of this Type.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *Type) Clone() *Type {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.SimpleType = _SimpleType_ClonePtr(v.SimpleType)
+	o.SliceType = v.SliceType.Clone()
+	o.KeyValueSliceType = v.KeyValueSliceType.Clone()
+	o.MapType = v.MapType.Clone()
+	o.ReferenceType = v.ReferenceType.Clone()
+	o.PointerType = v.PointerType.Clone()
+
+	return &o
+}
+
 // GetSimpleType returns the value of SimpleType if it is set or its
 // zero value if it is unset.
+//
+// This is safe to call on a nil Type.
 func (v *Type) GetSimpleType() (o SimpleType) {
-	if v.SimpleType != nil {
+	if v != nil && v.SimpleType != nil {
 		return *v.SimpleType
 	}
 
 	return
 }
 
+// IsSetSimpleType returns true if SimpleType is not nil.
+//
+// This is safe to call on a nil Type.
+func (v *Type) IsSetSimpleType() bool {
+	return v != nil && v.SimpleType != nil
+}
+
+// GetSliceType returns the value of SliceType if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Type.
+func (v *Type) GetSliceType() (o *Type) {
+	if v != nil && v.SliceType != nil {
+		return v.SliceType
+	}
+
+	return
+}
+
+// IsSetSliceType returns true if SliceType is not nil.
+//
+// This is safe to call on a nil Type.
+func (v *Type) IsSetSliceType() bool {
+	return v != nil && v.SliceType != nil
+}
+
+// GetKeyValueSliceType returns the value of KeyValueSliceType if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Type.
+func (v *Type) GetKeyValueSliceType() (o *TypePair) {
+	if v != nil && v.KeyValueSliceType != nil {
+		return v.KeyValueSliceType
+	}
+
+	return
+}
+
+// IsSetKeyValueSliceType returns true if KeyValueSliceType is not nil.
+//
+// This is safe to call on a nil Type.
+func (v *Type) IsSetKeyValueSliceType() bool {
+	return v != nil && v.KeyValueSliceType != nil
+}
+
+// GetMapType returns the value of MapType if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Type.
+func (v *Type) GetMapType() (o *TypePair) {
+	if v != nil && v.MapType != nil {
+		return v.MapType
+	}
+
+	return
+}
+
+// IsSetMapType returns true if MapType is not nil.
+//
+// This is safe to call on a nil Type.
+func (v *Type) IsSetMapType() bool {
+	return v != nil && v.MapType != nil
+}
+
+// GetReferenceType returns the value of ReferenceType if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Type.
+func (v *Type) GetReferenceType() (o *TypeReference) {
+	if v != nil && v.ReferenceType != nil {
+		return v.ReferenceType
+	}
+
+	return
+}
+
+// IsSetReferenceType returns true if ReferenceType is not nil.
+//
+// This is safe to call on a nil Type.
+func (v *Type) IsSetReferenceType() bool {
+	return v != nil && v.ReferenceType != nil
+}
+
+// GetPointerType returns the value of PointerType if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil Type.
+func (v *Type) GetPointerType() (o *Type) {
+	if v != nil && v.PointerType != nil {
+		return v.PointerType
+	}
+
+	return
+}
+
+// IsSetPointerType returns true if PointerType is not nil.
+//
+// This is safe to call on a nil Type.
+func (v *Type) IsSetPointerType() bool {
+	return v != nil && v.PointerType != nil
+}
+
 // TypePair is a pair of two types.
 type TypePair struct {
 	Left  *Type `json:"left,required"`
@@ -2675,6 +3554,10 @@ func (v *TypePair) ToWire() (wire.Value, error) {
 		err    error
 	)
 
+	if v == nil {
+		return wire.Value{}, errors.New("TypePair is nil")
+	}
+
 	if v.Left == nil {
 		return w, errors.New("field Left of TypePair is required")
 	}
@@ -2784,6 +3667,44 @@ func (v *TypePair) Equals(rhs *TypePair) bool {
 	return true
 }
 
+// Clone returns a deep copy of this TypePair.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *TypePair) Clone() *TypePair {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Left = v.Left.Clone()
+	o.Right = v.Right.Clone()
+
+	return &o
+}
+
+// GetLeft returns the value of Left if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil TypePair.
+func (v *TypePair) GetLeft() (o *Type) {
+	if v != nil {
+		o = v.Left
+	}
+	return
+}
+
+// GetRight returns the value of Right if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil TypePair.
+func (v *TypePair) GetRight() (o *Type) {
+	if v != nil {
+		o = v.Right
+	}
+	return
+}
+
 // TypeReference is a reference to a user-defined type.
 type TypeReference struct {
 	Name string `json:"name,required"`
@@ -2814,17 +3735,10 @@ type _Map_String_String_MapItemList map[string]string
 
 func (m _Map_String_String_MapItemList) ForEach(f func(wire.MapItem) error) error {
 	for k, v := range m {
-		kw, err := wire.NewValueString(k), error(nil)
-		if err != nil {
-			return err
-		}
+		kw := wire.NewValueString(k)
 
-		vw, err := wire.NewValueString(v), error(nil)
-		if err != nil {
-			return err
-		}
-		err = f(wire.MapItem{Key: kw, Value: vw})
-		if err != nil {
+		vw := wire.NewValueString(v)
+		if err := f(wire.MapItem{Key: kw, Value: vw}); err != nil {
 			return err
 		}
 	}
@@ -2865,27 +3779,21 @@ func (v *TypeReference) ToWire() (wire.Value, error) {
 		fields [3]wire.Field
 		i      int = 0
 		w      wire.Value
-		err    error
 	)
 
-	w, err = wire.NewValueString(v.Name), error(nil)
-	if err != nil {
-		return w, err
+	if v == nil {
+		return wire.Value{}, errors.New("TypeReference is nil")
 	}
+
+	w = wire.NewValueString(v.Name)
 	fields[i] = wire.Field{ID: 1, Value: w}
 	i++
 
-	w, err = wire.NewValueString(v.ImportPath), error(nil)
-	if err != nil {
-		return w, err
-	}
+	w = wire.NewValueString(v.ImportPath)
 	fields[i] = wire.Field{ID: 2, Value: w}
 	i++
 	if v.Annotations != nil {
-		w, err = wire.NewValueMap(_Map_String_String_MapItemList(v.Annotations)), error(nil)
-		if err != nil {
-			return w, err
-		}
+		w = wire.NewValueMap(_Map_String_String_MapItemList(v.Annotations))
 		fields[i] = wire.Field{ID: 3, Value: w}
 		i++
 	}
@@ -2904,15 +3812,9 @@ func _Map_String_String_Read(m wire.MapItemList) (map[string]string, error) {
 
 	o := make(map[string]string, m.Size())
 	err := m.ForEach(func(x wire.MapItem) error {
-		k, err := x.Key.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
+		k := x.Key.GetString()
 
-		v, err := x.Value.GetString(), error(nil)
-		if err != nil {
-			return err
-		}
+		v := x.Value.GetString()
 
 		o[k] = v
 		return nil
@@ -2948,18 +3850,12 @@ func (v *TypeReference) FromWire(w wire.Value) error {
 		switch field.ID {
 		case 1:
 			if field.Value.Type() == wire.TBinary {
-				v.Name, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.Name = field.Value.GetString()
 				nameIsSet = true
 			}
 		case 2:
 			if field.Value.Type() == wire.TBinary {
-				v.ImportPath, err = field.Value.GetString(), error(nil)
-				if err != nil {
-					return err
-				}
+				v.ImportPath = field.Value.GetString()
 				importPathIsSet = true
 			}
 		case 3:
@@ -3039,3 +3935,72 @@ func (v *TypeReference) Equals(rhs *TypeReference) bool {
 
 	return true
 }
+
+func _Map_String_String_Clone(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+
+	o := make(map[string]string, len(m))
+	for k, v := range m {
+		o[k] = v
+	}
+
+	return o
+}
+
+// Clone returns a deep copy of this TypeReference.
+//
+// Nested structs, containers, and binary fields are copied so that
+// the result shares no memory with the original.
+func (v *TypeReference) Clone() *TypeReference {
+	if v == nil {
+		return nil
+	}
+
+	o := *v
+	o.Annotations = _Map_String_String_Clone(v.Annotations)
+
+	return &o
+}
+
+// GetName returns the value of Name if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil TypeReference.
+func (v *TypeReference) GetName() (o string) {
+	if v != nil {
+		o = v.Name
+	}
+	return
+}
+
+// GetImportPath returns the value of ImportPath if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil TypeReference.
+func (v *TypeReference) GetImportPath() (o string) {
+	if v != nil {
+		o = v.ImportPath
+	}
+	return
+}
+
+// GetAnnotations returns the value of Annotations if it is set or its
+// zero value if it is unset.
+//
+// This is safe to call on a nil TypeReference.
+func (v *TypeReference) GetAnnotations() (o map[string]string) {
+	if v != nil && v.Annotations != nil {
+		return v.Annotations
+	}
+
+	return
+}
+
+// IsSetAnnotations returns true if Annotations is not nil.
+//
+// This is safe to call on a nil TypeReference.
+func (v *TypeReference) IsSetAnnotations() bool {
+	return v != nil && v.Annotations != nil
+}
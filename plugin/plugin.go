@@ -25,7 +25,8 @@ import (
 	"log"
 	"os"
 
-	"go.uber.org/thriftrw/internal/envelope"
+	"go.uber.org/thriftrw/envelope"
+	internalenvelope "go.uber.org/thriftrw/internal/envelope"
 	"go.uber.org/thriftrw/internal/frame"
 	"go.uber.org/thriftrw/internal/multiplex"
 	"go.uber.org/thriftrw/plugin/api"
@@ -57,6 +58,18 @@ type Plugin struct {
 	// stdout.
 	Reader io.Reader
 	Writer io.Writer
+
+	// SizeLimits limits the sizes of the requests this plugin accepts and
+	// of the responses it sends. Methods are keyed by envelope name, for
+	// example "ServiceGenerator:generate". Requests larger than every limit
+	// are discarded based on their length prefix without being read. By
+	// default, sizes are not limited.
+	SizeLimits envelope.SizeLimits
+
+	// ReportSizeStats, if non-nil, is called with statistics about the sizes
+	// of the requests and responses of each method when the plugin stops
+	// serving.
+	ReportSizeStats func(map[string]envelope.SizeStats)
 }
 
 // Main serves the given plugin. It is the entry point to the plugin system.
@@ -95,13 +108,20 @@ func Main(p *Plugin) {
 	}
 
 	server := frame.NewServer(reader, writer)
+	server.SetMaxFrameSize(int64(p.SizeLimits.MaxRequest()))
 	mainHandler.Put("Plugin", api.NewPluginHandler(pluginHandler{
 		server:   server,
 		plugin:   p,
 		features: features,
 	}))
 
-	if err := server.Serve(envelope.NewServer(_proto, mainHandler)); err != nil {
+	envelopeServer := internalenvelope.NewServer(_proto, mainHandler).
+		WithSizeLimits(p.SizeLimits)
+	err := server.Serve(envelopeServer)
+	if p.ReportSizeStats != nil {
+		p.ReportSizeStats(envelopeServer.SizeStats())
+	}
+	if err != nil {
 		log.Fatalf("plugin server failed with error: %v", err)
 	}
 }
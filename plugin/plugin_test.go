@@ -4,7 +4,8 @@ import (
 	"io"
 	"testing"
 
-	"go.uber.org/thriftrw/internal/envelope"
+	"go.uber.org/thriftrw/envelope"
+	internalenvelope "go.uber.org/thriftrw/internal/envelope"
 	"go.uber.org/thriftrw/internal/frame"
 	"go.uber.org/thriftrw/internal/multiplex"
 	"go.uber.org/thriftrw/plugin/api"
@@ -17,8 +18,8 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-func fakeEnvelopeClient(w io.Writer, r io.Reader) envelope.Client {
-	return envelope.NewClient(_proto, frame.NewClient(w, r))
+func fakeEnvelopeClient(w io.Writer, r io.Reader) internalenvelope.Client {
+	return internalenvelope.NewClient(_proto, frame.NewClient(w, r))
 }
 
 func TestEmptyPluginName(t *testing.T) {
@@ -48,6 +49,45 @@ func TestEmptyPlugin(t *testing.T) {
 	assert.NoError(t, client.Goodbye())
 }
 
+func TestSizeLimits(t *testing.T) {
+	stdinReader, stdinWriter := io.Pipe()
+	stdoutReader, stdoutWriter := io.Pipe()
+
+	statsc := make(chan map[string]envelope.SizeStats, 1)
+	go Main(&Plugin{
+		Name:   "hello",
+		Writer: stdoutWriter,
+		Reader: stdinReader,
+		SizeLimits: envelope.SizeLimits{
+			Default: envelope.SizeLimit{Request: 1024},
+		},
+		ReportSizeStats: func(stats map[string]envelope.SizeStats) {
+			statsc <- stats
+		},
+	})
+
+	transport := frame.NewClient(stdinWriter, stdoutReader)
+	client := api.NewPluginClient(multiplex.NewClient("Plugin",
+		internalenvelope.NewClient(_proto, transport)))
+
+	_, err := client.Handshake(&api.HandshakeRequest{})
+	require.NoError(t, err)
+
+	// The frame is discarded without being read and answered with an empty
+	// frame.
+	res, err := transport.Send(make([]byte, 2048))
+	require.NoError(t, err)
+	assert.Empty(t, res)
+
+	_, err = client.Handshake(&api.HandshakeRequest{})
+	require.NoError(t, err, "plugin must keep serving after a large request")
+	assert.NoError(t, client.Goodbye())
+
+	stats := <-statsc
+	assert.Equal(t, int64(2), stats["Plugin:handshake"].Requests)
+	assert.Equal(t, int64(1), stats["Plugin:goodbye"].Requests)
+}
+
 func TestServiceGenerator(t *testing.T) {
 	stdinReader, stdinWriter := io.Pipe()
 	stdoutReader, stdoutWriter := io.Pipe()
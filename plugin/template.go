@@ -25,12 +25,14 @@ import (
 	"fmt"
 	"go/format"
 	"go/parser"
+	"go/scanner"
 	"go/token"
 	"path/filepath"
 	"sort"
 	"text/template"
 
 	"go.uber.org/thriftrw/internal/goast"
+	"go.uber.org/thriftrw/internal/templates"
 	"go.uber.org/thriftrw/plugin/api"
 
 	"golang.org/x/tools/go/ast/astutil"
@@ -137,6 +139,16 @@ func (g *goFileGenerator) Import(path string) string {
 	return importedName
 }
 
+// Imports returns a map from import path to the imported name for all
+// packages imported so far.
+func (g *goFileGenerator) Imports() map[string]string {
+	names := make(map[string]string, len(g.importedNames))
+	for path, name := range g.importedNames {
+		names[path] = name
+	}
+	return names
+}
+
 // FormatType formats the given api.Type into a Go type, importing packages
 // necessary to reference this type.
 func (g *goFileGenerator) FormatType(t *api.Type) (string, error) {
@@ -204,26 +216,37 @@ func (g *goFileGenerator) FormatType(t *api.Type) (string, error) {
 func (g *goFileGenerator) Generate(filename, tmpl string, data interface{}) ([]byte, error) {
 	funcs := template.FuncMap{
 		"import":     g.Import,
+		"imports":    g.Imports,
 		"formatType": g.FormatType,
 	}
 	for k, v := range g.templateFuncs {
 		funcs[k] = v
 	}
 
-	t, err := template.New(filename).Delims("<", ">").Funcs(funcs).Parse(tmpl)
+	src, err := templates.ExpandRaw(tmpl)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse template %q: %v", filename, err)
 	}
 
+	t, err := template.New(filename).Delims("<", ">").Funcs(funcs).Parse(src)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template %q: %v",
+			filename, templates.AnnotateError(err, tmpl))
+	}
+
 	var buff bytes.Buffer
 	if err := t.Execute(&buff, data); err != nil {
-		return nil, err
+		return nil, templates.AnnotateError(err, tmpl)
 	}
 
 	fset := token.NewFileSet()
 	f, err := parser.ParseFile(fset, filename, buff.Bytes(), parser.ParseComments)
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse generated code: %v:\n%s", err, buff.String())
+		perr := fmt.Errorf("failed to parse generated code: %v", err)
+		if errs, ok := err.(scanner.ErrorList); ok && len(errs) > 0 {
+			return nil, templates.AnnotateLine(perr, buff.String(), errs[0].Pos.Line)
+		}
+		return nil, fmt.Errorf("%v:\n%s", perr, buff.String())
 	}
 
 	if len(f.Imports) > 0 {
@@ -252,7 +275,12 @@ func (g *goFileGenerator) Generate(filename, tmpl string, data interface{}) ([]b
 // data.
 //
 // The templating system follows the text/template templating format but with "<"
-// and ">" as the delimiters.
+// and ">" as the delimiters. Text between <raw> and <endraw> is copied to the
+// output verbatim so that it may contain the delimiters.
+//
+// 	if <raw>a<b && c>d<endraw> {
+//
+// Errors in the template include the offending lines of the template.
 //
 // The following functions are provided inside the template:
 //
@@ -266,6 +294,10 @@ func (g *goFileGenerator) Generate(filename, tmpl string, data interface{}) ([]b
 // 	<$wire := import "go.uber.org/thriftrw/wire">
 // 	var value <$wire>.Value
 //
+// imports: Returns a map from import path to the imported name of each package
+// imported so far in the file, including any aliases picked to resolve
+// conflicts.
+//
 // formatType: Formats an api.Type into a Go type representation, automatically
 // importing packages needed for type references. By default, this imports all
 // packages referenced in the api.Type. If the GoFileImportPath option is
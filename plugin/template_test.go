@@ -157,6 +157,38 @@ func TestGoFileFromTemplate(t *testing.T) {
 				`func bar() context2.Context { return nil }`,
 			),
 		},
+		{
+			desc: "imports",
+			template: `
+				package hello
+
+				<$a := import "example.com/a/shared">
+				<$b := import "example.com/b/shared">
+
+				var names = map[string]string{
+				<range $path, $name := imports>	<printf "%q" $path>: <printf "%q" $name>,
+				<end>}
+
+				var _ = <$a>.Foo
+				var _ = <$b>.Foo
+			`,
+			wantBody: unlines(
+				`package hello`,
+				``,
+				`import (`,
+				`	"example.com/a/shared"`,
+				`	shared2 "example.com/b/shared"`,
+				`)`,
+				``,
+				`var names = map[string]string{`,
+				`	"example.com/a/shared": "shared",`,
+				`	"example.com/b/shared": "shared2",`,
+				`}`,
+				``,
+				`var _ = shared.Foo`,
+				`var _ = shared2.Foo`,
+			),
+		},
 		{
 			desc: "import twice",
 			template: `
@@ -183,6 +215,34 @@ func TestGoFileFromTemplate(t *testing.T) {
 			template:  `<import "`,
 			wantError: `failed to parse template "test.go":`,
 		},
+		{
+			desc: "invalid template line",
+			template: unlines(
+				`package foo`,
+				``,
+				`var x = <unknown>`,
+			),
+			wantError: "\t>    3| var x = <unknown>",
+		},
+		{
+			desc:      "unterminated raw block",
+			template:  "package foo\n\n<raw>var x = 1",
+			wantError: "line 3: <raw> block is never closed with <endraw>",
+		},
+		{
+			desc: "raw block",
+			template: unlines(
+				`package foo`,
+				``,
+				`func <.>(a, b int) bool { return <raw>a<b || a>b<endraw> }`,
+			),
+			data: "neq",
+			wantBody: unlines(
+				`package foo`,
+				``,
+				`func neq(a, b int) bool { return a < b || a > b }`,
+			),
+		},
 		{
 			desc:      "invalid Go code",
 			template:  `func main() {}`,
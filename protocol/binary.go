@@ -34,7 +34,16 @@ func init() {
 	Binary = binaryProtocol{}
 }
 
-type binaryProtocol struct{}
+// BinaryWithLimits returns an implementation of the Thrift Binary Protocol
+// which fails to decode payloads that exceed the given limits with a
+// binary.LimitError.
+func BinaryWithLimits(l binary.Limits) Protocol {
+	return binaryProtocol{limits: l}
+}
+
+type binaryProtocol struct {
+	limits binary.Limits
+}
 
 func (binaryProtocol) Encode(v wire.Value, w io.Writer) error {
 	writer := binary.BorrowWriter(w)
@@ -43,8 +52,8 @@ func (binaryProtocol) Encode(v wire.Value, w io.Writer) error {
 	return err
 }
 
-func (binaryProtocol) Decode(r io.ReaderAt, t wire.Type) (wire.Value, error) {
-	reader := binary.NewReader(r)
+func (p binaryProtocol) Decode(r io.ReaderAt, t wire.Type) (wire.Value, error) {
+	reader := binary.NewReaderWithLimits(r, p.limits)
 	value, _, err := reader.ReadValue(t, 0)
 	return value, err
 }
@@ -56,8 +65,13 @@ func (binaryProtocol) EncodeEnveloped(e wire.Envelope, w io.Writer) error {
 	return err
 }
 
-func (binaryProtocol) DecodeEnveloped(r io.ReaderAt) (wire.Envelope, error) {
-	reader := binary.NewReader(r)
+func (p binaryProtocol) DecodeEnveloped(r io.ReaderAt) (wire.Envelope, error) {
+	reader := binary.NewReaderWithLimits(r, p.limits)
 	e, err := reader.ReadEnveloped()
 	return e, err
 }
+
+func (p binaryProtocol) DecodeEnvelopeHeader(r io.ReaderAt) (wire.Envelope, error) {
+	reader := binary.NewReaderWithLimits(r, p.limits)
+	return reader.ReadEnvelopeHeader()
+}
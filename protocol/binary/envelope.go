@@ -72,10 +72,43 @@ func (bw *Writer) WriteEnveloped(e wire.Envelope) error {
 // will always have a size >= 0, while strict payloads have selected
 // version numbers such that the value will always be negative.
 func (bw *Reader) ReadEnveloped() (wire.Envelope, error) {
+	e, err := bw.readEnveloped()
+	if err != nil {
+		err = bw.sniffError(err)
+	}
+	return e, err
+}
+
+func (bw *Reader) readEnveloped() (wire.Envelope, error) {
+	e, off, err := bw.readEnvelopeHeader()
+	if err != nil {
+		return e, err
+	}
+
+	e.Value, off, err = bw.readValue(wire.TStruct, off)
+	if err != nil {
+		return wire.Envelope{}, err
+	}
+
+	return e, nil
+}
+
+// ReadEnvelopeHeader reads the name, type, and sequence ID of an envelope
+// without reading the enveloped value. The Value of the returned Envelope is
+// not set.
+func (bw *Reader) ReadEnvelopeHeader() (wire.Envelope, error) {
+	e, _, err := bw.readEnvelopeHeader()
+	if err != nil {
+		err = bw.sniffError(err)
+	}
+	return e, err
+}
+
+func (bw *Reader) readEnvelopeHeader() (wire.Envelope, int64, error) {
 	var e wire.Envelope
 	initial, off, err := bw.readInt32(0)
 	if err != nil {
-		return wire.Envelope{}, err
+		return wire.Envelope{}, off, err
 	}
 
 	if initial > 0 {
@@ -84,20 +117,11 @@ func (bw *Reader) ReadEnveloped() (wire.Envelope, error) {
 		e, off, err = bw.readStrictNameType(initial, off)
 	}
 	if err != nil {
-		return e, err
+		return e, off, err
 	}
 
 	e.SeqID, off, err = bw.readInt32(off)
-	if err != nil {
-		return e, err
-	}
-
-	e.Value, off, err = bw.ReadValue(wire.TStruct, off)
-	if err != nil {
-		return wire.Envelope{}, err
-	}
-
-	return e, nil
+	return e, off, err
 }
 
 func (bw *Reader) readStrictNameType(initial int32, off int64) (wire.Envelope, int64, error) {
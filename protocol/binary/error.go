@@ -38,6 +38,10 @@ func decodeErrorf(f string, args ...interface{}) decodeError {
 func IsDecodeError(e error) bool {
 	// TODO(abg): decode error can probably be shared across protocols. move
 	// to protocol/
-	_, isDecodeError := e.(decodeError)
-	return isDecodeError
+	switch e.(type) {
+	case decodeError, LimitError:
+		return true
+	default:
+		return false
+	}
 }
@@ -69,7 +69,7 @@ func (ll *lazyValueList) ForEach(f func(wire.Value) error) error {
 			err error
 		)
 
-		val, off, err = ll.reader.ReadValue(ll.typ, off)
+		val, off, err = ll.reader.readValue(ll.typ, off)
 		if err != nil {
 			return err
 		}
@@ -81,6 +81,10 @@ func (ll *lazyValueList) ForEach(f func(wire.Value) error) error {
 	return nil
 }
 
+func (ll *lazyValueList) Iterator() wire.ValueListIterator {
+	return &lazyValueListIterator{list: ll, off: ll.startOffset}
+}
+
 func (ll *lazyValueList) Close() {
 	ll.reader = nil
 	lazyValueListPool.Put(ll)
@@ -116,12 +120,12 @@ func (lm *lazyMapItemList) ForEach(f func(wire.MapItem) error) error {
 			err  error
 		)
 
-		k, off, err = lm.reader.ReadValue(lm.ktype, off)
+		k, off, err = lm.reader.readValue(lm.ktype, off)
 		if err != nil {
 			return err
 		}
 
-		v, off, err = lm.reader.ReadValue(lm.vtype, off)
+		v, off, err = lm.reader.readValue(lm.vtype, off)
 		if err != nil {
 			return err
 		}
@@ -134,7 +138,77 @@ func (lm *lazyMapItemList) ForEach(f func(wire.MapItem) error) error {
 	return nil
 }
 
+func (lm *lazyMapItemList) Iterator() wire.MapItemListIterator {
+	return &lazyMapItemListIterator{list: lm, off: lm.startOffset}
+}
+
 func (lm *lazyMapItemList) Close() {
 	lm.reader = nil
 	lazyMapItemListPool.Put(lm)
 }
+
+// lazyValueListIterator parses the Values of a lazyValueList one at a time.
+type lazyValueListIterator struct {
+	list *lazyValueList
+	off  int64
+	read int32
+	cur  wire.Value
+	err  error
+}
+
+func (it *lazyValueListIterator) Next() bool {
+	if it.err != nil || it.read >= it.list.count {
+		return false
+	}
+
+	it.cur, it.off, it.err = it.list.reader.readValue(it.list.typ, it.off)
+	if it.err != nil {
+		return false
+	}
+	it.read++
+	return true
+}
+
+func (it *lazyValueListIterator) Value() wire.Value {
+	return it.cur
+}
+
+func (it *lazyValueListIterator) Err() error {
+	return it.err
+}
+
+// lazyMapItemListIterator parses the MapItems of a lazyMapItemList one at a
+// time.
+type lazyMapItemListIterator struct {
+	list *lazyMapItemList
+	off  int64
+	read int32
+	cur  wire.MapItem
+	err  error
+}
+
+func (it *lazyMapItemListIterator) Next() bool {
+	if it.err != nil || it.read >= it.list.count {
+		return false
+	}
+
+	it.cur.Key, it.off, it.err = it.list.reader.readValue(it.list.ktype, it.off)
+	if it.err != nil {
+		return false
+	}
+
+	it.cur.Value, it.off, it.err = it.list.reader.readValue(it.list.vtype, it.off)
+	if it.err != nil {
+		return false
+	}
+	it.read++
+	return true
+}
+
+func (it *lazyMapItemListIterator) Item() wire.MapItem {
+	return it.cur
+}
+
+func (it *lazyMapItemListIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package binary
+
+import "fmt"
+
+// Limits restricts the values a Reader accepts so that hostile or corrupt
+// input cannot make it allocate large amounts of memory or recurse too
+// deeply. Zero values mean that the corresponding property is not limited.
+//
+// Regardless of these limits, Readers reject binary values and containers
+// of fixed-width values which claim to be larger than the rest of the
+// input.
+type Limits struct {
+	// Maximum number of items in a list, set, or map.
+	MaxContainerLength int
+
+	// Maximum length in bytes of a binary or string value.
+	MaxBinaryLength int
+
+	// Maximum depth to which structs and containers may be nested. A
+	// struct which contains only primitive fields has a depth of 1.
+	MaxDepth int
+}
+
+// LimitError is returned by Readers when the input contains a value which
+// exceeds one of the Limits of the Reader.
+type LimitError struct {
+	// Name of the field of Limits which was exceeded.
+	Limit string
+
+	// Size of the value and the maximum allowed size.
+	Size, Max int64
+}
+
+func (e LimitError) Error() string {
+	return fmt.Sprintf("%v exceeded: got %d, limit is %d", e.Limit, e.Size, e.Max)
+}
+
+// checkLimit returns a LimitError if size exceeds max, unless max is zero.
+func checkLimit(name string, size int64, max int) error {
+	if max > 0 && size > int64(max) {
+		return LimitError{Limit: name, Size: size, Max: int64(max)}
+	}
+	return nil
+}
+
+// enter records that the Reader is descending into a struct or container.
+// It fails if this exceeds the maximum depth. Each successful call must be
+// paired with a call to leave.
+func (br *Reader) enter() error {
+	if err := checkLimit("MaxDepth", int64(br.depth+1), br.limits.MaxDepth); err != nil {
+		return err
+	}
+	br.depth++
+	return nil
+}
+
+func (br *Reader) leave() {
+	br.depth--
+}
+
+// checkRemaining returns io.ErrUnexpectedEOF if the input ends before n
+// bytes starting at off. This allows rejecting lengths which exceed the
+// rest of the input before acting on them.
+func (br *Reader) checkRemaining(off, n int64) error {
+	if n <= 0 {
+		return nil
+	}
+	_, err := br.read(br.buffer[0:1], off+n-1)
+	return err
+}
@@ -36,6 +36,8 @@ const bytesAllocThreshold = 1048576 // 1 MB
 // io.ReaderAt.
 type Reader struct {
 	reader io.ReaderAt
+	limits Limits
+	depth  int
 
 	// This buffer is re-used every time we need a slice of up to 8 bytes.
 	buffer [8]byte
@@ -46,6 +48,12 @@ func NewReader(r io.ReaderAt) Reader {
 	return Reader{reader: r}
 }
 
+// NewReaderWithLimits builds a new Reader based on the given io.ReaderAt
+// which fails with a LimitError if the input exceeds the given Limits.
+func NewReaderWithLimits(r io.ReaderAt, l Limits) Reader {
+	return Reader{reader: r, limits: l}
+}
+
 // For the reader, we keep track of the read offset manually everywhere so
 // that we can implement lazy collections without extra allocations
 
@@ -65,6 +73,8 @@ func fixedWidth(t wire.Type) int64 {
 		return 4
 	case wire.TI64:
 		return 8
+	case wire.TUUID:
+		return 16
 	default:
 		return -1
 	}
@@ -112,15 +122,25 @@ func (br *Reader) skipMap(off int64) (int64, error) {
 	if count < 0 {
 		return off, decodeErrorf("negative length %d requested for map", count)
 	}
+	if err := checkLimit("MaxContainerLength", int64(count), br.limits.MaxContainerLength); err != nil {
+		return off, err
+	}
+
+	return br.skipMapItems(kt, vt, count, off)
+}
 
+// skipMapItems skips count key-value pairs of the given types starting at
+// off.
+func (br *Reader) skipMapItems(kt, vt wire.Type, count int32, off int64) (int64, error) {
 	kw := fixedWidth(kt)
 	vw := fixedWidth(vt)
 	if kw > 0 && vw > 0 {
 		// key and value are fixed width. calculate exact offset increase.
-		off += int64(count) * (kw + vw)
-		return off, err
+		n := int64(count) * (kw + vw)
+		return off + n, br.checkRemaining(off, n)
 	}
 
+	var err error
 	for i := int32(0); i < count; i++ {
 		off, err = br.skipValue(kt, off)
 		if err != nil {
@@ -149,14 +169,23 @@ func (br *Reader) skipList(off int64) (int64, error) {
 	if count < 0 {
 		return off, decodeErrorf("negative length %d requested for collection", count)
 	}
+	if err := checkLimit("MaxContainerLength", int64(count), br.limits.MaxContainerLength); err != nil {
+		return off, err
+	}
+
+	return br.skipListItems(vt, count, off)
+}
 
+// skipListItems skips count values of the given type starting at off.
+func (br *Reader) skipListItems(vt wire.Type, count int32, off int64) (int64, error) {
 	vw := fixedWidth(vt)
 	if vw > 0 {
 		// value is fixed width. can calculate new offset right away.
-		off += int64(count) * vw
-		return off, err
+		n := int64(count) * vw
+		return off + n, br.checkRemaining(off, n)
 	}
 
+	var err error
 	for i := int32(0); i < count; i++ {
 		off, err = br.skipValue(vt, off)
 		if err != nil {
@@ -182,18 +211,31 @@ func (br *Reader) skipValue(t wire.Type, off int64) (int64, error) {
 				"negative length %d requested for binary value", length,
 			)
 		}
+		if err := checkLimit("MaxBinaryLength", int64(length), br.limits.MaxBinaryLength); err != nil {
+			return off, err
+		}
 		off += int64(length)
 		return off, err
+	case wire.TStruct, wire.TMap, wire.TSet, wire.TList:
+		if err := br.enter(); err != nil {
+			return off, err
+		}
+		off, err := br.skipContainer(t, off)
+		br.leave()
+		return off, err
+	default:
+		return off, decodeErrorf("unknown ttype %v", t)
+	}
+}
+
+func (br *Reader) skipContainer(t wire.Type, off int64) (int64, error) {
+	switch t {
 	case wire.TStruct:
 		return br.skipStruct(off)
 	case wire.TMap:
 		return br.skipMap(off)
-	case wire.TSet:
-		return br.skipList(off)
-	case wire.TList:
+	default: // TSet, TList
 		return br.skipList(off)
-	default:
-		return off, decodeErrorf("unknown ttype %v", t)
 	}
 }
 
@@ -256,6 +298,9 @@ func (br *Reader) readBytes(off int64) ([]byte, int64, error) {
 	if length == 0 {
 		return nil, off, nil
 	}
+	if err := checkLimit("MaxBinaryLength", int64(length), br.limits.MaxBinaryLength); err != nil {
+		return nil, off, err
+	}
 
 	// Use a dynamically resizing buffer for requests larger than
 	// bytesAllocThreshold. We don't want bad requests to lock the system up.
@@ -268,6 +313,10 @@ func (br *Reader) readBytes(off int64) ([]byte, int64, error) {
 		return buff.Bytes(), off, err
 	}
 
+	if err := br.checkRemaining(off, int64(length)); err != nil {
+		return nil, off, err
+	}
+
 	bs := make([]byte, length)
 	off, err = br.read(bs, off)
 	return bs, off, err
@@ -296,7 +345,7 @@ func (br *Reader) readStruct(off int64) (wire.Struct, int64, error) {
 			return wire.Struct{}, off, err
 		}
 
-		val, off, err = br.ReadValue(wire.Type(typ), off)
+		val, off, err = br.readValue(wire.Type(typ), off)
 		if err != nil {
 			return wire.Struct{}, off, err
 		}
@@ -329,21 +378,17 @@ func (br *Reader) readMap(off int64) (wire.MapItemList, int64, error) {
 	if count < 0 {
 		return nil, off, decodeErrorf("negative length %d requested for map", count)
 	}
+	if err := checkLimit("MaxContainerLength", int64(count), br.limits.MaxContainerLength); err != nil {
+		return nil, off, err
+	}
 
 	kt := wire.Type(ktByte)
 	vt := wire.Type(vtByte)
 
 	start := off
-	for i := int32(0); i < count; i++ {
-		off, err = br.skipValue(kt, off)
-		if err != nil {
-			return nil, off, err
-		}
-
-		off, err = br.skipValue(vt, off)
-		if err != nil {
-			return nil, off, err
-		}
+	off, err = br.skipMapItems(kt, vt, count, off)
+	if err != nil {
+		return nil, off, err
 	}
 
 	items := borrowLazyMapItemList()
@@ -369,13 +414,14 @@ func (br *Reader) readSet(off int64) (wire.ValueList, int64, error) {
 	if count < 0 {
 		return nil, off, decodeErrorf("negative length %d requested for set", count)
 	}
+	if err := checkLimit("MaxContainerLength", int64(count), br.limits.MaxContainerLength); err != nil {
+		return nil, off, err
+	}
 
 	start := off
-	for i := int32(0); i < count; i++ {
-		off, err = br.skipValue(wire.Type(typ), off)
-		if err != nil {
-			return nil, off, err
-		}
+	off, err = br.skipListItems(wire.Type(typ), count, off)
+	if err != nil {
+		return nil, off, err
 	}
 
 	items := borrowLazyValueList()
@@ -400,13 +446,14 @@ func (br *Reader) readList(off int64) (wire.ValueList, int64, error) {
 	if count < 0 {
 		return nil, off, decodeErrorf("negative length %d requested for list", count)
 	}
+	if err := checkLimit("MaxContainerLength", int64(count), br.limits.MaxContainerLength); err != nil {
+		return nil, off, err
+	}
 
 	start := off
-	for i := int32(0); i < count; i++ {
-		off, err = br.skipValue(wire.Type(typ), off)
-		if err != nil {
-			return nil, off, err
-		}
+	off, err = br.skipListItems(wire.Type(typ), count, off)
+	if err != nil {
+		return nil, off, err
 	}
 
 	items := borrowLazyValueList()
@@ -422,7 +469,17 @@ func (br *Reader) readList(off int64) (wire.ValueList, int64, error) {
 // given offset.
 //
 // Returns the Value, the new offset, and an error if there was a decode error.
+// If a struct at the start of the payload could not be decoded and the
+// payload looks like it was encoded some other way, the error says so.
 func (br *Reader) ReadValue(t wire.Type, off int64) (wire.Value, int64, error) {
+	v, newOff, err := br.readValue(t, off)
+	if err != nil && off == 0 && t == wire.TStruct {
+		err = br.sniffError(err)
+	}
+	return v, newOff, err
+}
+
+func (br *Reader) readValue(t wire.Type, off int64) (wire.Value, int64, error) {
 	switch t {
 	case wire.TBool:
 		b, off, err := br.readByte(off)
@@ -463,6 +520,26 @@ func (br *Reader) ReadValue(t wire.Type, off int64) (wire.Value, int64, error) {
 		v, off, err := br.readBytes(off)
 		return wire.NewValueBinary(v), off, err
 
+	case wire.TStruct, wire.TMap, wire.TSet, wire.TList:
+		if err := br.enter(); err != nil {
+			return wire.Value{}, off, err
+		}
+		v, off, err := br.readContainer(t, off)
+		br.leave()
+		return v, off, err
+
+	case wire.TUUID:
+		var u wire.UUID
+		off, err := br.read(u[:], off)
+		return wire.NewValueUUID(u), off, err
+
+	default:
+		return wire.Value{}, off, decodeErrorf("unknown ttype %v", t)
+	}
+}
+
+func (br *Reader) readContainer(t wire.Type, off int64) (wire.Value, int64, error) {
+	switch t {
 	case wire.TStruct:
 		s, off, err := br.readStruct(off)
 		return wire.NewValueStruct(s), off, err
@@ -475,11 +552,8 @@ func (br *Reader) ReadValue(t wire.Type, off int64) (wire.Value, int64, error) {
 		s, off, err := br.readSet(off)
 		return wire.NewValueSet(s), off, err
 
-	case wire.TList:
+	default: // TList
 		l, off, err := br.readList(off)
 		return wire.NewValueList(l), off, err
-
-	default:
-		return wire.Value{}, off, decodeErrorf("unknown ttype %v", t)
 	}
 }
@@ -0,0 +1,111 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package binary
+
+import "fmt"
+
+const (
+	compactProtocolID  = 0x82
+	compactVersion     = 1
+	compactVersionMask = 0x1f
+)
+
+// sniffError annotates an error encountered while decoding a payload from
+// the start with a hint if the payload looks like it was encoded with
+// something other than the Binary protocol.
+//
+// The returned error is a decode error.
+func (br *Reader) sniffError(err error) error {
+	if _, ok := err.(LimitError); ok {
+		// The payload was understood well enough to exceed a limit.
+		return err
+	}
+
+	var head [6]byte
+	n, _ := br.reader.ReadAt(head[:], 0)
+
+	hint := sniff(head[:n])
+	if hint == "" {
+		return err
+	}
+	return decodeError{message: fmt.Sprintf("%v: %v", hint, err)}
+}
+
+// sniff guesses what a payload which could not be decoded with the Binary
+// protocol is based on its first few bytes. An empty string is returned if
+// it does not look like anything in particular.
+func sniff(b []byte) string {
+	switch {
+	case len(b) >= 6 && (isBinaryEnvelope(b[4:]) || isCompactEnvelope(b[4:])):
+		// A 4 byte frame size followed by an envelope.
+		return "payload looks like it has a framed transport header; " +
+			"this decoder expects unframed payloads"
+	case len(b) >= 2 && isCompactEnvelope(b):
+		return "payload looks like compact protocol; this decoder is binary"
+	case len(b) >= 1 && isJSON(b):
+		return "payload looks like JSON; this decoder is binary"
+	case len(b) >= 1 && isCompactFieldHeader(b[0]):
+		return "payload looks like compact protocol; this decoder is binary"
+	default:
+		return ""
+	}
+}
+
+// isBinaryEnvelope returns true if b starts with the version of a strict
+// Binary protocol envelope.
+func isBinaryEnvelope(b []byte) bool {
+	return b[0] == version1>>24 && b[1] == (version1>>16)&0xff
+}
+
+// isCompactEnvelope returns true if b starts with the protocol ID and
+// version of a Compact protocol envelope.
+func isCompactEnvelope(b []byte) bool {
+	return b[0] == compactProtocolID && b[1]&compactVersionMask == compactVersion
+}
+
+// isJSON returns true if b looks like the start of a JSON object or array.
+func isJSON(b []byte) bool {
+	if b[0] != '{' && b[0] != '[' {
+		return false
+	}
+	if len(b) == 1 {
+		return true
+	}
+
+	switch c := b[1]; {
+	case c == '"', c == '{', c == '[', c == '}', c == ']', c == '-':
+		return true
+	case c >= '0' && c <= '9':
+		return true
+	case c == ' ', c == '\t', c == '\r', c == '\n':
+		return true
+	default:
+		return false
+	}
+}
+
+// isCompactFieldHeader returns true if c is a short-form Compact protocol
+// field header: a field ID delta in the upper 4 bits and a Compact type in
+// the lower 4 bits. No valid Binary protocol type ID has this shape.
+func isCompactFieldHeader(c byte) bool {
+	delta, typ := c>>4, c&0x0f
+	return delta != 0 && typ >= 1 && typ <= 12
+}
@@ -240,6 +240,10 @@ func (bw *Writer) WriteValue(v wire.Value) error {
 	case wire.TList:
 		return bw.writeList(v.GetList())
 
+	case wire.TUUID:
+		u := v.GetUUID()
+		return bw.write(u[:])
+
 	default:
 		return fmt.Errorf("unknown ttype %v", v.Type())
 	}
@@ -297,6 +297,178 @@ func TestBinaryLargeLength(t *testing.T) {
 	assert.True(t, wire.ValuesAreEqual(want, value), "values did not match")
 }
 
+func TestDecodeLengthExceedsInput(t *testing.T) {
+	tests := []struct {
+		desc    string
+		typ     wire.Type
+		encoded []byte
+	}{
+		{
+			desc: "list of fixed-width values",
+			typ:  wire.TList,
+			encoded: []byte{
+				0x0A,                   // type:1 = i64
+				0x7f, 0xff, 0xff, 0xff, // length:4 = 2147483647
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, // 1
+			},
+		},
+		{
+			desc: "map of fixed-width values",
+			typ:  wire.TMap,
+			encoded: []byte{
+				0x08, 0x08, // ktype, vtype = i32, i32
+				0x10, 0x00, 0x00, 0x00, // length:4 = 268435456
+			},
+		},
+		{
+			desc: "binary",
+			typ:  wire.TBinary,
+			encoded: []byte{
+				0x00, 0x0f, 0x00, 0x00, // length:4 = 983040
+				0x01, 0x02, 0x03,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			// The error must be reported by Decode rather than when the
+			// lazily decoded value is read.
+			_, err := Binary.Decode(bytes.NewReader(tt.encoded), tt.typ)
+			assert.Equal(t, io.ErrUnexpectedEOF, err)
+		})
+	}
+}
+
+func TestDecodeLimits(t *testing.T) {
+	tests := []struct {
+		desc      string
+		limits    binary.Limits
+		typ       wire.Type
+		encoded   []byte
+		wantLimit string // name of the exceeded limit, if any
+	}{
+		{
+			desc:   "list within limits",
+			limits: binary.Limits{MaxContainerLength: 2, MaxDepth: 1},
+			typ:    wire.TList,
+			encoded: []byte{
+				0x08,                   // type:1 = i32
+				0x00, 0x00, 0x00, 0x02, // length:4 = 2
+				0x00, 0x00, 0x00, 0x01, // 1
+				0x00, 0x00, 0x00, 0x02, // 2
+			},
+		},
+		{
+			desc:   "list too long",
+			limits: binary.Limits{MaxContainerLength: 1},
+			typ:    wire.TList,
+			encoded: []byte{
+				0x08,                   // type:1 = i32
+				0x00, 0x00, 0x00, 0x02, // length:4 = 2
+				0x00, 0x00, 0x00, 0x01, // 1
+				0x00, 0x00, 0x00, 0x02, // 2
+			},
+			wantLimit: "MaxContainerLength",
+		},
+		{
+			desc:   "nested map too long",
+			limits: binary.Limits{MaxContainerLength: 1},
+			typ:    wire.TStruct,
+			encoded: []byte{
+				0x0D,       // type:1 = map
+				0x00, 0x01, // id:2 = 1
+				0x08, 0x08, // ktype, vtype = i32, i32
+				0x00, 0x00, 0x00, 0x02, // length:4 = 2
+				0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x02, // 1: 2
+				0x00, 0x00, 0x00, 0x03, 0x00, 0x00, 0x00, 0x04, // 3: 4
+				0x00, // stop
+			},
+			wantLimit: "MaxContainerLength",
+		},
+		{
+			desc:   "binary too long",
+			limits: binary.Limits{MaxBinaryLength: 2},
+			typ:    wire.TBinary,
+			encoded: []byte{
+				0x00, 0x00, 0x00, 0x03, // length:4 = 3
+				'a', 'b', 'c',
+			},
+			wantLimit: "MaxBinaryLength",
+		},
+		{
+			desc:   "binary in list too long",
+			limits: binary.Limits{MaxBinaryLength: 2},
+			typ:    wire.TList,
+			encoded: []byte{
+				0x0B,                   // type:1 = binary
+				0x00, 0x00, 0x00, 0x01, // length:4 = 1
+				0x00, 0x00, 0x00, 0x03, // length:4 = 3
+				'a', 'b', 'c',
+			},
+			wantLimit: "MaxBinaryLength",
+		},
+		{
+			desc:   "structs nested too deeply",
+			limits: binary.Limits{MaxDepth: 2},
+			typ:    wire.TStruct,
+			encoded: []byte{
+				0x0C,       // type:1 = struct
+				0x00, 0x01, // id:2 = 1
+				0x0C,       // type:1 = struct
+				0x00, 0x01, // id:2 = 1
+				0x00, // stop
+				0x00, // stop
+				0x00, // stop
+			},
+			wantLimit: "MaxDepth",
+		},
+		{
+			desc:   "lists nested too deeply",
+			limits: binary.Limits{MaxDepth: 2},
+			typ:    wire.TStruct,
+			encoded: []byte{
+				0x0F,       // type:1 = list
+				0x00, 0x01, // id:2 = 1
+				0x0F,                   // type:1 = list
+				0x00, 0x00, 0x00, 0x01, // length:4 = 1
+				0x08,                   // type:1 = i32
+				0x00, 0x00, 0x00, 0x00, // length:4 = 0
+				0x00, // stop
+			},
+			wantLimit: "MaxDepth",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			proto := BinaryWithLimits(tt.limits)
+			value, err := proto.Decode(bytes.NewReader(tt.encoded), tt.typ)
+			if err == nil {
+				err = wire.EvaluateValue(value)
+			}
+
+			if tt.wantLimit == "" {
+				assert.NoError(t, err)
+				return
+			}
+
+			require.Error(t, err)
+			assert.True(t, binary.IsDecodeError(err), "expected decode error, got %v", err)
+			if limitErr, ok := err.(binary.LimitError); assert.True(t, ok, "expected LimitError, got %T", err) {
+				assert.Equal(t, tt.wantLimit, limitErr.Limit)
+			}
+
+			// Without limits, the payload must decode successfully.
+			value, err = Binary.Decode(bytes.NewReader(tt.encoded), tt.typ)
+			if err == nil {
+				err = wire.EvaluateValue(value)
+			}
+			assert.NoError(t, err, "payload must be valid without limits")
+		})
+	}
+}
+
 func TestBinaryDecodeFailure(t *testing.T) {
 	tests := []failureTest{
 		{0xff, 0x30, 0x30, 0x30}, // negative length
@@ -316,6 +488,27 @@ func TestBinaryEOFFailure(t *testing.T) {
 	checkEOFError(t, wire.TBinary, tests)
 }
 
+func TestUUID(t *testing.T) {
+	tests := []encodeDecodeTest{
+		{vuuid("00000000-0000-0000-0000-000000000000"), make([]byte, 16)},
+		{vuuid("6ba7b810-9dad-11d1-80b4-00c04fd430c8"), []byte{
+			0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1,
+			0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8,
+		}},
+	}
+
+	checkEncodeDecode(t, wire.TUUID, tests)
+}
+
+func TestUUIDEOFFailure(t *testing.T) {
+	tests := []failureTest{
+		{},
+		{0x6b, 0xa7, 0xb8, 0x10}, // incomplete
+	}
+
+	checkEOFError(t, wire.TUUID, tests)
+}
+
 func TestStruct(t *testing.T) {
 	tests := []encodeDecodeTest{
 		{vstruct(), []byte{0x00}},
@@ -525,6 +718,23 @@ func TestList(t *testing.T) {
 				// </struct>
 			},
 		},
+		{
+			vlist(
+				wire.TUUID,
+				vuuid("6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
+				vuuid("00000000-0000-0000-0000-000000000001"),
+			),
+			[]byte{
+				0x10,                   // vtype:1 = uuid
+				0x00, 0x00, 0x00, 0x02, // count:4 = 2
+
+				0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1,
+				0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8,
+
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01,
+			},
+		},
 	}
 
 	checkEncodeDecode(t, wire.TList, tests)
@@ -555,6 +765,93 @@ func TestListEOFFailure(t *testing.T) {
 	checkEOFError(t, wire.TList, tests)
 }
 
+func TestIterateDecodedList(t *testing.T) {
+	tests := []struct {
+		desc    string
+		encoded []byte
+		want    []wire.Value
+		wantErr bool
+	}{
+		{
+			desc: "success",
+			encoded: []byte{
+				0x06,                   // type: i16
+				0x00, 0x00, 0x00, 0x02, // count:4 = 2
+				0x00, 0x01, // 1
+				0x00, 0x02, // 2
+			},
+			want: []wire.Value{vi16(1), vi16(2)},
+		},
+		{
+			desc: "failure",
+			encoded: []byte{
+				0x02,                   // type: bool
+				0x00, 0x00, 0x00, 0x02, // count:4 = 2
+				0x01, // true
+				0x10, // invalid bool
+			},
+			want:    []wire.Value{vbool(true)},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		value, err := Binary.Decode(bytes.NewReader(tt.encoded), wire.TList)
+		require.NoError(t, err, tt.desc)
+
+		var got []wire.Value
+		it := wire.IterateValueList(value.GetList())
+		for it.Next() {
+			got = append(got, it.Value())
+		}
+
+		if tt.wantErr {
+			assert.True(t, binary.IsDecodeError(it.Err()), "%v: expected decode error, got %v", tt.desc, it.Err())
+		} else {
+			assert.NoError(t, it.Err(), tt.desc)
+		}
+
+		if assert.Len(t, got, len(tt.want), tt.desc) {
+			for i, v := range got {
+				assert.True(t, wire.ValuesAreEqual(tt.want[i], v), "%v: item %d: %v", tt.desc, i, v)
+			}
+		}
+	}
+}
+
+func TestIterateDecodedMap(t *testing.T) {
+	encoded := []byte{
+		0x0B,                   // ktype = binary
+		0x06,                   // vtype = i16
+		0x00, 0x00, 0x00, 0x02, // count:4 = 2
+		0x00, 0x00, 0x00, 0x01, 0x61, // 'a'
+		0x00, 0x01, // 1
+		0x00, 0x00, 0x00, 0x01, 0x62, // 'b'
+		0x00, 0x02, // 2
+	}
+	want := []wire.MapItem{
+		vitem(vbinary("a"), vi16(1)),
+		vitem(vbinary("b"), vi16(2)),
+	}
+
+	value, err := Binary.Decode(bytes.NewReader(encoded), wire.TMap)
+	require.NoError(t, err)
+
+	var got []wire.MapItem
+	it := wire.IterateMapItemList(value.GetMap())
+	for it.Next() {
+		got = append(got, it.Item())
+	}
+
+	require.NoError(t, it.Err())
+	if assert.Len(t, got, len(want)) {
+		for i, item := range got {
+			assert.True(t, wire.ValuesAreEqual(want[i].Key, item.Key), "key %d: %v", i, item.Key)
+			assert.True(t, wire.ValuesAreEqual(want[i].Value, item.Value), "value %d: %v", i, item.Value)
+		}
+	}
+}
+
 func TestStructOfContainers(t *testing.T) {
 	tests := []encodeDecodeTest{
 		{
@@ -687,6 +984,125 @@ func TestBinaryEnvelopeErrors(t *testing.T) {
 	}
 }
 
+func TestBinaryDecodeEnvelopeHeader(t *testing.T) {
+	tests := []struct {
+		desc    string
+		encoded []byte
+	}{
+		{
+			desc: "strict",
+			encoded: []byte{
+				0x80, 0x01, 0x00, 0x01, // version|type:4 = 1 | call
+				0x00, 0x00, 0x00, 0x03, 'a', 'b', 'c', // name~4 = "abc"
+				0x00, 0x00, 0x15, 0x3c, // seqID:4 = 5436
+
+				// The body is not read.
+				0x0b, 0x00, 0x01, 0x7f, 0xff, 0xff, 0xff,
+			},
+		},
+		{
+			desc: "non-strict",
+			encoded: []byte{
+				0x00, 0x00, 0x00, 0x03, 'a', 'b', 'c', // name~4 = "abc"
+				0x01,                   // type:1 = call
+				0x00, 0x00, 0x15, 0x3c, // seqID:4 = 5436
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		d, ok := Binary.(EnvelopeHeaderDecoder)
+		require.True(t, ok, "Binary must decode envelope headers")
+
+		e, err := d.DecodeEnvelopeHeader(bytes.NewReader(tt.encoded))
+		if assert.NoError(t, err, tt.desc) {
+			assert.Equal(t, wire.Envelope{Name: "abc", Type: wire.Call, SeqID: 5436}, e, tt.desc)
+		}
+	}
+}
+
+func TestBinaryDecodeMismatchedPayload(t *testing.T) {
+	tests := []struct {
+		desc      string
+		encoded   []byte
+		enveloped bool
+		wantHint  string // empty if the error should have no hint
+	}{
+		{
+			desc: "compact struct",
+			encoded: []byte{
+				0x15, // delta:4 = 1, type:4 = i32
+				0x02, // zigzag varint = 1
+				0x00, // stop
+			},
+			wantHint: "payload looks like compact protocol; this decoder is binary",
+		},
+		{
+			desc: "compact envelope",
+			encoded: []byte{
+				0x82,                // protocol ID
+				0x21,                // type:3 = call, version:5 = 1
+				0x01,                // seqID~varint = 1
+				0x03, 'a', 'b', 'c', // name~varint = "abc"
+				0x00, // stop
+			},
+			enveloped: true,
+			wantHint:  "payload looks like compact protocol; this decoder is binary",
+		},
+		{
+			desc:     "JSON struct",
+			encoded:  []byte(`{"1":{"i32":1}}`),
+			wantHint: "payload looks like JSON; this decoder is binary",
+		},
+		{
+			desc:      "JSON envelope",
+			encoded:   []byte(`[1,"abc",1,0,{}]`),
+			enveloped: true,
+			wantHint:  "payload looks like JSON; this decoder is binary",
+		},
+		{
+			desc: "framed envelope",
+			encoded: []byte{
+				0x00, 0x00, 0x00, 0x10, // frame size:4 = 16
+				0x80, 0x01, 0x00, 0x01, // version|type:4 = 1 | call
+				0x00, 0x00, 0x00, 0x03, 'a', 'b', 'c', // name~4 = "abc"
+				0x00, 0x00, 0x00, 0x01, // seqID:4 = 1
+				0x00, // stop
+			},
+			enveloped: true,
+			wantHint:  "payload looks like it has a framed transport header",
+		},
+		{
+			desc: "no hint",
+			encoded: []byte{
+				0x0B,       // type:1 = binary
+				0x00, 0x01, // id:2 = 1
+				0xff, 0xff, 0xff, 0xff, // length:4 = -1
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var err error
+			if tt.enveloped {
+				_, err = Binary.DecodeEnveloped(bytes.NewReader(tt.encoded))
+			} else {
+				_, err = Binary.Decode(bytes.NewReader(tt.encoded), wire.TStruct)
+			}
+			require.Error(t, err)
+
+			if tt.wantHint == "" {
+				assert.NotContains(t, err.Error(), "looks like")
+				return
+			}
+
+			assert.Contains(t, err.Error(), tt.wantHint)
+			assert.True(t, binary.IsDecodeError(err), "expected decode error, got %v", err)
+		})
+	}
+}
+
 func TestBinaryEnvelopeSuccessful(t *testing.T) {
 	tests := []struct {
 		msg      string
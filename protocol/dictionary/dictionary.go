@@ -0,0 +1,169 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package dictionary
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+)
+
+// MaxSize is the largest useful dictionary size. DEFLATE cannot refer to
+// data further back than this.
+const MaxSize = 32 * 1024
+
+// Formats of compressed payloads, recorded in their first byte.
+const (
+	formatNoDictionary byte = 0
+	formatDictionary   byte = 1
+)
+
+// headerSize is the size of the format byte and the fingerprint which
+// precede the DEFLATE stream of compressed payloads.
+const headerSize = 9
+
+var errTruncated = errors.New("compressed payload is too short")
+
+// UnknownDictionaryError is returned when decompressing a payload which was
+// compressed with a dictionary that is not available.
+type UnknownDictionaryError struct {
+	Fingerprint Fingerprint
+}
+
+func (e UnknownDictionaryError) Error() string {
+	return fmt.Sprintf("unknown dictionary for type with fingerprint %v", e.Fingerprint)
+}
+
+// Dictionaries maps type fingerprints to the dictionaries trained for them.
+type Dictionaries map[Fingerprint][]byte
+
+// Compress compresses the given payload of the type with the given
+// fingerprint. If there is no dictionary for the type, the payload is
+// compressed without one.
+func (d Dictionaries) Compress(fp Fingerprint, payload []byte) ([]byte, error) {
+	dict, ok := d[fp]
+
+	var buf bytes.Buffer
+	header := [headerSize]byte{formatNoDictionary}
+	if ok {
+		header[0] = formatDictionary
+	}
+	binary.BigEndian.PutUint64(header[1:], uint64(fp))
+	buf.Write(header[:])
+
+	w, err := flate.NewWriterDict(&buf, flate.BestCompression, dict)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := w.Write(payload); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Decompress decompresses a payload compressed with Compress. It returns
+// the fingerprint of the type of the payload along with the payload.
+func (d Dictionaries) Decompress(data []byte) (Fingerprint, []byte, error) {
+	if len(data) < headerSize {
+		return 0, nil, errTruncated
+	}
+	fp := Fingerprint(binary.BigEndian.Uint64(data[1:headerSize]))
+
+	var dict []byte
+	switch data[0] {
+	case formatNoDictionary:
+	case formatDictionary:
+		var ok bool
+		dict, ok = d[fp]
+		if !ok {
+			return fp, nil, UnknownDictionaryError{Fingerprint: fp}
+		}
+	default:
+		return fp, nil, fmt.Errorf("unknown compressed payload format %d", data[0])
+	}
+
+	r := flate.NewReaderDict(bytes.NewReader(data[headerSize:]), dict)
+	defer r.Close()
+
+	payload, err := ioutil.ReadAll(r)
+	if err != nil {
+		return fp, nil, err
+	}
+	return fp, payload, nil
+}
+
+// Encode writes the dictionaries to the given Writer. They are written as
+// a Binary-encoded Thrift map<i64, binary> so that they may be read by
+// other languages.
+func (d Dictionaries) Encode(w io.Writer) error {
+	fps := make([]uint64, 0, len(d))
+	for fp := range d {
+		fps = append(fps, uint64(fp))
+	}
+	sort.Sort(uint64s(fps))
+
+	items := make([]wire.MapItem, len(fps))
+	for i, fp := range fps {
+		items[i] = wire.MapItem{
+			Key:   wire.NewValueI64(int64(fp)),
+			Value: wire.NewValueBinary(d[Fingerprint(fp)]),
+		}
+	}
+	m := wire.MapItemListFromSlice(wire.TI64, wire.TBinary, items)
+	return protocol.Binary.Encode(wire.NewValueMap(m), w)
+}
+
+// Decode reads dictionaries written with Dictionaries.Encode.
+func Decode(r io.ReaderAt) (Dictionaries, error) {
+	v, err := protocol.Binary.Decode(r, wire.TMap)
+	if err != nil {
+		return nil, err
+	}
+
+	m := v.GetMap()
+	if m.KeyType() != wire.TI64 || m.ValueType() != wire.TBinary {
+		return nil, fmt.Errorf(
+			"expected map<i64, binary>, got map of %v to %v", m.KeyType(), m.ValueType())
+	}
+
+	d := make(Dictionaries, m.Size())
+	err = m.ForEach(func(item wire.MapItem) error {
+		d[Fingerprint(item.Key.GetI64())] = item.Value.GetBinary()
+		return nil
+	})
+	return d, err
+}
+
+type uint64s []uint64
+
+func (s uint64s) Len() int           { return len(s) }
+func (s uint64s) Less(i, j int) bool { return s[i] < s[j] }
+func (s uint64s) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
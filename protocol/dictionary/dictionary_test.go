@@ -0,0 +1,149 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package dictionary
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSpec = userSpec(
+	&compile.FieldSpec{ID: 1, Name: "name", Type: &compile.StringSpec{}, Required: true},
+	&compile.FieldSpec{ID: 2, Name: "email", Type: &compile.StringSpec{}},
+)
+
+func user(i int) wire.Value {
+	return wire.NewValueStruct(wire.Struct{Fields: []wire.Field{
+		{ID: 1, Value: wire.NewValueString(fmt.Sprintf("user-%d", i))},
+		{ID: 2, Value: wire.NewValueString(fmt.Sprintf("user-%d@accounts.example.com", i))},
+	}})
+}
+
+func trainedDictionaries(t *testing.T) Dictionaries {
+	trainer := NewTrainer(TrainerConfig{Size: 1024})
+	for i := 0; i < 100; i++ {
+		require.NoError(t, trainer.Add(testSpec, user(i)))
+	}
+	dicts := trainer.Train()
+	require.Len(t, dicts, 1)
+	return dicts
+}
+
+func TestCompress(t *testing.T) {
+	dicts := trainedDictionaries(t)
+	fp := FingerprintOf(testSpec)
+
+	var buf bytes.Buffer
+	require.NoError(t, protocol.Binary.Encode(user(1234), &buf))
+	payload := buf.Bytes()
+
+	withDict, err := dicts.Compress(fp, payload)
+	require.NoError(t, err)
+	withoutDict, err := Dictionaries{}.Compress(fp, payload)
+	require.NoError(t, err)
+	assert.True(t, len(withDict) < len(withoutDict),
+		"compressed with dictionary to %d bytes, without to %d bytes", len(withDict), len(withoutDict))
+
+	gotFP, got, err := dicts.Decompress(withDict)
+	require.NoError(t, err)
+	assert.Equal(t, fp, gotFP)
+	assert.Equal(t, payload, got)
+
+	// Payloads compressed without a dictionary may be decompressed by
+	// anyone.
+	_, got, err = Dictionaries{}.Decompress(withoutDict)
+	require.NoError(t, err)
+	assert.Equal(t, payload, got)
+
+	_, _, err = Dictionaries{}.Decompress(withDict)
+	assert.Equal(t, UnknownDictionaryError{Fingerprint: fp}, err)
+
+	_, _, err = dicts.Decompress(withDict[:4])
+	assert.Error(t, err)
+
+	invalid := append([]byte{42}, withDict[1:]...)
+	_, _, err = dicts.Decompress(invalid)
+	assert.EqualError(t, err, "unknown compressed payload format 42")
+}
+
+func TestEncodeDecode(t *testing.T) {
+	dicts := Dictionaries{
+		1: []byte("foo"),
+		2: []byte("bar"),
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, dicts.Encode(&buf))
+
+	got, err := Decode(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, dicts, got)
+
+	buf.Reset()
+	require.NoError(t, dicts.Encode(&buf))
+	var again bytes.Buffer
+	require.NoError(t, got.Encode(&again))
+	assert.Equal(t, buf.Bytes(), again.Bytes(), "encoding must be stable")
+
+	t.Run("wrong types", func(t *testing.T) {
+		var buf bytes.Buffer
+		m := wire.MapItemListFromSlice(wire.TI32, wire.TBinary, nil)
+		require.NoError(t, protocol.Binary.Encode(wire.NewValueMap(m), &buf))
+
+		_, err := Decode(bytes.NewReader(buf.Bytes()))
+		assert.EqualError(t, err, "expected map<i64, binary>, got map of TI32 to TBinary")
+	})
+}
+
+func TestProtocol(t *testing.T) {
+	p := NewProtocol(protocol.Binary, trainedDictionaries(t), testSpec)
+
+	var buf bytes.Buffer
+	require.NoError(t, p.Encode(user(42), &buf))
+	got, err := p.Decode(bytes.NewReader(buf.Bytes()), wire.TStruct)
+	require.NoError(t, err)
+	assert.True(t, wire.ValuesAreEqual(user(42), got), "expected %v, got %v", user(42), got)
+
+	t.Run("enveloped", func(t *testing.T) {
+		e := wire.Envelope{Name: "getUser", Type: wire.Reply, SeqID: 1, Value: user(42)}
+
+		var buf bytes.Buffer
+		require.NoError(t, p.EncodeEnveloped(e, &buf))
+		got, err := p.DecodeEnveloped(bytes.NewReader(buf.Bytes()))
+		require.NoError(t, err)
+		assert.Equal(t, e.Name, got.Name)
+		assert.True(t, wire.ValuesAreEqual(e.Value, got.Value), "expected %v, got %v", e.Value, got.Value)
+	})
+
+	t.Run("other type", func(t *testing.T) {
+		other := NewProtocol(protocol.Binary, nil, userSpec())
+		_, err := other.Decode(bytes.NewReader(buf.Bytes()), wire.TStruct)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "payload is for type with fingerprint")
+	})
+}
@@ -0,0 +1,44 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+// Package dictionary compresses Thrift payloads with DEFLATE dictionaries
+// trained on captured payloads of each type.
+//
+// Small structs compress poorly on their own because there is little
+// repetition within a single payload. Payloads of the same type, however,
+// repeat the same field headers, enum values, and strings, so compressing
+// them with a dictionary holding the common parts of captured payloads
+// yields much smaller messages.
+//
+//   trainer := dictionary.NewTrainer(dictionary.TrainerConfig{})
+//   for _, v := range captured {
+//     trainer.Add(spec, v)
+//   }
+//   dicts := trainer.Train()
+//
+//   p := dictionary.NewProtocol(protocol.Binary, dicts, spec)
+//   err := p.Encode(v, w)
+//
+// Dictionaries are keyed by the Fingerprint of the type they were trained
+// for. Compressed payloads record the fingerprint of their type so that
+// peers which have the same dictionaries can decompress them.
+//
+// The thriftrw command trains dictionaries with the --train-dictionaries
+// option.
+package dictionary
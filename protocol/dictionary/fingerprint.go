@@ -0,0 +1,103 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package dictionary
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"sort"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// Fingerprint identifies the shape of a Thrift type. Types with the same
+// name, field IDs, and field types have the same fingerprint.
+type Fingerprint uint64
+
+func (f Fingerprint) String() string {
+	return fmt.Sprintf("%016x", uint64(f))
+}
+
+// FingerprintOf returns the Fingerprint of the given type.
+//
+// The fingerprint of a struct covers its name and the IDs, requiredness,
+// and types of its fields. Other structs and enums referenced by its fields
+// contribute only their names so that they may change without changing the
+// fingerprint of the struct, and so that recursive types have fingerprints.
+// Typedefs have the fingerprints of the types they alias.
+func FingerprintOf(spec compile.TypeSpec) Fingerprint {
+	h := fnv.New64a()
+	writeTypeShape(h, compile.RootTypeSpec(spec), true)
+	return Fingerprint(h.Sum64())
+}
+
+// writeTypeShape writes a description of the given type to the hash. If
+// deep is false, user-defined types are described by name only.
+func writeTypeShape(h hash.Hash64, spec compile.TypeSpec, deep bool) {
+	spec = compile.RootTypeSpec(spec)
+	h.Write([]byte{byte(spec.TypeCode())})
+
+	switch s := spec.(type) {
+	case *compile.MapSpec:
+		writeTypeShape(h, s.KeySpec, false)
+		writeTypeShape(h, s.ValueSpec, false)
+	case *compile.ListSpec:
+		writeTypeShape(h, s.ValueSpec, false)
+	case *compile.SetSpec:
+		writeTypeShape(h, s.ValueSpec, false)
+	case *compile.StructSpec:
+		writeString(h, s.Name)
+		if !deep {
+			return
+		}
+
+		fields := make([]*compile.FieldSpec, len(s.Fields))
+		copy(fields, s.Fields)
+		sort.Sort(byFieldID(fields))
+
+		var buf [3]byte
+		for _, f := range fields {
+			binary.BigEndian.PutUint16(buf[:2], uint16(f.ID))
+			buf[2] = 0
+			if f.Required {
+				buf[2] = 1
+			}
+			h.Write(buf[:])
+			writeTypeShape(h, f.Type, false)
+		}
+	default:
+		writeString(h, s.ThriftName())
+	}
+}
+
+func writeString(h hash.Hash64, s string) {
+	var buf [4]byte
+	binary.BigEndian.PutUint32(buf[:], uint32(len(s)))
+	h.Write(buf[:])
+	h.Write([]byte(s))
+}
+
+type byFieldID []*compile.FieldSpec
+
+func (fs byFieldID) Len() int           { return len(fs) }
+func (fs byFieldID) Less(i, j int) bool { return fs[i].ID < fs[j].ID }
+func (fs byFieldID) Swap(i, j int)      { fs[i], fs[j] = fs[j], fs[i] }
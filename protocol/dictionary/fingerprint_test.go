@@ -0,0 +1,100 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package dictionary
+
+import (
+	"testing"
+
+	"go.uber.org/thriftrw/ast"
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func userSpec(fields ...*compile.FieldSpec) *compile.StructSpec {
+	return &compile.StructSpec{Name: "User", Type: ast.StructType, Fields: fields}
+}
+
+func TestFingerprintOf(t *testing.T) {
+	name := &compile.FieldSpec{ID: 1, Name: "name", Type: &compile.StringSpec{}, Required: true}
+	age := &compile.FieldSpec{ID: 2, Name: "age", Type: &compile.I32Spec{}}
+	base := FingerprintOf(userSpec(name, age))
+
+	t.Run("field order and names", func(t *testing.T) {
+		renamed := &compile.FieldSpec{ID: 1, Name: "fullName", Type: &compile.StringSpec{}, Required: true}
+		assert.Equal(t, base, FingerprintOf(userSpec(age, renamed)))
+	})
+
+	t.Run("typedef", func(t *testing.T) {
+		spec := &compile.TypedefSpec{Name: "Person", Target: userSpec(name, age)}
+		typedef, err := spec.Link(compile.EmptyScope("foo"))
+		require.NoError(t, err)
+		assert.Equal(t, base, FingerprintOf(typedef))
+	})
+
+	t.Run("changes", func(t *testing.T) {
+		tests := []struct {
+			desc string
+			spec compile.TypeSpec
+		}{
+			{"no fields", userSpec()},
+			{"optional", userSpec(
+				&compile.FieldSpec{ID: 1, Name: "name", Type: &compile.StringSpec{}},
+				age,
+			)},
+			{"field ID", userSpec(
+				name,
+				&compile.FieldSpec{ID: 3, Name: "age", Type: &compile.I32Spec{}},
+			)},
+			{"field type", userSpec(
+				name,
+				&compile.FieldSpec{ID: 2, Name: "age", Type: &compile.I64Spec{}},
+			)},
+			{"list type", userSpec(
+				name,
+				&compile.FieldSpec{ID: 2, Name: "age", Type: &compile.ListSpec{ValueSpec: &compile.I32Spec{}}},
+			)},
+			{"name", &compile.StructSpec{Name: "Person", Type: ast.StructType, Fields: compile.FieldGroup{name, age}}},
+		}
+
+		seen := map[Fingerprint]string{base: "base"}
+		for _, tt := range tests {
+			fp := FingerprintOf(tt.spec)
+			if other, ok := seen[fp]; ok {
+				t.Errorf("%v: fingerprint %v matches %v", tt.desc, fp, other)
+			}
+			seen[fp] = tt.desc
+		}
+	})
+
+	t.Run("recursive", func(t *testing.T) {
+		node := &compile.StructSpec{Name: "Node", Type: ast.StructType}
+		node.Fields = compile.FieldGroup{
+			{ID: 1, Name: "next", Type: node},
+			{ID: 2, Name: "children", Type: &compile.ListSpec{ValueSpec: node}},
+		}
+		assert.NotZero(t, FingerprintOf(node))
+	})
+
+	t.Run("String", func(t *testing.T) {
+		assert.Equal(t, "00000000000000ff", Fingerprint(255).String())
+	})
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package dictionary
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+)
+
+// Protocol is a protocol.Protocol which compresses the payloads of another
+// Protocol with the dictionary for a specific type.
+type Protocol struct {
+	p     protocol.Protocol
+	dicts Dictionaries
+	fp    Fingerprint
+}
+
+var _ protocol.Protocol = (*Protocol)(nil)
+
+// NewProtocol returns a Protocol which encodes values of the given type with
+// the given Protocol and compresses them with the dictionary trained for
+// that type.
+//
+// Values decoded with the returned Protocol must have been encoded for the
+// same type.
+func NewProtocol(p protocol.Protocol, dicts Dictionaries, spec compile.TypeSpec) *Protocol {
+	return &Protocol{p: p, dicts: dicts, fp: FingerprintOf(spec)}
+}
+
+// Encode encodes and compresses the given value.
+func (p *Protocol) Encode(v wire.Value, w io.Writer) error {
+	var buf bytes.Buffer
+	if err := p.p.Encode(v, &buf); err != nil {
+		return err
+	}
+	return p.write(buf.Bytes(), w)
+}
+
+// EncodeEnveloped encodes and compresses the given envelope. The body of the
+// envelope must be of the type of this Protocol.
+func (p *Protocol) EncodeEnveloped(e wire.Envelope, w io.Writer) error {
+	var buf bytes.Buffer
+	if err := p.p.EncodeEnveloped(e, &buf); err != nil {
+		return err
+	}
+	return p.write(buf.Bytes(), w)
+}
+
+// Decode decompresses and decodes a value of the given type.
+func (p *Protocol) Decode(r io.ReaderAt, t wire.Type) (wire.Value, error) {
+	payload, err := p.read(r)
+	if err != nil {
+		return wire.Value{}, err
+	}
+	return p.p.Decode(bytes.NewReader(payload), t)
+}
+
+// DecodeEnveloped decompresses and decodes an enveloped value.
+func (p *Protocol) DecodeEnveloped(r io.ReaderAt) (wire.Envelope, error) {
+	payload, err := p.read(r)
+	if err != nil {
+		return wire.Envelope{}, err
+	}
+	return p.p.DecodeEnveloped(bytes.NewReader(payload))
+}
+
+func (p *Protocol) write(payload []byte, w io.Writer) error {
+	data, err := p.dicts.Compress(p.fp, payload)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+func (p *Protocol) read(r io.ReaderAt) ([]byte, error) {
+	data, err := ioutil.ReadAll(io.NewSectionReader(r, 0, math.MaxInt64))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) >= headerSize {
+		if fp := Fingerprint(binary.BigEndian.Uint64(data[1:headerSize])); fp != p.fp {
+			return nil, fmt.Errorf(
+				"payload is for type with fingerprint %v, expected %v", fp, p.fp)
+		}
+	}
+
+	_, payload, err := p.dicts.Decompress(data)
+	return payload, err
+}
@@ -0,0 +1,221 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package dictionary
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math/rand"
+
+	"go.uber.org/thriftrw/compile"
+	"go.uber.org/thriftrw/protocol"
+	"go.uber.org/thriftrw/wire"
+)
+
+// dmerSize is the length of the substrings whose frequency across samples
+// decides which parts of the samples are copied into dictionaries.
+const dmerSize = 8
+
+// TrainerConfig configures a Trainer. Fields with zero values use the
+// defaults.
+type TrainerConfig struct {
+	// Size is the maximum size of each dictionary in bytes. Defaults to and
+	// may not exceed MaxSize.
+	Size int
+
+	// MaxSamples is the number of payloads of each type that are kept for
+	// training. Once this many have been added, payloads are sampled
+	// uniformly. Defaults to 1000.
+	MaxSamples int
+
+	// SegmentSize is the length of the segments of samples that are copied
+	// into dictionaries. Defaults to 64.
+	SegmentSize int
+}
+
+func (c TrainerConfig) withDefaults() TrainerConfig {
+	if c.Size <= 0 || c.Size > MaxSize {
+		c.Size = MaxSize
+	}
+	if c.MaxSamples <= 0 {
+		c.MaxSamples = 1000
+	}
+	if c.SegmentSize < dmerSize {
+		c.SegmentSize = 64
+	}
+	return c
+}
+
+// Trainer trains dictionaries from payloads captured for each type.
+type Trainer struct {
+	cfg     TrainerConfig
+	rand    *rand.Rand
+	samples map[Fingerprint]*samples
+}
+
+type samples struct {
+	payloads [][]byte
+	seen     int // number of payloads added, including those not kept
+}
+
+// NewTrainer builds a new Trainer.
+func NewTrainer(cfg TrainerConfig) *Trainer {
+	return &Trainer{
+		cfg:     cfg.withDefaults(),
+		rand:    rand.New(rand.NewSource(1)), // deterministic for reproducible dictionaries
+		samples: make(map[Fingerprint]*samples),
+	}
+}
+
+// Add adds a value of the given type to the samples for the type.
+func (t *Trainer) Add(spec compile.TypeSpec, v wire.Value) error {
+	var buf bytes.Buffer
+	if err := protocol.Binary.Encode(v, &buf); err != nil {
+		return err
+	}
+	t.AddEncoded(FingerprintOf(spec), buf.Bytes())
+	return nil
+}
+
+// AddEncoded adds an encoded payload to the samples for the type with the
+// given fingerprint. Payloads should be encoded with the protocol that will
+// be compressed with the dictionary.
+func (t *Trainer) AddEncoded(fp Fingerprint, payload []byte) {
+	s, ok := t.samples[fp]
+	if !ok {
+		s = &samples{}
+		t.samples[fp] = s
+	}
+
+	// Reservoir sampling keeps each payload with equal probability.
+	s.seen++
+	if len(s.payloads) < t.cfg.MaxSamples {
+		s.payloads = append(s.payloads, payload)
+	} else if i := t.rand.Intn(s.seen); i < t.cfg.MaxSamples {
+		s.payloads[i] = payload
+	}
+}
+
+// Train trains a dictionary for each type with samples. Types whose samples
+// have nothing in common do not get a dictionary.
+func (t *Trainer) Train() Dictionaries {
+	dicts := make(Dictionaries, len(t.samples))
+	for fp, s := range t.samples {
+		if dict := train(s.payloads, t.cfg.Size, t.cfg.SegmentSize); len(dict) > 0 {
+			dicts[fp] = dict
+		}
+	}
+	return dicts
+}
+
+// train builds a dictionary from the segments of the given samples which
+// hold the most substrings shared by other samples.
+//
+// Each substring of dmerSize bytes is scored by the number of samples which
+// contain it. The segment with the highest total score of the substrings it
+// starts is copied into the dictionary, after which the substrings it holds
+// no longer count, and this repeats until the dictionary is full or no
+// segment holds shared substrings.
+func train(payloads [][]byte, size, segmentSize int) []byte {
+	// Assign an ID to each distinct dmer and record the dmers starting at
+	// each position of each sample.
+	ids := make(map[uint64]int)
+	var scores []int
+	dmers := make([][]int, len(payloads))
+	for i, p := range payloads {
+		if len(p) < dmerSize {
+			continue
+		}
+		seen := make(map[int]struct{})
+		dmers[i] = make([]int, len(p)-dmerSize+1)
+		for j := range dmers[i] {
+			key := binary.LittleEndian.Uint64(p[j : j+dmerSize])
+			id, ok := ids[key]
+			if !ok {
+				id = len(scores)
+				ids[key] = id
+				scores = append(scores, 0)
+			}
+			dmers[i][j] = id
+			if _, ok := seen[id]; !ok {
+				seen[id] = struct{}{}
+				scores[id]++
+			}
+		}
+	}
+
+	// Substrings in only one sample are not worth keeping unless there is
+	// only one sample.
+	if len(payloads) > 1 {
+		for id, score := range scores {
+			if score < 2 {
+				scores[id] = 0
+			}
+		}
+	}
+
+	span := segmentSize - dmerSize + 1 // number of dmers starting in a segment
+	var segments [][]byte
+	total := 0
+	for total < size {
+		bestScore, bestSample, bestStart := 0, -1, 0
+		for i, ds := range dmers {
+			score := 0
+			for j, id := range ds {
+				score += scores[id]
+				if j >= span {
+					score -= scores[ds[j-span]]
+				}
+				if score > bestScore {
+					bestScore, bestSample, bestStart = score, i, j-span+1
+				}
+			}
+		}
+		if bestSample < 0 {
+			break
+		}
+
+		if bestStart < 0 {
+			bestStart = 0
+		}
+		end := bestStart + segmentSize
+		if p := payloads[bestSample]; end > len(p) {
+			end = len(p)
+		}
+		segment := payloads[bestSample][bestStart:end]
+		if total+len(segment) > size {
+			segment = segment[:size-total]
+		}
+		segments = append(segments, segment)
+		total += len(segment)
+
+		for j := bestStart; j < end-dmerSize+1; j++ {
+			scores[dmers[bestSample][j]] = 0
+		}
+	}
+
+	// DEFLATE encodes references to recent data more compactly, so the most
+	// valuable segments go at the end of the dictionary.
+	dict := make([]byte, 0, total)
+	for i := len(segments) - 1; i >= 0; i-- {
+		dict = append(dict, segments[i]...)
+	}
+	return dict
+}
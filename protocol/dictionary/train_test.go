@@ -0,0 +1,71 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package dictionary
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTrain(t *testing.T) {
+	payloads := [][]byte{
+		[]byte("header:common-prefix;unique-aaaa"),
+		[]byte("header:common-prefix;unique-bbbb"),
+		[]byte("header:common-prefix;unique-cccc"),
+	}
+
+	dict := train(payloads, 1024, 16)
+	assert.True(t, bytes.Contains(dict, []byte("header:common-pr")), "dictionary %q", dict)
+	assert.False(t, bytes.Contains(dict, []byte("aaaa")), "dictionary %q", dict)
+
+	t.Run("size", func(t *testing.T) {
+		assert.Len(t, train(payloads, 10, 16), 10)
+	})
+
+	t.Run("nothing shared", func(t *testing.T) {
+		assert.Empty(t, train([][]byte{[]byte("abcdefghij"), []byte("klmnopqrst")}, 1024, 16))
+	})
+
+	t.Run("short samples", func(t *testing.T) {
+		assert.Empty(t, train([][]byte{[]byte("abc"), []byte("abc")}, 1024, 16))
+	})
+}
+
+func TestTrainerSampling(t *testing.T) {
+	trainer := NewTrainer(TrainerConfig{MaxSamples: 10})
+	for i := 0; i < 100; i++ {
+		trainer.AddEncoded(1, []byte{byte(i)})
+	}
+	trainer.AddEncoded(2, []byte("foo"))
+
+	assert.Len(t, trainer.samples[1].payloads, 10)
+	assert.Equal(t, 100, trainer.samples[1].seen)
+	assert.Len(t, trainer.samples[2].payloads, 1)
+
+	var late int
+	for _, p := range trainer.samples[1].payloads {
+		if p[0] >= 10 {
+			late++
+		}
+	}
+	assert.NotZero(t, late, "payloads added after the first MaxSamples must be sampled")
+}
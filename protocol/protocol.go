@@ -44,3 +44,12 @@ type Protocol interface {
 	// Enveloped values are assumed to be TStructs.
 	DecodeEnveloped(r io.ReaderAt) (wire.Envelope, error)
 }
+
+// EnvelopeHeaderDecoder is implemented by Protocols which can decode the
+// header of an envelope without decoding the enveloped value.
+type EnvelopeHeaderDecoder interface {
+	// DecodeEnvelopeHeader reads the name, type, and sequence ID of an
+	// enveloped value from the given Reader. The Value of the returned
+	// Envelope is not set.
+	DecodeEnvelopeHeader(r io.ReaderAt) (wire.Envelope, error)
+}
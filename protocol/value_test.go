@@ -53,6 +53,14 @@ func vbinary(s string) wire.Value {
 	return wire.NewValueBinary([]byte(s))
 }
 
+func vuuid(s string) wire.Value {
+	u, err := wire.ParseUUID(s)
+	if err != nil {
+		panic(err)
+	}
+	return wire.NewValueUUID(u)
+}
+
 func vstruct(fs ...wire.Field) wire.Value {
 	return wire.NewValueStruct(wire.Struct{Fields: fs})
 }
@@ -0,0 +1,122 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package runtime
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// Client sends enveloped requests to a Thrift service, like the clients of
+// go.uber.org/thriftrw/envelope/balancer. Generated batchers send their
+// batches with it.
+type Client interface {
+	Send(name string, body wire.Value) (wire.Value, error)
+}
+
+// BatcherConfig configures how calls are coalesced into batches.
+type BatcherConfig struct {
+	// Window is how long a batch waits for more calls after its first
+	// call.
+	Window time.Duration
+
+	// MaxSize is the number of calls after which a batch is sent without
+	// waiting for the rest of the Window. If zero, batches are not limited.
+	MaxSize int
+}
+
+// Batcher coalesces calls which are made within a window into batches.
+// Generated batchers use it to turn calls to a function annotated with
+// thriftrw.batch into calls to the batch function.
+type Batcher struct {
+	cfg  BatcherConfig
+	send func([]interface{}) ([]interface{}, error)
+
+	mu      sync.Mutex
+	pending *batch
+}
+
+// NewBatcher builds a Batcher which sends batches with the given function.
+// The function must return one response for each request, in the same
+// order.
+func NewBatcher(cfg BatcherConfig, send func(requests []interface{}) ([]interface{}, error)) *Batcher {
+	return &Batcher{cfg: cfg, send: send}
+}
+
+type batch struct {
+	once      sync.Once
+	done      chan struct{}
+	requests  []interface{}
+	responses []interface{}
+	err       error
+}
+
+// Do adds the given request to the pending batch, and returns its response
+// once the batch has been sent. If the batch fails, all calls in it fail
+// with the same error.
+func (b *Batcher) Do(request interface{}) (interface{}, error) {
+	b.mu.Lock()
+	bt := b.pending
+	if bt == nil {
+		bt = &batch{done: make(chan struct{})}
+		b.pending = bt
+		time.AfterFunc(b.cfg.Window, func() { b.flush(bt) })
+	}
+	i := len(bt.requests)
+	bt.requests = append(bt.requests, request)
+	full := b.cfg.MaxSize > 0 && len(bt.requests) >= b.cfg.MaxSize
+	if full {
+		b.pending = nil
+	}
+	b.mu.Unlock()
+
+	if full {
+		b.flush(bt)
+	}
+
+	<-bt.done
+	if bt.err != nil {
+		return nil, bt.err
+	}
+	return bt.responses[i], nil
+}
+
+// flush sends the given batch unless it was sent already.
+func (b *Batcher) flush(bt *batch) {
+	bt.once.Do(func() {
+		b.mu.Lock()
+		if b.pending == bt {
+			b.pending = nil
+		}
+		requests := bt.requests
+		b.mu.Unlock()
+
+		bt.responses, bt.err = b.send(requests)
+		if bt.err == nil && len(bt.responses) != len(requests) {
+			bt.err = fmt.Errorf(
+				"batch of %v requests received %v responses", len(requests), len(bt.responses))
+		}
+		close(bt.done)
+	})
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package runtime
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// doubler sends batches by doubling each request and records the batches.
+type doubler struct {
+	mu      sync.Mutex
+	batches [][]interface{}
+}
+
+func (d *doubler) send(requests []interface{}) ([]interface{}, error) {
+	d.mu.Lock()
+	d.batches = append(d.batches, requests)
+	d.mu.Unlock()
+
+	responses := make([]interface{}, len(requests))
+	for i, r := range requests {
+		responses[i] = r.(int) * 2
+	}
+	return responses, nil
+}
+
+func doAll(b *Batcher, n int) []interface{} {
+	responses := make([]interface{}, n)
+	var wg sync.WaitGroup
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			responses[i], _ = b.Do(i)
+		}(i)
+	}
+	wg.Wait()
+	return responses
+}
+
+func TestBatcherWindow(t *testing.T) {
+	var d doubler
+	b := NewBatcher(BatcherConfig{Window: 50 * time.Millisecond}, d.send)
+
+	assert.Equal(t, []interface{}{0, 2, 4, 6}, doAll(b, 4))
+	assert.Len(t, d.batches, 1)
+
+	res, err := b.Do(5)
+	assert.NoError(t, err)
+	assert.Equal(t, 10, res)
+	assert.Len(t, d.batches, 2)
+}
+
+func TestBatcherMaxSize(t *testing.T) {
+	var d doubler
+	b := NewBatcher(BatcherConfig{Window: time.Hour, MaxSize: 2}, d.send)
+
+	assert.Equal(t, []interface{}{0, 2, 4, 6}, doAll(b, 4))
+	if assert.Len(t, d.batches, 2) {
+		assert.Len(t, d.batches[0], 2)
+		assert.Len(t, d.batches[1], 2)
+	}
+}
+
+func TestBatcherErrors(t *testing.T) {
+	b := NewBatcher(BatcherConfig{MaxSize: 1}, func([]interface{}) ([]interface{}, error) {
+		return nil, errors.New("great sadness")
+	})
+	_, err := b.Do(1)
+	assert.EqualError(t, err, "great sadness")
+
+	b = NewBatcher(BatcherConfig{MaxSize: 1}, func([]interface{}) ([]interface{}, error) {
+		return []interface{}{1, 2}, nil
+	})
+	_, err = b.Do(1)
+	assert.EqualError(t, err, "batch of 1 requests received 2 responses")
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package runtime
+
+import "go.uber.org/thriftrw/wire"
+
+// List is a wire.ValueList of the items of a Go slice.
+type List struct {
+	// Type of the items of the list.
+	Type wire.Type
+
+	// Number of items in the list.
+	Len int
+
+	// Item encodes the item at the given index.
+	Item func(i int) (wire.Value, error)
+}
+
+var _ wire.ValueList = List{}
+
+// ForEach calls f with each item of the list in order.
+func (l List) ForEach(f func(wire.Value) error) error {
+	for i := 0; i < l.Len; i++ {
+		w, err := l.Item(i)
+		if err != nil {
+			return err
+		}
+		if err := f(w); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Size returns the number of items in the list.
+func (l List) Size() int { return l.Len }
+
+// ValueType returns the type of the items of the list.
+func (l List) ValueType() wire.Type { return l.Type }
+
+// Close is a no-op.
+func (List) Close() {}
+
+// Set is a wire.ValueList of the items of a Go map used as a set.
+type Set struct {
+	// Type of the items of the set.
+	Type wire.Type
+
+	// Number of items in the set.
+	Len int
+
+	// Range encodes each item of the set and calls f with it, stopping at
+	// the first error.
+	Range func(f func(wire.Value) error) error
+}
+
+var _ wire.ValueList = Set{}
+
+// ForEach calls f with each item of the set.
+func (s Set) ForEach(f func(wire.Value) error) error { return s.Range(f) }
+
+// Size returns the number of items in the set.
+func (s Set) Size() int { return s.Len }
+
+// ValueType returns the type of the items of the set.
+func (s Set) ValueType() wire.Type { return s.Type }
+
+// Close is a no-op.
+func (Set) Close() {}
+
+// Map is a wire.MapItemList of the items of a Go map, or of a Go slice of
+// key-value pairs for maps whose keys are not hashable.
+type Map struct {
+	// Types of the keys and values of the map.
+	Key, Value wire.Type
+
+	// Number of items in the map.
+	Len int
+
+	// Range encodes each key-value pair of the map and calls f with it,
+	// stopping at the first error.
+	Range func(f func(wire.MapItem) error) error
+}
+
+var _ wire.MapItemList = Map{}
+
+// ForEach calls f with each item of the map.
+func (m Map) ForEach(f func(wire.MapItem) error) error { return m.Range(f) }
+
+// Size returns the number of items in the map.
+func (m Map) Size() int { return m.Len }
+
+// KeyType returns the type of the keys of the map.
+func (m Map) KeyType() wire.Type { return m.Key }
+
+// ValueType returns the type of the values of the map.
+func (m Map) ValueType() wire.Type { return m.Value }
+
+// Close is a no-op.
+func (Map) Close() {}
@@ -0,0 +1,43 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package runtime provides helpers which are called by code generated by
+// ThriftRW, most of them only with the --compact-code option.
+//
+// Code generated without --compact-code declares a new type with ForEach,
+// Size, ValueType, and Close methods for every list, set, and map type it
+// encodes. With --compact-code, the generated code declares a function for
+// each such type which fills one of the types in this package with the
+// logic specific to its items, and this package supplies the rest.
+// Similarly, the FromWire methods of generated structs decode fields with a
+// table of FieldReaders and a loop in this package rather than a switch
+// statement. This trades an indirect function call per item or field for a
+// smaller binary.
+//
+// The batchers generated for functions with a thriftrw.batch annotation
+// coalesce calls with a Batcher.
+//
+// MemSize methods generated with the --mem-size option estimate the size of
+// maps and sets with MapMemSize.
+//
+// This package is not intended to be used directly. Its API is only
+// guaranteed to be compatible with code generated by the same version of
+// ThriftRW.
+package runtime
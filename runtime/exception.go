@@ -0,0 +1,61 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package runtime
+
+import (
+	"fmt"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// UnexpectedApplicationError is returned by the UnwrapResponse functions of
+// generated code when the result of a function holds an exception which is
+// not declared in the IDL the code was generated from. This usually means
+// that the server was built with a newer version of the IDL.
+type UnexpectedApplicationError struct {
+	// Name of the generated result type.
+	Result string
+
+	// ID of the field of the result which holds the exception.
+	FieldID int16
+
+	// The exception as it was received.
+	Exception wire.Value
+}
+
+func (e *UnexpectedApplicationError) Error() string {
+	return fmt.Sprintf(
+		"%v holds an unexpected exception in field %v: %v",
+		e.Result, e.FieldID, e.Exception)
+}
+
+// FindUnexpectedException returns an UnexpectedApplicationError for the
+// first exception in the given unrecognized fields of a result, or nil if
+// there is none. The field with ID 0 holds the return value of a function
+// and is never an exception.
+func FindUnexpectedException(result string, unknown wire.UnknownFields) *UnexpectedApplicationError {
+	for _, f := range unknown {
+		if f.ID > 0 && f.Value.Type() == wire.TStruct {
+			return &UnexpectedApplicationError{Result: result, FieldID: f.ID, Exception: f.Value}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package runtime
+
+import (
+	"testing"
+
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindUnexpectedException(t *testing.T) {
+	exception := wire.NewValueStruct(wire.Struct{})
+
+	tests := []struct {
+		desc    string
+		unknown wire.UnknownFields
+		wantID  int16 // 0 if nil is expected
+	}{
+		{desc: "empty"},
+		{
+			desc: "success field",
+			unknown: wire.UnknownFields{
+				{ID: 0, Value: exception},
+			},
+		},
+		{
+			desc: "not a struct",
+			unknown: wire.UnknownFields{
+				{ID: 1, Value: wire.NewValueI32(42)},
+			},
+		},
+		{
+			desc: "first exception",
+			unknown: wire.UnknownFields{
+				{ID: 1, Value: wire.NewValueI32(42)},
+				{ID: 3, Value: exception},
+				{ID: 2, Value: exception},
+			},
+			wantID: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := FindUnexpectedException("Foo_Bar_Result", tt.unknown)
+			if tt.wantID == 0 {
+				assert.Nil(t, err)
+				return
+			}
+
+			if assert.NotNil(t, err) {
+				assert.Equal(t, tt.wantID, err.FieldID)
+				assert.Equal(t, "Foo_Bar_Result holds an unexpected exception in field 3: TStruct({})", err.Error())
+			}
+		})
+	}
+}
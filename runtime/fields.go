@@ -0,0 +1,89 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package runtime
+
+import "go.uber.org/thriftrw/wire"
+
+// FieldReader decodes a field of a struct.
+type FieldReader struct {
+	// ID and type of the field.
+	ID   int16
+	Type wire.Type
+
+	// Read decodes the given value into the field of the struct, which is
+	// the same value that was passed to FieldReaders.Read.
+	Read func(s interface{}, w wire.Value) error
+}
+
+// FieldReaders is a table of FieldReaders for the fields of a struct,
+// sorted by ID.
+type FieldReaders []FieldReader
+
+// Read decodes the fields of the given wire.Struct into s, which is passed
+// to the FieldReaders as-is.
+//
+// Fields which have a different type than their FieldReader are ignored.
+// Fields which do not have a FieldReader are added to unknown if it is
+// non-nil, and ignored otherwise. If isSet is non-nil, it must have the
+// same length as the table, and isSet[i] is set to true if the field read
+// by the FieldReader at index i was decoded.
+func (rs FieldReaders) Read(s interface{}, w wire.Struct, isSet []bool, unknown *wire.UnknownFields) error {
+	for _, f := range w.Fields {
+		i := rs.index(f.ID)
+		if i < 0 {
+			if unknown != nil {
+				if err := unknown.Add(f); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if rs[i].Type != f.Value.Type() {
+			continue
+		}
+
+		if err := rs[i].Read(s, f.Value); err != nil {
+			return err
+		}
+		if isSet != nil {
+			isSet[i] = true
+		}
+	}
+	return nil
+}
+
+// index returns the index of the FieldReader with the given ID, or -1 if
+// there isn't one.
+func (rs FieldReaders) index(id int16) int {
+	lo, hi := 0, len(rs)
+	for lo < hi {
+		mid := int(uint(lo+hi) >> 1)
+		switch {
+		case rs[mid].ID == id:
+			return mid
+		case rs[mid].ID < id:
+			lo = mid + 1
+		default:
+			hi = mid
+		}
+	}
+	return -1
+}
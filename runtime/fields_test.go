@@ -0,0 +1,141 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package runtime
+
+import (
+	"errors"
+	"testing"
+
+	"go.uber.org/thriftrw/wire"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldReaders(t *testing.T) {
+	type record struct{ fields map[int16]wire.Value }
+
+	reader := func(id int16, typ wire.Type) FieldReader {
+		return FieldReader{
+			ID:   id,
+			Type: typ,
+			Read: func(s interface{}, w wire.Value) error {
+				if w.Type() == wire.TBinary && w.GetString() == "fail" {
+					return errors.New("great sadness")
+				}
+				s.(*record).fields[id] = w
+				return nil
+			},
+		}
+	}
+	readers := FieldReaders{
+		reader(1, wire.TI32),
+		reader(3, wire.TBinary),
+		reader(7, wire.TBool),
+		reader(20, wire.TI64),
+	}
+
+	tests := []struct {
+		desc      string
+		give      []wire.Field
+		want      map[int16]wire.Value
+		wantIsSet []bool
+		wantErr   string
+	}{
+		{
+			desc:      "empty",
+			want:      map[int16]wire.Value{},
+			wantIsSet: []bool{false, false, false, false},
+		},
+		{
+			desc: "all fields",
+			give: []wire.Field{
+				{ID: 20, Value: wire.NewValueI64(4)},
+				{ID: 1, Value: wire.NewValueI32(1)},
+				{ID: 7, Value: wire.NewValueBool(true)},
+				{ID: 3, Value: wire.NewValueString("foo")},
+			},
+			want: map[int16]wire.Value{
+				1:  wire.NewValueI32(1),
+				3:  wire.NewValueString("foo"),
+				7:  wire.NewValueBool(true),
+				20: wire.NewValueI64(4),
+			},
+			wantIsSet: []bool{true, true, true, true},
+		},
+		{
+			desc: "unknown and mismatched fields",
+			give: []wire.Field{
+				{ID: 0, Value: wire.NewValueI32(1)},
+				{ID: 2, Value: wire.NewValueI32(1)},
+				{ID: 7, Value: wire.NewValueI32(1)},
+				{ID: 21, Value: wire.NewValueI64(1)},
+				{ID: 20, Value: wire.NewValueI64(4)},
+			},
+			want:      map[int16]wire.Value{20: wire.NewValueI64(4)},
+			wantIsSet: []bool{false, false, false, true},
+		},
+		{
+			desc: "error",
+			give: []wire.Field{
+				{ID: 3, Value: wire.NewValueString("fail")},
+			},
+			wantErr: "great sadness",
+		},
+	}
+
+	for _, tt := range tests {
+		r := record{fields: make(map[int16]wire.Value)}
+		isSet := make([]bool, len(readers))
+		err := readers.Read(&r, wire.Struct{Fields: tt.give}, isSet, nil)
+		if tt.wantErr != "" {
+			assert.EqualError(t, err, tt.wantErr, tt.desc)
+			continue
+		}
+		if assert.NoError(t, err, tt.desc) {
+			assert.Equal(t, tt.want, r.fields, tt.desc)
+			assert.Equal(t, tt.wantIsSet, isSet, tt.desc)
+		}
+
+		assert.NoError(t, readers.Read(&record{fields: make(map[int16]wire.Value)}, wire.Struct{Fields: tt.give}, nil, nil),
+			"%v: isSet may be nil", tt.desc)
+	}
+}
+
+func TestFieldReadersUnknownFields(t *testing.T) {
+	readers := FieldReaders{
+		{ID: 1, Type: wire.TI32, Read: func(interface{}, wire.Value) error { return nil }},
+	}
+
+	var unknown wire.UnknownFields
+	err := readers.Read(nil, wire.Struct{Fields: []wire.Field{
+		{ID: 2, Value: wire.NewValueString("foo")},
+		{ID: 1, Value: wire.NewValueString("wrong type")},
+		{ID: 1, Value: wire.NewValueI32(42)},
+		{ID: 3, Value: wire.NewValueBool(true)},
+	}}, nil, &unknown)
+	if assert.NoError(t, err) {
+		assert.Len(t, unknown, 2)
+		assert.True(t, unknown.Equals(wire.UnknownFields{
+			{ID: 2, Value: wire.NewValueString("foo")},
+			{ID: 3, Value: wire.NewValueBool(true)},
+		}), "unexpected unknown fields: %v", unknown)
+	}
+}
@@ -0,0 +1,47 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package runtime
+
+import "unsafe"
+
+// Estimates of the layout of Go maps used by MapMemSize.
+const (
+	mapHeaderSize     = 48  // size of a map header
+	mapBucketItems    = 8   // items per bucket
+	mapMaxLoadPercent = 650 // average items per bucket, as a percentage, before the map grows
+)
+
+// MapMemSize estimates the number of bytes held by a Go map with n items
+// whose keys and values have the given sizes. Memory referenced by the keys
+// and values is not included.
+//
+// MemSize methods generated with the --mem-size option use this to estimate
+// the size of maps and sets.
+func MapMemSize(n int, keySize, valueSize uintptr) int {
+	buckets := 1
+	for n*100 > buckets*mapMaxLoadPercent {
+		buckets *= 2
+	}
+
+	// Each bucket has a byte per item holding the top bits of its hash, the
+	// keys, the values, and a pointer to an overflow bucket.
+	bucketSize := mapBucketItems*(1+int(keySize)+int(valueSize)) + int(unsafe.Sizeof(uintptr(0)))
+	return mapHeaderSize + buckets*bucketSize
+}
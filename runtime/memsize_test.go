@@ -0,0 +1,39 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapMemSize(t *testing.T) {
+	empty := MapMemSize(0, 8, 8)
+	assert.True(t, empty > 0, "empty maps have a header and a bucket")
+	assert.Equal(t, empty, MapMemSize(6, 8, 8), "six items fit in one bucket")
+
+	two := MapMemSize(7, 8, 8)
+	assert.True(t, two > empty, "seven items need a second bucket")
+	assert.Equal(t, two, MapMemSize(13, 8, 8))
+
+	assert.True(t, MapMemSize(1000, 8, 8) >= 1000*16, "items must be counted")
+	assert.True(t, MapMemSize(1000, 16, 8) > MapMemSize(1000, 8, 8), "larger keys must take more memory")
+}
@@ -0,0 +1,183 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// initOptions are the options of the init command.
+type initOptions struct {
+	Args struct {
+		Name string `positional-arg-name:"NAME" description:"Name of the service. A directory with this name is created for it."`
+	} `positional-args:"yes" required:"yes"`
+}
+
+// scaffold holds the information used to write the files of a new project.
+type scaffold struct {
+	// Name of the project as given by the user. This is also the name of
+	// the Thrift file and the directory.
+	Name string
+
+	// Name of the Go package.
+	Package string
+
+	// Name of the Thrift service.
+	Service string
+}
+
+// scaffoldFiles are templates for the files written by the init command,
+// keyed by file name. "NAME" in file names is replaced with the name of the
+// project.
+var scaffoldFiles = map[string]*template.Template{
+	"NAME.thrift": template.Must(template.New("thrift").Parse(
+		`// Definitions for {{.Name}}.
+//
+// Code for this file is generated into gen-go/. Run "make thriftrw" or
+// "go generate" to regenerate it after making changes.
+
+exception {{.Service}}Error {
+    1: required string message
+}
+
+struct HelloRequest {
+    1: required string name
+}
+
+struct HelloResponse {
+    1: required string message
+}
+
+service {{.Service}} {
+    HelloResponse hello(1: HelloRequest request)
+        throws (1: {{.Service}}Error error)
+}
+`)),
+	"thriftrw.ini": template.Must(template.New("config").Parse(
+		`; Options for ThriftRW, used with "thriftrw --config thriftrw.ini".
+;
+; Sections are named after the groups of options listed by "thriftrw --help"
+; and hold their long names without the leading dashes. Options given on the
+; command line take precedence over this file.
+
+[Generator Options]
+out = gen-go
+`)),
+	"generate.go": template.Must(template.New("generate").Parse(
+		`// Package {{.Package}} holds the Thrift definitions for {{.Name}}.
+package {{.Package}}
+
+//go:generate thriftrw --config thriftrw.ini {{.Name}}.thrift
+`)),
+	"Makefile": template.Must(template.New("makefile").Parse(
+		`THRIFTRW ?= thriftrw
+THRIFT_FILES = {{.Name}}.thrift
+
+.PHONY: thriftrw
+thriftrw:
+	$(THRIFTRW) --config thriftrw.ini $(THRIFT_FILES)
+`)),
+}
+
+// initProject writes a starter Thrift file and the configuration needed to
+// generate code for it into a new directory named after the project inside
+// the given directory.
+//
+// Existing files are never overwritten.
+func initProject(dir, name string) error {
+	s, err := newScaffold(name)
+	if err != nil {
+		return err
+	}
+
+	projectDir := filepath.Join(dir, name)
+	if _, err := os.Stat(projectDir); err == nil {
+		return fmt.Errorf("cannot initialize %q: %q already exists", name, projectDir)
+	}
+	if err := os.MkdirAll(projectDir, 0755); err != nil {
+		return err
+	}
+
+	for fileName, tmpl := range scaffoldFiles {
+		var buff bytes.Buffer
+		if err := tmpl.Execute(&buff, s); err != nil {
+			return fmt.Errorf("failed to render %q: %v", fileName, err)
+		}
+
+		path := filepath.Join(projectDir, strings.Replace(fileName, "NAME", name, 1))
+		if err := ioutil.WriteFile(path, buff.Bytes(), 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// newScaffold builds a scaffold for a project with the given name. Names
+// are made up of ASCII letters, digits, dashes, and underscores, and must
+// start with a letter.
+func newScaffold(name string) (*scaffold, error) {
+	if !isValidProjectName(name) {
+		return nil, fmt.Errorf(
+			"invalid name %q: names must start with a letter and contain only "+
+				"letters, digits, dashes, and underscores", name)
+	}
+
+	var pkg, service []rune
+	upper := true
+	for _, r := range name {
+		if r == '-' || r == '_' {
+			upper = true
+			continue
+		}
+
+		pkg = append(pkg, unicode.ToLower(r))
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		service = append(service, r)
+	}
+
+	return &scaffold{
+		Name:    name,
+		Package: string(pkg),
+		Service: string(service),
+	}, nil
+}
+
+func isValidProjectName(name string) bool {
+	for i, r := range name {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z':
+		case i > 0 && (r >= '0' && r <= '9' || r == '-' || r == '_'):
+		default:
+			return false
+		}
+	}
+	return name != ""
+}
@@ -0,0 +1,120 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitProject(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "thriftrw-init-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	require.NoError(t, initProject(tmpDir, "my-service"))
+
+	dir := filepath.Join(tmpDir, "my-service")
+	m, err := compile.Compile(filepath.Join(dir, "my-service.thrift"))
+	require.NoError(t, err, "starter Thrift file must compile")
+	assert.Contains(t, m.Services, "MyService")
+
+	f, err := parser.ParseFile(token.NewFileSet(), filepath.Join(dir, "generate.go"), nil, parser.ParseComments)
+	require.NoError(t, err, "generate.go must be valid Go")
+	assert.Equal(t, "myservice", f.Name.Name)
+
+	for _, name := range []string{"generate.go", "Makefile"} {
+		contents, err := ioutil.ReadFile(filepath.Join(dir, name))
+		require.NoError(t, err)
+		assert.Contains(t, string(contents), "--config thriftrw.ini", name)
+	}
+
+	var opts options
+	_, err = parseArgs(newParser(&opts), &opts,
+		[]string{"--config", filepath.Join(dir, "thriftrw.ini")})
+	require.NoError(t, err, "thriftrw.ini must be a valid config")
+	assert.Equal(t, "gen-go", opts.GOpts.OutputDirectory)
+
+	err = initProject(tmpDir, "my-service")
+	assert.EqualError(t, err,
+		`cannot initialize "my-service": "`+dir+`" already exists`)
+}
+
+func TestNewScaffold(t *testing.T) {
+	tests := []struct {
+		give        string
+		wantPackage string
+		wantService string
+		wantErr     bool
+	}{
+		{give: "foo", wantPackage: "foo", wantService: "Foo"},
+		{give: "user-store", wantPackage: "userstore", wantService: "UserStore"},
+		{give: "Key_Value2", wantPackage: "keyvalue2", wantService: "KeyValue2"},
+		{give: "", wantErr: true},
+		{give: "2fa", wantErr: true},
+		{give: "-foo", wantErr: true},
+		{give: "foo/bar", wantErr: true},
+		{give: "foo bar", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		s, err := newScaffold(tt.give)
+		if tt.wantErr {
+			assert.Error(t, err, tt.give)
+			continue
+		}
+		if assert.NoError(t, err, tt.give) {
+			assert.Equal(t, tt.wantPackage, s.Package, tt.give)
+			assert.Equal(t, tt.wantService, s.Service, tt.give)
+		}
+	}
+}
+
+func TestParseArgsConfig(t *testing.T) {
+	f, err := ioutil.TempFile("", "thriftrw-config-test")
+	require.NoError(t, err)
+	defer os.Remove(f.Name())
+
+	_, err = f.WriteString("[Generator Options]\nout = from-config\nzap = true\n")
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+
+	var opts options
+	args, err := parseArgs(newParser(&opts), &opts,
+		[]string{"--config", f.Name(), "--out", "from-flag", "foo.thrift"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"foo.thrift"}, args)
+	assert.Equal(t, "from-flag", opts.GOpts.OutputDirectory, "flags take precedence")
+	assert.True(t, opts.GOpts.Zap)
+
+	_, err = parseArgs(newParser(&opts), &opts,
+		[]string{"--config", f.Name() + ".missing"})
+	assert.Error(t, err)
+}
@@ -29,4 +29,7 @@ type ThriftModule struct {
 	Includes []*ThriftModule // A reference to every included thrift modules.
 	SHA1     string          // The SHA1 of the thrift content.
 	Raw      string          // The full content of the thrift file.
+
+	// The version of ThriftRW which generated the package.
+	ThriftRWVersion string
 }
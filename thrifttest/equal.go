@@ -0,0 +1,244 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package thrifttest provides helpers for testing code that uses types
+// generated by ThriftRW.
+package thrifttest
+
+import (
+	"fmt"
+	"sort"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+// TestingT is the subset of testing.TB used to report failures.
+type TestingT interface {
+	Errorf(format string, args ...interface{})
+}
+
+// Value is implemented by all types generated by ThriftRW.
+type Value interface {
+	ToWire() (wire.Value, error)
+}
+
+// Option customizes the comparison made by AssertEqual.
+type Option struct {
+	apply func(*comparer)
+}
+
+// IgnoreUnsetOptional is an Option that treats fields set to their zero
+// value the same as fields that were not set. For example, an optional
+// string field set to "" matches the same field left nil.
+func IgnoreUnsetOptional() Option {
+	return Option{apply: func(c *comparer) {
+		c.ignoreUnset = true
+	}}
+}
+
+// IgnoreOrder is an Option that ignores the order of items in lists.
+func IgnoreOrder() Option {
+	return Option{apply: func(c *comparer) {
+		c.ignoreOrder = true
+	}}
+}
+
+// AssertEqual checks that the two given Thrift values are equal and reports a
+// failure to t if they are not. It returns true if the values are equal.
+//
+// 	thrifttest.AssertEqual(t, want, got, thrifttest.IgnoreUnsetOptional())
+//
+// Values are compared by their wire representation. Sets and maps are
+// compared regardless of the order of their items. Failures show both values
+// with the fields of structs listed by their field IDs.
+func AssertEqual(t TestingT, want, got Value, opts ...Option) bool {
+	var c comparer
+	for _, opt := range opts {
+		opt.apply(&c)
+	}
+
+	wantWire, err := want.ToWire()
+	if err != nil {
+		t.Errorf("failed to serialize expected value %v: %v", want, err)
+		return false
+	}
+
+	gotWire, err := got.ToWire()
+	if err != nil {
+		t.Errorf("failed to serialize actual value %v: %v", got, err)
+		return false
+	}
+
+	wantWire = c.normalize(wantWire)
+	gotWire = c.normalize(gotWire)
+	if wire.ValuesAreEqual(wantWire, gotWire) {
+		return true
+	}
+
+	t.Errorf("Not equal:\n"+
+		"expected: %v\n"+
+		"actual:   %v\n\n"+
+		"expected (wire):\n%v\n\n"+
+		"actual (wire):\n%v",
+		want, got, wire.Dump(wantWire), wire.Dump(gotWire))
+	return false
+}
+
+// comparer rewrites wire values so that differences ignored by the requested
+// options disappear.
+type comparer struct {
+	ignoreUnset bool
+	ignoreOrder bool
+}
+
+func (c *comparer) normalize(v wire.Value) wire.Value {
+	switch v.Type() {
+	case wire.TStruct:
+		var fields []wire.Field
+		for _, f := range v.GetStruct().Fields {
+			f.Value = c.normalize(f.Value)
+			if c.ignoreUnset && isZero(f.Value) {
+				continue
+			}
+			fields = append(fields, f)
+		}
+		return wire.NewValueStruct(wire.Struct{Fields: fields})
+
+	case wire.TList:
+		l := v.GetList()
+		items := c.normalizeAll(wire.ValueListToSlice(l))
+		if c.ignoreOrder {
+			sortValues(items)
+		}
+		return wire.NewValueList(wire.ValueListFromSlice(l.ValueType(), items))
+
+	case wire.TSet:
+		l := v.GetSet()
+		items := c.normalizeAll(wire.ValueListToSlice(l))
+		// Sort items so that they are dumped in a consistent order, which
+		// matters if the set is inside a list that we sort.
+		sortValues(items)
+		return wire.NewValueSet(wire.ValueListFromSlice(l.ValueType(), items))
+
+	case wire.TMap:
+		m := v.GetMap()
+		items := wire.MapItemListToSlice(m)
+		for i, item := range items {
+			items[i] = wire.MapItem{
+				Key:   c.normalize(item.Key),
+				Value: c.normalize(item.Value),
+			}
+		}
+		sortMapItems(items)
+		return wire.NewValueMap(wire.MapItemListFromSlice(m.KeyType(), m.ValueType(), items))
+
+	default:
+		return v
+	}
+}
+
+func (c *comparer) normalizeAll(vs []wire.Value) []wire.Value {
+	for i, v := range vs {
+		vs[i] = c.normalize(v)
+	}
+	return vs
+}
+
+// isZero checks whether the given normalized value is the zero value of its
+// type.
+func isZero(v wire.Value) bool {
+	switch v.Type() {
+	case wire.TBool:
+		return !v.GetBool()
+	case wire.TI8:
+		return v.GetI8() == 0
+	case wire.TDouble:
+		return v.GetDouble() == 0
+	case wire.TI16:
+		return v.GetI16() == 0
+	case wire.TI32:
+		return v.GetI32() == 0
+	case wire.TI64:
+		return v.GetI64() == 0
+	case wire.TBinary:
+		return len(v.GetBinary()) == 0
+	case wire.TStruct:
+		return len(v.GetStruct().Fields) == 0
+	case wire.TMap:
+		return v.GetMap().Size() == 0
+	case wire.TSet:
+		return v.GetSet().Size() == 0
+	case wire.TList:
+		return v.GetList().Size() == 0
+	case wire.TUUID:
+		return v.GetUUID() == wire.UUID{}
+	default:
+		panic(fmt.Sprintf("unknown value type %v", v.Type()))
+	}
+}
+
+// sortValues sorts the given normalized values by their dumped
+// representation. Equal normalized values always have the same
+// representation, so lists holding the same items sort the same way.
+func sortValues(vs []wire.Value) {
+	keys := make([]string, len(vs))
+	for i, v := range vs {
+		keys[i] = wire.Dump(v)
+	}
+	sort.Sort(valuesByKey{keys: keys, values: vs})
+}
+
+// sortMapItems sorts the given map items by the dumped representation of
+// their keys.
+func sortMapItems(items []wire.MapItem) {
+	keys := make([]string, len(items))
+	for i, item := range items {
+		keys[i] = wire.Dump(item.Key)
+	}
+	sort.Sort(mapItemsByKey{keys: keys, items: items})
+}
+
+type valuesByKey struct {
+	keys   []string
+	values []wire.Value
+}
+
+func (s valuesByKey) Len() int { return len(s.keys) }
+
+func (s valuesByKey) Less(i, j int) bool { return s.keys[i] < s.keys[j] }
+
+func (s valuesByKey) Swap(i, j int) {
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+	s.values[i], s.values[j] = s.values[j], s.values[i]
+}
+
+type mapItemsByKey struct {
+	keys  []string
+	items []wire.MapItem
+}
+
+func (s mapItemsByKey) Len() int { return len(s.keys) }
+
+func (s mapItemsByKey) Less(i, j int) bool { return s.keys[i] < s.keys[j] }
+
+func (s mapItemsByKey) Swap(i, j int) {
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+	s.items[i], s.items[j] = s.items[j], s.items[i]
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package thrifttest
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"go.uber.org/thriftrw/wire"
+)
+
+type fakeValue struct {
+	v   wire.Value
+	err error
+}
+
+func (f fakeValue) ToWire() (wire.Value, error) { return f.v, f.err }
+
+func (f fakeValue) String() string { return f.v.String() }
+
+type fakeT struct{ errors []string }
+
+func (t *fakeT) Errorf(format string, args ...interface{}) {
+	t.errors = append(t.errors, fmt.Sprintf(format, args...))
+}
+
+func TestAssertEqual(t *testing.T) {
+	tests := []struct {
+		desc      string
+		want, got wire.Value
+		opts      []Option
+		equal     bool
+	}{
+		{
+			desc:  "equal",
+			want:  wire.NewStruct().Field(1, wire.String("foo")).Value(),
+			got:   wire.NewStruct().Field(1, wire.String("foo")).Value(),
+			equal: true,
+		},
+		{
+			desc: "not equal",
+			want: wire.NewStruct().Field(1, wire.String("foo")).Value(),
+			got:  wire.NewStruct().Field(1, wire.String("bar")).Value(),
+		},
+		{
+			desc: "zero field",
+			want: wire.NewStruct().Value(),
+			got:  wire.NewStruct().Field(1, wire.String("")).Value(),
+		},
+		{
+			desc:  "zero field ignored",
+			want:  wire.NewStruct().Value(),
+			got:   wire.NewStruct().Field(1, wire.String("")).Value(),
+			opts:  []Option{IgnoreUnsetOptional()},
+			equal: true,
+		},
+		{
+			desc: "nested zero fields ignored",
+			want: wire.NewStruct().
+				Field(1, wire.I32(1)).
+				Value(),
+			got: wire.NewStruct().
+				Field(1, wire.I32(1)).
+				Field(2, wire.NewStruct().Field(1, wire.Bool(false)).Value()).
+				Field(3, wire.NewList(wire.TI64).Value()).
+				Value(),
+			opts:  []Option{IgnoreUnsetOptional()},
+			equal: true,
+		},
+		{
+			desc: "non-zero field is not ignored",
+			want: wire.NewStruct().Value(),
+			got:  wire.NewStruct().Field(1, wire.I16(1)).Value(),
+			opts: []Option{IgnoreUnsetOptional()},
+		},
+		{
+			desc: "list order",
+			want: wire.NewList(wire.TI32).Add(wire.I32(1), wire.I32(2)).Value(),
+			got:  wire.NewList(wire.TI32).Add(wire.I32(2), wire.I32(1)).Value(),
+		},
+		{
+			desc:  "list order ignored",
+			want:  wire.NewList(wire.TI32).Add(wire.I32(1), wire.I32(2)).Value(),
+			got:   wire.NewList(wire.TI32).Add(wire.I32(2), wire.I32(1)).Value(),
+			opts:  []Option{IgnoreOrder()},
+			equal: true,
+		},
+		{
+			desc: "list order ignored but not counts",
+			want: wire.NewList(wire.TI32).Add(wire.I32(1), wire.I32(1), wire.I32(2)).Value(),
+			got:  wire.NewList(wire.TI32).Add(wire.I32(1), wire.I32(2), wire.I32(2)).Value(),
+			opts: []Option{IgnoreOrder()},
+		},
+		{
+			desc: "list of sets order ignored",
+			want: wire.NewList(wire.TSet).
+				Add(wire.NewSet(wire.TBinary).Add(wire.String("a"), wire.String("b")).Value()).
+				Add(wire.NewSet(wire.TBinary).Add(wire.String("c")).Value()).
+				Value(),
+			got: wire.NewList(wire.TSet).
+				Add(wire.NewSet(wire.TBinary).Add(wire.String("c")).Value()).
+				Add(wire.NewSet(wire.TBinary).Add(wire.String("b"), wire.String("a")).Value()).
+				Value(),
+			opts:  []Option{IgnoreOrder()},
+			equal: true,
+		},
+		{
+			desc: "map order",
+			want: wire.NewMap(wire.TBinary, wire.TI32).
+				Add(wire.String("a"), wire.I32(1)).
+				Add(wire.String("b"), wire.I32(2)).
+				Value(),
+			got: wire.NewMap(wire.TBinary, wire.TI32).
+				Add(wire.String("b"), wire.I32(2)).
+				Add(wire.String("a"), wire.I32(1)).
+				Value(),
+			equal: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var ft fakeT
+			ok := AssertEqual(&ft, fakeValue{v: tt.want}, fakeValue{v: tt.got}, tt.opts...)
+			assert.Equal(t, tt.equal, ok)
+			if tt.equal {
+				assert.Empty(t, ft.errors)
+			} else if assert.Len(t, ft.errors, 1) {
+				assert.Contains(t, ft.errors[0], "Not equal")
+			}
+		})
+	}
+}
+
+func TestAssertEqualToWireError(t *testing.T) {
+	var ft fakeT
+	ok := AssertEqual(&ft,
+		fakeValue{v: wire.NewStruct().Value()},
+		fakeValue{err: errors.New("great sadness")},
+	)
+	assert.False(t, ok)
+	if assert.Len(t, ft.errors, 1) {
+		assert.Contains(t, ft.errors[0], "failed to serialize actual value")
+		assert.Contains(t, ft.errors[0], "great sadness")
+	}
+}
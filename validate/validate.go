@@ -0,0 +1,161 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// Package validate reports values of generated Thrift types which do not
+// satisfy the constraints of their Thrift definitions.
+//
+// Code generated with the --validate option provides a Validate method on
+// structs, unions, exceptions, and typedefs of those. Validate checks that
+// required fields are set and that unions have exactly one field set. It
+// descends into nested structs and into lists, sets, and maps, and reports
+// all violations it finds with the path of the offending value.
+//
+//   if err := req.Validate(); err != nil {
+//     // Items[2].Owner: field Name of User is required
+//     return err
+//   }
+//
+// Use Run with FailFast to stop at the first violation instead.
+//
+//   err := validate.Run(req, validate.Options{FailFast: true})
+package validate
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// Validator is implemented by generated types which may be validated.
+type Validator interface {
+	// ValidateWith reports the violations found in the value to the given
+	// Reporter. Paths of the violations are relative to the given path.
+	ValidateWith(r *Reporter, path string)
+}
+
+// Options controls how values are validated.
+type Options struct {
+	// Stop at the first violation instead of reporting all of them.
+	FailFast bool
+}
+
+// Run validates the given value with the given options. The returned error,
+// if any, is an *Error.
+func Run(v Validator, opts Options) error {
+	r := NewReporter(opts)
+	v.ValidateWith(r, "")
+	return r.Err()
+}
+
+// Violation is a single value which failed to validate.
+type Violation struct {
+	// Path to the value from the value being validated, like
+	// "Items[2].Owner". The path is empty if the value being validated is
+	// itself invalid.
+	Path string
+	Err  error
+}
+
+func (v Violation) Error() string {
+	if v.Path == "" {
+		return v.Err.Error()
+	}
+	return fmt.Sprintf("%v: %v", v.Path, v.Err)
+}
+
+// Error is returned when a value fails to validate. It lists all violations
+// in the order in which they were found.
+type Error struct {
+	Violations []Violation
+}
+
+func (e *Error) Error() string {
+	if len(e.Violations) == 1 {
+		return e.Violations[0].Error()
+	}
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%v violations: ", len(e.Violations))
+	for i, v := range e.Violations {
+		if i > 0 {
+			buf.WriteString("; ")
+		}
+		buf.WriteString(v.Error())
+	}
+	return buf.String()
+}
+
+// Reporter collects the violations found by generated ValidateWith methods.
+type Reporter struct {
+	opts       Options
+	violations []Violation
+}
+
+// NewReporter builds a new Reporter with the given options.
+func NewReporter(opts Options) *Reporter {
+	return &Reporter{opts: opts}
+}
+
+// Report records that the value at the given path is invalid. Violations
+// reported after Done returns true are ignored.
+func (r *Reporter) Report(path string, err error) {
+	if r.Done() {
+		return
+	}
+	r.violations = append(r.violations, Violation{Path: path, Err: err})
+}
+
+// Done returns true if validation should stop because FailFast was
+// requested and a violation has already been reported.
+func (r *Reporter) Done() bool {
+	return r.opts.FailFast && len(r.violations) > 0
+}
+
+// Err returns an *Error listing the violations reported so far, or nil if
+// there were none.
+func (r *Reporter) Err() error {
+	if len(r.violations) == 0 {
+		return nil
+	}
+
+	violations := make([]Violation, len(r.violations))
+	copy(violations, r.violations)
+	return &Error{Violations: violations}
+}
+
+// Field returns the path of the named field of the struct at the given
+// path.
+func Field(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
+
+// Index returns the path of the item at the given position of the list or
+// set at the given path.
+func Index(path string, i int) string {
+	return fmt.Sprintf("%v[%d]", path, i)
+}
+
+// Key returns the path of the value stored under the given key of the map at
+// the given path.
+func Key(path string, key interface{}) string {
+	return fmt.Sprintf("%v[%v]", path, key)
+}
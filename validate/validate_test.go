@@ -0,0 +1,118 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package validate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// pair is a Validator which reports errA and errB under the given fields.
+type pair struct{ a, b string }
+
+var (
+	errA = errors.New("a is invalid")
+	errB = errors.New("b is invalid")
+)
+
+func (p pair) ValidateWith(r *Reporter, path string) {
+	if p.a != "" {
+		r.Report(Field(path, p.a), errA)
+	}
+	if r.Done() {
+		return
+	}
+	if p.b != "" {
+		r.Report(Field(path, p.b), errB)
+	}
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		desc    string
+		v       Validator
+		opts    Options
+		want    []Violation
+		wantErr string
+	}{
+		{
+			desc: "valid",
+			v:    pair{},
+		},
+		{
+			desc:    "single violation",
+			v:       pair{b: "B"},
+			want:    []Violation{{Path: "B", Err: errB}},
+			wantErr: "B: b is invalid",
+		},
+		{
+			desc: "all violations",
+			v:    pair{a: "A", b: "B"},
+			want: []Violation{
+				{Path: "A", Err: errA},
+				{Path: "B", Err: errB},
+			},
+			wantErr: "2 violations: A: a is invalid; B: b is invalid",
+		},
+		{
+			desc:    "fail fast",
+			v:       pair{a: "A", b: "B"},
+			opts:    Options{FailFast: true},
+			want:    []Violation{{Path: "A", Err: errA}},
+			wantErr: "A: a is invalid",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := Run(tt.v, tt.opts)
+			if tt.want == nil {
+				assert.NoError(t, err)
+				return
+			}
+
+			if assert.IsType(t, &Error{}, err) {
+				assert.Equal(t, tt.want, err.(*Error).Violations)
+				assert.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestReporterIgnoresViolationsAfterDone(t *testing.T) {
+	r := NewReporter(Options{FailFast: true})
+	assert.False(t, r.Done())
+	assert.NoError(t, r.Err())
+
+	r.Report("", errA)
+	assert.True(t, r.Done())
+	r.Report("foo", errB)
+	assert.EqualError(t, r.Err(), "a is invalid")
+}
+
+func TestPaths(t *testing.T) {
+	assert.Equal(t, "Items", Field("", "Items"))
+	assert.Equal(t, "Items[2].Owner", Field(Index(Field("", "Items"), 2), "Owner"))
+	assert.Equal(t, "Users[alice].Manager", Field(Key("Users", "alice"), "Manager"))
+	assert.Equal(t, "[0]", Index("", 0))
+}
@@ -24,12 +24,22 @@ package version
 
 import (
 	"log"
+	"sync"
 
 	"go.uber.org/thriftrw/internal/semver"
 )
 
 var compatRange = computeCompatibleRange()
 
+// Binaries may import hundreds of generated packages, all of which check
+// the same version during initialization. compatVersions holds the versions
+// that are already known to be compatible so that they are parsed only
+// once.
+var (
+	compatVersionsMu sync.Mutex
+	compatVersions   = make(map[string]struct{})
+)
+
 func computeCompatibleRange() semver.Range {
 	v, err := semver.Parse(Version)
 	if err != nil {
@@ -50,6 +60,13 @@ func computeCompatibleRange() semver.Range {
 // This function will ensure that the version mismatch is detected and help
 // avoid bugs that could be caused by this discrepancy.
 func CheckCompatWithGeneratedCodeAt(genCodeVersion string, fromPkg string) {
+	compatVersionsMu.Lock()
+	defer compatVersionsMu.Unlock()
+
+	if _, ok := compatVersions[genCodeVersion]; ok {
+		return
+	}
+
 	v, err := semver.Parse(genCodeVersion)
 	if err != nil {
 		panic(err)
@@ -59,4 +76,6 @@ func CheckCompatWithGeneratedCodeAt(genCodeVersion string, fromPkg string) {
 		log.Panicf(`incompatible version from generated package %q, expected >=%s and <%s, got %s`,
 			fromPkg, &compatRange.Begin, &compatRange.End, &v)
 	}
+
+	compatVersions[genCodeVersion] = struct{}{}
 }
@@ -0,0 +1,48 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+// +build !thriftrw.disableVersionCheck
+
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckCompatWithGeneratedCodeAt(t *testing.T) {
+	assert.NotPanics(t, func() {
+		CheckCompatWithGeneratedCodeAt(Version, "foo")
+	})
+	assert.Contains(t, compatVersions, Version, "compatible versions must be remembered")
+
+	// Remembered versions are not parsed again.
+	assert.NotPanics(t, func() {
+		CheckCompatWithGeneratedCodeAt(Version, "bar")
+	})
+
+	for i := 0; i < 2; i++ {
+		assert.Panics(t, func() {
+			CheckCompatWithGeneratedCodeAt("0.1.0", "baz")
+		}, "incompatible versions must always panic")
+	}
+	assert.NotContains(t, compatVersions, "0.1.0")
+}
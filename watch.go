@@ -0,0 +1,172 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"errors"
+	"log"
+	"os"
+	"time"
+
+	"go.uber.org/thriftrw/compile"
+)
+
+// watcher runs the code generator again whenever the Thrift files it was
+// run on, or the files they include, change.
+type watcher struct {
+	// Files or directories passed to ThriftRW.
+	Args []string
+
+	// How often files are checked for changes.
+	Interval time.Duration
+
+	// Run generates code for Args. It returns the modules that were
+	// compiled, or nil if the files failed to compile.
+	Run func() ([]*compile.Module, error)
+
+	// Log receives the result of each run.
+	Log *log.Logger
+}
+
+// fileState is the state of a file used to decide whether it has changed.
+type fileState struct {
+	ModTime time.Time
+	Size    int64
+}
+
+// Watch runs the generator and then runs it again every time the watched
+// files change until stop is closed. A nil stop watches forever.
+//
+// Errors from the generator are logged rather than returned so that the
+// user may fix them without restarting ThriftRW.
+func (w *watcher) Watch(stop <-chan struct{}) error {
+	if w.Interval <= 0 {
+		return errors.New("--watch-interval must be positive")
+	}
+
+	// Files included by the Thrift files the last time they compiled. These
+	// are still watched if a later change breaks compilation so that fixing
+	// an included file triggers another run.
+	var included []string
+
+	// runOnce runs the generator and returns the state of the watched files
+	// as of the start of the run so that changes made during the run
+	// trigger another one.
+	runOnce := func() map[string]fileState {
+		// File systems may record modification times with a resolution as
+		// coarse as a second.
+		start := time.Now().Truncate(time.Second)
+		before := w.snapshot(included)
+
+		modules, err := w.Run()
+		if modules != nil {
+			included = thriftPaths(modules)
+		}
+		if err != nil {
+			w.Log.Printf("%v", err)
+		} else {
+			w.Log.Printf("Generated code for %v", quoteAll(w.Args))
+		}
+
+		// The set of included files may have changed. Files that were not
+		// watched before the run are left out if they may have changed
+		// during the run so that they are picked up by the next check.
+		after := w.snapshot(included)
+		for path, state := range after {
+			if old, ok := before[path]; ok {
+				after[path] = old
+			} else if !state.ModTime.Before(start) {
+				delete(after, path)
+			}
+		}
+		return after
+	}
+
+	last := runOnce()
+
+	ticker := time.NewTicker(w.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return nil
+		case <-ticker.C:
+		}
+
+		if sameFiles(last, w.snapshot(included)) {
+			continue
+		}
+
+		w.Log.Printf("Change detected. Regenerating code.")
+		last = runOnce()
+	}
+}
+
+// snapshot records the state of the Thrift files matched by the arguments
+// and the given included files. Files that do not exist are left out so that
+// removing them counts as a change.
+func (w *watcher) snapshot(included []string) map[string]fileState {
+	paths := append([]string(nil), included...)
+	if files, err := findThriftFiles(w.Args); err == nil {
+		paths = append(paths, files...)
+	}
+
+	files := make(map[string]fileState, len(paths))
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		files[path] = fileState{ModTime: info.ModTime(), Size: info.Size()}
+	}
+	return files
+}
+
+func sameFiles(l, r map[string]fileState) bool {
+	if len(l) != len(r) {
+		return false
+	}
+	for path, lstate := range l {
+		rstate, ok := r[path]
+		if !ok || !lstate.ModTime.Equal(rstate.ModTime) || lstate.Size != rstate.Size {
+			return false
+		}
+	}
+	return true
+}
+
+// thriftPaths returns the paths of all Thrift files that make up the given
+// modules, including the files they include.
+func thriftPaths(modules []*compile.Module) []string {
+	seen := make(map[string]struct{})
+	var paths []string
+	visit := func(m *compile.Module) error {
+		if _, ok := seen[m.ThriftPath]; !ok {
+			seen[m.ThriftPath] = struct{}{}
+			paths = append(paths, m.ThriftPath)
+		}
+		return nil
+	}
+	for _, m := range modules {
+		_ = m.Walk(visit) // visit never fails
+	}
+	return paths
+}
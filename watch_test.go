@@ -0,0 +1,127 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package main
+
+import (
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"go.uber.org/thriftrw/compile"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatch(t *testing.T) {
+	tmpDir, err := ioutil.TempDir("", "thriftrw-watch-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(tmpDir)
+
+	srcDir := filepath.Join(tmpDir, "src")
+	incDir := filepath.Join(tmpDir, "inc")
+	require.NoError(t, os.Mkdir(srcDir, 0755))
+	require.NoError(t, os.Mkdir(incDir, 0755))
+
+	// writeFile writes the given contents and pushes the modification time
+	// forward so that the change is noticed regardless of the resolution of
+	// the file system's timestamps.
+	modTime := time.Now()
+	writeFile := func(path, contents string) {
+		require.NoError(t, ioutil.WriteFile(path, []byte(contents), 0644))
+		modTime = modTime.Add(time.Minute)
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+	}
+
+	aPath := filepath.Join(srcDir, "a.thrift")
+	bPath := filepath.Join(incDir, "b.thrift")
+	writeFile(aPath, `include "../inc/b.thrift"`+"\n"+`struct A { 1: optional b.B b }`)
+	writeFile(bPath, `struct B {}`)
+
+	results := make(chan error)
+	w := watcher{
+		Args:     []string{srcDir},
+		Interval: time.Millisecond,
+		Run: func() ([]*compile.Module, error) {
+			files, err := findThriftFiles([]string{srcDir})
+			if err != nil {
+				results <- err
+				return nil, err
+			}
+			modules, err := compile.CompileFiles(files)
+			results <- err
+
+			// Files changed while the generator is still running must
+			// trigger another run.
+			time.Sleep(10 * time.Millisecond)
+			return modules, err
+		},
+		Log: log.New(ioutil.Discard, "", 0),
+	}
+
+	stop := make(chan struct{})
+	done := make(chan error)
+	go func() { done <- w.Watch(stop) }()
+
+	nextResult := func(msg string) error {
+		select {
+		case err := <-results:
+			return err
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "timed out waiting for a run", msg)
+			return nil
+		}
+	}
+
+	assert.NoError(t, nextResult("initial run"))
+
+	writeFile(bPath, `struct B { 1: optional string s }`)
+	assert.NoError(t, nextResult("included file changed"))
+
+	writeFile(aPath, `struct A {`)
+	assert.Error(t, nextResult("file broken"))
+
+	// Included files are still watched while the root file is broken.
+	writeFile(bPath, `struct B {}`)
+	assert.Error(t, nextResult("included file changed while broken"))
+
+	writeFile(aPath, `struct A {}`)
+	assert.NoError(t, nextResult("file fixed"))
+
+	writeFile(filepath.Join(srcDir, "c.thrift"), `struct C {}`)
+	assert.NoError(t, nextResult("file added"))
+
+	close(stop)
+	select {
+	case err := <-done:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for Watch to return")
+	}
+}
+
+func TestWatchInvalidInterval(t *testing.T) {
+	w := watcher{Interval: 0}
+	assert.EqualError(t, w.Watch(nil), "--watch-interval must be positive")
+}
@@ -0,0 +1,176 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package wire
+
+import "fmt"
+
+// Bool builds a Value that contains a boolean. It is shorthand for
+// NewValueBool.
+func Bool(v bool) Value { return NewValueBool(v) }
+
+// I8 builds a Value that contains a byte. It is shorthand for NewValueI8.
+func I8(v int8) Value { return NewValueI8(v) }
+
+// I16 builds a Value that contains an i16. It is shorthand for NewValueI16.
+func I16(v int16) Value { return NewValueI16(v) }
+
+// I32 builds a Value that contains an i32. It is shorthand for NewValueI32.
+func I32(v int32) Value { return NewValueI32(v) }
+
+// I64 builds a Value that contains an i64. It is shorthand for NewValueI64.
+func I64(v int64) Value { return NewValueI64(v) }
+
+// Double builds a Value that contains a double. It is shorthand for
+// NewValueDouble.
+func Double(v float64) Value { return NewValueDouble(v) }
+
+// Binary builds a Value that contains binary data. It is shorthand for
+// NewValueBinary.
+func Binary(v []byte) Value { return NewValueBinary(v) }
+
+// String builds a Value that contains a string. It is shorthand for
+// NewValueString.
+func String(v string) Value { return NewValueString(v) }
+
+// StructBuilder builds a struct Value one field at a time.
+//
+// 	v := wire.NewStruct().
+// 		Field(1, wire.String("hello")).
+// 		Field(2, wire.NewList(wire.TI32).Add(wire.I32(42)).Value()).
+// 		Value()
+type StructBuilder struct {
+	fields []Field
+}
+
+// NewStruct starts building a struct Value with no fields.
+func NewStruct() *StructBuilder {
+	return &StructBuilder{}
+}
+
+// Field adds a field with the given ID and value to the struct.
+//
+// Field panics if a field with the same ID was already added.
+func (b *StructBuilder) Field(id int16, v Value) *StructBuilder {
+	for _, f := range b.fields {
+		if f.ID == id {
+			panic(fmt.Sprintf("wire.StructBuilder: field %d was already added", id))
+		}
+	}
+	b.fields = append(b.fields, Field{ID: id, Value: v})
+	return b
+}
+
+// Struct returns the Struct built so far.
+func (b *StructBuilder) Struct() Struct {
+	fields := make([]Field, len(b.fields))
+	copy(fields, b.fields)
+	return Struct{Fields: fields}
+}
+
+// Value returns a Value containing the Struct built so far.
+func (b *StructBuilder) Value() Value {
+	return NewValueStruct(b.Struct())
+}
+
+// ListBuilder builds a list or set Value one item at a time.
+//
+// 	v := wire.NewSet(wire.TBinary).
+// 		Add(wire.String("foo")).
+// 		Add(wire.String("bar")).
+// 		Value()
+type ListBuilder struct {
+	typ   Type
+	set   bool
+	items []Value
+}
+
+// NewList starts building an empty list of items of the given type.
+func NewList(t Type) *ListBuilder {
+	return &ListBuilder{typ: t}
+}
+
+// NewSet starts building an empty set of items of the given type.
+func NewSet(t Type) *ListBuilder {
+	return &ListBuilder{typ: t, set: true}
+}
+
+// Add adds the given items to the list or set.
+//
+// Add panics if the type of an item does not match the type of the list or
+// set.
+func (b *ListBuilder) Add(vs ...Value) *ListBuilder {
+	for _, v := range vs {
+		if v.Type() != b.typ {
+			panic(fmt.Sprintf(
+				"wire.ListBuilder: cannot add %v to a collection of %v", v.Type(), b.typ))
+		}
+	}
+	b.items = append(b.items, vs...)
+	return b
+}
+
+// Value returns a Value containing the list or set built so far.
+func (b *ListBuilder) Value() Value {
+	items := make([]Value, len(b.items))
+	copy(items, b.items)
+
+	l := ValueListFromSlice(b.typ, items)
+	if b.set {
+		return NewValueSet(l)
+	}
+	return NewValueList(l)
+}
+
+// MapBuilder builds a map Value one item at a time.
+//
+// 	v := wire.NewMap(wire.TBinary, wire.TI32).
+// 		Add(wire.String("foo"), wire.I32(1)).
+// 		Value()
+type MapBuilder struct {
+	keyType, valueType Type
+	items              []MapItem
+}
+
+// NewMap starts building an empty map with the given key and value types.
+func NewMap(k, v Type) *MapBuilder {
+	return &MapBuilder{keyType: k, valueType: v}
+}
+
+// Add adds an item to the map.
+//
+// Add panics if the type of the key or the value does not match the types of
+// the map.
+func (b *MapBuilder) Add(k, v Value) *MapBuilder {
+	if k.Type() != b.keyType || v.Type() != b.valueType {
+		panic(fmt.Sprintf(
+			"wire.MapBuilder: cannot add %v: %v to a map<%v, %v>",
+			k.Type(), v.Type(), b.keyType, b.valueType))
+	}
+	b.items = append(b.items, MapItem{Key: k, Value: v})
+	return b
+}
+
+// Value returns a Value containing the map built so far.
+func (b *MapBuilder) Value() Value {
+	items := make([]MapItem, len(b.items))
+	copy(items, b.items)
+	return NewValueMap(MapItemListFromSlice(b.keyType, b.valueType, items))
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package wire
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilders(t *testing.T) {
+	tests := []struct {
+		desc string
+		give Value
+		want Value
+	}{
+		{
+			desc: "primitives",
+			give: NewStruct().
+				Field(1, Bool(true)).
+				Field(2, I8(1)).
+				Field(3, I16(2)).
+				Field(4, I32(3)).
+				Field(5, I64(4)).
+				Field(6, Double(5.0)).
+				Field(7, Binary([]byte("foo"))).
+				Field(8, String("bar")).
+				Value(),
+			want: NewValueStruct(Struct{Fields: []Field{
+				{ID: 1, Value: NewValueBool(true)},
+				{ID: 2, Value: NewValueI8(1)},
+				{ID: 3, Value: NewValueI16(2)},
+				{ID: 4, Value: NewValueI32(3)},
+				{ID: 5, Value: NewValueI64(4)},
+				{ID: 6, Value: NewValueDouble(5.0)},
+				{ID: 7, Value: NewValueBinary([]byte("foo"))},
+				{ID: 8, Value: NewValueString("bar")},
+			}}),
+		},
+		{
+			desc: "empty struct",
+			give: NewStruct().Value(),
+			want: NewValueStruct(Struct{Fields: []Field{}}),
+		},
+		{
+			desc: "list",
+			give: NewList(TI32).Add(I32(1), I32(2)).Add(I32(3)).Value(),
+			want: vlist(TI32, vi32(1), vi32(2), vi32(3)),
+		},
+		{
+			desc: "empty list",
+			give: NewList(TStruct).Value(),
+			want: vlist(TStruct),
+		},
+		{
+			desc: "set",
+			give: NewSet(TBinary).Add(String("a"), String("b")).Value(),
+			want: vset(TBinary, vbinary("b"), vbinary("a")),
+		},
+		{
+			desc: "map",
+			give: NewMap(TBinary, TI32).
+				Add(String("a"), I32(1)).
+				Add(String("b"), I32(2)).
+				Value(),
+			want: vmap(TBinary, TI32,
+				vitem(vbinary("a"), vi32(1)),
+				vitem(vbinary("b"), vi32(2)),
+			),
+		},
+		{
+			desc: "nested",
+			give: NewStruct().
+				Field(1, NewList(TStruct).
+					Add(NewStruct().Field(1, String("x")).Value()).
+					Value()).
+				Value(),
+			want: NewValueStruct(Struct{Fields: []Field{
+				{ID: 1, Value: vlist(TStruct, NewValueStruct(Struct{Fields: []Field{
+					{ID: 1, Value: vbinary("x")},
+				}}))},
+			}}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.True(t, ValuesAreEqual(tt.want, tt.give),
+				"expected %v, got %v", tt.want, tt.give)
+		})
+	}
+}
+
+func TestBuildersAreReusable(t *testing.T) {
+	b := NewStruct().Field(1, I32(1))
+	first := b.Value()
+	b.Field(2, I32(2))
+
+	assert.Len(t, first.GetStruct().Fields, 1)
+	assert.Len(t, b.Struct().Fields, 2)
+}
+
+func TestBuilderPanics(t *testing.T) {
+	assert.Panics(t, func() {
+		NewStruct().Field(1, I32(1)).Field(1, I32(2))
+	}, "duplicate field")
+
+	assert.Panics(t, func() {
+		NewList(TI32).Add(String("foo"))
+	}, "list item type mismatch")
+
+	assert.Panics(t, func() {
+		NewSet(TBinary).Add(I64(1))
+	}, "set item type mismatch")
+
+	assert.Panics(t, func() {
+		NewMap(TBinary, TI32).Add(String("foo"), String("bar"))
+	}, "map value type mismatch")
+}
@@ -26,7 +26,7 @@ import "fmt"
 // are spinned and any errors raised by them are returned.
 func EvaluateValue(v Value) error {
 	switch v.Type() {
-	case TBool, TI8, TDouble, TI16, TI32, TI64, TBinary:
+	case TBool, TI8, TDouble, TI16, TI32, TI64, TBinary, TUUID:
 		return nil
 	case TStruct:
 		for _, f := range v.GetStruct().Fields {
@@ -40,6 +40,105 @@ type ValueList interface {
 	Close()
 }
 
+// ValueListIterator reads the items of a ValueList one at a time. Unlike
+// ForEach, this allows callers to stop and resume iteration, or to step
+// through multiple lists in lockstep.
+//
+// Iterators are obtained with IterateValueList.
+//
+//   it := wire.IterateValueList(l)
+//   for it.Next() {
+//     process(it.Value())
+//   }
+//   if err := it.Err(); err != nil {
+//     return err
+//   }
+type ValueListIterator interface {
+	// Next advances the iterator to the next item and reports whether there
+	// was one. It returns false at the end of the list or if reading the
+	// next item failed.
+	Next() bool
+
+	// Value returns the item the iterator is positioned at. It may be called
+	// only after a call to Next returned true.
+	Value() Value
+
+	// Err returns the error, if any, which stopped the iteration.
+	Err() error
+}
+
+// MapItemListIterator reads the items of a MapItemList one at a time. It is
+// the MapItemList counterpart of ValueListIterator.
+//
+// Iterators are obtained with IterateMapItemList.
+type MapItemListIterator interface {
+	// Next advances the iterator to the next item and reports whether there
+	// was one. It returns false at the end of the list or if reading the
+	// next item failed.
+	Next() bool
+
+	// Item returns the item the iterator is positioned at. It may be called
+	// only after a call to Next returned true.
+	Item() MapItem
+
+	// Err returns the error, if any, which stopped the iteration.
+	Err() error
+}
+
+// iterableValueList is implemented by ValueLists which are able to provide
+// their own ValueListIterator.
+type iterableValueList interface {
+	Iterator() ValueListIterator
+}
+
+// iterableMapItemList is implemented by MapItemLists which are able to
+// provide their own MapItemListIterator.
+type iterableMapItemList interface {
+	Iterator() MapItemListIterator
+}
+
+// IterateValueList returns an iterator through the items of the given
+// ValueList.
+//
+// Lists built with ValueListFromSlice and lists decoded by the protocol
+// implementations in this library are iterated in place. Other lists may be
+// read into memory in their entirety first.
+//
+// The iterator does not close the list.
+func IterateValueList(l ValueList) ValueListIterator {
+	if il, ok := l.(iterableValueList); ok {
+		return il.Iterator()
+	}
+
+	values := make([]Value, 0, l.Size())
+	err := l.ForEach(func(v Value) error {
+		values = append(values, v)
+		return nil
+	})
+	return &sliceValueListIterator{values: values, err: err}
+}
+
+// IterateMapItemList returns an iterator through the items of the given
+// MapItemList.
+//
+// Lists built with MapItemListFromSlice and lists decoded by the protocol
+// implementations in this library are iterated in place. Other lists may be
+// read into memory in their entirety first.
+//
+// The iterator does not close the list.
+func IterateMapItemList(l MapItemList) MapItemListIterator {
+	if il, ok := l.(iterableMapItemList); ok {
+		return il.Iterator()
+	}
+
+	items := make([]MapItem, 0, l.Size())
+	err := l.ForEach(func(item MapItem) error {
+		items = append(items, item)
+		return nil
+	})
+	return &sliceMapItemListIterator{items: items, err: err}
+}
+
 // MapItemList represents a collection of MapItem objects as an iteration
 // through it. This helps us avoid the cost of allocating memory for all
 // collections passing through the system.
@@ -91,8 +190,43 @@ func (vs sliceValueList) ForEach(f func(Value) error) error {
 	return nil
 }
 
+func (vs sliceValueList) Iterator() ValueListIterator {
+	return &sliceValueListIterator{values: vs.values}
+}
+
 func (sliceValueList) Close() {}
 
+// sliceValueListIterator is a ValueListIterator through a slice of Values.
+//
+// If err is set, it is reported once Next returns false.
+type sliceValueListIterator struct {
+	values []Value
+	cur    Value
+	err    error
+	done   bool
+}
+
+func (it *sliceValueListIterator) Next() bool {
+	if len(it.values) == 0 {
+		it.done = true
+		return false
+	}
+	it.cur = it.values[0]
+	it.values = it.values[1:]
+	return true
+}
+
+func (it *sliceValueListIterator) Value() Value {
+	return it.cur
+}
+
+func (it *sliceValueListIterator) Err() error {
+	if !it.done {
+		return nil
+	}
+	return it.err
+}
+
 //////////////////////////////////////////////////////////////////////////////
 
 // MapItemListFromSlice builds a MapItemList from the given slice of Values.
@@ -126,8 +260,44 @@ func (vs sliceMapItemList) ForEach(f func(MapItem) error) error {
 	return nil
 }
 
+func (vs sliceMapItemList) Iterator() MapItemListIterator {
+	return &sliceMapItemListIterator{items: vs.items}
+}
+
 func (sliceMapItemList) Close() {}
 
+// sliceMapItemListIterator is a MapItemListIterator through a slice of
+// MapItems.
+//
+// If err is set, it is reported once Next returns false.
+type sliceMapItemListIterator struct {
+	items []MapItem
+	cur   MapItem
+	err   error
+	done  bool
+}
+
+func (it *sliceMapItemListIterator) Next() bool {
+	if len(it.items) == 0 {
+		it.done = true
+		return false
+	}
+	it.cur = it.items[0]
+	it.items = it.items[1:]
+	return true
+}
+
+func (it *sliceMapItemListIterator) Item() MapItem {
+	return it.cur
+}
+
+func (it *sliceMapItemListIterator) Err() error {
+	if !it.done {
+		return nil
+	}
+	return it.err
+}
+
 //////////////////////////////////////////////////////////////////////////////
 
 // ValueListToSlice builds a slice of values from the given ValueList.
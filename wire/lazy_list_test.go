@@ -132,3 +132,98 @@ func TestMapItemListFromSliceBreak(t *testing.T) {
 	assert.Equal(t, expectedErr, err)
 	assert.Equal(t, 2, i)
 }
+
+//////////////////////////////////////////////////////////////////////////////
+
+// forEachValueList is a ValueList which supports only ForEach iteration.
+type forEachValueList struct {
+	values []Value
+	err    error
+}
+
+func (l forEachValueList) Size() int       { return len(l.values) }
+func (l forEachValueList) ValueType() Type { return TI32 }
+func (l forEachValueList) Close()          {}
+
+func (l forEachValueList) ForEach(f func(Value) error) error {
+	for _, v := range l.values {
+		if err := f(v); err != nil {
+			return err
+		}
+	}
+	return l.err
+}
+
+func TestIterateValueList(t *testing.T) {
+	slice := []Value{
+		NewValueI32(1),
+		NewValueI32(2),
+		NewValueI32(3),
+	}
+
+	tests := []struct {
+		desc    string
+		list    ValueList
+		wantErr error
+	}{
+		{desc: "slice", list: ValueListFromSlice(TI32, slice)},
+		{desc: "ForEach only", list: forEachValueList{values: slice}},
+		{
+			desc:    "ForEach only with error",
+			list:    forEachValueList{values: slice, err: fmt.Errorf("great sadness")},
+			wantErr: fmt.Errorf("great sadness"),
+		},
+	}
+
+	for _, tt := range tests {
+		var got []Value
+		it := IterateValueList(tt.list)
+		for it.Next() {
+			assert.NoError(t, it.Err(), "%v: unexpected error before the end of the list", tt.desc)
+			got = append(got, it.Value())
+		}
+
+		assert.Equal(t, tt.wantErr, it.Err(), tt.desc)
+		if assert.Len(t, got, len(slice), tt.desc) {
+			for i, v := range got {
+				assert.True(t, ValuesAreEqual(slice[i], v), "%v: item %d: %v", tt.desc, i, v)
+			}
+		}
+		assert.False(t, it.Next(), "%v: iterator must stay exhausted", tt.desc)
+	}
+}
+
+func TestIterateMapItemList(t *testing.T) {
+	slice := []MapItem{
+		{Key: NewValueI32(1), Value: NewValueI64(101)},
+		{Key: NewValueI32(2), Value: NewValueI64(102)},
+	}
+
+	var got []MapItem
+	it := IterateMapItemList(MapItemListFromSlice(TI32, TI64, slice))
+	for it.Next() {
+		got = append(got, it.Item())
+	}
+
+	assert.NoError(t, it.Err())
+	if assert.Len(t, got, len(slice)) {
+		for i, item := range got {
+			assert.True(t, ValuesAreEqual(slice[i].Key, item.Key), "key %d: %v", i, item.Key)
+			assert.True(t, ValuesAreEqual(slice[i].Value, item.Value), "value %d: %v", i, item.Value)
+		}
+	}
+}
+
+func TestIteratorsAreIndependent(t *testing.T) {
+	l := ValueListFromSlice(TI32, []Value{NewValueI32(1), NewValueI32(2)})
+
+	first := IterateValueList(l)
+	second := IterateValueList(l)
+
+	assert.True(t, first.Next())
+	assert.True(t, first.Next())
+	assert.True(t, ValuesAreEqual(NewValueI32(2), first.Value()))
+
+	assert.True(t, second.Next())
+	assert.True(t, ValuesAreEqual(NewValueI32(1), second.Value()))
+}
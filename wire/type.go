@@ -36,6 +36,7 @@ const (
 	TMap    Type = 13
 	TSet    Type = 14
 	TList   Type = 15
+	TUUID   Type = 16
 )
 
 //go:generate stringer -type=Type
@@ -28,14 +28,14 @@ const (
 	_Type_name_0 = "TBoolTI8TDouble"
 	_Type_name_1 = "TI16"
 	_Type_name_2 = "TI32"
-	_Type_name_3 = "TI64TBinaryTStructTMapTSetTList"
+	_Type_name_3 = "TI64TBinaryTStructTMapTSetTListTUUID"
 )
 
 var (
 	_Type_index_0 = [...]uint8{0, 5, 8, 15}
 	_Type_index_1 = [...]uint8{0, 4}
 	_Type_index_2 = [...]uint8{0, 4}
-	_Type_index_3 = [...]uint8{0, 4, 11, 18, 22, 26, 31}
+	_Type_index_3 = [...]uint8{0, 4, 11, 18, 22, 26, 31, 36}
 )
 
 func (i Type) String() string {
@@ -47,7 +47,7 @@ func (i Type) String() string {
 		return _Type_name_1
 	case i == 8:
 		return _Type_name_2
-	case 10 <= i && i <= 15:
+	case 10 <= i && i <= 16:
 		i -= 10
 		return _Type_name_3[_Type_index_3[i]:_Type_index_3[i+1]]
 	default:
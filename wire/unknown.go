@@ -0,0 +1,127 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package wire
+
+// UnknownFields holds the fields of a struct which were not recognized when
+// it was decoded, in the order in which they were read.
+//
+// Code generated by ThriftRW with --preserve-unknown-fields adds an
+// UnknownFields field to structs and exceptions and writes these fields
+// back when they are encoded, so that services which are not aware of new
+// fields may pass them along unchanged.
+type UnknownFields []Field
+
+// Add records the given field.
+//
+// The field's value is copied in its entirety so that it remains valid
+// after the lists, sets, and maps it was decoded from are closed. An error
+// is returned if the value could not be read.
+func (u *UnknownFields) Add(f Field) error {
+	v, err := copyValue(f.Value)
+	if err != nil {
+		return err
+	}
+	*u = append(*u, Field{ID: f.ID, Value: v})
+	return nil
+}
+
+// Equals returns true if both lists have the same fields, in any order.
+func (u UnknownFields) Equals(other UnknownFields) bool {
+	return StructsAreEqual(Struct{Fields: u}, Struct{Fields: other})
+}
+
+// Clone returns a deep copy of these fields.
+func (u UnknownFields) Clone() UnknownFields {
+	if u == nil {
+		return nil
+	}
+
+	o := make(UnknownFields, len(u))
+	for i, f := range u {
+		// Fields which were added with Add are fully in memory and cannot
+		// fail to be copied.
+		v, _ := copyValue(f.Value)
+		o[i] = Field{ID: f.ID, Value: v}
+	}
+	return o
+}
+
+// copyValue returns a deep copy of the given value, reading lists, sets,
+// and maps into memory.
+func copyValue(v Value) (Value, error) {
+	switch v.Type() {
+	case TBinary:
+		return NewValueBinary(append([]byte{}, v.GetBinary()...)), nil
+	case TStruct:
+		fields := make([]Field, len(v.GetStruct().Fields))
+		for i, f := range v.GetStruct().Fields {
+			fv, err := copyValue(f.Value)
+			if err != nil {
+				return Value{}, err
+			}
+			fields[i] = Field{ID: f.ID, Value: fv}
+		}
+		return NewValueStruct(Struct{Fields: fields}), nil
+	case TMap:
+		m := v.GetMap()
+		defer m.Close()
+
+		items := make([]MapItem, 0, m.Size())
+		err := m.ForEach(func(item MapItem) error {
+			k, err := copyValue(item.Key)
+			if err != nil {
+				return err
+			}
+			v, err := copyValue(item.Value)
+			if err != nil {
+				return err
+			}
+			items = append(items, MapItem{Key: k, Value: v})
+			return nil
+		})
+		return NewValueMap(MapItemListFromSlice(m.KeyType(), m.ValueType(), items)), err
+	case TSet:
+		s := v.GetSet()
+		defer s.Close()
+
+		items, err := copyValues(s)
+		return NewValueSet(ValueListFromSlice(s.ValueType(), items)), err
+	case TList:
+		l := v.GetList()
+		defer l.Close()
+
+		items, err := copyValues(l)
+		return NewValueList(ValueListFromSlice(l.ValueType(), items)), err
+	default:
+		return v, nil
+	}
+}
+
+// copyValues returns deep copies of the items of the given ValueList.
+func copyValues(l ValueList) ([]Value, error) {
+	items := make([]Value, 0, l.Size())
+	err := l.ForEach(func(v Value) error {
+		c, err := copyValue(v)
+		items = append(items, c)
+		return err
+	})
+	return items, err
+}
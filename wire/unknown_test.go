@@ -0,0 +1,59 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package wire
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnknownFieldsAdd(t *testing.T) {
+	blob := []byte("foo")
+	var u UnknownFields
+	require.NoError(t, u.Add(Field{ID: 1, Value: NewValueBinary(blob)}))
+	require.NoError(t, u.Add(Field{ID: 2, Value: NewValueList(
+		ValueListFromSlice(TStruct, []Value{vstruct(Field{ID: 1, Value: NewValueI32(1)})}),
+	)}))
+
+	blob[0] = 'g'
+	assert.True(t, u.Equals(UnknownFields{
+		{ID: 2, Value: NewValueList(
+			ValueListFromSlice(TStruct, []Value{vstruct(Field{ID: 1, Value: NewValueI32(1)})}),
+		)},
+		{ID: 1, Value: NewValueBinary([]byte("foo"))},
+	}), "values must be copied: %v", u)
+
+	clone := u.Clone()
+	assert.True(t, u.Equals(clone))
+
+	clone[1].Value = NewValueBinary([]byte("bar"))
+	assert.False(t, u.Equals(clone))
+}
+
+func TestUnknownFieldsAddError(t *testing.T) {
+	var u UnknownFields
+	err := u.Add(Field{ID: 1, Value: NewValueList(failingList{err: errors.New("great sadness")})})
+	assert.EqualError(t, err, "great sadness")
+	assert.Empty(t, u)
+}
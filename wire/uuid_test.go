@@ -0,0 +1,114 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package wire
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseUUID(t *testing.T) {
+	want := UUID{
+		0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1,
+		0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8,
+	}
+
+	tests := []struct {
+		give    string
+		want    UUID
+		wantErr string
+	}{
+		{give: "6ba7b810-9dad-11d1-80b4-00c04fd430c8", want: want},
+		{give: "6BA7B810-9DAD-11D1-80B4-00C04FD430C8", want: want},
+		{give: "00000000-0000-0000-0000-000000000000", want: UUID{}},
+		{
+			give:    "",
+			wantErr: `invalid UUID "": must be in the form`,
+		},
+		{
+			give:    "6ba7b8109dad11d180b400c04fd430c8",
+			wantErr: `invalid UUID "6ba7b8109dad11d180b400c04fd430c8": must be in the form`,
+		},
+		{
+			give:    "6ba7b810-9dad-11d1-80b4_00c04fd430c8",
+			wantErr: `invalid UUID "6ba7b810-9dad-11d1-80b4_00c04fd430c8": must be in the form`,
+		},
+		{
+			give:    "6ba7b810-9dad-11d1-80b4-00c04fd430cg",
+			wantErr: `invalid UUID "6ba7b810-9dad-11d1-80b4-00c04fd430cg": encoding/hex: invalid byte`,
+		},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseUUID(tt.give)
+		if tt.wantErr != "" {
+			if assert.Error(t, err, tt.give) {
+				assert.Contains(t, err.Error(), tt.wantErr, tt.give)
+			}
+			continue
+		}
+
+		if assert.NoError(t, err, tt.give) {
+			assert.Equal(t, tt.want, got, tt.give)
+		}
+	}
+}
+
+func TestUUIDString(t *testing.T) {
+	u := UUID{
+		0x6b, 0xa7, 0xb8, 0x10, 0x9d, 0xad, 0x11, 0xd1,
+		0x80, 0xb4, 0x00, 0xc0, 0x4f, 0xd4, 0x30, 0xc8,
+	}
+	assert.Equal(t, "6ba7b810-9dad-11d1-80b4-00c04fd430c8", u.String())
+	assert.Equal(t, "00000000-0000-0000-0000-000000000000", UUID{}.String())
+}
+
+func TestUUIDJSON(t *testing.T) {
+	u, err := ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	require.NoError(t, err)
+
+	b, err := json.Marshal(u)
+	require.NoError(t, err)
+	assert.Equal(t, `"6ba7b810-9dad-11d1-80b4-00c04fd430c8"`, string(b))
+
+	var got UUID
+	require.NoError(t, json.Unmarshal(b, &got))
+	assert.Equal(t, u, got)
+
+	assert.Error(t, json.Unmarshal([]byte(`"foo"`), &got))
+}
+
+func TestUUIDValue(t *testing.T) {
+	u, err := ParseUUID("6ba7b810-9dad-11d1-80b4-00c04fd430c8")
+	require.NoError(t, err)
+
+	v := NewValueUUID(u)
+	assert.Equal(t, TUUID, v.Type())
+	assert.Equal(t, u, v.GetUUID())
+	assert.Equal(t, u, v.Get())
+	assert.Equal(t, "TUUID(6ba7b810-9dad-11d1-80b4-00c04fd430c8)", v.String())
+
+	assert.True(t, ValuesAreEqual(v, NewValueUUID(u)))
+	assert.False(t, ValuesAreEqual(v, NewValueUUID(UUID{})))
+}
@@ -39,7 +39,7 @@ type Value struct {
 	tnumber uint64
 	tbinary []byte
 	tstruct Struct
-	tcoll   interface{} // set/map/list
+	tcoll   interface{} // set/map/list/uuid
 }
 
 // Type retrieves the type of value inside a Value.
@@ -70,6 +70,8 @@ func (v *Value) Get() interface{} {
 		return v.GetMap()
 	case TSet, TList:
 		return v.GetList()
+	case TUUID:
+		return v.GetUUID()
 	default:
 		panic(fmt.Sprintf("Unknown value type %v", v.typ))
 	}
@@ -170,10 +172,7 @@ func NewValueBinary(v []byte) Value {
 
 // NewValueString constructs a new Value that contains a string.
 func NewValueString(v string) Value {
-	return Value{
-		typ:     TBinary,
-		tbinary: unsafeStringToBytes(v),
-	}
+	return NewValueBinary(unsafeStringToBytes(v))
 }
 
 // GetBinary gets the Binary value from a Value.
@@ -183,7 +182,7 @@ func (v *Value) GetBinary() []byte {
 
 // GetString gets a string value from a Value.
 func (v *Value) GetString() string {
-	return unsafeBytesToString(v.tbinary)
+	return unsafeBytesToString(v.GetBinary())
 }
 
 // NewValueStruct constructs a new Value that contains a struct.
@@ -238,6 +237,22 @@ func (v *Value) GetList() ValueList {
 	return v.tcoll.(ValueList)
 }
 
+// NewValueUUID constructs a new Value that contains a UUID.
+func NewValueUUID(v UUID) Value {
+	return Value{
+		typ:   TUUID,
+		tcoll: v,
+	}
+}
+
+// GetUUID gets the UUID value from a Value.
+func (v *Value) GetUUID() UUID {
+	u, _ := v.tcoll.(UUID)
+	return u
+}
+
+// String returns a compact, single-line representation of the Value. Use
+// Dump for a representation better suited to debugging nested values.
 func (v Value) String() string {
 	switch v.typ {
 	case TBool:
@@ -253,15 +268,17 @@ func (v Value) String() string {
 	case TI64:
 		return fmt.Sprintf("TI64(%v)", v.GetI64())
 	case TBinary:
-		return fmt.Sprintf("TBinary(%v)", v.tbinary)
+		return fmt.Sprintf("TBinary(%v)", v.GetBinary())
 	case TStruct:
-		return fmt.Sprintf("TStruct(%v)", v.tstruct)
+		return fmt.Sprintf("TStruct(%v)", v.GetStruct())
 	case TMap:
 		return fmt.Sprintf("TMap(%v)", v.tcoll)
 	case TSet:
 		return fmt.Sprintf("TSet(%v)", v.tcoll)
 	case TList:
 		return fmt.Sprintf("TList(%v)", v.tcoll)
+	case TUUID:
+		return fmt.Sprintf("TUUID(%v)", v.GetUUID())
 	default:
 		panic(fmt.Sprintf("Unknown value type %v", v.typ))
 	}
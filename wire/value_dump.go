@@ -0,0 +1,140 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package wire
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Dump returns a readable, multi-line representation of the given Value for
+// debugging. Unlike Value.String, it shows the items of lazily decoded
+// lists, sets, and maps, and it lists struct fields in the order of their
+// IDs so that dumps of equal structs are the same.
+//
+//   TStruct{
+//     1: TBinary("hello")
+//     2: TList<TI32>[
+//       TI32(1)
+//       TI32(2)
+//     ]
+//   }
+//
+// Errors encountered while reading lazily decoded collections are included
+// in the output.
+func Dump(v Value) string {
+	var buf bytes.Buffer
+	dumpValue(&buf, v, 0)
+	return buf.String()
+}
+
+func dumpValue(buf *bytes.Buffer, v Value, depth int) {
+	indent := strings.Repeat("  ", depth+1)
+	closing := indent[:len(indent)-2]
+
+	switch v.typ {
+	case TBinary:
+		fmt.Fprintf(buf, "TBinary(%q)", v.GetBinary())
+
+	case TStruct:
+		fields := make(fieldsByID, len(v.GetStruct().Fields))
+		copy(fields, v.GetStruct().Fields)
+		sort.Stable(fields)
+
+		buf.WriteString("TStruct{")
+		if len(fields) > 0 {
+			buf.WriteString("\n")
+		}
+		for _, f := range fields {
+			fmt.Fprintf(buf, "%v%v: ", indent, f.ID)
+			dumpValue(buf, f.Value, depth+1)
+			buf.WriteString("\n")
+		}
+		if len(fields) > 0 {
+			buf.WriteString(closing)
+		}
+		buf.WriteString("}")
+
+	case TMap:
+		m := v.GetMap()
+		fmt.Fprintf(buf, "TMap<%v, %v>{", m.KeyType(), m.ValueType())
+		if m.Size() > 0 {
+			buf.WriteString("\n")
+		}
+		err := m.ForEach(func(item MapItem) error {
+			buf.WriteString(indent)
+			dumpValue(buf, item.Key, depth+1)
+			buf.WriteString(": ")
+			dumpValue(buf, item.Value, depth+1)
+			buf.WriteString("\n")
+			return nil
+		})
+		dumpError(buf, err, indent)
+		if m.Size() > 0 {
+			buf.WriteString(closing)
+		}
+		buf.WriteString("}")
+
+	case TSet, TList:
+		var l ValueList
+		if v.typ == TSet {
+			l = v.GetSet()
+		} else {
+			l = v.GetList()
+		}
+
+		fmt.Fprintf(buf, "%v<%v>[", v.typ, l.ValueType())
+		if l.Size() > 0 {
+			buf.WriteString("\n")
+		}
+		err := l.ForEach(func(item Value) error {
+			buf.WriteString(indent)
+			dumpValue(buf, item, depth+1)
+			buf.WriteString("\n")
+			return nil
+		})
+		dumpError(buf, err, indent)
+		if l.Size() > 0 {
+			buf.WriteString(closing)
+		}
+		buf.WriteString("]")
+
+	case TBool, TI8, TDouble, TI16, TI32, TI64, TUUID:
+		buf.WriteString(v.String())
+
+	default:
+		fmt.Fprintf(buf, "%v", v.typ)
+	}
+}
+
+func dumpError(buf *bytes.Buffer, err error, indent string) {
+	if err != nil {
+		fmt.Fprintf(buf, "%v<error: %v>\n", indent, err)
+	}
+}
+
+type fieldsByID []Field
+
+func (fs fieldsByID) Len() int           { return len(fs) }
+func (fs fieldsByID) Less(i, j int) bool { return fs[i].ID < fs[j].ID }
+func (fs fieldsByID) Swap(i, j int)      { fs[i], fs[j] = fs[j], fs[i] }
@@ -0,0 +1,117 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package wire
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// failingList is a ValueList which fails after yielding its items.
+type failingList struct {
+	items []Value
+	err   error
+}
+
+func (l failingList) Size() int       { return len(l.items) + 1 }
+func (l failingList) ValueType() Type { return TI32 }
+func (l failingList) Close()          {}
+
+func (l failingList) ForEach(f func(Value) error) error {
+	for _, v := range l.items {
+		if err := f(v); err != nil {
+			return err
+		}
+	}
+	return l.err
+}
+
+func TestDump(t *testing.T) {
+	tests := []struct {
+		desc string
+		give Value
+		want string
+	}{
+		{
+			desc: "primitive",
+			give: vi32(42),
+			want: "TI32(42)",
+		},
+		{
+			desc: "binary",
+			give: vbinary("foo\n"),
+			want: `TBinary("foo\n")`,
+		},
+		{
+			desc: "empty struct",
+			give: vstruct(),
+			want: "TStruct{}",
+		},
+		{
+			desc: "empty list",
+			give: vlist(TI32),
+			want: "TList<TI32>[]",
+		},
+		{
+			desc: "struct fields are sorted",
+			give: vstruct(
+				Field{ID: 3, Value: vset(TBinary, vbinary("a"), vbinary("b"))},
+				Field{ID: 1, Value: NewValueBool(true)},
+				Field{ID: 2, Value: vmap(TI32, TStruct,
+					vitem(vi32(1), vstruct(Field{ID: 1, Value: vlist(TI32, vi32(2))})),
+				)},
+			),
+			want: `TStruct{
+  1: TBool(true)
+  2: TMap<TI32, TStruct>{
+    TI32(1): TStruct{
+      1: TList<TI32>[
+        TI32(2)
+      ]
+    }
+  }
+  3: TSet<TBinary>[
+    TBinary("a")
+    TBinary("b")
+  ]
+}`,
+		},
+		{
+			desc: "list error",
+			give: NewValueList(failingList{
+				items: []Value{vi32(1)},
+				err:   errors.New("great sadness"),
+			}),
+			want: `TList<TI32>[
+  TI32(1)
+  <error: great sadness>
+]`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.Equal(t, tt.want, Dump(tt.give))
+		})
+	}
+}
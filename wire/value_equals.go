@@ -50,15 +50,17 @@ func ValuesAreEqual(left, right Value) bool {
 	case TI64:
 		return left.GetI64() == right.GetI64()
 	case TBinary:
-		return bytes.Equal(left.tbinary, right.tbinary)
+		return bytes.Equal(left.GetBinary(), right.GetBinary())
 	case TStruct:
-		return StructsAreEqual(left.tstruct, right.tstruct)
+		return StructsAreEqual(left.GetStruct(), right.GetStruct())
 	case TMap:
-		return MapsAreEqual(left.tcoll.(MapItemList), right.tcoll.(MapItemList))
+		return MapsAreEqual(left.GetMap(), right.GetMap())
 	case TSet:
-		return SetsAreEqual(left.tcoll.(ValueList), right.tcoll.(ValueList))
+		return SetsAreEqual(left.GetSet(), right.GetSet())
 	case TList:
-		return ListsAreEqual(left.tcoll.(ValueList), right.tcoll.(ValueList))
+		return ListsAreEqual(left.GetList(), right.GetList())
+	case TUUID:
+		return left.GetUUID() == right.GetUUID()
 	default:
 		return false
 	}
@@ -204,7 +206,7 @@ func mapsAreEqualUnhashable(size int, l, r MapItemList) bool {
 
 func isHashable(t Type) bool {
 	switch t {
-	case TBool, TI8, TDouble, TI16, TI32, TI64, TBinary:
+	case TBool, TI8, TDouble, TI16, TI32, TI64, TBinary, TUUID:
 		return true
 	default:
 		return false
@@ -213,7 +215,7 @@ func isHashable(t Type) bool {
 
 func toHashable(v Value) interface{} {
 	switch v.Type() {
-	case TBool, TI8, TDouble, TI16, TI32, TI64:
+	case TBool, TI8, TDouble, TI16, TI32, TI64, TUUID:
 		return v.Get()
 	case TBinary:
 		return string(v.GetBinary())
@@ -231,15 +233,15 @@ func ListsAreEqual(left, right ValueList) bool {
 		return false
 	}
 
-	leftItems := ValueListToSlice(left)
-	rightItems := ValueListToSlice(right)
-
-	for i, lv := range leftItems {
-		rv := rightItems[i]
-		if !ValuesAreEqual(lv, rv) {
+	// Step through both lists in lockstep so that neither has to be read
+	// into memory.
+	li := IterateValueList(left)
+	ri := IterateValueList(right)
+	for li.Next() {
+		if !ri.Next() || !ValuesAreEqual(li.Value(), ri.Value()) {
 			return false
 		}
 	}
 
-	return true
+	return !ri.Next() && li.Err() == nil && ri.Err() == nil
 }
@@ -0,0 +1,122 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package wire
+
+import (
+	"encoding/binary"
+	"hash/fnv"
+	"math"
+)
+
+// Hash returns a hash of the given Value. Values which are equal according
+// to ValuesAreEqual have the same hash so Hash may be used together with
+// ValuesAreEqual to key caches and hash tables by Values without encoding
+// them.
+//
+// The order of struct fields, set items, and map items does not affect the
+// hash, and the hash does not change between runs of a program.
+func Hash(v Value) uint64 {
+	h := fnv.New64a()
+
+	var buf [8]byte
+	write := func(n uint64) {
+		binary.BigEndian.PutUint64(buf[:], n)
+		h.Write(buf[:])
+	}
+
+	h.Write([]byte{byte(v.typ)})
+	switch v.typ {
+	case TBool:
+		if v.GetBool() {
+			write(1)
+		} else {
+			write(0)
+		}
+	case TI8:
+		write(uint64(v.GetI8()))
+	case TDouble:
+		d := v.GetDouble()
+		if d == 0 {
+			d = 0 // -0 == 0 so they must have the same hash
+		}
+		write(math.Float64bits(d))
+	case TI16:
+		write(uint64(v.GetI16()))
+	case TI32:
+		write(uint64(v.GetI32()))
+	case TI64:
+		write(uint64(v.GetI64()))
+	case TBinary:
+		b := v.GetBinary()
+		write(uint64(len(b)))
+		h.Write(b)
+	case TStruct:
+		// Fields are unordered so their hashes are combined with a
+		// commutative operation.
+		var sum uint64
+		for id, fv := range v.GetStruct().fieldMap() {
+			sum += hashPair(uint64(id), Hash(fv))
+		}
+		write(sum)
+	case TMap:
+		m := v.GetMap()
+		h.Write([]byte{byte(m.KeyType()), byte(m.ValueType())})
+		var sum uint64
+		_ = m.ForEach(func(item MapItem) error {
+			sum += hashPair(Hash(item.Key), Hash(item.Value))
+			return nil
+		})
+		write(sum)
+	case TSet:
+		l := v.GetSet()
+		h.Write([]byte{byte(l.ValueType())})
+		var sum uint64
+		_ = l.ForEach(func(item Value) error {
+			sum += Hash(item)
+			return nil
+		})
+		write(sum)
+	case TList:
+		l := v.GetList()
+		h.Write([]byte{byte(l.ValueType())})
+		write(uint64(l.Size()))
+		_ = l.ForEach(func(item Value) error {
+			write(Hash(item))
+			return nil
+		})
+	case TUUID:
+		u := v.GetUUID()
+		h.Write(u[:])
+	}
+
+	return h.Sum64()
+}
+
+// hashPair hashes an ordered pair of hashes.
+func hashPair(a, b uint64) uint64 {
+	var buf [16]byte
+	binary.BigEndian.PutUint64(buf[:8], a)
+	binary.BigEndian.PutUint64(buf[8:], b)
+
+	h := fnv.New64a()
+	h.Write(buf[:])
+	return h.Sum64()
+}
@@ -0,0 +1,111 @@
+// Copyright (c) 2017 Uber Technologies, Inc.
+//
+// Permission is hereby granted, free of charge, to any person obtaining a copy
+// of this software and associated documentation files (the "Software"), to deal
+// in the Software without restriction, including without limitation the rights
+// to use, copy, modify, merge, publish, distribute, sublicense, and/or sell
+// copies of the Software, and to permit persons to whom the Software is
+// furnished to do so, subject to the following conditions:
+//
+// The above copyright notice and this permission notice shall be included in
+// all copies or substantial portions of the Software.
+//
+// THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+// IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY,
+// FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE
+// AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER
+// LIABILITY, WHETHER IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM,
+// OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
+// THE SOFTWARE.
+
+package wire
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func vstruct(fs ...Field) Value {
+	return NewValueStruct(Struct{Fields: fs})
+}
+
+func TestHashEqualValues(t *testing.T) {
+	tests := []struct {
+		desc string
+		l, r Value
+	}{
+		{
+			desc: "struct field order",
+			l:    vstruct(Field{ID: 1, Value: vi32(1)}, Field{ID: 2, Value: vbinary("foo")}),
+			r:    vstruct(Field{ID: 2, Value: vbinary("foo")}, Field{ID: 1, Value: vi32(1)}),
+		},
+		{
+			desc: "negative zero",
+			l:    NewValueDouble(0),
+			r:    NewValueDouble(math.Copysign(0, -1)),
+		},
+		{
+			desc: "nested",
+			l: vlist(TStruct,
+				vstruct(Field{ID: 1, Value: vset(TI32, vi32(1), vi32(2))}),
+			),
+			r: vlist(TStruct,
+				vstruct(Field{ID: 1, Value: vset(TI32, vi32(2), vi32(1))}),
+			),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.True(t, ValuesAreEqual(tt.l, tt.r), "values must be equal")
+			assert.Equal(t, Hash(tt.l), Hash(tt.r))
+		})
+	}
+}
+
+func TestHashUnequalValues(t *testing.T) {
+	tests := []struct {
+		desc string
+		l, r Value
+	}{
+		{
+			desc: "different types",
+			l:    NewValueI16(1),
+			r:    NewValueI32(1),
+		},
+		{
+			desc: "binary boundaries",
+			l:    vlist(TBinary, vbinary("ab"), vbinary("c")),
+			r:    vlist(TBinary, vbinary("a"), vbinary("bc")),
+		},
+		{
+			desc: "list order",
+			l:    vlist(TI32, vi32(1), vi32(2)),
+			r:    vlist(TI32, vi32(2), vi32(1)),
+		},
+		{
+			desc: "map keys and values",
+			l:    vmap(TI32, TI32, vitem(vi32(1), vi32(2))),
+			r:    vmap(TI32, TI32, vitem(vi32(2), vi32(1))),
+		},
+		{
+			desc: "struct field IDs",
+			l:    vstruct(Field{ID: 1, Value: vi32(1)}),
+			r:    vstruct(Field{ID: 2, Value: vi32(1)}),
+		},
+		{
+			desc: "set value types",
+			l:    vset(TI32),
+			r:    vset(TI64),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assert.False(t, ValuesAreEqual(tt.l, tt.r), "values must not be equal")
+			assert.NotEqual(t, Hash(tt.l), Hash(tt.r))
+		})
+	}
+}
@@ -109,5 +109,68 @@ func TestEquals(t *testing.T) {
 			t, ValuesAreEqual(tt.l, tt.r),
 			"Values should be equal:\n\t   %v\n\t!= %v", tt.l, tt.r,
 		)
+		assert.Equal(
+			t, Hash(tt.l), Hash(tt.r),
+			"Equal values should have the same hash:\n\t   %v\n\t!= %v", tt.l, tt.r,
+		)
+	}
+}
+
+func TestValueBinary(t *testing.T) {
+	tests := []struct {
+		desc string
+		give []byte
+		want []byte
+	}{
+		{desc: "nil", give: nil, want: []byte{}},
+		{desc: "empty", give: []byte{}, want: []byte{}},
+		{desc: "bytes", give: []byte("hello"), want: []byte("hello")},
+		{desc: "slice", give: []byte("hello world")[:5], want: []byte("hello")},
+	}
+
+	for _, tt := range tests {
+		v := NewValueBinary(tt.give)
+		assert.Equal(t, tt.want, v.GetBinary(), tt.desc)
+		assert.Equal(t, string(tt.want), v.GetString(), tt.desc)
+	}
+
+	assert.Nil(t, (&Value{}).GetBinary(), "zero Value")
+}
+
+func TestValueStruct(t *testing.T) {
+	tests := []struct {
+		desc string
+		give Struct
+	}{
+		{desc: "nil", give: Struct{}},
+		{desc: "empty", give: Struct{Fields: []Field{}}},
+		{
+			desc: "fields",
+			give: Struct{Fields: []Field{
+				{ID: 1, Value: vi32(42)},
+				{ID: 2, Value: vbinary("foo")},
+			}},
+		},
+	}
+
+	for _, tt := range tests {
+		v := NewValueStruct(tt.give)
+		assert.Equal(t, tt.give, v.GetStruct(), tt.desc)
+	}
+}
+
+func TestValueDeepEqual(t *testing.T) {
+	// Values built separately from equal inputs must be deeply equal so that
+	// they can be compared with assert.Equal.
+	tests := []func() Value{
+		func() Value { return vbinary("foo") },
+		func() Value { return NewValueString("foo") },
+		func() Value { return vstruct(Field{ID: 1, Value: vi32(42)}, Field{ID: 2, Value: vbinary("foo")}) },
+		func() Value { return vlist(TBinary, vbinary("a"), vbinary("b")) },
+	}
+
+	for _, build := range tests {
+		want := build()
+		assert.Equal(t, want, build())
 	}
 }